@@ -7,12 +7,18 @@ import (
 	"github.com/urfave/cli/v2"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
+	"time"
 )
 
+// stopTimeout bounds the graceful shutdown, in which workers finish or return the work in progress;
+// it should be shorter than the time a container runtime waits before killing the process.
+const stopTimeout = 30 * time.Second
+
 func New() *fx.App {
 	return fx.New(
 		appfx.New(),
 		loggingfx.WithLogger(),
+		fx.StopTimeout(stopTimeout),
 		fx.Invoke(func(
 			logger *zap.SugaredLogger,
 			_ *cli.App,
@@ -1,25 +1,59 @@
 package appfx
 
 import (
+	"github.com/bitmagnet-io/bitmagnet/internal/app/cmd/backupcmd"
+	"github.com/bitmagnet-io/bitmagnet/internal/app/cmd/classifiercmd"
+	"github.com/bitmagnet-io/bitmagnet/internal/app/cmd/classifycmd"
+	"github.com/bitmagnet-io/bitmagnet/internal/app/cmd/coveragecmd"
+	"github.com/bitmagnet-io/bitmagnet/internal/app/cmd/databasecmd"
+	"github.com/bitmagnet-io/bitmagnet/internal/app/cmd/queuecmd"
 	"github.com/bitmagnet-io/bitmagnet/internal/app/cmd/reprocesscmd"
+	"github.com/bitmagnet-io/bitmagnet/internal/app/cmd/takedowncmd"
 	"github.com/bitmagnet-io/bitmagnet/internal/app/cmd/torrentcmd"
+	"github.com/bitmagnet-io/bitmagnet/internal/audit/auditfx"
+	"github.com/bitmagnet-io/bitmagnet/internal/auth/authfx"
 	"github.com/bitmagnet-io/bitmagnet/internal/blocking/blockingfx"
+	"github.com/bitmagnet-io/bitmagnet/internal/blocklist/blocklistfx"
 	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/app/boilerplateappfx"
 	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/httpserver/httpserverfx"
 	"github.com/bitmagnet-io/bitmagnet/internal/classifier/classifierfx"
+	"github.com/bitmagnet-io/bitmagnet/internal/contentrefresh/contentrefreshfx"
 	"github.com/bitmagnet-io/bitmagnet/internal/database/databasefx"
 	"github.com/bitmagnet-io/bitmagnet/internal/database/migrations"
 	"github.com/bitmagnet-io/bitmagnet/internal/dhtcrawler/dhtcrawlerfx"
+	"github.com/bitmagnet-io/bitmagnet/internal/download/downloadfx"
+	"github.com/bitmagnet-io/bitmagnet/internal/dumpimport/dumpimportfx"
+	"github.com/bitmagnet-io/bitmagnet/internal/events/eventsfx"
+	"github.com/bitmagnet-io/bitmagnet/internal/feed/feedfx"
+	"github.com/bitmagnet-io/bitmagnet/internal/filesindex/filesindexfx"
 	"github.com/bitmagnet-io/bitmagnet/internal/gql/gqlfx"
+	"github.com/bitmagnet-io/bitmagnet/internal/imageproxy/imageproxyfx"
 	"github.com/bitmagnet-io/bitmagnet/internal/importer/importerfx"
+	"github.com/bitmagnet-io/bitmagnet/internal/indexstats/indexstatsfx"
+	"github.com/bitmagnet-io/bitmagnet/internal/maintenance/maintenancefx"
 	"github.com/bitmagnet-io/bitmagnet/internal/processor/processorfx"
 	"github.com/bitmagnet-io/bitmagnet/internal/protocol/dht/dhtfx"
 	"github.com/bitmagnet-io/bitmagnet/internal/protocol/metainfo/metainfofx"
 	"github.com/bitmagnet-io/bitmagnet/internal/queue/queuefx"
 	"github.com/bitmagnet-io/bitmagnet/internal/redis/redisfx"
+	"github.com/bitmagnet-io/bitmagnet/internal/releasename/releasenamefx"
+	"github.com/bitmagnet-io/bitmagnet/internal/retention/retentionfx"
+	"github.com/bitmagnet-io/bitmagnet/internal/savedsearch/savedsearchfx"
+	"github.com/bitmagnet-io/bitmagnet/internal/scaling/scalingfx"
+	"github.com/bitmagnet-io/bitmagnet/internal/servarr/servarrfx"
+	"github.com/bitmagnet-io/bitmagnet/internal/spam/spamfx"
+	"github.com/bitmagnet-io/bitmagnet/internal/takedown/takedownfx"
+	"github.com/bitmagnet-io/bitmagnet/internal/taskrun/taskrunfx"
 	"github.com/bitmagnet-io/bitmagnet/internal/telemetry/telemetryfx"
+	"github.com/bitmagnet-io/bitmagnet/internal/torrentexport/torrentexportfx"
+	"github.com/bitmagnet-io/bitmagnet/internal/torrentstatus/torrentstatusfx"
 	"github.com/bitmagnet-io/bitmagnet/internal/torznab/torznabfx"
+	"github.com/bitmagnet-io/bitmagnet/internal/torznabimport/torznabimportfx"
+	"github.com/bitmagnet-io/bitmagnet/internal/trackerscraper/trackerscraperfx"
 	"github.com/bitmagnet-io/bitmagnet/internal/version/versionfx"
+	"github.com/bitmagnet-io/bitmagnet/internal/wanted/wantedfx"
+	"github.com/bitmagnet-io/bitmagnet/internal/watchlist/watchlistfx"
+	"github.com/bitmagnet-io/bitmagnet/internal/webhook/webhookfx"
 	"github.com/bitmagnet-io/bitmagnet/internal/webui"
 	"go.uber.org/fx"
 )
@@ -27,25 +61,59 @@ import (
 func New() fx.Option {
 	return fx.Module(
 		"app",
+		auditfx.New(),
+		authfx.New(),
 		blockingfx.New(),
+		blocklistfx.New(),
 		boilerplateappfx.New(),
 		classifierfx.New(),
+		contentrefreshfx.New(),
 		dhtcrawlerfx.New(),
 		dhtfx.New(),
 		databasefx.New(),
+		downloadfx.New(),
+		dumpimportfx.New(),
+		eventsfx.New(),
+		feedfx.New(),
+		filesindexfx.New(),
 		gqlfx.New(),
 		httpserverfx.New(),
+		imageproxyfx.New(),
 		importerfx.New(),
+		indexstatsfx.New(),
+		maintenancefx.New(),
 		metainfofx.New(),
 		processorfx.New(),
 		queuefx.New(),
 		redisfx.New(),
+		releasenamefx.New(),
+		retentionfx.New(),
+		savedsearchfx.New(),
+		scalingfx.New(),
+		servarrfx.New(),
+		spamfx.New(),
+		takedownfx.New(),
+		taskrunfx.New(),
 		telemetryfx.New(),
+		torrentexportfx.New(),
+		torrentstatusfx.New(),
 		torznabfx.New(),
+		torznabimportfx.New(),
+		trackerscraperfx.New(),
 		versionfx.New(),
+		wantedfx.New(),
+		watchlistfx.New(),
+		webhookfx.New(),
 		// cli commands:
 		fx.Provide(
+			backupcmd.New,
+			classifiercmd.New,
+			classifycmd.New,
+			coveragecmd.New,
+			databasecmd.New,
+			queuecmd.New,
 			reprocesscmd.New,
+			takedowncmd.New,
 			torrentcmd.New,
 		),
 		fx.Provide(webui.New),
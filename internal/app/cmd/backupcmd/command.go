@@ -0,0 +1,105 @@
+package backupcmd
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/backup"
+	"github.com/urfave/cli/v2"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+type Params struct {
+	fx.In
+	Archiver lazy.Lazy[backup.Archiver]
+	Logger   *zap.SugaredLogger
+}
+
+type Result struct {
+	fx.Out
+	BackupCommand  *cli.Command `group:"commands"`
+	RestoreCommand *cli.Command `group:"commands"`
+}
+
+func New(p Params) (Result, error) {
+	return Result{
+		BackupCommand: &cli.Command{
+			Name:  "backup",
+			Usage: "write a consistent backup of the database to a file, or upload it to an HTTP(S) URL such as a presigned S3 URL",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     "to",
+					Usage:    "a file path, or an HTTP(S) URL to upload the backup to",
+					Required: true,
+				},
+				&cli.BoolFlag{
+					Name:  "excludeTransient",
+					Usage: "exclude tables of transient bookkeeping, such as task run and webhook delivery history",
+				},
+				&cli.StringSliceFlag{
+					Name:  "exclude",
+					Usage: "tables to exclude",
+				},
+			},
+			Action: func(ctx *cli.Context) error {
+				a, err := p.Archiver.Get()
+				if err != nil {
+					return err
+				}
+				exclude := ctx.StringSlice("exclude")
+				if ctx.Bool("excludeTransient") {
+					exclude = append(exclude, backup.TransientTables...)
+				}
+				w, err := backup.Create(ctx.Context, ctx.String("to"))
+				if err != nil {
+					return err
+				}
+				header, err := a.Backup(ctx.Context, w, backup.Options{Exclude: exclude})
+				if err != nil {
+					_ = w.Close()
+					return err
+				}
+				if err := w.Close(); err != nil {
+					return err
+				}
+				p.Logger.Infow("backup complete", "schemaVersion", header.SchemaVersion, "tables", len(header.Tables))
+				return nil
+			},
+		},
+		RestoreCommand: &cli.Command{
+			Name: "restore",
+			Usage: "replace the contents of the database with a backup read from a file or an HTTP(S) URL; " +
+				"the database must be migrated to the backup's schema version, and all other bitmagnet processes stopped",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     "from",
+					Usage:    "a file path, or an HTTP(S) URL to download the backup from",
+					Required: true,
+				},
+			},
+			Action: func(ctx *cli.Context) error {
+				a, err := p.Archiver.Get()
+				if err != nil {
+					return err
+				}
+				r, err := backup.Open(ctx.Context, ctx.String("from"))
+				if err != nil {
+					return err
+				}
+				defer func() {
+					_ = r.Close()
+				}()
+				header, err := a.Restore(ctx.Context, r)
+				if err != nil {
+					return err
+				}
+				p.Logger.Infow(
+					"restore complete",
+					"createdAt", header.CreatedAt,
+					"schemaVersion", header.SchemaVersion,
+					"tables", len(header.Tables),
+				)
+				return nil
+			},
+		},
+	}, nil
+}
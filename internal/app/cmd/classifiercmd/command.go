@@ -0,0 +1,167 @@
+package classifiercmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/bitmagnet-io/bitmagnet/internal/classifier"
+	"github.com/bitmagnet-io/bitmagnet/internal/classifier/bench"
+	"github.com/bitmagnet-io/bitmagnet/internal/importer"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/urfave/cli/v2"
+	"go.uber.org/fx"
+	"io"
+	"os"
+)
+
+type Params struct {
+	fx.In
+	Classifier lazy.Lazy[classifier.Classifier]
+}
+
+type Result struct {
+	fx.Out
+	Command *cli.Command `group:"commands"`
+}
+
+func New(p Params) (Result, error) {
+	return Result{Command: &cli.Command{
+		Name: "classifier",
+		Subcommands: []*cli.Command{
+			{
+				Name:  "bench",
+				Usage: "Classify a labeled dataset and report the precision and recall of each content type",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "file",
+						Value: "-",
+						Usage: "path to a dataset in the format of the import endpoint, one JSON item per line (\"-\" for stdin);\n" +
+							"the contentType of each item is its label, with the expected content in contentSource and contentId",
+					},
+					&cli.BoolFlag{
+						Name:  "mismatches",
+						Usage: "list the items that were classified incorrectly",
+					},
+				},
+				Action: func(ctx *cli.Context) error {
+					samples, skipped, err := readSamples(ctx.String("file"))
+					if err != nil {
+						return err
+					}
+					c, err := p.Classifier.Get()
+					if err != nil {
+						return err
+					}
+					report, err := bench.NewRunner(c).Run(ctx.Context, samples)
+					if err != nil {
+						return err
+					}
+					if ctx.Bool("mismatches") {
+						writeMismatches(ctx.App.Writer, report)
+					}
+					writeReport(ctx.App.Writer, report)
+					if skipped > 0 {
+						_, _ = fmt.Fprintf(ctx.App.Writer, "%d items without a content type were skipped\n", skipped)
+					}
+					return nil
+				},
+			},
+		},
+	}}, nil
+}
+
+// readSamples reads the items of a dataset, skipping those without a content type as they have no label.
+func readSamples(path string) ([]bench.Sample, int, error) {
+	var r io.Reader
+	if path == "-" {
+		r = os.Stdin
+	} else {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, 0, err
+		}
+		defer f.Close()
+		r = f
+	}
+	var samples []bench.Sample
+	skipped := 0
+	decoder := json.NewDecoder(r)
+	for {
+		var item importer.Item
+		if err := decoder.Decode(&item); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, 0, fmt.Errorf("item %d: %w", len(samples)+skipped+1, err)
+		}
+		if !item.ContentType.Valid {
+			skipped++
+			continue
+		}
+		samples = append(samples, bench.Sample{
+			InfoHash:      item.InfoHash,
+			Name:          item.Name,
+			Size:          item.Size,
+			ContentType:   item.ContentType.ContentType,
+			ContentSource: item.ContentSource,
+			ContentID:     item.ContentID,
+		})
+	}
+	return samples, skipped, nil
+}
+
+func writeReport(w io.Writer, report bench.Report) {
+	tw := table.NewWriter()
+	tw.SetOutputMirror(w)
+	tw.AppendHeader(table.Row{"Content Type", "Labeled", "Classified", "Correct", "Precision", "Recall"})
+	for _, s := range report.ContentTypes {
+		precision, recall := "-", "-"
+		if s.Predicted > 0 {
+			precision = percent(s.Precision())
+		}
+		if s.Labeled > 0 {
+			recall = percent(s.Recall())
+		}
+		tw.AppendRow(table.Row{s.ContentType.Label(), s.Labeled, s.Predicted, s.Correct, precision, recall})
+	}
+	tw.AppendFooter(table.Row{"Accuracy", len(report.Results), "", "", "", percent(report.Accuracy())})
+	tw.Render()
+	if report.ContentLabeled > 0 {
+		_, _ = fmt.Fprintf(w, "%d / %d items matched to their content\n", report.ContentMatched, report.ContentLabeled)
+	}
+	if report.Errors > 0 {
+		_, _ = fmt.Fprintf(w, "%d items failed to be classified\n", report.Errors)
+	}
+}
+
+func writeMismatches(w io.Writer, report bench.Report) {
+	tw := table.NewWriter()
+	tw.SetOutputMirror(w)
+	tw.AppendHeader(table.Row{"Name", "Label", "Classified"})
+	for _, result := range report.Results {
+		if result.Correct() {
+			continue
+		}
+		label := result.Sample.ContentType.Label()
+		if result.Sample.ContentSource.Valid && result.Sample.ContentID.Valid {
+			label += " " + result.Sample.ContentSource.String + ":" + result.Sample.ContentID.String
+		}
+		classified := "unknown"
+		if result.ContentType.Valid {
+			classified = result.ContentType.ContentType.Label()
+		}
+		if result.Content != nil {
+			classified += " " + result.Content.Source + ":" + result.Content.ID
+		}
+		if result.Err != nil {
+			classified = "error: " + result.Err.Error()
+		}
+		tw.AppendRow(table.Row{result.Sample.Name, label, classified})
+	}
+	tw.Render()
+}
+
+func percent(v float64) string {
+	return fmt.Sprintf("%.1f%%", v*100)
+}
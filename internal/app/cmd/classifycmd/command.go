@@ -0,0 +1,226 @@
+package classifycmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/bitmagnet-io/bitmagnet/internal/classifier"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/query"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/search"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"github.com/urfave/cli/v2"
+	"go.uber.org/fx"
+	"gorm.io/gen/field"
+)
+
+type Params struct {
+	fx.In
+	Classifier lazy.Lazy[classifier.Classifier]
+	Search     lazy.Lazy[search.Search]
+}
+
+type Result struct {
+	fx.Out
+	Command *cli.Command `group:"commands"`
+}
+
+func New(p Params) (Result, error) {
+	return Result{Command: &cli.Command{
+		Name:      "classify",
+		Usage:     "Classify a torrent name, or an indexed torrent by its info hash, and print the decisions made as JSON",
+		ArgsUsage: "<name or info hash>",
+		Action: func(ctx *cli.Context) error {
+			if ctx.NArg() != 1 {
+				return errors.New("expected a single torrent name or info hash")
+			}
+			input := ctx.Args().First()
+			torrent := model.Torrent{
+				Name:        input,
+				FilesStatus: model.FilesStatusNoInfo,
+			}
+			if infoHash, err := protocol.ParseID(input); err == nil {
+				s, err := p.Search.Get()
+				if err != nil {
+					return err
+				}
+				torrent, err = getTorrent(ctx, s, infoHash)
+				if err != nil {
+					return err
+				}
+			}
+			c, err := p.Classifier.Get()
+			if err != nil {
+				return err
+			}
+			classifyCtx, trace := classifier.WithTrace(classifier.WithBatch(ctx.Context))
+			cl, classifyErr := c.Classify(classifyCtx, torrent)
+			result := newOutput(input, torrent, trace.ClassificationTrace(torrent.InfoHash))
+			switch {
+			case classifyErr == nil:
+				result.Classification = newClassificationOutput(cl)
+			case !errors.Is(classifyErr, classifier.ErrNoMatch):
+				result.Error = classifyErr.Error()
+			}
+			encoder := json.NewEncoder(ctx.App.Writer)
+			encoder.SetIndent("", "  ")
+			return encoder.Encode(result)
+		},
+	}}, nil
+}
+
+// getTorrent loads an indexed torrent with its files and hint, which are used in its classification.
+func getTorrent(ctx *cli.Context, s search.Search, infoHash protocol.ID) (model.Torrent, error) {
+	result, err := s.TorrentsWithMissingInfoHashes(
+		ctx.Context,
+		[]protocol.ID{infoHash},
+		query.Preload(func(q *dao.Query) []field.RelationField {
+			return []field.RelationField{
+				q.Torrent.Files.RelationField,
+				q.Torrent.Hint.RelationField,
+			}
+		}),
+	)
+	if err != nil {
+		return model.Torrent{}, err
+	}
+	if len(result.Torrents) == 0 {
+		return model.Torrent{}, fmt.Errorf("torrent %s not found", infoHash)
+	}
+	return result.Torrents[0], nil
+}
+
+type output struct {
+	Input       string                            `json:"input"`
+	InfoHash    string                            `json:"infoHash,omitempty"`
+	Name        string                            `json:"name"`
+	Size        uint64                            `json:"size,omitempty"`
+	FilesCount  int                               `json:"filesCount,omitempty"`
+	Hint        *hintOutput                       `json:"hint,omitempty"`
+	Classifiers []string                          `json:"classifiers"`
+	Tokens      []string                          `json:"tokens"`
+	Lookups     []model.ClassificationTraceLookup `json:"lookups"`
+	// Classification is the final decision, and is null if the torrent couldn't be classified
+	Classification *classificationOutput `json:"classification"`
+	Error          string                `json:"error,omitempty"`
+}
+
+type hintOutput struct {
+	ContentType   string     `json:"contentType"`
+	ContentSource string     `json:"contentSource,omitempty"`
+	ContentID     string     `json:"contentId,omitempty"`
+	Title         string     `json:"title,omitempty"`
+	ReleaseYear   model.Year `json:"releaseYear,omitempty"`
+}
+
+type classificationOutput struct {
+	ContentType     string              `json:"contentType,omitempty"`
+	Content         *contentOutput      `json:"content,omitempty"`
+	MatchConfidence *float32            `json:"matchConfidence,omitempty"`
+	ProbablyFake    bool                `json:"probablyFake"`
+	FileContents    []contentOutput     `json:"fileContents,omitempty"`
+	Episodes        string              `json:"episodes,omitempty"`
+	AirDate         string              `json:"airDate,omitempty"`
+	SportEvent      *model.SportEvent   `json:"sportEvent,omitempty"`
+	Languages       []string            `json:"languages,omitempty"`
+	VideoResolution string              `json:"videoResolution,omitempty"`
+	VideoSource     string              `json:"videoSource,omitempty"`
+	VideoCodec      string              `json:"videoCodec,omitempty"`
+	ReleaseGroup    string              `json:"releaseGroup,omitempty"`
+	ReleaseTokens   model.ReleaseTokens `json:"releaseTokens,omitempty"`
+}
+
+type contentOutput struct {
+	FileIndex   *uint32    `json:"fileIndex,omitempty"`
+	Type        string     `json:"type"`
+	Source      string     `json:"source"`
+	ID          string     `json:"id"`
+	Title       string     `json:"title,omitempty"`
+	ReleaseYear model.Year `json:"releaseYear,omitempty"`
+}
+
+func newOutput(input string, t model.Torrent, trace model.ClassificationTrace) output {
+	o := output{
+		Input:       input,
+		Name:        t.Name,
+		Size:        t.Size,
+		FilesCount:  len(t.Files),
+		Classifiers: trace.Classifiers,
+		Tokens:      trace.Tokens,
+		Lookups:     trace.Lookups,
+	}
+	if !t.InfoHash.IsZero() {
+		o.InfoHash = t.InfoHash.String()
+	}
+	if !t.Hint.IsNil() {
+		o.Hint = &hintOutput{
+			ContentType:   t.Hint.ContentType.String(),
+			ContentSource: t.Hint.ContentSource.String,
+			ContentID:     t.Hint.ContentID.String,
+			Title:         t.Hint.Title.String,
+			ReleaseYear:   t.Hint.ReleaseYear,
+		}
+	}
+	return o
+}
+
+func newClassificationOutput(cl classifier.Classification) *classificationOutput {
+	o := &classificationOutput{
+		ProbablyFake:  cl.ProbablyFake,
+		SportEvent:    cl.SportEvent,
+		ReleaseTokens: cl.ReleaseTokens,
+	}
+	if cl.ContentType.Valid {
+		o.ContentType = cl.ContentType.ContentType.String()
+	}
+	if cl.Content != nil {
+		o.Content = &contentOutput{
+			Type:        cl.Content.Type.String(),
+			Source:      cl.Content.Source,
+			ID:          cl.Content.ID,
+			Title:       cl.Content.Title,
+			ReleaseYear: cl.Content.ReleaseYear,
+		}
+	}
+	if cl.MatchConfidence.Valid {
+		o.MatchConfidence = &cl.MatchConfidence.Float32
+	}
+	for _, fc := range cl.FileContents {
+		fileIndex := fc.FileIndex
+		c := contentOutput{
+			FileIndex: &fileIndex,
+			Type:      fc.ContentType.String(),
+			Source:    fc.ContentSource,
+			ID:        fc.ContentID,
+		}
+		if fc.Content != nil {
+			c.Title = fc.Content.Title
+			c.ReleaseYear = fc.Content.ReleaseYear
+		}
+		o.FileContents = append(o.FileContents, c)
+	}
+	if len(cl.Episodes) > 0 {
+		o.Episodes = cl.Episodes.String()
+	}
+	if !cl.AirDate.IsNil() {
+		o.AirDate = cl.AirDate.IsoDateString()
+	}
+	for _, lang := range cl.Languages.Slice() {
+		o.Languages = append(o.Languages, lang.String())
+	}
+	if cl.VideoResolution.Valid {
+		o.VideoResolution = cl.VideoResolution.VideoResolution.String()
+	}
+	if cl.VideoSource.Valid {
+		o.VideoSource = cl.VideoSource.VideoSource.String()
+	}
+	if cl.VideoCodec.Valid {
+		o.VideoCodec = cl.VideoCodec.VideoCodec.String()
+	}
+	if cl.ReleaseGroup.Valid {
+		o.ReleaseGroup = cl.ReleaseGroup.String
+	}
+	return o
+}
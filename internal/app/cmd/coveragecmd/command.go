@@ -0,0 +1,113 @@
+package coveragecmd
+
+import (
+	"bufio"
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/bitmagnet-io/bitmagnet/internal/coverage"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/search"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/urfave/cli/v2"
+	"go.uber.org/fx"
+	"io"
+	"os"
+	"strings"
+)
+
+type Params struct {
+	fx.In
+	Dao    lazy.Lazy[*dao.Query]
+	Search lazy.Lazy[search.Search]
+}
+
+type Result struct {
+	fx.Out
+	Command *cli.Command `group:"commands"`
+}
+
+func New(p Params) (Result, error) {
+	return Result{Command: &cli.Command{
+		Name:  "coverage",
+		Usage: "Report which items of a reference list of content IDs the index has torrents for",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "file",
+				Value: "-",
+				Usage: "path to a file with one content ID per line (\"-\" for stdin);\n" +
+					"IDs can be bare IMDb IDs (tt0111161), source:id (tmdb:278) or type:source:id (movie:tmdb:278)",
+			},
+			&cli.BoolFlag{
+				Name:  "missing",
+				Usage: "only list items that have no torrents",
+			},
+		},
+		Action: func(ctx *cli.Context) error {
+			refs, err := readRefs(ctx.String("file"))
+			if err != nil {
+				return err
+			}
+			d, err := p.Dao.Get()
+			if err != nil {
+				return err
+			}
+			s, err := p.Search.Get()
+			if err != nil {
+				return err
+			}
+			report, err := coverage.NewReporter(d, s).Report(ctx.Context, refs)
+			if err != nil {
+				return err
+			}
+			tw := table.NewWriter()
+			tw.SetOutputMirror(ctx.App.Writer)
+			tw.AppendHeader(table.Row{"ID", "Title", "Torrents", "Best Resolution"})
+			for _, item := range report.Items {
+				if ctx.Bool("missing") && item.Covered() {
+					continue
+				}
+				titles := make([]string, 0, len(item.Contents))
+				for _, c := range item.Contents {
+					titles = append(titles, c.Title)
+				}
+				best := ""
+				if item.BestResolution.Valid {
+					best = item.BestResolution.VideoResolution.Label()
+				}
+				tw.AppendRow(table.Row{item.Ref.String(), strings.Join(titles, ", "), item.TorrentCount, best})
+			}
+			tw.AppendFooter(table.Row{"", "Covered", fmt.Sprintf("%d / %d", report.CoveredCount(), len(report.Items))})
+			tw.Render()
+			return nil
+		},
+	}}, nil
+}
+
+func readRefs(path string) ([]model.ContentRef, error) {
+	var r io.Reader
+	if path == "-" {
+		r = os.Stdin
+	} else {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		r = f
+	}
+	var refs []model.ContentRef
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		ref, err := model.ParseContentRef(line)
+		if err != nil {
+			return nil, err
+		}
+		refs = append(refs, ref)
+	}
+	return refs, scanner.Err()
+}
@@ -0,0 +1,82 @@
+package databasecmd
+
+import (
+	"errors"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/migrations"
+	"github.com/bitmagnet-io/bitmagnet/internal/maintenance"
+	"github.com/urfave/cli/v2"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"time"
+)
+
+type Params struct {
+	fx.In
+	Migrator   lazy.Lazy[migrations.Migrator]
+	Maintainer lazy.Lazy[maintenance.Maintainer]
+	Logger     *zap.SugaredLogger
+}
+
+type Result struct {
+	fx.Out
+	Command *cli.Command `group:"commands"`
+}
+
+func New(p Params) (Result, error) {
+	return Result{Command: &cli.Command{
+		Name: "database",
+		Subcommands: []*cli.Command{
+			{
+				Name:  "maintain",
+				Usage: "vacuum and analyze the configured tables and rebuild their bloated indexes now, regardless of the quiet hours",
+				Flags: []cli.Flag{
+					&cli.DurationFlag{
+						Name:  "timeout",
+						Usage: "stop starting new operations after this duration",
+						Value: 3 * time.Hour,
+					},
+				},
+				Action: func(ctx *cli.Context) error {
+					m, err := p.Maintainer.Get()
+					if err != nil {
+						return err
+					}
+					return m.Maintain(ctx.Context, time.Now().Add(ctx.Duration("timeout")))
+				},
+			},
+			{
+				Name:  "partition",
+				Usage: "convert the largest tables to tables hash partitioned by info hash; stop all other bitmagnet processes first",
+				Flags: []cli.Flag{
+					&cli.StringSliceFlag{
+						Name:  "table",
+						Value: cli.NewStringSlice(migrations.PartitionableTables...),
+					},
+					&cli.UintFlag{
+						Name:  "partitions",
+						Value: 16,
+					},
+				},
+				Action: func(ctx *cli.Context) error {
+					m, err := p.Migrator.Get()
+					if err != nil {
+						return err
+					}
+					for _, table := range ctx.StringSlice("table") {
+						p.Logger.Infow("partitioning table", "table", table, "partitions", ctx.Uint("partitions"))
+						if err := m.Partition(ctx.Context, table, ctx.Uint("partitions")); err != nil {
+							if errors.Is(err, migrations.ErrAlreadyPartitioned) {
+								p.Logger.Infow("table is already partitioned", "table", table)
+								continue
+							}
+							return err
+						}
+						p.Logger.Infow("partitioned table", "table", table)
+					}
+					return nil
+				},
+			},
+		},
+	}}, nil
+}
@@ -0,0 +1,125 @@
+package queuecmd
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/bitmagnet-io/bitmagnet/internal/processor"
+	"github.com/bitmagnet-io/bitmagnet/internal/queue/deadletter"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/urfave/cli/v2"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"time"
+)
+
+type Params struct {
+	fx.In
+	DeadLetters lazy.Lazy[deadletter.Manager]
+	Logger      *zap.SugaredLogger
+}
+
+type Result struct {
+	fx.Out
+	Command *cli.Command `group:"commands"`
+}
+
+func New(p Params) (Result, error) {
+	queueFlag := &cli.StringFlag{
+		Name:  "queue",
+		Value: processor.MessageName,
+	}
+	return Result{Command: &cli.Command{
+		Name:  "queue",
+		Usage: "Manage the task queue",
+		Subcommands: []*cli.Command{
+			{
+				Name:  "dead-letters",
+				Usage: "Inspect and requeue messages that failed on every permitted attempt",
+				Subcommands: []*cli.Command{
+					{
+						Name:  "list",
+						Usage: "List dead-lettered messages with their errors, most recently failed first",
+						Flags: []cli.Flag{
+							queueFlag,
+							&cli.IntFlag{
+								Name:  "limit",
+								Value: 30,
+							},
+							&cli.IntFlag{
+								Name:  "page",
+								Value: 1,
+							},
+						},
+						Action: func(ctx *cli.Context) error {
+							m, err := p.DeadLetters.Get()
+							if err != nil {
+								return err
+							}
+							result, err := m.List(ctx.Context, ctx.String("queue"), ctx.Int("limit"), ctx.Int("page"))
+							if err != nil {
+								return err
+							}
+							tw := table.NewWriter()
+							tw.SetOutputMirror(ctx.App.Writer)
+							tw.AppendHeader(table.Row{"ID", "Failed At", "Attempts", "Error", "Payload"})
+							for _, msg := range result.Items {
+								failedAt := ""
+								if msg.LastFailedAt != nil {
+									failedAt = msg.LastFailedAt.Format(time.RFC3339)
+								}
+								tw.AppendRow(table.Row{msg.ID, failedAt, msg.Retried + 1, msg.Error, msg.Payload})
+							}
+							tw.AppendFooter(table.Row{"", "", "", "Total", result.TotalCount})
+							tw.Render()
+							return nil
+						},
+					},
+					{
+						Name:      "requeue",
+						Usage:     "Move dead-lettered messages back to the queue",
+						ArgsUsage: "<message ID>...",
+						Flags: []cli.Flag{
+							queueFlag,
+							&cli.BoolFlag{
+								Name:  "all",
+								Usage: "requeue all dead-lettered messages",
+							},
+						},
+						Action: func(ctx *cli.Context) error {
+							if !ctx.Bool("all") && ctx.NArg() == 0 {
+								return cli.Exit("specify message IDs or --all", 1)
+							}
+							m, err := p.DeadLetters.Get()
+							if err != nil {
+								return err
+							}
+							var n int
+							if ctx.Bool("all") {
+								n, err = m.RequeueAll(ctx.Context, ctx.String("queue"))
+							} else {
+								n, err = m.Requeue(ctx.Context, ctx.String("queue"), ctx.Args().Slice()...)
+							}
+							p.Logger.Infow("requeued dead-lettered messages", "queue", ctx.String("queue"), "count", n)
+							return err
+						},
+					},
+					{
+						Name:  "purge",
+						Usage: "Discard all dead-lettered messages",
+						Flags: []cli.Flag{
+							queueFlag,
+						},
+						Action: func(ctx *cli.Context) error {
+							m, err := p.DeadLetters.Get()
+							if err != nil {
+								return err
+							}
+							n, err := m.DeleteAll(ctx.Context, ctx.String("queue"))
+							p.Logger.Infow("purged dead-lettered messages", "queue", ctx.String("queue"), "count", n)
+							return err
+						},
+					},
+				},
+			},
+		},
+	}}, nil
+}
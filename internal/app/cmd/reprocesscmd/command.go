@@ -2,22 +2,26 @@ package reprocesscmd
 
 import (
 	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/bitmagnet-io/bitmagnet/internal/classifier"
 	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
 	"github.com/bitmagnet-io/bitmagnet/internal/model"
 	"github.com/bitmagnet-io/bitmagnet/internal/processor"
 	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
 	"github.com/bitmagnet-io/bitmagnet/internal/queue/publisher"
+	"github.com/bitmagnet-io/bitmagnet/internal/taskrun"
 	"github.com/schollz/progressbar/v3"
 	"github.com/urfave/cli/v2"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
 	"gorm.io/gen"
+	"gorm.io/gen/field"
 )
 
 type Params struct {
 	fx.In
 	Dao                lazy.Lazy[*dao.Query]
 	ProcessorPublisher lazy.Lazy[publisher.Publisher[processor.MessageParams]]
+	TaskRunRecorder    lazy.Lazy[taskrun.Recorder]
 	Logger             *zap.SugaredLogger
 }
 
@@ -29,7 +33,7 @@ type Result struct {
 func New(p Params) (Result, error) {
 	return Result{Command: &cli.Command{
 		Name:  "reprocess",
-		Usage: "Queue all torrents for reprocessing",
+		Usage: "Queue torrents for reprocessing",
 		Flags: []cli.Flag{
 			&cli.IntFlag{
 				Name:  "batchSize",
@@ -42,6 +46,21 @@ func New(p Params) (Result, error) {
 					"rematch (ignore any pre-existing classification and always classify from scratch);\n" +
 					"skip (skip classification for previously unmatched torrents that don't have any hint)",
 			},
+			&cli.BoolFlag{
+				Name:  "outdated",
+				Usage: "only reprocess torrents that are unclassified or were classified by an older version of the classifier",
+			},
+			&cli.StringSliceFlag{
+				Name:  "contentType",
+				Usage: "only reprocess torrents classified as one of the given content types (\"null\" for unknown)",
+			},
+			&cli.StringFlag{
+				Name:  "priority",
+				Value: "bulk",
+				Usage: "bulk (work behind newly discovered torrents);\n" +
+					"default (work alongside newly discovered torrents);\n" +
+					"interactive (work ahead of all other processing)",
+			},
 		},
 		Action: func(ctx *cli.Context) error {
 			var classifyMode processor.ClassifyMode
@@ -55,25 +74,83 @@ func New(p Params) (Result, error) {
 			default:
 				return cli.Exit("invalid classifyMode", 1)
 			}
-			println("queueing full reprocess...")
+			var priority processor.MessagePriority
+			switch ctx.String("priority") {
+			case "bulk":
+				priority = processor.MessagePriorityBulk
+			case "default":
+				priority = processor.MessagePriorityDefault
+			case "interactive":
+				priority = processor.MessagePriorityInteractive
+			default:
+				return cli.Exit("invalid priority", 1)
+			}
+			var contentTypes []string
+			includeNullContentType := false
+			for _, ct := range ctx.StringSlice("contentType") {
+				if ct == "null" {
+					includeNullContentType = true
+					continue
+				}
+				parsed, err := model.ParseContentType(ct)
+				if err != nil {
+					return cli.Exit(err.Error(), 1)
+				}
+				contentTypes = append(contentTypes, parsed.String())
+			}
 			d, err := p.Dao.Get()
 			if err != nil {
 				return err
 			}
+			q := d.Torrent.WithContext(ctx.Context)
+			if ctx.Bool("outdated") {
+				q = q.Not(
+					gen.Exists(
+						d.TorrentContent.Where(
+							d.TorrentContent.InfoHash.EqCol(d.Torrent.InfoHash),
+							d.TorrentContent.ClassifierVersion.Gte(classifier.Version),
+						),
+					),
+				)
+			}
+			if len(contentTypes) > 0 || includeNullContentType {
+				var contentTypeConds []field.Expr
+				if len(contentTypes) > 0 {
+					contentTypeConds = append(contentTypeConds, d.TorrentContent.ContentType.In(contentTypes...))
+				}
+				if includeNullContentType {
+					contentTypeConds = append(contentTypeConds, d.TorrentContent.ContentType.IsNull())
+				}
+				q = q.Where(
+					gen.Exists(
+						d.TorrentContent.Where(
+							d.TorrentContent.InfoHash.EqCol(d.Torrent.InfoHash),
+						).Where(
+							field.Or(contentTypeConds...),
+						),
+					),
+				)
+			}
+			println("queueing reprocess...")
+			tr, err := p.TaskRunRecorder.Get()
+			if err != nil {
+				return err
+			}
 			p, err := p.ProcessorPublisher.Get()
 			if err != nil {
 				return err
 			}
 			batchSize := ctx.Int("batchSize")
 			torrentCount := int64(0)
-			if result, err := d.Torrent.WithContext(ctx.Context).Count(); err != nil {
+			if result, err := q.Count(); err != nil {
 				return err
 			} else {
 				torrentCount = result
 			}
 			bar := progressbar.Default(torrentCount, "queuing torrents")
+			run := tr.Start(ctx.Context, taskrun.KindReprocess)
 			var torrentResult []*model.Torrent
-			if err := d.Torrent.WithContext(ctx.Context).FindInBatches(&torrentResult, batchSize, func(tx gen.Dao, _ int) error {
+			if err := q.FindInBatches(&torrentResult, batchSize, func(tx gen.Dao, _ int) error {
 				infoHashes := make([]protocol.ID, 0, len(torrentResult))
 				for _, c := range torrentResult {
 					infoHashes = append(infoHashes, c.InfoHash)
@@ -81,14 +158,18 @@ func New(p Params) (Result, error) {
 				if _, err := p.Publish(ctx.Context, processor.MessageParams{
 					ClassifyMode: classifyMode,
 					InfoHashes:   infoHashes,
+					Priority:     priority,
 				}); err != nil {
 					return err
 				}
 				_ = bar.Add(len(torrentResult))
+				run.Add(len(torrentResult))
 				return nil
 			}); err != nil {
+				run.Finish(err)
 				return err
 			}
+			run.Finish(nil)
 			_ = bar.Finish()
 			return nil
 		},
@@ -0,0 +1,160 @@
+package takedowncmd
+
+import (
+	"bufio"
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/takedown"
+	"github.com/urfave/cli/v2"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+type Params struct {
+	fx.In
+	Manager lazy.Lazy[takedown.Manager]
+	Logger  *zap.SugaredLogger
+}
+
+type Result struct {
+	fx.Out
+	Command *cli.Command `group:"commands"`
+}
+
+func New(p Params) (Result, error) {
+	entryFlags := []cli.Flag{
+		&cli.StringFlag{
+			Name:  "reason",
+			Usage: "the reason for the takedown, e.g. \"DMCA\"",
+		},
+		&cli.StringFlag{
+			Name:  "reference",
+			Usage: "a reference for the takedown, e.g. a notice ID or URL",
+		},
+	}
+	submit := func(ctx *cli.Context, list string, identifiers []string) error {
+		entries := make([]takedown.Entry, 0, len(identifiers))
+		for _, identifier := range identifiers {
+			e, err := takedown.ParseEntry(identifier)
+			if err != nil {
+				return err
+			}
+			if reason := ctx.String("reason"); reason != "" {
+				e.Reason = model.NewNullString(reason)
+			}
+			if reference := ctx.String("reference"); reference != "" {
+				e.Reference = model.NewNullString(reference)
+			}
+			entries = append(entries, e)
+		}
+		m, err := p.Manager.Get()
+		if err != nil {
+			return err
+		}
+		result, err := m.Submit(ctx.Context, list, entries...)
+		p.Logger.Infow(
+			"submitted takedowns",
+			"list", list,
+			"created", len(result.Takedowns),
+			"duplicates", result.Duplicates,
+			"removedTorrents", result.RemovedTorrents,
+		)
+		return err
+	}
+	return Result{Command: &cli.Command{
+		Name:  "takedown",
+		Usage: "Manage the takedown list",
+		Subcommands: []*cli.Command{
+			{
+				Name:      "submit",
+				Usage:     "Add entries to the takedown list and remove matching torrents",
+				ArgsUsage: "<info hash or content ID>...",
+				Flags: append([]cli.Flag{
+					&cli.StringFlag{
+						Name:  "list",
+						Value: takedown.ListManual,
+					},
+				}, entryFlags...),
+				Action: func(ctx *cli.Context) error {
+					if ctx.NArg() == 0 {
+						return cli.Exit("no entries specified", 1)
+					}
+					return submit(ctx, ctx.String("list"), ctx.Args().Slice())
+				},
+			},
+			{
+				Name: "import",
+				Usage: "Import a takedown list from a file with one entry per line;\n" +
+					"entries are 40 character hex info hashes or content IDs such as tmdb:278 or movie:tmdb:278",
+				Flags: append([]cli.Flag{
+					&cli.StringFlag{
+						Name:     "file",
+						Required: true,
+						Usage:    "path to the list file (\"-\" for stdin)",
+					},
+					&cli.StringFlag{
+						Name:     "list",
+						Required: true,
+						Usage:    "the name of the imported list",
+					},
+				}, entryFlags...),
+				Action: func(ctx *cli.Context) error {
+					identifiers, err := readLines(ctx.String("file"))
+					if err != nil {
+						return err
+					}
+					return submit(ctx, ctx.String("list"), identifiers)
+				},
+			},
+			{
+				Name:      "withdraw",
+				Usage:     "Remove entries from the takedown list; blocked info hashes remain blocked",
+				ArgsUsage: "<takedown ID>...",
+				Action: func(ctx *cli.Context) error {
+					ids := make([]int64, 0, ctx.NArg())
+					for _, arg := range ctx.Args().Slice() {
+						id, err := strconv.ParseInt(arg, 10, 64)
+						if err != nil {
+							return fmt.Errorf("invalid takedown ID %q: %w", arg, err)
+						}
+						ids = append(ids, id)
+					}
+					m, err := p.Manager.Get()
+					if err != nil {
+						return err
+					}
+					return m.Withdraw(ctx.Context, ids...)
+				},
+			},
+		},
+	}}, nil
+}
+
+func readLines(path string) ([]string, error) {
+	var r io.Reader
+	if path == "-" {
+		r = os.Stdin
+	} else {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		r = f
+	}
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines, scanner.Err()
+}
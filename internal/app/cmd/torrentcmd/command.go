@@ -2,9 +2,12 @@ package torrentcmd
 
 import (
 	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/dhtcrawler"
 	"github.com/bitmagnet-io/bitmagnet/internal/processor"
 	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
 	"github.com/bitmagnet-io/bitmagnet/internal/protocol/metainfo/metainforequester"
+	"github.com/bitmagnet-io/bitmagnet/internal/retention"
 	"github.com/urfave/cli/v2"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
@@ -13,8 +16,11 @@ import (
 
 type Params struct {
 	fx.In
+	Dao               lazy.Lazy[*dao.Query]
+	DhtCrawlerConfig  dhtcrawler.Config
 	MetaInfoRequester metainforequester.Requester
 	Processor         lazy.Lazy[processor.Processor]
+	RetentionJanitor  lazy.Lazy[retention.Janitor]
 	Logger            *zap.SugaredLogger
 }
 
@@ -83,6 +89,47 @@ func New(p Params) (Result, error) {
 					return nil
 				},
 			},
+			{
+				Name:  "purgeDead",
+				Usage: "Delete torrents whose estimated swarm health has decayed below a threshold",
+				Flags: []cli.Flag{
+					&cli.Float64Flag{
+						Name:  "minHealth",
+						Value: 0.5,
+					},
+				},
+				Action: func(ctx *cli.Context) error {
+					d, err := p.Dao.Get()
+					if err != nil {
+						return err
+					}
+					n, err := d.DeleteDeadTorrents(ctx.Context, float32(ctx.Float64("minHealth")), p.DhtCrawlerConfig.HealthHalfLife)
+					if err != nil {
+						return err
+					}
+					p.Logger.Infow("purged dead torrents", "count", n)
+					return nil
+				},
+			},
+			{
+				Name:  "prune",
+				Usage: "Delete torrents matching the configured retention policies",
+				Flags: []cli.Flag{
+					&cli.BoolFlag{
+						Name:  "dryRun",
+						Usage: "report the number of torrents matching each policy without deleting them",
+					},
+				},
+				Action: func(ctx *cli.Context) error {
+					j, err := p.RetentionJanitor.Get()
+					if err != nil {
+						return err
+					}
+					// the number of torrents matched or deleted by each policy is logged by the janitor
+					_, err = j.Prune(ctx.Context, ctx.Bool("dryRun"))
+					return err
+				},
+			},
 		},
 	}}, nil
 }
@@ -0,0 +1,15 @@
+package auditfx
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/audit"
+	"go.uber.org/fx"
+)
+
+func New() fx.Option {
+	return fx.Module(
+		"audit",
+		fx.Provide(
+			audit.New,
+		),
+	)
+}
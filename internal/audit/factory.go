@@ -0,0 +1,34 @@
+package audit
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+type Params struct {
+	fx.In
+	Dao    lazy.Lazy[*dao.Query]
+	Logger *zap.SugaredLogger
+}
+
+type Result struct {
+	fx.Out
+	Recorder lazy.Lazy[Recorder]
+}
+
+func New(p Params) Result {
+	return Result{
+		Recorder: lazy.New(func() (Recorder, error) {
+			d, err := p.Dao.Get()
+			if err != nil {
+				return nil, err
+			}
+			return recorder{
+				dao:    d,
+				logger: p.Logger.Named("audit"),
+			}, nil
+		}),
+	}
+}
@@ -0,0 +1,82 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/bitmagnet-io/bitmagnet/internal/auth"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"go.uber.org/zap"
+)
+
+// Recorder keeps a log of the mutating actions taken through the APIs, for accountability when an instance has multiple users.
+type Recorder interface {
+	// Record stores an entry for an action, attributed to the user and client IP of the request context.
+	// Failures are logged rather than returned, so that they don't affect the outcome of the action.
+	Record(ctx context.Context, action string, payload any, actionErr error)
+	List(ctx context.Context, params ListParams) ([]model.AuditLog, error)
+}
+
+type ListParams struct {
+	Actor  string
+	Action string
+	Limit  int
+	Offset int
+}
+
+type recorder struct {
+	dao    *dao.Query
+	logger *zap.SugaredLogger
+}
+
+type clientIPContextKey struct{}
+
+func WithClientIP(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, clientIPContextKey{}, ip)
+}
+
+func (r recorder) Record(ctx context.Context, action string, payload any, actionErr error) {
+	entry := model.AuditLog{
+		Action:  action,
+		Payload: "null",
+	}
+	if user, ok := auth.UserFromContext(ctx); ok {
+		entry.Actor = model.NewNullString(user.Name)
+	}
+	if ip, ok := ctx.Value(clientIPContextKey{}).(string); ok && ip != "" {
+		entry.ClientIP = model.NewNullString(ip)
+	}
+	if payload != nil {
+		if data, err := json.Marshal(payload); err == nil {
+			entry.Payload = string(data)
+		} else {
+			r.logger.Warnw("failed to encode audit payload", "action", action, "error", err)
+		}
+	}
+	if actionErr != nil {
+		entry.Error = model.NewNullString(actionErr.Error())
+	}
+	// the entry is stored even if the action was cancelled part way through
+	if err := r.dao.AuditLog.WithContext(context.WithoutCancel(ctx)).Create(&entry); err != nil {
+		r.logger.Errorw("failed to record audit log entry", "action", action, "error", err)
+	}
+}
+
+func (r recorder) List(ctx context.Context, params ListParams) ([]model.AuditLog, error) {
+	q := r.dao.AuditLog.WithContext(ctx)
+	if params.Actor != "" {
+		q = q.Where(r.dao.AuditLog.Actor.Eq(model.NewNullString(params.Actor)))
+	}
+	if params.Action != "" {
+		q = q.Where(r.dao.AuditLog.Action.Eq(params.Action))
+	}
+	entries, err := q.Order(r.dao.AuditLog.ID.Desc()).Limit(params.Limit).Offset(params.Offset).Find()
+	if err != nil {
+		return nil, err
+	}
+	result := make([]model.AuditLog, 0, len(entries))
+	for _, e := range entries {
+		result = append(result, *e)
+	}
+	return result, nil
+}
@@ -0,0 +1,152 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestAuthenticator(t *testing.T) *authenticator {
+	hash, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	assert.NoError(t, err)
+	return &authenticator{
+		users: map[string]UserConfig{
+			"admin":  {PasswordHash: string(hash), Role: RoleAdmin},
+			"reader": {PasswordHash: string(hash), Role: RoleReadOnly},
+			"sonarr": {PasswordHash: string(hash), Role: RoleTorznab},
+		},
+		signer:          signer{secret: []byte("test")},
+		sessionDuration: time.Hour,
+		now:             time.Now,
+		verified:        make(map[string][sha256.Size]byte),
+	}
+}
+
+func TestSignedToken(t *testing.T) {
+	t.Parallel()
+
+	s := signer{secret: []byte("test")}
+	now := time.Now()
+	token, err := encodeToken(s, User{Name: "admin", Role: RoleAdmin}, now.Add(time.Minute))
+	assert.NoError(t, err)
+
+	user, err := decodeToken[User](s, token, now)
+	assert.NoError(t, err)
+	assert.Equal(t, User{Name: "admin", Role: RoleAdmin}, user)
+
+	_, err = decodeToken[User](s, token, now.Add(time.Minute))
+	assert.ErrorIs(t, err, errInvalidToken)
+	_, err = decodeToken[User](signer{secret: []byte("other")}, token, now)
+	assert.ErrorIs(t, err, errInvalidToken)
+	_, err = decodeToken[User](s, "x"+token, now)
+	assert.ErrorIs(t, err, errInvalidToken)
+}
+
+func TestMiddleware(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+	e := gin.New()
+	assert.NoError(t, builder{
+		enabled:       true,
+		authenticator: newTestAuthenticator(t),
+		logger:        zap.NewNop().Sugar(),
+	}.Apply(e))
+	for _, path := range []string{"/", "/status", "/healthz", "/healthz/live", "/healthz/ready", "/graphql", "/torznab/api", "/feeds/rss", "/import", "/overseerr/webhook"} {
+		e.Any(path, func(c *gin.Context) {
+			user, _ := UserFromContext(c.Request.Context())
+			c.String(http.StatusOK, user.Name)
+		})
+	}
+
+	request := func(method, path, username string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, nil)
+		if username != "" {
+			req.SetBasicAuth(username, "secret")
+		}
+		w := httptest.NewRecorder()
+		e.ServeHTTP(w, req)
+		return w
+	}
+
+	for _, tc := range []struct {
+		method   string
+		path     string
+		username string
+		expected int
+	}{
+		{http.MethodGet, "/status", "", http.StatusOK},
+		{http.MethodGet, "/healthz", "", http.StatusOK},
+		{http.MethodGet, "/healthz/live", "", http.StatusOK},
+		{http.MethodGet, "/healthz/ready", "", http.StatusOK},
+		{http.MethodGet, "/", "", http.StatusUnauthorized},
+		{http.MethodGet, "/", "reader", http.StatusOK},
+		{http.MethodGet, "/", "sonarr", http.StatusForbidden},
+		{http.MethodPost, "/graphql", "reader", http.StatusOK},
+		{http.MethodPost, "/import", "reader", http.StatusForbidden},
+		{http.MethodPost, "/import", "admin", http.StatusOK},
+		// unauthenticated torznab requests are passed to the torznab API key check:
+		{http.MethodGet, "/torznab/api", "", http.StatusOK},
+		{http.MethodGet, "/torznab/api", "sonarr", http.StatusOK},
+		// unauthenticated feed requests are passed to the torznab API key check only if they have an API key:
+		{http.MethodGet, "/feeds/rss", "", http.StatusUnauthorized},
+		{http.MethodGet, "/feeds/rss?apikey=key", "", http.StatusOK},
+		{http.MethodGet, "/feeds/rss", "reader", http.StatusOK},
+		{http.MethodGet, "/", "unknown", http.StatusUnauthorized},
+		// the overseerr webhook is only public if it has an authorization header of its own:
+		{http.MethodPost, "/overseerr/webhook", "", http.StatusUnauthorized},
+	} {
+		w := request(tc.method, tc.path, tc.username)
+		assert.Equal(t, tc.expected, w.Code, "%s %s as %q", tc.method, tc.path, tc.username)
+		if tc.expected == http.StatusOK && tc.username != "" {
+			assert.Equal(t, tc.username, w.Body.String())
+		}
+	}
+	assert.Equal(t, `Basic realm="bitmagnet", charset="UTF-8"`, request(http.MethodGet, "/", "").Header().Get("WWW-Authenticate"))
+
+	// logging in sets a session cookie that authenticates subsequent requests:
+	req := httptest.NewRequest(http.MethodPost, "/auth/login", strings.NewReader(`{"username":"admin","password":"secret"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	e.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	cookies := w.Result().Cookies()
+	assert.Len(t, cookies, 1)
+	req = httptest.NewRequest(http.MethodPost, "/import", nil)
+	req.AddCookie(cookies[0])
+	w = httptest.NewRecorder()
+	e.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "admin", w.Body.String())
+
+	req = httptest.NewRequest(http.MethodPost, "/auth/login", strings.NewReader(`{"username":"admin","password":"wrong"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+	e.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+func TestPathPermission_Overseerr(t *testing.T) {
+	t.Parallel()
+
+	_, protected := builder{}.pathPermission("/overseerr/webhook")
+	assert.True(t, protected)
+	_, protected = builder{overseerrAuthenticated: true}.pathPermission("/overseerr/webhook")
+	assert.False(t, protected)
+}
+
+func TestSafeRedirect(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "/torrents?q=x", safeRedirect("/torrents?q=x"))
+	assert.Equal(t, "/", safeRedirect("https://example.com"))
+	assert.Equal(t, "/", safeRedirect("//example.com"))
+	assert.Equal(t, "/", safeRedirect(""))
+}
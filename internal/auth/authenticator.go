@@ -0,0 +1,133 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"golang.org/x/crypto/bcrypt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const sessionCookieName = "bitmagnet_session"
+
+var errInvalidCredentials = errors.New("invalid username or password")
+
+// dummyHash is compared against when a username is unknown, so that the response time doesn't reveal which usernames exist.
+var dummyHash = sync.OnceValue(func() []byte {
+	hash, _ := bcrypt.GenerateFromPassword([]byte("bitmagnet"), bcrypt.DefaultCost)
+	return hash
+})
+
+// authenticator identifies the users of requests by their session cookie or basic auth credentials.
+type authenticator struct {
+	users           map[string]UserConfig
+	signer          signer
+	sessionDuration time.Duration
+	oidc            *oidcProvider
+	now             func() time.Time
+	mutex           sync.Mutex
+	// verified caches a digest of each user's last verified password, as bcrypt is too slow to run on every basic auth request.
+	verified map[string][sha256.Size]byte
+}
+
+func (a *authenticator) validate() error {
+	for name, u := range a.users {
+		if !u.Role.Valid() {
+			return fmt.Errorf("user %s has an invalid role: %q", name, u.Role)
+		}
+		if _, err := bcrypt.Cost([]byte(u.PasswordHash)); err != nil {
+			return fmt.Errorf("user %s has an invalid password hash: %w", name, err)
+		}
+	}
+	if a.oidc != nil {
+		if a.oidc.config.ClientID == "" || a.oidc.config.RedirectURL == "" {
+			return errors.New("oidc requires a client ID and redirect URL")
+		}
+		if a.oidc.config.DefaultRole != "" && !a.oidc.config.DefaultRole.Valid() {
+			return fmt.Errorf("invalid oidc default role: %q", a.oidc.config.DefaultRole)
+		}
+		for value, role := range a.oidc.config.RoleMapping {
+			if !role.Valid() {
+				return fmt.Errorf("oidc role mapping for %s has an invalid role: %q", value, role)
+			}
+		}
+	} else if len(a.users) == 0 {
+		return errors.New("authentication is enabled, but no users or oidc provider are configured")
+	}
+	return nil
+}
+
+func (a *authenticator) authenticatePassword(name, password string) (User, error) {
+	u, ok := a.users[name]
+	if !ok {
+		_ = bcrypt.CompareHashAndPassword(dummyHash(), []byte(password))
+		return User{}, errInvalidCredentials
+	}
+	digest := sha256.Sum256([]byte(u.PasswordHash + "\x00" + password))
+	a.mutex.Lock()
+	cached, isCached := a.verified[name]
+	a.mutex.Unlock()
+	if !isCached || subtle.ConstantTimeCompare(cached[:], digest[:]) != 1 {
+		if err := bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(password)); err != nil {
+			return User{}, errInvalidCredentials
+		}
+		a.mutex.Lock()
+		a.verified[name] = digest
+		a.mutex.Unlock()
+	}
+	return User{Name: name, Role: u.Role, Provider: ProviderLocal}, nil
+}
+
+// authenticate returns the user of a request, if it has a valid session cookie or basic auth credentials.
+func (a *authenticator) authenticate(r *http.Request) (User, bool) {
+	if cookie, err := r.Cookie(sessionCookieName); err == nil {
+		if user, err := a.decodeSession(cookie.Value); err == nil {
+			return user, true
+		}
+	}
+	if name, password, ok := r.BasicAuth(); ok {
+		if user, err := a.authenticatePassword(name, password); err == nil {
+			return user, true
+		}
+	}
+	return User{}, false
+}
+
+func (a *authenticator) sessionCookie(r *http.Request, user User) (*http.Cookie, error) {
+	value, err := encodeToken(a.signer, user, a.now().Add(a.sessionDuration))
+	if err != nil {
+		return nil, err
+	}
+	return newCookie(r, sessionCookieName, "/", value, a.sessionDuration), nil
+}
+
+func (a *authenticator) decodeSession(value string) (User, error) {
+	user, err := decodeToken[User](a.signer, value, a.now())
+	if err != nil {
+		return User{}, err
+	}
+	// the sessions of local users reflect changes to their configuration:
+	if user.Provider == ProviderLocal {
+		u, ok := a.users[user.Name]
+		if !ok {
+			return User{}, errInvalidToken
+		}
+		user.Role = u.Role
+	}
+	return user, nil
+}
+
+func newCookie(r *http.Request, name, path, value string, maxAge time.Duration) *http.Cookie {
+	return &http.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     path,
+		MaxAge:   int(maxAge.Seconds()),
+		HttpOnly: true,
+		Secure:   r.TLS != nil || r.Header.Get("X-Forwarded-Proto") == "https",
+		SameSite: http.SameSiteLaxMode,
+	}
+}
@@ -0,0 +1,52 @@
+package auth
+
+import "time"
+
+type Config struct {
+	// Enabled requires users to authenticate before using the web UI and APIs.
+	Enabled bool
+	// Users maps the usernames of local users to their password hash and role.
+	Users map[string]UserConfig
+	// OIDC configures login with an OpenID Connect provider; it is disabled if no issuer URL is set.
+	OIDC OIDCConfig `mapstructure:"oidc"`
+	// SessionSecret signs session cookies; if empty a random secret is generated, and sessions don't survive a restart.
+	SessionSecret string `mapstructure:"session_secret"`
+	// SessionDuration is how long a login lasts.
+	SessionDuration time.Duration `mapstructure:"session_duration"`
+}
+
+type UserConfig struct {
+	// PasswordHash is a bcrypt hash of the user's password.
+	PasswordHash string `mapstructure:"password_hash"`
+	Role         Role   `mapstructure:"role"`
+}
+
+type OIDCConfig struct {
+	IssuerURL    string `mapstructure:"issuer_url"`
+	ClientID     string `mapstructure:"client_id"`
+	ClientSecret string `mapstructure:"client_secret"`
+	// RedirectURL is the URL of the /auth/oidc/callback endpoint, as registered with the provider.
+	RedirectURL string `mapstructure:"redirect_url"`
+	// Scopes are requested in addition to openid.
+	Scopes []string `mapstructure:"scopes"`
+	// RoleClaim is the ID token claim that a user's role is determined from; it may be a string or a list of strings.
+	RoleClaim string `mapstructure:"role_claim"`
+	// RoleMapping maps values of the role claim to roles; if empty, values matching a role name are used directly.
+	RoleMapping map[string]Role `mapstructure:"role_mapping"`
+	// DefaultRole is assigned to users whose claims don't map to any role; if empty, such users are denied.
+	DefaultRole Role `mapstructure:"default_role"`
+}
+
+func (c OIDCConfig) Enabled() bool {
+	return c.IssuerURL != ""
+}
+
+func NewDefaultConfig() Config {
+	return Config{
+		SessionDuration: time.Hour * 24 * 7,
+		OIDC: OIDCConfig{
+			Scopes:    []string{"profile", "email"},
+			RoleClaim: "roles",
+		},
+	}
+}
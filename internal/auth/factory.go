@@ -0,0 +1,56 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/httpserver"
+	"github.com/bitmagnet-io/bitmagnet/internal/wanted/overseerr"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"net/http"
+	"time"
+)
+
+type Params struct {
+	fx.In
+	Config          Config
+	OverseerrConfig overseerr.Config
+	Logger          *zap.SugaredLogger
+}
+
+type Result struct {
+	fx.Out
+	Option httpserver.Option `group:"http_server_options"`
+}
+
+func New(p Params) Result {
+	secret := []byte(p.Config.SessionSecret)
+	if len(secret) == 0 {
+		secret = make([]byte, 32)
+		_, _ = rand.Read(secret)
+	}
+	a := &authenticator{
+		users:           p.Config.Users,
+		signer:          signer{secret: secret},
+		sessionDuration: p.Config.SessionDuration,
+		now:             time.Now,
+		verified:        make(map[string][sha256.Size]byte),
+	}
+	if p.Config.OIDC.Enabled() {
+		a.oidc = &oidcProvider{
+			config: p.Config.OIDC,
+			httpClient: &http.Client{
+				Timeout: time.Second * 10,
+			},
+		}
+	}
+	return Result{
+		Option: builder{
+			enabled:       p.Config.Enabled,
+			authenticator: a,
+			// the overseerr webhook is authenticated by its own authorization header, without which it isn't served:
+			overseerrAuthenticated: p.OverseerrConfig.AuthorizationHeader != "",
+			logger:                 p.Logger.Named("auth"),
+		},
+	}
+}
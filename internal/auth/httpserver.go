@@ -0,0 +1,251 @@
+package auth
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// apiKeyParam is the query parameter of a torznab API key, which is accepted in place of a user by the feeds
+	apiKeyParam    = "apikey"
+	oidcCookieName = "bitmagnet_oidc"
+	oidcCookiePath = "/auth/oidc"
+	oidcLoginTTL   = time.Minute * 10
+)
+
+type builder struct {
+	enabled       bool
+	authenticator *authenticator
+	// overseerrAuthenticated is true if the overseerr webhook is authenticated by its own authorization header
+	overseerrAuthenticated bool
+	logger                 *zap.SugaredLogger
+}
+
+func (builder) Key() string {
+	return "auth"
+}
+
+// Apply adds the authentication middleware, which must run before the handlers of other options;
+// as options are applied in order of their keys, "auth" is applied first.
+func (b builder) Apply(e *gin.Engine) error {
+	if !b.enabled {
+		return nil
+	}
+	if err := b.authenticator.validate(); err != nil {
+		return err
+	}
+	e.Use(b.middleware)
+	e.POST("/auth/login", b.login)
+	e.POST("/auth/logout", b.logout)
+	e.GET("/auth/me", b.me)
+	if b.authenticator.oidc != nil {
+		e.GET("/auth/oidc/login", b.oidcLogin)
+		e.GET("/auth/oidc/callback", b.oidcCallback)
+	}
+	return nil
+}
+
+// pathPermission returns the permission required for a path, or false if the path is public.
+func (b builder) pathPermission(path string) (Permission, bool) {
+	switch {
+	case path == "/status",
+		// health checks are made by container runtimes and orchestrators, which can't authenticate:
+		path == "/healthz",
+		strings.HasPrefix(path, "/healthz/"),
+		strings.HasPrefix(path, "/auth/"),
+		b.overseerrAuthenticated && strings.HasPrefix(path, "/overseerr/"):
+		return 0, false
+	case strings.HasPrefix(path, "/torznab"):
+		return PermissionTorznab, true
+	case strings.HasPrefix(path, "/import"),
+		strings.HasPrefix(path, "/metrics"),
+		strings.HasPrefix(path, "/debug/"):
+		return PermissionAdmin, true
+	default:
+		// GraphQL mutations additionally require the admin permission, which is checked by the GraphQL server
+		return PermissionRead, true
+	}
+}
+
+// acceptsAPIKey returns true for a feed request with a torznab API key, which is checked by the feed handler.
+func acceptsAPIKey(r *http.Request) bool {
+	return strings.HasPrefix(r.URL.Path, "/feeds/") && r.URL.Query().Get(apiKeyParam) != ""
+}
+
+func (b builder) middleware(c *gin.Context) {
+	permission, protected := b.pathPermission(c.Request.URL.Path)
+	if !protected || c.Request.Method == http.MethodOptions {
+		c.Next()
+		return
+	}
+	user, ok := b.authenticator.authenticate(c.Request)
+	if !ok {
+		if permission == PermissionTorznab || acceptsAPIKey(c.Request) {
+			// torznab clients and feed readers can't log in, and are instead authenticated by the torznab API keys
+			c.Next()
+			return
+		}
+		b.challenge(c)
+		return
+	}
+	if !user.Role.Can(permission) {
+		c.AbortWithStatus(http.StatusForbidden)
+		return
+	}
+	c.Request = c.Request.WithContext(WithUser(c.Request.Context(), user))
+	c.Next()
+}
+
+// challenge responds to an unauthenticated request, redirecting browsers to the OIDC login if it's enabled.
+func (b builder) challenge(c *gin.Context) {
+	if b.authenticator.oidc != nil && c.Request.Method == http.MethodGet &&
+		strings.Contains(c.GetHeader("Accept"), "text/html") {
+		c.Redirect(http.StatusFound, "/auth/oidc/login?redirect="+url.QueryEscape(c.Request.URL.RequestURI()))
+		c.Abort()
+		return
+	}
+	if len(b.authenticator.users) > 0 {
+		c.Header("WWW-Authenticate", `Basic realm="bitmagnet", charset="UTF-8"`)
+	}
+	c.AbortWithStatus(http.StatusUnauthorized)
+}
+
+type loginRequest struct {
+	Username string `form:"username" json:"username" binding:"required"`
+	Password string `form:"password" json:"password" binding:"required"`
+}
+
+func (b builder) login(c *gin.Context) {
+	var req loginRequest
+	if err := c.ShouldBind(&req); err != nil {
+		c.String(http.StatusBadRequest, err.Error())
+		return
+	}
+	user, err := b.authenticator.authenticatePassword(req.Username, req.Password)
+	if err != nil {
+		b.logger.Infow("failed login", "username", req.Username, "client_ip", c.ClientIP())
+		c.String(http.StatusUnauthorized, err.Error())
+		return
+	}
+	b.setSession(c, user)
+}
+
+func (b builder) setSession(c *gin.Context, user User) {
+	cookie, err := b.authenticator.sessionCookie(c.Request, user)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	http.SetCookie(c.Writer, cookie)
+	c.JSON(http.StatusOK, user)
+}
+
+func (b builder) logout(c *gin.Context) {
+	http.SetCookie(c.Writer, newCookie(c.Request, sessionCookieName, "/", "", -1))
+	c.Status(http.StatusNoContent)
+}
+
+func (b builder) me(c *gin.Context) {
+	user, ok := b.authenticator.authenticate(c.Request)
+	if !ok {
+		c.Status(http.StatusUnauthorized)
+		return
+	}
+	c.JSON(http.StatusOK, user)
+}
+
+type oidcLoginState struct {
+	State    string `json:"s"`
+	Nonce    string `json:"n"`
+	Redirect string `json:"r"`
+}
+
+func (b builder) oidcLogin(c *gin.Context) {
+	state := oidcLoginState{
+		State:    randomString(),
+		Nonce:    randomString(),
+		Redirect: safeRedirect(c.Query("redirect")),
+	}
+	authURL, err := b.authenticator.oidc.authCodeURL(c, state.State, state.Nonce)
+	if err != nil {
+		b.logger.Errorw("oidc login failed", "error", err)
+		c.String(http.StatusBadGateway, "oidc login failed")
+		return
+	}
+	value, err := encodeToken(b.authenticator.signer, state, b.authenticator.now().Add(oidcLoginTTL))
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	http.SetCookie(c.Writer, newCookie(c.Request, oidcCookieName, oidcCookiePath, value, oidcLoginTTL))
+	c.Redirect(http.StatusFound, authURL)
+}
+
+func (b builder) oidcCallback(c *gin.Context) {
+	user, redirect, err := b.oidcUser(c)
+	http.SetCookie(c.Writer, newCookie(c.Request, oidcCookieName, oidcCookiePath, "", -1))
+	if err != nil {
+		b.logger.Infow("oidc login failed", "client_ip", c.ClientIP(), "error", err)
+		status := http.StatusUnauthorized
+		if errors.Is(err, ErrForbidden) {
+			status = http.StatusForbidden
+		}
+		c.String(status, "login failed: %s", err.Error())
+		return
+	}
+	cookie, err := b.authenticator.sessionCookie(c.Request, user)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	http.SetCookie(c.Writer, cookie)
+	c.Redirect(http.StatusFound, redirect)
+}
+
+func (b builder) oidcUser(c *gin.Context) (User, string, error) {
+	if errParam := c.Query("error"); errParam != "" {
+		return User{}, "", errors.New(errParam)
+	}
+	cookie, err := c.Request.Cookie(oidcCookieName)
+	if err != nil {
+		return User{}, "", errors.New("missing login state")
+	}
+	state, err := decodeToken[oidcLoginState](b.authenticator.signer, cookie.Value, b.authenticator.now())
+	if err != nil || state.State != c.Query("state") {
+		return User{}, "", errors.New("invalid login state")
+	}
+	idToken, err := b.authenticator.oidc.exchange(c, c.Query("code"))
+	if err != nil {
+		return User{}, "", err
+	}
+	claims, err := b.authenticator.oidc.verify(c, idToken, state.Nonce, b.authenticator.now())
+	if err != nil {
+		return User{}, "", err
+	}
+	user, err := b.authenticator.oidc.user(claims)
+	if err != nil {
+		return User{}, "", err
+	}
+	return user, state.Redirect, nil
+}
+
+// safeRedirect only allows redirects to paths on this server.
+func safeRedirect(redirect string) string {
+	if !strings.HasPrefix(redirect, "/") || strings.HasPrefix(redirect, "//") || strings.HasPrefix(redirect, "/\\") {
+		return "/"
+	}
+	return redirect
+}
+
+func randomString() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
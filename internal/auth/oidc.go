@@ -0,0 +1,291 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// oidcProvider implements the OpenID Connect authorization code flow, verifying RS256 signed ID tokens.
+type oidcProvider struct {
+	config     OIDCConfig
+	httpClient *http.Client
+	mutex      sync.Mutex
+	discovery  *oidcDiscovery
+	keys       map[string]*rsa.PublicKey
+}
+
+type oidcDiscovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+func (p *oidcProvider) getJSON(ctx context.Context, u string, target any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+	res, err := p.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d from %s", res.StatusCode, u)
+	}
+	return json.NewDecoder(io.LimitReader(res.Body, 1<<20)).Decode(target)
+}
+
+func (p *oidcProvider) discover(ctx context.Context) (oidcDiscovery, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if p.discovery != nil {
+		return *p.discovery, nil
+	}
+	var d oidcDiscovery
+	if err := p.getJSON(ctx, strings.TrimSuffix(p.config.IssuerURL, "/")+"/.well-known/openid-configuration", &d); err != nil {
+		return oidcDiscovery{}, fmt.Errorf("discovery failed: %w", err)
+	}
+	if d.AuthorizationEndpoint == "" || d.TokenEndpoint == "" || d.JWKSURI == "" {
+		return oidcDiscovery{}, errors.New("discovery failed: incomplete provider metadata")
+	}
+	p.discovery = &d
+	return d, nil
+}
+
+func (p *oidcProvider) authCodeURL(ctx context.Context, state, nonce string) (string, error) {
+	d, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+	u, err := url.Parse(d.AuthorizationEndpoint)
+	if err != nil {
+		return "", err
+	}
+	q := u.Query()
+	q.Set("response_type", "code")
+	q.Set("client_id", p.config.ClientID)
+	q.Set("redirect_uri", p.config.RedirectURL)
+	q.Set("scope", strings.Join(append([]string{"openid"}, p.config.Scopes...), " "))
+	q.Set("state", state)
+	q.Set("nonce", nonce)
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
+// exchange redeems an authorization code, returning the ID token.
+func (p *oidcProvider) exchange(ctx context.Context, code string) (string, error) {
+	d, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+	form := url.Values{
+		"grant_type":   {"authorization_code"},
+		"code":         {code},
+		"redirect_uri": {p.config.RedirectURL},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(url.QueryEscape(p.config.ClientID), url.QueryEscape(p.config.ClientSecret))
+	res, err := p.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+	var body struct {
+		IDToken          string `json:"id_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if err := json.NewDecoder(io.LimitReader(res.Body, 1<<20)).Decode(&body); err != nil {
+		return "", fmt.Errorf("invalid token response: %w", err)
+	}
+	if body.Error != "" {
+		return "", fmt.Errorf("token request failed: %s %s", body.Error, body.ErrorDescription)
+	}
+	if res.StatusCode != http.StatusOK || body.IDToken == "" {
+		return "", fmt.Errorf("token request failed with status %d", res.StatusCode)
+	}
+	return body.IDToken, nil
+}
+
+// key returns the provider's signing key with the given ID, refreshing the key set if it isn't known.
+func (p *oidcProvider) key(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	d, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if k, ok := p.keys[kid]; ok {
+		return k, nil
+	}
+	var jwks struct {
+		Keys []struct {
+			Kid string `json:"kid"`
+			Kty string `json:"kty"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+		} `json:"keys"`
+	}
+	if err := p.getJSON(ctx, d.JWKSURI, &jwks); err != nil {
+		return nil, fmt.Errorf("failed to get signing keys: %w", err)
+	}
+	keys := make(map[string]*rsa.PublicKey, len(jwks.Keys))
+	for _, k := range jwks.Keys {
+		if k.Kty != "RSA" {
+			continue
+		}
+		n, nErr := base64.RawURLEncoding.DecodeString(k.N)
+		e, eErr := base64.RawURLEncoding.DecodeString(k.E)
+		if nErr != nil || eErr != nil {
+			continue
+		}
+		keys[k.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+	p.keys = keys
+	if k, ok := keys[kid]; ok {
+		return k, nil
+	}
+	return nil, fmt.Errorf("unknown signing key: %s", kid)
+}
+
+// verify checks the signature and standard claims of an ID token, returning its claims.
+func (p *oidcProvider) verify(ctx context.Context, idToken, nonce string, now time.Time) (map[string]any, error) {
+	parts := strings.Split(idToken, ".")
+	if len(parts) != 3 {
+		return nil, errInvalidToken
+	}
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, err
+	}
+	if header.Alg != "RS256" {
+		return nil, fmt.Errorf("unsupported signing algorithm: %s", header.Alg)
+	}
+	key, err := p.key(ctx, header.Kid)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, errInvalidToken
+	}
+	hash := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, hash[:], sig); err != nil {
+		return nil, errInvalidToken
+	}
+	var claims map[string]any
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, err
+	}
+	d, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if iss, _ := claims["iss"].(string); iss != d.Issuer {
+		return nil, fmt.Errorf("unexpected issuer: %s", iss)
+	}
+	if !slices.Contains(stringClaims(claims["aud"]), p.config.ClientID) {
+		return nil, errors.New("the token wasn't issued to this client")
+	}
+	if exp, _ := claims["exp"].(float64); now.Unix() >= int64(exp) {
+		return nil, errors.New("the token has expired")
+	}
+	if n, _ := claims["nonce"].(string); n != nonce {
+		return nil, errors.New("invalid nonce")
+	}
+	return claims, nil
+}
+
+// user returns the user identified by an ID token's claims.
+func (p *oidcProvider) user(claims map[string]any) (User, error) {
+	name := ""
+	for _, claim := range []string{"preferred_username", "email", "sub"} {
+		if value, ok := claims[claim].(string); ok && value != "" {
+			name = value
+			break
+		}
+	}
+	if name == "" {
+		return User{}, errors.New("the token doesn't identify a user")
+	}
+	var roles []Role
+	for _, value := range stringClaims(claims[p.config.RoleClaim]) {
+		if len(p.config.RoleMapping) > 0 {
+			if role, ok := p.config.RoleMapping[value]; ok {
+				roles = append(roles, role)
+			}
+		} else {
+			roles = append(roles, Role(value))
+		}
+	}
+	// the most privileged role is used:
+	for _, role := range Roles {
+		if slices.Contains(roles, role) {
+			return User{Name: name, Role: role, Provider: ProviderOIDC}, nil
+		}
+	}
+	if p.config.DefaultRole != "" {
+		return User{Name: name, Role: p.config.DefaultRole, Provider: ProviderOIDC}, nil
+	}
+	return User{}, fmt.Errorf("%w: no role is assigned to %s", ErrForbidden, name)
+}
+
+func decodeSegment(segment string, target any) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return errInvalidToken
+	}
+	if err := json.Unmarshal(data, target); err != nil {
+		return errInvalidToken
+	}
+	return nil
+}
+
+// stringClaims returns the values of a claim that may be a string or a list of strings.
+func stringClaims(claim any) []string {
+	switch v := claim.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,149 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestOIDCLogin(t *testing.T) {
+	t.Parallel()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	encode := func(v any) string {
+		data, _ := json.Marshal(v)
+		return base64.RawURLEncoding.EncodeToString(data)
+	}
+	signToken := func(claims map[string]any) string {
+		data := encode(map[string]string{"alg": "RS256", "kid": "key1"}) + "." + encode(claims)
+		hash := sha256.Sum256([]byte(data))
+		sig, _ := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, hash[:])
+		return data + "." + base64.RawURLEncoding.EncodeToString(sig)
+	}
+
+	var nonce string
+	var provider *httptest.Server
+	provider = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/.well-known/openid-configuration":
+			_ = json.NewEncoder(w).Encode(oidcDiscovery{
+				Issuer:                provider.URL,
+				AuthorizationEndpoint: provider.URL + "/authorize",
+				TokenEndpoint:         provider.URL + "/token",
+				JWKSURI:               provider.URL + "/jwks",
+			})
+		case "/jwks":
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"keys": []map[string]string{{
+					"kid": "key1",
+					"kty": "RSA",
+					"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+					"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+				}},
+			})
+		case "/token":
+			clientID, clientSecret, _ := r.BasicAuth()
+			assert.Equal(t, "bitmagnet", clientID)
+			assert.Equal(t, "client-secret", clientSecret)
+			assert.NoError(t, r.ParseForm())
+			roles := []string{"bitmagnet-readers"}
+			if r.PostForm.Get("code") == "admin-code" {
+				roles = append(roles, "bitmagnet-admins")
+			}
+			_ = json.NewEncoder(w).Encode(map[string]string{
+				"id_token": signToken(map[string]any{
+					"iss":                provider.URL,
+					"aud":                "bitmagnet",
+					"exp":                time.Now().Add(time.Minute).Unix(),
+					"nonce":              nonce,
+					"sub":                "123",
+					"preferred_username": "oidc-user",
+					"groups":             roles,
+				}),
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer provider.Close()
+
+	a := newTestAuthenticator(t)
+	a.oidc = &oidcProvider{
+		config: OIDCConfig{
+			IssuerURL:    provider.URL,
+			ClientID:     "bitmagnet",
+			ClientSecret: "client-secret",
+			RedirectURL:  "http://bitmagnet/auth/oidc/callback",
+			RoleClaim:    "groups",
+			RoleMapping: map[string]Role{
+				"bitmagnet-admins":  RoleAdmin,
+				"bitmagnet-readers": RoleReadOnly,
+			},
+		},
+		httpClient: provider.Client(),
+	}
+	gin.SetMode(gin.TestMode)
+	e := gin.New()
+	assert.NoError(t, builder{enabled: true, authenticator: a, logger: zap.NewNop().Sugar()}.Apply(e))
+
+	// browsers are redirected to the login:
+	req := httptest.NewRequest(http.MethodGet, "/torrents", nil)
+	req.Header.Set("Accept", "text/html")
+	w := httptest.NewRecorder()
+	e.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusFound, w.Code)
+	assert.Equal(t, "/auth/oidc/login?redirect=%2Ftorrents", w.Header().Get("Location"))
+
+	login := func(code string) (*http.Cookie, string) {
+		w := httptest.NewRecorder()
+		e.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/auth/oidc/login?redirect=%2Ftorrents", nil))
+		assert.Equal(t, http.StatusFound, w.Code)
+		authURL, err := url.Parse(w.Header().Get("Location"))
+		assert.NoError(t, err)
+		assert.Equal(t, provider.URL+"/authorize", authURL.Scheme+"://"+authURL.Host+authURL.Path)
+		assert.Equal(t, "openid", authURL.Query().Get("scope"))
+		nonce = authURL.Query().Get("nonce")
+		stateCookie := w.Result().Cookies()[0]
+
+		req := httptest.NewRequest(http.MethodGet, "/auth/oidc/callback?"+url.Values{
+			"code":  {code},
+			"state": {authURL.Query().Get("state")},
+		}.Encode(), nil)
+		req.AddCookie(stateCookie)
+		w = httptest.NewRecorder()
+		e.ServeHTTP(w, req)
+		for _, c := range w.Result().Cookies() {
+			if c.Name == sessionCookieName {
+				return c, w.Header().Get("Location")
+			}
+		}
+		return nil, ""
+	}
+
+	for code, role := range map[string]Role{"code": RoleReadOnly, "admin-code": RoleAdmin} {
+		sessionCookie, location := login(code)
+		assert.NotNil(t, sessionCookie)
+		assert.Equal(t, "/torrents", location)
+		user, err := a.decodeSession(sessionCookie.Value)
+		assert.NoError(t, err)
+		assert.Equal(t, User{Name: "oidc-user", Role: role, Provider: ProviderOIDC}, user)
+	}
+
+	// a callback without the matching state is rejected:
+	w = httptest.NewRecorder()
+	e.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/auth/oidc/callback?code=code&state=forged", nil))
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
@@ -0,0 +1,62 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+)
+
+var errInvalidToken = errors.New("invalid token")
+
+// signer encodes values as tokens signed with an HMAC, for use in cookies.
+type signer struct {
+	secret []byte
+}
+
+// signedPayload wraps a value with its expiry time.
+type signedPayload[T any] struct {
+	Value     T     `json:"v"`
+	ExpiresAt int64 `json:"exp"`
+}
+
+func (s signer) sign(data string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(data))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func encodeToken[T any](s signer, value T, expiresAt time.Time) (string, error) {
+	payload, err := json.Marshal(signedPayload[T]{
+		Value:     value,
+		ExpiresAt: expiresAt.Unix(),
+	})
+	if err != nil {
+		return "", err
+	}
+	data := base64.RawURLEncoding.EncodeToString(payload)
+	return data + "." + s.sign(data), nil
+}
+
+func decodeToken[T any](s signer, token string, now time.Time) (T, error) {
+	var zero T
+	data, sig, ok := strings.Cut(token, ".")
+	if !ok || !hmac.Equal([]byte(sig), []byte(s.sign(data))) {
+		return zero, errInvalidToken
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(data)
+	if err != nil {
+		return zero, errInvalidToken
+	}
+	var p signedPayload[T]
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return zero, errInvalidToken
+	}
+	if now.Unix() >= p.ExpiresAt {
+		return zero, errInvalidToken
+	}
+	return p.Value, nil
+}
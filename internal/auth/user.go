@@ -0,0 +1,86 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Role determines what a user is permitted to do.
+type Role string
+
+const (
+	// RoleAdmin can use all endpoints, including GraphQL mutations and admin operations.
+	RoleAdmin Role = "admin"
+	// RoleReadOnly can search and browse, but can't make changes.
+	RoleReadOnly Role = "read_only"
+	// RoleTorznab can only use the torznab endpoint.
+	RoleTorznab Role = "torznab"
+)
+
+// Roles are ordered from most to least privileged.
+var Roles = []Role{RoleAdmin, RoleReadOnly, RoleTorznab}
+
+func (r Role) Valid() bool {
+	for _, role := range Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+type Permission int
+
+const (
+	PermissionTorznab Permission = iota
+	PermissionRead
+	PermissionAdmin
+)
+
+func (r Role) Can(p Permission) bool {
+	switch r {
+	case RoleAdmin:
+		return true
+	case RoleReadOnly:
+		return p == PermissionRead || p == PermissionTorznab
+	case RoleTorznab:
+		return p == PermissionTorznab
+	default:
+		return false
+	}
+}
+
+const (
+	ProviderLocal = "local"
+	ProviderOIDC  = "oidc"
+)
+
+type User struct {
+	Name     string `json:"name"`
+	Role     Role   `json:"role"`
+	Provider string `json:"provider"`
+}
+
+var ErrForbidden = errors.New("forbidden")
+
+type userContextKey struct{}
+
+func WithUser(ctx context.Context, user User) context.Context {
+	return context.WithValue(ctx, userContextKey{}, user)
+}
+
+// UserFromContext returns the authenticated user of a request, if authentication is enabled.
+func UserFromContext(ctx context.Context) (User, bool) {
+	user, ok := ctx.Value(userContextKey{}).(User)
+	return user, ok
+}
+
+// Authorize returns ErrForbidden if the request's user lacks the permission.
+// Requests without a user are allowed, as authentication is disabled or the endpoint was already authorized by the middleware.
+func Authorize(ctx context.Context, p Permission) error {
+	if user, ok := UserFromContext(ctx); ok && !user.Role.Can(p) {
+		return fmt.Errorf("%w: the %s role is not permitted to do this", ErrForbidden, user.Role)
+	}
+	return nil
+}
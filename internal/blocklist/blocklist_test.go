@@ -0,0 +1,73 @@
+package blocklist
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const testList = `# comment
+
+d2474e86c95b19b8bcfdb92bc12c9d44667cfa36
+movie:tmdb:278
+:
+`
+
+func TestLoadListFromPath(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "list.txt")
+	require.NoError(t, os.WriteFile(path, []byte(testList), 0o600))
+
+	entries, lineErrs, err := loadList(context.Background(), http.DefaultClient, ListConfig{Path: path})
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "d2474e86c95b19b8bcfdb92bc12c9d44667cfa36", entries[0].String())
+	assert.Equal(t, "movie:tmdb:278", entries[1].String())
+	require.Len(t, lineErrs, 1)
+	assert.ErrorContains(t, lineErrs[0], "line 5")
+}
+
+func TestLoadListFromURL(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/list.txt" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(testList))
+	}))
+	defer server.Close()
+
+	entries, _, err := loadList(context.Background(), server.Client(), ListConfig{URL: server.URL + "/list.txt"})
+	require.NoError(t, err)
+	assert.Len(t, entries, 2)
+
+	_, _, err = loadList(context.Background(), server.Client(), ListConfig{URL: server.URL + "/missing.txt"})
+	assert.Error(t, err)
+
+	_, _, err = loadList(context.Background(), server.Client(), ListConfig{})
+	assert.Error(t, err)
+}
+
+func TestMatchesName(t *testing.T) {
+	t.Parallel()
+
+	regexps, err := compilePatterns([]string{`\bsample\b`, `^bad\.`})
+	require.NoError(t, err)
+
+	m := manager{nameRegexps: regexps}
+	assert.True(t, m.matchesName("Some.Movie.SAMPLE.mkv"))
+	assert.True(t, m.matchesName("Bad.Release"))
+	assert.False(t, m.matchesName("Not.Bad.Release"))
+	assert.False(t, m.matchesName("Samples.Pack"))
+
+	_, err = compilePatterns([]string{"("})
+	assert.Error(t, err)
+}
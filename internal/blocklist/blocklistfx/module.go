@@ -0,0 +1,17 @@
+package blocklistfx
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/blocklist"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/config/configfx"
+	"go.uber.org/fx"
+)
+
+func New() fx.Option {
+	return fx.Module(
+		"blocklist",
+		configfx.NewConfigModule[blocklist.Config]("blocklist", blocklist.NewDefaultConfig()),
+		fx.Provide(
+			blocklist.New,
+		),
+	)
+}
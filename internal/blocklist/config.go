@@ -0,0 +1,28 @@
+package blocklist
+
+import "time"
+
+type Config struct {
+	// Lists maps list names to files or URLs of info hashes and content IDs to block, with one entry per line.
+	Lists map[string]ListConfig
+	// NamePatterns are regular expressions matched case-insensitively against torrent names; matching torrents are deleted and blocked.
+	// Patterns are matched both in Go and in PostgreSQL, so should use syntax common to both.
+	NamePatterns []string `mapstructure:"name_patterns"`
+	// RefreshInterval is the time between reloading the lists and removing stored torrents that match the name patterns.
+	RefreshInterval time.Duration `mapstructure:"refresh_interval"`
+	// Timeout applies to each download of a remote list.
+	Timeout time.Duration
+}
+
+type ListConfig struct {
+	// Path is a local file to load the list from; either a path or a URL must be set.
+	Path string `mapstructure:"path"`
+	URL  string `mapstructure:"url"`
+}
+
+func NewDefaultConfig() Config {
+	return Config{
+		RefreshInterval: time.Hour * 6,
+		Timeout:         time.Minute,
+	}
+}
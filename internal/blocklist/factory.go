@@ -0,0 +1,101 @@
+package blocklist
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/worker"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/events"
+	"github.com/bitmagnet-io/bitmagnet/internal/takedown"
+	"github.com/bitmagnet-io/bitmagnet/internal/taskrun"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"net/http"
+)
+
+type Params struct {
+	fx.In
+	Config          Config
+	Dao             lazy.Lazy[*dao.Query]
+	Takedown        lazy.Lazy[takedown.Manager]
+	EventBus        lazy.Lazy[events.Bus]
+	TaskRunRecorder lazy.Lazy[taskrun.Recorder]
+	Logger          *zap.SugaredLogger
+}
+
+type Result struct {
+	fx.Out
+	Manager lazy.Lazy[Manager]
+	Worker  worker.Worker `group:"workers"`
+}
+
+func New(p Params) Result {
+	lm := lazy.New(func() (*manager, error) {
+		d, err := p.Dao.Get()
+		if err != nil {
+			return nil, err
+		}
+		t, err := p.Takedown.Get()
+		if err != nil {
+			return nil, err
+		}
+		eb, err := p.EventBus.Get()
+		if err != nil {
+			return nil, err
+		}
+		regexps, err := compilePatterns(p.Config.NamePatterns)
+		if err != nil {
+			return nil, err
+		}
+		return &manager{
+			lists:        p.Config.Lists,
+			namePatterns: p.Config.NamePatterns,
+			nameRegexps:  regexps,
+			httpClient: &http.Client{
+				Timeout: p.Config.Timeout,
+			},
+			dao:      d,
+			takedown: t,
+			eventBus: eb,
+			logger:   p.Logger.Named("blocklist"),
+		}, nil
+	})
+	var r *refresher
+	return Result{
+		Manager: lazy.New(func() (Manager, error) {
+			return lm.Get()
+		}),
+		Worker: worker.NewWorker(
+			"blocklist",
+			fx.Hook{
+				OnStart: func(context.Context) error {
+					m, err := lm.Get()
+					if err != nil {
+						return err
+					}
+					tr, err := p.TaskRunRecorder.Get()
+					if err != nil {
+						return err
+					}
+					if !m.hasWork() {
+						return nil
+					}
+					r = &refresher{
+						manager:         m,
+						interval:        p.Config.RefreshInterval,
+						taskRunRecorder: tr,
+						stopped:         make(chan struct{}),
+					}
+					go r.start()
+					return nil
+				},
+				OnStop: func(context.Context) error {
+					if r != nil {
+						close(r.stopped)
+					}
+					return nil
+				},
+			},
+		),
+	}
+}
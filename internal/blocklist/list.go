@@ -0,0 +1,69 @@
+package blocklist
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/takedown"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// ListPrefix is prepended to the names of blocklists to give the name of the takedown list that their entries are added to.
+const ListPrefix = "blocklist:"
+
+// loadList reads the entries of a list; lines that can't be parsed are returned as errors rather than failing the list.
+func loadList(ctx context.Context, httpClient *http.Client, c ListConfig) ([]takedown.Entry, []error, error) {
+	var r io.Reader
+	switch {
+	case c.Path != "":
+		f, err := os.Open(c.Path)
+		if err != nil {
+			return nil, nil, err
+		}
+		defer func() {
+			_ = f.Close()
+		}()
+		r = f
+	case c.URL != "":
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.URL, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		res, err := httpClient.Do(req)
+		if err != nil {
+			return nil, nil, err
+		}
+		defer func() {
+			_ = res.Body.Close()
+		}()
+		if res.StatusCode != http.StatusOK {
+			return nil, nil, fmt.Errorf("unexpected status %d", res.StatusCode)
+		}
+		r = res.Body
+	default:
+		return nil, nil, errors.New("a path or URL is required")
+	}
+	var entries []takedown.Entry
+	var lineErrs []error
+	scanner := bufio.NewScanner(r)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		e, err := takedown.ParseEntry(line)
+		if err != nil {
+			lineErrs = append(lineErrs, fmt.Errorf("line %d: %w", lineNumber, err))
+			continue
+		}
+		entries = append(entries, e)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, err
+	}
+	return entries, lineErrs, nil
+}
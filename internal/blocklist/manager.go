@@ -0,0 +1,197 @@
+package blocklist
+
+import (
+	"context"
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/events"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"github.com/bitmagnet-io/bitmagnet/internal/takedown"
+	"go.uber.org/zap"
+	"net/http"
+	"regexp"
+	"slices"
+)
+
+const sweepBatchSize = 1000
+
+// Manager maintains the configured blocklists. Info hash and content ID lists are synchronised with takedown lists,
+// so that matching torrents are removed and blocked by the takedown manager; torrent names are matched against the name patterns.
+type Manager interface {
+	// Refresh reloads the lists, and removes stored torrents matching the name patterns.
+	Refresh(ctx context.Context) error
+	// Enforce deletes and blocks the torrents of any torrent contents with a name matching a pattern, and returns the remainder.
+	Enforce(ctx context.Context, tcs []model.TorrentContent) ([]model.TorrentContent, error)
+}
+
+type manager struct {
+	lists        map[string]ListConfig
+	namePatterns []string
+	nameRegexps  []*regexp.Regexp
+	httpClient   *http.Client
+	dao          *dao.Query
+	takedown     takedown.Manager
+	eventBus     events.Bus
+	logger       *zap.SugaredLogger
+}
+
+func compilePatterns(patterns []string) ([]*regexp.Regexp, error) {
+	regexps := make([]*regexp.Regexp, 0, len(patterns))
+	for _, p := range patterns {
+		re, err := regexp.Compile("(?i)" + p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid name pattern %q: %w", p, err)
+		}
+		regexps = append(regexps, re)
+	}
+	return regexps, nil
+}
+
+func (m manager) matchesName(name string) bool {
+	for _, re := range m.nameRegexps {
+		if re.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m manager) Enforce(ctx context.Context, tcs []model.TorrentContent) ([]model.TorrentContent, error) {
+	if len(m.nameRegexps) == 0 {
+		return tcs, nil
+	}
+	kept := make([]model.TorrentContent, 0, len(tcs))
+	var hashesToBlock []protocol.ID
+	for _, tc := range tcs {
+		if m.matchesName(tc.Torrent.Name) {
+			hashesToBlock = append(hashesToBlock, tc.InfoHash)
+		} else {
+			kept = append(kept, tc)
+		}
+	}
+	if len(hashesToBlock) == 0 {
+		return kept, nil
+	}
+	if err := m.deleteAndBlock(ctx, hashesToBlock); err != nil {
+		return nil, err
+	}
+	return kept, nil
+}
+
+func (m manager) deleteAndBlock(ctx context.Context, infoHashes []protocol.ID) error {
+	if _, err := m.dao.DeleteAndBlockTorrents(ctx, infoHashes); err != nil {
+		return err
+	}
+	m.eventBus.Publish(ctx, events.NewDeletedEvents(infoHashes...)...)
+	m.logger.Infow("removed blocked torrents", "count", len(infoHashes))
+	return nil
+}
+
+func (m manager) Refresh(ctx context.Context) error {
+	names := make([]string, 0, len(m.lists))
+	for name := range m.lists {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	var errs []error
+	for _, name := range names {
+		if err := m.refreshList(ctx, name, m.lists[name]); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			// a list that fails to load is left as it is until the next refresh
+			m.logger.Errorw("failed to refresh blocklist", "list", name, "error", err)
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+		}
+	}
+	if err := m.sweep(ctx); err != nil {
+		errs = append(errs, err)
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("blocklist refresh failed: %v", errs)
+	}
+	return nil
+}
+
+// refreshList adds new entries of a list to its takedown list, and withdraws entries that have been removed from it.
+func (m manager) refreshList(ctx context.Context, name string, c ListConfig) error {
+	entries, lineErrs, err := loadList(ctx, m.httpClient, c)
+	if err != nil {
+		return err
+	}
+	for _, lineErr := range lineErrs {
+		m.logger.Warnw("invalid blocklist entry", "list", name, "error", lineErr)
+	}
+	takedownList := ListPrefix + name
+	result, err := m.takedown.Submit(ctx, takedownList, entries...)
+	if err != nil {
+		return err
+	}
+	current := make(map[string]struct{}, len(entries))
+	for _, e := range entries {
+		current[e.String()] = struct{}{}
+	}
+	existing, err := m.dao.Takedown.WithContext(ctx).Where(m.dao.Takedown.List.Eq(takedownList)).Find()
+	if err != nil {
+		return err
+	}
+	var withdrawn []int64
+	for _, t := range existing {
+		if _, ok := current[takedownKey(*t)]; !ok {
+			withdrawn = append(withdrawn, t.ID)
+		}
+	}
+	if len(withdrawn) > 0 {
+		if err := m.takedown.Withdraw(ctx, withdrawn...); err != nil {
+			return err
+		}
+	}
+	m.logger.Infow(
+		"refreshed blocklist",
+		"list", name,
+		"entries", len(entries),
+		"added", len(result.Takedowns),
+		"withdrawn", len(withdrawn),
+		"removedTorrents", result.RemovedTorrents,
+	)
+	return nil
+}
+
+// takedownKey returns the string form of the entry a takedown was created from, as given by takedown.Entry.String.
+func takedownKey(t model.Takedown) string {
+	if t.InfoHash != nil {
+		return t.InfoHash.String()
+	}
+	return model.ContentRef{
+		Type:   t.ContentType.ContentType,
+		Source: t.ContentSource.String,
+		ID:     t.ContentID.String,
+	}.String()
+}
+
+// sweep deletes and blocks stored torrents with a name matching a pattern.
+func (m manager) sweep(ctx context.Context) error {
+	for _, p := range m.namePatterns {
+		for {
+			var infoHashes []protocol.ID
+			if err := m.dao.Torrent.WithContext(ctx).Where(
+				m.dao.RawCondition("name ~* ?", p),
+			).Limit(sweepBatchSize).Pluck(m.dao.Torrent.InfoHash, &infoHashes); err != nil {
+				return fmt.Errorf("failed to match name pattern %q: %w", p, err)
+			}
+			if len(infoHashes) == 0 {
+				break
+			}
+			if err := m.deleteAndBlock(ctx, infoHashes); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// hasWork returns true if any lists or name patterns are configured.
+func (m manager) hasWork() bool {
+	return len(m.lists) > 0 || len(m.namePatterns) > 0
+}
@@ -0,0 +1,32 @@
+package blocklist
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/taskrun"
+	"time"
+)
+
+// refresher refreshes the blocklists when the worker starts, and then at the configured interval.
+type refresher struct {
+	manager         *manager
+	interval        time.Duration
+	taskRunRecorder taskrun.Recorder
+	stopped         chan struct{}
+}
+
+func (r *refresher) start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		for {
+			run := r.taskRunRecorder.Start(ctx, taskrun.KindBlocklistRefresh)
+			run.Finish(r.manager.Refresh(ctx))
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(r.interval):
+			}
+		}
+	}()
+	<-r.stopped
+}
@@ -28,7 +28,8 @@ func New(p Params) (Result, error) {
 						Value: false,
 					},
 					&cli.StringSliceFlag{
-						Name: "keys",
+						Name:  "keys",
+						Usage: "keys of the workers, or names of the worker profiles, to run",
 					},
 				},
 				Action: func(ctx *cli.Context) error {
@@ -1,13 +1,16 @@
 package hooks
 
 import (
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/worker"
 	"go.uber.org/fx"
 )
 
 type Params struct {
 	fx.In
-	Lifecycle fx.Lifecycle
-	Hooks     []fx.Hook `group:"app_hooks"`
+	Lifecycle  fx.Lifecycle
+	Hooks      []fx.Hook `group:"app_hooks"`
+	CloseHooks []fx.Hook `group:"app_close_hooks"`
+	Workers    worker.Registry
 }
 
 type Result struct {
@@ -17,9 +20,18 @@ type Result struct {
 
 type AttachedHooks struct{}
 
+// New attaches the app hooks to the lifecycle. Hooks are stopped in the reverse order to which they're attached,
+// so on shutdown the running workers are stopped first, so that no new work is taken on, then the app hooks,
+// and finally the close hooks, which close resources such as database connections that the others may use.
 func New(p Params) (Result, error) {
+	for _, hook := range p.CloseHooks {
+		p.Lifecycle.Append(hook)
+	}
 	for _, hook := range p.Hooks {
 		p.Lifecycle.Append(hook)
 	}
+	p.Lifecycle.Append(fx.Hook{
+		OnStop: p.Workers.Stop,
+	})
 	return Result{}, nil
 }
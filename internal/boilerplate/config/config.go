@@ -26,26 +26,28 @@ type Result struct {
 }
 
 func New(p Params) (r Result, err error) {
-	resolvers := p.Resolvers
+	r.Resolved, err = resolve(p.Resolvers, p.Validate, p.Specs)
+	return
+}
+
+func resolve(resolvers []configresolver.Resolver, val *validator.Validate, specs []Spec) (ResolvedConfig, error) {
 	sort.Slice(resolvers, func(i, j int) bool {
 		if resolvers[i].Priority() == resolvers[j].Priority() {
 			return strings.Compare(resolvers[i].Key(), resolvers[j].Key()) < 0
 		}
 		return resolvers[i].Priority() < resolvers[j].Priority()
 	})
-	res := &ResolvedConfig{
+	res := ResolvedConfig{
 		NodeMap: make(map[string]ResolvedNode),
 	}
-	for _, spec := range p.Specs {
-		resolved, resolveErr := resolveRootNode(resolvers, p.Validate, spec)
+	for _, spec := range specs {
+		resolved, resolveErr := resolveRootNode(resolvers, val, spec)
 		if resolveErr != nil {
-			err = resolveErr
-			return
+			return ResolvedConfig{}, resolveErr
 		}
 		res.NodeMap[spec.Key] = resolved
 	}
-	r.Resolved = *res
-	return
+	return res, nil
 }
 
 type Spec struct {
@@ -10,6 +10,7 @@ import (
 func New() fx.Option {
 	options := []fx.Option{
 		fx.Provide(config.New),
+		fx.Provide(config.NewWatcher),
 		fx.Provide(fx.Annotated{
 			Group: "config_resolvers",
 			Target: func() (configresolver.Resolver, error) {
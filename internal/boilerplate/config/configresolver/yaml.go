@@ -3,10 +3,36 @@ package configresolver
 import (
 	"gopkg.in/yaml.v3"
 	"os"
+	"time"
 )
 
+// Reloadable is a resolver of a source that can change while the app is running, such as a config file.
+type Reloadable interface {
+	Resolver
+	// Reload returns a resolver of the current content of the source, and whether it has changed since this resolver was created.
+	Reload() (Resolver, bool, error)
+}
+
+type yamlFileResolver struct {
+	Resolver
+	path          string
+	ignoreMissing bool
+	options       []Option
+	modTime       time.Time
+	size          int64
+}
+
 func NewFromYamlFile(path string, ignoreMissing bool, options ...Option) (Resolver, error) {
+	r := &yamlFileResolver{
+		path:          path,
+		ignoreMissing: ignoreMissing,
+		options:       options,
+	}
 	m := make(map[string]interface{})
+	if info, statErr := os.Stat(path); statErr == nil {
+		r.modTime = info.ModTime()
+		r.size = info.Size()
+	}
 	data, readErr := os.ReadFile(path)
 	if readErr != nil {
 		if !ignoreMissing || !os.IsNotExist(readErr) {
@@ -18,5 +44,26 @@ func NewFromYamlFile(path string, ignoreMissing bool, options ...Option) (Resolv
 			return nil, parseErr
 		}
 	}
-	return NewMap(m, append([]Option{WithKey(path)}, options...)...), nil
+	r.Resolver = NewMap(m, append([]Option{WithKey(path)}, options...)...)
+	return r, nil
+}
+
+func (r *yamlFileResolver) Reload() (Resolver, bool, error) {
+	var modTime time.Time
+	var size int64
+	info, statErr := os.Stat(r.path)
+	if statErr == nil {
+		modTime = info.ModTime()
+		size = info.Size()
+	} else if !os.IsNotExist(statErr) {
+		return nil, false, statErr
+	}
+	if modTime.Equal(r.modTime) && size == r.size {
+		return r, false, nil
+	}
+	next, err := NewFromYamlFile(r.path, r.ignoreMissing, r.options...)
+	if err != nil {
+		return nil, false, err
+	}
+	return next, true, nil
 }
@@ -0,0 +1,182 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/config/configresolver"
+	validator "github.com/go-playground/validator/v10"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"os"
+	"os/signal"
+	"reflect"
+	"sort"
+	"syscall"
+	"time"
+)
+
+// OnConfigChange is provided in the config_change_hooks group by a component that can apply changes to the config
+// with the given key while the app is running, without a restart; the hook is called with the new value.
+type OnConfigChange struct {
+	Key  string
+	Hook func(value interface{}) error
+}
+
+func NewOnConfigChange[T any](key string, hook func(T) error) OnConfigChange {
+	return OnConfigChange{
+		Key: key,
+		Hook: func(value interface{}) error {
+			v, ok := value.(T)
+			if !ok {
+				return errors.New("unexpected config type")
+			}
+			return hook(v)
+		},
+	}
+}
+
+// watchInterval is how often config files are checked for changes; a reload can also be triggered with a SIGHUP.
+const watchInterval = 5 * time.Second
+
+type WatcherParams struct {
+	fx.In
+	Specs     []Spec                    `group:"config_specs"`
+	Resolvers []configresolver.Resolver `group:"config_resolvers"`
+	Validate  *validator.Validate
+	Resolved  ResolvedConfig
+	Hooks     []OnConfigChange `group:"config_change_hooks"`
+	Logger    *zap.SugaredLogger
+}
+
+type WatcherResult struct {
+	fx.Out
+	AppHook fx.Hook `group:"app_hooks"`
+}
+
+func NewWatcher(p WatcherParams) WatcherResult {
+	specs := make([]Spec, len(p.Specs))
+	copy(specs, p.Specs)
+	sort.Slice(specs, func(i, j int) bool {
+		return specs[i].Key < specs[j].Key
+	})
+	hooks := make(map[string][]OnConfigChange, len(p.Hooks))
+	for _, h := range p.Hooks {
+		hooks[h.Key] = append(hooks[h.Key], h)
+	}
+	w := &watcher{
+		specs:     specs,
+		resolvers: p.Resolvers,
+		validate:  p.Validate,
+		resolved:  p.Resolved,
+		hooks:     hooks,
+		logger:    p.Logger.Named("config_watcher"),
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan struct{})
+	return WatcherResult{
+		AppHook: fx.Hook{
+			OnStart: func(context.Context) error {
+				go func() {
+					defer close(stopped)
+					w.run(ctx)
+				}()
+				return nil
+			},
+			OnStop: func(context.Context) error {
+				cancel()
+				<-stopped
+				return nil
+			},
+		},
+	}
+}
+
+type watcher struct {
+	specs     []Spec
+	resolvers []configresolver.Resolver
+	validate  *validator.Validate
+	resolved  ResolvedConfig
+	hooks     map[string][]OnConfigChange
+	logger    *zap.SugaredLogger
+}
+
+func (w *watcher) run(ctx context.Context) {
+	ticker := time.NewTicker(watchInterval)
+	defer ticker.Stop()
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.reload(false)
+		case <-hup:
+			w.reload(true)
+		}
+	}
+}
+
+func (w *watcher) reload(force bool) {
+	changed := force
+	resolvers := make([]configresolver.Resolver, len(w.resolvers))
+	for i, r := range w.resolvers {
+		resolvers[i] = r
+		if reloadable, ok := r.(configresolver.Reloadable); ok {
+			next, nextChanged, err := reloadable.Reload()
+			if err != nil {
+				w.logger.Errorw("failed to reload config", "source", r.Key(), "error", err)
+				return
+			}
+			resolvers[i] = next
+			changed = changed || nextChanged
+		}
+	}
+	if !changed {
+		return
+	}
+	// the new sources are kept even if they're invalid, so that the error is only logged once for each change
+	w.resolvers = resolvers
+	resolved, err := resolve(resolvers, w.validate, w.specs)
+	if err != nil {
+		w.logger.Errorw("the changed config is invalid and has not been applied", "error", err)
+		return
+	}
+	for _, spec := range w.specs {
+		previous := w.resolved.NodeMap[spec.Key].Value
+		value := resolved.NodeMap[spec.Key].Value
+		if reflect.DeepEqual(previous, value) {
+			continue
+		}
+		hooks := w.hooks[spec.Key]
+		if len(hooks) == 0 {
+			w.logger.Warnw("config changed; a restart is required to apply it", "key", spec.Key)
+			continue
+		}
+		if hookErr := w.apply(spec.Key, hooks, previous, value); hookErr != nil {
+			w.logger.Errorw("failed to apply config change; the previous config is kept", "key", spec.Key, "error", hookErr)
+			// the change is applied again on the next forced reload
+			resolved.NodeMap[spec.Key] = w.resolved.NodeMap[spec.Key]
+			continue
+		}
+		w.logger.Infow("applied config change", "key", spec.Key)
+	}
+	w.resolved = resolved
+}
+
+// apply calls the hooks of a key with its new value; if a hook fails, the hooks already called are called again with
+// the previous value, so that the config of a key is applied by all of its hooks or by none.
+func (w *watcher) apply(key string, hooks []OnConfigChange, previous, value interface{}) error {
+	for i, h := range hooks {
+		if err := h.Hook(value); err != nil {
+			for _, applied := range hooks[:i] {
+				if revertErr := applied.Hook(previous); revertErr != nil {
+					w.logger.Errorw("failed to revert config change", "key", key, "error", revertErr)
+				}
+			}
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package config
+
+import (
+	"errors"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/config/configresolver"
+	validator "github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWatcherReload(t *testing.T) {
+	t.Parallel()
+
+	type levelConfig struct {
+		Level string
+	}
+	type otherConfig struct {
+		Enabled bool
+	}
+
+	path := filepath.Join(t.TempDir(), "config.yml")
+	require.NoError(t, os.WriteFile(path, []byte("log:\n  level: info\n"), 0o600))
+	resolver, err := configresolver.NewFromYamlFile(path, true)
+	require.NoError(t, err)
+	specs := []Spec{
+		{Key: "log", DefaultValue: levelConfig{Level: "warn"}},
+		{Key: "other", DefaultValue: otherConfig{}},
+	}
+	resolvers := []configresolver.Resolver{resolver}
+	resolved, err := resolve(resolvers, validator.New(), specs)
+	require.NoError(t, err)
+	assert.Equal(t, levelConfig{Level: "info"}, resolved.NodeMap["log"].Value)
+
+	var applied []levelConfig
+	w := &watcher{
+		specs:     specs,
+		resolvers: resolvers,
+		validate:  validator.New(),
+		resolved:  resolved,
+		hooks: map[string][]OnConfigChange{
+			"log": {NewOnConfigChange("log", func(cfg levelConfig) error {
+				applied = append(applied, cfg)
+				return nil
+			})},
+		},
+		logger: zap.NewNop().Sugar(),
+	}
+
+	w.reload(false)
+	assert.Empty(t, applied, "unchanged file")
+
+	require.NoError(t, os.WriteFile(path, []byte("log:\n  level: debug\nother:\n  enabled: true\n"), 0o600))
+	w.reload(false)
+	assert.Equal(t, []levelConfig{{Level: "debug"}}, applied)
+	assert.Equal(t, otherConfig{Enabled: true}, w.resolved.NodeMap["other"].Value)
+
+	w.reload(true)
+	assert.Len(t, applied, 1, "forced reload of unchanged values")
+}
+
+func TestWatcherReload_HookError(t *testing.T) {
+	t.Parallel()
+
+	type levelConfig struct {
+		Level string
+	}
+
+	path := filepath.Join(t.TempDir(), "config.yml")
+	require.NoError(t, os.WriteFile(path, []byte("log:\n  level: info\n"), 0o600))
+	resolver, err := configresolver.NewFromYamlFile(path, true)
+	require.NoError(t, err)
+	specs := []Spec{{Key: "log", DefaultValue: levelConfig{Level: "warn"}}}
+	resolvers := []configresolver.Resolver{resolver}
+	resolved, err := resolve(resolvers, validator.New(), specs)
+	require.NoError(t, err)
+
+	var applied []levelConfig
+	failing := true
+	w := &watcher{
+		specs:     specs,
+		resolvers: resolvers,
+		validate:  validator.New(),
+		resolved:  resolved,
+		hooks: map[string][]OnConfigChange{
+			"log": {
+				NewOnConfigChange("log", func(cfg levelConfig) error {
+					applied = append(applied, cfg)
+					return nil
+				}),
+				NewOnConfigChange("log", func(levelConfig) error {
+					if failing {
+						return errors.New("failed")
+					}
+					return nil
+				}),
+			},
+		},
+		logger: zap.NewNop().Sugar(),
+	}
+
+	require.NoError(t, os.WriteFile(path, []byte("log:\n  level: debug\n"), 0o600))
+	w.reload(false)
+	assert.Equal(t, []levelConfig{{Level: "debug"}, {Level: "info"}}, applied, "the change should be reverted")
+	assert.Equal(t, levelConfig{Level: "info"}, w.resolved.NodeMap["log"].Value, "the previous config should be kept")
+
+	failing = false
+	w.reload(true)
+	assert.Equal(t, levelConfig{Level: "debug"}, w.resolved.NodeMap["log"].Value, "a forced reload should apply it again")
+}
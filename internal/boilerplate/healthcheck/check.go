@@ -0,0 +1,38 @@
+package healthcheck
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrInactive is returned by a check of a component that isn't running in this process, such as the DHT crawler
+// when its worker isn't enabled; inactive components don't affect the status.
+var ErrInactive = errors.New("inactive")
+
+// Check is a health check of a component, provided to the "healthchecks" group.
+type Check struct {
+	Name string
+	// Critical checks must pass for the process to be ready; a failing non-critical check only degrades the status.
+	Critical bool
+	// Timeout defaults to 5 seconds.
+	Timeout time.Duration
+	// CacheFor reuses the last result for this long, for checks of external services that shouldn't be called often.
+	CacheFor time.Duration
+	Check    func(ctx context.Context) error
+}
+
+const defaultTimeout = 5 * time.Second
+
+func (c Check) timeout() time.Duration {
+	if c.Timeout == 0 {
+		return defaultTimeout
+	}
+	return c.Timeout
+}
+
+func (c Check) run(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout())
+	defer cancel()
+	return c.Check(ctx)
+}
@@ -0,0 +1,137 @@
+package healthcheck
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+)
+
+type Status string
+
+const (
+	StatusOk          Status = "ok"
+	StatusDegraded    Status = "degraded"
+	StatusUnavailable Status = "unavailable"
+)
+
+type ComponentStatus string
+
+const (
+	ComponentStatusOk       ComponentStatus = "ok"
+	ComponentStatusFailed   ComponentStatus = "failed"
+	ComponentStatusInactive ComponentStatus = "inactive"
+)
+
+type Report struct {
+	Status     Status            `json:"status"`
+	CheckedAt  time.Time         `json:"checkedAt"`
+	Components []ComponentReport `json:"components"`
+}
+
+// Ready is true if every critical component is ok or inactive.
+func (r Report) Ready() bool {
+	return r.Status != StatusUnavailable
+}
+
+type ComponentReport struct {
+	Name      string          `json:"name"`
+	Critical  bool            `json:"critical"`
+	Status    ComponentStatus `json:"status"`
+	CheckedAt time.Time       `json:"checkedAt"`
+	Latency   time.Duration   `json:"latency"`
+	Error     *string         `json:"error,omitempty"`
+	// LastError is the most recent error of the component, which may be from an earlier check.
+	LastError   *string    `json:"lastError,omitempty"`
+	LastErrorAt *time.Time `json:"lastErrorAt,omitempty"`
+}
+
+func (r ComponentReport) LatencySeconds() float64 {
+	return r.Latency.Seconds()
+}
+
+type Checker interface {
+	// Check runs the health checks concurrently, or reuses cached results, and reports the status of each component.
+	Check(ctx context.Context) Report
+}
+
+type checker struct {
+	checks []Check
+	mutex  sync.Mutex
+	last   map[string]ComponentReport
+}
+
+func newChecker(checks []Check) *checker {
+	sorted := make([]Check, len(checks))
+	copy(sorted, checks)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Name < sorted[j].Name
+	})
+	return &checker{
+		checks: sorted,
+		last:   make(map[string]ComponentReport, len(checks)),
+	}
+}
+
+func (c *checker) Check(ctx context.Context) Report {
+	report := Report{
+		Status:     StatusOk,
+		CheckedAt:  time.Now(),
+		Components: make([]ComponentReport, len(c.checks)),
+	}
+	var wg sync.WaitGroup
+	for i, check := range c.checks {
+		wg.Add(1)
+		go func(i int, check Check) {
+			defer wg.Done()
+			report.Components[i] = c.checkComponent(ctx, check)
+		}(i, check)
+	}
+	wg.Wait()
+	for _, component := range report.Components {
+		if component.Status != ComponentStatusFailed {
+			continue
+		}
+		if component.Critical {
+			report.Status = StatusUnavailable
+		} else if report.Status == StatusOk {
+			report.Status = StatusDegraded
+		}
+	}
+	return report
+}
+
+func (c *checker) checkComponent(ctx context.Context, check Check) ComponentReport {
+	c.mutex.Lock()
+	last, hasLast := c.last[check.Name]
+	c.mutex.Unlock()
+	if hasLast && check.CacheFor > 0 && time.Since(last.CheckedAt) < check.CacheFor {
+		return last
+	}
+	startedAt := time.Now()
+	err := check.run(ctx)
+	component := ComponentReport{
+		Name:        check.Name,
+		Critical:    check.Critical,
+		Status:      ComponentStatusOk,
+		CheckedAt:   startedAt,
+		Latency:     time.Since(startedAt),
+		LastError:   last.LastError,
+		LastErrorAt: last.LastErrorAt,
+	}
+	switch {
+	case errors.Is(err, ErrInactive):
+		component.Status = ComponentStatusInactive
+	case err != nil:
+		component.Status = ComponentStatusFailed
+		msg := err.Error()
+		component.Error = &msg
+		component.LastError = &msg
+		component.LastErrorAt = &startedAt
+	}
+	c.mutex.Lock()
+	c.last[check.Name] = component
+	c.mutex.Unlock()
+	return component
+}
@@ -0,0 +1,69 @@
+package healthcheck
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestChecker(t *testing.T) {
+	t.Parallel()
+
+	ok := func(context.Context) error { return nil }
+	failing := func(context.Context) error { return errors.New("failed") }
+	inactive := func(context.Context) error { return ErrInactive }
+
+	for _, tc := range []struct {
+		name     string
+		checks   []Check
+		expected Status
+	}{
+		{"all ok", []Check{{Name: "a", Critical: true, Check: ok}, {Name: "b", Check: ok}}, StatusOk},
+		{"inactive", []Check{{Name: "a", Critical: true, Check: inactive}}, StatusOk},
+		{"non-critical failed", []Check{{Name: "a", Critical: true, Check: ok}, {Name: "b", Check: failing}}, StatusDegraded},
+		{"critical failed", []Check{{Name: "a", Critical: true, Check: failing}, {Name: "b", Check: failing}}, StatusUnavailable},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			report := newChecker(tc.checks).Check(context.Background())
+			assert.Equal(t, tc.expected, report.Status)
+			assert.Equal(t, tc.expected != StatusUnavailable, report.Ready())
+		})
+	}
+
+	t.Run("last error and cache", func(t *testing.T) {
+		t.Parallel()
+		var calls atomic.Int32
+		var flaking atomic.Bool
+		flaking.Store(true)
+		c := newChecker([]Check{
+			{Name: "cached", CacheFor: time.Hour, Check: func(context.Context) error {
+				calls.Add(1)
+				return nil
+			}},
+			{Name: "flaky", Check: func(context.Context) error {
+				if flaking.Load() {
+					return errors.New("flaked")
+				}
+				return nil
+			}},
+		})
+		first := c.Check(context.Background())
+		require.Len(t, first.Components, 2)
+		require.NotNil(t, first.Components[1].Error)
+		assert.Equal(t, ComponentStatusFailed, first.Components[1].Status)
+		flaking.Store(false)
+		second := c.Check(context.Background())
+		assert.Equal(t, int32(1), calls.Load())
+		assert.Equal(t, first.Components[0], second.Components[0])
+		assert.Equal(t, ComponentStatusOk, second.Components[1].Status)
+		assert.Nil(t, second.Components[1].Error)
+		require.NotNil(t, second.Components[1].LastError)
+		assert.Equal(t, "flaked", *second.Components[1].LastError)
+	})
+}
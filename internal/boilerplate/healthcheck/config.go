@@ -0,0 +1,16 @@
+package healthcheck
+
+type Config struct {
+	// DiskSpacePaths are checked for free disk space, such as the mount point of the Postgres data directory
+	// when it's on the same host.
+	DiskSpacePaths []string
+	// MinFreeDiskSpace is the free disk space in bytes below which the disk space check fails.
+	MinFreeDiskSpace uint64
+}
+
+func NewDefaultConfig() Config {
+	return Config{
+		DiskSpacePaths:   []string{"/"},
+		MinFreeDiskSpace: 1_000_000_000,
+	}
+}
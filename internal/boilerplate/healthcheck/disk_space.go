@@ -0,0 +1,40 @@
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"go.uber.org/fx"
+)
+
+type DiskSpaceParams struct {
+	fx.In
+	Config Config
+}
+
+type DiskSpaceResult struct {
+	fx.Out
+	Check Check `group:"healthchecks"`
+}
+
+func NewDiskSpaceCheck(p DiskSpaceParams) DiskSpaceResult {
+	return DiskSpaceResult{
+		Check: Check{
+			Name: "disk_space",
+			Check: func(context.Context) error {
+				if len(p.Config.DiskSpacePaths) == 0 {
+					return ErrInactive
+				}
+				for _, path := range p.Config.DiskSpacePaths {
+					free, err := freeDiskSpace(path)
+					if err != nil {
+						return err
+					}
+					if free < p.Config.MinFreeDiskSpace {
+						return fmt.Errorf("%s has %d bytes free, less than the minimum of %d", path, free, p.Config.MinFreeDiskSpace)
+					}
+				}
+				return nil
+			},
+		},
+	}
+}
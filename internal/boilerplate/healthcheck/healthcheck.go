@@ -1,24 +1,46 @@
 package healthcheck
 
 import (
+	"context"
+	"errors"
 	"github.com/hellofresh/health-go/v5"
 	"go.uber.org/fx"
 )
 
 type Params struct {
 	fx.In
+	Checks  []Check         `group:"healthchecks"`
 	Options []health.Option `group:"healthcheck_options"`
 }
 
 type Result struct {
 	fx.Out
-	Health *health.Health
+	Health  *health.Health
+	Checker Checker
 }
 
 func New(p Params) (Result, error) {
-	h, err := health.New(append(p.Options, health.WithSystemInfo())...)
+	options := append(p.Options, health.WithSystemInfo())
+	for _, check := range p.Checks {
+		check := check
+		options = append(options, health.WithChecks(health.Config{
+			Name:      check.Name,
+			Timeout:   check.timeout(),
+			SkipOnErr: !check.Critical,
+			Check: func(ctx context.Context) error {
+				if err := check.Check(ctx); err != nil && !errors.Is(err, ErrInactive) {
+					return err
+				}
+				return nil
+			},
+		}))
+	}
+	h, err := health.New(options...)
 	if err != nil {
 		return Result{}, err
 	}
-	return Result{Health: h}, nil
+	return Result{
+		Health:  h,
+		Checker: newChecker(p.Checks),
+	}, nil
 }
@@ -1,6 +1,7 @@
 package healthcheckfx
 
 import (
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/config/configfx"
 	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/healthcheck"
 	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/healthcheck/httpserver"
 	"go.uber.org/fx"
@@ -9,7 +10,9 @@ import (
 func New() fx.Option {
 	return fx.Module(
 		"healthcheck",
+		configfx.NewConfigModule[healthcheck.Config]("healthcheck", healthcheck.NewDefaultConfig()),
 		fx.Provide(healthcheck.New),
+		fx.Provide(healthcheck.NewDiskSpaceCheck),
 		fx.Provide(httpserver.New),
 	)
 }
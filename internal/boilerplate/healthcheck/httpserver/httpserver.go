@@ -1,15 +1,18 @@
 package httpserver
 
 import (
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/healthcheck"
 	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/httpserver"
 	"github.com/gin-gonic/gin"
 	"github.com/hellofresh/health-go/v5"
 	"go.uber.org/fx"
+	"net/http"
 )
 
 type Params struct {
 	fx.In
-	Health *health.Health
+	Health  *health.Health
+	Checker healthcheck.Checker
 }
 
 type Result struct {
@@ -23,12 +26,14 @@ func New(p Params) (r Result, err error) {
 		handler: func(c *gin.Context) {
 			handler.ServeHTTP(c.Writer, c.Request)
 		},
+		checker: p.Checker,
 	}
 	return
 }
 
 type builder struct {
 	handler gin.HandlerFunc
+	checker healthcheck.Checker
 }
 
 func (builder) Key() string {
@@ -37,5 +42,25 @@ func (builder) Key() string {
 
 func (b *builder) Apply(e *gin.Engine) error {
 	e.GET("/status", b.handler)
+	// the full report, which is unavailable (503) if a critical component has failed
+	e.GET("/healthz", func(c *gin.Context) {
+		report := b.checker.Check(c.Request.Context())
+		c.JSON(reportStatusCode(report), report)
+	})
+	// liveness only requires the process to be serving requests, so that it isn't restarted while a dependency is down
+	e.GET("/healthz/live", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"status": healthcheck.StatusOk})
+	})
+	e.GET("/healthz/ready", func(c *gin.Context) {
+		report := b.checker.Check(c.Request.Context())
+		c.JSON(reportStatusCode(report), gin.H{"status": report.Status})
+	})
 	return nil
 }
+
+func reportStatusCode(report healthcheck.Report) int {
+	if report.Ready() {
+		return http.StatusOK
+	}
+	return http.StatusServiceUnavailable
+}
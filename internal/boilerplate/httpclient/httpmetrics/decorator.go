@@ -0,0 +1,45 @@
+package httpmetrics
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/httpclient"
+	"github.com/prometheus/client_golang/prometheus"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// NewRequestDuration returns a histogram of request durations for the decorator, labelled by response status code,
+// or "error" for requests that failed without a response.
+func NewRequestDuration(subsystem string) *prometheus.HistogramVec {
+	return prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "bitmagnet",
+		Subsystem: subsystem,
+		Name:      "request_duration_seconds",
+		Help:      "A histogram of HTTP request durations, by status code.",
+	}, []string{"status"})
+}
+
+func NewDecorator(requestDuration *prometheus.HistogramVec) httpclient.TransportDecorator {
+	return func(t http.RoundTripper) http.RoundTripper {
+		return &httpMetrics{
+			requestDuration: requestDuration,
+			transport:       t,
+		}
+	}
+}
+
+type httpMetrics struct {
+	requestDuration *prometheus.HistogramVec
+	transport       http.RoundTripper
+}
+
+func (m *httpMetrics) RoundTrip(req *http.Request) (*http.Response, error) {
+	startTime := time.Now()
+	resp, err := m.transport.RoundTrip(req)
+	status := "error"
+	if err == nil {
+		status = strconv.Itoa(resp.StatusCode)
+	}
+	m.requestDuration.WithLabelValues(status).Observe(time.Since(startTime).Seconds())
+	return resp, err
+}
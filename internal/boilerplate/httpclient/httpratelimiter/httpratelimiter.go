@@ -8,9 +8,14 @@ import (
 )
 
 func NewDecorator(limit time.Duration, burst int) httpclient.TransportDecorator {
+	return NewLimiterDecorator(rate.NewLimiter(rate.Every(limit), burst))
+}
+
+// NewLimiterDecorator limits requests with the given limiter, whose limit and burst can be changed while in use.
+func NewLimiterDecorator(limiter *rate.Limiter) httpclient.TransportDecorator {
 	return func(t http.RoundTripper) http.RoundTripper {
 		return &httpRateLimiter{
-			limiter:   rate.NewLimiter(rate.Every(limit), burst),
+			limiter:   limiter,
 			transport: t,
 		}
 	}
@@ -5,6 +5,22 @@ type Config struct {
 	GinMode      string
 	Cors         CorsConfig
 	Options      []string
+	RateLimit    RateLimitConfig `mapstructure:"rate_limit"`
+	// TrustedProxies are the IPs or CIDRs of the reverse proxies whose X-Forwarded-For header is trusted to give the
+	// client IP; the header isn't trusted if empty, and the client IP is that of the connection.
+	TrustedProxies []string `mapstructure:"trusted_proxies"`
+}
+
+type RateLimitConfig struct {
+	// PerIP is the maximum number of requests per minute from each client IP; requests aren't limited by IP if zero.
+	PerIP uint `mapstructure:"per_ip"`
+	// PerAPIKey is the maximum number of requests per minute with each value of the apikey parameter, in addition to
+	// the limit of the client IP; requests aren't limited by API key if zero.
+	PerAPIKey uint `mapstructure:"per_api_key"`
+	// Burst is the number of requests that can be made at once before being limited to the rate.
+	Burst uint
+	// Paths are the prefixes of the paths that are rate limited.
+	Paths []string
 }
 
 type CorsConfig struct {
@@ -59,5 +75,9 @@ func NewDefaultConfig() Config {
 			Debug:          true,
 		},
 		Options: []string{"*"},
+		RateLimit: RateLimitConfig{
+			Burst: 10,
+			Paths: []string{"/graphql", "/torznab"},
+		},
 	}
 }
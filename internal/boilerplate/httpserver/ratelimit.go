@@ -0,0 +1,90 @@
+package httpserver
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/concurrency"
+	"github.com/gin-gonic/gin"
+	"golang.org/x/time/rate"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// rateLimitClients is the maximum number of clients whose rate limits are tracked at once.
+const rateLimitClients = 10000
+
+type rateLimiter struct {
+	paths     []string
+	perIP     concurrency.KeyedLimiter
+	perAPIKey concurrency.KeyedLimiter
+}
+
+func newRateLimiter(config RateLimitConfig) *rateLimiter {
+	if config.PerIP == 0 && config.PerAPIKey == 0 {
+		return nil
+	}
+	l := &rateLimiter{paths: config.Paths}
+	newLimiter := func(perMinute uint) concurrency.KeyedLimiter {
+		// a limiter is forgotten once it would have been refilled
+		ttl := time.Minute * time.Duration(max(config.Burst, 1)) / time.Duration(perMinute)
+		return concurrency.NewKeyedLimiter(
+			rate.Limit(float64(perMinute)/60),
+			int(max(config.Burst, 1)),
+			rateLimitClients,
+			max(ttl, time.Minute),
+		)
+	}
+	if config.PerIP > 0 {
+		l.perIP = newLimiter(config.PerIP)
+	}
+	if config.PerAPIKey > 0 {
+		l.perAPIKey = newLimiter(config.PerAPIKey)
+	}
+	return l
+}
+
+// middleware rejects requests exceeding the limit of their client IP, or of their API key if they have one, with a 429
+// status and a Retry-After header of when the request would be allowed.
+func (l *rateLimiter) middleware(c *gin.Context) {
+	if !l.limited(c.Request.URL.Path) {
+		c.Next()
+		return
+	}
+	now := time.Now()
+	var reservations []*rate.Reservation
+	if l.perIP != nil {
+		reservations = append(reservations, l.perIP.ReserveAt(c.ClientIP(), now))
+	}
+	if apiKey := c.Query("apikey"); apiKey != "" && l.perAPIKey != nil {
+		reservations = append(reservations, l.perAPIKey.ReserveAt(apiKey, now))
+	}
+	var delay time.Duration
+	for _, r := range reservations {
+		if !r.OK() {
+			delay = max(delay, time.Minute)
+		} else {
+			delay = max(delay, r.DelayFrom(now))
+		}
+	}
+	if delay > 0 {
+		// the request is rejected, so it shouldn't use up any of its limits
+		for _, r := range reservations {
+			r.CancelAt(now)
+		}
+		c.Header("Retry-After", strconv.Itoa(int(math.Ceil(delay.Seconds()))))
+		c.String(http.StatusTooManyRequests, "rate limit exceeded\n")
+		c.Abort()
+		return
+	}
+	c.Next()
+}
+
+func (l *rateLimiter) limited(path string) bool {
+	for _, p := range l.paths {
+		if strings.HasPrefix(path, p) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,91 @@
+package httpserver
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, newRateLimiter(RateLimitConfig{Burst: 10}))
+
+	limiter := newRateLimiter(RateLimitConfig{
+		PerIP:     6,
+		PerAPIKey: 2,
+		Burst:     2,
+		Paths:     []string{"/torznab"},
+	})
+	gin.SetMode(gin.TestMode)
+	g := gin.New()
+	assert.NoError(t, g.SetTrustedProxies(nil))
+	g.Use(limiter.middleware)
+	g.GET("/*any", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	request := func(path, ip string, forwardedFor ...string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, path, nil)
+		r.RemoteAddr = ip + ":1234"
+		for _, f := range forwardedFor {
+			r.Header.Set("X-Forwarded-For", f)
+		}
+		g.ServeHTTP(w, r)
+		return w
+	}
+
+	assert.Equal(t, http.StatusOK, request("/torznab/api", "10.0.0.1").Code)
+	assert.Equal(t, http.StatusOK, request("/torznab/api", "10.0.0.1").Code)
+	limited := request("/torznab/api", "10.0.0.1")
+	assert.Equal(t, http.StatusTooManyRequests, limited.Code)
+	assert.Equal(t, "10", limited.Header().Get("Retry-After"))
+	// other clients and paths aren't limited
+	assert.Equal(t, http.StatusOK, request("/torznab/api", "10.0.0.2").Code)
+	assert.Equal(t, http.StatusOK, request("/graphql", "10.0.0.1").Code)
+
+	// an API key is limited across client IPs
+	assert.Equal(t, http.StatusOK, request("/torznab/api?apikey=a", "10.0.0.3").Code)
+	assert.Equal(t, http.StatusOK, request("/torznab/api?apikey=a", "10.0.0.4").Code)
+	limited = request("/torznab/api?apikey=a", "10.0.0.5")
+	assert.Equal(t, http.StatusTooManyRequests, limited.Code)
+	assert.Equal(t, "30", limited.Header().Get("Retry-After"))
+	// the rejected request didn't use up the limit of its client IP
+	assert.Equal(t, http.StatusOK, request("/torznab/api", "10.0.0.5").Code)
+	assert.Equal(t, http.StatusOK, request("/torznab/api", "10.0.0.5").Code)
+
+	// a client can't evade its limit with a forwarded header, as no proxy is trusted
+	assert.Equal(t, http.StatusOK, request("/torznab/api", "10.0.0.6", "192.168.0.1").Code)
+	assert.Equal(t, http.StatusOK, request("/torznab/api", "10.0.0.6", "192.168.0.2").Code)
+	assert.Equal(t, http.StatusTooManyRequests, request("/torznab/api", "10.0.0.6", "192.168.0.3").Code)
+}
+
+func TestRateLimiter_TrustedProxy(t *testing.T) {
+	t.Parallel()
+
+	limiter := newRateLimiter(RateLimitConfig{PerIP: 6, Burst: 1, Paths: []string{"/torznab"}})
+	g := gin.New()
+	assert.NoError(t, g.SetTrustedProxies([]string{"10.0.0.1"}))
+	g.Use(limiter.middleware)
+	g.GET("/*any", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	request := func(ip, forwardedFor string) int {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/torznab/api", nil)
+		r.RemoteAddr = ip + ":1234"
+		r.Header.Set("X-Forwarded-For", forwardedFor)
+		g.ServeHTTP(w, r)
+		return w.Code
+	}
+
+	// clients behind a trusted proxy are limited separately
+	assert.Equal(t, http.StatusOK, request("10.0.0.1", "192.168.0.1"))
+	assert.Equal(t, http.StatusOK, request("10.0.0.1", "192.168.0.2"))
+	assert.Equal(t, http.StatusTooManyRequests, request("10.0.0.1", "192.168.0.1"))
+	// the header of an untrusted client is ignored
+	assert.Equal(t, http.StatusOK, request("10.0.0.2", "192.168.0.3"))
+	assert.Equal(t, http.StatusTooManyRequests, request("10.0.0.2", "192.168.0.4"))
+}
@@ -36,7 +36,14 @@ func New(p Params) Result {
 				OnStart: func(ctx context.Context) error {
 					gin.SetMode(p.Config.GinMode)
 					g := gin.New()
+					// gin trusts the forwarded headers of any client by default, which would allow the rate limits to be evaded
+					if err := g.SetTrustedProxies(p.Config.TrustedProxies); err != nil {
+						return err
+					}
 					g.Use(ginzap.Ginzap(p.Logger.Named("gin"), time.RFC3339, true), gin.Recovery())
+					if limiter := newRateLimiter(p.Config.RateLimit); limiter != nil {
+						g.Use(limiter.middleware)
+					}
 					options, optionsErr := resolveOptions(p.Config.Options, p.Options)
 					if optionsErr != nil {
 						return optionsErr
@@ -2,6 +2,7 @@ package logging
 
 import (
 	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/config"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -18,6 +19,8 @@ type Result struct {
 	Logger  *zap.Logger
 	Sugar   *zap.SugaredLogger
 	AppHook fx.Hook `group:"app_hooks"`
+	// changes to the log levels are applied while running; other changes require a restart
+	OnConfigChange config.OnConfigChange `group:"config_change_hooks"`
 }
 
 func New(params Params) Result {
@@ -36,10 +39,12 @@ func New(params Params) Result {
 	if params.Config.Development {
 		opts = append(opts, zap.Development())
 	}
+	level := zap.NewAtomicLevelAt(levelToZapLevel(params.Config.Level))
+	fileLevel := zap.NewAtomicLevelAt(levelToZapLevel(params.Config.FileRotator.Level))
 	core := zapcore.NewCore(
 		encoder,
 		writeSyncer,
-		levelToZapLevel(params.Config.Level),
+		level,
 	)
 	if params.Config.FileRotator.Enabled {
 		fWriteSyncer := NewFileRotator(params.Config.FileRotator)
@@ -48,7 +53,7 @@ func New(params Params) Result {
 			zapcore.NewCore(
 				zapcore.NewJSONEncoder(jsonEncoderConfig),
 				fWriteSyncer,
-				levelToZapLevel(params.Config.FileRotator.Level),
+				fileLevel,
 			),
 		)
 		appHook = fx.Hook{
@@ -62,5 +67,10 @@ func New(params Params) Result {
 		Logger:  l,
 		Sugar:   l.Sugar(),
 		AppHook: appHook,
+		OnConfigChange: config.NewOnConfigChange("log", func(cfg Config) error {
+			level.SetLevel(levelToZapLevel(cfg.Level))
+			fileLevel.SetLevel(levelToZapLevel(cfg.FileRotator.Level))
+			return nil
+		}),
 	}
 }
@@ -14,6 +14,7 @@ type RegistryParams struct {
 	fx.Shutdowner
 	Workers    []Worker    `group:"workers"`
 	Decorators []Decorator `group:"worker_decorators"`
+	Profiles   []Profile   `group:"worker_profiles"`
 	Logger     *zap.SugaredLogger
 }
 
@@ -24,13 +25,20 @@ type RegistryResult struct {
 
 func NewRegistry(p RegistryParams) (RegistryResult, error) {
 	r := &registry{
-		mutex:   &sync.RWMutex{},
-		workers: make(map[string]Worker),
-		logger:  p.Logger,
+		mutex:    &sync.RWMutex{},
+		workers:  make(map[string]Worker),
+		profiles: make(map[string][]string),
+		logger:   p.Logger,
 	}
 	for _, w := range p.Workers {
 		r.workers[w.Key()] = w
 	}
+	for _, pr := range p.Profiles {
+		if _, ok := r.workers[pr.Name]; ok {
+			return RegistryResult{}, fmt.Errorf("profile %s has the same name as a worker", pr.Name)
+		}
+		r.profiles[pr.Name] = pr.Keys
+	}
 	for _, d := range p.Decorators {
 		if err := r.decorate(d.Key, d.Decorate); err != nil {
 			return RegistryResult{}, err
@@ -62,6 +70,12 @@ type Worker interface {
 
 type DecorateFunction func(fx.Hook) fx.Hook
 
+// Profile names a set of workers that are run together, and can be enabled in place of the worker keys.
+type Profile struct {
+	Name string
+	Keys []string
+}
+
 type Decorator struct {
 	Key      string
 	Decorate DecorateFunction
@@ -116,9 +130,10 @@ func (w *worker) setStarted(started bool) {
 }
 
 type registry struct {
-	mutex   *sync.RWMutex
-	workers map[string]Worker
-	logger  *zap.SugaredLogger
+	mutex    *sync.RWMutex
+	workers  map[string]Worker
+	profiles map[string][]string
+	logger   *zap.SugaredLogger
 }
 
 func (r *registry) Workers() []Worker {
@@ -135,10 +150,16 @@ func (r *registry) Enable(names ...string) error {
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
 	for _, name := range names {
-		if w, ok := r.workers[name]; ok {
-			w.setEnabled(true)
-		} else {
-			return fmt.Errorf("worker %s not found", name)
+		keys, ok := r.profiles[name]
+		if !ok {
+			keys = []string{name}
+		}
+		for _, key := range keys {
+			if w, ok := r.workers[key]; ok {
+				w.setEnabled(true)
+			} else {
+				return fmt.Errorf("worker %s not found", key)
+			}
 		}
 	}
 	return nil
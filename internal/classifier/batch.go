@@ -0,0 +1,64 @@
+package classifier
+
+import (
+	"context"
+	"errors"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"sync"
+)
+
+type batchContextKey struct{}
+
+// batchLookups memoizes the results of content lookups for the duration of a processing batch.
+// Torrents for the same content (e.g. multiple releases of a movie) tend to be queued together,
+// and without this each of them would incur its own provider API calls, as nothing is persisted until the batch completes.
+type batchLookups struct {
+	mutex   sync.Mutex
+	results map[string]*batchLookupResult
+}
+
+// batchLookupResult is locked while its lookup is made, so that lookups of the same key are made once, while lookups of
+// other keys, including those nested within it, can proceed.
+type batchLookupResult struct {
+	mutex sync.Mutex
+	done  bool
+	value any
+	err   error
+}
+
+// WithBatch returns a context within which the results of lookups made with BatchLookup are shared.
+func WithBatch(ctx context.Context) context.Context {
+	return context.WithValue(ctx, batchContextKey{}, &batchLookups{
+		results: make(map[string]*batchLookupResult),
+	})
+}
+
+// BatchLookup returns the result of a previous lookup with the same key in the current batch, or calls fn.
+// Outside a batch fn is always called. Only successful results and ErrNoMatch are reused;
+// other errors may be transient, so are retried.
+func BatchLookup[T any](ctx context.Context, key string, fn func() (T, error)) (T, error) {
+	b, ok := ctx.Value(batchContextKey{}).(*batchLookups)
+	if !ok {
+		return fn()
+	}
+	b.mutex.Lock()
+	r, ok := b.results[key]
+	if !ok {
+		r = &batchLookupResult{}
+		b.results[key] = r
+	}
+	b.mutex.Unlock()
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if r.done {
+		TraceLookup(ctx, model.ClassificationTraceLookup{Source: "batch", Query: key})
+		return r.value.(T), r.err
+	}
+	value, err := fn()
+	if err == nil || errors.Is(err, ErrNoMatch) {
+		r.done = true
+		r.value = value
+		r.err = err
+	}
+	return value, err
+}
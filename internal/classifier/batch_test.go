@@ -0,0 +1,54 @@
+package classifier
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestBatchLookup(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+	lookup := func(ctx context.Context, key string, value string, err error) (string, error) {
+		return BatchLookup(ctx, key, func() (string, error) {
+			calls++
+			return value, err
+		})
+	}
+
+	ctx := context.Background()
+	_, _ = lookup(ctx, "a", "1", nil)
+	_, _ = lookup(ctx, "a", "1", nil)
+	assert.Equal(t, 2, calls, "lookups outside a batch should not be reused")
+
+	calls = 0
+	ctx = WithBatch(context.Background())
+	v, err := lookup(ctx, "a", "1", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "1", v)
+	v, err = lookup(ctx, "a", "2", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "1", v)
+	_, err = lookup(ctx, "b", "", ErrNoMatch)
+	assert.ErrorIs(t, err, ErrNoMatch)
+	_, err = lookup(ctx, "b", "3", nil)
+	assert.ErrorIs(t, err, ErrNoMatch)
+	assert.Equal(t, 2, calls)
+
+	transient := errors.New("rate limited")
+	_, err = lookup(ctx, "c", "", transient)
+	assert.ErrorIs(t, err, transient)
+	v, err = lookup(ctx, "c", "4", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "4", v)
+	assert.Equal(t, 4, calls)
+
+	// lookups can be nested within others
+	v, err = BatchLookup(ctx, "d", func() (string, error) {
+		return lookup(ctx, "e", "5", nil)
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "5", v)
+}
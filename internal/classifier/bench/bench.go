@@ -0,0 +1,183 @@
+package bench
+
+import (
+	"context"
+	"errors"
+	"github.com/bitmagnet-io/bitmagnet/internal/classifier"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+)
+
+// Sample is a torrent of a labeled dataset, with the content type it should be classified as, and optionally the
+// content it should be matched to.
+type Sample struct {
+	InfoHash    protocol.ID
+	Name        string
+	Size        uint64
+	ContentType model.ContentType
+	// ContentSource and ContentID identify the expected content, for example tmdb and 603 or imdb and tt0133093.
+	ContentSource model.NullString
+	ContentID     model.NullString
+}
+
+func (s Sample) torrent() model.Torrent {
+	return model.Torrent{
+		InfoHash:    s.InfoHash,
+		Name:        s.Name,
+		Size:        s.Size,
+		FilesStatus: model.FilesStatusNoInfo,
+	}
+}
+
+func (s Sample) hasContent() bool {
+	return s.ContentSource.Valid && s.ContentID.Valid
+}
+
+// Result is the classification of a sample.
+type Result struct {
+	Sample      Sample
+	ContentType model.NullContentType
+	Content     *model.Content
+	Err         error
+}
+
+// Correct is true if the sample was classified as its content type, and matched to its content if it's labeled with one.
+func (r Result) Correct() bool {
+	return r.contentTypeCorrect() && (!r.Sample.hasContent() || r.contentCorrect())
+}
+
+func (r Result) contentTypeCorrect() bool {
+	return r.ContentType.Valid && r.ContentType.ContentType == r.Sample.ContentType
+}
+
+func (r Result) contentCorrect() bool {
+	if r.Content == nil {
+		return false
+	}
+	id, ok := r.Content.Identifier(r.Sample.ContentSource.String)
+	return ok && id == r.Sample.ContentID.String
+}
+
+// ContentTypeStats are the classifications of a content type, from which its precision and recall are calculated.
+type ContentTypeStats struct {
+	ContentType model.ContentType
+	// Labeled is the number of samples labeled with the content type
+	Labeled uint
+	// Predicted is the number of samples classified as the content type
+	Predicted uint
+	// Correct is the number of samples labeled with the content type that were classified as it
+	Correct uint
+}
+
+// Precision is the proportion of the samples classified as the content type that are labeled with it, or 0 if none are.
+func (s ContentTypeStats) Precision() float64 {
+	if s.Predicted == 0 {
+		return 0
+	}
+	return float64(s.Correct) / float64(s.Predicted)
+}
+
+// Recall is the proportion of the samples labeled with the content type that were classified as it, or 0 if none are.
+func (s ContentTypeStats) Recall() float64 {
+	if s.Labeled == 0 {
+		return 0
+	}
+	return float64(s.Correct) / float64(s.Labeled)
+}
+
+type Report struct {
+	Results []Result
+	// ContentTypes are the stats of each content type that samples are labeled with or classified as, in the order of
+	// the content type enum.
+	ContentTypes []ContentTypeStats
+	// ContentLabeled is the number of samples labeled with content, of which ContentMatched were matched to it.
+	ContentLabeled uint
+	ContentMatched uint
+	Errors         uint
+}
+
+// Accuracy is the proportion of samples that were classified correctly.
+func (r Report) Accuracy() float64 {
+	if len(r.Results) == 0 {
+		return 0
+	}
+	correct := 0
+	for _, result := range r.Results {
+		if result.Correct() {
+			correct++
+		}
+	}
+	return float64(correct) / float64(len(r.Results))
+}
+
+type Runner interface {
+	Run(ctx context.Context, samples []Sample) (Report, error)
+}
+
+func NewRunner(c classifier.Classifier) Runner {
+	return runner{classifier: c}
+}
+
+type runner struct {
+	classifier classifier.Classifier
+}
+
+// Run classifies each sample by its name and size alone, as the processor would classify a torrent without hints or
+// files. A sample that fails to be classified counts as classified without a content type.
+func (r runner) Run(ctx context.Context, samples []Sample) (Report, error) {
+	ctx = classifier.WithBatch(ctx)
+	results := make([]Result, 0, len(samples))
+	for _, s := range samples {
+		if err := ctx.Err(); err != nil {
+			return Report{}, err
+		}
+		result := Result{Sample: s}
+		cl, err := r.classifier.Classify(ctx, s.torrent())
+		switch {
+		case err == nil:
+			result.ContentType = cl.ContentType
+			result.Content = cl.Content
+		case !errors.Is(err, classifier.ErrNoMatch):
+			result.Err = err
+		}
+		results = append(results, result)
+	}
+	return newReport(results), nil
+}
+
+func newReport(results []Result) Report {
+	report := Report{Results: results}
+	stats := make(map[model.ContentType]*ContentTypeStats)
+	getStats := func(ct model.ContentType) *ContentTypeStats {
+		s, ok := stats[ct]
+		if !ok {
+			s = &ContentTypeStats{ContentType: ct}
+			stats[ct] = s
+		}
+		return s
+	}
+	for _, result := range results {
+		getStats(result.Sample.ContentType).Labeled++
+		if result.ContentType.Valid {
+			getStats(result.ContentType.ContentType).Predicted++
+		}
+		if result.contentTypeCorrect() {
+			getStats(result.Sample.ContentType).Correct++
+		}
+		if result.Sample.hasContent() {
+			report.ContentLabeled++
+			if result.contentCorrect() {
+				report.ContentMatched++
+			}
+		}
+		if result.Err != nil {
+			report.Errors++
+		}
+	}
+	for _, ct := range model.ContentTypeValues() {
+		if s, ok := stats[ct]; ok {
+			report.ContentTypes = append(report.ContentTypes, *s)
+		}
+	}
+	return report
+}
@@ -0,0 +1,67 @@
+package bench
+
+import (
+	"context"
+	"errors"
+	"github.com/bitmagnet-io/bitmagnet/internal/classifier"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+type stubClassifier map[string]classifier.Classification
+
+func (c stubClassifier) Classify(_ context.Context, t model.Torrent) (classifier.Classification, error) {
+	if t.Name == "failing" {
+		return classifier.Classification{}, errors.New("lookup failed")
+	}
+	cl, ok := c[t.Name]
+	if !ok {
+		return classifier.Classification{}, classifier.ErrNoMatch
+	}
+	return cl, nil
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	movie := model.NewNullContentType(model.ContentTypeMovie)
+	matrix := &model.Content{
+		Type:   model.ContentTypeMovie,
+		Source: "tmdb",
+		ID:     "603",
+		Attributes: []model.ContentAttribute{
+			{Source: "imdb", Key: "id", Value: "tt0133093"},
+		},
+	}
+	c := stubClassifier{
+		"The.Matrix.1999.1080p":    {ContentType: movie, Content: matrix},
+		"The.Matrix.2.1999.1080p":  {ContentType: movie, Content: matrix},
+		"Some.Show.S01E01.1080p":   {ContentType: movie},
+		"Another.Show.S01E01.720p": {ContentType: model.NewNullContentType(model.ContentTypeTvShow)},
+	}
+	samples := []Sample{
+		{Name: "The.Matrix.1999.1080p", ContentType: model.ContentTypeMovie, ContentSource: model.NewNullString("imdb"), ContentID: model.NewNullString("tt0133093")},
+		{Name: "The.Matrix.2.1999.1080p", ContentType: model.ContentTypeMovie, ContentSource: model.NewNullString("tmdb"), ContentID: model.NewNullString("604")},
+		{Name: "Some.Show.S01E01.1080p", ContentType: model.ContentTypeTvShow},
+		{Name: "Another.Show.S01E01.720p", ContentType: model.ContentTypeTvShow},
+		{Name: "Unknown.Movie", ContentType: model.ContentTypeMovie},
+		{Name: "failing", ContentType: model.ContentTypeMovie},
+	}
+
+	report, err := NewRunner(c).Run(context.Background(), samples)
+	require.NoError(t, err)
+
+	assert.Equal(t, []ContentTypeStats{
+		{ContentType: model.ContentTypeMovie, Labeled: 4, Predicted: 3, Correct: 2},
+		{ContentType: model.ContentTypeTvShow, Labeled: 2, Predicted: 1, Correct: 1},
+	}, report.ContentTypes)
+	assert.InDelta(t, 2.0/3, report.ContentTypes[0].Precision(), 0.001)
+	assert.InDelta(t, 0.5, report.ContentTypes[0].Recall(), 0.001)
+	assert.Equal(t, uint(2), report.ContentLabeled)
+	assert.Equal(t, uint(1), report.ContentMatched)
+	assert.Equal(t, uint(1), report.Errors)
+	// the sequel was classified as the wrong movie, so only the first movie and the second show are correct:
+	assert.InDelta(t, 2.0/6, report.Accuracy(), 0.001)
+}
@@ -3,20 +3,34 @@ package classifier
 import "github.com/bitmagnet-io/bitmagnet/internal/model"
 
 type ContentAttributes struct {
-	Languages       model.Languages
-	LanguageMulti   bool
-	Episodes        model.Episodes
+	Languages     model.Languages
+	LanguageMulti bool
+	Subtitles     model.Languages
+	Subtitled     bool
+	Episodes      model.Episodes
+	// AirDate is the air date of a daily show or sports event that's released by date rather than by episode
+	AirDate         model.Date
+	SportEvent      *model.SportEvent
 	VideoResolution model.NullVideoResolution
 	VideoSource     model.NullVideoSource
 	VideoCodec      model.NullVideoCodec
 	Video3d         model.NullVideo3d
 	VideoModifier   model.NullVideoModifier
+	HdrFormats      model.HdrFormats
+	AudioFormats    model.AudioFormats
 	ReleaseGroup    model.NullString
+	ReleaseTokens   model.ReleaseTokens
 }
 
 type Classification struct {
 	ContentType model.NullContentType
 	Content     *model.Content
+	// MatchConfidence is between 0 and 1 if the torrent was matched to content, and is 1 for a match by content reference.
+	MatchConfidence model.NullFloat32
+	// ProbablyFake is true if the size of the torrent is implausible for its content, such as a 1080p movie of 100MB.
+	ProbablyFake bool
+	// FileContents are the contents matched to the files of a torrent containing several contents, such as a pack of movies.
+	FileContents model.TorrentFileContents
 	ContentAttributes
 }
 
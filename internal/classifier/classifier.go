@@ -4,13 +4,23 @@ import (
 	"context"
 	"errors"
 	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/telemetry/tracing"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 )
 
+var tracer = otel.Tracer("github.com/bitmagnet-io/bitmagnet/internal/classifier")
+
 var (
 	ErrNoMatch = errors.New("no match")
 )
 
+// Version is stamped on torrent contents by the processor. It should be incremented when a change to the classifier
+// would improve the classification of existing torrents, so that they can be found by `reprocess --outdated`.
+const Version uint = 7
+
 type Classifier interface {
 	Classify(ctx context.Context, torrent model.Torrent) (Classification, error)
 }
@@ -22,20 +32,47 @@ type SubClassifier interface {
 }
 
 type classifier struct {
-	subClassifiers []SubClassifier
-	logger         *zap.SugaredLogger
+	subClassifiers  []SubClassifier
+	classifiedTotal *prometheus.CounterVec
+	logger          *zap.SugaredLogger
 }
 
-func (c classifier) Classify(ctx context.Context, t model.Torrent) (Classification, error) {
+func (c classifier) Classify(ctx context.Context, t model.Torrent) (_ Classification, err error) {
+	ctx, span := tracer.Start(ctx, "classifier.classify")
+	span.SetAttributes(attribute.String("info_hash", t.InfoHash.String()))
+	defer func() {
+		tracing.End(span, err, ErrNoMatch)
+	}()
 	for _, sc := range c.subClassifiers {
+		traceClassifier(ctx, sc.Key())
 		tc, err := sc.Classify(ctx, t)
 		if err == nil {
+			span.SetAttributes(
+				attribute.String("classifier", sc.Key()),
+				attribute.String("content_type", tc.ContentType.ContentType.String()),
+				attribute.Bool("matched", tc.Content != nil),
+			)
+			c.observe(tc)
 			return tc, nil
 		}
 		if !errors.Is(err, ErrNoMatch) {
 			c.logger.Errorw("error classifying content", "classifier", sc.Key(), "torrent", t, "error", err)
+			c.classifiedTotal.WithLabelValues("", "error").Inc()
 			return Classification{}, err
 		}
 	}
 	return Classification{}, ErrNoMatch
 }
+
+// observe counts a classification as matched if it was matched to a content item, such as a TMDB movie.
+func (c classifier) observe(tc Classification) {
+	contentType := "unknown"
+	if tc.ContentType.Valid {
+		contentType = tc.ContentType.ContentType.String()
+	}
+	result := "unmatched"
+	if tc.Content != nil {
+		result = "matched"
+	}
+	c.classifiedTotal.WithLabelValues(contentType, result).Inc()
+}
@@ -2,6 +2,8 @@ package classifierfx
 
 import (
 	"github.com/bitmagnet-io/bitmagnet/internal/classifier"
+	"github.com/bitmagnet-io/bitmagnet/internal/classifier/shadow/shadowfx"
+	"github.com/bitmagnet-io/bitmagnet/internal/classifier/sport/sportfx"
 	"github.com/bitmagnet-io/bitmagnet/internal/classifier/video/videofx"
 	"go.uber.org/fx"
 )
@@ -13,5 +15,7 @@ func New() fx.Option {
 			classifier.New,
 		),
 		videofx.New(),
+		sportfx.New(),
+		shadowfx.New(),
 	)
 }
@@ -2,6 +2,7 @@ package classifier
 
 import (
 	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
 	"sort"
@@ -15,13 +16,21 @@ type Params struct {
 
 type Result struct {
 	fx.Out
-	Classifier lazy.Lazy[Classifier]
+	Classifier      lazy.Lazy[Classifier]
+	ClassifiedTotal prometheus.Collector `group:"prometheus_collectors"`
 }
 
 func New(p Params) Result {
+	classifiedTotal := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "bitmagnet",
+		Subsystem: "classifier",
+		Name:      "classified_total",
+		Help:      "A counter of classified torrents, by content type and whether they were matched to a content item.",
+	}, []string{"content_type", "result"})
 	return Result{
+		ClassifiedTotal: classifiedTotal,
 		Classifier: lazy.New(func() (Classifier, error) {
-			subClassifiers := make([]SubClassifier, 0, len(p.SubClassifiers)+1)
+			subClassifiers := make([]SubClassifier, 0, len(p.SubClassifiers))
 			for _, subResolver := range p.SubClassifiers {
 				r, err := subResolver.Get()
 				if err != nil {
@@ -29,11 +38,25 @@ func New(p Params) Result {
 				}
 				subClassifiers = append(subClassifiers, r)
 			}
-			subClassifiers = append(subClassifiers, FallbackClassifier{})
-			sort.Slice(subClassifiers, func(i, j int) bool {
-				return subClassifiers[i].Priority() < subClassifiers[j].Priority()
-			})
-			return classifier{subClassifiers, p.Logger}, nil
+			return newClassifier(subClassifiers, classifiedTotal, p.Logger), nil
 		}),
 	}
 }
+
+// NewUnobserved returns a classifier trying the sub-classifiers in order of priority, followed by the fallback
+// classifier. Unlike the classifier provided by New, its classifications aren't counted in the classifier metrics.
+func NewUnobserved(logger *zap.SugaredLogger, subClassifiers ...SubClassifier) Classifier {
+	return newClassifier(subClassifiers, prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "unobserved_classified_total",
+	}, []string{"content_type", "result"}), logger)
+}
+
+func newClassifier(subClassifiers []SubClassifier, classifiedTotal *prometheus.CounterVec, logger *zap.SugaredLogger) classifier {
+	sorted := make([]SubClassifier, 0, len(subClassifiers)+1)
+	sorted = append(sorted, subClassifiers...)
+	sorted = append(sorted, FallbackClassifier{})
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Priority() < sorted[j].Priority()
+	})
+	return classifier{sorted, classifiedTotal, logger}
+}
@@ -0,0 +1,19 @@
+package classifier
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+)
+
+type lookupFilterContextKey struct{}
+
+// WithLookupFilter returns a context within which classifiers only look up metadata for the content types accepted by the filter.
+func WithLookupFilter(ctx context.Context, filter func(model.ContentType) bool) context.Context {
+	return context.WithValue(ctx, lookupFilterContextKey{}, filter)
+}
+
+// LookupEnabled returns true if metadata should be looked up for the content type; lookups are enabled by default.
+func LookupEnabled(ctx context.Context, ct model.ContentType) bool {
+	filter, ok := ctx.Value(lookupFilterContextKey{}).(func(model.ContentType) bool)
+	return !ok || filter(ct)
+}
@@ -0,0 +1,83 @@
+package shadow
+
+import (
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/classifier"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"sort"
+	"strings"
+)
+
+// summarize returns the fields of a classification that are compared in shadow mode, omitting fields without a value.
+func summarize(cl classifier.Classification) map[string]string {
+	summary := make(map[string]string)
+	set := func(key, value string) {
+		if value != "" {
+			summary[key] = value
+		}
+	}
+	if cl.ContentType.Valid {
+		set("content_type", cl.ContentType.ContentType.String())
+	}
+	if cl.Content != nil {
+		set("content", cl.Content.Source+":"+cl.Content.ID)
+	}
+	if len(cl.Episodes) > 0 {
+		set("episodes", cl.Episodes.String())
+	}
+	if !cl.AirDate.IsNil() {
+		set("air_date", cl.AirDate.IsoDateString())
+	}
+	if cl.SportEvent != nil {
+		set("sport_league", cl.SportEvent.League)
+	}
+	languages := make([]string, 0, len(cl.Languages))
+	for _, lang := range cl.Languages.Slice() {
+		languages = append(languages, lang.String())
+	}
+	set("languages", strings.Join(languages, ","))
+	if cl.VideoResolution.Valid {
+		set("video_resolution", cl.VideoResolution.VideoResolution.String())
+	}
+	if cl.VideoSource.Valid {
+		set("video_source", cl.VideoSource.VideoSource.String())
+	}
+	if cl.VideoCodec.Valid {
+		set("video_codec", cl.VideoCodec.VideoCodec.String())
+	}
+	if cl.ReleaseGroup.Valid {
+		set("release_group", cl.ReleaseGroup.String)
+	}
+	if cl.ProbablyFake {
+		set("probably_fake", "true")
+	}
+	fileContents := make([]string, 0, len(cl.FileContents))
+	for _, fc := range cl.FileContents {
+		fileContents = append(fileContents, fmt.Sprintf("%d=%s:%s", fc.FileIndex, fc.ContentSource, fc.ContentID))
+	}
+	set("file_contents", strings.Join(fileContents, ","))
+	return summary
+}
+
+// divergentFields returns the sorted names of the fields that differ between two summaries.
+func divergentFields(active, shadow map[string]string) []string {
+	var fields []string
+	for key, value := range active {
+		if shadow[key] != value {
+			fields = append(fields, key)
+		}
+	}
+	for key := range shadow {
+		if _, ok := active[key]; !ok {
+			fields = append(fields, key)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// sampled returns true if a torrent is in the sample, which is chosen by info hash so that it's stable.
+func sampled(infoHash protocol.ID, ratio float64) bool {
+	n := uint32(infoHash[0])<<24 | uint32(infoHash[1])<<16 | uint32(infoHash[2])<<8 | uint32(infoHash[3])
+	return float64(n) < ratio*(1<<32)
+}
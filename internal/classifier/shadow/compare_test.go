@@ -0,0 +1,43 @@
+package shadow
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/classifier"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestDivergentFields(t *testing.T) {
+	t.Parallel()
+
+	active := classifier.Classification{
+		ContentType: model.NewNullContentType(model.ContentTypeMovie),
+		Content:     &model.Content{Source: "tmdb", ID: "603"},
+		ContentAttributes: classifier.ContentAttributes{
+			VideoResolution: model.NewNullVideoResolution(model.VideoResolutionV1080p),
+		},
+	}
+	assert.Empty(t, divergentFields(summarize(active), summarize(active)))
+
+	shadow := active
+	shadow.Content = &model.Content{Source: "tmdb", ID: "604"}
+	shadow.ProbablyFake = true
+	shadow.VideoResolution = model.NullVideoResolution{}
+	activeSummary, shadowSummary := summarize(active), summarize(shadow)
+	assert.Equal(t, []string{"content", "probably_fake", "video_resolution"}, divergentFields(activeSummary, shadowSummary))
+	assert.Equal(t, "tmdb:603", activeSummary["content"])
+	assert.Equal(t, "tmdb:604", shadowSummary["content"])
+	assert.NotContains(t, shadowSummary, "video_resolution")
+}
+
+func TestSampled(t *testing.T) {
+	t.Parallel()
+
+	low := protocol.ID{0x10}
+	high := protocol.ID{0xf0}
+	assert.False(t, sampled(low, 0))
+	assert.True(t, sampled(low, 0.1))
+	assert.False(t, sampled(high, 0.5))
+	assert.True(t, sampled(high, 1))
+}
@@ -0,0 +1,23 @@
+package shadow
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/classifier/sport"
+	"github.com/bitmagnet-io/bitmagnet/internal/classifier/video"
+)
+
+type Config struct {
+	// SampleRatio is the fraction of classified torrents that are also classified with the shadow configuration, between
+	// 0 and 1; 0 disables shadow mode. Torrents are sampled by info hash, so the same torrents are sampled when reprocessed.
+	SampleRatio float64 `validate:"gte=0,lte=1"`
+	// VideoClassifier and SportClassifier are the complete configurations of the shadow classifiers, and aren't merged
+	// with the active configurations
+	VideoClassifier video.Config
+	SportClassifier sport.Config
+}
+
+func NewDefaultConfig() Config {
+	return Config{
+		VideoClassifier: video.NewDefaultConfig(),
+		SportClassifier: sport.NewDefaultConfig(),
+	}
+}
@@ -0,0 +1,91 @@
+package shadow
+
+import (
+	"context"
+	"database/sql/driver"
+	"github.com/bitmagnet-io/bitmagnet/internal/classifier"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+	"gorm.io/gorm/clause"
+)
+
+// Evaluation is a torrent with its classification by the active classifier.
+type Evaluation struct {
+	Torrent        model.Torrent
+	Classification classifier.Classification
+}
+
+// Evaluator runs a shadow classifier configuration alongside the active one, so that changes to the classifier
+// configuration can be validated on real data before they're rolled out.
+type Evaluator interface {
+	// Evaluate classifies the sampled torrents with the shadow configuration, and records where the result diverges from
+	// the active classification. The shadow classifications are otherwise discarded, and errors are logged rather than
+	// returned, so that shadow mode doesn't affect processing.
+	Evaluate(ctx context.Context, evaluations ...Evaluation)
+}
+
+type evaluator struct {
+	classifier     classifier.Classifier
+	sampleRatio    float64
+	dao            *dao.Query
+	evaluatedTotal *prometheus.CounterVec
+	logger         *zap.SugaredLogger
+}
+
+func (e evaluator) Evaluate(ctx context.Context, evaluations ...Evaluation) {
+	if e.sampleRatio <= 0 {
+		return
+	}
+	// the shadow classifier has its own batch, so that it doesn't reuse the lookups made with the active configuration
+	ctx = classifier.WithBatch(ctx)
+	var divergences []*model.ClassifierShadowDivergence
+	var convergedHashes []driver.Valuer
+	for _, ev := range evaluations {
+		if !sampled(ev.Torrent.InfoHash, e.sampleRatio) {
+			continue
+		}
+		shadowCl, err := e.classifier.Classify(ctx, ev.Torrent)
+		if err != nil {
+			e.logger.Warnw("shadow classification failed", "info_hash", ev.Torrent.InfoHash, "error", err)
+			e.evaluatedTotal.WithLabelValues("error").Inc()
+			continue
+		}
+		active, shadow := summarize(ev.Classification), summarize(shadowCl)
+		fields := divergentFields(active, shadow)
+		if len(fields) == 0 {
+			e.evaluatedTotal.WithLabelValues("same").Inc()
+			convergedHashes = append(convergedHashes, ev.Torrent.InfoHash)
+			continue
+		}
+		e.evaluatedTotal.WithLabelValues("diverged").Inc()
+		e.logger.Debugw("shadow classification diverged", "info_hash", ev.Torrent.InfoHash, "fields", fields)
+		divergences = append(divergences, &model.ClassifierShadowDivergence{
+			InfoHash: ev.Torrent.InfoHash,
+			Fields:   fields,
+			Active:   active,
+			Shadow:   shadow,
+		})
+	}
+	if err := e.record(ctx, divergences, convergedHashes); err != nil {
+		e.logger.Errorw("failed to record shadow classification divergences", "error", err)
+	}
+}
+
+// record stores the latest divergence of each torrent, and removes earlier divergences of torrents that no longer diverge.
+func (e evaluator) record(ctx context.Context, divergences []*model.ClassifierShadowDivergence, convergedHashes []driver.Valuer) error {
+	if len(convergedHashes) > 0 {
+		if _, err := e.dao.ClassifierShadowDivergence.WithContext(ctx).Where(
+			e.dao.ClassifierShadowDivergence.InfoHash.In(convergedHashes...),
+		).Delete(); err != nil {
+			return err
+		}
+	}
+	if len(divergences) == 0 {
+		return nil
+	}
+	return e.dao.ClassifierShadowDivergence.WithContext(ctx).Clauses(clause.OnConflict{
+		UpdateAll: true,
+	}).CreateInBatches(divergences, 100)
+}
@@ -0,0 +1,78 @@
+package shadow
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/bitmagnet-io/bitmagnet/internal/classifier"
+	"github.com/bitmagnet-io/bitmagnet/internal/classifier/sport"
+	"github.com/bitmagnet-io/bitmagnet/internal/classifier/video"
+	"github.com/bitmagnet-io/bitmagnet/internal/classifier/video/tmdb"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/releasename"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+type Params struct {
+	fx.In
+	Config       Config
+	TmdbClient   lazy.Lazy[tmdb.Client]
+	TokensParser releasename.Parser
+	Dao          lazy.Lazy[*dao.Query]
+	Logger       *zap.SugaredLogger
+}
+
+type Result struct {
+	fx.Out
+	Evaluator      lazy.Lazy[Evaluator]
+	EvaluatedTotal prometheus.Collector `group:"prometheus_collectors"`
+}
+
+func New(p Params) Result {
+	evaluatedTotal := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "bitmagnet",
+		Subsystem: "classifier_shadow",
+		Name:      "evaluated_total",
+		Help:      "A counter of torrents classified with the shadow configuration, by whether the result diverged from the active classification.",
+	}, []string{"result"})
+	logger := p.Logger.Named("classifier_shadow")
+	return Result{
+		EvaluatedTotal: evaluatedTotal,
+		Evaluator: lazy.New(func() (Evaluator, error) {
+			if p.Config.SampleRatio <= 0 {
+				return evaluator{}, nil
+			}
+			// the shadow sub-classifiers are built as the active ones, but from the shadow configuration
+			videoResult, err := video.New(video.Params{
+				Config:       p.Config.VideoClassifier,
+				TmdbClient:   p.TmdbClient,
+				TokensParser: p.TokensParser,
+			})
+			if err != nil {
+				return nil, err
+			}
+			videoClassifier, err := videoResult.Classifier.Get()
+			if err != nil {
+				return nil, err
+			}
+			sportClassifier, err := sport.New(sport.Params{
+				Config:       p.Config.SportClassifier,
+				TokensParser: p.TokensParser,
+			}).Classifier.Get()
+			if err != nil {
+				return nil, err
+			}
+			d, err := p.Dao.Get()
+			if err != nil {
+				return nil, err
+			}
+			return evaluator{
+				classifier:     classifier.NewUnobserved(logger, videoClassifier, sportClassifier),
+				sampleRatio:    p.Config.SampleRatio,
+				dao:            d,
+				evaluatedTotal: evaluatedTotal,
+				logger:         logger,
+			}, nil
+		}),
+	}
+}
@@ -0,0 +1,17 @@
+package shadowfx
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/config/configfx"
+	"github.com/bitmagnet-io/bitmagnet/internal/classifier/shadow"
+	"go.uber.org/fx"
+)
+
+func New() fx.Option {
+	return fx.Module(
+		"classifier_shadow",
+		configfx.NewConfigModule[shadow.Config]("classifier_shadow", shadow.NewDefaultConfig()),
+		fx.Provide(
+			shadow.New,
+		),
+	)
+}
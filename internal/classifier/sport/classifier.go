@@ -0,0 +1,49 @@
+package sport
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/classifier"
+	"github.com/bitmagnet-io/bitmagnet/internal/classifier/video"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/releasename"
+)
+
+// sportClassifier classifies sports releases, such as races, matches and fights, that would otherwise be misfiled as
+// movies; it runs before the video classifier, and isn't matched to content.
+type sportClassifier struct {
+	parser       parser
+	tokensParser releasename.Parser
+}
+
+func (c sportClassifier) Key() string {
+	return "sport"
+}
+
+func (c sportClassifier) Priority() int {
+	return 0
+}
+
+func (c sportClassifier) Classify(_ context.Context, t model.Torrent) (classifier.Classification, error) {
+	if hasVideo := t.HasFileType(model.FileTypeVideo); hasVideo.Valid && !hasVideo.Bool {
+		return classifier.Classification{}, classifier.ErrNoMatch
+	}
+	if !t.Hint.IsNil() && t.Hint.ContentType != model.ContentTypeSport {
+		return classifier.Classification{}, classifier.ErrNoMatch
+	}
+	event, err := c.parser.parse(t.Name)
+	if err != nil && t.Hint.IsNil() {
+		return classifier.Classification{}, err
+	}
+	// the video attributes are parsed as for other videos, with the date of the event as its air date
+	_, _, _, attrs, _ := video.ParseContent(c.tokensParser, model.NullContentType{}, t.Name)
+	attrs.Episodes = nil
+	if err == nil {
+		attrs.SportEvent = &event
+	}
+	cl := classifier.Classification{
+		ContentAttributes: attrs,
+	}
+	cl.ApplyHint(t.Hint)
+	cl.ContentType = model.NewNullContentType(model.ContentTypeSport)
+	return cl, nil
+}
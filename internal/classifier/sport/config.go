@@ -0,0 +1,57 @@
+package sport
+
+type Config struct {
+	// Leagues maps league names to the aliases they're written as at the start of release names, and is merged into
+	// the default leagues; each name is also an alias of itself. Aliases are matched ignoring case, and a space, dot,
+	// underscore or hyphen in an alias matches any of these or none.
+	Leagues map[string][]string
+}
+
+func NewDefaultConfig() Config {
+	return Config{}
+}
+
+var defaultLeagues = map[string][]string{
+	"Formula 1":        {"F1"},
+	"Formula 2":        {"F2"},
+	"Formula E":        {},
+	"MotoGP":           {},
+	"NASCAR":           {"NASCAR Cup Series"},
+	"IndyCar":          {},
+	"WRC":              {},
+	"WEC":              {},
+	"NFL":              {},
+	"NBA":              {},
+	"NHL":              {},
+	"MLB":              {},
+	"MLS":              {},
+	"UFC":              {"UFC Fight Night"},
+	"Bellator":         {},
+	"PFL":              {},
+	"WWE":              {},
+	"AEW":              {},
+	"Premier League":   {"EPL"},
+	"Champions League": {"UEFA Champions League", "UCL"},
+	"La Liga":          {},
+	"Bundesliga":       {},
+	"Six Nations":      {"6 Nations"},
+}
+
+// mergeLeagues merges the configured leagues into the defaults, adding leagues and aliases.
+func mergeLeagues(defaults, configured map[string][]string) map[string][]string {
+	merged := make(map[string][]string, len(defaults)+len(configured))
+	for league, aliases := range defaults {
+		merged[league] = aliases
+	}
+	for league, aliases := range configured {
+		// a configured league written differently to a default league, such as formula 1, adds to its aliases
+		for other := range defaults {
+			if normalizeAlias(other) == normalizeAlias(league) {
+				league = other
+				break
+			}
+		}
+		merged[league] = append(append([]string{}, merged[league]...), aliases...)
+	}
+	return merged
+}
@@ -0,0 +1,35 @@
+package sport
+
+import (
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/bitmagnet-io/bitmagnet/internal/classifier"
+	"github.com/bitmagnet-io/bitmagnet/internal/releasename"
+	"go.uber.org/fx"
+)
+
+type Params struct {
+	fx.In
+	Config       Config
+	TokensParser releasename.Parser
+}
+
+type Result struct {
+	fx.Out
+	Classifier lazy.Lazy[classifier.SubClassifier] `group:"content_classifiers"`
+}
+
+func New(p Params) Result {
+	return Result{
+		Classifier: lazy.New(func() (classifier.SubClassifier, error) {
+			parser, err := newParser(mergeLeagues(defaultLeagues, p.Config.Leagues))
+			if err != nil {
+				return nil, fmt.Errorf("invalid sport classifier leagues: %w", err)
+			}
+			return sportClassifier{
+				parser:       parser,
+				tokensParser: p.TokensParser,
+			}, nil
+		}),
+	}
+}
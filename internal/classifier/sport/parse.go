@@ -0,0 +1,186 @@
+package sport
+
+import (
+	"errors"
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/classifier"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// parser parses the sports event of a release name that starts with a known league.
+type parser struct {
+	leagueRegex *regexp.Regexp
+	// leagues maps lower cased aliases to their leagues
+	leagues map[string]string
+}
+
+var aliasSeparatorRegex = regexp.MustCompile(`[ ._-]+`)
+
+func newParser(leagues map[string][]string) (parser, error) {
+	p := parser{leagues: make(map[string]string)}
+	patterns := make(map[string]struct{})
+	for league, aliases := range leagues {
+		if strings.TrimSpace(league) == "" {
+			return parser{}, errors.New("empty league")
+		}
+		for _, alias := range append([]string{league}, aliases...) {
+			key := normalizeAlias(alias)
+			if key == "" {
+				continue
+			}
+			if other, ok := p.leagues[key]; ok && other != league {
+				return parser{}, fmt.Errorf("alias %s is shared by %s and %s", alias, other, league)
+			}
+			p.leagues[key] = league
+			parts := aliasSeparatorRegex.Split(strings.TrimSpace(alias), -1)
+			for i, part := range parts {
+				parts[i] = regexp.QuoteMeta(part)
+			}
+			patterns[strings.Join(parts, `[ ._-]?`)] = struct{}{}
+		}
+	}
+	if len(patterns) == 0 {
+		return parser{}, errors.New("no leagues")
+	}
+	alternatives := make([]string, 0, len(patterns))
+	for pattern := range patterns {
+		alternatives = append(alternatives, pattern)
+	}
+	// the longest aliases are tried first, so that for example UFC Fight Night is preferred to UFC
+	sort.Slice(alternatives, func(i, j int) bool {
+		if len(alternatives[i]) != len(alternatives[j]) {
+			return len(alternatives[i]) > len(alternatives[j])
+		}
+		return alternatives[i] < alternatives[j]
+	})
+	p.leagueRegex = regexp.MustCompile(`(?i)^(?:\[[^\]]*\][ ._-]*)?(` + strings.Join(alternatives, "|") + `)(?:$|[^\p{L}\p{N}])`)
+	return p, nil
+}
+
+func normalizeAlias(alias string) string {
+	return strings.ToLower(aliasSeparatorRegex.ReplaceAllString(strings.TrimSpace(alias), ""))
+}
+
+var (
+	wordSeparatorRegex = regexp.MustCompile(`[ ._]+`)
+	yearRegex          = regexp.MustCompile(`^(?:19|20)\d{2}$`)
+	dateRegex          = regexp.MustCompile(`^(?:19|20)\d{2}-\d{1,2}-\d{1,2}$`)
+	seasonRegex        = regexp.MustCompile(`^(?:19|20)\d{2}[-/](?:(?:19|20)?\d{2})$`)
+	dayOrMonthRegex    = regexp.MustCompile(`^\d{1,2}$`)
+	eventNumberRegex   = regexp.MustCompile(`^\d{1,4}$`)
+	roundRegex         = regexp.MustCompile(`(?i)^(round|rd|r|week|wk|matchday|md)(\d{1,2})?$`)
+	resolutionRegex    = regexp.MustCompile(`(?i)^\d{3,4}[pi]$`)
+)
+
+// stopWords are the words that start the technical part of a release name following the event.
+var stopWords = map[string]struct{}{
+	"4k": {}, "uhd": {}, "hd": {}, "sd": {}, "hdtv": {}, "pdtv": {}, "web": {}, "webrip": {}, "web-dl": {}, "webdl": {},
+	"bluray": {}, "hdrip": {}, "x264": {}, "x265": {}, "h264": {}, "h265": {}, "hevc": {}, "avc": {}, "xvid": {},
+	"aac": {}, "ac3": {}, "eac3": {}, "ddp": {}, "f1tv": {}, "ppv": {}, "proper": {}, "repack": {}, "internal": {},
+	"multi": {}, "english": {},
+}
+
+var roundLabels = map[string]string{
+	"round":    "Round",
+	"rd":       "Round",
+	"r":        "Round",
+	"week":     "Week",
+	"wk":       "Week",
+	"matchday": "Matchday",
+	"md":       "Matchday",
+}
+
+func isStopWord(word string) bool {
+	lower := strings.ToLower(word)
+	if _, ok := stopWords[lower]; ok {
+		return true
+	}
+	if before, _, ok := strings.Cut(lower, "-"); ok {
+		if _, ok := stopWords[before]; ok {
+			return true
+		}
+		lower = before
+	}
+	return resolutionRegex.MatchString(lower)
+}
+
+// parse returns the sports event of a release name; the event must have a year, date, round, event number or teams
+// as well as a league, so that titles merely starting with a league's name aren't matched.
+func (p parser) parse(input string) (model.SportEvent, error) {
+	loc := p.leagueRegex.FindStringSubmatchIndex(input)
+	if loc == nil {
+		return model.SportEvent{}, classifier.ErrNoMatch
+	}
+	event := model.SportEvent{League: p.leagues[normalizeAlias(input[loc[2]:loc[3]])]}
+	words := wordSeparatorRegex.Split(strings.Trim(input[loc[3]:], " ._-"), -1)
+	identified := false
+	var before, after []string
+	vs := false
+	for i := 0; i < len(words); i++ {
+		word := words[i]
+		if word == "" {
+			continue
+		}
+		if isStopWord(word) {
+			break
+		}
+		if i == len(words)-1 {
+			// the last word may be followed by the release group
+			if name, _, ok := strings.Cut(word, "-"); ok && name != "" {
+				word = name
+			}
+		}
+		lower := strings.ToLower(word)
+		switch {
+		case yearRegex.MatchString(word):
+			// the year may be followed by the month and day of the event's date
+			if i+2 < len(words) && dayOrMonthRegex.MatchString(words[i+1]) && dayOrMonthRegex.MatchString(words[i+2]) {
+				i += 2
+			}
+			identified = true
+		case dateRegex.MatchString(word) || seasonRegex.MatchString(word):
+			identified = true
+		case roundRegex.MatchString(word):
+			match := roundRegex.FindStringSubmatch(word)
+			number := match[2]
+			if number == "" && i+1 < len(words) && dayOrMonthRegex.MatchString(words[i+1]) {
+				number = words[i+1]
+				i++
+			}
+			if number == "" {
+				// a word such as R or MD on its own isn't a round
+				before, after = appendWord(before, after, vs, word)
+				continue
+			}
+			event.Round = roundLabels[strings.ToLower(match[1])] + " " + strings.TrimLeft(number, "0")
+			identified = true
+		case lower == "vs" || lower == "v" || lower == "@":
+			vs = true
+		case eventNumberRegex.MatchString(word) && event.Event == "" && len(before) == 0 && !vs:
+			event.Event = event.League + " " + word
+			identified = true
+		default:
+			before, after = appendWord(before, after, vs, word)
+		}
+	}
+	if vs && len(before) > 0 && len(after) > 0 {
+		event.Teams = []string{strings.Join(before, " "), strings.Join(after, " ")}
+		identified = true
+	} else if len(before) > 0 && event.Event == "" {
+		event.Event = strings.Join(before, " ")
+	}
+	if !identified {
+		return model.SportEvent{}, classifier.ErrNoMatch
+	}
+	return event, nil
+}
+
+func appendWord(before, after []string, vs bool, word string) ([]string, []string) {
+	if vs {
+		return before, append(after, word)
+	}
+	return append(before, word), after
+}
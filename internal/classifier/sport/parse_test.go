@@ -0,0 +1,97 @@
+package sport
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/classifier"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	p, err := newParser(defaultLeagues)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		input    string
+		expected model.SportEvent
+	}{
+		{"Formula1.2024.Round08.Monaco.Grand.Prix.Race.1080p.F1TV.WEB-DL.AAC2.0.H.264-GRP", model.SportEvent{
+			League: "Formula 1", Event: "Monaco Grand Prix Race", Round: "Round 8",
+		}},
+		{"F1.2024.R08.Monaco.Qualifying.1080p", model.SportEvent{
+			League: "Formula 1", Event: "Monaco Qualifying", Round: "Round 8",
+		}},
+		{"NFL.2023.09.10.Lions.vs.Chiefs.720p.WEB.h264-GRP", model.SportEvent{
+			League: "NFL", Teams: []string{"Lions", "Chiefs"},
+		}},
+		{"NFL 2023 Week 1 Detroit Lions @ Kansas City Chiefs 1080p", model.SportEvent{
+			League: "NFL", Round: "Week 1", Teams: []string{"Detroit Lions", "Kansas City Chiefs"},
+		}},
+		{"UFC.300.Pereira.vs.Hill.PPV.1080p.WEB.h264-GRP", model.SportEvent{
+			League: "UFC", Event: "UFC 300", Teams: []string{"Pereira", "Hill"},
+		}},
+		{"UFC.Fight.Night.240.Nicolau.vs.Perez-GRP", model.SportEvent{
+			League: "UFC", Event: "UFC 240", Teams: []string{"Nicolau", "Perez"},
+		}},
+		{"[GRP] Premier League 2023-24 Matchday 10 Arsenal v Sheffield United", model.SportEvent{
+			League: "Premier League", Round: "Matchday 10", Teams: []string{"Arsenal", "Sheffield United"},
+		}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.input, func(t *testing.T) {
+			t.Parallel()
+			event, err := p.parse(test.input)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, event)
+		})
+	}
+}
+
+func TestParseNoMatch(t *testing.T) {
+	t.Parallel()
+
+	p, err := newParser(defaultLeagues)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, input := range []string{
+		"The.Matrix.1999.1080p.BluRay.x264-GRP",
+		"NFL.Films.Presents.1080p",
+		"Bundesliga",
+		"NBA2K24.PC-GRP",
+	} {
+		input := input
+		t.Run(input, func(t *testing.T) {
+			t.Parallel()
+			_, err := p.parse(input)
+			assert.ErrorIs(t, err, classifier.ErrNoMatch)
+		})
+	}
+}
+
+func TestConfiguredLeagues(t *testing.T) {
+	t.Parallel()
+
+	p, err := newParser(mergeLeagues(defaultLeagues, map[string][]string{
+		"formula 1":   {"Formula.One"},
+		"Super Rugby": {},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	event, err := p.parse("Formula.One.2024.Round.8.Monaco.Grand.Prix")
+	assert.NoError(t, err)
+	assert.Equal(t, model.SportEvent{League: "Formula 1", Event: "Monaco Grand Prix", Round: "Round 8"}, event)
+
+	event, err = p.parse("Super.Rugby.2024.Round.1.Blues.v.Chiefs")
+	assert.NoError(t, err)
+	assert.Equal(t, model.SportEvent{League: "Super Rugby", Round: "Round 1", Teams: []string{"Blues", "Chiefs"}}, event)
+}
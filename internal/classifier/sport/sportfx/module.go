@@ -0,0 +1,17 @@
+package sportfx
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/config/configfx"
+	"github.com/bitmagnet-io/bitmagnet/internal/classifier/sport"
+	"go.uber.org/fx"
+)
+
+func New() fx.Option {
+	return fx.Module(
+		"sport",
+		configfx.NewConfigModule[sport.Config]("sport_classifier", sport.NewDefaultConfig()),
+		fx.Provide(
+			sport.New,
+		),
+	)
+}
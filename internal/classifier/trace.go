@@ -0,0 +1,83 @@
+package classifier
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"sync"
+)
+
+const (
+	// maxTraceLookups limits the lookups recorded for a torrent, as a pack may look up content for each of its files
+	maxTraceLookups = 20
+	// maxTraceCandidates limits the candidates recorded for a lookup, so that traces stay compact
+	maxTraceCandidates = 10
+)
+
+type traceContextKey struct{}
+
+// Trace records the decisions made while classifying a torrent: the classifiers tried, the tokens parsed from its name,
+// and the lookups of its content with the candidates found, so that a wrong classification can be explained without
+// classifying it again with debug logging.
+type Trace struct {
+	mutex       sync.Mutex
+	classifiers []string
+	tokens      []string
+	lookups     []model.ClassificationTraceLookup
+}
+
+// WithTrace returns a context within which the decisions of classifiers are recorded to the returned trace.
+func WithTrace(ctx context.Context) (context.Context, *Trace) {
+	t := &Trace{}
+	return context.WithValue(ctx, traceContextKey{}, t), t
+}
+
+func traceFromContext(ctx context.Context) (*Trace, bool) {
+	t, ok := ctx.Value(traceContextKey{}).(*Trace)
+	return t, ok
+}
+
+// TraceTokens records tokens parsed from a release name, formatted as kind:value. Outside a trace it does nothing.
+func TraceTokens(ctx context.Context, tokens ...string) {
+	if t, ok := traceFromContext(ctx); ok {
+		t.mutex.Lock()
+		defer t.mutex.Unlock()
+		t.tokens = append(t.tokens, tokens...)
+	}
+}
+
+// TraceLookup records a lookup of content. Outside a trace it does nothing.
+func TraceLookup(ctx context.Context, lookup model.ClassificationTraceLookup) {
+	if t, ok := traceFromContext(ctx); ok {
+		t.mutex.Lock()
+		defer t.mutex.Unlock()
+		if len(t.lookups) >= maxTraceLookups {
+			return
+		}
+		if len(lookup.Candidates) > maxTraceCandidates {
+			lookup.Candidates = lookup.Candidates[:maxTraceCandidates]
+		}
+		t.lookups = append(t.lookups, lookup)
+	}
+}
+
+func traceClassifier(ctx context.Context, key string) {
+	if t, ok := traceFromContext(ctx); ok {
+		t.mutex.Lock()
+		defer t.mutex.Unlock()
+		t.classifiers = append(t.classifiers, key)
+	}
+}
+
+// ClassificationTrace returns the trace to be stored for a torrent classified by the current classifier version.
+func (t *Trace) ClassificationTrace(infoHash protocol.ID) model.ClassificationTrace {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return model.ClassificationTrace{
+		InfoHash:          infoHash,
+		ClassifierVersion: Version,
+		Classifiers:       append([]string{}, t.classifiers...),
+		Tokens:            append([]string{}, t.tokens...),
+		Lookups:           append([]model.ClassificationTraceLookup{}, t.lookups...),
+	}
+}
@@ -0,0 +1,47 @@
+package classifier
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestTrace(t *testing.T) {
+	t.Parallel()
+
+	// outside a trace nothing is recorded
+	TraceTokens(context.Background(), "year:1999")
+
+	ctx, trace := WithTrace(context.Background())
+	traceClassifier(ctx, "video")
+	TraceTokens(ctx, "title:The Matrix", "year:1999")
+	candidates := make([]model.ClassificationTraceCandidate, maxTraceCandidates+5)
+	for i := 0; i < maxTraceLookups+5; i++ {
+		TraceLookup(ctx, model.ClassificationTraceLookup{Source: "tmdb", Query: "The Matrix", Candidates: candidates})
+	}
+
+	infoHash := protocol.ID{1}
+	ct := trace.ClassificationTrace(infoHash)
+	assert.Equal(t, infoHash, ct.InfoHash)
+	assert.Equal(t, Version, ct.ClassifierVersion)
+	assert.Equal(t, []string{"video"}, ct.Classifiers)
+	assert.Equal(t, []string{"title:The Matrix", "year:1999"}, ct.Tokens)
+	assert.Len(t, ct.Lookups, maxTraceLookups)
+	assert.Len(t, ct.Lookups[0].Candidates, maxTraceCandidates)
+}
+
+func TestTraceBatchLookup(t *testing.T) {
+	t.Parallel()
+
+	ctx, trace := WithTrace(WithBatch(context.Background()))
+	for i := 0; i < 2; i++ {
+		_, err := BatchLookup(ctx, "movie:the matrix:1999", func() (int, error) {
+			return 1, nil
+		})
+		assert.NoError(t, err)
+	}
+	lookups := trace.ClassificationTrace(protocol.ID{}).Lookups
+	assert.Equal(t, []model.ClassificationTraceLookup{{Source: "batch", Query: "movie:the matrix:1999"}}, lookups)
+}
@@ -0,0 +1,76 @@
+package video
+
+import (
+	"context"
+	"errors"
+	"github.com/bitmagnet-io/bitmagnet/internal/classifier"
+	"github.com/bitmagnet-io/bitmagnet/internal/classifier/video/tmdb"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/releasename"
+	"sort"
+)
+
+// Candidate is content that a torrent may refer to, with a score between 0 and 1 computed as for the match confidence.
+type Candidate struct {
+	Content model.Content
+	Score   float32
+}
+
+// CandidateFinder suggests content for a torrent, e.g. to choose from when fixing a missing or wrong match.
+type CandidateFinder interface {
+	// Candidates returns up to limit candidates, best first; the content type is taken from the torrent's hint or name
+	// unless one is given, and no candidates are returned for content types other than video.
+	Candidates(ctx context.Context, t model.Torrent, contentType model.NullContentType, limit uint) ([]Candidate, error)
+}
+
+type candidateFinder struct {
+	tmdbClient   tmdb.Client
+	tokensParser releasename.Parser
+}
+
+func (f candidateFinder) Candidates(
+	ctx context.Context,
+	t model.Torrent,
+	contentType model.NullContentType,
+	limit uint,
+) ([]Candidate, error) {
+	if !contentType.Valid {
+		contentType = t.Hint.NullContentType()
+	}
+	if contentType.Valid && !contentType.ContentType.IsVideo() {
+		return nil, nil
+	}
+	ct, title, year, _, err := ParseContent(f.tokensParser, contentType, t.Name)
+	if err != nil {
+		if errors.Is(err, classifier.ErrNoMatch) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if t.Hint.Title.Valid {
+		title = t.Hint.Title.String
+	}
+	contents, err := f.tmdbClient.SearchCandidates(ctx, tmdb.SearchCandidatesParams{
+		ContentType:  ct,
+		Title:        title,
+		IncludeAdult: true,
+		Limit:        limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([]Candidate, 0, len(contents))
+	for _, content := range contents {
+		candidates = append(candidates, Candidate{
+			Content: content,
+			Score:   matchConfidence(title, year, content),
+		})
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Score > candidates[j].Score
+	})
+	if uint(len(candidates)) > limit {
+		candidates = candidates[:limit]
+	}
+	return candidates, nil
+}
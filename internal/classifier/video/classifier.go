@@ -6,10 +6,21 @@ import (
 	"github.com/bitmagnet-io/bitmagnet/internal/classifier"
 	"github.com/bitmagnet-io/bitmagnet/internal/classifier/video/tmdb"
 	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/releasename"
+	"github.com/bitmagnet-io/bitmagnet/internal/romanize"
+	"math"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
 )
 
 type videoClassifier struct {
-	tmdbClient tmdb.Client
+	tmdbClient         tmdb.Client
+	tokensParser       releasename.Parser
+	romanizeTitles     *atomic.Bool
+	movieYearTolerance *atomic.Uint32
+	plausibility       *atomic.Pointer[plausibility]
 }
 
 func (c videoClassifier) Key() string {
@@ -27,7 +38,7 @@ func (c videoClassifier) Classify(ctx context.Context, t model.Torrent) (classif
 	if !t.Hint.IsNil() && !t.Hint.ContentType.IsVideo() {
 		return classifier.Classification{}, classifier.ErrNoMatch
 	}
-	ct, title, year, attrs, err := ParseContent(t.Hint.NullContentType(), t.Name)
+	ct, title, year, attrs, err := ParseContent(c.tokensParser, t.Hint.NullContentType(), t.Name)
 	if err != nil {
 		return classifier.Classification{}, err
 	}
@@ -35,15 +46,60 @@ func (c videoClassifier) Classify(ctx context.Context, t model.Torrent) (classif
 	if t.Hint.Title.Valid {
 		title = t.Hint.Title.String
 	}
+	classifier.TraceTokens(ctx, parsedTokens(ct, title, year, attrs)...)
+	if ref.Valid {
+		classifier.TraceTokens(ctx, "hint:"+ref.Val.String())
+	}
 	cl := classifier.Classification{
 		ContentAttributes: attrs,
 	}
-	if content, err := c.resolveContent(ctx, ct, ref, title, year); err == nil {
+	files := getVideoFiles(t)
+	runtime := files.estimateRuntime(attrs.VideoResolution)
+	// a torrent containing several movies, such as a pack, is also matched to each movie by the names of its files
+	var packFiles []movieFile
+	if ct == model.ContentTypeMovie && !ref.Valid {
+		packFiles = movieFiles(c.tokensParser, t)
+	}
+	lookupCt := ct
+	// a torrent parsed as a movie that contains several similarly sized video files is probably a miniseries
+	// without episode markers in its name, so a matching TV show is preferred
+	episodic := ct == model.ContentTypeMovie && !ref.Valid && len(attrs.Episodes) == 0 && len(packFiles) == 0 &&
+		files.looksEpisodic() && classifier.LookupEnabled(ctx, model.ContentTypeTvShow)
+	if episodic {
+		lookupCt = model.ContentTypeTvShow
+	}
+	var content model.Content
+	err = classifier.ErrNoMatch
+	// an override without a content reference is a manually cleared match, which shouldn't be looked up
+	lookup := classifier.LookupEnabled(ctx, ct) && (ref.Valid || !t.Hint.Override)
+	if lookup {
+		content, err = classifier.BatchLookup(ctx, lookupKey(lookupCt, ref, title, year, runtime), func() (model.Content, error) {
+			content, err := c.resolveContent(ctx, lookupCt, ref, title, year, runtime)
+			if episodic && errors.Is(err, classifier.ErrNoMatch) {
+				content, err = c.resolveContent(ctx, ct, ref, title, year, runtime)
+			}
+			// the romanized title is only used for the lookup; the original is preserved in the hint and torrent name
+			if errors.Is(err, classifier.ErrNoMatch) && c.romanizeTitles.Load() && !ref.Valid && romanize.HasNonLatinLetters(title) {
+				content, err = c.resolveContent(ctx, ct, ref, romanize.Romanize(title), year, runtime)
+			}
+			return content, err
+		})
+	}
+	if err == nil {
 		cl.Content = &content
+		if ref.Valid {
+			cl.MatchConfidence = model.NewNullFloat32(1)
+		} else {
+			cl.MatchConfidence = model.NewNullFloat32(matchConfidence(title, year, content))
+		}
 	} else if !errors.Is(err, classifier.ErrNoMatch) {
 		return classifier.Classification{}, err
 	}
 	cl.ApplyHint(t.Hint)
+	if !lookup && !cl.ContentType.Valid {
+		// without a lookup to confirm it, the parsed content type is the best available
+		cl.ContentType = model.NewNullContentType(ct)
+	}
 	if cl.Content != nil {
 		cl.ContentType = model.NewNullContentType(cl.Content.Type)
 		if cl.Content.OriginalLanguage.Valid {
@@ -58,6 +114,27 @@ func (c videoClassifier) Classify(ctx context.Context, t model.Torrent) (classif
 	if !cl.ContentType.Valid {
 		return classifier.Classification{}, classifier.ErrNoMatch
 	}
+	if lookup && len(packFiles) > 0 && cl.ContentType.ContentType == model.ContentTypeMovie {
+		fileContents, err := c.classifyFiles(ctx, packFiles)
+		if err != nil {
+			return classifier.Classification{}, err
+		}
+		cl.FileContents = fileContents
+	}
+	// a daily show released by air date is resolved to its episodes, so that it can be found by season and episode
+	if cl.Content != nil && cl.Content.Type == model.ContentTypeTvShow && cl.Content.Source == tmdb.SourceTmdb &&
+		len(cl.Episodes) == 0 && !cl.AirDate.IsNil() {
+		episodes, err := c.tmdbClient.GetEpisodeByAirDate(ctx, cl.Content.ID, cl.AirDate)
+		if err == nil {
+			cl.Episodes = episodes
+		} else if !errors.Is(err, classifier.ErrNoMatch) {
+			return classifier.Classification{}, err
+		}
+	}
+	// a manual classification is trusted regardless of its size
+	if !t.Hint.Override {
+		c.plausibility.Load().apply(&cl, t, files)
+	}
 	return cl, nil
 }
 
@@ -67,6 +144,7 @@ func (c videoClassifier) resolveContent(
 	ref model.Maybe[model.ContentRef],
 	title string,
 	year model.Year,
+	runtime time.Duration,
 ) (model.Content, error) {
 	if ct == model.ContentTypeMovie || ct == model.ContentTypeXxx {
 		if ref.Valid {
@@ -76,7 +154,9 @@ func (c videoClassifier) resolveContent(
 			Title:                title,
 			Year:                 year,
 			IncludeAdult:         true,
-			LevenshteinThreshold: 5,
+			LevenshteinThreshold: levenshteinThreshold,
+			YearTolerance:        uint(c.movieYearTolerance.Load()),
+			Runtime:              runtime,
 		})
 	}
 	if ct == model.ContentTypeTvShow {
@@ -87,8 +167,32 @@ func (c videoClassifier) resolveContent(
 			Name:                 title,
 			FirstAirDateYear:     year,
 			IncludeAdult:         true,
-			LevenshteinThreshold: 5,
+			LevenshteinThreshold: levenshteinThreshold,
 		})
 	}
 	return model.Content{}, classifier.ErrNoMatch
 }
+
+// runtimeBucketRatio is the ratio between the bounds of the buckets of estimated runtimes in lookup keys
+const runtimeBucketRatio = 1.25
+
+// lookupKey identifies torrents that probably refer to the same content, so that lookups can be shared within a batch;
+// as a runtime estimate chooses between content of the same title and year, torrents share a lookup only if their
+// estimated runtimes are similar.
+func lookupKey(
+	ct model.ContentType,
+	ref model.Maybe[model.ContentRef],
+	title string,
+	year model.Year,
+	runtime time.Duration,
+) string {
+	if ref.Valid {
+		return strings.Join([]string{"video", ct.String(), ref.Val.Source, ref.Val.ID}, ":")
+	}
+	parts := []string{"video", ct.String(), strings.Join(strings.Fields(strings.ToLower(title)), " "), year.String()}
+	if runtime > 0 {
+		bucket := int(math.Floor(math.Log(runtime.Minutes()) / math.Log(runtimeBucketRatio)))
+		parts = append(parts, "runtime", strconv.Itoa(bucket))
+	}
+	return strings.Join(parts, ":")
+}
@@ -0,0 +1,54 @@
+package video
+
+import (
+	"github.com/agnivade/levenshtein"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/regex"
+	"github.com/bitmagnet-io/bitmagnet/internal/romanize"
+)
+
+// levenshteinThreshold is the maximum edit distance between a parsed title and a matching content title
+const levenshteinThreshold = 5
+
+const (
+	titleWeight = 0.5
+	yearWeight  = 0.3
+	localWeight = 0.2
+)
+
+// matchConfidence scores how likely a match found by title is to be correct, between 0 and 1. The closeness of the parsed title
+// to the content title (or original title, also in romanized form) counts for half; whether the parsed year agrees with the release year counts for 0.3,
+// with half of that if either year is unknown; and the remainder is given to content already in the database, which has been matched
+// by other torrents, over content just found on TMDB.
+func matchConfidence(title string, year model.Year, content model.Content) float32 {
+	titles := []string{title}
+	if romanize.HasNonLatinLetters(title) {
+		titles = append(titles, romanize.Romanize(title))
+	}
+	candidates := []string{content.Title}
+	if content.OriginalTitle.Valid {
+		candidates = append(candidates, content.OriginalTitle.String)
+		if romanize.HasNonLatinLetters(content.OriginalTitle.String) {
+			candidates = append(candidates, romanize.Romanize(content.OriginalTitle.String))
+		}
+	}
+	distance := levenshteinThreshold + 1
+	for _, t := range titles {
+		normTitle := regex.NormalizeString(t)
+		for _, c := range candidates {
+			distance = min(distance, levenshtein.ComputeDistance(normTitle, regex.NormalizeString(c)))
+		}
+	}
+	score := titleWeight * float32(levenshteinThreshold+1-distance) / (levenshteinThreshold + 1)
+	switch {
+	case year.IsNil() || content.ReleaseYear.IsNil():
+		score += yearWeight / 2
+	case year == content.ReleaseYear:
+		score += yearWeight
+	}
+	// content loaded from the database has a creation time, while content just fetched from TMDB doesn't
+	if !content.CreatedAt.IsZero() {
+		score += localWeight
+	}
+	return score
+}
@@ -0,0 +1,50 @@
+package video
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestMatchConfidence(t *testing.T) {
+	t.Parallel()
+
+	remote := model.Content{
+		Title:         "The Shawshank Redemption",
+		OriginalTitle: model.NewNullString("The Shawshank Redemption"),
+		ReleaseYear:   1994,
+	}
+	local := remote
+	local.CreatedAt = time.Now()
+
+	for _, tc := range []struct {
+		name     string
+		title    string
+		year     model.Year
+		content  model.Content
+		expected float32
+	}{
+		{"exact local match", "The Shawshank Redemption", 1994, local, 1},
+		{"exact remote match", "The Shawshank Redemption", 1994, remote, 0.8},
+		{"unknown year", "The Shawshank Redemption", 0, remote, 0.65},
+		{"other year", "The Shawshank Redemption", 2004, remote, 0.5},
+		{"distant title", "The Shawshank Redemptn", 1994, remote, 0.3 + 0.5*4.0/6},
+		{"original title", "Le Fabuleux Destin", 2001, model.Content{
+			Title:         "Amelie",
+			OriginalTitle: model.NewNullString("Le Fabuleux Destin"),
+			ReleaseYear:   2001,
+		}, 0.8},
+		{"transliterated original title", "Brat 2", 2000, model.Content{
+			Title:         "Brother 2",
+			OriginalTitle: model.NewNullString("Брат 2"),
+			ReleaseYear:   2000,
+		}, 0.8},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.InDelta(t, tc.expected, matchConfidence(tc.title, tc.year, tc.content), 0.001)
+		})
+	}
+}
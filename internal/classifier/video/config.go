@@ -0,0 +1,49 @@
+package video
+
+type Config struct {
+	// RomanizeTitles when true, titles in non-Latin scripts (e.g. Cyrillic, Chinese, Japanese) that fail to match
+	// will be retried in romanized form, since TMDB often indexes foreign releases under a romanized title.
+	RomanizeTitles bool
+	// MovieYearTolerance is the number of years that the release year of a movie may differ from the year of a release
+	// name and still be matched, as release dates differ between countries and re-releases keep the original title.
+	MovieYearTolerance uint
+	// Plausibility flags torrents whose size is implausible for their content as probably fake.
+	Plausibility PlausibilityConfig
+}
+
+type PlausibilityConfig struct {
+	Enabled bool
+	// MinMovieSizes maps video resolutions, such as 1080p, to the minimum plausible size in bytes of the video files of a movie.
+	MinMovieSizes map[string]uint64 `mapstructure:"min_movie_sizes"`
+	// MinEpisodeSizes maps video resolutions to the minimum plausible size in bytes of each video file of a TV show.
+	MinEpisodeSizes map[string]uint64 `mapstructure:"min_episode_sizes"`
+	// ConfidenceFactor multiplies the match confidence of torrents that are probably fake.
+	ConfidenceFactor float32 `mapstructure:"confidence_factor"`
+}
+
+const mb = 1_000_000
+
+func NewDefaultConfig() Config {
+	return Config{
+		RomanizeTitles:     false,
+		MovieYearTolerance: 1,
+		Plausibility: PlausibilityConfig{
+			Enabled: true,
+			MinMovieSizes: map[string]uint64{
+				"720p":  100 * mb,
+				"1080p": 200 * mb,
+				"1440p": 300 * mb,
+				"2160p": 500 * mb,
+				"4320p": 1000 * mb,
+			},
+			MinEpisodeSizes: map[string]uint64{
+				"720p":  20 * mb,
+				"1080p": 40 * mb,
+				"1440p": 60 * mb,
+				"2160p": 100 * mb,
+				"4320p": 200 * mb,
+			},
+			ConfidenceFactor: 0.5,
+		},
+	}
+}
@@ -0,0 +1,165 @@
+package video
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// episodesRegex matches the seasons and episodes of a release name, such as S01E05, S01E01-E05, S01E01E02, S01-S03,
+// Season 1-3, S01.S02 or S01E05-S02E03.
+var episodesRegex = func() *regexp.Regexp {
+	sep := `[ ._]`
+	join := sep + `?[-,&+]` + sep + `?`
+	season := `(?:season` + sep + `?|s)\d{1,2}`
+	episode := `(?:episode|ep|e)` + sep + `?\d{1,3}`
+	item := season + `(?:` + sep + `?` + episode + `(?:(?:` + join + `|` + sep + `?)` + episode + `|` + join + `\d{1,3})*)?`
+	spec := item + `(?:(?:` + join + `|` + sep + `?)` + item + `|` + join + `\d{1,2})*`
+	return regexp.MustCompile(`(?i)(?:^|[^\p{L}\p{N}])(` + spec + `)(?:$|[^\p{L}\p{N}])`)
+}()
+
+var episodesTokenRegex = regexp.MustCompile(`(?i)(season|s)[ ._]?(\d+)|(episode|ep|e)[ ._]?(\d+)|([-,&+])|(\d+)`)
+
+// findEpisodes returns the episodes of the first seasons and episodes in the input after its start, with their location.
+func findEpisodes(input string) (model.Episodes, []int) {
+	for offset := 0; offset < len(input); {
+		loc := episodesRegex.FindStringSubmatchIndex(input[offset:])
+		if loc == nil {
+			break
+		}
+		start, end := offset+loc[2], offset+loc[3]
+		if start > 0 {
+			if episodes := parseEpisodes(input[start:end]); len(episodes) > 0 {
+				return episodes, []int{start, end}
+			}
+		}
+		offset = end
+	}
+	return nil, nil
+}
+
+type episodesItem struct {
+	season   int
+	episodes []int
+	// whole is set for the earlier seasons of a range across seasons, of which the last episodes aren't known
+	whole bool
+}
+
+// parseEpisodes parses a match of episodesRegex. A bare number continues the seasons or episodes it follows, as a range
+// after a hyphen and otherwise as a list; a range across seasons includes the whole of the seasons before the last, as
+// their numbers of episodes aren't known.
+func parseEpisodes(spec string) model.Episodes {
+	var items []episodesItem
+	const (
+		kindSeason = iota + 1
+		kindEpisode
+	)
+	lastKind := 0
+	isRange := false
+	crossSeason := false
+	withinSeason := false
+	addSeason := func(n int) {
+		if isRange && len(items) > 0 {
+			last := &items[len(items)-1]
+			if lastKind == kindEpisode && n >= last.season {
+				if n == last.season {
+					// a range within a season written with the season, such as S01E01-S01E05, continues to the next episode
+					withinSeason = true
+					return
+				}
+				last.whole = true
+				crossSeason = true
+			}
+			for s := last.season + 1; s < n; s++ {
+				items = append(items, episodesItem{season: s})
+			}
+		}
+		items = append(items, episodesItem{season: n})
+	}
+	addEpisode := func(n int) {
+		last := &items[len(items)-1]
+		switch {
+		case crossSeason:
+			for e := 1; e <= n; e++ {
+				last.episodes = append(last.episodes, e)
+			}
+			crossSeason = false
+		case (isRange || withinSeason) && len(last.episodes) > 0:
+			for e := last.episodes[len(last.episodes)-1] + 1; e <= n; e++ {
+				last.episodes = append(last.episodes, e)
+			}
+			withinSeason = false
+		default:
+			last.episodes = append(last.episodes, n)
+		}
+	}
+	for _, match := range episodesTokenRegex.FindAllStringSubmatch(spec, -1) {
+		switch {
+		case match[1] != "":
+			n, _ := strconv.Atoi(match[2])
+			addSeason(n)
+			if withinSeason {
+				lastKind = kindEpisode
+			} else {
+				lastKind = kindSeason
+			}
+		case match[3] != "":
+			if len(items) == 0 {
+				continue
+			}
+			n, _ := strconv.Atoi(match[4])
+			addEpisode(n)
+			lastKind = kindEpisode
+		case match[5] != "":
+			isRange = strings.TrimSpace(match[5]) == "-"
+			continue
+		case lastKind == kindSeason:
+			n, _ := strconv.Atoi(match[6])
+			addSeason(n)
+		case lastKind == kindEpisode:
+			n, _ := strconv.Atoi(match[6])
+			addEpisode(n)
+		}
+		isRange = false
+	}
+	episodes := make(model.Episodes)
+	for _, item := range items {
+		if item.whole || len(item.episodes) == 0 {
+			episodes = episodes.AddSeason(item.season)
+			continue
+		}
+		if eps, ok := episodes[item.season]; ok && len(eps) == 0 {
+			continue
+		}
+		for _, e := range item.episodes {
+			episodes = episodes.AddEpisode(item.season, e)
+		}
+	}
+	return episodes
+}
+
+// airDateRegex matches the air date of a daily show such as a talk show or news, which are released by air date rather
+// than by episode, such as 2024.05.17 or 2024-05-17.
+var airDateRegex = regexp.MustCompile(`(?:^|[^\p{L}\p{N}])((?:19|20)\d{2}[ ._-]\d{2}[ ._-]\d{2})(?:$|[^\p{L}\p{N}])`)
+
+var airDateSeparatorRegex = regexp.MustCompile(`[ ._-]`)
+
+// findAirDate returns the first valid air date in the input after its start, with its location.
+func findAirDate(input string) (model.Date, []int) {
+	for offset := 0; offset < len(input); {
+		loc := airDateRegex.FindStringSubmatchIndex(input[offset:])
+		if loc == nil {
+			break
+		}
+		start, end := offset+loc[2], offset+loc[3]
+		if start > 0 {
+			iso := airDateSeparatorRegex.ReplaceAllString(input[start:end], "-")
+			if date, err := model.NewDateFromIsoString(iso); err == nil {
+				return date, []int{start, end}
+			}
+		}
+		offset = end
+	}
+	return model.Date{}, nil
+}
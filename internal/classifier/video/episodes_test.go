@@ -0,0 +1,79 @@
+package video
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestFindEpisodes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input    string
+		expected model.Episodes
+		rest     string
+	}{
+		{"Show.S01E05.1080p", make(model.Episodes).AddEpisode(1, 5), ".1080p"},
+		{"Show.s01e05.720p", make(model.Episodes).AddEpisode(1, 5), ".720p"},
+		{"Show.S01E01E02.1080p", make(model.Episodes).AddEpisode(1, 1).AddEpisode(1, 2), ".1080p"},
+		{"Show.S01E01.E02.1080p", make(model.Episodes).AddEpisode(1, 1).AddEpisode(1, 2), ".1080p"},
+		{"Show.S01E01-E03.1080p", make(model.Episodes).AddEpisode(1, 1).AddEpisode(1, 2).AddEpisode(1, 3), ".1080p"},
+		{"Show.S01E01-S01E03.1080p", make(model.Episodes).AddEpisode(1, 1).AddEpisode(1, 2).AddEpisode(1, 3), ".1080p"},
+		{"Show.S01.E02-E03.1080p", make(model.Episodes).AddEpisode(1, 2).AddEpisode(1, 3), ".1080p"},
+		{"Show.S01E01,03.1080p", make(model.Episodes).AddEpisode(1, 1).AddEpisode(1, 3), ".1080p"},
+		{"Show.S02.1080p", make(model.Episodes).AddSeason(2), ".1080p"},
+		{"Show.S01-S03.1080p", make(model.Episodes).AddSeason(1).AddSeason(2).AddSeason(3), ".1080p"},
+		{"Show.S03-5.1080p", make(model.Episodes).AddSeason(3).AddSeason(4).AddSeason(5), ".1080p"},
+		{"Show.Season.1-2.1080p", make(model.Episodes).AddSeason(1).AddSeason(2), ".1080p"},
+		{"Show.S01.S02.1080p", make(model.Episodes).AddSeason(1).AddSeason(2), ".1080p"},
+		// the number of episodes of the first season isn't known, so it's included whole
+		{"Show.S01E05-S03E02.1080p", make(model.Episodes).AddSeason(1).AddSeason(2).AddEpisode(3, 1).AddEpisode(3, 2), ".1080p"},
+		{"Show.1080p", nil, ""},
+		// there must be a title before the episodes
+		{"S01E01.1080p", nil, ""},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.input, func(t *testing.T) {
+			t.Parallel()
+			episodes, loc := findEpisodes(test.input)
+			assert.Equal(t, test.expected, episodes)
+			if loc != nil {
+				assert.Equal(t, test.rest, test.input[loc[1]:])
+			}
+		})
+	}
+}
+
+func TestFindAirDate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input    string
+		expected model.Date
+		rest     string
+	}{
+		{"Show.2024.05.17.Guest.1080p", model.NewDateFromParts(2024, time.May, 17), ".Guest.1080p"},
+		{"Show 2024-05-17 720p", model.NewDateFromParts(2024, time.May, 17), " 720p"},
+		// an invalid date isn't an air date
+		{"Show.2024.13.01.1080p", model.Date{}, ""},
+		{"Movie.2024.1080p", model.Date{}, ""},
+		// there must be a title before the air date
+		{"2024.05.17.1080p", model.Date{}, ""},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.input, func(t *testing.T) {
+			t.Parallel()
+			date, loc := findAirDate(test.input)
+			assert.Equal(t, test.expected, date)
+			if loc != nil {
+				assert.Equal(t, test.rest, test.input[loc[1]:])
+			}
+		})
+	}
+}
@@ -1,23 +1,40 @@
 package video
 
 import (
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/config"
 	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
 	"github.com/bitmagnet-io/bitmagnet/internal/classifier"
 	"github.com/bitmagnet-io/bitmagnet/internal/classifier/video/tmdb"
+	"github.com/bitmagnet-io/bitmagnet/internal/releasename"
 	"go.uber.org/fx"
+	"sync/atomic"
 )
 
 type Params struct {
 	fx.In
-	TmdbClient lazy.Lazy[tmdb.Client]
+	Config       Config
+	TmdbClient   lazy.Lazy[tmdb.Client]
+	TokensParser releasename.Parser
 }
 
 type Result struct {
 	fx.Out
-	Classifier lazy.Lazy[classifier.SubClassifier] `group:"content_classifiers"`
+	Classifier      lazy.Lazy[classifier.SubClassifier] `group:"content_classifiers"`
+	CandidateFinder lazy.Lazy[CandidateFinder]
+	OnConfigChange  config.OnConfigChange `group:"config_change_hooks"`
 }
 
-func New(p Params) Result {
+func New(p Params) (Result, error) {
+	romanizeTitles := &atomic.Bool{}
+	romanizeTitles.Store(p.Config.RomanizeTitles)
+	movieYearTolerance := &atomic.Uint32{}
+	movieYearTolerance.Store(uint32(p.Config.MovieYearTolerance))
+	rules, err := newPlausibility(p.Config.Plausibility)
+	if err != nil {
+		return Result{}, err
+	}
+	plausibilityRules := &atomic.Pointer[plausibility]{}
+	plausibilityRules.Store(rules)
 	return Result{
 		Classifier: lazy.New(func() (classifier.SubClassifier, error) {
 			tmdbClient, err := p.TmdbClient.Get()
@@ -25,8 +42,32 @@ func New(p Params) Result {
 				return nil, err
 			}
 			return videoClassifier{
-				tmdbClient: tmdbClient,
+				tmdbClient:         tmdbClient,
+				tokensParser:       p.TokensParser,
+				romanizeTitles:     romanizeTitles,
+				movieYearTolerance: movieYearTolerance,
+				plausibility:       plausibilityRules,
 			}, nil
 		}),
-	}
+		CandidateFinder: lazy.New(func() (CandidateFinder, error) {
+			tmdbClient, err := p.TmdbClient.Get()
+			if err != nil {
+				return nil, err
+			}
+			return candidateFinder{
+				tmdbClient:   tmdbClient,
+				tokensParser: p.TokensParser,
+			}, nil
+		}),
+		OnConfigChange: config.NewOnConfigChange("video_classifier", func(cfg Config) error {
+			rules, err := newPlausibility(cfg.Plausibility)
+			if err != nil {
+				return err
+			}
+			romanizeTitles.Store(cfg.RomanizeTitles)
+			movieYearTolerance.Store(uint32(cfg.MovieYearTolerance))
+			plausibilityRules.Store(rules)
+			return nil
+		}),
+	}, nil
 }
@@ -0,0 +1,104 @@
+package video
+
+import (
+	"context"
+	"errors"
+	"github.com/bitmagnet-io/bitmagnet/internal/classifier"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/releasename"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// maxFileContents limits the files of a torrent that are looked up, as each may need a search of the metadata provider.
+const maxFileContents = 50
+
+// extraFileRegex matches the paths of samples and extras, which aren't matched to content of their own.
+var extraFileRegex = regexp.MustCompile(`(?i)(?:^|[^\p{L}\p{N}])(?:sample|trailers?|extras?|featurettes?|bonus|behind[ ._-]the[ ._-]scenes)(?:$|[^\p{L}\p{N}])`)
+
+type movieFile struct {
+	file  model.TorrentFile
+	title string
+	year  model.Year
+	attrs classifier.ContentAttributes
+}
+
+// movieFiles returns the main video files of a torrent that are named as distinct movies, such as the movies of a pack,
+// or nil unless there are at least two of them. Samples, extras and other files much smaller than the largest video
+// file are ignored, as are further parts of a movie split across several files.
+func movieFiles(tokensParser releasename.Parser, t model.Torrent) []movieFile {
+	if t.FilesStatus != model.FilesStatusMulti {
+		return nil
+	}
+	largest := uint64(0)
+	for _, f := range t.Files {
+		if ft := f.FileType(); ft.Valid && ft.FileType == model.FileTypeVideo && f.Size > largest {
+			largest = f.Size
+		}
+	}
+	var files []movieFile
+	keys := make(map[string]struct{})
+	for _, f := range t.Files {
+		if ft := f.FileType(); !ft.Valid || ft.FileType != model.FileTypeVideo || f.Size < largest/4 ||
+			extraFileRegex.MatchString(f.Path) {
+			continue
+		}
+		name := path.Base(f.Path)
+		name = strings.TrimSuffix(name, path.Ext(name))
+		ct, title, year, attrs, err := ParseContent(tokensParser, model.NullContentType{}, name)
+		if err != nil || ct != model.ContentTypeMovie || year.IsNil() {
+			continue
+		}
+		key := lookupKey(model.ContentTypeMovie, model.Maybe[model.ContentRef]{}, title, year, 0)
+		if _, ok := keys[key]; ok {
+			continue
+		}
+		keys[key] = struct{}{}
+		files = append(files, movieFile{file: f, title: title, year: year, attrs: attrs})
+		if len(files) == maxFileContents {
+			break
+		}
+	}
+	if len(files) < 2 {
+		return nil
+	}
+	return files
+}
+
+// classifyFiles matches the movie files of a torrent to their movies, each movie being included once.
+func (c videoClassifier) classifyFiles(ctx context.Context, files []movieFile) (model.TorrentFileContents, error) {
+	var fileContents model.TorrentFileContents
+	refs := make(map[model.ContentRef]struct{})
+	for _, f := range files {
+		runtime := videoFiles{sizes: []uint64{f.file.Size}}.estimateRuntime(f.attrs.VideoResolution)
+		ref := model.Maybe[model.ContentRef]{}
+		content, err := classifier.BatchLookup(ctx, lookupKey(model.ContentTypeMovie, ref, f.title, f.year, runtime), func() (model.Content, error) {
+			return c.resolveContent(ctx, model.ContentTypeMovie, ref, f.title, f.year, runtime)
+		})
+		if errors.Is(err, classifier.ErrNoMatch) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := refs[content.Ref()]; ok {
+			continue
+		}
+		refs[content.Ref()] = struct{}{}
+		fileContents = append(fileContents, model.TorrentFileContent{
+			FileIndex:       f.file.Index,
+			Path:            f.file.Path,
+			ContentType:     content.Type,
+			ContentSource:   content.Source,
+			ContentID:       content.ID,
+			MatchConfidence: float64(matchConfidence(f.title, f.year, content)),
+			Content:         &content,
+		})
+	}
+	sort.Slice(fileContents, func(i, j int) bool {
+		return fileContents[i].FileIndex < fileContents[j].FileIndex
+	})
+	return fileContents, nil
+}
@@ -0,0 +1,50 @@
+package video
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/releasename"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestMovieFiles(t *testing.T) {
+	t.Parallel()
+
+	const gb = 1_000_000_000
+
+	tokensParser := releasename.NewDefaultParser()
+
+	torrent := func(files ...model.TorrentFile) model.Torrent {
+		for i := range files {
+			files[i].Index = uint32(i)
+		}
+		return model.Torrent{FilesStatus: model.FilesStatusMulti, Files: files}
+	}
+
+	titles := func(files []movieFile) []string {
+		var result []string
+		for _, f := range files {
+			result = append(result, f.title+" "+f.year.String())
+		}
+		return result
+	}
+
+	assert.Equal(t, []string{"Iron Man 2008", "The Incredible Hulk 2008", "Iron Man 2 2010"}, titles(movieFiles(tokensParser, torrent(
+		model.TorrentFile{Path: "Iron.Man.2008.1080p.BluRay.x264/Iron.Man.2008.1080p.BluRay.x264.mkv", Size: 8 * gb},
+		model.TorrentFile{Path: "Iron.Man.2008.1080p.BluRay.x264/Sample/iron.man.2008.sample.mkv", Size: 3 * gb},
+		model.TorrentFile{Path: "The.Incredible.Hulk.2008.1080p.BluRay.x264/The.Incredible.Hulk.2008.1080p.BluRay.x264.mkv", Size: 7 * gb},
+		model.TorrentFile{Path: "Iron.Man.2.2010.1080p.BluRay.x264.mkv", Size: 8 * gb},
+		model.TorrentFile{Path: "Iron.Man.2.2010.1080p.BluRay.x264.nfo", Size: 1000},
+	))))
+
+	assert.Nil(t, movieFiles(tokensParser, torrent(
+		model.TorrentFile{Path: "Movie.2001.CD1.avi", Size: gb},
+		model.TorrentFile{Path: "Movie.2001.CD2.avi", Size: gb},
+		model.TorrentFile{Path: "Movie.2001.Trailer.avi", Size: gb},
+	)), "a movie split across several files isn't a pack")
+
+	assert.Nil(t, movieFiles(tokensParser, torrent(
+		model.TorrentFile{Path: "Show.S01E01.2020.mkv", Size: gb},
+		model.TorrentFile{Path: "Show.S01E02.2020.mkv", Size: gb},
+	)), "episodes aren't movies")
+}
@@ -4,10 +4,10 @@ import (
 	"github.com/bitmagnet-io/bitmagnet/internal/classifier"
 	"github.com/bitmagnet-io/bitmagnet/internal/model"
 	"github.com/bitmagnet-io/bitmagnet/internal/regex"
+	"github.com/bitmagnet-io/bitmagnet/internal/releasename"
 	"github.com/hedhyw/rex/pkg/dialect"
 	"github.com/hedhyw/rex/pkg/rex"
 	"strconv"
-	"strings"
 )
 
 var titleTokens = []dialect.Token{
@@ -52,63 +52,7 @@ var titleYearRegex = rex.New(
 	rex.Group.NonCaptured(rex.Group.NonCaptured(titleTokens...), rex.Group.NonCaptured(yearTokens...)),
 ).MustCompile()
 
-func rangeToken(runes string) dialect.Token {
-	return rex.Group.Define(
-		rex.Group.Define(rex.Chars.Digits().Repeat().Between(1, 2)),
-		rex.Group.Composite(
-			rex.Group.Define(
-				rex.Chars.Whitespace().Repeat().ZeroOrOne(),
-				rex.Chars.Single('-'),
-				rex.Chars.Whitespace().Repeat().ZeroOrOne(),
-				rex.Group.NonCaptured(
-					rex.Chars.Runes(runes).Repeat().ZeroOrOne(),
-					rex.Chars.Whitespace().Repeat().ZeroOrOne(),
-				).Repeat().ZeroOrOne(),
-				rex.Group.Define(rex.Chars.Digits().Repeat().Between(1, 2)),
-			).NonCaptured(),
-			rex.Group.Define(
-				rex.Chars.Whitespace().Repeat().ZeroOrOne(),
-				rex.Chars.Single(','),
-				rex.Chars.Whitespace().Repeat().ZeroOrOne(),
-				rex.Group.NonCaptured(
-					rex.Chars.Runes(runes).Repeat().ZeroOrOne(),
-					rex.Chars.Whitespace().Repeat().ZeroOrOne(),
-				).Repeat().ZeroOrOne(),
-				rex.Group.Define(rex.Chars.Digits().Repeat().Between(1, 2)),
-				rex.Chars.Whitespace().Repeat().ZeroOrOne(),
-			).NonCaptured().Repeat().OneOrMore(),
-		).NonCaptured().Repeat().ZeroOrOne(),
-	)
-}
-
-var seasonToken = rex.Group.Define(
-	rex.Group.Composite(
-		regex.RegexTokensFromNames("season", "s")...,
-	).NonCaptured(),
-	rex.Chars.Whitespace().Repeat().ZeroOrOne(),
-	rangeToken("sS"),
-	rex.Chars.Whitespace().Repeat().ZeroOrOne(),
-).NonCaptured()
-
-var episodeToken = rex.Group.Define(
-	rex.Group.Composite(
-		regex.RegexTokensFromNames("episode", "ep", "e")...,
-	).NonCaptured(),
-	rex.Chars.Whitespace().Repeat().ZeroOrOne(),
-	rangeToken("eE"),
-).NonCaptured()
-
-var episodesTokens = rex.Group.Define(
-	seasonToken,
-	episodeToken.Repeat().ZeroOrOne(),
-).NonCaptured()
-
-var titleEpisodesRegex = rex.New(
-	rex.Chars.Begin(),
-	rex.Group.NonCaptured(rex.Group.NonCaptured(titleTokens...), episodesTokens),
-).MustCompile()
-
-var multiRegex = regex.NewRegexFromNames("multi")
+var multiRegex = regex.NewRegexFromNames("multi", "dual")
 
 var separatorToken = rex.Chars.Runes(" ._")
 
@@ -171,60 +115,42 @@ func parseTitle(input string) (string, string, error) {
 }
 
 func parseTitleYearEpisodes(input string) (string, model.Year, model.Episodes, string, error) {
-	if match := titleEpisodesRegex.FindStringSubmatch(input); match != nil {
-		title := match[1]
-		year := model.Year(0)
-		if t, y, _, err := parseTitleYear(title); err == nil {
-			title = t
-			year = y
-		} else {
-			title = cleanTitle(title)
-		}
-		episodes := model.Episodes{}
-		seasonStart, _ := strconv.ParseInt(match[3], 10, 16)
-		if match[6] == "" {
-			// no episodes
-			if match[4] != "" {
-				// a season range
-				seasonEnd, _ := strconv.ParseInt(match[4], 10, 16)
-				for i := seasonStart; i <= seasonEnd; i++ {
-					episodes = episodes.AddSeason(int(i))
-				}
-			} else if match[5] != "" {
-				// a list of seasons
-				includedSeasons := strings.Split(match[2], ",")
-				for _, season := range includedSeasons {
-					seasonIndex, _ := strconv.ParseInt(season, 10, 16)
-					episodes = episodes.AddSeason(int(seasonIndex))
-				}
-			} else {
-				// or just a single season
-				episodes = episodes.AddSeason(int(seasonStart))
-			}
-		} else {
-			// episodes
-			episodeStart, _ := strconv.ParseInt(match[7], 10, 16)
-			if match[8] != "" {
-				// an episode range
-				episodeEnd, _ := strconv.ParseInt(match[8], 10, 16)
-				for i := episodeStart; i <= episodeEnd; i++ {
-					episodes = episodes.AddEpisode(int(seasonStart), int(i))
-				}
-			} else if match[9] != "" {
-				// a list of episodes
-				includedEpisodes := strings.Split(match[6], ",")
-				for _, episode := range includedEpisodes {
-					episodeIndex, _ := strconv.ParseInt(episode, 10, 16)
-					episodes = episodes.AddEpisode(int(seasonStart), int(episodeIndex))
-				}
-			} else {
-				// a single episode
-				episodes = episodes.AddEpisode(int(seasonStart), int(episodeStart))
-			}
-		}
-		return title, year, episodes, input[len(match[0]):], nil
+	episodes, loc := findEpisodes(input)
+	if loc == nil {
+		return "", 0, nil, "", classifier.ErrNoMatch
 	}
-	return "", 0, nil, "", classifier.ErrNoMatch
+	title := input[:loc[0]]
+	year := model.Year(0)
+	if t, y, _, err := parseTitleYear(title); err == nil {
+		title = t
+		year = y
+	} else {
+		title = cleanTitle(title)
+	}
+	if title == "" {
+		return "", 0, nil, "", classifier.ErrNoMatch
+	}
+	return title, year, episodes, input[loc[1]:], nil
+}
+
+// parseTitleYearAirDate parses the title of a daily show released by air date, with its first air year if present.
+func parseTitleYearAirDate(input string) (string, model.Year, model.Date, string, error) {
+	airDate, loc := findAirDate(input)
+	if loc == nil {
+		return "", 0, model.Date{}, "", classifier.ErrNoMatch
+	}
+	title := input[:loc[0]]
+	year := model.Year(0)
+	if t, y, _, err := parseTitleYear(title); err == nil {
+		title = t
+		year = y
+	} else {
+		title = cleanTitle(title)
+	}
+	if title == "" {
+		return "", 0, model.Date{}, "", classifier.ErrNoMatch
+	}
+	return title, year, airDate, input[loc[1]:], nil
 }
 
 func ParseTitleYearEpisodes(contentType model.NullContentType, input string) (string, model.Year, model.Episodes, string, error) {
@@ -242,32 +168,80 @@ func ParseTitleYearEpisodes(contentType model.NullContentType, input string) (st
 	return "", 0, nil, "", classifier.ErrNoMatch
 }
 
-func ParseContent(hintCt model.NullContentType, input string) (model.ContentType, string, model.Year, classifier.ContentAttributes, error) {
+// ParseContent parses the content type, title, year and attributes of a release name; the tokens parser recognises the
+// tokens following the title that aren't otherwise attributes.
+func ParseContent(
+	tokensParser releasename.Parser,
+	hintCt model.NullContentType,
+	input string,
+) (model.ContentType, string, model.Year, classifier.ContentAttributes, error) {
 	title, year, episodes, rest, err := ParseTitleYearEpisodes(hintCt, input)
 	if err != nil {
 		return "", "", 0, classifier.ContentAttributes{}, err
 	}
+	var airDate model.Date
+	if len(episodes) == 0 && (!hintCt.Valid || hintCt.ContentType == model.ContentTypeTvShow) {
+		if t, y, d, r, dateErr := parseTitleYearAirDate(input); dateErr == nil {
+			title, year, airDate, rest = t, y, d, r
+		}
+	}
 	var ct model.ContentType
 	if hintCt.Valid {
 		ct = hintCt.ContentType
-	} else if len(episodes) > 0 {
+	} else if len(episodes) > 0 || !airDate.IsNil() {
 		ct = model.ContentTypeTvShow
 	} else {
 		ct = model.ContentTypeMovie
 	}
 	if ct != model.ContentTypeTvShow {
 		episodes = nil
+		airDate = model.Date{}
 	}
 	vc, rg := model.InferVideoCodecAndReleaseGroup(rest)
-	return ct, title, year, classifier.ContentAttributes{
+	// subtitle languages are excluded from the audio languages
+	subtitles, subtitled, audioRest := model.InferSubtitles(rest)
+	attrs := classifier.ContentAttributes{
 		Episodes:        episodes,
-		Languages:       model.InferLanguages(rest),
-		LanguageMulti:   multiRegex.MatchString(rest),
+		AirDate:         airDate,
+		Languages:       model.InferLanguages(audioRest),
+		LanguageMulti:   multiRegex.MatchString(audioRest),
+		Subtitles:       subtitles,
+		Subtitled:       subtitled,
 		VideoResolution: model.InferVideoResolution(rest),
 		VideoSource:     model.InferVideoSource(rest),
 		VideoCodec:      vc,
 		Video3d:         model.InferVideo3d(rest),
 		VideoModifier:   model.InferVideoModifier(rest),
 		ReleaseGroup:    rg,
-	}, nil
+	}
+	applyReleaseTokens(&attrs, tokensParser.Parse(rest))
+	return ct, title, year, attrs, nil
+}
+
+// applyReleaseTokens sets the attributes of the tokens that are attribute values, keeping the others as release tokens:
+// HDR and audio format tokens add to the formats, and the first video codec token is the video codec if it couldn't be
+// otherwise inferred.
+func applyReleaseTokens(attrs *classifier.ContentAttributes, tokens model.ReleaseTokens) {
+	for _, token := range tokens {
+		switch token.Kind {
+		case releasename.KindVideoCodec:
+			if codec, err := model.ParseVideoCodec(token.Value); err == nil {
+				if !attrs.VideoCodec.Valid {
+					attrs.VideoCodec = model.NewNullVideoCodec(codec)
+				}
+				continue
+			}
+		case releasename.KindHdr:
+			if format, err := model.ParseHdrFormat(token.Value); err == nil {
+				attrs.HdrFormats = attrs.HdrFormats.Add(format)
+				continue
+			}
+		case releasename.KindAudio:
+			if format, err := model.ParseAudioFormat(token.Value); err == nil {
+				attrs.AudioFormats = attrs.AudioFormats.Add(format)
+				continue
+			}
+		}
+		attrs.ReleaseTokens = append(attrs.ReleaseTokens, token)
+	}
 }
@@ -3,8 +3,10 @@ package video
 import (
 	"github.com/bitmagnet-io/bitmagnet/internal/classifier"
 	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/releasename"
 	"github.com/stretchr/testify/assert"
 	"testing"
+	"time"
 )
 
 func TestParse(t *testing.T) {
@@ -55,6 +57,26 @@ func TestParse(t *testing.T) {
 				},
 			},
 		},
+		{
+			inputString: "Mission.Impossible.2023.MULTi.VOSTFR.1080p.BluRay.x264-SPARKS",
+			expectedOutput: output{
+				contentType: model.ContentTypeMovie,
+				title:       "Mission Impossible",
+				releaseYear: 2023,
+				attrs: classifier.ContentAttributes{
+					LanguageMulti:   true,
+					Subtitles:       model.Languages{"fr": {}},
+					Subtitled:       true,
+					VideoResolution: model.NewNullVideoResolution(model.VideoResolutionV1080p),
+					VideoSource:     model.NewNullVideoSource(model.VideoSourceBluRay),
+					VideoCodec:      model.NewNullVideoCodec(model.VideoCodecX264),
+					ReleaseGroup: model.NullString{
+						String: "SPARKS",
+						Valid:  true,
+					},
+				},
+			},
+		},
 		{
 			inputString: "Die.Hard.(With.A.Vengeance!).And.A.Suffix.2023.1080p.BluRay.x264-SPARKS",
 			expectedOutput: output{
@@ -116,6 +138,10 @@ func TestParse(t *testing.T) {
 					VideoResolution: model.NewNullVideoResolution(model.VideoResolutionV720p),
 					VideoSource:     model.NewNullVideoSource(model.VideoSourceWEBDL),
 					VideoCodec:      model.NewNullVideoCodec(model.VideoCodecH264),
+					AudioFormats:    model.AudioFormats{model.AudioFormatAC3},
+					ReleaseTokens: model.ReleaseTokens{
+						{Kind: releasename.KindBitDepth, Value: "8bit"},
+					},
 				},
 			},
 		},
@@ -232,11 +258,102 @@ func TestParse(t *testing.T) {
 				},
 			},
 		},
+		{
+			inputString: "Mad.Max.Fury.Road.2015.2160p.MAX.WEB-DL.DDP5.1.DV.HDR10+.AV1.10bit-GRP",
+			expectedOutput: output{
+				contentType: model.ContentTypeMovie,
+				title:       "Mad Max Fury Road",
+				releaseYear: 2015,
+				attrs: classifier.ContentAttributes{
+					VideoResolution: model.NewNullVideoResolution(model.VideoResolutionV2160p),
+					VideoSource:     model.NewNullVideoSource(model.VideoSourceWEBDL),
+					VideoCodec:      model.NewNullVideoCodec(model.VideoCodecAV1),
+					HdrFormats:      model.HdrFormats{model.HdrFormatDV, model.HdrFormatHDR10Plus},
+					AudioFormats:    model.AudioFormats{model.AudioFormatEAC3},
+					ReleaseTokens: model.ReleaseTokens{
+						{Kind: releasename.KindBitDepth, Value: "10bit"},
+						{Kind: releasename.KindStreamingService, Value: "MAX"},
+					},
+				},
+			},
+		},
+		{
+			inputString: "The.Daily.Show.2024.05.17.Guest.Name.1080p.WEB.h264-GRP",
+			expectedOutput: output{
+				contentType: model.ContentTypeTvShow,
+				title:       "The Daily Show",
+				attrs: classifier.ContentAttributes{
+					AirDate:         model.NewDateFromParts(2024, time.May, 17),
+					VideoResolution: model.NewNullVideoResolution(model.VideoResolutionV1080p),
+					VideoSource:     model.NewNullVideoSource(model.VideoSourceWEBRip),
+					VideoCodec:      model.NewNullVideoCodec(model.VideoCodecH264),
+					ReleaseGroup: model.NullString{
+						String: "GRP",
+						Valid:  true,
+					},
+				},
+			},
+		},
+		{
+			inputString: "The.Series.S02E05.1080p.ATVP.WEBRip.HEVC-GRP",
+			expectedOutput: output{
+				contentType: model.ContentTypeTvShow,
+				title:       "The Series",
+				attrs: classifier.ContentAttributes{
+					Episodes:        make(model.Episodes).AddEpisode(2, 5),
+					VideoResolution: model.NewNullVideoResolution(model.VideoResolutionV1080p),
+					VideoSource:     model.NewNullVideoSource(model.VideoSourceWEBRip),
+					VideoCodec:      model.NewNullVideoCodec(model.VideoCodecX265),
+					ReleaseGroup: model.NullString{
+						String: "GRP",
+						Valid:  true,
+					},
+					ReleaseTokens: model.ReleaseTokens{
+						{Kind: releasename.KindStreamingService, Value: "ATVP"},
+					},
+				},
+			},
+		},
+		{
+			inputString: "Some.Movie.2023.2160p.UHD.BluRay.REMUX.DV.HDR10.HEVC.TrueHD.7.1.Atmos-GRP",
+			expectedOutput: output{
+				contentType: model.ContentTypeMovie,
+				title:       "Some Movie",
+				releaseYear: 2023,
+				attrs: classifier.ContentAttributes{
+					VideoResolution: model.NewNullVideoResolution(model.VideoResolutionV2160p),
+					VideoSource:     model.NewNullVideoSource(model.VideoSourceBluRay),
+					VideoCodec:      model.NewNullVideoCodec(model.VideoCodecX265),
+					VideoModifier:   model.NewNullVideoModifier(model.VideoModifierREMUX),
+					HdrFormats:      model.HdrFormats{model.HdrFormatDV, model.HdrFormatHDR10},
+					AudioFormats:    model.AudioFormats{model.AudioFormatAtmos, model.AudioFormatTrueHD},
+				},
+			},
+		},
+		{
+			inputString: "Some.Movie.2023.1080p.BluRay.DTS-HD.MA.5.1.x264-GRP",
+			expectedOutput: output{
+				contentType: model.ContentTypeMovie,
+				title:       "Some Movie",
+				releaseYear: 2023,
+				attrs: classifier.ContentAttributes{
+					VideoResolution: model.NewNullVideoResolution(model.VideoResolutionV1080p),
+					VideoSource:     model.NewNullVideoSource(model.VideoSourceBluRay),
+					VideoCodec:      model.NewNullVideoCodec(model.VideoCodecX264),
+					AudioFormats:    model.AudioFormats{model.AudioFormatDTSHD},
+					ReleaseGroup: model.NullString{
+						String: "GRP",
+						Valid:  true,
+					},
+				},
+			},
+		},
 	}
 
 	for _, test := range parseTests {
 		t.Run(test.inputString, func(t *testing.T) {
 			ct, title, year, attrs, err := ParseContent(
+				releasename.NewDefaultParser(),
 				test.contentType,
 				test.inputString,
 			)
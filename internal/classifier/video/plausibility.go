@@ -0,0 +1,78 @@
+package video
+
+import (
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/classifier"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+)
+
+// plausibility checks the size of the video files of a classified torrent against the minimum plausible size of its
+// content type and video resolution, as spam is often named as a high resolution release of popular content.
+type plausibility struct {
+	minMovieSizes    map[model.VideoResolution]uint64
+	minEpisodeSizes  map[model.VideoResolution]uint64
+	confidenceFactor float32
+}
+
+func newPlausibility(config PlausibilityConfig) (*plausibility, error) {
+	if !config.Enabled {
+		return nil, nil
+	}
+	if config.ConfidenceFactor < 0 || config.ConfidenceFactor > 1 {
+		return nil, fmt.Errorf("invalid plausibility confidence factor %v, expected between 0 and 1", config.ConfidenceFactor)
+	}
+	p := &plausibility{
+		minMovieSizes:    make(map[model.VideoResolution]uint64, len(config.MinMovieSizes)),
+		minEpisodeSizes:  make(map[model.VideoResolution]uint64, len(config.MinEpisodeSizes)),
+		confidenceFactor: config.ConfidenceFactor,
+	}
+	for _, sizes := range []struct {
+		config map[string]uint64
+		result map[model.VideoResolution]uint64
+	}{
+		{config.MinMovieSizes, p.minMovieSizes},
+		{config.MinEpisodeSizes, p.minEpisodeSizes},
+	} {
+		for label, size := range sizes.config {
+			resolution, err := model.ParseVideoResolution("V" + label)
+			if err != nil {
+				return nil, fmt.Errorf("invalid plausibility video resolution %s", label)
+			}
+			sizes.result[resolution] = size
+		}
+	}
+	return p, nil
+}
+
+// apply flags the classification as probably fake if its video files are smaller than the minimum for its content
+// type and resolution, and reduces its match confidence. The size of each file of a TV show is its average size,
+// and the size of the torrent is used if its files aren't known.
+func (p *plausibility) apply(cl *classifier.Classification, t model.Torrent, files videoFiles) {
+	if p == nil || !cl.ContentType.Valid || !cl.VideoResolution.Valid {
+		return
+	}
+	size, count := files.totalSize(), uint64(len(files.sizes))
+	if count == 0 {
+		if t.HasFilesInfo() {
+			return
+		}
+		size, count = t.Size, 1
+	}
+	var minSize uint64
+	switch cl.ContentType.ContentType {
+	case model.ContentTypeMovie:
+		minSize = p.minMovieSizes[cl.VideoResolution.VideoResolution]
+	case model.ContentTypeTvShow:
+		minSize = p.minEpisodeSizes[cl.VideoResolution.VideoResolution]
+		size /= count
+	default:
+		return
+	}
+	if size >= minSize {
+		return
+	}
+	cl.ProbablyFake = true
+	if cl.MatchConfidence.Valid {
+		cl.MatchConfidence.Float32 *= p.confidenceFactor
+	}
+}
@@ -0,0 +1,61 @@
+package video
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/classifier"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestPlausibility(t *testing.T) {
+	t.Parallel()
+
+	p, err := newPlausibility(NewDefaultConfig().Plausibility)
+	require.NoError(t, err)
+
+	torrent := func(sizes ...uint64) model.Torrent {
+		torrent := model.Torrent{FilesStatus: model.FilesStatusMulti}
+		for i, size := range sizes {
+			torrent.Size += size
+			torrent.Files = append(torrent.Files, model.TorrentFile{Index: uint32(i), Path: "file.mkv", Size: size})
+		}
+		return torrent
+	}
+
+	for _, tc := range []struct {
+		name        string
+		contentType model.ContentType
+		resolution  model.VideoResolution
+		torrent     model.Torrent
+		expected    bool
+	}{
+		{"1080p movie", model.ContentTypeMovie, model.VideoResolutionV1080p, torrent(2_000*mb, 20*mb), false},
+		{"tiny 1080p movie", model.ContentTypeMovie, model.VideoResolutionV1080p, torrent(150 * mb), true},
+		{"small 720p movie", model.ContentTypeMovie, model.VideoResolutionV720p, torrent(150 * mb), false},
+		{"small 480p movie", model.ContentTypeMovie, model.VideoResolutionV480p, torrent(10 * mb), false},
+		{"2160p season", model.ContentTypeTvShow, model.VideoResolutionV2160p, torrent(900*mb, 1_000*mb, 1_100*mb), false},
+		{"tiny 2160p episodes", model.ContentTypeTvShow, model.VideoResolutionV2160p, torrent(50*mb, 60*mb, 70*mb), true},
+		{"unknown files", model.ContentTypeMovie, model.VideoResolutionV2160p, model.Torrent{Size: 100 * mb}, true},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			cl := classifier.Classification{
+				ContentType:     model.NewNullContentType(tc.contentType),
+				MatchConfidence: model.NewNullFloat32(0.8),
+			}
+			cl.VideoResolution = model.NewNullVideoResolution(tc.resolution)
+			p.apply(&cl, tc.torrent, getVideoFiles(tc.torrent))
+			assert.Equal(t, tc.expected, cl.ProbablyFake)
+			if tc.expected {
+				assert.Equal(t, float32(0.4), cl.MatchConfidence.Float32)
+			} else {
+				assert.Equal(t, float32(0.8), cl.MatchConfidence.Float32)
+			}
+		})
+	}
+
+	_, err = newPlausibility(PlausibilityConfig{Enabled: true, MinMovieSizes: map[string]uint64{"1080i": mb}})
+	assert.Error(t, err)
+}
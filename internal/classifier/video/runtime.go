@@ -0,0 +1,87 @@
+package video
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"sort"
+	"time"
+)
+
+// typicalBitrates are rough overall bitrates in megabits per second of releases at each resolution;
+// actual bitrates vary widely by codec and source, so estimated runtimes are only useful to choose between candidates.
+var typicalBitrates = map[model.VideoResolution]float64{
+	model.VideoResolutionV360p:  1,
+	model.VideoResolutionV480p:  1.5,
+	model.VideoResolutionV540p:  2,
+	model.VideoResolutionV576p:  2,
+	model.VideoResolutionV720p:  4,
+	model.VideoResolutionV1080p: 8,
+	model.VideoResolutionV1440p: 12,
+	model.VideoResolutionV2160p: 20,
+	model.VideoResolutionV4320p: 40,
+}
+
+// minEpisodeFiles is the number of similarly sized video files above which a torrent without episode markers
+// probably contains a miniseries rather than a movie; a lower count could be a movie split across discs.
+const minEpisodeFiles = 4
+
+// videoFiles summarises the sizes of the video files in a torrent, ignoring samples and extras much smaller than the largest file.
+type videoFiles struct {
+	sizes []uint64
+}
+
+func getVideoFiles(t model.Torrent) videoFiles {
+	var sizes []uint64
+	switch t.FilesStatus {
+	case model.FilesStatusSingle:
+		if ft := t.FileType(); ft.Valid && ft.FileType == model.FileTypeVideo {
+			sizes = append(sizes, t.Size)
+		}
+	case model.FilesStatusMulti:
+		for _, f := range t.Files {
+			if ft := f.FileType(); ft.Valid && ft.FileType == model.FileTypeVideo {
+				sizes = append(sizes, f.Size)
+			}
+		}
+	}
+	if len(sizes) == 0 {
+		return videoFiles{}
+	}
+	sort.Slice(sizes, func(i, j int) bool {
+		return sizes[i] > sizes[j]
+	})
+	n := 1
+	for n < len(sizes) && sizes[n] >= sizes[0]/4 {
+		n++
+	}
+	return videoFiles{sizes: sizes[:n]}
+}
+
+func (v videoFiles) totalSize() uint64 {
+	total := uint64(0)
+	for _, s := range v.sizes {
+		total += s
+	}
+	return total
+}
+
+// looksEpisodic returns true if there are enough similarly sized video files for the torrent to probably be episodes of a series.
+func (v videoFiles) looksEpisodic() bool {
+	if len(v.sizes) < minEpisodeFiles {
+		return false
+	}
+	return v.sizes[len(v.sizes)-1] >= v.sizes[0]/2
+}
+
+// estimateRuntime estimates the total running time of the video files from their size and the typical bitrate
+// of the resolution, returning zero if either is unknown.
+func (v videoFiles) estimateRuntime(resolution model.NullVideoResolution) time.Duration {
+	if !resolution.Valid || len(v.sizes) == 0 {
+		return 0
+	}
+	bitrate, ok := typicalBitrates[resolution.VideoResolution]
+	if !ok {
+		return 0
+	}
+	seconds := float64(v.totalSize()) * 8 / (bitrate * 1_000_000)
+	return time.Duration(seconds * float64(time.Second))
+}
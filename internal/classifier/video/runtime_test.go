@@ -0,0 +1,49 @@
+package video
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestVideoFiles(t *testing.T) {
+	t.Parallel()
+
+	const gb = 1_000_000_000
+
+	files := func(sizes ...uint64) model.Torrent {
+		torrent := model.Torrent{FilesStatus: model.FilesStatusMulti}
+		for i, size := range sizes {
+			torrent.Files = append(torrent.Files, model.TorrentFile{Index: uint32(i), Path: "file.mkv", Size: size})
+		}
+		torrent.Files = append(torrent.Files, model.TorrentFile{Index: uint32(len(sizes)), Path: "info.nfo", Size: 10 * gb})
+		return torrent
+	}
+
+	movie := getVideoFiles(files(8*gb, 50_000_000))
+	assert.Equal(t, []uint64{8 * gb}, movie.sizes, "samples and non-video files should be ignored")
+	assert.False(t, movie.looksEpisodic())
+	assert.Equal(t, 8000*time.Second, movie.estimateRuntime(model.NewNullVideoResolution(model.VideoResolutionV1080p)))
+	assert.Equal(t, time.Duration(0), movie.estimateRuntime(model.NullVideoResolution{}))
+
+	assert.False(t, getVideoFiles(files(4*gb, 4*gb)).looksEpisodic(), "a movie split across discs isn't episodic")
+	assert.True(t, getVideoFiles(files(gb, gb, 900_000_000, 1_100_000_000)).looksEpisodic())
+	assert.False(t, getVideoFiles(files(4*gb, gb, 1_100_000_000, 1_200_000_000)).looksEpisodic())
+}
+
+func TestLookupKey(t *testing.T) {
+	t.Parallel()
+
+	noRef := model.Maybe[model.ContentRef]{}
+	key := func(runtime time.Duration) string {
+		return lookupKey(model.ContentTypeMovie, noRef, "The  Movie", 2020, runtime)
+	}
+	assert.Equal(t, "video:movie:the movie:2020", key(0))
+	assert.Equal(t, key(90*time.Minute), key(100*time.Minute), "similar runtimes share a lookup")
+	assert.NotEqual(t, key(60*time.Minute), key(150*time.Minute), "different runtimes don't share a lookup")
+	assert.Equal(t,
+		"video:movie:tmdb:123",
+		lookupKey(model.ContentTypeMovie, model.Maybe[model.ContentRef]{Valid: true, Val: model.ContentRef{Source: "tmdb", ID: "123"}}, "The Movie", 2020, time.Hour),
+	)
+}
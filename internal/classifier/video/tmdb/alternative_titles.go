@@ -0,0 +1,88 @@
+package tmdb
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/regex"
+	tmdb "github.com/cyruzin/golang-tmdb"
+)
+
+// maxAlternativeTitles is the number of alternative and translated titles stored for each content item; popular
+// movies can have hundreds, many of which differ only slightly.
+const maxAlternativeTitles = 50
+
+// alternativeTitlesAppendToResponse returns the responses appended to details requests for alternative titles, if enabled.
+func (c *client) alternativeTitlesAppendToResponse() []string {
+	if !c.fetchAlternativeTitles {
+		return nil
+	}
+	return []string{"alternative_titles", "translations"}
+}
+
+func movieAlternativeTitles(details tmdb.MovieDetails) []string {
+	var titles []string
+	if details.MovieAlternativeTitlesAppend != nil && details.MovieAlternativeTitlesAppend.AlternativeTitles != nil {
+		for _, t := range details.MovieAlternativeTitlesAppend.AlternativeTitles.Titles {
+			titles = append(titles, t.Title)
+		}
+	}
+	if details.MovieTranslationsAppend != nil && details.MovieTranslationsAppend.Translations != nil {
+		for _, t := range details.MovieTranslationsAppend.Translations.Translations {
+			titles = append(titles, t.Data.Title)
+		}
+	}
+	return titles
+}
+
+func tvShowAlternativeTitles(details tmdb.TVDetails) []string {
+	var titles []string
+	if details.TVAlternativeTitlesAppend != nil && details.TVAlternativeTitlesAppend.AlternativeTitles != nil &&
+		details.TVAlternativeTitlesAppend.AlternativeTitles.TVAlternativeTitlesResults != nil {
+		for _, t := range details.TVAlternativeTitlesAppend.AlternativeTitles.Results {
+			titles = append(titles, t.Title)
+		}
+	}
+	if details.TVTranslationsAppend != nil && details.TVTranslationsAppend.Translations != nil {
+		for _, t := range details.TVTranslationsAppend.Translations.Translations {
+			titles = append(titles, t.Data.Name)
+		}
+	}
+	return titles
+}
+
+// alternativeTitleAttributes returns the attributes of the distinct titles that differ from the title and original
+// title once normalized, up to maxAlternativeTitles.
+func alternativeTitleAttributes(titles []string, title string, originalTitle string) []model.ContentAttribute {
+	seen := map[string]struct{}{
+		regex.NormalizeString(title):         {},
+		regex.NormalizeString(originalTitle): {},
+	}
+	var attributes []model.ContentAttribute
+	for _, t := range titles {
+		norm := regex.NormalizeString(t)
+		if norm == "" {
+			continue
+		}
+		if _, ok := seen[norm]; ok {
+			continue
+		}
+		seen[norm] = struct{}{}
+		attributes = append(attributes, model.ContentAttribute{
+			Source: SourceTmdb,
+			Key:    model.AlternativeTitleKeyPrefix + norm,
+			Value:  t,
+		})
+		if len(attributes) == maxAlternativeTitles {
+			break
+		}
+	}
+	return attributes
+}
+
+// titleCandidates returns the titles of content that a parsed title is compared with.
+func titleCandidates(c model.Content) []string {
+	candidates := []string{c.Title}
+	if c.OriginalTitle.Valid {
+		candidates = append(candidates, c.OriginalTitle.String)
+	}
+	return append(candidates, c.AlternativeTitles()...)
+}
@@ -0,0 +1,50 @@
+package tmdb
+
+import (
+	"encoding/json"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	tmdb "github.com/cyruzin/golang-tmdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestMovieDetailsAlternativeTitles(t *testing.T) {
+	t.Parallel()
+
+	var details tmdb.MovieDetails
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"id": 129,
+		"title": "Spirited Away",
+		"original_title": "千と千尋の神隠し",
+		"release_date": "2001-07-20",
+		"alternative_titles": {
+			"titles": [
+				{"iso_3166_1": "FR", "title": "Le Voyage de Chihiro", "type": ""},
+				{"iso_3166_1": "US", "title": "SPIRITED AWAY", "type": ""},
+				{"iso_3166_1": "DE", "title": "Chihiros Reise ins Zauberland", "type": ""}
+			]
+		},
+		"translations": {
+			"translations": [
+				{"iso_639_1": "fr", "iso_3166_1": "FR", "data": {"title": "Le voyage de Chihiro"}},
+				{"iso_639_1": "es", "iso_3166_1": "ES", "data": {"title": "El viaje de Chihiro"}},
+				{"iso_639_1": "it", "iso_3166_1": "IT", "data": {"title": ""}}
+			]
+		}
+	}`), &details))
+
+	movie, err := MovieDetailsToMovieModel(details)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"Le Voyage de Chihiro",
+		"Chihiros Reise ins Zauberland",
+		"El viaje de Chihiro",
+	}, movie.AlternativeTitles())
+
+	movie.UpdateTsv()
+	assert.Contains(t, movie.Tsv, "chihiro")
+
+	assert.True(t, levenshteinCheck("Le Voyage De Chihiro", titleCandidates(movie), 5))
+	assert.False(t, levenshteinCheck("Le Voyage De Chihiro", titleCandidates(model.Content{Title: movie.Title}), 5))
+}
@@ -0,0 +1,168 @@
+package tmdb
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/query"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/search"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/telemetry/tracing"
+	"strconv"
+)
+
+type CandidateClient interface {
+	// SearchCandidates returns content that may be referred to by a title, from the database followed by a TMDB search,
+	// without the title distance check applied when classifying. TMDB results that aren't in the database are built from
+	// the search results alone, so they lack the details fetched when content is matched.
+	SearchCandidates(ctx context.Context, p SearchCandidatesParams) ([]model.Content, error)
+}
+
+type SearchCandidatesParams struct {
+	ContentType  model.ContentType
+	Title        string
+	IncludeAdult bool
+	// Limit applies to each of the database and TMDB results
+	Limit uint
+}
+
+func (c *client) SearchCandidates(ctx context.Context, p SearchCandidatesParams) ([]model.Content, error) {
+	contentTypes := []model.ContentType{p.ContentType}
+	if p.ContentType == model.ContentTypeMovie || p.ContentType == model.ContentTypeXxx {
+		contentTypes = []model.ContentType{model.ContentTypeMovie, model.ContentTypeXxx}
+	}
+	result, err := c.s.Content(
+		ctx,
+		query.Where(search.ContentTypeCriteria(contentTypes...)),
+		query.QueryString(p.Title),
+		query.OrderByQueryStringRank(),
+		query.Limit(p.Limit),
+		search.ContentDefaultPreload(),
+		search.ContentDefaultHydrate(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([]model.Content, 0, len(result.Items))
+	seen := make(map[string]struct{}, len(result.Items))
+	for _, item := range result.Items {
+		candidates = append(candidates, item.Content)
+		seen[item.Source+":"+item.ID] = struct{}{}
+	}
+	var remote []model.Content
+	switch p.ContentType {
+	case model.ContentTypeMovie, model.ContentTypeXxx:
+		remote, err = c.searchMovieCandidatesTmdb(ctx, p)
+	case model.ContentTypeTvShow:
+		remote, err = c.searchTvShowCandidatesTmdb(ctx, p)
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, content := range remote {
+		if _, ok := seen[content.Source+":"+content.ID]; !ok {
+			candidates = append(candidates, content)
+		}
+	}
+	return candidates, nil
+}
+
+func (c *client) searchMovieCandidatesTmdb(ctx context.Context, p SearchCandidatesParams) ([]model.Content, error) {
+	urlOptions := make(map[string]string)
+	if p.IncludeAdult {
+		urlOptions["include_adult"] = "true"
+	}
+	_, span := tracer.Start(ctx, "tmdb.search_movie")
+	searchResult, searchErr := c.c.GetSearchMovies(p.Title, urlOptions)
+	tracing.End(span, searchErr)
+	if searchErr != nil {
+		return nil, searchErr
+	}
+	var candidates []model.Content
+	for _, item := range searchResult.Results {
+		if uint(len(candidates)) >= p.Limit {
+			break
+		}
+		ct := model.ContentTypeMovie
+		if item.Adult {
+			ct = model.ContentTypeXxx
+		}
+		releaseDate, _ := model.NewDateFromIsoString(item.ReleaseDate)
+		candidates = append(candidates, model.Content{
+			Type:             ct,
+			Source:           SourceTmdb,
+			ID:               strconv.Itoa(int(item.ID)),
+			Title:            item.Title,
+			ReleaseDate:      releaseDate,
+			ReleaseYear:      releaseDate.Year,
+			Adult:            model.NewNullBool(item.Adult),
+			OriginalLanguage: model.ParseLanguage(item.OriginalLanguage),
+			OriginalTitle:    model.NewNullString(item.OriginalTitle),
+			Overview: model.NullString{
+				String: item.Overview,
+				Valid:  item.Overview != "",
+			},
+			Popularity:  model.NewNullFloat32(item.Popularity),
+			VoteAverage: model.NewNullFloat32(item.VoteAverage),
+			VoteCount:   model.NewNullUint(uint(item.VoteCount)),
+			Attributes:  searchResultAttributes(item.PosterPath, item.BackdropPath),
+		})
+	}
+	return candidates, nil
+}
+
+func (c *client) searchTvShowCandidatesTmdb(ctx context.Context, p SearchCandidatesParams) ([]model.Content, error) {
+	urlOptions := make(map[string]string)
+	if p.IncludeAdult {
+		urlOptions["include_adult"] = "true"
+	}
+	_, span := tracer.Start(ctx, "tmdb.search_tv_show")
+	searchResult, searchErr := c.c.GetSearchTVShow(p.Title, urlOptions)
+	tracing.End(span, searchErr)
+	if searchErr != nil {
+		return nil, searchErr
+	}
+	var candidates []model.Content
+	for _, item := range searchResult.Results {
+		if uint(len(candidates)) >= p.Limit {
+			break
+		}
+		firstAirDate, _ := model.NewDateFromIsoString(item.FirstAirDate)
+		candidates = append(candidates, model.Content{
+			Type:             model.ContentTypeTvShow,
+			Source:           SourceTmdb,
+			ID:               strconv.Itoa(int(item.ID)),
+			Title:            item.Name,
+			ReleaseDate:      firstAirDate,
+			ReleaseYear:      firstAirDate.Year,
+			OriginalLanguage: model.ParseLanguage(item.OriginalLanguage),
+			OriginalTitle:    model.NewNullString(item.OriginalName),
+			Overview: model.NullString{
+				String: item.Overview,
+				Valid:  item.Overview != "",
+			},
+			Popularity:  model.NewNullFloat32(item.Popularity),
+			VoteAverage: model.NewNullFloat32(item.VoteAverage),
+			VoteCount:   model.NewNullUint(uint(item.VoteCount)),
+			Attributes:  searchResultAttributes(item.PosterPath, item.BackdropPath),
+		})
+	}
+	return candidates, nil
+}
+
+func searchResultAttributes(posterPath, backdropPath string) []model.ContentAttribute {
+	var attributes []model.ContentAttribute
+	if posterPath != "" {
+		attributes = append(attributes, model.ContentAttribute{
+			Source: "tmdb",
+			Key:    "poster_path",
+			Value:  posterPath,
+		})
+	}
+	if backdropPath != "" {
+		attributes = append(attributes, model.ContentAttribute{
+			Source: "tmdb",
+			Key:    "backdrop_path",
+			Value:  backdropPath,
+		})
+	}
+	return attributes
+}
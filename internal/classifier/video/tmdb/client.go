@@ -4,20 +4,31 @@ import (
 	"errors"
 	"github.com/bitmagnet-io/bitmagnet/internal/database/search"
 	"github.com/cyruzin/golang-tmdb"
+	"go.opentelemetry.io/otel"
 )
 
+// tracer traces the TMDB API calls; the API client doesn't accept a context, so spans are started around each call.
+var tracer = otel.Tracer("github.com/bitmagnet-io/bitmagnet/internal/classifier/video/tmdb")
+
 type Client interface {
 	MovieClient
 	TvShowClient
+	EpisodeClient
+	CandidateClient
+	FetchClient
 }
 
 type client struct {
-	c *tmdb.Client
-	s search.Search
+	c            *tmdb.Client
+	s            search.Search
+	fetchCredits bool
+	// fetchAlternativeTitles enables storing the alternative and translated titles of content, for local matching
+	fetchAlternativeTitles bool
 }
 
 const SourceTmdb = "tmdb"
 
 var (
-	ErrUnknownSource = errors.New("unknown source")
+	ErrUnknownSource      = errors.New("unknown source")
+	ErrUnknownContentType = errors.New("unknown content type")
 )
@@ -8,13 +8,19 @@ type Config struct {
 	ApiKey         string
 	RateLimit      time.Duration
 	RateLimitBurst int
+	// FetchCredits enables storing the cast and crew of matched content, for searching by person
+	FetchCredits bool
+	// FetchAlternativeTitles enables storing the alternative and translated titles of matched content, so that release
+	// names in other languages are matched to content that has already been fetched
+	FetchAlternativeTitles bool
 }
 
 func NewDefaultConfig() Config {
 	return Config{
-		ApiKey:         defaultTmdbApiKey,
-		RateLimit:      defaultRateLimit,
-		RateLimitBurst: defaultRateLimitBurst,
+		ApiKey:                 defaultTmdbApiKey,
+		RateLimit:              defaultRateLimit,
+		RateLimitBurst:         defaultRateLimitBurst,
+		FetchAlternativeTitles: true,
 	}
 }
 
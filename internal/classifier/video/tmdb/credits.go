@@ -0,0 +1,117 @@
+package tmdb
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	tmdb "github.com/cyruzin/golang-tmdb"
+	"strconv"
+	"strings"
+)
+
+// maxCastCredits is the number of top-billed cast members stored for each content item
+const maxCastCredits = 20
+
+// crewJobs are the crew jobs stored for each content item; the full crew of a film can run to hundreds of credits
+var crewJobs = map[string]struct{}{
+	"Director":                {},
+	"Screenplay":              {},
+	"Writer":                  {},
+	"Story":                   {},
+	"Novel":                   {},
+	"Creator":                 {},
+	"Producer":                {},
+	"Executive Producer":      {},
+	"Original Music Composer": {},
+	"Director of Photography": {},
+}
+
+// detailsUrlOptions returns the options for a movie or TV show details request, with credits appended if enabled.
+func (c *client) detailsUrlOptions(appendToResponse ...string) map[string]string {
+	if c.fetchCredits {
+		appendToResponse = append(appendToResponse, "credits")
+	}
+	options := make(map[string]string)
+	if len(appendToResponse) > 0 {
+		options["append_to_response"] = strings.Join(appendToResponse, ",")
+	}
+	return options
+}
+
+type castCredit struct {
+	creditID  string
+	personID  int64
+	name      string
+	character string
+	order     int
+}
+
+type crewCredit struct {
+	creditID   string
+	personID   int64
+	name       string
+	job        string
+	department string
+}
+
+func movieCredits(details tmdb.MovieDetails) (cast []castCredit, crew []crewCredit) {
+	if details.MovieCreditsAppend == nil || details.MovieCreditsAppend.Credits.MovieCredits == nil {
+		return
+	}
+	credits := details.MovieCreditsAppend.Credits.MovieCredits
+	for _, c := range credits.Cast {
+		cast = append(cast, castCredit{c.CreditID, c.ID, c.Name, c.Character, c.Order})
+	}
+	for _, c := range credits.Crew {
+		crew = append(crew, crewCredit{c.CreditID, c.ID, c.Name, c.Job, c.Department})
+	}
+	return
+}
+
+func tvShowCredits(details tmdb.TVDetails) (cast []castCredit, crew []crewCredit) {
+	if details.TVCreditsAppend == nil || details.TVCreditsAppend.Credits.TVCredits == nil {
+		return
+	}
+	credits := details.TVCreditsAppend.Credits.TVCredits
+	for _, c := range credits.Cast {
+		cast = append(cast, castCredit{c.CreditID, c.ID, c.Name, c.Character, c.Order})
+	}
+	for _, c := range credits.Crew {
+		crew = append(crew, crewCredit{c.CreditID, c.ID, c.Name, c.Job, c.Department})
+	}
+	return
+}
+
+// creditsToPeople converts the top-billed cast and the crew with notable jobs to content people;
+// the content reference is filled in when the content is saved.
+func creditsToPeople(cast []castCredit, crew []crewCredit) []model.ContentPerson {
+	var people []model.ContentPerson
+	for _, c := range cast {
+		if c.order >= maxCastCredits {
+			continue
+		}
+		people = append(people, model.ContentPerson{
+			Source:    SourceTmdb,
+			CreditID:  c.creditID,
+			PersonID:  strconv.Itoa(int(c.personID)),
+			Name:      c.name,
+			Role:      model.ContentPersonRoleCast,
+			Character: model.NullString{String: c.character, Valid: c.character != ""},
+			Position:  int32(c.order),
+		})
+	}
+	for i, c := range crew {
+		if _, ok := crewJobs[c.job]; !ok {
+			continue
+		}
+		people = append(people, model.ContentPerson{
+			Source:     SourceTmdb,
+			CreditID:   c.creditID,
+			PersonID:   strconv.Itoa(int(c.personID)),
+			Name:       c.name,
+			Role:       model.ContentPersonRoleCrew,
+			Job:        model.NullString{String: c.job, Valid: true},
+			Department: model.NullString{String: c.department, Valid: c.department != ""},
+			Position:   int32(i),
+		})
+	}
+	return people
+}
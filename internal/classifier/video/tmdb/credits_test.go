@@ -0,0 +1,61 @@
+package tmdb
+
+import (
+	"encoding/json"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	tmdb "github.com/cyruzin/golang-tmdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestMovieDetailsCredits(t *testing.T) {
+	t.Parallel()
+
+	var details tmdb.MovieDetails
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"id": 27205,
+		"title": "Inception",
+		"release_date": "2010-07-15",
+		"credits": {
+			"cast": [
+				{"credit_id": "a", "id": 6193, "name": "Leonardo DiCaprio", "character": "Cobb", "order": 0},
+				{"credit_id": "b", "id": 99, "name": "Extra", "character": "", "order": 25}
+			],
+			"crew": [
+				{"credit_id": "c", "id": 525, "name": "Christopher Nolan", "job": "Director", "department": "Directing"},
+				{"credit_id": "d", "id": 100, "name": "Grip", "job": "Key Grip", "department": "Crew"}
+			]
+		}
+	}`), &details))
+
+	movie, err := MovieDetailsToMovieModel(details)
+	require.NoError(t, err)
+	assert.Equal(t, []model.ContentPerson{
+		{
+			Source:    SourceTmdb,
+			CreditID:  "a",
+			PersonID:  "6193",
+			Name:      "Leonardo DiCaprio",
+			Role:      model.ContentPersonRoleCast,
+			Character: model.NewNullString("Cobb"),
+		},
+		{
+			Source:     SourceTmdb,
+			CreditID:   "c",
+			PersonID:   "525",
+			Name:       "Christopher Nolan",
+			Role:       model.ContentPersonRoleCrew,
+			Job:        model.NewNullString("Director"),
+			Department: model.NewNullString("Directing"),
+		},
+	}, movie.People)
+}
+
+func TestMovieDetailsWithoutCredits(t *testing.T) {
+	t.Parallel()
+
+	movie, err := MovieDetailsToMovieModel(tmdb.MovieDetails{ID: 27205, Title: "Inception"})
+	require.NoError(t, err)
+	assert.Empty(t, movie.People)
+}
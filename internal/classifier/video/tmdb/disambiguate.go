@@ -0,0 +1,54 @@
+package tmdb
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/regex"
+	"math"
+	"time"
+)
+
+// maxRuntimeCandidates limits the detail lookups made to compare the runtimes of tied TMDB search results
+const maxRuntimeCandidates = 3
+
+// tieKey identifies candidates that can't be told apart by title and year, e.g. remakes released in the same year
+// or same-named films where the year is unknown
+func tieKey(title string, year model.Year) string {
+	return regex.NormalizeString(title) + ":" + year.String()
+}
+
+// closestRuntime returns the index of the candidate with the runtime closest to the estimate,
+// comparing by ratio so that the same error matters equally for short and long films;
+// candidates with an unknown runtime are only chosen if no runtimes are known.
+func closestRuntime(runtimes []model.NullUint16, estimate time.Duration) int {
+	best, bestDistance := 0, math.Inf(1)
+	for i, r := range runtimes {
+		if !r.Valid || r.Uint16 == 0 {
+			continue
+		}
+		distance := math.Abs(math.Log(float64(time.Duration(r.Uint16)*time.Minute) / float64(estimate)))
+		if distance < bestDistance {
+			best, bestDistance = i, distance
+		}
+	}
+	return best
+}
+
+// disambiguateContent chooses between matching candidates, preferring the first unless other candidates tie with it
+// on title and year and an estimated runtime is available to choose between them.
+func disambiguateContent(candidates []model.Content, estimate time.Duration) model.Content {
+	if estimate <= 0 || len(candidates) < 2 {
+		return candidates[0]
+	}
+	key := tieKey(candidates[0].Title, candidates[0].ReleaseYear)
+	var ties []model.Content
+	for _, c := range candidates {
+		if tieKey(c.Title, c.ReleaseYear) == key {
+			ties = append(ties, c)
+		}
+	}
+	runtimes := make([]model.NullUint16, 0, len(ties))
+	for _, c := range ties {
+		runtimes = append(runtimes, c.Runtime)
+	}
+	return ties[closestRuntime(runtimes, estimate)]
+}
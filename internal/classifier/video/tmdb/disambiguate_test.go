@@ -0,0 +1,32 @@
+package tmdb
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestDisambiguateContent(t *testing.T) {
+	t.Parallel()
+
+	content := func(id string, title string, year model.Year, runtime uint16) model.Content {
+		c := model.Content{ID: id, Title: title, ReleaseYear: year}
+		if runtime > 0 {
+			c.Runtime = model.NewNullUint16(runtime)
+		}
+		return c
+	}
+
+	candidates := []model.Content{
+		content("1", "The Thing", 2011, 0),
+		content("2", "the thing", 2011, 103),
+		content("3", "The Thing", 2011, 25),
+		content("4", "The Other Thing", 2011, 100),
+	}
+
+	assert.Equal(t, "1", disambiguateContent(candidates, 0).ID, "the first candidate is chosen without an estimate")
+	assert.Equal(t, "2", disambiguateContent(candidates, 90*time.Minute).ID)
+	assert.Equal(t, "3", disambiguateContent(candidates, 30*time.Minute).ID)
+	assert.Equal(t, "1", disambiguateContent(candidates[:1], 30*time.Minute).ID)
+}
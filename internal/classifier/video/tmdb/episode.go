@@ -0,0 +1,88 @@
+package tmdb
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/classifier"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/telemetry/tracing"
+	tmdb "github.com/cyruzin/golang-tmdb"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"sort"
+	"strconv"
+)
+
+type EpisodeClient interface {
+	// GetEpisodeByAirDate gets the episodes of a TMDB TV show that aired on the date, for daily shows such as
+	// talk shows and news that are released by air date rather than by episode; classifier.ErrNoMatch is returned if no
+	// episode aired on the date.
+	GetEpisodeByAirDate(ctx context.Context, id string, airDate model.Date) (model.Episodes, error)
+}
+
+func (c *client) GetEpisodeByAirDate(ctx context.Context, id string, airDate model.Date) (model.Episodes, error) {
+	intId, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, err
+	}
+	// the details are shared by the torrents of a show's episodes in a batch
+	d, err := classifier.BatchLookup(ctx, "tmdb:tv_details:"+id, func() (*tmdb.TVDetails, error) {
+		_, span := tracer.Start(ctx, "tmdb.tv_show_details", trace.WithAttributes(attribute.Int("tmdb_id", intId)))
+		d, err := c.c.GetTVDetails(intId, nil)
+		tracing.End(span, err)
+		return d, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	date := airDate.IsoDateString()
+	// the episode is looked for in the latest seasons that started airing by the date, as a season's episodes can
+	// air after the next season starts, such as with specials
+	type season struct {
+		number  int
+		airDate string
+	}
+	var seasons []season
+	for _, s := range d.Seasons {
+		if s.AirDate != "" && s.AirDate <= date {
+			seasons = append(seasons, season{number: s.SeasonNumber, airDate: s.AirDate})
+		}
+	}
+	sort.Slice(seasons, func(i, j int) bool {
+		return seasons[i].airDate > seasons[j].airDate
+	})
+	for i, s := range seasons {
+		if i == maxAirDateSeasons {
+			break
+		}
+		sd, err := classifier.BatchLookup(
+			ctx,
+			"tmdb:tv_season_details:"+id+":"+strconv.Itoa(s.number),
+			func() (*tmdb.TVSeasonDetails, error) {
+				_, span := tracer.Start(ctx, "tmdb.tv_season_details", trace.WithAttributes(
+					attribute.Int("tmdb_id", intId),
+					attribute.Int("season", s.number),
+				))
+				sd, err := c.c.GetTVSeasonDetails(intId, s.number, nil)
+				tracing.End(span, err)
+				return sd, err
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+		// several episodes can air on the same day
+		episodes := make(model.Episodes)
+		for _, e := range sd.Episodes {
+			if e.AirDate == date {
+				episodes = episodes.AddEpisode(s.number, e.EpisodeNumber)
+			}
+		}
+		if len(episodes) > 0 {
+			return episodes, nil
+		}
+	}
+	return nil, classifier.ErrNoMatch
+}
+
+// maxAirDateSeasons is the number of seasons in which an episode is looked for by air date.
+const maxAirDateSeasons = 2
@@ -0,0 +1,39 @@
+package tmdb
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/classifier"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	tmdb "github.com/cyruzin/golang-tmdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"testing"
+)
+
+func TestGetEpisodeByAirDate_batch(t *testing.T) {
+	t.Parallel()
+
+	var calls []string
+	tmdbClient, err := tmdb.Init("key")
+	require.NoError(t, err)
+	tmdbClient.SetClientConfig(http.Client{Transport: &tmdbTransport{
+		calls: &calls,
+		responses: map[string]string{
+			"/3/tv/1": `{"id":1,"seasons":[{"season_number":1,"air_date":"2024-01-08"}]}`,
+			"/3/tv/1/season/1": `{"season_number":1,"episodes":[` +
+				`{"episode_number":1,"air_date":"2024-01-08"},{"episode_number":2,"air_date":"2024-01-09"}]}`,
+		},
+	}})
+	c := &client{c: tmdbClient}
+	ctx := classifier.WithBatch(context.Background())
+
+	for day, episode := range map[uint8]int{8: 1, 9: 2} {
+		episodes, err := c.GetEpisodeByAirDate(ctx, "1", model.Date{Year: 2024, Month: 1, Day: day})
+		require.NoError(t, err)
+		assert.Equal(t, make(model.Episodes).AddEpisode(1, episode), episodes)
+	}
+	_, err = c.GetEpisodeByAirDate(ctx, "1", model.Date{Year: 2024, Month: 1, Day: 10})
+	assert.ErrorIs(t, err, classifier.ErrNoMatch)
+	assert.Equal(t, []string{"/3/tv/1", "/3/tv/1/season/1"}, calls, "the details should be shared by the batch")
+}
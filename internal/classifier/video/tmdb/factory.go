@@ -1,13 +1,19 @@
 package tmdb
 
 import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/config"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/healthcheck"
 	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/httpclient/httplogger"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/httpclient/httpmetrics"
 	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/httpclient/httpratelimiter"
 	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
 	"github.com/bitmagnet-io/bitmagnet/internal/database/search"
 	tmdb "github.com/cyruzin/golang-tmdb"
+	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
+	"golang.org/x/time/rate"
 	"net/http"
 	"time"
 )
@@ -21,45 +27,97 @@ type Params struct {
 
 type Result struct {
 	fx.Out
-	Client lazy.Lazy[Client]
+	Client          lazy.Lazy[Client]
+	RequestDuration prometheus.Collector `group:"prometheus_collectors"`
+	HealthCheck     healthcheck.Check    `group:"healthchecks"`
+	// changes to the rate limit are applied while running; a changed API key requires a restart
+	OnConfigChange config.OnConfigChange `group:"config_change_hooks"`
 }
 
 func New(p Params) Result {
+	requestDuration := httpmetrics.NewRequestDuration("tmdb")
+	rateLimit, rateLimitBurst := rateLimitOf(p.Config)
+	limiter := rate.NewLimiter(rate.Every(rateLimit), rateLimitBurst)
+	apiClient := lazy.New(func() (*tmdb.Client, error) {
+		logger := p.Logger.Named("tmdb_client")
+		if p.Config.ApiKey == defaultTmdbApiKey {
+			logger.Warnln("you are using the default TMDB api key; TMDB requests will be limited to 1 per second; to remove this warning please configure a personal TMDB api key")
+		}
+		httpClient := http.Client{
+			// need to set a non-zero value as the underlying client unfortunately sets 10 seconds as the default if none is provided;
+			// this does not work well with the rate limiter; a 30 second timeout fixes this assuming a concurrency of 10 on the queue
+			// (and a maximum of 2 TMDB requests per classification)
+			Timeout: time.Second * 30,
+			Transport: httpratelimiter.NewLimiterDecorator(
+				limiter,
+			)(httplogger.NewDecorator(
+				logger,
+			)(httpmetrics.NewDecorator(
+				requestDuration,
+			)(http.DefaultTransport))),
+		}
+		c, initErr := tmdb.Init(p.Config.ApiKey)
+		if initErr != nil {
+			return nil, initErr
+		}
+		c.SetClientConfig(httpClient)
+		return c, nil
+	})
 	return Result{
+		RequestDuration: requestDuration,
 		Client: lazy.New(func() (Client, error) {
 			s, err := p.Search.Get()
 			if err != nil {
 				return nil, err
 			}
-			logger := p.Logger.Named("tmdb_client")
-			rateLimit := p.Config.RateLimit
-			rateLimitBurst := p.Config.RateLimitBurst
-			if p.Config.ApiKey == defaultTmdbApiKey {
-				rateLimit = time.Second
-				rateLimitBurst = 1
-				logger.Warnln("you are using the default TMDB api key; TMDB requests will be limited to 1 per second; to remove this warning please configure a personal TMDB api key")
-			}
-			httpClient := http.Client{
-				// need to set a non-zero value as the underlying client unfortunately sets 10 seconds as the default if none is provided;
-				// this does not work well with the rate limiter; a 30 second timeout fixes this assuming a concurrency of 10 on the queue
-				// (and a maximum of 2 TMDB requests per classification)
-				Timeout: time.Second * 30,
-				Transport: httpratelimiter.NewDecorator(
-					rateLimit,
-					rateLimitBurst,
-				)(httplogger.NewDecorator(
-					logger,
-				)(http.DefaultTransport)),
-			}
-			c, initErr := tmdb.Init(p.Config.ApiKey)
-			c.SetClientConfig(httpClient)
-			if initErr != nil {
-				return nil, initErr
+			c, err := apiClient.Get()
+			if err != nil {
+				return nil, err
 			}
 			return &client{
-				c: c,
-				s: s,
+				c:                      c,
+				s:                      s,
+				fetchCredits:           p.Config.FetchCredits,
+				fetchAlternativeTitles: p.Config.FetchAlternativeTitles,
 			}, nil
 		}),
+		HealthCheck: healthcheck.Check{
+			Name:    "tmdb",
+			Timeout: 10 * time.Second,
+			// the check is an API request, which counts towards the rate limit
+			CacheFor: 5 * time.Minute,
+			Check: func(ctx context.Context) error {
+				c, err := apiClient.Get()
+				if err != nil {
+					return err
+				}
+				// the API client doesn't accept a context, so the request is abandoned rather than cancelled on timeout
+				result := make(chan error, 1)
+				go func() {
+					_, err := c.GetConfigurationAPI()
+					result <- err
+				}()
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case err := <-result:
+					return err
+				}
+			},
+		},
+		OnConfigChange: config.NewOnConfigChange("tmdb", func(cfg Config) error {
+			rateLimit, rateLimitBurst := rateLimitOf(cfg)
+			limiter.SetLimit(rate.Every(rateLimit))
+			limiter.SetBurst(rateLimitBurst)
+			return nil
+		}),
+	}
+}
+
+// rateLimitOf returns the configured rate limit, unless the default API key is used, which is limited to 1 request per second.
+func rateLimitOf(cfg Config) (time.Duration, int) {
+	if cfg.ApiKey == defaultTmdbApiKey {
+		return time.Second, 1
 	}
+	return cfg.RateLimit, cfg.RateLimitBurst
 }
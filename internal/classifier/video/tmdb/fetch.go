@@ -0,0 +1,35 @@
+package tmdb
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/classifier"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"strconv"
+	"strings"
+)
+
+type FetchClient interface {
+	// FetchContent gets the current details of TMDB content from TMDB, ignoring any copy in the database;
+	// classifier.ErrNoMatch is returned if the content is no longer on TMDB.
+	FetchContent(ctx context.Context, contentType model.ContentType, id string) (model.Content, error)
+}
+
+func (c *client) FetchContent(ctx context.Context, contentType model.ContentType, id string) (content model.Content, err error) {
+	intId, err := strconv.Atoi(id)
+	if err != nil {
+		return
+	}
+	switch contentType {
+	case model.ContentTypeMovie, model.ContentTypeXxx:
+		content, err = c.getMovieByTmbdId(ctx, intId)
+	case model.ContentTypeTvShow:
+		content, err = c.getTvShowByTmdbId(ctx, intId)
+	default:
+		err = ErrUnknownContentType
+	}
+	// TMDB's status code for a resource that couldn't be found
+	if err != nil && strings.HasPrefix(err.Error(), "code: 34") {
+		err = classifier.ErrNoMatch
+	}
+	return
+}
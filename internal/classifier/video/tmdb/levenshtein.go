@@ -3,20 +3,46 @@ package tmdb
 import (
 	"github.com/agnivade/levenshtein"
 	"github.com/bitmagnet-io/bitmagnet/internal/regex"
+	"github.com/bitmagnet-io/bitmagnet/internal/romanize"
+	"math"
 )
 
+// levenshteinCheck returns true if the target is within the threshold edit distance of any of the candidates.
 func levenshteinCheck(target string, candidates []string, threshold uint) bool {
-	normTarget := regex.NormalizeString(target)
+	return levenshteinDistance(target, candidates) <= threshold
+}
+
+// levenshteinDistance returns the smallest edit distance between the target and any of the candidates.
+// Titles in non-Latin scripts are also compared in romanized form, so that a release named in Cyrillic or a
+// transliteration of it can match a content title in either script.
+func levenshteinDistance(target string, candidates []string) uint {
+	normTargets := normalizedForms(target)
 	triedCandidates := make(map[string]struct{}, len(candidates))
+	distance := -1
 	for _, candidate := range candidates {
-		normCandidate := regex.NormalizeString(candidate)
-		if _, ok := triedCandidates[normCandidate]; ok {
-			continue
+		for _, normCandidate := range normalizedForms(candidate) {
+			if _, ok := triedCandidates[normCandidate]; ok {
+				continue
+			}
+			for _, normTarget := range normTargets {
+				if d := levenshtein.ComputeDistance(normTarget, normCandidate); distance < 0 || d < distance {
+					distance = d
+				}
+			}
+			triedCandidates[normCandidate] = struct{}{}
 		}
-		if levenshtein.ComputeDistance(normTarget, normCandidate) <= int(threshold) {
-			return true
-		}
-		triedCandidates[normCandidate] = struct{}{}
 	}
-	return false
+	if distance < 0 {
+		return math.MaxUint
+	}
+	return uint(distance)
+}
+
+// normalizedForms returns the normalized title, followed by its normalized romanization if it has non-Latin letters.
+func normalizedForms(title string) []string {
+	forms := []string{regex.NormalizeString(title)}
+	if romanize.HasNonLatinLetters(title) {
+		forms = append(forms, regex.NormalizeString(romanize.Romanize(title)))
+	}
+	return forms
 }
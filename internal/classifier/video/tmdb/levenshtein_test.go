@@ -0,0 +1,26 @@
+package tmdb
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func TestLevenshteinCheck(t *testing.T) {
+	t.Parallel()
+
+	candidates := []string{"Moscow Does Not Believe in Tears", "Москва слезам не верит"}
+	assert.True(t, levenshteinCheck("Москва слезам не верит", candidates, 5))
+	assert.True(t, levenshteinCheck("Moscow Does Not Believe in Tears", candidates, 5))
+	assert.True(t, levenshteinCheck("Moskva slezam ne verit", candidates, 5), "a transliterated title matches the original title")
+	assert.True(t, levenshteinCheck("Брат 2", []string{"Brother 2", "Brat 2"}, 0), "a title in Cyrillic matches a transliterated title")
+	assert.False(t, levenshteinCheck("Moskva", candidates, 5))
+}
+
+func TestLevenshteinDistance(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, uint(0), levenshteinDistance("The Matrix", []string{"Matrix", "The Matrix"}))
+	assert.Equal(t, uint(1), levenshteinDistance("The Matrx", []string{"The Matrix Reloaded", "The Matrix"}), "the closest candidate is used")
+	assert.Equal(t, uint(math.MaxUint), levenshteinDistance("The Matrix", nil))
+}
@@ -8,9 +8,14 @@ import (
 	"github.com/bitmagnet-io/bitmagnet/internal/database/query"
 	"github.com/bitmagnet-io/bitmagnet/internal/database/search"
 	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/telemetry/tracing"
 	tmdb "github.com/cyruzin/golang-tmdb"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 )
 
 type MovieClient interface {
@@ -23,19 +28,33 @@ type SearchMovieParams struct {
 	Year                 model.Year
 	IncludeAdult         bool
 	LevenshteinThreshold uint
+	// YearTolerance is the number of years that the release year of a match may differ from Year, as release dates
+	// differ between countries and re-releases; other years are only searched if there's no match of the exact year
+	YearTolerance uint
+	// Runtime is an estimate of the runtime used to choose between candidates with the same title and year; zero if unknown
+	Runtime time.Duration
 }
 
-func (c *client) SearchMovie(ctx context.Context, p SearchMovieParams) (movie model.Content, err error) {
-	if localResult, localErr := c.searchMovieLocal(ctx, p); localErr == nil {
-		return localResult, nil
-	} else if !errors.Is(localErr, classifier.ErrNoMatch) {
-		err = localErr
-		return
+// SearchMovie searches locally and then on TMDB for a movie of the exact year, and only if neither has a match, for a
+// movie of a year within the tolerance, so that a match of a nearby year never takes precedence over the exact year.
+func (c *client) SearchMovie(ctx context.Context, p SearchMovieParams) (model.Content, error) {
+	movie, err := c.searchMovie(ctx, p, false)
+	if errors.Is(err, classifier.ErrNoMatch) && !p.Year.IsNil() && p.YearTolerance > 0 {
+		movie, err = c.searchMovie(ctx, p, true)
 	}
-	return c.searchMovieTmdb(ctx, p)
+	return movie, err
 }
 
-func (c *client) searchMovieLocal(ctx context.Context, p SearchMovieParams) (movie model.Content, err error) {
+func (c *client) searchMovie(ctx context.Context, p SearchMovieParams, nearbyYears bool) (model.Content, error) {
+	if localResult, localErr := c.searchMovieLocal(ctx, p, nearbyYears); !errors.Is(localErr, classifier.ErrNoMatch) {
+		return localResult, localErr
+	}
+	return c.searchMovieTmdb(ctx, p, nearbyYears)
+}
+
+// searchMovieLocal searches the database for a movie of the exact year, or if nearbyYears is true, for a movie of a
+// year within the tolerance.
+func (c *client) searchMovieLocal(ctx context.Context, p SearchMovieParams, nearbyYears bool) (movie model.Content, err error) {
 	options := []query.Option{
 		query.Where(search.ContentTypeCriteria(model.ContentTypeMovie, model.ContentTypeXxx)),
 		query.QueryString(fmt.Sprintf("\"%s\"", p.Title)),
@@ -45,7 +64,11 @@ func (c *client) searchMovieLocal(ctx context.Context, p SearchMovieParams) (mov
 		search.ContentDefaultHydrate(),
 	}
 	if !p.Year.IsNil() {
-		options = append(options, query.Where(search.ContentReleaseDateCriteria(model.NewDateRangeFromYear(p.Year))))
+		var tolerance uint
+		if nearbyYears {
+			tolerance = p.YearTolerance
+		}
+		options = append(options, query.Where(search.ContentReleaseDateCriteria(yearDateRange(p.Year, tolerance))))
 	}
 	result, searchErr := c.s.Content(
 		ctx,
@@ -55,40 +78,138 @@ func (c *client) searchMovieLocal(ctx context.Context, p SearchMovieParams) (mov
 		err = searchErr
 		return
 	}
+	var matches []model.Content
+	lookup := model.ClassificationTraceLookup{Source: "local", Query: p.Title, Year: p.Year}
 	for _, item := range result.Items {
-		candidates := []string{item.Title}
-		if item.OriginalTitle.Valid {
-			candidates = append(candidates, item.OriginalTitle.String)
-		}
-		if levenshteinCheck(p.Title, candidates, p.LevenshteinThreshold) {
-			return item.Content, nil
+		if distance := levenshteinDistance(p.Title, titleCandidates(item.Content)); distance <= p.LevenshteinThreshold {
+			matches = append(matches, item.Content)
+			lookup.Candidates = append(lookup.Candidates, traceContentCandidate(item.Content, distance, ""))
+		} else {
+			lookup.Candidates = append(lookup.Candidates, traceContentCandidate(item.Content, distance, rejectedTitle))
 		}
 	}
-	err = classifier.ErrNoMatch
-	return
+	classifier.TraceLookup(ctx, lookup)
+	if len(matches) == 0 {
+		err = classifier.ErrNoMatch
+		return
+	}
+	if !p.Year.IsNil() {
+		sortByYearDistance(matches, p.Year)
+	}
+	return disambiguateContent(matches, p.Runtime), nil
 }
 
-func (c *client) searchMovieTmdb(ctx context.Context, p SearchMovieParams) (model.Content, error) {
+// searchMovieTmdb searches TMDB for a movie of the exact year, or if nearbyYears is true, for a movie of a year within
+// the tolerance, ordered by the distance of its year.
+func (c *client) searchMovieTmdb(ctx context.Context, p SearchMovieParams, nearbyYears bool) (model.Content, error) {
+	exactYear := !nearbyYears
 	urlOptions := make(map[string]string)
-	if !p.Year.IsNil() {
+	if !p.Year.IsNil() && exactYear {
 		urlOptions["year"] = strconv.Itoa(int(p.Year))
 	}
 	if p.IncludeAdult {
 		urlOptions["include_adult"] = "true"
 	}
-	searchResult, searchErr := c.c.GetSearchMovies(
-		p.Title,
-		urlOptions,
-	)
+	searchMovies := func() (*tmdb.SearchMovies, error) {
+		_, span := tracer.Start(ctx, "tmdb.search_movie")
+		searchResult, searchErr := c.c.GetSearchMovies(
+			p.Title,
+			urlOptions,
+		)
+		tracing.End(span, searchErr)
+		return searchResult, searchErr
+	}
+	var searchResult *tmdb.SearchMovies
+	var searchErr error
+	if exactYear {
+		searchResult, searchErr = searchMovies()
+	} else {
+		// TMDB only searches by an exact year, so movies of nearby years are found by a search without one, which is
+		// shared by the lookups of a title with different years in a batch
+		searchResult, searchErr = classifier.BatchLookup(
+			ctx,
+			"tmdb:search_movie:"+strings.Join(strings.Fields(strings.ToLower(p.Title)), " ")+":"+strconv.FormatBool(p.IncludeAdult),
+			searchMovies,
+		)
+	}
 	if searchErr != nil {
 		return model.Content{}, searchErr
 	}
-	for _, item := range searchResult.Results {
-		if levenshteinCheck(p.Title, []string{item.Title, item.OriginalTitle}, p.LevenshteinThreshold) {
-			return c.GetMovieByExternalId(ctx, SourceTmdb, strconv.Itoa(int(item.ID)))
+	lookup := model.ClassificationTraceLookup{Source: "tmdb", Query: p.Title}
+	if exactYear {
+		lookup.Year = p.Year
+	}
+	defer func() {
+		classifier.TraceLookup(ctx, lookup)
+	}()
+	results := searchResult.Results
+	if !p.Year.IsNil() && !exactYear {
+		results = results[:0:0]
+		for _, item := range searchResult.Results {
+			if year := releaseYearFromDate(item.ReleaseDate); !year.IsNil() && yearDistance(year, p.Year) <= p.YearTolerance {
+				results = append(results, item)
+			} else {
+				distance := levenshteinDistance(p.Title, []string{item.Title, item.OriginalTitle})
+				lookup.Candidates = append(lookup.Candidates, traceTmdbCandidate(item.ID, item.Title, year, distance, rejectedYear))
+			}
+		}
+		sort.SliceStable(results, func(i, j int) bool {
+			return yearDistance(releaseYearFromDate(results[i].ReleaseDate), p.Year) <
+				yearDistance(releaseYearFromDate(results[j].ReleaseDate), p.Year)
+		})
+	}
+	var matchIds []int64
+	var firstKey string
+	for _, item := range results {
+		year := releaseYearFromDate(item.ReleaseDate)
+		distance := levenshteinDistance(p.Title, []string{item.Title, item.OriginalTitle})
+		if distance > p.LevenshteinThreshold {
+			lookup.Candidates = append(lookup.Candidates, traceTmdbCandidate(item.ID, item.Title, year, distance, rejectedTitle))
+			continue
 		}
+		key := tieKey(item.Title, year)
+		if len(matchIds) == 0 {
+			firstKey = key
+		} else if p.Runtime <= 0 || key != firstKey || len(matchIds) >= maxRuntimeCandidates {
+			lookup.Candidates = append(lookup.Candidates, traceTmdbCandidate(item.ID, item.Title, year, distance, rejectedOrder))
+			continue
+		}
+		lookup.Candidates = append(lookup.Candidates, traceTmdbCandidate(item.ID, item.Title, year, distance, ""))
+		matchIds = append(matchIds, item.ID)
+	}
+	if len(matchIds) == 0 {
+		return model.Content{}, classifier.ErrNoMatch
+	}
+	if len(matchIds) == 1 {
+		return c.GetMovieByExternalId(ctx, SourceTmdb, strconv.Itoa(int(matchIds[0])))
+	}
+	// the search results don't include runtimes, so the details of each tied candidate are needed
+	candidates := make([]model.Content, 0, len(matchIds))
+	for _, id := range matchIds {
+		movie, err := c.GetMovieByExternalId(ctx, SourceTmdb, strconv.Itoa(int(id)))
+		if err != nil {
+			if errors.Is(err, classifier.ErrNoMatch) {
+				continue
+			}
+			return model.Content{}, err
+		}
+		candidates = append(candidates, movie)
+	}
+	if len(candidates) == 0 {
+		return model.Content{}, classifier.ErrNoMatch
+	}
+	return disambiguateContent(candidates, p.Runtime), nil
+}
+
+func releaseYearFromDate(date string) model.Year {
+	if len(date) < 4 {
+		return 0
+	}
+	year, err := strconv.Atoi(date[:4])
+	if err != nil {
+		return 0
 	}
-	return model.Content{}, classifier.ErrNoMatch
+	return model.Year(year)
 }
 
 func (c *client) GetMovieByExternalId(ctx context.Context, source, id string) (model.Content, error) {
@@ -142,9 +263,11 @@ func (c *client) GetMovieByExternalId(ctx context.Context, source, id string) (m
 	if externalSourceErr != nil {
 		return model.Content{}, externalSourceErr
 	}
+	_, span := tracer.Start(ctx, "tmdb.find", trace.WithAttributes(attribute.String("external_source", externalSource)))
 	byIdResult, byIdErr := c.c.GetFindByID(externalId, map[string]string{
 		"external_source": externalSource,
 	})
+	tracing.End(span, byIdErr)
 	if byIdErr != nil {
 		return model.Content{}, byIdErr
 	}
@@ -172,7 +295,9 @@ func getExternalSource(source string, id string) (externalSource string, externa
 }
 
 func (c *client) getMovieByTmbdId(ctx context.Context, id int) (movie model.Content, err error) {
-	d, getDetailsErr := c.c.GetMovieDetails(id, map[string]string{})
+	_, span := tracer.Start(ctx, "tmdb.movie_details", trace.WithAttributes(attribute.Int("tmdb_id", id)))
+	d, getDetailsErr := c.c.GetMovieDetails(id, c.detailsUrlOptions(c.alternativeTitlesAppendToResponse()...))
+	tracing.End(span, getDetailsErr)
 	if getDetailsErr != nil {
 		// a hacky workaround for TMDB returning 404 for some (correct) movie IDs
 		// e.g. there's some issue with tt15168124 which points to 878564 when the correct ID is 888491
@@ -235,6 +360,7 @@ func MovieDetailsToMovieModel(details tmdb.MovieDetails) (movie model.Content, e
 			Value:  details.BackdropPath,
 		})
 	}
+	attributes = append(attributes, alternativeTitleAttributes(movieAlternativeTitles(details), details.Title, details.OriginalTitle)...)
 	releaseYear := releaseDate.Year
 
 	typeVideo := model.ContentTypeMovie
@@ -266,5 +392,6 @@ func MovieDetailsToMovieModel(details tmdb.MovieDetails) (movie model.Content, e
 		VoteCount:   model.NewNullUint(uint(details.VoteCount)),
 		Collections: collections,
 		Attributes:  attributes,
+		People:      creditsToPeople(movieCredits(details)),
 	}, nil
 }
@@ -0,0 +1,98 @@
+package tmdb
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/classifier"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/query"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/search"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	tmdb "github.com/cyruzin/golang-tmdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// emptySearch finds no local content, recording its searches.
+type emptySearch struct {
+	search.Search
+	calls *[]string
+}
+
+func (s emptySearch) Content(context.Context, ...query.Option) (search.ContentResult, error) {
+	*s.calls = append(*s.calls, "local")
+	return search.ContentResult{}, nil
+}
+
+// tmdbTransport serves TMDB responses by path, recording the requests made.
+type tmdbTransport struct {
+	mutex     sync.Mutex
+	calls     *[]string
+	responses map[string]string
+}
+
+func (t *tmdbTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	call := req.URL.Path
+	if year := req.URL.Query().Get("year"); year != "" {
+		call += "?year=" + year
+	}
+	*t.calls = append(*t.calls, call)
+	body, ok := t.responses[call]
+	if !ok {
+		body = `{"page":1,"results":[]}`
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}, nil
+}
+
+func TestSearchMovie_exactYearFirst(t *testing.T) {
+	t.Parallel()
+
+	var calls []string
+	tmdbClient, err := tmdb.Init("key")
+	require.NoError(t, err)
+	tmdbClient.SetClientConfig(http.Client{Transport: &tmdbTransport{
+		calls: &calls,
+		responses: map[string]string{
+			"/3/search/movie": `{"page":1,"results":[{"id":1,"title":"The Movie","release_date":"2021-03-01"}]}`,
+			"/3/movie/1":      `{"id":1,"title":"The Movie","release_date":"2021-03-01"}`,
+		},
+	}})
+	c := &client{c: tmdbClient, s: emptySearch{calls: &calls}}
+	ctx := classifier.WithBatch(context.Background())
+	params := SearchMovieParams{Title: "The Movie", Year: 2020, LevenshteinThreshold: 5, YearTolerance: 1}
+
+	movie, err := c.SearchMovie(ctx, params)
+	require.NoError(t, err)
+	assert.Equal(t, model.Year(2021), movie.ReleaseYear)
+	assert.Equal(t, []string{
+		"local",
+		"/3/search/movie?year=2020",
+		"local",
+		"/3/search/movie",
+		"local",
+		"/3/movie/1",
+	}, calls, "nearby years are only searched without a match of the exact year")
+
+	// the search without a year is shared by lookups of other years in the batch
+	calls = nil
+	params.Year = 2022
+	_, err = c.SearchMovie(ctx, params)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"local",
+		"/3/search/movie?year=2022",
+		"local",
+		"local",
+		"/3/movie/1",
+	}, calls)
+}
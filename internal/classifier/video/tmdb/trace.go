@@ -0,0 +1,34 @@
+package tmdb
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"strconv"
+)
+
+// reasons that candidates of a traced lookup are rejected
+const (
+	rejectedTitle = "title too distant"
+	rejectedYear  = "release year outside tolerance"
+	rejectedOrder = "preceded by a better match"
+)
+
+// traceCandidate returns a candidate of a traced lookup, which is rejected for the reason given unless it's empty.
+func traceCandidate(source, id, title string, year model.Year, distance uint, reason string) model.ClassificationTraceCandidate {
+	return model.ClassificationTraceCandidate{
+		Source:   source,
+		ID:       id,
+		Title:    title,
+		Year:     year,
+		Distance: distance,
+		Matched:  reason == "",
+		Reason:   model.NullString{String: reason, Valid: reason != ""},
+	}
+}
+
+func traceContentCandidate(content model.Content, distance uint, reason string) model.ClassificationTraceCandidate {
+	return traceCandidate(content.Source, content.ID, content.Title, content.ReleaseYear, distance, reason)
+}
+
+func traceTmdbCandidate(id int64, title string, year model.Year, distance uint, reason string) model.ClassificationTraceCandidate {
+	return traceCandidate(SourceTmdb, strconv.Itoa(int(id)), title, year, distance, reason)
+}
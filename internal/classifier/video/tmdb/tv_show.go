@@ -8,7 +8,10 @@ import (
 	"github.com/bitmagnet-io/bitmagnet/internal/database/query"
 	"github.com/bitmagnet-io/bitmagnet/internal/database/search"
 	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/telemetry/tracing"
 	tmdb "github.com/cyruzin/golang-tmdb"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"strconv"
 )
 
@@ -54,14 +57,17 @@ func (c *client) searchTvShowLocal(ctx context.Context, p SearchTvShowParams) (t
 		err = searchErr
 		return
 	}
+	lookup := model.ClassificationTraceLookup{Source: "local", Query: p.Name, Year: p.FirstAirDateYear}
+	defer func() {
+		classifier.TraceLookup(ctx, lookup)
+	}()
 	for _, item := range result.Items {
-		candidates := []string{item.Title}
-		if item.OriginalTitle.Valid {
-			candidates = append(candidates, item.OriginalTitle.String)
-		}
-		if levenshteinCheck(p.Name, candidates, p.LevenshteinThreshold) {
+		distance := levenshteinDistance(p.Name, titleCandidates(item.Content))
+		if distance <= p.LevenshteinThreshold {
+			lookup.Candidates = append(lookup.Candidates, traceContentCandidate(item.Content, distance, ""))
 			return item.Content, nil
 		}
+		lookup.Candidates = append(lookup.Candidates, traceContentCandidate(item.Content, distance, rejectedTitle))
 	}
 	err = classifier.ErrNoMatch
 	return
@@ -75,19 +81,28 @@ func (c *client) searchTvShowTmdb(ctx context.Context, p SearchTvShowParams) (tv
 	if p.IncludeAdult {
 		urlOptions["include_adult"] = "true"
 	}
+	_, span := tracer.Start(ctx, "tmdb.search_tv_show")
 	searchResult, searchErr := c.c.GetSearchTVShow(
 		p.Name,
 		urlOptions,
 	)
+	tracing.End(span, searchErr)
 	if searchErr != nil {
 		err = searchErr
 		return
 	}
+	lookup := model.ClassificationTraceLookup{Source: "tmdb", Query: p.Name, Year: p.FirstAirDateYear}
 	for _, item := range searchResult.Results {
-		if levenshteinCheck(p.Name, []string{item.Name, item.OriginalName}, p.LevenshteinThreshold) {
+		year := releaseYearFromDate(item.FirstAirDate)
+		distance := levenshteinDistance(p.Name, []string{item.Name, item.OriginalName})
+		if distance <= p.LevenshteinThreshold {
+			lookup.Candidates = append(lookup.Candidates, traceTmdbCandidate(item.ID, item.Name, year, distance, ""))
+			classifier.TraceLookup(ctx, lookup)
 			return c.GetTvShowByExternalId(ctx, SourceTmdb, strconv.Itoa(int(item.ID)))
 		}
+		lookup.Candidates = append(lookup.Candidates, traceTmdbCandidate(item.ID, item.Name, year, distance, rejectedTitle))
 	}
+	classifier.TraceLookup(ctx, lookup)
 	err = classifier.ErrNoMatch
 	return
 }
@@ -140,9 +155,11 @@ func (c *client) GetTvShowByExternalId(ctx context.Context, source, id string) (
 		err = externalSourceErr
 		return
 	}
+	_, span := tracer.Start(ctx, "tmdb.find", trace.WithAttributes(attribute.String("external_source", externalSource)))
 	byIdResult, byIdErr := c.c.GetFindByID(externalId, map[string]string{
 		"external_source": externalSource,
 	})
+	tracing.End(span, byIdErr)
 	if byIdErr != nil {
 		err = byIdErr
 		return
@@ -155,9 +172,9 @@ func (c *client) GetTvShowByExternalId(ctx context.Context, source, id string) (
 }
 
 func (c *client) getTvShowByTmdbId(ctx context.Context, id int) (tvShow model.Content, err error) {
-	d, getDetailsErr := c.c.GetTVDetails(id, map[string]string{
-		"append_to_response": "external_ids",
-	})
+	_, span := tracer.Start(ctx, "tmdb.tv_show_details", trace.WithAttributes(attribute.Int("tmdb_id", id)))
+	d, getDetailsErr := c.c.GetTVDetails(id, c.detailsUrlOptions(append([]string{"external_ids"}, c.alternativeTitlesAppendToResponse()...)...))
+	tracing.End(span, getDetailsErr)
 	if getDetailsErr != nil {
 		err = getDetailsErr
 		return
@@ -214,6 +231,7 @@ func TvShowDetailsToTvShowModel(details tmdb.TVDetails) (movie model.Content, er
 			Value:  details.BackdropPath,
 		})
 	}
+	attributes = append(attributes, alternativeTitleAttributes(tvShowAlternativeTitles(details), details.Name, details.OriginalName)...)
 	return model.Content{
 		Type:             model.ContentTypeTvShow,
 		Source:           SourceTmdb,
@@ -232,5 +250,6 @@ func TvShowDetailsToTvShowModel(details tmdb.TVDetails) (movie model.Content, er
 		VoteCount:   model.NewNullUint(uint(details.VoteCount)),
 		Collections: collections,
 		Attributes:  attributes,
+		People:      creditsToPeople(tvShowCredits(details)),
 	}, nil
 }
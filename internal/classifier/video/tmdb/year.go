@@ -0,0 +1,34 @@
+package tmdb
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"sort"
+)
+
+// yearDistance returns the number of years between two years.
+func yearDistance(a, b model.Year) uint {
+	if a > b {
+		return uint(a - b)
+	}
+	return uint(b - a)
+}
+
+// yearDateRange returns the range of dates within the given number of years of a year.
+func yearDateRange(year model.Year, tolerance uint) model.DateRange {
+	start := model.Year(1)
+	if uint(year) > tolerance {
+		start = year - model.Year(tolerance)
+	}
+	return model.NewDateRangeFromDates(
+		model.NewDateRangeFromYear(start).Start(),
+		model.NewDateRangeFromYear(year+model.Year(tolerance)).End(),
+	)
+}
+
+// sortByYearDistance orders content by the distance of its release year from a year, keeping the order of content
+// released equally far from it, so that a match of the exact year is preferred.
+func sortByYearDistance(contents []model.Content, year model.Year) {
+	sort.SliceStable(contents, func(i, j int) bool {
+		return yearDistance(contents[i].ReleaseYear, year) < yearDistance(contents[j].ReleaseYear, year)
+	})
+}
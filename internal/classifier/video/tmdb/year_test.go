@@ -0,0 +1,36 @@
+package tmdb
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestYearDateRange(t *testing.T) {
+	t.Parallel()
+
+	r := yearDateRange(2000, 1)
+	assert.Equal(t, "1999-01-01", r.Start().IsoDateString())
+	assert.Equal(t, "2001-12-31", r.End().IsoDateString())
+
+	r = yearDateRange(2000, 0)
+	assert.Equal(t, "2000-01-01", r.Start().IsoDateString())
+	assert.Equal(t, "2000-12-31", r.End().IsoDateString())
+}
+
+func TestSortByYearDistance(t *testing.T) {
+	t.Parallel()
+
+	contents := []model.Content{
+		{ID: "1", ReleaseYear: 1999},
+		{ID: "2", ReleaseYear: 2001},
+		{ID: "3", ReleaseYear: 2000},
+		{ID: "4", ReleaseYear: 2000},
+	}
+	sortByYearDistance(contents, 2000)
+	ids := make([]string, 0, len(contents))
+	for _, c := range contents {
+		ids = append(ids, c.ID)
+	}
+	assert.Equal(t, []string{"3", "4", "1", "2"}, ids)
+}
@@ -0,0 +1,54 @@
+package video
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/classifier"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+)
+
+// parsedTokens returns what was parsed from a release name for the classification trace, formatted as kind:value.
+func parsedTokens(ct model.ContentType, title string, year model.Year, attrs classifier.ContentAttributes) []string {
+	tokens := []string{"content_type:" + ct.String(), "title:" + title}
+	if !year.IsNil() {
+		tokens = append(tokens, "year:"+year.String())
+	}
+	if len(attrs.Episodes) > 0 {
+		tokens = append(tokens, "episodes:"+attrs.Episodes.String())
+	}
+	if !attrs.AirDate.IsNil() {
+		tokens = append(tokens, "air_date:"+attrs.AirDate.IsoDateString())
+	}
+	for _, lang := range attrs.Languages.Slice() {
+		tokens = append(tokens, "language:"+lang.String())
+	}
+	if attrs.LanguageMulti {
+		tokens = append(tokens, "language:multi")
+	}
+	for _, lang := range attrs.Subtitles.Slice() {
+		tokens = append(tokens, "subtitles:"+lang.String())
+	}
+	if attrs.VideoResolution.Valid {
+		tokens = append(tokens, "video_resolution:"+attrs.VideoResolution.VideoResolution.String())
+	}
+	if attrs.VideoSource.Valid {
+		tokens = append(tokens, "video_source:"+attrs.VideoSource.VideoSource.String())
+	}
+	if attrs.VideoCodec.Valid {
+		tokens = append(tokens, "video_codec:"+attrs.VideoCodec.VideoCodec.String())
+	}
+	if attrs.Video3d.Valid {
+		tokens = append(tokens, "video_3d:"+attrs.Video3d.Video3d.String())
+	}
+	if attrs.VideoModifier.Valid {
+		tokens = append(tokens, "video_modifier:"+attrs.VideoModifier.VideoModifier.String())
+	}
+	for _, f := range attrs.HdrFormats {
+		tokens = append(tokens, "hdr_format:"+f.String())
+	}
+	for _, f := range attrs.AudioFormats {
+		tokens = append(tokens, "audio_format:"+f.String())
+	}
+	if attrs.ReleaseGroup.Valid {
+		tokens = append(tokens, "release_group:"+attrs.ReleaseGroup.String)
+	}
+	return append(tokens, attrs.ReleaseTokens.Strings()...)
+}
@@ -11,6 +11,7 @@ func New() fx.Option {
 	return fx.Module(
 		"movie",
 		configfx.NewConfigModule[tmdb.Config]("tmdb", tmdb.NewDefaultConfig()),
+		configfx.NewConfigModule[video.Config]("video_classifier", video.NewDefaultConfig()),
 		fx.Provide(
 			tmdb.New,
 			video.New,
@@ -0,0 +1,73 @@
+package concurrency
+
+import (
+	"context"
+	"sync"
+)
+
+// AdjustableLimiter limits the number of concurrent holders to a limit that can be changed while in use.
+// Lowering the limit doesn't interrupt existing holders; new holders wait until enough have released.
+type AdjustableLimiter interface {
+	Acquire(ctx context.Context) error
+	Release()
+	Limit() int
+	SetLimit(limit int)
+}
+
+type adjustableLimiter struct {
+	mutex  sync.Mutex
+	limit  int
+	active int
+	// changed is closed and replaced whenever a waiting holder may be able to acquire
+	changed chan struct{}
+}
+
+func NewAdjustableLimiter(limit int) AdjustableLimiter {
+	return &adjustableLimiter{
+		limit:   max(limit, 1),
+		changed: make(chan struct{}),
+	}
+}
+
+func (l *adjustableLimiter) Acquire(ctx context.Context) error {
+	for {
+		l.mutex.Lock()
+		if l.active < l.limit {
+			l.active++
+			l.mutex.Unlock()
+			return nil
+		}
+		changed := l.changed
+		l.mutex.Unlock()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-changed:
+		}
+	}
+}
+
+func (l *adjustableLimiter) Release() {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.active--
+	l.notify()
+}
+
+func (l *adjustableLimiter) Limit() int {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.limit
+}
+
+func (l *adjustableLimiter) SetLimit(limit int) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.limit = max(limit, 1)
+	l.notify()
+}
+
+func (l *adjustableLimiter) notify() {
+	close(l.changed)
+	l.changed = make(chan struct{})
+}
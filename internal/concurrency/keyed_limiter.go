@@ -12,6 +12,9 @@ import (
 type KeyedLimiter interface {
 	Allow(key string) bool
 	Wait(ctx context.Context, key string) error
+	// ReserveAt returns a reservation of an event at the time for the key, which can be cancelled at the same time if
+	// the event won't happen.
+	ReserveAt(key string, t time.Time) *rate.Reservation
 }
 
 type keyedLimiter struct {
@@ -39,6 +42,10 @@ func (i *keyedLimiter) Wait(ctx context.Context, key string) error {
 	return i.getLimiter(key).Wait(ctx)
 }
 
+func (i *keyedLimiter) ReserveAt(key string, t time.Time) *rate.Reservation {
+	return i.getLimiter(key).ReserveN(t, 1)
+}
+
 func (i *keyedLimiter) getLimiter(key string) *rate.Limiter {
 	i.mu.Lock()
 	l, ok := i.lru.Get(key)
@@ -0,0 +1,20 @@
+package contentrefresh
+
+import "time"
+
+type Config struct {
+	// MaxAge is the age after which TMDB content is refreshed; zero disables refreshing.
+	MaxAge time.Duration `mapstructure:"max_age"`
+	// BatchSize is the maximum number of content items refreshed in each run, so that refreshing doesn't use up the TMDB
+	// rate limit needed for classifying new torrents.
+	BatchSize uint `mapstructure:"batch_size"`
+	// Interval is the time between runs of the refresher.
+	Interval time.Duration
+}
+
+func NewDefaultConfig() Config {
+	return Config{
+		BatchSize: 500,
+		Interval:  time.Hour,
+	}
+}
@@ -0,0 +1,17 @@
+package contentrefreshfx
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/config/configfx"
+	"github.com/bitmagnet-io/bitmagnet/internal/contentrefresh"
+	"go.uber.org/fx"
+)
+
+func New() fx.Option {
+	return fx.Module(
+		"content_refresh",
+		configfx.NewConfigModule[contentrefresh.Config]("content_refresh", contentrefresh.NewDefaultConfig()),
+		fx.Provide(
+			contentrefresh.New,
+		),
+	)
+}
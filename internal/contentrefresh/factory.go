@@ -0,0 +1,83 @@
+package contentrefresh
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/worker"
+	"github.com/bitmagnet-io/bitmagnet/internal/classifier/video/tmdb"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/taskrun"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+type Params struct {
+	fx.In
+	Config          Config
+	Dao             lazy.Lazy[*dao.Query]
+	TmdbClient      lazy.Lazy[tmdb.Client]
+	TaskRunRecorder lazy.Lazy[taskrun.Recorder]
+	Logger          *zap.SugaredLogger
+}
+
+type Result struct {
+	fx.Out
+	Refresher lazy.Lazy[Refresher]
+	Worker    worker.Worker `group:"workers"`
+}
+
+func New(p Params) Result {
+	lr := lazy.New(func() (Refresher, error) {
+		d, err := p.Dao.Get()
+		if err != nil {
+			return nil, err
+		}
+		c, err := p.TmdbClient.Get()
+		if err != nil {
+			return nil, err
+		}
+		return refresher{
+			maxAge:     p.Config.MaxAge,
+			dao:        d,
+			tmdbClient: c,
+			logger:     p.Logger.Named("content_refresh"),
+		}, nil
+	})
+	var s *scheduler
+	return Result{
+		Refresher: lr,
+		Worker: worker.NewWorker(
+			"content_refresh",
+			fx.Hook{
+				OnStart: func(context.Context) error {
+					if p.Config.MaxAge <= 0 {
+						return nil
+					}
+					r, err := lr.Get()
+					if err != nil {
+						return err
+					}
+					tr, err := p.TaskRunRecorder.Get()
+					if err != nil {
+						return err
+					}
+					s = &scheduler{
+						refresher:       r,
+						batchSize:       p.Config.BatchSize,
+						interval:        p.Config.Interval,
+						taskRunRecorder: tr,
+						stopped:         make(chan struct{}),
+					}
+					go s.start()
+					return nil
+				},
+				OnStop: func(context.Context) error {
+					if s != nil {
+						close(s.stopped)
+					}
+					return nil
+				},
+			},
+		),
+	}
+}
@@ -0,0 +1,78 @@
+package contentrefresh
+
+import (
+	"context"
+	"errors"
+	"github.com/bitmagnet-io/bitmagnet/internal/classifier"
+	"github.com/bitmagnet-io/bitmagnet/internal/classifier/video/tmdb"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+// Refresher re-fetches the details of TMDB content, such as the vote counts and collections, that haven't been
+// updated for longer than the configured age. The least recently updated content is refreshed first, so an
+// interrupted refresh resumes where it left off.
+type Refresher interface {
+	// Refresh refreshes up to limit stale content items, returning the number refreshed. Content that's no longer
+	// on TMDB is kept as it is, and isn't tried again until it's stale again.
+	Refresh(ctx context.Context, limit uint) (int, error)
+}
+
+type refresher struct {
+	maxAge     time.Duration
+	dao        *dao.Query
+	tmdbClient tmdb.Client
+	logger     *zap.SugaredLogger
+}
+
+func (r refresher) Refresh(ctx context.Context, limit uint) (int, error) {
+	c := r.dao.Content
+	stale, err := c.WithContext(ctx).Select(
+		c.Type, c.Source, c.ID,
+	).Where(
+		c.Source.Eq(tmdb.SourceTmdb),
+		c.Type.In(model.ContentTypeMovie.String(), model.ContentTypeXxx.String(), model.ContentTypeTvShow.String()),
+		c.UpdatedAt.Lt(time.Now().Add(-r.maxAge)),
+	).Order(
+		c.UpdatedAt,
+	).Limit(int(limit)).Find()
+	if err != nil {
+		return 0, err
+	}
+	refreshed := 0
+	for _, s := range stale {
+		if err := r.refresh(ctx, s.Ref()); err != nil {
+			return refreshed, err
+		}
+		refreshed++
+	}
+	return refreshed, nil
+}
+
+func (r refresher) refresh(ctx context.Context, ref model.ContentRef) error {
+	content, err := r.tmdbClient.FetchContent(ctx, ref.Type, ref.ID)
+	if err != nil {
+		if !errors.Is(err, classifier.ErrNoMatch) {
+			return err
+		}
+		r.logger.Debugw("content not found on TMDB", "ref", ref)
+		_, err := r.dao.Content.WithContext(ctx).Where(
+			r.dao.Content.Type.Eq(ref.Type.String()),
+			r.dao.Content.Source.Eq(ref.Source),
+			r.dao.Content.ID.Eq(ref.ID),
+		).Update(r.dao.Content.UpdatedAt, time.Now())
+		return err
+	}
+	// the type of a movie can change between movie and xxx with its adult flag, but the stored content is kept
+	content.Type = ref.Type
+	// full saving of associations updates changed attributes and collection names rather than only adding new ones
+	return r.dao.Content.WithContext(ctx).Session(
+		&gorm.Session{FullSaveAssociations: true},
+	).Clauses(
+		clause.OnConflict{UpdateAll: true},
+	).Create(&content)
+}
@@ -0,0 +1,35 @@
+package contentrefresh
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/taskrun"
+	"time"
+)
+
+// scheduler runs the refresher when it starts, and then at the configured interval.
+type scheduler struct {
+	refresher       Refresher
+	batchSize       uint
+	interval        time.Duration
+	taskRunRecorder taskrun.Recorder
+	stopped         chan struct{}
+}
+
+func (s *scheduler) start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		for {
+			run := s.taskRunRecorder.Start(ctx, taskrun.KindContentRefresh)
+			n, err := s.refresher.Refresh(ctx, s.batchSize)
+			run.Add(n)
+			run.Finish(err)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(s.interval):
+			}
+		}
+	}()
+	<-s.stopped
+}
@@ -0,0 +1,173 @@
+package coverage
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/query"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/search"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"slices"
+)
+
+const batchSize = 100
+
+// Item reports how well a single reference list entry is covered by the index.
+type Item struct {
+	Ref model.ContentRef
+	// Contents holds the indexed content items matching the reference; a reference without a type may match more than one.
+	Contents         []model.Content
+	TorrentCount     uint
+	BestResolution   model.NullVideoResolution
+	VideoResolutions []model.VideoResolution
+}
+
+func (i Item) Covered() bool {
+	return i.TorrentCount > 0
+}
+
+type Report struct {
+	Items []Item
+}
+
+func (r Report) CoveredCount() int {
+	n := 0
+	for _, i := range r.Items {
+		if i.Covered() {
+			n++
+		}
+	}
+	return n
+}
+
+type Reporter interface {
+	Report(ctx context.Context, refs []model.ContentRef) (Report, error)
+}
+
+func NewReporter(d *dao.Query, s search.Search) Reporter {
+	return reporter{dao: d, search: s}
+}
+
+type reporter struct {
+	dao    *dao.Query
+	search search.Search
+}
+
+func (r reporter) Report(ctx context.Context, refs []model.ContentRef) (Report, error) {
+	items := make([]Item, 0, len(refs))
+	for i := 0; i < len(refs); i += batchSize {
+		batchItems, err := r.reportBatch(ctx, refs[i:min(i+batchSize, len(refs))])
+		if err != nil {
+			return Report{}, err
+		}
+		items = append(items, batchItems...)
+	}
+	return Report{Items: items}, nil
+}
+
+func (r reporter) reportBatch(ctx context.Context, refs []model.ContentRef) ([]Item, error) {
+	result, err := r.search.Content(
+		ctx,
+		query.Where(search.ContentIdentifierCriteria(refs...)),
+		search.ContentDefaultPreload(),
+		// a typeless reference can match a movie and a TV show, so allow some headroom:
+		query.Limit(uint(len(refs)*2)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	stats, err := r.torrentStats(ctx, result.Items)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]Item, 0, len(refs))
+	for _, ref := range refs {
+		item := Item{Ref: ref}
+		for _, c := range result.Items {
+			if !matchesRef(c.Content, ref) {
+				continue
+			}
+			item.Contents = append(item.Contents, c.Content)
+			for _, s := range stats[c.Ref()] {
+				item.TorrentCount += s.Count
+				if s.VideoResolution.Valid && !slices.Contains(item.VideoResolutions, s.VideoResolution.VideoResolution) {
+					item.VideoResolutions = append(item.VideoResolutions, s.VideoResolution.VideoResolution)
+				}
+			}
+		}
+		slices.SortFunc(item.VideoResolutions, compareResolutions)
+		if len(item.VideoResolutions) > 0 {
+			item.BestResolution = model.NewNullVideoResolution(item.VideoResolutions[len(item.VideoResolutions)-1])
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+type torrentStat struct {
+	ContentType     model.ContentType
+	ContentSource   string
+	ContentID       string
+	VideoResolution model.NullVideoResolution
+	Count           uint
+}
+
+func (r reporter) torrentStats(ctx context.Context, contents []search.ContentResultItem) (map[model.ContentRef][]torrentStat, error) {
+	stats := make(map[model.ContentRef][]torrentStat)
+	if len(contents) == 0 {
+		return stats, nil
+	}
+	wanted := make(map[model.ContentRef]struct{}, len(contents))
+	sources := make([]string, 0)
+	ids := make([]string, 0, len(contents))
+	for _, c := range contents {
+		wanted[c.Ref()] = struct{}{}
+		if !slices.Contains(sources, c.Source) {
+			sources = append(sources, c.Source)
+		}
+		ids = append(ids, c.ID)
+	}
+	tc := r.dao.TorrentContent
+	var rows []torrentStat
+	if err := tc.WithContext(ctx).Select(
+		tc.ContentType,
+		tc.ContentSource,
+		tc.ContentID,
+		tc.VideoResolution,
+		tc.InfoHash.Count().As("count"),
+	).Where(
+		tc.ContentSource.In(sources...),
+		tc.ContentID.In(ids...),
+	).Group(
+		tc.ContentType,
+		tc.ContentSource,
+		tc.ContentID,
+		tc.VideoResolution,
+	).Scan(&rows); err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		ref := model.ContentRef{
+			Type:   row.ContentType,
+			Source: row.ContentSource,
+			ID:     row.ContentID,
+		}
+		// the source and ID conditions are applied independently, so discard any cross-matched rows:
+		if _, ok := wanted[ref]; ok {
+			stats[ref] = append(stats[ref], row)
+		}
+	}
+	return stats, nil
+}
+
+func matchesRef(c model.Content, ref model.ContentRef) bool {
+	if !ref.Type.IsNil() && ref.Type != c.Type {
+		return false
+	}
+	id, ok := c.Identifier(ref.Source)
+	return ok && id == ref.ID
+}
+
+func compareResolutions(a, b model.VideoResolution) int {
+	values := model.VideoResolutionValues()
+	return slices.Index(values, a) - slices.Index(values, b)
+}
@@ -0,0 +1,273 @@
+package backup
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+	goose "github.com/pressly/goose/v3"
+	"io"
+	"slices"
+	"strings"
+	"time"
+)
+
+var ErrSchemaVersionMismatch = errors.New("backup schema version doesn't match the database")
+
+type Options struct {
+	// Exclude lists tables that aren't backed up.
+	Exclude []string
+}
+
+type Archiver interface {
+	// Backup writes a consistent snapshot of the database to w, without blocking writes to it.
+	Backup(ctx context.Context, w io.Writer, options Options) (Header, error)
+	// Restore replaces the contents of the tables in a backup with the backed up rows, in a single transaction;
+	// tables that weren't backed up but reference the restored tables are emptied.
+	// The database must be migrated to the same schema version as the backed up database.
+	Restore(ctx context.Context, r io.Reader) (Header, error)
+}
+
+type archiver struct {
+	db *sql.DB
+}
+
+func (a archiver) Backup(ctx context.Context, w io.Writer, options Options) (Header, error) {
+	schemaVersion, err := goose.GetDBVersionContext(ctx, a.db)
+	if err != nil {
+		return Header{}, err
+	}
+	var header Header
+	err = a.withConn(ctx, func(conn *pgx.Conn) error {
+		tx, err := conn.BeginTx(ctx, pgx.TxOptions{
+			IsoLevel:   pgx.RepeatableRead,
+			AccessMode: pgx.ReadOnly,
+		})
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = tx.Rollback(ctx)
+		}()
+		tables, err := queryTables(ctx, tx, options.Exclude)
+		if err != nil {
+			return err
+		}
+		header = Header{
+			FormatVersion: FormatVersion,
+			SchemaVersion: schemaVersion,
+			CreatedAt:     time.Now(),
+			Tables:        tables,
+		}
+		gz := gzip.NewWriter(w)
+		if err := writeLine(gz, header); err != nil {
+			return err
+		}
+		for _, table := range tables {
+			columns, err := queryColumns(ctx, tx, table)
+			if err != nil {
+				return err
+			}
+			if err := writeLine(gz, sectionHeader{Table: table, Columns: columns}); err != nil {
+				return err
+			}
+			fw := frameWriter{gz}
+			// the select form can also copy partitioned tables
+			if _, err := conn.PgConn().CopyTo(
+				ctx,
+				fw,
+				"COPY (SELECT "+quoteIdents(columns)+" FROM "+quoteIdent(table)+") TO STDOUT",
+			); err != nil {
+				return fmt.Errorf("backing up %s: %w", table, err)
+			}
+			if err := fw.Close(); err != nil {
+				return err
+			}
+		}
+		if err := gz.Close(); err != nil {
+			return err
+		}
+		return tx.Commit(ctx)
+	})
+	return header, err
+}
+
+func (a archiver) Restore(ctx context.Context, r io.Reader) (Header, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return Header{}, fmt.Errorf("invalid backup: %w", err)
+	}
+	br := bufio.NewReader(gz)
+	var header Header
+	if err := readLine(br, &header); err != nil {
+		return Header{}, err
+	}
+	if header.FormatVersion != FormatVersion {
+		return header, fmt.Errorf("unsupported backup format version: %d", header.FormatVersion)
+	}
+	schemaVersion, err := goose.GetDBVersionContext(ctx, a.db)
+	if err != nil {
+		return header, err
+	}
+	if schemaVersion != header.SchemaVersion {
+		return header, fmt.Errorf(
+			"%w: backup is at version %d, database is at version %d",
+			ErrSchemaVersionMismatch,
+			header.SchemaVersion,
+			schemaVersion,
+		)
+	}
+	return header, a.withConn(ctx, func(conn *pgx.Conn) error {
+		tx, err := conn.Begin(ctx)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = tx.Rollback(ctx)
+		}()
+		if len(header.Tables) > 0 {
+			// tables excluded from the backup that reference the restored tables are also emptied
+			if _, err := tx.Exec(ctx, "TRUNCATE "+quoteIdents(header.Tables)+" CASCADE"); err != nil {
+				return err
+			}
+		}
+		for range header.Tables {
+			var section sectionHeader
+			if err := readLine(br, &section); err != nil {
+				return err
+			}
+			if !slices.Contains(header.Tables, section.Table) {
+				return fmt.Errorf("invalid backup: unexpected table %s", section.Table)
+			}
+			if _, err := conn.PgConn().CopyFrom(
+				ctx,
+				&frameReader{r: br},
+				"COPY "+quoteIdent(section.Table)+" ("+quoteIdents(section.Columns)+") FROM STDIN",
+			); err != nil {
+				return fmt.Errorf("restoring %s: %w", section.Table, err)
+			}
+			if err := resetSequences(ctx, tx, section.Table); err != nil {
+				return err
+			}
+		}
+		return tx.Commit(ctx)
+	})
+}
+
+func (a archiver) withConn(ctx context.Context, fn func(conn *pgx.Conn) error) error {
+	c, err := a.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = c.Close()
+	}()
+	return c.Raw(func(driverConn any) error {
+		sc, ok := driverConn.(*stdlib.Conn)
+		if !ok {
+			return fmt.Errorf("unsupported database driver connection: %T", driverConn)
+		}
+		return fn(sc.Conn())
+	})
+}
+
+func queryTables(ctx context.Context, tx pgx.Tx, exclude []string) ([]string, error) {
+	rows, err := tx.Query(
+		ctx,
+		"SELECT relname FROM pg_class"+
+			" WHERE relnamespace = current_schema()::regnamespace AND relkind IN ('r', 'p') AND NOT relispartition",
+	)
+	if err != nil {
+		return nil, err
+	}
+	names, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, err
+	}
+	tables := make([]string, 0, len(names))
+	for _, name := range names {
+		if !slices.Contains(exclude, name) && !slices.Contains(excludedTables, name) {
+			tables = append(tables, name)
+		}
+	}
+	rows, err = tx.Query(
+		ctx,
+		"SELECT t.relname, r.relname FROM pg_constraint c"+
+			" JOIN pg_class t ON t.oid = c.conrelid JOIN pg_class r ON r.oid = c.confrelid"+
+			" WHERE c.contype = 'f' AND c.connamespace = current_schema()::regnamespace",
+	)
+	if err != nil {
+		return nil, err
+	}
+	foreignKeys, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (foreignKey, error) {
+		var fk foreignKey
+		err := row.Scan(&fk.table, &fk.referenced)
+		return fk, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sortTables(tables, foreignKeys)
+}
+
+func queryColumns(ctx context.Context, tx pgx.Tx, table string) ([]string, error) {
+	rows, err := tx.Query(
+		ctx,
+		"SELECT attname FROM pg_attribute"+
+			" WHERE attrelid = to_regclass($1) AND attnum > 0 AND NOT attisdropped AND attgenerated = ''"+
+			" ORDER BY attnum",
+		quoteIdent(table),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowTo[string])
+}
+
+// resetSequences advances the sequences of a table's serial and identity columns past the restored values.
+func resetSequences(ctx context.Context, tx pgx.Tx, table string) error {
+	rows, err := tx.Query(
+		ctx,
+		"SELECT attname, pg_get_serial_sequence($1, attname) FROM pg_attribute"+
+			" WHERE attrelid = to_regclass($1) AND attnum > 0 AND NOT attisdropped"+
+			" AND pg_get_serial_sequence($1, attname) IS NOT NULL",
+		quoteIdent(table),
+	)
+	if err != nil {
+		return err
+	}
+	sequences, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) ([2]string, error) {
+		var s [2]string
+		err := row.Scan(&s[0], &s[1])
+		return s, err
+	})
+	if err != nil {
+		return err
+	}
+	for _, s := range sequences {
+		if _, err := tx.Exec(
+			ctx,
+			"SELECT setval($1, coalesce((SELECT max("+quoteIdent(s[0])+") FROM "+quoteIdent(table)+"), 0) + 1, false)",
+			s[1],
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func quoteIdent(name string) string {
+	return pgx.Identifier{name}.Sanitize()
+}
+
+func quoteIdents(names []string) string {
+	quoted := make([]string, 0, len(names))
+	for _, name := range names {
+		quoted = append(quoted, quoteIdent(name))
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,29 @@
+package backup
+
+import (
+	"database/sql"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"go.uber.org/fx"
+)
+
+type Params struct {
+	fx.In
+	DB lazy.Lazy[*sql.DB]
+}
+
+type Result struct {
+	fx.Out
+	Archiver lazy.Lazy[Archiver]
+}
+
+func New(p Params) Result {
+	return Result{
+		Archiver: lazy.New(func() (Archiver, error) {
+			db, err := p.DB.Get()
+			if err != nil {
+				return nil, err
+			}
+			return archiver{db: db}, nil
+		}),
+	}
+}
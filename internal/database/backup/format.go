@@ -0,0 +1,116 @@
+package backup
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// FormatVersion is the version of the backup file format.
+const FormatVersion = 1
+
+// A backup is a gzip compressed stream of a JSON header line, followed by a section for each table in the header.
+// Each section is a JSON line naming the table and its columns, followed by the table's rows in the COPY text format,
+// split into length prefixed frames and ended by an empty frame.
+
+type Header struct {
+	FormatVersion int       `json:"formatVersion"`
+	SchemaVersion int64     `json:"schemaVersion"`
+	CreatedAt     time.Time `json:"createdAt"`
+	// Tables are listed in the order they're restored in, with referenced tables before the tables referencing them.
+	Tables []string `json:"tables"`
+}
+
+type sectionHeader struct {
+	Table   string   `json:"table"`
+	Columns []string `json:"columns"`
+}
+
+func writeLine(w io.Writer, v interface{}) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(b, '\n'))
+	return err
+}
+
+func readLine(r *bufio.Reader, v interface{}) error {
+	line, err := r.ReadBytes('\n')
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	if err := json.Unmarshal(line, v); err != nil {
+		return fmt.Errorf("invalid backup: %w", err)
+	}
+	return nil
+}
+
+// frameWriter writes each non-empty write as a frame; Close writes the empty frame ending the section.
+type frameWriter struct {
+	w io.Writer
+}
+
+func (f frameWriter) Write(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	if err := f.writeFrameLength(len(p)); err != nil {
+		return 0, err
+	}
+	return f.w.Write(p)
+}
+
+func (f frameWriter) Close() error {
+	return f.writeFrameLength(0)
+}
+
+func (f frameWriter) writeFrameLength(n int) error {
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], uint32(n))
+	_, err := f.w.Write(b[:])
+	return err
+}
+
+// frameReader reads the frames of a section, returning io.EOF at the empty frame ending it.
+type frameReader struct {
+	r         io.Reader
+	remaining uint32
+	done      bool
+}
+
+func (f *frameReader) Read(p []byte) (int, error) {
+	if f.done {
+		return 0, io.EOF
+	}
+	if f.remaining == 0 {
+		var b [4]byte
+		if _, err := io.ReadFull(f.r, b[:]); err != nil {
+			if errors.Is(err, io.EOF) {
+				return 0, io.ErrUnexpectedEOF
+			}
+			return 0, err
+		}
+		f.remaining = binary.BigEndian.Uint32(b[:])
+		if f.remaining == 0 {
+			f.done = true
+			return 0, io.EOF
+		}
+	}
+	if uint32(len(p)) > f.remaining {
+		p = p[:f.remaining]
+	}
+	n, err := f.r.Read(p)
+	f.remaining -= uint32(n)
+	if errors.Is(err, io.EOF) {
+		err = io.ErrUnexpectedEOF
+	}
+	return n, err
+}
@@ -0,0 +1,66 @@
+package backup
+
+import (
+	"bufio"
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"testing"
+)
+
+func TestFrames(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	require.NoError(t, writeLine(&buf, sectionHeader{Table: "torrents", Columns: []string{"info_hash", "name"}}))
+	fw := frameWriter{&buf}
+	for _, chunk := range []string{"a\tb\n", "", "c\td\n"} {
+		_, err := fw.Write([]byte(chunk))
+		require.NoError(t, err)
+	}
+	require.NoError(t, fw.Close())
+	require.NoError(t, writeLine(&buf, sectionHeader{Table: "content"}))
+	require.NoError(t, frameWriter{&buf}.Close())
+
+	br := bufio.NewReader(&buf)
+	var section sectionHeader
+	require.NoError(t, readLine(br, &section))
+	assert.Equal(t, sectionHeader{Table: "torrents", Columns: []string{"info_hash", "name"}}, section)
+	data, err := io.ReadAll(&frameReader{r: br})
+	require.NoError(t, err)
+	assert.Equal(t, "a\tb\nc\td\n", string(data))
+	require.NoError(t, readLine(br, &section))
+	assert.Equal(t, "content", section.Table)
+	data, err = io.ReadAll(&frameReader{r: br})
+	require.NoError(t, err)
+	assert.Empty(t, data)
+	assert.ErrorIs(t, readLine(br, &section), io.ErrUnexpectedEOF)
+}
+
+func TestFramesTruncated(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	_, err := frameWriter{&buf}.Write([]byte("a\tb\n"))
+	require.NoError(t, err)
+	_, err = io.ReadAll(&frameReader{r: bytes.NewReader(buf.Bytes()[:buf.Len()-1])})
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
+
+func TestSortTables(t *testing.T) {
+	t.Parallel()
+	sorted, err := sortTables(
+		[]string{"torrent_contents", "torrents", "content", "torrent_files", "key_values"},
+		[]foreignKey{
+			{"torrent_contents", "torrents"},
+			{"torrent_contents", "content"},
+			{"torrent_files", "torrents"},
+			{"torrents", "torrents"},
+			{"torrent_hints", "torrents"},
+		},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"content", "key_values", "torrents", "torrent_contents", "torrent_files"}, sorted)
+
+	_, err = sortTables([]string{"a", "b"}, []foreignKey{{"a", "b"}, {"b", "a"}})
+	assert.Error(t, err)
+}
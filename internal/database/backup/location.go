@@ -0,0 +1,85 @@
+package backup
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// Create returns a writer for a backup at a location, which is either a file path or an HTTP(S) URL,
+// such as a presigned S3 upload URL. Uploads require the length of the backup up front,
+// so a backup to a URL is first written to a temporary file, and uploaded when the writer is closed.
+func Create(ctx context.Context, location string) (io.WriteCloser, error) {
+	if !isURL(location) {
+		return os.Create(location)
+	}
+	f, err := os.CreateTemp("", "bitmagnet-backup-*")
+	if err != nil {
+		return nil, err
+	}
+	return upload{ctx: ctx, url: location, File: f}, nil
+}
+
+// Open returns a reader for a backup at a location, which is either a file path or an HTTP(S) URL,
+// such as a presigned S3 download URL.
+func Open(ctx context.Context, location string) (io.ReadCloser, error) {
+	if !isURL(location) {
+		return os.Open(location)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, location, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		_ = res.Body.Close()
+		return nil, fmt.Errorf("downloading backup: unexpected status %s", res.Status)
+	}
+	return res.Body, nil
+}
+
+func isURL(location string) bool {
+	return strings.HasPrefix(location, "http://") || strings.HasPrefix(location, "https://")
+}
+
+type upload struct {
+	ctx context.Context
+	url string
+	*os.File
+}
+
+func (u upload) Close() error {
+	defer func() {
+		_ = u.File.Close()
+		_ = os.Remove(u.Name())
+	}()
+	info, err := u.Stat()
+	if err != nil {
+		return err
+	}
+	if _, err := u.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(u.ctx, http.MethodPut, u.url, io.NopCloser(u.File))
+	if err != nil {
+		return err
+	}
+	req.ContentLength = info.Size()
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("uploading backup: unexpected status %s", res.Status)
+	}
+	return nil
+}
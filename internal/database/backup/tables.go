@@ -0,0 +1,58 @@
+package backup
+
+import (
+	"fmt"
+	"slices"
+)
+
+// TransientTables hold bookkeeping that can be lost without affecting the index, such as the history of task runs
+// and webhook deliveries; they can be excluded from backups to make them smaller. The job queue itself is kept in Redis,
+// and is never part of a backup.
+var TransientTables = []string{
+	"metainfo_attempts",
+	"task_runs",
+	"webhook_deliveries",
+}
+
+// excludedTables are never backed up, as they're managed by the migrations.
+var excludedTables = []string{
+	"goose_db_version",
+}
+
+type foreignKey struct {
+	table      string
+	referenced string
+}
+
+// sortTables orders tables so that each table comes after the tables it references, and otherwise alphabetically.
+func sortTables(tables []string, foreignKeys []foreignKey) ([]string, error) {
+	remaining := slices.Clone(tables)
+	slices.Sort(remaining)
+	dependencies := make(map[string]map[string]struct{}, len(tables))
+	for _, fk := range foreignKeys {
+		if fk.table == fk.referenced || !slices.Contains(tables, fk.table) || !slices.Contains(tables, fk.referenced) {
+			continue
+		}
+		if dependencies[fk.table] == nil {
+			dependencies[fk.table] = make(map[string]struct{})
+		}
+		dependencies[fk.table][fk.referenced] = struct{}{}
+	}
+	sorted := make([]string, 0, len(tables))
+	for len(remaining) > 0 {
+		i := slices.IndexFunc(remaining, func(table string) bool {
+			for dependency := range dependencies[table] {
+				if !slices.Contains(sorted, dependency) {
+					return false
+				}
+			}
+			return true
+		})
+		if i < 0 {
+			return nil, fmt.Errorf("circular foreign keys between tables: %v", remaining)
+		}
+		sorted = append(sorted, remaining[i])
+		remaining = slices.Delete(remaining, i, i+1)
+	}
+	return sorted, nil
+}
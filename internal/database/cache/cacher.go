@@ -0,0 +1,90 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	caches "github.com/mgdigital/gorm-cache/v2"
+	"github.com/prometheus/client_golang/prometheus"
+	redis "github.com/redis/go-redis/v9"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+type Params struct {
+	fx.In
+	Config Config
+	Redis  lazy.Lazy[*redis.Client]
+	Logger *zap.SugaredLogger
+}
+
+type Result struct {
+	fx.Out
+	Cacher      caches.Cacher
+	HitsTotal   prometheus.Collector `group:"prometheus_collectors"`
+	MissesTotal prometheus.Collector `group:"prometheus_collectors"`
+}
+
+// New creates the cacher of the configured backend.
+func New(p Params) (Result, error) {
+	m := metrics{
+		hitsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "bitmagnet",
+			Subsystem: "gorm_cache",
+			Name:      "hits_total",
+			Help:      "A counter of cached queries satisfied from the cache, by whether the entry was stored by the search warmer.",
+		}, []string{"warmed"}),
+		missesTotal: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: "bitmagnet",
+			Subsystem: "gorm_cache",
+			Name:      "misses_total",
+			Help:      "A counter of cached queries not found in the cache.",
+		}),
+	}
+	logger := p.Logger.Named("gorm_cache")
+	var cacher caches.Cacher
+	switch p.Config.Backend {
+	case BackendMemory:
+		cacher = newInMemoryCacher(p.Config, m, logger)
+	case BackendRedis:
+		cacher = newRedisCacher(p.Config, p.Redis, m, logger)
+	default:
+		return Result{}, fmt.Errorf("invalid gorm cache backend: %s", p.Config.Backend)
+	}
+	return Result{
+		Cacher:      cacher,
+		HitsTotal:   m.hitsTotal,
+		MissesTotal: m.missesTotal,
+	}, nil
+}
+
+type metrics struct {
+	hitsTotal   *prometheus.CounterVec
+	missesTotal prometheus.Counter
+}
+
+type Mode int
+
+const (
+	// ModeNoCache the query will not be satisfied from the cache, and any existing cache entry will be removed to avoid stale results in future (default)
+	ModeNoCache Mode = iota
+	// ModeCached the query will be satisfied from the cache if possible, otherwise the result will be stored in the cache
+	ModeCached
+	// ModeWarm the query will not be satisfied from the cache, but the result will be stored in the cache for future queries using ModeCached
+	ModeWarm
+)
+
+type modeKey string
+
+// The ModeKey context value specifies the caching Mode for a particular query.
+// I don't really like storing this in the context, but it's the simplest way for now.
+const ModeKey modeKey = "gorm_cache_mode"
+
+func cacheModeFromContext(ctx context.Context) Mode {
+	ctxValue := ctx.Value(ModeKey)
+	m, isOk := ctxValue.(Mode)
+	if !isOk {
+		return ModeNoCache
+	}
+	return m
+}
@@ -5,10 +5,19 @@ import "time"
 type Config struct {
 	CacheEnabled bool
 	EaserEnabled bool
-	Ttl          time.Duration
-	MaxKeys      uint
+	// Backend is where cached query results are stored: memory, for a cache local to each process, or redis, for a cache
+	// shared by all processes using the same Redis instance, such as multiple API replicas.
+	Backend string
+	Ttl     time.Duration
+	// MaxKeys is the maximum number of entries of the memory backend; entries of the redis backend are evicted by Redis.
+	MaxKeys uint
 }
 
+const (
+	BackendMemory = "memory"
+	BackendRedis  = "redis"
+)
+
 func NewDefaultConfig() Config {
 	return Config{
 		CacheEnabled: true,
@@ -16,6 +25,7 @@ func NewDefaultConfig() Config {
 		// if I can get time to understand the problem better I may open an issue in https://github.com/go-gorm/caches, though they
 		// don't seem very responsive to issues, hence why bitmagnet uses a forked version of this library...
 		EaserEnabled: false,
+		Backend:      BackendMemory,
 		Ttl:          time.Minute * 60,
 		MaxKeys:      1000,
 	}
@@ -9,7 +9,9 @@ import (
 
 type DecoratorParams struct {
 	fx.In
+	Config Config
 	Plugin *caches.Caches
+	Cacher caches.Cacher
 	DB     lazy.Lazy[*gorm.DB]
 }
 
@@ -28,6 +30,11 @@ func NewDecorator(p DecoratorParams) DecoratorResult {
 			if err := db.Use(p.Plugin); err != nil {
 				return nil, err
 			}
+			if inv, ok := p.Cacher.(invalidator); ok && p.Config.CacheEnabled {
+				if err := registerInvalidation(db, newBatchInvalidator(inv, invalidationInterval)); err != nil {
+					return nil, err
+				}
+			}
 			return db, nil
 		}),
 	}
@@ -0,0 +1,160 @@
+package cache
+
+import (
+	"context"
+	"database/sql"
+	"gorm.io/gorm"
+	"regexp"
+	"sync"
+	"time"
+)
+
+// invalidator is implemented by cachers that are shared between processes, whose entries can't be left to expire
+// after a write made by another process.
+type invalidator interface {
+	Invalidate(ctx context.Context, tables ...string)
+}
+
+// invalidationInterval is the longest a written table's invalidation is delayed by;
+// all writes to a table within the interval are invalidated at once.
+const invalidationInterval = time.Second
+
+// writtenTableRegex matches the table written by a raw SQL statement
+var writtenTableRegex = regexp.MustCompile(`(?i)^\s*(?:INSERT\s+INTO|UPDATE|DELETE\s+FROM)\s+"?([a-z_][a-z0-9_]*)"?`)
+
+// registerInvalidation invalidates the tables written by the DB. Writes made in a transaction are invalidated once
+// it's committed, so that readers can't re-cache the data it's about to change.
+func registerInvalidation(db *gorm.DB, b *batchInvalidator) error {
+	pool := invalidatingPool{ConnPool: db.ConnPool, invalidator: b}
+	db.ConnPool = pool
+	db.Statement.ConnPool = pool
+	invalidate := func(tx *gorm.DB) {
+		if tx.Error != nil || tx.Statement.RowsAffected == 0 {
+			return
+		}
+		table := tx.Statement.Table
+		if table == "" {
+			if m := writtenTableRegex.FindStringSubmatch(tx.Statement.SQL.String()); m != nil {
+				table = m[1]
+			}
+		}
+		if table == "" {
+			return
+		}
+		if t, ok := tx.Statement.ConnPool.(*invalidatingTx); ok {
+			t.written(table)
+		} else {
+			b.add(table)
+		}
+	}
+	if err := db.Callback().Create().After("gorm:create").Register("cache:invalidate", invalidate); err != nil {
+		return err
+	}
+	if err := db.Callback().Update().After("gorm:update").Register("cache:invalidate", invalidate); err != nil {
+		return err
+	}
+	if err := db.Callback().Delete().After("gorm:delete").Register("cache:invalidate", invalidate); err != nil {
+		return err
+	}
+	return db.Callback().Raw().After("gorm:raw").Register("cache:invalidate", invalidate)
+}
+
+// batchInvalidator invalidates written tables in the background, each at most once per interval,
+// so that writers neither wait on the cache nor flood it with an invalidation per statement.
+type batchInvalidator struct {
+	invalidator invalidator
+	interval    time.Duration
+	mutex       sync.Mutex
+	pending     map[string]struct{}
+}
+
+func newBatchInvalidator(inv invalidator, interval time.Duration) *batchInvalidator {
+	return &batchInvalidator{
+		invalidator: inv,
+		interval:    interval,
+		pending:     make(map[string]struct{}),
+	}
+}
+
+func (b *batchInvalidator) add(tables ...string) {
+	if len(tables) == 0 {
+		return
+	}
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	if len(b.pending) == 0 {
+		time.AfterFunc(b.interval, b.flush)
+	}
+	for _, table := range tables {
+		b.pending[table] = struct{}{}
+	}
+}
+
+func (b *batchInvalidator) flush() {
+	b.mutex.Lock()
+	tables := make([]string, 0, len(b.pending))
+	for table := range b.pending {
+		tables = append(tables, table)
+	}
+	b.pending = make(map[string]struct{})
+	b.mutex.Unlock()
+	if len(tables) > 0 {
+		b.invalidator.Invalidate(context.Background(), tables...)
+	}
+}
+
+// invalidatingPool begins transactions that invalidate the tables they've written once they're committed.
+type invalidatingPool struct {
+	gorm.ConnPool
+	invalidator *batchInvalidator
+}
+
+func (p invalidatingPool) BeginTx(ctx context.Context, opts *sql.TxOptions) (gorm.ConnPool, error) {
+	beginner, ok := p.ConnPool.(gorm.TxBeginner)
+	if !ok {
+		return nil, gorm.ErrInvalidTransaction
+	}
+	tx, err := beginner.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &invalidatingTx{Tx: tx, pool: p}, nil
+}
+
+func (p invalidatingPool) GetDBConn() (*sql.DB, error) {
+	switch pool := p.ConnPool.(type) {
+	case *sql.DB:
+		return pool, nil
+	case gorm.GetDBConnector:
+		return pool.GetDBConn()
+	default:
+		return nil, gorm.ErrInvalidDB
+	}
+}
+
+type invalidatingTx struct {
+	*sql.Tx
+	pool   invalidatingPool
+	mutex  sync.Mutex
+	tables []string
+}
+
+func (t *invalidatingTx) written(table string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.tables = append(t.tables, table)
+}
+
+func (t *invalidatingTx) Commit() error {
+	if err := t.Tx.Commit(); err != nil {
+		return err
+	}
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.pool.invalidator.add(t.tables...)
+	return nil
+}
+
+func (t *invalidatingTx) GetDBConn() (*sql.DB, error) {
+	return t.pool.GetDBConn()
+}
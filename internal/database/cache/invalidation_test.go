@@ -0,0 +1,150 @@
+package cache
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+	"gorm.io/gorm/utils/tests"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+type recordingInvalidator struct {
+	mutex sync.Mutex
+	calls [][]string
+}
+
+func (r *recordingInvalidator) Invalidate(_ context.Context, tables ...string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	sort.Strings(tables)
+	r.calls = append(r.calls, tables)
+}
+
+func (r *recordingInvalidator) invalidated() [][]string {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return append([][]string(nil), r.calls...)
+}
+
+// writeConnector connects to a database in which every statement affects a single row.
+type writeConnector struct{}
+
+func (writeConnector) Connect(context.Context) (driver.Conn, error) {
+	return writeConn{}, nil
+}
+
+func (writeConnector) Driver() driver.Driver {
+	return nil
+}
+
+type writeConn struct{}
+
+func (writeConn) Prepare(string) (driver.Stmt, error) {
+	return writeStmt{}, nil
+}
+
+func (writeConn) Close() error {
+	return nil
+}
+
+func (c writeConn) Begin() (driver.Tx, error) {
+	return c, nil
+}
+
+func (writeConn) Commit() error {
+	return nil
+}
+
+func (writeConn) Rollback() error {
+	return nil
+}
+
+type writeStmt struct{}
+
+func (writeStmt) Close() error {
+	return nil
+}
+
+func (writeStmt) NumInput() int {
+	return -1
+}
+
+func (writeStmt) Exec([]driver.Value) (driver.Result, error) {
+	return driver.RowsAffected(1), nil
+}
+
+func (writeStmt) Query([]driver.Value) (driver.Rows, error) {
+	return nil, errors.New("not implemented")
+}
+
+const testInvalidationInterval = 10 * time.Millisecond
+
+func newInvalidatingDB(t *testing.T) (*gorm.DB, *recordingInvalidator) {
+	t.Helper()
+	db, err := gorm.Open(tests.DummyDialector{}, &gorm.Config{
+		ConnPool:             sql.OpenDB(writeConnector{}),
+		DisableAutomaticPing: true,
+		Logger:               logger.Discard,
+	})
+	require.NoError(t, err)
+	inv := &recordingInvalidator{}
+	require.NoError(t, registerInvalidation(db, newBatchInvalidator(inv, testInvalidationInterval)))
+	return db, inv
+}
+
+func TestInvalidation_batchesWrites(t *testing.T) {
+	t.Parallel()
+	db, inv := newInvalidatingDB(t)
+	for _, table := range []string{"torrents", "torrents", "content", "torrents"} {
+		require.NoError(t, db.Exec("UPDATE "+table+" SET x = 1").Error)
+	}
+	assert.Eventually(t, func() bool {
+		return len(inv.invalidated()) > 0
+	}, time.Second, testInvalidationInterval)
+	time.Sleep(3 * testInvalidationInterval)
+	assert.Equal(t, [][]string{{"content", "torrents"}}, inv.invalidated())
+}
+
+func TestInvalidation_afterCommit(t *testing.T) {
+	t.Parallel()
+	db, inv := newInvalidatingDB(t)
+	require.NoError(t, db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("INSERT INTO torrents (x) VALUES (1)").Error; err != nil {
+			return err
+		}
+		if err := tx.Exec("DELETE FROM torrent_files").Error; err != nil {
+			return err
+		}
+		time.Sleep(5 * testInvalidationInterval)
+		assert.Empty(t, inv.invalidated(), "invalidated before commit")
+		return nil
+	}))
+	assert.Eventually(t, func() bool {
+		return len(inv.invalidated()) > 0
+	}, time.Second, testInvalidationInterval)
+	assert.Equal(t, [][]string{{"torrent_files", "torrents"}}, inv.invalidated())
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	assert.NotNil(t, sqlDB)
+}
+
+func TestInvalidation_rollback(t *testing.T) {
+	t.Parallel()
+	db, inv := newInvalidatingDB(t)
+	require.Error(t, db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("UPDATE torrents SET x = 1").Error; err != nil {
+			return err
+		}
+		return errors.New("rollback")
+	}))
+	time.Sleep(5 * testInvalidationInterval)
+	assert.Empty(t, inv.invalidated())
+}
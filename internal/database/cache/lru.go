@@ -4,52 +4,31 @@ import (
 	"context"
 	"github.com/hashicorp/golang-lru/v2/expirable"
 	caches "github.com/mgdigital/gorm-cache/v2"
-	"go.uber.org/fx"
+	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
+	"strconv"
 )
 
-type Params struct {
-	fx.In
-	Config Config
-	Logger *zap.SugaredLogger
-}
-
-type Result struct {
-	fx.Out
-	Cacher caches.Cacher
-}
-
-func NewInMemoryCacher(p Params) Result {
-	return Result{
-		Cacher: &inMemoryCacher{
-			lru:    expirable.NewLRU[string, *caches.Query](int(p.Config.MaxKeys), nil, p.Config.Ttl),
-			logger: p.Logger.Named("gorm_cache"),
-		},
+func newInMemoryCacher(config Config, m metrics, logger *zap.SugaredLogger) *inMemoryCacher {
+	return &inMemoryCacher{
+		lru:     expirable.NewLRU[string, cacheEntry](int(config.MaxKeys), nil, config.Ttl),
+		metrics: m,
+		logger:  logger,
 	}
 }
 
-type Mode int
-
-const (
-	// ModeNoCache the query will not be satisfied from the cache, and any existing cache entry will be removed to avoid stale results in future (default)
-	ModeNoCache Mode = iota
-	// ModeCached the query will be satisfied from the cache if possible, otherwise the result will be stored in the cache
-	ModeCached
-	// ModeWarm the query will not be satisfied from the cache, but the result will be stored in the cache for future queries using ModeCached
-	ModeWarm
-)
-
-type modeKey string
-
-// The ModeKey context value specifies the caching Mode for a particular query.
-// I don't really like storing this in the context, but it's the simplest way for now.
-const ModeKey modeKey = "gorm_cache_mode"
-
 type inMemoryCacher struct {
-	lru    *expirable.LRU[string, *caches.Query]
+	lru *expirable.LRU[string, cacheEntry]
+	metrics
 	logger *zap.SugaredLogger
 }
 
+type cacheEntry struct {
+	query *caches.Query
+	// warmed is true if the entry was stored by a query using ModeWarm
+	warmed bool
+}
+
 func (c *inMemoryCacher) Get(ctx context.Context, key string) *caches.Query {
 	m := cacheModeFromContext(ctx)
 	if m == ModeNoCache || m == ModeWarm {
@@ -57,26 +36,19 @@ func (c *inMemoryCacher) Get(ctx context.Context, key string) *caches.Query {
 	}
 	val, ok := c.lru.Get(key)
 	if !ok {
+		c.missesTotal.Inc()
 		return nil
 	}
+	c.hitsTotal.With(prometheus.Labels{"warmed": strconv.FormatBool(val.warmed)}).Inc()
 	c.logger.Debugw("cache hit", "key", key)
 
-	return val
+	return val.query
 }
 
 func (c *inMemoryCacher) Store(ctx context.Context, key string, val *caches.Query) error {
 	m := cacheModeFromContext(ctx)
 	if m == ModeCached || m == ModeWarm {
-		c.lru.Add(key, val)
+		c.lru.Add(key, cacheEntry{query: val, warmed: m == ModeWarm})
 	}
 	return nil
 }
-
-func cacheModeFromContext(ctx context.Context) Mode {
-	ctxValue := ctx.Value(ModeKey)
-	m, isOk := ctxValue.(Mode)
-	if !isOk {
-		return ModeNoCache
-	}
-	return m
-}
@@ -0,0 +1,212 @@
+package cache
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/gob"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	caches "github.com/mgdigital/gorm-cache/v2"
+	"github.com/prometheus/client_golang/prometheus"
+	redis "github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+	"reflect"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// keyPrefix is the prefix of the Redis keys of cache entries, and of the sets of entry keys tagged with each table
+const keyPrefix = "bitmagnet:gorm_cache:"
+
+// readTablesRegex matches the tables that a query reads from in its SQL; it may also match some other identifiers,
+// such as the names of common table expressions, which only results in entries being invalidated more often.
+var readTablesRegex = regexp.MustCompile(`(?i)\b(?:FROM|JOIN)\s+"?([a-z_][a-z0-9_]*)"?`)
+
+func newRedisCacher(config Config, r lazy.Lazy[*redis.Client], m metrics, logger *zap.SugaredLogger) *redisCacher {
+	return &redisCacher{
+		ttl:     config.Ttl,
+		redis:   r,
+		metrics: m,
+		logger:  logger,
+	}
+}
+
+// redisCacher stores the results of cached queries in Redis, so that they're shared by all processes using the same
+// Redis instance. Entries are tagged with the tables read by their query, and are invalidated on writes to those tables.
+type redisCacher struct {
+	ttl   time.Duration
+	redis lazy.Lazy[*redis.Client]
+	metrics
+	// types maps the names of the destination types stored by this process to their types, as an entry can only be decoded
+	// into the type it was encoded from; entries of a type not yet stored by this process are treated as misses.
+	types  sync.Map
+	logger *zap.SugaredLogger
+}
+
+type redisEntry struct {
+	Type         string
+	RowsAffected int64
+	Warmed       bool
+	Dest         []byte
+}
+
+func (c *redisCacher) Get(ctx context.Context, key string) *caches.Query {
+	m := cacheModeFromContext(ctx)
+	if m == ModeNoCache || m == ModeWarm {
+		return nil
+	}
+	r, err := c.redis.Get()
+	if err != nil {
+		c.logger.Errorw("failed to get redis client", "error", err)
+		return nil
+	}
+	data, err := r.Get(ctx, entryKey(key)).Bytes()
+	if err != nil {
+		if !errors.Is(err, redis.Nil) {
+			c.logger.Warnw("failed to get cache entry", "error", err)
+		}
+		c.missesTotal.Inc()
+		return nil
+	}
+	q, warmed, err := c.decode(data)
+	if err != nil {
+		c.logger.Debugw("failed to decode cache entry", "key", key, "error", err)
+		c.missesTotal.Inc()
+		return nil
+	}
+	c.hitsTotal.With(prometheus.Labels{"warmed": strconv.FormatBool(warmed)}).Inc()
+	c.logger.Debugw("cache hit", "key", key)
+
+	return q
+}
+
+// Store stores the result of a query; failures are logged rather than returned, as returning an error would fail the query.
+func (c *redisCacher) Store(ctx context.Context, key string, val *caches.Query) error {
+	m := cacheModeFromContext(ctx)
+	if m != ModeCached && m != ModeWarm {
+		return nil
+	}
+	data, err := c.encode(val, m == ModeWarm)
+	if err != nil {
+		c.logger.Debugw("failed to encode cache entry", "key", key, "error", err)
+		return nil
+	}
+	r, err := c.redis.Get()
+	if err != nil {
+		c.logger.Errorw("failed to get redis client", "error", err)
+		return nil
+	}
+	k := entryKey(key)
+	if _, err := r.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		p.Set(ctx, k, data, c.ttl)
+		for _, t := range queryTables(key) {
+			tk := tableKey(t)
+			p.SAdd(ctx, tk, k)
+			p.Expire(ctx, tk, c.ttl)
+		}
+		return nil
+	}); err != nil {
+		c.logger.Warnw("failed to store cache entry", "error", err)
+	}
+	return nil
+}
+
+// Invalidate deletes the entries tagged with any of the tables, so that no process is served results cached before a write.
+func (c *redisCacher) Invalidate(ctx context.Context, tables ...string) {
+	r, err := c.redis.Get()
+	if err != nil {
+		c.logger.Errorw("failed to get redis client", "error", err)
+		return
+	}
+	for _, t := range tables {
+		tk := tableKey(t)
+		keys, err := r.SMembers(ctx, tk).Result()
+		if err != nil {
+			c.logger.Warnw("failed to get tagged cache entries", "table", t, "error", err)
+			continue
+		}
+		if len(keys) == 0 {
+			continue
+		}
+		members := make([]interface{}, 0, len(keys))
+		for _, k := range keys {
+			members = append(members, k)
+		}
+		// only the deleted keys are removed from the set, as entries may have been tagged since it was read
+		if _, err := r.TxPipelined(ctx, func(p redis.Pipeliner) error {
+			p.Del(ctx, keys...)
+			p.SRem(ctx, tk, members...)
+			return nil
+		}); err != nil {
+			c.logger.Warnw("failed to invalidate cache entries", "table", t, "error", err)
+		}
+	}
+}
+
+func (c *redisCacher) encode(val *caches.Query, warmed bool) ([]byte, error) {
+	t := reflect.TypeOf(val.Dest)
+	if t == nil || t.Kind() != reflect.Ptr {
+		return nil, fmt.Errorf("unsupported destination type: %v", t)
+	}
+	dest := &bytes.Buffer{}
+	if err := gob.NewEncoder(dest).Encode(val.Dest); err != nil {
+		return nil, err
+	}
+	c.types.Store(t.String(), t)
+	buf := &bytes.Buffer{}
+	if err := gob.NewEncoder(buf).Encode(redisEntry{
+		Type:         t.String(),
+		RowsAffected: val.RowsAffected,
+		Warmed:       warmed,
+		Dest:         dest.Bytes(),
+	}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (c *redisCacher) decode(data []byte) (*caches.Query, bool, error) {
+	var e redisEntry
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&e); err != nil {
+		return nil, false, err
+	}
+	t, ok := c.types.Load(e.Type)
+	if !ok {
+		return nil, false, fmt.Errorf("unknown destination type: %s", e.Type)
+	}
+	dest := reflect.New(t.(reflect.Type).Elem())
+	if err := gob.NewDecoder(bytes.NewReader(e.Dest)).DecodeValue(dest); err != nil {
+		return nil, false, err
+	}
+	return &caches.Query{
+		Dest:         dest.Interface(),
+		RowsAffected: e.RowsAffected,
+	}, e.Warmed, nil
+}
+
+// entryKey hashes a query identifier, which contains the full SQL and its arguments, to a Redis key.
+func entryKey(key string) string {
+	h := sha256.Sum256([]byte(key))
+	return keyPrefix + "entry:" + hex.EncodeToString(h[:])
+}
+
+func tableKey(table string) string {
+	return keyPrefix + "table:" + table
+}
+
+func queryTables(sql string) []string {
+	var tables []string
+	seen := make(map[string]struct{})
+	for _, m := range readTablesRegex.FindAllStringSubmatch(sql, -1) {
+		if _, ok := seen[m[1]]; !ok {
+			seen[m[1]] = struct{}{}
+			tables = append(tables, m[1])
+		}
+	}
+	return tables
+}
@@ -0,0 +1,61 @@
+package cache
+
+import (
+	caches "github.com/mgdigital/gorm-cache/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"testing"
+	"time"
+)
+
+type testRow struct {
+	Name      string
+	Count     int
+	CreatedAt time.Time
+	Tags      []string
+}
+
+func TestRedisCacher_encodeDecode(t *testing.T) {
+	c := newRedisCacher(NewDefaultConfig(), nil, metrics{}, zap.NewNop().Sugar())
+	rows := []testRow{
+		{Name: "a", Count: 1, CreatedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), Tags: []string{"x"}},
+		{Name: "b"},
+	}
+	data, err := c.encode(&caches.Query{Dest: &rows, RowsAffected: 2}, true)
+	require.NoError(t, err)
+	q, warmed, err := c.decode(data)
+	require.NoError(t, err)
+	assert.True(t, warmed)
+	assert.Equal(t, int64(2), q.RowsAffected)
+	assert.Equal(t, &rows, q.Dest)
+}
+
+func TestRedisCacher_decodeUnknownType(t *testing.T) {
+	c := newRedisCacher(NewDefaultConfig(), nil, metrics{}, zap.NewNop().Sugar())
+	data, err := c.encode(&caches.Query{Dest: &[]testRow{{Name: "a"}}}, false)
+	require.NoError(t, err)
+	other := newRedisCacher(NewDefaultConfig(), nil, metrics{}, zap.NewNop().Sugar())
+	_, _, err = other.decode(data)
+	assert.Error(t, err)
+}
+
+func TestQueryTables(t *testing.T) {
+	assert.Equal(t, []string{"torrent_contents", "torrents", "content"}, queryTables(
+		`SELECT * FROM "torrent_contents" LEFT JOIN "torrents" ON "torrents"."info_hash" = "torrent_contents"."info_hash" `+
+			`JOIN content ON true WHERE "torrent_contents"."id" IN (SELECT id FROM "torrents")-[1]`,
+	))
+}
+
+func TestWrittenTableRegex(t *testing.T) {
+	for sql, table := range map[string]string{
+		`INSERT INTO "torrents" ("info_hash") VALUES ($1)`: "torrents",
+		`update torrent_contents set seeders = 1`:          "torrent_contents",
+		` DELETE FROM "torrents_torrent_sources"`:          "torrents_torrent_sources",
+	} {
+		m := writtenTableRegex.FindStringSubmatch(sql)
+		require.NotNil(t, m, sql)
+		assert.Equal(t, table, m[1])
+	}
+	assert.Nil(t, writtenTableRegex.FindStringSubmatch("VACUUM torrents"))
+}
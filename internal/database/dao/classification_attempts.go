@@ -0,0 +1,49 @@
+package dao
+
+import (
+	"context"
+	"database/sql/driver"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// RecordClassificationAttempts counts an attempt for each torrent that couldn't be classified,
+// and clears the count of torrents that were classified, so that the count is of consecutive failed attempts.
+func (q *Query) RecordClassificationAttempts(ctx context.Context, classified []protocol.ID, unclassified []protocol.ID) error {
+	if len(classified) > 0 {
+		valuers := make([]driver.Valuer, 0, len(classified))
+		for _, infoHash := range classified {
+			valuers = append(valuers, infoHash)
+		}
+		if _, err := q.ClassificationAttempt.WithContext(ctx).Where(
+			q.ClassificationAttempt.InfoHash.In(valuers...),
+		).Delete(); err != nil {
+			return err
+		}
+	}
+	if len(unclassified) == 0 {
+		return nil
+	}
+	attempts := make([]*model.ClassificationAttempt, 0, len(unclassified))
+	for _, infoHash := range unclassified {
+		attempts = append(attempts, &model.ClassificationAttempt{
+			InfoHash: infoHash,
+			Attempts: 1,
+		})
+	}
+	return q.ClassificationAttempt.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: string(q.ClassificationAttempt.InfoHash.ColumnName())}},
+		DoUpdates: append(
+			clause.Set{{
+				Column: clause.Column{Name: string(q.ClassificationAttempt.Attempts.ColumnName())},
+				Value: gorm.Expr(
+					"? + 1",
+					clause.Column{Table: q.ClassificationAttempt.TableName(), Name: string(q.ClassificationAttempt.Attempts.ColumnName())},
+				),
+			}},
+			clause.AssignmentColumns([]string{string(q.ClassificationAttempt.UpdatedAt.ColumnName())})...,
+		),
+	}).CreateInBatches(attempts, 100)
+}
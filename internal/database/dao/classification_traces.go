@@ -0,0 +1,21 @@
+package dao
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"gorm.io/gorm/clause"
+)
+
+// RecordClassificationTraces stores the trace of the latest classification of each torrent, replacing any earlier trace.
+func (q *Query) RecordClassificationTraces(ctx context.Context, traces []model.ClassificationTrace) error {
+	if len(traces) == 0 {
+		return nil
+	}
+	tracesPtr := make([]*model.ClassificationTrace, 0, len(traces))
+	for i := range traces {
+		tracesPtr = append(tracesPtr, &traces[i])
+	}
+	return q.ClassificationTrace.WithContext(ctx).Clauses(clause.OnConflict{
+		UpdateAll: true,
+	}).CreateInBatches(tracesPtr, 100)
+}
@@ -66,6 +66,12 @@ func newContent(db *gorm.DB, opts ...gen.DOOption) content {
 		},
 	}
 
+	_content.People = contentHasManyPeople{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("People", "model.ContentPerson"),
+	}
+
 	_content.MetadataSource = contentBelongsToMetadataSource{
 		db: db.Session(&gorm.Session{}),
 
@@ -102,6 +108,8 @@ type content struct {
 
 	Attributes contentHasManyAttributes
 
+	People contentHasManyPeople
+
 	MetadataSource contentBelongsToMetadataSource
 
 	fieldMap map[string]field.Expr
@@ -152,7 +160,7 @@ func (c *content) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (c *content) fillFieldMap() {
-	c.fieldMap = make(map[string]field.Expr, 20)
+	c.fieldMap = make(map[string]field.Expr, 21)
 	c.fieldMap["type"] = c.Type
 	c.fieldMap["source"] = c.Source
 	c.fieldMap["id"] = c.ID
@@ -333,6 +341,77 @@ func (a contentHasManyAttributesTx) Count() int64 {
 	return a.tx.Count()
 }
 
+type contentHasManyPeople struct {
+	db *gorm.DB
+
+	field.RelationField
+}
+
+func (a contentHasManyPeople) Where(conds ...field.Expr) *contentHasManyPeople {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a contentHasManyPeople) WithContext(ctx context.Context) *contentHasManyPeople {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a contentHasManyPeople) Session(session *gorm.Session) *contentHasManyPeople {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a contentHasManyPeople) Model(m *model.Content) *contentHasManyPeopleTx {
+	return &contentHasManyPeopleTx{a.db.Model(m).Association(a.Name())}
+}
+
+type contentHasManyPeopleTx struct{ tx *gorm.Association }
+
+func (a contentHasManyPeopleTx) Find() (result []*model.ContentPerson, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a contentHasManyPeopleTx) Append(values ...*model.ContentPerson) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a contentHasManyPeopleTx) Replace(values ...*model.ContentPerson) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a contentHasManyPeopleTx) Delete(values ...*model.ContentPerson) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a contentHasManyPeopleTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a contentHasManyPeopleTx) Count() int64 {
+	return a.tx.Count()
+}
+
 type contentBelongsToMetadataSource struct {
 	db *gorm.DB
 
@@ -0,0 +1,158 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"gorm.io/gen"
+)
+
+var ErrContentNotFound = errors.New("content not found")
+
+// contentMergeStatements are executed in order to merge the content "from" into the content "into". Torrent contents,
+// hints, flags, wanted items and takedowns are repointed, the collections and attributes of the content are combined,
+// its people are kept only if the merged content has none, and its identifier is recorded as an alternative
+// identifier; it's recorded with the "id" key unless the merged content already has an identifier of that source.
+var contentMergeStatements = []string{
+	`delete from torrent_contents as tc
+	where tc.content_type = @type and tc.content_source = @from_source and tc.content_id = @from_id
+	and exists(
+		select 1 from torrent_contents where info_hash = tc.info_hash
+		and content_type = @type and content_source = @into_source and content_id = @into_id
+	)`,
+	`update torrent_contents set content_source = @into_source, content_id = @into_id
+	where content_type = @type and content_source = @from_source and content_id = @from_id`,
+	`update torrent_contents set file_contents = (
+		select jsonb_agg(case
+			when e->>'contentType' = @type and e->>'contentSource' = @from_source and e->>'contentId' = @from_id
+			then e || jsonb_build_object('contentSource', cast(@into_source as text), 'contentId', cast(@into_id as text))
+			else e end order by ord)
+		from jsonb_array_elements(file_contents) with ordinality as x(e, ord)
+	)
+	where file_contents @> jsonb_build_array(
+		jsonb_build_object('contentType', cast(@type as text), 'contentSource', cast(@from_source as text), 'contentId', cast(@from_id as text))
+	)`,
+	`update torrent_hints set content_source = @into_source, content_id = @into_id
+	where content_type = @type and content_source = @from_source and content_id = @from_id`,
+	`insert into content_attributes (content_type, content_source, content_id, source, key, value, created_at, updated_at)
+	select content_type, @into_source, @into_id, source, key, value, created_at, now() from content_attributes
+	where content_type = @type and content_source = @from_source and content_id = @from_id
+	on conflict do nothing`,
+	`insert into content_attributes (content_type, content_source, content_id, source, key, value, created_at, updated_at)
+	select @type, @into_source, @into_id, @from_source,
+		case when cast(@from_source as text) = cast(@into_source as text) or exists(
+			select 1 from content_attributes where content_type = @type and content_source = @into_source
+			and content_id = @into_id and source = @from_source and key = 'id'
+		) then 'merged_id:' || cast(@from_id as text) else 'id' end,
+		@from_id, now(), now()
+	on conflict do nothing`,
+	`insert into content_collections_content (content_type, content_source, content_id, content_collection_type,
+		content_collection_source, content_collection_id)
+	select content_type, @into_source, @into_id, content_collection_type, content_collection_source, content_collection_id
+	from content_collections_content
+	where content_type = @type and content_source = @from_source and content_id = @from_id
+	on conflict do nothing`,
+	`insert into content_people (content_type, content_source, content_id, source, credit_id, person_id, name, role, job,
+		department, character, position, created_at, updated_at)
+	select content_type, @into_source, @into_id, source, credit_id, person_id, name, role, job, department, character,
+		position, created_at, now()
+	from content_people
+	where content_type = @type and content_source = @from_source and content_id = @from_id
+	and not exists(
+		select 1 from content_people where content_type = @type and content_source = @into_source and content_id = @into_id
+	)
+	on conflict do nothing`,
+	`insert into content_flags (content_type, content_source, content_id, watching, ignored, best_info_hash, created_at,
+		updated_at)
+	select content_type, @into_source, @into_id, watching, ignored, best_info_hash, created_at, now() from content_flags
+	where content_type = @type and content_source = @from_source and content_id = @from_id
+	on conflict (content_type, content_source, content_id) do update set
+		watching = content_flags.watching or excluded.watching,
+		ignored = content_flags.ignored or excluded.ignored,
+		best_info_hash = coalesce(content_flags.best_info_hash, excluded.best_info_hash),
+		updated_at = excluded.updated_at`,
+	`update wanted_items set content_source = @into_source, content_id = @into_id
+	where content_type = @type and content_source = @from_source and content_id = @from_id`,
+	`delete from takedowns as t
+	where t.content_source = @from_source and t.content_id = @from_id and coalesce(t.content_type, @type) = @type
+	and exists(
+		select 1 from takedowns where content_source = @into_source and content_id = @into_id
+		and coalesce(content_type, '') = coalesce(t.content_type, '')
+	)`,
+	`update takedowns set content_source = @into_source, content_id = @into_id
+	where content_source = @from_source and content_id = @from_id and coalesce(content_type, @type) = @type`,
+	`delete from content where type = @type and source = @from_source and id = @from_id`,
+}
+
+// updateMergedContentTsv makes the merged content searchable by the recorded alternative identifier.
+func updateMergedContentTsv(ctx context.Context, tx *Query, ref model.ContentRef) error {
+	where := []gen.Condition{
+		tx.Content.Type.Eq(ref.Type.String()),
+		tx.Content.Source.Eq(ref.Source),
+		tx.Content.ID.Eq(ref.ID),
+	}
+	content, err := tx.Content.WithContext(ctx).Preload(
+		tx.Content.Attributes,
+		tx.Content.Collections,
+	).Where(where...).First()
+	if err != nil {
+		return err
+	}
+	content.UpdateTsv()
+	_, err = tx.Content.WithContext(ctx).Where(where...).Update(tx.Content.Tsv, content.Tsv)
+	return err
+}
+
+// MergeContent merges a duplicate content item into another of the same type, which it's then deleted in favour of,
+// returning the info hashes of the torrents that were matched to it. The search vector of the merged content is updated
+// in the same transaction.
+func (q *Query) MergeContent(ctx context.Context, from, into model.ContentRef) ([]protocol.ID, error) {
+	if from.Type != into.Type {
+		return nil, errors.New("merged content must be of the same type")
+	}
+	if from == into {
+		return nil, errors.New("content cannot be merged into itself")
+	}
+	params := map[string]any{
+		"type":        from.Type.String(),
+		"from_source": from.Source,
+		"from_id":     from.ID,
+		"into_source": into.Source,
+		"into_id":     into.ID,
+	}
+	var infoHashes []protocol.ID
+	if txErr := q.Transaction(func(tx *Query) error {
+		db := tx.Content.WithContext(ctx).UnderlyingDB()
+		var count int64
+		if err := db.Raw(
+			`select count(*) from content where type = @type
+			and ((source = @from_source and id = @from_id) or (source = @into_source and id = @into_id))`,
+			params,
+		).Scan(&count).Error; err != nil {
+			return err
+		}
+		if count != 2 {
+			return ErrContentNotFound
+		}
+		if err := db.Raw(
+			`select distinct info_hash from torrent_contents
+			where (content_type = @type and content_source = @from_source and content_id = @from_id)
+			or file_contents @> jsonb_build_array(
+				jsonb_build_object('contentType', cast(@type as text), 'contentSource', cast(@from_source as text), 'contentId', cast(@from_id as text))
+			)`,
+			params,
+		).Scan(&infoHashes).Error; err != nil {
+			return err
+		}
+		for _, stmt := range contentMergeStatements {
+			if err := db.Exec(stmt, params).Error; err != nil {
+				return err
+			}
+		}
+		return updateMergedContentTsv(ctx, tx, into)
+	}); txErr != nil {
+		return nil, txErr
+	}
+	return infoHashes, nil
+}
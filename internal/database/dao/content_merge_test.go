@@ -0,0 +1,84 @@
+package dao
+
+import (
+	"context"
+	"database/sql/driver"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestMergeContent(t *testing.T) {
+	t.Parallel()
+
+	q, connector := newFakeQuery(t)
+	ctx := context.Background()
+	from := model.ContentRef{Type: model.ContentTypeMovie, Source: "tmdb", ID: "1"}
+	into := model.ContentRef{Type: model.ContentTypeMovie, Source: "tmdb", ID: "2"}
+
+	_, err := q.MergeContent(ctx, from, model.ContentRef{Type: model.ContentTypeTvShow, Source: "tmdb", ID: "2"})
+	assert.EqualError(t, err, "merged content must be of the same type")
+
+	_, err = q.MergeContent(ctx, from, from)
+	assert.EqualError(t, err, "content cannot be merged into itself")
+
+	_, err = q.MergeContent(ctx, from, into)
+	assert.ErrorIs(t, err, ErrContentNotFound)
+	assert.Len(t, connector.executed(), 1, "nothing should be merged if the content isn't found")
+}
+
+func TestMergeContent_Statements(t *testing.T) {
+	t.Parallel()
+
+	q, connector := newFakeQuery(t)
+	connector.answer = func(query string) *fakeRows {
+		switch {
+		case strings.HasPrefix(query, "select count(*) from content"):
+			return newCountRows(2)
+		case strings.HasPrefix(query, "select distinct info_hash"):
+			return &fakeRows{columns: []string{"info_hash"}}
+		case strings.HasPrefix(query, "SELECT * FROM `content` "):
+			return &fakeRows{
+				columns: []string{"type", "source", "id", "title"},
+				values:  [][]driver.Value{{"movie", "tmdb", "2", "The Movie"}},
+			}
+		case strings.HasPrefix(query, "SELECT * FROM "):
+			return &fakeRows{columns: []string{"content_type"}}
+		}
+		return nil
+	}
+	from := model.ContentRef{Type: model.ContentTypeMovie, Source: "tmdb", ID: "1"}
+	into := model.ContentRef{Type: model.ContentTypeMovie, Source: "tmdb", ID: "2"}
+
+	_, err := q.MergeContent(context.Background(), from, into)
+	require.NoError(t, err)
+
+	// the content is counted and its torrents are listed before it's merged:
+	statements := connector.executed()
+	require.Greater(t, len(statements), 2+len(contentMergeStatements))
+	merge := statements[2 : 2+len(contentMergeStatements)]
+	namedParam := regexp.MustCompile(`@\w+`)
+	for i, stmt := range contentMergeStatements {
+		assert.Equal(t, namedParam.ReplaceAllString(stmt, "?"), merge[i].query)
+	}
+
+	dedupe, repoint, alternativeID := merge[0], merge[1], merge[5]
+	assert.True(t, strings.HasPrefix(dedupe.query, "delete from torrent_contents"))
+	assert.Equal(t, []driver.Value{"movie", "tmdb", "1", "movie", "tmdb", "2"}, dedupe.args,
+		"torrents matched to both contents should lose their match to the merged content")
+	assert.True(t, strings.HasPrefix(repoint.query, "update torrent_contents set content_source"))
+	assert.Equal(t, []driver.Value{"tmdb", "2", "movie", "tmdb", "1"}, repoint.args)
+	assert.Contains(t, alternativeID.query, "'merged_id:'")
+	assert.Equal(t, []driver.Value{
+		"movie", "tmdb", "2", "tmdb",
+		"tmdb", "tmdb",
+		"movie", "tmdb", "2", "tmdb",
+		"1", "1",
+	}, alternativeID.args)
+
+	assert.True(t, strings.HasPrefix(statements[len(statements)-1].query, "UPDATE `content` SET `tsv`="),
+		"the search vector of the merged content should be updated last")
+}
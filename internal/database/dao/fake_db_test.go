@@ -0,0 +1,140 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+	"gorm.io/gorm/utils/tests"
+	"io"
+	"sync"
+	"testing"
+)
+
+type fakeStatement struct {
+	query string
+	args  []driver.Value
+}
+
+// fakeConnector connects to a database that records the statements executed against it, in which every query returns
+// the rows given by answer, or a count of 0 if there are none.
+type fakeConnector struct {
+	mutex      sync.Mutex
+	statements []fakeStatement
+	answer     func(query string) *fakeRows
+}
+
+func (c *fakeConnector) Connect(context.Context) (driver.Conn, error) {
+	return fakeConn{c}, nil
+}
+
+func (c *fakeConnector) Driver() driver.Driver {
+	return nil
+}
+
+func (c *fakeConnector) record(query string, args []driver.Value) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.statements = append(c.statements, fakeStatement{query, args})
+}
+
+func (c *fakeConnector) executed() []fakeStatement {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return append([]fakeStatement(nil), c.statements...)
+}
+
+type fakeConn struct {
+	connector *fakeConnector
+}
+
+func (c fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return fakeStmt{c.connector, query}, nil
+}
+
+func (fakeConn) Close() error {
+	return nil
+}
+
+func (c fakeConn) Begin() (driver.Tx, error) {
+	return c, nil
+}
+
+func (fakeConn) Commit() error {
+	return nil
+}
+
+func (fakeConn) Rollback() error {
+	return nil
+}
+
+type fakeStmt struct {
+	connector *fakeConnector
+	query     string
+}
+
+func (fakeStmt) Close() error {
+	return nil
+}
+
+func (fakeStmt) NumInput() int {
+	return -1
+}
+
+func (s fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.connector.record(s.query, args)
+	return driver.RowsAffected(0), nil
+}
+
+func (s fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	s.connector.record(s.query, args)
+	if s.connector.answer != nil {
+		if rows := s.connector.answer(s.query); rows != nil {
+			return rows, nil
+		}
+	}
+	return newCountRows(0), nil
+}
+
+type fakeRows struct {
+	columns []string
+	values  [][]driver.Value
+}
+
+func newCountRows(count int64) *fakeRows {
+	return &fakeRows{
+		columns: []string{"count"},
+		values:  [][]driver.Value{{count}},
+	}
+}
+
+func (r *fakeRows) Columns() []string {
+	return r.columns
+}
+
+func (*fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.values[0])
+	r.values = r.values[1:]
+	return nil
+}
+
+func newFakeQuery(t *testing.T) (*Query, *fakeConnector) {
+	t.Helper()
+	connector := &fakeConnector{}
+	db, err := gorm.Open(tests.DummyDialector{}, &gorm.Config{
+		ConnPool:             sql.OpenDB(connector),
+		DisableAutomaticPing: true,
+		Logger:               logger.Discard,
+	})
+	require.NoError(t, err)
+	return Use(db), connector
+}
@@ -16,99 +16,189 @@ import (
 )
 
 var (
-	Q                        = new(Query)
-	BloomFilter              *bloomFilter
-	Content                  *content
-	ContentAttribute         *contentAttribute
-	ContentCollection        *contentCollection
-	ContentCollectionContent *contentCollectionContent
-	KeyValue                 *keyValue
-	MetadataSource           *metadataSource
-	Torrent                  *torrent
-	TorrentContent           *torrentContent
-	TorrentFile              *torrentFile
-	TorrentHint              *torrentHint
-	TorrentSource            *torrentSource
-	TorrentTag               *torrentTag
-	TorrentsTorrentSource    *torrentsTorrentSource
+	Q                          = new(Query)
+	AuditLog                   *auditLog
+	BloomFilter                *bloomFilter
+	ClassificationAttempt      *classificationAttempt
+	ClassificationTrace        *classificationTrace
+	ClassifierShadowDivergence *classifierShadowDivergence
+	Content                    *content
+	ContentAttribute           *contentAttribute
+	ContentCollection          *contentCollection
+	ContentCollectionContent   *contentCollectionContent
+	ContentFlag                *contentFlag
+	ContentPerson              *contentPerson
+	KeyValue                   *keyValue
+	MetadataSource             *metadataSource
+	MetainfoAttempt            *metainfoAttempt
+	SavedSearch                *savedSearch
+	SavedSearchMatch           *savedSearchMatch
+	ServarrPush                *servarrPush
+	Takedown                   *takedown
+	TakedownLog                *takedownLog
+	TaskRun                    *taskRun
+	Torrent                    *torrent
+	TorrentContent             *torrentContent
+	TorrentDownload            *torrentDownload
+	TorrentFile                *torrentFile
+	TorrentHint                *torrentHint
+	TorrentReport              *torrentReport
+	TorrentSource              *torrentSource
+	TorrentTag                 *torrentTag
+	TorrentsTorrentSource      *torrentsTorrentSource
+	TorznabAPIKey              *torznabAPIKey
+	WantedItem                 *wantedItem
+	WebhookDelivery            *webhookDelivery
 )
 
 func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 	*Q = *Use(db, opts...)
+	AuditLog = &Q.AuditLog
 	BloomFilter = &Q.BloomFilter
+	ClassificationAttempt = &Q.ClassificationAttempt
+	ClassificationTrace = &Q.ClassificationTrace
+	ClassifierShadowDivergence = &Q.ClassifierShadowDivergence
 	Content = &Q.Content
 	ContentAttribute = &Q.ContentAttribute
 	ContentCollection = &Q.ContentCollection
 	ContentCollectionContent = &Q.ContentCollectionContent
+	ContentFlag = &Q.ContentFlag
+	ContentPerson = &Q.ContentPerson
 	KeyValue = &Q.KeyValue
 	MetadataSource = &Q.MetadataSource
+	MetainfoAttempt = &Q.MetainfoAttempt
+	SavedSearch = &Q.SavedSearch
+	SavedSearchMatch = &Q.SavedSearchMatch
+	ServarrPush = &Q.ServarrPush
+	Takedown = &Q.Takedown
+	TakedownLog = &Q.TakedownLog
+	TaskRun = &Q.TaskRun
 	Torrent = &Q.Torrent
 	TorrentContent = &Q.TorrentContent
+	TorrentDownload = &Q.TorrentDownload
 	TorrentFile = &Q.TorrentFile
 	TorrentHint = &Q.TorrentHint
+	TorrentReport = &Q.TorrentReport
 	TorrentSource = &Q.TorrentSource
 	TorrentTag = &Q.TorrentTag
 	TorrentsTorrentSource = &Q.TorrentsTorrentSource
+	TorznabAPIKey = &Q.TorznabAPIKey
+	WantedItem = &Q.WantedItem
+	WebhookDelivery = &Q.WebhookDelivery
 }
 
 func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 	return &Query{
-		db:                       db,
-		BloomFilter:              newBloomFilter(db, opts...),
-		Content:                  newContent(db, opts...),
-		ContentAttribute:         newContentAttribute(db, opts...),
-		ContentCollection:        newContentCollection(db, opts...),
-		ContentCollectionContent: newContentCollectionContent(db, opts...),
-		KeyValue:                 newKeyValue(db, opts...),
-		MetadataSource:           newMetadataSource(db, opts...),
-		Torrent:                  newTorrent(db, opts...),
-		TorrentContent:           newTorrentContent(db, opts...),
-		TorrentFile:              newTorrentFile(db, opts...),
-		TorrentHint:              newTorrentHint(db, opts...),
-		TorrentSource:            newTorrentSource(db, opts...),
-		TorrentTag:               newTorrentTag(db, opts...),
-		TorrentsTorrentSource:    newTorrentsTorrentSource(db, opts...),
+		db:                         db,
+		AuditLog:                   newAuditLog(db, opts...),
+		BloomFilter:                newBloomFilter(db, opts...),
+		ClassificationAttempt:      newClassificationAttempt(db, opts...),
+		ClassificationTrace:        newClassificationTrace(db, opts...),
+		ClassifierShadowDivergence: newClassifierShadowDivergence(db, opts...),
+		Content:                    newContent(db, opts...),
+		ContentAttribute:           newContentAttribute(db, opts...),
+		ContentCollection:          newContentCollection(db, opts...),
+		ContentCollectionContent:   newContentCollectionContent(db, opts...),
+		ContentFlag:                newContentFlag(db, opts...),
+		ContentPerson:              newContentPerson(db, opts...),
+		KeyValue:                   newKeyValue(db, opts...),
+		MetadataSource:             newMetadataSource(db, opts...),
+		MetainfoAttempt:            newMetainfoAttempt(db, opts...),
+		SavedSearch:                newSavedSearch(db, opts...),
+		SavedSearchMatch:           newSavedSearchMatch(db, opts...),
+		ServarrPush:                newServarrPush(db, opts...),
+		Takedown:                   newTakedown(db, opts...),
+		TakedownLog:                newTakedownLog(db, opts...),
+		TaskRun:                    newTaskRun(db, opts...),
+		Torrent:                    newTorrent(db, opts...),
+		TorrentContent:             newTorrentContent(db, opts...),
+		TorrentDownload:            newTorrentDownload(db, opts...),
+		TorrentFile:                newTorrentFile(db, opts...),
+		TorrentHint:                newTorrentHint(db, opts...),
+		TorrentReport:              newTorrentReport(db, opts...),
+		TorrentSource:              newTorrentSource(db, opts...),
+		TorrentTag:                 newTorrentTag(db, opts...),
+		TorrentsTorrentSource:      newTorrentsTorrentSource(db, opts...),
+		TorznabAPIKey:              newTorznabAPIKey(db, opts...),
+		WantedItem:                 newWantedItem(db, opts...),
+		WebhookDelivery:            newWebhookDelivery(db, opts...),
 	}
 }
 
 type Query struct {
 	db *gorm.DB
 
-	BloomFilter              bloomFilter
-	Content                  content
-	ContentAttribute         contentAttribute
-	ContentCollection        contentCollection
-	ContentCollectionContent contentCollectionContent
-	KeyValue                 keyValue
-	MetadataSource           metadataSource
-	Torrent                  torrent
-	TorrentContent           torrentContent
-	TorrentFile              torrentFile
-	TorrentHint              torrentHint
-	TorrentSource            torrentSource
-	TorrentTag               torrentTag
-	TorrentsTorrentSource    torrentsTorrentSource
+	AuditLog                   auditLog
+	BloomFilter                bloomFilter
+	ClassificationAttempt      classificationAttempt
+	ClassificationTrace        classificationTrace
+	ClassifierShadowDivergence classifierShadowDivergence
+	Content                    content
+	ContentAttribute           contentAttribute
+	ContentCollection          contentCollection
+	ContentCollectionContent   contentCollectionContent
+	ContentFlag                contentFlag
+	ContentPerson              contentPerson
+	KeyValue                   keyValue
+	MetadataSource             metadataSource
+	MetainfoAttempt            metainfoAttempt
+	SavedSearch                savedSearch
+	SavedSearchMatch           savedSearchMatch
+	ServarrPush                servarrPush
+	Takedown                   takedown
+	TakedownLog                takedownLog
+	TaskRun                    taskRun
+	Torrent                    torrent
+	TorrentContent             torrentContent
+	TorrentDownload            torrentDownload
+	TorrentFile                torrentFile
+	TorrentHint                torrentHint
+	TorrentReport              torrentReport
+	TorrentSource              torrentSource
+	TorrentTag                 torrentTag
+	TorrentsTorrentSource      torrentsTorrentSource
+	TorznabAPIKey              torznabAPIKey
+	WantedItem                 wantedItem
+	WebhookDelivery            webhookDelivery
 }
 
 func (q *Query) Available() bool { return q.db != nil }
 
 func (q *Query) clone(db *gorm.DB) *Query {
 	return &Query{
-		db:                       db,
-		BloomFilter:              q.BloomFilter.clone(db),
-		Content:                  q.Content.clone(db),
-		ContentAttribute:         q.ContentAttribute.clone(db),
-		ContentCollection:        q.ContentCollection.clone(db),
-		ContentCollectionContent: q.ContentCollectionContent.clone(db),
-		KeyValue:                 q.KeyValue.clone(db),
-		MetadataSource:           q.MetadataSource.clone(db),
-		Torrent:                  q.Torrent.clone(db),
-		TorrentContent:           q.TorrentContent.clone(db),
-		TorrentFile:              q.TorrentFile.clone(db),
-		TorrentHint:              q.TorrentHint.clone(db),
-		TorrentSource:            q.TorrentSource.clone(db),
-		TorrentTag:               q.TorrentTag.clone(db),
-		TorrentsTorrentSource:    q.TorrentsTorrentSource.clone(db),
+		db:                         db,
+		AuditLog:                   q.AuditLog.clone(db),
+		BloomFilter:                q.BloomFilter.clone(db),
+		ClassificationAttempt:      q.ClassificationAttempt.clone(db),
+		ClassificationTrace:        q.ClassificationTrace.clone(db),
+		ClassifierShadowDivergence: q.ClassifierShadowDivergence.clone(db),
+		Content:                    q.Content.clone(db),
+		ContentAttribute:           q.ContentAttribute.clone(db),
+		ContentCollection:          q.ContentCollection.clone(db),
+		ContentCollectionContent:   q.ContentCollectionContent.clone(db),
+		ContentFlag:                q.ContentFlag.clone(db),
+		ContentPerson:              q.ContentPerson.clone(db),
+		KeyValue:                   q.KeyValue.clone(db),
+		MetadataSource:             q.MetadataSource.clone(db),
+		MetainfoAttempt:            q.MetainfoAttempt.clone(db),
+		SavedSearch:                q.SavedSearch.clone(db),
+		SavedSearchMatch:           q.SavedSearchMatch.clone(db),
+		ServarrPush:                q.ServarrPush.clone(db),
+		Takedown:                   q.Takedown.clone(db),
+		TakedownLog:                q.TakedownLog.clone(db),
+		TaskRun:                    q.TaskRun.clone(db),
+		Torrent:                    q.Torrent.clone(db),
+		TorrentContent:             q.TorrentContent.clone(db),
+		TorrentDownload:            q.TorrentDownload.clone(db),
+		TorrentFile:                q.TorrentFile.clone(db),
+		TorrentHint:                q.TorrentHint.clone(db),
+		TorrentReport:              q.TorrentReport.clone(db),
+		TorrentSource:              q.TorrentSource.clone(db),
+		TorrentTag:                 q.TorrentTag.clone(db),
+		TorrentsTorrentSource:      q.TorrentsTorrentSource.clone(db),
+		TorznabAPIKey:              q.TorznabAPIKey.clone(db),
+		WantedItem:                 q.WantedItem.clone(db),
+		WebhookDelivery:            q.WebhookDelivery.clone(db),
 	}
 }
 
@@ -122,57 +212,111 @@ func (q *Query) WriteDB() *Query {
 
 func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 	return &Query{
-		db:                       db,
-		BloomFilter:              q.BloomFilter.replaceDB(db),
-		Content:                  q.Content.replaceDB(db),
-		ContentAttribute:         q.ContentAttribute.replaceDB(db),
-		ContentCollection:        q.ContentCollection.replaceDB(db),
-		ContentCollectionContent: q.ContentCollectionContent.replaceDB(db),
-		KeyValue:                 q.KeyValue.replaceDB(db),
-		MetadataSource:           q.MetadataSource.replaceDB(db),
-		Torrent:                  q.Torrent.replaceDB(db),
-		TorrentContent:           q.TorrentContent.replaceDB(db),
-		TorrentFile:              q.TorrentFile.replaceDB(db),
-		TorrentHint:              q.TorrentHint.replaceDB(db),
-		TorrentSource:            q.TorrentSource.replaceDB(db),
-		TorrentTag:               q.TorrentTag.replaceDB(db),
-		TorrentsTorrentSource:    q.TorrentsTorrentSource.replaceDB(db),
+		db:                         db,
+		AuditLog:                   q.AuditLog.replaceDB(db),
+		BloomFilter:                q.BloomFilter.replaceDB(db),
+		ClassificationAttempt:      q.ClassificationAttempt.replaceDB(db),
+		ClassificationTrace:        q.ClassificationTrace.replaceDB(db),
+		ClassifierShadowDivergence: q.ClassifierShadowDivergence.replaceDB(db),
+		Content:                    q.Content.replaceDB(db),
+		ContentAttribute:           q.ContentAttribute.replaceDB(db),
+		ContentCollection:          q.ContentCollection.replaceDB(db),
+		ContentCollectionContent:   q.ContentCollectionContent.replaceDB(db),
+		ContentFlag:                q.ContentFlag.replaceDB(db),
+		ContentPerson:              q.ContentPerson.replaceDB(db),
+		KeyValue:                   q.KeyValue.replaceDB(db),
+		MetadataSource:             q.MetadataSource.replaceDB(db),
+		MetainfoAttempt:            q.MetainfoAttempt.replaceDB(db),
+		SavedSearch:                q.SavedSearch.replaceDB(db),
+		SavedSearchMatch:           q.SavedSearchMatch.replaceDB(db),
+		ServarrPush:                q.ServarrPush.replaceDB(db),
+		Takedown:                   q.Takedown.replaceDB(db),
+		TakedownLog:                q.TakedownLog.replaceDB(db),
+		TaskRun:                    q.TaskRun.replaceDB(db),
+		Torrent:                    q.Torrent.replaceDB(db),
+		TorrentContent:             q.TorrentContent.replaceDB(db),
+		TorrentDownload:            q.TorrentDownload.replaceDB(db),
+		TorrentFile:                q.TorrentFile.replaceDB(db),
+		TorrentHint:                q.TorrentHint.replaceDB(db),
+		TorrentReport:              q.TorrentReport.replaceDB(db),
+		TorrentSource:              q.TorrentSource.replaceDB(db),
+		TorrentTag:                 q.TorrentTag.replaceDB(db),
+		TorrentsTorrentSource:      q.TorrentsTorrentSource.replaceDB(db),
+		TorznabAPIKey:              q.TorznabAPIKey.replaceDB(db),
+		WantedItem:                 q.WantedItem.replaceDB(db),
+		WebhookDelivery:            q.WebhookDelivery.replaceDB(db),
 	}
 }
 
 type queryCtx struct {
-	BloomFilter              IBloomFilterDo
-	Content                  IContentDo
-	ContentAttribute         IContentAttributeDo
-	ContentCollection        IContentCollectionDo
-	ContentCollectionContent IContentCollectionContentDo
-	KeyValue                 IKeyValueDo
-	MetadataSource           IMetadataSourceDo
-	Torrent                  ITorrentDo
-	TorrentContent           ITorrentContentDo
-	TorrentFile              ITorrentFileDo
-	TorrentHint              ITorrentHintDo
-	TorrentSource            ITorrentSourceDo
-	TorrentTag               ITorrentTagDo
-	TorrentsTorrentSource    ITorrentsTorrentSourceDo
+	AuditLog                   IAuditLogDo
+	BloomFilter                IBloomFilterDo
+	ClassificationAttempt      IClassificationAttemptDo
+	ClassificationTrace        IClassificationTraceDo
+	ClassifierShadowDivergence IClassifierShadowDivergenceDo
+	Content                    IContentDo
+	ContentAttribute           IContentAttributeDo
+	ContentCollection          IContentCollectionDo
+	ContentCollectionContent   IContentCollectionContentDo
+	ContentFlag                IContentFlagDo
+	ContentPerson              IContentPersonDo
+	KeyValue                   IKeyValueDo
+	MetadataSource             IMetadataSourceDo
+	MetainfoAttempt            IMetainfoAttemptDo
+	SavedSearch                ISavedSearchDo
+	SavedSearchMatch           ISavedSearchMatchDo
+	ServarrPush                IServarrPushDo
+	Takedown                   ITakedownDo
+	TakedownLog                ITakedownLogDo
+	TaskRun                    ITaskRunDo
+	Torrent                    ITorrentDo
+	TorrentContent             ITorrentContentDo
+	TorrentDownload            ITorrentDownloadDo
+	TorrentFile                ITorrentFileDo
+	TorrentHint                ITorrentHintDo
+	TorrentReport              ITorrentReportDo
+	TorrentSource              ITorrentSourceDo
+	TorrentTag                 ITorrentTagDo
+	TorrentsTorrentSource      ITorrentsTorrentSourceDo
+	TorznabAPIKey              ITorznabAPIKeyDo
+	WantedItem                 IWantedItemDo
+	WebhookDelivery            IWebhookDeliveryDo
 }
 
 func (q *Query) WithContext(ctx context.Context) *queryCtx {
 	return &queryCtx{
-		BloomFilter:              q.BloomFilter.WithContext(ctx),
-		Content:                  q.Content.WithContext(ctx),
-		ContentAttribute:         q.ContentAttribute.WithContext(ctx),
-		ContentCollection:        q.ContentCollection.WithContext(ctx),
-		ContentCollectionContent: q.ContentCollectionContent.WithContext(ctx),
-		KeyValue:                 q.KeyValue.WithContext(ctx),
-		MetadataSource:           q.MetadataSource.WithContext(ctx),
-		Torrent:                  q.Torrent.WithContext(ctx),
-		TorrentContent:           q.TorrentContent.WithContext(ctx),
-		TorrentFile:              q.TorrentFile.WithContext(ctx),
-		TorrentHint:              q.TorrentHint.WithContext(ctx),
-		TorrentSource:            q.TorrentSource.WithContext(ctx),
-		TorrentTag:               q.TorrentTag.WithContext(ctx),
-		TorrentsTorrentSource:    q.TorrentsTorrentSource.WithContext(ctx),
+		AuditLog:                   q.AuditLog.WithContext(ctx),
+		BloomFilter:                q.BloomFilter.WithContext(ctx),
+		ClassificationAttempt:      q.ClassificationAttempt.WithContext(ctx),
+		ClassificationTrace:        q.ClassificationTrace.WithContext(ctx),
+		ClassifierShadowDivergence: q.ClassifierShadowDivergence.WithContext(ctx),
+		Content:                    q.Content.WithContext(ctx),
+		ContentAttribute:           q.ContentAttribute.WithContext(ctx),
+		ContentCollection:          q.ContentCollection.WithContext(ctx),
+		ContentCollectionContent:   q.ContentCollectionContent.WithContext(ctx),
+		ContentFlag:                q.ContentFlag.WithContext(ctx),
+		ContentPerson:              q.ContentPerson.WithContext(ctx),
+		KeyValue:                   q.KeyValue.WithContext(ctx),
+		MetadataSource:             q.MetadataSource.WithContext(ctx),
+		MetainfoAttempt:            q.MetainfoAttempt.WithContext(ctx),
+		SavedSearch:                q.SavedSearch.WithContext(ctx),
+		SavedSearchMatch:           q.SavedSearchMatch.WithContext(ctx),
+		ServarrPush:                q.ServarrPush.WithContext(ctx),
+		Takedown:                   q.Takedown.WithContext(ctx),
+		TakedownLog:                q.TakedownLog.WithContext(ctx),
+		TaskRun:                    q.TaskRun.WithContext(ctx),
+		Torrent:                    q.Torrent.WithContext(ctx),
+		TorrentContent:             q.TorrentContent.WithContext(ctx),
+		TorrentDownload:            q.TorrentDownload.WithContext(ctx),
+		TorrentFile:                q.TorrentFile.WithContext(ctx),
+		TorrentHint:                q.TorrentHint.WithContext(ctx),
+		TorrentReport:              q.TorrentReport.WithContext(ctx),
+		TorrentSource:              q.TorrentSource.WithContext(ctx),
+		TorrentTag:                 q.TorrentTag.WithContext(ctx),
+		TorrentsTorrentSource:      q.TorrentsTorrentSource.WithContext(ctx),
+		TorznabAPIKey:              q.TorznabAPIKey.WithContext(ctx),
+		WantedItem:                 q.WantedItem.WithContext(ctx),
+		WebhookDelivery:            q.WebhookDelivery.WithContext(ctx),
 	}
 }
 
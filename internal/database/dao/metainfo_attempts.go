@@ -0,0 +1,45 @@
+package dao
+
+import (
+	"context"
+	"database/sql/driver"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+// RetryMetainfoNow schedules an immediate meta info fetch attempt for those of the given torrents whose files are unknown.
+// The attempt count is reset, so that torrents which had exhausted their automatic retries become eligible again.
+func (q *Query) RetryMetainfoNow(ctx context.Context, infoHashes []protocol.ID) error {
+	var valuers []driver.Valuer
+	for _, infoHash := range infoHashes {
+		valuers = append(valuers, infoHash)
+	}
+	var noInfoHashes []protocol.ID
+	if err := q.Torrent.WithContext(ctx).Where(
+		q.Torrent.InfoHash.In(valuers...),
+		q.Torrent.FilesStatus.Eq(model.FilesStatusNoInfo),
+	).Pluck(q.Torrent.InfoHash, &noInfoHashes); err != nil {
+		return err
+	}
+	if len(noInfoHashes) == 0 {
+		return nil
+	}
+	now := time.Now()
+	attempts := make([]*model.MetainfoAttempt, 0, len(noInfoHashes))
+	for _, infoHash := range noInfoHashes {
+		attempts = append(attempts, &model.MetainfoAttempt{
+			InfoHash:      infoHash,
+			NextAttemptAt: now,
+		})
+	}
+	return q.MetainfoAttempt.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: string(q.MetainfoAttempt.InfoHash.ColumnName())}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			string(q.MetainfoAttempt.Attempts.ColumnName()),
+			string(q.MetainfoAttempt.NextAttemptAt.ColumnName()),
+			string(q.MetainfoAttempt.UpdatedAt.ColumnName()),
+		}),
+	}).CreateInBatches(attempts, 100)
+}
@@ -0,0 +1,13 @@
+package dao
+
+import (
+	"gorm.io/gen"
+)
+
+// RawCondition returns a condition of raw SQL, for expressions that can't be built from fields, such as a regular
+// expression match; gen.Cond doesn't accept a clause.Expr, so the SQL is wrapped in a query of its own.
+func (q *Query) RawCondition(sql string, vars ...interface{}) gen.Condition {
+	var do gen.DO
+	do.ReplaceDB(q.db.Where(sql, vars...))
+	return &do
+}
@@ -43,6 +43,21 @@ func newTorrentContent(db *gorm.DB, opts ...gen.DOOption) torrentContent {
 	_torrentContent.CreatedAt = field.NewTime(tableName, "created_at")
 	_torrentContent.UpdatedAt = field.NewTime(tableName, "updated_at")
 	_torrentContent.Tsv = field.NewField(tableName, "tsv")
+	_torrentContent.ClassifierVersion = field.NewUint(tableName, "classifier_version")
+	_torrentContent.SubtitleLanguages = field.NewField(tableName, "subtitle_languages")
+	_torrentContent.Subtitled = field.NewBool(tableName, "subtitled")
+	_torrentContent.MultiAudio = field.NewBool(tableName, "multi_audio")
+	_torrentContent.MatchConfidence = field.NewFloat32(tableName, "match_confidence")
+	_torrentContent.ReleaseTokens = field.NewField(tableName, "release_tokens")
+	_torrentContent.HdrFormats = field.NewField(tableName, "hdr_formats")
+	_torrentContent.AudioFormats = field.NewField(tableName, "audio_formats")
+	_torrentContent.AirDate = field.NewTime(tableName, "air_date")
+	_torrentContent.SportEvent = field.NewField(tableName, "sport_event")
+	_torrentContent.FileContents = field.NewField(tableName, "file_contents")
+	_torrentContent.ProbablyFake = field.NewBool(tableName, "probably_fake")
+	_torrentContent.SpamReasons = field.NewField(tableName, "spam_reasons")
+	_torrentContent.Quarantined = field.NewBool(tableName, "quarantined")
+	_torrentContent.QuarantineOverride = field.NewBool(tableName, "quarantine_override")
 	_torrentContent.Torrent = torrentContentBelongsToTorrent{
 		db: db.Session(&gorm.Session{}),
 
@@ -127,24 +142,39 @@ func newTorrentContent(db *gorm.DB, opts ...gen.DOOption) torrentContent {
 type torrentContent struct {
 	torrentContentDo
 
-	ALL             field.Asterisk
-	ID              field.String
-	InfoHash        field.Field
-	ContentType     field.String
-	ContentSource   field.String
-	ContentID       field.String
-	Languages       field.Field
-	Episodes        field.Field
-	VideoResolution field.Field
-	VideoSource     field.Field
-	VideoCodec      field.Field
-	Video3d         field.Field
-	VideoModifier   field.Field
-	ReleaseGroup    field.Field
-	CreatedAt       field.Time
-	UpdatedAt       field.Time
-	Tsv             field.Field
-	Torrent         torrentContentBelongsToTorrent
+	ALL                field.Asterisk
+	ID                 field.String
+	InfoHash           field.Field
+	ContentType        field.String
+	ContentSource      field.String
+	ContentID          field.String
+	Languages          field.Field
+	Episodes           field.Field
+	VideoResolution    field.Field
+	VideoSource        field.Field
+	VideoCodec         field.Field
+	Video3d            field.Field
+	VideoModifier      field.Field
+	ReleaseGroup       field.Field
+	CreatedAt          field.Time
+	UpdatedAt          field.Time
+	Tsv                field.Field
+	ClassifierVersion  field.Uint
+	SubtitleLanguages  field.Field
+	Subtitled          field.Bool
+	MultiAudio         field.Bool
+	MatchConfidence    field.Float32
+	ReleaseTokens      field.Field
+	HdrFormats         field.Field
+	AudioFormats       field.Field
+	AirDate            field.Time
+	SportEvent         field.Field
+	FileContents       field.Field
+	ProbablyFake       field.Bool
+	SpamReasons        field.Field
+	Quarantined        field.Bool
+	QuarantineOverride field.Bool
+	Torrent            torrentContentBelongsToTorrent
 
 	Content torrentContentBelongsToContent
 
@@ -179,6 +209,21 @@ func (t *torrentContent) updateTableName(table string) *torrentContent {
 	t.CreatedAt = field.NewTime(table, "created_at")
 	t.UpdatedAt = field.NewTime(table, "updated_at")
 	t.Tsv = field.NewField(table, "tsv")
+	t.ClassifierVersion = field.NewUint(table, "classifier_version")
+	t.SubtitleLanguages = field.NewField(table, "subtitle_languages")
+	t.Subtitled = field.NewBool(table, "subtitled")
+	t.MultiAudio = field.NewBool(table, "multi_audio")
+	t.MatchConfidence = field.NewFloat32(table, "match_confidence")
+	t.ReleaseTokens = field.NewField(table, "release_tokens")
+	t.HdrFormats = field.NewField(table, "hdr_formats")
+	t.AudioFormats = field.NewField(table, "audio_formats")
+	t.AirDate = field.NewTime(table, "air_date")
+	t.SportEvent = field.NewField(table, "sport_event")
+	t.FileContents = field.NewField(table, "file_contents")
+	t.ProbablyFake = field.NewBool(table, "probably_fake")
+	t.SpamReasons = field.NewField(table, "spam_reasons")
+	t.Quarantined = field.NewBool(table, "quarantined")
+	t.QuarantineOverride = field.NewBool(table, "quarantine_override")
 
 	t.fillFieldMap()
 
@@ -195,7 +240,7 @@ func (t *torrentContent) GetFieldByName(fieldName string) (field.OrderExpr, bool
 }
 
 func (t *torrentContent) fillFieldMap() {
-	t.fieldMap = make(map[string]field.Expr, 18)
+	t.fieldMap = make(map[string]field.Expr, 33)
 	t.fieldMap["id"] = t.ID
 	t.fieldMap["info_hash"] = t.InfoHash
 	t.fieldMap["content_type"] = t.ContentType
@@ -212,6 +257,21 @@ func (t *torrentContent) fillFieldMap() {
 	t.fieldMap["created_at"] = t.CreatedAt
 	t.fieldMap["updated_at"] = t.UpdatedAt
 	t.fieldMap["tsv"] = t.Tsv
+	t.fieldMap["classifier_version"] = t.ClassifierVersion
+	t.fieldMap["subtitle_languages"] = t.SubtitleLanguages
+	t.fieldMap["subtitled"] = t.Subtitled
+	t.fieldMap["multi_audio"] = t.MultiAudio
+	t.fieldMap["match_confidence"] = t.MatchConfidence
+	t.fieldMap["release_tokens"] = t.ReleaseTokens
+	t.fieldMap["hdr_formats"] = t.HdrFormats
+	t.fieldMap["audio_formats"] = t.AudioFormats
+	t.fieldMap["air_date"] = t.AirDate
+	t.fieldMap["sport_event"] = t.SportEvent
+	t.fieldMap["file_contents"] = t.FileContents
+	t.fieldMap["probably_fake"] = t.ProbablyFake
+	t.fieldMap["spam_reasons"] = t.SpamReasons
+	t.fieldMap["quarantined"] = t.Quarantined
+	t.fieldMap["quarantine_override"] = t.QuarantineOverride
 
 }
 
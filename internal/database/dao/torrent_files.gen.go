@@ -34,6 +34,7 @@ func newTorrentFile(db *gorm.DB, opts ...gen.DOOption) torrentFile {
 	_torrentFile.Size = field.NewUint64(tableName, "size")
 	_torrentFile.CreatedAt = field.NewTime(tableName, "created_at")
 	_torrentFile.UpdatedAt = field.NewTime(tableName, "updated_at")
+	_torrentFile.Tsv = field.NewField(tableName, "tsv")
 
 	_torrentFile.fillFieldMap()
 
@@ -51,6 +52,7 @@ type torrentFile struct {
 	Size      field.Uint64
 	CreatedAt field.Time
 	UpdatedAt field.Time
+	Tsv       field.Field
 
 	fieldMap map[string]field.Expr
 }
@@ -74,6 +76,7 @@ func (t *torrentFile) updateTableName(table string) *torrentFile {
 	t.Size = field.NewUint64(table, "size")
 	t.CreatedAt = field.NewTime(table, "created_at")
 	t.UpdatedAt = field.NewTime(table, "updated_at")
+	t.Tsv = field.NewField(table, "tsv")
 
 	t.fillFieldMap()
 
@@ -90,7 +93,7 @@ func (t *torrentFile) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (t *torrentFile) fillFieldMap() {
-	t.fieldMap = make(map[string]field.Expr, 7)
+	t.fieldMap = make(map[string]field.Expr, 8)
 	t.fieldMap["info_hash"] = t.InfoHash
 	t.fieldMap["index"] = t.Index
 	t.fieldMap["path"] = t.Path
@@ -98,6 +101,7 @@ func (t *torrentFile) fillFieldMap() {
 	t.fieldMap["size"] = t.Size
 	t.fieldMap["created_at"] = t.CreatedAt
 	t.fieldMap["updated_at"] = t.UpdatedAt
+	t.fieldMap["tsv"] = t.Tsv
 }
 
 func (t torrentFile) clone(db *gorm.DB) torrentFile {
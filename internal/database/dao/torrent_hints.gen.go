@@ -41,6 +41,7 @@ func newTorrentHint(db *gorm.DB, opts ...gen.DOOption) torrentHint {
 	_torrentHint.Video3d = field.NewField(tableName, "video_3d")
 	_torrentHint.VideoModifier = field.NewField(tableName, "video_modifier")
 	_torrentHint.ReleaseGroup = field.NewField(tableName, "release_group")
+	_torrentHint.Override = field.NewBool(tableName, "override")
 	_torrentHint.CreatedAt = field.NewTime(tableName, "created_at")
 	_torrentHint.UpdatedAt = field.NewTime(tableName, "updated_at")
 
@@ -67,6 +68,7 @@ type torrentHint struct {
 	Video3d         field.Field
 	VideoModifier   field.Field
 	ReleaseGroup    field.Field
+	Override        field.Bool
 	CreatedAt       field.Time
 	UpdatedAt       field.Time
 
@@ -99,6 +101,7 @@ func (t *torrentHint) updateTableName(table string) *torrentHint {
 	t.Video3d = field.NewField(table, "video_3d")
 	t.VideoModifier = field.NewField(table, "video_modifier")
 	t.ReleaseGroup = field.NewField(table, "release_group")
+	t.Override = field.NewBool(table, "override")
 	t.CreatedAt = field.NewTime(table, "created_at")
 	t.UpdatedAt = field.NewTime(table, "updated_at")
 
@@ -117,7 +120,7 @@ func (t *torrentHint) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (t *torrentHint) fillFieldMap() {
-	t.fieldMap = make(map[string]field.Expr, 16)
+	t.fieldMap = make(map[string]field.Expr, 17)
 	t.fieldMap["info_hash"] = t.InfoHash
 	t.fieldMap["content_type"] = t.ContentType
 	t.fieldMap["content_source"] = t.ContentSource
@@ -132,6 +135,7 @@ func (t *torrentHint) fillFieldMap() {
 	t.fieldMap["video_3d"] = t.Video3d
 	t.fieldMap["video_modifier"] = t.VideoModifier
 	t.fieldMap["release_group"] = t.ReleaseGroup
+	t.fieldMap["override"] = t.Override
 	t.fieldMap["created_at"] = t.CreatedAt
 	t.fieldMap["updated_at"] = t.UpdatedAt
 }
@@ -0,0 +1,50 @@
+package dao
+
+import (
+	"context"
+	"database/sql/driver"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+)
+
+// Counts returns the numbers of reports of the torrents that have any, by reason.
+func (t *torrentReport) Counts(ctx context.Context, infoHashes []protocol.ID) ([]model.TorrentReportCounts, error) {
+	if len(infoHashes) == 0 {
+		return nil, nil
+	}
+	valuers := make([]driver.Valuer, 0, len(infoHashes))
+	for _, infoHash := range infoHashes {
+		valuers = append(valuers, infoHash)
+	}
+	var rows []struct {
+		InfoHash protocol.ID
+		Reason   model.TorrentReportReason
+		Count    uint
+	}
+	if err := t.WithContext(ctx).Select(
+		t.InfoHash,
+		t.Reason,
+		t.InfoHash.Count().As("count"),
+	).Where(
+		t.InfoHash.In(valuers...),
+	).Group(t.InfoHash, t.Reason).Scan(&rows); err != nil {
+		return nil, err
+	}
+	indexes := make(map[protocol.ID]int, len(rows))
+	counts := make([]model.TorrentReportCounts, 0, len(rows))
+	for _, row := range rows {
+		i, ok := indexes[row.InfoHash]
+		if !ok {
+			i = len(counts)
+			indexes[row.InfoHash] = i
+			counts = append(counts, model.TorrentReportCounts{InfoHash: row.InfoHash})
+		}
+		switch row.Reason {
+		case model.TorrentReportReasonFake:
+			counts[i].Fake = row.Count
+		case model.TorrentReportReasonMislabeled:
+			counts[i].Mislabeled = row.Count
+		}
+	}
+	return counts, nil
+}
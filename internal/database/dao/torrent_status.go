@@ -0,0 +1,38 @@
+package dao
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+)
+
+// TorrentStatus returns whether a torrent exists and how it has been classified, in a single query that only reads
+// the primary key of torrents and a covering index of torrent contents, for frequent checks by external tools.
+func (q *Query) TorrentStatus(ctx context.Context, infoHash protocol.ID) (model.TorrentStatus, error) {
+	var row struct {
+		Exists      bool
+		Classified  bool
+		ContentType model.NullContentType
+		Quarantined bool
+	}
+	if err := q.Torrent.WithContext(ctx).UnderlyingDB().Raw(
+		`select exists(select 1 from torrents where info_hash = @info_hash) as exists,
+			tc.info_hash is not null as classified,
+			tc.content_type,
+			coalesce(tc.quarantined, false) as quarantined
+		from (select 1) as s
+		left join lateral (
+			select info_hash, content_type, quarantined from torrent_contents where info_hash = @info_hash limit 1
+		) as tc on true`,
+		map[string]any{"info_hash": infoHash},
+	).Scan(&row).Error; err != nil {
+		return model.TorrentStatus{}, err
+	}
+	return model.TorrentStatus{
+		InfoHash:    infoHash,
+		Exists:      row.Exists,
+		Classified:  row.Classified,
+		ContentType: row.ContentType,
+		Quarantined: row.Quarantined,
+	}, nil
+}
@@ -0,0 +1,53 @@
+package dao
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"gorm.io/gen"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+// DeleteDeadTorrents deletes torrents for which a health estimate exists, but no source has a health of at least minHealth.
+// Torrents that have never been scraped are left alone. Health is decayed by the time since it was estimated, as a
+// torrent that's no longer seen in the DHT isn't scraped again to update it.
+func (q *Query) DeleteDeadTorrents(ctx context.Context, minHealth float32, halfLife time.Duration) (int64, error) {
+	do := q.Torrent.WithContext(ctx).Where(
+		gen.Exists(
+			q.TorrentsTorrentSource.Where(
+				q.TorrentsTorrentSource.InfoHash.EqCol(q.Torrent.InfoHash),
+				q.TorrentsTorrentSource.Health.IsNotNull(),
+			),
+		),
+	)
+	do.UnderlyingDB().Where(
+		"NOT EXISTS (SELECT 1 FROM ? AS tts WHERE ? = ? AND ? >= ?)",
+		clause.Table{Name: model.TableNameTorrentsTorrentSource},
+		clause.Column{Table: "tts", Name: string(q.TorrentsTorrentSource.InfoHash.ColumnName())},
+		clause.Column{Table: model.TableNameTorrent, Name: string(q.Torrent.InfoHash.ColumnName())},
+		decayedHealth("tts", halfLife),
+		float64(minHealth),
+	)
+	result, err := do.Delete()
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected, nil
+}
+
+// decayedHealth is the health of the torrent sources of a table as of now, halving every halfLife since it was
+// estimated, as in model.TorrentsTorrentSource.DecayedHealth.
+func decayedHealth(table string, halfLife time.Duration) clause.Expr {
+	health := clause.Column{Table: table, Name: "health"}
+	if halfLife <= 0 {
+		return clause.Expr{SQL: "?", Vars: []interface{}{health}}
+	}
+	return clause.Expr{
+		SQL: "? * power(0.5, greatest(extract(epoch FROM now() - ?)::float8, 0) / ?::float8)",
+		Vars: []interface{}{
+			health,
+			clause.Column{Table: table, Name: "updated_at"},
+			halfLife.Seconds(),
+		},
+	}
+}
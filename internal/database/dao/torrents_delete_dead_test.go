@@ -0,0 +1,43 @@
+package dao
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestDeleteDeadTorrents(t *testing.T) {
+	t.Parallel()
+
+	t.Run("decays health by the time since it was estimated", func(t *testing.T) {
+		t.Parallel()
+		q, connector := newFakeQuery(t)
+		_, err := q.DeleteDeadTorrents(context.Background(), 0.5, 30*24*time.Hour)
+		require.NoError(t, err)
+		statements := connector.executed()
+		require.Len(t, statements, 1)
+		assert.Equal(t, "DELETE FROM `torrents` WHERE "+
+			"EXISTS (SELECT * FROM `torrents_torrent_sources` WHERE "+
+			"`torrents_torrent_sources`.`info_hash` = `torrents`.`info_hash` AND "+
+			"`torrents_torrent_sources`.`health` IS NOT NULL) AND "+
+			"(NOT EXISTS (SELECT 1 FROM `torrents_torrent_sources` AS tts WHERE "+
+			"`tts`.`info_hash` = `torrents`.`info_hash` AND "+
+			"`tts`.`health` * power(0.5, greatest(extract(epoch FROM now() - `tts`.`updated_at`)::float8, 0) / ?::float8) >= ?))",
+			statements[0].query)
+		require.Len(t, statements[0].args, 2)
+		assert.Equal(t, float64(30*24*60*60), statements[0].args[0])
+		assert.Equal(t, 0.5, statements[0].args[1])
+	})
+
+	t.Run("without decay", func(t *testing.T) {
+		t.Parallel()
+		q, connector := newFakeQuery(t)
+		_, err := q.DeleteDeadTorrents(context.Background(), 1, 0)
+		require.NoError(t, err)
+		statements := connector.executed()
+		require.Len(t, statements, 1)
+		assert.Contains(t, statements[0].query, "`tts`.`health` >= ?")
+	})
+}
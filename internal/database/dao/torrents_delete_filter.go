@@ -0,0 +1,178 @@
+package dao
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+var ErrEmptyTorrentFilter = errors.New("at least one filter criterion is required")
+
+// TorrentFilter selects torrents for bulk operations; torrents must match every specified criterion.
+type TorrentFilter struct {
+	// NameRegex is a case-insensitive POSIX regular expression matched against the torrent name.
+	NameRegex string
+	MinSize   model.NullUint64
+	MaxSize   model.NullUint64
+	// ContentTypes matches the classified content type; a null content type matches torrents of unknown type.
+	ContentTypes []model.NullContentType
+	Sources      []string
+	// CreatedBefore matches torrents first seen before the given time, if set.
+	CreatedBefore time.Time
+	// ZeroSeeders matches torrents with a known seeder count from at least one source, and none with any seeders.
+	ZeroSeeders bool
+	// NotSeededSince matches torrents with no source seen with any seeders since the given time, if set.
+	NotSeededSince time.Time
+	// MinClassificationAttempts matches torrents that have failed to be classified at least this many consecutive times, if non-zero.
+	MinClassificationAttempts uint
+}
+
+func (f TorrentFilter) IsEmpty() bool {
+	return f.NameRegex == "" && !f.MinSize.Valid && !f.MaxSize.Valid && len(f.ContentTypes) == 0 && len(f.Sources) == 0 &&
+		f.CreatedBefore.IsZero() && !f.ZeroSeeders && f.NotSeededSince.IsZero() && f.MinClassificationAttempts == 0
+}
+
+func (q *Query) torrentFilterConditions(f TorrentFilter) ([]gen.Condition, error) {
+	if f.IsEmpty() {
+		return nil, ErrEmptyTorrentFilter
+	}
+	var conds []gen.Condition
+	if f.NameRegex != "" {
+		conds = append(conds, q.RawCondition(
+			"? ~* ?",
+			clause.Column{Table: q.Torrent.TableName(), Name: string(q.Torrent.Name.ColumnName())},
+			f.NameRegex,
+		))
+	}
+	if f.MinSize.Valid {
+		conds = append(conds, q.Torrent.Size.Gte(f.MinSize.Uint64))
+	}
+	if f.MaxSize.Valid {
+		conds = append(conds, q.Torrent.Size.Lte(f.MaxSize.Uint64))
+	}
+	if len(f.ContentTypes) > 0 {
+		var types []string
+		includeUnknown := false
+		for _, ct := range f.ContentTypes {
+			if ct.Valid {
+				types = append(types, ct.ContentType.String())
+			} else {
+				includeUnknown = true
+			}
+		}
+		var ctConds []field.Expr
+		if len(types) > 0 {
+			ctConds = append(ctConds, q.TorrentContent.ContentType.In(types...))
+		}
+		if includeUnknown {
+			ctConds = append(ctConds, q.TorrentContent.ContentType.IsNull())
+		}
+		ctExists := gen.Exists(q.TorrentContent.Where(
+			q.TorrentContent.InfoHash.EqCol(q.Torrent.InfoHash),
+			field.Or(ctConds...),
+		))
+		if includeUnknown {
+			// torrents that haven't been classified at all are also of unknown type
+			tc := q.TorrentContent.As("tc")
+			unclassified := q.Torrent.Not(gen.Exists(tc.Where(tc.InfoHash.EqCol(q.Torrent.InfoHash))))
+			conds = append(conds, q.Torrent.Where(ctExists).Or(unclassified))
+		} else {
+			conds = append(conds, ctExists)
+		}
+	}
+	if len(f.Sources) > 0 {
+		conds = append(conds, gen.Exists(q.TorrentsTorrentSource.Where(
+			q.TorrentsTorrentSource.InfoHash.EqCol(q.Torrent.InfoHash),
+			q.TorrentsTorrentSource.Source.In(f.Sources...),
+		)))
+	}
+	if !f.CreatedBefore.IsZero() {
+		conds = append(conds, q.Torrent.CreatedAt.Lt(f.CreatedBefore))
+	}
+	if f.ZeroSeeders {
+		seeded := q.TorrentsTorrentSource.As("seeded")
+		conds = append(
+			conds,
+			gen.Exists(q.TorrentsTorrentSource.Where(
+				q.TorrentsTorrentSource.InfoHash.EqCol(q.Torrent.InfoHash),
+				q.TorrentsTorrentSource.Seeders.IsNotNull(),
+			)),
+			q.Torrent.Not(gen.Exists(seeded.Where(
+				seeded.InfoHash.EqCol(q.Torrent.InfoHash),
+				seeded.Seeders.Gt(model.NewNullUint(0)),
+			))),
+		)
+	}
+	if !f.NotSeededSince.IsZero() {
+		recentlySeeded := q.TorrentsTorrentSource.As("recently_seeded")
+		conds = append(conds, q.Torrent.Not(gen.Exists(recentlySeeded.Where(
+			recentlySeeded.InfoHash.EqCol(q.Torrent.InfoHash),
+			recentlySeeded.LastSeededAt.Gte(f.NotSeededSince),
+		))))
+	}
+	if f.MinClassificationAttempts > 0 {
+		conds = append(conds, gen.Exists(q.ClassificationAttempt.Where(
+			q.ClassificationAttempt.InfoHash.EqCol(q.Torrent.InfoHash),
+			q.ClassificationAttempt.Attempts.Gte(f.MinClassificationAttempts),
+		)))
+	}
+	return conds, nil
+}
+
+// CountFilteredTorrents returns the number of torrents matching the filter.
+func (q *Query) CountFilteredTorrents(ctx context.Context, f TorrentFilter) (int64, error) {
+	conds, err := q.torrentFilterConditions(f)
+	if err != nil {
+		return 0, err
+	}
+	return q.Torrent.WithContext(ctx).Where(conds...).Count()
+}
+
+// FindFilteredTorrentHashes returns the info hashes of up to limit torrents matching the filter.
+func (q *Query) FindFilteredTorrentHashes(ctx context.Context, f TorrentFilter, limit int) ([]protocol.ID, error) {
+	conds, err := q.torrentFilterConditions(f)
+	if err != nil {
+		return nil, err
+	}
+	var infoHashes []protocol.ID
+	if err := q.Torrent.WithContext(ctx).Where(conds...).Limit(limit).Pluck(q.Torrent.InfoHash, &infoHashes); err != nil {
+		return nil, err
+	}
+	return infoHashes, nil
+}
+
+// FindFilteredTorrentHashesAfter returns the info hashes of up to limit torrents matching the filter in info hash order,
+// starting after the given info hash if any; unlike FindFilteredTorrentHashes, it can page through torrents that are left in place.
+func (q *Query) FindFilteredTorrentHashesAfter(ctx context.Context, f TorrentFilter, after *protocol.ID, limit int) ([]protocol.ID, error) {
+	conds, err := q.torrentFilterConditions(f)
+	if err != nil {
+		return nil, err
+	}
+	if after != nil {
+		conds = append(conds, q.Torrent.InfoHash.Gt(*after))
+	}
+	var infoHashes []protocol.ID
+	if err := q.Torrent.WithContext(ctx).Where(conds...).Order(q.Torrent.InfoHash).Limit(limit).Pluck(q.Torrent.InfoHash, &infoHashes); err != nil {
+		return nil, err
+	}
+	return infoHashes, nil
+}
+
+// DeleteTorrents deletes the given torrents without blocking them; their contents, files, sources and tags are removed by cascade.
+func (q *Query) DeleteTorrents(ctx context.Context, infoHashes []protocol.ID) (int64, error) {
+	valuers := make([]driver.Valuer, 0, len(infoHashes))
+	for _, infoHash := range infoHashes {
+		valuers = append(valuers, infoHash)
+	}
+	result, err := q.Torrent.WithContext(ctx).Where(q.Torrent.InfoHash.In(valuers...)).Delete()
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,47 @@
+package dao
+
+import (
+	"context"
+	"database/sql/driver"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestCountFilteredTorrents(t *testing.T) {
+	t.Parallel()
+
+	q, connector := newFakeQuery(t)
+	_, err := q.CountFilteredTorrents(context.Background(), TorrentFilter{})
+	assert.ErrorIs(t, err, ErrEmptyTorrentFilter)
+
+	_, err = q.CountFilteredTorrents(context.Background(), TorrentFilter{
+		NameRegex: "^sample",
+		MinSize:   model.NewNullUint64(1000),
+	})
+	require.NoError(t, err)
+	statements := connector.executed()
+	require.Len(t, statements, 1)
+	assert.Equal(t, "SELECT count(*) FROM `torrents` WHERE `torrents`.`name` ~* ? AND `torrents`.`size` >= ?",
+		statements[0].query)
+	require.Len(t, statements[0].args, 2)
+	assert.Equal(t, "^sample", statements[0].args[0])
+}
+
+func TestCountFilteredTorrents_NotSeededSince(t *testing.T) {
+	t.Parallel()
+
+	q, connector := newFakeQuery(t)
+	since := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	_, err := q.CountFilteredTorrents(context.Background(), TorrentFilter{NotSeededSince: since})
+	require.NoError(t, err)
+	statements := connector.executed()
+	require.Len(t, statements, 1)
+	assert.Equal(t, "SELECT count(*) FROM `torrents` WHERE "+
+		"NOT EXISTS (SELECT * FROM `torrents_torrent_sources` AS `recently_seeded` WHERE "+
+		"`recently_seeded`.`info_hash` = `torrents`.`info_hash` AND `recently_seeded`.`last_seeded_at` >= ?)",
+		statements[0].query)
+	assert.Equal(t, []driver.Value{since}, statements[0].args)
+}
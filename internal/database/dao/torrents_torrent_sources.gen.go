@@ -37,6 +37,9 @@ func newTorrentsTorrentSource(db *gorm.DB, opts ...gen.DOOption) torrentsTorrent
 	_torrentsTorrentSource.PublishedAt = field.NewTime(tableName, "published_at")
 	_torrentsTorrentSource.CreatedAt = field.NewTime(tableName, "created_at")
 	_torrentsTorrentSource.UpdatedAt = field.NewTime(tableName, "updated_at")
+	_torrentsTorrentSource.Health = field.NewField(tableName, "health")
+	_torrentsTorrentSource.DiscoveryMethod = field.NewField(tableName, "discovery_method")
+	_torrentsTorrentSource.LastSeededAt = field.NewTime(tableName, "last_seeded_at")
 	_torrentsTorrentSource.TorrentSource = torrentsTorrentSourceHasOneTorrentSource{
 		db: db.Session(&gorm.Session{}),
 
@@ -51,18 +54,21 @@ func newTorrentsTorrentSource(db *gorm.DB, opts ...gen.DOOption) torrentsTorrent
 type torrentsTorrentSource struct {
 	torrentsTorrentSourceDo
 
-	ALL           field.Asterisk
-	Source        field.String
-	InfoHash      field.Field
-	ImportID      field.Field
-	Bfsd          field.Bytes
-	Bfpe          field.Bytes
-	Seeders       field.Field
-	Leechers      field.Field
-	PublishedAt   field.Time
-	CreatedAt     field.Time
-	UpdatedAt     field.Time
-	TorrentSource torrentsTorrentSourceHasOneTorrentSource
+	ALL             field.Asterisk
+	Source          field.String
+	InfoHash        field.Field
+	ImportID        field.Field
+	Bfsd            field.Bytes
+	Bfpe            field.Bytes
+	Seeders         field.Field
+	Leechers        field.Field
+	PublishedAt     field.Time
+	CreatedAt       field.Time
+	UpdatedAt       field.Time
+	Health          field.Field
+	DiscoveryMethod field.Field
+	LastSeededAt    field.Time
+	TorrentSource   torrentsTorrentSourceHasOneTorrentSource
 
 	fieldMap map[string]field.Expr
 }
@@ -89,6 +95,9 @@ func (t *torrentsTorrentSource) updateTableName(table string) *torrentsTorrentSo
 	t.PublishedAt = field.NewTime(table, "published_at")
 	t.CreatedAt = field.NewTime(table, "created_at")
 	t.UpdatedAt = field.NewTime(table, "updated_at")
+	t.Health = field.NewField(table, "health")
+	t.DiscoveryMethod = field.NewField(table, "discovery_method")
+	t.LastSeededAt = field.NewTime(table, "last_seeded_at")
 
 	t.fillFieldMap()
 
@@ -105,7 +114,7 @@ func (t *torrentsTorrentSource) GetFieldByName(fieldName string) (field.OrderExp
 }
 
 func (t *torrentsTorrentSource) fillFieldMap() {
-	t.fieldMap = make(map[string]field.Expr, 11)
+	t.fieldMap = make(map[string]field.Expr, 14)
 	t.fieldMap["source"] = t.Source
 	t.fieldMap["info_hash"] = t.InfoHash
 	t.fieldMap["import_id"] = t.ImportID
@@ -116,6 +125,9 @@ func (t *torrentsTorrentSource) fillFieldMap() {
 	t.fieldMap["published_at"] = t.PublishedAt
 	t.fieldMap["created_at"] = t.CreatedAt
 	t.fieldMap["updated_at"] = t.UpdatedAt
+	t.fieldMap["health"] = t.Health
+	t.fieldMap["discovery_method"] = t.DiscoveryMethod
+	t.fieldMap["last_seeded_at"] = t.LastSeededAt
 
 }
 
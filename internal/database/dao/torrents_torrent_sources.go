@@ -0,0 +1,22 @@
+package dao
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"gorm.io/gorm/clause"
+)
+
+// LastSeededAtAssignment is the assignment of an upsert of torrent sources that keeps the time a source was last
+// seeded, unless the upserted source was seeded more recently.
+func (q *Query) LastSeededAtAssignment() clause.Assignment {
+	col := string(q.TorrentsTorrentSource.LastSeededAt.ColumnName())
+	return clause.Assignment{
+		Column: clause.Column{Name: col},
+		Value: clause.Expr{
+			SQL: "coalesce(excluded.?, ?)",
+			Vars: []interface{}{
+				clause.Column{Name: col},
+				clause.Column{Table: model.TableNameTorrentsTorrentSource, Name: col},
+			},
+		},
+	}
+}
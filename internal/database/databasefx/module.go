@@ -3,6 +3,7 @@ package databasefx
 import (
 	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/config/configfx"
 	"github.com/bitmagnet-io/bitmagnet/internal/database"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/backup"
 	"github.com/bitmagnet-io/bitmagnet/internal/database/cache"
 	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
 	"github.com/bitmagnet-io/bitmagnet/internal/database/healthcheck"
@@ -18,9 +19,11 @@ func New() fx.Option {
 		"database",
 		configfx.NewConfigModule[postgres.Config]("postgres", postgres.NewDefaultConfig()),
 		configfx.NewConfigModule[cache.Config]("gorm_cache", cache.NewDefaultConfig()),
+		configfx.NewConfigModule[search.Config]("search", search.NewDefaultConfig()),
 		configfx.NewConfigModule[warmer.Config]("search_warmer", warmer.NewDefaultConfig()),
 		fx.Provide(
-			cache.NewInMemoryCacher,
+			backup.New,
+			cache.New,
 			cache.NewPlugin,
 			dao.New,
 			database.New,
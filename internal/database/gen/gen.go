@@ -65,6 +65,12 @@ func BuildGenerator(db *gorm.DB) *gen.Generator {
 			return tag
 		}),
 		createdAtReadOnly,
+		gen.FieldType("tsv", "fts.Tsvector"),
+		gen.FieldGORMTag("tsv", func(tag field.GormTag) field.GormTag {
+			tag.Set("->", "false")
+			tag.Set("<-", "create")
+			return tag
+		}),
 	)
 	torrentsTorrentSources := g.GenerateModel(
 		"torrents_torrent_sources",
@@ -73,6 +79,9 @@ func BuildGenerator(db *gorm.DB) *gen.Generator {
 		infoHashReadOnly,
 		gen.FieldType("seeders", "NullUint"),
 		gen.FieldType("leechers", "NullUint"),
+		gen.FieldType("health", "NullFloat32"),
+		gen.FieldType("discovery_method", "NullDiscoveryMethod"),
+		readAndCreateField("discovery_method"),
 		gen.FieldRelate(
 			field.HasOne,
 			"TorrentSource",
@@ -215,6 +224,18 @@ func BuildGenerator(db *gorm.DB) *gen.Generator {
 		readAndCreateField("key"),
 		createdAtReadOnly,
 	)
+	contentPeople := g.GenerateModelAs(
+		"content_people",
+		"ContentPerson",
+		readAndCreateField("content_type"),
+		gen.FieldType("content_type", "ContentType"),
+		readAndCreateField("content_source"),
+		readAndCreateField("content_id"),
+		readAndCreateField("source"),
+		readAndCreateField("credit_id"),
+		gen.FieldType("role", "ContentPersonRole"),
+		createdAtReadOnly,
+	)
 	content := g.GenerateModel(
 		"content",
 		gen.FieldRelate(
@@ -236,6 +257,14 @@ func BuildGenerator(db *gorm.DB) *gen.Generator {
 				RelateSlice: true,
 			},
 		),
+		gen.FieldRelate(
+			field.HasMany,
+			"People",
+			contentPeople,
+			&field.RelateConfig{
+				RelateSlice: true,
+			},
+		),
 		gen.FieldRelate(
 			field.BelongsTo,
 			"MetadataSource",
@@ -263,6 +292,16 @@ func BuildGenerator(db *gorm.DB) *gen.Generator {
 		gen.FieldType("tsv", "fts.Tsvector"),
 		createdAtReadOnly,
 	)
+	contentFlags := g.GenerateModel(
+		"content_flags",
+		readAndCreateField("content_type"),
+		gen.FieldType("content_type", "ContentType"),
+		gen.FieldGenType("content_type", "String"),
+		readAndCreateField("content_source"),
+		readAndCreateField("content_id"),
+		gen.FieldType("best_info_hash", "*protocol.ID"),
+		createdAtReadOnly,
+	)
 	contentCollectionContent := g.GenerateModelAs(
 		"content_collections_content",
 		"ContentCollectionContent",
@@ -298,11 +337,49 @@ func BuildGenerator(db *gorm.DB) *gen.Generator {
 			tag.Set("serializer", "json")
 			return tag
 		}),
+		gen.FieldType("subtitle_languages", "Languages"),
+		gen.FieldGORMTag("subtitle_languages", func(tag field.GormTag) field.GormTag {
+			tag.Set("serializer", "json")
+			return tag
+		}),
+		gen.FieldType("release_tokens", "ReleaseTokens"),
+		gen.FieldGORMTag("release_tokens", func(tag field.GormTag) field.GormTag {
+			tag.Set("serializer", "json")
+			return tag
+		}),
+		gen.FieldType("hdr_formats", "HdrFormats"),
+		gen.FieldGORMTag("hdr_formats", func(tag field.GormTag) field.GormTag {
+			tag.Set("serializer", "json")
+			return tag
+		}),
+		gen.FieldType("audio_formats", "AudioFormats"),
+		gen.FieldGORMTag("audio_formats", func(tag field.GormTag) field.GormTag {
+			tag.Set("serializer", "json")
+			return tag
+		}),
 		gen.FieldType("episodes", "Episodes"),
 		gen.FieldGORMTag("episodes", func(tag field.GormTag) field.GormTag {
 			tag.Set("serializer", "json")
 			return tag
 		}),
+		gen.FieldType("air_date", "Date"),
+		gen.FieldGenType("air_date", "Time"),
+		gen.FieldType("sport_event", "*SportEvent"),
+		gen.FieldGORMTag("sport_event", func(tag field.GormTag) field.GormTag {
+			tag.Set("serializer", "json")
+			return tag
+		}),
+		gen.FieldType("file_contents", "TorrentFileContents"),
+		gen.FieldGORMTag("file_contents", func(tag field.GormTag) field.GormTag {
+			tag.Set("serializer", "json")
+			return tag
+		}),
+		gen.FieldType("spam_reasons", "SpamReasons"),
+		gen.FieldGORMTag("spam_reasons", func(tag field.GormTag) field.GormTag {
+			tag.Set("serializer", "json")
+			return tag
+		}),
+		gen.FieldType("quarantine_override", "NullBool"),
 		gen.FieldType("video_resolution", "NullVideoResolution"),
 		gen.FieldType("video_source", "NullVideoSource"),
 		gen.FieldType("video_codec", "NullVideoCodec"),
@@ -342,6 +419,7 @@ func BuildGenerator(db *gorm.DB) *gen.Generator {
 					},
 				),
 				gen.FieldType("content_type", "NullContentType"),
+				gen.FieldType("match_confidence", "NullFloat32"),
 			},
 			torrentContentBaseOptions...,
 		)...,
@@ -366,7 +444,177 @@ func BuildGenerator(db *gorm.DB) *gen.Generator {
 		"key_values",
 		createdAtReadOnly,
 	)
+	taskRuns := g.GenerateModel(
+		"task_runs",
+		readAndCreateField("kind"),
+		readAndCreateField("started_at"),
+		readAndCreateField("target"),
+		gen.FieldType("status", "TaskRunStatus"),
+		gen.FieldType("finished_at", "*time.Time"),
+		gen.FieldType("total_count", "*int64"),
+		createdAtReadOnly,
+	)
+	metainfoAttempts := g.GenerateModel(
+		"metainfo_attempts",
+		infoHashType,
+		infoHashReadOnly,
+		gen.FieldType("attempts", "uint"),
+		gen.FieldType("last_attempt_at", "*time.Time"),
+		createdAtReadOnly,
+	)
+	classificationAttempts := g.GenerateModel(
+		"classification_attempts",
+		infoHashType,
+		infoHashReadOnly,
+		gen.FieldType("attempts", "uint"),
+		createdAtReadOnly,
+	)
+	classificationTraces := g.GenerateModel(
+		"classification_traces",
+		infoHashType,
+		infoHashReadOnly,
+		gen.FieldType("classifier_version", "uint"),
+		gen.FieldType("classifiers", "[]string"),
+		gen.FieldGORMTag("classifiers", func(tag field.GormTag) field.GormTag {
+			tag.Set("serializer", "json")
+			return tag
+		}),
+		gen.FieldType("tokens", "[]string"),
+		gen.FieldGORMTag("tokens", func(tag field.GormTag) field.GormTag {
+			tag.Set("serializer", "json")
+			return tag
+		}),
+		gen.FieldType("lookups", "[]ClassificationTraceLookup"),
+		gen.FieldGORMTag("lookups", func(tag field.GormTag) field.GormTag {
+			tag.Set("serializer", "json")
+			return tag
+		}),
+		gen.FieldType("match_confidence", "NullFloat32"),
+		createdAtReadOnly,
+	)
+	classifierShadowDivergences := g.GenerateModel(
+		"classifier_shadow_divergences",
+		infoHashType,
+		infoHashReadOnly,
+		gen.FieldType("fields", "[]string"),
+		gen.FieldGORMTag("fields", func(tag field.GormTag) field.GormTag {
+			tag.Set("serializer", "json")
+			return tag
+		}),
+		gen.FieldType("active", "map[string]string"),
+		gen.FieldGORMTag("active", func(tag field.GormTag) field.GormTag {
+			tag.Set("serializer", "json")
+			return tag
+		}),
+		gen.FieldType("shadow", "map[string]string"),
+		gen.FieldGORMTag("shadow", func(tag field.GormTag) field.GormTag {
+			tag.Set("serializer", "json")
+			return tag
+		}),
+		createdAtReadOnly,
+	)
+	takedowns := g.GenerateModel(
+		"takedowns",
+		gen.FieldType("info_hash", "*protocol.ID"),
+		infoHashReadOnly,
+		gen.FieldType("content_type", "NullContentType"),
+		gen.FieldGenType("content_type", "String"),
+		gen.FieldGenType("content_source", "String"),
+		gen.FieldGenType("content_id", "String"),
+		readAndCreateField("content_type"),
+		readAndCreateField("content_source"),
+		readAndCreateField("content_id"),
+		createdAtReadOnly,
+	)
+	takedownLog := g.GenerateModel(
+		"takedown_log",
+		gen.FieldType("info_hash", "*protocol.ID"),
+		gen.FieldType("content_type", "NullContentType"),
+		gen.FieldType("action", "TakedownAction"),
+		gen.FieldType("removed_torrents", "uint"),
+		createdAtReadOnly,
+	)
 
+	wantedItems := g.GenerateModel(
+		"wanted_items",
+		gen.FieldType("content_type", "ContentType"),
+		gen.FieldGenType("content_type", "String"),
+		gen.FieldType("season", "NullUint"),
+		gen.FieldGenType("request_id", "String"),
+		gen.FieldType("min_video_resolution", "NullVideoResolution"),
+		gen.FieldType("fulfilled_info_hash", "*protocol.ID"),
+		createdAtReadOnly,
+	)
+	savedSearches := g.GenerateModel(
+		"saved_searches",
+		gen.FieldType("facets", "[]SavedSearchFacet"),
+		gen.FieldGORMTag("facets", func(tag field.GormTag) field.GormTag {
+			tag.Set("serializer", "json")
+			return tag
+		}),
+		gen.FieldType("order_by", "SavedSearchOrderBy"),
+		gen.FieldType("last_matched_at", "*time.Time"),
+		createdAtReadOnly,
+	)
+	savedSearchMatches := g.GenerateModel(
+		"saved_search_matches",
+		infoHashType,
+		infoHashReadOnly,
+		createdAtReadOnly,
+	)
+	webhookDeliveries := g.GenerateModel(
+		"webhook_deliveries",
+		readAndCreateField("endpoint"),
+		readAndCreateField("event_type"),
+		readAndCreateField("url"),
+		readAndCreateField("payload"),
+		gen.FieldType("event_type", "WebhookEventType"),
+		gen.FieldType("status", "WebhookDeliveryStatus"),
+		gen.FieldType("response_status", "NullUint"),
+		gen.FieldType("next_attempt_at", "time.Time"),
+		gen.FieldType("delivered_at", "*time.Time"),
+		createdAtReadOnly,
+	)
+	servarrPushes := g.GenerateModel(
+		"servarr_pushes",
+		readAndCreateField("target"),
+		infoHashType,
+		infoHashReadOnly,
+		createdAtReadOnly,
+	)
+	auditLogs := g.GenerateModel(
+		"audit_logs",
+		readAndCreateField("actor"),
+		readAndCreateField("client_ip"),
+		readAndCreateField("action"),
+		readAndCreateField("payload"),
+		readAndCreateField("error"),
+		createdAtReadOnly,
+	)
+	torrentDownloads := g.GenerateModel(
+		"torrent_downloads",
+		infoHashType,
+		infoHashReadOnly,
+		readAndCreateField("client"),
+		createdAtReadOnly,
+	)
+	torznabAPIKeys := g.GenerateModel(
+		"torznab_api_keys",
+		readAndCreateField("name"),
+		readAndCreateField("key"),
+		gen.FieldType("rate_limit", "NullUint"),
+		gen.FieldType("last_used_at", "*time.Time"),
+		createdAtReadOnly,
+	)
+	torrentReports := g.GenerateModel(
+		"torrent_reports",
+		infoHashType,
+		infoHashReadOnly,
+		readAndCreateField("reporter"),
+		gen.FieldType("reason", "TorrentReportReason"),
+		gen.FieldGenType("reason", "String"),
+		createdAtReadOnly,
+	)
 	g.ApplyBasic(
 		torrentSources,
 		torrentFiles,
@@ -380,8 +628,26 @@ func BuildGenerator(db *gorm.DB) *gen.Generator {
 		content,
 		contentCollectionContent,
 		contentAttributes,
+		contentPeople,
+		contentFlags,
 		bloomFilters,
 		keyValues,
+		taskRuns,
+		metainfoAttempts,
+		classificationAttempts,
+		classificationTraces,
+		classifierShadowDivergences,
+		takedowns,
+		takedownLog,
+		wantedItems,
+		savedSearches,
+		savedSearchMatches,
+		webhookDeliveries,
+		torznabAPIKeys,
+		servarrPushes,
+		torrentDownloads,
+		auditLogs,
+		torrentReports,
 	)
 
 	return g
@@ -1,9 +1,11 @@
 package database
 
 import (
+	"context"
 	"database/sql"
 	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
 	gorm2 "github.com/bitmagnet-io/bitmagnet/internal/database/gorm"
+	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
@@ -19,8 +21,10 @@ type Params struct {
 
 type Result struct {
 	fx.Out
-	GormDb lazy.Lazy[*gorm.DB]
-	SqlDb  lazy.Lazy[*sql.DB]
+	GormDb    lazy.Lazy[*gorm.DB]
+	SqlDb     lazy.Lazy[*sql.DB]
+	DbStats   prometheus.Collector `group:"prometheus_collectors"`
+	CloseHook fx.Hook              `group:"app_close_hooks"`
 }
 
 func New(p Params) Result {
@@ -37,20 +41,36 @@ func New(p Params) Result {
 		if dbErr != nil {
 			return nil, dbErr
 		}
+		if err := gDb.Use(gorm2.TracingPlugin{}); err != nil {
+			return nil, err
+		}
 		return gDb, nil
 	})
+	sqlDb := lazy.New(func() (*sql.DB, error) {
+		gDb, gDbErr := gormDb.Get()
+		if gDbErr != nil {
+			return nil, gDbErr
+		}
+		sqlDb, sqlDbErr := gDb.DB()
+		if sqlDbErr != nil {
+			return nil, sqlDbErr
+		}
+		return sqlDb, nil
+	})
 	return Result{
-		GormDb: gormDb,
-		SqlDb: lazy.New(func() (*sql.DB, error) {
-			gDb, gDbErr := gormDb.Get()
-			if gDbErr != nil {
-				return nil, gDbErr
-			}
-			sqlDb, sqlDbErr := gDb.DB()
-			if sqlDbErr != nil {
-				return nil, sqlDbErr
-			}
-			return sqlDb, nil
-		}),
+		GormDb:  gormDb,
+		SqlDb:   sqlDb,
+		DbStats: newDbStatsCollector(sqlDb),
+		CloseHook: fx.Hook{
+			OnStop: func(context.Context) error {
+				return gormDb.IfInitialized(func(db *gorm.DB) error {
+					sqlDb, err := db.DB()
+					if err != nil {
+						return err
+					}
+					return sqlDb.Close()
+				})
+			},
+		},
 	}
 }
@@ -4,10 +4,9 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/healthcheck"
 	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
-	"github.com/hellofresh/health-go/v5"
 	"go.uber.org/fx"
-	"time"
 )
 
 type Params struct {
@@ -17,14 +16,14 @@ type Params struct {
 
 type Result struct {
 	fx.Out
-	Config health.Option `group:"healthcheck_options"`
+	Check healthcheck.Check `group:"healthchecks"`
 }
 
 func New(p Params) Result {
 	return Result{
-		Config: health.WithChecks(health.Config{
-			Name:    "postgres",
-			Timeout: time.Second * 5,
+		Check: healthcheck.Check{
+			Name:     "postgres",
+			Critical: true,
 			Check: func(ctx context.Context) error {
 				db, dbErr := p.DB.Get()
 				if dbErr != nil {
@@ -36,6 +35,6 @@ func New(p Params) Result {
 				}
 				return nil
 			},
-		}),
+		},
 	}
 }
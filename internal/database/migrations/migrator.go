@@ -55,6 +55,8 @@ type Migrator interface {
 	UpTo(ctx context.Context, version int64) error
 	Down(ctx context.Context) error
 	DownTo(ctx context.Context, version int64) error
+	// Partition converts one of the PartitionableTables to a table hash partitioned by info hash.
+	Partition(ctx context.Context, table string, partitions uint) error
 }
 
 type migrator struct {
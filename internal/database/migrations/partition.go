@@ -0,0 +1,199 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// PartitionKey is the column that partitionable tables are hash partitioned by.
+const PartitionKey = "info_hash"
+
+// PartitionableTables are the tables that can be converted to partitioned tables; they're the largest tables,
+// and every unique constraint on them can include the info hash.
+var PartitionableTables = []string{"torrents", "torrent_contents"}
+
+var (
+	ErrNotPartitionable   = errors.New("table is not partitionable")
+	ErrAlreadyPartitioned = errors.New("table is already partitioned")
+)
+
+// Partition converts an unpartitioned table to a table hash partitioned by info hash, in a single transaction.
+// The rows are copied to the new table, and its indexes, constraints and the foreign keys referencing it are recreated,
+// so this takes a while for a large table, requires space for a second copy of it, and blocks all access to it until done.
+func (m *migrator) Partition(ctx context.Context, table string, partitions uint) error {
+	if !slices.Contains(PartitionableTables, table) {
+		return fmt.Errorf("%w: %s", ErrNotPartitionable, table)
+	}
+	if partitions < 2 {
+		return fmt.Errorf("at least 2 partitions are required, got %d", partitions)
+	}
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+	if err := partition(ctx, tx, table, partitions); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+type tableConstraint struct {
+	table      string
+	name       string
+	definition string
+}
+
+func partition(ctx context.Context, tx *sql.Tx, table string, partitions uint) error {
+	var partitioned bool
+	if err := tx.QueryRowContext(
+		ctx,
+		"SELECT relkind = 'p' FROM pg_class WHERE oid = to_regclass($1)",
+		table,
+	).Scan(&partitioned); err != nil {
+		return err
+	}
+	if partitioned {
+		return fmt.Errorf("%w: %s", ErrAlreadyPartitioned, table)
+	}
+	columns, err := queryStrings(
+		ctx,
+		tx,
+		"SELECT quote_ident(column_name) FROM information_schema.columns"+
+			" WHERE table_schema = current_schema() AND table_name = $1 AND is_generated = 'NEVER'"+
+			" ORDER BY ordinal_position",
+		table,
+	)
+	if err != nil {
+		return err
+	}
+	// indexes backing constraints are recreated along with their constraints
+	indexes, err := queryStrings(
+		ctx,
+		tx,
+		"SELECT pg_get_indexdef(i.indexrelid) FROM pg_index i"+
+			" WHERE i.indrelid = to_regclass($1)"+
+			" AND NOT EXISTS (SELECT 1 FROM pg_constraint c WHERE c.conindid = i.indexrelid)",
+		table,
+	)
+	if err != nil {
+		return err
+	}
+	// definitions are captured before the table is renamed, so that they refer to the table by its original name
+	constraints, err := queryConstraints(
+		ctx,
+		tx,
+		"SELECT conrelid::regclass::text, quote_ident(conname), pg_get_constraintdef(oid) FROM pg_constraint"+
+			" WHERE conrelid = to_regclass($1) AND contype IN ('c', 'f', 'p', 'u', 'x') ORDER BY contype = 'f', conname",
+		table,
+	)
+	if err != nil {
+		return err
+	}
+	references, err := queryConstraints(
+		ctx,
+		tx,
+		"SELECT conrelid::regclass::text, quote_ident(conname), pg_get_constraintdef(oid) FROM pg_constraint"+
+			" WHERE confrelid = to_regclass($1) AND conrelid <> confrelid AND contype = 'f'",
+		table,
+	)
+	if err != nil {
+		return err
+	}
+	unpartitioned := table + "_unpartitioned"
+	statements := make([]string, 0, len(references)*2+int(partitions)+len(indexes)+len(constraints)+5)
+	for _, r := range references {
+		statements = append(statements, "ALTER TABLE "+r.table+" DROP CONSTRAINT "+r.name)
+	}
+	statements = append(
+		statements,
+		"ALTER TABLE "+table+" RENAME TO "+unpartitioned,
+		"CREATE TABLE "+table+" (LIKE "+unpartitioned+" INCLUDING DEFAULTS INCLUDING GENERATED INCLUDING STORAGE)"+
+			" PARTITION BY HASH ("+PartitionKey+")",
+	)
+	for i := uint(0); i < partitions; i++ {
+		statements = append(statements, fmt.Sprintf(
+			"CREATE TABLE %s_p%d PARTITION OF %s FOR VALUES WITH (MODULUS %d, REMAINDER %d)",
+			table, i, table, partitions, i,
+		))
+	}
+	statements = append(
+		statements,
+		"INSERT INTO "+table+" ("+strings.Join(columns, ", ")+") SELECT "+strings.Join(columns, ", ")+" FROM "+unpartitioned,
+		"DROP TABLE "+unpartitioned,
+	)
+	statements = append(statements, indexes...)
+	for _, c := range constraints {
+		statements = append(statements, "ALTER TABLE "+table+" ADD CONSTRAINT "+c.name+" "+withPartitionKey(c.definition))
+	}
+	for _, r := range references {
+		statements = append(statements, "ALTER TABLE "+r.table+" ADD CONSTRAINT "+r.name+" "+r.definition)
+	}
+	statements = append(statements, "ANALYZE "+table)
+	for _, stmt := range statements {
+		if _, err := tx.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("%s: %w", stmt, err)
+		}
+	}
+	return nil
+}
+
+// withPartitionKey adds the partition key to a primary key or unique constraint that doesn't include it,
+// as a partitioned table can only enforce uniqueness within each partition.
+func withPartitionKey(definition string) string {
+	for _, prefix := range []string{"PRIMARY KEY (", "UNIQUE ("} {
+		if !strings.HasPrefix(definition, prefix) {
+			continue
+		}
+		end := strings.Index(definition, ")")
+		if end < 0 {
+			return definition
+		}
+		columns := strings.Split(definition[len(prefix):end], ", ")
+		if slices.Contains(columns, PartitionKey) {
+			return definition
+		}
+		return definition[:end] + ", " + PartitionKey + definition[end:]
+	}
+	return definition
+}
+
+func queryStrings(ctx context.Context, tx *sql.Tx, query string, args ...interface{}) ([]string, error) {
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var result []string
+	for rows.Next() {
+		var str string
+		if err := rows.Scan(&str); err != nil {
+			return nil, err
+		}
+		result = append(result, str)
+	}
+	return result, rows.Err()
+}
+
+func queryConstraints(ctx context.Context, tx *sql.Tx, query string, args ...interface{}) ([]tableConstraint, error) {
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var result []tableConstraint
+	for rows.Next() {
+		var c tableConstraint
+		if err := rows.Scan(&c.table, &c.name, &c.definition); err != nil {
+			return nil, err
+		}
+		result = append(result, c)
+	}
+	return result, rows.Err()
+}
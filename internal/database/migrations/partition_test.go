@@ -0,0 +1,30 @@
+package migrations
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestWithPartitionKey(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		input    string
+		expected string
+	}{
+		{"PRIMARY KEY (id)", "PRIMARY KEY (id, info_hash)"},
+		{"PRIMARY KEY (info_hash)", "PRIMARY KEY (info_hash)"},
+		{
+			"UNIQUE (info_hash, content_type, content_source, content_id)",
+			"UNIQUE (info_hash, content_type, content_source, content_id)",
+		},
+		{"UNIQUE (name) WITH (fillfactor='90')", "UNIQUE (name, info_hash) WITH (fillfactor='90')"},
+		{
+			"FOREIGN KEY (info_hash) REFERENCES torrents(info_hash) ON DELETE CASCADE",
+			"FOREIGN KEY (info_hash) REFERENCES torrents(info_hash) ON DELETE CASCADE",
+		},
+		{"CHECK ((content_type IS NOT NULL) OR (content_id IS NULL))", "CHECK ((content_type IS NOT NULL) OR (content_id IS NULL))"},
+	} {
+		assert.Equal(t, tc.expected, withPartitionKey(tc.input), tc.input)
+	}
+}
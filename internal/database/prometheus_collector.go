@@ -0,0 +1,37 @@
+package database
+
+import (
+	"database/sql"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"sync"
+)
+
+// dbStatsCollector collects the connection pool stats of the database once it has been connected to,
+// so that scraping metrics doesn't open a connection in a process that doesn't otherwise use the database.
+type dbStatsCollector struct {
+	db        lazy.Lazy[*sql.DB]
+	once      sync.Once
+	collector prometheus.Collector
+}
+
+func newDbStatsCollector(db lazy.Lazy[*sql.DB]) *dbStatsCollector {
+	return &dbStatsCollector{db: db}
+}
+
+// Describe sends no descriptors, making this an unchecked collector, as its metrics are only known once connected.
+func (c *dbStatsCollector) Describe(chan<- *prometheus.Desc) {}
+
+func (c *dbStatsCollector) Collect(ch chan<- prometheus.Metric) {
+	_ = c.db.IfInitialized(func(db *sql.DB) error {
+		if db == nil {
+			return nil
+		}
+		c.once.Do(func() {
+			c.collector = collectors.NewDBStatsCollector(db, "bitmagnet")
+		})
+		c.collector.Collect(ch)
+		return nil
+	})
+}
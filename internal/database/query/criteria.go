@@ -30,18 +30,21 @@ func Where(conditions ...Criteria) Option {
 	}
 }
 
+// And matches when all the criteria match; each criteria is parenthesized.
 func And(criteria ...Criteria) Criteria {
 	return AndCriteria{
 		Criteria: criteria,
 	}
 }
 
+// Or matches when any of the criteria match; each criteria is parenthesized. An empty Or applies no condition.
 func Or(criteria ...Criteria) Criteria {
 	return OrCriteria{
 		Criteria: criteria,
 	}
 }
 
+// Not matches when none of the criteria match.
 func Not(criteria ...Criteria) Criteria {
 	return NotCriteria{
 		Criteria: criteria,
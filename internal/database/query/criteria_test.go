@@ -0,0 +1,45 @@
+package query
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+	"gorm.io/gorm/utils/tests"
+	"testing"
+)
+
+func TestCriteriaComposition(t *testing.T) {
+	t.Parallel()
+
+	db, err := gorm.Open(tests.DummyDialector{}, &gorm.Config{DryRun: true, DisableAutomaticPing: true})
+	assert.NoError(t, err)
+	ctx := dbContext{q: dao.Use(db), tableName: model.TableNameTorrentContent}
+
+	a := DbCriteria{Sql: "a = ?", Args: []interface{}{1}}
+	b := DbCriteria{Sql: "b = ? OR c = ?", Args: []interface{}{2, 3}}
+	c := DbCriteria{Sql: "d = ?", Args: []interface{}{4}}
+
+	for _, tc := range []struct {
+		name     string
+		criteria Criteria
+		sql      string
+		vars     []interface{}
+	}{
+		{"or", Or(a, b), "x = 1 AND (a = ? OR (b = ? OR c = ?))", []interface{}{1, 2, 3}},
+		{"and of or", And(Or(a, c), b), "x = 1 AND ((a = ? OR d = ?) AND (b = ? OR c = ?))", []interface{}{1, 4, 2, 3}},
+		{"not of or", Not(Or(a, c)), "x = 1 AND NOT (a = ? OR d = ?)", []interface{}{1, 4}},
+		{"not of and", Not(And(a, c)), "x = 1 AND NOT (a = ? AND d = ?)", []interface{}{1, 4}},
+		{"or of and and not", Or(And(a, c), Not(b)), "x = 1 AND ((a = ? AND d = ?) OR NOT (b = ? OR c = ?))", []interface{}{1, 4, 2, 3}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			raw, err := tc.criteria.Raw(ctx)
+			assert.NoError(t, err)
+			var result []model.TorrentContent
+			stmt := db.Session(&gorm.Session{DryRun: true}).Model(&model.TorrentContent{}).
+				Where("x = 1").Where(raw.Query, raw.Args...).Find(&result).Statement
+			assert.Equal(t, "SELECT * FROM `torrent_contents` WHERE "+tc.sql, stmt.SQL.String())
+			assert.Equal(t, tc.vars, stmt.Vars)
+		})
+	}
+}
@@ -0,0 +1,199 @@
+package query
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrInvalidCursor is returned when a cursor can't be decoded, or was created for a query with a different ordering.
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// cursor identifies the position of the last item of a page, by the values of the ordered columns for that item.
+// Values are matched by keyset against the ordering of the next query, so the ordering must end in a unique column
+// (for torrent contents this is the ID, which is prefixed by the info hash) and the ordered columns must not be null.
+type cursor struct {
+	Keys   []string          `json:"k"`
+	Values []json.RawMessage `json:"v"`
+	// ReferenceTime is the reference time of the first page, if the query's selection depends on it.
+	ReferenceTime *time.Time `json:"t,omitempty"`
+}
+
+// ReferenceTime is a placeholder for the current time in the variables of a selected expression, such as a score that
+// decays with age. It's replaced by the time of the query, truncated to referenceTimePrecision so that the query can
+// still be cached, or for the following pages of a query by the reference time of its first page, which is kept in the
+// cursor so that the selected values, and the position of the cursor among them, don't change between pages.
+var ReferenceTime interface{} = referenceTime{}
+
+type referenceTime struct{}
+
+const referenceTimePrecision = time.Hour
+
+// cursorReferenceTime returns the reference time kept in a cursor, if it has one.
+func cursorReferenceTime(str string) (time.Time, bool) {
+	raw, err := base64.RawURLEncoding.DecodeString(str)
+	if err != nil {
+		return time.Time{}, false
+	}
+	c := cursor{}
+	if err := json.Unmarshal(raw, &c); err != nil || c.ReferenceTime == nil {
+		return time.Time{}, false
+	}
+	return *c.ReferenceTime, true
+}
+
+// resolveReferenceTime returns the variables with any ReferenceTime placeholder replaced by the given time, including
+// those of nested expressions.
+func resolveReferenceTime(vars []interface{}, t time.Time) []interface{} {
+	resolved := make([]interface{}, len(vars))
+	for i, v := range vars {
+		switch e := v.(type) {
+		case referenceTime:
+			v = t
+		case clause.Expr:
+			e.Vars = resolveReferenceTime(e.Vars, t)
+			v = e
+		}
+		resolved[i] = v
+	}
+	return resolved
+}
+
+func cursorKeys(orderBy []clause.OrderByColumn) []string {
+	keys := make([]string, 0, len(orderBy))
+	for _, ob := range orderBy {
+		direction := "asc"
+		if ob.Desc {
+			direction = "desc"
+		}
+		keys = append(keys, ob.Column.Name+":"+direction)
+	}
+	return keys
+}
+
+func encodeCursor(orderBy []clause.OrderByColumn, values []interface{}, referenceTime *time.Time) (string, error) {
+	c := cursor{
+		Keys:          cursorKeys(orderBy),
+		Values:        make([]json.RawMessage, 0, len(values)),
+		ReferenceTime: referenceTime,
+	}
+	for _, v := range values {
+		raw, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		c.Values = append(c.Values, raw)
+	}
+	raw, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+// decodeCursor decodes a cursor for the given ordering, returning the value of each ordered column with the type of its
+// field in the result schema.
+func decodeCursor(str string, orderBy []clause.OrderByColumn, sch *schema.Schema) ([]interface{}, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(str)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	c := cursor{}
+	if err := json.Unmarshal(raw, &c); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	keys := cursorKeys(orderBy)
+	if len(c.Keys) != len(keys) || len(c.Values) != len(keys) {
+		return nil, fmt.Errorf("%w: ordering does not match", ErrInvalidCursor)
+	}
+	values := make([]interface{}, 0, len(keys))
+	for i, key := range keys {
+		if c.Keys[i] != key {
+			return nil, fmt.Errorf("%w: ordering does not match", ErrInvalidCursor)
+		}
+		f := sch.LookUpField(orderBy[i].Column.Name)
+		if f == nil {
+			return nil, fmt.Errorf("%w: unknown column %s", ErrInvalidCursor, orderBy[i].Column.Name)
+		}
+		if string(c.Values[i]) == "null" {
+			return nil, fmt.Errorf("%w: null value for column %s", ErrInvalidCursor, orderBy[i].Column.Name)
+		}
+		v := reflect.New(f.FieldType)
+		if err := json.Unmarshal(c.Values[i], v.Interface()); err != nil {
+			return nil, ErrInvalidCursor
+		}
+		values = append(values, v.Elem().Interface())
+	}
+	return values, nil
+}
+
+// keysetCondition creates a condition matching the rows that come after the given values in the given ordering,
+// i.e. (a > x) OR (a = x AND b > y) OR ..., with selected aliases substituted by their underlying expression.
+func keysetCondition(orderBy []clause.OrderByColumn, aliases map[string]clause.Expr, values []interface{}) clause.Expr {
+	columns := make([]interface{}, 0, len(orderBy))
+	for _, ob := range orderBy {
+		if alias, ok := aliases[ob.Column.Name]; ok && ob.Column.Table == "" {
+			columns = append(columns, alias)
+		} else {
+			columns = append(columns, ob.Column)
+		}
+	}
+	var parts []string
+	var vars []interface{}
+	for i, ob := range orderBy {
+		var conditions []string
+		for j := 0; j < i; j++ {
+			conditions = append(conditions, "? = ?")
+			vars = append(vars, columns[j], values[j])
+		}
+		if ob.Desc {
+			conditions = append(conditions, "? < ?")
+		} else {
+			conditions = append(conditions, "? > ?")
+		}
+		vars = append(vars, columns[i], values[i])
+		parts = append(parts, "("+strings.Join(conditions, " AND ")+")")
+	}
+	return clause.Expr{
+		SQL:  "(" + strings.Join(parts, " OR ") + ")",
+		Vars: vars,
+	}
+}
+
+// cursorValues returns the values of the ordered columns for a result item, or false if any can't be resolved.
+func cursorValues(ctx context.Context, orderBy []clause.OrderByColumn, sch *schema.Schema, item reflect.Value) ([]interface{}, bool) {
+	values := make([]interface{}, 0, len(orderBy))
+	for _, ob := range orderBy {
+		f := sch.LookUpField(ob.Column.Name)
+		if f == nil {
+			return nil, false
+		}
+		v, _ := f.ValueOf(ctx, item)
+		if v == nil {
+			return nil, false
+		}
+		if valuer, ok := v.(driver.Valuer); ok {
+			if dv, err := valuer.Value(); err != nil || dv == nil {
+				return nil, false
+			}
+		}
+		values = append(values, v)
+	}
+	return values, true
+}
+
+var resultSchemaCache = &sync.Map{}
+
+func parseResultSchema[T interface{}](db *gorm.DB) (*schema.Schema, error) {
+	return schema.Parse(new(T), resultSchemaCache, db.NamingStrategy)
+}
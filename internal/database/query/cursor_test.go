@@ -0,0 +1,155 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/utils/tests"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type cursorTestItem struct {
+	ResultItem
+	model.TorrentContent
+}
+
+func TestCursor(t *testing.T) {
+	t.Parallel()
+
+	db, err := gorm.Open(tests.DummyDialector{}, &gorm.Config{DryRun: true, DisableAutomaticPing: true})
+	require.NoError(t, err)
+	sch, err := parseResultSchema[cursorTestItem](db)
+	require.NoError(t, err)
+
+	orderBy := []clause.OrderByColumn{
+		{Column: clause.Column{Name: queryStringRankField}, Desc: true},
+		{Column: clause.Column{Table: clause.CurrentTable, Name: "updated_at"}, Desc: true},
+		{Column: clause.Column{Table: clause.CurrentTable, Name: "id"}},
+	}
+	updatedAt := time.Date(2024, 3, 1, 12, 30, 0, 123000, time.UTC)
+	item := cursorTestItem{
+		ResultItem: ResultItem{QueryStringRank: 0.25},
+		TorrentContent: model.TorrentContent{
+			ID:        "0123456789abcdef0123456789abcdef01234567:movie:tmdb:123",
+			UpdatedAt: updatedAt,
+		},
+	}
+
+	values, ok := cursorValues(context.Background(), orderBy, sch, reflect.ValueOf(item))
+	require.True(t, ok)
+	str, err := encodeCursor(orderBy, values, nil)
+	require.NoError(t, err)
+
+	t.Run("round trip", func(t *testing.T) {
+		decoded, err := decodeCursor(str, orderBy, sch)
+		require.NoError(t, err)
+		require.Len(t, decoded, 3)
+		assert.Equal(t, 0.25, decoded[0])
+		assert.True(t, updatedAt.Equal(decoded[1].(time.Time)))
+		assert.Equal(t, item.ID, decoded[2])
+	})
+
+	t.Run("ordering mismatch", func(t *testing.T) {
+		_, err := decodeCursor(str, orderBy[1:], sch)
+		assert.True(t, errors.Is(err, ErrInvalidCursor))
+		reversed := append([]clause.OrderByColumn{}, orderBy...)
+		reversed[2].Desc = true
+		_, err = decodeCursor(str, reversed, sch)
+		assert.True(t, errors.Is(err, ErrInvalidCursor))
+	})
+
+	t.Run("malformed", func(t *testing.T) {
+		_, err := decodeCursor("not a cursor", orderBy, sch)
+		assert.True(t, errors.Is(err, ErrInvalidCursor))
+	})
+
+	t.Run("keyset condition", func(t *testing.T) {
+		aliases := map[string]clause.Expr{
+			queryStringRankField: {SQL: "ts_rank_cd(tsv, ?)", Vars: []interface{}{"q"}},
+		}
+		c := keysetCondition(orderBy, aliases, []interface{}{0.25, updatedAt, item.ID})
+		var result []model.TorrentContent
+		stmt := db.Session(&gorm.Session{DryRun: true}).Model(&model.TorrentContent{}).
+			Where(c.SQL, c.Vars...).Find(&result).Statement
+		assert.Equal(t, "SELECT * FROM `torrent_contents` WHERE ("+
+			"(ts_rank_cd(tsv, ?) < ?) OR "+
+			"(ts_rank_cd(tsv, ?) = ? AND `torrent_contents`.`updated_at` < ?) OR "+
+			"(ts_rank_cd(tsv, ?) = ? AND `torrent_contents`.`updated_at` = ? AND `torrent_contents`.`id` > ?))",
+			stmt.SQL.String())
+		assert.Equal(t, []interface{}{
+			"q", 0.25,
+			"q", 0.25, updatedAt,
+			"q", 0.25, updatedAt, item.ID,
+		}, stmt.Vars)
+	})
+}
+
+func TestCursor_ReferenceTime(t *testing.T) {
+	t.Parallel()
+
+	db, err := gorm.Open(tests.DummyDialector{}, &gorm.Config{DryRun: true, DisableAutomaticPing: true})
+	require.NoError(t, err)
+	sch, err := parseResultSchema[cursorTestItem](db)
+	require.NoError(t, err)
+
+	recency := clause.Expr{
+		SQL:  "1 / (1 + extract(epoch FROM ?::timestamptz - created_at))",
+		Vars: []interface{}{ReferenceTime},
+	}
+	page := func(now time.Time, cursor string) optionBuilder {
+		b := newQueryContext(dbContext{tableName: "torrent_contents"}).(optionBuilder)
+		b.now = now
+		built, err := Options(
+			OrderByRelevance(1, recency),
+			OrderBy(clause.OrderByColumn{Column: clause.Column{Table: clause.CurrentTable, Name: "id"}}),
+			Limit(1),
+			Cursor(cursor),
+		)(b)
+		require.NoError(t, err)
+		return built.(optionBuilder)
+	}
+	next := func(b optionBuilder, relevance float64, id string) string {
+		item := cursorTestItem{
+			ResultItem:     ResultItem{Relevance: relevance},
+			TorrentContent: model.TorrentContent{ID: id},
+		}
+		str := b.nextCursor(context.Background(), sch, reflect.ValueOf(item))
+		require.NotEmpty(t, str)
+		return str
+	}
+	firstPageAt := time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC)
+	referenceTime := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	first := page(firstPageAt, "")
+	assert.Equal(t, []interface{}{referenceTime}, first.resolvedSelections()[0].Vars)
+	str := next(first, 0.5, "a:movie:tmdb:1")
+
+	// the scores of the second page are taken at the time of the first, although time has passed:
+	secondPageAt := firstPageAt.Add(3 * time.Hour)
+	second := page(secondPageAt, str)
+	assert.Equal(t, []interface{}{referenceTime}, second.resolvedSelections()[0].Vars)
+	c, err := second.cursorCondition(sch)
+	require.NoError(t, err)
+	relevance := clause.Expr{
+		SQL:  "(1 / (1 + extract(epoch FROM ?::timestamptz - created_at)))",
+		Vars: []interface{}{referenceTime},
+	}
+	assert.Equal(t, []interface{}{
+		relevance, 0.5,
+		relevance, 0.5, clause.Column{Table: clause.CurrentTable, Name: "id"}, "a:movie:tmdb:1",
+	}, c.Vars)
+
+	// and so are those of the third:
+	third := page(secondPageAt.Add(time.Hour), next(second, 0.25, "b:movie:tmdb:2"))
+	assert.Equal(t, []interface{}{referenceTime}, third.resolvedSelections()[0].Vars)
+
+	// a new query is taken at its own time:
+	assert.Equal(t, []interface{}{time.Date(2024, 3, 1, 15, 0, 0, 0, time.UTC)},
+		page(secondPageAt, "").resolvedSelections()[0].Vars)
+}
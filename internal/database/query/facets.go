@@ -7,7 +7,9 @@ import (
 	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
 	"github.com/bitmagnet-io/bitmagnet/internal/maps"
 	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"gorm.io/gorm/clause"
 	"sort"
+	"strings"
 	"sync"
 )
 
@@ -26,6 +28,27 @@ type Facet interface {
 	Criteria() []Criteria
 }
 
+// GroupedFacet is a facet that's aggregated by counting rows grouped by a single expression. Grouped facets that are
+// aggregated with the same filter are counted together in a single query using grouping sets, rather than with a query
+// for each facet.
+type GroupedFacet interface {
+	Facet
+	Group(ctx DbContext) (FacetGroup, error)
+	GroupAggregation(counts []FacetGroupCount) (AggregationItems, error)
+}
+
+type FacetGroup struct {
+	// Expr is the SQL expression to group by; its values are cast to text.
+	Expr string
+	// Joins names the tables that the expression requires to be joined.
+	Joins []string
+}
+
+type FacetGroupCount struct {
+	Value *string
+	Count uint
+}
+
 type FacetFilter map[string]struct{}
 
 // Values allows iteration over deterministically sorted filter values, which helps with query caching.
@@ -186,18 +209,21 @@ func (c facetConfig) Filter() FacetFilter {
 	return c.filter
 }
 
+// FacetFilterCriteria returns the criteria for the facet's filter, combined according to the facet's logic.
+func FacetFilterCriteria(facet Facet) Criteria {
+	if facet.Logic() == model.FacetLogicOr {
+		return OrCriteria{facet.Criteria()}
+	}
+	return AndCriteria{facet.Criteria()}
+}
+
 func (b optionBuilder) createFacetsFilterCriteria() (c Criteria, err error) {
 	cs := make([]Criteria, 0, len(b.facets))
 	for _, facet := range b.facets {
-		cr := facet.Criteria()
-		switch facet.Logic() {
-		case model.FacetLogicAnd:
-			cs = append(cs, AndCriteria{cr})
-		case model.FacetLogicOr:
-			if b.currentFacet != facet.Key() {
-				cs = append(cs, OrCriteria{cr})
-			}
+		if facet.Logic() == model.FacetLogicOr && b.currentFacet == facet.Key() {
+			continue
 		}
+		cs = append(cs, FacetFilterCriteria(facet))
 	}
 	return AndCriteria{cs}, nil
 }
@@ -209,18 +235,57 @@ func withCurrentFacet(facetKey string) Option {
 	}
 }
 
+// isGroupable returns true if the facet can be aggregated in a single query with other grouped facets,
+// which is the case if its aggregation applies the same filter as the search itself.
+func isGroupable(facet Facet) (GroupedFacet, bool) {
+	grouped, ok := facet.(GroupedFacet)
+	if !ok || !facet.IsAggregated() {
+		return nil, false
+	}
+	return grouped, facet.Logic() != model.FacetLogicOr || len(facet.Filter()) == 0
+}
+
 func (b optionBuilder) calculateAggregations(ctx context.Context) (Aggregations, error) {
 	aggregations := make(Aggregations, len(b.facets))
+	var grouped []GroupedFacet
+	for _, facet := range b.facets {
+		if g, ok := isGroupable(facet); ok {
+			grouped = append(grouped, g)
+		}
+	}
+	// there's nothing to be gained from a grouping sets query for a single facet
+	if len(grouped) < 2 {
+		grouped = nil
+	}
 	wg := sync.WaitGroup{}
-	wg.Add(len(b.facets))
+	wg.Add(len(b.facets) + 1)
 	mtx := sync.Mutex{}
 	var errs []error
+	go (func() {
+		defer wg.Done()
+		if len(grouped) == 0 {
+			return
+		}
+		groupedAggregations, aggregateErr := b.calculateGroupedAggregations(ctx, grouped)
+		mtx.Lock()
+		defer mtx.Unlock()
+		if aggregateErr != nil {
+			errs = append(errs, aggregateErr)
+		} else {
+			for k, v := range groupedAggregations {
+				aggregations[k] = v
+			}
+		}
+	})()
 	for _, facet := range b.facets {
 		go (func(facet Facet) {
 			defer wg.Done()
 			if !facet.IsAggregated() {
 				return
 			}
+			if _, ok := isGroupable(facet); ok && len(grouped) > 0 {
+				return
+			}
 			aggBuilder, aggBuilderErr := Options(facet.AggregationOption, withCurrentFacet(facet.Key()))(b)
 			if aggBuilderErr != nil {
 				errs = append(errs, fmt.Errorf("failed to create aggregation option for key '%s': %w", facet.Key(), aggBuilderErr))
@@ -247,3 +312,96 @@ func (b optionBuilder) calculateAggregations(ctx context.Context) (Aggregations,
 	wg.Wait()
 	return aggregations, errors.Join(errs...)
 }
+
+// calculateGroupedAggregations counts the values of each facet in a single query, grouped by each facet's expression
+// with grouping sets; the grouping() function identifies which facet each row of counts belongs to.
+func (b optionBuilder) calculateGroupedAggregations(ctx context.Context, facets []GroupedFacet) (Aggregations, error) {
+	groups := make([]FacetGroup, 0, len(facets))
+	var options []Option
+	for _, facet := range facets {
+		group, err := facet.Group(b)
+		if err != nil {
+			return nil, fmt.Errorf("failed to group key '%s': %w", facet.Key(), err)
+		}
+		groups = append(groups, group)
+		options = append(options, facet.AggregationOption)
+		options = append(options, RequireJoin(group.Joins...))
+	}
+	aggCtx := facetContext{
+		optionBuilder: b,
+		ctx:           ctx,
+	}
+	q, qErr := aggCtx.NewAggregationQuery(options...)
+	if qErr != nil {
+		return nil, qErr
+	}
+	selections, groupBy := groupingSetsClauses(groups)
+	var rows []map[string]interface{}
+	if err := q.UnderlyingDB().Select(selections).Clauses(clause.GroupBy{
+		Columns: []clause.Column{{Name: groupBy, Raw: true}},
+	}).Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to aggregate grouped facets: %w", err)
+	}
+	counts := make([][]FacetGroupCount, len(facets))
+	for _, row := range rows {
+		count, err := groupedRowInt(row, "count")
+		if err != nil {
+			return nil, err
+		}
+		for i := range facets {
+			g, err := groupedRowInt(row, fmt.Sprintf("g%d", i))
+			if err != nil {
+				return nil, err
+			}
+			// grouping() is 0 for the expression that the row is grouped by
+			if g != 0 {
+				continue
+			}
+			var value *string
+			if v, ok := row[fmt.Sprintf("v%d", i)].(string); ok {
+				value = &v
+			}
+			counts[i] = append(counts[i], FacetGroupCount{Value: value, Count: uint(count)})
+		}
+	}
+	aggregations := make(Aggregations, len(facets))
+	for i, facet := range facets {
+		items, err := facet.GroupAggregation(counts[i])
+		if err != nil {
+			return nil, fmt.Errorf("failed to aggregate key '%s': %w", facet.Key(), err)
+		}
+		aggregations[facet.Key()] = AggregationGroup{
+			Label: facet.Label(),
+			Logic: facet.Logic(),
+			Items: items,
+		}
+	}
+	return aggregations, nil
+}
+
+func groupingSetsClauses(groups []FacetGroup) (selections string, groupBy string) {
+	selectParts := make([]string, 0, len(groups)*2+1)
+	setParts := make([]string, 0, len(groups))
+	for i, g := range groups {
+		selectParts = append(selectParts,
+			fmt.Sprintf("(%s)::text AS v%d", g.Expr, i),
+			fmt.Sprintf("grouping(%s) AS g%d", g.Expr, i),
+		)
+		setParts = append(setParts, "("+g.Expr+")")
+	}
+	selectParts = append(selectParts, "count(*) AS count")
+	return strings.Join(selectParts, ", "), "GROUPING SETS (" + strings.Join(setParts, ", ") + ")"
+}
+
+func groupedRowInt(row map[string]interface{}, key string) (int64, error) {
+	switch v := row[key].(type) {
+	case int64:
+		return v, nil
+	case int32:
+		return int64(v), nil
+	case int:
+		return int64(v), nil
+	default:
+		return 0, fmt.Errorf("unexpected type %T for grouped aggregation column '%s'", v, key)
+	}
+}
@@ -0,0 +1,78 @@
+package query
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type testGroupedFacet struct {
+	FacetConfig
+}
+
+func (testGroupedFacet) Aggregate(FacetContext) (AggregationItems, error) {
+	return nil, nil
+}
+
+func (testGroupedFacet) Criteria() []Criteria {
+	return nil
+}
+
+func (testGroupedFacet) Group(DbContext) (FacetGroup, error) {
+	return FacetGroup{}, nil
+}
+
+func (testGroupedFacet) GroupAggregation([]FacetGroupCount) (AggregationItems, error) {
+	return nil, nil
+}
+
+func TestIsGroupable(t *testing.T) {
+	t.Parallel()
+
+	filter := FacetFilter{"movie": struct{}{}}
+
+	for _, tc := range []struct {
+		name     string
+		facet    Facet
+		expected bool
+	}{
+		{
+			name:     "aggregated or facet without filter",
+			facet:    testGroupedFacet{NewFacetConfig(FacetIsAggregated(), FacetUsesOrLogic())},
+			expected: true,
+		},
+		{
+			name:     "aggregated and facet with filter",
+			facet:    testGroupedFacet{NewFacetConfig(FacetIsAggregated(), FacetUsesAndLogic(), FacetHasFilter(filter))},
+			expected: true,
+		},
+		{
+			name:     "aggregated or facet with filter",
+			facet:    testGroupedFacet{NewFacetConfig(FacetIsAggregated(), FacetUsesLogic(model.FacetLogicOr), FacetHasFilter(filter))},
+			expected: false,
+		},
+		{
+			name:     "not aggregated",
+			facet:    testGroupedFacet{NewFacetConfig(FacetUsesOrLogic())},
+			expected: false,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, ok := isGroupable(tc.facet)
+			assert.Equal(t, tc.expected, ok)
+		})
+	}
+}
+
+func TestGroupingSetsClauses(t *testing.T) {
+	t.Parallel()
+
+	selections, groupBy := groupingSetsClauses([]FacetGroup{
+		{Expr: "torrent_contents.content_type"},
+		{Expr: "content.release_year", Joins: []string{"content"}},
+	})
+
+	assert.Equal(t, "(torrent_contents.content_type)::text AS v0, grouping(torrent_contents.content_type) AS g0, "+
+		"(content.release_year)::text AS v1, grouping(content.release_year) AS g1, count(*) AS count", selections)
+	assert.Equal(t, "GROUPING SETS ((torrent_contents.content_type), (content.release_year))", groupBy)
+}
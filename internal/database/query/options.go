@@ -78,7 +78,7 @@ func QueryString(str string) Option {
 		if len(query) == 0 {
 			return ctx.Select(clause.Expr{
 				SQL: "0 AS " + queryStringRankField,
-			}), nil
+			}).Alias(queryStringRankField, clause.Expr{SQL: "0"}), nil
 		}
 		c, err := GenCriteria(func(ctx DbContext) (Criteria, error) {
 			return DbCriteria{
@@ -93,15 +93,58 @@ func QueryString(str string) Option {
 		if err != nil {
 			return ctx, err
 		}
+		rank := clause.Expr{
+			SQL: "ts_rank_cd(" + ctx.TableName() + ".tsv, ?::tsquery)",
+			Vars: []interface{}{
+				query,
+			},
+		}
 		ctx = ctx.Scope(func(dao SubQuery) error {
 			dao.UnderlyingDB().Where(c.Query, c.Args...)
 			return nil
 		}).RequireJoin(ctx.TableName()).Select(clause.Expr{
-			SQL: "ts_rank_cd(" + ctx.TableName() + ".tsv, ?::tsquery) AS " + queryStringRankField,
-			Vars: []interface{}{
-				query,
-			},
-		})
+			SQL:  rank.SQL + " AS " + queryStringRankField,
+			Vars: rank.Vars,
+		}).Alias(queryStringRankField, rank)
+		return ctx, nil
+	}
+}
+
+// FuzzyQueryString matches the query string against text columns by trigram word similarity rather than full text
+// search, so that misspelled queries still find results; the query string rank is the closest similarity of any column.
+// The tables of the columns are required to be joined.
+func FuzzyQueryString(str string, columns ...clause.Column) Option {
+	str = strings.TrimSpace(str)
+	return func(ctx OptionBuilder) (OptionBuilder, error) {
+		if str == "" || len(columns) == 0 {
+			return ctx.Select(clause.Expr{
+				SQL: "0 AS " + queryStringRankField,
+			}).Alias(queryStringRankField, clause.Expr{SQL: "0"}), nil
+		}
+		conditions := make([]string, 0, len(columns))
+		similarities := make([]string, 0, len(columns))
+		vars := make([]interface{}, 0, len(columns))
+		for _, column := range columns {
+			name := column.Name
+			if column.Table != "" {
+				name = column.Table + "." + name
+				ctx = ctx.RequireJoin(column.Table)
+			}
+			conditions = append(conditions, "? <% "+name)
+			similarities = append(similarities, "word_similarity(?, "+name+")")
+			vars = append(vars, str)
+		}
+		rank := clause.Expr{
+			SQL:  "greatest(" + strings.Join(similarities, ", ") + ")",
+			Vars: vars,
+		}
+		ctx = ctx.Scope(func(dao SubQuery) error {
+			dao.UnderlyingDB().Where("("+strings.Join(conditions, " OR ")+")", vars...)
+			return nil
+		}).Select(clause.Expr{
+			SQL:  rank.SQL + " AS " + queryStringRankField,
+			Vars: rank.Vars,
+		}).Alias(queryStringRankField, rank)
 		return ctx, nil
 	}
 }
@@ -134,6 +177,14 @@ func Offset(n uint) Option {
 	}
 }
 
+// Cursor continues from the position following the last item of a previous page, as given by its NextCursor.
+// The query must have the same ordering as the query that produced the cursor, and can't have an offset.
+func Cursor(cursor string) Option {
+	return func(ctx OptionBuilder) (OptionBuilder, error) {
+		return ctx.Cursor(cursor), nil
+	}
+}
+
 func OrderBy(columns ...clause.OrderByColumn) Option {
 	return func(ctx OptionBuilder) (OptionBuilder, error) {
 		return ctx.OrderBy(columns...), nil
@@ -152,6 +203,41 @@ func OrderByQueryStringRank() Option {
 	}
 }
 
+const relevanceField = "relevance"
+
+// OrderByRelevance orders by a relevance score, most relevant first. The score is the query string rank multiplied
+// by rankWeight, plus the value of each of the given terms; the rank is only counted if the QueryString option was
+// applied before this option.
+func OrderByRelevance(rankWeight float64, terms ...clause.Expr) Option {
+	return func(ctx OptionBuilder) (OptionBuilder, error) {
+		var sqls []string
+		var vars []interface{}
+		if rank, ok := ctx.aliased(queryStringRankField); ok && rankWeight != 0 {
+			sqls = append(sqls, "?::float8 * "+rank.SQL)
+			vars = append(append(vars, rankWeight), rank.Vars...)
+		}
+		for _, term := range terms {
+			sqls = append(sqls, term.SQL)
+			vars = append(vars, term.Vars...)
+		}
+		if len(sqls) == 0 {
+			sqls = append(sqls, "0")
+		}
+		relevance := clause.Expr{
+			SQL:  "(" + strings.Join(sqls, " + ") + ")",
+			Vars: vars,
+		}
+		return ctx.Select(clause.Expr{
+			SQL:  relevance.SQL + " AS " + relevanceField,
+			Vars: relevance.Vars,
+		}).Alias(relevanceField, relevance).OrderBy(clause.OrderByColumn{
+			Column:  clause.Column{Name: relevanceField},
+			Desc:    true,
+			Reorder: true,
+		}), nil
+	}
+}
+
 func OrderByColumn(field string, desc bool) Option {
 	return OrderBy(clause.OrderByColumn{
 		Column: clause.Column{Name: field},
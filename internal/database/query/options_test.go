@@ -0,0 +1,84 @@
+package query
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm/clause"
+	"testing"
+)
+
+func TestOrderByRelevance(t *testing.T) {
+	t.Parallel()
+
+	seeders := clause.Expr{SQL: "?::float8 * ln(1 + seeders)", Vars: []interface{}{0.5}}
+
+	t.Run("weighs the query string rank", func(t *testing.T) {
+		t.Parallel()
+		b := newQueryContext(dbContext{tableName: "torrent_contents"}).
+			Alias(queryStringRankField, clause.Expr{SQL: "ts_rank_cd(tsv, ?::tsquery)", Vars: []interface{}{"'foo'"}})
+		b, err := OrderByRelevance(2, seeders)(b)
+		require.NoError(t, err)
+		ob := b.(optionBuilder)
+		expected := clause.Expr{
+			SQL:  "(?::float8 * ts_rank_cd(tsv, ?::tsquery) + ?::float8 * ln(1 + seeders))",
+			Vars: []interface{}{2.0, "'foo'", 0.5},
+		}
+		assert.Equal(t, expected, ob.aliases[relevanceField])
+		assert.Equal(t, []clause.Expr{{SQL: expected.SQL + " AS " + relevanceField, Vars: expected.Vars}}, ob.selections)
+		assert.Equal(t, []clause.OrderByColumn{
+			{Column: clause.Column{Name: relevanceField}, Desc: true},
+		}, ob.resolvedOrderBy())
+	})
+
+	t.Run("leaves out the rank without a query string", func(t *testing.T) {
+		t.Parallel()
+		b, err := OrderByRelevance(2, seeders)(newQueryContext(dbContext{tableName: "torrent_contents"}))
+		require.NoError(t, err)
+		assert.Equal(t, clause.Expr{
+			SQL:  "(?::float8 * ln(1 + seeders))",
+			Vars: []interface{}{0.5},
+		}, b.(optionBuilder).aliases[relevanceField])
+	})
+
+	t.Run("scores zero without any terms", func(t *testing.T) {
+		t.Parallel()
+		b, err := OrderByRelevance(0)(newQueryContext(dbContext{tableName: "torrent_contents"}))
+		require.NoError(t, err)
+		assert.Equal(t, clause.Expr{SQL: "(0)"}, b.(optionBuilder).aliases[relevanceField])
+	})
+}
+
+func TestFuzzyQueryString(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ranks by the closest column", func(t *testing.T) {
+		t.Parallel()
+		b, err := FuzzyQueryString(
+			" intersteller ",
+			clause.Column{Table: "torrents", Name: "name"},
+			clause.Column{Table: "content", Name: "title"},
+		)(newQueryContext(dbContext{tableName: "torrent_contents"}))
+		require.NoError(t, err)
+		ob := b.(optionBuilder)
+		assert.Equal(t, clause.Expr{
+			SQL:  "greatest(word_similarity(?, torrents.name), word_similarity(?, content.title))",
+			Vars: []interface{}{"intersteller", "intersteller"},
+		}, ob.aliases[queryStringRankField])
+		_, hasTorrents := ob.requiredJoins.Get("torrents")
+		_, hasContent := ob.requiredJoins.Get("content")
+		assert.True(t, hasTorrents)
+		assert.True(t, hasContent)
+		assert.Len(t, ob.scopes, 1)
+	})
+
+	t.Run("ranks zero without a query string", func(t *testing.T) {
+		t.Parallel()
+		b, err := FuzzyQueryString(" ", clause.Column{Table: "torrents", Name: "name"})(
+			newQueryContext(dbContext{tableName: "torrent_contents"}),
+		)
+		require.NoError(t, err)
+		ob := b.(optionBuilder)
+		assert.Equal(t, clause.Expr{SQL: "0"}, ob.aliases[queryStringRankField])
+		assert.Empty(t, ob.scopes)
+	})
+}
@@ -6,17 +6,22 @@ import (
 
 type SearchParams struct {
   QueryString model.NullString
+  Fuzzy       model.NullBool
+  Highlight   model.NullBool
   Limit       model.NullUint
   Offset      model.NullUint
+  Cursor      model.NullString
   TotalCount  model.NullBool
   HasNextPage model.NullBool
   Cached      model.NullBool
 }
 
+// Option returns the options of the search parameters; the caller is responsible for ordering by the query string,
+// and for matching a fuzzy query string and highlighting matches in the columns of the searched table.
 func (s SearchParams) Option() Option {
   var options []Option
-  if s.QueryString.Valid {
-    options = append(options, QueryString(s.QueryString.String), OrderByQueryStringRank())
+  if s.QueryString.Valid && !s.Fuzzy.Bool {
+    options = append(options, QueryString(s.QueryString.String))
   }
   if s.Limit.Valid {
     options = append(options, Limit(s.Limit.Uint))
@@ -24,6 +29,9 @@ func (s SearchParams) Option() Option {
   if s.Offset.Valid {
     options = append(options, Offset(s.Offset.Uint))
   }
+  if s.Cursor.Valid {
+    options = append(options, Cursor(s.Cursor.String))
+  }
   if s.TotalCount.Valid {
     options = append(options, WithTotalCount(s.TotalCount.Bool))
   }
@@ -11,17 +11,22 @@ import (
 	"gorm.io/gen/field"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+	"reflect"
 	"strings"
 	"sync"
+	"time"
 )
 
 type ResultItem struct {
 	QueryStringRank float64
+	Relevance       float64
 }
 
 type GenericResult[T interface{}] struct {
 	TotalCount   uint
 	HasNextPage  bool
+	NextCursor   string
 	Items        []T
 	Aggregations Aggregations
 }
@@ -86,6 +91,17 @@ func GenericQuery[T interface{}](
 			addErr(sqErr)
 			return
 		}
+		sch, schErr := parseResultSchema[T](sq.UnderlyingDB())
+		if builder.hasCursor() {
+			if schErr != nil {
+				addErr(schErr)
+				return
+			}
+			if cursorErr := builder.applyCursor(sq, sch); cursorErr != nil {
+				addErr(cursorErr)
+				return
+			}
+		}
 		if postErr := builder.applyPost(sq); postErr != nil {
 			addErr(postErr)
 			return
@@ -102,6 +118,9 @@ func GenericQuery[T interface{}](
 				r.HasNextPage = true
 				copiedItems = copiedItems[:len(copiedItems)-1]
 			}
+			if schErr == nil && builder.mayHaveNextPage(len(copiedItems), r.HasNextPage) {
+				r.NextCursor = builder.nextCursor(ctx, sch, reflect.ValueOf(&copiedItems[len(copiedItems)-1]).Elem())
+			}
 			if len(copiedItems) > 0 {
 				if cbErr := builder.applyCallbacks(ctx, copiedItems); cbErr != nil {
 					addErr(cbErr)
@@ -210,6 +229,9 @@ type OptionBuilder interface {
 	OrderBy(...clause.OrderByColumn) OptionBuilder
 	Limit(uint) OptionBuilder
 	Offset(uint) OptionBuilder
+	Cursor(string) OptionBuilder
+	Alias(string, clause.Expr) OptionBuilder
+	aliased(string) (clause.Expr, bool)
 	Group(...clause.Column) OptionBuilder
 	Facet(...Facet) OptionBuilder
 	Preload(...field.RelationField) OptionBuilder
@@ -217,6 +239,7 @@ type OptionBuilder interface {
 	Context(func(ctx context.Context) context.Context) OptionBuilder
 	applySelect(SubQuery) error
 	applyPre(SubQuery) error
+	applyCursor(SubQuery, *schema.Schema) error
 	applyPost(SubQuery) error
 	createFacetsFilterCriteria() (Criteria, error)
 	calculateAggregations(context.Context) (Aggregations, error)
@@ -227,6 +250,9 @@ type OptionBuilder interface {
 	hasZeroLimit() bool
 	needsNextPage() bool
 	hasNextPage(nItems int) bool
+	hasCursor() bool
+	mayHaveNextPage(nItems int, hasNextPage bool) bool
+	nextCursor(context.Context, *schema.Schema, reflect.Value) string
 	withCurrentFacet(string) OptionBuilder
 	createContext(context.Context) context.Context
 }
@@ -242,6 +268,9 @@ type optionBuilder struct {
 	limit         model.NullUint
 	nextPage      bool
 	offset        uint
+	cursor        string
+	now           time.Time
+	aliases       map[string]clause.Expr
 	facets        []Facet
 	currentFacet  string
 	preloads      []field.RelationField
@@ -260,6 +289,7 @@ func newQueryContext(dbCtx dbContext) OptionBuilder {
 		dbContext:     dbCtx,
 		joins:         make(map[string]TableJoin),
 		requiredJoins: maps.NewInsertMap[string, struct{}](),
+		now:           time.Now(),
 	}
 }
 
@@ -327,6 +357,27 @@ func (b optionBuilder) Offset(offset uint) OptionBuilder {
 	return b
 }
 
+func (b optionBuilder) Cursor(cursor string) OptionBuilder {
+	b.cursor = cursor
+	return b
+}
+
+// Alias registers the expression behind an alias in the selection, so that it can be referenced in a cursor condition.
+func (b optionBuilder) Alias(name string, expr clause.Expr) OptionBuilder {
+	aliases := make(map[string]clause.Expr, len(b.aliases)+1)
+	for k, v := range b.aliases {
+		aliases[k] = v
+	}
+	aliases[name] = expr
+	b.aliases = aliases
+	return b
+}
+
+func (b optionBuilder) aliased(name string) (clause.Expr, bool) {
+	expr, ok := b.aliases[name]
+	return expr, ok
+}
+
 func (b optionBuilder) Facet(facets ...Facet) OptionBuilder {
 	b.facets = append(b.facets, facets...)
 	return b
@@ -385,6 +436,71 @@ func (b optionBuilder) hasNextPage(nItems int) bool {
 	return nItems > int(b.limit.Uint)
 }
 
+func (b optionBuilder) hasCursor() bool {
+	return b.cursor != ""
+}
+
+// mayHaveNextPage returns true if a cursor should be provided for the next page; when the next page isn't being
+// checked for, this is the case whenever the page is full.
+func (b optionBuilder) mayHaveNextPage(nItems int, hasNextPage bool) bool {
+	if nItems == 0 || !b.limit.Valid || len(b.orderBy) == 0 {
+		return false
+	}
+	if b.nextPage {
+		return hasNextPage
+	}
+	return nItems == int(b.limit.Uint)
+}
+
+func (b optionBuilder) nextCursor(ctx context.Context, sch *schema.Schema, item reflect.Value) string {
+	orderBy := b.resolvedOrderBy()
+	values, ok := cursorValues(ctx, orderBy, sch, item)
+	if !ok {
+		return ""
+	}
+	var referenceTime *time.Time
+	if b.usesReferenceTime() {
+		t := b.referenceTime()
+		referenceTime = &t
+	}
+	c, err := encodeCursor(orderBy, values, referenceTime)
+	if err != nil {
+		return ""
+	}
+	return c
+}
+
+// referenceTime returns the time to substitute for ReferenceTime: that of the first page if the query has a cursor,
+// or else the time of the query.
+func (b optionBuilder) referenceTime() time.Time {
+	if t, ok := cursorReferenceTime(b.cursor); ok {
+		return t
+	}
+	return b.now.UTC().Truncate(referenceTimePrecision)
+}
+
+func (b optionBuilder) usesReferenceTime() bool {
+	for _, s := range b.selections {
+		for _, v := range s.Vars {
+			if v == ReferenceTime {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// resolvedSelections returns the selections, with the reference time substituted.
+func (b optionBuilder) resolvedSelections() []clause.Expr {
+	referenceTime := b.referenceTime()
+	selections := make([]clause.Expr, 0, len(b.selections))
+	for _, s := range b.selections {
+		s.Vars = resolveReferenceTime(s.Vars, referenceTime)
+		selections = append(selections, s)
+	}
+	return selections
+}
+
 func (b optionBuilder) withCurrentFacet(facet string) OptionBuilder {
 	b.currentFacet = facet
 	return b
@@ -403,7 +519,7 @@ func (b optionBuilder) applySelect(sq SubQuery) error {
 	if len(b.selections) == 0 {
 		selectQueryParts = append(selectQueryParts, "*")
 	} else {
-		for _, s := range b.selections {
+		for _, s := range b.resolvedSelections() {
 			selectQueryParts = append(selectQueryParts, s.SQL)
 			selectQueryArgs = append(selectQueryArgs, s.Vars...)
 		}
@@ -486,21 +602,53 @@ func applyJoins(sq SubQuery, joins ...TableJoin) {
 	}
 }
 
+// resolvedOrderBy returns the ordering of the query, with any columns to be reordered moved to the start.
+func (b optionBuilder) resolvedOrderBy() []clause.OrderByColumn {
+	orderBy := make([]clause.OrderByColumn, 0, len(b.orderBy))
+	for _, ob := range b.orderBy {
+		if ob.Reorder {
+			orderBy = append([]clause.OrderByColumn{{
+				Column: ob.Column,
+				Desc:   ob.Desc,
+			}}, orderBy...)
+		} else {
+			orderBy = append(orderBy, ob)
+		}
+	}
+	return orderBy
+}
+
+// applyCursor restricts the query to the items following the cursor, by keyset on the ordered columns.
+func (b optionBuilder) applyCursor(sq SubQuery, sch *schema.Schema) error {
+	c, err := b.cursorCondition(sch)
+	if err != nil {
+		return err
+	}
+	sq.UnderlyingDB().Where(c.SQL, c.Vars...)
+	return nil
+}
+
+func (b optionBuilder) cursorCondition(sch *schema.Schema) (clause.Expr, error) {
+	if b.offset > 0 {
+		return clause.Expr{}, fmt.Errorf("%w: cannot be combined with an offset", ErrInvalidCursor)
+	}
+	orderBy := b.resolvedOrderBy()
+	if len(orderBy) == 0 {
+		return clause.Expr{}, fmt.Errorf("%w: query is not ordered", ErrInvalidCursor)
+	}
+	values, err := decodeCursor(b.cursor, orderBy, sch)
+	if err != nil {
+		return clause.Expr{}, err
+	}
+	c := keysetCondition(orderBy, b.aliases, values)
+	c.Vars = resolveReferenceTime(c.Vars, b.referenceTime())
+	return c, nil
+}
+
 func (b optionBuilder) applyPost(sq SubQuery) error {
 	if len(b.orderBy) > 0 {
-		orderBy := make([]clause.OrderByColumn, 0, len(b.orderBy))
-		for _, ob := range b.orderBy {
-			if ob.Reorder {
-				orderBy = append([]clause.OrderByColumn{{
-					Column: ob.Column,
-					Desc:   ob.Desc,
-				}}, orderBy...)
-			} else {
-				orderBy = append(orderBy, ob)
-			}
-		}
 		sq.UnderlyingDB().Statement.AddClause(clause.OrderBy{
-			Columns: orderBy,
+			Columns: b.resolvedOrderBy(),
 		})
 	}
 	if b.limit.Valid {
@@ -0,0 +1,41 @@
+package search
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/database/query"
+	"time"
+)
+
+const (
+	OrderRank      = "rank"
+	OrderRelevance = "relevance"
+)
+
+type Config struct {
+	// DefaultOrder is the ordering of torrent content searches with a query string, where no other ordering is requested:
+	// either "rank", by how well the query string matches alone, or "relevance", which also weighs seeders and recency.
+	DefaultOrder string `validate:"oneof=rank relevance"`
+	// RankWeight, SeedersWeight and RecencyWeight are the weights of each component of the relevance score.
+	RankWeight    float64
+	SeedersWeight float64
+	RecencyWeight float64
+	// RecencyHalfLife is the age at which a torrent's recency component is halved.
+	RecencyHalfLife time.Duration
+}
+
+func NewDefaultConfig() Config {
+	return Config{
+		DefaultOrder:    OrderRelevance,
+		RankWeight:      1,
+		SeedersWeight:   0.05,
+		RecencyWeight:   0.2,
+		RecencyHalfLife: 365 * 24 * time.Hour,
+	}
+}
+
+// QueryStringOrder returns the default ordering of torrent content searches with a query string.
+func (c Config) QueryStringOrder() query.Option {
+	if c.DefaultOrder == OrderRank {
+		return query.OrderByQueryStringRank()
+	}
+	return TorrentContentOrderByRelevance(c)
+}
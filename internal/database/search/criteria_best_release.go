@@ -0,0 +1,101 @@
+package search
+
+import (
+	"bytes"
+	"cmp"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/query"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"slices"
+	"strings"
+)
+
+// releaseCodecRanks orders video codecs by preference; codecs not listed rank lowest.
+var releaseCodecRanks = map[model.VideoCodec]int{
+	model.VideoCodecAV1:   3,
+	model.VideoCodecX265:  3,
+	model.VideoCodecH264:  2,
+	model.VideoCodecX264:  2,
+	model.VideoCodecXviD:  1,
+	model.VideoCodecDivX:  1,
+	model.VideoCodecMPEG4: 1,
+}
+
+func releaseResolutionRank(r model.NullVideoResolution) int {
+	if !r.Valid {
+		return 0
+	}
+	return slices.Index(model.VideoResolutionValues(), r.VideoResolution) + 1
+}
+
+func releaseCodecRank(c model.NullVideoCodec) int {
+	if !c.Valid {
+		return 0
+	}
+	return releaseCodecRanks[c.VideoCodec]
+}
+
+func releaseSeedersRank(t model.Torrent) int {
+	if seeders := t.Seeders(); seeders.Valid {
+		return int(seeders.Uint)
+	}
+	return -1
+}
+
+// CompareReleases orders releases of the same content from best to worst: by video resolution, then video codec,
+// then seeders, with ties broken by info hash. This is the same order that BestReleaseCriteria selects by;
+// the torrents must have their sources loaded for seeders to be compared.
+func CompareReleases(a, b model.TorrentContent) int {
+	if c := CompareReleaseQuality(a, b); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(releaseSeedersRank(b.Torrent), releaseSeedersRank(a.Torrent)); c != 0 {
+		return c
+	}
+	return bytes.Compare(a.InfoHash[:], b.InfoHash[:])
+}
+
+// CompareReleaseQuality orders releases from best to worst by video resolution, then video codec, ignoring seeders;
+// releases of equal quality compare as 0.
+func CompareReleaseQuality(a, b model.TorrentContent) int {
+	if c := cmp.Compare(releaseResolutionRank(b.VideoResolution), releaseResolutionRank(a.VideoResolution)); c != 0 {
+		return c
+	}
+	return cmp.Compare(releaseCodecRank(b.VideoCodec), releaseCodecRank(a.VideoCodec))
+}
+
+// BestReleaseCriteria matches only the best release of each content item, as ordered by CompareReleases.
+// The best release is chosen from all torrents of the content, regardless of any other criteria;
+// torrents that aren't matched to a content item are always included.
+func BestReleaseCriteria() query.Criteria {
+	return query.GenCriteria(func(ctx query.DbContext) (query.Criteria, error) {
+		var args []interface{}
+		resolutionCase := []string{"CASE best.video_resolution"}
+		for i, r := range model.VideoResolutionValues() {
+			resolutionCase = append(resolutionCase, "WHEN ? THEN ?")
+			args = append(args, r.String(), i+1)
+		}
+		resolutionCase = append(resolutionCase, "ELSE 0 END")
+		codecCase := []string{"CASE best.video_codec"}
+		for _, c := range model.VideoCodecValues() {
+			if rank, ok := releaseCodecRanks[c]; ok {
+				codecCase = append(codecCase, "WHEN ? THEN ?")
+				args = append(args, c.String(), rank)
+			}
+		}
+		codecCase = append(codecCase, "ELSE 0 END")
+		t := ctx.TableName()
+		return query.DbCriteria{
+			Sql: t + ".content_id IS NULL OR " + t + ".info_hash = (" +
+				"SELECT best.info_hash FROM " + model.TableNameTorrentContent + " best" +
+				" WHERE best.content_type = " + t + ".content_type" +
+				" AND best.content_source = " + t + ".content_source" +
+				" AND best.content_id = " + t + ".content_id" +
+				" ORDER BY " + strings.Join(resolutionCase, " ") + " DESC, " +
+				strings.Join(codecCase, " ") + " DESC, " +
+				"(SELECT max(s.seeders) FROM " + model.TableNameTorrentsTorrentSource + " s WHERE s.info_hash = best.info_hash) DESC NULLS LAST, " +
+				"best.info_hash" +
+				" LIMIT 1)",
+			Args: args,
+		}, nil
+	})
+}
@@ -0,0 +1,56 @@
+package search
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"github.com/stretchr/testify/assert"
+	"slices"
+	"testing"
+)
+
+func TestCompareReleases(t *testing.T) {
+	t.Parallel()
+
+	release := func(hashByte byte, resolution model.VideoResolution, codec model.VideoCodec, seeders ...uint) model.TorrentContent {
+		tc := model.TorrentContent{
+			InfoHash:        protocol.ID{hashByte},
+			VideoResolution: model.NewNullVideoResolution(resolution),
+			VideoCodec:      model.NewNullVideoCodec(codec),
+		}
+		for _, s := range seeders {
+			tc.Torrent.Sources = append(tc.Torrent.Sources, model.TorrentsTorrentSource{Seeders: model.NewNullUint(s)})
+		}
+		return tc
+	}
+
+	uhd := release(1, model.VideoResolutionV2160p, model.VideoCodecH264)
+	hdX265 := release(2, model.VideoResolutionV1080p, model.VideoCodecX265)
+	hdX264Seeded := release(3, model.VideoResolutionV1080p, model.VideoCodecX264, 5, 50)
+	hdX264 := release(4, model.VideoResolutionV1080p, model.VideoCodecX264, 10)
+	hdH264Unscraped := release(5, model.VideoResolutionV1080p, model.VideoCodecH264)
+	hdH264Unscraped2 := release(6, model.VideoResolutionV1080p, model.VideoCodecH264)
+	unknown := model.TorrentContent{InfoHash: protocol.ID{7}}
+
+	releases := []model.TorrentContent{unknown, hdH264Unscraped2, hdX264, hdH264Unscraped, uhd, hdX264Seeded, hdX265}
+	slices.SortFunc(releases, CompareReleases)
+
+	assert.Equal(t, []model.TorrentContent{uhd, hdX265, hdX264Seeded, hdX264, hdH264Unscraped, hdH264Unscraped2, unknown}, releases)
+}
+
+func TestCompareReleaseQuality(t *testing.T) {
+	t.Parallel()
+
+	hd := model.TorrentContent{
+		VideoResolution: model.NewNullVideoResolution(model.VideoResolutionV1080p),
+		VideoCodec:      model.NewNullVideoCodec(model.VideoCodecX264),
+	}
+	hdSeeded := hd
+	hdSeeded.Torrent.Sources = []model.TorrentsTorrentSource{{Seeders: model.NewNullUint(100)}}
+	uhd := model.TorrentContent{
+		VideoResolution: model.NewNullVideoResolution(model.VideoResolutionV2160p),
+	}
+
+	assert.Equal(t, 0, CompareReleaseQuality(hd, hdSeeded))
+	assert.Negative(t, CompareReleaseQuality(uhd, hdSeeded))
+	assert.Positive(t, CompareReleaseQuality(model.TorrentContent{}, hd))
+}
@@ -59,3 +59,22 @@ func ContentCollectionCriteria(refs ...model.ContentCollectionRef) query.Criteri
 		), nil
 	})
 }
+
+// ContentInCollectionCriteria matches the content items in a collection, for queries of the content table.
+func ContentInCollectionCriteria(ref model.ContentCollectionRef) query.Criteria {
+	return query.GenCriteria(func(ctx query.DbContext) (query.Criteria, error) {
+		q := ctx.Query()
+		return query.RawCriteria{
+			Query: gen.Exists(
+				q.ContentCollectionContent.Where(
+					q.ContentCollectionContent.ContentType.EqCol(q.Content.Type),
+					q.ContentCollectionContent.ContentSource.EqCol(q.Content.Source),
+					q.ContentCollectionContent.ContentID.EqCol(q.Content.ID),
+					q.ContentCollectionContent.ContentCollectionType.Eq(ref.Type),
+					q.ContentCollectionContent.ContentCollectionSource.Eq(ref.Source),
+					q.ContentCollectionContent.ContentCollectionID.Eq(ref.ID),
+				),
+			),
+		}, nil
+	})
+}
@@ -0,0 +1,27 @@
+package search
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/database/query"
+	"github.com/bitmagnet-io/bitmagnet/internal/maps"
+	"gorm.io/gen"
+)
+
+// TorrentContentNotIgnoredCriteria excludes torrent content of content that's flagged as ignored.
+func TorrentContentNotIgnoredCriteria() query.Criteria {
+	return query.Not(query.GenCriteria(func(ctx query.DbContext) (query.Criteria, error) {
+		q := ctx.Query()
+		return query.RawCriteria{
+			Joins: maps.NewInsertMap(
+				maps.MapEntry[string, struct{}]{Key: q.TorrentContent.TableName()},
+			),
+			Query: gen.Exists(
+				q.ContentFlag.Where(
+					q.ContentFlag.ContentType.EqCol(q.TorrentContent.ContentType),
+					q.ContentFlag.ContentSource.EqCol(q.TorrentContent.ContentSource),
+					q.ContentFlag.ContentID.EqCol(q.TorrentContent.ContentID),
+					q.ContentFlag.Ignored.Is(true),
+				),
+			),
+		}, nil
+	}))
+}
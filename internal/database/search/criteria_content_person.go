@@ -0,0 +1,74 @@
+package search
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/query"
+	"github.com/bitmagnet-io/bitmagnet/internal/maps"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+	"gorm.io/gorm/clause"
+)
+
+// PersonFilter matches a person credited for content; the name and job are matched ignoring case,
+// and the role and job are only matched if set.
+type PersonFilter struct {
+	Name string
+	Role model.NullContentPersonRole
+	Job  string
+}
+
+// TorrentContentPersonCriteria matches torrent content of content crediting a person.
+func TorrentContentPersonCriteria(f PersonFilter) query.Criteria {
+	return query.GenCriteria(func(ctx query.DbContext) (query.Criteria, error) {
+		q := ctx.Query()
+		return query.RawCriteria{
+			Joins: maps.NewInsertMap(
+				maps.MapEntry[string, struct{}]{Key: q.TorrentContent.TableName()},
+			),
+			Query: gen.Exists(
+				q.ContentPerson.Where(
+					personConditions(q, f,
+						q.ContentPerson.ContentType.EqCol(q.TorrentContent.ContentType),
+						q.ContentPerson.ContentSource.EqCol(q.TorrentContent.ContentSource),
+						q.ContentPerson.ContentID.EqCol(q.TorrentContent.ContentID),
+					)...,
+				),
+			),
+		}, nil
+	})
+}
+
+// ContentPersonCriteria matches content crediting a person, for queries of the content table.
+func ContentPersonCriteria(f PersonFilter) query.Criteria {
+	return query.GenCriteria(func(ctx query.DbContext) (query.Criteria, error) {
+		q := ctx.Query()
+		return query.RawCriteria{
+			Query: gen.Exists(
+				q.ContentPerson.Where(
+					personConditions(q, f,
+						q.ContentPerson.ContentType.EqCol(q.Content.Type),
+						q.ContentPerson.ContentSource.EqCol(q.Content.Source),
+						q.ContentPerson.ContentID.EqCol(q.Content.ID),
+					)...,
+				),
+			),
+		}, nil
+	})
+}
+
+func personConditions(q *dao.Query, f PersonFilter, conds ...gen.Condition) []gen.Condition {
+	conds = append(conds, lowerEq(q, q.ContentPerson.TableName(), q.ContentPerson.Name, f.Name))
+	if f.Role.Valid {
+		conds = append(conds, q.ContentPerson.Role.Eq(f.Role.ContentPersonRole))
+	}
+	if f.Job != "" {
+		conds = append(conds, lowerEq(q, q.ContentPerson.TableName(), q.ContentPerson.Job, f.Job))
+	}
+	return conds
+}
+
+// lowerEq compares a column to a value ignoring case, in a form that can use an index on lower(column).
+func lowerEq(q *dao.Query, table string, col field.Expr, value string) gen.Condition {
+	return q.RawCondition("lower(?) = lower(?)", clause.Column{Table: table, Name: string(col.ColumnName())}, value)
+}
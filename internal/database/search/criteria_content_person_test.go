@@ -0,0 +1,33 @@
+package search
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+	"gorm.io/gorm/utils/tests"
+	"testing"
+)
+
+func TestPersonConditions(t *testing.T) {
+	t.Parallel()
+
+	db, err := gorm.Open(tests.DummyDialector{}, &gorm.Config{Logger: logger.Discard})
+	require.NoError(t, err)
+	q := dao.Use(db)
+
+	do := q.ContentPerson.Where(personConditions(q, PersonFilter{
+		Name: "Sigourney Weaver",
+		Role: model.NewNullContentPersonRole(model.ContentPersonRoleCast),
+		Job:  "Actor",
+	})...)
+	sql := do.UnderlyingDB().ToSQL(func(tx *gorm.DB) *gorm.DB {
+		return tx.Find(&[]model.ContentPerson{})
+	})
+	assert.Equal(t, "SELECT * FROM `content_people` WHERE "+
+		"lower(`content_people`.`name`) = lower(\"Sigourney Weaver\") AND "+
+		"`content_people`.`role` = \"cast\" AND "+
+		"lower(`content_people`.`job`) = lower(\"Actor\")", sql)
+}
@@ -0,0 +1,40 @@
+package search
+
+import (
+	"database/sql/driver"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/query"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"gorm.io/gen/field"
+)
+
+// FileInfoHashCriteria matches the files of the given torrents, for searches of torrent files.
+func FileInfoHashCriteria(infoHashes ...protocol.ID) query.Criteria {
+	valuers := make([]driver.Valuer, 0, len(infoHashes))
+	for _, infoHash := range infoHashes {
+		valuers = append(valuers, infoHash)
+	}
+	return query.DaoCriteria{
+		Conditions: func(ctx query.DbContext) ([]field.Expr, error) {
+			return []field.Expr{ctx.Query().TorrentFile.InfoHash.In(valuers...)}, nil
+		},
+	}
+}
+
+// FileExtensionCriteria matches files with any of the given extensions, for searches of torrent files.
+func FileExtensionCriteria(extensions ...string) query.Criteria {
+	return query.DaoCriteria{
+		Conditions: func(ctx query.DbContext) ([]field.Expr, error) {
+			return []field.Expr{ctx.Query().TorrentFile.Extension.In(extensions...)}, nil
+		},
+	}
+}
+
+// FileTypeCriteria matches files of any of the given types, for searches of torrent files.
+func FileTypeCriteria(fileTypes ...model.FileType) query.Criteria {
+	var extensions []string
+	for _, fileType := range fileTypes {
+		extensions = append(extensions, fileType.Extensions()...)
+	}
+	return FileExtensionCriteria(extensions...)
+}
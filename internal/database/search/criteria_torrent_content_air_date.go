@@ -0,0 +1,20 @@
+package search
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/database/query"
+	"github.com/bitmagnet-io/bitmagnet/internal/maps"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"gorm.io/gen/field"
+)
+
+// TorrentContentAirDateCriteria matches daily shows released by air date with an air date in the range.
+func TorrentContentAirDateCriteria(dateRange model.DateRange) query.Criteria {
+	return query.DaoCriteria{
+		Conditions: func(ctx query.DbContext) ([]field.Expr, error) {
+			return dateRangeConditions(ctx.Query().TorrentContent.AirDate, dateRange), nil
+		},
+		Joins: maps.NewInsertMap(
+			maps.MapEntry[string, struct{}]{Key: model.TableNameTorrentContent},
+		),
+	}
+}
@@ -13,6 +13,10 @@ var VideoResolutionCriteria = torrentContentAttributeCriteria[model.VideoResolut
 
 var Video3dCriteria = torrentContentAttributeCriteria[model.Video3d](video3dField)
 
+var VideoCodecCriteria = torrentContentAttributeCriteria[model.VideoCodec](videoCodecField)
+
+var VideoSourceCriteria = torrentContentAttributeCriteria[model.VideoSource](videoSourceField)
+
 func torrentContentAttributeCriteria[T attribute](getFld func(*dao.Query) field.Field) func(...T) query.Criteria {
 	return func(values ...T) query.Criteria {
 		return query.DaoCriteria{
@@ -0,0 +1,27 @@
+package search
+
+import (
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/query"
+	"github.com/bitmagnet-io/bitmagnet/internal/maps"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+)
+
+// TorrentContentEpisodeCriteria matches torrent content including the season, or if the episode is given, including
+// the episode either on its own or as part of a pack, such as the whole season or a range of episodes. The season and
+// episode are stored as the keys of a JSON object of seasons to objects of episodes, which is empty for a whole season.
+func TorrentContentEpisodeCriteria(season int, episode model.NullInt) query.Criteria {
+	column := model.TableNameTorrentContent + ".episodes"
+	// as the numbers are integers they're safe to inline, which avoids the ? operator being mistaken for a query parameter
+	q := fmt.Sprintf("%s ? '%d'", column, season)
+	if episode.Valid {
+		q = fmt.Sprintf(
+			"%s and (%s -> '%d' = '{}'::jsonb or %s -> '%d' ? '%d')",
+			q, column, season, column, season, episode.Int,
+		)
+	}
+	return query.RawCriteria{
+		Query: q,
+		Joins: maps.NewInsertMap(maps.MapEntry[string, struct{}]{Key: model.TableNameTorrentContent}),
+	}
+}
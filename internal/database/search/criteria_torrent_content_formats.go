@@ -0,0 +1,35 @@
+package search
+
+import (
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/query"
+	"github.com/bitmagnet-io/bitmagnet/internal/maps"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"strings"
+)
+
+// HdrFormatCriteria matches torrent content with any of the HDR formats.
+func HdrFormatCriteria(formats ...model.HdrFormat) query.Criteria {
+	return torrentContentFormatsCriteria("hdr_formats", formats)
+}
+
+// AudioFormatCriteria matches torrent content with any of the audio formats.
+func AudioFormatCriteria(formats ...model.AudioFormat) query.Criteria {
+	return torrentContentFormatsCriteria("audio_formats", formats)
+}
+
+// torrentContentFormatsCriteria matches a JSONB array column containing any of the values; as the values are from
+// a known set, they're inlined as with languages.
+func torrentContentFormatsCriteria[T fmt.Stringer](column string, values []T) query.Criteria {
+	if len(values) == 0 {
+		return query.AndCriteria{}
+	}
+	strs := make([]string, 0, len(values))
+	for _, v := range values {
+		strs = append(strs, "'"+v.String()+"'")
+	}
+	return query.RawCriteria{
+		Query: fmt.Sprintf("%s.%s ?| array[%s]", model.TableNameTorrentContent, column, strings.Join(strs, ",")),
+		Joins: maps.NewInsertMap(maps.MapEntry[string, struct{}]{Key: model.TableNameTorrentContent}),
+	}
+}
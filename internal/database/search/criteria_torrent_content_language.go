@@ -0,0 +1,72 @@
+package search
+
+import (
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/query"
+	"github.com/bitmagnet-io/bitmagnet/internal/maps"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"gorm.io/gen/field"
+)
+
+// TorrentContentLanguageCriteria matches torrent content with audio in any of the languages.
+func TorrentContentLanguageCriteria(languages ...model.Language) query.Criteria {
+	return languagesCriteria("languages", languages)
+}
+
+// TorrentContentSubtitleLanguageCriteria matches torrent content with subtitles in any of the languages.
+func TorrentContentSubtitleLanguageCriteria(languages ...model.Language) query.Criteria {
+	return languagesCriteria("subtitle_languages", languages)
+}
+
+// TorrentContentSubtitledCriteria matches torrent content that is tagged as having subtitles or not,
+// whether or not the subtitle languages are known.
+func TorrentContentSubtitledCriteria(subtitled bool) query.Criteria {
+	return query.DaoCriteria{
+		Conditions: func(ctx query.DbContext) ([]field.Expr, error) {
+			return []field.Expr{
+				ctx.Query().TorrentContent.Subtitled.Is(subtitled),
+			}, nil
+		},
+		Joins: maps.NewInsertMap(
+			maps.MapEntry[string, struct{}]{Key: model.TableNameTorrentContent},
+		),
+	}
+}
+
+// TorrentContentMultiAudioCriteria matches torrent content that is tagged as having multiple audio languages or not,
+// such as with MULTI or DUAL.
+func TorrentContentMultiAudioCriteria(multiAudio bool) query.Criteria {
+	return query.DaoCriteria{
+		Conditions: func(ctx query.DbContext) ([]field.Expr, error) {
+			return []field.Expr{
+				ctx.Query().TorrentContent.MultiAudio.Is(multiAudio),
+			}, nil
+		},
+		Joins: maps.NewInsertMap(
+			maps.MapEntry[string, struct{}]{Key: model.TableNameTorrentContent},
+		),
+	}
+}
+
+func languagesCriteria(column string, languages []model.Language) query.Criteria {
+	if len(languages) == 0 {
+		return query.AndCriteria{}
+	}
+	return query.RawCriteria{
+		Query: fmt.Sprintf("%s.%s ?| %s", model.TableNameTorrentContent, column, languagesArray(languages)),
+		Joins: maps.NewInsertMap(maps.MapEntry[string, struct{}]{Key: model.TableNameTorrentContent}),
+	}
+}
+
+// languagesArray returns an array literal of language IDs; as the IDs are from a known set, they're safe to inline,
+// which avoids the ?| operator being mistaken for a query parameter.
+func languagesArray(languages []model.Language) string {
+	array := "array["
+	for i, lang := range languages {
+		if i > 0 {
+			array += ","
+		}
+		array += fmt.Sprintf("'%s'", lang.Id())
+	}
+	return array + "]"
+}
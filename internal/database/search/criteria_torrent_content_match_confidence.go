@@ -0,0 +1,23 @@
+package search
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/database/query"
+	"github.com/bitmagnet-io/bitmagnet/internal/maps"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"gorm.io/gen/field"
+)
+
+// TorrentContentMatchConfidenceBelowCriteria matches torrents matched to content with a confidence below the maximum;
+// torrents that aren't matched to content have no confidence, and aren't matched.
+func TorrentContentMatchConfidenceBelowCriteria(max float32) query.Criteria {
+	return query.DaoCriteria{
+		Conditions: func(ctx query.DbContext) ([]field.Expr, error) {
+			return []field.Expr{
+				ctx.Query().TorrentContent.MatchConfidence.Lt(max),
+			}, nil
+		},
+		Joins: maps.NewInsertMap(
+			maps.MapEntry[string, struct{}]{Key: model.TableNameTorrentContent},
+		),
+	}
+}
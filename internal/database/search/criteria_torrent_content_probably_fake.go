@@ -0,0 +1,23 @@
+package search
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/database/query"
+	"github.com/bitmagnet-io/bitmagnet/internal/maps"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"gorm.io/gen/field"
+)
+
+// TorrentContentProbablyFakeCriteria matches torrent content that was flagged by the classifier as probably fake or not,
+// as its size is implausible for its content.
+func TorrentContentProbablyFakeCriteria(probablyFake bool) query.Criteria {
+	return query.DaoCriteria{
+		Conditions: func(ctx query.DbContext) ([]field.Expr, error) {
+			return []field.Expr{
+				ctx.Query().TorrentContent.ProbablyFake.Is(probablyFake),
+			}, nil
+		},
+		Joins: maps.NewInsertMap(
+			maps.MapEntry[string, struct{}]{Key: model.TableNameTorrentContent},
+		),
+	}
+}
@@ -0,0 +1,40 @@
+package search
+
+import (
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/query"
+	"github.com/bitmagnet-io/bitmagnet/internal/maps"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"gorm.io/gen/field"
+	"strings"
+)
+
+// TorrentContentQuarantinedCriteria matches torrent content that is quarantined as probable spam or malware, or not.
+func TorrentContentQuarantinedCriteria(quarantined bool) query.Criteria {
+	return query.DaoCriteria{
+		Conditions: func(ctx query.DbContext) ([]field.Expr, error) {
+			return []field.Expr{
+				ctx.Query().TorrentContent.Quarantined.Is(quarantined),
+			}, nil
+		},
+		Joins: maps.NewInsertMap(
+			maps.MapEntry[string, struct{}]{Key: model.TableNameTorrentContent},
+		),
+	}
+}
+
+// TorrentContentSpamReasonCriteria matches torrent content detected as spam for any of the reasons.
+func TorrentContentSpamReasonCriteria(reasons ...model.SpamReason) query.Criteria {
+	if len(reasons) == 0 {
+		return query.AndCriteria{}
+	}
+	// as the reasons are from a known set, they're safe to inline, which avoids the ?| operator being mistaken for a query parameter
+	values := make([]string, 0, len(reasons))
+	for _, r := range reasons {
+		values = append(values, fmt.Sprintf("'%s'", r.String()))
+	}
+	return query.RawCriteria{
+		Query: fmt.Sprintf("%s.spam_reasons ?| array[%s]", model.TableNameTorrentContent, strings.Join(values, ",")),
+		Joins: maps.NewInsertMap(maps.MapEntry[string, struct{}]{Key: model.TableNameTorrentContent}),
+	}
+}
@@ -0,0 +1,24 @@
+package search
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/query"
+	"github.com/bitmagnet-io/bitmagnet/internal/maps"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+)
+
+// TorrentContentReleaseTokenCriteria matches torrent content with any of the release tokens.
+func TorrentContentReleaseTokenCriteria(tokens ...model.ReleaseToken) query.Criteria {
+	criteria := make([]query.Criteria, 0, len(tokens))
+	for _, token := range tokens {
+		// containment of a single element array is supported by the GIN index, unlike the ?| operator with a parameter
+		value, _ := json.Marshal([]string{token.String()})
+		criteria = append(criteria, query.RawCriteria{
+			Query: fmt.Sprintf("%s.release_tokens @> ?::jsonb", model.TableNameTorrentContent),
+			Args:  []interface{}{string(value)},
+			Joins: maps.NewInsertMap(maps.MapEntry[string, struct{}]{Key: model.TableNameTorrentContent}),
+		})
+	}
+	return query.Or(criteria...)
+}
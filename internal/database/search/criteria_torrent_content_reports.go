@@ -0,0 +1,22 @@
+package search
+
+import (
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/query"
+	"github.com/bitmagnet-io/bitmagnet/internal/maps"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+)
+
+// TorrentContentMaxReportsCriteria matches torrent content of torrents reported by users no more than the given number of times.
+func TorrentContentMaxReportsCriteria(maxReports uint) query.Criteria {
+	return query.RawCriteria{
+		Query: fmt.Sprintf(
+			"(select count(*) from %s where %s.info_hash = %s.info_hash) <= ?",
+			model.TableNameTorrentReport,
+			model.TableNameTorrentReport,
+			model.TableNameTorrentContent,
+		),
+		Args:  []interface{}{maxReports},
+		Joins: maps.NewInsertMap(maps.MapEntry[string, struct{}]{Key: model.TableNameTorrentContent}),
+	}
+}
@@ -67,6 +67,29 @@ func (r yearFacet) Aggregate(ctx query.FacetContext) (query.AggregationItems, er
 	return agg, nil
 }
 
+// Group groups by the release year of the content, which is joined when searching torrent content.
+func (r yearFacet) Group(query.DbContext) (query.FacetGroup, error) {
+	return query.FacetGroup{
+		Expr:  model.TableNameContent + "." + r.field,
+		Joins: []string{model.TableNameContent},
+	}, nil
+}
+
+func (r yearFacet) GroupAggregation(counts []query.FacetGroupCount) (query.AggregationItems, error) {
+	agg := make(query.AggregationItems, len(counts))
+	for _, c := range counts {
+		key, label := "null", "Unknown"
+		if c.Value != nil {
+			key, label = *c.Value, *c.Value
+		}
+		agg[key] = query.AggregationItem{
+			Label: label,
+			Count: c.Count,
+		}
+	}
+	return agg, nil
+}
+
 func (r yearFacet) Criteria() []query.Criteria {
 	return []query.Criteria{
 		query.GenCriteria(func(ctx query.DbContext) (query.Criteria, error) {
@@ -42,23 +42,49 @@ func (f torrentContentAttributeFacet[T]) Aggregate(ctx query.FacetContext) (quer
 	}
 	agg := make(query.AggregationItems, len(results))
 	for _, item := range results {
-		var key, label string
-		if item.Value == nil {
-			key = "null"
-			label = "Unknown"
-		} else {
-			vV := *item.Value
-			key = vV.String()
-			label = vV.Label()
-		}
-		agg[key] = query.AggregationItem{
-			Label: label,
-			Count: item.Count,
+		addAttributeAggregationItem(agg, item.Value, item.Count)
+	}
+	return agg, nil
+}
+
+func (f torrentContentAttributeFacet[T]) Group(ctx query.DbContext) (query.FacetGroup, error) {
+	return query.FacetGroup{
+		Expr: ctx.TableName() + "." + string(f.field(ctx.Query()).ColumnName()),
+	}, nil
+}
+
+func (f torrentContentAttributeFacet[T]) GroupAggregation(counts []query.FacetGroupCount) (query.AggregationItems, error) {
+	agg := make(query.AggregationItems, len(counts))
+	for _, c := range counts {
+		var value *T
+		if c.Value != nil {
+			v, err := f.parse(*c.Value)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse aggregated value: %w", err)
+			}
+			value = &v
 		}
+		addAttributeAggregationItem(agg, value, c.Count)
 	}
 	return agg, nil
 }
 
+func addAttributeAggregationItem[T attribute](agg query.AggregationItems, value *T, count uint) {
+	var key, label string
+	if value == nil {
+		key = "null"
+		label = "Unknown"
+	} else {
+		vV := *value
+		key = vV.String()
+		label = vV.Label()
+	}
+	agg[key] = query.AggregationItem{
+		Label: label,
+		Count: count,
+	}
+}
+
 func (f torrentContentAttributeFacet[T]) Criteria() []query.Criteria {
 	return []query.Criteria{
 		query.GenCriteria(func(ctx query.DbContext) (query.Criteria, error) {
@@ -0,0 +1,133 @@
+package search
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/query"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+)
+
+const (
+	HdrFormatFacetKey   = "hdr_format"
+	AudioFormatFacetKey = "audio_format"
+)
+
+func HdrFormatFacet(options ...query.FacetOption) query.Facet {
+	return torrentContentFormatsFacet[model.HdrFormat]{
+		FacetConfig: query.NewFacetConfig(
+			append([]query.FacetOption{
+				query.FacetHasKey(HdrFormatFacetKey),
+				query.FacetHasLabel("HDR Format"),
+				query.FacetUsesOrLogic(),
+			}, options...)...,
+		),
+		column:   "hdr_formats",
+		parse:    model.ParseHdrFormat,
+		criteria: HdrFormatCriteria,
+	}
+}
+
+func AudioFormatFacet(options ...query.FacetOption) query.Facet {
+	return torrentContentFormatsFacet[model.AudioFormat]{
+		FacetConfig: query.NewFacetConfig(
+			append([]query.FacetOption{
+				query.FacetHasKey(AudioFormatFacetKey),
+				query.FacetHasLabel("Audio Format"),
+				query.FacetUsesOrLogic(),
+			}, options...)...,
+		),
+		column:   "audio_formats",
+		parse:    model.ParseAudioFormat,
+		criteria: AudioFormatCriteria,
+	}
+}
+
+// torrentContentFormatsFacet is a facet of an attribute of which torrent content can have several values,
+// stored as a JSONB array.
+type torrentContentFormatsFacet[T attribute] struct {
+	query.FacetConfig
+	column   string
+	parse    func(string) (T, error)
+	criteria func(...T) query.Criteria
+}
+
+func (f torrentContentFormatsFacet[T]) Aggregate(ctx query.FacetContext) (query.AggregationItems, error) {
+	var results []struct {
+		Value string
+		Count uint
+	}
+	q, qErr := ctx.NewAggregationQuery()
+	if qErr != nil {
+		return nil, qErr
+	}
+	if err := q.UnderlyingDB().Select(
+		fmt.Sprintf("jsonb_array_elements_text(%s.%s) as value", model.TableNameTorrentContent, f.column),
+		"count(*) as count",
+	).Group(
+		"value",
+	).Find(&results).Error; err != nil {
+		return nil, fmt.Errorf("failed to aggregate %s: %w", f.column, err)
+	}
+	agg := make(query.AggregationItems, len(results))
+	for _, item := range results {
+		v, err := f.parse(item.Value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse aggregated value: %w", err)
+		}
+		agg[v.String()] = query.AggregationItem{
+			Label: v.Label(),
+			Count: item.Count,
+		}
+	}
+	return agg, nil
+}
+
+// Group groups by the array of values, for the same reason as the languages facet.
+func (f torrentContentFormatsFacet[T]) Group(query.DbContext) (query.FacetGroup, error) {
+	return query.FacetGroup{
+		Expr:  model.TableNameTorrentContent + "." + f.column,
+		Joins: []string{model.TableNameTorrentContent},
+	}, nil
+}
+
+func (f torrentContentFormatsFacet[T]) GroupAggregation(counts []query.FacetGroupCount) (query.AggregationItems, error) {
+	agg := make(query.AggregationItems)
+	for _, c := range counts {
+		if c.Value == nil {
+			continue
+		}
+		var strs []string
+		if err := json.Unmarshal([]byte(*c.Value), &strs); err != nil {
+			return nil, fmt.Errorf("failed to parse aggregated %s: %w", f.column, err)
+		}
+		for _, str := range strs {
+			v, err := f.parse(str)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse aggregated value: %w", err)
+			}
+			item := agg[v.String()]
+			agg[v.String()] = query.AggregationItem{
+				Label: v.Label(),
+				Count: item.Count + c.Count,
+			}
+		}
+	}
+	return agg, nil
+}
+
+func (f torrentContentFormatsFacet[T]) Criteria() []query.Criteria {
+	return []query.Criteria{
+		query.GenCriteria(func(query.DbContext) (query.Criteria, error) {
+			filter := f.Filter().Values()
+			values := make([]T, 0, len(filter))
+			for _, v := range filter {
+				parsed, err := f.parse(v)
+				if err != nil {
+					return nil, err
+				}
+				values = append(values, parsed)
+			}
+			return f.criteria(values...), nil
+		}),
+	}
+}
@@ -0,0 +1,30 @@
+package search
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/database/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestHdrFormatFacet_GroupAggregation(t *testing.T) {
+	t.Parallel()
+
+	value := func(str string) *string {
+		return &str
+	}
+
+	agg, err := HdrFormatFacet().(query.GroupedFacet).GroupAggregation([]query.FacetGroupCount{
+		{Value: value(`["DV", "HDR10"]`), Count: 3},
+		{Value: value(`["HDR10Plus"]`), Count: 2},
+		{Value: value(`["HDR10"]`), Count: 1},
+		{Value: nil, Count: 10},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, query.AggregationItems{
+		"DV":        {Label: "Dolby Vision", Count: 3},
+		"HDR10":     {Label: "HDR10", Count: 4},
+		"HDR10Plus": {Label: "HDR10+", Count: 2},
+	}, agg)
+}
@@ -1,10 +1,10 @@
 package search
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/bitmagnet-io/bitmagnet/internal/database/query"
-	"github.com/bitmagnet-io/bitmagnet/internal/maps"
 	"github.com/bitmagnet-io/bitmagnet/internal/model"
 )
 
@@ -54,6 +54,36 @@ func (f torrentContentLanguageFacet) Aggregate(ctx query.FacetContext) (query.Ag
 	return agg, nil
 }
 
+// Group groups by the array of languages, as grouping by its elements would count other facets' rows once per language;
+// the counts of each combination of languages are then summed for each language.
+func (f torrentContentLanguageFacet) Group(query.DbContext) (query.FacetGroup, error) {
+	return query.FacetGroup{
+		Expr:  "torrent_contents.languages",
+		Joins: []string{model.TableNameTorrentContent},
+	}, nil
+}
+
+func (f torrentContentLanguageFacet) GroupAggregation(counts []query.FacetGroupCount) (query.AggregationItems, error) {
+	agg := make(query.AggregationItems)
+	for _, c := range counts {
+		if c.Value == nil {
+			continue
+		}
+		var langs model.Languages
+		if err := json.Unmarshal([]byte(*c.Value), &langs); err != nil {
+			return nil, fmt.Errorf("failed to parse aggregated languages: %w", err)
+		}
+		for lang := range langs {
+			item := agg[lang.Id()]
+			agg[lang.Id()] = query.AggregationItem{
+				Label: lang.Name(),
+				Count: item.Count + c.Count,
+			}
+		}
+	}
+	return agg, nil
+}
+
 func (f torrentContentLanguageFacet) Criteria() []query.Criteria {
 	return []query.Criteria{
 		query.GenCriteria(func(ctx query.DbContext) (query.Criteria, error) {
@@ -66,21 +96,7 @@ func (f torrentContentLanguageFacet) Criteria() []query.Criteria {
 				}
 				langs = append(langs, lang.Language)
 			}
-			if len(langs) == 0 {
-				return query.AndCriteria{}, nil
-			}
-			array := "array["
-			for i, lang := range langs {
-				if i > 0 {
-					array += ","
-				}
-				array += fmt.Sprintf("'%s'", lang.Id())
-			}
-			array += "]"
-			return query.RawCriteria{
-				Query: "torrent_contents.languages ?| " + array,
-				Joins: maps.NewInsertMap(maps.MapEntry[string, struct{}]{Key: model.TableNameTorrentContent}),
-			}, nil
+			return TorrentContentLanguageCriteria(langs...), nil
 		}),
 	}
 }
@@ -0,0 +1,30 @@
+package search
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/database/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestTorrentContentLanguageFacet_GroupAggregation(t *testing.T) {
+	t.Parallel()
+
+	value := func(str string) *string {
+		return &str
+	}
+
+	agg, err := torrentContentLanguageFacet{}.GroupAggregation([]query.FacetGroupCount{
+		{Value: value(`["en"]`), Count: 5},
+		{Value: value(`["en", "fr"]`), Count: 2},
+		{Value: value(`["de"]`), Count: 1},
+		{Value: nil, Count: 10},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, query.AggregationItems{
+		"en": {Label: "English", Count: 7},
+		"fr": {Label: "French", Count: 2},
+		"de": {Label: "German", Count: 1},
+	}, agg)
+}
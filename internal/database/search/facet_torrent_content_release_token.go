@@ -0,0 +1,102 @@
+package search
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/query"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+)
+
+const ReleaseTokenFacetKey = "release_token"
+
+func TorrentContentReleaseTokenFacet(options ...query.FacetOption) query.Facet {
+	return torrentContentReleaseTokenFacet{
+		FacetConfig: query.NewFacetConfig(
+			append([]query.FacetOption{
+				query.FacetHasKey(ReleaseTokenFacetKey),
+				query.FacetHasLabel("Release Token"),
+				query.FacetUsesAndLogic(),
+			}, options...)...,
+		),
+	}
+}
+
+type torrentContentReleaseTokenFacet struct {
+	query.FacetConfig
+}
+
+func (f torrentContentReleaseTokenFacet) Aggregate(ctx query.FacetContext) (query.AggregationItems, error) {
+	var results []struct {
+		Token string
+		Count uint
+	}
+	q, qErr := ctx.NewAggregationQuery()
+	if qErr != nil {
+		return nil, qErr
+	}
+	tx := q.UnderlyingDB().Select(
+		"jsonb_array_elements_text(torrent_contents.release_tokens) as token",
+		"count(*) as count",
+	).Group(
+		"token",
+	).Find(&results)
+	if tx.Error != nil {
+		return nil, fmt.Errorf("failed to aggregate release tokens: %w", tx.Error)
+	}
+	agg := make(query.AggregationItems, len(results))
+	for _, item := range results {
+		token, err := model.ParseReleaseToken(item.Token)
+		if err != nil {
+			return nil, err
+		}
+		agg[item.Token] = query.AggregationItem{
+			Label: token.Value,
+			Count: item.Count,
+		}
+	}
+	return agg, nil
+}
+
+// Group groups by the array of tokens, as with the languages facet.
+func (f torrentContentReleaseTokenFacet) Group(query.DbContext) (query.FacetGroup, error) {
+	return query.FacetGroup{
+		Expr:  "torrent_contents.release_tokens",
+		Joins: []string{model.TableNameTorrentContent},
+	}, nil
+}
+
+func (f torrentContentReleaseTokenFacet) GroupAggregation(counts []query.FacetGroupCount) (query.AggregationItems, error) {
+	agg := make(query.AggregationItems)
+	for _, c := range counts {
+		if c.Value == nil {
+			continue
+		}
+		var tokens model.ReleaseTokens
+		if err := json.Unmarshal([]byte(*c.Value), &tokens); err != nil {
+			return nil, fmt.Errorf("failed to parse aggregated release tokens: %w", err)
+		}
+		for _, token := range tokens {
+			item := agg[token.String()]
+			agg[token.String()] = query.AggregationItem{
+				Label: token.Value,
+				Count: item.Count + c.Count,
+			}
+		}
+	}
+	return agg, nil
+}
+
+func (f torrentContentReleaseTokenFacet) Criteria() []query.Criteria {
+	filter := f.Filter().Values()
+	criteria := make([]query.Criteria, len(filter))
+	for i, v := range filter {
+		token, err := model.ParseReleaseToken(v)
+		criteria[i] = query.GenCriteria(func(query.DbContext) (query.Criteria, error) {
+			if err != nil {
+				return nil, err
+			}
+			return TorrentContentReleaseTokenCriteria(token), nil
+		})
+	}
+	return criteria
+}
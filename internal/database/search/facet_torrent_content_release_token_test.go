@@ -0,0 +1,29 @@
+package search
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/database/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestTorrentContentReleaseTokenFacet_GroupAggregation(t *testing.T) {
+	t.Parallel()
+
+	value := func(str string) *string {
+		return &str
+	}
+
+	agg, err := torrentContentReleaseTokenFacet{}.GroupAggregation([]query.FacetGroupCount{
+		{Value: value(`["hdr:DV", "hdr:HDR10+"]`), Count: 3},
+		{Value: value(`["hdr:DV", "streaming_service:NF"]`), Count: 2},
+		{Value: nil, Count: 10},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, query.AggregationItems{
+		"hdr:DV":               {Label: "DV", Count: 5},
+		"hdr:HDR10+":           {Label: "HDR10+", Count: 3},
+		"streaming_service:NF": {Label: "NF", Count: 2},
+	}, agg)
+}
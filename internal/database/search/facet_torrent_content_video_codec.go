@@ -9,6 +9,10 @@ import (
 
 const VideoCodecFacetKey = "video_codec"
 
+func videoCodecField(q *dao.Query) field.Field {
+	return q.TorrentContent.VideoCodec
+}
+
 func VideoCodecFacet(options ...query.FacetOption) query.Facet {
 	return torrentContentAttributeFacet[model.VideoCodec]{
 		FacetConfig: query.NewFacetConfig(
@@ -18,9 +22,7 @@ func VideoCodecFacet(options ...query.FacetOption) query.Facet {
 				query.FacetUsesOrLogic(),
 			}, options...)...,
 		),
-		field: func(q *dao.Query) field.Field {
-			return q.TorrentContent.VideoCodec
-		},
+		field: videoCodecField,
 		parse: model.ParseVideoCodec,
 	}
 }
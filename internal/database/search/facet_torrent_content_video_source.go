@@ -9,6 +9,10 @@ import (
 
 const VideoSourceFacetKey = "video_source"
 
+func videoSourceField(q *dao.Query) field.Field {
+	return q.TorrentContent.VideoSource
+}
+
 func VideoSourceFacet(options ...query.FacetOption) query.Facet {
 	return torrentContentAttributeFacet[model.VideoSource]{
 		FacetConfig: query.NewFacetConfig(
@@ -18,9 +22,7 @@ func VideoSourceFacet(options ...query.FacetOption) query.Facet {
 				query.FacetUsesOrLogic(),
 			}, options...)...,
 		),
-		field: func(q *dao.Query) field.Field {
-			return q.TorrentContent.VideoSource
-		},
+		field: videoSourceField,
 		parse: model.ParseVideoSource,
 	}
 }
@@ -0,0 +1,17 @@
+package search
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/database/query"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"gorm.io/gorm/clause"
+)
+
+// TorrentContentFuzzyQueryString matches a query string against torrent names and content titles by trigram similarity,
+// for queries that may be misspelled; it's an alternative to the full text search of the query string option.
+func TorrentContentFuzzyQueryString(str string) query.Option {
+	return query.FuzzyQueryString(
+		str,
+		clause.Column{Table: model.TableNameTorrent, Name: "name"},
+		clause.Column{Table: model.TableNameContent, Name: "title"},
+	)
+}
@@ -0,0 +1,116 @@
+package search
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/database/fts"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/query"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"gorm.io/gorm/clause"
+	"strings"
+)
+
+// highlightStartSel and highlightStopSel delimit the matched words of a highlight; they're control characters,
+// so that they can't be confused with the highlighted text.
+const (
+	highlightStartSel = "\x02"
+	highlightStopSel  = "\x03"
+)
+
+const (
+	HighlightFieldName  = "name"
+	HighlightFieldTitle = "title"
+	HighlightFieldFile  = "file"
+)
+
+// TorrentContentHighlights are snippets of a torrent content's fields, with the words that matched a query string
+// delimited. The file is the path of the first file that matched the query string on its own; fields are null
+// where there's nothing to highlight.
+type TorrentContentHighlights struct {
+	Name  model.NullString
+	Title model.NullString
+	File  model.NullString
+}
+
+type HighlightSegment struct {
+	Text    string
+	Matched bool
+}
+
+// ParseHighlight splits a highlight into segments of matched and unmatched text.
+func ParseHighlight(str model.NullString) []HighlightSegment {
+	if !str.Valid {
+		return nil
+	}
+	var segments []HighlightSegment
+	rest := str.String
+	for rest != "" {
+		start := strings.Index(rest, highlightStartSel)
+		if start < 0 {
+			segments = append(segments, HighlightSegment{Text: rest})
+			break
+		}
+		if start > 0 {
+			segments = append(segments, HighlightSegment{Text: rest[:start]})
+		}
+		rest = rest[start+len(highlightStartSel):]
+		stop := strings.Index(rest, highlightStopSel)
+		if stop < 0 {
+			stop = len(rest)
+		}
+		if stop > 0 {
+			segments = append(segments, HighlightSegment{Text: rest[:stop], Matched: true})
+		}
+		rest = strings.TrimPrefix(rest[stop:], highlightStopSel)
+	}
+	return segments
+}
+
+// MatchedFields returns the fields in which any word matched the query string.
+func (h TorrentContentHighlights) MatchedFields() []string {
+	fields := make([]string, 0, 3)
+	for _, f := range []struct {
+		name  string
+		value model.NullString
+	}{
+		{HighlightFieldName, h.Name},
+		{HighlightFieldTitle, h.Title},
+		{HighlightFieldFile, h.File},
+	} {
+		if f.value.Valid && strings.Contains(f.value.String, highlightStartSel) {
+			fields = append(fields, f.name)
+		}
+	}
+	return fields
+}
+
+// TorrentContentHighlight selects highlights of the torrent name, content title and first matching file path for a
+// query string, into the Highlights of the result items. Texts are split into words on any non-alphanumeric characters,
+// approximating the tokenizer that the text search vectors are built with, so the highlights are normalized in the same way.
+func TorrentContentHighlight(str string) query.Option {
+	tsquery := fts.AppQueryToTsquery(str)
+	if tsquery == "" {
+		return query.Options()
+	}
+	options := "StartSel=" + highlightStartSel + ", StopSel=" + highlightStopSel
+	headline := func(text string) string {
+		return "ts_headline('simple', regexp_replace(" + text + ", '[^[:alnum:]]+', ' ', 'g'), ?::tsquery, ?)"
+	}
+	return query.Options(
+		query.RequireJoin(model.TableNameTorrent, model.TableNameContent),
+		query.Select(
+			clause.Expr{
+				SQL:  headline(model.TableNameTorrent+".name") + " AS highlight_name",
+				Vars: []interface{}{tsquery, options + ", HighlightAll=true"},
+			},
+			clause.Expr{
+				SQL:  headline(model.TableNameContent+".title") + " AS highlight_title",
+				Vars: []interface{}{tsquery, options + ", HighlightAll=true"},
+			},
+			clause.Expr{
+				SQL: "(SELECT " + headline("f.path") + " FROM " + model.TableNameTorrentFile + " f" +
+					" WHERE f.info_hash = " + model.TableNameTorrentContent + ".info_hash AND f.tsv @@ ?::tsquery" +
+					" ORDER BY f.index LIMIT 1) AS highlight_file",
+				Vars: []interface{}{tsquery, options, tsquery},
+			},
+		),
+	)
+}
@@ -0,0 +1,45 @@
+package search
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseHighlight(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		input    model.NullString
+		expected []HighlightSegment
+	}{
+		{model.NullString{}, nil},
+		{model.NewNullString("Some Movie 2023"), []HighlightSegment{{Text: "Some Movie 2023"}}},
+		{
+			model.NewNullString("\x02Interstellar\x03 2014 \x021080p\x03"),
+			[]HighlightSegment{
+				{Text: "Interstellar", Matched: true},
+				{Text: " 2014 "},
+				{Text: "1080p", Matched: true},
+			},
+		},
+		{
+			model.NewNullString("The \x02Movie"),
+			[]HighlightSegment{{Text: "The "}, {Text: "Movie", Matched: true}},
+		},
+	} {
+		assert.Equal(t, tc.expected, ParseHighlight(tc.input), tc.input.String)
+	}
+}
+
+func TestTorrentContentHighlightsMatchedFields(t *testing.T) {
+	t.Parallel()
+
+	h := TorrentContentHighlights{
+		Name:  model.NewNullString("\x02Interstellar\x03 2014 1080p"),
+		Title: model.NewNullString("Interstellar"),
+		File:  model.NewNullString("Featurettes \x02Interstellar\x03 Science"),
+	}
+	assert.Equal(t, []string{HighlightFieldName, HighlightFieldFile}, h.MatchedFields())
+	assert.Empty(t, TorrentContentHighlights{}.MatchedFields())
+}
@@ -0,0 +1,63 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/query"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+)
+
+// HydrateTorrentContentFileContents loads the contents matched to the files of torrents containing several contents,
+// which are referenced by the torrent contents rather than joined.
+func HydrateTorrentContentFileContents() query.Option {
+	return func(b query.OptionBuilder) (query.OptionBuilder, error) {
+		return b.Callback(func(ctx context.Context, cbCtx query.CallbackContext, result any) error {
+			items, ok := result.([]TorrentContentResultItem)
+			if !ok {
+				return errors.New("invalid result type")
+			}
+			return hydrateTorrentContentFileContents(ctx, cbCtx, items)
+		}), nil
+	}
+}
+
+func hydrateTorrentContentFileContents(ctx context.Context, cbCtx query.CallbackContext, items []TorrentContentResultItem) error {
+	refsMap := make(map[model.ContentRef]struct{})
+	var refs []model.ContentRef
+	cbCtx.Lock()
+	for _, item := range items {
+		for _, fc := range item.FileContents {
+			if _, ok := refsMap[fc.ContentRef()]; !ok {
+				refsMap[fc.ContentRef()] = struct{}{}
+				refs = append(refs, fc.ContentRef())
+			}
+		}
+	}
+	cbCtx.Unlock()
+	if len(refs) == 0 {
+		return nil
+	}
+	contentResult, err := search{cbCtx.Query()}.Content(
+		ctx,
+		query.Where(ContentCanonicalIdentifierCriteria(refs...)),
+		ContentDefaultPreload(),
+		ContentDefaultHydrate(),
+	)
+	if err != nil {
+		return err
+	}
+	contents := make(map[model.ContentRef]model.Content, len(contentResult.Items))
+	for _, c := range contentResult.Items {
+		contents[c.Ref()] = c.Content
+	}
+	cbCtx.Lock()
+	defer cbCtx.Unlock()
+	for i := range items {
+		for j, fc := range items[i].FileContents {
+			if content, ok := contents[fc.ContentRef()]; ok {
+				items[i].FileContents[j].Content = &content
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package search
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/query"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+)
+
+// HydrateTorrentContentReports loads the numbers of user reports of the torrents.
+func HydrateTorrentContentReports() query.Option {
+	return query.HydrateHasOne[TorrentContentResultItem, model.TorrentReportCounts, protocol.ID](
+		torrentContentReportsHydrator{},
+	)
+}
+
+type torrentContentReportsHydrator struct{}
+
+func (h torrentContentReportsHydrator) RootToSubID(root TorrentContentResultItem) (protocol.ID, bool) {
+	return root.InfoHash, true
+}
+
+func (h torrentContentReportsHydrator) GetSubs(ctx context.Context, dbCtx query.DbContext, ids []protocol.ID) ([]model.TorrentReportCounts, error) {
+	return dbCtx.Query().TorrentReport.Counts(ctx, ids)
+}
+
+func (h torrentContentReportsHydrator) SubID(item model.TorrentReportCounts) protocol.ID {
+	return item.InfoHash
+}
+
+func (h torrentContentReportsHydrator) Hydrate(root *TorrentContentResultItem, sub model.TorrentReportCounts) {
+	root.Reports = sub
+}
+
+func (h torrentContentReportsHydrator) MustSucceed() bool {
+	return false
+}
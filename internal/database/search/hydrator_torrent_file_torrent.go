@@ -0,0 +1,40 @@
+package search
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/query"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+)
+
+func HydrateTorrentFileTorrent() query.Option {
+	return query.HydrateHasOne[TorrentFileResultItem, model.Torrent, protocol.ID](
+		torrentFileTorrentHydrator{},
+	)
+}
+
+type torrentFileTorrentHydrator struct{}
+
+func (h torrentFileTorrentHydrator) RootToSubID(root TorrentFileResultItem) (protocol.ID, bool) {
+	return root.InfoHash, true
+}
+
+func (h torrentFileTorrentHydrator) GetSubs(ctx context.Context, dbCtx query.DbContext, ids []protocol.ID) ([]model.Torrent, error) {
+	result, err := search{dbCtx.Query()}.Torrents(ctx, query.Where(TorrentInfoHashCriteria(ids...)), torrentFileTorrentPreload())
+	if err != nil {
+		return nil, err
+	}
+	return result.Items, nil
+}
+
+func (h torrentFileTorrentHydrator) SubID(item model.Torrent) protocol.ID {
+	return item.InfoHash
+}
+
+func (h torrentFileTorrentHydrator) Hydrate(root *TorrentFileResultItem, sub model.Torrent) {
+	root.Torrent = sub
+}
+
+func (h torrentFileTorrentHydrator) MustSucceed() bool {
+	return true
+}
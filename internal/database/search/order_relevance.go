@@ -0,0 +1,31 @@
+package search
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/database/query"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"gorm.io/gorm/clause"
+)
+
+// TorrentContentOrderByRelevance orders torrent contents by a relevance score, most relevant first, combining the
+// query string rank with the log of the torrent's seeders and a recency that decays with the time since the torrent was
+// classified, having halved by RecencyHalfLife; components with a weight of zero are left out. The rank is only
+// counted if the query string option was applied first. The age is taken at the query's reference time rather than
+// now(), so that the scores don't decay between the pages of a cursor.
+func TorrentContentOrderByRelevance(c Config) query.Option {
+	var terms []clause.Expr
+	if c.SeedersWeight != 0 {
+		terms = append(terms, clause.Expr{
+			SQL: "?::float8 * ln(1 + coalesce((SELECT max(s.seeders) FROM " + model.TableNameTorrentsTorrentSource +
+				" s WHERE s.info_hash = " + model.TableNameTorrentContent + ".info_hash), 0))",
+			Vars: []interface{}{c.SeedersWeight},
+		})
+	}
+	if c.RecencyWeight != 0 && c.RecencyHalfLife > 0 {
+		terms = append(terms, clause.Expr{
+			SQL: "?::float8 / (1 + greatest(extract(epoch FROM ?::timestamptz - " + model.TableNameTorrentContent +
+				".created_at)::float8, 0) / ?::float8)",
+			Vars: []interface{}{c.RecencyWeight, query.ReferenceTime, c.RecencyHalfLife.Seconds()},
+		})
+	}
+	return query.OrderByRelevance(c.RankWeight, terms...)
+}
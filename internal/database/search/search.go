@@ -8,8 +8,11 @@ import (
 
 type Search interface {
 	ContentSearch
+	ContentTrendingSearch
 	TorrentSearch
 	TorrentContentSearch
+	TorrentFileSearch
+	TorrentLookupSearch
 }
 
 type search struct {
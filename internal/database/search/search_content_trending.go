@@ -0,0 +1,99 @@
+package search
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/cache"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"time"
+)
+
+const (
+	TrendingDefaultWindow = 7 * 24 * time.Hour
+	TrendingDefaultLimit  = 20
+	TrendingMaxLimit      = 100
+)
+
+type TrendingQuery struct {
+	// Window is the sliding window over which discoveries and swarm growth are counted; defaults to a week.
+	Window time.Duration
+	// ContentType restricts the ranking to a content type, if valid.
+	ContentType model.NullContentType
+	Limit       uint
+	// CacheMode is how the query uses the search cache; the warmer stores the results of the default queries.
+	CacheMode cache.Mode
+}
+
+type TrendingContentItem struct {
+	ContentType     model.ContentType
+	ContentSource   string
+	ContentID       string
+	DiscoveredCount int
+	SwarmGrowth     float64
+	Score           float64
+}
+
+func (i TrendingContentItem) Ref() model.ContentRef {
+	return model.ContentRef{
+		Type:   i.ContentType,
+		Source: i.ContentSource,
+		ID:     i.ContentID,
+	}
+}
+
+type ContentTrendingSearch interface {
+	// ContentTrending ranks content by the number of its torrents discovered within the window, and by the growth of their
+	// swarms within the window. No history of swarm sizes is kept, so the growth of a swarm is estimated as the excess of
+	// its last scraped size over its health, the decaying average of its size; this is positive when a swarm is growing.
+	// Both are log-scaled before being added, so that neither dominates the score.
+	ContentTrending(ctx context.Context, q TrendingQuery) ([]TrendingContentItem, error)
+}
+
+// trendingSQL refers to now() rather than taking the start of the window as a parameter,
+// so that the SQL and its parameters are unchanged between calls and the results can be cached.
+const trendingSQL = `WITH recent AS (
+  SELECT info_hash, true AS discovered, 0::double precision AS growth
+  FROM torrents
+  WHERE created_at >= now() - @window * interval '1 second'
+  UNION ALL
+  SELECT info_hash, false, greatest(coalesce(seeders, 0) + coalesce(leechers, 0) - health, 0)
+  FROM torrents_torrent_sources
+  WHERE updated_at >= now() - @window * interval '1 second' AND health IS NOT NULL
+)
+SELECT tc.content_type, tc.content_source, tc.content_id,
+  count(DISTINCT r.info_hash) FILTER (WHERE r.discovered) AS discovered_count,
+  sum(r.growth) AS swarm_growth,
+  ln(1 + count(DISTINCT r.info_hash) FILTER (WHERE r.discovered)) + ln(1 + sum(r.growth)) AS score
+FROM recent r
+JOIN torrent_contents tc ON tc.info_hash = r.info_hash
+WHERE tc.content_id IS NOT NULL AND (@content_type = '' OR tc.content_type = @content_type)
+GROUP BY tc.content_type, tc.content_source, tc.content_id
+ORDER BY score DESC, discovered_count DESC, tc.content_id
+LIMIT @limit`
+
+func (s search) ContentTrending(ctx context.Context, q TrendingQuery) ([]TrendingContentItem, error) {
+	window := q.Window
+	if window <= 0 {
+		window = TrendingDefaultWindow
+	}
+	limit := q.Limit
+	if limit == 0 {
+		limit = TrendingDefaultLimit
+	}
+	limit = min(limit, TrendingMaxLimit)
+	contentType := ""
+	if q.ContentType.Valid {
+		contentType = q.ContentType.ContentType.String()
+	}
+	var items []TrendingContentItem
+	// Find rather than Scan runs the query callbacks, which are where the cache is applied
+	if err := s.q.TorrentContent.WithContext(
+		context.WithValue(ctx, cache.ModeKey, q.CacheMode),
+	).UnderlyingDB().Raw(trendingSQL, map[string]interface{}{
+		"window":       int64(window.Seconds()),
+		"content_type": contentType,
+		"limit":        limit,
+	}).Find(&items).Error; err != nil {
+		return nil, err
+	}
+	return items, nil
+}
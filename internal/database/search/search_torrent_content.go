@@ -12,6 +12,10 @@ import (
 type TorrentContentResultItem struct {
 	query.ResultItem
 	model.TorrentContent
+	// Highlights are only selected by the TorrentContentHighlight option.
+	Highlights TorrentContentHighlights `gorm:"embedded;embeddedPrefix:highlight_"`
+	// Reports are hydrated from the reports of the torrent by users.
+	Reports model.TorrentReportCounts `gorm:"-"`
 }
 
 type TorrentContentResult = query.GenericResult[TorrentContentResultItem]
@@ -87,5 +91,7 @@ func TorrentContentDefaultHydrate() query.Option {
 	return query.Options(
 		HydrateTorrentContentTorrent(),
 		HydrateTorrentContentContent(),
+		HydrateTorrentContentFileContents(),
+		HydrateTorrentContentReports(),
 	)
 }
@@ -0,0 +1,77 @@
+package search
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/query"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"gorm.io/gen/field"
+	"gorm.io/gorm/clause"
+)
+
+type TorrentFileResultItem struct {
+	query.ResultItem
+	model.TorrentFile
+	Torrent model.Torrent `gorm:"-"`
+}
+
+type TorrentFilesResult = query.GenericResult[TorrentFileResultItem]
+
+type TorrentFileSearch interface {
+	TorrentFiles(ctx context.Context, options ...query.Option) (TorrentFilesResult, error)
+}
+
+func (s search) TorrentFiles(ctx context.Context, options ...query.Option) (TorrentFilesResult, error) {
+	return query.GenericQuery[TorrentFileResultItem](
+		ctx,
+		s.q,
+		query.Options(append([]query.Option{query.SelectAll()}, options...)...),
+		model.TableNameTorrentFile,
+		func(ctx context.Context, q *dao.Query) query.SubQuery {
+			return query.GenericSubQuery[dao.ITorrentFileDo]{
+				SubQuery: q.TorrentFile.WithContext(ctx).ReadDB(),
+			}
+		},
+	)
+}
+
+func TorrentFileDefaultOption() query.Option {
+	return query.Options(
+		query.DefaultOption(),
+		HydrateTorrentFileTorrent(),
+		query.OrderBy(
+			clause.OrderByColumn{
+				Column: clause.Column{
+					Table: clause.CurrentTable,
+					Name:  "created_at",
+				},
+				Desc: true,
+			},
+			clause.OrderByColumn{
+				Column: clause.Column{
+					Table: clause.CurrentTable,
+					Name:  "info_hash",
+				},
+			},
+			clause.OrderByColumn{
+				Column: clause.Column{
+					Table: clause.CurrentTable,
+					Name:  "index",
+				},
+			},
+		),
+	)
+}
+
+// torrentFileTorrentPreload loads the torrents of file search results without their own files,
+// which could number in the thousands for each torrent.
+func torrentFileTorrentPreload() query.Option {
+	return query.Preload(func(q *dao.Query) []field.RelationField {
+		return []field.RelationField{
+			q.Torrent.Sources.RelationField,
+			q.Torrent.Sources.TorrentSource.RelationField,
+			q.Torrent.Hint.RelationField,
+			q.Torrent.Tags.RelationField,
+		}
+	})
+}
@@ -0,0 +1,119 @@
+package search
+
+import (
+	"context"
+	"database/sql/driver"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/query"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"gorm.io/gen/field"
+)
+
+// TorrentLookupResultItem is the result of looking up an info hash.
+type TorrentLookupResultItem struct {
+	InfoHash protocol.ID
+	Known    bool
+	// Torrent is set if the torrent is known and TorrentLookupFields.Torrent was requested.
+	Torrent *model.Torrent
+	// TorrentContent is set if the torrent has been classified and TorrentLookupFields.TorrentContent was requested.
+	TorrentContent *TorrentContentResultItem
+}
+
+// TorrentLookupFields are the fields of a lookup's results to load besides whether each torrent is known, so that
+// checking many info hashes doesn't load the torrents and their files.
+type TorrentLookupFields struct {
+	Torrent bool
+	// TorrentFiles preloads the files of the torrents, if Torrent is also set.
+	TorrentFiles   bool
+	TorrentContent bool
+}
+
+type TorrentLookupSearch interface {
+	// TorrentLookup returns a result for each distinct info hash in the order given, including those that are unknown,
+	// so that it can be checked which of many torrents are known in a single round trip.
+	TorrentLookup(ctx context.Context, infoHashes []protocol.ID, fields TorrentLookupFields) ([]TorrentLookupResultItem, error)
+}
+
+func (s search) TorrentLookup(ctx context.Context, infoHashes []protocol.ID, fields TorrentLookupFields) ([]TorrentLookupResultItem, error) {
+	items := make([]TorrentLookupResultItem, 0, len(infoHashes))
+	indexes := make(map[protocol.ID]int, len(infoHashes))
+	for _, h := range infoHashes {
+		if _, ok := indexes[h]; !ok {
+			indexes[h] = len(items)
+			items = append(items, TorrentLookupResultItem{InfoHash: h})
+		}
+	}
+	if len(items) == 0 {
+		return items, nil
+	}
+	distinct := make([]driver.Valuer, 0, len(items))
+	for _, item := range items {
+		distinct = append(distinct, item.InfoHash)
+	}
+	var known []protocol.ID
+	if err := s.q.Torrent.WithContext(ctx).Where(
+		s.q.Torrent.InfoHash.In(distinct...),
+	).Pluck(s.q.Torrent.InfoHash, &known); err != nil {
+		return nil, err
+	}
+	for _, h := range known {
+		items[indexes[h]].Known = true
+	}
+	if len(known) == 0 {
+		return items, nil
+	}
+	if fields.TorrentContent {
+		// the default option isn't used, as its limit would apply to the number of info hashes
+		contentResult, err := s.TorrentContent(
+			ctx,
+			HydrateTorrentContentContent(),
+			HydrateTorrentContentFileContents(),
+			HydrateTorrentContentReports(),
+			TorrentContentCoreJoins(),
+			query.Where(TorrentInfoHashCriteria(known...)),
+		)
+		if err != nil {
+			return nil, err
+		}
+		for i := range contentResult.Items {
+			item := &items[indexes[contentResult.Items[i].InfoHash]]
+			// the most recently updated content of a torrent is returned
+			if item.TorrentContent == nil || contentResult.Items[i].UpdatedAt.After(item.TorrentContent.UpdatedAt) {
+				item.TorrentContent = &contentResult.Items[i]
+			}
+		}
+	}
+	if fields.Torrent {
+		torrentsResult, err := s.Torrents(
+			ctx,
+			query.Where(TorrentInfoHashCriteria(known...)),
+			torrentLookupPreload(fields.TorrentFiles),
+		)
+		if err != nil {
+			return nil, err
+		}
+		for i := range torrentsResult.Items {
+			item := &items[indexes[torrentsResult.Items[i].InfoHash]]
+			item.Torrent = &torrentsResult.Items[i]
+			if item.TorrentContent != nil {
+				item.TorrentContent.Torrent = torrentsResult.Items[i]
+			}
+		}
+	}
+	return items, nil
+}
+
+func torrentLookupPreload(files bool) query.Option {
+	if files {
+		return TorrentDefaultPreload()
+	}
+	return query.Preload(func(q *dao.Query) []field.RelationField {
+		return []field.RelationField{
+			q.Torrent.Sources.RelationField,
+			q.Torrent.Sources.TorrentSource.RelationField,
+			q.Torrent.Hint.RelationField,
+			q.Torrent.Tags.RelationField,
+		}
+	})
+}
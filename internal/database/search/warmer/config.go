@@ -3,13 +3,46 @@ package warmer
 import "time"
 
 type Config struct {
-	Enabled  bool
+	Enabled bool
+	// Interval is the time between warms of the query groups that don't set their own interval.
 	Interval time.Duration
+	// Queries maps names to the groups of queries that are warmed, replacing the default groups if any are configured;
+	// the names label the task runs and metrics of each group.
+	Queries map[string]QueryConfig
 }
 
+type QueryConfig struct {
+	// Kind is the kind of queries warmed: facets, for the aggregations of the torrent search facets; trending, for the
+	// default trending content query; or first_page, for the first page of torrent search results without a query string.
+	Kind string
+	// Interval overrides the default interval.
+	Interval time.Duration
+	// ContentTypes restricts the queries to these content types, where "all" is unfiltered and "null" is unknown content;
+	// by default queries are warmed for all content and for each content type.
+	ContentTypes []string `mapstructure:"content_types"`
+	// PageSize is the number of results of a first_page query, which must match the page size of the client to be of use.
+	PageSize uint `mapstructure:"page_size"`
+}
+
+const (
+	KindFacets    = "facets"
+	KindTrending  = "trending"
+	KindFirstPage = "first_page"
+)
+
 func NewDefaultConfig() Config {
 	return Config{
 		Enabled:  true,
 		Interval: 50 * time.Minute,
 	}
 }
+
+// defaultQueries are warmed if no query groups are configured.
+var defaultQueries = map[string]QueryConfig{
+	"facets": {
+		Kind: KindFacets,
+	},
+	"trending": {
+		Kind: KindTrending,
+	},
+}
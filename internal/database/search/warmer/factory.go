@@ -5,23 +5,30 @@ import (
 	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
 	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/worker"
 	"github.com/bitmagnet-io/bitmagnet/internal/database/search"
+	"github.com/bitmagnet-io/bitmagnet/internal/taskrun"
+	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
 )
 
 type DecoratorParams struct {
 	fx.In
-	Config Config
-	Search lazy.Lazy[search.Search]
-	Logger *zap.SugaredLogger
+	Config          Config
+	Search          lazy.Lazy[search.Search]
+	TaskRunRecorder lazy.Lazy[taskrun.Recorder]
+	Logger          *zap.SugaredLogger
 }
 
 type DecoratorResult struct {
 	fx.Out
-	Decorator worker.Decorator `group:"worker_decorators"`
+	Decorator     worker.Decorator     `group:"worker_decorators"`
+	WarmedTotal   prometheus.Collector `group:"prometheus_collectors"`
+	ErrorsTotal   prometheus.Collector `group:"prometheus_collectors"`
+	GroupDuration prometheus.Collector `group:"prometheus_collectors"`
 }
 
 func New(params DecoratorParams) DecoratorResult {
+	m := newMetrics()
 	var w warmer
 	return DecoratorResult{
 		Decorator: worker.Decorator{
@@ -29,15 +36,28 @@ func New(params DecoratorParams) DecoratorResult {
 			Decorate: func(hook fx.Hook) fx.Hook {
 				return fx.Hook{
 					OnStart: func(ctx context.Context) error {
+						if !params.Config.Enabled {
+							return hook.OnStart(ctx)
+						}
+						groups, err := newGroups(params.Config)
+						if err != nil {
+							return err
+						}
 						s, err := params.Search.Get()
 						if err != nil {
 							return err
 						}
+						tr, err := params.TaskRunRecorder.Get()
+						if err != nil {
+							return err
+						}
 						w = warmer{
-							stopped:  make(chan struct{}),
-							interval: params.Config.Interval,
-							search:   s,
-							logger:   params.Logger.Named("search_warmer"),
+							stopped:         make(chan struct{}),
+							groups:          groups,
+							search:          s,
+							taskRunRecorder: tr,
+							metrics:         m,
+							logger:          params.Logger.Named("search_warmer"),
 						}
 						go w.start()
 						return hook.OnStart(ctx)
@@ -51,5 +71,8 @@ func New(params DecoratorParams) DecoratorResult {
 				}
 			},
 		},
+		WarmedTotal:   m.warmedTotal,
+		ErrorsTotal:   m.errorsTotal,
+		GroupDuration: m.groupDuration,
 	}
 }
@@ -0,0 +1,235 @@
+package warmer
+
+import (
+	"context"
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/cache"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/query"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/search"
+	"github.com/bitmagnet-io/bitmagnet/internal/maps"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"sort"
+	"time"
+)
+
+// defaultPageSize is the page size of the web UI's torrent search.
+const defaultPageSize = 10
+
+// group is a named group of queries that are warmed together on a schedule.
+type group struct {
+	name     string
+	interval time.Duration
+	queries  []warmQuery
+}
+
+type warmQuery struct {
+	key  string
+	warm func(ctx context.Context, s search.Search) error
+}
+
+// contentTypeFilter is all content if all is true, or else content of the given type, where null is unknown content.
+type contentTypeFilter struct {
+	all         bool
+	contentType model.NullContentType
+}
+
+func (f contentTypeFilter) String() string {
+	if f.all {
+		return "all"
+	}
+	if !f.contentType.Valid {
+		return "null"
+	}
+	return f.contentType.ContentType.String()
+}
+
+// newGroups creates the configured query groups, ordered by name.
+func newGroups(config Config) ([]group, error) {
+	queries := config.Queries
+	if len(queries) == 0 {
+		queries = defaultQueries
+	}
+	names := make([]string, 0, len(queries))
+	for name := range queries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	groups := make([]group, 0, len(names))
+	for _, name := range names {
+		qc := queries[name]
+		contentTypes, err := parseContentTypes(qc.ContentTypes)
+		if err != nil {
+			return nil, fmt.Errorf("search warmer query group %s: %w", name, err)
+		}
+		g := group{
+			name:     name,
+			interval: qc.Interval,
+		}
+		if g.interval <= 0 {
+			g.interval = config.Interval
+		}
+		switch qc.Kind {
+		case KindFacets:
+			g.queries = facetQueries(contentTypes)
+		case KindTrending:
+			g.queries, err = trendingQueries(contentTypes)
+			if err != nil {
+				return nil, fmt.Errorf("search warmer query group %s: %w", name, err)
+			}
+		case KindFirstPage:
+			pageSize := qc.PageSize
+			if pageSize == 0 {
+				pageSize = defaultPageSize
+			}
+			g.queries = firstPageQueries(contentTypes, pageSize)
+		default:
+			return nil, fmt.Errorf("search warmer query group %s: invalid kind: %q", name, qc.Kind)
+		}
+		groups = append(groups, g)
+	}
+	return groups, nil
+}
+
+func parseContentTypes(strs []string) ([]contentTypeFilter, error) {
+	if len(strs) == 0 {
+		filters := []contentTypeFilter{{all: true}}
+		for _, ct := range model.ContentTypeValues() {
+			filters = append(filters, contentTypeFilter{contentType: model.NewNullContentType(ct)})
+		}
+		return filters, nil
+	}
+	filters := make([]contentTypeFilter, 0, len(strs))
+	for _, str := range strs {
+		switch str {
+		case "all":
+			filters = append(filters, contentTypeFilter{all: true})
+		case "null":
+			filters = append(filters, contentTypeFilter{})
+		default:
+			ct, err := model.ParseContentType(str)
+			if err != nil {
+				return nil, err
+			}
+			filters = append(filters, contentTypeFilter{contentType: model.NewNullContentType(ct)})
+		}
+	}
+	return filters, nil
+}
+
+// torrentContentQuery warms a torrent content search with the given options.
+func torrentContentQuery(key string, options ...query.Option) warmQuery {
+	return warmQuery{
+		key: key,
+		warm: func(ctx context.Context, s search.Search) error {
+			_, err := s.TorrentContent(ctx, append(options, query.CacheWarm())...)
+			return err
+		},
+	}
+}
+
+func contentTypeFilterFacet(f contentTypeFilter) query.Option {
+	return query.WithFacet(search.TorrentContentTypeFacet(query.FacetHasFilter(query.FacetFilter{
+		f.String(): struct{}{},
+	})))
+}
+
+// facetQueries warms the aggregations of the top-level facets, as well as the facets aggregated by the web UI,
+// which are counted together in a single grouping sets query.
+func facetQueries(contentTypes []contentTypeFilter) []warmQuery {
+	facets := maps.NewInsertMap[string, func(options ...query.FacetOption) query.Facet]()
+	facets.Set(search.TorrentContentTypeFacetKey, search.TorrentContentTypeFacet)
+	facets.Set(search.ContentGenreFacetKey, search.TorrentContentGenreFacet)
+	facets.Set(search.LanguageFacetKey, search.TorrentContentLanguageFacet)
+	facets.Set(search.HdrFormatFacetKey, search.HdrFormatFacet)
+	facets.Set(search.AudioFormatFacetKey, search.AudioFormatFacet)
+	facets.Set(search.ReleaseTokenFacetKey, search.TorrentContentReleaseTokenFacet)
+	facets.Set(search.Video3dFacetKey, search.Video3dFacet)
+	facets.Set(search.VideoCodecFacetKey, search.VideoCodecFacet)
+	facets.Set(search.VideoModifierFacetKey, search.VideoModifierFacet)
+	facets.Set(search.VideoResolutionFacetKey, search.VideoResolutionFacet)
+	facets.Set(search.VideoSourceFacetKey, search.VideoSourceFacet)
+	facets.Set(search.TorrentFileTypeFacetKey, search.TorrentFileTypeFacet)
+	facets.Set(search.TorrentSourceFacetKey, search.TorrentSourceFacet)
+	facets.Set(search.TorrentTagFacetKey, search.TorrentTagsFacet)
+	groupedFacets := func() []query.Facet {
+		return []query.Facet{
+			search.TorrentContentLanguageFacet(query.FacetIsAggregated()),
+			search.VideoResolutionFacet(query.FacetIsAggregated()),
+			search.VideoSourceFacet(query.FacetIsAggregated()),
+		}
+	}
+	aggregation := func(key string, options ...query.Option) warmQuery {
+		return torrentContentQuery(key, append([]query.Option{
+			query.Limit(0),
+			search.TorrentContentCoreJoins(),
+		}, options...)...)
+	}
+	var queries []warmQuery
+	for _, ct := range contentTypes {
+		if ct.all {
+			for _, f := range facets.Entries() {
+				queries = append(queries, aggregation("aggs:"+f.Key, query.WithFacet(
+					f.Value(query.FacetIsAggregated()),
+				)))
+			}
+			queries = append(queries, aggregation("aggs:grouped", query.WithFacet(
+				append([]query.Facet{search.TorrentContentTypeFacet(query.FacetIsAggregated())}, groupedFacets()...)...,
+			)))
+			continue
+		}
+		// the content type facet is filtered rather than aggregated
+		for _, f := range facets.Entries()[1:] {
+			queries = append(queries, aggregation(
+				"aggs:"+ct.String()+"/"+f.Key,
+				contentTypeFilterFacet(ct),
+				query.WithFacet(f.Value(query.FacetIsAggregated())),
+			))
+		}
+		queries = append(queries, aggregation(
+			"aggs:"+ct.String()+"/grouped",
+			contentTypeFilterFacet(ct),
+			query.WithFacet(groupedFacets()...),
+		))
+	}
+	return queries
+}
+
+// trendingQueries warms the default trending content query; unknown content can't trend, as it has no content item.
+func trendingQueries(contentTypes []contentTypeFilter) ([]warmQuery, error) {
+	queries := make([]warmQuery, 0, len(contentTypes))
+	for _, ct := range contentTypes {
+		if !ct.all && !ct.contentType.Valid {
+			return nil, fmt.Errorf("unknown content can't be trending")
+		}
+		q := search.TrendingQuery{
+			ContentType: ct.contentType,
+			CacheMode:   cache.ModeWarm,
+		}
+		queries = append(queries, warmQuery{
+			key: "trending:" + ct.String(),
+			warm: func(ctx context.Context, s search.Search) error {
+				_, err := s.ContentTrending(ctx, q)
+				return err
+			},
+		})
+	}
+	return queries, nil
+}
+
+// firstPageQueries warms the first page of torrent search results without a query string, as requested by the web UI.
+func firstPageQueries(contentTypes []contentTypeFilter, pageSize uint) []warmQuery {
+	queries := make([]warmQuery, 0, len(contentTypes))
+	for _, ct := range contentTypes {
+		options := []query.Option{
+			search.TorrentContentDefaultOption(),
+			query.Limit(pageSize),
+			query.Offset(0),
+			query.WithHasNextPage(true),
+		}
+		if !ct.all {
+			options = append(options, contentTypeFilterFacet(ct))
+		}
+		queries = append(queries, torrentContentQuery("first_page:"+ct.String(), options...))
+	}
+	return queries
+}
@@ -0,0 +1,55 @@
+package warmer
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestNewGroups__defaults(t *testing.T) {
+	groups, err := newGroups(NewDefaultConfig())
+	require.NoError(t, err)
+	require.Len(t, groups, 2)
+	assert.Equal(t, "facets", groups[0].name)
+	assert.Equal(t, 50*time.Minute, groups[0].interval)
+	assert.Equal(t, "aggs:content_type", groups[0].queries[0].key)
+	assert.Equal(t, "trending", groups[1].name)
+	assert.Len(t, groups[1].queries, len(model.ContentTypeValues())+1)
+}
+
+func TestNewGroups__configured(t *testing.T) {
+	config := NewDefaultConfig()
+	config.Queries = map[string]QueryConfig{
+		"home": {
+			Kind:         KindFirstPage,
+			Interval:     10 * time.Minute,
+			ContentTypes: []string{"all", "movie", "null"},
+		},
+	}
+	groups, err := newGroups(config)
+	require.NoError(t, err)
+	require.Len(t, groups, 1)
+	assert.Equal(t, 10*time.Minute, groups[0].interval)
+	keys := make([]string, 0, len(groups[0].queries))
+	for _, q := range groups[0].queries {
+		keys = append(keys, q.key)
+	}
+	assert.Equal(t, []string{"first_page:all", "first_page:movie", "first_page:null"}, keys)
+}
+
+func TestNewGroups__invalid(t *testing.T) {
+	for name, qc := range map[string]QueryConfig{
+		"kind":                {Kind: "homepage"},
+		"content type":        {Kind: KindFacets, ContentTypes: []string{"films"}},
+		"trending of unknown": {Kind: KindTrending, ContentTypes: []string{"null"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			config := NewDefaultConfig()
+			config.Queries = map[string]QueryConfig{"invalid": qc}
+			_, err := newGroups(config)
+			assert.Error(t, err)
+		})
+	}
+}
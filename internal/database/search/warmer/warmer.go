@@ -2,94 +2,103 @@ package warmer
 
 import (
 	"context"
-	"github.com/bitmagnet-io/bitmagnet/internal/database/query"
+	"errors"
 	"github.com/bitmagnet-io/bitmagnet/internal/database/search"
-	"github.com/bitmagnet-io/bitmagnet/internal/maps"
-	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/taskrun"
+	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
+	"sync"
 	"time"
 )
 
 type warmer struct {
-	stopped  chan struct{}
-	interval time.Duration
-	search   search.Search
-	logger   *zap.SugaredLogger
+	stopped         chan struct{}
+	groups          []group
+	search          search.Search
+	taskRunRecorder taskrun.Recorder
+	metrics         metrics
+	logger          *zap.SugaredLogger
+}
+
+type metrics struct {
+	warmedTotal   *prometheus.CounterVec
+	errorsTotal   *prometheus.CounterVec
+	groupDuration *prometheus.HistogramVec
+}
+
+func newMetrics() metrics {
+	return metrics{
+		warmedTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "bitmagnet",
+			Subsystem: "search_warmer",
+			Name:      "warmed_total",
+			Help:      "A counter of warmed queries.",
+		}, []string{"group"}),
+		errorsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "bitmagnet",
+			Subsystem: "search_warmer",
+			Name:      "errors_total",
+			Help:      "A counter of queries that failed to be warmed.",
+		}, []string{"group"}),
+		groupDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "bitmagnet",
+			Subsystem: "search_warmer",
+			Name:      "group_duration_seconds",
+			Help:      "A histogram of the time taken to warm each query group.",
+			Buckets:   []float64{1, 5, 15, 30, 60, 120, 300, 600, 1200},
+		}, []string{"group"}),
+	}
 }
 
 func (w warmer) start() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
-	ticker := time.NewTicker(w.interval)
-	go func() {
-		for {
-			warmed := make(chan struct{})
-			go func() {
-				w.warm(ctx)
-				close(warmed)
-			}()
-			// wait for warming to complete
-			select {
-			case <-ctx.Done():
-				return
-			case <-warmed:
-			}
-			// then wait for the next tick
-			select {
-			case <-ctx.Done():
-				return
-			case <-ticker.C:
-			}
-		}
-	}()
+	wg := &sync.WaitGroup{}
+	for _, g := range w.groups {
+		wg.Add(1)
+		go func(g group) {
+			defer wg.Done()
+			w.run(ctx, g)
+		}(g)
+	}
 	<-w.stopped
+	cancel()
+	wg.Wait()
 }
 
-func (w warmer) warm(ctx context.Context) {
-	for _, e := range warmers.Entries() {
-		w.logger.Debugw("warming", "warmer", e.Key)
-		if _, err := w.search.TorrentContent(
-			ctx,
-			query.Limit(0),
-			search.TorrentContentCoreJoins(),
-			e.Value,
-			query.CacheWarm(),
-		); err != nil {
-			w.logger.Errorw("error warming", "warmer", e.Key, "error", err)
+// run warms a group immediately, and then at its interval after each warm completes.
+func (w warmer) run(ctx context.Context, g group) {
+	ticker := time.NewTicker(g.interval)
+	defer ticker.Stop()
+	for {
+		w.warm(ctx, g)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
 		}
 	}
 }
 
-var warmers = maps.NewInsertMap[string, query.Option]()
-
-func init() {
-
-	facets := maps.NewInsertMap[string, func(options ...query.FacetOption) query.Facet]()
-	facets.Set(search.TorrentContentTypeFacetKey, search.TorrentContentTypeFacet)
-	facets.Set(search.ContentGenreFacetKey, search.TorrentContentGenreFacet)
-	facets.Set(search.LanguageFacetKey, search.TorrentContentLanguageFacet)
-	facets.Set(search.Video3dFacetKey, search.Video3dFacet)
-	facets.Set(search.VideoCodecFacetKey, search.VideoCodecFacet)
-	facets.Set(search.VideoModifierFacetKey, search.VideoModifierFacet)
-	facets.Set(search.VideoResolutionFacetKey, search.VideoResolutionFacet)
-	facets.Set(search.VideoSourceFacetKey, search.VideoSourceFacet)
-	facets.Set(search.TorrentFileTypeFacetKey, search.TorrentFileTypeFacet)
-	facets.Set(search.TorrentSourceFacetKey, search.TorrentSourceFacet)
-	facets.Set(search.TorrentTagFacetKey, search.TorrentTagsFacet)
-
-	// All the top-level facets should be warmed:
-	for _, f := range facets.Entries() {
-		warmers.Set("aggs:"+f.Key, query.WithFacet(
-			f.Value(query.FacetIsAggregated()),
-		))
-	}
-	// All the top-level facets within each content type should be warmed:
-	for _, ct := range model.ContentTypeValues() {
-		for _, f := range facets.Entries()[1:] {
-			warmers.Set("aggs:"+ct.String()+"/"+f.Key, query.Options(query.WithFacet(
-				search.TorrentContentTypeFacet(query.FacetHasFilter(query.FacetFilter{
-					ct.String(): struct{}{},
-				}))), query.WithFacet(f.Value(query.FacetIsAggregated()))))
+func (w warmer) warm(ctx context.Context, g group) {
+	start := time.Now()
+	run := w.taskRunRecorder.StartTarget(ctx, taskrun.KindWarm, g.name)
+	run.SetTotal(int64(len(g.queries)))
+	var errs []error
+	for _, q := range g.queries {
+		if ctx.Err() != nil {
+			break
+		}
+		w.logger.Debugw("warming", "group", g.name, "query", q.key)
+		if err := q.warm(ctx, w.search); err != nil {
+			w.logger.Errorw("error warming", "group", g.name, "query", q.key, "error", err)
+			w.metrics.errorsTotal.With(prometheus.Labels{"group": g.name}).Inc()
+			errs = append(errs, err)
+		} else {
+			w.metrics.warmedTotal.With(prometheus.Labels{"group": g.name}).Inc()
+			run.Add(1)
 		}
 	}
+	w.metrics.groupDuration.With(prometheus.Labels{"group": g.name}).Observe(time.Since(start).Seconds())
+	run.Finish(errors.Join(errs...))
 }
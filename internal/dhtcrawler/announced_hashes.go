@@ -0,0 +1,66 @@
+package dhtcrawler
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/concurrency"
+	"github.com/bitmagnet-io/bitmagnet/internal/dhtcrawler/firehose"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol/dht/responder"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/fx"
+	"time"
+)
+
+type AnnouncedHashesParams struct {
+	fx.In
+	Config Config
+}
+
+type AnnouncedHashesResult struct {
+	fx.Out
+	AnnouncedHashes concurrency.BatchingChannel[responder.AnnouncedHash] `name:"dht_announced_hashes"`
+}
+
+// NewAnnouncedHashes creates the channel for info hashes announced to the DHT server.
+// It is provided as a separate service to avoid a circular dependency with the DHT server.
+func NewAnnouncedHashes(params AnnouncedHashesParams) AnnouncedHashesResult {
+	return AnnouncedHashesResult{
+		AnnouncedHashes: concurrency.NewBatchingChannel[responder.AnnouncedHash](int(10*params.Config.ScalingFactor), 100, time.Second),
+	}
+}
+
+// runAnnouncedHashes forwards info hashes announced to the DHT server to the infoHashTriage channel.
+// The announcing node is a peer of the torrent, so it is asked for the peers of the hash.
+func (c *crawler) runAnnouncedHashes(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case as := <-c.announcedHashes.Out():
+			discoveredHashes := make([]nodeHasPeersForHash, 0, len(as))
+			for _, a := range as {
+				if c.firehose.Enabled() {
+					c.firehose.Publish(firehose.NewDiscoveredEvent(a.InfoHash, a.Node))
+				}
+				if !c.ignoreHashes.testAndAdd(a.InfoHash) {
+					discoveredHashes = append(discoveredHashes, nodeHasPeersForHash{
+						infoHash:        a.InfoHash,
+						node:            a.Node,
+						discoveryMethod: model.NewNullDiscoveryMethod(model.DiscoveryMethodDhtAnnounce),
+					})
+				}
+			}
+			c.discoveredHashesTotal.With(prometheus.Labels{
+				"method": model.DiscoveryMethodDhtAnnounce.String(),
+			}).Add(float64(len(discoveredHashes)))
+			for _, h := range discoveredHashes {
+				select {
+				case <-ctx.Done():
+					return
+				case c.infoHashTriage.In() <- h:
+					continue
+				}
+			}
+		}
+	}
+}
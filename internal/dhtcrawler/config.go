@@ -16,10 +16,21 @@ type Config struct {
 	// Some torrents contain thousands of files which can severely impact performance and uses a lot of disk space.
 	SaveFilesThreshold uint
 	// SavePieces when true, torrent pieces will be persisted to the database.
-	// The pieces take up quite a lot of space, and aren't currently very useful, but they may be used by future features.
+	// The pieces take up quite a lot of space, but are needed to export torrent files.
 	SavePieces bool
 	// RescrapeThreshold is the amount of time that must pass before a torrent is rescraped to count seeders and leechers.
 	RescrapeThreshold time.Duration
+	// HealthHalfLife is the time after which a previous swarm size estimate counts for half of a torrent's health.
+	// Torrents that are no longer seen in the DHT will see their health decay towards zero.
+	HealthHalfLife time.Duration
+	// MetainfoRetryInitialDelay is the delay before retrying to fetch the meta info of a torrent for which it is unknown
+	// (for example a torrent that was imported without files); the delay doubles on each failed attempt.
+	MetainfoRetryInitialDelay time.Duration
+	// MetainfoRetryMaxDelay caps the delay between meta info fetch attempts.
+	MetainfoRetryMaxDelay time.Duration
+	// MetainfoRetryMaxAttempts is the number of meta info fetch attempts after which a torrent is no longer retried,
+	// unless a retry is requested manually.
+	MetainfoRetryMaxAttempts uint
 }
 
 func NewDefaultConfig() Config {
@@ -30,5 +41,9 @@ func NewDefaultConfig() Config {
 		SaveFilesThreshold:           50,
 		SavePieces:                   false,
 		RescrapeThreshold:            time.Hour * 24 * 30,
+		HealthHalfLife:               time.Hour * 24 * 30,
+		MetainfoRetryInitialDelay:    time.Hour,
+		MetainfoRetryMaxDelay:        time.Hour * 24 * 7,
+		MetainfoRetryMaxAttempts:     10,
 	}
 }
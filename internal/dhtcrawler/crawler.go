@@ -6,10 +6,14 @@ import (
 	"github.com/bitmagnet-io/bitmagnet/internal/bloom"
 	"github.com/bitmagnet-io/bitmagnet/internal/concurrency"
 	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/dhtcrawler/firehose"
+	"github.com/bitmagnet-io/bitmagnet/internal/events"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
 	"github.com/bitmagnet-io/bitmagnet/internal/processor"
 	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
 	"github.com/bitmagnet-io/bitmagnet/internal/protocol/dht/client"
 	"github.com/bitmagnet-io/bitmagnet/internal/protocol/dht/ktable"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol/dht/responder"
 	"github.com/bitmagnet-io/bitmagnet/internal/protocol/metainfo"
 	"github.com/bitmagnet-io/bitmagnet/internal/protocol/metainfo/banning"
 	"github.com/bitmagnet-io/bitmagnet/internal/protocol/metainfo/metainforequester"
@@ -32,6 +36,7 @@ type crawler struct {
 	getOldestNodesInterval       time.Duration
 	oldPeerThreshold             time.Duration
 	discoveredNodes              concurrency.BatchingChannel[ktable.Node]
+	announcedHashes              concurrency.BatchingChannel[responder.AnnouncedHash]
 	nodesForPing                 concurrency.BufferedConcurrentChannel[ktable.Node]
 	nodesForFindNode             concurrency.BufferedConcurrentChannel[ktable.Node]
 	nodesForSampleInfoHashes     concurrency.BufferedConcurrentChannel[ktable.Node]
@@ -42,6 +47,10 @@ type crawler struct {
 	persistTorrents              concurrency.BatchingChannel[infoHashWithMetaInfo]
 	persistSources               concurrency.BatchingChannel[infoHashWithScrape]
 	rescrapeThreshold            time.Duration
+	healthHalfLife               time.Duration
+	getStaleSourcesInterval      time.Duration
+	metainfoRetryPolicy          MetainfoRetryPolicy
+	getMetainfoRetriesInterval   time.Duration
 	saveFilesThreshold           uint
 	savePieces                   bool
 	dao                          *dao.Query
@@ -51,12 +60,15 @@ type crawler struct {
 	// has already been indexed.
 	ignoreHashes    *ignoreHashes
 	blockingManager blocking.Manager
+	firehose        firehose.Firehose
+	eventBus        events.Bus
 	// soughtNodeID is a random node ID used as the target for find_node and sample_infohashes requests.
 	// It is rotated every 10 seconds.
-	soughtNodeID   *concurrency.AtomicValue[protocol.ID]
-	stopped        chan struct{}
-	persistedTotal *prometheus.CounterVec
-	logger         *zap.SugaredLogger
+	soughtNodeID          *concurrency.AtomicValue[protocol.ID]
+	stopped               chan struct{}
+	persistedTotal        *prometheus.CounterVec
+	discoveredHashesTotal *prometheus.CounterVec
+	logger                *zap.SugaredLogger
 }
 
 func (c *crawler) start() {
@@ -69,6 +81,7 @@ func (c *crawler) start() {
 	go c.runFindNode(ctx)
 	go c.getNodesForFindNode(ctx)
 	go c.runSampleInfoHashes(ctx)
+	go c.runAnnouncedHashes(ctx)
 	go c.getNodesForSampleInfoHashes(ctx)
 	go c.runInfoHashTriage(ctx)
 	go c.runGetPeers(ctx)
@@ -77,6 +90,8 @@ func (c *crawler) start() {
 	go c.reseedBootstrapNodes(ctx)
 	go c.runPersistTorrents(ctx)
 	go c.runPersistSources(ctx)
+	go c.getStaleSourcesForScrape(ctx)
+	go c.getNoInfoTorrentsForRetry(ctx)
 	go c.getOldNodes(ctx)
 	<-c.stopped
 }
@@ -84,6 +99,8 @@ func (c *crawler) start() {
 type nodeHasPeersForHash struct {
 	infoHash protocol.ID
 	node     netip.AddrPort
+	// discoveryMethod records how the hash was discovered; it's null for hashes that are recrawled from the database.
+	discoveryMethod model.NullDiscoveryMethod
 }
 
 type infoHashWithMetaInfo struct {
@@ -4,6 +4,7 @@ import (
 	adht "github.com/anacrolix/dht/v2"
 	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/config/configfx"
 	"github.com/bitmagnet-io/bitmagnet/internal/dhtcrawler"
+	"github.com/bitmagnet-io/bitmagnet/internal/dhtcrawler/firehose"
 	"go.uber.org/fx"
 	"net"
 	"net/netip"
@@ -13,6 +14,7 @@ func New() fx.Option {
 	return fx.Module(
 		"dht_crawler",
 		configfx.NewConfigModule[dhtcrawler.Config]("dht_crawler", dhtcrawler.NewDefaultConfig()),
+		configfx.NewConfigModule[firehose.Config]("dht_firehose", firehose.NewDefaultConfig()),
 		fx.Provide(
 			fx.Annotated{
 				Name: "dht_bootstrap_nodes",
@@ -30,6 +32,8 @@ func New() fx.Option {
 			},
 			dhtcrawler.New,
 			dhtcrawler.NewDiscoveredNodes,
+			dhtcrawler.NewAnnouncedHashes,
+			firehose.New,
 		),
 	)
 }
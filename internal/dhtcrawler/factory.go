@@ -2,16 +2,21 @@ package dhtcrawler
 
 import (
 	"context"
+	"errors"
 	"github.com/bitmagnet-io/bitmagnet/internal/blocking"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/healthcheck"
 	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
 	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/worker"
 	"github.com/bitmagnet-io/bitmagnet/internal/concurrency"
 	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
 	"github.com/bitmagnet-io/bitmagnet/internal/database/search"
+	"github.com/bitmagnet-io/bitmagnet/internal/dhtcrawler/firehose"
+	"github.com/bitmagnet-io/bitmagnet/internal/events"
 	"github.com/bitmagnet-io/bitmagnet/internal/processor"
 	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
 	"github.com/bitmagnet-io/bitmagnet/internal/protocol/dht/client"
 	"github.com/bitmagnet-io/bitmagnet/internal/protocol/dht/ktable"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol/dht/responder"
 	"github.com/bitmagnet-io/bitmagnet/internal/protocol/metainfo/banning"
 	"github.com/bitmagnet-io/bitmagnet/internal/protocol/metainfo/metainforequester"
 	"github.com/bitmagnet-io/bitmagnet/internal/queue/publisher"
@@ -19,6 +24,7 @@ import (
 	boom "github.com/tylertreat/BoomFilters"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
+	"sync/atomic"
 	"time"
 )
 
@@ -32,25 +38,40 @@ type Params struct {
 	Search             lazy.Lazy[search.Search]
 	Dao                lazy.Lazy[*dao.Query]
 	BlockingManager    lazy.Lazy[blocking.Manager]
+	Firehose           firehose.Firehose
+	EventBus           lazy.Lazy[events.Bus]
 	ProcessorPublisher lazy.Lazy[publisher.Publisher[processor.MessageParams]]
-	DiscoveredNodes    concurrency.BatchingChannel[ktable.Node] `name:"dht_discovered_nodes"`
+	DiscoveredNodes    concurrency.BatchingChannel[ktable.Node]             `name:"dht_discovered_nodes"`
+	AnnouncedHashes    concurrency.BatchingChannel[responder.AnnouncedHash] `name:"dht_announced_hashes"`
 	Logger             *zap.SugaredLogger
 }
 
 type Result struct {
 	fx.Out
-	Worker         worker.Worker        `group:"workers"`
-	PersistedTotal prometheus.Collector `group:"prometheus_collectors"`
+	Worker                worker.Worker        `group:"workers"`
+	PersistedTotal        prometheus.Collector `group:"prometheus_collectors"`
+	DiscoveredHashesTotal prometheus.Collector `group:"prometheus_collectors"`
+	HealthCheck           healthcheck.Check    `group:"healthchecks"`
 }
 
+// healthCheckGracePeriod is the time the crawler has after starting to populate its routing table before it's unhealthy.
+const healthCheckGracePeriod = time.Minute
+
 func New(params Params) Result {
 	var c crawler
+	var startedAt atomic.Pointer[time.Time]
 	persistedTotal := prometheus.NewCounterVec(prometheus.CounterOpts{
 		Namespace: "bitmagnet",
 		Subsystem: "dht_crawler",
 		Name:      "persisted_total",
 		Help:      "A counter of persisted database entities.",
 	}, []string{"entity"})
+	discoveredHashesTotal := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "bitmagnet",
+		Subsystem: "dht_crawler",
+		Name:      "discovered_hashes_total",
+		Help:      "A counter of newly discovered info hashes by discovery method.",
+	}, []string{"method"})
 	return Result{
 		Worker: worker.NewWorker(
 			"dht_crawler",
@@ -73,6 +94,10 @@ func New(params Params) Result {
 					if err != nil {
 						return err
 					}
+					eventBus, err := params.EventBus.Get()
+					if err != nil {
+						return err
+					}
 					c = crawler{
 						kTable:                       params.KTable,
 						client:                       cl,
@@ -83,6 +108,7 @@ func New(params Params) Result {
 						getOldestNodesInterval:       time.Second * 10,
 						oldPeerThreshold:             time.Minute * 15,
 						discoveredNodes:              params.DiscoveredNodes,
+						announcedHashes:              params.AnnouncedHashes,
 						nodesForPing:                 concurrency.NewBufferedConcurrentChannel[ktable.Node](scalingFactor, scalingFactor),
 						nodesForFindNode:             concurrency.NewBufferedConcurrentChannel[ktable.Node](10*scalingFactor, 10*scalingFactor),
 						nodesForSampleInfoHashes:     concurrency.NewBufferedConcurrentChannel[ktable.Node](10*scalingFactor, 10*scalingFactor),
@@ -100,25 +126,39 @@ func New(params Params) Result {
 							1000,
 							time.Minute,
 						),
-						saveFilesThreshold: params.Config.SaveFilesThreshold,
-						savePieces:         params.Config.SavePieces,
-						rescrapeThreshold:  params.Config.RescrapeThreshold,
-						dao:                query,
-						processorPublisher: classifierPublisher,
+						saveFilesThreshold:      params.Config.SaveFilesThreshold,
+						savePieces:              params.Config.SavePieces,
+						rescrapeThreshold:       params.Config.RescrapeThreshold,
+						healthHalfLife:          params.Config.HealthHalfLife,
+						getStaleSourcesInterval: time.Second * 10,
+						metainfoRetryPolicy: MetainfoRetryPolicy{
+							InitialDelay: params.Config.MetainfoRetryInitialDelay,
+							MaxDelay:     params.Config.MetainfoRetryMaxDelay,
+							MaxAttempts:  params.Config.MetainfoRetryMaxAttempts,
+						},
+						getMetainfoRetriesInterval: time.Second * 10,
+						dao:                        query,
+						processorPublisher:         classifierPublisher,
 						ignoreHashes: &ignoreHashes{
 							bloom: boom.NewStableBloomFilter(10_000_000, 2, 0.001),
 						},
-						blockingManager: blockingManager,
-						soughtNodeID:    &concurrency.AtomicValue[protocol.ID]{},
-						stopped:         make(chan struct{}),
-						persistedTotal:  persistedTotal,
-						logger:          params.Logger.Named("dht_crawler"),
+						blockingManager:       blockingManager,
+						firehose:              params.Firehose,
+						eventBus:              eventBus,
+						soughtNodeID:          &concurrency.AtomicValue[protocol.ID]{},
+						stopped:               make(chan struct{}),
+						persistedTotal:        persistedTotal,
+						discoveredHashesTotal: discoveredHashesTotal,
+						logger:                params.Logger.Named("dht_crawler"),
 					}
 					c.soughtNodeID.Set(protocol.RandomNodeID())
 					go c.start()
+					now := time.Now()
+					startedAt.Store(&now)
 					return nil
 				},
 				OnStop: func(context.Context) error {
+					startedAt.Store(nil)
 					if c.stopped != nil {
 						close(c.stopped)
 					}
@@ -126,6 +166,21 @@ func New(params Params) Result {
 				},
 			},
 		),
-		PersistedTotal: persistedTotal,
+		PersistedTotal:        persistedTotal,
+		DiscoveredHashesTotal: discoveredHashesTotal,
+		HealthCheck: healthcheck.Check{
+			Name: "dht",
+			Check: func(context.Context) error {
+				started := startedAt.Load()
+				if started == nil {
+					return healthcheck.ErrInactive
+				}
+				if time.Since(*started) > healthCheckGracePeriod &&
+					len(params.KTable.GetClosestNodes(params.KTable.Origin())) == 0 {
+					return errors.New("the routing table has no nodes")
+				}
+				return nil
+			},
+		},
 	}
 }
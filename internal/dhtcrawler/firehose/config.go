@@ -0,0 +1,16 @@
+package firehose
+
+type Config struct {
+	// Addresses are the addresses on which the firehose is served, in the form tcp://host:port or unix:///path/to/socket.
+	// The firehose is disabled if no addresses are configured.
+	Addresses []string
+	// BufferSize is the number of events buffered for each connected client;
+	// events are dropped for clients that fall further behind, so that a slow client never slows the crawler.
+	BufferSize uint
+}
+
+func NewDefaultConfig() Config {
+	return Config{
+		BufferSize: 10_000,
+	}
+}
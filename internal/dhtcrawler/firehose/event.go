@@ -0,0 +1,64 @@
+package firehose
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol/metainfo"
+	"net/netip"
+	"time"
+)
+
+type EventType string
+
+const (
+	// EventTypeDiscovered is sent for every info hash sampled from a DHT node, including those already crawled.
+	EventTypeDiscovered EventType = "discovered"
+	// EventTypeMetaInfo is sent when the meta info of a torrent is fetched from a peer.
+	EventTypeMetaInfo EventType = "metainfo"
+)
+
+// Event is written to clients as a line of JSON.
+type Event struct {
+	Type     EventType `json:"type"`
+	Time     time.Time `json:"time"`
+	InfoHash string    `json:"infoHash"`
+	// Node is the address of the DHT node that the info hash was sampled from.
+	Node    string `json:"node,omitempty"`
+	Name    string `json:"name,omitempty"`
+	Size    uint64 `json:"size,omitempty"`
+	Private bool   `json:"private,omitempty"`
+	Files   []File `json:"files,omitempty"`
+}
+
+type File struct {
+	Path string `json:"path"`
+	Size uint64 `json:"size"`
+}
+
+func NewDiscoveredEvent(infoHash protocol.ID, node netip.AddrPort) Event {
+	return Event{
+		Type:     EventTypeDiscovered,
+		Time:     time.Now(),
+		InfoHash: infoHash.String(),
+		Node:     node.String(),
+	}
+}
+
+func NewMetaInfoEvent(infoHash protocol.ID, node netip.AddrPort, info metainfo.Info) Event {
+	files := make([]File, 0, len(info.Files))
+	for _, f := range info.Files {
+		files = append(files, File{
+			Path: f.DisplayPath(&info),
+			Size: uint64(f.Length),
+		})
+	}
+	return Event{
+		Type:     EventTypeMetaInfo,
+		Time:     time.Now(),
+		InfoHash: infoHash.String(),
+		Node:     node.String(),
+		Name:     info.BestName(),
+		Size:     uint64(info.TotalLength()),
+		Private:  info.Private != nil && *info.Private,
+		Files:    files,
+	}
+}
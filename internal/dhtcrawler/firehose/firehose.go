@@ -0,0 +1,199 @@
+package firehose
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/worker"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"net"
+	"net/url"
+	"os"
+	"sync"
+	"sync/atomic"
+)
+
+// Firehose streams the events of the DHT crawler as newline-delimited JSON to any connected clients,
+// independently of what is persisted to the database.
+type Firehose interface {
+	// Publish sends an event to every connected client without blocking.
+	Publish(e Event)
+	// Enabled returns true if any clients are connected, so that events need only be created when they'll be sent.
+	Enabled() bool
+}
+
+type Params struct {
+	fx.In
+	Config Config
+	Logger *zap.SugaredLogger
+}
+
+type Result struct {
+	fx.Out
+	Firehose     Firehose
+	Decorator    worker.Decorator     `group:"worker_decorators"`
+	DroppedTotal prometheus.Collector `group:"prometheus_collectors"`
+}
+
+// New serves the firehose while the DHT crawler is running.
+func New(p Params) Result {
+	droppedTotal := prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "bitmagnet",
+		Subsystem: "dht_firehose",
+		Name:      "dropped_total",
+		Help:      "A counter of events dropped for clients that fell behind.",
+	})
+	f := &firehose{
+		bufferSize:   int(p.Config.BufferSize),
+		clients:      make(map[*client]struct{}),
+		droppedTotal: droppedTotal,
+		logger:       p.Logger.Named("dht_firehose"),
+	}
+	return Result{
+		Firehose: f,
+		Decorator: worker.Decorator{
+			Key: "dht_crawler",
+			Decorate: func(hook fx.Hook) fx.Hook {
+				return fx.Hook{
+					OnStart: func(ctx context.Context) error {
+						if err := f.listen(p.Config.Addresses); err != nil {
+							return err
+						}
+						return hook.OnStart(ctx)
+					},
+					OnStop: func(ctx context.Context) error {
+						f.close()
+						return hook.OnStop(ctx)
+					},
+				}
+			},
+		},
+		DroppedTotal: droppedTotal,
+	}
+}
+
+type firehose struct {
+	bufferSize   int
+	mutex        sync.RWMutex
+	listeners    []net.Listener
+	clients      map[*client]struct{}
+	clientCount  atomic.Int32
+	droppedTotal prometheus.Counter
+	logger       *zap.SugaredLogger
+}
+
+type client struct {
+	conn   net.Conn
+	events chan Event
+}
+
+func (f *firehose) Enabled() bool {
+	return f.clientCount.Load() > 0
+}
+
+func (f *firehose) Publish(e Event) {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	for c := range f.clients {
+		select {
+		case c.events <- e:
+		default:
+			f.droppedTotal.Inc()
+		}
+	}
+}
+
+func (f *firehose) listen(addresses []string) error {
+	for _, addr := range addresses {
+		l, err := listen(addr)
+		if err != nil {
+			f.close()
+			return err
+		}
+		f.logger.Infow("serving firehose", "address", addr)
+		f.listeners = append(f.listeners, l)
+		go f.accept(l)
+	}
+	return nil
+}
+
+func listen(addr string) (net.Listener, error) {
+	u, err := url.Parse(addr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid firehose address %q: %w", addr, err)
+	}
+	switch u.Scheme {
+	case "tcp":
+		return net.Listen("tcp", u.Host)
+	case "unix":
+		// remove a socket left behind by a previous run
+		if err := os.Remove(u.Path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, err
+		}
+		return net.Listen("unix", u.Path)
+	default:
+		return nil, fmt.Errorf("invalid firehose address %q: scheme must be tcp or unix", addr)
+	}
+}
+
+func (f *firehose) accept(l net.Listener) {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				f.logger.Errorw("failed to accept firehose connection", "error", err)
+			}
+			return
+		}
+		c := &client{
+			conn:   conn,
+			events: make(chan Event, f.bufferSize),
+		}
+		f.mutex.Lock()
+		f.clients[c] = struct{}{}
+		f.clientCount.Store(int32(len(f.clients)))
+		f.mutex.Unlock()
+		f.logger.Debugw("firehose client connected", "remote", conn.RemoteAddr().String())
+		go f.write(c)
+	}
+}
+
+// write sends events to the client until the connection fails or the firehose is closed.
+func (f *firehose) write(c *client) {
+	defer f.disconnect(c)
+	encoder := json.NewEncoder(c.conn)
+	for e := range c.events {
+		if err := encoder.Encode(e); err != nil {
+			return
+		}
+	}
+}
+
+func (f *firehose) disconnect(c *client) {
+	f.mutex.Lock()
+	if _, ok := f.clients[c]; ok {
+		delete(f.clients, c)
+		close(c.events)
+	}
+	f.clientCount.Store(int32(len(f.clients)))
+	f.mutex.Unlock()
+	_ = c.conn.Close()
+}
+
+func (f *firehose) close() {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, l := range f.listeners {
+		_ = l.Close()
+	}
+	f.listeners = nil
+	for c := range f.clients {
+		delete(f.clients, c)
+		close(c.events)
+		_ = c.conn.Close()
+	}
+	f.clientCount.Store(0)
+}
@@ -0,0 +1,43 @@
+package firehose
+
+import (
+	"bufio"
+	"encoding/json"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+)
+
+func TestFirehose(t *testing.T) {
+	t.Parallel()
+
+	f := New(Params{
+		Config: NewDefaultConfig(),
+		Logger: zap.NewNop().Sugar(),
+	}).Firehose.(*firehose)
+	require.NoError(t, f.listen([]string{"tcp://127.0.0.1:0"}))
+	defer f.close()
+
+	assert.False(t, f.Enabled())
+	conn, err := net.Dial("tcp", f.listeners[0].Addr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+	assert.Eventually(t, f.Enabled, time.Second, time.Millisecond*10)
+
+	infoHash := protocol.RandomNodeID()
+	f.Publish(NewDiscoveredEvent(infoHash, netip.MustParseAddrPort("1.2.3.4:6881")))
+
+	_ = conn.SetReadDeadline(time.Now().Add(time.Second))
+	line, err := bufio.NewReader(conn).ReadBytes('\n')
+	require.NoError(t, err)
+	var e Event
+	require.NoError(t, json.Unmarshal(line, &e))
+	assert.Equal(t, EventTypeDiscovered, e.Type)
+	assert.Equal(t, infoHash.String(), e.InfoHash)
+	assert.Equal(t, "1.2.3.4:6881", e.Node)
+}
@@ -2,6 +2,7 @@ package dhtcrawler
 
 import (
 	"context"
+	"database/sql"
 	"database/sql/driver"
 	"github.com/bitmagnet-io/bitmagnet/internal/model"
 	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
@@ -12,7 +13,8 @@ import (
 // and forwards them to the appropriate channel. Possible outcomes are:
 // 1. The hash is not in the database, so it is forwarded to the getPeers channel to attempt retrieval of the meta info.
 // 2. The hash is in the database, but we don't have the full details of the torrent (for example it was imported outside the DHT crawler,
-// and so we don't have the files info), so it is forwarded to the getPeers channel to attempt retrieval of the meta info.
+// and so we don't have the files info), so it is forwarded to the getPeers channel to attempt retrieval of the meta info,
+// unless a previous attempt failed and the retry policy says to back off.
 // 3. The hash is in the database, but the seeders/leechers are not known or are outdated, so it is forwarded to the scrape channel.
 // 4. The hash is in the database and the seeders/leechers are known and up to date, so it is discarded.
 func (c *crawler) runInfoHashTriage(ctx context.Context) {
@@ -51,10 +53,15 @@ func (c *crawler) runInfoHashTriage(ctx context.Context) {
 				c.dao.TorrentsTorrentSource.Seeders,
 				c.dao.TorrentsTorrentSource.Leechers,
 				c.dao.TorrentsTorrentSource.UpdatedAt,
+				c.dao.MetainfoAttempt.Attempts,
+				c.dao.MetainfoAttempt.NextAttemptAt,
 			).LeftJoin(
 				c.dao.TorrentsTorrentSource,
 				c.dao.Torrent.InfoHash.EqCol(c.dao.TorrentsTorrentSource.InfoHash),
 				c.dao.TorrentsTorrentSource.Source.Eq("dht"),
+			).LeftJoin(
+				c.dao.MetainfoAttempt,
+				c.dao.Torrent.InfoHash.EqCol(c.dao.MetainfoAttempt.InfoHash),
 			).Where(
 				c.dao.Torrent.InfoHash.In(valuers...),
 			).UnderlyingDB().Find(&result).Error; queryErr != nil {
@@ -65,9 +72,20 @@ func (c *crawler) runInfoHashTriage(ctx context.Context) {
 			for _, t := range result {
 				foundTorrents[t.InfoHash] = *t
 			}
+			var noInfoAttempts []metainfoRetryCandidate
 			for h := range filteredHashMap {
 				r := reqMap[h]
-				if t, ok := foundTorrents[r.infoHash]; !ok || t.FilesStatus == model.FilesStatusNoInfo {
+				t, ok := foundTorrents[r.infoHash]
+				if ok && t.FilesStatus == model.FilesStatusNoInfo {
+					if t.NextAttemptAt.Valid && (t.NextAttemptAt.Time.After(time.Now()) || c.metainfoRetryPolicy.Exhausted(t.Attempts.Uint)) {
+						continue
+					}
+					noInfoAttempts = append(noInfoAttempts, metainfoRetryCandidate{
+						InfoHash: t.InfoHash,
+						Attempts: t.Attempts,
+					})
+				}
+				if !ok || t.FilesStatus == model.FilesStatusNoInfo {
 					select {
 					case <-ctx.Done():
 						return
@@ -83,6 +101,11 @@ func (c *crawler) runInfoHashTriage(ctx context.Context) {
 					}
 				}
 			}
+			if len(noInfoAttempts) > 0 {
+				if err := c.recordMetainfoAttempts(ctx, noInfoAttempts); err != nil {
+					c.logger.Errorf("failed to record meta info attempts: %s", err.Error())
+				}
+			}
 		}
 	}
 }
@@ -93,4 +116,7 @@ type triageResult struct {
 	Seeders     model.NullInt
 	Leechers    model.NullInt
 	UpdatedAt   time.Time
+	// Attempts and NextAttemptAt are only set for torrents with a previous meta info fetch attempt
+	Attempts      model.NullUint
+	NextAttemptAt sql.NullTime
 }
@@ -2,12 +2,16 @@ package dhtcrawler
 
 import (
 	"context"
+	"database/sql/driver"
+	"github.com/bitmagnet-io/bitmagnet/internal/events"
 	"github.com/bitmagnet-io/bitmagnet/internal/model"
 	"github.com/bitmagnet-io/bitmagnet/internal/processor"
 	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
 	"github.com/bitmagnet-io/bitmagnet/internal/protocol/metainfo"
 	"github.com/prometheus/client_golang/prometheus"
+	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
+	"time"
 )
 
 // runPersistTorrents waits on the persistTorrents channel, and persists torrents to the database in batches.
@@ -26,7 +30,7 @@ func (c *crawler) runPersistTorrents(ctx context.Context) {
 					continue
 				}
 				hashMap[i.infoHash] = i
-				if t, err := createTorrentModel(i.infoHash, i.metaInfo, c.savePieces, c.saveFilesThreshold); err != nil {
+				if t, err := createTorrentModel(i.nodeHasPeersForHash, i.metaInfo, c.savePieces, c.saveFilesThreshold); err != nil {
 					c.logger.Errorf("error creating torrent model: %s", err.Error())
 				} else {
 					ts = append(ts, &t)
@@ -45,6 +49,19 @@ func (c *crawler) runPersistTorrents(ctx context.Context) {
 			} else {
 				c.persistedTotal.With(prometheus.Labels{"entity": "Torrent"}).Add(float64(len(ts)))
 				c.logger.Debugw("persisted torrents", "count", len(ts))
+				persistedHashes := make([]driver.Valuer, 0, len(ts))
+				discoveredEvents := make([]events.Event, 0, len(ts))
+				for _, t := range ts {
+					persistedHashes = append(persistedHashes, t.InfoHash)
+					discoveredEvents = append(discoveredEvents, events.NewDiscoveredEvent(*t))
+				}
+				c.eventBus.Publish(ctx, discoveredEvents...)
+				// the meta info is now known, so any scheduled retries are no longer needed
+				if _, deleteErr := c.dao.MetainfoAttempt.WithContext(ctx).Where(
+					c.dao.MetainfoAttempt.InfoHash.In(persistedHashes...),
+				).Delete(); deleteErr != nil {
+					c.logger.Errorf("error deleting meta info attempts: %s", deleteErr.Error())
+				}
 				hashesToClassify := make([]protocol.ID, 0, classifyBatchSize)
 				flushClassify := func() {
 					if len(hashesToClassify) == 0 {
@@ -78,7 +95,7 @@ func (c *crawler) runPersistTorrents(ctx context.Context) {
 }
 
 func createTorrentModel(
-	hash protocol.ID,
+	req nodeHasPeersForHash,
 	info metainfo.Info,
 	savePieces bool,
 	saveFilesThreshold uint,
@@ -110,7 +127,7 @@ func createTorrentModel(
 		pieces = info.Pieces
 	}
 	return model.Torrent{
-		InfoHash:    hash,
+		InfoHash:    req.infoHash,
 		Name:        name,
 		Size:        uint64(info.TotalLength()),
 		Private:     private,
@@ -120,8 +137,9 @@ func createTorrentModel(
 		FilesStatus: filesStatus,
 		Sources: []model.TorrentsTorrentSource{
 			{
-				Source:   "dht",
-				InfoHash: hash,
+				Source:          "dht",
+				InfoHash:        req.infoHash,
+				DiscoveryMethod: req.discoveryMethod,
 			},
 		},
 	}, nil
@@ -138,20 +156,41 @@ func (c *crawler) runPersistSources(ctx context.Context) {
 			return
 		case scrapes := <-c.persistSources.Out():
 			srcs := make([]*model.TorrentsTorrentSource, 0, len(scrapes))
-			hashSet := make(map[protocol.ID]struct{}, len(scrapes))
+			hashes := make([]protocol.ID, 0, len(scrapes))
+			hashMap := make(map[protocol.ID]infoHashWithScrape, len(scrapes))
 			for _, s := range scrapes {
-				if _, ok := hashSet[s.infoHash]; ok {
+				// scrapes of the same hash from multiple nodes are combined, as per BEP 33
+				if existing, ok := hashMap[s.infoHash]; ok {
+					_ = existing.bfsd.Merge(&s.bfsd)
+					_ = existing.bfpe.Merge(&s.bfpe)
 					continue
 				}
-				hashSet[s.infoHash] = struct{}{}
-				if src, err := createTorrentSourceModel(s); err != nil {
+				hashes = append(hashes, s.infoHash)
+				hashMap[s.infoHash] = s
+			}
+			for _, h := range hashes {
+				if src, err := createTorrentSourceModel(hashMap[h]); err != nil {
 					c.logger.Errorf("error creating torrent source model: %s", err.Error())
 				} else {
 					srcs = append(srcs, &src)
 				}
 			}
 			if persistErr := c.dao.WithContext(ctx).TorrentsTorrentSource.Clauses(clause.OnConflict{
-				UpdateAll: true,
+				Columns: []clause.Column{
+					{Name: string(c.dao.TorrentsTorrentSource.Source.ColumnName())},
+					{Name: string(c.dao.TorrentsTorrentSource.InfoHash.ColumnName())},
+				},
+				DoUpdates: append(
+					clause.AssignmentColumns([]string{
+						string(c.dao.TorrentsTorrentSource.Bfsd.ColumnName()),
+						string(c.dao.TorrentsTorrentSource.Bfpe.ColumnName()),
+						string(c.dao.TorrentsTorrentSource.Seeders.ColumnName()),
+						string(c.dao.TorrentsTorrentSource.Leechers.ColumnName()),
+						string(c.dao.TorrentsTorrentSource.UpdatedAt.ColumnName()),
+					}),
+					healthDecayAssignment(c.healthHalfLife),
+					c.dao.LastSeededAtAssignment(),
+				),
 			}).CreateInBatches(srcs, 20); persistErr != nil {
 				c.logger.Errorf("error persisting torrent sources: %s", persistErr.Error())
 			} else {
@@ -176,12 +215,29 @@ func createTorrentSourceModel(
 	if bfpeErr != nil {
 		return model.TorrentsTorrentSource{}, bfpeErr
 	}
-	return model.TorrentsTorrentSource{
+	src := model.TorrentsTorrentSource{
 		Source:   "dht",
 		InfoHash: result.infoHash,
 		Bfsd:     bfsdBytes,
 		Bfpe:     bfpeBytes,
-		Leechers: seeders,
-		Seeders:  leechers,
-	}, nil
+		Leechers: leechers,
+		Health:   model.NewNullFloat32(float32(seeders.Uint + leechers.Uint)),
+		// the discovery method is only written if this creates the source
+		DiscoveryMethod: result.discoveryMethod,
+	}
+	src.SetSeeders(seeders, time.Now())
+	return src, nil
+}
+
+// healthDecayAssignment combines the newly estimated swarm size with the previous health value,
+// weighting the previous value by how recently it was recorded: after one half-life it counts for half.
+func healthDecayAssignment(halfLife time.Duration) clause.Assignment {
+	return clause.Assignment{
+		Column: clause.Column{Name: "health"},
+		Value: gorm.Expr(
+			"coalesce(excluded.health + (torrents_torrent_sources.health - excluded.health) * "+
+				"power(0.5, extract(epoch from (now() - torrents_torrent_sources.updated_at)) / ?), excluded.health)",
+			halfLife.Seconds(),
+		),
+	}
 }
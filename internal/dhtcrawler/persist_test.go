@@ -0,0 +1,40 @@
+package dhtcrawler
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/bloom"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol/dht"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/netip"
+	"testing"
+)
+
+func scrapeFilter(n int) bloom.Filter {
+	f := bloom.FromScrape(dht.ScrapeBloomFilter{})
+	for i := 0; i < n; i++ {
+		addr := netip.AddrFrom4([4]byte{10, 0, byte(i >> 8), byte(i)})
+		f.Add(addr.AsSlice())
+	}
+	return f
+}
+
+func TestCreateTorrentSourceModel(t *testing.T) {
+	t.Parallel()
+
+	infoHash := protocol.RandomNodeID()
+	src, err := createTorrentSourceModel(infoHashWithScrape{
+		nodeHasPeersForHash: nodeHasPeersForHash{infoHash: infoHash},
+		bfsd:                scrapeFilter(40),
+		bfpe:                scrapeFilter(5),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "dht", src.Source)
+	assert.Equal(t, infoHash, src.InfoHash)
+	// the seeds filter counts seeders, and the peers filter counts leechers:
+	require.True(t, src.Seeders.Valid)
+	require.True(t, src.Leechers.Valid)
+	assert.InDelta(t, 40, src.Seeders.Uint, 4)
+	assert.InDelta(t, 5, src.Leechers.Uint, 1)
+}
@@ -3,18 +3,26 @@ package dhtcrawler
 import (
 	"context"
 	"errors"
+	"github.com/bitmagnet-io/bitmagnet/internal/dhtcrawler/firehose"
 	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol/dht/ktable"
 	"github.com/bitmagnet-io/bitmagnet/internal/protocol/metainfo/metainforequester"
 	"net/netip"
 	"sync"
 )
 
+// maxPeersPerMetaInfoRequest limits how many peers will be tried for a single hash, including those learned via peer exchange
+const maxPeersPerMetaInfoRequest = 20
+
 func (c *crawler) runRequestMetaInfo(ctx context.Context) {
 	_ = c.requestMetaInfo.Run(ctx, func(req infoHashWithPeers) {
 		mi, reqErr := c.doRequestMetaInfo(ctx, req.infoHash, req.peers)
 		if reqErr != nil {
 			return
 		}
+		if c.firehose.Enabled() {
+			c.firehose.Publish(firehose.NewMetaInfoEvent(req.infoHash, req.node, mi.Info))
+		}
 		select {
 		case <-ctx.Done():
 		case c.persistTorrents.In() <- infoHashWithMetaInfo{
@@ -25,6 +33,8 @@ func (c *crawler) runRequestMetaInfo(ctx context.Context) {
 	})
 }
 
+// doRequestMetaInfo tries each of the peers in turn until the meta info is retrieved.
+// Peers learned via peer exchange are appended to the list, which helps with rare torrents where the DHT knows few peers.
 func (c *crawler) doRequestMetaInfo(
 	ctx context.Context,
 	hash protocol.ID,
@@ -37,8 +47,31 @@ func (c *crawler) doRequestMetaInfo(
 		errs = append(errs, err)
 		errsMutex.Unlock()
 	}
+	seenPeers := make(map[netip.AddrPort]struct{}, len(peers))
 	for _, p := range peers {
-		res, err := c.metainfoRequester.Request(ctx, hash, p)
+		seenPeers[p] = struct{}{}
+	}
+	var pexPeers []ktable.HashPeer
+	addPexPeers := func(res metainforequester.Response) {
+		for _, p := range res.Peers {
+			if _, ok := seenPeers[p]; ok {
+				continue
+			}
+			seenPeers[p] = struct{}{}
+			pexPeers = append(pexPeers, ktable.HashPeer{Addr: p})
+			if len(peers) < maxPeersPerMetaInfoRequest {
+				peers = append(peers, p)
+			}
+		}
+	}
+	defer func() {
+		if len(pexPeers) > 0 {
+			c.kTable.BatchCommand(ktable.PutHash{ID: hash, Peers: pexPeers})
+		}
+	}()
+	for i := 0; i < len(peers); i++ {
+		res, err := c.metainfoRequester.Request(ctx, hash, peers[i])
+		addPexPeers(res)
 		if err != nil {
 			addErr(err)
 			continue
@@ -0,0 +1,55 @@
+package dhtcrawler
+
+import (
+	"context"
+	"time"
+)
+
+const staleSourcesBatchSize = 100
+
+// getStaleSourcesForScrape periodically finds torrents whose DHT scrape is older than the rescrape threshold,
+// and forwards them to the scrape channel along with the closest known nodes. Torrents would otherwise only be
+// rescraped when they're rediscovered; this keeps the health of torrents that have dropped out of the DHT decaying.
+// A cursor on the updated_at column ensures that hashes that fail to be scraped don't block the rest of the queue.
+func (c *crawler) getStaleSourcesForScrape(ctx context.Context) {
+	var cursor time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(c.getStaleSourcesInterval):
+			q := c.dao.TorrentsTorrentSource
+			sources, err := q.WithContext(ctx).Select(
+				q.InfoHash,
+				q.UpdatedAt,
+			).Where(
+				q.Source.Eq("dht"),
+				q.UpdatedAt.Lt(time.Now().Add(-c.rescrapeThreshold)),
+				q.UpdatedAt.Gt(cursor),
+			).Order(q.UpdatedAt).Limit(staleSourcesBatchSize).Find()
+			if err != nil {
+				c.logger.Errorf("failed to get stale sources: %s", err.Error())
+				continue
+			}
+			if len(sources) == 0 {
+				cursor = time.Time{}
+				continue
+			}
+			cursor = sources[len(sources)-1].UpdatedAt
+			for _, s := range sources {
+				nodes := c.kTable.GetClosestNodes(s.InfoHash)
+				// a few of the closest nodes are asked, and their results merged before persisting
+				for i := 0; i < len(nodes) && i < 3; i++ {
+					select {
+					case <-ctx.Done():
+						return
+					case c.scrape.In() <- nodeHasPeersForHash{
+						infoHash: s.InfoHash,
+						node:     nodes[i].Addr(),
+					}:
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package dhtcrawler
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"gorm.io/gen/field"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+const metainfoRetriesBatchSize = 100
+
+// MetainfoRetryPolicy determines when the meta info fetch for a torrent with unknown files should next be attempted.
+type MetainfoRetryPolicy struct {
+	InitialDelay time.Duration
+	MaxDelay     time.Duration
+	MaxAttempts  uint
+}
+
+// Delay returns the delay before the next attempt, given the number of attempts already made.
+func (p MetainfoRetryPolicy) Delay(attempts uint) time.Duration {
+	delay := p.InitialDelay
+	for i := uint(1); i < attempts && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, p.MaxDelay)
+}
+
+// Exhausted returns true if no more automatic attempts should be made.
+func (p MetainfoRetryPolicy) Exhausted(attempts uint) bool {
+	return attempts >= p.MaxAttempts
+}
+
+type metainfoRetryCandidate struct {
+	InfoHash protocol.ID
+	Attempts model.NullUint
+}
+
+// getNoInfoTorrentsForRetry periodically finds torrents for which the meta info is unknown and the next attempt is due,
+// records the attempt, and forwards them to the getPeers channel along with the closest known nodes.
+// These hashes would otherwise only be retried if they're rediscovered, and the ignoreHashes filter makes that unlikely.
+func (c *crawler) getNoInfoTorrentsForRetry(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(c.getMetainfoRetriesInterval):
+			var candidates []metainfoRetryCandidate
+			if err := c.dao.Torrent.WithContext(ctx).Select(
+				c.dao.Torrent.InfoHash,
+				c.dao.MetainfoAttempt.Attempts,
+			).LeftJoin(
+				c.dao.MetainfoAttempt,
+				c.dao.MetainfoAttempt.InfoHash.EqCol(c.dao.Torrent.InfoHash),
+			).Where(
+				c.dao.Torrent.FilesStatus.Eq(model.FilesStatusNoInfo),
+				field.Or(
+					c.dao.MetainfoAttempt.InfoHash.IsNull(),
+					field.And(
+						c.dao.MetainfoAttempt.NextAttemptAt.Lte(time.Now()),
+						c.dao.MetainfoAttempt.Attempts.Lt(c.metainfoRetryPolicy.MaxAttempts),
+					),
+				),
+			).UnderlyingDB().Order(
+				"metainfo_attempts.next_attempt_at NULLS FIRST",
+			).Limit(metainfoRetriesBatchSize).Find(&candidates).Error; err != nil {
+				c.logger.Errorf("failed to get torrents for meta info retry: %s", err.Error())
+				continue
+			}
+			if len(candidates) == 0 {
+				continue
+			}
+			if err := c.recordMetainfoAttempts(ctx, candidates); err != nil {
+				c.logger.Errorf("failed to record meta info attempts: %s", err.Error())
+				continue
+			}
+			for _, candidate := range candidates {
+				nodes := c.kTable.GetClosestNodes(candidate.InfoHash)
+				for i := 0; i < len(nodes) && i < 3; i++ {
+					select {
+					case <-ctx.Done():
+						return
+					case c.getPeers.In() <- nodeHasPeersForHash{
+						infoHash: candidate.InfoHash,
+						node:     nodes[i].Addr(),
+					}:
+					}
+				}
+			}
+		}
+	}
+}
+
+// recordMetainfoAttempts increments the attempt counts of the given torrents and schedules their next attempt.
+func (c *crawler) recordMetainfoAttempts(ctx context.Context, candidates []metainfoRetryCandidate) error {
+	now := time.Now()
+	attempts := make([]*model.MetainfoAttempt, 0, len(candidates))
+	for _, candidate := range candidates {
+		n := candidate.Attempts.Uint + 1
+		attempts = append(attempts, &model.MetainfoAttempt{
+			InfoHash:      candidate.InfoHash,
+			Attempts:      n,
+			LastAttemptAt: &now,
+			NextAttemptAt: now.Add(c.metainfoRetryPolicy.Delay(n)),
+		})
+	}
+	return c.dao.MetainfoAttempt.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: string(c.dao.MetainfoAttempt.InfoHash.ColumnName())}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			string(c.dao.MetainfoAttempt.Attempts.ColumnName()),
+			string(c.dao.MetainfoAttempt.LastAttemptAt.ColumnName()),
+			string(c.dao.MetainfoAttempt.NextAttemptAt.ColumnName()),
+			string(c.dao.MetainfoAttempt.UpdatedAt.ColumnName()),
+		}),
+	}).CreateInBatches(attempts, 100)
+}
@@ -0,0 +1,26 @@
+package dhtcrawler
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestMetainfoRetryPolicy(t *testing.T) {
+	t.Parallel()
+
+	p := MetainfoRetryPolicy{
+		InitialDelay: time.Hour,
+		MaxDelay:     time.Hour * 24,
+		MaxAttempts:  10,
+	}
+
+	assert.Equal(t, time.Hour, p.Delay(0))
+	assert.Equal(t, time.Hour, p.Delay(1))
+	assert.Equal(t, time.Hour*2, p.Delay(2))
+	assert.Equal(t, time.Hour*16, p.Delay(5))
+	assert.Equal(t, time.Hour*24, p.Delay(6))
+	assert.Equal(t, time.Hour*24, p.Delay(1000))
+	assert.False(t, p.Exhausted(9))
+	assert.True(t, p.Exhausted(10))
+}
@@ -3,7 +3,10 @@ package dhtcrawler
 import (
 	"context"
 	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/dhtcrawler/firehose"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
 	"github.com/bitmagnet-io/bitmagnet/internal/protocol/dht/ktable"
+	"github.com/prometheus/client_golang/prometheus"
 	"time"
 )
 
@@ -34,13 +37,20 @@ func (c *crawler) runSampleInfoHashes(ctx context.Context) {
 		}
 		var discoveredHashes []nodeHasPeersForHash
 		for _, s := range res.Samples {
+			if c.firehose.Enabled() {
+				c.firehose.Publish(firehose.NewDiscoveredEvent(s, n.Addr()))
+			}
 			if !c.ignoreHashes.testAndAdd(s) {
 				discoveredHashes = append(discoveredHashes, nodeHasPeersForHash{
-					infoHash: s,
-					node:     n.Addr(),
+					infoHash:        s,
+					node:            n.Addr(),
+					discoveryMethod: model.NewNullDiscoveryMethod(model.DiscoveryMethodDhtSampleInfohashes),
 				})
 			}
 		}
+		c.discoveredHashesTotal.With(prometheus.Labels{
+			"method": model.DiscoveryMethodDhtSampleInfohashes.String(),
+		}).Add(float64(len(discoveredHashes)))
 		for _, h := range discoveredHashes {
 			select {
 			case <-ctx.Done():
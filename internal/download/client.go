@@ -0,0 +1,79 @@
+package download
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+const (
+	typeQBittorrent  = "qbittorrent"
+	typeTransmission = "transmission"
+)
+
+// client adds magnet links to a torrent client.
+type client interface {
+	add(ctx context.Context, magnet string, opts addOptions) error
+}
+
+type addOptions struct {
+	category string
+	savePath string
+}
+
+// namedClient is a configured client along with its defaults.
+type namedClient struct {
+	client
+	name     string
+	category string
+	savePath string
+}
+
+func newClients(configs map[string]ClientConfig, httpClient *http.Client) ([]namedClient, error) {
+	clients := make([]namedClient, 0, len(configs))
+	for name, c := range configs {
+		cl, err := newClient(c, httpClient)
+		if err != nil {
+			return nil, fmt.Errorf("download client %s: %w", name, err)
+		}
+		clients = append(clients, namedClient{
+			client:   cl,
+			name:     name,
+			category: c.Category,
+			savePath: c.SavePath,
+		})
+	}
+	slices.SortFunc(clients, func(a, b namedClient) int {
+		return strings.Compare(a.name, b.name)
+	})
+	return clients, nil
+}
+
+func newClient(c ClientConfig, httpClient *http.Client) (client, error) {
+	u, err := url.Parse(c.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return nil, fmt.Errorf("invalid URL: %s", c.URL)
+	}
+	baseURL := strings.TrimSuffix(u.String(), "/")
+	switch c.Type {
+	case typeQBittorrent:
+		return &qBittorrentClient{
+			baseURL:    baseURL,
+			username:   c.Username,
+			password:   c.Password,
+			httpClient: httpClient,
+		}, nil
+	case typeTransmission:
+		return &transmissionClient{
+			rpcURL:     baseURL + "/transmission/rpc",
+			username:   c.Username,
+			password:   c.Password,
+			httpClient: httpClient,
+		}, nil
+	default:
+		return nil, fmt.Errorf("invalid type %q, expected %s or %s", c.Type, typeQBittorrent, typeTransmission)
+	}
+}
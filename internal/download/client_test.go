@@ -0,0 +1,106 @@
+package download
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const testMagnet = "magnet:?xt=urn:btih:0123456789abcdef0123456789abcdef01234567"
+
+func TestQBittorrentAdd(t *testing.T) {
+	t.Parallel()
+
+	var added []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseForm())
+		switch r.URL.Path {
+		case "/api/v2/auth/login":
+			if r.PostForm.Get("username") != "admin" || r.PostForm.Get("password") != "secret" {
+				_, _ = w.Write([]byte("Fails."))
+				return
+			}
+			http.SetCookie(w, &http.Cookie{Name: "SID", Value: "session"})
+			_, _ = w.Write([]byte("Ok."))
+		case "/api/v2/torrents/add":
+			if cookie, err := r.Cookie("SID"); err != nil || cookie.Value != "session" {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			assert.Equal(t, "movies", r.PostForm.Get("category"))
+			assert.Equal(t, "/downloads/movies", r.PostForm.Get("savepath"))
+			added = append(added, r.PostForm.Get("urls"))
+			_, _ = w.Write([]byte("Ok."))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	opts := addOptions{category: "movies", savePath: "/downloads/movies"}
+
+	c, err := newClient(ClientConfig{Type: "qbittorrent", URL: server.URL, Username: "admin", Password: "secret"}, server.Client())
+	assert.NoError(t, err)
+	assert.NoError(t, c.add(context.Background(), testMagnet, opts))
+	// the session is reused for subsequent requests:
+	assert.NoError(t, c.add(context.Background(), testMagnet, opts))
+	assert.Equal(t, []string{testMagnet, testMagnet}, added)
+
+	invalid, err := newClient(ClientConfig{Type: "qbittorrent", URL: server.URL, Username: "admin", Password: "wrong"}, server.Client())
+	assert.NoError(t, err)
+	assert.Error(t, invalid.add(context.Background(), testMagnet, opts))
+}
+
+func TestTransmissionAdd(t *testing.T) {
+	t.Parallel()
+
+	var requests []transmissionRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/transmission/rpc", r.URL.Path)
+		if username, password, ok := r.BasicAuth(); !ok || username != "admin" || password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.Header.Get(transmissionSessionHeader) != "session" {
+			w.Header().Set(transmissionSessionHeader, "session")
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		var req transmissionRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		requests = append(requests, req)
+		_, _ = w.Write([]byte(`{"result":"success","arguments":{}}`))
+	}))
+	defer server.Close()
+
+	c, err := newClient(ClientConfig{Type: "transmission", URL: server.URL + "/", Username: "admin", Password: "secret"}, server.Client())
+	assert.NoError(t, err)
+	assert.NoError(t, c.add(context.Background(), testMagnet, addOptions{category: "tv", savePath: "/downloads/tv"}))
+	assert.Equal(t, []transmissionRequest{{
+		Method: "torrent-add",
+		Arguments: map[string]any{
+			"filename":     testMagnet,
+			"labels":       []any{"tv"},
+			"download-dir": "/downloads/tv",
+		},
+	}}, requests)
+
+	invalid, err := newClient(ClientConfig{Type: "transmission", URL: server.URL, Username: "admin"}, server.Client())
+	assert.NoError(t, err)
+	assert.Error(t, invalid.add(context.Background(), testMagnet, addOptions{}))
+}
+
+func TestNewClientInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, invalid := range []ClientConfig{
+		{Type: "deluge", URL: "http://deluge:8112"},
+		{Type: "qbittorrent", URL: "qbittorrent:8080"},
+	} {
+		_, err := newClient(invalid, http.DefaultClient)
+		assert.Error(t, err)
+	}
+}
@@ -0,0 +1,31 @@
+package download
+
+import "time"
+
+type Config struct {
+	// Clients maps client names to the torrent clients that torrents can be sent to.
+	Clients map[string]ClientConfig
+	// DefaultClient is the client used when none is specified; if empty and only one client is configured, that client is used.
+	DefaultClient string
+	// Timeout applies to each request made to a client.
+	Timeout time.Duration
+}
+
+type ClientConfig struct {
+	// Type is either qbittorrent or transmission.
+	Type string `mapstructure:"type"`
+	// URL is the base URL of the client's web interface, for example http://qbittorrent:8080.
+	URL      string `mapstructure:"url"`
+	Username string `mapstructure:"username"`
+	Password string `mapstructure:"password"`
+	// Category is the default category (a label in Transmission) assigned to sent torrents.
+	Category string `mapstructure:"category"`
+	// SavePath is the default directory that sent torrents are downloaded to; the client's own default is used if empty.
+	SavePath string `mapstructure:"save_path"`
+}
+
+func NewDefaultConfig() Config {
+	return Config{
+		Timeout: time.Second * 10,
+	}
+}
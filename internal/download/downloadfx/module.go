@@ -0,0 +1,17 @@
+package downloadfx
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/config/configfx"
+	"github.com/bitmagnet-io/bitmagnet/internal/download"
+	"go.uber.org/fx"
+)
+
+func New() fx.Option {
+	return fx.Module(
+		"download",
+		configfx.NewConfigModule[download.Config]("download", download.NewDefaultConfig()),
+		fx.Provide(
+			download.New,
+		),
+	)
+}
@@ -0,0 +1,55 @@
+package download
+
+import (
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/torrentexport"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"net/http"
+)
+
+type Params struct {
+	fx.In
+	Config      Config
+	Dao         lazy.Lazy[*dao.Query]
+	TrackerList lazy.Lazy[torrentexport.TrackerList]
+	Logger      *zap.SugaredLogger
+}
+
+type Result struct {
+	fx.Out
+	Manager lazy.Lazy[Manager]
+}
+
+func New(p Params) Result {
+	return Result{
+		Manager: lazy.New(func() (Manager, error) {
+			d, err := p.Dao.Get()
+			if err != nil {
+				return nil, err
+			}
+			tl, err := p.TrackerList.Get()
+			if err != nil {
+				return nil, err
+			}
+			clients, err := newClients(p.Config.Clients, &http.Client{
+				Timeout: p.Config.Timeout,
+			})
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := p.Config.Clients[p.Config.DefaultClient]; p.Config.DefaultClient != "" && !ok {
+				return nil, fmt.Errorf("%w: %s", ErrUnknownClient, p.Config.DefaultClient)
+			}
+			return manager{
+				dao:           d,
+				clients:       clients,
+				defaultClient: p.Config.DefaultClient,
+				trackerList:   tl,
+				logger:        p.Logger.Named("download"),
+			}, nil
+		}),
+	}
+}
@@ -0,0 +1,168 @@
+package download
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"github.com/bitmagnet-io/bitmagnet/internal/torrentexport"
+	"go.uber.org/zap"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrNoClient      = errors.New("no download client specified")
+	ErrUnknownClient = errors.New("unknown download client")
+)
+
+type SendParams struct {
+	InfoHashes []protocol.ID
+	// Client is the name of the client to send to; the default client is used if empty.
+	Client string
+	// Category and SavePath override the client's configured defaults if not empty.
+	Category string
+	SavePath string
+}
+
+// Manager sends torrents to the configured torrent clients, and records which torrents have been sent.
+type Manager interface {
+	// Clients returns the names of the configured clients.
+	Clients() []string
+	// Send adds the torrents to a client as magnet links, returning the download records.
+	// Sending stops at the first torrent the client fails to add; torrents already sent are still recorded.
+	Send(ctx context.Context, params SendParams) ([]model.TorrentDownload, error)
+	// List returns the download records of the given torrents.
+	List(ctx context.Context, infoHashes ...protocol.ID) ([]model.TorrentDownload, error)
+}
+
+type manager struct {
+	dao           *dao.Query
+	clients       []namedClient
+	defaultClient string
+	trackerList   torrentexport.TrackerList
+	logger        *zap.SugaredLogger
+}
+
+func (m manager) Clients() []string {
+	names := make([]string, 0, len(m.clients))
+	for _, c := range m.clients {
+		names = append(names, c.name)
+	}
+	return names
+}
+
+func (m manager) client(name string) (namedClient, error) {
+	if name == "" {
+		name = m.defaultClient
+	}
+	if name == "" {
+		if len(m.clients) != 1 {
+			return namedClient{}, ErrNoClient
+		}
+		return m.clients[0], nil
+	}
+	for _, c := range m.clients {
+		if c.name == name {
+			return c, nil
+		}
+	}
+	return namedClient{}, fmt.Errorf("%w: %s", ErrUnknownClient, name)
+}
+
+func (m manager) Send(ctx context.Context, params SendParams) ([]model.TorrentDownload, error) {
+	c, err := m.client(params.Client)
+	if err != nil {
+		return nil, err
+	}
+	opts := addOptions{
+		category: c.category,
+		savePath: c.savePath,
+	}
+	if params.Category != "" {
+		opts.category = params.Category
+	}
+	if params.SavePath != "" {
+		opts.savePath = params.SavePath
+	}
+	infoHashes := make([]driver.Valuer, 0, len(params.InfoHashes))
+	for _, h := range params.InfoHashes {
+		infoHashes = append(infoHashes, h)
+	}
+	torrents, err := m.dao.Torrent.WithContext(ctx).Where(
+		m.dao.Torrent.InfoHash.In(infoHashes...),
+	).Find()
+	if err != nil {
+		return nil, err
+	}
+	torrentsMap := make(map[protocol.ID]*model.Torrent, len(torrents))
+	for _, t := range torrents {
+		torrentsMap[t.InfoHash] = t
+	}
+	for _, h := range params.InfoHashes {
+		if _, ok := torrentsMap[h]; !ok {
+			return nil, fmt.Errorf("torrent not found: %s", h)
+		}
+	}
+	downloads := make([]model.TorrentDownload, 0, len(params.InfoHashes))
+	var sendErr error
+	trackers := m.trackerList.Trackers()
+	for _, h := range params.InfoHashes {
+		if err := c.add(ctx, torrentsMap[h].MagnetUri(trackers...), opts); err != nil {
+			sendErr = fmt.Errorf("failed to send %s to %s: %w", h, c.name, err)
+			break
+		}
+		m.logger.Debugw("sent torrent", "client", c.name, "info_hash", h)
+		download := model.TorrentDownload{
+			InfoHash: h,
+			Client:   c.name,
+		}
+		if opts.category != "" {
+			download.Category = model.NewNullString(opts.category)
+		}
+		if opts.savePath != "" {
+			download.SavePath = model.NewNullString(opts.savePath)
+		}
+		downloads = append(downloads, download)
+	}
+	if len(downloads) > 0 {
+		if err := m.dao.TorrentDownload.WithContext(ctx).Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "info_hash"}, {Name: "client"}},
+			DoUpdates: clause.AssignmentColumns([]string{"category", "save_path", "updated_at"}),
+		}).Create(toPointers(downloads)...); err != nil {
+			return nil, errors.Join(sendErr, err)
+		}
+	}
+	return downloads, sendErr
+}
+
+func (m manager) List(ctx context.Context, infoHashes ...protocol.ID) ([]model.TorrentDownload, error) {
+	if len(infoHashes) == 0 {
+		return nil, nil
+	}
+	values := make([]driver.Valuer, 0, len(infoHashes))
+	for _, h := range infoHashes {
+		values = append(values, h)
+	}
+	result, err := m.dao.TorrentDownload.WithContext(ctx).Where(
+		m.dao.TorrentDownload.InfoHash.In(values...),
+	).Order(m.dao.TorrentDownload.UpdatedAt.Desc()).Find()
+	if err != nil {
+		return nil, err
+	}
+	downloads := make([]model.TorrentDownload, 0, len(result))
+	for _, d := range result {
+		downloads = append(downloads, *d)
+	}
+	return downloads, nil
+}
+
+func toPointers(downloads []model.TorrentDownload) []*model.TorrentDownload {
+	pointers := make([]*model.TorrentDownload, 0, len(downloads))
+	for i := range downloads {
+		pointers = append(pointers, &downloads[i])
+	}
+	return pointers
+}
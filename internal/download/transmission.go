@@ -0,0 +1,104 @@
+package download
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+)
+
+const transmissionSessionHeader = "X-Transmission-Session-Id"
+
+// transmissionClient adds torrents using the Transmission RPC API.
+type transmissionClient struct {
+	rpcURL     string
+	username   string
+	password   string
+	httpClient *http.Client
+	mutex      sync.Mutex
+	// sessionID is required by Transmission's CSRF protection, and is obtained from a 409 response.
+	sessionID string
+}
+
+type transmissionRequest struct {
+	Method    string         `json:"method"`
+	Arguments map[string]any `json:"arguments"`
+}
+
+type transmissionResponse struct {
+	Result string `json:"result"`
+}
+
+func (c *transmissionClient) add(ctx context.Context, magnet string, opts addOptions) error {
+	args := map[string]any{"filename": magnet}
+	if opts.category != "" {
+		args["labels"] = []string{opts.category}
+	}
+	if opts.savePath != "" {
+		args["download-dir"] = opts.savePath
+	}
+	body, err := json.Marshal(transmissionRequest{
+		Method:    "torrent-add",
+		Arguments: args,
+	})
+	if err != nil {
+		return err
+	}
+	res, err := c.do(ctx, body)
+	if err != nil {
+		return err
+	}
+	if res.Result != "success" {
+		return fmt.Errorf("the torrent was rejected: %s", res.Result)
+	}
+	return nil
+}
+
+func (c *transmissionClient) do(ctx context.Context, body []byte) (transmissionResponse, error) {
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.rpcURL, bytes.NewReader(body))
+		if err != nil {
+			return transmissionResponse{}, err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		if c.username != "" || c.password != "" {
+			req.SetBasicAuth(c.username, c.password)
+		}
+		c.mutex.Lock()
+		req.Header.Set(transmissionSessionHeader, c.sessionID)
+		c.mutex.Unlock()
+		res, err := c.httpClient.Do(req)
+		if err != nil {
+			return transmissionResponse{}, err
+		}
+		resBody, readErr := io.ReadAll(io.LimitReader(res.Body, 1<<16))
+		_ = res.Body.Close()
+		if readErr != nil {
+			return transmissionResponse{}, readErr
+		}
+		switch res.StatusCode {
+		case http.StatusOK:
+			var r transmissionResponse
+			if err := json.Unmarshal(resBody, &r); err != nil {
+				return transmissionResponse{}, fmt.Errorf("invalid response: %w", err)
+			}
+			return r, nil
+		case http.StatusConflict:
+			sessionID := res.Header.Get(transmissionSessionHeader)
+			if attempt > 0 || sessionID == "" {
+				return transmissionResponse{}, errors.New("failed to obtain a session ID")
+			}
+			c.mutex.Lock()
+			c.sessionID = sessionID
+			c.mutex.Unlock()
+		case http.StatusUnauthorized:
+			return transmissionResponse{}, errors.New("invalid credentials")
+		default:
+			return transmissionResponse{}, fmt.Errorf("unexpected status %d", res.StatusCode)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package dumpimport
+
+type Config struct {
+	// Dumps maps names to the remote torrent dumps that are imported on a schedule.
+	Dumps map[string]DumpConfig
+}
+
+type DumpConfig struct {
+	// URL is downloaded on each run; gzip compressed dumps are decompressed.
+	URL string `mapstructure:"url"`
+	// Schedule is a cron expression, such as "0 4 * * *", or a descriptor such as "@daily".
+	Schedule string
+	// Format is "csv" or "jsonl"; it's inferred from the extension of the URL if empty.
+	Format string
+	// Source is the torrent source of the imported torrents, and defaults to the name of the dump.
+	Source string
+	// MaxSize is the maximum size in bytes of a decompressed dump, beyond which its import fails, so that a corrupt or
+	// malicious dump can't be read forever; it defaults to defaultMaxSize.
+	MaxSize uint64 `mapstructure:"max_size"`
+}
+
+const (
+	defaultSchedule = "@daily"
+	defaultMaxSize  = 20_000_000_000
+)
+
+func NewDefaultConfig() Config {
+	return Config{}
+}
@@ -0,0 +1,17 @@
+package dumpimportfx
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/config/configfx"
+	"github.com/bitmagnet-io/bitmagnet/internal/dumpimport"
+	"go.uber.org/fx"
+)
+
+func New() fx.Option {
+	return fx.Module(
+		"dump_import",
+		configfx.NewConfigModule[dumpimport.Config]("dump_import", dumpimport.NewDefaultConfig()),
+		fx.Provide(
+			dumpimport.New,
+		),
+	)
+}
@@ -0,0 +1,78 @@
+package dumpimport
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/worker"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/importer"
+	"github.com/bitmagnet-io/bitmagnet/internal/taskrun"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+type Params struct {
+	fx.In
+	Config          Config
+	Dao             lazy.Lazy[*dao.Query]
+	Importer        lazy.Lazy[importer.Importer]
+	TaskRunRecorder lazy.Lazy[taskrun.Recorder]
+	Logger          *zap.SugaredLogger
+}
+
+type Result struct {
+	fx.Out
+	Worker worker.Worker `group:"workers"`
+}
+
+func New(p Params) Result {
+	var s *scheduler
+	return Result{
+		Worker: worker.NewWorker(
+			"dump_import",
+			fx.Hook{
+				OnStart: func(context.Context) error {
+					if len(p.Config.Dumps) == 0 {
+						return nil
+					}
+					schedules, err := parseSchedules(p.Config.Dumps)
+					if err != nil {
+						return err
+					}
+					d, err := p.Dao.Get()
+					if err != nil {
+						return err
+					}
+					i, err := p.Importer.Get()
+					if err != nil {
+						return err
+					}
+					tr, err := p.TaskRunRecorder.Get()
+					if err != nil {
+						return err
+					}
+					s = &scheduler{
+						runner: runner{
+							httpClient:      newHTTPClient(),
+							dao:             d,
+							importer:        i,
+							taskRunRecorder: tr,
+							logger:          p.Logger.Named("dump_import"),
+						},
+						dumps:     p.Config.Dumps,
+						schedules: schedules,
+						stopped:   make(chan struct{}),
+					}
+					go s.start()
+					return nil
+				},
+				OnStop: func(context.Context) error {
+					if s != nil {
+						close(s.stopped)
+					}
+					return nil
+				},
+			},
+		),
+	}
+}
@@ -0,0 +1,259 @@
+package dumpimport
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/importer"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	FormatCsv   = "csv"
+	FormatJsonl = "jsonl"
+)
+
+// maxJsonlRowLength is the length in bytes of the longest row of a JSONL dump; longer rows are invalid.
+const maxJsonlRowLength = 1024 * 1024
+
+var (
+	errMissingColumn = errors.New("missing column")
+	errRowTooLong    = errors.New("row too long")
+)
+
+// dumpFormat returns the configured format of a dump, or the format of the extension of its URL, ignoring a .gz suffix.
+func dumpFormat(dump DumpConfig) (string, error) {
+	if dump.Format != "" {
+		switch dump.Format {
+		case FormatCsv, FormatJsonl:
+			return dump.Format, nil
+		}
+		return "", fmt.Errorf("unsupported dump format: %s", dump.Format)
+	}
+	p := strings.ToLower(dump.URL)
+	if i := strings.IndexAny(p, "?#"); i >= 0 {
+		p = p[:i]
+	}
+	p = strings.TrimSuffix(p, ".gz")
+	if path.Ext(p) == ".csv" {
+		return FormatCsv, nil
+	}
+	return FormatJsonl, nil
+}
+
+// readRows calls fn with the item of each row of a dump, or with the error of a row that isn't valid; reading stops if
+// fn returns an error.
+func readRows(r io.Reader, format string, fn func(item importer.Item, rowErr error) error) error {
+	if format == FormatCsv {
+		return readCsvRows(r, fn)
+	}
+	return readJsonlRows(r, fn)
+}
+
+func readJsonlRows(r io.Reader, fn func(item importer.Item, rowErr error) error) error {
+	br := bufio.NewReaderSize(r, 64*1024)
+	var line []byte
+	tooLong := false
+	for {
+		// lines longer than the buffer are read in chunks, and the rest of a line that's too long is skipped:
+		chunk, isPrefix, err := br.ReadLine()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if !tooLong {
+			if len(line)+len(chunk) > maxJsonlRowLength {
+				tooLong = true
+			} else {
+				line = append(line, chunk...)
+			}
+		}
+		if isPrefix {
+			continue
+		}
+		var item importer.Item
+		var rowErr error
+		if tooLong {
+			rowErr = errRowTooLong
+		} else if trimmed := bytes.TrimSpace(line); len(trimmed) == 0 {
+			line = line[:0]
+			continue
+		} else if rowErr = json.Unmarshal(trimmed, &item); rowErr == nil {
+			rowErr = validateItem(item)
+		}
+		line, tooLong = line[:0], false
+		if err := fn(item, rowErr); err != nil {
+			return err
+		}
+	}
+}
+
+type csvColumn struct {
+	field string
+	set   func(item *importer.Item, value string) error
+}
+
+// csvColumns maps the normalized names of the columns of a CSV dump's header to the fields they set; of several columns
+// of the same field, such as name and title, the first is used.
+var csvColumns = map[string]csvColumn{
+	"infohash":      {"info_hash", setInfoHash},
+	"hash":          {"info_hash", setInfoHash},
+	"btih":          {"info_hash", setInfoHash},
+	"name":          {"name", setName},
+	"title":         {"name", setName},
+	"size":          {"size", setSize},
+	"length":        {"size", setSize},
+	"private":       {"private", setPrivate},
+	"contenttype":   {"content_type", setContentType},
+	"contentsource": {"content_source", setContentSource},
+	"contentid":     {"content_id", setContentID},
+	"category":      {"category", setCategory},
+	"publishedat":   {"published_at", setPublishedAt},
+	"date":          {"published_at", setPublishedAt},
+	"added":         {"published_at", setPublishedAt},
+	"createdat":     {"published_at", setPublishedAt},
+}
+
+func readCsvRows(r io.Reader, fn func(item importer.Item, rowErr error) error) error {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.LazyQuotes = true
+	cr.ReuseRecord = true
+	header, err := cr.Read()
+	if err != nil {
+		return err
+	}
+	setters := make([]func(item *importer.Item, value string) error, len(header))
+	fields := make(map[string]struct{}, len(header))
+	for i, name := range header {
+		name = strings.Map(func(r rune) rune {
+			if r == '_' || r == '-' || r == ' ' {
+				return -1
+			}
+			return r
+		}, strings.ToLower(strings.TrimSpace(name)))
+		if column, ok := csvColumns[name]; ok {
+			if _, ok := fields[column.field]; !ok {
+				fields[column.field] = struct{}{}
+				setters[i] = column.set
+			}
+		}
+	}
+	if _, ok := fields["info_hash"]; !ok {
+		return fmt.Errorf("%w: info_hash", errMissingColumn)
+	}
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		var rowErr error
+		var item importer.Item
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			rowErr = err
+		} else if err != nil {
+			return err
+		} else {
+			for i, value := range record {
+				value = strings.TrimSpace(value)
+				if i < len(setters) && setters[i] != nil && value != "" {
+					if setErr := setters[i](&item, value); setErr != nil {
+						rowErr = fmt.Errorf("column %s: %w", header[i], setErr)
+						break
+					}
+				}
+			}
+			if rowErr == nil {
+				rowErr = validateItem(item)
+			}
+		}
+		if err := fn(item, rowErr); err != nil {
+			return err
+		}
+	}
+}
+
+func setInfoHash(item *importer.Item, value string) error {
+	infoHash, err := protocol.ParseID(strings.TrimPrefix(strings.ToLower(value), "urn:btih:"))
+	item.InfoHash = infoHash
+	return err
+}
+
+func setName(item *importer.Item, value string) error {
+	item.Name = value
+	return nil
+}
+
+func setSize(item *importer.Item, value string) error {
+	size, err := strconv.ParseUint(value, 10, 64)
+	item.Size = size
+	return err
+}
+
+func setPrivate(item *importer.Item, value string) error {
+	private, err := strconv.ParseBool(value)
+	item.Private = private
+	return err
+}
+
+func setContentType(item *importer.Item, value string) error {
+	contentType, err := model.ParseContentType(value)
+	if err == nil {
+		item.ContentType = model.NewNullContentType(contentType)
+	}
+	return err
+}
+
+func setContentSource(item *importer.Item, value string) error {
+	item.ContentSource = model.NewNullString(value)
+	return nil
+}
+
+func setContentID(item *importer.Item, value string) error {
+	item.ContentID = model.NewNullString(value)
+	return nil
+}
+
+func setCategory(item *importer.Item, value string) error {
+	item.Category = model.NewNullString(value)
+	return nil
+}
+
+var publishedAtLayouts = []string{time.RFC3339, "2006-01-02 15:04:05", "2006-01-02"}
+
+// setPublishedAt parses a time in one of publishedAtLayouts, or as seconds since the epoch.
+func setPublishedAt(item *importer.Item, value string) error {
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		item.PublishedAt = time.Unix(seconds, 0)
+		return nil
+	}
+	for _, layout := range publishedAtLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			item.PublishedAt = t
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid time: %s", value)
+}
+
+func validateItem(item importer.Item) error {
+	if item.InfoHash.IsZero() {
+		return errors.New("missing info hash")
+	}
+	if item.Name == "" {
+		return errors.New("missing name")
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+package dumpimport
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/importer"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+type readResult struct {
+	items   []importer.Item
+	invalid int
+}
+
+func read(t *testing.T, data string, format string) readResult {
+	var result readResult
+	require.NoError(t, readRows(strings.NewReader(data), format, func(item importer.Item, rowErr error) error {
+		if rowErr != nil {
+			result.invalid++
+		} else {
+			result.items = append(result.items, item)
+		}
+		return nil
+	}))
+	return result
+}
+
+func TestReadCsvRows(t *testing.T) {
+	t.Parallel()
+
+	result := read(t, strings.Join([]string{
+		"ID,Info Hash,Title,Name,Size,content_type,added",
+		"1,AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA,The Title,Other Name,123,movie,2024-05-17 10:00:00",
+		"2,not a hash,Another,,1,,",
+		"3,bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb,,,,,",
+		"4,cccccccccccccccccccccccccccccccccccccccc,\"Quoted, Title\",,,,1715940000",
+	}, "\n"), FormatCsv)
+	assert.Equal(t, 2, result.invalid)
+	require.Len(t, result.items, 2)
+	assert.Equal(t, protocol.MustParseID("aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"), result.items[0].InfoHash)
+	assert.Equal(t, "The Title", result.items[0].Name)
+	assert.Equal(t, uint64(123), result.items[0].Size)
+	assert.Equal(t, model.NewNullContentType(model.ContentTypeMovie), result.items[0].ContentType)
+	assert.Equal(t, 2024, result.items[0].PublishedAt.Year())
+	assert.Equal(t, "Quoted, Title", result.items[1].Name)
+	assert.Equal(t, int64(1715940000), result.items[1].PublishedAt.Unix())
+
+	err := readRows(strings.NewReader("name,size\nx,1"), FormatCsv, func(importer.Item, error) error {
+		return nil
+	})
+	assert.ErrorIs(t, err, errMissingColumn)
+}
+
+func TestReadJsonlRows(t *testing.T) {
+	t.Parallel()
+
+	result := read(t, strings.Join([]string{
+		`{"infoHash":"aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa","name":"A","size":1}`,
+		``,
+		`{"infoHash":"bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"}`,
+		`not json`,
+		`{"infoHash":"cccccccccccccccccccccccccccccccccccccccc","name":"` + strings.Repeat("C", maxJsonlRowLength) + `"}`,
+		`{"infoHash":"dddddddddddddddddddddddddddddddddddddddd","name":"D"}`,
+	}, "\n"), FormatJsonl)
+	assert.Equal(t, 3, result.invalid, "a row that's too long should be invalid without stopping the import")
+	require.Len(t, result.items, 2)
+	assert.Equal(t, "A", result.items[0].Name)
+	assert.Equal(t, "D", result.items[1].Name)
+}
+
+func TestDumpFormat(t *testing.T) {
+	t.Parallel()
+
+	for url, expected := range map[string]string{
+		"https://example.com/dump.csv.gz":     FormatCsv,
+		"https://example.com/dump.CSV?key=1":  FormatCsv,
+		"https://example.com/dump.jsonl.gz":   FormatJsonl,
+		"https://example.com/latest?format=x": FormatJsonl,
+	} {
+		format, err := dumpFormat(DumpConfig{URL: url})
+		require.NoError(t, err)
+		assert.Equal(t, expected, format, url)
+	}
+	_, err := dumpFormat(DumpConfig{Format: "xml"})
+	assert.Error(t, err)
+}
+
+func TestDecompress(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	_, _ = gw.Write([]byte("compressed"))
+	require.NoError(t, gw.Close())
+	for input, expected := range map[string]string{
+		buf.String(): "compressed",
+		"plain":      "plain",
+	} {
+		r, err := decompress(io.NopCloser(strings.NewReader(input)))
+		require.NoError(t, err)
+		data, err := io.ReadAll(r)
+		require.NoError(t, err)
+		assert.Equal(t, expected, string(data))
+	}
+}
+
+func TestDownload(t *testing.T) {
+	t.Parallel()
+
+	var gzipped bytes.Buffer
+	gw := gzip.NewWriter(&gzipped)
+	_, _ = gw.Write(make([]byte, 10_000))
+	require.NoError(t, gw.Close())
+	stall := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/stalled" {
+			_, _ = w.Write([]byte("partial"))
+			w.(http.Flusher).Flush()
+			select {
+			case <-stall:
+			case <-r.Context().Done():
+			}
+			return
+		}
+		_, _ = w.Write(gzipped.Bytes())
+	}))
+	t.Cleanup(server.Close)
+	t.Cleanup(func() {
+		close(stall)
+	})
+	r := runner{httpClient: newHTTPClient()}
+
+	body, err := r.download(context.Background(), server.URL, 10_000, time.Second)
+	require.NoError(t, err)
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+	assert.Len(t, data, 10_000)
+	require.NoError(t, body.Close())
+
+	body, err = r.download(context.Background(), server.URL, 1000, time.Second)
+	require.NoError(t, err)
+	data, err = io.ReadAll(body)
+	assert.ErrorIs(t, err, errDumpTooLarge, "the decompressed size should be limited")
+	assert.Len(t, data, 1000)
+	require.NoError(t, body.Close())
+
+	body, err = r.download(context.Background(), server.URL+"/stalled", 10_000, 50*time.Millisecond)
+	require.NoError(t, err)
+	_, err = io.ReadAll(body)
+	assert.ErrorIs(t, err, errDownloadStalled)
+	require.NoError(t, body.Close())
+}
@@ -0,0 +1,266 @@
+package dumpimport
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/importer"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"github.com/bitmagnet-io/bitmagnet/internal/taskrun"
+	"go.uber.org/zap"
+	"io"
+	"net/http"
+	"time"
+)
+
+// batchSize is the number of rows that are checked against the already imported torrents at a time.
+const batchSize = 1000
+
+const (
+	// responseHeaderTimeout is how long the server of a dump has to respond to its request.
+	responseHeaderTimeout = time.Minute
+	// readTimeout is how long a read of a dump's body may block before the download is cancelled; dumps can be large,
+	// so the download as a whole isn't given a timeout.
+	readTimeout = 2 * time.Minute
+)
+
+var (
+	errDownloadStalled = errors.New("dump download stalled")
+	errDumpTooLarge    = errors.New("dump exceeds its maximum size")
+)
+
+func newHTTPClient() *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.ResponseHeaderTimeout = responseHeaderTimeout
+	return &http.Client{Transport: transport}
+}
+
+// Report is the outcome of a run of a dump import.
+type Report struct {
+	// Rows is the number of rows read from the dump
+	Rows int
+	// Invalid is the number of rows that couldn't be parsed or lacked an info hash or name
+	Invalid int
+	// Known is the number of rows of torrents already imported from the dump's source, or repeated in the dump
+	Known int
+	// Imported is the number of rows of new torrents that were imported
+	Imported int
+}
+
+type runner struct {
+	httpClient      *http.Client
+	dao             *dao.Query
+	importer        importer.Importer
+	taskRunRecorder taskrun.Recorder
+	logger          *zap.SugaredLogger
+}
+
+// run downloads a dump and imports the torrents of its rows that haven't already been imported from its source.
+func (r runner) run(ctx context.Context, name string, dump DumpConfig) (report Report, err error) {
+	taskRun := r.taskRunRecorder.StartTarget(ctx, taskrun.KindDumpImport, name)
+	defer func() {
+		taskRun.SetTotal(int64(report.Rows))
+		taskRun.Finish(err)
+		if err != nil {
+			r.logger.Errorw("dump import failed", "dump", name, "report", report, "error", err)
+		} else {
+			r.logger.Infow("dump import finished", "dump", name, "report", report)
+		}
+	}()
+	format, err := dumpFormat(dump)
+	if err != nil {
+		return report, err
+	}
+	maxSize := dump.MaxSize
+	if maxSize == 0 {
+		maxSize = defaultMaxSize
+	}
+	body, err := r.download(ctx, dump.URL, maxSize, readTimeout)
+	if err != nil {
+		return report, err
+	}
+	defer func() {
+		_ = body.Close()
+	}()
+	source := dump.Source
+	if source == "" {
+		source = name
+	}
+	ai := r.importer.New(ctx, importer.Info{
+		ID: fmt.Sprintf("dump_import:%s:%d", name, time.Now().Unix()),
+	})
+	seen := make(map[protocol.ID]struct{})
+	batch := make([]importer.Item, 0, batchSize)
+	flush := func() error {
+		items, flushErr := r.newItems(ctx, source, batch, seen)
+		if flushErr != nil {
+			return flushErr
+		}
+		report.Known += len(batch) - len(items)
+		batch = batch[:0]
+		if len(items) == 0 {
+			return nil
+		}
+		if flushErr := ai.Import(items...); flushErr != nil {
+			return flushErr
+		}
+		report.Imported += len(items)
+		taskRun.Add(len(items))
+		return nil
+	}
+	readErr := readRows(body, format, func(item importer.Item, rowErr error) error {
+		report.Rows++
+		if rowErr != nil {
+			report.Invalid++
+			r.logger.Debugw("invalid dump row", "dump", name, "row", report.Rows, "error", rowErr)
+			return nil
+		}
+		item.Source = source
+		if item.PublishedAt.IsZero() {
+			item.PublishedAt = time.Now()
+		}
+		batch = append(batch, item)
+		if len(batch) < batchSize {
+			return nil
+		}
+		return flush()
+	})
+	if readErr == nil && len(batch) > 0 {
+		readErr = flush()
+	}
+	if readErr != nil {
+		_ = ai.Close()
+		return report, readErr
+	}
+	ai.Drain()
+	return report, ai.Close()
+}
+
+// download returns the body of a dump, decompressing it if it's gzip compressed. Reading the body fails once more than
+// maxSize decompressed bytes are read, or if a read blocks for longer than the read timeout.
+func (r runner) download(ctx context.Context, url string, maxSize uint64, timeout time.Duration) (io.ReadCloser, error) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		cancel(nil)
+		return nil, err
+	}
+	res, err := r.httpClient.Do(req)
+	if err != nil {
+		cancel(nil)
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		_ = res.Body.Close()
+		cancel(nil)
+		return nil, fmt.Errorf("unexpected status code: %d", res.StatusCode)
+	}
+	timer := time.AfterFunc(timeout, func() {
+		cancel(errDownloadStalled)
+	})
+	timer.Stop()
+	body, err := decompress(&deadlineReader{
+		ReadCloser: res.Body,
+		ctx:        ctx,
+		cancel:     cancel,
+		timer:      timer,
+		timeout:    timeout,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return readCloser{&maxSizeReader{Reader: body, remaining: maxSize}, body}, nil
+}
+
+// deadlineReader cancels the request of a body if a read blocks for longer than the timeout; the deadline only runs
+// during reads, so that a slow import of the rows read doesn't cancel the download.
+type deadlineReader struct {
+	io.ReadCloser
+	ctx     context.Context
+	cancel  context.CancelCauseFunc
+	timer   *time.Timer
+	timeout time.Duration
+}
+
+func (r *deadlineReader) Read(p []byte) (int, error) {
+	r.timer.Reset(r.timeout)
+	n, err := r.ReadCloser.Read(p)
+	r.timer.Stop()
+	if err != nil && errors.Is(context.Cause(r.ctx), errDownloadStalled) {
+		err = errDownloadStalled
+	}
+	return n, err
+}
+
+func (r *deadlineReader) Close() error {
+	r.timer.Stop()
+	err := r.ReadCloser.Close()
+	r.cancel(nil)
+	return err
+}
+
+// maxSizeReader fails once more than the remaining bytes are read.
+type maxSizeReader struct {
+	io.Reader
+	remaining uint64
+}
+
+func (r *maxSizeReader) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	if uint64(n) > r.remaining {
+		n = int(r.remaining)
+		r.remaining = 0
+		return n, errDumpTooLarge
+	}
+	r.remaining -= uint64(n)
+	return n, err
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+func decompress(body io.ReadCloser) (io.ReadCloser, error) {
+	br := bufio.NewReader(body)
+	if magic, err := br.Peek(2); err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		gr, err := gzip.NewReader(br)
+		if err != nil {
+			_ = body.Close()
+			return nil, err
+		}
+		return readCloser{gr, body}, nil
+	}
+	return readCloser{br, body}, nil
+}
+
+// newItems returns the items of a batch whose torrents haven't already been imported from the source, or earlier
+// in the dump.
+func (r runner) newItems(ctx context.Context, source string, batch []importer.Item, seen map[protocol.ID]struct{}) ([]importer.Item, error) {
+	valuers := make([]driver.Valuer, 0, len(batch))
+	for _, item := range batch {
+		valuers = append(valuers, item.InfoHash)
+	}
+	var knownHashes []protocol.ID
+	if err := r.dao.TorrentsTorrentSource.WithContext(ctx).Where(
+		r.dao.TorrentsTorrentSource.Source.Eq(source),
+		r.dao.TorrentsTorrentSource.InfoHash.In(valuers...),
+	).Pluck(r.dao.TorrentsTorrentSource.InfoHash, &knownHashes); err != nil {
+		return nil, err
+	}
+	for _, infoHash := range knownHashes {
+		seen[infoHash] = struct{}{}
+	}
+	items := make([]importer.Item, 0, len(batch))
+	for _, item := range batch {
+		if _, ok := seen[item.InfoHash]; !ok {
+			seen[item.InfoHash] = struct{}{}
+			items = append(items, item)
+		}
+	}
+	return items, nil
+}
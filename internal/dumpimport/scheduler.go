@@ -0,0 +1,58 @@
+package dumpimport
+
+import (
+	"context"
+	"github.com/robfig/cron/v3"
+	"sync"
+	"time"
+)
+
+// scheduler runs each dump import at the times of its schedule.
+type scheduler struct {
+	runner    runner
+	dumps     map[string]DumpConfig
+	schedules map[string]cron.Schedule
+	stopped   chan struct{}
+}
+
+func (s *scheduler) start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	for name, dump := range s.dumps {
+		name, dump, schedule := name, dump, s.schedules[name]
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(time.Until(schedule.Next(time.Now()))):
+				}
+				_, _ = s.runner.run(ctx, name, dump)
+			}
+		}()
+	}
+	<-s.stopped
+	cancel()
+	wg.Wait()
+}
+
+func parseSchedules(dumps map[string]DumpConfig) (map[string]cron.Schedule, error) {
+	schedules := make(map[string]cron.Schedule, len(dumps))
+	for name, dump := range dumps {
+		spec := dump.Schedule
+		if spec == "" {
+			spec = defaultSchedule
+		}
+		schedule, err := cron.ParseStandard(spec)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := dumpFormat(dump); err != nil {
+			return nil, err
+		}
+		schedules[name] = schedule
+	}
+	return schedules, nil
+}
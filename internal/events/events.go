@@ -0,0 +1,151 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"github.com/bitmagnet-io/bitmagnet/internal/queue/redis"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+	"time"
+)
+
+// channel is the Redis pub/sub channel that events are published to;
+// Redis is used so that subscribers receive events from workers running in other processes.
+const channel = "bitmagnet:torrent_events"
+
+// subscriptionBufferSize is the number of events buffered for a slow subscriber before events are dropped
+const subscriptionBufferSize = 1000
+
+// Event describes a change to a torrent; the content fields are only set for classified events.
+type Event struct {
+	Type          model.TorrentEventType
+	InfoHash      protocol.ID
+	Name          string
+	Size          uint64
+	ContentType   model.NullContentType
+	ContentSource model.NullString
+	ContentID     model.NullString
+	Title         model.NullString
+	Time          time.Time
+}
+
+func NewDiscoveredEvent(t model.Torrent) Event {
+	return Event{
+		Type:     model.TorrentEventTypeDiscovered,
+		InfoHash: t.InfoHash,
+		Name:     t.Name,
+		Size:     t.Size,
+		Time:     time.Now(),
+	}
+}
+
+func NewClassifiedEvent(tc model.TorrentContent) Event {
+	e := Event{
+		Type:          model.TorrentEventTypeClassified,
+		InfoHash:      tc.InfoHash,
+		Name:          tc.Torrent.Name,
+		Size:          tc.Torrent.Size,
+		ContentType:   tc.ContentType,
+		ContentSource: tc.ContentSource,
+		ContentID:     tc.ContentID,
+		Time:          time.Now(),
+	}
+	if tc.Content.Title != "" {
+		e.Title = model.NewNullString(tc.Content.Title)
+	}
+	return e
+}
+
+func NewDeletedEvents(infoHashes ...protocol.ID) []Event {
+	now := time.Now()
+	events := make([]Event, 0, len(infoHashes))
+	for _, h := range infoHashes {
+		events = append(events, Event{
+			Type:     model.TorrentEventTypeDeleted,
+			InfoHash: h,
+			Time:     now,
+		})
+	}
+	return events
+}
+
+// Bus publishes torrent events to any subscribers, across all processes sharing the Redis instance.
+type Bus interface {
+	// Publish sends the events to current subscribers; errors are logged rather than returned,
+	// as events are informational and shouldn't interrupt the work that produced them.
+	Publish(ctx context.Context, events ...Event)
+	// Subscribe returns a channel of events, which is closed when the context is done.
+	// Events are dropped if the subscriber falls too far behind; they're counted by the dropped total metric.
+	Subscribe(ctx context.Context) (<-chan Event, error)
+}
+
+type bus struct {
+	redis        *redis.Client
+	droppedTotal prometheus.Counter
+	logger       *zap.SugaredLogger
+}
+
+func (b bus) Publish(ctx context.Context, events ...Event) {
+	if len(events) == 0 {
+		return
+	}
+	payload, err := json.Marshal(events)
+	if err != nil {
+		b.logger.Errorw("failed to marshal events", "error", err)
+		return
+	}
+	if err := b.redis.Publish(ctx, channel, payload).Err(); err != nil {
+		b.logger.Errorw("failed to publish events", "error", err)
+	}
+}
+
+func (b bus) Subscribe(ctx context.Context) (<-chan Event, error) {
+	sub := b.redis.Subscribe(ctx, channel)
+	// wait for the subscription to be confirmed, so that no events are missed after returning
+	if _, err := sub.Receive(ctx); err != nil {
+		_ = sub.Close()
+		return nil, err
+	}
+	out := make(chan Event, subscriptionBufferSize)
+	go func() {
+		defer close(out)
+		defer func() {
+			_ = sub.Close()
+		}()
+		msgs := sub.Channel()
+		// a warning is logged when the subscriber starts falling behind, rather than for each dropped event
+		dropping := false
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-msgs:
+				if !ok {
+					return
+				}
+				var events []Event
+				if err := json.Unmarshal([]byte(msg.Payload), &events); err != nil {
+					b.logger.Errorw("failed to unmarshal events", "error", err)
+					continue
+				}
+				for _, e := range events {
+					select {
+					case out <- e:
+						dropping = false
+					default:
+						// the subscriber isn't keeping up; blocking would only move the backlog to Redis,
+						// which disconnects subscribers that fall too far behind
+						b.droppedTotal.Inc()
+						if !dropping {
+							dropping = true
+							b.logger.Warnw("dropping events for a subscriber that isn't keeping up", "type", e.Type)
+						}
+					}
+				}
+			}
+		}
+	}()
+	return out, nil
+}
@@ -0,0 +1,39 @@
+package events
+
+import (
+	"encoding/json"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestEventPayloadRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	infoHash := protocol.MustParseID("0123456789abcdef0123456789abcdef01234567")
+	sent := []Event{
+		NewClassifiedEvent(model.TorrentContent{
+			InfoHash:      infoHash,
+			ContentType:   model.NewNullContentType(model.ContentTypeMovie),
+			ContentSource: model.NewNullString("tmdb"),
+			ContentID:     model.NewNullString("278"),
+			Torrent:       model.Torrent{Name: "The.Shawshank.Redemption.1994.1080p", Size: 1 << 30},
+			Content:       model.Content{Title: "The Shawshank Redemption"},
+		}),
+	}
+	sent = append(sent, NewDeletedEvents(infoHash)...)
+	payload, err := json.Marshal(sent)
+	require.NoError(t, err)
+	var received []Event
+	require.NoError(t, json.Unmarshal(payload, &received))
+	require.Len(t, received, 2)
+	for i := range sent {
+		assert.True(t, sent[i].Time.Equal(received[i].Time))
+		received[i].Time = sent[i].Time
+		// Set only records that the field was present when unmarshalling
+		received[i].ContentType.Set = false
+	}
+	assert.Equal(t, sent, received)
+}
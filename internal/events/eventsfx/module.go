@@ -0,0 +1,15 @@
+package eventsfx
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/events"
+	"go.uber.org/fx"
+)
+
+func New() fx.Option {
+	return fx.Module(
+		"events",
+		fx.Provide(
+			events.New,
+		),
+	)
+}
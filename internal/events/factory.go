@@ -0,0 +1,44 @@
+package events
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/bitmagnet-io/bitmagnet/internal/queue/redis"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+type Params struct {
+	fx.In
+	Redis  lazy.Lazy[*redis.Client]
+	Logger *zap.SugaredLogger
+}
+
+type Result struct {
+	fx.Out
+	Bus          lazy.Lazy[Bus]
+	DroppedTotal prometheus.Collector `group:"prometheus_collectors"`
+}
+
+func New(p Params) Result {
+	droppedTotal := prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "bitmagnet",
+		Subsystem: "events",
+		Name:      "dropped_total",
+		Help:      "A counter of torrent events dropped for subscribers that didn't keep up.",
+	})
+	return Result{
+		Bus: lazy.New(func() (Bus, error) {
+			r, err := p.Redis.Get()
+			if err != nil {
+				return nil, err
+			}
+			return bus{
+				redis:        r,
+				droppedTotal: droppedTotal,
+				logger:       p.Logger.Named("events"),
+			}, nil
+		}),
+		DroppedTotal: droppedTotal,
+	}
+}
@@ -0,0 +1,228 @@
+package feed
+
+import (
+	"encoding/xml"
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/search"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/torznab"
+	"strings"
+	"time"
+)
+
+const enclosureType = "application/x-bittorrent;x-scheme-handler/magnet"
+
+// Feed is a format-independent list of search results, rendered as RSS or Atom.
+type Feed struct {
+	Title   string
+	SelfURL string
+	Updated time.Time
+	Items   []Item
+}
+
+type Item struct {
+	InfoHash    string
+	Title       string
+	Category    string
+	Description string
+	MagnetURI   string
+	Size        uint64
+	Published   time.Time
+}
+
+func newFeed(title string, selfURL string, res search.TorrentContentResult, trackers []string) Feed {
+	f := Feed{
+		Title:   title,
+		SelfURL: selfURL,
+		Items:   make([]Item, 0, len(res.Items)),
+	}
+	for _, item := range res.Items {
+		if item.UpdatedAt.After(f.Updated) {
+			f.Updated = item.UpdatedAt
+		}
+		f.Items = append(f.Items, Item{
+			InfoHash:    item.InfoHash.String(),
+			Title:       item.Torrent.Name,
+			Category:    category(item.TorrentContent),
+			Description: description(item.TorrentContent),
+			MagnetURI:   item.Torrent.MagnetUri(trackers...),
+			Size:        item.Torrent.Size,
+			Published:   item.CreatedAt,
+		})
+	}
+	if f.Updated.IsZero() {
+		f.Updated = time.Now()
+	}
+	return f
+}
+
+func category(tc model.TorrentContent) string {
+	if tc.ContentType.Valid {
+		return tc.ContentType.ContentType.Label()
+	}
+	return "Unknown"
+}
+
+func description(tc model.TorrentContent) string {
+	var parts []string
+	if tc.Title() != tc.Torrent.Name {
+		parts = append(parts, tc.Title())
+	}
+	if tc.VideoResolution.Valid {
+		parts = append(parts, tc.VideoResolution.VideoResolution.Label())
+	}
+	if tc.VideoSource.Valid {
+		parts = append(parts, tc.VideoSource.VideoSource.Label())
+	}
+	parts = append(parts, formatSize(tc.Torrent.Size))
+	if seeders := tc.Torrent.Seeders(); seeders.Valid {
+		parts = append(parts, fmt.Sprintf("%d seeders", seeders.Uint))
+	}
+	return strings.Join(parts, " | ")
+}
+
+func formatSize(size uint64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+	div, exp := uint64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(size)/float64(div), "KMGTPE"[exp])
+}
+
+func (f Feed) RSS() ([]byte, error) {
+	type rssEnclosure struct {
+		URL    string `xml:"url,attr"`
+		Length uint64 `xml:"length,attr"`
+		Type   string `xml:"type,attr"`
+	}
+	type rssGUID struct {
+		Value       string `xml:",chardata"`
+		IsPermaLink bool   `xml:"isPermaLink,attr"`
+	}
+	type rssItem struct {
+		Title       string       `xml:"title"`
+		Link        string       `xml:"link"`
+		GUID        rssGUID      `xml:"guid"`
+		Category    string       `xml:"category"`
+		Description string       `xml:"description"`
+		PubDate     string       `xml:"pubDate"`
+		Enclosure   rssEnclosure `xml:"enclosure"`
+	}
+	type atomLink struct {
+		Href string `xml:"href,attr"`
+		Rel  string `xml:"rel,attr"`
+		Type string `xml:"type,attr"`
+	}
+	type rssChannel struct {
+		Title         string    `xml:"title"`
+		Link          string    `xml:"link"`
+		Description   string    `xml:"description"`
+		AtomLink      atomLink  `xml:"atom:link"`
+		LastBuildDate string    `xml:"lastBuildDate"`
+		Items         []rssItem `xml:"item"`
+	}
+	type rss struct {
+		XMLName xml.Name   `xml:"rss"`
+		Version string     `xml:"version,attr"`
+		AtomNs  string     `xml:"xmlns:atom,attr"`
+		Channel rssChannel `xml:"channel"`
+	}
+	items := make([]rssItem, 0, len(f.Items))
+	for _, item := range f.Items {
+		items = append(items, rssItem{
+			Title:       item.Title,
+			Link:        item.MagnetURI,
+			GUID:        rssGUID{Value: item.InfoHash},
+			Category:    item.Category,
+			Description: item.Description,
+			PubDate:     item.Published.Format(torznab.RssDateDefaultFormat),
+			Enclosure: rssEnclosure{
+				URL:    item.MagnetURI,
+				Length: item.Size,
+				Type:   enclosureType,
+			},
+		})
+	}
+	return marshal(rss{
+		Version: "2.0",
+		AtomNs:  "http://www.w3.org/2005/Atom",
+		Channel: rssChannel{
+			Title:         f.Title,
+			Link:          f.SelfURL,
+			Description:   f.Title,
+			AtomLink:      atomLink{Href: f.SelfURL, Rel: "self", Type: "application/rss+xml"},
+			LastBuildDate: f.Updated.Format(torznab.RssDateDefaultFormat),
+			Items:         items,
+		},
+	})
+}
+
+func (f Feed) Atom() ([]byte, error) {
+	type atomLink struct {
+		Href   string `xml:"href,attr"`
+		Rel    string `xml:"rel,attr"`
+		Type   string `xml:"type,attr,omitempty"`
+		Length uint64 `xml:"length,attr,omitempty"`
+	}
+	type atomCategory struct {
+		Term string `xml:"term,attr"`
+	}
+	type atomEntry struct {
+		Title     string       `xml:"title"`
+		ID        string       `xml:"id"`
+		Updated   string       `xml:"updated"`
+		Published string       `xml:"published"`
+		Category  atomCategory `xml:"category"`
+		Summary   string       `xml:"summary"`
+		Links     []atomLink   `xml:"link"`
+	}
+	type atomAuthor struct {
+		Name string `xml:"name"`
+	}
+	type atomFeed struct {
+		XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+		Title   string      `xml:"title"`
+		ID      string      `xml:"id"`
+		Updated string      `xml:"updated"`
+		Author  atomAuthor  `xml:"author"`
+		Link    atomLink    `xml:"link"`
+		Entries []atomEntry `xml:"entry"`
+	}
+	entries := make([]atomEntry, 0, len(f.Items))
+	for _, item := range f.Items {
+		published := item.Published.Format(time.RFC3339)
+		entries = append(entries, atomEntry{
+			Title:     item.Title,
+			ID:        "urn:btih:" + item.InfoHash,
+			Updated:   published,
+			Published: published,
+			Category:  atomCategory{Term: item.Category},
+			Summary:   item.Description,
+			Links: []atomLink{
+				{Href: item.MagnetURI, Rel: "alternate"},
+				{Href: item.MagnetURI, Rel: "enclosure", Type: enclosureType, Length: item.Size},
+			},
+		})
+	}
+	return marshal(atomFeed{
+		Title:   f.Title,
+		ID:      f.SelfURL,
+		Updated: f.Updated.Format(time.RFC3339),
+		Author:  atomAuthor{Name: "bitmagnet"},
+		Link:    atomLink{Href: f.SelfURL, Rel: "self", Type: "application/atom+xml"},
+		Entries: entries,
+	})
+}
+
+func marshal(v any) ([]byte, error) {
+	body, err := xml.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), body...), nil
+}
@@ -0,0 +1,93 @@
+package feed
+
+import (
+	"encoding/xml"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/stretchr/testify/assert"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestParseRequest(t *testing.T) {
+	t.Parallel()
+
+	params, err := url.ParseQuery("q=dune&content_type=movie&video_resolution=V1080p,V2160p&video_resolution=V720p&limit=500&apikey=secret")
+	assert.NoError(t, err)
+	r, err := parseRequest(params)
+	assert.NoError(t, err)
+	assert.Equal(t, model.NewNullString("dune"), r.filter.QueryString)
+	assert.Equal(t, uint(maxLimit), r.limit)
+	assert.Equal(t, []model.SavedSearchFacet{
+		{Key: "content_type", Logic: model.FacetLogicOr, Values: []string{"movie"}},
+		{Key: "video_resolution", Logic: model.FacetLogicOr, Values: []string{"V1080p", "V2160p", "V720p"}},
+	}, r.filter.Facets)
+	assert.Equal(t, "bitmagnet: dune; content_type: movie; video_resolution: V1080p, V2160p, V720p", r.title())
+
+	for _, invalid := range []string{"order_by=name", "desc=maybe", "limit=0", "limit=x"} {
+		params, err := url.ParseQuery(invalid)
+		assert.NoError(t, err)
+		_, err = parseRequest(params)
+		assert.ErrorIs(t, err, errInvalidParam, invalid)
+	}
+}
+
+func TestRender(t *testing.T) {
+	t.Parallel()
+
+	published := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	f := Feed{
+		Title:   "bitmagnet: dune",
+		SelfURL: "http://localhost:3333/feeds/rss?q=dune",
+		Updated: published,
+		Items: []Item{
+			{
+				InfoHash:  "0123456789abcdef0123456789abcdef01234567",
+				Title:     "Dune.2021.1080p",
+				Category:  "Movie",
+				MagnetURI: "magnet:?xt=urn:btih:0123456789abcdef0123456789abcdef01234567&dn=Dune.2021.1080p",
+				Size:      1024,
+				Published: published,
+			},
+		},
+	}
+
+	body, err := f.RSS()
+	assert.NoError(t, err)
+	var rss struct {
+		Items []struct {
+			Title     string `xml:"title"`
+			GUID      string `xml:"guid"`
+			PubDate   string `xml:"pubDate"`
+			Enclosure struct {
+				URL    string `xml:"url,attr"`
+				Length uint64 `xml:"length,attr"`
+			} `xml:"enclosure"`
+		} `xml:"channel>item"`
+	}
+	assert.NoError(t, xml.Unmarshal(body, &rss))
+	assert.Len(t, rss.Items, 1)
+	assert.Equal(t, "Dune.2021.1080p", rss.Items[0].Title)
+	assert.Equal(t, f.Items[0].InfoHash, rss.Items[0].GUID)
+	assert.Equal(t, "Fri, 01 Mar 2024 12:00:00 +0000", rss.Items[0].PubDate)
+	assert.Equal(t, f.Items[0].MagnetURI, rss.Items[0].Enclosure.URL)
+	assert.Equal(t, uint64(1024), rss.Items[0].Enclosure.Length)
+
+	body, err = f.Atom()
+	assert.NoError(t, err)
+	var atom struct {
+		XMLName xml.Name `xml:"http://www.w3.org/2005/Atom feed"`
+		Entries []struct {
+			ID    string `xml:"id"`
+			Links []struct {
+				Href string `xml:"href,attr"`
+				Rel  string `xml:"rel,attr"`
+			} `xml:"link"`
+		} `xml:"entry"`
+	}
+	assert.NoError(t, xml.Unmarshal(body, &atom))
+	assert.Len(t, atom.Entries, 1)
+	assert.Equal(t, "urn:btih:"+f.Items[0].InfoHash, atom.Entries[0].ID)
+	assert.Equal(t, "enclosure", atom.Entries[0].Links[1].Rel)
+	assert.Equal(t, f.Items[0].MagnetURI, atom.Entries[0].Links[1].Href)
+}
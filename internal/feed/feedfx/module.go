@@ -0,0 +1,15 @@
+package feedfx
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/feed"
+	"go.uber.org/fx"
+)
+
+func New() fx.Option {
+	return fx.Module(
+		"feed",
+		fx.Provide(
+			feed.New,
+		),
+	)
+}
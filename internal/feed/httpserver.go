@@ -0,0 +1,196 @@
+package feed
+
+import (
+	"errors"
+	"github.com/bitmagnet-io/bitmagnet/internal/auth"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/httpserver"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/query"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/search"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/savedsearch"
+	"github.com/bitmagnet-io/bitmagnet/internal/torrentexport"
+	"github.com/bitmagnet-io/bitmagnet/internal/torznab/apikey"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"net/http"
+	"strconv"
+)
+
+type Params struct {
+	fx.In
+	Dao          lazy.Lazy[*dao.Query]
+	Search       lazy.Lazy[search.Search]
+	SearchConfig search.Config
+	TrackerList  lazy.Lazy[torrentexport.TrackerList]
+	APIKeys      lazy.Lazy[apikey.Manager]
+	Logger       *zap.SugaredLogger
+}
+
+type Result struct {
+	fx.Out
+	Option httpserver.Option `group:"http_server_options"`
+}
+
+// New serves search results as feeds at /feeds/rss and /feeds/atom, filtered by the query parameters or by a saved search.
+func New(p Params) Result {
+	return Result{
+		Option: builder{
+			dao:          p.Dao,
+			search:       p.Search,
+			searchConfig: p.SearchConfig,
+			trackerList:  p.TrackerList,
+			apiKeys:      p.APIKeys,
+			logger:       p.Logger.Named("feeds"),
+		},
+	}
+}
+
+type builder struct {
+	dao          lazy.Lazy[*dao.Query]
+	search       lazy.Lazy[search.Search]
+	searchConfig search.Config
+	trackerList  lazy.Lazy[torrentexport.TrackerList]
+	apiKeys      lazy.Lazy[apikey.Manager]
+	logger       *zap.SugaredLogger
+}
+
+func (builder) Key() string {
+	return "feeds"
+}
+
+func (b builder) Apply(e *gin.Engine) error {
+	d, err := b.dao.Get()
+	if err != nil {
+		return err
+	}
+	s, err := b.search.Get()
+	if err != nil {
+		return err
+	}
+	tl, err := b.trackerList.Get()
+	if err != nil {
+		return err
+	}
+	keys, err := b.apiKeys.Get()
+	if err != nil {
+		return err
+	}
+	h := handler{dao: d, search: s, searchConfig: b.searchConfig, trackerList: tl, apiKeys: keys, logger: b.logger}
+	e.GET("/feeds/rss", func(c *gin.Context) {
+		h.handle(c, "application/rss+xml", Feed.RSS)
+	})
+	e.GET("/feeds/atom", func(c *gin.Context) {
+		h.handle(c, "application/atom+xml", Feed.Atom)
+	})
+	return nil
+}
+
+type handler struct {
+	dao          *dao.Query
+	search       search.Search
+	searchConfig search.Config
+	trackerList  torrentexport.TrackerList
+	apiKeys      apikey.Manager
+	logger       *zap.SugaredLogger
+}
+
+func (h handler) handle(c *gin.Context, contentType string, render func(Feed) ([]byte, error)) {
+	if !h.authenticate(c) {
+		return
+	}
+	req, err := parseRequest(c.Request.URL.Query())
+	if err != nil {
+		c.String(http.StatusBadRequest, err.Error())
+		return
+	}
+	title := req.title()
+	filter := req.filter
+	if req.savedSearch != "" {
+		saved, err := h.findSavedSearch(c, req.savedSearch)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				c.String(http.StatusNotFound, "saved search not found")
+			} else {
+				h.logger.Errorw("failed to find saved search", "error", err)
+				c.Status(http.StatusInternalServerError)
+			}
+			return
+		}
+		title = "bitmagnet: " + saved.Name
+		filter = saved
+	}
+	option, err := savedsearch.Option(filter, h.searchConfig)
+	if err != nil {
+		c.String(http.StatusBadRequest, err.Error())
+		return
+	}
+	result, err := h.search.TorrentContent(c, option, query.Limit(req.limit))
+	if err != nil {
+		h.logger.Errorw("failed to search", "error", err)
+		c.Status(http.StatusInternalServerError)
+		return
+	}
+	body, err := render(newFeed(title, selfURL(c), result, h.trackerList.Trackers()))
+	if err != nil {
+		h.logger.Errorw("failed to render feed", "error", err)
+		c.Status(http.StatusInternalServerError)
+		return
+	}
+	c.Data(http.StatusOK, contentType+"; charset=utf-8", body)
+}
+
+// authenticate checks the torznab API key of a request that wasn't authenticated as a user, which the auth middleware
+// lets through so that feed readers that can't log in can use the feeds; it returns false if the request was rejected.
+func (h handler) authenticate(c *gin.Context) bool {
+	if _, ok := auth.UserFromContext(c.Request.Context()); ok {
+		return true
+	}
+	value := c.Query(paramAPIKey)
+	if value == "" {
+		return true
+	}
+	_, err := h.apiKeys.Authenticate(c, value)
+	switch {
+	case err == nil:
+		return true
+	case errors.Is(err, apikey.ErrMissingKey), errors.Is(err, apikey.ErrInvalidKey):
+		c.String(http.StatusUnauthorized, err.Error())
+	case errors.Is(err, apikey.ErrRateLimited):
+		c.Header("Retry-After", "60")
+		c.String(http.StatusTooManyRequests, err.Error())
+	default:
+		h.logger.Errorw("failed to authenticate", "error", err)
+		c.Status(http.StatusInternalServerError)
+	}
+	return false
+}
+
+// findSavedSearch looks up a saved search by its ID, or failing that, its name.
+func (h handler) findSavedSearch(c *gin.Context, idOrName string) (model.SavedSearch, error) {
+	q := h.dao.SavedSearch.WithContext(c)
+	if id, err := strconv.ParseInt(idOrName, 10, 64); err == nil {
+		q = q.Where(h.dao.SavedSearch.ID.Eq(id)).Or(h.dao.SavedSearch.Name.Eq(idOrName))
+	} else {
+		q = q.Where(h.dao.SavedSearch.Name.Eq(idOrName))
+	}
+	s, err := q.First()
+	if err != nil {
+		return model.SavedSearch{}, err
+	}
+	return *s, nil
+}
+
+func selfURL(c *gin.Context) string {
+	scheme := "http"
+	if c.Request.TLS != nil {
+		scheme = "https"
+	}
+	if forwarded := c.GetHeader("X-Forwarded-Proto"); forwarded != "" {
+		scheme = forwarded
+	}
+	return scheme + "://" + c.Request.Host + c.Request.URL.RequestURI()
+}
@@ -0,0 +1,114 @@
+package feed
+
+import (
+	"errors"
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	paramQuery       = "q"
+	paramOrderBy     = "order_by"
+	paramDesc        = "desc"
+	paramLimit       = "limit"
+	paramSavedSearch = "saved_search"
+	// paramAPIKey is a torznab API key, which authenticates feed readers that can't log in
+	paramAPIKey = "apikey"
+)
+
+const (
+	defaultLimit = 50
+	maxLimit     = 100
+)
+
+var errInvalidParam = errors.New("invalid parameter")
+
+type request struct {
+	// savedSearch is the ID or name of a saved search to render, in which case the other filter parameters are ignored.
+	savedSearch string
+	filter      model.SavedSearch
+	limit       uint
+}
+
+// parseRequest reads the feed's filter from the query parameters. Any parameter other than the reserved ones
+// is a facet filter, for example content_type=movie&video_resolution=V1080p,V2160p; repeated or comma separated values are ORed.
+func parseRequest(params url.Values) (request, error) {
+	r := request{
+		savedSearch: params.Get(paramSavedSearch),
+		filter: model.SavedSearch{
+			Facets: []model.SavedSearchFacet{},
+		},
+		limit: defaultLimit,
+	}
+	if q := params.Get(paramQuery); q != "" {
+		r.filter.QueryString = model.NewNullString(q)
+	}
+	if orderBy := params.Get(paramOrderBy); orderBy != "" {
+		parsed, err := model.ParseSavedSearchOrderBy(orderBy)
+		if err != nil {
+			return r, fmt.Errorf("%w: %s", errInvalidParam, paramOrderBy)
+		}
+		r.filter.OrderBy = parsed
+		r.filter.OrderDesc = true
+	}
+	if desc := params.Get(paramDesc); desc != "" {
+		parsed, err := strconv.ParseBool(desc)
+		if err != nil {
+			return r, fmt.Errorf("%w: %s", errInvalidParam, paramDesc)
+		}
+		r.filter.OrderDesc = parsed
+	}
+	if limit := params.Get(paramLimit); limit != "" {
+		parsed, err := strconv.ParseUint(limit, 10, 64)
+		if err != nil || parsed == 0 {
+			return r, fmt.Errorf("%w: %s", errInvalidParam, paramLimit)
+		}
+		r.limit = min(uint(parsed), maxLimit)
+	}
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		values := params[key]
+		switch key {
+		case paramQuery, paramOrderBy, paramDesc, paramLimit, paramSavedSearch, paramAPIKey:
+			continue
+		}
+		var facetValues []string
+		for _, v := range values {
+			for _, part := range strings.Split(v, ",") {
+				if part = strings.TrimSpace(part); part != "" {
+					facetValues = append(facetValues, part)
+				}
+			}
+		}
+		if len(facetValues) > 0 {
+			r.filter.Facets = append(r.filter.Facets, model.SavedSearchFacet{
+				Key:    key,
+				Logic:  model.FacetLogicOr,
+				Values: facetValues,
+			})
+		}
+	}
+	return r, nil
+}
+
+func (r request) title() string {
+	var parts []string
+	if r.filter.QueryString.Valid {
+		parts = append(parts, r.filter.QueryString.String)
+	}
+	for _, f := range r.filter.Facets {
+		parts = append(parts, f.Key+": "+strings.Join(f.Values, ", "))
+	}
+	if len(parts) == 0 {
+		return "bitmagnet"
+	}
+	return "bitmagnet: " + strings.Join(parts, "; ")
+}
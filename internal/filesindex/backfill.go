@@ -0,0 +1,65 @@
+package filesindex
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// batchSize is the number of files indexed in each transaction, which keeps the backfill of a large table from holding
+// locks for too long.
+const batchSize = 1000
+
+// backfiller indexes the paths of the files that were stored before files were indexed for full text search, with the
+// same tokenizer as new files.
+type backfiller struct {
+	db     *gorm.DB
+	logger *zap.SugaredLogger
+}
+
+// backfill indexes batches of unindexed files until there are none left.
+func (b backfiller) backfill(ctx context.Context) error {
+	total := 0
+	for {
+		n, err := b.backfillBatch(ctx)
+		if err != nil {
+			return err
+		}
+		total += n
+		if n < batchSize {
+			break
+		}
+		b.logger.Debugw("indexed files", "count", total)
+	}
+	if total > 0 {
+		b.logger.Infow("finished indexing files", "count", total)
+	}
+	return nil
+}
+
+func (b backfiller) backfillBatch(ctx context.Context) (int, error) {
+	var files []model.TorrentFile
+	err := b.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// rows locked by another process running the backfill are skipped
+		if err := tx.Raw(
+			"SELECT info_hash, index, path FROM torrent_files WHERE tsv IS NULL LIMIT ? FOR UPDATE SKIP LOCKED",
+			batchSize,
+		).Scan(&files).Error; err != nil {
+			return err
+		}
+		for i := range files {
+			files[i].UpdateTsv()
+			if err := tx.Exec(
+				"UPDATE torrent_files SET tsv = ?::tsvector WHERE info_hash = ? AND index = ?",
+				files[i].Tsv.String(),
+				files[i].InfoHash,
+				files[i].Index,
+			).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return len(files), err
+}
@@ -0,0 +1,54 @@
+package filesindex
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/worker"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type Params struct {
+	fx.In
+	DB     lazy.Lazy[*gorm.DB]
+	Logger *zap.SugaredLogger
+}
+
+type Result struct {
+	fx.Out
+	Worker worker.Worker `group:"workers"`
+}
+
+func New(p Params) Result {
+	logger := p.Logger.Named("files_index")
+	var cancel context.CancelFunc
+	return Result{
+		Worker: worker.NewWorker(
+			"files_index",
+			fx.Hook{
+				OnStart: func(context.Context) error {
+					db, err := p.DB.Get()
+					if err != nil {
+						return err
+					}
+					b := backfiller{db: db, logger: logger}
+					var ctx context.Context
+					ctx, cancel = context.WithCancel(context.Background())
+					go func() {
+						if err := b.backfill(ctx); err != nil && ctx.Err() == nil {
+							logger.Errorw("failed to index files", "error", err)
+						}
+					}()
+					return nil
+				},
+				OnStop: func(context.Context) error {
+					if cancel != nil {
+						cancel()
+					}
+					return nil
+				},
+			},
+		),
+	}
+}
@@ -0,0 +1,15 @@
+package filesindexfx
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/filesindex"
+	"go.uber.org/fx"
+)
+
+func New() fx.Option {
+	return fx.Module(
+		"files_index",
+		fx.Provide(
+			filesindex.New,
+		),
+	)
+}
@@ -22,9 +22,15 @@ var Enums = []enum{
 	newEnum("FileType", model.FileTypeNames()),
 	newEnum("FilesStatus", model.FilesStatusNames()),
 	newEnum("Language", model.LanguageValueStrings()),
+	newEnum("SavedSearchOrderBy", model.SavedSearchOrderByNames()),
+	newEnum("TakedownAction", model.TakedownActionNames()),
+	newEnum("TaskRunStatus", model.TaskRunStatusNames()),
+	newEnum("TorrentEventType", model.TorrentEventTypeNames()),
 	newEnum("Video3d", model.Video3dNames()),
 	newEnum("VideoCodec", model.VideoCodecNames()),
 	newEnum("VideoModifier", model.VideoModifierNames()),
 	newEnum("VideoResolution", model.VideoResolutionNames()),
 	newEnum("VideoSource", model.VideoSourceNames()),
+	newEnum("WebhookDeliveryStatus", model.WebhookDeliveryStatusNames()),
+	newEnum("WebhookEventType", model.WebhookEventTypeNames()),
 }
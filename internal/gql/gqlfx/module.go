@@ -2,25 +2,65 @@ package gqlfx
 
 import (
 	"github.com/99designs/gqlgen/graphql"
+	"github.com/bitmagnet-io/bitmagnet/internal/audit"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/config/configfx"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/healthcheck"
 	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/bitmagnet-io/bitmagnet/internal/classifier/video"
 	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
 	"github.com/bitmagnet-io/bitmagnet/internal/database/search"
+	"github.com/bitmagnet-io/bitmagnet/internal/dhtcrawler"
+	"github.com/bitmagnet-io/bitmagnet/internal/download"
+	"github.com/bitmagnet-io/bitmagnet/internal/events"
 	"github.com/bitmagnet-io/bitmagnet/internal/gql"
 	"github.com/bitmagnet-io/bitmagnet/internal/gql/config"
 	"github.com/bitmagnet-io/bitmagnet/internal/gql/httpserver"
 	"github.com/bitmagnet-io/bitmagnet/internal/gql/resolvers"
+	"github.com/bitmagnet-io/bitmagnet/internal/indexstats"
+	"github.com/bitmagnet-io/bitmagnet/internal/processor"
+	"github.com/bitmagnet-io/bitmagnet/internal/processor/asynq/purger"
+	"github.com/bitmagnet-io/bitmagnet/internal/processor/reprocess"
+	"github.com/bitmagnet-io/bitmagnet/internal/queue/deadletter"
+	"github.com/bitmagnet-io/bitmagnet/internal/queue/messages"
+	"github.com/bitmagnet-io/bitmagnet/internal/queue/publisher"
+	"github.com/bitmagnet-io/bitmagnet/internal/queue/stats"
+	"github.com/bitmagnet-io/bitmagnet/internal/savedsearch"
+	"github.com/bitmagnet-io/bitmagnet/internal/takedown"
+	"github.com/bitmagnet-io/bitmagnet/internal/torrentexport"
+	"github.com/bitmagnet-io/bitmagnet/internal/torznab/apikey"
+	"github.com/bitmagnet-io/bitmagnet/internal/watchlist"
 	"go.uber.org/fx"
 )
 
 func New() fx.Option {
 	return fx.Module(
 		"graphql",
+		configfx.NewConfigModule[httpserver.Config]("graphql", httpserver.NewDefaultConfig()),
 		fx.Provide(
 			config.New,
 			httpserver.New,
 			func(
 				ls lazy.Lazy[search.Search],
+				sc search.Config,
 				ld lazy.Lazy[*dao.Query],
+				lt lazy.Lazy[takedown.Manager],
+				ldl lazy.Lazy[deadletter.Manager],
+				lqm lazy.Lazy[messages.Manager],
+				lqs lazy.Lazy[stats.Reader],
+				lqp lazy.Lazy[purger.Purger],
+				lpp lazy.Lazy[publisher.Publisher[processor.MessageParams]],
+				leb lazy.Lazy[events.Bus],
+				lss lazy.Lazy[savedsearch.Manager],
+				lak lazy.Lazy[apikey.Manager],
+				ldm lazy.Lazy[download.Manager],
+				lar lazy.Lazy[audit.Recorder],
+				hc healthcheck.Checker,
+				lcf lazy.Lazy[video.CandidateFinder],
+				lrm lazy.Lazy[reprocess.Manager],
+				lwl lazy.Lazy[watchlist.Manager],
+				ltl lazy.Lazy[torrentexport.TrackerList],
+				lis lazy.Lazy[indexstats.Reader],
+				dcc dhtcrawler.Config,
 			) lazy.Lazy[gql.ResolverRoot] {
 				return lazy.New(func() (gql.ResolverRoot, error) {
 					s, err := ls.Get()
@@ -31,7 +71,71 @@ func New() fx.Option {
 					if err != nil {
 						return nil, err
 					}
-					return resolvers.New(d, s), nil
+					t, err := lt.Get()
+					if err != nil {
+						return nil, err
+					}
+					dl, err := ldl.Get()
+					if err != nil {
+						return nil, err
+					}
+					qm, err := lqm.Get()
+					if err != nil {
+						return nil, err
+					}
+					qs, err := lqs.Get()
+					if err != nil {
+						return nil, err
+					}
+					qp, err := lqp.Get()
+					if err != nil {
+						return nil, err
+					}
+					pp, err := lpp.Get()
+					if err != nil {
+						return nil, err
+					}
+					eb, err := leb.Get()
+					if err != nil {
+						return nil, err
+					}
+					ss, err := lss.Get()
+					if err != nil {
+						return nil, err
+					}
+					ak, err := lak.Get()
+					if err != nil {
+						return nil, err
+					}
+					dm, err := ldm.Get()
+					if err != nil {
+						return nil, err
+					}
+					ar, err := lar.Get()
+					if err != nil {
+						return nil, err
+					}
+					cf, err := lcf.Get()
+					if err != nil {
+						return nil, err
+					}
+					rm, err := lrm.Get()
+					if err != nil {
+						return nil, err
+					}
+					wl, err := lwl.Get()
+					if err != nil {
+						return nil, err
+					}
+					tl, err := ltl.Get()
+					if err != nil {
+						return nil, err
+					}
+					is, err := lis.Get()
+					if err != nil {
+						return nil, err
+					}
+					return resolvers.New(d, s, sc, t, dl, qm, qs, qp, pp, eb, ss, ak, dm, ar, hc, cf, rm, wl, tl, is, dcc.HealthHalfLife), nil
 				})
 			},
 			func(
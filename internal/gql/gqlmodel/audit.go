@@ -0,0 +1,38 @@
+package gqlmodel
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/audit"
+	"github.com/bitmagnet-io/bitmagnet/internal/auth"
+	"github.com/bitmagnet-io/bitmagnet/internal/gql/gqlmodel/gen"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+)
+
+type AuditQuery struct {
+	Recorder audit.Recorder
+}
+
+type AuditLogResult struct {
+	Items []model.AuditLog
+}
+
+func (a AuditQuery) Log(ctx context.Context, query *gen.AuditLogQueryInput) (AuditLogResult, error) {
+	if err := auth.Authorize(ctx, auth.PermissionAdmin); err != nil {
+		return AuditLogResult{}, err
+	}
+	params := audit.ListParams{Limit: defaultListLimit}
+	if query != nil {
+		if actor, ok := query.Actor.ValueOK(); ok && actor != nil {
+			params.Actor = *actor
+		}
+		if action, ok := query.Action.ValueOK(); ok && action != nil {
+			params.Action = *action
+		}
+		params.Limit, params.Offset = listLimitOffset(query.Limit, query.Offset)
+	}
+	items, err := a.Recorder.List(ctx, params)
+	if err != nil {
+		return AuditLogResult{}, err
+	}
+	return AuditLogResult{Items: items}, nil
+}
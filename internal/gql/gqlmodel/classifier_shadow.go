@@ -0,0 +1,68 @@
+package gqlmodel
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/gql/gqlmodel/gen"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"time"
+)
+
+type ClassifierShadowQuery struct {
+	Dao *dao.Query
+}
+
+type ClassifierShadowDivergencesResult struct {
+	Items []ClassifierShadowDivergence
+}
+
+type ClassifierShadowDivergence struct {
+	InfoHash  protocol.ID
+	Fields    []ClassifierShadowFieldDivergence
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type ClassifierShadowFieldDivergence struct {
+	Field  string
+	Active model.NullString
+	Shadow model.NullString
+}
+
+func (c ClassifierShadowQuery) Divergences(
+	ctx context.Context,
+	query *gen.ClassifierShadowDivergencesQueryInput,
+) (ClassifierShadowDivergencesResult, error) {
+	limit, offset := defaultListLimit, 0
+	if query != nil {
+		limit, offset = listLimitOffset(query.Limit, query.Offset)
+	}
+	divergences, err := c.Dao.ClassifierShadowDivergence.WithContext(ctx).Order(
+		c.Dao.ClassifierShadowDivergence.UpdatedAt.Desc(),
+	).Limit(limit).Offset(offset).Find()
+	if err != nil {
+		return ClassifierShadowDivergencesResult{}, err
+	}
+	items := make([]ClassifierShadowDivergence, 0, len(divergences))
+	for _, d := range divergences {
+		fields := make([]ClassifierShadowFieldDivergence, 0, len(d.Fields))
+		for _, f := range d.Fields {
+			fd := ClassifierShadowFieldDivergence{Field: f}
+			if v, ok := d.Active[f]; ok {
+				fd.Active = model.NewNullString(v)
+			}
+			if v, ok := d.Shadow[f]; ok {
+				fd.Shadow = model.NewNullString(v)
+			}
+			fields = append(fields, fd)
+		}
+		items = append(items, ClassifierShadowDivergence{
+			InfoHash:  d.InfoHash,
+			Fields:    fields,
+			CreatedAt: d.CreatedAt,
+			UpdatedAt: d.UpdatedAt,
+		})
+	}
+	return ClassifierShadowDivergencesResult{Items: items}, nil
+}
@@ -0,0 +1,221 @@
+package gqlmodel
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/query"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/search"
+	"github.com/bitmagnet-io/bitmagnet/internal/gql/gqlmodel/gen"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"gorm.io/gen/field"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+const (
+	collectionsDefaultLimit = 100
+	collectionsMaxLimit     = 1000
+)
+
+type ContentCollectionTypeInfo struct {
+	Type  string
+	Count int
+}
+
+func (c ContentQuery) CollectionTypes(ctx context.Context) ([]ContentCollectionTypeInfo, error) {
+	var items []ContentCollectionTypeInfo
+	if err := c.Dao.ContentCollection.WithContext(ctx).Select(
+		c.Dao.ContentCollection.Type,
+		c.Dao.ContentCollection.ID.Count().As("count"),
+	).Group(
+		c.Dao.ContentCollection.Type,
+	).Order(
+		c.Dao.ContentCollection.Type,
+	).Scan(&items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+type ContentCollectionsResult struct {
+	TotalCount int
+	Items      []ContentCollectionCount
+}
+
+type ContentCollectionCount struct {
+	Collection   model.ContentCollection
+	ContentCount int
+}
+
+func (c ContentQuery) Collections(ctx context.Context, query *gen.ContentCollectionsQueryInput) (ContentCollectionsResult, error) {
+	cc := c.Dao.ContentCollection
+	ccc := c.Dao.ContentCollectionContent
+	q := cc.WithContext(ctx)
+	limit, offset := collectionsDefaultLimit, 0
+	if query != nil {
+		if types, ok := query.Types.ValueOK(); ok && len(types) > 0 {
+			q = q.Where(cc.Type.In(types...))
+		}
+		if sources, ok := query.Sources.ValueOK(); ok && len(sources) > 0 {
+			q = q.Where(cc.Source.In(sources...))
+		}
+		if name, ok := query.Name.ValueOK(); ok && name != nil && *name != "" {
+			q = q.Where(c.Dao.RawCondition(
+				"? ILIKE ?",
+				clause.Column{Table: cc.TableName(), Name: string(cc.Name.ColumnName())},
+				"%"+*name+"%",
+			))
+		}
+		limit, offset = listLimitOffset(query.Limit, query.Offset)
+	}
+	totalCount, err := q.Count()
+	if err != nil {
+		return ContentCollectionsResult{}, err
+	}
+	var rows []struct {
+		Type         string
+		Source       string
+		ID           string
+		Name         string
+		CreatedAt    time.Time
+		UpdatedAt    time.Time
+		ContentCount int
+	}
+	if err := q.Select(
+		cc.ALL,
+		ccc.ContentID.Count().As("content_count"),
+	).LeftJoin(
+		ccc,
+		ccc.ContentCollectionType.EqCol(cc.Type),
+		ccc.ContentCollectionSource.EqCol(cc.Source),
+		ccc.ContentCollectionID.EqCol(cc.ID),
+	).Group(
+		cc.Type, cc.Source, cc.ID,
+	).Order(
+		field.NewField("", "content_count").Desc(),
+		cc.Name,
+	).Limit(limit).Offset(offset).Scan(&rows); err != nil {
+		return ContentCollectionsResult{}, err
+	}
+	sources, err := c.Dao.MetadataSource.WithContext(ctx).Find()
+	if err != nil {
+		return ContentCollectionsResult{}, err
+	}
+	sourceMap := make(map[string]model.MetadataSource, len(sources))
+	for _, s := range sources {
+		sourceMap[s.Key] = *s
+	}
+	items := make([]ContentCollectionCount, 0, len(rows))
+	for _, r := range rows {
+		items = append(items, ContentCollectionCount{
+			Collection: model.ContentCollection{
+				Type:           r.Type,
+				Source:         r.Source,
+				ID:             r.ID,
+				Name:           r.Name,
+				CreatedAt:      r.CreatedAt,
+				UpdatedAt:      r.UpdatedAt,
+				MetadataSource: sourceMap[r.Source],
+			},
+			ContentCount: r.ContentCount,
+		})
+	}
+	return ContentCollectionsResult{
+		TotalCount: int(totalCount),
+		Items:      items,
+	}, nil
+}
+
+type ContentCollectionContentResult struct {
+	TotalCount uint
+	Items      []model.Content
+}
+
+func (c ContentQuery) CollectionContent(
+	ctx context.Context,
+	collection gen.ContentCollectionRefInput,
+	limit *int,
+	offset *int,
+) (ContentCollectionContentResult, error) {
+	l, o := collectionsLimitOffset(limit, offset)
+	result, err := c.Search.Content(
+		ctx,
+		query.Where(search.ContentInCollectionCriteria(collectionRef(collection))),
+		query.OrderBy(
+			clause.OrderByColumn{
+				Column: clause.Column{Table: clause.CurrentTable, Name: "release_date"},
+			},
+			clause.OrderByColumn{
+				Column: clause.Column{Table: clause.CurrentTable, Name: "title"},
+			},
+		),
+		query.Limit(uint(l)),
+		query.Offset(uint(o)),
+		query.WithTotalCount(true),
+		search.ContentDefaultPreload(),
+		search.ContentDefaultHydrate(),
+	)
+	if err != nil {
+		return ContentCollectionContentResult{}, err
+	}
+	items := make([]model.Content, 0, len(result.Items))
+	for _, item := range result.Items {
+		items = append(items, item.Content)
+	}
+	return ContentCollectionContentResult{
+		TotalCount: result.TotalCount,
+		Items:      items,
+	}, nil
+}
+
+type ContentCollectionTorrentsResult struct {
+	TotalCount uint
+	Items      []TorrentContent
+}
+
+func (c ContentQuery) CollectionTorrents(
+	ctx context.Context,
+	collection gen.ContentCollectionRefInput,
+	limit *int,
+	offset *int,
+) (ContentCollectionTorrentsResult, error) {
+	l, o := collectionsLimitOffset(limit, offset)
+	result, err := c.Search.TorrentContent(
+		ctx,
+		search.TorrentContentDefaultOption(),
+		query.Where(search.ContentCollectionCriteria(collectionRef(collection))),
+		query.Where(search.TorrentContentQuarantinedCriteria(false)),
+		query.Limit(uint(l)),
+		query.Offset(uint(o)),
+		query.WithTotalCount(true),
+	)
+	if err != nil {
+		return ContentCollectionTorrentsResult{}, err
+	}
+	items := make([]TorrentContent, 0, len(result.Items))
+	for _, item := range result.Items {
+		items = append(items, NewTorrentContentFromResultItem(item))
+	}
+	return ContentCollectionTorrentsResult{
+		TotalCount: result.TotalCount,
+		Items:      items,
+	}, nil
+}
+
+func collectionRef(input gen.ContentCollectionRefInput) model.ContentCollectionRef {
+	return model.ContentCollectionRef{
+		Type:   input.Type,
+		Source: input.Source,
+		ID:     input.ID,
+	}
+}
+
+func collectionsLimitOffset(limit, offset *int) (int, int) {
+	l, o := collectionsDefaultLimit, 0
+	if limit != nil && *limit > 0 {
+		l = min(*limit, collectionsMaxLimit)
+	}
+	if offset != nil && *offset > 0 {
+		o = *offset
+	}
+	return l, o
+}
@@ -0,0 +1,143 @@
+package gqlmodel
+
+import (
+	"context"
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/coverage"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/query"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/search"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/watchlist"
+	"slices"
+)
+
+const contentCoverageMaxIdentifiers = 10000
+
+type ContentQuery struct {
+	Dao       *dao.Query
+	Search    search.Search
+	Watchlist watchlist.Manager
+}
+
+type ContentCoverageResult struct {
+	Total   int
+	Covered int
+	Items   []ContentCoverageItem
+}
+
+type ContentCoverageItem struct {
+	Identifier          string
+	Covered             bool
+	Content             []model.Content
+	TorrentCount        uint
+	BestVideoResolution model.NullVideoResolution
+	VideoResolutions    []model.VideoResolution
+}
+
+func (c ContentQuery) MetadataSources(ctx context.Context) ([]SourceInfo, error) {
+	return listMetadataSources(ctx, c.Dao)
+}
+
+func (c ContentQuery) Coverage(ctx context.Context, identifiers []string) (ContentCoverageResult, error) {
+	if len(identifiers) > contentCoverageMaxIdentifiers {
+		return ContentCoverageResult{}, fmt.Errorf("too many identifiers: %d (max %d)", len(identifiers), contentCoverageMaxIdentifiers)
+	}
+	refs := make([]model.ContentRef, 0, len(identifiers))
+	for _, id := range identifiers {
+		ref, err := model.ParseContentRef(id)
+		if err != nil {
+			return ContentCoverageResult{}, err
+		}
+		refs = append(refs, ref)
+	}
+	report, err := coverage.NewReporter(c.Dao, c.Search).Report(ctx, refs)
+	if err != nil {
+		return ContentCoverageResult{}, err
+	}
+	items := make([]ContentCoverageItem, 0, len(report.Items))
+	for i, item := range report.Items {
+		items = append(items, ContentCoverageItem{
+			Identifier:          identifiers[i],
+			Covered:             item.Covered(),
+			Content:             item.Contents,
+			TorrentCount:        item.TorrentCount,
+			BestVideoResolution: item.BestResolution,
+			VideoResolutions:    item.VideoResolutions,
+		})
+	}
+	return ContentCoverageResult{
+		Total:   len(items),
+		Covered: report.CoveredCount(),
+		Items:   items,
+	}, nil
+}
+
+const (
+	contentReleasesMaxIdentifiers = 100
+	// contentReleasesMaxRanked is the number of the most recently updated torrents of each identifier that are ranked.
+	contentReleasesMaxRanked = 1000
+	contentReleasesMaxItems  = 100
+)
+
+type ContentReleases struct {
+	Content      model.Content
+	ReleaseCount int
+	Best         TorrentContent
+	Releases     []TorrentContent
+}
+
+func (c ContentQuery) Releases(ctx context.Context, identifiers []string) ([]ContentReleases, error) {
+	if len(identifiers) > contentReleasesMaxIdentifiers {
+		return nil, fmt.Errorf("too many identifiers: %d (max %d)", len(identifiers), contentReleasesMaxIdentifiers)
+	}
+	var groups []ContentReleases
+	seen := make(map[model.ContentRef]struct{})
+	for _, id := range identifiers {
+		ref, err := model.ParseContentRef(id)
+		if err != nil {
+			return nil, err
+		}
+		result, err := c.Search.TorrentContent(
+			ctx,
+			search.TorrentContentDefaultOption(),
+			query.Where(search.ContentIdentifierCriteria(ref)),
+			query.Where(search.TorrentContentQuarantinedCriteria(false)),
+			query.Limit(contentReleasesMaxRanked),
+		)
+		if err != nil {
+			return nil, err
+		}
+		// a typeless identifier can match more than one content item, which are grouped separately
+		var refs []model.ContentRef
+		byContent := make(map[model.ContentRef][]search.TorrentContentResultItem)
+		for _, item := range result.Items {
+			contentRef := item.Content.Ref()
+			if _, ok := seen[contentRef]; ok {
+				continue
+			}
+			if _, ok := byContent[contentRef]; !ok {
+				refs = append(refs, contentRef)
+			}
+			byContent[contentRef] = append(byContent[contentRef], item)
+		}
+		for _, contentRef := range refs {
+			seen[contentRef] = struct{}{}
+			items := byContent[contentRef]
+			slices.SortFunc(items, func(a, b search.TorrentContentResultItem) int {
+				return search.CompareReleases(a.TorrentContent, b.TorrentContent)
+			})
+			releases := make([]TorrentContent, 0, min(len(items), contentReleasesMaxItems))
+			for _, item := range items[:min(len(items), contentReleasesMaxItems)] {
+				releases = append(releases, NewTorrentContentFromResultItem(item))
+			}
+			groups = append(groups, ContentReleases{
+				Content:      items[0].Content,
+				ReleaseCount: len(items),
+				Best:         releases[0],
+				Releases:     releases,
+			})
+		}
+	}
+	return groups, nil
+}
@@ -0,0 +1,116 @@
+package gqlmodel
+
+import (
+	"context"
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/query"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/search"
+	"github.com/bitmagnet-io/bitmagnet/internal/gql/gqlmodel/gen"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/processor"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"github.com/bitmagnet-io/bitmagnet/internal/queue/publisher"
+	"github.com/bitmagnet-io/bitmagnet/internal/watchlist"
+	"time"
+)
+
+type ContentFlags struct {
+	Content      model.Content
+	Watching     bool
+	Ignored      bool
+	BestInfoHash *protocol.ID
+	UpdatedAt    time.Time
+}
+
+func (c ContentQuery) Flagged(ctx context.Context, watching *bool, ignored *bool) ([]ContentFlags, error) {
+	flags, err := c.Watchlist.List(ctx, watching, ignored)
+	if err != nil {
+		return nil, err
+	}
+	return newContentFlags(ctx, c.Search, flags...)
+}
+
+type ContentMutation struct {
+	Dao                *dao.Query
+	Search             search.Search
+	Watchlist          watchlist.Manager
+	ProcessorPublisher publisher.Publisher[processor.MessageParams]
+}
+
+func (c ContentMutation) SetFlags(ctx context.Context, input gen.ContentSetFlagsInput) (ContentFlags, error) {
+	ref := model.ContentRef{
+		Type:   input.Type,
+		Source: input.Source,
+		ID:     input.ID,
+	}
+	contents, err := loadContents(ctx, c.Search, ref)
+	if err != nil {
+		return ContentFlags{}, err
+	}
+	if _, ok := contents[ref]; !ok {
+		return ContentFlags{}, fmt.Errorf("content not found: %s:%s:%s", ref.Type, ref.Source, ref.ID)
+	}
+	watching, _ := input.Watching.ValueOK()
+	ignored, _ := input.Ignored.ValueOK()
+	flag, err := c.Watchlist.Set(ctx, ref, watching, ignored)
+	if err != nil {
+		return ContentFlags{}, err
+	}
+	return newContentFlag(flag, contents[ref]), nil
+}
+
+func newContentFlags(ctx context.Context, s search.Search, flags ...model.ContentFlag) ([]ContentFlags, error) {
+	refs := make([]model.ContentRef, 0, len(flags))
+	for _, f := range flags {
+		refs = append(refs, contentFlagRef(f))
+	}
+	contents, err := loadContents(ctx, s, refs...)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]ContentFlags, 0, len(flags))
+	for _, f := range flags {
+		result = append(result, newContentFlag(f, contents[contentFlagRef(f)]))
+	}
+	return result, nil
+}
+
+func newContentFlag(f model.ContentFlag, content model.Content) ContentFlags {
+	return ContentFlags{
+		Content:      content,
+		Watching:     f.Watching,
+		Ignored:      f.Ignored,
+		BestInfoHash: f.BestInfoHash,
+		UpdatedAt:    f.UpdatedAt,
+	}
+}
+
+func contentFlagRef(f model.ContentFlag) model.ContentRef {
+	return model.ContentRef{
+		Type:   f.ContentType,
+		Source: f.ContentSource,
+		ID:     f.ContentID,
+	}
+}
+
+func loadContents(ctx context.Context, s search.Search, refs ...model.ContentRef) (map[model.ContentRef]model.Content, error) {
+	contents := make(map[model.ContentRef]model.Content, len(refs))
+	if len(refs) == 0 {
+		return contents, nil
+	}
+	result, err := s.Content(
+		ctx,
+		query.Where(search.ContentCanonicalIdentifierCriteria(refs...)),
+		query.Limit(uint(len(refs))),
+		search.ContentDefaultPreload(),
+		search.ContentDefaultHydrate(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range result.Items {
+		contents[item.Content.Ref()] = item.Content
+	}
+	return contents, nil
+}
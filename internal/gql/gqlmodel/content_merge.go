@@ -0,0 +1,48 @@
+package gqlmodel
+
+import (
+	"context"
+	"errors"
+	"github.com/bitmagnet-io/bitmagnet/internal/auth"
+	"github.com/bitmagnet-io/bitmagnet/internal/gql/gqlmodel/gen"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/processor"
+	"github.com/hibiken/asynq"
+)
+
+// Merge merges a duplicate content item into another, and reprocesses the torrents that were matched to it so that
+// their search vectors include the merged content.
+func (c ContentMutation) Merge(ctx context.Context, input gen.ContentMergeInput) (model.Content, error) {
+	if err := auth.Authorize(ctx, auth.PermissionAdmin); err != nil {
+		return model.Content{}, err
+	}
+	into := model.ContentRef{
+		Type:   input.Type,
+		Source: input.IntoSource,
+		ID:     input.IntoID,
+	}
+	infoHashes, err := c.Dao.MergeContent(ctx, model.ContentRef{
+		Type:   input.Type,
+		Source: input.Source,
+		ID:     input.ID,
+	}, into)
+	if err != nil {
+		return model.Content{}, err
+	}
+	contents, err := loadContents(ctx, c.Search, into)
+	if err != nil {
+		return model.Content{}, err
+	}
+	content := contents[into]
+	if len(infoHashes) > 0 {
+		// the existing matches, now of the merged content, are kept
+		if _, err := c.ProcessorPublisher.Publish(ctx, processor.MessageParams{
+			ClassifyMode: processor.ClassifyModeDefault,
+			InfoHashes:   infoHashes,
+			Priority:     processor.MessagePriorityInteractive,
+		}); err != nil && !errors.Is(err, asynq.ErrDuplicateTask) {
+			return model.Content{}, err
+		}
+	}
+	return content, nil
+}
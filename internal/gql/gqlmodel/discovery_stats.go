@@ -0,0 +1,40 @@
+package gqlmodel
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/gql/gqlmodel/gen"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"time"
+)
+
+type TorrentDiscoveryStat struct {
+	Source          string
+	DiscoveryMethod model.NullDiscoveryMethod
+	Bucket          time.Time
+	Count           int
+}
+
+// DiscoveryStats counts the torrent sources created since the given time, by source, discovery method and time bucket.
+// A source is created when a torrent is first discovered by it, so the counts are of newly discovered torrents.
+func (t TorrentQuery) DiscoveryStats(ctx context.Context, input gen.TorrentDiscoveryStatsInput) ([]TorrentDiscoveryStat, error) {
+	bucket := gen.TorrentDiscoveryStatsBucketDay
+	if b, ok := input.Bucket.ValueOK(); ok && b != nil && b.IsValid() {
+		bucket = *b
+	}
+	db := t.Dao.TorrentsTorrentSource.WithContext(ctx).UnderlyingDB().Select(
+		"source, discovery_method, date_trunc(?, created_at) as bucket, count(*) as count",
+		bucket.String(),
+	).Where("created_at >= ?", input.Since)
+	if sources, ok := input.Sources.ValueOK(); ok && len(sources) > 0 {
+		db = db.Where("source in ?", sources)
+	}
+	var stats []TorrentDiscoveryStat
+	if err := db.Group(
+		"source, discovery_method, bucket",
+	).Order(
+		"bucket, source, discovery_method",
+	).Scan(&stats).Error; err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
@@ -0,0 +1,41 @@
+package gqlmodel
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/download"
+	"github.com/bitmagnet-io/bitmagnet/internal/gql/gqlmodel/gen"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+)
+
+type DownloadQuery struct {
+	Manager download.Manager
+}
+
+func (d DownloadQuery) Clients() []string {
+	return d.Manager.Clients()
+}
+
+func (d DownloadQuery) List(ctx context.Context, infoHashes []protocol.ID) ([]model.TorrentDownload, error) {
+	return d.Manager.List(ctx, infoHashes...)
+}
+
+type DownloadMutation struct {
+	Manager download.Manager
+}
+
+func (d DownloadMutation) Send(ctx context.Context, input gen.DownloadSendInput) ([]model.TorrentDownload, error) {
+	params := download.SendParams{
+		InfoHashes: input.InfoHashes,
+	}
+	if c, ok := input.Client.ValueOK(); ok && c != nil {
+		params.Client = *c
+	}
+	if c, ok := input.Category.ValueOK(); ok && c != nil {
+		params.Category = *c
+	}
+	if p, ok := input.SavePath.ValueOK(); ok && p != nil {
+		params.SavePath = *p
+	}
+	return d.Manager.Send(ctx, params)
+}
@@ -134,6 +134,49 @@ func videoSourceFacet(input gen.VideoSourceFacetInput) q.Facet {
 	return facet(input.Aggregate, graphql.Omittable[*model.FacetLogic]{}, input.Filter, search.VideoSourceFacet)
 }
 
+func videoCodecFacet(input gen.VideoCodecFacetInput) q.Facet {
+	return facet(input.Aggregate, graphql.Omittable[*model.FacetLogic]{}, input.Filter, search.VideoCodecFacet)
+}
+
+func hdrFormatFacet(input gen.HdrFormatFacetInput) q.Facet {
+	var filter graphql.Omittable[[]*model.HdrFormat]
+	if f, ok := input.Filter.ValueOK(); ok {
+		filterValues := make([]*model.HdrFormat, 0, len(f))
+		for _, v := range f {
+			vv := v
+			filterValues = append(filterValues, &vv)
+		}
+		filter = graphql.OmittableOf[[]*model.HdrFormat](filterValues)
+	}
+	return facet(input.Aggregate, graphql.Omittable[*model.FacetLogic]{}, filter, search.HdrFormatFacet)
+}
+
+func audioFormatFacet(input gen.AudioFormatFacetInput) q.Facet {
+	var filter graphql.Omittable[[]*model.AudioFormat]
+	if f, ok := input.Filter.ValueOK(); ok {
+		filterValues := make([]*model.AudioFormat, 0, len(f))
+		for _, v := range f {
+			vv := v
+			filterValues = append(filterValues, &vv)
+		}
+		filter = graphql.OmittableOf[[]*model.AudioFormat](filterValues)
+	}
+	return facet(input.Aggregate, graphql.Omittable[*model.FacetLogic]{}, filter, search.AudioFormatFacet)
+}
+
+func releaseTokenFacet(input gen.ReleaseTokenFacetInput) q.Facet {
+	var filter graphql.Omittable[[]*string]
+	if f, ok := input.Filter.ValueOK(); ok {
+		filterValues := make([]*string, 0, len(f))
+		for _, v := range f {
+			vv := v
+			filterValues = append(filterValues, &vv)
+		}
+		filter = graphql.OmittableOf[[]*string](filterValues)
+	}
+	return facet(input.Aggregate, input.Logic, filter, search.TorrentContentReleaseTokenFacet)
+}
+
 func aggs[T any, Agg comparable](
 	items q.AggregationItems,
 	parse func(string) (T, error),
@@ -220,3 +263,27 @@ func videoSourceAggs(items q.AggregationItems) ([]gen.VideoSourceAgg, error) {
 		return gen.VideoSourceAgg{Value: value, Label: label, Count: int(count)}
 	})
 }
+
+func videoCodecAggs(items q.AggregationItems) ([]gen.VideoCodecAgg, error) {
+	return aggs(items, model.ParseVideoCodec, func(value *model.VideoCodec, label string, count uint) gen.VideoCodecAgg {
+		return gen.VideoCodecAgg{Value: value, Label: label, Count: int(count)}
+	})
+}
+
+func hdrFormatAggs(items q.AggregationItems) ([]gen.HdrFormatAgg, error) {
+	return aggs(items, model.ParseHdrFormat, func(value *model.HdrFormat, label string, count uint) gen.HdrFormatAgg {
+		return gen.HdrFormatAgg{Value: *value, Label: label, Count: int(count)}
+	})
+}
+
+func audioFormatAggs(items q.AggregationItems) ([]gen.AudioFormatAgg, error) {
+	return aggs(items, model.ParseAudioFormat, func(value *model.AudioFormat, label string, count uint) gen.AudioFormatAgg {
+		return gen.AudioFormatAgg{Value: *value, Label: label, Count: int(count)}
+	})
+}
+
+func releaseTokenAggs(items q.AggregationItems) ([]gen.ReleaseTokenAgg, error) {
+	return aggs(items, func(s string) (string, error) { return s, nil }, func(value *string, label string, count uint) gen.ReleaseTokenAgg {
+		return gen.ReleaseTokenAgg{Value: *value, Label: label, Count: int(count)}
+	})
+}
@@ -0,0 +1,114 @@
+package gqlmodel
+
+import (
+	"errors"
+	"fmt"
+	q "github.com/bitmagnet-io/bitmagnet/internal/database/query"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/search"
+	"github.com/bitmagnet-io/bitmagnet/internal/gql/gqlmodel/gen"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+)
+
+const maxFilterDepth = 10
+
+var ErrEmptyFilter = errors.New("filter has no conditions")
+
+// torrentContentFilterCriteria converts a filter input to criteria; the conditions of a single filter are ANDed,
+// and nested filters are combined according to the and, or and not fields.
+func torrentContentFilterCriteria(input gen.TorrentContentFilterInput, depth int) (q.Criteria, error) {
+	if depth >= maxFilterDepth {
+		return nil, fmt.Errorf("filter exceeds the maximum depth of %d", maxFilterDepth)
+	}
+	var criteria []q.Criteria
+	if queryString, ok := input.QueryString.ValueOK(); ok && queryString != nil && *queryString != "" {
+		criteria = append(criteria, q.QueryStringCriteria(*queryString))
+	}
+	if infoHashes, ok := input.InfoHash.ValueOK(); ok && len(infoHashes) > 0 {
+		criteria = append(criteria, search.TorrentInfoHashCriteria(infoHashes...))
+	}
+	if facets, ok := input.Facets.ValueOK(); ok && facets != nil {
+		for _, facet := range torrentContentFacets(*facets) {
+			if len(facet.Filter()) > 0 {
+				criteria = append(criteria, q.FacetFilterCriteria(facet))
+			}
+		}
+	}
+	if bestRelease, ok := input.BestRelease.ValueOK(); ok && bestRelease != nil && *bestRelease {
+		criteria = append(criteria, search.BestReleaseCriteria())
+	}
+	if languages, ok := input.Languages.ValueOK(); ok && len(languages) > 0 {
+		criteria = append(criteria, search.TorrentContentLanguageCriteria(languages...))
+	}
+	if subtitleLanguages, ok := input.SubtitleLanguages.ValueOK(); ok && len(subtitleLanguages) > 0 {
+		criteria = append(criteria, search.TorrentContentSubtitleLanguageCriteria(subtitleLanguages...))
+	}
+	if subtitled, ok := input.Subtitled.ValueOK(); ok && subtitled != nil {
+		criteria = append(criteria, search.TorrentContentSubtitledCriteria(*subtitled))
+	}
+	if multiAudio, ok := input.MultiAudio.ValueOK(); ok && multiAudio != nil {
+		criteria = append(criteria, search.TorrentContentMultiAudioCriteria(*multiAudio))
+	}
+	if probablyFake, ok := input.ProbablyFake.ValueOK(); ok && probablyFake != nil {
+		criteria = append(criteria, search.TorrentContentProbablyFakeCriteria(*probablyFake))
+	}
+	if maxReports, ok := input.MaxReports.ValueOK(); ok && maxReports != nil {
+		if *maxReports < 0 {
+			return nil, errors.New("max reports must not be negative")
+		}
+		criteria = append(criteria, search.TorrentContentMaxReportsCriteria(uint(*maxReports)))
+	}
+	if quarantined, ok := input.Quarantined.ValueOK(); ok && quarantined != nil {
+		criteria = append(criteria, search.TorrentContentQuarantinedCriteria(*quarantined))
+	}
+	if airDate, ok := input.AirDate.ValueOK(); ok && airDate != nil && *airDate != "" {
+		dateRange, err := model.NewDateRangeFromString(*airDate)
+		if err != nil {
+			return nil, err
+		}
+		criteria = append(criteria, search.TorrentContentAirDateCriteria(dateRange))
+	}
+	if person, ok := input.Person.ValueOK(); ok && person != nil {
+		if person.Name == "" {
+			return nil, errors.New("person name must not be empty")
+		}
+		f := search.PersonFilter{Name: person.Name}
+		if role, ok := person.Role.ValueOK(); ok && role != nil {
+			f.Role = model.NullContentPersonRole{ContentPersonRole: *role, Valid: true}
+		}
+		if job, ok := person.Job.ValueOK(); ok && job != nil {
+			f.Job = *job
+		}
+		criteria = append(criteria, search.TorrentContentPersonCriteria(f))
+	}
+	if and, ok := input.And.ValueOK(); ok {
+		for _, sub := range and {
+			c, err := torrentContentFilterCriteria(sub, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			criteria = append(criteria, c)
+		}
+	}
+	if or, ok := input.Or.ValueOK(); ok && len(or) > 0 {
+		subCriteria := make([]q.Criteria, 0, len(or))
+		for _, sub := range or {
+			c, err := torrentContentFilterCriteria(sub, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			subCriteria = append(subCriteria, c)
+		}
+		criteria = append(criteria, q.Or(subCriteria...))
+	}
+	if not, ok := input.Not.ValueOK(); ok && not != nil {
+		c, err := torrentContentFilterCriteria(*not, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		criteria = append(criteria, q.Not(c))
+	}
+	if len(criteria) == 0 {
+		return nil, ErrEmptyFilter
+	}
+	return q.And(criteria...), nil
+}
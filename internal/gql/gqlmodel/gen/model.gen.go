@@ -3,10 +3,85 @@
 package gen
 
 import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"github.com/bitmagnet-io/bitmagnet/internal/queue/messages"
 )
 
+type AudioFormatAgg struct {
+	Value model.AudioFormat `json:"value"`
+	Label string            `json:"label"`
+	Count int               `json:"count"`
+}
+
+type AudioFormatFacetInput struct {
+	Aggregate graphql.Omittable[*bool]               `json:"aggregate,omitempty"`
+	Filter    graphql.Omittable[[]model.AudioFormat] `json:"filter,omitempty"`
+}
+
+type AuditLogQueryInput struct {
+	Actor graphql.Omittable[*string] `json:"actor,omitempty"`
+	// the action name, such as torrent.delete or import
+	Action graphql.Omittable[*string] `json:"action,omitempty"`
+	// defaults to 100, capped at 1000
+	Limit  graphql.Omittable[*int] `json:"limit,omitempty"`
+	Offset graphql.Omittable[*int] `json:"offset,omitempty"`
+}
+
+type ClassifierShadowDivergencesQueryInput struct {
+	// defaults to 100, capped at 1000
+	Limit  graphql.Omittable[*int] `json:"limit,omitempty"`
+	Offset graphql.Omittable[*int] `json:"offset,omitempty"`
+}
+
+type ContentCollectionRefInput struct {
+	Type   string `json:"type"`
+	Source string `json:"source"`
+	ID     string `json:"id"`
+}
+
+type ContentCollectionsQueryInput struct {
+	Types   graphql.Omittable[[]string] `json:"types,omitempty"`
+	Sources graphql.Omittable[[]string] `json:"sources,omitempty"`
+	// matches collections with names containing this, ignoring case
+	Name graphql.Omittable[*string] `json:"name,omitempty"`
+	// defaults to 100, capped at 1000
+	Limit  graphql.Omittable[*int] `json:"limit,omitempty"`
+	Offset graphql.Omittable[*int] `json:"offset,omitempty"`
+}
+
+type ContentMergeInput struct {
+	Type model.ContentType `json:"type"`
+	// the source and ID of the duplicate content, which is deleted
+	Source string `json:"source"`
+	ID     string `json:"id"`
+	// the source and ID of the content that the duplicate is merged into
+	IntoSource string `json:"intoSource"`
+	IntoID     string `json:"intoId"`
+}
+
+type ContentSetFlagsInput struct {
+	Type     model.ContentType        `json:"type"`
+	Source   string                   `json:"source"`
+	ID       string                   `json:"id"`
+	Watching graphql.Omittable[*bool] `json:"watching,omitempty"`
+	Ignored  graphql.Omittable[*bool] `json:"ignored,omitempty"`
+}
+
+type ContentTrendingInput struct {
+	ContentType graphql.Omittable[*model.ContentType] `json:"contentType,omitempty"`
+	// the sliding window in hours, defaults to 168 (a week)
+	WindowHours graphql.Omittable[*int] `json:"windowHours,omitempty"`
+	// defaults to 20, capped at 100
+	Limit graphql.Omittable[*int] `json:"limit,omitempty"`
+}
+
 type ContentTypeAgg struct {
 	Value *model.ContentType `json:"value,omitempty"`
 	Label string             `json:"label"`
@@ -18,6 +93,16 @@ type ContentTypeFacetInput struct {
 	Filter    graphql.Omittable[[]*model.ContentType] `json:"filter,omitempty"`
 }
 
+type DownloadSendInput struct {
+	InfoHashes []protocol.ID `json:"infoHashes"`
+	// the name of the client to send to; the default client is used if null
+	Client graphql.Omittable[*string] `json:"client,omitempty"`
+	// overrides the client's configured category (a label in Transmission)
+	Category graphql.Omittable[*string] `json:"category,omitempty"`
+	// overrides the client's configured save path
+	SavePath graphql.Omittable[*string] `json:"savePath,omitempty"`
+}
+
 type GenreAgg struct {
 	Value string `json:"value"`
 	Label string `json:"label"`
@@ -30,6 +115,27 @@ type GenreFacetInput struct {
 	Filter    graphql.Omittable[[]string]          `json:"filter,omitempty"`
 }
 
+type HdrFormatAgg struct {
+	Value model.HdrFormat `json:"value"`
+	Label string          `json:"label"`
+	Count int             `json:"count"`
+}
+
+type HdrFormatFacetInput struct {
+	Aggregate graphql.Omittable[*bool]             `json:"aggregate,omitempty"`
+	Filter    graphql.Omittable[[]model.HdrFormat] `json:"filter,omitempty"`
+}
+
+type IndexStatsTimelineInput struct {
+	Since time.Time `json:"since"`
+	// defaults to now
+	Until graphql.Omittable[*time.Time] `json:"until,omitempty"`
+	// defaults to day
+	Interval graphql.Omittable[*IndexStatsInterval] `json:"interval,omitempty"`
+	// restricts the timeline to these content types, where null matches torrents of unknown content type
+	ContentTypes graphql.Omittable[[]*model.ContentType] `json:"contentTypes,omitempty"`
+}
+
 type LanguageAgg struct {
 	Value model.Language `json:"value"`
 	Label string         `json:"label"`
@@ -44,9 +150,57 @@ type LanguageFacetInput struct {
 type Mutation struct {
 }
 
+type PersonFilterInput struct {
+	// the name of the person, matched ignoring case
+	Name string                                      `json:"name"`
+	Role graphql.Omittable[*model.ContentPersonRole] `json:"role,omitempty"`
+	// a crew job, such as Director or Screenplay, matched ignoring case
+	Job graphql.Omittable[*string] `json:"job,omitempty"`
+}
+
+type QuarantineListQueryInput struct {
+	// lists torrents detected as spam for any of the reasons
+	Reasons graphql.Omittable[[]model.SpamReason] `json:"reasons,omitempty"`
+	// defaults to 100, capped at 1000
+	Limit  graphql.Omittable[*int] `json:"limit,omitempty"`
+	Offset graphql.Omittable[*int] `json:"offset,omitempty"`
+}
+
 type Query struct {
 }
 
+type QueueDeadLettersQueryInput struct {
+	// defaults to the processor queue
+	Queue graphql.Omittable[*string] `json:"queue,omitempty"`
+	// defaults to 30, capped at 1000
+	Limit graphql.Omittable[*int] `json:"limit,omitempty"`
+	// defaults to 1
+	Page graphql.Omittable[*int] `json:"page,omitempty"`
+}
+
+type QueueMessagesQueryInput struct {
+	// defaults to the processor queue
+	Queue graphql.Omittable[*string] `json:"queue,omitempty"`
+	State messages.State             `json:"state"`
+	// defaults to 30, capped at 1000
+	Limit graphql.Omittable[*int] `json:"limit,omitempty"`
+	// defaults to 1
+	Page graphql.Omittable[*int] `json:"page,omitempty"`
+}
+
+type ReleaseTokenAgg struct {
+	Value string `json:"value"`
+	Label string `json:"label"`
+	Count int    `json:"count"`
+}
+
+type ReleaseTokenFacetInput struct {
+	Aggregate graphql.Omittable[*bool]             `json:"aggregate,omitempty"`
+	Logic     graphql.Omittable[*model.FacetLogic] `json:"logic,omitempty"`
+	// release tokens formatted as kind:value, e.g. streaming_service:ATVP or bit_depth:10bit
+	Filter graphql.Omittable[[]string] `json:"filter,omitempty"`
+}
+
 type ReleaseYearAgg struct {
 	Value *model.Year `json:"value,omitempty"`
 	Label string      `json:"label"`
@@ -58,11 +212,78 @@ type ReleaseYearFacetInput struct {
 	Filter    graphql.Omittable[[]*model.Year] `json:"filter,omitempty"`
 }
 
+type ReviewListQueryInput struct {
+	// matches with a confidence below this are listed; defaults to 0.5
+	MaxConfidence graphql.Omittable[*float64]            `json:"maxConfidence,omitempty"`
+	ContentTypes  graphql.Omittable[[]model.ContentType] `json:"contentTypes,omitempty"`
+	// defaults to 100, capped at 1000
+	Limit  graphql.Omittable[*int] `json:"limit,omitempty"`
+	Offset graphql.Omittable[*int] `json:"offset,omitempty"`
+}
+
+type SavedSearchInput struct {
+	Name        string                     `json:"name"`
+	QueryString graphql.Omittable[*string] `json:"queryString,omitempty"`
+	// aggregation options are ignored
+	Facets graphql.Omittable[*TorrentContentFacetsInput] `json:"facets,omitempty"`
+	// defaults to updated_at
+	OrderBy graphql.Omittable[*model.SavedSearchOrderBy] `json:"orderBy,omitempty"`
+	// defaults to true; relevance is always most relevant first
+	OrderDesc  graphql.Omittable[*bool]   `json:"orderDesc,omitempty"`
+	WebhookURL graphql.Omittable[*string] `json:"webhookUrl,omitempty"`
+	// email notifications require SMTP to be configured
+	Email graphql.Omittable[*string] `json:"email,omitempty"`
+}
+
+type Subscription struct {
+}
+
 type SuggestTagsQueryInput struct {
 	Prefix     graphql.Omittable[*string]  `json:"prefix,omitempty"`
 	Exclusions graphql.Omittable[[]string] `json:"exclusions,omitempty"`
 }
 
+type TakedownListQueryInput struct {
+	Lists         graphql.Omittable[[]string]      `json:"lists,omitempty"`
+	InfoHashes    graphql.Omittable[[]protocol.ID] `json:"infoHashes,omitempty"`
+	CreatedAfter  graphql.Omittable[*time.Time]    `json:"createdAfter,omitempty"`
+	CreatedBefore graphql.Omittable[*time.Time]    `json:"createdBefore,omitempty"`
+	// defaults to 100, capped at 1000
+	Limit  graphql.Omittable[*int] `json:"limit,omitempty"`
+	Offset graphql.Omittable[*int] `json:"offset,omitempty"`
+}
+
+type TakedownLogQueryInput struct {
+	Lists   graphql.Omittable[[]string]               `json:"lists,omitempty"`
+	Actions graphql.Omittable[[]model.TakedownAction] `json:"actions,omitempty"`
+	// log entries are kept after a takedown is withdrawn, so entries for an info hash can be found even when it's no longer on the takedown list
+	InfoHashes    graphql.Omittable[[]protocol.ID] `json:"infoHashes,omitempty"`
+	CreatedAfter  graphql.Omittable[*time.Time]    `json:"createdAfter,omitempty"`
+	CreatedBefore graphql.Omittable[*time.Time]    `json:"createdBefore,omitempty"`
+	// defaults to 100, capped at 1000
+	Limit  graphql.Omittable[*int] `json:"limit,omitempty"`
+	Offset graphql.Omittable[*int] `json:"offset,omitempty"`
+}
+
+type TakedownSubmitInput struct {
+	// defaults to "manual"
+	List      graphql.Omittable[*string] `json:"list,omitempty"`
+	Entries   []string                   `json:"entries"`
+	Reason    graphql.Omittable[*string] `json:"reason,omitempty"`
+	Reference graphql.Omittable[*string] `json:"reference,omitempty"`
+}
+
+type TaskRunListQueryInput struct {
+	Kinds    graphql.Omittable[[]string]              `json:"kinds,omitempty"`
+	Statuses graphql.Omittable[[]model.TaskRunStatus] `json:"statuses,omitempty"`
+	// defaults to 7 days ago
+	StartedAfter graphql.Omittable[*time.Time] `json:"startedAfter,omitempty"`
+	// defaults to 100, capped at 1000
+	Limit graphql.Omittable[*int] `json:"limit,omitempty"`
+}
+
+// aggregations of facets that are filtered with AND logic, or with OR logic and no filter values, are counted together in a single query;
+// other facets are counted with a query each, as OR logic excludes a facet's own filter from its counts
 type TorrentContentAggregations struct {
 	ContentType     []ContentTypeAgg     `json:"contentType,omitempty"`
 	TorrentSource   []TorrentSourceAgg   `json:"torrentSource,omitempty"`
@@ -73,6 +294,10 @@ type TorrentContentAggregations struct {
 	ReleaseYear     []ReleaseYearAgg     `json:"releaseYear,omitempty"`
 	VideoResolution []VideoResolutionAgg `json:"videoResolution,omitempty"`
 	VideoSource     []VideoSourceAgg     `json:"videoSource,omitempty"`
+	VideoCodec      []VideoCodecAgg      `json:"videoCodec,omitempty"`
+	HdrFormat       []HdrFormatAgg       `json:"hdrFormat,omitempty"`
+	AudioFormat     []AudioFormatAgg     `json:"audioFormat,omitempty"`
+	ReleaseToken    []ReleaseTokenAgg    `json:"releaseToken,omitempty"`
 }
 
 type TorrentContentFacetsInput struct {
@@ -85,6 +310,65 @@ type TorrentContentFacetsInput struct {
 	ReleaseYear     graphql.Omittable[*ReleaseYearFacetInput]     `json:"releaseYear,omitempty"`
 	VideoResolution graphql.Omittable[*VideoResolutionFacetInput] `json:"videoResolution,omitempty"`
 	VideoSource     graphql.Omittable[*VideoSourceFacetInput]     `json:"videoSource,omitempty"`
+	VideoCodec      graphql.Omittable[*VideoCodecFacetInput]      `json:"videoCodec,omitempty"`
+	HdrFormat       graphql.Omittable[*HdrFormatFacetInput]       `json:"hdrFormat,omitempty"`
+	AudioFormat     graphql.Omittable[*AudioFormatFacetInput]     `json:"audioFormat,omitempty"`
+	ReleaseToken    graphql.Omittable[*ReleaseTokenFacetInput]    `json:"releaseToken,omitempty"`
+}
+
+// a boolean filter over torrent content; the conditions of a filter are ANDed, and filters can be combined with and, or and not.
+// Filter values of facets are applied with the facet's logic, and aggregation options are ignored.
+// Note that a negated condition doesn't match rows where the value is unknown; use a null facet filter value to include them.
+type TorrentContentFilterInput struct {
+	And         graphql.Omittable[[]TorrentContentFilterInput] `json:"and,omitempty"`
+	Or          graphql.Omittable[[]TorrentContentFilterInput] `json:"or,omitempty"`
+	Not         graphql.Omittable[*TorrentContentFilterInput]  `json:"not,omitempty"`
+	QueryString graphql.Omittable[*string]                     `json:"queryString,omitempty"`
+	InfoHash    graphql.Omittable[[]protocol.ID]               `json:"infoHash,omitempty"`
+	Facets      graphql.Omittable[*TorrentContentFacetsInput]  `json:"facets,omitempty"`
+	// if true, matches only the best release of each content item, ranked by video resolution, then video codec, then seeders;
+	// the best release is chosen from all torrents of the content regardless of other conditions, and torrents not matched to content are always included
+	BestRelease graphql.Omittable[*bool] `json:"bestRelease,omitempty"`
+	// matches torrent content with audio in any of the languages
+	Languages graphql.Omittable[[]model.Language] `json:"languages,omitempty"`
+	// matches torrent content with subtitles in any of the languages
+	SubtitleLanguages graphql.Omittable[[]model.Language] `json:"subtitleLanguages,omitempty"`
+	Subtitled         graphql.Omittable[*bool]            `json:"subtitled,omitempty"`
+	MultiAudio        graphql.Omittable[*bool]            `json:"multiAudio,omitempty"`
+	// matches torrent content that the classifier flagged as probably fake, as its size is implausible for its content, or not
+	ProbablyFake graphql.Omittable[*bool] `json:"probablyFake,omitempty"`
+	// excludes torrents reported as fake or mislabeled by more than this many users
+	MaxReports graphql.Omittable[*int] `json:"maxReports,omitempty"`
+	// matches torrent content that is quarantined as probable spam or malware, or not; quarantined torrents are excluded
+	// from search results unless this is set at the top level of the filter
+	Quarantined graphql.Omittable[*bool] `json:"quarantined,omitempty"`
+	// matches daily shows released by air date with an air date in the range, e.g. 2024-05-17, 2024-05 or 2024-05-01 to 2024-05-31
+	AirDate graphql.Omittable[*string] `json:"airDate,omitempty"`
+	// matches torrent content of content crediting the person, e.g. {name: "Christopher Nolan", job: "Director"};
+	// only content matched while TMDB credits are fetched has people
+	Person graphql.Omittable[*PersonFilterInput] `json:"person,omitempty"`
+}
+
+type TorrentDeleteByFilterInput struct {
+	// a case-insensitive POSIX regular expression matched against the torrent name
+	NameRegex graphql.Omittable[*string] `json:"nameRegex,omitempty"`
+	MinSize   graphql.Omittable[*int]    `json:"minSize,omitempty"`
+	MaxSize   graphql.Omittable[*int]    `json:"maxSize,omitempty"`
+	// a null content type matches torrents of unknown type
+	ContentType   graphql.Omittable[[]*model.ContentType] `json:"contentType,omitempty"`
+	TorrentSource graphql.Omittable[[]string]             `json:"torrentSource,omitempty"`
+	// defaults to false
+	Block graphql.Omittable[*bool] `json:"block,omitempty"`
+	// counts the matching torrents without deleting them
+	DryRun graphql.Omittable[*bool] `json:"dryRun,omitempty"`
+}
+
+type TorrentDiscoveryStatsInput struct {
+	Since time.Time `json:"since"`
+	// defaults to day
+	Bucket graphql.Omittable[*TorrentDiscoveryStatsBucket] `json:"bucket,omitempty"`
+	// restricts the counts to these torrent sources
+	Sources graphql.Omittable[[]string] `json:"sources,omitempty"`
 }
 
 type TorrentFileTypeAgg struct {
@@ -99,6 +383,47 @@ type TorrentFileTypeFacetInput struct {
 	Filter    graphql.Omittable[[]model.FileType]  `json:"filter,omitempty"`
 }
 
+type TorrentFilesQueryInput struct {
+	// a full text query of the file names and paths
+	QueryString graphql.Omittable[*string] `json:"queryString,omitempty"`
+	// file extensions without the leading dot, such as nfo or mkv
+	Extensions graphql.Omittable[[]string]         `json:"extensions,omitempty"`
+	FileTypes  graphql.Omittable[[]model.FileType] `json:"fileTypes,omitempty"`
+	// restricts the search to the files of these torrents
+	InfoHashes graphql.Omittable[[]protocol.ID] `json:"infoHashes,omitempty"`
+	// defaults to 100, capped at 1000
+	Limit      graphql.Omittable[*int]  `json:"limit,omitempty"`
+	Offset     graphql.Omittable[*int]  `json:"offset,omitempty"`
+	TotalCount graphql.Omittable[*bool] `json:"totalCount,omitempty"`
+}
+
+type TorrentReportInput struct {
+	InfoHashes []protocol.ID              `json:"infoHashes"`
+	Reason     model.TorrentReportReason  `json:"reason"`
+	Comment    graphql.Omittable[*string] `json:"comment,omitempty"`
+}
+
+type TorrentReprocessByFilterInput struct {
+	// a case-insensitive POSIX regular expression matched against the torrent name
+	NameRegex graphql.Omittable[*string] `json:"nameRegex,omitempty"`
+	MinSize   graphql.Omittable[*int]    `json:"minSize,omitempty"`
+	MaxSize   graphql.Omittable[*int]    `json:"maxSize,omitempty"`
+	// a null content type matches torrents of unknown type
+	ContentType   graphql.Omittable[[]*model.ContentType] `json:"contentType,omitempty"`
+	TorrentSource graphql.Omittable[[]string]             `json:"torrentSource,omitempty"`
+	// ignores any existing classification and classifies from scratch; defaults to true,
+	// and otherwise only previously unmatched torrents are matched
+	Rematch graphql.Omittable[*bool] `json:"rematch,omitempty"`
+	// counts the matching torrents without queueing them
+	DryRun graphql.Omittable[*bool] `json:"dryRun,omitempty"`
+}
+
+type TorrentSetContentInput struct {
+	InfoHashes  []protocol.ID              `json:"infoHashes"`
+	ContentType model.ContentType          `json:"contentType"`
+	ContentRef  graphql.Omittable[*string] `json:"contentRef,omitempty"`
+}
+
 type TorrentSourceAgg struct {
 	Value string `json:"value"`
 	Label string `json:"label"`
@@ -123,6 +448,23 @@ type TorrentTagFacetInput struct {
 	Filter    graphql.Omittable[[]string]          `json:"filter,omitempty"`
 }
 
+type TorznabAPIKeyInput struct {
+	Name string `json:"name"`
+	// the maximum number of requests per minute; requests are not limited if null
+	RateLimit graphql.Omittable[*int] `json:"rateLimit,omitempty"`
+}
+
+type VideoCodecAgg struct {
+	Value *model.VideoCodec `json:"value,omitempty"`
+	Label string            `json:"label"`
+	Count int               `json:"count"`
+}
+
+type VideoCodecFacetInput struct {
+	Aggregate graphql.Omittable[*bool]               `json:"aggregate,omitempty"`
+	Filter    graphql.Omittable[[]*model.VideoCodec] `json:"filter,omitempty"`
+}
+
 type VideoResolutionAgg struct {
 	Value *model.VideoResolution `json:"value,omitempty"`
 	Label string                 `json:"label"`
@@ -144,3 +486,100 @@ type VideoSourceFacetInput struct {
 	Aggregate graphql.Omittable[*bool]                `json:"aggregate,omitempty"`
 	Filter    graphql.Omittable[[]*model.VideoSource] `json:"filter,omitempty"`
 }
+
+type WebhookDeliveriesQueryInput struct {
+	Endpoints  graphql.Omittable[[]string]                      `json:"endpoints,omitempty"`
+	EventTypes graphql.Omittable[[]model.WebhookEventType]      `json:"eventTypes,omitempty"`
+	Statuses   graphql.Omittable[[]model.WebhookDeliveryStatus] `json:"statuses,omitempty"`
+	// defaults to 100, capped at 1000
+	Limit  graphql.Omittable[*int] `json:"limit,omitempty"`
+	Offset graphql.Omittable[*int] `json:"offset,omitempty"`
+}
+
+type IndexStatsInterval string
+
+const (
+	IndexStatsIntervalHour  IndexStatsInterval = "hour"
+	IndexStatsIntervalDay   IndexStatsInterval = "day"
+	IndexStatsIntervalWeek  IndexStatsInterval = "week"
+	IndexStatsIntervalMonth IndexStatsInterval = "month"
+)
+
+var AllIndexStatsInterval = []IndexStatsInterval{
+	IndexStatsIntervalHour,
+	IndexStatsIntervalDay,
+	IndexStatsIntervalWeek,
+	IndexStatsIntervalMonth,
+}
+
+func (e IndexStatsInterval) IsValid() bool {
+	switch e {
+	case IndexStatsIntervalHour, IndexStatsIntervalDay, IndexStatsIntervalWeek, IndexStatsIntervalMonth:
+		return true
+	}
+	return false
+}
+
+func (e IndexStatsInterval) String() string {
+	return string(e)
+}
+
+func (e *IndexStatsInterval) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = IndexStatsInterval(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid IndexStatsInterval", str)
+	}
+	return nil
+}
+
+func (e IndexStatsInterval) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type TorrentDiscoveryStatsBucket string
+
+const (
+	TorrentDiscoveryStatsBucketHour TorrentDiscoveryStatsBucket = "hour"
+	TorrentDiscoveryStatsBucketDay  TorrentDiscoveryStatsBucket = "day"
+	TorrentDiscoveryStatsBucketWeek TorrentDiscoveryStatsBucket = "week"
+)
+
+var AllTorrentDiscoveryStatsBucket = []TorrentDiscoveryStatsBucket{
+	TorrentDiscoveryStatsBucketHour,
+	TorrentDiscoveryStatsBucketDay,
+	TorrentDiscoveryStatsBucketWeek,
+}
+
+func (e TorrentDiscoveryStatsBucket) IsValid() bool {
+	switch e {
+	case TorrentDiscoveryStatsBucketHour, TorrentDiscoveryStatsBucketDay, TorrentDiscoveryStatsBucketWeek:
+		return true
+	}
+	return false
+}
+
+func (e TorrentDiscoveryStatsBucket) String() string {
+	return string(e)
+}
+
+func (e *TorrentDiscoveryStatsBucket) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TorrentDiscoveryStatsBucket(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TorrentDiscoveryStatsBucket", str)
+	}
+	return nil
+}
+
+func (e TorrentDiscoveryStatsBucket) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
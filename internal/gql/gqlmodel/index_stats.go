@@ -0,0 +1,35 @@
+package gqlmodel
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/gql/gqlmodel/gen"
+	"github.com/bitmagnet-io/bitmagnet/internal/indexstats"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+)
+
+type IndexStatsQuery struct {
+	Reader indexstats.Reader
+}
+
+func (q IndexStatsQuery) Timeline(ctx context.Context, input gen.IndexStatsTimelineInput) ([]indexstats.TimelineBucket, error) {
+	tq := indexstats.TimelineQuery{
+		Since:    input.Since,
+		Interval: indexstats.IntervalDay,
+	}
+	if until, ok := input.Until.ValueOK(); ok && until != nil {
+		tq.Until = *until
+	}
+	if interval, ok := input.Interval.ValueOK(); ok && interval != nil {
+		tq.Interval = indexstats.Interval(*interval)
+	}
+	if cts, ok := input.ContentTypes.ValueOK(); ok {
+		for _, ct := range cts {
+			if ct == nil {
+				tq.ContentTypes = append(tq.ContentTypes, model.NullContentType{})
+			} else {
+				tq.ContentTypes = append(tq.ContentTypes, model.NewNullContentType(*ct))
+			}
+		}
+	}
+	return q.Reader.Timeline(ctx, tq)
+}
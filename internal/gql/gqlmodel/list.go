@@ -0,0 +1,22 @@
+package gqlmodel
+
+import "github.com/99designs/gqlgen/graphql"
+
+// the number of items returned by list queries if no limit is given, and the maximum limit
+const (
+	defaultListLimit = 100
+	maxListLimit     = 1000
+)
+
+// listLimitOffset returns the limit and offset of a page of a list query, defaulting to the first page of the default
+// limit, and capping the limit at the maximum.
+func listLimitOffset(limit, offset graphql.Omittable[*int]) (int, int) {
+	l, o := defaultListLimit, 0
+	if v, ok := limit.ValueOK(); ok && v != nil && *v > 0 {
+		l = min(*v, maxListLimit)
+	}
+	if v, ok := offset.ValueOK(); ok && v != nil && *v > 0 {
+		o = *v
+	}
+	return l, o
+}
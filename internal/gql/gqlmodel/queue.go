@@ -0,0 +1,104 @@
+package gqlmodel
+
+import (
+	"context"
+	"errors"
+	"github.com/bitmagnet-io/bitmagnet/internal/auth"
+	"github.com/bitmagnet-io/bitmagnet/internal/gql/gqlmodel/gen"
+	"github.com/bitmagnet-io/bitmagnet/internal/processor"
+	"github.com/bitmagnet-io/bitmagnet/internal/queue/deadletter"
+	"github.com/bitmagnet-io/bitmagnet/internal/queue/messages"
+	"github.com/bitmagnet-io/bitmagnet/internal/queue/stats"
+)
+
+const (
+	deadLettersDefaultLimit = 30
+	deadLettersMaxLimit     = 1000
+)
+
+type QueueQuery struct {
+	DeadLetterManager deadletter.Manager
+	MessageManager    messages.Manager
+	StatsReader       stats.Reader
+}
+
+func (q QueueQuery) Metrics(ctx context.Context) ([]stats.QueueStats, error) {
+	return q.StatsReader.Stats(ctx)
+}
+
+func (q QueueQuery) DeadLetters(ctx context.Context, query *gen.QueueDeadLettersQueryInput) (deadletter.ListResult, error) {
+	queue := processor.MessageName
+	limit, page := deadLettersDefaultLimit, 1
+	if query != nil {
+		if qn, ok := query.Queue.ValueOK(); ok && qn != nil && *qn != "" {
+			queue = *qn
+		}
+		if l, ok := query.Limit.ValueOK(); ok && l != nil && *l > 0 {
+			limit = min(*l, deadLettersMaxLimit)
+		}
+		if p, ok := query.Page.ValueOK(); ok && p != nil && *p > 0 {
+			page = *p
+		}
+	}
+	return q.DeadLetterManager.List(ctx, queue, limit, page)
+}
+
+func (q QueueQuery) Messages(ctx context.Context, query gen.QueueMessagesQueryInput) (messages.ListResult, error) {
+	if err := auth.Authorize(ctx, auth.PermissionAdmin); err != nil {
+		return messages.ListResult{}, err
+	}
+	limit, page := deadLettersDefaultLimit, 1
+	if l, ok := query.Limit.ValueOK(); ok && l != nil && *l > 0 {
+		limit = min(*l, deadLettersMaxLimit)
+	}
+	if p, ok := query.Page.ValueOK(); ok && p != nil && *p > 0 {
+		page = *p
+	}
+	queue, _ := query.Queue.ValueOK()
+	return q.MessageManager.List(ctx, queueOrDefault(queue), query.State, limit, page)
+}
+
+func (q QueueQuery) Message(ctx context.Context, queue *string, id string) (*messages.Message, error) {
+	if err := auth.Authorize(ctx, auth.PermissionAdmin); err != nil {
+		return nil, err
+	}
+	msg, err := q.MessageManager.Get(ctx, queueOrDefault(queue), id)
+	if err != nil {
+		if errors.Is(err, messages.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &msg, nil
+}
+
+type QueueMutation struct {
+	DeadLetterManager deadletter.Manager
+	MessageManager    messages.Manager
+}
+
+func (q QueueMutation) RequeueDeadLetters(ctx context.Context, queue *string, ids []string) (int, error) {
+	if ids == nil {
+		return q.DeadLetterManager.RequeueAll(ctx, queueOrDefault(queue))
+	}
+	return q.DeadLetterManager.Requeue(ctx, queueOrDefault(queue), ids...)
+}
+
+func (q QueueMutation) PurgeDeadLetters(ctx context.Context, queue *string) (int, error) {
+	return q.DeadLetterManager.DeleteAll(ctx, queueOrDefault(queue))
+}
+
+func (q QueueMutation) RetryMessages(ctx context.Context, queue *string, ids []string) (int, error) {
+	return q.MessageManager.Retry(ctx, queueOrDefault(queue), ids...)
+}
+
+func (q QueueMutation) DeleteMessages(ctx context.Context, queue *string, ids []string) (int, error) {
+	return q.MessageManager.Delete(ctx, queueOrDefault(queue), ids...)
+}
+
+func queueOrDefault(queue *string) string {
+	if queue == nil || *queue == "" {
+		return processor.MessageName
+	}
+	return *queue
+}
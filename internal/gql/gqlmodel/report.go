@@ -0,0 +1,95 @@
+package gqlmodel
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/auth"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/gql/gqlmodel/gen"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"gorm.io/gorm/clause"
+	"strings"
+)
+
+// anonymousReporter is the reporter of reports made without authentication, which count as a single user.
+const anonymousReporter = "anonymous"
+
+type ReportQuery struct {
+	Dao *dao.Query
+}
+
+func (r ReportQuery) Counts(ctx context.Context, infoHashes []protocol.ID) ([]model.TorrentReportCounts, error) {
+	return r.Dao.TorrentReport.Counts(ctx, infoHashes)
+}
+
+func (r ReportQuery) List(ctx context.Context, infoHash protocol.ID) ([]*model.TorrentReport, error) {
+	return r.Dao.TorrentReport.WithContext(ctx).Where(
+		r.Dao.TorrentReport.InfoHash.Eq(infoHash),
+	).Order(r.Dao.TorrentReport.UpdatedAt.Desc()).Find()
+}
+
+type ReportMutation struct {
+	Dao *dao.Query
+}
+
+// Submit reports the torrents that exist on behalf of the user, replacing any earlier reports by the user.
+func (r ReportMutation) Submit(ctx context.Context, input gen.TorrentReportInput) (*string, error) {
+	var infoHashes []protocol.ID
+	if err := r.Dao.Torrent.WithContext(ctx).Where(
+		r.Dao.Torrent.InfoHash.In(infoHashValuers(input.InfoHashes)...),
+	).Pluck(r.Dao.Torrent.InfoHash, &infoHashes); err != nil || len(infoHashes) == 0 {
+		return nil, err
+	}
+	var comment model.NullString
+	if c, ok := input.Comment.ValueOK(); ok && c != nil && strings.TrimSpace(*c) != "" {
+		comment = model.NewNullString(strings.TrimSpace(*c))
+	}
+	reporter := reporterFromContext(ctx)
+	reports := make([]*model.TorrentReport, 0, len(infoHashes))
+	for _, infoHash := range infoHashes {
+		reports = append(reports, &model.TorrentReport{
+			InfoHash: infoHash,
+			Reporter: reporter,
+			Reason:   input.Reason,
+			Comment:  comment,
+		})
+	}
+	return nil, r.Dao.TorrentReport.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{
+			{Name: r.Dao.TorrentReport.InfoHash.ColumnName().String()},
+			{Name: r.Dao.TorrentReport.Reporter.ColumnName().String()},
+		},
+		DoUpdates: clause.AssignmentColumns([]string{
+			r.Dao.TorrentReport.Reason.ColumnName().String(),
+			r.Dao.TorrentReport.Comment.ColumnName().String(),
+			r.Dao.TorrentReport.UpdatedAt.ColumnName().String(),
+		}),
+	}).CreateInBatches(reports, torrentMutationBatchSize)
+}
+
+// Withdraw deletes the user's reports of the torrents.
+func (r ReportMutation) Withdraw(ctx context.Context, infoHashes []protocol.ID) (*string, error) {
+	_, err := r.Dao.TorrentReport.WithContext(ctx).Where(
+		r.Dao.TorrentReport.InfoHash.In(infoHashValuers(infoHashes)...),
+		r.Dao.TorrentReport.Reporter.Eq(reporterFromContext(ctx)),
+	).Delete()
+	return nil, err
+}
+
+// Clear deletes all reports of the torrents; unlike other reporting, it's restricted to admins.
+func (r ReportMutation) Clear(ctx context.Context, infoHashes []protocol.ID) (*string, error) {
+	if err := auth.Authorize(ctx, auth.PermissionAdmin); err != nil {
+		return nil, err
+	}
+	_, err := r.Dao.TorrentReport.WithContext(ctx).Where(
+		r.Dao.TorrentReport.InfoHash.In(infoHashValuers(infoHashes)...),
+	).Delete()
+	return nil, err
+}
+
+func reporterFromContext(ctx context.Context) string {
+	if user, ok := auth.UserFromContext(ctx); ok {
+		return user.Name
+	}
+	return anonymousReporter
+}
@@ -0,0 +1,272 @@
+package gqlmodel
+
+import (
+	"context"
+	"database/sql/driver"
+	"github.com/bitmagnet-io/bitmagnet/internal/classifier/video"
+	q "github.com/bitmagnet-io/bitmagnet/internal/database/query"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/search"
+	"github.com/bitmagnet-io/bitmagnet/internal/gql/gqlmodel/gen"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	reviewDefaultMaxConfidence = 0.5
+	candidatesDefaultLimit     = 10
+	candidatesMaxLimit         = 50
+)
+
+type ReviewQuery struct {
+	TorrentSearch        search.TorrentSearch
+	TorrentContentSearch search.TorrentContentSearch
+	CandidateFinder      video.CandidateFinder
+}
+
+type ReviewListResult struct {
+	TotalCount uint
+	Items      []TorrentContent
+}
+
+func (r ReviewQuery) List(ctx context.Context, query *gen.ReviewListQueryInput) (ReviewListResult, error) {
+	maxConfidence := float32(reviewDefaultMaxConfidence)
+	limit, offset := defaultListLimit, 0
+	var contentTypes []model.ContentType
+	if query != nil {
+		if mc, ok := query.MaxConfidence.ValueOK(); ok && mc != nil {
+			maxConfidence = float32(*mc)
+		}
+		if cts, ok := query.ContentTypes.ValueOK(); ok {
+			contentTypes = cts
+		}
+		limit, offset = listLimitOffset(query.Limit, query.Offset)
+	}
+	options := []q.Option{
+		q.DefaultOption(),
+		search.TorrentContentDefaultHydrate(),
+		search.TorrentContentCoreJoins(),
+		q.Where(search.TorrentContentMatchConfidenceBelowCriteria(maxConfidence)),
+		q.OrderBy(
+			clause.OrderByColumn{
+				Column: clause.Column{Table: clause.CurrentTable, Name: "match_confidence"},
+			},
+			clause.OrderByColumn{
+				Column: clause.Column{Table: clause.CurrentTable, Name: "id"},
+			},
+		),
+		q.Limit(uint(limit)),
+		q.Offset(uint(offset)),
+		q.WithTotalCount(true),
+	}
+	if len(contentTypes) > 0 {
+		options = append(options, q.Where(search.TorrentContentTypeCriteria(contentTypes...)))
+	}
+	result, err := r.TorrentContentSearch.TorrentContent(ctx, options...)
+	if err != nil {
+		return ReviewListResult{}, err
+	}
+	items := make([]TorrentContent, 0, len(result.Items))
+	for _, item := range result.Items {
+		items = append(items, NewTorrentContentFromResultItem(item))
+	}
+	return ReviewListResult{
+		TotalCount: result.TotalCount,
+		Items:      items,
+	}, nil
+}
+
+// Quarantine lists the quarantined torrents, most recently updated first.
+func (r ReviewQuery) Quarantine(ctx context.Context, query *gen.QuarantineListQueryInput) (ReviewListResult, error) {
+	limit, offset := defaultListLimit, 0
+	var reasons []model.SpamReason
+	if query != nil {
+		if rs, ok := query.Reasons.ValueOK(); ok {
+			reasons = rs
+		}
+		limit, offset = listLimitOffset(query.Limit, query.Offset)
+	}
+	options := []q.Option{
+		search.TorrentContentDefaultOption(),
+		q.Where(search.TorrentContentQuarantinedCriteria(true)),
+		q.Limit(uint(limit)),
+		q.Offset(uint(offset)),
+		q.WithTotalCount(true),
+	}
+	if len(reasons) > 0 {
+		options = append(options, q.Where(search.TorrentContentSpamReasonCriteria(reasons...)))
+	}
+	result, err := r.TorrentContentSearch.TorrentContent(ctx, options...)
+	if err != nil {
+		return ReviewListResult{}, err
+	}
+	items := make([]TorrentContent, 0, len(result.Items))
+	for _, item := range result.Items {
+		items = append(items, NewTorrentContentFromResultItem(item))
+	}
+	return ReviewListResult{
+		TotalCount: result.TotalCount,
+		Items:      items,
+	}, nil
+}
+
+type ContentCandidate struct {
+	Content model.Content
+	Score   float64
+	Local   bool
+}
+
+func (r ReviewQuery) Candidates(
+	ctx context.Context,
+	infoHash protocol.ID,
+	contentType *model.ContentType,
+	limit *int,
+) ([]ContentCandidate, error) {
+	result, err := r.TorrentSearch.Torrents(
+		ctx,
+		q.Where(search.TorrentInfoHashCriteria(infoHash)),
+		search.TorrentDefaultPreload(),
+	)
+	if err != nil || len(result.Items) == 0 {
+		return nil, err
+	}
+	var ct model.NullContentType
+	if contentType != nil {
+		ct = model.NewNullContentType(*contentType)
+	}
+	l := candidatesDefaultLimit
+	if limit != nil && *limit > 0 {
+		l = min(*limit, candidatesMaxLimit)
+	}
+	candidates, err := r.CandidateFinder.Candidates(ctx, result.Items[0], ct, uint(l))
+	if err != nil {
+		return nil, err
+	}
+	items := make([]ContentCandidate, 0, len(candidates))
+	for _, c := range candidates {
+		items = append(items, ContentCandidate{
+			Content: c.Content,
+			Score:   float64(c.Score),
+			// content loaded from the database has a creation time, while content from a TMDB search doesn't
+			Local: !c.Content.CreatedAt.IsZero(),
+		})
+	}
+	return items, nil
+}
+
+// ReviewMutation resolves reviews of content matches using content override hints, as set by TorrentMutation.SetContent.
+type ReviewMutation struct {
+	TorrentMutation
+}
+
+// Accept pins the current content matches of the torrents with override hints, so that they're kept on reprocessing,
+// and sets their confidence to 1, as for a match by content reference.
+func (r ReviewMutation) Accept(ctx context.Context, infoHashes []protocol.ID) (*string, error) {
+	matched, err := r.findMatched(ctx, infoHashes)
+	if err != nil || len(matched) == 0 {
+		return nil, err
+	}
+	matchedHashes := make([]protocol.ID, 0, len(matched))
+	valuers := make([]driver.Valuer, 0, len(matched))
+	for _, tc := range matched {
+		matchedHashes = append(matchedHashes, tc.InfoHash)
+		valuers = append(valuers, tc.InfoHash)
+	}
+	hints, err := r.findHints(ctx, matchedHashes)
+	if err != nil {
+		return nil, err
+	}
+	for _, h := range hints {
+		tc := matched[h.InfoHash]
+		h.Override = true
+		h.ContentType = tc.ContentType.ContentType
+		h.ContentSource = tc.ContentSource
+		h.ContentID = tc.ContentID
+	}
+	if err := r.Dao.TorrentHint.WithContext(ctx).CreateInBatches(hints, torrentMutationBatchSize); err != nil {
+		return nil, err
+	}
+	_, err = r.Dao.TorrentContent.WithContext(ctx).Where(
+		r.Dao.TorrentContent.InfoHash.In(valuers...),
+	).Update(r.Dao.TorrentContent.MatchConfidence, 1)
+	return nil, err
+}
+
+func (r ReviewMutation) Fix(ctx context.Context, input gen.TorrentSetContentInput) (*string, error) {
+	return r.SetContent(ctx, input)
+}
+
+// Reject clears the content matches of the torrents, keeping the content type of each.
+func (r ReviewMutation) Reject(ctx context.Context, infoHashes []protocol.ID) (*string, error) {
+	matched, err := r.findMatched(ctx, infoHashes)
+	if err != nil {
+		return nil, err
+	}
+	byType := make(map[model.ContentType][]protocol.ID)
+	for _, tc := range matched {
+		byType[tc.ContentType.ContentType] = append(byType[tc.ContentType.ContentType], tc.InfoHash)
+	}
+	for ct, hashes := range byType {
+		if _, err := r.SetContent(ctx, gen.TorrentSetContentInput{
+			InfoHashes:  hashes,
+			ContentType: ct,
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
+// Release releases the torrents from quarantine, overriding any spam detected when they're reprocessed.
+func (r ReviewMutation) Release(ctx context.Context, infoHashes []protocol.ID) (*string, error) {
+	return nil, r.overrideQuarantine(ctx, infoHashes, model.NewNullBool(false))
+}
+
+// Quarantine quarantines the torrents, whether or not spam is detected when they're reprocessed.
+func (r ReviewMutation) Quarantine(ctx context.Context, infoHashes []protocol.ID) (*string, error) {
+	return nil, r.overrideQuarantine(ctx, infoHashes, model.NewNullBool(true))
+}
+
+// ResetQuarantine clears the quarantine overrides of the torrents, which are then quarantined only if spam was detected.
+func (r ReviewMutation) ResetQuarantine(ctx context.Context, infoHashes []protocol.ID) (*string, error) {
+	return nil, r.overrideQuarantine(ctx, infoHashes, model.NullBool{})
+}
+
+func (r ReviewMutation) overrideQuarantine(ctx context.Context, infoHashes []protocol.ID, override model.NullBool) error {
+	valuers := make([]driver.Valuer, 0, len(infoHashes))
+	for _, infoHash := range infoHashes {
+		valuers = append(valuers, infoHash)
+	}
+	quarantined := any(override.Bool)
+	if !override.Valid {
+		quarantined = gorm.Expr("coalesce(jsonb_array_length(spam_reasons), 0) > 0")
+	}
+	_, err := r.Dao.TorrentContent.WithContext(ctx).Where(
+		r.Dao.TorrentContent.InfoHash.In(valuers...),
+	).Updates(map[string]any{
+		r.Dao.TorrentContent.QuarantineOverride.ColumnName().String(): override,
+		r.Dao.TorrentContent.Quarantined.ColumnName().String():        quarantined,
+	})
+	return err
+}
+
+// findMatched returns the torrent contents of the torrents that are matched to content, by info hash.
+func (r ReviewMutation) findMatched(ctx context.Context, infoHashes []protocol.ID) (map[protocol.ID]*model.TorrentContent, error) {
+	valuers := make([]driver.Valuer, 0, len(infoHashes))
+	for _, infoHash := range infoHashes {
+		valuers = append(valuers, infoHash)
+	}
+	tcs, err := r.Dao.TorrentContent.WithContext(ctx).Where(
+		r.Dao.TorrentContent.InfoHash.In(valuers...),
+		r.Dao.TorrentContent.ContentID.IsNotNull(),
+	).Find()
+	if err != nil {
+		return nil, err
+	}
+	matched := make(map[protocol.ID]*model.TorrentContent, len(tcs))
+	for _, tc := range tcs {
+		matched[tc.InfoHash] = tc
+	}
+	return matched, nil
+}
@@ -0,0 +1,102 @@
+package gqlmodel
+
+import (
+	"context"
+	"fmt"
+	q "github.com/bitmagnet-io/bitmagnet/internal/database/query"
+	"github.com/bitmagnet-io/bitmagnet/internal/gql/gqlmodel/gen"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/savedsearch"
+	"strconv"
+)
+
+type SavedSearchQuery struct {
+	Manager savedsearch.Manager
+}
+
+func (s SavedSearchQuery) List(ctx context.Context) ([]model.SavedSearch, error) {
+	return s.Manager.List(ctx)
+}
+
+func (s SavedSearchQuery) Search(
+	ctx context.Context,
+	id string,
+	limit *int,
+	offset *int,
+	cursor *string,
+) (TorrentContentSearchResult, error) {
+	intID, err := parseSavedSearchID(id)
+	if err != nil {
+		return TorrentContentSearchResult{}, err
+	}
+	options := []q.Option{
+		q.WithHasNextPage(true),
+	}
+	if limit != nil && *limit > 0 {
+		options = append(options, q.Limit(uint(*limit)))
+	}
+	if offset != nil && *offset > 0 {
+		options = append(options, q.Offset(uint(*offset)))
+	}
+	if cursor != nil && *cursor != "" {
+		options = append(options, q.Cursor(*cursor))
+	}
+	result, err := s.Manager.Search(ctx, intID, options...)
+	if err != nil {
+		return TorrentContentSearchResult{}, err
+	}
+	return transformTorrentContentSearchResult(result)
+}
+
+type SavedSearchMutation struct {
+	Manager savedsearch.Manager
+}
+
+func (s SavedSearchMutation) Save(ctx context.Context, input gen.SavedSearchInput) (model.SavedSearch, error) {
+	ss := model.SavedSearch{
+		Name: input.Name,
+	}
+	if qs, ok := input.QueryString.ValueOK(); ok && qs != nil && *qs != "" {
+		ss.QueryString = model.NewNullString(*qs)
+	}
+	if facets, ok := input.Facets.ValueOK(); ok && facets != nil {
+		for _, f := range torrentContentFacets(*facets) {
+			ss.Facets = append(ss.Facets, savedsearch.NewFacet(f))
+		}
+	}
+	ss.OrderBy = model.SavedSearchOrderByUpdatedAt
+	if orderBy, ok := input.OrderBy.ValueOK(); ok && orderBy != nil {
+		ss.OrderBy = *orderBy
+	}
+	ss.OrderDesc = true
+	if desc, ok := input.OrderDesc.ValueOK(); ok && desc != nil {
+		ss.OrderDesc = *desc
+	}
+	if u, ok := input.WebhookURL.ValueOK(); ok && u != nil && *u != "" {
+		ss.WebhookURL = model.NewNullString(*u)
+	}
+	if e, ok := input.Email.ValueOK(); ok && e != nil && *e != "" {
+		ss.Email = model.NewNullString(*e)
+	}
+	return s.Manager.Save(ctx, ss)
+}
+
+func (s SavedSearchMutation) Delete(ctx context.Context, ids []string) (*string, error) {
+	intIds := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		n, err := parseSavedSearchID(id)
+		if err != nil {
+			return nil, err
+		}
+		intIds = append(intIds, n)
+	}
+	return nil, s.Manager.Delete(ctx, intIds...)
+}
+
+func parseSavedSearchID(id string) (int64, error) {
+	n, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid saved search ID %q: %w", id, err)
+	}
+	return n, nil
+}
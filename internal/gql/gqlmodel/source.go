@@ -0,0 +1,78 @@
+package gqlmodel
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+)
+
+// SourceInfo describes a registered torrent or metadata source. Sources are stored in the database rather than
+// being a schema enum, so that sources registered at runtime (e.g. by an import) are listed without a schema change.
+type SourceInfo struct {
+	Key   string
+	Name  string
+	Count uint
+}
+
+type sourceCount struct {
+	Source string
+	Count  uint
+}
+
+func listTorrentSources(ctx context.Context, d *dao.Query) ([]SourceInfo, error) {
+	sources, err := d.TorrentSource.WithContext(ctx).Order(d.TorrentSource.Key).Find()
+	if err != nil {
+		return nil, err
+	}
+	var counts []sourceCount
+	if err := d.TorrentsTorrentSource.WithContext(ctx).Select(
+		d.TorrentsTorrentSource.Source,
+		d.TorrentsTorrentSource.InfoHash.Count().As("count"),
+	).Group(
+		d.TorrentsTorrentSource.Source,
+	).Scan(&counts); err != nil {
+		return nil, err
+	}
+	result := make([]SourceInfo, 0, len(sources))
+	for _, s := range sources {
+		result = append(result, SourceInfo{
+			Key:  s.Key,
+			Name: s.Name,
+		})
+	}
+	return withSourceCounts(result, counts), nil
+}
+
+func listMetadataSources(ctx context.Context, d *dao.Query) ([]SourceInfo, error) {
+	sources, err := d.MetadataSource.WithContext(ctx).Order(d.MetadataSource.Key).Find()
+	if err != nil {
+		return nil, err
+	}
+	var counts []sourceCount
+	if err := d.Content.WithContext(ctx).Select(
+		d.Content.Source,
+		d.Content.ID.Count().As("count"),
+	).Group(
+		d.Content.Source,
+	).Scan(&counts); err != nil {
+		return nil, err
+	}
+	result := make([]SourceInfo, 0, len(sources))
+	for _, s := range sources {
+		result = append(result, SourceInfo{
+			Key:  s.Key,
+			Name: s.Name,
+		})
+	}
+	return withSourceCounts(result, counts), nil
+}
+
+func withSourceCounts(sources []SourceInfo, counts []sourceCount) []SourceInfo {
+	countMap := make(map[string]uint, len(counts))
+	for _, c := range counts {
+		countMap[c.Source] = c.Count
+	}
+	for i := range sources {
+		sources[i].Count = countMap[sources[i].Key]
+	}
+	return sources
+}
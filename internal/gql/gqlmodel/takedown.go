@@ -0,0 +1,139 @@
+package gqlmodel
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/gql/gqlmodel/gen"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"github.com/bitmagnet-io/bitmagnet/internal/takedown"
+	"strconv"
+)
+
+type TakedownQuery struct {
+	Dao *dao.Query
+}
+
+type TakedownListResult struct {
+	Items []model.Takedown
+}
+
+func (t TakedownQuery) List(ctx context.Context, query *gen.TakedownListQueryInput) (TakedownListResult, error) {
+	limit, offset := defaultListLimit, 0
+	q := t.Dao.Takedown.WithContext(ctx)
+	if query != nil {
+		if lists, ok := query.Lists.ValueOK(); ok && len(lists) > 0 {
+			q = q.Where(t.Dao.Takedown.List.In(lists...))
+		}
+		if infoHashes, ok := query.InfoHashes.ValueOK(); ok && len(infoHashes) > 0 {
+			q = q.Where(t.Dao.Takedown.InfoHash.In(infoHashValuers(infoHashes)...))
+		}
+		if after, ok := query.CreatedAfter.ValueOK(); ok && after != nil {
+			q = q.Where(t.Dao.Takedown.CreatedAt.Gte(*after))
+		}
+		if before, ok := query.CreatedBefore.ValueOK(); ok && before != nil {
+			q = q.Where(t.Dao.Takedown.CreatedAt.Lt(*before))
+		}
+		limit, offset = listLimitOffset(query.Limit, query.Offset)
+	}
+	takedowns, err := q.Order(t.Dao.Takedown.ID.Desc()).Limit(limit).Offset(offset).Find()
+	if err != nil {
+		return TakedownListResult{}, err
+	}
+	items := make([]model.Takedown, 0, len(takedowns))
+	for _, td := range takedowns {
+		items = append(items, *td)
+	}
+	return TakedownListResult{Items: items}, nil
+}
+
+type TakedownLogResult struct {
+	Items []model.TakedownLog
+}
+
+func (t TakedownQuery) Log(ctx context.Context, query *gen.TakedownLogQueryInput) (TakedownLogResult, error) {
+	limit, offset := defaultListLimit, 0
+	q := t.Dao.TakedownLog.WithContext(ctx)
+	if query != nil {
+		if lists, ok := query.Lists.ValueOK(); ok && len(lists) > 0 {
+			q = q.Where(t.Dao.TakedownLog.List.In(lists...))
+		}
+		if actions, ok := query.Actions.ValueOK(); ok && len(actions) > 0 {
+			values := make([]driver.Valuer, 0, len(actions))
+			for _, a := range actions {
+				values = append(values, a)
+			}
+			q = q.Where(t.Dao.TakedownLog.Action.In(values...))
+		}
+		if infoHashes, ok := query.InfoHashes.ValueOK(); ok && len(infoHashes) > 0 {
+			q = q.Where(t.Dao.TakedownLog.InfoHash.In(infoHashValuers(infoHashes)...))
+		}
+		if after, ok := query.CreatedAfter.ValueOK(); ok && after != nil {
+			q = q.Where(t.Dao.TakedownLog.CreatedAt.Gte(*after))
+		}
+		if before, ok := query.CreatedBefore.ValueOK(); ok && before != nil {
+			q = q.Where(t.Dao.TakedownLog.CreatedAt.Lt(*before))
+		}
+		limit, offset = listLimitOffset(query.Limit, query.Offset)
+	}
+	entries, err := q.Order(t.Dao.TakedownLog.ID.Desc()).Limit(limit).Offset(offset).Find()
+	if err != nil {
+		return TakedownLogResult{}, err
+	}
+	items := make([]model.TakedownLog, 0, len(entries))
+	for _, e := range entries {
+		items = append(items, *e)
+	}
+	return TakedownLogResult{Items: items}, nil
+}
+
+func infoHashValuers(infoHashes []protocol.ID) []driver.Valuer {
+	values := make([]driver.Valuer, 0, len(infoHashes))
+	for _, h := range infoHashes {
+		values = append(values, h)
+	}
+	return values
+}
+
+type TakedownMutation struct {
+	Manager takedown.Manager
+}
+
+func (t TakedownMutation) Submit(ctx context.Context, input gen.TakedownSubmitInput) (takedown.SubmitResult, error) {
+	list := takedown.ListManual
+	if l, ok := input.List.ValueOK(); ok && l != nil && *l != "" {
+		list = *l
+	}
+	var reason, reference model.NullString
+	if r, ok := input.Reason.ValueOK(); ok && r != nil {
+		reason = model.NewNullString(*r)
+	}
+	if r, ok := input.Reference.ValueOK(); ok && r != nil {
+		reference = model.NewNullString(*r)
+	}
+	entries := make([]takedown.Entry, 0, len(input.Entries))
+	for _, s := range input.Entries {
+		e, err := takedown.ParseEntry(s)
+		if err != nil {
+			return takedown.SubmitResult{}, err
+		}
+		e.Reason = reason
+		e.Reference = reference
+		entries = append(entries, e)
+	}
+	return t.Manager.Submit(ctx, list, entries...)
+}
+
+func (t TakedownMutation) Withdraw(ctx context.Context, ids []string) (*string, error) {
+	intIds := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		n, err := strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid takedown ID %q: %w", id, err)
+		}
+		intIds = append(intIds, n)
+	}
+	return nil, t.Manager.Withdraw(ctx, intIds...)
+}
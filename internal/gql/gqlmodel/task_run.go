@@ -0,0 +1,80 @@
+package gqlmodel
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/gql/gqlmodel/gen"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"gorm.io/gorm"
+	"strconv"
+	"time"
+)
+
+const (
+	taskRunDefaultLimit = 100
+	taskRunMaxLimit     = 1000
+)
+
+type TaskRunQuery struct {
+	Dao *dao.Query
+}
+
+type TaskRunListResult struct {
+	Items []model.TaskRun
+}
+
+func (t TaskRunQuery) List(ctx context.Context, query *gen.TaskRunListQueryInput) (TaskRunListResult, error) {
+	startedAfter := time.Now().Add(-7 * 24 * time.Hour)
+	limit := taskRunDefaultLimit
+	q := t.Dao.TaskRun.WithContext(ctx)
+	if query != nil {
+		if kinds, ok := query.Kinds.ValueOK(); ok && len(kinds) > 0 {
+			q = q.Where(t.Dao.TaskRun.Kind.In(kinds...))
+		}
+		if statuses, ok := query.Statuses.ValueOK(); ok && len(statuses) > 0 {
+			values := make([]driver.Valuer, 0, len(statuses))
+			for _, s := range statuses {
+				values = append(values, s)
+			}
+			q = q.Where(t.Dao.TaskRun.Status.In(values...))
+		}
+		if after, ok := query.StartedAfter.ValueOK(); ok && after != nil {
+			startedAfter = *after
+		}
+		if l, ok := query.Limit.ValueOK(); ok && l != nil && *l > 0 {
+			limit = min(*l, taskRunMaxLimit)
+		}
+	}
+	runs, err := q.Where(
+		t.Dao.TaskRun.StartedAt.Gt(startedAfter),
+	).Order(
+		t.Dao.TaskRun.StartedAt.Desc(),
+		t.Dao.TaskRun.ID.Desc(),
+	).Limit(limit).Find()
+	if err != nil {
+		return TaskRunListResult{}, err
+	}
+	items := make([]model.TaskRun, 0, len(runs))
+	for _, r := range runs {
+		items = append(items, *r)
+	}
+	return TaskRunListResult{Items: items}, nil
+}
+
+func (t TaskRunQuery) Get(ctx context.Context, id string) (*model.TaskRun, error) {
+	intId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid task run ID %q: %w", id, err)
+	}
+	run, err := t.Dao.TaskRun.WithContext(ctx).Where(t.Dao.TaskRun.ID.Eq(intId)).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return run, nil
+}
@@ -2,12 +2,35 @@ package gqlmodel
 
 import (
 	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	q "github.com/bitmagnet-io/bitmagnet/internal/database/query"
 	"github.com/bitmagnet-io/bitmagnet/internal/database/search"
+	"github.com/bitmagnet-io/bitmagnet/internal/events"
 	"github.com/bitmagnet-io/bitmagnet/internal/gql/gqlmodel/gen"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/processor"
+	"github.com/bitmagnet-io/bitmagnet/internal/processor/asynq/purger"
+	"github.com/bitmagnet-io/bitmagnet/internal/processor/reprocess"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"github.com/bitmagnet-io/bitmagnet/internal/queue/publisher"
+	"github.com/hibiken/asynq"
+	"gorm.io/gorm"
+	"strings"
 )
 
 type TorrentQuery struct {
-	TorrentSearch search.TorrentSearch
+	TorrentSearch       search.TorrentSearch
+	TorrentFileSearch   search.TorrentFileSearch
+	TorrentLookupSearch search.TorrentLookupSearch
+	Dao                 *dao.Query
+}
+
+func (t TorrentQuery) Sources(ctx context.Context) ([]SourceInfo, error) {
+	return listTorrentSources(ctx, t.Dao)
 }
 
 func (t TorrentQuery) SuggestTags(ctx context.Context, query *gen.SuggestTagsQueryInput) (search.TorrentSuggestTagsResult, error) {
@@ -23,4 +46,384 @@ func (t TorrentQuery) SuggestTags(ctx context.Context, query *gen.SuggestTagsQue
 	return t.TorrentSearch.TorrentSuggestTags(ctx, suggestTagsQuery)
 }
 
-type TorrentMutation struct{}
+// torrentLookupMaxInfoHashes limits the info hashes of a lookup, which are all looked up in one query.
+const torrentLookupMaxInfoHashes = 1000
+
+type TorrentLookupResult struct {
+	InfoHash       protocol.ID
+	Known          bool
+	Torrent        *model.Torrent
+	TorrentContent *TorrentContent
+}
+
+func (t TorrentQuery) Lookup(ctx context.Context, infoHashes []protocol.ID) ([]TorrentLookupResult, error) {
+	if len(infoHashes) > torrentLookupMaxInfoHashes {
+		return nil, fmt.Errorf("at most %d info hashes can be looked up at once", torrentLookupMaxInfoHashes)
+	}
+	items, err := t.TorrentLookupSearch.TorrentLookup(ctx, infoHashes, torrentLookupFields(ctx))
+	if err != nil {
+		return nil, err
+	}
+	results := make([]TorrentLookupResult, 0, len(items))
+	for _, item := range items {
+		result := TorrentLookupResult{
+			InfoHash: item.InfoHash,
+			Known:    item.Known,
+			Torrent:  item.Torrent,
+		}
+		if item.TorrentContent != nil {
+			c := NewTorrentContentFromResultItem(*item.TorrentContent)
+			result.TorrentContent = &c
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// torrentLookupFilesFields are the fields of a torrent that need its files to be loaded.
+var torrentLookupFilesFields = map[string]struct{}{
+	"files":     {},
+	"fileTree":  {},
+	"fileTypes": {},
+}
+
+// torrentLookupFields returns the fields of the lookup results selected by the query, or all fields outside a query.
+func torrentLookupFields(ctx context.Context) search.TorrentLookupFields {
+	if !graphql.HasOperationContext(ctx) || graphql.GetFieldContext(ctx) == nil {
+		return search.TorrentLookupFields{Torrent: true, TorrentFiles: true, TorrentContent: true}
+	}
+	opCtx := graphql.GetOperationContext(ctx)
+	var fields search.TorrentLookupFields
+	selectTorrent := func(torrentField graphql.CollectedField) {
+		fields.Torrent = true
+		for _, f := range graphql.CollectFields(opCtx, torrentField.Selections, nil) {
+			if _, ok := torrentLookupFilesFields[f.Name]; ok {
+				fields.TorrentFiles = true
+			}
+		}
+	}
+	for _, f := range graphql.CollectFieldsCtx(ctx, nil) {
+		switch f.Name {
+		case "torrent":
+			selectTorrent(f)
+		case "torrentContent":
+			fields.TorrentContent = true
+			for _, cf := range graphql.CollectFields(opCtx, f.Selections, nil) {
+				if cf.Name == "torrent" {
+					selectTorrent(cf)
+				}
+			}
+		}
+	}
+	return fields
+}
+
+// ClassificationTrace returns the trace of the latest classification of a torrent, or nil if no trace was stored.
+func (t TorrentQuery) ClassificationTrace(ctx context.Context, infoHash protocol.ID) (*model.ClassificationTrace, error) {
+	trace, err := t.Dao.ClassificationTrace.WithContext(ctx).Where(t.Dao.ClassificationTrace.InfoHash.Eq(infoHash)).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return trace, nil
+}
+
+const (
+	torrentFilesDefaultLimit = 100
+	torrentFilesMaxLimit     = 1000
+)
+
+type TorrentFilesResult struct {
+	TotalCount  uint
+	HasNextPage bool
+	Items       []TorrentFileResult
+}
+
+type TorrentFileResult struct {
+	File    model.TorrentFile
+	Torrent model.Torrent
+}
+
+func (t TorrentQuery) Files(ctx context.Context, query gen.TorrentFilesQueryInput) (TorrentFilesResult, error) {
+	options := []q.Option{
+		search.TorrentFileDefaultOption(),
+		q.Limit(torrentFilesDefaultLimit),
+		q.WithHasNextPage(true),
+	}
+	if queryString, ok := query.QueryString.ValueOK(); ok && queryString != nil && *queryString != "" {
+		options = append(options, q.QueryString(*queryString), q.OrderByQueryStringRank())
+	}
+	var criteria []q.Criteria
+	if extensions, ok := query.Extensions.ValueOK(); ok && len(extensions) > 0 {
+		normalized := make([]string, 0, len(extensions))
+		for _, ext := range extensions {
+			normalized = append(normalized, strings.ToLower(strings.TrimPrefix(ext, ".")))
+		}
+		criteria = append(criteria, search.FileExtensionCriteria(normalized...))
+	}
+	if fileTypes, ok := query.FileTypes.ValueOK(); ok && len(fileTypes) > 0 {
+		criteria = append(criteria, search.FileTypeCriteria(fileTypes...))
+	}
+	if infoHashes, ok := query.InfoHashes.ValueOK(); ok && len(infoHashes) > 0 {
+		criteria = append(criteria, search.FileInfoHashCriteria(infoHashes...))
+	}
+	if len(criteria) > 0 {
+		options = append(options, q.Where(criteria...))
+	}
+	if limit, ok := query.Limit.ValueOK(); ok && limit != nil && *limit > 0 {
+		options = append(options, q.Limit(uint(min(*limit, torrentFilesMaxLimit))))
+	}
+	if offset, ok := query.Offset.ValueOK(); ok && offset != nil && *offset > 0 {
+		options = append(options, q.Offset(uint(*offset)))
+	}
+	if totalCount, ok := query.TotalCount.ValueOK(); ok && totalCount != nil {
+		options = append(options, q.WithTotalCount(*totalCount))
+	}
+	result, err := t.TorrentFileSearch.TorrentFiles(ctx, options...)
+	if err != nil {
+		return TorrentFilesResult{}, err
+	}
+	items := make([]TorrentFileResult, 0, len(result.Items))
+	for _, item := range result.Items {
+		items = append(items, TorrentFileResult{
+			File:    item.TorrentFile,
+			Torrent: item.Torrent,
+		})
+	}
+	return TorrentFilesResult{
+		TotalCount:  result.TotalCount,
+		HasNextPage: result.HasNextPage,
+		Items:       items,
+	}, nil
+}
+
+const torrentMutationBatchSize = 1000
+
+type TorrentMutation struct {
+	Dao                *dao.Query
+	QueuePurger        purger.Purger
+	ProcessorPublisher publisher.Publisher[processor.MessageParams]
+	EventBus           events.Bus
+	ReprocessManager   reprocess.Manager
+}
+
+// Delete deletes the torrents, optionally blocking them, and removes them from any queued processor messages.
+func (t TorrentMutation) Delete(ctx context.Context, infoHashes []protocol.ID, block *bool) (*string, error) {
+	if _, err := t.deleteTorrents(ctx, infoHashes, block == nil || *block); err != nil {
+		return nil, err
+	}
+	return nil, t.purgeQueued(ctx, infoHashes)
+}
+
+func (t TorrentMutation) DeleteByFilter(ctx context.Context, input gen.TorrentDeleteByFilterInput) (int, error) {
+	filter := torrentFilter(input.NameRegex, input.MinSize, input.MaxSize, input.ContentType, input.TorrentSource)
+	if dryRun, ok := input.DryRun.ValueOK(); ok && dryRun != nil && *dryRun {
+		n, err := t.Dao.CountFilteredTorrents(ctx, filter)
+		return int(n), err
+	}
+	block := false
+	if b, ok := input.Block.ValueOK(); ok && b != nil {
+		block = *b
+	}
+	deleted := 0
+	var deletedHashes []protocol.ID
+	var err error
+	for {
+		var infoHashes []protocol.ID
+		infoHashes, err = t.Dao.FindFilteredTorrentHashes(ctx, filter, torrentMutationBatchSize)
+		if err != nil || len(infoHashes) == 0 {
+			break
+		}
+		var n int
+		n, err = t.deleteTorrents(ctx, infoHashes, block)
+		deleted += n
+		if err != nil {
+			break
+		}
+		deletedHashes = append(deletedHashes, infoHashes...)
+	}
+	// the torrents deleted before any error are purged too
+	return deleted, errors.Join(err, t.purgeQueued(ctx, deletedHashes))
+}
+
+type TorrentReprocessByFilterResult struct {
+	TotalCount int
+	TaskRunID  *int64
+}
+
+func (t TorrentMutation) ReprocessByFilter(ctx context.Context, input gen.TorrentReprocessByFilterInput) (TorrentReprocessByFilterResult, error) {
+	filter := torrentFilter(input.NameRegex, input.MinSize, input.MaxSize, input.ContentType, input.TorrentSource)
+	if dryRun, ok := input.DryRun.ValueOK(); ok && dryRun != nil && *dryRun {
+		n, err := t.Dao.CountFilteredTorrents(ctx, filter)
+		return TorrentReprocessByFilterResult{TotalCount: int(n)}, err
+	}
+	classifyMode := processor.ClassifyModeRematch
+	if rematch, ok := input.Rematch.ValueOK(); ok && rematch != nil && !*rematch {
+		classifyMode = processor.ClassifyModeDefault
+	}
+	job, err := t.ReprocessManager.Start(ctx, reprocess.Request{
+		Filter:       filter,
+		ClassifyMode: classifyMode,
+	})
+	if err != nil {
+		return TorrentReprocessByFilterResult{}, err
+	}
+	result := TorrentReprocessByFilterResult{TotalCount: int(job.TotalCount)}
+	if job.TaskRunID != 0 {
+		result.TaskRunID = &job.TaskRunID
+	}
+	return result, nil
+}
+
+func torrentFilter(
+	nameRegex graphql.Omittable[*string],
+	minSize graphql.Omittable[*int],
+	maxSize graphql.Omittable[*int],
+	contentTypes graphql.Omittable[[]*model.ContentType],
+	sources graphql.Omittable[[]string],
+) dao.TorrentFilter {
+	filter := dao.TorrentFilter{}
+	if nr, ok := nameRegex.ValueOK(); ok && nr != nil {
+		filter.NameRegex = *nr
+	}
+	if ms, ok := minSize.ValueOK(); ok && ms != nil {
+		filter.MinSize = model.NewNullUint64(uint64(max(*ms, 0)))
+	}
+	if ms, ok := maxSize.ValueOK(); ok && ms != nil {
+		filter.MaxSize = model.NewNullUint64(uint64(max(*ms, 0)))
+	}
+	if cts, ok := contentTypes.ValueOK(); ok {
+		for _, ct := range cts {
+			if ct == nil {
+				filter.ContentTypes = append(filter.ContentTypes, model.NullContentType{})
+			} else {
+				filter.ContentTypes = append(filter.ContentTypes, model.NewNullContentType(*ct))
+			}
+		}
+	}
+	if s, ok := sources.ValueOK(); ok {
+		filter.Sources = s
+	}
+	return filter
+}
+
+// SetContent saves a content override hint for each torrent, keeping any other attributes of an existing hint.
+func (t TorrentMutation) SetContent(ctx context.Context, input gen.TorrentSetContentInput) (*string, error) {
+	var ref model.Maybe[model.ContentRef]
+	if str, ok := input.ContentRef.ValueOK(); ok && str != nil && *str != "" {
+		r, err := model.ParseContentRef(*str)
+		if err != nil {
+			return nil, err
+		}
+		if !r.Type.IsNil() && r.Type != input.ContentType {
+			return nil, fmt.Errorf("%w: content type %s doesn't match %s", model.ErrInvalidContentRef, r.Type, input.ContentType)
+		}
+		ref = model.MaybeValid(r)
+	}
+	hints, err := t.findHints(ctx, input.InfoHashes)
+	if err != nil {
+		return nil, err
+	}
+	for _, h := range hints {
+		h.Override = true
+		h.ContentType = input.ContentType
+		if ref.Valid {
+			h.ContentSource = model.NewNullString(ref.Val.Source)
+			h.ContentID = model.NewNullString(ref.Val.ID)
+		} else {
+			h.ContentSource = model.NullString{}
+			h.ContentID = model.NullString{}
+		}
+	}
+	if err := t.Dao.TorrentHint.WithContext(ctx).CreateInBatches(hints, torrentMutationBatchSize); err != nil {
+		return nil, err
+	}
+	return nil, t.reprocess(ctx, input.InfoHashes)
+}
+
+// ClearContentOverride deletes the override hints of the torrents; hints without an override are unaffected.
+func (t TorrentMutation) ClearContentOverride(ctx context.Context, infoHashes []protocol.ID) (*string, error) {
+	valuers := make([]driver.Valuer, 0, len(infoHashes))
+	for _, infoHash := range infoHashes {
+		valuers = append(valuers, infoHash)
+	}
+	if _, err := t.Dao.TorrentHint.WithContext(ctx).Where(
+		t.Dao.TorrentHint.InfoHash.In(valuers...),
+		t.Dao.TorrentHint.Override.Is(true),
+	).Delete(); err != nil {
+		return nil, err
+	}
+	return nil, t.reprocess(ctx, infoHashes)
+}
+
+func (t TorrentMutation) findHints(ctx context.Context, infoHashes []protocol.ID) ([]*model.TorrentHint, error) {
+	valuers := make([]driver.Valuer, 0, len(infoHashes))
+	for _, infoHash := range infoHashes {
+		valuers = append(valuers, infoHash)
+	}
+	existing, err := t.Dao.TorrentHint.WithContext(ctx).Where(t.Dao.TorrentHint.InfoHash.In(valuers...)).Find()
+	if err != nil {
+		return nil, err
+	}
+	byHash := make(map[protocol.ID]*model.TorrentHint, len(existing))
+	for _, h := range existing {
+		byHash[h.InfoHash] = h
+	}
+	hints := make([]*model.TorrentHint, 0, len(infoHashes))
+	seen := make(map[protocol.ID]struct{}, len(infoHashes))
+	for _, infoHash := range infoHashes {
+		if _, ok := seen[infoHash]; ok {
+			continue
+		}
+		seen[infoHash] = struct{}{}
+		if h, ok := byHash[infoHash]; ok {
+			hints = append(hints, h)
+		} else {
+			hints = append(hints, &model.TorrentHint{InfoHash: infoHash})
+		}
+	}
+	return hints, nil
+}
+
+// reprocess classifies the torrents from scratch at interactive priority, so that a change is visible right away.
+func (t TorrentMutation) reprocess(ctx context.Context, infoHashes []protocol.ID) error {
+	_, err := t.ProcessorPublisher.Publish(ctx, processor.MessageParams{
+		ClassifyMode: processor.ClassifyModeRematch,
+		InfoHashes:   infoHashes,
+		Priority:     processor.MessagePriorityInteractive,
+	})
+	// an identical message that's still pending will do the same work
+	if errors.Is(err, asynq.ErrDuplicateTask) {
+		return nil
+	}
+	return err
+}
+
+func (t TorrentMutation) deleteTorrents(ctx context.Context, infoHashes []protocol.ID, block bool) (int, error) {
+	var n int
+	if block {
+		if _, err := t.Dao.DeleteAndBlockTorrents(ctx, infoHashes); err != nil {
+			return 0, err
+		}
+		n = len(infoHashes)
+	} else {
+		rows, err := t.Dao.DeleteTorrents(ctx, infoHashes)
+		if err != nil {
+			return 0, err
+		}
+		n = int(rows)
+	}
+	t.EventBus.Publish(ctx, events.NewDeletedEvents(infoHashes...)...)
+	return n, nil
+}
+
+// purgeQueued removes deleted torrents from the queued processor messages. As every queued message is scanned, it's
+// called once with all the torrents of a delete rather than for each batch.
+func (t TorrentMutation) purgeQueued(ctx context.Context, infoHashes []protocol.ID) error {
+	if _, err := t.QueuePurger.Purge(ctx, infoHashes); err != nil {
+		return fmt.Errorf("deleted torrents but failed to purge queued messages: %w", err)
+	}
+	return nil
+}
@@ -12,86 +12,158 @@ import (
 
 type TorrentContentQuery struct {
 	TorrentContentSearch search.TorrentContentSearch
+	SearchConfig         search.Config
 }
 
 type TorrentContent struct {
-	ID              string
-	InfoHash        protocol.ID
-	ContentType     model.NullContentType
-	ContentSource   model.NullString
-	ContentID       model.NullString
-	Title           string
-	Languages       []model.Language `json:"omitempty"`
-	Episodes        *Episodes
-	VideoResolution model.NullVideoResolution
-	VideoSource     model.NullVideoSource
-	VideoCodec      model.NullVideoCodec
-	Video3d         model.NullVideo3d
-	VideoModifier   model.NullVideoModifier
-	ReleaseGroup    model.NullString
-	SearchString    string
-	CreatedAt       time.Time
-	UpdatedAt       time.Time
-	Torrent         model.Torrent
-	Content         *model.Content
+	ID                 string
+	InfoHash           protocol.ID
+	ContentType        model.NullContentType
+	ContentSource      model.NullString
+	ContentID          model.NullString
+	Title              string
+	Languages          []model.Language `json:"omitempty"`
+	MultiAudio         bool
+	Subtitled          bool
+	SubtitleLanguages  []model.Language
+	Episodes           *Episodes
+	AirDate            *model.Date
+	SportEvent         *model.SportEvent
+	FileContents       []model.TorrentFileContent
+	VideoResolution    model.NullVideoResolution
+	VideoSource        model.NullVideoSource
+	VideoCodec         model.NullVideoCodec
+	Video3d            model.NullVideo3d
+	VideoModifier      model.NullVideoModifier
+	HdrFormats         []model.HdrFormat
+	AudioFormats       []model.AudioFormat
+	ReleaseGroup       model.NullString
+	ReleaseTokens      []model.ReleaseToken
+	MatchConfidence    model.NullFloat32
+	ProbablyFake       bool
+	SpamReasons        []model.SpamReason
+	Quarantined        bool
+	QuarantineOverride model.NullBool
+	Reports            model.TorrentReportCounts
+	SearchString       string
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+	Torrent            model.Torrent
+	Content            *model.Content
+	Highlights         *TorrentContentHighlights
+}
+
+type TorrentContentHighlights struct {
+	MatchedFields []string
+	Name          []search.HighlightSegment
+	Title         []search.HighlightSegment
+	File          []search.HighlightSegment
 }
 
 type Episodes struct {
 	Label   string
 	Seasons []model.Season `json:"omitempty"`
+	Pack    bool
 }
 
 func NewTorrentContentFromResultItem(item search.TorrentContentResultItem) TorrentContent {
 	c := TorrentContent{
-		ID:              item.ID,
-		InfoHash:        item.InfoHash,
-		ContentType:     item.ContentType,
-		ContentSource:   item.ContentSource,
-		ContentID:       item.ContentID,
-		Title:           item.Title(),
-		VideoResolution: item.VideoResolution,
-		VideoSource:     item.VideoSource,
-		VideoCodec:      item.VideoCodec,
-		Video3d:         item.Video3d,
-		VideoModifier:   item.VideoModifier,
-		ReleaseGroup:    item.ReleaseGroup,
-		CreatedAt:       item.CreatedAt,
-		UpdatedAt:       item.UpdatedAt,
-		Torrent:         item.Torrent,
+		ID:                 item.ID,
+		InfoHash:           item.InfoHash,
+		ContentType:        item.ContentType,
+		ContentSource:      item.ContentSource,
+		ContentID:          item.ContentID,
+		Title:              item.Title(),
+		VideoResolution:    item.VideoResolution,
+		VideoSource:        item.VideoSource,
+		VideoCodec:         item.VideoCodec,
+		Video3d:            item.Video3d,
+		VideoModifier:      item.VideoModifier,
+		ReleaseGroup:       item.ReleaseGroup,
+		MatchConfidence:    item.MatchConfidence,
+		ProbablyFake:       item.ProbablyFake,
+		SpamReasons:        append([]model.SpamReason{}, item.SpamReasons...),
+		Quarantined:        item.Quarantined,
+		QuarantineOverride: item.QuarantineOverride,
+		Reports:            item.Reports,
+		MultiAudio:         item.MultiAudio,
+		Subtitled:          item.Subtitled,
+		CreatedAt:          item.CreatedAt,
+		UpdatedAt:          item.UpdatedAt,
+		Torrent:            item.Torrent,
 	}
+	// torrents without reports have no counts to hydrate
+	c.Reports.InfoHash = item.InfoHash
 	if item.Content.ID != "" {
 		c.Content = &item.Content
 	}
+	if h := item.Highlights; h.Name.Valid || h.Title.Valid || h.File.Valid {
+		c.Highlights = &TorrentContentHighlights{
+			MatchedFields: h.MatchedFields(),
+			Name:          search.ParseHighlight(h.Name),
+			Title:         search.ParseHighlight(h.Title),
+			File:          search.ParseHighlight(h.File),
+		}
+	}
 	languages := item.Languages.Slice()
 	if len(languages) > 0 {
 		c.Languages = languages
 	}
+	subtitleLanguages := item.SubtitleLanguages.Slice()
+	if len(subtitleLanguages) > 0 {
+		c.SubtitleLanguages = subtitleLanguages
+	}
+	if len(item.HdrFormats) > 0 {
+		c.HdrFormats = item.HdrFormats
+	}
+	if len(item.AudioFormats) > 0 {
+		c.AudioFormats = item.AudioFormats
+	}
+	if len(item.ReleaseTokens) > 0 {
+		c.ReleaseTokens = item.ReleaseTokens
+	}
+	if !item.AirDate.IsNil() {
+		c.AirDate = &item.AirDate
+	}
+	c.SportEvent = item.SportEvent
+	if len(item.FileContents) > 0 {
+		c.FileContents = item.FileContents
+	}
 	if len(item.Episodes) > 0 {
 		c.Episodes = &Episodes{
 			Label:   item.Episodes.String(),
 			Seasons: item.Episodes.SeasonEntries(),
+			Pack:    item.Episodes.IsPack(),
 		}
 	}
 	return c
 }
 
 type TorrentSource struct {
-	Key      string
-	Name     string
-	ImportID model.NullString
-	Seeders  model.NullUint
-	Leechers model.NullUint
+	Key             string
+	Name            string
+	ImportID        model.NullString
+	DiscoveryMethod model.NullDiscoveryMethod
+	Seeders         model.NullUint
+	Leechers        model.NullUint
+	Health          model.NullFloat32
+	UpdatedAt       time.Time
 }
 
-func TorrentSourcesFromTorrent(t model.Torrent) []TorrentSource {
+// TorrentSourcesFromTorrent returns the sources of a torrent, with their health decayed to the present.
+func TorrentSourcesFromTorrent(t model.Torrent, healthHalfLife time.Duration) []TorrentSource {
+	now := time.Now()
 	var sources []TorrentSource
 	for _, s := range t.Sources {
 		sources = append(sources, TorrentSource{
-			Key:      s.Source,
-			Name:     s.TorrentSource.Name,
-			ImportID: s.ImportID,
-			Seeders:  s.Seeders,
-			Leechers: s.Leechers,
+			Key:             s.Source,
+			Name:            s.TorrentSource.Name,
+			ImportID:        s.ImportID,
+			DiscoveryMethod: s.DiscoveryMethod,
+			Seeders:         s.Seeders,
+			Leechers:        s.Leechers,
+			Health:          s.DecayedHealth(now, healthHalfLife),
+			UpdatedAt:       s.UpdatedAt,
 		})
 	}
 	return sources
@@ -100,47 +172,45 @@ func TorrentSourcesFromTorrent(t model.Torrent) []TorrentSource {
 type TorrentContentSearchResult struct {
 	TotalCount   uint
 	HasNextPage  bool
+	NextCursor   model.NullString
 	Items        []TorrentContent
 	Aggregations gen.TorrentContentAggregations
 }
 
-func (t TorrentContentQuery) Search(ctx context.Context, query *q.SearchParams, facets *gen.TorrentContentFacetsInput) (TorrentContentSearchResult, error) {
+func (t TorrentContentQuery) Search(
+	ctx context.Context,
+	query *q.SearchParams,
+	facets *gen.TorrentContentFacetsInput,
+	filter *gen.TorrentContentFilterInput,
+) (TorrentContentSearchResult, error) {
 	options := []q.Option{
 		search.TorrentContentDefaultOption(),
 	}
 	if query != nil {
 		options = append(options, query.Option())
-	}
-	if facets != nil {
-		var qFacets []q.Facet
-		if contentType, ok := facets.ContentType.ValueOK(); ok {
-			qFacets = append(qFacets, torrentContentTypeFacet(*contentType))
+		if query.QueryString.Valid && query.Fuzzy.Bool {
+			options = append(options, search.TorrentContentFuzzyQueryString(query.QueryString.String))
 		}
-		if torrentSource, ok := facets.TorrentSource.ValueOK(); ok {
-			qFacets = append(qFacets, torrentSourceFacet(*torrentSource))
+		if query.QueryString.Valid {
+			options = append(options, t.SearchConfig.QueryStringOrder())
+			if query.Highlight.Bool {
+				options = append(options, search.TorrentContentHighlight(query.QueryString.String))
+			}
 		}
-		if torrentTag, ok := facets.TorrentTag.ValueOK(); ok {
-			qFacets = append(qFacets, torrentTagFacet(*torrentTag))
-		}
-		if torrentFileType, ok := facets.TorrentFileType.ValueOK(); ok {
-			qFacets = append(qFacets, torrentFileTypeFacet(*torrentFileType))
-		}
-		if language, ok := facets.Language.ValueOK(); ok {
-			qFacets = append(qFacets, languageFacet(*language))
-		}
-		if genre, ok := facets.Genre.ValueOK(); ok {
-			qFacets = append(qFacets, genreFacet(*genre))
-		}
-		if releaseYear, ok := facets.ReleaseYear.ValueOK(); ok {
-			qFacets = append(qFacets, releaseYearFacet(*releaseYear))
-		}
-		if videoResolution, ok := facets.VideoResolution.ValueOK(); ok {
-			qFacets = append(qFacets, videoResolutionFacet(*videoResolution))
-		}
-		if videoSource, ok := facets.VideoSource.ValueOK(); ok {
-			qFacets = append(qFacets, videoSourceFacet(*videoSource))
+	}
+	if facets != nil {
+		options = append(options, q.WithFacet(torrentContentFacets(*facets)...))
+	}
+	if filter != nil {
+		criteria, err := torrentContentFilterCriteria(*filter, 0)
+		if err != nil {
+			return TorrentContentSearchResult{}, err
 		}
-		options = append(options, q.WithFacet(qFacets...))
+		options = append(options, q.Where(criteria))
+	}
+	// quarantined torrents are excluded unless the filter asks for them
+	if filter == nil || !filter.Quarantined.IsSet() {
+		options = append(options, q.Where(search.TorrentContentQuarantinedCriteria(false)))
 	}
 	result, resultErr := t.TorrentContentSearch.TorrentContent(ctx, options...)
 	if resultErr != nil {
@@ -149,6 +219,50 @@ func (t TorrentContentQuery) Search(ctx context.Context, query *q.SearchParams,
 	return transformTorrentContentSearchResult(result)
 }
 
+func torrentContentFacets(facets gen.TorrentContentFacetsInput) []q.Facet {
+	var qFacets []q.Facet
+	if contentType, ok := facets.ContentType.ValueOK(); ok {
+		qFacets = append(qFacets, torrentContentTypeFacet(*contentType))
+	}
+	if torrentSource, ok := facets.TorrentSource.ValueOK(); ok {
+		qFacets = append(qFacets, torrentSourceFacet(*torrentSource))
+	}
+	if torrentTag, ok := facets.TorrentTag.ValueOK(); ok {
+		qFacets = append(qFacets, torrentTagFacet(*torrentTag))
+	}
+	if torrentFileType, ok := facets.TorrentFileType.ValueOK(); ok {
+		qFacets = append(qFacets, torrentFileTypeFacet(*torrentFileType))
+	}
+	if language, ok := facets.Language.ValueOK(); ok {
+		qFacets = append(qFacets, languageFacet(*language))
+	}
+	if genre, ok := facets.Genre.ValueOK(); ok {
+		qFacets = append(qFacets, genreFacet(*genre))
+	}
+	if releaseYear, ok := facets.ReleaseYear.ValueOK(); ok {
+		qFacets = append(qFacets, releaseYearFacet(*releaseYear))
+	}
+	if videoResolution, ok := facets.VideoResolution.ValueOK(); ok {
+		qFacets = append(qFacets, videoResolutionFacet(*videoResolution))
+	}
+	if videoSource, ok := facets.VideoSource.ValueOK(); ok {
+		qFacets = append(qFacets, videoSourceFacet(*videoSource))
+	}
+	if videoCodec, ok := facets.VideoCodec.ValueOK(); ok {
+		qFacets = append(qFacets, videoCodecFacet(*videoCodec))
+	}
+	if hdrFormat, ok := facets.HdrFormat.ValueOK(); ok {
+		qFacets = append(qFacets, hdrFormatFacet(*hdrFormat))
+	}
+	if audioFormat, ok := facets.AudioFormat.ValueOK(); ok {
+		qFacets = append(qFacets, audioFormatFacet(*audioFormat))
+	}
+	if releaseToken, ok := facets.ReleaseToken.ValueOK(); ok {
+		qFacets = append(qFacets, releaseTokenFacet(*releaseToken))
+	}
+	return qFacets
+}
+
 func transformTorrentContentSearchResult(result q.GenericResult[search.TorrentContentResultItem]) (TorrentContentSearchResult, error) {
 	aggs, aggsErr := transformTorrentContentAggregations(result.Aggregations)
 	if aggsErr != nil {
@@ -161,6 +275,7 @@ func transformTorrentContentSearchResult(result q.GenericResult[search.TorrentCo
 	return TorrentContentSearchResult{
 		TotalCount:   result.TotalCount,
 		HasNextPage:  result.HasNextPage,
+		NextCursor:   model.NullString{String: result.NextCursor, Valid: result.NextCursor != ""},
 		Items:        items,
 		Aggregations: aggs,
 	}, nil
@@ -231,5 +346,33 @@ func transformTorrentContentAggregations(aggs q.Aggregations) (gen.TorrentConten
 		}
 		a.VideoSource = agg
 	}
+	if videoCodec, ok := aggs[search.VideoCodecFacetKey]; ok {
+		agg, err := videoCodecAggs(videoCodec.Items)
+		if err != nil {
+			return a, err
+		}
+		a.VideoCodec = agg
+	}
+	if hdrFormat, ok := aggs[search.HdrFormatFacetKey]; ok {
+		agg, err := hdrFormatAggs(hdrFormat.Items)
+		if err != nil {
+			return a, err
+		}
+		a.HdrFormat = agg
+	}
+	if audioFormat, ok := aggs[search.AudioFormatFacetKey]; ok {
+		agg, err := audioFormatAggs(audioFormat.Items)
+		if err != nil {
+			return a, err
+		}
+		a.AudioFormat = agg
+	}
+	if releaseTokens, ok := aggs[search.ReleaseTokenFacetKey]; ok {
+		agg, err := releaseTokenAggs(releaseTokens.Items)
+		if err != nil {
+			return a, err
+		}
+		a.ReleaseToken = agg
+	}
 	return a, nil
 }
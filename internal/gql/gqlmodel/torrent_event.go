@@ -0,0 +1,37 @@
+package gqlmodel
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/events"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+)
+
+// TorrentEvents subscribes to the event bus, forwarding the events of the given types; all events are forwarded if no types are given.
+func TorrentEvents(ctx context.Context, bus events.Bus, types []model.TorrentEventType) (<-chan events.Event, error) {
+	in, err := bus.Subscribe(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(types) == 0 {
+		return in, nil
+	}
+	typeSet := make(map[model.TorrentEventType]struct{}, len(types))
+	for _, t := range types {
+		typeSet[t] = struct{}{}
+	}
+	out := make(chan events.Event)
+	go func() {
+		defer close(out)
+		for e := range in {
+			if _, ok := typeSet[e.Type]; !ok {
+				continue
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case out <- e:
+			}
+		}
+	}()
+	return out, nil
+}
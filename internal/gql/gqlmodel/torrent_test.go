@@ -0,0 +1,60 @@
+package gqlmodel
+
+import (
+	"context"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/search"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+	"testing"
+)
+
+func lookupContext(t *testing.T, query string) context.Context {
+	t.Helper()
+	doc, err := parser.ParseQuery(&ast.Source{Input: query})
+	require.NoError(t, err)
+	field, ok := doc.Operations[0].SelectionSet[0].(*ast.Field)
+	require.True(t, ok)
+	ctx := graphql.WithOperationContext(context.Background(), &graphql.OperationContext{Doc: doc})
+	return graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Field: graphql.CollectedField{Field: field, Selections: field.SelectionSet},
+	})
+}
+
+func TestTorrentLookupFields(t *testing.T) {
+	t.Parallel()
+
+	for _, c := range []struct {
+		query    string
+		expected search.TorrentLookupFields
+	}{
+		{
+			query: `{ lookup { infoHash known } }`,
+		},
+		{
+			query:    `{ lookup { known torrent { name seeders } } }`,
+			expected: search.TorrentLookupFields{Torrent: true},
+		},
+		{
+			query:    `{ lookup { torrent { name files { path } } } }`,
+			expected: search.TorrentLookupFields{Torrent: true, TorrentFiles: true},
+		},
+		{
+			query:    `{ lookup { torrentContent { title } } }`,
+			expected: search.TorrentLookupFields{TorrentContent: true},
+		},
+		{
+			query:    `{ lookup { torrentContent { torrent { fileTree { path } } } } }`,
+			expected: search.TorrentLookupFields{Torrent: true, TorrentFiles: true, TorrentContent: true},
+		},
+	} {
+		assert.Equal(t, c.expected, torrentLookupFields(lookupContext(t, c.query)), c.query)
+	}
+
+	assert.Equal(t,
+		search.TorrentLookupFields{Torrent: true, TorrentFiles: true, TorrentContent: true},
+		torrentLookupFields(context.Background()),
+	)
+}
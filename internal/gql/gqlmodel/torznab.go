@@ -0,0 +1,49 @@
+package gqlmodel
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/auth"
+	"github.com/bitmagnet-io/bitmagnet/internal/gql/gqlmodel/gen"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/torznab/apikey"
+)
+
+type TorznabQuery struct {
+	Manager apikey.Manager
+}
+
+// listedKeyPrefixLength is the number of characters of a listed API key that are shown, enough to tell keys apart;
+// the full key is only shown when it's created.
+const listedKeyPrefixLength = 6
+
+func (t TorznabQuery) ApiKeys(ctx context.Context) ([]model.TorznabAPIKey, error) {
+	if err := auth.Authorize(ctx, auth.PermissionAdmin); err != nil {
+		return nil, err
+	}
+	keys, err := t.Manager.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range keys {
+		if len(keys[i].Key) > listedKeyPrefixLength {
+			keys[i].Key = keys[i].Key[:listedKeyPrefixLength]
+		}
+	}
+	return keys, nil
+}
+
+type TorznabMutation struct {
+	Manager apikey.Manager
+}
+
+func (t TorznabMutation) CreateApiKey(ctx context.Context, input gen.TorznabAPIKeyInput) (model.TorznabAPIKey, error) {
+	rateLimit := model.NullUint{}
+	if limit, ok := input.RateLimit.ValueOK(); ok && limit != nil && *limit > 0 {
+		rateLimit = model.NewNullUint(uint(*limit))
+	}
+	return t.Manager.Create(ctx, input.Name, rateLimit)
+}
+
+func (t TorznabMutation) DeleteApiKeys(ctx context.Context, names []string) (*string, error) {
+	return nil, t.Manager.Delete(ctx, names...)
+}
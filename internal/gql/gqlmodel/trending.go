@@ -0,0 +1,60 @@
+package gqlmodel
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/cache"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/search"
+	"github.com/bitmagnet-io/bitmagnet/internal/gql/gqlmodel/gen"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"time"
+)
+
+type TrendingContent struct {
+	Content         model.Content
+	DiscoveredCount int
+	SwarmGrowth     float64
+	Score           float64
+}
+
+func (c ContentQuery) Trending(ctx context.Context, input *gen.ContentTrendingInput) ([]TrendingContent, error) {
+	q := search.TrendingQuery{
+		CacheMode: cache.ModeCached,
+	}
+	if input != nil {
+		if ct, ok := input.ContentType.ValueOK(); ok && ct != nil {
+			q.ContentType = model.NewNullContentType(*ct)
+		}
+		if hours, ok := input.WindowHours.ValueOK(); ok && hours != nil && *hours > 0 {
+			q.Window = time.Duration(*hours) * time.Hour
+		}
+		if limit, ok := input.Limit.ValueOK(); ok && limit != nil && *limit > 0 {
+			q.Limit = uint(*limit)
+		}
+	}
+	items, err := c.Search.ContentTrending(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	refs := make([]model.ContentRef, 0, len(items))
+	for _, item := range items {
+		refs = append(refs, item.Ref())
+	}
+	contents, err := loadContents(ctx, c.Search, refs...)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]TrendingContent, 0, len(items))
+	for _, item := range items {
+		content, ok := contents[item.Ref()]
+		if !ok {
+			continue
+		}
+		result = append(result, TrendingContent{
+			Content:         content,
+			DiscoveredCount: item.DiscoveredCount,
+			SwarmGrowth:     item.SwarmGrowth,
+			Score:           item.Score,
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,84 @@
+package gqlmodel
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/gql/gqlmodel/gen"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"strconv"
+	"time"
+)
+
+type WebhookQuery struct {
+	Dao *dao.Query
+}
+
+type WebhookDeliveriesResult struct {
+	Items []model.WebhookDelivery
+}
+
+func (w WebhookQuery) Deliveries(ctx context.Context, query *gen.WebhookDeliveriesQueryInput) (WebhookDeliveriesResult, error) {
+	limit, offset := defaultListLimit, 0
+	q := w.Dao.WebhookDelivery.WithContext(ctx)
+	if query != nil {
+		if endpoints, ok := query.Endpoints.ValueOK(); ok && len(endpoints) > 0 {
+			q = q.Where(w.Dao.WebhookDelivery.Endpoint.In(endpoints...))
+		}
+		if eventTypes, ok := query.EventTypes.ValueOK(); ok && len(eventTypes) > 0 {
+			values := make([]driver.Valuer, 0, len(eventTypes))
+			for _, t := range eventTypes {
+				values = append(values, t)
+			}
+			q = q.Where(w.Dao.WebhookDelivery.EventType.In(values...))
+		}
+		if statuses, ok := query.Statuses.ValueOK(); ok && len(statuses) > 0 {
+			values := make([]driver.Valuer, 0, len(statuses))
+			for _, s := range statuses {
+				values = append(values, s)
+			}
+			q = q.Where(w.Dao.WebhookDelivery.Status.In(values...))
+		}
+		limit, offset = listLimitOffset(query.Limit, query.Offset)
+	}
+	deliveries, err := q.Order(w.Dao.WebhookDelivery.ID.Desc()).Limit(limit).Offset(offset).Find()
+	if err != nil {
+		return WebhookDeliveriesResult{}, err
+	}
+	items := make([]model.WebhookDelivery, 0, len(deliveries))
+	for _, d := range deliveries {
+		items = append(items, *d)
+	}
+	return WebhookDeliveriesResult{Items: items}, nil
+}
+
+type WebhookMutation struct {
+	Dao *dao.Query
+}
+
+func (w WebhookMutation) Redeliver(ctx context.Context, ids []string) (int, error) {
+	intIds := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		n, err := strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid webhook delivery ID %q: %w", id, err)
+		}
+		intIds = append(intIds, n)
+	}
+	if len(intIds) == 0 {
+		return 0, nil
+	}
+	result, err := w.Dao.WebhookDelivery.WithContext(ctx).Where(
+		w.Dao.WebhookDelivery.ID.In(intIds...),
+	).UpdateSimple(
+		w.Dao.WebhookDelivery.Status.Value(model.WebhookDeliveryStatusPending),
+		w.Dao.WebhookDelivery.Attempts.Value(0),
+		w.Dao.WebhookDelivery.NextAttemptAt.Value(time.Now()),
+		w.Dao.WebhookDelivery.UpdatedAt.Value(time.Now()),
+	)
+	if err != nil {
+		return 0, err
+	}
+	return int(result.RowsAffected), nil
+}
@@ -0,0 +1,22 @@
+package httpserver
+
+type Config struct {
+	// ComplexityLimit is the maximum complexity of an operation, being the number of fields it selects including those
+	// of nested selections; operations aren't limited if zero.
+	ComplexityLimit uint `mapstructure:"complexity_limit"`
+	// DepthLimit is the maximum depth of nested selections in an operation, excluding introspection; operations aren't
+	// limited if zero.
+	DepthLimit uint `mapstructure:"depth_limit"`
+	// PersistedQueriesFile is the path of a JSON file mapping the SHA-256 hashes of queries to the queries, which clients
+	// can then send by hash alone.
+	PersistedQueriesFile string `mapstructure:"persisted_queries_file"`
+	// PersistedQueriesOnly rejects queries that aren't in the persisted queries file, unless the user has the admin role.
+	PersistedQueriesOnly bool `mapstructure:"persisted_queries_only"`
+}
+
+func NewDefaultConfig() Config {
+	return Config{
+		ComplexityLimit: 1000,
+		DepthLimit:      15,
+	}
+}
@@ -1,20 +1,30 @@
 package httpserver
 
 import (
+	"context"
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/99designs/gqlgen/graphql/playground"
+	"github.com/bitmagnet-io/bitmagnet/internal/audit"
+	"github.com/bitmagnet-io/bitmagnet/internal/auth"
 	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/httpserver"
 	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
 	"github.com/gin-gonic/gin"
+	"github.com/vektah/gqlparser/v2/ast"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
+	"time"
 )
 
 type Params struct {
 	fx.In
-	Schema lazy.Lazy[graphql.ExecutableSchema]
-	Logger *zap.SugaredLogger
+	Config        Config
+	Schema        lazy.Lazy[graphql.ExecutableSchema]
+	AuditRecorder lazy.Lazy[audit.Recorder]
+	Logger        *zap.SugaredLogger
 }
 
 type Result struct {
@@ -25,13 +35,17 @@ type Result struct {
 func New(p Params) Result {
 	return Result{
 		Option: &builder{
-			schema: p.Schema,
+			config:        p.Config,
+			schema:        p.Schema,
+			auditRecorder: p.AuditRecorder,
 		},
 	}
 }
 
 type builder struct {
-	schema lazy.Lazy[graphql.ExecutableSchema]
+	config        Config
+	schema        lazy.Lazy[graphql.ExecutableSchema]
+	auditRecorder lazy.Lazy[audit.Recorder]
 }
 
 func (builder) Key() string {
@@ -43,13 +57,112 @@ func (b builder) Apply(e *gin.Engine) error {
 	if err != nil {
 		return err
 	}
-	gql := handler.NewDefaultServer(schema)
+	auditRecorder, err := b.auditRecorder.Get()
+	if err != nil {
+		return err
+	}
+	gql, err := b.newServer(schema)
+	if err != nil {
+		return err
+	}
+	gql.AroundOperations(authorizeMutations)
+	gql.AroundFields(func(ctx context.Context, next graphql.Resolver) (any, error) {
+		return auditMutations(ctx, next, auditRecorder)
+	})
 	e.POST("/graphql", func(c *gin.Context) {
+		c.Request = c.Request.WithContext(audit.WithClientIP(c.Request.Context(), c.ClientIP()))
 		gql.ServeHTTP(c.Writer, c.Request)
 	})
 	pg := playground.Handler("GraphQL playground", "/graphql")
 	e.GET("/graphql", func(c *gin.Context) {
+		// subscriptions are served over a WebSocket connection to the same endpoint as the playground
+		if c.IsWebsocket() {
+			gql.ServeHTTP(c.Writer, c.Request)
+			return
+		}
 		pg.ServeHTTP(c.Writer, c.Request)
 	})
 	return nil
 }
+
+// newServer returns a server configured as by handler.NewDefaultServer, with the addition of the configured limits and
+// persisted queries.
+func (b builder) newServer(schema graphql.ExecutableSchema) (*handler.Server, error) {
+	srv := handler.New(schema)
+	srv.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: 10 * time.Second,
+	})
+	srv.AddTransport(transport.Options{})
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+	srv.AddTransport(transport.MultipartForm{})
+	srv.SetQueryCache(lru.New(1000))
+	srv.Use(extension.Introspection{})
+	if b.config.PersistedQueriesFile != "" || b.config.PersistedQueriesOnly {
+		pq := persistedQueries{only: b.config.PersistedQueriesOnly}
+		if b.config.PersistedQueriesFile != "" {
+			queries, err := loadPersistedQueries(b.config.PersistedQueriesFile)
+			if err != nil {
+				return nil, err
+			}
+			pq.queries = queries
+		}
+		// persisted queries must be resolved before automatic persisted queries, which reject unknown hashes
+		srv.Use(pq)
+	}
+	srv.Use(extension.AutomaticPersistedQuery{
+		Cache: lru.New(100),
+	})
+	if b.config.ComplexityLimit > 0 {
+		srv.Use(extension.FixedComplexityLimit(int(b.config.ComplexityLimit)))
+	}
+	if b.config.DepthLimit > 0 {
+		srv.Use(depthLimit(b.config.DepthLimit))
+	}
+	return srv, nil
+}
+
+// readMutations are the fields of the root Mutation type that are permitted with the read permission,
+// as they're used by users that can't otherwise make changes.
+var readMutations = map[string]struct{}{
+	"report": {},
+}
+
+// authorizeMutations requires the admin role for mutations, when authentication is enabled,
+// unless the mutation only selects read mutations.
+func authorizeMutations(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	if op := graphql.GetOperationContext(ctx); op.Operation != nil && op.Operation.Operation == ast.Mutation {
+		permission := auth.PermissionRead
+		for _, selection := range op.Operation.SelectionSet {
+			f, ok := selection.(*ast.Field)
+			if !ok {
+				permission = auth.PermissionAdmin
+				break
+			}
+			if _, ok := readMutations[f.Name]; !ok && f.Name != "__typename" {
+				permission = auth.PermissionAdmin
+				break
+			}
+		}
+		if err := auth.Authorize(ctx, permission); err != nil {
+			return graphql.OneShot(graphql.ErrorResponse(ctx, "%s", err.Error()))
+		}
+	}
+	return next(ctx)
+}
+
+// auditMutations records each mutation action, being a field of one of the mutation types nested under the root Mutation type.
+func auditMutations(ctx context.Context, next graphql.Resolver, recorder audit.Recorder) (any, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc.Parent == nil || fc.Parent.Object != "Mutation" {
+		return next(ctx)
+	}
+	res, err := next(ctx)
+	recorder.Record(
+		ctx,
+		fc.Parent.Field.Name+"."+fc.Field.Name,
+		fc.Field.ArgumentMap(graphql.GetOperationContext(ctx).Variables),
+		err,
+	)
+	return res, err
+}
@@ -0,0 +1,156 @@
+package httpserver
+
+import (
+	"context"
+	"github.com/99designs/gqlgen/complexity"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/bitmagnet-io/bitmagnet/internal/auth"
+	"github.com/bitmagnet-io/bitmagnet/internal/gql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestAuthorizeMutations(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		query      string
+		role       auth.Role
+		authorized bool
+	}{
+		{"query", `query { torrent { __typename } }`, auth.RoleReadOnly, true},
+		{"report", `mutation { report { submit(input: {infoHashes: [], reason: fake}) } }`, auth.RoleReadOnly, true},
+		{"report and other", `mutation { report { __typename } torrent { __typename } }`, auth.RoleReadOnly, false},
+		{"other", `mutation { torrent { __typename } }`, auth.RoleReadOnly, false},
+		{"other as admin", `mutation { torrent { __typename } }`, auth.RoleAdmin, true},
+		{"report as torznab", `mutation { report { __typename } }`, auth.RoleTorznab, false},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			doc, err := parser.ParseQuery(&ast.Source{Input: test.query})
+			require.NoError(t, err)
+			ctx := auth.WithUser(context.Background(), auth.User{Name: "user", Role: test.role})
+			ctx = graphql.WithOperationContext(ctx, &graphql.OperationContext{Operation: doc.Operations[0]})
+			called := false
+			handler := authorizeMutations(ctx, func(ctx context.Context) graphql.ResponseHandler {
+				called = true
+				return graphql.OneShot(&graphql.Response{})
+			})
+			response := handler(ctx)
+			assert.Equal(t, test.authorized, called)
+			assert.Equal(t, test.authorized, len(response.Errors) == 0)
+		})
+	}
+}
+
+func TestDepthLimit(t *testing.T) {
+	t.Parallel()
+
+	schema := gql.NewExecutableSchema(gql.Config{}).Schema()
+	for query, expected := range map[string]int{
+		`{ __typename }`: 0,
+		`{ torrent { suggestTags(query: {}) { suggestions { name } } } }`:                                                       4,
+		`query { __schema { types { fields { type { ofType { ofType { name } } } } } } }`:                                       0,
+		`{ ...A } fragment A on Query { torrent { ... on TorrentQuery { __typename suggestTags(query: {}) { __typename } } } }`: 2,
+	} {
+		doc, err := gqlparser.LoadQuery(schema, query)
+		require.Empty(t, err, query)
+		depth := selectionSetDepth(doc.Operations[0].SelectionSet)
+		assert.Equal(t, expected, depth, query)
+		rc := &graphql.OperationContext{Operation: doc.Operations[0]}
+		assert.Nil(t, depthLimit(expected).MutateOperationContext(context.Background(), rc), query)
+		if expected > 0 {
+			assert.NotNil(t, depthLimit(expected-1).MutateOperationContext(context.Background(), rc), query)
+		}
+	}
+}
+
+// TestDocumentLimits checks that the documents of the web UI are within the default limits.
+func TestDocumentLimits(t *testing.T) {
+	t.Parallel()
+
+	es := gql.NewExecutableSchema(gql.Config{})
+	config := NewDefaultConfig()
+	paths, err := filepath.Glob("../../../graphql/*/*.graphql")
+	require.NoError(t, err)
+	for _, path := range paths {
+		if filepath.Base(filepath.Dir(path)) == "fragments" {
+			continue
+		}
+		doc, gqlErr := gqlparser.LoadQuery(es.Schema(), readDocument(t, path, make(map[string]struct{})))
+		require.Empty(t, gqlErr, path)
+		op := doc.Operations[0]
+		assert.LessOrEqual(t, selectionSetDepth(op.SelectionSet), int(config.DepthLimit), path)
+		assert.LessOrEqual(t, complexity.Calculate(es, op, nil), int(config.ComplexityLimit), path)
+	}
+}
+
+// readDocument returns a document with the fragments of its #import lines appended.
+func readDocument(t *testing.T, path string, imported map[string]struct{}) string {
+	imported[path] = struct{}{}
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	document := string(data)
+	for _, line := range strings.Split(document, "\n") {
+		if importPath, ok := strings.CutPrefix(line, "#import "); ok {
+			importPath = filepath.Join(filepath.Dir(path), strings.Trim(importPath, `"`)+".graphql")
+			if _, ok := imported[importPath]; !ok {
+				document += "\n" + readDocument(t, importPath, imported)
+			}
+		}
+	}
+	return document
+}
+
+func TestPersistedQueries(t *testing.T) {
+	t.Parallel()
+
+	persisted := `{ __typename }`
+	hash := queryHash(persisted)
+	path := filepath.Join(t.TempDir(), "queries.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"`+strings.ToUpper(hash)+`": "{ __typename }"}`), 0o600))
+	queries, err := loadPersistedQueries(path)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{hash: persisted}, queries)
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"`+hash+`": "{ torrent { __typename } }"}`), 0o600))
+	_, err = loadPersistedQueries(path)
+	assert.Error(t, err)
+
+	byHash := func() *graphql.RawParams {
+		return &graphql.RawParams{
+			Extensions: map[string]any{"persistedQuery": map[string]any{"version": 1, "sha256Hash": hash}},
+		}
+	}
+	admin := auth.WithUser(context.Background(), auth.User{Name: "admin", Role: auth.RoleAdmin})
+	readOnly := auth.WithUser(context.Background(), auth.User{Name: "user", Role: auth.RoleReadOnly})
+
+	for _, only := range []bool{false, true} {
+		pq := persistedQueries{queries: queries, only: only}
+		params := byHash()
+		assert.Nil(t, pq.MutateOperationParameters(readOnly, params))
+		assert.Equal(t, persisted, params.Query)
+		assert.Nil(t, pq.MutateOperationParameters(readOnly, &graphql.RawParams{Query: persisted}))
+		assert.Nil(t, pq.MutateOperationParameters(admin, &graphql.RawParams{Query: `{ torrent { __typename } }`}))
+		otherErr := pq.MutateOperationParameters(readOnly, &graphql.RawParams{Query: `{ torrent { __typename } }`})
+		anonymousErr := pq.MutateOperationParameters(context.Background(), &graphql.RawParams{Query: `{ torrent { __typename } }`})
+		if only {
+			assert.NotNil(t, otherErr)
+			assert.NotNil(t, anonymousErr)
+		} else {
+			assert.Nil(t, otherErr)
+			assert.Nil(t, anonymousErr)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package httpserver
+
+import (
+	"context"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"strings"
+)
+
+// depthLimit rejects operations with selections nested deeper than the limit, as each level of nesting can require
+// further joins against the database.
+type depthLimit uint
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationContextMutator
+} = depthLimit(0)
+
+func (depthLimit) ExtensionName() string {
+	return "DepthLimit"
+}
+
+func (depthLimit) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (l depthLimit) MutateOperationContext(_ context.Context, rc *graphql.OperationContext) *gqlerror.Error {
+	if depth := selectionSetDepth(rc.Operation.SelectionSet); depth > int(l) {
+		return gqlerror.Errorf("operation has depth %d, which exceeds the limit of %d", depth, l)
+	}
+	return nil
+}
+
+// selectionSetDepth returns the depth of the most nested field of a selection set, including the fields of fragments;
+// introspection fields are excluded, as introspection queries are deeply nested but don't touch the database.
+func selectionSetDepth(selectionSet ast.SelectionSet) int {
+	maxDepth := 0
+	for _, selection := range selectionSet {
+		depth := 0
+		switch s := selection.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(s.Name, "__") {
+				continue
+			}
+			depth = 1 + selectionSetDepth(s.SelectionSet)
+		case *ast.FragmentSpread:
+			if s.Definition != nil {
+				depth = selectionSetDepth(s.Definition.SelectionSet)
+			}
+		case *ast.InlineFragment:
+			depth = selectionSetDepth(s.SelectionSet)
+		}
+		maxDepth = max(maxDepth, depth)
+	}
+	return maxDepth
+}
@@ -0,0 +1,85 @@
+package httpserver
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/bitmagnet-io/bitmagnet/internal/auth"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"os"
+	"strings"
+)
+
+// persistedQueries resolves queries sent by the hash of a persisted query, using the persistedQuery extension of
+// automatic persisted queries, which handles any hashes that aren't found. If only is set, other queries are rejected
+// unless the user has the admin role.
+type persistedQueries struct {
+	queries map[string]string
+	only    bool
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationParameterMutator
+} = persistedQueries{}
+
+func (persistedQueries) ExtensionName() string {
+	return "PersistedQueries"
+}
+
+func (persistedQueries) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (p persistedQueries) MutateOperationParameters(ctx context.Context, rawParams *graphql.RawParams) *gqlerror.Error {
+	if rawParams.Query == "" {
+		if query, ok := p.queries[persistedQueryHash(rawParams)]; ok {
+			rawParams.Query = query
+			return nil
+		}
+	} else if _, ok := p.queries[queryHash(rawParams.Query)]; ok {
+		return nil
+	}
+	if p.only {
+		if user, ok := auth.UserFromContext(ctx); !ok || !user.Role.Can(auth.PermissionAdmin) {
+			return gqlerror.Errorf("only persisted queries are permitted")
+		}
+	}
+	return nil
+}
+
+// persistedQueryHash returns the lower cased hash of the persistedQuery extension, or an empty string.
+func persistedQueryHash(rawParams *graphql.RawParams) string {
+	extension, _ := rawParams.Extensions["persistedQuery"].(map[string]any)
+	hash, _ := extension["sha256Hash"].(string)
+	return strings.ToLower(hash)
+}
+
+func queryHash(query string) string {
+	hash := sha256.Sum256([]byte(query))
+	return hex.EncodeToString(hash[:])
+}
+
+// loadPersistedQueries reads a JSON file mapping the SHA-256 hashes of queries to the queries, such as the persisted
+// documents generated by GraphQL Code Generator.
+func loadPersistedQueries(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read persisted queries: %w", err)
+	}
+	var queries map[string]string
+	if err := json.Unmarshal(data, &queries); err != nil {
+		return nil, fmt.Errorf("failed to decode persisted queries: %w", err)
+	}
+	result := make(map[string]string, len(queries))
+	for hash, query := range queries {
+		if strings.ToLower(hash) != queryHash(query) {
+			return nil, fmt.Errorf("persisted query hash %s doesn't match its query", hash)
+		}
+		result[strings.ToLower(hash)] = query
+	}
+	return result, nil
+}
@@ -6,10 +6,12 @@ package resolvers
 
 import (
 	"context"
+	"time"
 
 	"github.com/bitmagnet-io/bitmagnet/internal/gql"
 	"github.com/bitmagnet-io/bitmagnet/internal/gql/gqlmodel"
 	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/torrentexport"
 )
 
 // OriginalLanguage is the resolver for the originalLanguage field.
@@ -23,7 +25,36 @@ func (r *contentResolver) OriginalLanguage(ctx context.Context, obj *model.Conte
 
 // Sources is the resolver for the sources field.
 func (r *torrentResolver) Sources(ctx context.Context, obj *model.Torrent) ([]gqlmodel.TorrentSource, error) {
-	return gqlmodel.TorrentSourcesFromTorrent(*obj), nil
+	return gqlmodel.TorrentSourcesFromTorrent(*obj, r.healthHalfLife), nil
+}
+
+// Health is the resolver for the health field.
+func (r *torrentResolver) Health(ctx context.Context, obj *model.Torrent) (*float64, error) {
+	health := obj.Health(time.Now(), r.healthHalfLife)
+	if !health.Valid {
+		return nil, nil
+	}
+	f := float64(health.Float32)
+	return &f, nil
+}
+
+// MagnetURI is the resolver for the magnetUri field.
+func (r *torrentResolver) MagnetURI(ctx context.Context, obj *model.Torrent) (string, error) {
+	return obj.MagnetUri(r.trackerList.Trackers()...), nil
+}
+
+// MagnetURIWithTrackers is the resolver for the magnetUriWithTrackers field.
+func (r *torrentResolver) MagnetURIWithTrackers(ctx context.Context, obj *model.Torrent) (string, error) {
+	return obj.MagnetUri(r.trackerList.Trackers()...), nil
+}
+
+// TorrentFileURL is the resolver for the torrentFileUrl field.
+func (r *torrentResolver) TorrentFileURL(ctx context.Context, obj *model.Torrent) (*string, error) {
+	if !torrentexport.CanReconstruct(*obj) {
+		return nil, nil
+	}
+	u := "/torrents/" + obj.InfoHash.String() + "/torrent"
+	return &u, nil
 }
 
 // Content returns gql.ContentResolver implementation.
@@ -14,13 +14,49 @@ import (
 
 // Torrent is the resolver for the torrent field.
 func (r *mutationResolver) Torrent(ctx context.Context) (gqlmodel.TorrentMutation, error) {
-	return gqlmodel.TorrentMutation{}, nil
+	return gqlmodel.TorrentMutation{
+		Dao:                r.dao,
+		QueuePurger:        r.queuePurger,
+		ProcessorPublisher: r.processorPublisher,
+		EventBus:           r.eventBus,
+		ReprocessManager:   r.reprocessManager,
+	}, nil
 }
 
-// Delete is the resolver for the delete field.
-func (r *torrentMutationResolver) Delete(ctx context.Context, obj *gqlmodel.TorrentMutation, infoHashes []protocol.ID) (*string, error) {
-	_, err := r.dao.DeleteAndBlockTorrents(ctx, infoHashes)
-	return nil, err
+// Takedown is the resolver for the takedown field.
+func (r *mutationResolver) Takedown(ctx context.Context) (gqlmodel.TakedownMutation, error) {
+	return gqlmodel.TakedownMutation{
+		Manager: r.takedown,
+	}, nil
+}
+
+// Queue is the resolver for the queue field.
+func (r *mutationResolver) Queue(ctx context.Context) (gqlmodel.QueueMutation, error) {
+	return gqlmodel.QueueMutation{
+		DeadLetterManager: r.deadLetters,
+		MessageManager:    r.queueMessages,
+	}, nil
+}
+
+// SavedSearch is the resolver for the savedSearch field.
+func (r *mutationResolver) SavedSearch(ctx context.Context) (gqlmodel.SavedSearchMutation, error) {
+	return gqlmodel.SavedSearchMutation{
+		Manager: r.savedSearch,
+	}, nil
+}
+
+// Webhook is the resolver for the webhook field.
+func (r *mutationResolver) Webhook(ctx context.Context) (gqlmodel.WebhookMutation, error) {
+	return gqlmodel.WebhookMutation{
+		Dao: r.dao,
+	}, nil
+}
+
+// Torznab is the resolver for the torznab field.
+func (r *mutationResolver) Torznab(ctx context.Context) (gqlmodel.TorznabMutation, error) {
+	return gqlmodel.TorznabMutation{
+		Manager: r.torznabAPIKeys,
+	}, nil
 }
 
 // PutTags is the resolver for the putTags field.
@@ -38,6 +74,48 @@ func (r *torrentMutationResolver) DeleteTags(ctx context.Context, obj *gqlmodel.
 	return nil, r.dao.TorrentTag.Delete(ctx, infoHashes, tagNames)
 }
 
+// RetryMetaInfo is the resolver for the retryMetaInfo field.
+func (r *torrentMutationResolver) RetryMetaInfo(ctx context.Context, obj *gqlmodel.TorrentMutation, infoHashes []protocol.ID) (*string, error) {
+	return nil, r.dao.RetryMetainfoNow(ctx, infoHashes)
+}
+
+// Download is the resolver for the download field.
+func (r *mutationResolver) Download(ctx context.Context) (gqlmodel.DownloadMutation, error) {
+	return gqlmodel.DownloadMutation{
+		Manager: r.downloads,
+	}, nil
+}
+
+// Review is the resolver for the review field.
+func (r *mutationResolver) Review(ctx context.Context) (gqlmodel.ReviewMutation, error) {
+	return gqlmodel.ReviewMutation{
+		TorrentMutation: gqlmodel.TorrentMutation{
+			Dao:                r.dao,
+			QueuePurger:        r.queuePurger,
+			ProcessorPublisher: r.processorPublisher,
+			EventBus:           r.eventBus,
+			ReprocessManager:   r.reprocessManager,
+		},
+	}, nil
+}
+
+// Content is the resolver for the content field.
+func (r *mutationResolver) Content(ctx context.Context) (gqlmodel.ContentMutation, error) {
+	return gqlmodel.ContentMutation{
+		Dao:                r.dao,
+		Search:             r.search,
+		Watchlist:          r.watchlist,
+		ProcessorPublisher: r.processorPublisher,
+	}, nil
+}
+
+// Report is the resolver for the report field.
+func (r *mutationResolver) Report(ctx context.Context) (gqlmodel.ReportMutation, error) {
+	return gqlmodel.ReportMutation{
+		Dao: r.dao,
+	}, nil
+}
+
 // Mutation returns gql.MutationResolver implementation.
 func (r *Resolver) Mutation() gql.MutationResolver { return &mutationResolver{r} }
 
@@ -7,6 +7,7 @@ package resolvers
 import (
 	"context"
 
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/healthcheck"
 	"github.com/bitmagnet-io/bitmagnet/internal/gql"
 	"github.com/bitmagnet-io/bitmagnet/internal/gql/gqlmodel"
 )
@@ -14,7 +15,10 @@ import (
 // Torrent is the resolver for the torrent field.
 func (r *queryResolver) Torrent(ctx context.Context) (gqlmodel.TorrentQuery, error) {
 	return gqlmodel.TorrentQuery{
-		TorrentSearch: r.search,
+		TorrentSearch:       r.search,
+		TorrentFileSearch:   r.search,
+		TorrentLookupSearch: r.search,
+		Dao:                 r.dao,
 	}, nil
 }
 
@@ -22,6 +26,109 @@ func (r *queryResolver) Torrent(ctx context.Context) (gqlmodel.TorrentQuery, err
 func (r *queryResolver) TorrentContent(ctx context.Context) (gqlmodel.TorrentContentQuery, error) {
 	return gqlmodel.TorrentContentQuery{
 		TorrentContentSearch: r.search,
+		SearchConfig:         r.searchConfig,
+	}, nil
+}
+
+// TaskRun is the resolver for the taskRun field.
+func (r *queryResolver) TaskRun(ctx context.Context) (gqlmodel.TaskRunQuery, error) {
+	return gqlmodel.TaskRunQuery{
+		Dao: r.dao,
+	}, nil
+}
+
+// Content is the resolver for the content field.
+func (r *queryResolver) Content(ctx context.Context) (gqlmodel.ContentQuery, error) {
+	return gqlmodel.ContentQuery{
+		Dao:       r.dao,
+		Search:    r.search,
+		Watchlist: r.watchlist,
+	}, nil
+}
+
+// Takedown is the resolver for the takedown field.
+func (r *queryResolver) Takedown(ctx context.Context) (gqlmodel.TakedownQuery, error) {
+	return gqlmodel.TakedownQuery{
+		Dao: r.dao,
+	}, nil
+}
+
+// Queue is the resolver for the queue field.
+func (r *queryResolver) Queue(ctx context.Context) (gqlmodel.QueueQuery, error) {
+	return gqlmodel.QueueQuery{
+		DeadLetterManager: r.deadLetters,
+		MessageManager:    r.queueMessages,
+		StatsReader:       r.queueStats,
+	}, nil
+}
+
+// SavedSearch is the resolver for the savedSearch field.
+func (r *queryResolver) SavedSearch(ctx context.Context) (gqlmodel.SavedSearchQuery, error) {
+	return gqlmodel.SavedSearchQuery{
+		Manager: r.savedSearch,
+	}, nil
+}
+
+// Webhook is the resolver for the webhook field.
+func (r *queryResolver) Webhook(ctx context.Context) (gqlmodel.WebhookQuery, error) {
+	return gqlmodel.WebhookQuery{
+		Dao: r.dao,
+	}, nil
+}
+
+// Torznab is the resolver for the torznab field.
+func (r *queryResolver) Torznab(ctx context.Context) (gqlmodel.TorznabQuery, error) {
+	return gqlmodel.TorznabQuery{
+		Manager: r.torznabAPIKeys,
+	}, nil
+}
+
+// Download is the resolver for the download field.
+func (r *queryResolver) Download(ctx context.Context) (gqlmodel.DownloadQuery, error) {
+	return gqlmodel.DownloadQuery{
+		Manager: r.downloads,
+	}, nil
+}
+
+// Audit is the resolver for the audit field.
+func (r *queryResolver) Audit(ctx context.Context) (gqlmodel.AuditQuery, error) {
+	return gqlmodel.AuditQuery{
+		Recorder: r.auditRecorder,
+	}, nil
+}
+
+// Health is the resolver for the health field.
+func (r *queryResolver) Health(ctx context.Context) (healthcheck.Report, error) {
+	return r.healthChecker.Check(ctx), nil
+}
+
+// Review is the resolver for the review field.
+func (r *queryResolver) Review(ctx context.Context) (gqlmodel.ReviewQuery, error) {
+	return gqlmodel.ReviewQuery{
+		TorrentSearch:        r.search,
+		TorrentContentSearch: r.search,
+		CandidateFinder:      r.candidateFinder,
+	}, nil
+}
+
+// IndexStats is the resolver for the indexStats field.
+func (r *queryResolver) IndexStats(ctx context.Context) (gqlmodel.IndexStatsQuery, error) {
+	return gqlmodel.IndexStatsQuery{
+		Reader: r.indexStats,
+	}, nil
+}
+
+// Report is the resolver for the report field.
+func (r *queryResolver) Report(ctx context.Context) (gqlmodel.ReportQuery, error) {
+	return gqlmodel.ReportQuery{
+		Dao: r.dao,
+	}, nil
+}
+
+// ClassifierShadow is the resolver for the classifierShadow field.
+func (r *queryResolver) ClassifierShadow(ctx context.Context) (gqlmodel.ClassifierShadowQuery, error) {
+	return gqlmodel.ClassifierShadowQuery{
+		Dao: r.dao,
 	}, nil
 }
 
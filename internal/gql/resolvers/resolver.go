@@ -1,9 +1,28 @@
 package resolvers
 
 import (
+	"github.com/bitmagnet-io/bitmagnet/internal/audit"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/healthcheck"
+	"github.com/bitmagnet-io/bitmagnet/internal/classifier/video"
 	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
 	"github.com/bitmagnet-io/bitmagnet/internal/database/search"
+	"github.com/bitmagnet-io/bitmagnet/internal/download"
+	"github.com/bitmagnet-io/bitmagnet/internal/events"
 	"github.com/bitmagnet-io/bitmagnet/internal/gql"
+	"github.com/bitmagnet-io/bitmagnet/internal/indexstats"
+	"github.com/bitmagnet-io/bitmagnet/internal/processor"
+	"github.com/bitmagnet-io/bitmagnet/internal/processor/asynq/purger"
+	"github.com/bitmagnet-io/bitmagnet/internal/processor/reprocess"
+	"github.com/bitmagnet-io/bitmagnet/internal/queue/deadletter"
+	"github.com/bitmagnet-io/bitmagnet/internal/queue/messages"
+	"github.com/bitmagnet-io/bitmagnet/internal/queue/publisher"
+	"github.com/bitmagnet-io/bitmagnet/internal/queue/stats"
+	"github.com/bitmagnet-io/bitmagnet/internal/savedsearch"
+	"github.com/bitmagnet-io/bitmagnet/internal/takedown"
+	"github.com/bitmagnet-io/bitmagnet/internal/torrentexport"
+	"github.com/bitmagnet-io/bitmagnet/internal/torznab/apikey"
+	"github.com/bitmagnet-io/bitmagnet/internal/watchlist"
+	"time"
 )
 
 // This file will not be regenerated automatically.
@@ -11,16 +30,73 @@ import (
 // It serves as dependency injection for your app, add any dependencies you require here.
 
 type Resolver struct {
-	dao    *dao.Query
-	search search.Search
+	dao                *dao.Query
+	search             search.Search
+	searchConfig       search.Config
+	takedown           takedown.Manager
+	deadLetters        deadletter.Manager
+	queueMessages      messages.Manager
+	queueStats         stats.Reader
+	queuePurger        purger.Purger
+	processorPublisher publisher.Publisher[processor.MessageParams]
+	eventBus           events.Bus
+	savedSearch        savedsearch.Manager
+	torznabAPIKeys     apikey.Manager
+	downloads          download.Manager
+	auditRecorder      audit.Recorder
+	healthChecker      healthcheck.Checker
+	candidateFinder    video.CandidateFinder
+	reprocessManager   reprocess.Manager
+	watchlist          watchlist.Manager
+	trackerList        torrentexport.TrackerList
+	indexStats         indexstats.Reader
+	healthHalfLife     time.Duration
 }
 
 func New(
 	dao *dao.Query,
 	search search.Search,
+	searchConfig search.Config,
+	takedown takedown.Manager,
+	deadLetters deadletter.Manager,
+	queueMessages messages.Manager,
+	queueStats stats.Reader,
+	queuePurger purger.Purger,
+	processorPublisher publisher.Publisher[processor.MessageParams],
+	eventBus events.Bus,
+	savedSearch savedsearch.Manager,
+	torznabAPIKeys apikey.Manager,
+	downloads download.Manager,
+	auditRecorder audit.Recorder,
+	healthChecker healthcheck.Checker,
+	candidateFinder video.CandidateFinder,
+	reprocessManager reprocess.Manager,
+	watchlist watchlist.Manager,
+	trackerList torrentexport.TrackerList,
+	indexStats indexstats.Reader,
+	healthHalfLife time.Duration,
 ) gql.ResolverRoot {
 	return &Resolver{
-		dao:    dao,
-		search: search,
+		dao:                dao,
+		search:             search,
+		searchConfig:       searchConfig,
+		takedown:           takedown,
+		deadLetters:        deadLetters,
+		queueMessages:      queueMessages,
+		queueStats:         queueStats,
+		queuePurger:        queuePurger,
+		processorPublisher: processorPublisher,
+		eventBus:           eventBus,
+		savedSearch:        savedSearch,
+		torznabAPIKeys:     torznabAPIKeys,
+		downloads:          downloads,
+		auditRecorder:      auditRecorder,
+		healthChecker:      healthChecker,
+		candidateFinder:    candidateFinder,
+		reprocessManager:   reprocessManager,
+		watchlist:          watchlist,
+		trackerList:        trackerList,
+		indexStats:         indexStats,
+		healthHalfLife:     healthHalfLife,
 	}
 }
@@ -0,0 +1,24 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.43
+
+import (
+	"context"
+
+	"github.com/bitmagnet-io/bitmagnet/internal/events"
+	"github.com/bitmagnet-io/bitmagnet/internal/gql"
+	"github.com/bitmagnet-io/bitmagnet/internal/gql/gqlmodel"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+)
+
+// TorrentEvents is the resolver for the torrentEvents field.
+func (r *subscriptionResolver) TorrentEvents(ctx context.Context, types []model.TorrentEventType) (<-chan events.Event, error) {
+	return gqlmodel.TorrentEvents(ctx, r.eventBus, types)
+}
+
+// Subscription returns gql.SubscriptionResolver implementation.
+func (r *Resolver) Subscription() gql.SubscriptionResolver { return &subscriptionResolver{r} }
+
+type subscriptionResolver struct{ *Resolver }
@@ -0,0 +1,28 @@
+package imageproxy
+
+import (
+	"github.com/adrg/xdg"
+	"path"
+	"time"
+)
+
+type Config struct {
+	// CacheDir is the directory that fetched images are stored in, in a subdirectory for each size.
+	CacheDir string
+	// MaxCacheSize is the maximum total size in bytes of the cached images; the least recently served images are deleted
+	// once it's exceeded.
+	MaxCacheSize uint64 `validate:"gt=0"`
+	// BaseURL is the TMDB image base URL, to which the size and the image path are appended.
+	BaseURL string
+	// Timeout applies to each image fetched from TMDB.
+	Timeout time.Duration
+}
+
+func NewDefaultConfig() Config {
+	return Config{
+		CacheDir:     path.Join(xdg.CacheHome, "bitmagnet", "images"),
+		MaxCacheSize: 1_000_000_000,
+		BaseURL:      "https://image.tmdb.org/t/p",
+		Timeout:      time.Second * 30,
+	}
+}
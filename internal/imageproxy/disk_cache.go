@@ -0,0 +1,121 @@
+package imageproxy
+
+import (
+	"github.com/hashicorp/golang-lru/v2/simplelru"
+	"io/fs"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// diskCache tracks the files of the image cache in the order they were last served, and deletes the least recently
+// served files once their total size exceeds the maximum. The modification time of a file is updated when it's served,
+// so that the order survives a restart.
+type diskCache struct {
+	mutex   sync.Mutex
+	maxSize int64
+	size    int64
+	files   *simplelru.LRU[string, int64]
+	onError func(file string, err error)
+}
+
+func newDiskCache(dir string, maxSize uint64, onError func(file string, err error)) (*diskCache, error) {
+	c := &diskCache{
+		maxSize: int64(min(maxSize, math.MaxInt64)),
+		onError: onError,
+	}
+	files, err := simplelru.NewLRU[string, int64](math.MaxInt, c.evicted)
+	if err != nil {
+		return nil, err
+	}
+	c.files = files
+	type cachedFile struct {
+		path    string
+		size    int64
+		modTime time.Time
+	}
+	var existing []cachedFile
+	if err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if path == dir && os.IsNotExist(err) {
+				return fs.SkipDir
+			}
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		// incomplete downloads are left by a stop during a fetch
+		if strings.HasPrefix(d.Name(), ".download-") {
+			return os.Remove(path)
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		existing = append(existing, cachedFile{path, info.Size(), info.ModTime()})
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	sort.Slice(existing, func(i, j int) bool {
+		return existing[i].modTime.Before(existing[j].modTime)
+	})
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for _, f := range existing {
+		c.files.Add(f.path, f.size)
+		c.size += f.size
+	}
+	c.evict()
+	return c, nil
+}
+
+// touch marks a file as recently served, returning false if it isn't cached, or has been deleted since it was cached.
+func (c *diskCache) touch(file string) bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if _, ok := c.files.Get(file); !ok {
+		return false
+	}
+	now := time.Now()
+	if err := os.Chtimes(file, now, now); err != nil {
+		if os.IsNotExist(err) {
+			c.files.Remove(file)
+			return false
+		}
+		c.onError(file, err)
+	}
+	return true
+}
+
+// add records a fetched file, deleting the least recently served files if the cache is then too large.
+func (c *diskCache) add(file string, size int64) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if previous, ok := c.files.Peek(file); ok {
+		c.size -= previous
+	}
+	c.files.Add(file, size)
+	c.size += size
+	c.evict()
+}
+
+// evict deletes the least recently served files until the cache isn't too large; the most recent file is kept
+// even if it's larger than the maximum, so that it can be served.
+func (c *diskCache) evict() {
+	for c.size > c.maxSize && c.files.Len() > 1 {
+		c.files.RemoveOldest()
+	}
+}
+
+func (c *diskCache) evicted(file string, size int64) {
+	c.size -= size
+	if err := os.Remove(file); err != nil && !os.IsNotExist(err) {
+		c.onError(file, err)
+	}
+}
@@ -0,0 +1,192 @@
+package imageproxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/httpserver"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// sizes are the TMDB image size variants that can be requested
+var sizes = map[string]struct{}{
+	"w92":      {},
+	"w154":     {},
+	"w185":     {},
+	"w300":     {},
+	"w342":     {},
+	"w500":     {},
+	"w780":     {},
+	"w1280":    {},
+	"original": {},
+}
+
+// imagePathRegex matches the image paths stored by the TMDB client, for example /9cqNxx0GxF0bflZmeSMuL5tnGzr.jpg
+var imagePathRegex = regexp.MustCompile(`^/[A-Za-z0-9_-]+\.(jpg|jpeg|png|svg|webp)$`)
+
+// imageKeys are the keys of the TMDB content attributes that hold image paths
+var imageKeys = []string{"poster_path", "backdrop_path"}
+
+var errNotFound = errors.New("image not found")
+
+type Params struct {
+	fx.In
+	Config Config
+	Dao    lazy.Lazy[*dao.Query]
+	Logger *zap.SugaredLogger
+}
+
+type Result struct {
+	fx.Out
+	Option httpserver.Option `group:"http_server_options"`
+}
+
+// New serves TMDB posters and backdrops at /images/tmdb/<size>/<path>, fetching them on first request and caching them on disk.
+// Only images referenced by stored content attributes are fetched, so that the endpoint can't be used as an open proxy.
+func New(p Params) Result {
+	return Result{
+		Option: builder{
+			config: p.Config,
+			dao:    p.Dao,
+			logger: p.Logger.Named("image_proxy"),
+		},
+	}
+}
+
+type builder struct {
+	config Config
+	dao    lazy.Lazy[*dao.Query]
+	logger *zap.SugaredLogger
+}
+
+func (builder) Key() string {
+	return "images"
+}
+
+func (b builder) Apply(e *gin.Engine) error {
+	d, err := b.dao.Get()
+	if err != nil {
+		return err
+	}
+	cache, err := newDiskCache(b.config.CacheDir, b.config.MaxCacheSize, func(file string, err error) {
+		b.logger.Errorw("failed to update cached image", "file", file, "error", err)
+	})
+	if err != nil {
+		return err
+	}
+	h := &handler{
+		cacheDir: b.config.CacheDir,
+		cache:    cache,
+		baseURL:  strings.TrimSuffix(b.config.BaseURL, "/"),
+		client:   &http.Client{Timeout: b.config.Timeout},
+		isKnown: func(ctx context.Context, imagePath string) (bool, error) {
+			count, err := d.ContentAttribute.WithContext(ctx).Where(
+				d.ContentAttribute.Source.Eq("tmdb"),
+				d.ContentAttribute.Key.In(imageKeys...),
+				d.ContentAttribute.Value.Eq(imagePath),
+			).Count()
+			return count > 0, err
+		},
+		logger: b.logger,
+	}
+	e.GET("/images/tmdb/:size/*path", h.handle)
+	return nil
+}
+
+type handler struct {
+	cacheDir string
+	cache    *diskCache
+	baseURL  string
+	client   *http.Client
+	// isKnown reports whether an image path is referenced by stored content
+	isKnown func(ctx context.Context, imagePath string) (bool, error)
+	group   singleflight.Group
+	logger  *zap.SugaredLogger
+}
+
+func (h *handler) handle(c *gin.Context) {
+	size := c.Param("size")
+	imagePath := c.Param("path")
+	if _, ok := sizes[size]; !ok || !imagePathRegex.MatchString(imagePath) {
+		c.Status(http.StatusNotFound)
+		return
+	}
+	file := filepath.Join(h.cacheDir, "tmdb", size, imagePath[1:])
+	if !h.cache.touch(file) {
+		// concurrent requests for the same image share a single fetch
+		if _, err, _ := h.group.Do(file, func() (interface{}, error) {
+			return nil, h.fetch(c.Request.Context(), size, imagePath, file)
+		}); err != nil {
+			if errors.Is(err, errNotFound) {
+				c.Status(http.StatusNotFound)
+			} else {
+				h.logger.Errorw("failed to fetch image", "size", size, "path", imagePath, "error", err)
+				c.Status(http.StatusBadGateway)
+			}
+			return
+		}
+	}
+	// image paths change when an image is replaced, so cached images never go stale
+	c.Header("Cache-Control", "public, max-age=31536000, immutable")
+	c.File(file)
+}
+
+// fetch downloads an image to the cache, writing to a temporary file that's renamed into place once complete.
+func (h *handler) fetch(ctx context.Context, size, imagePath, file string) error {
+	if known, err := h.isKnown(ctx, imagePath); err != nil {
+		return err
+	} else if !known {
+		return errNotFound
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, h.baseURL+"/"+size+imagePath, nil)
+	if err != nil {
+		return err
+	}
+	res, err := h.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+	if res.StatusCode == http.StatusNotFound {
+		return errNotFound
+	}
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d", res.StatusCode)
+	}
+	if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(file), ".download-*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = os.Remove(tmp.Name())
+	}()
+	n, err := io.Copy(tmp, res.Body)
+	if err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), file); err != nil {
+		return err
+	}
+	h.cache.add(file, n)
+	return nil
+}
@@ -0,0 +1,106 @@
+package imageproxy
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	var fetches atomic.Int32
+	tmdb := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+		if r.URL.Path != "/w300/poster.jpg" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte("image"))
+	}))
+	defer tmdb.Close()
+
+	cacheDir := t.TempDir()
+	cache, err := newDiskCache(cacheDir, 1000, func(string, error) {})
+	require.NoError(t, err)
+	h := &handler{
+		cacheDir: cacheDir,
+		cache:    cache,
+		baseURL:  tmdb.URL,
+		client:   tmdb.Client(),
+		isKnown: func(_ context.Context, imagePath string) (bool, error) {
+			return imagePath == "/poster.jpg" || imagePath == "/missing.jpg", nil
+		},
+		logger: zap.NewNop().Sugar(),
+	}
+	gin.SetMode(gin.TestMode)
+	e := gin.New()
+	e.GET("/images/tmdb/:size/*path", h.handle)
+
+	get := func(path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		e.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		return w
+	}
+
+	for i := 0; i < 2; i++ {
+		w := get("/images/tmdb/w300/poster.jpg")
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "image", w.Body.String())
+	}
+	assert.Equal(t, int32(1), fetches.Load(), "the image is served from the cache after the first request")
+
+	assert.Equal(t, http.StatusNotFound, get("/images/tmdb/w300/missing.jpg").Code, "missing on TMDB")
+	assert.Equal(t, http.StatusNotFound, get("/images/tmdb/w300/unknown.jpg").Code, "not referenced by content")
+	assert.Equal(t, http.StatusNotFound, get("/images/tmdb/w301/poster.jpg").Code, "unknown size")
+	assert.Equal(t, http.StatusNotFound, get("/images/tmdb/w300/../poster.jpg").Code, "invalid path")
+	assert.Equal(t, int32(2), fetches.Load())
+}
+
+func TestDiskCache(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	write := func(name string, size int, modTime time.Time) string {
+		file := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(file, make([]byte, size), 0o600))
+		require.NoError(t, os.Chtimes(file, modTime, modTime))
+		return file
+	}
+	exists := func(file string) bool {
+		_, err := os.Stat(file)
+		return err == nil
+	}
+	now := time.Now()
+	oldest := write("oldest.jpg", 40, now.Add(-3*time.Hour))
+	older := write("older.jpg", 40, now.Add(-2*time.Hour))
+	newer := write("newer.jpg", 40, now.Add(-time.Hour))
+	partial := write(".download-123", 10, now)
+
+	cache, err := newDiskCache(dir, 100, func(string, error) {})
+	require.NoError(t, err)
+	assert.False(t, exists(oldest), "the least recently served file should be deleted to fit the maximum size")
+	assert.False(t, exists(partial), "incomplete downloads should be deleted")
+	assert.True(t, exists(older))
+	assert.True(t, exists(newer))
+	assert.False(t, cache.touch(oldest))
+
+	assert.True(t, cache.touch(older))
+	added := write("added.jpg", 40, now)
+	cache.add(added, 40)
+	assert.False(t, exists(newer), "serving a file should make it recently served")
+	assert.True(t, exists(older))
+	assert.True(t, exists(added))
+
+	require.NoError(t, os.Remove(older))
+	assert.False(t, cache.touch(older), "a file deleted from the cache directory should be fetched again")
+}
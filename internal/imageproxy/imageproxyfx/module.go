@@ -0,0 +1,17 @@
+package imageproxyfx
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/config/configfx"
+	"github.com/bitmagnet-io/bitmagnet/internal/imageproxy"
+	"go.uber.org/fx"
+)
+
+func New() fx.Option {
+	return fx.Module(
+		"image_proxy",
+		configfx.NewConfigModule[imageproxy.Config]("image_proxy", imageproxy.NewDefaultConfig()),
+		fx.Provide(
+			imageproxy.New,
+		),
+	)
+}
@@ -0,0 +1,60 @@
+package importer
+
+import (
+	"context"
+	"sync"
+)
+
+// activeImports tracks the imports in progress, so that they can be closed and their accepted items persisted
+// before the process shuts down.
+type activeImports struct {
+	mutex    sync.Mutex
+	imports  map[*activeImport]struct{}
+	shutdown bool
+}
+
+func newActiveImports() *activeImports {
+	return &activeImports{
+		imports: make(map[*activeImport]struct{}),
+	}
+}
+
+// add tracks an import, returning false if the process is shutting down and no more imports are accepted.
+func (a *activeImports) add(i *activeImport) bool {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	if a.shutdown {
+		return false
+	}
+	a.imports[i] = struct{}{}
+	return true
+}
+
+func (a *activeImports) remove(i *activeImport) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	delete(a.imports, i)
+}
+
+// Shutdown stops accepting imports, and closes the imports in progress, waiting until their accepted items have been
+// persisted or the context is done.
+func (a *activeImports) Shutdown(ctx context.Context) error {
+	a.mutex.Lock()
+	a.shutdown = true
+	imports := make([]*activeImport, 0, len(a.imports))
+	for i := range a.imports {
+		imports = append(imports, i)
+	}
+	a.mutex.Unlock()
+	for _, i := range imports {
+		i.stop()
+	}
+	for _, i := range imports {
+		select {
+		case <-i.done:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
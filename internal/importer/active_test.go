@@ -0,0 +1,33 @@
+package importer
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestActiveImports_Shutdown(t *testing.T) {
+	a := newActiveImports()
+	ctx, cancel := context.WithCancel(context.Background())
+	i := &activeImport{ctx: ctx, stop: cancel, done: make(chan struct{})}
+	assert.True(t, a.add(i))
+	go func() {
+		// the import's run loop closes it when its context is done
+		<-i.ctx.Done()
+		a.remove(i)
+		close(i.done)
+	}()
+	assert.NoError(t, a.Shutdown(context.Background()))
+	assert.Empty(t, a.imports)
+	assert.False(t, a.add(&activeImport{}))
+}
+
+func TestActiveImports_Shutdown__timeout(t *testing.T) {
+	a := newActiveImports()
+	_, cancel := context.WithCancel(context.Background())
+	assert.True(t, a.add(&activeImport{stop: cancel, done: make(chan struct{})}))
+	ctx, cancelShutdown := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancelShutdown()
+	assert.ErrorIs(t, a.Shutdown(ctx), context.DeadlineExceeded)
+}
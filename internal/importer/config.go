@@ -0,0 +1,27 @@
+package importer
+
+type Config struct {
+	// Sources configures default hints and transforms for the items of each source, by source key.
+	Sources map[string]SourceConfig
+}
+
+type SourceConfig struct {
+	// ContentType is the content type hint of items without one.
+	ContentType string `mapstructure:"content_type"`
+	// StripNamePrefixes are removed from the start of the names of items, ignoring case; the first matching prefix
+	// is removed, along with any following spaces.
+	StripNamePrefixes []string `mapstructure:"strip_name_prefixes"`
+	// Categories maps the categories of items to hints, which are applied before the source's defaults.
+	Categories map[string]CategoryConfig
+}
+
+type CategoryConfig struct {
+	ContentType     string `mapstructure:"content_type"`
+	VideoResolution string `mapstructure:"video_resolution"`
+	VideoSource     string `mapstructure:"video_source"`
+	VideoCodec      string `mapstructure:"video_codec"`
+}
+
+func NewDefaultConfig() Config {
+	return Config{}
+}
@@ -1,28 +1,80 @@
 package importer
 
 import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/blocking"
 	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/worker"
 	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/events"
 	"github.com/bitmagnet-io/bitmagnet/internal/processor"
 	"github.com/bitmagnet-io/bitmagnet/internal/queue/publisher"
+	"github.com/bitmagnet-io/bitmagnet/internal/taskrun"
+	"github.com/bitmagnet-io/bitmagnet/internal/webhook"
+	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/fx"
+	"go.uber.org/zap"
 	"time"
 )
 
 type Params struct {
 	fx.In
+	Config             Config
 	Dao                lazy.Lazy[*dao.Query]
+	BlockingManager    lazy.Lazy[blocking.Manager]
 	ProcessorPublisher lazy.Lazy[publisher.Publisher[processor.MessageParams]]
+	TaskRunRecorder    lazy.Lazy[taskrun.Recorder]
+	EventBus           lazy.Lazy[events.Bus]
+	WebhookDispatcher  lazy.Lazy[webhook.Dispatcher]
+	Logger             *zap.SugaredLogger
 }
 
 type Result struct {
 	fx.Out
-	Importer lazy.Lazy[Importer]
+	Importer      lazy.Lazy[Importer]
+	Decorator     worker.Decorator     `group:"worker_decorators"`
+	ImportedTotal prometheus.Collector `group:"prometheus_collectors"`
+	FailedTotal   prometheus.Collector `group:"prometheus_collectors"`
 }
 
 func New(p Params) Result {
+	importedTotal := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "bitmagnet",
+		Subsystem: "importer",
+		Name:      "imported_total",
+		Help:      "A counter of imported torrents, by source.",
+	}, []string{"source"})
+	failedTotal := prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "bitmagnet",
+		Subsystem: "importer",
+		Name:      "failed_total",
+		Help:      "A counter of items that failed to import.",
+	})
+	active := newActiveImports()
 	return Result{
+		// imports are made through the HTTP server, whose shutdown waits for the requests in progress; they're closed first
+		// so that the requests end once their accepted items have been persisted, rather than when the shutdown times out
+		Decorator: worker.Decorator{
+			Key: "http_server",
+			Decorate: func(hook fx.Hook) fx.Hook {
+				return fx.Hook{
+					OnStart: hook.OnStart,
+					OnStop: func(ctx context.Context) error {
+						if err := active.Shutdown(ctx); err != nil {
+							p.Logger.Named("importer").Errorw("failed to close imports", "error", err)
+						}
+						return hook.OnStop(ctx)
+					},
+				}
+			},
+		},
+		ImportedTotal: importedTotal,
+		FailedTotal:   failedTotal,
 		Importer: lazy.New(func() (Importer, error) {
+			sourceTransforms, err := newSourceTransforms(p.Config.Sources)
+			if err != nil {
+				return nil, err
+			}
 			d, err := p.Dao.Get()
 			if err != nil {
 				return nil, err
@@ -31,11 +83,36 @@ func New(p Params) Result {
 			if err != nil {
 				return nil, err
 			}
+			tr, err := p.TaskRunRecorder.Get()
+			if err != nil {
+				return nil, err
+			}
+			bm, err := p.BlockingManager.Get()
+			if err != nil {
+				return nil, err
+			}
+			eb, err := p.EventBus.Get()
+			if err != nil {
+				return nil, err
+			}
+			wd, err := p.WebhookDispatcher.Get()
+			if err != nil {
+				return nil, err
+			}
 			return importer{
 				dao:                d,
+				blockingManager:    bm,
 				processorPublisher: cp,
+				taskRunRecorder:    tr,
+				eventBus:           eb,
+				webhookDispatcher:  wd,
+				importedTotal:      importedTotal,
+				failedTotal:        failedTotal,
 				bufferSize:         100,
 				maxWaitTime:        500 * time.Millisecond,
+				active:             active,
+				sourceTransforms:   sourceTransforms,
+				logger:             p.Logger.Named("importer"),
 			}, nil
 		}),
 	}
@@ -3,21 +3,25 @@ package httpserver
 import (
 	"bufio"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/audit"
 	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/httpserver"
 	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
 	"github.com/bitmagnet-io/bitmagnet/internal/importer"
 	"github.com/gin-gonic/gin"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
+	"net/http"
 	"strconv"
 	"time"
 )
 
 type Params struct {
 	fx.In
-	Importer lazy.Lazy[importer.Importer]
-	Logger   *zap.SugaredLogger
+	Importer      lazy.Lazy[importer.Importer]
+	AuditRecorder lazy.Lazy[audit.Recorder]
+	Logger        *zap.SugaredLogger
 }
 
 type Result struct {
@@ -28,17 +32,25 @@ type Result struct {
 func New(p Params) Result {
 	return Result{
 		Option: &builder{
-			importer: p.Importer,
-			logger:   p.Logger.Named("importer"),
+			importer:      p.Importer,
+			auditRecorder: p.AuditRecorder,
+			logger:        p.Logger.Named("importer"),
 		},
 	}
 }
 
-const ImportIdHeader = "x-import-id"
+const (
+	ImportIdHeader = "x-import-id"
+	// RejectInvalidHintsHeader can be set to "true" to skip items with inconsistent hints instead of sanitizing them
+	RejectInvalidHintsHeader = "x-import-reject-invalid-hints"
+	// maxReportedInvalidHints limits the response size for a large import with many inconsistent items
+	maxReportedInvalidHints = 1000
+)
 
 type builder struct {
-	importer lazy.Lazy[importer.Importer]
-	logger   *zap.SugaredLogger
+	importer      lazy.Lazy[importer.Importer]
+	auditRecorder lazy.Lazy[audit.Recorder]
+	logger        *zap.SugaredLogger
 }
 
 func (builder) Key() string {
@@ -50,26 +62,66 @@ func (b builder) Apply(e *gin.Engine) error {
 	if err != nil {
 		return err
 	}
+	r, err := b.auditRecorder.Get()
+	if err != nil {
+		return err
+	}
 	e.POST("/import", func(ctx *gin.Context) {
-		b.handle(ctx, i)
+		b.handle(ctx, i, r)
 	})
 	return nil
 }
 
-func (b builder) handle(ctx *gin.Context, i importer.Importer) {
+// importAudit is the audit log payload of an import.
+type importAudit struct {
+	ID       string `json:"id"`
+	Count    int    `json:"count"`
+	Invalid  int    `json:"invalid"`
+	Rejected int    `json:"rejected"`
+}
+
+func (b builder) handle(ctx *gin.Context, i importer.Importer, r audit.Recorder) {
 	s := bufio.NewScanner(ctx.Request.Body)
 	s.Split(bufio.ScanRunes)
 	importId := ctx.Request.Header.Get(ImportIdHeader)
 	if importId == "" {
 		importId = strconv.FormatUint(uint64(time.Now().Unix()), 10)
 	}
+	rejectInvalidHints := ctx.Request.Header.Get(RejectInvalidHintsHeader) == "true"
+	invalidCount, rejectedCount := 0, 0
 	ai := i.New(ctx, importer.Info{
-		ID: importId,
+		ID:                 importId,
+		RejectInvalidHints: rejectInvalidHints,
+		OnInvalidHints: func(err importer.HintValidationError) {
+			invalidCount++
+			if err.Rejected {
+				rejectedCount++
+			}
+			if invalidCount <= maxReportedInvalidHints {
+				action := "sanitized"
+				if err.Rejected {
+					action = "rejected"
+				}
+				_, _ = ctx.Writer.WriteString(fmt.Sprintf("%s: %s\n", action, err.Error()))
+			}
+		},
 	})
 	var currentLine []rune
 	count := 0
+	var importErr error
+	defer func() {
+		r.Record(
+			audit.WithClientIP(ctx.Request.Context(), ctx.ClientIP()),
+			"import",
+			importAudit{ID: importId, Count: count - rejectedCount, Invalid: invalidCount, Rejected: rejectedCount},
+			importErr,
+		)
+	}()
 	writeCount := func() {
-		_, _ = ctx.Writer.WriteString(fmt.Sprintf("%d items imported\n", count))
+		_, _ = ctx.Writer.WriteString(fmt.Sprintf("%d items imported\n", count-rejectedCount))
+		if invalidCount > 0 {
+			_, _ = ctx.Writer.WriteString(fmt.Sprintf("%d items with inconsistent hints, %d rejected\n", invalidCount, rejectedCount))
+		}
 	}
 	addItem := func() error {
 		item := importer.Item{}
@@ -80,6 +132,14 @@ func (b builder) handle(ctx *gin.Context, i importer.Importer) {
 			return err
 		}
 		if err := ai.Import(item); err != nil {
+			if errors.Is(err, importer.ErrImportClosed) {
+				// the process is shutting down; the items accepted so far are persisted, and the rest can be imported again
+				_ = ai.Close()
+				ctx.Status(http.StatusServiceUnavailable)
+				_, _ = ctx.Writer.WriteString(err.Error() + "\n")
+				writeCount()
+				return err
+			}
 			b.logger.Errorw("error importing item", "error", err)
 			ctx.Status(400)
 			_, _ = ctx.Writer.WriteString(err.Error())
@@ -98,6 +158,7 @@ func (b builder) handle(ctx *gin.Context, i importer.Importer) {
 		for _, ch := range s.Text() {
 			if ch == '\n' {
 				if err := addItem(); err != nil {
+					importErr = err
 					return
 				}
 				currentLine = nil
@@ -108,11 +169,13 @@ func (b builder) handle(ctx *gin.Context, i importer.Importer) {
 	}
 	if len(currentLine) > 0 {
 		if err := addItem(); err != nil {
+			importErr = err
 			return
 		}
 	}
 	ai.Drain()
 	if err := ai.Close(); err != nil {
+		importErr = err
 		b.logger.Errorw("error closing import", "error", err)
 		ctx.Status(400)
 		_, _ = ctx.Writer.WriteString(err.Error())
@@ -3,12 +3,19 @@ package importer
 import (
 	"context"
 	"errors"
+	"github.com/bitmagnet-io/bitmagnet/internal/blocking"
 	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/events"
 	"github.com/bitmagnet-io/bitmagnet/internal/model"
 	"github.com/bitmagnet-io/bitmagnet/internal/processor"
 	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
 	"github.com/bitmagnet-io/bitmagnet/internal/queue/publisher"
+	"github.com/bitmagnet-io/bitmagnet/internal/taskrun"
+	"github.com/bitmagnet-io/bitmagnet/internal/webhook"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
 	"gorm.io/gorm/clause"
+	"strings"
 	"sync"
 	"time"
 )
@@ -37,17 +44,39 @@ type Item struct {
 	VideoModifier   model.NullVideoModifier
 	ReleaseGroup    model.NullString
 	PublishedAt     time.Time
+	// Category is the category of the item at its source, which can be mapped to hints in the config of the source.
+	Category model.NullString
 }
 
 type Info struct {
 	ID string
+	// OnImported is called with the info hash of each item once it has been persisted and queued for processing.
+	// It's called from the import's flush, so it shouldn't block or call back into the import.
+	OnImported func(infoHash protocol.ID)
+	// RetainImportedHashes accumulates the imported info hashes in memory so that they can be retrieved
+	// with ImportedHashes; as this grows with the size of the import, OnImported is preferred for large imports.
+	RetainImportedHashes bool
+	// RejectInvalidHints skips items with hints that are inconsistent with their content type;
+	// by default the inconsistent hint fields are removed and the rest of the item is imported.
+	RejectInvalidHints bool
+	// OnInvalidHints is called from Import for each item with inconsistent hints, after it's been sanitized or rejected.
+	OnInvalidHints func(err HintValidationError)
 }
 
 type importer struct {
 	dao                *dao.Query
+	blockingManager    blocking.Manager
 	processorPublisher publisher.Publisher[processor.MessageParams]
+	taskRunRecorder    taskrun.Recorder
+	eventBus           events.Bus
+	webhookDispatcher  webhook.Dispatcher
+	importedTotal      *prometheus.CounterVec
+	failedTotal        prometheus.Counter
 	bufferSize         uint
 	maxWaitTime        time.Duration
+	active             *activeImports
+	sourceTransforms   map[string]sourceTransform
+	logger             *zap.SugaredLogger
 }
 
 var (
@@ -61,9 +90,15 @@ func (i importer) New(ctx context.Context, info Info) ActiveImport {
 		mutex:           &sync.RWMutex{},
 		info:            info,
 		itemChan:        make(chan Item),
+		done:            make(chan struct{}),
 		importedSources: make(map[string]struct{}),
+		taskRun:         i.taskRunRecorder.Start(ctx, taskrun.KindImport),
 	}
 	ai.run(ctx)
+	if !i.active.add(ai) {
+		// the process is shutting down, so the import is closed before any items are accepted
+		ai.stop()
+	}
 	return ai
 }
 
@@ -73,6 +108,7 @@ type ActiveImport interface {
 	Closed() bool
 	Close() error
 	Err() error
+	// ImportedHashes returns the info hashes imported so far; it's always empty unless Info.RetainImportedHashes is set.
 	ImportedHashes() []protocol.ID
 }
 
@@ -114,20 +150,20 @@ type activeImport struct {
 	itemBuffer      []Item
 	importedSources map[string]struct{}
 	importedHashes  []protocol.ID
+	importedCount   int
 	errors          ImportErrors
+	taskRun         taskrun.Run
+	// done is closed once the import has been closed and its accepted items have been persisted
+	done chan struct{}
 }
 
 func (i *activeImport) run(ctx context.Context) {
-	i.mutex.Lock()
+	i.ctx, i.stop = context.WithCancel(ctx)
 	go (func() {
-		iCtx, cancel := context.WithCancel(ctx)
-		defer cancel()
-		i.ctx = iCtx
-		i.stop = cancel
-		i.mutex.Unlock()
 		for {
 			select {
-			case <-iCtx.Done():
+			// the context is done when the request making the import ends, or when the process shuts down
+			case <-i.ctx.Done():
 				_ = i.Close()
 				return
 			case item, ok := <-i.itemChan:
@@ -143,7 +179,6 @@ func (i *activeImport) run(ctx context.Context) {
 }
 
 func (i *activeImport) buffer(item Item) {
-	i.wg.Add(1)
 	defer i.wg.Done()
 	i.mutex.Lock()
 	defer i.mutex.Unlock()
@@ -165,6 +200,7 @@ func (i *activeImport) flushLocked() {
 	}
 	err := i.persistItems(i.itemBuffer...)
 	if err != nil {
+		i.failedTotal.Add(float64(len(i.itemBuffer)))
 		i.errors = append(i.errors, ImportItemsError{
 			Items: i.itemBuffer,
 			Err:   err,
@@ -174,6 +210,15 @@ func (i *activeImport) flushLocked() {
 }
 
 func (i *activeImport) persistItems(items ...Item) error {
+	// items already accepted are persisted even if the import's context has been cancelled
+	ctx := context.WithoutCancel(i.ctx)
+	items, filterErr := i.filterBlocked(ctx, items)
+	if filterErr != nil {
+		return filterErr
+	}
+	if len(items) == 0 {
+		return nil
+	}
 	var sources []*model.TorrentSource
 	sourcesMap := make(map[string]struct{})
 	torrents := make([]*model.Torrent, 0, len(items))
@@ -193,7 +238,7 @@ func (i *activeImport) persistItems(items ...Item) error {
 		infoHashes = append(infoHashes, item.InfoHash)
 	}
 	if len(sources) > 0 {
-		if createSourcesErr := i.dao.TorrentSource.WithContext(i.ctx).Clauses(clause.OnConflict{
+		if createSourcesErr := i.dao.TorrentSource.WithContext(ctx).Clauses(clause.OnConflict{
 			DoNothing: true,
 		}).CreateInBatches(sources, 100); createSourcesErr != nil {
 			return createSourcesErr
@@ -202,22 +247,68 @@ func (i *activeImport) persistItems(items ...Item) error {
 			i.importedSources[s.Key] = struct{}{}
 		}
 	}
-	if createTorrentsErr := i.dao.Torrent.WithContext(i.ctx).Clauses(clause.OnConflict{
+	if createTorrentsErr := i.dao.Torrent.WithContext(ctx).Clauses(clause.OnConflict{
 		// todo work out how to handle conflicts here
 		UpdateAll: true,
 	}).CreateInBatches(torrents, 100); createTorrentsErr != nil {
 		return createTorrentsErr
 	}
-	_, publishErr := i.processorPublisher.Publish(i.ctx, processor.MessageParams{
+	_, publishErr := i.processorPublisher.Publish(ctx, processor.MessageParams{
 		InfoHashes: infoHashes,
+		Priority:   processor.MessagePriorityBulk,
 	})
 	if publishErr != nil {
 		return publishErr
 	}
-	i.importedHashes = append(i.importedHashes, infoHashes...)
+	discoveredEvents := make([]events.Event, 0, len(torrents))
+	for _, t := range torrents {
+		discoveredEvents = append(discoveredEvents, events.NewDiscoveredEvent(*t))
+	}
+	i.eventBus.Publish(ctx, discoveredEvents...)
+	i.recordImported(items, infoHashes)
 	return nil
 }
 
+// recordImported records the items that have been persisted and queued for processing.
+func (i *activeImport) recordImported(items []Item, infoHashes []protocol.ID) {
+	if i.info.RetainImportedHashes {
+		i.importedHashes = append(i.importedHashes, infoHashes...)
+	}
+	if i.info.OnImported != nil {
+		for _, h := range infoHashes {
+			i.info.OnImported(h)
+		}
+	}
+	for _, item := range items {
+		i.importedTotal.WithLabelValues(item.Source).Inc()
+	}
+	i.importedCount += len(infoHashes)
+	i.taskRun.Add(len(infoHashes))
+}
+
+// filterBlocked discards items for blocked info hashes, such as deleted torrents or those on the takedown list
+func (i *activeImport) filterBlocked(ctx context.Context, items []Item) ([]Item, error) {
+	hashes := make([]protocol.ID, 0, len(items))
+	for _, item := range items {
+		hashes = append(hashes, item.InfoHash)
+	}
+	allowedHashes, err := i.blockingManager.Filter(ctx, hashes)
+	if err != nil {
+		return nil, err
+	}
+	allowed := make(map[protocol.ID]struct{}, len(allowedHashes))
+	for _, h := range allowedHashes {
+		allowed[h] = struct{}{}
+	}
+	filtered := make([]Item, 0, len(allowed))
+	for _, item := range items {
+		if _, ok := allowed[item.InfoHash]; ok {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered, nil
+}
+
 func createTorrentModel(info Info, item Item) model.Torrent {
 	t := model.Torrent{
 		InfoHash:    item.InfoHash,
@@ -227,9 +318,10 @@ func createTorrentModel(info Info, item Item) model.Torrent {
 		FilesStatus: model.FilesStatusNoInfo,
 		Sources: []model.TorrentsTorrentSource{
 			{
-				Source:      item.Source,
-				ImportID:    model.NewNullString(info.ID),
-				PublishedAt: item.PublishedAt,
+				Source:          item.Source,
+				ImportID:        model.NewNullString(info.ID),
+				PublishedAt:     item.PublishedAt,
+				DiscoveryMethod: model.NewNullDiscoveryMethod(model.DiscoveryMethodImport),
 			},
 		},
 	}
@@ -259,7 +351,30 @@ func (i *activeImport) Import(items ...Item) error {
 		return ErrImportClosed
 	}
 	for _, item := range items {
-		i.itemChan <- item
+		if t, ok := i.sourceTransforms[strings.ToLower(item.Source)]; ok {
+			item = t.apply(item)
+		}
+		item, issues := item.Sanitize()
+		if len(issues) > 0 {
+			if i.info.OnInvalidHints != nil {
+				i.info.OnInvalidHints(HintValidationError{
+					InfoHash: item.InfoHash,
+					Rejected: i.info.RejectInvalidHints,
+					Issues:   issues,
+				})
+			}
+			if i.info.RejectInvalidHints {
+				continue
+			}
+		}
+		// the item is counted before it's sent, so that Close waits for it to be buffered
+		i.wg.Add(1)
+		select {
+		case i.itemChan <- item:
+		case <-i.ctx.Done():
+			i.wg.Done()
+			return ErrImportClosed
+		}
 	}
 	return nil
 }
@@ -286,18 +401,44 @@ func (i *activeImport) Closed() bool {
 	return i.stopped
 }
 
+// Close stops accepting items, and persists the items already accepted before finishing the import.
+// If the import is already being closed, it waits for that to complete.
 func (i *activeImport) Close() error {
+	i.mutex.Lock()
+	if i.stopped {
+		i.mutex.Unlock()
+		<-i.done
+		return i.Err()
+	}
+	i.stopped = true
+	close(i.itemChan)
+	i.mutex.Unlock()
+	// the items sent before the channel was closed are buffered by goroutines that need the lock
+	i.wg.Wait()
 	i.mutex.Lock()
 	defer i.mutex.Unlock()
 	i.flushLocked()
-	if !i.stopped {
-		i.stopped = true
-		i.stop()
-		close(i.itemChan)
-	}
+	i.stop()
+	i.taskRun.Finish(i.errors.OrNil())
+	i.dispatchFinished()
+	i.active.remove(i)
+	close(i.done)
 	return i.errors.OrNil()
 }
 
+func (i *activeImport) dispatchFinished() {
+	if !i.webhookDispatcher.Subscribed(model.WebhookEventTypeImportFinished) {
+		return
+	}
+	// the import's context has been cancelled by now:
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	event := webhook.NewImportFinishedEvent(i.info.ID, i.importedCount, i.errors.OrNil())
+	if err := i.webhookDispatcher.Dispatch(ctx, event); err != nil {
+		i.logger.Errorw("failed to dispatch import finished event", "id", i.info.ID, "error", err)
+	}
+}
+
 func (i *activeImport) ImportedHashes() []protocol.ID {
 	i.mutex.RLock()
 	defer i.mutex.RUnlock()
@@ -0,0 +1,67 @@
+package importer
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"github.com/bitmagnet-io/bitmagnet/internal/taskrun"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+)
+
+type countingRun struct {
+	taskrun.Run
+	count int
+}
+
+func (r *countingRun) Add(n int) {
+	r.count += n
+}
+
+func TestActiveImport_RecordImported(t *testing.T) {
+	t.Parallel()
+
+	items := []Item{
+		{Source: "test", InfoHash: protocol.ID{1}},
+		{Source: "test", InfoHash: protocol.ID{2}},
+	}
+	infoHashes := []protocol.ID{{1}, {2}}
+	newImport := func(info Info) (*activeImport, *countingRun) {
+		run := &countingRun{}
+		return &activeImport{
+			importer: importer{
+				importedTotal: prometheus.NewCounterVec(prometheus.CounterOpts{Name: "imported_total"}, []string{"source"}),
+			},
+			mutex:   &sync.RWMutex{},
+			info:    info,
+			taskRun: run,
+		}, run
+	}
+
+	t.Run("hashes are only retained if requested", func(t *testing.T) {
+		t.Parallel()
+
+		i, run := newImport(Info{})
+		i.recordImported(items, infoHashes)
+		assert.Empty(t, i.ImportedHashes())
+		assert.Equal(t, 2, i.importedCount)
+		assert.Equal(t, 2, run.count)
+
+		i, _ = newImport(Info{RetainImportedHashes: true})
+		i.recordImported(items[:1], infoHashes[:1])
+		i.recordImported(items[1:], infoHashes[1:])
+		assert.Equal(t, infoHashes, i.ImportedHashes())
+	})
+
+	t.Run("the callback is called once for each persisted item", func(t *testing.T) {
+		t.Parallel()
+
+		var imported []protocol.ID
+		i, _ := newImport(Info{OnImported: func(infoHash protocol.ID) {
+			imported = append(imported, infoHash)
+		}})
+		i.recordImported(items, infoHashes)
+		assert.Equal(t, infoHashes, imported)
+		assert.Empty(t, i.ImportedHashes())
+	})
+}
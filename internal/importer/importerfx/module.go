@@ -1,6 +1,7 @@
 package importerfx
 
 import (
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/config/configfx"
 	"github.com/bitmagnet-io/bitmagnet/internal/importer"
 	"github.com/bitmagnet-io/bitmagnet/internal/importer/httpserver"
 	"go.uber.org/fx"
@@ -9,6 +10,7 @@ import (
 func New() fx.Option {
 	return fx.Module(
 		"importer",
+		configfx.NewConfigModule[importer.Config]("importer", importer.NewDefaultConfig()),
 		fx.Provide(
 			httpserver.New,
 			importer.New,
@@ -0,0 +1,119 @@
+package importer
+
+import (
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"strings"
+)
+
+// sourceTransform applies the configured default hints and transforms of a source to its items.
+type sourceTransform struct {
+	contentType       model.NullContentType
+	stripNamePrefixes []string
+	categories        map[string]categoryHints
+}
+
+type categoryHints struct {
+	contentType     model.NullContentType
+	videoResolution model.NullVideoResolution
+	videoSource     model.NullVideoSource
+	videoCodec      model.NullVideoCodec
+}
+
+// newSourceTransforms parses the configured sources, keyed by their lowercased keys as the keys of the config are
+// case-insensitive.
+func newSourceTransforms(sources map[string]SourceConfig) (map[string]sourceTransform, error) {
+	transforms := make(map[string]sourceTransform, len(sources))
+	for key, source := range sources {
+		t := sourceTransform{
+			categories: make(map[string]categoryHints, len(source.Categories)),
+		}
+		if source.ContentType != "" {
+			contentType, err := model.ParseContentType(source.ContentType)
+			if err != nil {
+				return nil, fmt.Errorf("source %s: %w", key, err)
+			}
+			t.contentType = model.NewNullContentType(contentType)
+		}
+		for _, prefix := range source.StripNamePrefixes {
+			if prefix != "" {
+				t.stripNamePrefixes = append(t.stripNamePrefixes, strings.ToLower(prefix))
+			}
+		}
+		for category, config := range source.Categories {
+			hints, err := newCategoryHints(config)
+			if err != nil {
+				return nil, fmt.Errorf("source %s, category %s: %w", key, category, err)
+			}
+			t.categories[strings.ToLower(category)] = hints
+		}
+		transforms[strings.ToLower(key)] = t
+	}
+	return transforms, nil
+}
+
+func newCategoryHints(config CategoryConfig) (categoryHints, error) {
+	var hints categoryHints
+	if config.ContentType != "" {
+		v, err := model.ParseContentType(config.ContentType)
+		if err != nil {
+			return hints, err
+		}
+		hints.contentType = model.NewNullContentType(v)
+	}
+	if config.VideoResolution != "" {
+		v, err := model.ParseVideoResolution(config.VideoResolution)
+		if err != nil {
+			return hints, err
+		}
+		hints.videoResolution = model.NewNullVideoResolution(v)
+	}
+	if config.VideoSource != "" {
+		v, err := model.ParseVideoSource(config.VideoSource)
+		if err != nil {
+			return hints, err
+		}
+		hints.videoSource = model.NewNullVideoSource(v)
+	}
+	if config.VideoCodec != "" {
+		v, err := model.ParseVideoCodec(config.VideoCodec)
+		if err != nil {
+			return hints, err
+		}
+		hints.videoCodec = model.NewNullVideoCodec(v)
+	}
+	return hints, nil
+}
+
+// apply returns the item with its name transformed and the hints of its category and source set, where the item
+// doesn't already have them.
+func (t sourceTransform) apply(item Item) Item {
+	for _, prefix := range t.stripNamePrefixes {
+		if len(item.Name) > len(prefix) && strings.ToLower(item.Name[:len(prefix)]) == prefix {
+			if name := strings.TrimLeft(item.Name[len(prefix):], " "); name != "" {
+				item.Name = name
+				break
+			}
+		}
+	}
+	if item.Category.Valid {
+		if hints, ok := t.categories[strings.ToLower(item.Category.String)]; ok {
+			if !item.ContentType.Valid {
+				item.ContentType = hints.contentType
+			}
+			if !item.VideoResolution.Valid {
+				item.VideoResolution = hints.videoResolution
+			}
+			if !item.VideoSource.Valid {
+				item.VideoSource = hints.videoSource
+			}
+			if !item.VideoCodec.Valid {
+				item.VideoCodec = hints.videoCodec
+			}
+		}
+	}
+	if !item.ContentType.Valid {
+		item.ContentType = t.contentType
+	}
+	return item
+}
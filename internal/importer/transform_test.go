@@ -0,0 +1,53 @@
+package importer
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestSourceTransforms(t *testing.T) {
+	t.Parallel()
+
+	transforms, err := newSourceTransforms(map[string]SourceConfig{
+		"RuTracker-Music": {
+			ContentType:       "music",
+			StripNamePrefixes: []string{"[rutracker.org]"},
+			Categories: map[string]CategoryConfig{
+				"Movies_x264_1080": {ContentType: "movie", VideoResolution: "V1080p", VideoCodec: "x264"},
+			},
+		},
+	})
+	require.NoError(t, err)
+	transform, ok := transforms["rutracker-music"]
+	require.True(t, ok)
+
+	item := transform.apply(Item{Name: "[RuTracker.org] Some Album"})
+	assert.Equal(t, "Some Album", item.Name)
+	assert.Equal(t, model.NewNullContentType(model.ContentTypeMusic), item.ContentType)
+
+	item = transform.apply(Item{Name: "A Movie", Category: model.NewNullString("movies_x264_1080")})
+	assert.Equal(t, model.NewNullContentType(model.ContentTypeMovie), item.ContentType)
+	assert.Equal(t, model.NewNullVideoResolution(model.VideoResolutionV1080p), item.VideoResolution)
+	assert.Equal(t, model.NewNullVideoCodec(model.VideoCodecX264), item.VideoCodec)
+
+	// the hints of an item take precedence
+	item = transform.apply(Item{
+		Name:            "A Movie",
+		Category:        model.NewNullString("movies_x264_1080"),
+		ContentType:     model.NewNullContentType(model.ContentTypeTvShow),
+		VideoResolution: model.NewNullVideoResolution(model.VideoResolutionV720p),
+	})
+	assert.Equal(t, model.NewNullContentType(model.ContentTypeTvShow), item.ContentType)
+	assert.Equal(t, model.NewNullVideoResolution(model.VideoResolutionV720p), item.VideoResolution)
+
+	// a name consisting only of a prefix isn't emptied
+	item = transform.apply(Item{Name: "[rutracker.org] "})
+	assert.Equal(t, "[rutracker.org] ", item.Name)
+
+	_, err = newSourceTransforms(map[string]SourceConfig{
+		"x": {Categories: map[string]CategoryConfig{"y": {VideoResolution: "huge"}}},
+	})
+	assert.ErrorIs(t, err, model.ErrInvalidVideoResolution)
+}
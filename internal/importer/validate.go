@@ -0,0 +1,87 @@
+package importer
+
+import (
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"strings"
+)
+
+// HintIssue describes a hint field of an item that's inconsistent with the rest of the item.
+type HintIssue struct {
+	Field  string `json:"field"`
+	Reason string `json:"reason"`
+}
+
+// HintValidationError lists the inconsistent hint fields of an item; depending on Info.RejectInvalidHints,
+// the item was either rejected or imported with those fields removed.
+type HintValidationError struct {
+	InfoHash protocol.ID
+	Rejected bool
+	Issues   []HintIssue
+}
+
+func (e HintValidationError) Error() string {
+	issues := make([]string, 0, len(e.Issues))
+	for _, issue := range e.Issues {
+		issues = append(issues, issue.Field+": "+issue.Reason)
+	}
+	return fmt.Sprintf("inconsistent hints for %s: %s", e.InfoHash, strings.Join(issues, "; "))
+}
+
+// Sanitize returns the item with any hint fields that are inconsistent with its content type removed,
+// along with the issues found. The classifier trusts hints, so a mixed dump with, for example, episodes on a movie
+// or a video resolution on a book would otherwise lead to bad classifications.
+func (item Item) Sanitize() (Item, []HintIssue) {
+	var issues []HintIssue
+	drop := func(field, reason string) {
+		issues = append(issues, HintIssue{Field: field, Reason: reason})
+	}
+	if !item.ContentType.Valid {
+		// hints can only be saved for a known content type
+		const reason = "requires a contentType"
+		if item.ContentSource.Valid || item.ContentID.Valid {
+			drop("contentSource", reason)
+			item.ContentSource, item.ContentID = model.NullString{}, model.NullString{}
+		}
+		if item.Title.Valid {
+			drop("title", reason)
+			item.Title = model.NullString{}
+		}
+		if !item.ReleaseYear.IsNil() {
+			drop("releaseYear", reason)
+			item.ReleaseYear = 0
+		}
+	} else if item.ContentSource.Valid != item.ContentID.Valid {
+		drop("contentSource", "contentSource and contentId must be specified together")
+		item.ContentSource, item.ContentID = model.NullString{}, model.NullString{}
+	}
+	if len(item.Episodes) > 0 && (!item.ContentType.Valid || item.ContentType.ContentType != model.ContentTypeTvShow) {
+		drop("episodes", "only valid for the tv_show content type")
+		item.Episodes = nil
+	}
+	if !item.ContentType.Valid || !item.ContentType.ContentType.IsVideo() {
+		const reason = "only valid for video content types"
+		if item.VideoResolution.Valid {
+			drop("videoResolution", reason)
+			item.VideoResolution = model.NullVideoResolution{}
+		}
+		if item.VideoSource.Valid {
+			drop("videoSource", reason)
+			item.VideoSource = model.NullVideoSource{}
+		}
+		if item.VideoCodec.Valid {
+			drop("videoCodec", reason)
+			item.VideoCodec = model.NullVideoCodec{}
+		}
+		if item.Video3d.Valid {
+			drop("video3d", reason)
+			item.Video3d = model.NullVideo3d{}
+		}
+		if item.VideoModifier.Valid {
+			drop("videoModifier", reason)
+			item.VideoModifier = model.NullVideoModifier{}
+		}
+	}
+	return item, issues
+}
@@ -0,0 +1,44 @@
+package importer
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestItemSanitize(t *testing.T) {
+	t.Parallel()
+
+	tvShow := Item{
+		ContentType:     model.NewNullContentType(model.ContentTypeTvShow),
+		ContentSource:   model.NewNullString("tmdb"),
+		ContentID:       model.NewNullString("1399"),
+		Episodes:        model.Episodes{1: {1: {}}},
+		VideoResolution: model.NewNullVideoResolution(model.VideoResolutionV1080p),
+	}
+	sanitized, issues := tvShow.Sanitize()
+	assert.Empty(t, issues)
+	assert.Equal(t, tvShow, sanitized)
+
+	movie := tvShow
+	movie.ContentType = model.NewNullContentType(model.ContentTypeMovie)
+	sanitized, issues = movie.Sanitize()
+	assert.Equal(t, []HintIssue{{Field: "episodes", Reason: "only valid for the tv_show content type"}}, issues)
+	assert.Nil(t, sanitized.Episodes)
+	assert.Equal(t, movie.VideoResolution, sanitized.VideoResolution)
+
+	book := Item{
+		ContentType:     model.NewNullContentType(model.ContentTypeBook),
+		ContentID:       model.NewNullString("123"),
+		VideoResolution: model.NewNullVideoResolution(model.VideoResolutionV720p),
+	}
+	sanitized, issues = book.Sanitize()
+	assert.Len(t, issues, 2)
+	assert.False(t, sanitized.ContentID.Valid)
+	assert.False(t, sanitized.VideoResolution.Valid)
+
+	untyped := Item{Title: model.NewNullString("The Matrix"), ReleaseYear: 1999}
+	sanitized, issues = untyped.Sanitize()
+	assert.Len(t, issues, 2)
+	assert.Equal(t, Item{}, sanitized)
+}
@@ -0,0 +1,18 @@
+package indexstats
+
+import "time"
+
+type Config struct {
+	// Interval is the time between refreshes of the rollup table.
+	Interval time.Duration
+	// RecomputeWindow is how far back each refresh recomputes the rollup, so that torrents classified some time after
+	// they were discovered are counted by content type; older buckets are kept as they are.
+	RecomputeWindow time.Duration `mapstructure:"recompute_window"`
+}
+
+func NewDefaultConfig() Config {
+	return Config{
+		Interval:        5 * time.Minute,
+		RecomputeWindow: 24 * time.Hour,
+	}
+}
@@ -0,0 +1,67 @@
+package indexstats
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/worker"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type Params struct {
+	fx.In
+	Config Config
+	DB     lazy.Lazy[*gorm.DB]
+	Logger *zap.SugaredLogger
+}
+
+type Result struct {
+	fx.Out
+	Reader lazy.Lazy[Reader]
+	Worker worker.Worker `group:"workers"`
+}
+
+func New(p Params) Result {
+	ls := lazy.New(func() (indexStats, error) {
+		db, err := p.DB.Get()
+		if err != nil {
+			return indexStats{}, err
+		}
+		return indexStats{
+			db:              db,
+			recomputeWindow: p.Config.RecomputeWindow,
+		}, nil
+	})
+	var s *scheduler
+	return Result{
+		Reader: lazy.New(func() (Reader, error) {
+			return ls.Get()
+		}),
+		Worker: worker.NewWorker(
+			"index_stats",
+			fx.Hook{
+				OnStart: func(context.Context) error {
+					r, err := ls.Get()
+					if err != nil {
+						return err
+					}
+					s = &scheduler{
+						refresher: r,
+						interval:  p.Config.Interval,
+						stopped:   make(chan struct{}),
+						logger:    p.Logger.Named("index_stats"),
+					}
+					go s.start()
+					return nil
+				},
+				OnStop: func(context.Context) error {
+					if s != nil {
+						close(s.stopped)
+					}
+					return nil
+				},
+			},
+		),
+	}
+}
@@ -0,0 +1,166 @@
+package indexstats
+
+import (
+	"context"
+	"database/sql"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"gorm.io/gorm"
+	"time"
+)
+
+// Interval is the length of the buckets of a timeline.
+type Interval string
+
+const (
+	IntervalHour  Interval = "hour"
+	IntervalDay   Interval = "day"
+	IntervalWeek  Interval = "week"
+	IntervalMonth Interval = "month"
+)
+
+func (i Interval) IsValid() bool {
+	switch i {
+	case IntervalHour, IntervalDay, IntervalWeek, IntervalMonth:
+		return true
+	}
+	return false
+}
+
+type TimelineQuery struct {
+	Since time.Time
+	// Until defaults to now.
+	Until    time.Time
+	Interval Interval
+	// ContentTypes restricts the timeline to these content types, where null matches torrents of unknown content type.
+	ContentTypes []model.NullContentType
+}
+
+type TimelineBucket struct {
+	Bucket      time.Time
+	ContentType model.NullContentType
+	// DiscoveredCount is the number of torrents discovered in the bucket, by the content type they're now classified as.
+	DiscoveredCount int64
+	// DiscoveredSize is the total size of the torrents discovered in the bucket.
+	DiscoveredSize int64
+	// ClassifiedCount is the number of torrents first classified in the bucket.
+	ClassifiedCount int64
+}
+
+type Reader interface {
+	// Timeline returns the counts of discovered and classified torrents in each bucket and content type.
+	// The counts are read from the rollup table, so they lag behind by up to the refresh interval.
+	Timeline(ctx context.Context, q TimelineQuery) ([]TimelineBucket, error)
+}
+
+type Refresher interface {
+	// Refresh recomputes the hourly rollup of the torrents discovered and classified within the recompute window,
+	// or since the first torrent was discovered if the rollup is empty.
+	Refresh(ctx context.Context) error
+}
+
+// chunkSize is the period of time recomputed in each transaction, which keeps the initial backfill of a large index
+// from holding locks for too long.
+const chunkSize = 24 * time.Hour
+
+type indexStats struct {
+	db              *gorm.DB
+	recomputeWindow time.Duration
+}
+
+func (s indexStats) Refresh(ctx context.Context) error {
+	db := s.db.WithContext(ctx)
+	var latest sql.NullTime
+	if err := db.Raw("SELECT max(bucket) FROM index_stats").Scan(&latest).Error; err != nil {
+		return err
+	}
+	from := latest.Time.Add(-s.recomputeWindow)
+	if !latest.Valid {
+		var first sql.NullTime
+		if err := db.Raw("SELECT min(created_at) FROM torrents").Scan(&first).Error; err != nil {
+			return err
+		}
+		if !first.Valid {
+			return nil
+		}
+		from = first.Time
+	}
+	for _, w := range windows(from.UTC().Truncate(time.Hour), time.Now(), chunkSize) {
+		if err := db.Transaction(func(tx *gorm.DB) error {
+			return recompute(tx, w)
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type window struct {
+	start time.Time
+	end   time.Time
+}
+
+// windows splits the time from start until end into consecutive windows of the given size, the last of which may end
+// after end.
+func windows(start, end time.Time, size time.Duration) []window {
+	var ws []window
+	for t := start; t.Before(end); t = t.Add(size) {
+		ws = append(ws, window{start: t, end: t.Add(size)})
+	}
+	return ws
+}
+
+// recompute replaces the hourly buckets of a window. Buckets are truncated in UTC so that they're aligned with the
+// window whatever the time zone of the database session.
+func recompute(tx *gorm.DB, w window) error {
+	args := map[string]interface{}{"start": w.start, "end": w.end}
+	if err := tx.Exec("DELETE FROM index_stats WHERE bucket >= @start AND bucket < @end", args).Error; err != nil {
+		return err
+	}
+	return tx.Exec(`INSERT INTO index_stats (bucket, content_type, discovered_count, discovered_size, classified_count)
+SELECT bucket, content_type, sum(discovered_count), sum(discovered_size), sum(classified_count) FROM (
+  SELECT date_trunc('hour', t.created_at, 'UTC') AS bucket, coalesce(tc.content_type, '') AS content_type,
+    count(*) AS discovered_count, sum(t.size) AS discovered_size, 0 AS classified_count
+  FROM torrents t
+  LEFT JOIN LATERAL (
+    SELECT content_type FROM torrent_contents WHERE info_hash = t.info_hash ORDER BY updated_at DESC LIMIT 1
+  ) tc ON true
+  WHERE t.created_at >= @start AND t.created_at < @end
+  GROUP BY 1, 2
+  UNION ALL
+  SELECT date_trunc('hour', created_at, 'UTC'), coalesce(content_type, ''), 0, 0, count(DISTINCT info_hash)
+  FROM torrent_contents
+  WHERE created_at >= @start AND created_at < @end
+  GROUP BY 1, 2
+) s GROUP BY bucket, content_type`, args).Error
+}
+
+func (s indexStats) Timeline(ctx context.Context, q TimelineQuery) ([]TimelineBucket, error) {
+	interval := q.Interval
+	if !interval.IsValid() {
+		interval = IntervalDay
+	}
+	until := q.Until
+	if until.IsZero() {
+		until = time.Now()
+	}
+	db := s.db.WithContext(ctx).Table("index_stats").Select(
+		"date_trunc(?, bucket) AS bucket, nullif(content_type, '') AS content_type, "+
+			"sum(discovered_count)::bigint AS discovered_count, sum(discovered_size)::bigint AS discovered_size, "+
+			"sum(classified_count)::bigint AS classified_count",
+		string(interval),
+	).Where("bucket >= ? AND bucket < ?", q.Since, until)
+	if len(q.ContentTypes) > 0 {
+		contentTypes := make([]string, 0, len(q.ContentTypes))
+		for _, ct := range q.ContentTypes {
+			// unknown content types are stored as an empty string, as part of the primary key
+			contentTypes = append(contentTypes, ct.ContentType.String())
+		}
+		db = db.Where("content_type IN ?", contentTypes)
+	}
+	var buckets []TimelineBucket
+	// grouping by position, as the bucket output column has the same name as the table column
+	if err := db.Group("1, 2").Order("1, 2").Scan(&buckets).Error; err != nil {
+		return nil, err
+	}
+	return buckets, nil
+}
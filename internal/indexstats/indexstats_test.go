@@ -0,0 +1,29 @@
+package indexstats
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestWindows(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ws := windows(start, start.Add(36*time.Hour), 24*time.Hour)
+	assert.Equal(t, []window{
+		{start: start, end: start.Add(24 * time.Hour)},
+		{start: start.Add(24 * time.Hour), end: start.Add(48 * time.Hour)},
+	}, ws)
+}
+
+func TestWindows__end_on_boundary(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ws := windows(start, start.Add(24*time.Hour), 24*time.Hour)
+	assert.Equal(t, []window{
+		{start: start, end: start.Add(24 * time.Hour)},
+	}, ws)
+}
+
+func TestInterval_IsValid(t *testing.T) {
+	assert.True(t, IntervalWeek.IsValid())
+	assert.False(t, Interval("year").IsValid())
+}
@@ -0,0 +1,17 @@
+package indexstatsfx
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/config/configfx"
+	"github.com/bitmagnet-io/bitmagnet/internal/indexstats"
+	"go.uber.org/fx"
+)
+
+func New() fx.Option {
+	return fx.Module(
+		"index_stats",
+		configfx.NewConfigModule[indexstats.Config]("index_stats", indexstats.NewDefaultConfig()),
+		fx.Provide(
+			indexstats.New,
+		),
+	)
+}
@@ -0,0 +1,33 @@
+package indexstats
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"time"
+)
+
+// scheduler refreshes the rollup when it starts, and then at the configured interval.
+type scheduler struct {
+	refresher Refresher
+	interval  time.Duration
+	stopped   chan struct{}
+	logger    *zap.SugaredLogger
+}
+
+func (s *scheduler) start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		for {
+			if err := s.refresher.Refresh(ctx); err != nil && ctx.Err() == nil {
+				s.logger.Errorw("failed to refresh index stats", "error", err)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(s.interval):
+			}
+		}
+	}()
+	<-s.stopped
+}
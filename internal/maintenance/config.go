@@ -0,0 +1,37 @@
+package maintenance
+
+type Config struct {
+	// Enabled runs maintenance in the quiet hours of each day, in the process running the maintenance worker.
+	Enabled bool
+	// QuietHoursStart and QuietHoursEnd bound the daily window in which maintenance runs, as local times such as "02:00";
+	// the window may span midnight. Operations aren't started outside of the window.
+	QuietHoursStart string
+	QuietHoursEnd   string
+	// Tables are vacuumed and analyzed in each window, and their bloated indexes are rebuilt.
+	Tables []string
+	// MaxActiveQueries is the number of other active queries above which the database is considered busy;
+	// operations are postponed while it's busy.
+	MaxActiveQueries uint
+	// ReindexLeafDensity is the average leaf density percentage below which a B-tree index is considered bloated
+	// and is rebuilt. Index bloat is measured with the pgstattuple extension; indexes aren't rebuilt if it isn't installed.
+	ReindexLeafDensity float64
+	// ReindexMinSize is the size in bytes of the smallest index that will be rebuilt.
+	ReindexMinSize uint64
+}
+
+func NewDefaultConfig() Config {
+	return Config{
+		QuietHoursStart: "02:00",
+		QuietHoursEnd:   "05:00",
+		Tables: []string{
+			"torrents",
+			"torrent_contents",
+			"torrents_torrent_sources",
+			"torrent_files",
+			"content",
+		},
+		MaxActiveQueries:   4,
+		ReindexLeafDensity: 50,
+		ReindexMinSize:     100_000_000,
+	}
+}
@@ -0,0 +1,86 @@
+package maintenance
+
+import (
+	"context"
+	"database/sql"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/worker"
+	"github.com/bitmagnet-io/bitmagnet/internal/taskrun"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"time"
+)
+
+type Params struct {
+	fx.In
+	Config          Config
+	DB              lazy.Lazy[*sql.DB]
+	TaskRunRecorder lazy.Lazy[taskrun.Recorder]
+	Logger          *zap.SugaredLogger
+}
+
+type Result struct {
+	fx.Out
+	Maintainer lazy.Lazy[Maintainer]
+	Worker     worker.Worker `group:"workers"`
+}
+
+func New(p Params) Result {
+	logger := p.Logger.Named("maintenance")
+	lm := lazy.New(func() (Maintainer, error) {
+		db, err := p.DB.Get()
+		if err != nil {
+			return nil, err
+		}
+		tr, err := p.TaskRunRecorder.Get()
+		if err != nil {
+			return nil, err
+		}
+		return maintainer{
+			db:                 db,
+			tables:             p.Config.Tables,
+			maxActiveQueries:   p.Config.MaxActiveQueries,
+			reindexLeafDensity: p.Config.ReindexLeafDensity,
+			reindexMinSize:     p.Config.ReindexMinSize,
+			busyRetryInterval:  time.Minute,
+			taskRunRecorder:    tr,
+			logger:             logger,
+		}, nil
+	})
+	var s *scheduler
+	return Result{
+		Maintainer: lm,
+		Worker: worker.NewWorker(
+			"maintenance",
+			fx.Hook{
+				OnStart: func(context.Context) error {
+					if !p.Config.Enabled {
+						return nil
+					}
+					q, err := parseQuietHours(p.Config.QuietHoursStart, p.Config.QuietHoursEnd)
+					if err != nil {
+						return err
+					}
+					m, err := lm.Get()
+					if err != nil {
+						return err
+					}
+					s = &scheduler{
+						maintainer: m,
+						quietHours: q,
+						logger:     logger,
+						stopped:    make(chan struct{}),
+					}
+					go s.start()
+					return nil
+				},
+				OnStop: func(context.Context) error {
+					if s != nil {
+						close(s.stopped)
+					}
+					return nil
+				},
+			},
+		),
+	}
+}
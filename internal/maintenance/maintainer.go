@@ -0,0 +1,185 @@
+package maintenance
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/taskrun"
+	"go.uber.org/zap"
+	"strings"
+	"time"
+)
+
+// ErrBusy is returned when the database stayed busy until the end of the quiet hours.
+var ErrBusy = errors.New("database is busy")
+
+type Maintainer interface {
+	// Maintain vacuums and analyzes the configured tables and rebuilds their bloated indexes,
+	// waiting for the database to be idle before each operation, and stopping at the deadline.
+	Maintain(ctx context.Context, deadline time.Time) error
+}
+
+type maintainer struct {
+	db                 *sql.DB
+	tables             []string
+	maxActiveQueries   uint
+	reindexLeafDensity float64
+	reindexMinSize     uint64
+	busyRetryInterval  time.Duration
+	taskRunRecorder    taskrun.Recorder
+	logger             *zap.SugaredLogger
+}
+
+func (m maintainer) Maintain(ctx context.Context, deadline time.Time) error {
+	ctx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+	for _, table := range m.tables {
+		if err := m.run(ctx, taskrun.KindVacuum, table, "VACUUM (ANALYZE) "+quoteIdent(table)); err != nil {
+			return err
+		}
+	}
+	if err := m.dropInvalidIndexes(ctx); err != nil {
+		return err
+	}
+	indexes, err := m.bloatedIndexes(ctx)
+	if err != nil {
+		return err
+	}
+	for _, index := range indexes {
+		// concurrent rebuilds don't block writes to the table
+		if err := m.run(ctx, taskrun.KindReindex, index, "REINDEX INDEX CONCURRENTLY "+quoteIdent(index)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m maintainer) run(ctx context.Context, kind string, target string, stmt string) error {
+	if err := m.awaitIdle(ctx); err != nil {
+		return err
+	}
+	m.logger.Infow("running maintenance", "kind", kind, "target", target)
+	run := m.taskRunRecorder.StartTarget(ctx, kind, target)
+	_, err := m.db.ExecContext(ctx, stmt)
+	run.Finish(err)
+	if err != nil {
+		return fmt.Errorf("%s: %w", stmt, err)
+	}
+	return nil
+}
+
+// awaitIdle waits until the database has no more than the maximum number of other active queries.
+func (m maintainer) awaitIdle(ctx context.Context) error {
+	for {
+		var active uint
+		if err := m.db.QueryRowContext(
+			ctx,
+			"SELECT count(*) FROM pg_stat_activity"+
+				" WHERE datname = current_database() AND state = 'active' AND pid <> pg_backend_pid()",
+		).Scan(&active); err != nil {
+			return err
+		}
+		if active <= m.maxActiveQueries {
+			return nil
+		}
+		m.logger.Debugw("database is busy, postponing maintenance", "activeQueries", active)
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return ErrBusy
+			}
+			return ctx.Err()
+		case <-time.After(m.busyRetryInterval):
+		}
+	}
+}
+
+// tableIndexesCondition matches the indexes of the configured tables, given as $1, and of their partitions.
+const tableIndexesCondition = "(x.indrelid = ANY (SELECT to_regclass(t) FROM unnest($1::text[]) t)" +
+	" OR x.indrelid IN (SELECT inhrelid FROM pg_inherits WHERE inhparent = ANY (SELECT to_regclass(t) FROM unnest($1::text[]) t)))"
+
+// dropInvalidIndexes drops the invalid indexes left by concurrent rebuilds that were interrupted, for example by the end
+// of the quiet hours, so that they're neither maintained on writes nor left to accumulate with each interrupted rebuild.
+// The new index of an interrupted rebuild is suffixed with _ccnew, and an old index that couldn't be dropped after its
+// rebuild with _ccold.
+func (m maintainer) dropInvalidIndexes(ctx context.Context) error {
+	indexes, err := m.queryIndexes(
+		ctx,
+		"SELECT i.relname FROM pg_index x"+
+			" JOIN pg_class i ON i.oid = x.indexrelid"+
+			" WHERE NOT x.indisvalid AND i.relnamespace = current_schema()::regnamespace"+
+			" AND i.relname ~ '_cc(new|old)[0-9]*$'"+
+			" AND "+tableIndexesCondition+
+			" ORDER BY i.relname",
+		m.tables,
+	)
+	if err != nil {
+		return err
+	}
+	for _, index := range indexes {
+		if err := m.awaitIdle(ctx); err != nil {
+			return err
+		}
+		m.logger.Warnw("dropping invalid index left by an interrupted rebuild", "index", index)
+		stmt := "DROP INDEX CONCURRENTLY IF EXISTS " + quoteIdent(index)
+		if _, err := m.db.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("%s: %w", stmt, err)
+		}
+	}
+	return nil
+}
+
+// bloatedIndexes returns the valid B-tree indexes of the configured tables, and of their partitions,
+// with an average leaf density below the threshold. Index bloat can only be measured with the pgstattuple extension;
+// no indexes are returned if it isn't installed.
+func (m maintainer) bloatedIndexes(ctx context.Context) ([]string, error) {
+	var installed bool
+	if err := m.db.QueryRowContext(
+		ctx,
+		"SELECT EXISTS (SELECT 1 FROM pg_extension WHERE extname = 'pgstattuple')",
+	).Scan(&installed); err != nil {
+		return nil, err
+	}
+	if !installed {
+		m.logger.Infow("the pgstattuple extension isn't installed, skipping index rebuilds")
+		return nil, nil
+	}
+	return m.queryIndexes(
+		ctx,
+		"SELECT i.relname FROM pg_index x"+
+			" JOIN pg_class i ON i.oid = x.indexrelid"+
+			" JOIN pg_am a ON a.oid = i.relam"+
+			" WHERE a.amname = 'btree' AND i.relkind = 'i' AND x.indisvalid"+
+			" AND i.relnamespace = current_schema()::regnamespace"+
+			" AND "+tableIndexesCondition+
+			" AND pg_relation_size(i.oid) >= $2"+
+			" AND (SELECT avg_leaf_density FROM pgstatindex(i.oid::regclass)) < $3"+
+			" ORDER BY i.relname",
+		m.tables,
+		m.reindexMinSize,
+		m.reindexLeafDensity,
+	)
+}
+
+// queryIndexes returns the index names returned by a query.
+func (m maintainer) queryIndexes(ctx context.Context, query string, args ...any) ([]string, error) {
+	rows, err := m.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var indexes []string
+	for rows.Next() {
+		var index string
+		if err := rows.Scan(&index); err != nil {
+			return nil, err
+		}
+		indexes = append(indexes, index)
+	}
+	return indexes, rows.Err()
+}
+
+func quoteIdent(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
@@ -0,0 +1,17 @@
+package maintenancefx
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/config/configfx"
+	"github.com/bitmagnet-io/bitmagnet/internal/maintenance"
+	"go.uber.org/fx"
+)
+
+func New() fx.Option {
+	return fx.Module(
+		"maintenance",
+		configfx.NewConfigModule[maintenance.Config]("maintenance", maintenance.NewDefaultConfig()),
+		fx.Provide(
+			maintenance.New,
+		),
+	)
+}
@@ -0,0 +1,55 @@
+package maintenance
+
+import (
+	"fmt"
+	"time"
+)
+
+const day = 24 * time.Hour
+
+// quietHours is a daily window, as offsets from local midnight.
+type quietHours struct {
+	start  time.Duration
+	length time.Duration
+}
+
+func parseQuietHours(start, end string) (quietHours, error) {
+	startOffset, err := parseTimeOfDay(start)
+	if err != nil {
+		return quietHours{}, err
+	}
+	endOffset, err := parseTimeOfDay(end)
+	if err != nil {
+		return quietHours{}, err
+	}
+	if startOffset == endOffset {
+		return quietHours{}, fmt.Errorf("quiet hours must not start and end at the same time: %s", start)
+	}
+	length := endOffset - startOffset
+	if length < 0 {
+		length += day
+	}
+	return quietHours{start: startOffset, length: length}, nil
+}
+
+func parseTimeOfDay(str string) (time.Duration, error) {
+	t, err := time.Parse("15:04", str)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day %q, expected a time such as 02:00", str)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// next returns the bounds of the window containing t, or else of the next window to start after t.
+func (q quietHours) next(t time.Time) (time.Time, time.Time) {
+	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	for i := -1; ; i++ {
+		// the date is advanced rather than adding days, so that the window keeps its local time across DST changes
+		dayStart := midnight.AddDate(0, 0, i)
+		start := dayStart.Add(q.start)
+		end := start.Add(q.length)
+		if end.After(t) {
+			return start, end
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package maintenance
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestQuietHours(t *testing.T) {
+	t.Parallel()
+
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2024, 3, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	t.Run("same day", func(t *testing.T) {
+		t.Parallel()
+		q, err := parseQuietHours("02:00", "05:30")
+		require.NoError(t, err)
+		for _, tc := range []struct {
+			t             time.Time
+			expectedStart time.Time
+		}{
+			{at(10, 1, 0), at(10, 2, 0)},
+			{at(10, 2, 0), at(10, 2, 0)},
+			{at(10, 5, 29), at(10, 2, 0)},
+			{at(10, 5, 30), at(11, 2, 0)},
+			{at(10, 23, 0), at(11, 2, 0)},
+		} {
+			start, end := q.next(tc.t)
+			assert.Equal(t, tc.expectedStart, start, tc.t.String())
+			assert.Equal(t, tc.expectedStart.Add(3*time.Hour+30*time.Minute), end, tc.t.String())
+		}
+	})
+
+	t.Run("spanning midnight", func(t *testing.T) {
+		t.Parallel()
+		q, err := parseQuietHours("23:00", "04:00")
+		require.NoError(t, err)
+		for _, tc := range []struct {
+			t             time.Time
+			expectedStart time.Time
+		}{
+			{at(10, 1, 0), at(9, 23, 0)},
+			{at(10, 4, 0), at(10, 23, 0)},
+			{at(10, 22, 59), at(10, 23, 0)},
+			{at(10, 23, 30), at(10, 23, 0)},
+		} {
+			start, end := q.next(tc.t)
+			assert.Equal(t, tc.expectedStart, start, tc.t.String())
+			assert.Equal(t, tc.expectedStart.Add(5*time.Hour), end, tc.t.String())
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+		for _, tc := range [][2]string{{"2am", "05:00"}, {"02:00", "25:00"}, {"03:00", "03:00"}} {
+			_, err := parseQuietHours(tc[0], tc[1])
+			assert.Error(t, err, tc)
+		}
+	})
+}
@@ -0,0 +1,40 @@
+package maintenance
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"time"
+)
+
+// scheduler runs maintenance once in each window of quiet hours.
+type scheduler struct {
+	maintainer Maintainer
+	quietHours quietHours
+	logger     *zap.SugaredLogger
+	stopped    chan struct{}
+}
+
+func (s *scheduler) start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		for {
+			start, end := s.quietHours.next(time.Now())
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(time.Until(start)):
+			}
+			if err := s.maintainer.Maintain(ctx, end); err != nil {
+				s.logger.Errorw("maintenance failed", "error", err)
+			}
+			// wait for the end of the window, so that maintenance runs only once in it
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(time.Until(end)):
+			}
+		}
+	}()
+	<-s.stopped
+}
@@ -0,0 +1,47 @@
+package model
+
+import "sort"
+
+// AudioFormat represents an audio codec or format of a release
+// ENUM(AAC, AC3, EAC3, DTS, DTSHD, DTSX, TrueHD, Atmos, FLAC)
+type AudioFormat string
+
+func (v AudioFormat) Label() string {
+	switch v {
+	case AudioFormatAC3:
+		return "DD"
+	case AudioFormatEAC3:
+		return "DD+"
+	case AudioFormatDTSHD:
+		return "DTS-HD"
+	case AudioFormatDTSX:
+		return "DTS:X"
+	default:
+		return v.String()
+	}
+}
+
+// AudioFormats are the audio formats of a release, such as TrueHD with Atmos.
+type AudioFormats []AudioFormat
+
+// Add adds a format if not already present, keeping the formats sorted.
+func (f AudioFormats) Add(format AudioFormat) AudioFormats {
+	for _, existing := range f {
+		if existing == format {
+			return f
+		}
+	}
+	f = append(f, format)
+	sort.Slice(f, func(i, j int) bool {
+		return f[i] < f[j]
+	})
+	return f
+}
+
+func (f AudioFormats) Labels() []string {
+	labels := make([]string, 0, len(f))
+	for _, format := range f {
+		labels = append(labels, format.Label())
+	}
+	return labels
+}
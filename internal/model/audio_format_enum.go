@@ -0,0 +1,237 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version:
+// Revision:
+// Build Date:
+// Built By:
+
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	AudioFormatAAC    AudioFormat = "AAC"
+	AudioFormatAC3    AudioFormat = "AC3"
+	AudioFormatEAC3   AudioFormat = "EAC3"
+	AudioFormatDTS    AudioFormat = "DTS"
+	AudioFormatDTSHD  AudioFormat = "DTSHD"
+	AudioFormatDTSX   AudioFormat = "DTSX"
+	AudioFormatTrueHD AudioFormat = "TrueHD"
+	AudioFormatAtmos  AudioFormat = "Atmos"
+	AudioFormatFLAC   AudioFormat = "FLAC"
+)
+
+var ErrInvalidAudioFormat = fmt.Errorf("not a valid AudioFormat, try [%s]", strings.Join(_AudioFormatNames, ", "))
+
+var _AudioFormatNames = []string{
+	string(AudioFormatAAC),
+	string(AudioFormatAC3),
+	string(AudioFormatEAC3),
+	string(AudioFormatDTS),
+	string(AudioFormatDTSHD),
+	string(AudioFormatDTSX),
+	string(AudioFormatTrueHD),
+	string(AudioFormatAtmos),
+	string(AudioFormatFLAC),
+}
+
+// AudioFormatNames returns a list of possible string values of AudioFormat.
+func AudioFormatNames() []string {
+	tmp := make([]string, len(_AudioFormatNames))
+	copy(tmp, _AudioFormatNames)
+	return tmp
+}
+
+// AudioFormatValues returns a list of the values for AudioFormat
+func AudioFormatValues() []AudioFormat {
+	return []AudioFormat{
+		AudioFormatAAC,
+		AudioFormatAC3,
+		AudioFormatEAC3,
+		AudioFormatDTS,
+		AudioFormatDTSHD,
+		AudioFormatDTSX,
+		AudioFormatTrueHD,
+		AudioFormatAtmos,
+		AudioFormatFLAC,
+	}
+}
+
+// String implements the Stringer interface.
+func (x AudioFormat) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x AudioFormat) IsValid() bool {
+	_, err := ParseAudioFormat(string(x))
+	return err == nil
+}
+
+var _AudioFormatValue = map[string]AudioFormat{
+	"AAC":    AudioFormatAAC,
+	"aac":    AudioFormatAAC,
+	"AC3":    AudioFormatAC3,
+	"ac3":    AudioFormatAC3,
+	"EAC3":   AudioFormatEAC3,
+	"eac3":   AudioFormatEAC3,
+	"DTS":    AudioFormatDTS,
+	"dts":    AudioFormatDTS,
+	"DTSHD":  AudioFormatDTSHD,
+	"dtshd":  AudioFormatDTSHD,
+	"DTSX":   AudioFormatDTSX,
+	"dtsx":   AudioFormatDTSX,
+	"TrueHD": AudioFormatTrueHD,
+	"truehd": AudioFormatTrueHD,
+	"Atmos":  AudioFormatAtmos,
+	"atmos":  AudioFormatAtmos,
+	"FLAC":   AudioFormatFLAC,
+	"flac":   AudioFormatFLAC,
+}
+
+// ParseAudioFormat attempts to convert a string to a AudioFormat.
+func ParseAudioFormat(name string) (AudioFormat, error) {
+	if x, ok := _AudioFormatValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _AudioFormatValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return AudioFormat(""), fmt.Errorf("%s is %w", name, ErrInvalidAudioFormat)
+}
+
+// MarshalText implements the text marshaller method.
+func (x AudioFormat) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *AudioFormat) UnmarshalText(text []byte) error {
+	tmp, err := ParseAudioFormat(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+var errAudioFormatNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *AudioFormat) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = AudioFormat("")
+		return
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case string:
+		*x, err = ParseAudioFormat(v)
+	case []byte:
+		*x, err = ParseAudioFormat(string(v))
+	case AudioFormat:
+		*x = v
+	case *AudioFormat:
+		if v == nil {
+			return errAudioFormatNilPtr
+		}
+		*x = *v
+	case *string:
+		if v == nil {
+			return errAudioFormatNilPtr
+		}
+		*x, err = ParseAudioFormat(*v)
+	default:
+		return errors.New("invalid type for AudioFormat")
+	}
+
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x AudioFormat) Value() (driver.Value, error) {
+	return x.String(), nil
+}
+
+type NullAudioFormat struct {
+	AudioFormat AudioFormat
+	Valid       bool
+	Set         bool
+}
+
+func NewNullAudioFormat(val interface{}) (x NullAudioFormat) {
+	err := x.Scan(val) // yes, we ignore this error, it will just be an invalid value.
+	_ = err            // make any errcheck linters happy
+	return
+}
+
+// Scan implements the Scanner interface.
+func (x *NullAudioFormat) Scan(value interface{}) (err error) {
+	if value == nil {
+		x.AudioFormat, x.Valid = AudioFormat(""), false
+		return
+	}
+
+	err = x.AudioFormat.Scan(value)
+	x.Valid = (err == nil)
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x NullAudioFormat) Value() (driver.Value, error) {
+	if !x.Valid {
+		return nil, nil
+	}
+	return x.AudioFormat.String(), nil
+}
+
+// MarshalJSON correctly serializes a NullAudioFormat to JSON.
+func (n NullAudioFormat) MarshalJSON() ([]byte, error) {
+	const nullStr = "null"
+	if n.Valid {
+		return json.Marshal(n.AudioFormat)
+	}
+	return []byte(nullStr), nil
+}
+
+// UnmarshalJSON correctly deserializes a NullAudioFormat from JSON.
+func (n *NullAudioFormat) UnmarshalJSON(b []byte) error {
+	n.Set = true
+	var x interface{}
+	err := json.Unmarshal(b, &x)
+	if err != nil {
+		return err
+	}
+	err = n.Scan(x)
+	return err
+}
+
+// MarshalGQL correctly serializes a NullAudioFormat to GraphQL.
+func (n NullAudioFormat) MarshalGQL(w io.Writer) {
+	bytes, err := json.Marshal(n)
+	if err == nil {
+		_, _ = w.Write(bytes)
+	}
+}
+
+// UnmarshalGQL correctly deserializes a NullAudioFormat from GraphQL.
+func (n *NullAudioFormat) UnmarshalGQL(v any) error {
+	if v == nil {
+		return nil
+	}
+	str, ok := v.(string)
+	if !ok {
+		return errors.New("value is not a string")
+	}
+	return n.UnmarshalJSON([]byte(str))
+}
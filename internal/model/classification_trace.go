@@ -0,0 +1,23 @@
+package model
+
+// ClassificationTraceLookup is a search for the content of a torrent made while classifying it, with the candidates found.
+type ClassificationTraceLookup struct {
+	// Source is where the content was looked up: "local" for the database, "tmdb" for a TMDB search, or "batch" for
+	// the result of the same lookup made earlier in a processing batch, of which the candidates aren't repeated
+	Source     string                         `json:"source"`
+	Query      string                         `json:"query"`
+	Year       Year                           `json:"year,omitempty"`
+	Candidates []ClassificationTraceCandidate `json:"candidates,omitempty"`
+}
+
+// ClassificationTraceCandidate is content found by a lookup, with the reason it was rejected if it wasn't matched.
+type ClassificationTraceCandidate struct {
+	Source string `json:"source"`
+	ID     string `json:"id"`
+	Title  string `json:"title"`
+	Year   Year   `json:"year,omitempty"`
+	// Distance is the smallest Levenshtein distance between the looked up title and the candidate's titles
+	Distance uint       `json:"distance"`
+	Matched  bool       `json:"matched"`
+	Reason   NullString `json:"reason"`
+}
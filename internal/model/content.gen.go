@@ -33,6 +33,7 @@ type Content struct {
 	Tsv              fts.Tsvector        `gorm:"column:tsv" json:"tsv"`
 	Collections      []ContentCollection `gorm:"many2many:content_collections_content" json:"collections"`
 	Attributes       []ContentAttribute  `json:"attributes"`
+	People           []ContentPerson     `json:"people"`
 	MetadataSource   MetadataSource      `gorm:"foreignKey:Source" json:"metadata_source"`
 }
 
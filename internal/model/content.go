@@ -2,8 +2,14 @@ package model
 
 import (
 	"github.com/bitmagnet-io/bitmagnet/internal/database/fts"
+	"github.com/bitmagnet-io/bitmagnet/internal/romanize"
+	"strings"
 )
 
+// AlternativeTitleKeyPrefix prefixes the keys of the attributes of the alternative and translated titles of content,
+// which are keyed by the normalized title so that each is stored once.
+const AlternativeTitleKeyPrefix = "alternative_title:"
+
 type ContentRef struct {
 	Type   ContentType
 	Source string
@@ -30,6 +36,17 @@ func (c Content) Identifier(source string) (string, bool) {
 	return "", false
 }
 
+// AlternativeTitles returns the alternative and translated titles of the content.
+func (c Content) AlternativeTitles() []string {
+	var titles []string
+	for _, attr := range c.Attributes {
+		if strings.HasPrefix(attr.Key, AlternativeTitleKeyPrefix) {
+			titles = append(titles, attr.Value)
+		}
+	}
+	return titles
+}
+
 type ExternalLink struct {
 	MetadataSource
 	ID  string
@@ -81,9 +98,19 @@ func (c *Content) UpdateTsv() {
 	if c.OriginalTitle.Valid && c.Title != c.OriginalTitle.String {
 		tsv.AddText(c.OriginalTitle.String, fts.TsvectorWeightA)
 	}
+	// titles in non-Latin scripts are also searchable in romanized form, as they're often written in torrent names
+	if romanize.HasNonLatinLetters(c.Title) || (c.OriginalTitle.Valid && romanize.HasNonLatinLetters(c.OriginalTitle.String)) {
+		tsv.AddText(romanize.Romanize(c.Title), fts.TsvectorWeightA)
+		if c.OriginalTitle.Valid {
+			tsv.AddText(romanize.Romanize(c.OriginalTitle.String), fts.TsvectorWeightA)
+		}
+	}
 	if !c.ReleaseYear.IsNil() {
 		tsv.AddText(c.ReleaseYear.String(), fts.TsvectorWeightB)
 	}
+	for _, title := range c.AlternativeTitles() {
+		tsv.AddText(title, fts.TsvectorWeightB)
+	}
 	for _, c := range c.Collections {
 		if c.Type == "genre" {
 			tsv.AddText(c.Name, fts.TsvectorWeightD)
@@ -0,0 +1,5 @@
+package model
+
+// ContentPersonRole represents how a person is credited for content
+// ENUM(cast, crew)
+type ContentPersonRole string
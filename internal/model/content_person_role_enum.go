@@ -0,0 +1,200 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version:
+// Revision:
+// Build Date:
+// Built By:
+
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	ContentPersonRoleCast ContentPersonRole = "cast"
+	ContentPersonRoleCrew ContentPersonRole = "crew"
+)
+
+var ErrInvalidContentPersonRole = fmt.Errorf("not a valid ContentPersonRole, try [%s]", strings.Join(_ContentPersonRoleNames, ", "))
+
+var _ContentPersonRoleNames = []string{
+	string(ContentPersonRoleCast),
+	string(ContentPersonRoleCrew),
+}
+
+// ContentPersonRoleNames returns a list of possible string values of ContentPersonRole.
+func ContentPersonRoleNames() []string {
+	tmp := make([]string, len(_ContentPersonRoleNames))
+	copy(tmp, _ContentPersonRoleNames)
+	return tmp
+}
+
+// ContentPersonRoleValues returns a list of the values for ContentPersonRole
+func ContentPersonRoleValues() []ContentPersonRole {
+	return []ContentPersonRole{
+		ContentPersonRoleCast,
+		ContentPersonRoleCrew,
+	}
+}
+
+// String implements the Stringer interface.
+func (x ContentPersonRole) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x ContentPersonRole) IsValid() bool {
+	_, err := ParseContentPersonRole(string(x))
+	return err == nil
+}
+
+var _ContentPersonRoleValue = map[string]ContentPersonRole{
+	"cast": ContentPersonRoleCast,
+	"crew": ContentPersonRoleCrew,
+}
+
+// ParseContentPersonRole attempts to convert a string to a ContentPersonRole.
+func ParseContentPersonRole(name string) (ContentPersonRole, error) {
+	if x, ok := _ContentPersonRoleValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _ContentPersonRoleValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return ContentPersonRole(""), fmt.Errorf("%s is %w", name, ErrInvalidContentPersonRole)
+}
+
+// MarshalText implements the text marshaller method.
+func (x ContentPersonRole) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *ContentPersonRole) UnmarshalText(text []byte) error {
+	tmp, err := ParseContentPersonRole(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+var errContentPersonRoleNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *ContentPersonRole) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = ContentPersonRole("")
+		return
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case string:
+		*x, err = ParseContentPersonRole(v)
+	case []byte:
+		*x, err = ParseContentPersonRole(string(v))
+	case ContentPersonRole:
+		*x = v
+	case *ContentPersonRole:
+		if v == nil {
+			return errContentPersonRoleNilPtr
+		}
+		*x = *v
+	case *string:
+		if v == nil {
+			return errContentPersonRoleNilPtr
+		}
+		*x, err = ParseContentPersonRole(*v)
+	default:
+		return errors.New("invalid type for ContentPersonRole")
+	}
+
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x ContentPersonRole) Value() (driver.Value, error) {
+	return x.String(), nil
+}
+
+type NullContentPersonRole struct {
+	ContentPersonRole ContentPersonRole
+	Valid             bool
+	Set               bool
+}
+
+func NewNullContentPersonRole(val interface{}) (x NullContentPersonRole) {
+	err := x.Scan(val) // yes, we ignore this error, it will just be an invalid value.
+	_ = err            // make any errcheck linters happy
+	return
+}
+
+// Scan implements the Scanner interface.
+func (x *NullContentPersonRole) Scan(value interface{}) (err error) {
+	if value == nil {
+		x.ContentPersonRole, x.Valid = ContentPersonRole(""), false
+		return
+	}
+
+	err = x.ContentPersonRole.Scan(value)
+	x.Valid = (err == nil)
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x NullContentPersonRole) Value() (driver.Value, error) {
+	if !x.Valid {
+		return nil, nil
+	}
+	return x.ContentPersonRole.String(), nil
+}
+
+// MarshalJSON correctly serializes a NullContentPersonRole to JSON.
+func (n NullContentPersonRole) MarshalJSON() ([]byte, error) {
+	const nullStr = "null"
+	if n.Valid {
+		return json.Marshal(n.ContentPersonRole)
+	}
+	return []byte(nullStr), nil
+}
+
+// UnmarshalJSON correctly deserializes a NullContentPersonRole from JSON.
+func (n *NullContentPersonRole) UnmarshalJSON(b []byte) error {
+	n.Set = true
+	var x interface{}
+	err := json.Unmarshal(b, &x)
+	if err != nil {
+		return err
+	}
+	err = n.Scan(x)
+	return err
+}
+
+// MarshalGQL correctly serializes a NullContentPersonRole to GraphQL.
+func (n NullContentPersonRole) MarshalGQL(w io.Writer) {
+	bytes, err := json.Marshal(n)
+	if err == nil {
+		_, _ = w.Write(bytes)
+	}
+}
+
+// UnmarshalGQL correctly deserializes a NullContentPersonRole from GraphQL.
+func (n *NullContentPersonRole) UnmarshalGQL(v any) error {
+	if v == nil {
+		return nil
+	}
+	str, ok := v.(string)
+	if !ok {
+		return errors.New("value is not a string")
+	}
+	return n.UnmarshalJSON([]byte(str))
+}
@@ -0,0 +1,49 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var imdbIdRegex = regexp.MustCompile(`^tt\d+$`)
+
+var ErrInvalidContentRef = errors.New("invalid content reference")
+
+// ParseContentRef parses a content reference from one of the following forms:
+//   - a bare IMDb ID, e.g. "tt0111161"
+//   - "source:id", e.g. "tmdb:278" or "imdb:tt0111161"
+//   - "type:source:id", e.g. "movie:tmdb:278"
+func ParseContentRef(str string) (ContentRef, error) {
+	str = strings.TrimSpace(str)
+	if imdbIdRegex.MatchString(str) {
+		return ContentRef{Source: "imdb", ID: str}, nil
+	}
+	parts := strings.Split(str, ":")
+	for _, p := range parts {
+		if p == "" {
+			return ContentRef{}, fmt.Errorf("%w: %q", ErrInvalidContentRef, str)
+		}
+	}
+	switch len(parts) {
+	case 2:
+		return ContentRef{Source: parts[0], ID: parts[1]}, nil
+	case 3:
+		contentType, err := ParseContentType(parts[0])
+		if err != nil {
+			return ContentRef{}, fmt.Errorf("%w: %q: %w", ErrInvalidContentRef, str, err)
+		}
+		return ContentRef{Type: contentType, Source: parts[1], ID: parts[2]}, nil
+	default:
+		return ContentRef{}, fmt.Errorf("%w: %q", ErrInvalidContentRef, str)
+	}
+}
+
+// String is the inverse of ParseContentRef.
+func (r ContentRef) String() string {
+	if r.Type.IsNil() {
+		return r.Source + ":" + r.ID
+	}
+	return r.Type.String() + ":" + r.Source + ":" + r.ID
+}
@@ -0,0 +1,38 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseContentRef(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		input    string
+		expected ContentRef
+	}{
+		{"tt0111161", ContentRef{Source: "imdb", ID: "tt0111161"}},
+		{" tt0111161\t", ContentRef{Source: "imdb", ID: "tt0111161"}},
+		{"imdb:tt0111161", ContentRef{Source: "imdb", ID: "tt0111161"}},
+		{"tmdb:278", ContentRef{Source: "tmdb", ID: "278"}},
+		{"movie:tmdb:278", ContentRef{Type: ContentTypeMovie, Source: "tmdb", ID: "278"}},
+		{"tv_show:tvdb:81189", ContentRef{Type: ContentTypeTvShow, Source: "tvdb", ID: "81189"}},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			ref, err := ParseContentRef(tc.input)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, ref)
+			roundTripped, err := ParseContentRef(ref.String())
+			assert.NoError(t, err)
+			assert.Equal(t, ref, roundTripped)
+		})
+	}
+
+	for _, input := range []string{"", "278", "tmdb:", ":278", "film:tmdb:278", "a:b:c:d"} {
+		t.Run(input, func(t *testing.T) {
+			_, err := ParseContentRef(input)
+			assert.ErrorIs(t, err, ErrInvalidContentRef)
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestContent_UpdateTsv(t *testing.T) {
+	t.Parallel()
+
+	t.Run("latin titles", func(t *testing.T) {
+		t.Parallel()
+		c := Content{Title: "The Matrix", OriginalTitle: NewNullString("The Matrix"), ReleaseYear: 1999}
+		c.UpdateTsv()
+		assert.Equal(t, "'1999':4B 'matrix':2A 'the':1A", c.Tsv.String())
+	})
+
+	t.Run("non-latin original title", func(t *testing.T) {
+		t.Parallel()
+		c := Content{Title: "Parasite", OriginalTitle: NewNullString("기생충")}
+		c.UpdateTsv()
+		assert.Equal(t, "'cung':5A 'gi':3A 'gisaengcung':9A 'parasite':1A,7A 'saeng':4A", c.Tsv.String())
+	})
+}
+
+func TestTorrentContent_UpdateTsv(t *testing.T) {
+	t.Parallel()
+
+	tc := TorrentContent{Torrent: Torrent{Name: "カラテ 1080p"}}
+	tc.UpdateTsv()
+	assert.Contains(t, tc.Tsv, "karate")
+	assert.Contains(t, tc.Tsv, "ka")
+}
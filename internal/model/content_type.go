@@ -1,7 +1,7 @@
 package model
 
 // ContentType represents the type of content
-// ENUM(movie, tv_show, music, game, software, book, xxx)
+// ENUM(movie, tv_show, music, game, software, book, xxx, sport)
 type ContentType string
 
 func (c ContentType) Label() string {
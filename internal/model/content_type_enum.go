@@ -23,6 +23,7 @@ const (
 	ContentTypeSoftware ContentType = "software"
 	ContentTypeBook     ContentType = "book"
 	ContentTypeXxx      ContentType = "xxx"
+	ContentTypeSport    ContentType = "sport"
 )
 
 var ErrInvalidContentType = fmt.Errorf("not a valid ContentType, try [%s]", strings.Join(_ContentTypeNames, ", "))
@@ -35,6 +36,7 @@ var _ContentTypeNames = []string{
 	string(ContentTypeSoftware),
 	string(ContentTypeBook),
 	string(ContentTypeXxx),
+	string(ContentTypeSport),
 }
 
 // ContentTypeNames returns a list of possible string values of ContentType.
@@ -54,6 +56,7 @@ func ContentTypeValues() []ContentType {
 		ContentTypeSoftware,
 		ContentTypeBook,
 		ContentTypeXxx,
+		ContentTypeSport,
 	}
 }
 
@@ -77,6 +80,7 @@ var _ContentTypeValue = map[string]ContentType{
 	"software": ContentTypeSoftware,
 	"book":     ContentTypeBook,
 	"xxx":      ContentTypeXxx,
+	"sport":    ContentTypeSport,
 }
 
 // ParseContentType attempts to convert a string to a ContentType.
@@ -0,0 +1,5 @@
+package model
+
+// DiscoveryMethod represents how a torrent was first discovered by a source
+// ENUM(dht_sample_infohashes, dht_announce, import)
+type DiscoveryMethod string
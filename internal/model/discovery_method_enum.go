@@ -0,0 +1,204 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version:
+// Revision:
+// Build Date:
+// Built By:
+
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	DiscoveryMethodDhtSampleInfohashes DiscoveryMethod = "dht_sample_infohashes"
+	DiscoveryMethodDhtAnnounce         DiscoveryMethod = "dht_announce"
+	DiscoveryMethodImport              DiscoveryMethod = "import"
+)
+
+var ErrInvalidDiscoveryMethod = fmt.Errorf("not a valid DiscoveryMethod, try [%s]", strings.Join(_DiscoveryMethodNames, ", "))
+
+var _DiscoveryMethodNames = []string{
+	string(DiscoveryMethodDhtSampleInfohashes),
+	string(DiscoveryMethodDhtAnnounce),
+	string(DiscoveryMethodImport),
+}
+
+// DiscoveryMethodNames returns a list of possible string values of DiscoveryMethod.
+func DiscoveryMethodNames() []string {
+	tmp := make([]string, len(_DiscoveryMethodNames))
+	copy(tmp, _DiscoveryMethodNames)
+	return tmp
+}
+
+// DiscoveryMethodValues returns a list of the values for DiscoveryMethod
+func DiscoveryMethodValues() []DiscoveryMethod {
+	return []DiscoveryMethod{
+		DiscoveryMethodDhtSampleInfohashes,
+		DiscoveryMethodDhtAnnounce,
+		DiscoveryMethodImport,
+	}
+}
+
+// String implements the Stringer interface.
+func (x DiscoveryMethod) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x DiscoveryMethod) IsValid() bool {
+	_, err := ParseDiscoveryMethod(string(x))
+	return err == nil
+}
+
+var _DiscoveryMethodValue = map[string]DiscoveryMethod{
+	"dht_sample_infohashes": DiscoveryMethodDhtSampleInfohashes,
+	"dht_announce":          DiscoveryMethodDhtAnnounce,
+	"import":                DiscoveryMethodImport,
+}
+
+// ParseDiscoveryMethod attempts to convert a string to a DiscoveryMethod.
+func ParseDiscoveryMethod(name string) (DiscoveryMethod, error) {
+	if x, ok := _DiscoveryMethodValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _DiscoveryMethodValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return DiscoveryMethod(""), fmt.Errorf("%s is %w", name, ErrInvalidDiscoveryMethod)
+}
+
+// MarshalText implements the text marshaller method.
+func (x DiscoveryMethod) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *DiscoveryMethod) UnmarshalText(text []byte) error {
+	tmp, err := ParseDiscoveryMethod(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+var errDiscoveryMethodNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *DiscoveryMethod) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = DiscoveryMethod("")
+		return
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case string:
+		*x, err = ParseDiscoveryMethod(v)
+	case []byte:
+		*x, err = ParseDiscoveryMethod(string(v))
+	case DiscoveryMethod:
+		*x = v
+	case *DiscoveryMethod:
+		if v == nil {
+			return errDiscoveryMethodNilPtr
+		}
+		*x = *v
+	case *string:
+		if v == nil {
+			return errDiscoveryMethodNilPtr
+		}
+		*x, err = ParseDiscoveryMethod(*v)
+	default:
+		return errors.New("invalid type for DiscoveryMethod")
+	}
+
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x DiscoveryMethod) Value() (driver.Value, error) {
+	return x.String(), nil
+}
+
+type NullDiscoveryMethod struct {
+	DiscoveryMethod DiscoveryMethod
+	Valid           bool
+	Set             bool
+}
+
+func NewNullDiscoveryMethod(val interface{}) (x NullDiscoveryMethod) {
+	err := x.Scan(val) // yes, we ignore this error, it will just be an invalid value.
+	_ = err            // make any errcheck linters happy
+	return
+}
+
+// Scan implements the Scanner interface.
+func (x *NullDiscoveryMethod) Scan(value interface{}) (err error) {
+	if value == nil {
+		x.DiscoveryMethod, x.Valid = DiscoveryMethod(""), false
+		return
+	}
+
+	err = x.DiscoveryMethod.Scan(value)
+	x.Valid = (err == nil)
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x NullDiscoveryMethod) Value() (driver.Value, error) {
+	if !x.Valid {
+		return nil, nil
+	}
+	return x.DiscoveryMethod.String(), nil
+}
+
+// MarshalJSON correctly serializes a NullDiscoveryMethod to JSON.
+func (n NullDiscoveryMethod) MarshalJSON() ([]byte, error) {
+	const nullStr = "null"
+	if n.Valid {
+		return json.Marshal(n.DiscoveryMethod)
+	}
+	return []byte(nullStr), nil
+}
+
+// UnmarshalJSON correctly deserializes a NullDiscoveryMethod from JSON.
+func (n *NullDiscoveryMethod) UnmarshalJSON(b []byte) error {
+	n.Set = true
+	var x interface{}
+	err := json.Unmarshal(b, &x)
+	if err != nil {
+		return err
+	}
+	err = n.Scan(x)
+	return err
+}
+
+// MarshalGQL correctly serializes a NullDiscoveryMethod to GraphQL.
+func (n NullDiscoveryMethod) MarshalGQL(w io.Writer) {
+	bytes, err := json.Marshal(n)
+	if err == nil {
+		_, _ = w.Write(bytes)
+	}
+}
+
+// UnmarshalGQL correctly deserializes a NullDiscoveryMethod from GraphQL.
+func (n *NullDiscoveryMethod) UnmarshalGQL(v any) error {
+	if v == nil {
+		return nil
+	}
+	str, ok := v.(string)
+	if !ok {
+		return errors.New("value is not a string")
+	}
+	return n.UnmarshalJSON([]byte(str))
+}
@@ -1,6 +1,6 @@
 package model
 
-//go:generate go run github.com/abice/go-enum --marshal --names --nocase --nocomments --sql --sqlnullstr --values -t enums.gql.tmpl -f content_type.go -f facet_logic.go -f file_type.go -f files_status.go -f video_3d.go -f video_codec.go -f video_modifier.go -f video_resolution.go -f video_source.go
+//go:generate go run github.com/abice/go-enum --marshal --names --nocase --nocomments --sql --sqlnullstr --values -t enums.gql.tmpl -f audio_format.go -f content_person_role.go -f content_type.go -f discovery_method.go -f facet_logic.go -f file_type.go -f files_status.go -f hdr_format.go -f saved_search_order_by.go -f spam_reason.go -f takedown_action.go -f task_run_status.go -f torrent_event_type.go -f torrent_report_reason.go -f video_3d.go -f video_codec.go -f video_modifier.go -f video_resolution.go -f video_source.go -f webhook_delivery_status.go -f webhook_event_type.go
 
 func removeEnumPrefixes(names ...string) []string {
 	var result []string
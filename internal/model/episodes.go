@@ -47,6 +47,18 @@ func (e Episodes) HasEpisode(season, episode int) bool {
 	return epOk
 }
 
+// IsPack returns true if the episodes are more than a single episode: a season pack, an episode range or
+// a multi-season bundle.
+func (e Episodes) IsPack() bool {
+	if len(e) != 1 {
+		return len(e) > 1
+	}
+	for _, episodes := range e {
+		return len(episodes) != 1
+	}
+	return false
+}
+
 func (e Episodes) AddEpisode(season, episode int) Episodes {
 	_, epMapOk := e[season]
 	if !epMapOk {
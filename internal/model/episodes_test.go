@@ -76,3 +76,62 @@ func TestEpisodesString(t *testing.T) {
 		})
 	}
 }
+
+func TestEpisodesIsPack(t *testing.T) {
+	tests := []struct {
+		name     string
+		episodes Episodes
+		want     bool
+	}{
+		{
+			name:     "empty",
+			episodes: make(Episodes),
+			want:     false,
+		},
+		{
+			name:     "single episode",
+			episodes: make(Episodes).AddEpisode(1, 5),
+			want:     false,
+		},
+		{
+			name:     "episode range",
+			episodes: make(Episodes).AddEpisode(1, 5).AddEpisode(1, 6),
+			want:     true,
+		},
+		{
+			name:     "season pack",
+			episodes: make(Episodes).AddSeason(1),
+			want:     true,
+		},
+		{
+			name:     "multi-season bundle",
+			episodes: make(Episodes).AddSeason(1).AddEpisode(2, 1),
+			want:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.episodes.IsPack(); got != tt.want {
+				t.Errorf("Episodes.IsPack() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEpisodesHasEpisode(t *testing.T) {
+	episodes := make(Episodes).AddSeason(1).AddEpisode(2, 3).AddEpisode(2, 4)
+	for _, tt := range []struct {
+		season  int
+		episode int
+		want    bool
+	}{
+		{season: 1, episode: 5, want: true},
+		{season: 2, episode: 4, want: true},
+		{season: 2, episode: 5, want: false},
+		{season: 3, episode: 1, want: false},
+	} {
+		if got := episodes.HasEpisode(tt.season, tt.episode); got != tt.want {
+			t.Errorf("Episodes.HasEpisode(%d, %d) = %v, want %v", tt.season, tt.episode, got, tt.want)
+		}
+	}
+}
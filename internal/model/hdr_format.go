@@ -0,0 +1,43 @@
+package model
+
+import "sort"
+
+// HdrFormat represents a high dynamic range format of a video
+// ENUM(HDR, HDR10, HDR10Plus, DV, HLG)
+type HdrFormat string
+
+func (v HdrFormat) Label() string {
+	switch v {
+	case HdrFormatHDR10Plus:
+		return "HDR10+"
+	case HdrFormatDV:
+		return "Dolby Vision"
+	default:
+		return v.String()
+	}
+}
+
+// HdrFormats are the HDR formats of a video, such as a Dolby Vision release with an HDR10 fallback layer.
+type HdrFormats []HdrFormat
+
+// Add adds a format if not already present, keeping the formats sorted.
+func (f HdrFormats) Add(format HdrFormat) HdrFormats {
+	for _, existing := range f {
+		if existing == format {
+			return f
+		}
+	}
+	f = append(f, format)
+	sort.Slice(f, func(i, j int) bool {
+		return f[i] < f[j]
+	})
+	return f
+}
+
+func (f HdrFormats) Labels() []string {
+	labels := make([]string, 0, len(f))
+	for _, format := range f {
+		labels = append(labels, format.Label())
+	}
+	return labels
+}
@@ -0,0 +1,217 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version:
+// Revision:
+// Build Date:
+// Built By:
+
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	HdrFormatHDR       HdrFormat = "HDR"
+	HdrFormatHDR10     HdrFormat = "HDR10"
+	HdrFormatHDR10Plus HdrFormat = "HDR10Plus"
+	HdrFormatDV        HdrFormat = "DV"
+	HdrFormatHLG       HdrFormat = "HLG"
+)
+
+var ErrInvalidHdrFormat = fmt.Errorf("not a valid HdrFormat, try [%s]", strings.Join(_HdrFormatNames, ", "))
+
+var _HdrFormatNames = []string{
+	string(HdrFormatHDR),
+	string(HdrFormatHDR10),
+	string(HdrFormatHDR10Plus),
+	string(HdrFormatDV),
+	string(HdrFormatHLG),
+}
+
+// HdrFormatNames returns a list of possible string values of HdrFormat.
+func HdrFormatNames() []string {
+	tmp := make([]string, len(_HdrFormatNames))
+	copy(tmp, _HdrFormatNames)
+	return tmp
+}
+
+// HdrFormatValues returns a list of the values for HdrFormat
+func HdrFormatValues() []HdrFormat {
+	return []HdrFormat{
+		HdrFormatHDR,
+		HdrFormatHDR10,
+		HdrFormatHDR10Plus,
+		HdrFormatDV,
+		HdrFormatHLG,
+	}
+}
+
+// String implements the Stringer interface.
+func (x HdrFormat) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x HdrFormat) IsValid() bool {
+	_, err := ParseHdrFormat(string(x))
+	return err == nil
+}
+
+var _HdrFormatValue = map[string]HdrFormat{
+	"HDR":       HdrFormatHDR,
+	"hdr":       HdrFormatHDR,
+	"HDR10":     HdrFormatHDR10,
+	"hdr10":     HdrFormatHDR10,
+	"HDR10Plus": HdrFormatHDR10Plus,
+	"hdr10plus": HdrFormatHDR10Plus,
+	"DV":        HdrFormatDV,
+	"dv":        HdrFormatDV,
+	"HLG":       HdrFormatHLG,
+	"hlg":       HdrFormatHLG,
+}
+
+// ParseHdrFormat attempts to convert a string to a HdrFormat.
+func ParseHdrFormat(name string) (HdrFormat, error) {
+	if x, ok := _HdrFormatValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _HdrFormatValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return HdrFormat(""), fmt.Errorf("%s is %w", name, ErrInvalidHdrFormat)
+}
+
+// MarshalText implements the text marshaller method.
+func (x HdrFormat) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *HdrFormat) UnmarshalText(text []byte) error {
+	tmp, err := ParseHdrFormat(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+var errHdrFormatNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *HdrFormat) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = HdrFormat("")
+		return
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case string:
+		*x, err = ParseHdrFormat(v)
+	case []byte:
+		*x, err = ParseHdrFormat(string(v))
+	case HdrFormat:
+		*x = v
+	case *HdrFormat:
+		if v == nil {
+			return errHdrFormatNilPtr
+		}
+		*x = *v
+	case *string:
+		if v == nil {
+			return errHdrFormatNilPtr
+		}
+		*x, err = ParseHdrFormat(*v)
+	default:
+		return errors.New("invalid type for HdrFormat")
+	}
+
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x HdrFormat) Value() (driver.Value, error) {
+	return x.String(), nil
+}
+
+type NullHdrFormat struct {
+	HdrFormat HdrFormat
+	Valid     bool
+	Set       bool
+}
+
+func NewNullHdrFormat(val interface{}) (x NullHdrFormat) {
+	err := x.Scan(val) // yes, we ignore this error, it will just be an invalid value.
+	_ = err            // make any errcheck linters happy
+	return
+}
+
+// Scan implements the Scanner interface.
+func (x *NullHdrFormat) Scan(value interface{}) (err error) {
+	if value == nil {
+		x.HdrFormat, x.Valid = HdrFormat(""), false
+		return
+	}
+
+	err = x.HdrFormat.Scan(value)
+	x.Valid = (err == nil)
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x NullHdrFormat) Value() (driver.Value, error) {
+	if !x.Valid {
+		return nil, nil
+	}
+	return x.HdrFormat.String(), nil
+}
+
+// MarshalJSON correctly serializes a NullHdrFormat to JSON.
+func (n NullHdrFormat) MarshalJSON() ([]byte, error) {
+	const nullStr = "null"
+	if n.Valid {
+		return json.Marshal(n.HdrFormat)
+	}
+	return []byte(nullStr), nil
+}
+
+// UnmarshalJSON correctly deserializes a NullHdrFormat from JSON.
+func (n *NullHdrFormat) UnmarshalJSON(b []byte) error {
+	n.Set = true
+	var x interface{}
+	err := json.Unmarshal(b, &x)
+	if err != nil {
+		return err
+	}
+	err = n.Scan(x)
+	return err
+}
+
+// MarshalGQL correctly serializes a NullHdrFormat to GraphQL.
+func (n NullHdrFormat) MarshalGQL(w io.Writer) {
+	bytes, err := json.Marshal(n)
+	if err == nil {
+		_, _ = w.Write(bytes)
+	}
+}
+
+// UnmarshalGQL correctly deserializes a NullHdrFormat from GraphQL.
+func (n *NullHdrFormat) UnmarshalGQL(v any) error {
+	if v == nil {
+		return nil
+	}
+	str, ok := v.(string)
+	if !ok {
+		return errors.New("value is not a string")
+	}
+	return n.UnmarshalJSON([]byte(str))
+}
@@ -0,0 +1,85 @@
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"strings"
+)
+
+// ReleaseToken is a token recognised in a release name that isn't otherwise an attribute of torrent content,
+// such as an HDR format or the streaming service of a web release; it's formatted as kind:value.
+type ReleaseToken struct {
+	Kind  string
+	Value string
+}
+
+func (t ReleaseToken) String() string {
+	return t.Kind + ":" + t.Value
+}
+
+func ParseReleaseToken(str string) (ReleaseToken, error) {
+	kind, value, ok := strings.Cut(str, ":")
+	if !ok || kind == "" || value == "" {
+		return ReleaseToken{}, errors.New("invalid release token, expected kind:value")
+	}
+	return ReleaseToken{Kind: kind, Value: value}, nil
+}
+
+// ReleaseTokens are stored as a JSON array of formatted tokens, so that they can be matched with JSONB operators.
+type ReleaseTokens []ReleaseToken
+
+// Add adds a token if not already present, keeping the tokens sorted.
+func (t ReleaseTokens) Add(token ReleaseToken) ReleaseTokens {
+	i := sort.Search(len(t), func(i int) bool {
+		return t[i].String() >= token.String()
+	})
+	if i < len(t) && t[i] == token {
+		return t
+	}
+	return append(t[:i], append(ReleaseTokens{token}, t[i:]...)...)
+}
+
+// Values returns the values of the tokens of a kind.
+func (t ReleaseTokens) Values(kind string) []string {
+	var values []string
+	for _, token := range t {
+		if token.Kind == kind {
+			values = append(values, token.Value)
+		}
+	}
+	return values
+}
+
+func (t ReleaseTokens) Strings() []string {
+	strs := make([]string, 0, len(t))
+	for _, token := range t {
+		strs = append(strs, token.String())
+	}
+	return strs
+}
+
+func (t ReleaseTokens) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Strings())
+}
+
+func (t *ReleaseTokens) UnmarshalJSON(data []byte) error {
+	var strs []string
+	if err := json.Unmarshal(data, &strs); err != nil {
+		return err
+	}
+	tokens := make(ReleaseTokens, 0, len(strs))
+	for _, str := range strs {
+		token, err := ParseReleaseToken(str)
+		if err != nil {
+			return err
+		}
+		tokens = tokens.Add(token)
+	}
+	if len(tokens) == 0 {
+		*t = nil
+	} else {
+		*t = tokens
+	}
+	return nil
+}
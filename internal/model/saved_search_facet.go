@@ -0,0 +1,9 @@
+package model
+
+// SavedSearchFacet is a facet filter of a saved search, with the values as they would be passed to the facet's filter;
+// a "null" value matches torrents where the value is unknown.
+type SavedSearchFacet struct {
+	Key    string     `json:"key"`
+	Logic  FacetLogic `json:"logic"`
+	Values []string   `json:"values"`
+}
@@ -0,0 +1,5 @@
+package model
+
+// SavedSearchOrderBy represents the ordering of the results of a saved search
+// ENUM(relevance, updated_at, size)
+type SavedSearchOrderBy string
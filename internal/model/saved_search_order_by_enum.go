@@ -0,0 +1,204 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version:
+// Revision:
+// Build Date:
+// Built By:
+
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	SavedSearchOrderByRelevance SavedSearchOrderBy = "relevance"
+	SavedSearchOrderByUpdatedAt SavedSearchOrderBy = "updated_at"
+	SavedSearchOrderBySize      SavedSearchOrderBy = "size"
+)
+
+var ErrInvalidSavedSearchOrderBy = fmt.Errorf("not a valid SavedSearchOrderBy, try [%s]", strings.Join(_SavedSearchOrderByNames, ", "))
+
+var _SavedSearchOrderByNames = []string{
+	string(SavedSearchOrderByRelevance),
+	string(SavedSearchOrderByUpdatedAt),
+	string(SavedSearchOrderBySize),
+}
+
+// SavedSearchOrderByNames returns a list of possible string values of SavedSearchOrderBy.
+func SavedSearchOrderByNames() []string {
+	tmp := make([]string, len(_SavedSearchOrderByNames))
+	copy(tmp, _SavedSearchOrderByNames)
+	return tmp
+}
+
+// SavedSearchOrderByValues returns a list of the values for SavedSearchOrderBy
+func SavedSearchOrderByValues() []SavedSearchOrderBy {
+	return []SavedSearchOrderBy{
+		SavedSearchOrderByRelevance,
+		SavedSearchOrderByUpdatedAt,
+		SavedSearchOrderBySize,
+	}
+}
+
+// String implements the Stringer interface.
+func (x SavedSearchOrderBy) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x SavedSearchOrderBy) IsValid() bool {
+	_, err := ParseSavedSearchOrderBy(string(x))
+	return err == nil
+}
+
+var _SavedSearchOrderByValue = map[string]SavedSearchOrderBy{
+	"relevance":  SavedSearchOrderByRelevance,
+	"updated_at": SavedSearchOrderByUpdatedAt,
+	"size":       SavedSearchOrderBySize,
+}
+
+// ParseSavedSearchOrderBy attempts to convert a string to a SavedSearchOrderBy.
+func ParseSavedSearchOrderBy(name string) (SavedSearchOrderBy, error) {
+	if x, ok := _SavedSearchOrderByValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _SavedSearchOrderByValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return SavedSearchOrderBy(""), fmt.Errorf("%s is %w", name, ErrInvalidSavedSearchOrderBy)
+}
+
+// MarshalText implements the text marshaller method.
+func (x SavedSearchOrderBy) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *SavedSearchOrderBy) UnmarshalText(text []byte) error {
+	tmp, err := ParseSavedSearchOrderBy(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+var errSavedSearchOrderByNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *SavedSearchOrderBy) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = SavedSearchOrderBy("")
+		return
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case string:
+		*x, err = ParseSavedSearchOrderBy(v)
+	case []byte:
+		*x, err = ParseSavedSearchOrderBy(string(v))
+	case SavedSearchOrderBy:
+		*x = v
+	case *SavedSearchOrderBy:
+		if v == nil {
+			return errSavedSearchOrderByNilPtr
+		}
+		*x = *v
+	case *string:
+		if v == nil {
+			return errSavedSearchOrderByNilPtr
+		}
+		*x, err = ParseSavedSearchOrderBy(*v)
+	default:
+		return errors.New("invalid type for SavedSearchOrderBy")
+	}
+
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x SavedSearchOrderBy) Value() (driver.Value, error) {
+	return x.String(), nil
+}
+
+type NullSavedSearchOrderBy struct {
+	SavedSearchOrderBy SavedSearchOrderBy
+	Valid              bool
+	Set                bool
+}
+
+func NewNullSavedSearchOrderBy(val interface{}) (x NullSavedSearchOrderBy) {
+	err := x.Scan(val) // yes, we ignore this error, it will just be an invalid value.
+	_ = err            // make any errcheck linters happy
+	return
+}
+
+// Scan implements the Scanner interface.
+func (x *NullSavedSearchOrderBy) Scan(value interface{}) (err error) {
+	if value == nil {
+		x.SavedSearchOrderBy, x.Valid = SavedSearchOrderBy(""), false
+		return
+	}
+
+	err = x.SavedSearchOrderBy.Scan(value)
+	x.Valid = (err == nil)
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x NullSavedSearchOrderBy) Value() (driver.Value, error) {
+	if !x.Valid {
+		return nil, nil
+	}
+	return x.SavedSearchOrderBy.String(), nil
+}
+
+// MarshalJSON correctly serializes a NullSavedSearchOrderBy to JSON.
+func (n NullSavedSearchOrderBy) MarshalJSON() ([]byte, error) {
+	const nullStr = "null"
+	if n.Valid {
+		return json.Marshal(n.SavedSearchOrderBy)
+	}
+	return []byte(nullStr), nil
+}
+
+// UnmarshalJSON correctly deserializes a NullSavedSearchOrderBy from JSON.
+func (n *NullSavedSearchOrderBy) UnmarshalJSON(b []byte) error {
+	n.Set = true
+	var x interface{}
+	err := json.Unmarshal(b, &x)
+	if err != nil {
+		return err
+	}
+	err = n.Scan(x)
+	return err
+}
+
+// MarshalGQL correctly serializes a NullSavedSearchOrderBy to GraphQL.
+func (n NullSavedSearchOrderBy) MarshalGQL(w io.Writer) {
+	bytes, err := json.Marshal(n)
+	if err == nil {
+		_, _ = w.Write(bytes)
+	}
+}
+
+// UnmarshalGQL correctly deserializes a NullSavedSearchOrderBy from GraphQL.
+func (n *NullSavedSearchOrderBy) UnmarshalGQL(v any) error {
+	if v == nil {
+		return nil
+	}
+	str, ok := v.(string)
+	if !ok {
+		return errors.New("value is not a string")
+	}
+	return n.UnmarshalJSON([]byte(str))
+}
@@ -0,0 +1,24 @@
+package model
+
+import "sort"
+
+// SpamReason represents a heuristic by which a torrent was detected as probable spam or malware
+// ENUM(password_protected_archive, executable_in_video, spam_name, padded_files)
+type SpamReason string
+
+// SpamReasons are the reasons a torrent was detected as spam; torrents with any reason are quarantined unless released.
+type SpamReasons []SpamReason
+
+// Add adds a reason if not already present, keeping the reasons sorted.
+func (r SpamReasons) Add(reason SpamReason) SpamReasons {
+	for _, existing := range r {
+		if existing == reason {
+			return r
+		}
+	}
+	r = append(r, reason)
+	sort.Slice(r, func(i, j int) bool {
+		return r[i] < r[j]
+	})
+	return r
+}
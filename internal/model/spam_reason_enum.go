@@ -0,0 +1,208 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version:
+// Revision:
+// Build Date:
+// Built By:
+
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	SpamReasonPasswordProtectedArchive SpamReason = "password_protected_archive"
+	SpamReasonExecutableInVideo        SpamReason = "executable_in_video"
+	SpamReasonSpamName                 SpamReason = "spam_name"
+	SpamReasonPaddedFiles              SpamReason = "padded_files"
+)
+
+var ErrInvalidSpamReason = fmt.Errorf("not a valid SpamReason, try [%s]", strings.Join(_SpamReasonNames, ", "))
+
+var _SpamReasonNames = []string{
+	string(SpamReasonPasswordProtectedArchive),
+	string(SpamReasonExecutableInVideo),
+	string(SpamReasonSpamName),
+	string(SpamReasonPaddedFiles),
+}
+
+// SpamReasonNames returns a list of possible string values of SpamReason.
+func SpamReasonNames() []string {
+	tmp := make([]string, len(_SpamReasonNames))
+	copy(tmp, _SpamReasonNames)
+	return tmp
+}
+
+// SpamReasonValues returns a list of the values for SpamReason
+func SpamReasonValues() []SpamReason {
+	return []SpamReason{
+		SpamReasonPasswordProtectedArchive,
+		SpamReasonExecutableInVideo,
+		SpamReasonSpamName,
+		SpamReasonPaddedFiles,
+	}
+}
+
+// String implements the Stringer interface.
+func (x SpamReason) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x SpamReason) IsValid() bool {
+	_, err := ParseSpamReason(string(x))
+	return err == nil
+}
+
+var _SpamReasonValue = map[string]SpamReason{
+	"password_protected_archive": SpamReasonPasswordProtectedArchive,
+	"executable_in_video":        SpamReasonExecutableInVideo,
+	"spam_name":                  SpamReasonSpamName,
+	"padded_files":               SpamReasonPaddedFiles,
+}
+
+// ParseSpamReason attempts to convert a string to a SpamReason.
+func ParseSpamReason(name string) (SpamReason, error) {
+	if x, ok := _SpamReasonValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _SpamReasonValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return SpamReason(""), fmt.Errorf("%s is %w", name, ErrInvalidSpamReason)
+}
+
+// MarshalText implements the text marshaller method.
+func (x SpamReason) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *SpamReason) UnmarshalText(text []byte) error {
+	tmp, err := ParseSpamReason(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+var errSpamReasonNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *SpamReason) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = SpamReason("")
+		return
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case string:
+		*x, err = ParseSpamReason(v)
+	case []byte:
+		*x, err = ParseSpamReason(string(v))
+	case SpamReason:
+		*x = v
+	case *SpamReason:
+		if v == nil {
+			return errSpamReasonNilPtr
+		}
+		*x = *v
+	case *string:
+		if v == nil {
+			return errSpamReasonNilPtr
+		}
+		*x, err = ParseSpamReason(*v)
+	default:
+		return errors.New("invalid type for SpamReason")
+	}
+
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x SpamReason) Value() (driver.Value, error) {
+	return x.String(), nil
+}
+
+type NullSpamReason struct {
+	SpamReason SpamReason
+	Valid      bool
+	Set        bool
+}
+
+func NewNullSpamReason(val interface{}) (x NullSpamReason) {
+	err := x.Scan(val) // yes, we ignore this error, it will just be an invalid value.
+	_ = err            // make any errcheck linters happy
+	return
+}
+
+// Scan implements the Scanner interface.
+func (x *NullSpamReason) Scan(value interface{}) (err error) {
+	if value == nil {
+		x.SpamReason, x.Valid = SpamReason(""), false
+		return
+	}
+
+	err = x.SpamReason.Scan(value)
+	x.Valid = (err == nil)
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x NullSpamReason) Value() (driver.Value, error) {
+	if !x.Valid {
+		return nil, nil
+	}
+	return x.SpamReason.String(), nil
+}
+
+// MarshalJSON correctly serializes a NullSpamReason to JSON.
+func (n NullSpamReason) MarshalJSON() ([]byte, error) {
+	const nullStr = "null"
+	if n.Valid {
+		return json.Marshal(n.SpamReason)
+	}
+	return []byte(nullStr), nil
+}
+
+// UnmarshalJSON correctly deserializes a NullSpamReason from JSON.
+func (n *NullSpamReason) UnmarshalJSON(b []byte) error {
+	n.Set = true
+	var x interface{}
+	err := json.Unmarshal(b, &x)
+	if err != nil {
+		return err
+	}
+	err = n.Scan(x)
+	return err
+}
+
+// MarshalGQL correctly serializes a NullSpamReason to GraphQL.
+func (n NullSpamReason) MarshalGQL(w io.Writer) {
+	bytes, err := json.Marshal(n)
+	if err == nil {
+		_, _ = w.Write(bytes)
+	}
+}
+
+// UnmarshalGQL correctly deserializes a NullSpamReason from GraphQL.
+func (n *NullSpamReason) UnmarshalGQL(v any) error {
+	if v == nil {
+		return nil
+	}
+	str, ok := v.(string)
+	if !ok {
+		return errors.New("value is not a string")
+	}
+	return n.UnmarshalJSON([]byte(str))
+}
@@ -0,0 +1,14 @@
+package model
+
+// SportEvent is the sports event of a sports release, such as a race, match or fight; its date is the air date of the
+// torrent content.
+type SportEvent struct {
+	// League is the league, series or promotion, such as Formula 1, NFL or UFC
+	League string `json:"league"`
+	// Event is the name of the event, such as Monaco Grand Prix Race or UFC 300
+	Event string `json:"event,omitempty"`
+	// Round is the round, week or matchday of the season, such as Round 8 or Week 1
+	Round string `json:"round,omitempty"`
+	// Teams are the teams or competitors, such as the teams of a match or the fighters of a bout
+	Teams []string `json:"teams,omitempty"`
+}
@@ -0,0 +1,108 @@
+package model
+
+import (
+	"regexp"
+	"strings"
+)
+
+var subtitleTokenRegex = regexp.MustCompile(`[\p{L}\p{N}]+`)
+
+// subtitleWords are words marking subtitles, which may be suffixed to a language (EngSub) or prefixed to one (SubITA)
+var subtitleWords = []string{"subtitles", "subtitled", "subtitle", "subbed", "subs", "sub"}
+
+// subtitleMarkers are subtitle words with a prefix that doesn't name a language
+var subtitleMarkers = map[string]struct{}{
+	"m":     {},
+	"multi": {},
+	"hard":  {},
+	"soft":  {},
+}
+
+func isSubtitleWord(token string) bool {
+	for _, w := range subtitleWords {
+		if token == w {
+			return true
+		}
+	}
+	return false
+}
+
+// InferSubtitles finds subtitle tags in a torrent name, such as VOSTFR, ENG SUBS, SubITA, ESub, MSubs or HardSub.
+// It returns the subtitle languages named, whether any subtitle tag was found, and the input with the subtitle tags
+// blanked out, from which audio languages can then be inferred without mistaking subtitle languages for them.
+func InferSubtitles(input string) (Languages, bool, string) {
+	languages := make(Languages)
+	subtitled := false
+	matches := subtitleTokenRegex.FindAllStringIndex(input, -1)
+	tokens := make([]string, len(matches))
+	for i, m := range matches {
+		tokens[i] = strings.ToLower(input[m[0]:m[1]])
+	}
+	tagged := make([]bool, len(tokens))
+	addLanguage := func(i int, name string) bool {
+		if lang := ParseLanguage(name); lang.Valid {
+			languages[lang.Language] = struct{}{}
+			tagged[i] = true
+			return true
+		}
+		return false
+	}
+	for i, token := range tokens {
+		switch {
+		case strings.HasPrefix(token, "vost"):
+			// version originale sous-titrée, with an optional subtitle language such as VOSTFR
+			if token == "vost" || addLanguage(i, token[4:]) {
+				subtitled = true
+				tagged[i] = true
+			}
+		case isSubtitleWord(token):
+			subtitled = true
+			tagged[i] = true
+			if i > 0 && !tagged[i-1] {
+				if _, ok := subtitleMarkers[tokens[i-1]]; ok {
+					tagged[i-1] = true
+				} else {
+					addLanguage(i-1, tokens[i-1])
+				}
+			}
+			if i+1 < len(tokens) {
+				addLanguage(i+1, tokens[i+1])
+			}
+		default:
+			for _, w := range subtitleWords {
+				if prefix, ok := strings.CutSuffix(token, w); ok && prefix != "" {
+					// ESub is short for English subtitles
+					if prefix == "e" {
+						prefix = "en"
+					}
+					if _, ok := subtitleMarkers[prefix]; ok || addLanguage(i, prefix) {
+						subtitled = true
+						tagged[i] = true
+					}
+					break
+				}
+				if suffix, ok := strings.CutPrefix(token, w); ok && suffix != "" {
+					if addLanguage(i, suffix) {
+						subtitled = true
+					}
+					break
+				}
+			}
+		}
+	}
+	if len(languages) == 0 {
+		languages = nil
+	}
+	if !subtitled {
+		return nil, false, input
+	}
+	rest := []byte(input)
+	for i, m := range matches {
+		if tagged[i] {
+			for j := m[0]; j < m[1]; j++ {
+				rest[j] = ' '
+			}
+		}
+	}
+	return languages, subtitled, string(rest)
+}
@@ -0,0 +1,37 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestInferSubtitles(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		input     string
+		languages []Language
+		subtitled bool
+		rest      string
+	}{
+		{input: "2019 1080p WEB-DL x264", rest: "2019 1080p WEB-DL x264"},
+		{input: "2019 VOSTFR 1080p", languages: []Language{"fr"}, subtitled: true, rest: "2019        1080p"},
+		{input: "2019 FRENCH ENG SUBS 720p", languages: []Language{"en"}, subtitled: true, rest: "2019 FRENCH          720p"},
+		{input: "S01E01 SubITA 720p", languages: []Language{"it"}, subtitled: true, rest: "S01E01        720p"},
+		{input: "2020 1080p ESub", languages: []Language{"en"}, subtitled: true, rest: "2020 1080p     "},
+		{input: "2020 MULTi SUBS 1080p", subtitled: true, rest: "2020            1080p"},
+		{input: "2020 HardSub 720p", subtitled: true, rest: "2020         720p"},
+		{input: "2020 Substance 720p", rest: "2020 Substance 720p"},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			languages, subtitled, rest := InferSubtitles(tc.input)
+			if tc.languages == nil {
+				assert.Nil(t, languages)
+			} else {
+				assert.ElementsMatch(t, tc.languages, languages.Slice())
+			}
+			assert.Equal(t, tc.subtitled, subtitled)
+			assert.Equal(t, tc.rest, rest)
+		})
+	}
+}
@@ -0,0 +1,5 @@
+package model
+
+// TakedownAction represents an entry in the takedown log
+// ENUM(submitted, enforced, withdrawn)
+type TakedownAction string
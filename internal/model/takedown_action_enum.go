@@ -0,0 +1,204 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version:
+// Revision:
+// Build Date:
+// Built By:
+
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	TakedownActionSubmitted TakedownAction = "submitted"
+	TakedownActionEnforced  TakedownAction = "enforced"
+	TakedownActionWithdrawn TakedownAction = "withdrawn"
+)
+
+var ErrInvalidTakedownAction = fmt.Errorf("not a valid TakedownAction, try [%s]", strings.Join(_TakedownActionNames, ", "))
+
+var _TakedownActionNames = []string{
+	string(TakedownActionSubmitted),
+	string(TakedownActionEnforced),
+	string(TakedownActionWithdrawn),
+}
+
+// TakedownActionNames returns a list of possible string values of TakedownAction.
+func TakedownActionNames() []string {
+	tmp := make([]string, len(_TakedownActionNames))
+	copy(tmp, _TakedownActionNames)
+	return tmp
+}
+
+// TakedownActionValues returns a list of the values for TakedownAction
+func TakedownActionValues() []TakedownAction {
+	return []TakedownAction{
+		TakedownActionSubmitted,
+		TakedownActionEnforced,
+		TakedownActionWithdrawn,
+	}
+}
+
+// String implements the Stringer interface.
+func (x TakedownAction) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x TakedownAction) IsValid() bool {
+	_, err := ParseTakedownAction(string(x))
+	return err == nil
+}
+
+var _TakedownActionValue = map[string]TakedownAction{
+	"submitted": TakedownActionSubmitted,
+	"enforced":  TakedownActionEnforced,
+	"withdrawn": TakedownActionWithdrawn,
+}
+
+// ParseTakedownAction attempts to convert a string to a TakedownAction.
+func ParseTakedownAction(name string) (TakedownAction, error) {
+	if x, ok := _TakedownActionValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _TakedownActionValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return TakedownAction(""), fmt.Errorf("%s is %w", name, ErrInvalidTakedownAction)
+}
+
+// MarshalText implements the text marshaller method.
+func (x TakedownAction) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *TakedownAction) UnmarshalText(text []byte) error {
+	tmp, err := ParseTakedownAction(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+var errTakedownActionNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *TakedownAction) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = TakedownAction("")
+		return
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case string:
+		*x, err = ParseTakedownAction(v)
+	case []byte:
+		*x, err = ParseTakedownAction(string(v))
+	case TakedownAction:
+		*x = v
+	case *TakedownAction:
+		if v == nil {
+			return errTakedownActionNilPtr
+		}
+		*x = *v
+	case *string:
+		if v == nil {
+			return errTakedownActionNilPtr
+		}
+		*x, err = ParseTakedownAction(*v)
+	default:
+		return errors.New("invalid type for TakedownAction")
+	}
+
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x TakedownAction) Value() (driver.Value, error) {
+	return x.String(), nil
+}
+
+type NullTakedownAction struct {
+	TakedownAction TakedownAction
+	Valid          bool
+	Set            bool
+}
+
+func NewNullTakedownAction(val interface{}) (x NullTakedownAction) {
+	err := x.Scan(val) // yes, we ignore this error, it will just be an invalid value.
+	_ = err            // make any errcheck linters happy
+	return
+}
+
+// Scan implements the Scanner interface.
+func (x *NullTakedownAction) Scan(value interface{}) (err error) {
+	if value == nil {
+		x.TakedownAction, x.Valid = TakedownAction(""), false
+		return
+	}
+
+	err = x.TakedownAction.Scan(value)
+	x.Valid = (err == nil)
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x NullTakedownAction) Value() (driver.Value, error) {
+	if !x.Valid {
+		return nil, nil
+	}
+	return x.TakedownAction.String(), nil
+}
+
+// MarshalJSON correctly serializes a NullTakedownAction to JSON.
+func (n NullTakedownAction) MarshalJSON() ([]byte, error) {
+	const nullStr = "null"
+	if n.Valid {
+		return json.Marshal(n.TakedownAction)
+	}
+	return []byte(nullStr), nil
+}
+
+// UnmarshalJSON correctly deserializes a NullTakedownAction from JSON.
+func (n *NullTakedownAction) UnmarshalJSON(b []byte) error {
+	n.Set = true
+	var x interface{}
+	err := json.Unmarshal(b, &x)
+	if err != nil {
+		return err
+	}
+	err = n.Scan(x)
+	return err
+}
+
+// MarshalGQL correctly serializes a NullTakedownAction to GraphQL.
+func (n NullTakedownAction) MarshalGQL(w io.Writer) {
+	bytes, err := json.Marshal(n)
+	if err == nil {
+		_, _ = w.Write(bytes)
+	}
+}
+
+// UnmarshalGQL correctly deserializes a NullTakedownAction from GraphQL.
+func (n *NullTakedownAction) UnmarshalGQL(v any) error {
+	if v == nil {
+		return nil
+	}
+	str, ok := v.(string)
+	if !ok {
+		return errors.New("value is not a string")
+	}
+	return n.UnmarshalJSON([]byte(str))
+}
@@ -0,0 +1,5 @@
+package model
+
+// TaskRunStatus represents the outcome of a background task run
+// ENUM(running, succeeded, failed)
+type TaskRunStatus string
@@ -0,0 +1,204 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version:
+// Revision:
+// Build Date:
+// Built By:
+
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	TaskRunStatusRunning   TaskRunStatus = "running"
+	TaskRunStatusSucceeded TaskRunStatus = "succeeded"
+	TaskRunStatusFailed    TaskRunStatus = "failed"
+)
+
+var ErrInvalidTaskRunStatus = fmt.Errorf("not a valid TaskRunStatus, try [%s]", strings.Join(_TaskRunStatusNames, ", "))
+
+var _TaskRunStatusNames = []string{
+	string(TaskRunStatusRunning),
+	string(TaskRunStatusSucceeded),
+	string(TaskRunStatusFailed),
+}
+
+// TaskRunStatusNames returns a list of possible string values of TaskRunStatus.
+func TaskRunStatusNames() []string {
+	tmp := make([]string, len(_TaskRunStatusNames))
+	copy(tmp, _TaskRunStatusNames)
+	return tmp
+}
+
+// TaskRunStatusValues returns a list of the values for TaskRunStatus
+func TaskRunStatusValues() []TaskRunStatus {
+	return []TaskRunStatus{
+		TaskRunStatusRunning,
+		TaskRunStatusSucceeded,
+		TaskRunStatusFailed,
+	}
+}
+
+// String implements the Stringer interface.
+func (x TaskRunStatus) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x TaskRunStatus) IsValid() bool {
+	_, err := ParseTaskRunStatus(string(x))
+	return err == nil
+}
+
+var _TaskRunStatusValue = map[string]TaskRunStatus{
+	"running":   TaskRunStatusRunning,
+	"succeeded": TaskRunStatusSucceeded,
+	"failed":    TaskRunStatusFailed,
+}
+
+// ParseTaskRunStatus attempts to convert a string to a TaskRunStatus.
+func ParseTaskRunStatus(name string) (TaskRunStatus, error) {
+	if x, ok := _TaskRunStatusValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _TaskRunStatusValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return TaskRunStatus(""), fmt.Errorf("%s is %w", name, ErrInvalidTaskRunStatus)
+}
+
+// MarshalText implements the text marshaller method.
+func (x TaskRunStatus) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *TaskRunStatus) UnmarshalText(text []byte) error {
+	tmp, err := ParseTaskRunStatus(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+var errTaskRunStatusNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *TaskRunStatus) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = TaskRunStatus("")
+		return
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case string:
+		*x, err = ParseTaskRunStatus(v)
+	case []byte:
+		*x, err = ParseTaskRunStatus(string(v))
+	case TaskRunStatus:
+		*x = v
+	case *TaskRunStatus:
+		if v == nil {
+			return errTaskRunStatusNilPtr
+		}
+		*x = *v
+	case *string:
+		if v == nil {
+			return errTaskRunStatusNilPtr
+		}
+		*x, err = ParseTaskRunStatus(*v)
+	default:
+		return errors.New("invalid type for TaskRunStatus")
+	}
+
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x TaskRunStatus) Value() (driver.Value, error) {
+	return x.String(), nil
+}
+
+type NullTaskRunStatus struct {
+	TaskRunStatus TaskRunStatus
+	Valid         bool
+	Set           bool
+}
+
+func NewNullTaskRunStatus(val interface{}) (x NullTaskRunStatus) {
+	err := x.Scan(val) // yes, we ignore this error, it will just be an invalid value.
+	_ = err            // make any errcheck linters happy
+	return
+}
+
+// Scan implements the Scanner interface.
+func (x *NullTaskRunStatus) Scan(value interface{}) (err error) {
+	if value == nil {
+		x.TaskRunStatus, x.Valid = TaskRunStatus(""), false
+		return
+	}
+
+	err = x.TaskRunStatus.Scan(value)
+	x.Valid = (err == nil)
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x NullTaskRunStatus) Value() (driver.Value, error) {
+	if !x.Valid {
+		return nil, nil
+	}
+	return x.TaskRunStatus.String(), nil
+}
+
+// MarshalJSON correctly serializes a NullTaskRunStatus to JSON.
+func (n NullTaskRunStatus) MarshalJSON() ([]byte, error) {
+	const nullStr = "null"
+	if n.Valid {
+		return json.Marshal(n.TaskRunStatus)
+	}
+	return []byte(nullStr), nil
+}
+
+// UnmarshalJSON correctly deserializes a NullTaskRunStatus from JSON.
+func (n *NullTaskRunStatus) UnmarshalJSON(b []byte) error {
+	n.Set = true
+	var x interface{}
+	err := json.Unmarshal(b, &x)
+	if err != nil {
+		return err
+	}
+	err = n.Scan(x)
+	return err
+}
+
+// MarshalGQL correctly serializes a NullTaskRunStatus to GraphQL.
+func (n NullTaskRunStatus) MarshalGQL(w io.Writer) {
+	bytes, err := json.Marshal(n)
+	if err == nil {
+		_, _ = w.Write(bytes)
+	}
+}
+
+// UnmarshalGQL correctly deserializes a NullTaskRunStatus from GraphQL.
+func (n *NullTaskRunStatus) UnmarshalGQL(v any) error {
+	if v == nil {
+		return nil
+	}
+	str, ok := v.(string)
+	if !ok {
+		return errors.New("value is not a string")
+	}
+	return n.UnmarshalJSON([]byte(str))
+}
@@ -15,24 +15,39 @@ const TableNameTorrentContent = "torrent_contents"
 
 // TorrentContent mapped from table <torrent_contents>
 type TorrentContent struct {
-	ID              string              `gorm:"column:id;primaryKey;<-:false" json:"id"`
-	InfoHash        protocol.ID         `gorm:"column:info_hash;not null;<-:create" json:"infoHash"`
-	ContentType     NullContentType     `gorm:"column:content_type" json:"contentType"`
-	ContentSource   NullString          `gorm:"column:content_source" json:"contentSource"`
-	ContentID       NullString          `gorm:"column:content_id" json:"contentId"`
-	Languages       Languages           `gorm:"column:languages;serializer:json" json:"languages"`
-	Episodes        Episodes            `gorm:"column:episodes;serializer:json" json:"episodes"`
-	VideoResolution NullVideoResolution `gorm:"column:video_resolution" json:"videoResolution"`
-	VideoSource     NullVideoSource     `gorm:"column:video_source" json:"videoSource"`
-	VideoCodec      NullVideoCodec      `gorm:"column:video_codec" json:"videoCodec"`
-	Video3d         NullVideo3d         `gorm:"column:video_3d" json:"video3D"`
-	VideoModifier   NullVideoModifier   `gorm:"column:video_modifier" json:"videoModifier"`
-	ReleaseGroup    NullString          `gorm:"column:release_group" json:"releaseGroup"`
-	CreatedAt       time.Time           `gorm:"column:created_at;not null;<-:create" json:"createdAt"`
-	UpdatedAt       time.Time           `gorm:"column:updated_at;not null" json:"updatedAt"`
-	Tsv             fts.Tsvector        `gorm:"column:tsv" json:"tsv"`
-	Torrent         Torrent             `gorm:"foreignKey:InfoHash;references:InfoHash" json:"torrent"`
-	Content         Content             `gorm:"foreignKey:ContentType,ContentSource,ContentID;references:Type,Source,ID" json:"content"`
+	ID                 string              `gorm:"column:id;primaryKey;<-:false" json:"id"`
+	InfoHash           protocol.ID         `gorm:"column:info_hash;not null;<-:create" json:"infoHash"`
+	ContentType        NullContentType     `gorm:"column:content_type" json:"contentType"`
+	ContentSource      NullString          `gorm:"column:content_source" json:"contentSource"`
+	ContentID          NullString          `gorm:"column:content_id" json:"contentId"`
+	Languages          Languages           `gorm:"column:languages;serializer:json" json:"languages"`
+	Episodes           Episodes            `gorm:"column:episodes;serializer:json" json:"episodes"`
+	VideoResolution    NullVideoResolution `gorm:"column:video_resolution" json:"videoResolution"`
+	VideoSource        NullVideoSource     `gorm:"column:video_source" json:"videoSource"`
+	VideoCodec         NullVideoCodec      `gorm:"column:video_codec" json:"videoCodec"`
+	Video3d            NullVideo3d         `gorm:"column:video_3d" json:"video3D"`
+	VideoModifier      NullVideoModifier   `gorm:"column:video_modifier" json:"videoModifier"`
+	ReleaseGroup       NullString          `gorm:"column:release_group" json:"releaseGroup"`
+	CreatedAt          time.Time           `gorm:"column:created_at;not null;<-:create" json:"createdAt"`
+	UpdatedAt          time.Time           `gorm:"column:updated_at;not null" json:"updatedAt"`
+	Tsv                fts.Tsvector        `gorm:"column:tsv" json:"tsv"`
+	ClassifierVersion  uint                `gorm:"column:classifier_version;not null" json:"classifierVersion"`
+	SubtitleLanguages  Languages           `gorm:"column:subtitle_languages;serializer:json" json:"subtitleLanguages"`
+	Subtitled          bool                `gorm:"column:subtitled;not null" json:"subtitled"`
+	MultiAudio         bool                `gorm:"column:multi_audio;not null" json:"multiAudio"`
+	MatchConfidence    NullFloat32         `gorm:"column:match_confidence" json:"matchConfidence"`
+	ReleaseTokens      ReleaseTokens       `gorm:"column:release_tokens;serializer:json" json:"releaseTokens"`
+	HdrFormats         HdrFormats          `gorm:"column:hdr_formats;serializer:json" json:"hdrFormats"`
+	AudioFormats       AudioFormats        `gorm:"column:audio_formats;serializer:json" json:"audioFormats"`
+	AirDate            Date                `gorm:"column:air_date" json:"airDate"`
+	SportEvent         *SportEvent         `gorm:"column:sport_event;serializer:json" json:"sportEvent"`
+	FileContents       TorrentFileContents `gorm:"column:file_contents;serializer:json" json:"fileContents"`
+	ProbablyFake       bool                `gorm:"column:probably_fake;not null" json:"probablyFake"`
+	SpamReasons        SpamReasons         `gorm:"column:spam_reasons;serializer:json" json:"spamReasons"`
+	Quarantined        bool                `gorm:"column:quarantined;not null" json:"quarantined"`
+	QuarantineOverride NullBool            `gorm:"column:quarantine_override" json:"quarantineOverride"`
+	Torrent            Torrent             `gorm:"foreignKey:InfoHash;references:InfoHash" json:"torrent"`
+	Content            Content             `gorm:"foreignKey:ContentType,ContentSource,ContentID;references:Type,Source,ID" json:"content"`
 }
 
 // TableName TorrentContent's table name
@@ -3,6 +3,7 @@ package model
 import (
 	"fmt"
 	"github.com/bitmagnet-io/bitmagnet/internal/database/fts"
+	"github.com/bitmagnet-io/bitmagnet/internal/romanize"
 	"strings"
 )
 
@@ -21,6 +22,9 @@ func (tc TorrentContent) Title() string {
 	if len(tc.Episodes) > 0 {
 		titleParts = append(titleParts, tc.Episodes.String())
 	}
+	if !tc.AirDate.IsNil() {
+		titleParts = append(titleParts, tc.AirDate.IsoDateString())
+	}
 	return strings.Join(titleParts, " ")
 }
 
@@ -35,6 +39,15 @@ func (tc TorrentContent) ContentRef() Maybe[ContentRef] {
 	return Maybe[ContentRef]{}
 }
 
+// UpdateQuarantined quarantines the torrent content if spam was detected, unless a review has overridden the quarantine.
+func (tc *TorrentContent) UpdateQuarantined() {
+	if tc.QuarantineOverride.Valid {
+		tc.Quarantined = tc.QuarantineOverride.Bool
+	} else {
+		tc.Quarantined = len(tc.SpamReasons) > 0
+	}
+}
+
 func (tc *TorrentContent) UpdateTsv() {
 	var tsv fts.Tsvector
 	if !tc.ContentID.Valid {
@@ -57,11 +70,33 @@ func (tc *TorrentContent) UpdateTsv() {
 	if tc.VideoModifier.Valid {
 		tsv.AddText(tc.VideoModifier.VideoModifier.String(), fts.TsvectorWeightC)
 	}
+	// formats are searchable by both their names and labels, such as DV and Dolby Vision
+	for _, format := range tc.HdrFormats {
+		tsv.AddText(format.String()+" "+format.Label(), fts.TsvectorWeightC)
+	}
+	for _, format := range tc.AudioFormats {
+		tsv.AddText(format.String()+" "+format.Label(), fts.TsvectorWeightC)
+	}
+	// sports events aren't matched to content, so their attributes are searchable in place of its title
+	if tc.SportEvent != nil {
+		tsv.AddText(tc.SportEvent.League, fts.TsvectorWeightA)
+		tsv.AddText(tc.SportEvent.Event, fts.TsvectorWeightA)
+		tsv.AddText(tc.SportEvent.Round, fts.TsvectorWeightB)
+		for _, team := range tc.SportEvent.Teams {
+			tsv.AddText(team, fts.TsvectorWeightA)
+		}
+	}
 	if tc.ReleaseGroup.Valid {
 		tsv.AddText(tc.ReleaseGroup.String, fts.TsvectorWeightC)
 	}
+	for _, token := range tc.ReleaseTokens {
+		tsv.AddText(token.Value, fts.TsvectorWeightC)
+	}
 	tsv.AddText(tc.InfoHash.String(), fts.TsvectorWeightA)
 	tsv.AddText(tc.Torrent.Name, fts.TsvectorWeightA)
+	if romanize.HasNonLatinLetters(tc.Torrent.Name) {
+		tsv.AddText(romanize.Romanize(tc.Torrent.Name), fts.TsvectorWeightA)
+	}
 	for _, str := range tc.Torrent.fileSearchStrings() {
 		tsv.AddText(str, fts.TsvectorWeightD)
 	}
@@ -0,0 +1,5 @@
+package model
+
+// TorrentEventType represents the kind of change to a torrent that's published as an event
+// ENUM(discovered, classified, deleted)
+type TorrentEventType string
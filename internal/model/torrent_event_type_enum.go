@@ -0,0 +1,204 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version:
+// Revision:
+// Build Date:
+// Built By:
+
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	TorrentEventTypeDiscovered TorrentEventType = "discovered"
+	TorrentEventTypeClassified TorrentEventType = "classified"
+	TorrentEventTypeDeleted    TorrentEventType = "deleted"
+)
+
+var ErrInvalidTorrentEventType = fmt.Errorf("not a valid TorrentEventType, try [%s]", strings.Join(_TorrentEventTypeNames, ", "))
+
+var _TorrentEventTypeNames = []string{
+	string(TorrentEventTypeDiscovered),
+	string(TorrentEventTypeClassified),
+	string(TorrentEventTypeDeleted),
+}
+
+// TorrentEventTypeNames returns a list of possible string values of TorrentEventType.
+func TorrentEventTypeNames() []string {
+	tmp := make([]string, len(_TorrentEventTypeNames))
+	copy(tmp, _TorrentEventTypeNames)
+	return tmp
+}
+
+// TorrentEventTypeValues returns a list of the values for TorrentEventType
+func TorrentEventTypeValues() []TorrentEventType {
+	return []TorrentEventType{
+		TorrentEventTypeDiscovered,
+		TorrentEventTypeClassified,
+		TorrentEventTypeDeleted,
+	}
+}
+
+// String implements the Stringer interface.
+func (x TorrentEventType) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x TorrentEventType) IsValid() bool {
+	_, err := ParseTorrentEventType(string(x))
+	return err == nil
+}
+
+var _TorrentEventTypeValue = map[string]TorrentEventType{
+	"discovered": TorrentEventTypeDiscovered,
+	"classified": TorrentEventTypeClassified,
+	"deleted":    TorrentEventTypeDeleted,
+}
+
+// ParseTorrentEventType attempts to convert a string to a TorrentEventType.
+func ParseTorrentEventType(name string) (TorrentEventType, error) {
+	if x, ok := _TorrentEventTypeValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _TorrentEventTypeValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return TorrentEventType(""), fmt.Errorf("%s is %w", name, ErrInvalidTorrentEventType)
+}
+
+// MarshalText implements the text marshaller method.
+func (x TorrentEventType) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *TorrentEventType) UnmarshalText(text []byte) error {
+	tmp, err := ParseTorrentEventType(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+var errTorrentEventTypeNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *TorrentEventType) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = TorrentEventType("")
+		return
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case string:
+		*x, err = ParseTorrentEventType(v)
+	case []byte:
+		*x, err = ParseTorrentEventType(string(v))
+	case TorrentEventType:
+		*x = v
+	case *TorrentEventType:
+		if v == nil {
+			return errTorrentEventTypeNilPtr
+		}
+		*x = *v
+	case *string:
+		if v == nil {
+			return errTorrentEventTypeNilPtr
+		}
+		*x, err = ParseTorrentEventType(*v)
+	default:
+		return errors.New("invalid type for TorrentEventType")
+	}
+
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x TorrentEventType) Value() (driver.Value, error) {
+	return x.String(), nil
+}
+
+type NullTorrentEventType struct {
+	TorrentEventType TorrentEventType
+	Valid            bool
+	Set              bool
+}
+
+func NewNullTorrentEventType(val interface{}) (x NullTorrentEventType) {
+	err := x.Scan(val) // yes, we ignore this error, it will just be an invalid value.
+	_ = err            // make any errcheck linters happy
+	return
+}
+
+// Scan implements the Scanner interface.
+func (x *NullTorrentEventType) Scan(value interface{}) (err error) {
+	if value == nil {
+		x.TorrentEventType, x.Valid = TorrentEventType(""), false
+		return
+	}
+
+	err = x.TorrentEventType.Scan(value)
+	x.Valid = (err == nil)
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x NullTorrentEventType) Value() (driver.Value, error) {
+	if !x.Valid {
+		return nil, nil
+	}
+	return x.TorrentEventType.String(), nil
+}
+
+// MarshalJSON correctly serializes a NullTorrentEventType to JSON.
+func (n NullTorrentEventType) MarshalJSON() ([]byte, error) {
+	const nullStr = "null"
+	if n.Valid {
+		return json.Marshal(n.TorrentEventType)
+	}
+	return []byte(nullStr), nil
+}
+
+// UnmarshalJSON correctly deserializes a NullTorrentEventType from JSON.
+func (n *NullTorrentEventType) UnmarshalJSON(b []byte) error {
+	n.Set = true
+	var x interface{}
+	err := json.Unmarshal(b, &x)
+	if err != nil {
+		return err
+	}
+	err = n.Scan(x)
+	return err
+}
+
+// MarshalGQL correctly serializes a NullTorrentEventType to GraphQL.
+func (n NullTorrentEventType) MarshalGQL(w io.Writer) {
+	bytes, err := json.Marshal(n)
+	if err == nil {
+		_, _ = w.Write(bytes)
+	}
+}
+
+// UnmarshalGQL correctly deserializes a NullTorrentEventType from GraphQL.
+func (n *NullTorrentEventType) UnmarshalGQL(v any) error {
+	if v == nil {
+		return nil
+	}
+	str, ok := v.(string)
+	if !ok {
+		return errors.New("value is not a string")
+	}
+	return n.UnmarshalJSON([]byte(str))
+}
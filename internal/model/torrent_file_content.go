@@ -0,0 +1,26 @@
+package model
+
+// TorrentFileContent is the content matched to a file of a torrent that contains several contents, such as a pack of movies,
+// in which the content of the torrent itself is at best one of them.
+type TorrentFileContent struct {
+	FileIndex     uint32      `json:"fileIndex"`
+	Path          string      `json:"path"`
+	ContentType   ContentType `json:"contentType"`
+	ContentSource string      `json:"contentSource"`
+	ContentID     string      `json:"contentId"`
+	// MatchConfidence is between 0 and 1, as for the match of the torrent content
+	MatchConfidence float64 `json:"matchConfidence"`
+	// Content is hydrated by searches, and isn't stored with the torrent content
+	Content *Content `json:"-"`
+}
+
+func (c TorrentFileContent) ContentRef() ContentRef {
+	return ContentRef{
+		Type:   c.ContentType,
+		Source: c.ContentSource,
+		ID:     c.ContentID,
+	}
+}
+
+// TorrentFileContents are stored as a JSON array ordered by file index.
+type TorrentFileContents []TorrentFileContent
@@ -0,0 +1,115 @@
+package model
+
+import (
+	"sort"
+	"strings"
+)
+
+// TorrentFileTreeNode is a file or directory of the file tree of a torrent. The tree is built from the paths of the
+// torrent's files, so that clients can render the directory structure and pick files by their index.
+type TorrentFileTreeNode struct {
+	// Name is the last element of the path
+	Name string
+	// Path is the path within the torrent, which for a file is the path of its TorrentFile
+	Path      string
+	Directory bool
+	// Size is the size of a file, or the total size of the files in a directory
+	Size uint64
+	// Index is the index of a file in the torrent, and is invalid for a directory
+	Index     NullUint
+	Extension NullString
+	FileType  NullFileType
+	// FilesCount is 1 for a file, or the number of files in a directory and its subdirectories
+	FilesCount uint
+	// Children are the directories followed by the files of a directory, each ordered by name
+	Children []TorrentFileTreeNode
+}
+
+// FileTree returns the top level files and directories of the torrent, or nil if its files aren't known. A single file
+// torrent has a single file, named as the torrent.
+func (t Torrent) FileTree() []TorrentFileTreeNode {
+	switch t.FilesStatus {
+	case FilesStatusSingle:
+		return []TorrentFileTreeNode{
+			{
+				Name:       t.Name,
+				Path:       t.Name,
+				Size:       t.Size,
+				Index:      NewNullUint(0),
+				Extension:  t.Extension,
+				FileType:   t.FileType(),
+				FilesCount: 1,
+			},
+		}
+	case FilesStatusMulti:
+		root := &fileTreeDir{}
+		dirs := map[string]*fileTreeDir{"": root}
+		var getDir func(path string) *fileTreeDir
+		getDir = func(path string) *fileTreeDir {
+			if dir, ok := dirs[path]; ok {
+				return dir
+			}
+			parentPath, name := splitFileTreePath(path)
+			dir := &fileTreeDir{name: name, path: path}
+			parent := getDir(parentPath)
+			parent.dirs = append(parent.dirs, dir)
+			dirs[path] = dir
+			return dir
+		}
+		for _, f := range t.Files {
+			parentPath, name := splitFileTreePath(f.Path)
+			dir := getDir(parentPath)
+			dir.files = append(dir.files, TorrentFileTreeNode{
+				Name:       name,
+				Path:       f.Path,
+				Size:       f.Size,
+				Index:      NewNullUint(uint(f.Index)),
+				Extension:  fileExtensionFromPath(f.Path),
+				FileType:   f.FileType(),
+				FilesCount: 1,
+			})
+		}
+		return root.node().Children
+	}
+	return nil
+}
+
+func splitFileTreePath(path string) (string, string) {
+	path = strings.Trim(path, "/")
+	if i := strings.LastIndex(path, "/"); i >= 0 {
+		return path[:i], path[i+1:]
+	}
+	return "", path
+}
+
+type fileTreeDir struct {
+	name  string
+	path  string
+	dirs  []*fileTreeDir
+	files []TorrentFileTreeNode
+}
+
+// node returns the node of a directory, summing the sizes and counts of its files and ordering its children by name.
+func (d *fileTreeDir) node() TorrentFileTreeNode {
+	n := TorrentFileTreeNode{
+		Name:      d.name,
+		Path:      d.path,
+		Directory: true,
+		Children:  make([]TorrentFileTreeNode, 0, len(d.dirs)+len(d.files)),
+	}
+	sort.Slice(d.dirs, func(i, j int) bool {
+		return d.dirs[i].name < d.dirs[j].name
+	})
+	sort.SliceStable(d.files, func(i, j int) bool {
+		return d.files[i].Name < d.files[j].Name
+	})
+	for _, dir := range d.dirs {
+		n.Children = append(n.Children, dir.node())
+	}
+	n.Children = append(n.Children, d.files...)
+	for _, child := range n.Children {
+		n.Size += child.Size
+		n.FilesCount += child.FilesCount
+	}
+	return n
+}
@@ -0,0 +1,80 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestTorrentFileTree(t *testing.T) {
+	t.Parallel()
+
+	torrent := Torrent{
+		Name:        "Show.S01",
+		FilesStatus: FilesStatusMulti,
+		Files: []TorrentFile{
+			{Index: 0, Path: "Show.S01E02.mkv", Size: 200},
+			{Index: 1, Path: "Subs/English.srt", Size: 2},
+			{Index: 2, Path: "Show.S01E01.mkv", Size: 100},
+			{Index: 3, Path: "Subs/Extra/French.srt", Size: 3},
+		},
+	}
+
+	assert.Equal(t, []TorrentFileTreeNode{
+		{
+			Name:       "Subs",
+			Path:       "Subs",
+			Directory:  true,
+			Size:       5,
+			FilesCount: 2,
+			Children: []TorrentFileTreeNode{
+				{
+					Name:       "Extra",
+					Path:       "Subs/Extra",
+					Directory:  true,
+					Size:       3,
+					FilesCount: 1,
+					Children: []TorrentFileTreeNode{
+						{
+							Name:       "French.srt",
+							Path:       "Subs/Extra/French.srt",
+							Size:       3,
+							Index:      NewNullUint(3),
+							Extension:  NewNullString("srt"),
+							FileType:   NewNullFileType(FileTypeSubtitles),
+							FilesCount: 1,
+						},
+					},
+				},
+				{
+					Name:       "English.srt",
+					Path:       "Subs/English.srt",
+					Size:       2,
+					Index:      NewNullUint(1),
+					Extension:  NewNullString("srt"),
+					FileType:   NewNullFileType(FileTypeSubtitles),
+					FilesCount: 1,
+				},
+			},
+		},
+		{
+			Name:       "Show.S01E01.mkv",
+			Path:       "Show.S01E01.mkv",
+			Size:       100,
+			Index:      NewNullUint(2),
+			Extension:  NewNullString("mkv"),
+			FileType:   NewNullFileType(FileTypeVideo),
+			FilesCount: 1,
+		},
+		{
+			Name:       "Show.S01E02.mkv",
+			Path:       "Show.S01E02.mkv",
+			Size:       200,
+			Index:      NewNullUint(0),
+			Extension:  NewNullString("mkv"),
+			FileType:   NewNullFileType(FileTypeVideo),
+			FilesCount: 1,
+		},
+	}, torrent.FileTree())
+
+	assert.Nil(t, Torrent{FilesStatus: FilesStatusNoInfo}.FileTree())
+}
@@ -7,6 +7,7 @@ package model
 import (
 	"time"
 
+	"github.com/bitmagnet-io/bitmagnet/internal/database/fts"
 	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
 )
 
@@ -14,13 +15,14 @@ const TableNameTorrentFile = "torrent_files"
 
 // TorrentFile mapped from table <torrent_files>
 type TorrentFile struct {
-	InfoHash  protocol.ID `gorm:"column:info_hash;primaryKey;<-:create" json:"infoHash"`
-	Index     uint32      `gorm:"column:index;not null;<-:create" json:"index"`
-	Path      string      `gorm:"column:path;primaryKey;<-:create" json:"path"`
-	Extension NullString  `gorm:"column:extension;<-:false" json:"extension"`
-	Size      uint64      `gorm:"column:size;not null" json:"size"`
-	CreatedAt time.Time   `gorm:"column:created_at;not null;<-:create" json:"createdAt"`
-	UpdatedAt time.Time   `gorm:"column:updated_at;not null" json:"updatedAt"`
+	InfoHash  protocol.ID  `gorm:"column:info_hash;primaryKey;<-:create" json:"infoHash"`
+	Index     uint32       `gorm:"column:index;not null;<-:create" json:"index"`
+	Path      string       `gorm:"column:path;primaryKey;<-:create" json:"path"`
+	Extension NullString   `gorm:"column:extension;<-:false" json:"extension"`
+	Size      uint64       `gorm:"column:size;not null" json:"size"`
+	CreatedAt time.Time    `gorm:"column:created_at;not null;<-:create" json:"createdAt"`
+	UpdatedAt time.Time    `gorm:"column:updated_at;not null" json:"updatedAt"`
+	Tsv       fts.Tsvector `gorm:"column:tsv;->:false;<-:create" json:"tsv"`
 }
 
 // TableName TorrentFile's table name
@@ -1,6 +1,7 @@
 package model
 
 import (
+	"github.com/bitmagnet-io/bitmagnet/internal/database/fts"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 	"regexp"
@@ -8,6 +9,7 @@ import (
 )
 
 func (f *TorrentFile) BeforeCreate(tx *gorm.DB) (err error) {
+	f.UpdateTsv()
 	tx.Statement.AddClause(clause.OnConflict{
 		DoNothing: true,
 	})
@@ -35,3 +37,10 @@ func fileTypeFromPath(path string) NullFileType {
 func (f TorrentFile) FileType() NullFileType {
 	return fileTypeFromPath(f.Path)
 }
+
+// UpdateTsv indexes the file path for full text search of files; as files are never updated, this is done on creation.
+func (f *TorrentFile) UpdateTsv() {
+	tsv := fts.Tsvector{}
+	tsv.AddText(f.Path, fts.TsvectorWeightD)
+	f.Tsv = tsv
+}
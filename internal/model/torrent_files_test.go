@@ -0,0 +1,15 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestTorrentFile_UpdateTsv(t *testing.T) {
+	t.Parallel()
+
+	f := TorrentFile{Path: "Show.S01/Show.S01E02.1080p.mkv"}
+	f.UpdateTsv()
+
+	assert.Equal(t, "'1080p':5 'mkv':6 's01':2 's01e02':4 'show':1,3", f.Tsv.String())
+}
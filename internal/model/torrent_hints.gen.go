@@ -28,6 +28,7 @@ type TorrentHint struct {
 	Video3d         NullVideo3d         `gorm:"column:video_3d" json:"video3D"`
 	VideoModifier   NullVideoModifier   `gorm:"column:video_modifier" json:"videoModifier"`
 	ReleaseGroup    NullString          `gorm:"column:release_group" json:"releaseGroup"`
+	Override        bool                `gorm:"column:override;not null" json:"override"`
 	CreatedAt       time.Time           `gorm:"column:created_at;not null;<-:create" json:"createdAt"`
 	UpdatedAt       time.Time           `gorm:"column:updated_at;not null" json:"updatedAt"`
 }
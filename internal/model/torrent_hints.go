@@ -6,9 +6,16 @@ import (
 )
 
 func (f *TorrentHint) BeforeCreate(tx *gorm.DB) (err error) {
-	tx.Statement.AddClause(clause.OnConflict{
+	onConflict := clause.OnConflict{
 		UpdateAll: true,
-	})
+	}
+	// a manual override is only replaced by another override, so that re-imports don't undo a manual match
+	if !f.Override {
+		onConflict.Where = clause.Where{Exprs: []clause.Expression{
+			clause.Eq{Column: clause.Column{Table: TableNameTorrentHint, Name: "override"}, Value: false},
+		}}
+	}
+	tx.Statement.AddClause(onConflict)
 	return nil
 }
 
@@ -0,0 +1,5 @@
+package model
+
+// TorrentReportReason represents why a user reported a torrent
+// ENUM(fake, mislabeled)
+type TorrentReportReason string
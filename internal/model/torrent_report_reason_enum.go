@@ -0,0 +1,200 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version:
+// Revision:
+// Build Date:
+// Built By:
+
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	TorrentReportReasonFake       TorrentReportReason = "fake"
+	TorrentReportReasonMislabeled TorrentReportReason = "mislabeled"
+)
+
+var ErrInvalidTorrentReportReason = fmt.Errorf("not a valid TorrentReportReason, try [%s]", strings.Join(_TorrentReportReasonNames, ", "))
+
+var _TorrentReportReasonNames = []string{
+	string(TorrentReportReasonFake),
+	string(TorrentReportReasonMislabeled),
+}
+
+// TorrentReportReasonNames returns a list of possible string values of TorrentReportReason.
+func TorrentReportReasonNames() []string {
+	tmp := make([]string, len(_TorrentReportReasonNames))
+	copy(tmp, _TorrentReportReasonNames)
+	return tmp
+}
+
+// TorrentReportReasonValues returns a list of the values for TorrentReportReason
+func TorrentReportReasonValues() []TorrentReportReason {
+	return []TorrentReportReason{
+		TorrentReportReasonFake,
+		TorrentReportReasonMislabeled,
+	}
+}
+
+// String implements the Stringer interface.
+func (x TorrentReportReason) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x TorrentReportReason) IsValid() bool {
+	_, err := ParseTorrentReportReason(string(x))
+	return err == nil
+}
+
+var _TorrentReportReasonValue = map[string]TorrentReportReason{
+	"fake":       TorrentReportReasonFake,
+	"mislabeled": TorrentReportReasonMislabeled,
+}
+
+// ParseTorrentReportReason attempts to convert a string to a TorrentReportReason.
+func ParseTorrentReportReason(name string) (TorrentReportReason, error) {
+	if x, ok := _TorrentReportReasonValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _TorrentReportReasonValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return TorrentReportReason(""), fmt.Errorf("%s is %w", name, ErrInvalidTorrentReportReason)
+}
+
+// MarshalText implements the text marshaller method.
+func (x TorrentReportReason) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *TorrentReportReason) UnmarshalText(text []byte) error {
+	tmp, err := ParseTorrentReportReason(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+var errTorrentReportReasonNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *TorrentReportReason) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = TorrentReportReason("")
+		return
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case string:
+		*x, err = ParseTorrentReportReason(v)
+	case []byte:
+		*x, err = ParseTorrentReportReason(string(v))
+	case TorrentReportReason:
+		*x = v
+	case *TorrentReportReason:
+		if v == nil {
+			return errTorrentReportReasonNilPtr
+		}
+		*x = *v
+	case *string:
+		if v == nil {
+			return errTorrentReportReasonNilPtr
+		}
+		*x, err = ParseTorrentReportReason(*v)
+	default:
+		return errors.New("invalid type for TorrentReportReason")
+	}
+
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x TorrentReportReason) Value() (driver.Value, error) {
+	return x.String(), nil
+}
+
+type NullTorrentReportReason struct {
+	TorrentReportReason TorrentReportReason
+	Valid               bool
+	Set                 bool
+}
+
+func NewNullTorrentReportReason(val interface{}) (x NullTorrentReportReason) {
+	err := x.Scan(val) // yes, we ignore this error, it will just be an invalid value.
+	_ = err            // make any errcheck linters happy
+	return
+}
+
+// Scan implements the Scanner interface.
+func (x *NullTorrentReportReason) Scan(value interface{}) (err error) {
+	if value == nil {
+		x.TorrentReportReason, x.Valid = TorrentReportReason(""), false
+		return
+	}
+
+	err = x.TorrentReportReason.Scan(value)
+	x.Valid = (err == nil)
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x NullTorrentReportReason) Value() (driver.Value, error) {
+	if !x.Valid {
+		return nil, nil
+	}
+	return x.TorrentReportReason.String(), nil
+}
+
+// MarshalJSON correctly serializes a NullTorrentReportReason to JSON.
+func (n NullTorrentReportReason) MarshalJSON() ([]byte, error) {
+	const nullStr = "null"
+	if n.Valid {
+		return json.Marshal(n.TorrentReportReason)
+	}
+	return []byte(nullStr), nil
+}
+
+// UnmarshalJSON correctly deserializes a NullTorrentReportReason from JSON.
+func (n *NullTorrentReportReason) UnmarshalJSON(b []byte) error {
+	n.Set = true
+	var x interface{}
+	err := json.Unmarshal(b, &x)
+	if err != nil {
+		return err
+	}
+	err = n.Scan(x)
+	return err
+}
+
+// MarshalGQL correctly serializes a NullTorrentReportReason to GraphQL.
+func (n NullTorrentReportReason) MarshalGQL(w io.Writer) {
+	bytes, err := json.Marshal(n)
+	if err == nil {
+		_, _ = w.Write(bytes)
+	}
+}
+
+// UnmarshalGQL correctly deserializes a NullTorrentReportReason from GraphQL.
+func (n *NullTorrentReportReason) UnmarshalGQL(v any) error {
+	if v == nil {
+		return nil
+	}
+	str, ok := v.(string)
+	if !ok {
+		return errors.New("value is not a string")
+	}
+	return n.UnmarshalJSON([]byte(str))
+}
@@ -0,0 +1,14 @@
+package model
+
+import "github.com/bitmagnet-io/bitmagnet/internal/protocol"
+
+// TorrentReportCounts are the numbers of users that have reported a torrent, by reason.
+type TorrentReportCounts struct {
+	InfoHash   protocol.ID
+	Fake       uint
+	Mislabeled uint
+}
+
+func (c TorrentReportCounts) Total() uint {
+	return c.Fake + c.Mislabeled
+}
@@ -0,0 +1,12 @@
+package model
+
+import "github.com/bitmagnet-io/bitmagnet/internal/protocol"
+
+// TorrentStatus is whether a torrent is known and how it has been classified, without any of its other data.
+type TorrentStatus struct {
+	InfoHash    protocol.ID     `json:"infoHash"`
+	Exists      bool            `json:"exists"`
+	Classified  bool            `json:"classified"`
+	ContentType NullContentType `json:"contentType"`
+	Quarantined bool            `json:"quarantined"`
+}
@@ -8,6 +8,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 )
 
 func (t *Torrent) AfterFind(tx *gorm.DB) error {
@@ -53,10 +54,29 @@ func (t Torrent) Leechers() NullUint {
 	return leechers
 }
 
-func (t Torrent) MagnetUri() string {
-	return "magnet:?xt=urn:btih:" + t.InfoHash.String() +
+// Health returns the highest estimated swarm health from all sources as of the given time;
+// this is a decaying average of the swarm size, so torrents that stop being seen tend towards zero.
+func (t Torrent) Health(at time.Time, halfLife time.Duration) NullFloat32 {
+	health := NullFloat32{}
+	for _, source := range t.Sources {
+		if sourceHealth := source.DecayedHealth(at, halfLife); sourceHealth.Valid {
+			if !health.Valid || sourceHealth.Float32 > health.Float32 {
+				health = sourceHealth
+			}
+		}
+	}
+	return health
+}
+
+// MagnetUri returns the magnet URI of the torrent, with any trackers added as tr parameters.
+func (t Torrent) MagnetUri(trackers ...string) string {
+	uri := "magnet:?xt=urn:btih:" + t.InfoHash.String() +
 		"&dn=" + url.QueryEscape(t.Name) +
 		"&xl=" + strconv.FormatUint(t.Size, 10)
+	for _, tr := range trackers {
+		uri += "&tr=" + url.QueryEscape(tr)
+	}
+	return uri
 }
 
 // HasFilesInfo returns true if we know about the files in this torrent.
@@ -0,0 +1,25 @@
+package model
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestTorrentMagnetUri(t *testing.T) {
+	t.Parallel()
+	torrent := Torrent{
+		InfoHash: protocol.MustParseID("0123456789abcdef0123456789abcdef01234567"),
+		Name:     "Some Name",
+		Size:     10,
+	}
+	assert.Equal(t,
+		"magnet:?xt=urn:btih:0123456789abcdef0123456789abcdef01234567&dn=Some+Name&xl=10",
+		torrent.MagnetUri(),
+	)
+	assert.Equal(t,
+		"magnet:?xt=urn:btih:0123456789abcdef0123456789abcdef01234567&dn=Some+Name&xl=10"+
+			"&tr=udp%3A%2F%2Ftracker.example.com%3A1337%2Fannounce",
+		torrent.MagnetUri("udp://tracker.example.com:1337/announce"),
+	)
+}
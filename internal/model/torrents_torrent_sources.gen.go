@@ -14,17 +14,20 @@ const TableNameTorrentsTorrentSource = "torrents_torrent_sources"
 
 // TorrentsTorrentSource mapped from table <torrents_torrent_sources>
 type TorrentsTorrentSource struct {
-	Source        string        `gorm:"column:source;primaryKey;<-:create" json:"source"`
-	InfoHash      protocol.ID   `gorm:"column:info_hash;primaryKey;<-:create" json:"infoHash"`
-	ImportID      NullString    `gorm:"column:import_id" json:"importId"`
-	Bfsd          []byte        `gorm:"column:bfsd" json:"bfsd"`
-	Bfpe          []byte        `gorm:"column:bfpe" json:"bfpe"`
-	Seeders       NullUint      `gorm:"column:seeders" json:"seeders"`
-	Leechers      NullUint      `gorm:"column:leechers" json:"leechers"`
-	PublishedAt   time.Time     `gorm:"column:published_at" json:"publishedAt"`
-	CreatedAt     time.Time     `gorm:"column:created_at;not null;<-:create" json:"createdAt"`
-	UpdatedAt     time.Time     `gorm:"column:updated_at;not null" json:"updatedAt"`
-	TorrentSource TorrentSource `gorm:"foreignKey:Source" json:"torrent_source"`
+	Source          string              `gorm:"column:source;primaryKey;<-:create" json:"source"`
+	InfoHash        protocol.ID         `gorm:"column:info_hash;primaryKey;<-:create" json:"infoHash"`
+	ImportID        NullString          `gorm:"column:import_id" json:"importId"`
+	Bfsd            []byte              `gorm:"column:bfsd" json:"bfsd"`
+	Bfpe            []byte              `gorm:"column:bfpe" json:"bfpe"`
+	Seeders         NullUint            `gorm:"column:seeders" json:"seeders"`
+	Leechers        NullUint            `gorm:"column:leechers" json:"leechers"`
+	PublishedAt     time.Time           `gorm:"column:published_at" json:"publishedAt"`
+	CreatedAt       time.Time           `gorm:"column:created_at;not null;<-:create" json:"createdAt"`
+	UpdatedAt       time.Time           `gorm:"column:updated_at;not null" json:"updatedAt"`
+	Health          NullFloat32         `gorm:"column:health" json:"health"`
+	DiscoveryMethod NullDiscoveryMethod `gorm:"column:discovery_method;<-:create" json:"discoveryMethod"`
+	LastSeededAt    *time.Time          `gorm:"column:last_seeded_at" json:"lastSeededAt"`
+	TorrentSource   TorrentSource       `gorm:"foreignKey:Source" json:"torrent_source"`
 }
 
 // TableName TorrentsTorrentSource's table name
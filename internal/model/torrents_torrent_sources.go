@@ -0,0 +1,26 @@
+package model
+
+import (
+	"math"
+	"time"
+)
+
+// DecayedHealth returns the health of the source as of the given time. Health is only estimated when a torrent is
+// scraped, so from then on it halves every halfLife until the torrent is scraped again; a torrent that's no longer seen
+// in the DHT tends towards zero.
+func (s TorrentsTorrentSource) DecayedHealth(at time.Time, halfLife time.Duration) NullFloat32 {
+	age := at.Sub(s.UpdatedAt)
+	if !s.Health.Valid || halfLife <= 0 || age <= 0 {
+		return s.Health
+	}
+	return NewNullFloat32(s.Health.Float32 * float32(math.Pow(0.5, age.Seconds()/halfLife.Seconds())))
+}
+
+// SetSeeders sets the seeder count of the source as scraped at the given time, which is recorded as the time the
+// source was last seeded if it has any seeders.
+func (s *TorrentsTorrentSource) SetSeeders(seeders NullUint, at time.Time) {
+	s.Seeders = seeders
+	if seeders.Valid && seeders.Uint > 0 {
+		s.LastSeededAt = &at
+	}
+}
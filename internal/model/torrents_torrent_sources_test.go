@@ -0,0 +1,50 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestTorrentsTorrentSource_DecayedHealth(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	halfLife := 30 * 24 * time.Hour
+	source := TorrentsTorrentSource{Health: NewNullFloat32(40), UpdatedAt: now.Add(-2 * halfLife)}
+
+	health := source.DecayedHealth(now, halfLife)
+	require.True(t, health.Valid)
+	assert.InDelta(t, 10, health.Float32, 0.001)
+	assert.Equal(t, source.Health, source.DecayedHealth(source.UpdatedAt, halfLife))
+	assert.Equal(t, source.Health, source.DecayedHealth(now, 0))
+	assert.Equal(t, NullFloat32{}, TorrentsTorrentSource{UpdatedAt: source.UpdatedAt}.DecayedHealth(now, halfLife))
+
+	torrent := Torrent{Sources: []TorrentsTorrentSource{
+		source,
+		{Health: NewNullFloat32(20), UpdatedAt: now.Add(-halfLife)},
+		{},
+	}}
+	health = torrent.Health(now, halfLife)
+	require.True(t, health.Valid)
+	assert.InDelta(t, 10, health.Float32, 0.001)
+}
+
+func TestTorrentsTorrentSource_SetSeeders(t *testing.T) {
+	t.Parallel()
+
+	seededAt := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	var source TorrentsTorrentSource
+	source.SetSeeders(NewNullUint(3), seededAt)
+	assert.Equal(t, NewNullUint(3), source.Seeders)
+	require.NotNil(t, source.LastSeededAt)
+	assert.Equal(t, seededAt, *source.LastSeededAt)
+
+	// the time isn't changed by a scrape without seeders
+	source.SetSeeders(NewNullUint(0), seededAt.Add(time.Hour))
+	assert.Equal(t, NewNullUint(0), source.Seeders)
+	assert.Equal(t, seededAt, *source.LastSeededAt)
+	source.SetSeeders(NullUint{}, seededAt.Add(time.Hour))
+	assert.Equal(t, seededAt, *source.LastSeededAt)
+}
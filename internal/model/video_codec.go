@@ -8,7 +8,7 @@ import (
 )
 
 // VideoCodec represents the codec of a video
-// ENUM(H264, x264, x265, XviD, DivX, MPEG2, MPEG4)
+// ENUM(H264, x264, x265, XviD, DivX, MPEG2, MPEG4, AV1)
 type VideoCodec string
 
 func (v VideoCodec) Label() string {
@@ -16,7 +16,9 @@ func (v VideoCodec) Label() string {
 }
 
 var videoCodecAliases = map[string]VideoCodec{
-	"avc": VideoCodecH264,
+	"avc":  VideoCodecH264,
+	"hevc": VideoCodecX265,
+	"h265": VideoCodecX265,
 }
 
 func createVideoCodecAndOptionalReleaseGroupRegex() *regexp.Regexp {
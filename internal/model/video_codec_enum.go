@@ -23,6 +23,7 @@ const (
 	VideoCodecDivX  VideoCodec = "DivX"
 	VideoCodecMPEG2 VideoCodec = "MPEG2"
 	VideoCodecMPEG4 VideoCodec = "MPEG4"
+	VideoCodecAV1   VideoCodec = "AV1"
 )
 
 var ErrInvalidVideoCodec = fmt.Errorf("not a valid VideoCodec, try [%s]", strings.Join(_VideoCodecNames, ", "))
@@ -35,6 +36,7 @@ var _VideoCodecNames = []string{
 	string(VideoCodecDivX),
 	string(VideoCodecMPEG2),
 	string(VideoCodecMPEG4),
+	string(VideoCodecAV1),
 }
 
 // VideoCodecNames returns a list of possible string values of VideoCodec.
@@ -54,6 +56,7 @@ func VideoCodecValues() []VideoCodec {
 		VideoCodecDivX,
 		VideoCodecMPEG2,
 		VideoCodecMPEG4,
+		VideoCodecAV1,
 	}
 }
 
@@ -82,6 +85,8 @@ var _VideoCodecValue = map[string]VideoCodec{
 	"mpeg2": VideoCodecMPEG2,
 	"MPEG4": VideoCodecMPEG4,
 	"mpeg4": VideoCodecMPEG4,
+	"AV1":   VideoCodecAV1,
+	"av1":   VideoCodecAV1,
 }
 
 // ParseVideoCodec attempts to convert a string to a VideoCodec.
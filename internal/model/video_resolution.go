@@ -3,6 +3,7 @@ package model
 import (
 	"github.com/bitmagnet-io/bitmagnet/internal/regex"
 	"regexp"
+	"slices"
 	"strings"
 )
 
@@ -14,6 +15,12 @@ func (v VideoResolution) Label() string {
 	return v.String()[1:]
 }
 
+// AtLeast returns true if the resolution is the same as or higher than the other resolution.
+func (v VideoResolution) AtLeast(other VideoResolution) bool {
+	values := VideoResolutionValues()
+	return slices.Index(values, v) >= slices.Index(values, other)
+}
+
 var videoResolutionAliases = map[string]VideoResolution{
 	"1080i":     VideoResolutionV1080p,
 	"1920x1080": VideoResolutionV1080p,
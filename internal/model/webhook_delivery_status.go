@@ -0,0 +1,5 @@
+package model
+
+// WebhookDeliveryStatus represents the state of a webhook delivery
+// ENUM(pending, succeeded, failed)
+type WebhookDeliveryStatus string
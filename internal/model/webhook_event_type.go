@@ -0,0 +1,5 @@
+package model
+
+// WebhookEventType represents the kind of event delivered to webhooks
+// ENUM(torrent_discovered, torrent_classified, import_finished, health_degraded, better_release)
+type WebhookEventType string
@@ -5,40 +5,96 @@ import (
 	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
 	"github.com/bitmagnet-io/bitmagnet/internal/processor"
 	"github.com/bitmagnet-io/bitmagnet/internal/queue/consumer"
+	"github.com/bitmagnet-io/bitmagnet/internal/taskrun"
+	"github.com/bitmagnet-io/bitmagnet/internal/telemetry/tracing"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/fx"
+	"time"
 )
 
+var tracer = otel.Tracer("github.com/bitmagnet-io/bitmagnet/internal/processor/asynq/consumer")
+
+// runInterval is the interval of the recorded process task runs; recording a run per message would write a task run
+// for every few torrents processed.
+const runInterval = time.Hour
+
 type Params struct {
 	fx.In
-	Processor lazy.Lazy[processor.Processor]
+	Processor       lazy.Lazy[processor.Processor]
+	TaskRunRecorder lazy.Lazy[taskrun.Recorder]
 }
 
 type Result struct {
 	fx.Out
-	Consumer lazy.Lazy[consumer.Consumer] `group:"queue_consumers"`
+	Consumer      lazy.Lazy[consumer.Consumer] `group:"queue_consumers"`
+	Duration      prometheus.Collector         `group:"prometheus_collectors"`
+	SuccessTotal  prometheus.Collector         `group:"prometheus_collectors"`
+	ErrorTotal    prometheus.Collector         `group:"prometheus_collectors"`
+	TorrentsTotal prometheus.Collector         `group:"prometheus_collectors"`
+	AppHook       fx.Hook                      `group:"app_hooks"`
 }
 
 func New(p Params) Result {
+	collector := newPrometheusCollector()
+	runs := lazy.New(func() (*taskrun.Aggregator, error) {
+		tr, err := p.TaskRunRecorder.Get()
+		if err != nil {
+			return nil, err
+		}
+		return taskrun.NewAggregator(tr, taskrun.KindProcess, runInterval), nil
+	})
 	return Result{
 		Consumer: lazy.New(func() (consumer.Consumer, error) {
 			pr, err := p.Processor.Get()
 			if err != nil {
 				return nil, err
 			}
+			r, err := runs.Get()
+			if err != nil {
+				return nil, err
+			}
+			collector.handler = cns{pr, r}
 			return consumer.New[processor.MessageParams](
 				processor.MessageName,
-				cns{
-					pr,
-				},
+				collector,
 			), nil
 		}),
+		Duration:      collector.duration,
+		SuccessTotal:  collector.successTotal,
+		ErrorTotal:    collector.errorTotal,
+		TorrentsTotal: collector.torrentsTotal,
+		// the app hooks are stopped after the workers, so the current run is finished once no more messages are handled
+		AppHook: fx.Hook{
+			OnStop: func(ctx context.Context) error {
+				return runs.IfInitialized(func(r *taskrun.Aggregator) error {
+					if r == nil {
+						return nil
+					}
+					return r.Close(ctx)
+				})
+			},
+		},
 	}
 }
 
 type cns struct {
-	p processor.Processor
+	p    processor.Processor
+	runs *taskrun.Aggregator
 }
 
-func (c cns) Handle(ctx context.Context, params processor.MessageParams) error {
-	return c.p.Process(ctx, params)
+func (c cns) Handle(ctx context.Context, params processor.MessageParams) (err error) {
+	ctx, span := tracer.Start(ctx, "processor.consume")
+	span.SetAttributes(
+		attribute.Int("torrents", len(params.InfoHashes)),
+		attribute.Int("classify_mode", int(params.ClassifyMode)),
+		attribute.Int("priority", int(params.Priority)),
+	)
+	defer func() {
+		tracing.End(span, err)
+	}()
+	err = c.p.Process(ctx, params)
+	c.runs.Record(ctx, len(params.InfoHashes), err)
+	return err
 }
@@ -0,0 +1,63 @@
+package consumer
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/processor"
+	"github.com/bitmagnet-io/bitmagnet/internal/queue/consumer"
+	"github.com/prometheus/client_golang/prometheus"
+	"time"
+)
+
+type prometheusCollector struct {
+	handler       consumer.Handler[processor.MessageParams]
+	duration      prometheus.Histogram
+	successTotal  prometheus.Counter
+	errorTotal    prometheus.Counter
+	torrentsTotal prometheus.Counter
+}
+
+const namespace = "bitmagnet"
+const subsystem = "processor"
+
+func newPrometheusCollector() *prometheusCollector {
+	return &prometheusCollector{
+		duration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "duration_seconds",
+			Help:      "Duration of successfully processed messages in seconds.",
+			Buckets:   prometheus.DefBuckets,
+		}),
+		successTotal: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "success_total",
+			Help:      "Total number of successfully processed messages.",
+		}),
+		errorTotal: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "error_total",
+			Help:      "Total number of messages that failed processing.",
+		}),
+		torrentsTotal: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "torrents_total",
+			Help:      "Total number of torrents in successfully processed messages.",
+		}),
+	}
+}
+
+func (c prometheusCollector) Handle(ctx context.Context, params processor.MessageParams) error {
+	start := time.Now()
+	err := c.handler.Handle(ctx, params)
+	if err == nil {
+		c.duration.Observe(time.Since(start).Seconds())
+		c.successTotal.Inc()
+		c.torrentsTotal.Add(float64(len(params.InfoHashes)))
+	} else {
+		c.errorTotal.Inc()
+	}
+	return err
+}
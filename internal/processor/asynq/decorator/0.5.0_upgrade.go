@@ -89,6 +89,7 @@ func New(p Params) (Result, error) {
 									if _, err := p.Publish(ctx, processor.MessageParams{
 										ClassifyMode: processor.ClassifyModeSkipUnmatched,
 										InfoHashes:   infoHashes,
+										Priority:     processor.MessagePriorityBulk,
 									}); err != nil {
 										return err
 									}
@@ -8,12 +8,22 @@ import (
 )
 
 func New() producer.Producer[processor.MessageParams] {
-	return producer.New[processor.MessageParams](
-		processor.MessageName,
-		asynq.Queue(processor.MessageName),
-		asynq.MaxRetry(1),
-		// high retention here allows for a large queue to be fully worked down
-		asynq.Retention(time.Hour*24*7),
-		asynq.Unique(time.Hour*24),
-	)
+	return priorityProducer{
+		producer.New[processor.MessageParams](
+			processor.MessageName,
+			asynq.MaxRetry(1),
+			// high retention here allows for a large queue to be fully worked down
+			asynq.Retention(time.Hour*24*7),
+			asynq.Unique(time.Hour*24),
+		),
+	}
+}
+
+// priorityProducer routes each message to the queue for its priority
+type priorityProducer struct {
+	producer.Producer[processor.MessageParams]
+}
+
+func (p priorityProducer) Produce(payload processor.MessageParams, options ...asynq.Option) (*asynq.Task, error) {
+	return p.Producer.Produce(payload, append([]asynq.Option{asynq.Queue(payload.Priority.QueueName())}, options...)...)
 }
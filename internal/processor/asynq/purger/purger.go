@@ -0,0 +1,140 @@
+package purger
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/bitmagnet-io/bitmagnet/internal/processor"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"github.com/bitmagnet-io/bitmagnet/internal/queue/publisher"
+	"github.com/hibiken/asynq"
+	"go.uber.org/fx"
+)
+
+const pageSize = 1000
+
+var queueNames = []string{processor.MessageName, processor.QueueNameBulk, processor.QueueNameInteractive}
+
+// Purger removes info hashes from the processor messages that are waiting in the queue,
+// so that deleted torrents don't cause messages to fail with missing info hashes.
+type Purger interface {
+	// Purge removes the given info hashes from all pending, scheduled, retrying and dead-lettered processor messages;
+	// messages left without info hashes are deleted, and the others are replaced by a message for the remaining hashes.
+	// Messages that are already being processed are left alone. It returns the number of messages changed.
+	// As every queued message is scanned, the torrents of a delete should be purged at once rather than in batches.
+	Purge(ctx context.Context, infoHashes []protocol.ID) (int, error)
+}
+
+type Params struct {
+	fx.In
+	Inspector lazy.Lazy[*asynq.Inspector]
+	Publisher lazy.Lazy[publisher.Publisher[processor.MessageParams]]
+}
+
+type Result struct {
+	fx.Out
+	Purger lazy.Lazy[Purger]
+}
+
+func New(p Params) Result {
+	return Result{
+		Purger: lazy.New(func() (Purger, error) {
+			i, err := p.Inspector.Get()
+			if err != nil {
+				return nil, err
+			}
+			pub, err := p.Publisher.Get()
+			if err != nil {
+				return nil, err
+			}
+			return purger{i, pub}, nil
+		}),
+	}
+}
+
+type purger struct {
+	inspector *asynq.Inspector
+	publisher publisher.Publisher[processor.MessageParams]
+}
+
+type affectedMessage struct {
+	id     string
+	queue  string
+	params processor.MessageParams
+}
+
+func (p purger) Purge(ctx context.Context, infoHashes []protocol.ID) (int, error) {
+	if len(infoHashes) == 0 {
+		return 0, nil
+	}
+	purged := make(map[protocol.ID]struct{}, len(infoHashes))
+	for _, h := range infoHashes {
+		purged[h] = struct{}{}
+	}
+	var affected []affectedMessage
+	for _, queue := range queueNames {
+		for _, list := range []func(string, ...asynq.ListOption) ([]*asynq.TaskInfo, error){
+			p.inspector.ListPendingTasks,
+			p.inspector.ListScheduledTasks,
+			p.inspector.ListRetryTasks,
+			p.inspector.ListArchivedTasks,
+		} {
+			// the affected messages are collected before changing any, so that the pages don't shift
+			for page := 1; ; page++ {
+				tasks, err := list(queue, asynq.PageSize(pageSize), asynq.Page(page))
+				if err != nil {
+					if errors.Is(err, asynq.ErrQueueNotFound) {
+						break
+					}
+					return 0, err
+				}
+				for _, t := range tasks {
+					if t.Type != processor.MessageName {
+						continue
+					}
+					var params processor.MessageParams
+					if err := json.Unmarshal(t.Payload, &params); err != nil {
+						continue
+					}
+					if remaining, ok := removeInfoHashes(params.InfoHashes, purged); ok {
+						params.InfoHashes = remaining
+						affected = append(affected, affectedMessage{t.ID, t.Queue, params})
+					}
+				}
+				if len(tasks) < pageSize {
+					break
+				}
+			}
+		}
+	}
+	n := 0
+	for _, m := range affected {
+		if err := p.inspector.DeleteTask(m.queue, m.id); err != nil {
+			if errors.Is(err, asynq.ErrTaskNotFound) {
+				continue
+			}
+			return n, fmt.Errorf("failed to delete message %s: %w", m.id, err)
+		}
+		n++
+		if len(m.params.InfoHashes) == 0 {
+			continue
+		}
+		if _, err := p.publisher.Publish(ctx, m.params); err != nil && !errors.Is(err, asynq.ErrDuplicateTask) {
+			return n, fmt.Errorf("failed to replace message %s: %w", m.id, err)
+		}
+	}
+	return n, nil
+}
+
+// removeInfoHashes returns the info hashes that aren't in the purged set, and whether any were removed.
+func removeInfoHashes(infoHashes []protocol.ID, purged map[protocol.ID]struct{}) ([]protocol.ID, bool) {
+	remaining := make([]protocol.ID, 0, len(infoHashes))
+	for _, h := range infoHashes {
+		if _, ok := purged[h]; !ok {
+			remaining = append(remaining, h)
+		}
+	}
+	return remaining, len(remaining) < len(infoHashes)
+}
@@ -0,0 +1,26 @@
+package purger
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestRemoveInfoHashes(t *testing.T) {
+	t.Parallel()
+
+	a, b, c := protocol.ID{1}, protocol.ID{2}, protocol.ID{3}
+	purged := map[protocol.ID]struct{}{b: {}}
+
+	remaining, ok := removeInfoHashes([]protocol.ID{a, b, c}, purged)
+	assert.True(t, ok)
+	assert.Equal(t, []protocol.ID{a, c}, remaining)
+
+	remaining, ok = removeInfoHashes([]protocol.ID{b}, purged)
+	assert.True(t, ok)
+	assert.Empty(t, remaining)
+
+	remaining, ok = removeInfoHashes([]protocol.ID{a, c}, purged)
+	assert.False(t, ok)
+	assert.Equal(t, []protocol.ID{a, c}, remaining)
+}
@@ -0,0 +1,160 @@
+package tuner
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/worker"
+	"github.com/bitmagnet-io/bitmagnet/internal/concurrency"
+	"github.com/bitmagnet-io/bitmagnet/internal/processor"
+	"github.com/bitmagnet-io/bitmagnet/internal/queue/stats"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"time"
+)
+
+type Params struct {
+	fx.In
+	Config     processor.Config
+	Limiter    concurrency.AdjustableLimiter `name:"processor_limiter"`
+	QueueStats lazy.Lazy[stats.Reader]
+	GormDb     lazy.Lazy[*gorm.DB]
+	Logger     *zap.SugaredLogger
+}
+
+type Result struct {
+	fx.Out
+	Decorator worker.Decorator `group:"worker_decorators"`
+}
+
+// New adjusts the processor concurrency while the queue server is running, if adaptive concurrency is enabled.
+func New(p Params) Result {
+	var cancel context.CancelFunc
+	return Result{
+		Decorator: worker.Decorator{
+			Key: "queue_server",
+			Decorate: func(hook fx.Hook) fx.Hook {
+				return fx.Hook{
+					OnStart: func(ctx context.Context) error {
+						if err := hook.OnStart(ctx); err != nil {
+							return err
+						}
+						if !p.Config.AdaptiveConcurrency {
+							return nil
+						}
+						r, err := p.QueueStats.Get()
+						if err != nil {
+							return err
+						}
+						db, err := p.GormDb.Get()
+						if err != nil {
+							return err
+						}
+						t := tuner{
+							config:  p.Config,
+							limiter: p.Limiter,
+							stats:   r,
+							db:      db,
+							logger:  p.Logger.Named("processor_tuner"),
+						}
+						var tunerCtx context.Context
+						tunerCtx, cancel = context.WithCancel(context.Background())
+						go t.run(tunerCtx)
+						return nil
+					},
+					OnStop: func(ctx context.Context) error {
+						if cancel != nil {
+							cancel()
+						}
+						return hook.OnStop(ctx)
+					},
+				}
+			},
+		},
+	}
+}
+
+type tuner struct {
+	config  processor.Config
+	limiter concurrency.AdjustableLimiter
+	stats   stats.Reader
+	db      *gorm.DB
+	logger  *zap.SugaredLogger
+}
+
+func (t tuner) run(ctx context.Context) {
+	t.limiter.SetLimit(int(clamp(t.config.Concurrency, t.config.MinConcurrency, t.config.MaxConcurrency)))
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(t.config.AdaptiveInterval):
+			queueLatency, err := t.queueLatency(ctx)
+			if err != nil {
+				t.logger.Errorw("failed to get queue latency", "error", err)
+				continue
+			}
+			dbLatency, err := t.dbLatency(ctx)
+			if err != nil {
+				t.logger.Errorw("failed to get database latency", "error", err)
+				continue
+			}
+			current := uint(t.limiter.Limit())
+			next := nextConcurrency(t.config, current, queueLatency, dbLatency)
+			if next != current {
+				t.logger.Debugw(
+					"adjusting processor concurrency",
+					"from", current,
+					"to", next,
+					"queueLatency", queueLatency,
+					"dbLatency", dbLatency,
+				)
+				t.limiter.SetLimit(int(next))
+			}
+		}
+	}
+}
+
+// queueLatency returns how long the oldest pending message in any of the processor queues has been waiting.
+func (t tuner) queueLatency(ctx context.Context) (time.Duration, error) {
+	queueStats, err := t.stats.Stats(ctx)
+	if err != nil {
+		return 0, err
+	}
+	latency := time.Duration(0)
+	for _, s := range queueStats {
+		switch s.Queue {
+		case processor.MessageName, processor.QueueNameBulk, processor.QueueNameInteractive:
+			latency = max(latency, s.Latency)
+		}
+	}
+	return latency, nil
+}
+
+// dbLatency returns the duration of a trivial database round trip.
+func (t tuner) dbLatency(ctx context.Context) (time.Duration, error) {
+	start := time.Now()
+	if err := t.db.WithContext(ctx).Exec("SELECT 1").Error; err != nil {
+		return 0, err
+	}
+	return time.Since(start), nil
+}
+
+// nextConcurrency halves the concurrency if the database is overloaded, increments it if messages are waiting too long,
+// and decrements it if the queue is keeping up comfortably.
+func nextConcurrency(config processor.Config, current uint, queueLatency, dbLatency time.Duration) uint {
+	next := current
+	switch {
+	case dbLatency > config.MaxDbLatency:
+		next = current / 2
+	case queueLatency > config.TargetQueueLatency:
+		next = current + 1
+	case queueLatency < config.TargetQueueLatency/2 && current > config.Concurrency:
+		next = current - 1
+	}
+	return clamp(next, config.MinConcurrency, config.MaxConcurrency)
+}
+
+func clamp(n, minN, maxN uint) uint {
+	return max(min(n, maxN), minN, 1)
+}
@@ -0,0 +1,23 @@
+package tuner
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/processor"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestNextConcurrency(t *testing.T) {
+	t.Parallel()
+
+	config := processor.NewDefaultConfig()
+
+	assert.Equal(t, uint(3), nextConcurrency(config, 2, time.Hour, time.Millisecond), "a backlog should increase concurrency")
+	assert.Equal(t, config.MaxConcurrency, nextConcurrency(config, config.MaxConcurrency, time.Hour, time.Millisecond))
+	assert.Equal(t, uint(3), nextConcurrency(config, 6, time.Hour, time.Second), "an overloaded database should halve concurrency")
+	assert.Equal(t, config.MinConcurrency, nextConcurrency(config, 1, time.Hour, time.Second))
+	assert.Equal(t, uint(4), nextConcurrency(config, 5, 0, time.Millisecond), "an empty queue should decrease concurrency")
+	assert.Equal(t, config.Concurrency, nextConcurrency(config, config.Concurrency, 0, time.Millisecond),
+		"an empty queue shouldn't decrease concurrency below the configured value")
+	assert.Equal(t, uint(5), nextConcurrency(config, 5, config.TargetQueueLatency*3/4, time.Millisecond))
+}
@@ -0,0 +1,44 @@
+package processor
+
+import "time"
+
+type Config struct {
+	// Concurrency is the number of batches of torrents that can be processed at once, or the initial number if AdaptiveConcurrency is enabled.
+	// Processing is also limited by the queue concurrency.
+	Concurrency uint
+	// BatchSize is the maximum number of torrents classified and persisted together; larger messages are processed in several batches.
+	BatchSize uint
+	// AdaptiveConcurrency scales the concurrency between MinConcurrency and MaxConcurrency while the queue server is running:
+	// it is increased while processor messages wait longer than TargetQueueLatency, and halved while database round trips
+	// take longer than MaxDbLatency.
+	AdaptiveConcurrency bool
+	MinConcurrency      uint
+	MaxConcurrency      uint
+	TargetQueueLatency  time.Duration
+	MaxDbLatency        time.Duration
+	// AdaptiveInterval is the time to wait between concurrency adjustments.
+	AdaptiveInterval time.Duration
+	// Pipelines maps content types to the stages applied to torrents of that type ("lookup" and "persist");
+	// for example, a tv_show pipeline of [persist] classifies TV shows without TMDB lookups, and an xxx pipeline with no stages
+	// leaves XXX torrents unclassified. Torrents of an unknown type use the "unknown" pipeline, and others use the "default" pipeline.
+	Pipelines map[string][]string
+	// ClassificationTraces enables storing a trace of the decisions made classifying each torrent, such as the tokens parsed
+	// from its name and the candidates of its content lookups, which can be retrieved through the GraphQL API. A trace is
+	// kept per classified torrent, typically of a few kilobytes, so it's disabled by default.
+	ClassificationTraces bool
+}
+
+func NewDefaultConfig() Config {
+	return Config{
+		Concurrency:        2,
+		BatchSize:          100,
+		MinConcurrency:     1,
+		MaxConcurrency:     8,
+		TargetQueueLatency: time.Minute,
+		MaxDbLatency:       time.Millisecond * 200,
+		AdaptiveInterval:   time.Second * 30,
+		Pipelines: map[string][]string{
+			PipelineDefault: {string(StageLookup), string(StagePersist)},
+		},
+	}
+}
@@ -1,10 +1,20 @@
 package processor
 
 import (
+	"github.com/bitmagnet-io/bitmagnet/internal/blocklist"
 	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
 	"github.com/bitmagnet-io/bitmagnet/internal/classifier"
+	"github.com/bitmagnet-io/bitmagnet/internal/classifier/shadow"
+	"github.com/bitmagnet-io/bitmagnet/internal/concurrency"
 	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
 	"github.com/bitmagnet-io/bitmagnet/internal/database/search"
+	"github.com/bitmagnet-io/bitmagnet/internal/events"
+	"github.com/bitmagnet-io/bitmagnet/internal/savedsearch"
+	"github.com/bitmagnet-io/bitmagnet/internal/servarr"
+	"github.com/bitmagnet-io/bitmagnet/internal/spam"
+	"github.com/bitmagnet-io/bitmagnet/internal/takedown"
+	"github.com/bitmagnet-io/bitmagnet/internal/wanted"
+	"github.com/bitmagnet-io/bitmagnet/internal/watchlist"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
 	"golang.org/x/sync/semaphore"
@@ -12,19 +22,33 @@ import (
 
 type Params struct {
 	fx.In
-	Search     lazy.Lazy[search.Search]
-	Classifier lazy.Lazy[classifier.Classifier]
-	Dao        lazy.Lazy[*dao.Query]
-	Logger     *zap.SugaredLogger
+	Config      Config
+	Search      lazy.Lazy[search.Search]
+	Classifier  lazy.Lazy[classifier.Classifier]
+	Shadow      lazy.Lazy[shadow.Evaluator]
+	Dao         lazy.Lazy[*dao.Query]
+	Takedown    lazy.Lazy[takedown.Manager]
+	Blocklist   lazy.Lazy[blocklist.Manager]
+	Spam        spam.Detector
+	Wanted      lazy.Lazy[wanted.Manager]
+	SavedSearch lazy.Lazy[savedsearch.Manager]
+	Servarr     lazy.Lazy[servarr.Scheduler]
+	Watchlist   lazy.Lazy[watchlist.Manager]
+	EventBus    lazy.Lazy[events.Bus]
+	Logger      *zap.SugaredLogger
 }
 
 type Result struct {
 	fx.Out
 	Processor lazy.Lazy[Processor]
+	// Limiter is exposed so that the concurrency can be adjusted while running
+	Limiter concurrency.AdjustableLimiter `name:"processor_limiter"`
 }
 
 func New(p Params) Result {
+	limiter := concurrency.NewAdjustableLimiter(int(p.Config.Concurrency))
 	return Result{
+		Limiter: limiter,
 		Processor: lazy.New(func() (Processor, error) {
 			s, err := p.Search.Get()
 			if err != nil {
@@ -38,12 +62,60 @@ func New(p Params) Result {
 			if err != nil {
 				return nil, err
 			}
+			se, err := p.Shadow.Get()
+			if err != nil {
+				return nil, err
+			}
+			tm, err := p.Takedown.Get()
+			if err != nil {
+				return nil, err
+			}
+			bm, err := p.Blocklist.Get()
+			if err != nil {
+				return nil, err
+			}
+			wm, err := p.Wanted.Get()
+			if err != nil {
+				return nil, err
+			}
+			ssm, err := p.SavedSearch.Get()
+			if err != nil {
+				return nil, err
+			}
+			sp, err := p.Servarr.Get()
+			if err != nil {
+				return nil, err
+			}
+			wl, err := p.Watchlist.Get()
+			if err != nil {
+				return nil, err
+			}
+			eb, err := p.EventBus.Get()
+			if err != nil {
+				return nil, err
+			}
+			pl, err := newPipelines(p.Config.Pipelines)
+			if err != nil {
+				return nil, err
+			}
 			return processor{
-				classifier:       c,
-				dao:              d,
-				search:           s,
-				processSemaphore: semaphore.NewWeighted(2),
-				persistSemaphore: semaphore.NewWeighted(1),
+				classifier:         c,
+				shadowEvaluator:    se,
+				dao:                d,
+				search:             s,
+				takedownManager:    tm,
+				blocklistManager:   bm,
+				spamDetector:       p.Spam,
+				wantedManager:      wm,
+				savedSearchManager: ssm,
+				servarrScheduler:   sp,
+				watchlistManager:   wl,
+				eventBus:           eb,
+				pipelines:          pl,
+				batchSize:          max(int(p.Config.BatchSize), 1),
+				traces:             p.Config.ClassificationTraces,
+				processLimiter:     limiter,
+				persistSemaphore:   semaphore.NewWeighted(1),
 			}, nil
 		}),
 	}
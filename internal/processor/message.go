@@ -20,7 +20,38 @@ const (
 	ClassifyModeSkipUnmatched
 )
 
+// MessagePriority determines which queue a message is published to;
+// the queue server weights the queues so that higher priority messages aren't stuck behind a large backlog.
+type MessagePriority int
+
+const (
+	// MessagePriorityDefault is used for newly discovered torrents, e.g. from the DHT crawler
+	MessagePriorityDefault MessagePriority = iota
+	// MessagePriorityBulk is used for large volumes of work such as imports and full reprocessing
+	MessagePriorityBulk
+	// MessagePriorityInteractive is used for work triggered by a user, which should be processed as soon as possible
+	MessagePriorityInteractive
+)
+
+const (
+	QueueNameBulk        = MessageName + "_bulk"
+	QueueNameInteractive = MessageName + "_interactive"
+)
+
+// QueueName returns the name of the queue for messages of this priority.
+func (p MessagePriority) QueueName() string {
+	switch p {
+	case MessagePriorityBulk:
+		return QueueNameBulk
+	case MessagePriorityInteractive:
+		return QueueNameInteractive
+	default:
+		return MessageName
+	}
+}
+
 type MessageParams struct {
 	ClassifyMode ClassifyMode
 	InfoHashes   []protocol.ID
+	Priority     MessagePriority `json:",omitempty"`
 }
@@ -5,20 +5,39 @@ import (
 	"database/sql/driver"
 	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
 	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"github.com/bitmagnet-io/bitmagnet/internal/telemetry/tracing"
+	"go.opentelemetry.io/otel/attribute"
 	"gorm.io/gorm/clause"
 )
 
-func (c processor) Persist(ctx context.Context, torrentContents ...model.TorrentContent) error {
+func (c processor) Persist(ctx context.Context, torrentContents ...model.TorrentContent) (err error) {
 	if len(torrentContents) == 0 {
 		return nil
 	}
+	ctx, span := tracer.Start(ctx, "processor.persist")
+	span.SetAttributes(attribute.Int("torrent_contents", len(torrentContents)))
+	defer func() {
+		tracing.End(span, err)
+	}()
+	return c.persist(ctx, torrentContents)
+}
+
+func (c processor) persist(ctx context.Context, torrentContents []model.TorrentContent) error {
 	contentsMap := make(map[model.ContentRef]struct{}, len(torrentContents))
 	contentsPtr := make([]*model.Content, 0, len(torrentContents))
 	torrentContentsPtr := make([]*model.TorrentContent, 0, len(torrentContents))
 	deleteHashes := make([]driver.Valuer, 0, len(torrentContents))
+	var classifiedHashes, unclassifiedHashes []protocol.ID
 	for _, tc := range torrentContents {
 		tcCopy := tc
 		deleteHashes = append(deleteHashes, tcCopy.InfoHash)
+		// torrents skipped by the classifier have no version, so aren't counted as a failed attempt
+		if tcCopy.ContentType.Valid {
+			classifiedHashes = append(classifiedHashes, tcCopy.InfoHash)
+		} else if tcCopy.ClassifierVersion > 0 {
+			unclassifiedHashes = append(unclassifiedHashes, tcCopy.InfoHash)
+		}
 		tcCopy.Torrent = model.Torrent{}
 		if tcCopy.ContentID.Valid {
 			contentRef := tcCopy.Content.Ref()
@@ -28,6 +47,21 @@ func (c processor) Persist(ctx context.Context, torrentContents ...model.Torrent
 				contentsPtr = append(contentsPtr, &contentCopy)
 			}
 		}
+		// the contents of files are persisted with the contents of torrents, but are only referenced by the torrent content
+		fileContents := make(model.TorrentFileContents, 0, len(tcCopy.FileContents))
+		for _, fc := range tcCopy.FileContents {
+			if fc.Content != nil {
+				if _, ok := contentsMap[fc.ContentRef()]; !ok {
+					contentsMap[fc.ContentRef()] = struct{}{}
+					contentsPtr = append(contentsPtr, fc.Content)
+				}
+			}
+			fc.Content = nil
+			fileContents = append(fileContents, fc)
+		}
+		if len(fileContents) > 0 {
+			tcCopy.FileContents = fileContents
+		}
 		tcCopy.Content = model.Content{}
 		torrentContentsPtr = append(torrentContentsPtr, &tcCopy)
 	}
@@ -50,10 +84,13 @@ func (c processor) Persist(ctx context.Context, torrentContents ...model.Torrent
 		).Delete(); deleteErr != nil {
 			return deleteErr
 		}
-		return tx.TorrentContent.WithContext(ctx).Clauses(
+		if createErr := tx.TorrentContent.WithContext(ctx).Clauses(
 			clause.OnConflict{
 				DoNothing: true,
 			},
-		).CreateInBatches(torrentContentsPtr, 20)
+		).CreateInBatches(torrentContentsPtr, 20); createErr != nil {
+			return createErr
+		}
+		return tx.RecordClassificationAttempts(ctx, classifiedHashes, unclassifiedHashes)
 	})
 }
@@ -0,0 +1,65 @@
+package processor
+
+import (
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+)
+
+// Stage is an optional stage of processing that can be enabled for each content type.
+type Stage string
+
+const (
+	// StageLookup matches torrents against metadata sources such as TMDB.
+	StageLookup Stage = "lookup"
+	// StagePersist saves the classification of torrents; torrents without this stage are left unclassified
+	// and so won't appear in search results.
+	StagePersist Stage = "persist"
+)
+
+const (
+	// PipelineDefault is the pipeline used for content types that don't have a pipeline configured.
+	PipelineDefault = "default"
+	// PipelineUnknown is the pipeline used for torrents whose content type couldn't be determined.
+	PipelineUnknown = "unknown"
+)
+
+type pipelines map[string]map[Stage]struct{}
+
+func newPipelines(config map[string][]string) (pipelines, error) {
+	p := make(pipelines, len(config))
+	for key, stages := range config {
+		if key != PipelineDefault && key != PipelineUnknown {
+			if _, err := model.ParseContentType(key); err != nil {
+				return nil, fmt.Errorf("invalid pipeline %q: %w", key, err)
+			}
+		}
+		stageSet := make(map[Stage]struct{}, len(stages))
+		for _, s := range stages {
+			switch Stage(s) {
+			case StageLookup, StagePersist:
+				stageSet[Stage(s)] = struct{}{}
+			default:
+				return nil, fmt.Errorf("invalid stage %q in pipeline %q", s, key)
+			}
+		}
+		p[key] = stageSet
+	}
+	if _, ok := p[PipelineDefault]; !ok {
+		p[PipelineDefault] = map[Stage]struct{}{StageLookup: {}, StagePersist: {}}
+	}
+	return p, nil
+}
+
+// has returns true if the pipeline for the content type includes the stage.
+func (p pipelines) has(ct model.NullContentType, stage Stage) bool {
+	key := PipelineUnknown
+	if ct.Valid {
+		key = ct.ContentType.String()
+	}
+	stages, ok := p[key]
+	if !ok {
+		stages = p[PipelineDefault]
+	}
+	_, ok = stages[stage]
+	return ok
+}
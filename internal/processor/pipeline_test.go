@@ -0,0 +1,30 @@
+package processor
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestPipelines(t *testing.T) {
+	t.Parallel()
+
+	p, err := newPipelines(map[string][]string{
+		"xxx":     {},
+		"tv_show": {"persist"},
+		"unknown": {"persist"},
+	})
+	assert.NoError(t, err)
+
+	assert.True(t, p.has(model.NewNullContentType(model.ContentTypeMovie), StageLookup), "the default pipeline should include every stage")
+	assert.True(t, p.has(model.NewNullContentType(model.ContentTypeMovie), StagePersist))
+	assert.False(t, p.has(model.NewNullContentType(model.ContentTypeXxx), StagePersist))
+	assert.False(t, p.has(model.NewNullContentType(model.ContentTypeTvShow), StageLookup))
+	assert.True(t, p.has(model.NewNullContentType(model.ContentTypeTvShow), StagePersist))
+	assert.True(t, p.has(model.NullContentType{}, StagePersist))
+
+	_, err = newPipelines(map[string][]string{"films": {}})
+	assert.Error(t, err)
+	_, err = newPipelines(map[string][]string{"movie": {"classify"}})
+	assert.Error(t, err)
+}
@@ -2,28 +2,56 @@ package processor
 
 import (
 	"context"
+	"database/sql/driver"
 	"errors"
 	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/blocklist"
 	"github.com/bitmagnet-io/bitmagnet/internal/classifier"
+	"github.com/bitmagnet-io/bitmagnet/internal/classifier/shadow"
+	"github.com/bitmagnet-io/bitmagnet/internal/concurrency"
 	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
 	"github.com/bitmagnet-io/bitmagnet/internal/database/query"
 	"github.com/bitmagnet-io/bitmagnet/internal/database/search"
+	"github.com/bitmagnet-io/bitmagnet/internal/events"
 	"github.com/bitmagnet-io/bitmagnet/internal/model"
 	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"github.com/bitmagnet-io/bitmagnet/internal/savedsearch"
+	"github.com/bitmagnet-io/bitmagnet/internal/servarr"
+	"github.com/bitmagnet-io/bitmagnet/internal/spam"
+	"github.com/bitmagnet-io/bitmagnet/internal/takedown"
+	"github.com/bitmagnet-io/bitmagnet/internal/telemetry/tracing"
+	"github.com/bitmagnet-io/bitmagnet/internal/wanted"
+	"github.com/bitmagnet-io/bitmagnet/internal/watchlist"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
 	"golang.org/x/sync/semaphore"
 	"gorm.io/gen/field"
 )
 
+var tracer = otel.Tracer("github.com/bitmagnet-io/bitmagnet/internal/processor")
+
 type Processor interface {
 	Process(ctx context.Context, params MessageParams) error
 }
 
 type processor struct {
-	search           search.Search
-	classifier       classifier.Classifier
-	dao              *dao.Query
-	processSemaphore *semaphore.Weighted
-	persistSemaphore *semaphore.Weighted
+	search             search.Search
+	classifier         classifier.Classifier
+	shadowEvaluator    shadow.Evaluator
+	dao                *dao.Query
+	takedownManager    takedown.Manager
+	blocklistManager   blocklist.Manager
+	spamDetector       spam.Detector
+	wantedManager      wanted.Manager
+	savedSearchManager savedsearch.Manager
+	watchlistManager   watchlist.Manager
+	servarrScheduler   servarr.Scheduler
+	eventBus           events.Bus
+	pipelines          pipelines
+	batchSize          int
+	traces             bool
+	processLimiter     concurrency.AdjustableLimiter
+	persistSemaphore   *semaphore.Weighted
 }
 
 type MissingHashesError struct {
@@ -35,10 +63,37 @@ func (e MissingHashesError) Error() string {
 }
 
 func (c processor) Process(ctx context.Context, params MessageParams) error {
-	if err := c.processSemaphore.Acquire(ctx, 1); err != nil {
+	var errs []error
+	for i := 0; i < len(params.InfoHashes); i += c.batchSize {
+		batchParams := params
+		batchParams.InfoHashes = params.InfoHashes[i:min(i+c.batchSize, len(params.InfoHashes))]
+		if err := c.processBatch(ctx, batchParams); err != nil {
+			errs = append(errs, err)
+			if ctx.Err() != nil {
+				break
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (c processor) processBatch(ctx context.Context, params MessageParams) (err error) {
+	ctx, span := tracer.Start(ctx, "processor.batch")
+	span.SetAttributes(attribute.Int("torrents", len(params.InfoHashes)))
+	defer func() {
+		tracing.End(span, err)
+	}()
+	// time spent waiting for a slot is recorded as an event, as it's where latency goes when the backlog grows
+	if err := c.processLimiter.Acquire(ctx); err != nil {
 		return err
 	}
-	defer c.processSemaphore.Release(1)
+	span.AddEvent("acquired process limiter")
+	defer c.processLimiter.Release()
+	// torrents in the batch that probably refer to the same content will share the classifier's provider lookups
+	ctx = classifier.WithBatch(ctx)
+	ctx = classifier.WithLookupFilter(ctx, func(ct model.ContentType) bool {
+		return c.pipelines.has(model.NewNullContentType(ct), StageLookup)
+	})
 	searchResult, searchErr := c.search.TorrentsWithMissingInfoHashes(
 		ctx,
 		params.InfoHashes,
@@ -55,8 +110,13 @@ func (c processor) Process(ctx context.Context, params MessageParams) error {
 	}
 	var errs []error
 	tcs := make([]model.TorrentContent, 0, len(searchResult.Torrents))
+	traces := make(map[protocol.ID]model.ClassificationTrace)
+	classifications := make(map[protocol.ID]classifier.Classification)
+	// torrents classified for the first time, rather than reprocessed, are new releases
+	newlyClassified := make(map[protocol.ID]struct{})
+	var unpersistedHashes []driver.Valuer
 	for _, torrent := range searchResult.Torrents {
-		if params.ClassifyMode != ClassifyModeRematch && !torrent.Hint.ContentSource.Valid {
+		if params.ClassifyMode != ClassifyModeRematch && !torrent.Hint.ContentSource.Valid && !torrent.Hint.Override {
 			for _, tc := range torrent.Contents {
 				if tc.ContentType.Valid &&
 					tc.ContentSource.Valid &&
@@ -69,19 +129,110 @@ func (c processor) Process(ctx context.Context, params MessageParams) error {
 			}
 		}
 		useClassifier := c.classifier
-		if params.ClassifyMode == ClassifyModeSkipUnmatched && torrent.Hint.IsNil() {
+		skipped := params.ClassifyMode == ClassifyModeSkipUnmatched && torrent.Hint.IsNil()
+		if skipped {
 			useClassifier = classifier.FallbackClassifier{}
 		}
-		classification, classifyErr := useClassifier.Classify(ctx, torrent)
+		classifyCtx := ctx
+		var trace *classifier.Trace
+		if c.traces && !skipped {
+			classifyCtx, trace = classifier.WithTrace(ctx)
+		}
+		classification, classifyErr := useClassifier.Classify(classifyCtx, torrent)
 		if classifyErr != nil {
 			errs = append(errs, classifyErr)
 			continue
 		}
+		if !c.pipelines.has(classification.ContentType, StagePersist) {
+			unpersistedHashes = append(unpersistedHashes, torrent.InfoHash)
+			continue
+		}
 		torrentContent := newTorrentContent(torrent, classification)
+		torrentContent.SpamReasons = c.spamDetector.Detect(torrentContent)
+		torrentContent.UpdateQuarantined()
+		// skipped torrents keep no version, so that they're still found by a reprocess of outdated torrents
+		if !skipped {
+			torrentContent.ClassifierVersion = classifier.Version
+		}
+		if trace != nil {
+			classificationTrace := trace.ClassificationTrace(torrent.InfoHash)
+			classificationTrace.MatchConfidence = classification.MatchConfidence
+			traces[torrent.InfoHash] = classificationTrace
+		}
+		if !skipped {
+			classifications[torrent.InfoHash] = classification
+		}
+		if params.ClassifyMode != ClassifyModeRematch && len(torrent.Contents) == 0 {
+			newlyClassified[torrent.InfoHash] = struct{}{}
+		}
 		tcs = append(tcs, torrentContent)
 	}
-	if resolveErr := c.Persist(ctx, tcs...); resolveErr != nil {
+	// torrents classified as content on the takedown list, or with a blocked name, are removed instead of persisted
+	enforcedTcs, enforceErr := c.takedownManager.Enforce(ctx, tcs)
+	if enforceErr == nil {
+		enforcedTcs, enforceErr = c.blocklistManager.Enforce(ctx, enforcedTcs)
+	}
+	if enforceErr != nil {
+		errs = append(errs, enforceErr)
+	} else if resolveErr := c.Persist(ctx, enforcedTcs...); resolveErr != nil {
 		errs = append(errs, resolveErr)
+	} else {
+		classifiedEvents := make([]events.Event, 0, len(enforcedTcs))
+		for _, tc := range enforcedTcs {
+			classifiedEvents = append(classifiedEvents, events.NewClassifiedEvent(tc))
+		}
+		c.eventBus.Publish(ctx, classifiedEvents...)
+		// traces are only kept for persisted torrents, as the others may have been removed
+		persistedTraces := make([]model.ClassificationTrace, 0, len(enforcedTcs))
+		for _, tc := range enforcedTcs {
+			if trace, ok := traces[tc.InfoHash]; ok {
+				persistedTraces = append(persistedTraces, trace)
+			}
+		}
+		if traceErr := c.dao.RecordClassificationTraces(ctx, persistedTraces); traceErr != nil {
+			errs = append(errs, traceErr)
+		}
+		evaluations := make([]shadow.Evaluation, 0, len(enforcedTcs))
+		for _, tc := range enforcedTcs {
+			if classification, ok := classifications[tc.InfoHash]; ok {
+				evaluations = append(evaluations, shadow.Evaluation{Torrent: tc.Torrent, Classification: classification})
+			}
+		}
+		c.shadowEvaluator.Evaluate(ctx, evaluations...)
+		// quarantined torrents are kept for review, but aren't used to fulfil wanted content or sent anywhere
+		releasedTcs := make([]model.TorrentContent, 0, len(enforcedTcs))
+		for _, tc := range enforcedTcs {
+			if !tc.Quarantined {
+				releasedTcs = append(releasedTcs, tc)
+			}
+		}
+		if fulfillErr := c.wantedManager.Fulfill(ctx, releasedTcs); fulfillErr != nil {
+			errs = append(errs, fulfillErr)
+		}
+		if evaluateErr := c.savedSearchManager.Evaluate(ctx, releasedTcs); evaluateErr != nil {
+			errs = append(errs, evaluateErr)
+		}
+		if alertErr := c.watchlistManager.Alert(ctx, releasedTcs); alertErr != nil {
+			errs = append(errs, alertErr)
+		}
+		// only new releases are pushed, so that a reprocess doesn't push the whole index again
+		newTcs := make([]model.TorrentContent, 0, len(releasedTcs))
+		for _, tc := range releasedTcs {
+			if _, ok := newlyClassified[tc.InfoHash]; ok {
+				newTcs = append(newTcs, tc)
+			}
+		}
+		if pushErr := c.servarrScheduler.Schedule(ctx, newTcs); pushErr != nil {
+			errs = append(errs, pushErr)
+		}
+	}
+	// any previous classification of torrents that are no longer persisted is removed
+	if len(unpersistedHashes) > 0 {
+		if _, deleteErr := c.dao.TorrentContent.WithContext(ctx).Where(
+			c.dao.TorrentContent.InfoHash.In(unpersistedHashes...),
+		).Delete(); deleteErr != nil {
+			errs = append(errs, deleteErr)
+		}
 	}
 	if len(searchResult.MissingInfoHashes) > 0 {
 		errs = append(errs, MissingHashesError{
@@ -93,17 +244,33 @@ func (c processor) Process(ctx context.Context, params MessageParams) error {
 
 func newTorrentContent(t model.Torrent, c classifier.Classification) model.TorrentContent {
 	tc := model.TorrentContent{
-		Torrent:         t,
-		InfoHash:        t.InfoHash,
-		ContentType:     c.ContentType,
-		Languages:       c.Languages,
-		Episodes:        c.Episodes,
-		VideoResolution: c.VideoResolution,
-		VideoSource:     c.VideoSource,
-		VideoCodec:      c.VideoCodec,
-		Video3d:         c.Video3d,
-		VideoModifier:   c.VideoModifier,
-		ReleaseGroup:    c.ReleaseGroup,
+		Torrent:           t,
+		InfoHash:          t.InfoHash,
+		ContentType:       c.ContentType,
+		Languages:         c.Languages,
+		MultiAudio:        c.LanguageMulti,
+		SubtitleLanguages: c.Subtitles,
+		Subtitled:         c.Subtitled,
+		Episodes:          c.Episodes,
+		AirDate:           c.AirDate,
+		SportEvent:        c.SportEvent,
+		VideoResolution:   c.VideoResolution,
+		VideoSource:       c.VideoSource,
+		VideoCodec:        c.VideoCodec,
+		Video3d:           c.Video3d,
+		VideoModifier:     c.VideoModifier,
+		HdrFormats:        c.HdrFormats,
+		AudioFormats:      c.AudioFormats,
+		ReleaseGroup:      c.ReleaseGroup,
+		ReleaseTokens:     c.ReleaseTokens,
+		ProbablyFake:      c.ProbablyFake,
+	}
+	// a quarantine override set by a review is kept when the torrent is reprocessed
+	for _, existing := range t.Contents {
+		if existing.QuarantineOverride.Valid {
+			tc.QuarantineOverride = existing.QuarantineOverride
+			break
+		}
 	}
 	if c.Content != nil {
 		content := *c.Content
@@ -112,6 +279,15 @@ func newTorrentContent(t model.Torrent, c classifier.Classification) model.Torre
 		tc.ContentSource = model.NewNullString(content.Source)
 		tc.ContentID = model.NewNullString(content.ID)
 		tc.Content = content
+		tc.MatchConfidence = c.MatchConfidence
+	}
+	for _, fc := range c.FileContents {
+		if fc.Content != nil {
+			content := *fc.Content
+			content.UpdateTsv()
+			fc.Content = &content
+		}
+		tc.FileContents = append(tc.FileContents, fc)
 	}
 	tc.UpdateTsv()
 	return tc
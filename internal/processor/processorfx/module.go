@@ -1,23 +1,31 @@
 package processorfx
 
 import (
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/config/configfx"
 	"github.com/bitmagnet-io/bitmagnet/internal/processor"
 	"github.com/bitmagnet-io/bitmagnet/internal/processor/asynq/consumer"
 	"github.com/bitmagnet-io/bitmagnet/internal/processor/asynq/decorator"
 	"github.com/bitmagnet-io/bitmagnet/internal/processor/asynq/producer"
 	"github.com/bitmagnet-io/bitmagnet/internal/processor/asynq/publisher"
+	"github.com/bitmagnet-io/bitmagnet/internal/processor/asynq/purger"
+	"github.com/bitmagnet-io/bitmagnet/internal/processor/asynq/tuner"
+	"github.com/bitmagnet-io/bitmagnet/internal/processor/reprocess"
 	"go.uber.org/fx"
 )
 
 func New() fx.Option {
 	return fx.Module(
 		"processor",
+		configfx.NewConfigModule[processor.Config]("processor", processor.NewDefaultConfig()),
 		fx.Provide(
 			processor.New,
 			consumer.New,
 			decorator.New,
 			producer.New,
 			publisher.New,
+			purger.New,
+			tuner.New,
+			reprocess.New,
 		),
 	)
 }
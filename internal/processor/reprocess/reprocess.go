@@ -0,0 +1,136 @@
+package reprocess
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/processor"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"github.com/bitmagnet-io/bitmagnet/internal/queue/publisher"
+	"github.com/bitmagnet-io/bitmagnet/internal/taskrun"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"sync"
+)
+
+const batchSize = 100
+
+type Request struct {
+	Filter       dao.TorrentFilter
+	ClassifyMode processor.ClassifyMode
+}
+
+type Job struct {
+	// TaskRunID is the ID of the task run recording the progress of the job, or 0 if it couldn't be recorded.
+	TaskRunID  int64
+	TotalCount int64
+}
+
+// Manager queues the torrents matching a filter for reprocessing.
+type Manager interface {
+	// Start counts the torrents matching the filter and queues them for reprocessing at bulk priority in the background;
+	// the progress of the job is recorded as a task run.
+	Start(ctx context.Context, r Request) (Job, error)
+}
+
+type Params struct {
+	fx.In
+	Dao                lazy.Lazy[*dao.Query]
+	ProcessorPublisher lazy.Lazy[publisher.Publisher[processor.MessageParams]]
+	TaskRunRecorder    lazy.Lazy[taskrun.Recorder]
+	Logger             *zap.SugaredLogger
+}
+
+type Result struct {
+	fx.Out
+	Manager lazy.Lazy[Manager]
+	AppHook fx.Hook `group:"app_hooks"`
+}
+
+func New(p Params) Result {
+	// jobs outlive the requests that start them, and are cancelled on shutdown
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+	lm := lazy.New(func() (Manager, error) {
+		d, err := p.Dao.Get()
+		if err != nil {
+			return nil, err
+		}
+		pub, err := p.ProcessorPublisher.Get()
+		if err != nil {
+			return nil, err
+		}
+		tr, err := p.TaskRunRecorder.Get()
+		if err != nil {
+			return nil, err
+		}
+		return &manager{
+			ctx:       ctx,
+			wg:        wg,
+			dao:       d,
+			publisher: pub,
+			recorder:  tr,
+			logger:    p.Logger.Named("reprocess"),
+		}, nil
+	})
+	return Result{
+		Manager: lm,
+		AppHook: fx.Hook{
+			OnStop: func(context.Context) error {
+				cancel()
+				wg.Wait()
+				return nil
+			},
+		},
+	}
+}
+
+type manager struct {
+	ctx       context.Context
+	wg        *sync.WaitGroup
+	dao       *dao.Query
+	publisher publisher.Publisher[processor.MessageParams]
+	recorder  taskrun.Recorder
+	logger    *zap.SugaredLogger
+}
+
+func (m *manager) Start(ctx context.Context, r Request) (Job, error) {
+	total, err := m.dao.CountFilteredTorrents(ctx, r.Filter)
+	if err != nil {
+		return Job{}, err
+	}
+	run := m.recorder.Start(ctx, taskrun.KindReprocess)
+	run.SetTotal(total)
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		err := m.run(r, run)
+		if err != nil {
+			m.logger.Errorw("error queueing torrents for reprocessing", "taskRunId", run.ID(), "error", err)
+		}
+		run.Finish(err)
+	}()
+	return Job{
+		TaskRunID:  run.ID(),
+		TotalCount: total,
+	}, nil
+}
+
+func (m *manager) run(r Request, run taskrun.Run) error {
+	var after *protocol.ID
+	for {
+		infoHashes, err := m.dao.FindFilteredTorrentHashesAfter(m.ctx, r.Filter, after, batchSize)
+		if err != nil || len(infoHashes) == 0 {
+			return err
+		}
+		if _, err := m.publisher.Publish(m.ctx, processor.MessageParams{
+			ClassifyMode: r.ClassifyMode,
+			InfoHashes:   infoHashes,
+			Priority:     processor.MessagePriorityBulk,
+		}); err != nil {
+			return err
+		}
+		run.Add(len(infoHashes))
+		after = &infoHashes[len(infoHashes)-1]
+	}
+}
@@ -0,0 +1,37 @@
+package responder
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol/dht"
+	"net/netip"
+	"time"
+)
+
+// AnnouncedHash is an info hash announced to the DHT server, along with the node that announced it.
+type AnnouncedHash struct {
+	InfoHash protocol.ID
+	Node     netip.AddrPort
+}
+
+// responderAnnouncedHashes attempts to add info hashes from successful announce_peer requests to the announced hashes channel.
+type responderAnnouncedHashes struct {
+	responder       Responder
+	announcedHashes chan<- AnnouncedHash
+}
+
+func (r responderAnnouncedHashes) Respond(ctx context.Context, msg dht.RecvMsg) (dht.Return, error) {
+	ret, err := r.responder.Respond(ctx, msg)
+	if err == nil && msg.Msg.Q == dht.QAnnouncePeer && msg.Msg.A != nil {
+		go func() {
+			// wait for up to a second
+			cancelCtx, cancel := context.WithTimeout(ctx, time.Second)
+			defer cancel()
+			select {
+			case <-cancelCtx.Done():
+			case r.announcedHashes <- AnnouncedHash{InfoHash: msg.Msg.A.InfoHash, Node: msg.From}:
+			}
+		}()
+	}
+	return ret, err
+}
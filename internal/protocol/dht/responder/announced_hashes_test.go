@@ -0,0 +1,69 @@
+package responder
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol/dht"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestResponderAnnouncedHashes(t *testing.T) {
+	mocks := newTestResponderMocks(t)
+	announcedHashes := make(chan AnnouncedHash, 1)
+	r := responderAnnouncedHashes{
+		responder:       mocks.responder,
+		announcedHashes: announcedHashes,
+	}
+	infoHash := protocol.RandomNodeID()
+	from := mocks.sender.Addr.ToAddrPort()
+	msg := dht.RecvMsg{
+		From: from,
+		Msg: dht.Msg{
+			Q: dht.QAnnouncePeer,
+			A: &dht.MsgArgs{
+				ID:       mocks.sender.ID,
+				InfoHash: infoHash,
+				Token:    mocks.responder.announceToken(infoHash, mocks.sender.ID, from.Addr()),
+			},
+		},
+	}
+	mocks.table.On("BatchCommand", mock.Anything).Return()
+	_, err := r.Respond(context.Background(), msg)
+	assert.NoError(t, err)
+	select {
+	case a := <-announcedHashes:
+		assert.Equal(t, AnnouncedHash{InfoHash: infoHash, Node: from}, a)
+	case <-time.After(time.Second):
+		t.Fatal("announced hash was not forwarded")
+	}
+}
+
+func TestResponderAnnouncedHashes__invalid_token(t *testing.T) {
+	mocks := newTestResponderMocks(t)
+	announcedHashes := make(chan AnnouncedHash, 1)
+	r := responderAnnouncedHashes{
+		responder:       mocks.responder,
+		announcedHashes: announcedHashes,
+	}
+	msg := dht.RecvMsg{
+		From: mocks.sender.Addr.ToAddrPort(),
+		Msg: dht.Msg{
+			Q: dht.QAnnouncePeer,
+			A: &dht.MsgArgs{
+				ID:       mocks.sender.ID,
+				InfoHash: protocol.RandomNodeID(),
+				Token:    "invalid",
+			},
+		},
+	}
+	_, err := r.Respond(context.Background(), msg)
+	assert.Equal(t, ErrInvalidToken, err)
+	select {
+	case <-announcedHashes:
+		t.Fatal("hash with an invalid token was forwarded")
+	case <-time.After(10 * time.Millisecond):
+	}
+}
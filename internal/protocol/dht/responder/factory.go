@@ -15,7 +15,8 @@ import (
 type Params struct {
 	fx.In
 	KTable          ktable.Table
-	DiscoveredNodes concurrency.BatchingChannel[ktable.Node] `name:"dht_discovered_nodes"`
+	DiscoveredNodes concurrency.BatchingChannel[ktable.Node]   `name:"dht_discovered_nodes"`
+	AnnouncedHashes concurrency.BatchingChannel[AnnouncedHash] `name:"dht_announced_hashes"`
 	Logger          *zap.SugaredLogger
 }
 
@@ -43,11 +44,14 @@ func New(p Params) Result {
 	})
 	return Result{
 		Responder: responderNodeDiscovery{
-			responder: responderLogger{
-				responder: collector,
-				logger: p.Logger.WithOptions(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
-					return zapcore.NewSamplerWithOptions(core, time.Minute, 10, 0)
-				})).Named(subsystem),
+			responder: responderAnnouncedHashes{
+				responder: responderLogger{
+					responder: collector,
+					logger: p.Logger.WithOptions(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+						return zapcore.NewSamplerWithOptions(core, time.Minute, 10, 0)
+					})).Named(subsystem),
+				},
+				announcedHashes: p.AnnouncedHashes.In(),
 			},
 			discoveredNodes: p.DiscoveredNodes.In(),
 		},
@@ -0,0 +1,56 @@
+package metainforequester
+
+import (
+	"encoding/binary"
+	"github.com/anacrolix/torrent/bencode"
+	"net/netip"
+)
+
+// maxPexPeers limits the number of peers harvested from a single connection
+const maxPexPeers = 200
+
+// https://www.bittorrent.org/beps/bep_0011.html
+type pexMessage struct {
+	Added []byte `bencode:"added"`
+}
+
+// parsePexMessage returns the peers added in a ut_pex message payload.
+// Only IPv4 peers are returned, as the requester only connects over IPv4.
+func parsePexMessage(payload []byte) ([]netip.AddrPort, error) {
+	msg := new(pexMessage)
+	if err := bencode.Unmarshal(payload, msg); err != nil {
+		return nil, err
+	}
+	peers := make([]netip.AddrPort, 0, len(msg.Added)/6)
+	for i := 0; i+6 <= len(msg.Added); i += 6 {
+		addr := netip.AddrFrom4([4]byte(msg.Added[i : i+4]))
+		port := binary.BigEndian.Uint16(msg.Added[i+4 : i+6])
+		if port == 0 || !addr.IsGlobalUnicast() || addr.IsPrivate() {
+			continue
+		}
+		peers = append(peers, netip.AddrPortFrom(addr, port))
+	}
+	return peers, nil
+}
+
+// pexPeers collects the peers harvested over a connection
+type pexPeers struct {
+	seen  map[netip.AddrPort]struct{}
+	peers []netip.AddrPort
+}
+
+func (p *pexPeers) add(peers ...netip.AddrPort) {
+	if p.seen == nil {
+		p.seen = make(map[netip.AddrPort]struct{})
+	}
+	for _, peer := range peers {
+		if len(p.peers) >= maxPexPeers {
+			return
+		}
+		if _, ok := p.seen[peer]; ok {
+			continue
+		}
+		p.seen[peer] = struct{}{}
+		p.peers = append(p.peers, peer)
+	}
+}
@@ -0,0 +1,59 @@
+package metainforequester
+
+import (
+	"bytes"
+	"github.com/anacrolix/torrent/bencode"
+	"github.com/stretchr/testify/assert"
+	"net/netip"
+	"testing"
+)
+
+func TestParsePexMessage(t *testing.T) {
+	t.Parallel()
+
+	payload, err := bencode.Marshal(map[string]interface{}{
+		"added": string([]byte{
+			1, 2, 3, 4, 0x1a, 0xe1,
+			192, 168, 0, 1, 0x1a, 0xe1, // private, discarded
+			5, 6, 7, 8, 0, 0, // zero port, discarded
+			9, 10, 11, 12, 0x00, 0x50,
+			13, 14, // truncated, discarded
+		}),
+		"added.f": string([]byte{0, 0, 0, 0}),
+		"dropped": "",
+	})
+	assert.NoError(t, err)
+
+	peers, err := parsePexMessage(payload)
+	assert.NoError(t, err)
+	assert.Equal(t, []netip.AddrPort{
+		netip.MustParseAddrPort("1.2.3.4:6881"),
+		netip.MustParseAddrPort("9.10.11.12:80"),
+	}, peers)
+}
+
+func TestReadUmMessageCollectsPex(t *testing.T) {
+	t.Parallel()
+
+	pexPayload, err := bencode.Marshal(map[string]interface{}{
+		"added": string([]byte{1, 2, 3, 4, 0x1a, 0xe1}),
+	})
+	assert.NoError(t, err)
+	umPayload := []byte("d8:msg_typei1e5:piecei0ee")
+
+	var buf bytes.Buffer
+	for _, msg := range [][]byte{
+		append([]byte{20, myUTPex}, pexPayload...),
+		append([]byte{20, myUTPex}, pexPayload...),
+		append([]byte{20, myUTMetadata}, umPayload...),
+	} {
+		buf.Write(uintToBigEndian4(uint(len(msg))))
+		buf.Write(msg)
+	}
+
+	pex := &pexPeers{}
+	msg, err := readUmMessage(&buf, pex)
+	assert.NoError(t, err)
+	assert.Equal(t, append([]byte{20, myUTMetadata}, umPayload...), msg)
+	assert.Equal(t, []netip.AddrPort{netip.MustParseAddrPort("1.2.3.4:6881")}, pex.peers)
+}
@@ -73,6 +73,9 @@ type HandshakeInfo struct {
 type Response struct {
 	HandshakeInfo
 	Info metainfo.Info
+	// Peers holds any additional peers for the infohash that were received via peer exchange (ut_pex).
+	// Peers may also be returned alongside an error, if the failure occurred after the extension handshake.
+	Peers []netip.AddrPort
 }
 
 func (r requester) Request(ctx context.Context, infoHash protocol.ID, addr netip.AddrPort) (Response, error) {
@@ -93,20 +96,22 @@ func (r requester) Request(ctx context.Context, infoHash protocol.ID, addr netip
 	if exHandshakeErr != nil {
 		return Response{}, exHandshakeErr
 	}
+	pex := &pexPeers{}
 	if requestAllPiecesErr := requestAllPieces(conn, metadataSize, utMetadata); requestAllPiecesErr != nil {
 		return Response{}, requestAllPiecesErr
 	}
-	pieces, readAllPiecesErr := readAllPieces(conn, metadataSize)
+	pieces, readAllPiecesErr := readAllPieces(conn, metadataSize, pex)
 	if readAllPiecesErr != nil {
-		return Response{}, readAllPiecesErr
+		return Response{HandshakeInfo: hsInfo, Peers: pex.peers}, readAllPiecesErr
 	}
 	parsed, parseErr := metainfo.ParseMetaInfoBytes(infoHash, pieces)
 	if parseErr != nil {
-		return Response{}, parseErr
+		return Response{HandshakeInfo: hsInfo, Peers: pex.peers}, parseErr
 	}
 	return Response{
 		HandshakeInfo: hsInfo,
 		Info:          parsed,
+		Peers:         pex.peers,
 	}, nil
 }
 
@@ -180,10 +185,23 @@ type rootDict struct {
 	MetadataSize int   `bencode:"metadata_size"`
 }
 
+// myRootDict is our extension handshake; unlike the peer's handshake it has no metadata size, as we don't have the metadata
+type myRootDict struct {
+	M mDict `bencode:"m"`
+}
+
 type mDict struct {
 	UTMetadata int `bencode:"ut_metadata"`
+	UTPex      int `bencode:"ut_pex,omitempty"`
 }
 
+// The extension message IDs we advertise in our extension handshake;
+// the peer uses these IDs for the messages it sends us.
+const (
+	myUTMetadata = 1
+	myUTPex      = 2
+)
+
 type extDict struct {
 	MsgType int `bencode:"msg_type"`
 	Piece   int `bencode:"piece"`
@@ -192,7 +210,20 @@ type extDict struct {
 const maxMetadataSize = 10 * 1024 * 1024
 
 func exHandshake(rw io.ReadWriter) (metadataSize uint, utMetadata uint8, err error) {
-	if _, writeErr := rw.Write([]byte("\x00\x00\x00\x1a\x14\x00d1:md11:ut_metadatai1eee")); err != nil {
+	handshakeDump, marshalErr := bencode.Marshal(myRootDict{
+		M: mDict{
+			UTMetadata: myUTMetadata,
+			UTPex:      myUTPex,
+		},
+	})
+	if marshalErr != nil { // ASSERT
+		panic(marshalErr)
+	}
+	if _, writeErr := rw.Write([]byte(fmt.Sprintf(
+		"%s\x14\x00%s",
+		uintToBigEndian4(uint(2+len(handshakeDump))),
+		handshakeDump,
+	))); writeErr != nil {
 		err = writeErr
 		return
 	}
@@ -250,11 +281,11 @@ func uintToBigEndian4(i uint) []byte {
 	return b
 }
 
-func readAllPieces(r io.Reader, metadataSize uint) ([]byte, error) {
+func readAllPieces(r io.Reader, metadataSize uint, pex *pexPeers) ([]byte, error) {
 	metadataBytes := make([]byte, metadataSize)
 	receivedSize := uint(0)
 	for receivedSize < metadataSize {
-		rUmMessage, err := readUmMessage(r)
+		rUmMessage, err := readUmMessage(r, pex)
 		if err != nil {
 			return nil, err
 		}
@@ -315,16 +346,22 @@ func readExMessage(r io.Reader) ([]byte, error) {
 // readUmMessage returns an ut_metadata extension message, sans the first 4 bytes indicating its
 // length.
 //
-// It will IGNORE all non-"ut_metadata extension" messages!
-func readUmMessage(r io.Reader) ([]byte, error) {
+// Any ut_pex messages received in the meantime are added to pex; all other messages are IGNORED!
+func readUmMessage(r io.Reader, pex *pexPeers) ([]byte, error) {
 	for {
 		rExMessage, err := readExMessage(r)
 		if err != nil {
 			return nil, err
 		}
 
-		if rExMessage[1] == 0x01 {
+		switch rExMessage[1] {
+		case myUTMetadata:
 			return rExMessage, nil
+		case myUTPex:
+			// a malformed PEX message shouldn't fail the metadata request
+			if peers, pexErr := parsePexMessage(rExMessage[2:]); pexErr == nil {
+				pex.add(peers...)
+			}
 		}
 	}
 }
@@ -0,0 +1,107 @@
+package tracker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/anacrolix/torrent/bencode"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+)
+
+var ErrNoScrapeUrl = errors.New("tracker does not support scraping")
+
+type httpScraper struct {
+	client *http.Client
+}
+
+func newHttpScraper(timeout time.Duration) httpScraper {
+	return httpScraper{
+		client: &http.Client{
+			Timeout: timeout,
+		},
+	}
+}
+
+type httpScrapeResponse struct {
+	Files         map[string]httpScrapeFile `bencode:"files"`
+	FailureReason string                    `bencode:"failure reason"`
+}
+
+type httpScrapeFile struct {
+	Complete   uint `bencode:"complete"`
+	Downloaded uint `bencode:"downloaded"`
+	Incomplete uint `bencode:"incomplete"`
+}
+
+func (s httpScraper) scrape(ctx context.Context, announceUrl *url.URL, infoHashes []protocol.ID) (map[protocol.ID]ScrapeResult, error) {
+	scrapeUrl, err := ScrapeUrl(announceUrl, infoHashes...)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, scrapeUrl.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", res.StatusCode)
+	}
+	body, err := io.ReadAll(io.LimitReader(res.Body, 1<<20))
+	if err != nil {
+		return nil, err
+	}
+	return parseHttpScrapeResponse(body)
+}
+
+// ScrapeUrl derives the scrape URL from an announce URL, and appends the info_hash parameters, as per BEP 48.
+func ScrapeUrl(announceUrl *url.URL, infoHashes ...protocol.ID) (*url.URL, error) {
+	dir, file := path.Split(announceUrl.Path)
+	if !strings.HasPrefix(file, "announce") {
+		return nil, ErrNoScrapeUrl
+	}
+	u := *announceUrl
+	u.Path = dir + "scrape" + strings.TrimPrefix(file, "announce")
+	rawQuery := u.RawQuery
+	for _, h := range infoHashes {
+		if rawQuery != "" {
+			rawQuery += "&"
+		}
+		rawQuery += "info_hash=" + url.QueryEscape(string(h[:]))
+	}
+	u.RawQuery = rawQuery
+	return &u, nil
+}
+
+func parseHttpScrapeResponse(body []byte) (map[protocol.ID]ScrapeResult, error) {
+	var res httpScrapeResponse
+	if err := bencode.Unmarshal(body, &res); err != nil {
+		return nil, err
+	}
+	if res.FailureReason != "" {
+		return nil, fmt.Errorf("tracker failure: %s", res.FailureReason)
+	}
+	results := make(map[protocol.ID]ScrapeResult, len(res.Files))
+	for k, f := range res.Files {
+		if len(k) != 20 {
+			continue
+		}
+		results[protocol.NewIDFromRawString(k)] = ScrapeResult{
+			Seeders:   f.Complete,
+			Leechers:  f.Incomplete,
+			Completed: f.Downloaded,
+		}
+	}
+	return results, nil
+}
@@ -0,0 +1,60 @@
+package tracker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"net/url"
+	"time"
+)
+
+// ScrapeResult is the swarm information returned by a tracker for a single info hash
+type ScrapeResult struct {
+	Seeders   uint
+	Leechers  uint
+	Completed uint
+}
+
+// Scraper scrapes trackers for swarm information, see https://www.bittorrent.org/beps/bep_0048.html and
+// https://www.bittorrent.org/beps/bep_0015.html
+type Scraper interface {
+	// Scrape requests swarm information for the given hashes; hashes unknown to the tracker will be missing from the result.
+	Scrape(ctx context.Context, trackerUrl string, infoHashes ...protocol.ID) (map[protocol.ID]ScrapeResult, error)
+}
+
+var ErrUnsupportedScheme = errors.New("unsupported tracker url scheme")
+
+// MaxUdpScrapeHashes is the maximum number of hashes that fit into a single UDP scrape packet
+const MaxUdpScrapeHashes = 74
+
+type scraper struct {
+	timeout time.Duration
+	http    httpScraper
+	udp     udpScraper
+}
+
+func NewScraper(timeout time.Duration) Scraper {
+	return scraper{
+		timeout: timeout,
+		http:    newHttpScraper(timeout),
+		udp:     udpScraper{},
+	}
+}
+
+func (s scraper) Scrape(ctx context.Context, trackerUrl string, infoHashes ...protocol.ID) (map[protocol.ID]ScrapeResult, error) {
+	u, err := url.Parse(trackerUrl)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	switch u.Scheme {
+	case "http", "https":
+		return s.http.scrape(ctx, u, infoHashes)
+	case "udp":
+		return s.udp.scrape(ctx, u, infoHashes)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedScheme, u.Scheme)
+	}
+}
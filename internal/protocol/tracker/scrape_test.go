@@ -0,0 +1,54 @@
+package tracker
+
+import (
+	"encoding/binary"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/url"
+	"testing"
+)
+
+func TestScrapeUrl(t *testing.T) {
+	hash := protocol.MustParseID("738a9b2e0a3aaa3b60e15a4c5a3a6b6c1bf1d64f")
+	for _, tc := range []struct {
+		announce string
+		expected string
+	}{
+		{"http://example.com/announce", "http://example.com/scrape?info_hash=s%8A%9B.%0A%3A%AA%3B%60%E1ZLZ%3Akl%1B%F1%D6O"},
+		{"http://example.com/x/announce.php?k=v", "http://example.com/x/scrape.php?k=v&info_hash=s%8A%9B.%0A%3A%AA%3B%60%E1ZLZ%3Akl%1B%F1%D6O"},
+	} {
+		t.Run(tc.announce, func(t *testing.T) {
+			u, err := url.Parse(tc.announce)
+			require.NoError(t, err)
+			scrapeUrl, err := ScrapeUrl(u, hash)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, scrapeUrl.String())
+		})
+	}
+	u, _ := url.Parse("http://example.com/a")
+	_, err := ScrapeUrl(u, hash)
+	assert.ErrorIs(t, err, ErrNoScrapeUrl)
+}
+
+func TestParseHttpScrapeResponse(t *testing.T) {
+	hash := protocol.MustParseID("738a9b2e0a3aaa3b60e15a4c5a3a6b6c1bf1d64f")
+	body := "d5:filesd20:" + string(hash[:]) + "d8:completei5e10:downloadedi50e10:incompletei10eeee"
+	result, err := parseHttpScrapeResponse([]byte(body))
+	require.NoError(t, err)
+	assert.Equal(t, map[protocol.ID]ScrapeResult{
+		hash: {Seeders: 5, Leechers: 10, Completed: 50},
+	}, result)
+}
+
+func TestParseUdpScrapeResponse(t *testing.T) {
+	hashes := []protocol.ID{protocol.RandomNodeID(), protocol.RandomNodeID()}
+	payload := make([]byte, 0, 24)
+	for _, n := range []uint32{1, 2, 3, 4, 5, 6} {
+		payload = binary.BigEndian.AppendUint32(payload, n)
+	}
+	assert.Equal(t, map[protocol.ID]ScrapeResult{
+		hashes[0]: {Seeders: 1, Completed: 2, Leechers: 3},
+		hashes[1]: {Seeders: 4, Completed: 5, Leechers: 6},
+	}, parseUdpScrapeResponse(payload, hashes))
+}
@@ -0,0 +1,124 @@
+package tracker
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"net"
+	"net/url"
+)
+
+// https://www.bittorrent.org/beps/bep_0015.html
+const (
+	udpProtocolID   uint64 = 0x41727101980
+	udpActionConn   uint32 = 0
+	udpActionScrape uint32 = 2
+	udpActionError  uint32 = 3
+)
+
+var ErrTransactionMismatch = errors.New("transaction ID mismatch")
+
+type udpScraper struct{}
+
+func (s udpScraper) scrape(ctx context.Context, trackerUrl *url.URL, infoHashes []protocol.ID) (map[protocol.ID]ScrapeResult, error) {
+	if len(infoHashes) > MaxUdpScrapeHashes {
+		return nil, fmt.Errorf("too many hashes for a UDP scrape: %d", len(infoHashes))
+	}
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "udp", trackerUrl.Host)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+	connectionID, err := s.connect(conn)
+	if err != nil {
+		return nil, err
+	}
+	txID := newTransactionID()
+	req := new(bytes.Buffer)
+	_ = binary.Write(req, binary.BigEndian, connectionID)
+	_ = binary.Write(req, binary.BigEndian, udpActionScrape)
+	_ = binary.Write(req, binary.BigEndian, txID)
+	for _, h := range infoHashes {
+		req.Write(h[:])
+	}
+	res, err := s.roundTrip(conn, req.Bytes(), udpActionScrape, txID)
+	if err != nil {
+		return nil, err
+	}
+	return parseUdpScrapeResponse(res, infoHashes), nil
+}
+
+func (s udpScraper) connect(conn net.Conn) (uint64, error) {
+	txID := newTransactionID()
+	req := new(bytes.Buffer)
+	_ = binary.Write(req, binary.BigEndian, udpProtocolID)
+	_ = binary.Write(req, binary.BigEndian, udpActionConn)
+	_ = binary.Write(req, binary.BigEndian, txID)
+	res, err := s.roundTrip(conn, req.Bytes(), udpActionConn, txID)
+	if err != nil {
+		return 0, err
+	}
+	if len(res) < 8 {
+		return 0, errors.New("short connect response")
+	}
+	return binary.BigEndian.Uint64(res[:8]), nil
+}
+
+// roundTrip sends a request and returns the response payload following the action and transaction ID
+func (s udpScraper) roundTrip(conn net.Conn, req []byte, action uint32, txID uint32) ([]byte, error) {
+	if _, err := conn.Write(req); err != nil {
+		return nil, err
+	}
+	buf := make([]byte, 8+12*MaxUdpScrapeHashes)
+	n, err := conn.Read(buf)
+	if err != nil {
+		return nil, err
+	}
+	if n < 8 {
+		return nil, errors.New("short response")
+	}
+	resAction := binary.BigEndian.Uint32(buf[0:4])
+	if binary.BigEndian.Uint32(buf[4:8]) != txID {
+		return nil, ErrTransactionMismatch
+	}
+	if resAction == udpActionError {
+		return nil, fmt.Errorf("tracker error: %s", string(buf[8:n]))
+	}
+	if resAction != action {
+		return nil, fmt.Errorf("unexpected action: %d", resAction)
+	}
+	return buf[8:n], nil
+}
+
+// parseUdpScrapeResponse parses the seeders, completed, leechers triples, which are in the same order as the requested hashes
+func parseUdpScrapeResponse(payload []byte, infoHashes []protocol.ID) map[protocol.ID]ScrapeResult {
+	results := make(map[protocol.ID]ScrapeResult, len(infoHashes))
+	for i, h := range infoHashes {
+		offset := i * 12
+		if offset+12 > len(payload) {
+			break
+		}
+		results[h] = ScrapeResult{
+			Seeders:   uint(binary.BigEndian.Uint32(payload[offset : offset+4])),
+			Completed: uint(binary.BigEndian.Uint32(payload[offset+4 : offset+8])),
+			Leechers:  uint(binary.BigEndian.Uint32(payload[offset+8 : offset+12])),
+		}
+	}
+	return results
+}
+
+func newTransactionID() uint32 {
+	var b [4]byte
+	_, _ = rand.Read(b[:])
+	return binary.BigEndian.Uint32(b[:])
+}
@@ -1,17 +1,31 @@
 package queue
 
-import "github.com/bitmagnet-io/bitmagnet/internal/processor"
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/processor"
+	"github.com/bitmagnet-io/bitmagnet/internal/servarr"
+	"time"
+)
 
 type Config struct {
 	Concurrency int
-	Queues      map[string]int
+	// Queues maps queue names to their weights; a queue with a higher weight is more likely to be worked first.
+	Queues map[string]int
+	// StrictPriority causes higher weighted queues to always be emptied before lower weighted queues are worked.
+	StrictPriority bool
+	// ShutdownTimeout is how long the server waits on shutdown for tasks in progress to complete,
+	// after which they're returned to the queue.
+	ShutdownTimeout time.Duration `mapstructure:"shutdown_timeout"`
 }
 
 func NewDefaultConfig() Config {
 	return Config{
 		Concurrency: 10,
 		Queues: map[string]int{
-			processor.MessageName: 3,
+			processor.QueueNameInteractive: 6,
+			processor.MessageName:          3,
+			processor.QueueNameBulk:        1,
+			servarr.MessageName:            3,
 		},
+		ShutdownTimeout: 10 * time.Second,
 	}
 }
@@ -0,0 +1,128 @@
+package deadletter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/hibiken/asynq"
+	"go.uber.org/fx"
+	"time"
+)
+
+// Message is a task that failed on every permitted attempt and has been moved to the dead-letter state.
+// Dead-lettered messages are kept until they are requeued, deleted or expire from the archive.
+type Message struct {
+	ID           string
+	Queue        string
+	Type         string
+	Payload      string
+	Retried      int
+	MaxRetry     int
+	Error        string
+	LastFailedAt *time.Time
+}
+
+type ListResult struct {
+	TotalCount int
+	Items      []Message
+}
+
+// Manager provides access to the dead-lettered messages of a queue, which are stored as archived asynq tasks.
+type Manager interface {
+	// List returns a page of dead-lettered messages, most recently failed first; pages start at 1.
+	List(ctx context.Context, queue string, pageSize int, page int) (ListResult, error)
+	// Requeue moves the given dead-lettered messages back to the pending state, returning how many were requeued.
+	Requeue(ctx context.Context, queue string, ids ...string) (int, error)
+	// RequeueAll moves all dead-lettered messages of the queue back to the pending state.
+	RequeueAll(ctx context.Context, queue string) (int, error)
+	// DeleteAll discards all dead-lettered messages of the queue.
+	DeleteAll(ctx context.Context, queue string) (int, error)
+}
+
+type Params struct {
+	fx.In
+	Inspector lazy.Lazy[*asynq.Inspector]
+}
+
+type Result struct {
+	fx.Out
+	Manager lazy.Lazy[Manager]
+}
+
+func New(p Params) Result {
+	return Result{
+		Manager: lazy.New(func() (Manager, error) {
+			i, err := p.Inspector.Get()
+			if err != nil {
+				return nil, err
+			}
+			return manager{i}, nil
+		}),
+	}
+}
+
+type manager struct {
+	inspector *asynq.Inspector
+}
+
+func (m manager) List(_ context.Context, queue string, pageSize int, page int) (ListResult, error) {
+	tasks, err := m.inspector.ListArchivedTasks(queue, asynq.PageSize(pageSize), asynq.Page(page))
+	if err != nil {
+		if errors.Is(err, asynq.ErrQueueNotFound) {
+			return ListResult{}, nil
+		}
+		return ListResult{}, err
+	}
+	info, err := m.inspector.GetQueueInfo(queue)
+	if err != nil {
+		return ListResult{}, err
+	}
+	items := make([]Message, 0, len(tasks))
+	for _, t := range tasks {
+		items = append(items, messageFromTaskInfo(t))
+	}
+	return ListResult{
+		TotalCount: info.Archived,
+		Items:      items,
+	}, nil
+}
+
+func (m manager) Requeue(_ context.Context, queue string, ids ...string) (int, error) {
+	n := 0
+	for _, id := range ids {
+		if err := m.inspector.RunTask(queue, id); err != nil {
+			if errors.Is(err, asynq.ErrTaskNotFound) {
+				continue
+			}
+			return n, fmt.Errorf("failed to requeue message %s: %w", id, err)
+		}
+		n++
+	}
+	return n, nil
+}
+
+func (m manager) RequeueAll(_ context.Context, queue string) (int, error) {
+	return m.inspector.RunAllArchivedTasks(queue)
+}
+
+func (m manager) DeleteAll(_ context.Context, queue string) (int, error) {
+	return m.inspector.DeleteAllArchivedTasks(queue)
+}
+
+func messageFromTaskInfo(t *asynq.TaskInfo) Message {
+	msg := Message{
+		ID:       t.ID,
+		Queue:    t.Queue,
+		Type:     t.Type,
+		Payload:  string(t.Payload),
+		Retried:  t.Retried,
+		MaxRetry: t.MaxRetry,
+		Error:    t.LastErr,
+	}
+	if !t.LastFailedAt.IsZero() {
+		lastFailedAt := t.LastFailedAt
+		msg.LastFailedAt = &lastFailedAt
+	}
+	return msg
+}
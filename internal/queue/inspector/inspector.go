@@ -0,0 +1,26 @@
+package inspector
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/bitmagnet-io/bitmagnet/internal/queue/redis"
+	"github.com/hibiken/asynq"
+	"go.uber.org/fx"
+)
+
+type Params struct {
+	fx.In
+	Redis lazy.Lazy[*redis.Client]
+}
+
+type Result struct {
+	fx.Out
+	Inspector lazy.Lazy[*asynq.Inspector]
+}
+
+func New(p Params) Result {
+	return Result{
+		Inspector: lazy.New(func() (*asynq.Inspector, error) {
+			return asynq.NewInspector(redis.Wrapper{Redis: p.Redis}), nil
+		}),
+	}
+}
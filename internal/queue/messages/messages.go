@@ -0,0 +1,201 @@
+package messages
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/hibiken/asynq"
+	"go.uber.org/fx"
+	"time"
+)
+
+// State is the state of a message in the queue; messages that failed on every permitted attempt are archived,
+// and are also known as dead letters.
+type State string
+
+const (
+	StatePending   State = "pending"
+	StateActive    State = "active"
+	StateScheduled State = "scheduled"
+	StateRetry     State = "retry"
+	StateArchived  State = "archived"
+	StateCompleted State = "completed"
+)
+
+var ErrNotFound = errors.New("message not found")
+
+type Message struct {
+	ID       string
+	Queue    string
+	Type     string
+	State    State
+	Payload  string
+	Retried  int
+	MaxRetry int
+	// LastError is the error of the most recent failed attempt, if any.
+	LastError     *string
+	LastFailedAt  *time.Time
+	NextProcessAt *time.Time
+	CompletedAt   *time.Time
+}
+
+func (m Message) PayloadSize() int {
+	return len(m.Payload)
+}
+
+func (m Message) PayloadSummary() string {
+	return summarizePayload([]byte(m.Payload))
+}
+
+type ListResult struct {
+	TotalCount int
+	Items      []Message
+}
+
+// Manager provides access to the individual messages of a queue in any state.
+type Manager interface {
+	// List returns a page of the messages of a queue in the given state; pages start at 1.
+	List(ctx context.Context, queue string, state State, pageSize int, page int) (ListResult, error)
+	// Get returns a message by ID, or ErrNotFound.
+	Get(ctx context.Context, queue string, id string) (Message, error)
+	// Retry moves the given scheduled, retrying or archived messages to the pending state so that they're processed
+	// immediately, returning how many were moved.
+	Retry(ctx context.Context, queue string, ids ...string) (int, error)
+	// Delete discards the given messages, returning how many were deleted; active messages can't be deleted.
+	Delete(ctx context.Context, queue string, ids ...string) (int, error)
+}
+
+type Params struct {
+	fx.In
+	Inspector lazy.Lazy[*asynq.Inspector]
+}
+
+type Result struct {
+	fx.Out
+	Manager lazy.Lazy[Manager]
+}
+
+func New(p Params) Result {
+	return Result{
+		Manager: lazy.New(func() (Manager, error) {
+			i, err := p.Inspector.Get()
+			if err != nil {
+				return nil, err
+			}
+			return manager{i}, nil
+		}),
+	}
+}
+
+type manager struct {
+	inspector *asynq.Inspector
+}
+
+func (m manager) List(_ context.Context, queue string, state State, pageSize int, page int) (ListResult, error) {
+	var list func(string, ...asynq.ListOption) ([]*asynq.TaskInfo, error)
+	var count func(*asynq.QueueInfo) int
+	switch state {
+	case StatePending:
+		list, count = m.inspector.ListPendingTasks, func(i *asynq.QueueInfo) int { return i.Pending }
+	case StateActive:
+		list, count = m.inspector.ListActiveTasks, func(i *asynq.QueueInfo) int { return i.Active }
+	case StateScheduled:
+		list, count = m.inspector.ListScheduledTasks, func(i *asynq.QueueInfo) int { return i.Scheduled }
+	case StateRetry:
+		list, count = m.inspector.ListRetryTasks, func(i *asynq.QueueInfo) int { return i.Retry }
+	case StateArchived:
+		list, count = m.inspector.ListArchivedTasks, func(i *asynq.QueueInfo) int { return i.Archived }
+	case StateCompleted:
+		list, count = m.inspector.ListCompletedTasks, func(i *asynq.QueueInfo) int { return i.Completed }
+	default:
+		return ListResult{}, fmt.Errorf("invalid message state: %s", state)
+	}
+	tasks, err := list(queue, asynq.PageSize(pageSize), asynq.Page(page))
+	if err != nil {
+		if errors.Is(err, asynq.ErrQueueNotFound) {
+			return ListResult{}, nil
+		}
+		return ListResult{}, err
+	}
+	info, err := m.inspector.GetQueueInfo(queue)
+	if err != nil {
+		return ListResult{}, err
+	}
+	items := make([]Message, 0, len(tasks))
+	for _, t := range tasks {
+		items = append(items, messageFromTaskInfo(t))
+	}
+	return ListResult{
+		TotalCount: count(info),
+		Items:      items,
+	}, nil
+}
+
+func (m manager) Get(_ context.Context, queue string, id string) (Message, error) {
+	t, err := m.inspector.GetTaskInfo(queue, id)
+	if err != nil {
+		if errors.Is(err, asynq.ErrQueueNotFound) || errors.Is(err, asynq.ErrTaskNotFound) {
+			return Message{}, ErrNotFound
+		}
+		return Message{}, err
+	}
+	return messageFromTaskInfo(t), nil
+}
+
+func (m manager) Retry(_ context.Context, queue string, ids ...string) (int, error) {
+	n := 0
+	for _, id := range ids {
+		if err := m.inspector.RunTask(queue, id); err != nil {
+			if errors.Is(err, asynq.ErrTaskNotFound) {
+				continue
+			}
+			return n, fmt.Errorf("failed to retry message %s: %w", id, err)
+		}
+		n++
+	}
+	return n, nil
+}
+
+func (m manager) Delete(_ context.Context, queue string, ids ...string) (int, error) {
+	n := 0
+	for _, id := range ids {
+		if err := m.inspector.DeleteTask(queue, id); err != nil {
+			if errors.Is(err, asynq.ErrTaskNotFound) {
+				continue
+			}
+			return n, fmt.Errorf("failed to delete message %s: %w", id, err)
+		}
+		n++
+	}
+	return n, nil
+}
+
+func messageFromTaskInfo(t *asynq.TaskInfo) Message {
+	msg := Message{
+		ID:       t.ID,
+		Queue:    t.Queue,
+		Type:     t.Type,
+		State:    State(t.State.String()),
+		Payload:  string(t.Payload),
+		Retried:  t.Retried,
+		MaxRetry: t.MaxRetry,
+	}
+	if t.LastErr != "" {
+		lastErr := t.LastErr
+		msg.LastError = &lastErr
+	}
+	if !t.LastFailedAt.IsZero() {
+		lastFailedAt := t.LastFailedAt
+		msg.LastFailedAt = &lastFailedAt
+	}
+	if !t.NextProcessAt.IsZero() {
+		nextProcessAt := t.NextProcessAt
+		msg.NextProcessAt = &nextProcessAt
+	}
+	if !t.CompletedAt.IsZero() {
+		completedAt := t.CompletedAt
+		msg.CompletedAt = &completedAt
+	}
+	return msg
+}
@@ -0,0 +1,45 @@
+package messages
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	maxSummaryLength    = 200
+	maxFieldValueLength = 40
+)
+
+// summarizePayload describes a JSON object payload by its fields, with arrays reduced to their length,
+// so that a message of thousands of info hashes can be listed; other payloads are truncated.
+func summarizePayload(payload []byte) string {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(payload, &fields); err != nil {
+		return truncate(string(payload), maxSummaryLength)
+	}
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		var items []json.RawMessage
+		if err := json.Unmarshal(fields[k], &items); err == nil {
+			parts = append(parts, fmt.Sprintf("%s: %d items", k, len(items)))
+		} else {
+			parts = append(parts, k+": "+truncate(string(fields[k]), maxFieldValueLength))
+		}
+	}
+	return truncate(strings.Join(parts, ", "), maxSummaryLength)
+}
+
+func truncate(str string, length int) string {
+	runes := []rune(str)
+	if len(runes) <= length {
+		return str
+	}
+	return string(runes[:length]) + "..."
+}
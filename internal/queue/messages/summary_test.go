@@ -0,0 +1,44 @@
+package messages
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestSummarizePayload(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name     string
+		payload  string
+		expected string
+	}{
+		{
+			name:     "processor message",
+			payload:  `{"InfoHashes":["a","b","c"],"ClassifyMode":1,"Priority":2}`,
+			expected: "ClassifyMode: 1, InfoHashes: 3 items, Priority: 2",
+		},
+		{
+			name:     "long field value",
+			payload:  `{"Name":"` + strings.Repeat("x", 50) + `"}`,
+			expected: `Name: "` + strings.Repeat("x", 39) + "...",
+		},
+		{
+			name:     "not an object",
+			payload:  `not json`,
+			expected: "not json",
+		},
+		{
+			name:     "long payload",
+			payload:  strings.Repeat("y", 300),
+			expected: strings.Repeat("y", 200) + "...",
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, summarizePayload([]byte(tc.payload)))
+		})
+	}
+}
@@ -4,8 +4,12 @@ import (
   "github.com/bitmagnet-io/bitmagnet/internal/boilerplate/config/configfx"
   "github.com/bitmagnet-io/bitmagnet/internal/queue"
   "github.com/bitmagnet-io/bitmagnet/internal/queue/client"
+  "github.com/bitmagnet-io/bitmagnet/internal/queue/deadletter"
+  "github.com/bitmagnet-io/bitmagnet/internal/queue/inspector"
+  "github.com/bitmagnet-io/bitmagnet/internal/queue/messages"
   "github.com/bitmagnet-io/bitmagnet/internal/queue/prometheus"
   "github.com/bitmagnet-io/bitmagnet/internal/queue/server"
+  "github.com/bitmagnet-io/bitmagnet/internal/queue/stats"
   "go.uber.org/fx"
 )
 
@@ -15,8 +19,12 @@ func New() fx.Option {
     configfx.NewConfigModule[queue.Config]("queue", queue.NewDefaultConfig()),
     fx.Provide(
       client.New,
+      deadletter.New,
+      inspector.New,
+      messages.New,
       prometheus.New,
       server.New,
+      stats.New,
     ),
   )
 }
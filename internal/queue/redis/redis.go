@@ -18,3 +18,17 @@ func (w Wrapper) MakeRedisClient() interface{} {
 	}
 	return redis
 }
+
+// DedicatedWrapper makes a new client with the options of the shared client, for an asynq server,
+// which closes its client on shutdown while the shared client may still be in use.
+type DedicatedWrapper struct {
+	Redis lazy.Lazy[*r.Client]
+}
+
+func (w DedicatedWrapper) MakeRedisClient() interface{} {
+	redis, err := w.Redis.Get()
+	if err != nil {
+		return err
+	}
+	return r.NewClient(redis.Options())
+}
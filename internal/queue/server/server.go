@@ -34,15 +34,18 @@ func New(p Params) (Result, error) {
 			fx.Hook{
 				OnStart: func(ctx context.Context) error {
 					cfg := &asynq.Config{
-						Concurrency: p.Config.Concurrency,
-						Logger:      loggerWrapper{p.Logger.Named("asynq")},
-						LogLevel:    asynq.DebugLevel,
-						Queues:      p.Config.Queues,
+						Concurrency:    p.Config.Concurrency,
+						Logger:         loggerWrapper{p.Logger.Named("asynq")},
+						LogLevel:       asynq.DebugLevel,
+						Queues:         p.Config.Queues,
+						StrictPriority: p.Config.StrictPriority,
+						// tasks still in progress when the timeout is reached are returned to the queue, to be retried
+						ShutdownTimeout: p.Config.ShutdownTimeout,
 					}
 					for _, opt := range p.Options {
 						opt.apply(cfg)
 					}
-					srv = asynq.NewServer(redis.Wrapper{Redis: p.Redis}, *cfg)
+					srv = asynq.NewServer(redis.DedicatedWrapper{Redis: p.Redis}, *cfg)
 					mux := asynq.NewServeMux()
 					for _, lc := range p.Consumers {
 						c, err := lc.Get()
@@ -0,0 +1,131 @@
+package stats
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/bitmagnet-io/bitmagnet/internal/queue"
+	"github.com/hibiken/asynq"
+	"go.uber.org/fx"
+	"sort"
+	"time"
+)
+
+// QueueStats is a snapshot of the state of a queue. The same figures are exported as Prometheus metrics
+// by the asynq collector (asynq_queue_size, asynq_queue_latency_seconds, asynq_tasks_processed_total etc.).
+type QueueStats struct {
+	Queue     string
+	Size      int
+	Pending   int
+	Active    int
+	Scheduled int
+	Retry     int
+	Archived  int
+	// Latency is how long the oldest pending message has been waiting to be processed.
+	Latency        time.Duration
+	ProcessedToday int
+	FailedToday    int
+	ProcessedTotal int
+	FailedTotal    int
+	Paused         bool
+}
+
+// LatencySeconds returns the age of the oldest pending message in seconds.
+func (s QueueStats) LatencySeconds() float64 {
+	return s.Latency.Seconds()
+}
+
+// DeadLetters returns the number of messages that failed on every permitted attempt.
+func (s QueueStats) DeadLetters() int {
+	return s.Archived
+}
+
+// FailureRate returns the proportion of messages processed today that failed.
+func (s QueueStats) FailureRate() float64 {
+	if s.ProcessedToday == 0 {
+		return 0
+	}
+	return float64(s.FailedToday) / float64(s.ProcessedToday)
+}
+
+type Reader interface {
+	// Stats returns the stats of each configured queue and any other queue known to the broker.
+	Stats(ctx context.Context) ([]QueueStats, error)
+}
+
+type Params struct {
+	fx.In
+	Config    queue.Config
+	Inspector lazy.Lazy[*asynq.Inspector]
+}
+
+type Result struct {
+	fx.Out
+	Reader lazy.Lazy[Reader]
+}
+
+func New(p Params) Result {
+	return Result{
+		Reader: lazy.New(func() (Reader, error) {
+			i, err := p.Inspector.Get()
+			if err != nil {
+				return nil, err
+			}
+			return reader{
+				config:    p.Config,
+				inspector: i,
+			}, nil
+		}),
+	}
+}
+
+type reader struct {
+	config    queue.Config
+	inspector *asynq.Inspector
+}
+
+func (r reader) Stats(context.Context) ([]QueueStats, error) {
+	known, err := r.inspector.Queues()
+	if err != nil {
+		return nil, err
+	}
+	// a configured queue doesn't exist in the broker until a message is published to it
+	queueNames := make(map[string]bool, len(r.config.Queues)+len(known))
+	for name := range r.config.Queues {
+		queueNames[name] = false
+	}
+	for _, name := range known {
+		queueNames[name] = true
+	}
+	names := make([]string, 0, len(queueNames))
+	for name := range queueNames {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	result := make([]QueueStats, 0, len(names))
+	for _, name := range names {
+		if !queueNames[name] {
+			result = append(result, QueueStats{Queue: name})
+			continue
+		}
+		info, err := r.inspector.GetQueueInfo(name)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, QueueStats{
+			Queue:          name,
+			Size:           info.Size,
+			Pending:        info.Pending,
+			Active:         info.Active,
+			Scheduled:      info.Scheduled,
+			Retry:          info.Retry,
+			Archived:       info.Archived,
+			Latency:        info.Latency,
+			ProcessedToday: info.Processed,
+			FailedToday:    info.Failed,
+			ProcessedTotal: info.ProcessedTotal,
+			FailedTotal:    info.FailedTotal,
+			Paused:         info.Paused,
+		})
+	}
+	return result, nil
+}
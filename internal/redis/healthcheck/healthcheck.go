@@ -2,8 +2,8 @@ package healthcheck
 
 import (
 	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/healthcheck"
 	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
-	"github.com/hellofresh/health-go/v5"
 	"github.com/redis/go-redis/v9"
 	"go.uber.org/fx"
 )
@@ -15,12 +15,14 @@ type Params struct {
 
 type Result struct {
 	fx.Out
-	Option health.Option `group:"healthcheck_options"`
+	Check healthcheck.Check `group:"healthchecks"`
 }
 
+// New checks Redis, which is the backend of the job queue.
 func New(p Params) (r Result, err error) {
-	r.Option = health.WithChecks(health.Config{
-		Name: "redis",
+	r.Check = healthcheck.Check{
+		Name:     "redis",
+		Critical: true,
 		Check: func(ctx context.Context) error {
 			r, err := p.Redis.Get()
 			if err != nil {
@@ -29,6 +31,6 @@ func New(p Params) (r Result, err error) {
 			_, err = r.Ping(ctx).Result()
 			return err
 		},
-	})
+	}
 	return
 }
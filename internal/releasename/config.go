@@ -0,0 +1,120 @@
+package releasename
+
+type Config struct {
+	// Tokens maps token kinds to dictionaries that are merged into the default dictionaries, adding kinds, values and aliases.
+	Tokens map[string]DictionaryConfig
+}
+
+type DictionaryConfig struct {
+	// Values maps the values of tokens to the aliases they're written as in release names; each value is also an alias of
+	// itself. Aliases are matched ignoring case between separators, and a space, dot, underscore or hyphen in an alias
+	// matches any of these or none.
+	Values map[string][]string
+	// FollowedBy is a regular expression that must directly follow a token of the kind, after a separator, for it to match;
+	// it's for tokens too short to be recognised on their own, such as the streaming service before WEB-DL.
+	FollowedBy string `mapstructure:"followed_by"`
+	// Suffix is a regular expression that may directly follow a token of the kind, such as the channels of an audio format.
+	Suffix string
+}
+
+func NewDefaultConfig() Config {
+	return Config{}
+}
+
+const (
+	KindHdr              = "hdr"
+	KindStreamingService = "streaming_service"
+	KindVideoCodec       = "video_codec"
+	KindBitDepth         = "bit_depth"
+	KindAudio            = "audio"
+)
+
+// defaultDictionaries are the dictionaries that configured tokens are merged into. The values of the hdr, audio and
+// video_codec kinds that are HDR formats, audio formats and video codecs are classified as these attributes.
+var defaultDictionaries = map[string]DictionaryConfig{
+	KindHdr: {
+		Values: map[string][]string{
+			"HDR":       {},
+			"HDR10":     {},
+			"HDR10Plus": {"HDR10+", "HDR10P"},
+			"DV":        {"DoVi", "Dolby Vision"},
+			"HLG":       {},
+		},
+	},
+	KindAudio: {
+		Values: map[string][]string{
+			"AAC":    {},
+			"AC3":    {"DD", "Dolby Digital"},
+			"EAC3":   {"E-AC3", "DDP", "DD+", "Dolby Digital Plus"},
+			"DTS":    {},
+			"DTSHD":  {"DTS-HD", "DTS-HD MA", "DTS-MA", "DTS-HD HRA"},
+			"DTSX":   {"DTS-X", "DTS:X"},
+			"TrueHD": {"True HD"},
+			"Atmos":  {},
+			"FLAC":   {},
+		},
+		Suffix: `[ ._-]?[1-9][ ._][0-2]`,
+	},
+	KindStreamingService: {
+		Values: map[string][]string{
+			"AMZN": {"Amazon"},
+			"ATVP": {},
+			"CR":   {"Crunchyroll"},
+			"CRAV": {"Crave"},
+			"DSNP": {"DSNY", "Disney+"},
+			"HULU": {},
+			"iT":   {"iTunes"},
+			"MAX":  {"HMAX"},
+			"NF":   {"Netflix"},
+			"PCOK": {"Peacock"},
+			"PMTP": {"Paramount+"},
+			"STAN": {},
+		},
+		FollowedBy: `web(?:[ ._-]?(?:dl|rip))?`,
+	},
+	KindVideoCodec: {
+		Values: map[string][]string{
+			"AV1": {},
+			"VP9": {},
+			"VVC": {"H266"},
+		},
+	},
+	KindBitDepth: {
+		Values: map[string][]string{
+			"8bit":  {"8 bit"},
+			"10bit": {"10 bit", "Hi10P", "Hi10"},
+			"12bit": {"12 bit"},
+		},
+	},
+}
+
+// mergeDictionaries merges the configured dictionaries into the defaults; a configured FollowedBy or Suffix replaces the default.
+func mergeDictionaries(defaults, configured map[string]DictionaryConfig) map[string]DictionaryConfig {
+	merged := make(map[string]DictionaryConfig, len(defaults)+len(configured))
+	for kind, d := range defaults {
+		merged[kind] = d
+	}
+	for kind, d := range configured {
+		m, ok := merged[kind]
+		if !ok {
+			merged[kind] = d
+			continue
+		}
+		values := make(map[string][]string, len(m.Values)+len(d.Values))
+		for value, aliases := range m.Values {
+			values[value] = aliases
+		}
+		for value, aliases := range d.Values {
+			values[value] = append(append([]string{}, values[value]...), aliases...)
+		}
+		m.Values = values
+		if d.FollowedBy != "" {
+			m.FollowedBy = d.FollowedBy
+		}
+		if d.Suffix != "" {
+			m.Suffix = d.Suffix
+		}
+		merged[kind] = m
+	}
+	return merged
+}
@@ -0,0 +1,173 @@
+package releasename
+
+import (
+	"errors"
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"go.uber.org/fx"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Parser recognises the tokens of configurable dictionaries in release names.
+type Parser interface {
+	// Parse returns the tokens in the input, which should be the part of a release name following the title, as
+	// some aliases are common words.
+	Parse(input string) model.ReleaseTokens
+}
+
+type Params struct {
+	fx.In
+	Config Config
+}
+
+type Result struct {
+	fx.Out
+	Parser Parser
+}
+
+func New(p Params) (Result, error) {
+	parser, err := NewParser(mergeDictionaries(defaultDictionaries, p.Config.Tokens))
+	if err != nil {
+		return Result{}, err
+	}
+	return Result{Parser: parser}, nil
+}
+
+// NewDefaultParser returns a parser of the default dictionaries.
+func NewDefaultParser() Parser {
+	parser, err := NewParser(defaultDictionaries)
+	if err != nil {
+		panic(err)
+	}
+	return parser
+}
+
+func NewParser(dictionaries map[string]DictionaryConfig) (Parser, error) {
+	kinds := make([]string, 0, len(dictionaries))
+	for kind := range dictionaries {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	p := make(parser, 0, len(kinds))
+	for _, kind := range kinds {
+		d, err := newDictionary(kind, dictionaries[kind])
+		if err != nil {
+			return nil, fmt.Errorf("invalid release name tokens of kind %s: %w", kind, err)
+		}
+		if d.regex != nil {
+			p = append(p, d)
+		}
+	}
+	return p, nil
+}
+
+type parser []dictionary
+
+func (p parser) Parse(input string) model.ReleaseTokens {
+	var tokens model.ReleaseTokens
+	for _, d := range p {
+		tokens = d.parse(input, tokens)
+	}
+	return tokens
+}
+
+type dictionary struct {
+	kind  string
+	regex *regexp.Regexp
+	// values maps lower cased aliases to their values
+	values map[string]string
+}
+
+// separatorRegex matches the characters of an alias that match any separator or none.
+var separatorRegex = regexp.MustCompile(`[ ._-]+`)
+
+const (
+	boundaryStart = `(?:^|[^\p{L}\p{N}])`
+	boundaryEnd   = `(?:$|[^\p{L}\p{N}])`
+)
+
+func newDictionary(kind string, config DictionaryConfig) (dictionary, error) {
+	if kind == "" || strings.Contains(kind, ":") {
+		return dictionary{}, errors.New("invalid kind")
+	}
+	d := dictionary{
+		kind:   kind,
+		values: make(map[string]string),
+	}
+	patterns := make(map[string]struct{})
+	for value, aliases := range config.Values {
+		if value == "" {
+			return dictionary{}, errors.New("empty value")
+		}
+		for _, alias := range append([]string{value}, aliases...) {
+			key := normalizeAlias(alias)
+			if key == "" {
+				continue
+			}
+			if other, ok := d.values[key]; ok && other != value {
+				return dictionary{}, fmt.Errorf("alias %s is shared by %s and %s", alias, other, value)
+			}
+			d.values[key] = value
+			patterns[aliasPattern(alias)] = struct{}{}
+		}
+	}
+	if len(patterns) == 0 {
+		return d, nil
+	}
+	alternatives := make([]string, 0, len(patterns))
+	for pattern := range patterns {
+		alternatives = append(alternatives, pattern)
+	}
+	// the longest aliases are tried first, so that for example HDR10+ is preferred to HDR10
+	sort.Slice(alternatives, func(i, j int) bool {
+		if len(alternatives[i]) != len(alternatives[j]) {
+			return len(alternatives[i]) > len(alternatives[j])
+		}
+		return alternatives[i] < alternatives[j]
+	})
+	expr := `(?i)` + boundaryStart + `(` + strings.Join(alternatives, "|") + `)`
+	if config.Suffix != "" {
+		expr += `(?:` + config.Suffix + `)?`
+	}
+	if config.FollowedBy != "" {
+		expr += `[^\p{L}\p{N}]+(?:` + config.FollowedBy + `)`
+	}
+	expr += boundaryEnd
+	regex, err := regexp.Compile(expr)
+	if err != nil {
+		return dictionary{}, err
+	}
+	d.regex = regex
+	return d, nil
+}
+
+func normalizeAlias(alias string) string {
+	return strings.ToLower(separatorRegex.ReplaceAllString(strings.TrimSpace(alias), ""))
+}
+
+func aliasPattern(alias string) string {
+	parts := separatorRegex.Split(strings.TrimSpace(alias), -1)
+	for i, part := range parts {
+		parts[i] = regexp.QuoteMeta(part)
+	}
+	return strings.Join(parts, `[ ._-]?`)
+}
+
+// parse adds the tokens matched in the input; as the boundaries of adjacent tokens share a separator, each search
+// continues from the end of the previous alias rather than the end of the match.
+func (d dictionary) parse(input string, tokens model.ReleaseTokens) model.ReleaseTokens {
+	for offset := 0; offset < len(input); {
+		loc := d.regex.FindStringSubmatchIndex(input[offset:])
+		if loc == nil {
+			break
+		}
+		alias := input[offset+loc[2] : offset+loc[3]]
+		if value, ok := d.values[normalizeAlias(alias)]; ok {
+			tokens = tokens.Add(model.ReleaseToken{Kind: d.kind, Value: value})
+		}
+		offset += loc[3]
+	}
+	return tokens
+}
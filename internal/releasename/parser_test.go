@@ -0,0 +1,106 @@
+package releasename
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	parser := NewDefaultParser()
+
+	tests := []struct {
+		input    string
+		expected []string
+	}{
+		{".2160p.ATVP.WEB-DL.DDP5.1.Atmos.DV.HDR10+.H.265-GRP", []string{"audio:Atmos", "audio:EAC3", "hdr:DV", "hdr:HDR10Plus", "streaming_service:ATVP"}},
+		{" 1080p NF WEBRip x264", []string{"streaming_service:NF"}},
+		{".1080p.Dolby.Vision.HDR10Plus.BluRay", []string{"hdr:DV", "hdr:HDR10Plus"}},
+		{".BluRay.DTS-HD.MA.7.1.TrueHD.DD+2.0.AAC", []string{"audio:AAC", "audio:DTSHD", "audio:EAC3", "audio:TrueHD"}},
+		{".HDR10.HDR.hlg", []string{"hdr:HDR", "hdr:HDR10", "hdr:HLG"}},
+		{".720p.Hi10P.AV1.vp9", []string{"bit_depth:10bit", "video_codec:AV1", "video_codec:VP9"}},
+		// a streaming service must be followed by a web source
+		{".MAX.Payne.1080p.BluRay", nil},
+		{".HDRip.xvid", nil},
+		{"", nil},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.input, func(t *testing.T) {
+			t.Parallel()
+			tokens := parser.Parse(test.input)
+			if test.expected == nil {
+				assert.Empty(t, tokens)
+			} else {
+				assert.Equal(t, test.expected, tokens.Strings())
+			}
+		})
+	}
+}
+
+func TestConfiguredTokens(t *testing.T) {
+	t.Parallel()
+
+	result, err := New(Params{
+		Config: Config{
+			Tokens: map[string]DictionaryConfig{
+				KindStreamingService: {
+					Values: map[string][]string{
+						"SKST": {"SkyShowtime"},
+						"NF":   {"Nflx"},
+					},
+				},
+				KindAudio: {
+					Values: map[string][]string{
+						"Opus": {},
+						"EAC3": {"DDPA"},
+					},
+				},
+				"edition": {
+					Values: map[string][]string{
+						"IMAX":      {},
+						"Criterion": {"CC"},
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, model.ReleaseTokens{
+		{Kind: KindAudio, Value: "EAC3"},
+		{Kind: KindAudio, Value: "Opus"},
+		{Kind: "edition", Value: "Criterion"},
+		{Kind: "edition", Value: "IMAX"},
+		{Kind: KindStreamingService, Value: "NF"},
+		{Kind: KindStreamingService, Value: "SKST"},
+	}, result.Parser.Parse(".IMAX.CC.NFLX.WEB.DDPA5.1.Opus.SkyShowtime.WEBRip"))
+	assert.Equal(t, []string{"streaming_service:NF"}, result.Parser.Parse(".Netflix.WEB-DL").Strings())
+}
+
+func TestInvalidTokens(t *testing.T) {
+	t.Parallel()
+
+	for name, dictionaries := range map[string]map[string]DictionaryConfig{
+		"shared alias": {
+			KindHdr: {Values: map[string][]string{"DV": {"DoVi"}, "DoVi": {}}},
+		},
+		"invalid kind": {
+			"hdr:dv": {Values: map[string][]string{"DV": {}}},
+		},
+		"invalid followed by": {
+			KindHdr: {Values: map[string][]string{"DV": {}}, FollowedBy: "("},
+		},
+	} {
+		dictionaries := dictionaries
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			_, err := NewParser(dictionaries)
+			assert.Error(t, err)
+		})
+	}
+}
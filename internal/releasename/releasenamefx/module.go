@@ -0,0 +1,17 @@
+package releasenamefx
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/config/configfx"
+	"github.com/bitmagnet-io/bitmagnet/internal/releasename"
+	"go.uber.org/fx"
+)
+
+func New() fx.Option {
+	return fx.Module(
+		"release_name",
+		configfx.NewConfigModule[releasename.Config]("release_name", releasename.NewDefaultConfig()),
+		fx.Provide(
+			releasename.New,
+		),
+	)
+}
@@ -0,0 +1,32 @@
+package retention
+
+import "time"
+
+type Config struct {
+	// Policies maps policy names to the torrents they delete; a torrent is deleted if it matches every criterion of any policy.
+	Policies map[string]PolicyConfig
+	// DryRun reports the number of torrents matching each policy without deleting them.
+	DryRun bool `mapstructure:"dry_run"`
+	// Interval is the time between runs of the janitor.
+	Interval time.Duration
+}
+
+type PolicyConfig struct {
+	// UnclassifiedAttempts matches torrents that have failed to be classified at least this many consecutive times.
+	UnclassifiedAttempts uint `mapstructure:"unclassified_attempts"`
+	// NoSeedersFor matches torrents first seen at least this long ago that have a known seeder count of zero from every
+	// source, and that no source has seen with any seeders for at least this long.
+	NoSeedersFor time.Duration `mapstructure:"no_seeders_for"`
+	// SmallerThan matches torrents smaller than this many bytes.
+	SmallerThan uint64 `mapstructure:"smaller_than"`
+	// ContentTypes matches torrents classified as any of these content types; "null" matches torrents of unknown type.
+	ContentTypes []string `mapstructure:"content_types"`
+	// MinAge matches torrents first seen at least this long ago.
+	MinAge time.Duration `mapstructure:"min_age"`
+}
+
+func NewDefaultConfig() Config {
+	return Config{
+		Interval: time.Hour * 24,
+	}
+}
@@ -0,0 +1,104 @@
+package retention
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/worker"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/events"
+	"github.com/bitmagnet-io/bitmagnet/internal/processor/asynq/purger"
+	"github.com/bitmagnet-io/bitmagnet/internal/taskrun"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+type Params struct {
+	fx.In
+	Config          Config
+	Dao             lazy.Lazy[*dao.Query]
+	QueuePurger     lazy.Lazy[purger.Purger]
+	EventBus        lazy.Lazy[events.Bus]
+	TaskRunRecorder lazy.Lazy[taskrun.Recorder]
+	Logger          *zap.SugaredLogger
+}
+
+type Result struct {
+	fx.Out
+	Janitor     lazy.Lazy[Janitor]
+	Worker      worker.Worker        `group:"workers"`
+	PrunedTotal prometheus.Collector `group:"prometheus_collectors"`
+}
+
+func New(p Params) Result {
+	prunedTotal := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "bitmagnet",
+		Subsystem: "retention",
+		Name:      "pruned_total",
+		Help:      "A counter of torrents deleted by retention policies.",
+	}, []string{"policy"})
+	lj := lazy.New(func() (Janitor, error) {
+		policies, err := newPolicies(p.Config.Policies)
+		if err != nil {
+			return nil, err
+		}
+		d, err := p.Dao.Get()
+		if err != nil {
+			return nil, err
+		}
+		qp, err := p.QueuePurger.Get()
+		if err != nil {
+			return nil, err
+		}
+		eb, err := p.EventBus.Get()
+		if err != nil {
+			return nil, err
+		}
+		return janitor{
+			policies:    policies,
+			dao:         d,
+			queuePurger: qp,
+			eventBus:    eb,
+			prunedTotal: prunedTotal,
+			logger:      p.Logger.Named("retention"),
+		}, nil
+	})
+	var pr *pruner
+	return Result{
+		Janitor: lj,
+		Worker: worker.NewWorker(
+			"retention",
+			fx.Hook{
+				OnStart: func(context.Context) error {
+					if len(p.Config.Policies) == 0 {
+						return nil
+					}
+					j, err := lj.Get()
+					if err != nil {
+						return err
+					}
+					tr, err := p.TaskRunRecorder.Get()
+					if err != nil {
+						return err
+					}
+					pr = &pruner{
+						janitor:         j,
+						dryRun:          p.Config.DryRun,
+						interval:        p.Config.Interval,
+						taskRunRecorder: tr,
+						stopped:         make(chan struct{}),
+					}
+					go pr.start()
+					return nil
+				},
+				OnStop: func(context.Context) error {
+					if pr != nil {
+						close(pr.stopped)
+					}
+					return nil
+				},
+			},
+		),
+		PrunedTotal: prunedTotal,
+	}
+}
@@ -0,0 +1,98 @@
+package retention
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/events"
+	"github.com/bitmagnet-io/bitmagnet/internal/processor/asynq/purger"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+	"time"
+)
+
+const batchSize = 1000
+
+// Janitor deletes torrents matching the configured retention policies. Pruned torrents aren't blocked,
+// so they can be indexed again if they're rediscovered.
+type Janitor interface {
+	// Prune deletes the torrents matching each policy, or only counts them if dryRun is true.
+	Prune(ctx context.Context, dryRun bool) ([]PolicyResult, error)
+}
+
+type PolicyResult struct {
+	Policy string
+	// Torrents is the number of torrents deleted, or that would be deleted by a dry run.
+	Torrents int64
+}
+
+type janitor struct {
+	policies    []policy
+	dao         *dao.Query
+	queuePurger purger.Purger
+	eventBus    events.Bus
+	prunedTotal *prometheus.CounterVec
+	logger      *zap.SugaredLogger
+}
+
+func (j janitor) Prune(ctx context.Context, dryRun bool) ([]PolicyResult, error) {
+	now := time.Now()
+	results := make([]PolicyResult, 0, len(j.policies))
+	var errs []error
+	// the pruned torrents are purged from the queued processor messages once, as every queued message is scanned
+	var prunedHashes []protocol.ID
+	for _, p := range j.policies {
+		var n int64
+		var err error
+		if dryRun {
+			n, err = j.dao.CountFilteredTorrents(ctx, p.filter(now))
+		} else {
+			var infoHashes []protocol.ID
+			n, infoHashes, err = j.prunePolicy(ctx, p, now)
+			prunedHashes = append(prunedHashes, infoHashes...)
+		}
+		results = append(results, PolicyResult{Policy: p.name, Torrents: n})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("retention policy %q: %w", p.name, err))
+			if ctx.Err() != nil {
+				break
+			}
+			continue
+		}
+		if dryRun {
+			j.logger.Infow("dry run: torrents matching retention policy", "policy", p.name, "torrents", n)
+		} else {
+			j.logger.Infow("pruned torrents", "policy", p.name, "torrents", n)
+		}
+	}
+	if _, err := j.queuePurger.Purge(ctx, prunedHashes); err != nil {
+		errs = append(errs, fmt.Errorf("deleted torrents but failed to purge queued messages: %w", err))
+	}
+	return results, errors.Join(errs...)
+}
+
+// prunePolicy deletes the torrents matching a policy, returning the number deleted and their info hashes.
+func (j janitor) prunePolicy(ctx context.Context, p policy, now time.Time) (int64, []protocol.ID, error) {
+	f := p.filter(now)
+	var pruned int64
+	var prunedHashes []protocol.ID
+	for {
+		infoHashes, err := j.dao.FindFilteredTorrentHashes(ctx, f, batchSize)
+		if err != nil || len(infoHashes) == 0 {
+			return pruned, prunedHashes, err
+		}
+		n, err := j.dao.DeleteTorrents(ctx, infoHashes)
+		if err != nil {
+			return pruned, prunedHashes, err
+		}
+		pruned += n
+		prunedHashes = append(prunedHashes, infoHashes...)
+		j.prunedTotal.WithLabelValues(p.name).Add(float64(n))
+		j.eventBus.Publish(ctx, events.NewDeletedEvents(infoHashes...)...)
+		if len(infoHashes) < batchSize {
+			return pruned, prunedHashes, nil
+		}
+	}
+}
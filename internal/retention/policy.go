@@ -0,0 +1,74 @@
+package retention
+
+import (
+	"errors"
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"slices"
+	"sort"
+	"time"
+)
+
+var ErrEmptyPolicy = errors.New("a retention policy must have at least one criterion")
+
+// policy is a named retention policy with its content types parsed.
+type policy struct {
+	name         string
+	config       PolicyConfig
+	contentTypes []model.NullContentType
+}
+
+func newPolicies(configs map[string]PolicyConfig) ([]policy, error) {
+	policies := make([]policy, 0, len(configs))
+	for name, c := range configs {
+		p, err := newPolicy(name, c)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, p)
+	}
+	sort.Slice(policies, func(i, j int) bool {
+		return policies[i].name < policies[j].name
+	})
+	return policies, nil
+}
+
+func newPolicy(name string, c PolicyConfig) (policy, error) {
+	p := policy{name: name, config: c}
+	for _, str := range c.ContentTypes {
+		if str == "null" {
+			p.contentTypes = append(p.contentTypes, model.NullContentType{})
+			continue
+		}
+		ct, err := model.ParseContentType(str)
+		if err != nil {
+			return policy{}, fmt.Errorf("retention policy %q: %w", name, err)
+		}
+		p.contentTypes = append(p.contentTypes, model.NewNullContentType(ct))
+	}
+	if c.UnclassifiedAttempts == 0 && c.NoSeedersFor == 0 && c.SmallerThan == 0 && len(p.contentTypes) == 0 && c.MinAge == 0 {
+		return policy{}, fmt.Errorf("retention policy %q: %w", name, ErrEmptyPolicy)
+	}
+	return p, nil
+}
+
+// filter returns the filter for torrents matching the policy at the given time.
+func (p policy) filter(now time.Time) dao.TorrentFilter {
+	f := dao.TorrentFilter{
+		ContentTypes:              slices.Clone(p.contentTypes),
+		ZeroSeeders:               p.config.NoSeedersFor > 0,
+		MinClassificationAttempts: p.config.UnclassifiedAttempts,
+	}
+	if p.config.SmallerThan > 0 {
+		f.MaxSize = model.NewNullUint64(p.config.SmallerThan - 1)
+	}
+	// a torrent that's had no seeders for a while must also be at least that old, as it may not have been scraped yet
+	if minAge := max(p.config.MinAge, p.config.NoSeedersFor); minAge > 0 {
+		f.CreatedBefore = now.Add(-minAge)
+	}
+	if p.config.NoSeedersFor > 0 {
+		f.NotSeededSince = now.Add(-p.config.NoSeedersFor)
+	}
+	return f
+}
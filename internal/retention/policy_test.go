@@ -0,0 +1,83 @@
+package retention
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestNewPolicies(t *testing.T) {
+	t.Parallel()
+
+	policies, err := newPolicies(map[string]PolicyConfig{
+		"unclassified": {UnclassifiedAttempts: 3},
+		"dead":         {NoSeedersFor: time.Hour * 24 * 30},
+	})
+	require.NoError(t, err)
+	require.Len(t, policies, 2)
+	assert.Equal(t, "dead", policies[0].name)
+	assert.Equal(t, "unclassified", policies[1].name)
+
+	_, err = newPolicies(map[string]PolicyConfig{"everything": {}})
+	assert.ErrorIs(t, err, ErrEmptyPolicy)
+
+	_, err = newPolicies(map[string]PolicyConfig{"invalid": {ContentTypes: []string{"films"}}})
+	assert.ErrorIs(t, err, model.ErrInvalidContentType)
+}
+
+func TestPolicyFilter(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, tc := range []struct {
+		name     string
+		config   PolicyConfig
+		expected dao.TorrentFilter
+	}{
+		{
+			name:   "unclassified attempts",
+			config: PolicyConfig{UnclassifiedAttempts: 3},
+			expected: dao.TorrentFilter{
+				MinClassificationAttempts: 3,
+			},
+		},
+		{
+			name:   "no seeders",
+			config: PolicyConfig{NoSeedersFor: time.Hour * 24},
+			expected: dao.TorrentFilter{
+				ZeroSeeders:    true,
+				NotSeededSince: now.Add(-time.Hour * 24),
+				CreatedBefore:  now.Add(-time.Hour * 24),
+			},
+		},
+		{
+			name:   "longest age applies",
+			config: PolicyConfig{NoSeedersFor: time.Hour, MinAge: time.Hour * 2},
+			expected: dao.TorrentFilter{
+				ZeroSeeders:    true,
+				NotSeededSince: now.Add(-time.Hour),
+				CreatedBefore:  now.Add(-time.Hour * 2),
+			},
+		},
+		{
+			name:   "small torrents of content types",
+			config: PolicyConfig{SmallerThan: 1024, ContentTypes: []string{"xxx", "null"}},
+			expected: dao.TorrentFilter{
+				MaxSize:      model.NewNullUint64(1023),
+				ContentTypes: []model.NullContentType{model.NewNullContentType(model.ContentTypeXxx), {}},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p, err := newPolicy(tc.name, tc.config)
+			require.NoError(t, err)
+			f := p.filter(now)
+			assert.Equal(t, tc.expected, f)
+			assert.False(t, f.IsEmpty())
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package retention
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/taskrun"
+	"time"
+)
+
+// pruner runs the janitor when it starts, and then at the configured interval.
+type pruner struct {
+	janitor         Janitor
+	dryRun          bool
+	interval        time.Duration
+	taskRunRecorder taskrun.Recorder
+	stopped         chan struct{}
+}
+
+func (r *pruner) start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		for {
+			run := r.taskRunRecorder.Start(ctx, taskrun.KindRetentionPrune)
+			results, err := r.janitor.Prune(ctx, r.dryRun)
+			if !r.dryRun {
+				for _, result := range results {
+					run.Add(int(result.Torrents))
+				}
+			}
+			run.Finish(err)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(r.interval):
+			}
+		}
+	}()
+	<-r.stopped
+}
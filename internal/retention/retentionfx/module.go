@@ -0,0 +1,17 @@
+package retentionfx
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/config/configfx"
+	"github.com/bitmagnet-io/bitmagnet/internal/retention"
+	"go.uber.org/fx"
+)
+
+func New() fx.Option {
+	return fx.Module(
+		"retention",
+		configfx.NewConfigModule[retention.Config]("retention", retention.NewDefaultConfig()),
+		fx.Provide(
+			retention.New,
+		),
+	)
+}
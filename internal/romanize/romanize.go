@@ -0,0 +1,23 @@
+package romanize
+
+import (
+	"github.com/mozillazg/go-unidecode"
+	"strings"
+	"unicode"
+)
+
+// Romanize transliterates text in non-Latin scripts to ASCII, for example Cyrillic to Latin,
+// Chinese to pinyin and Japanese kana to romaji. Whitespace in the result is normalized.
+func Romanize(str string) string {
+	return strings.Join(strings.Fields(unidecode.Unidecode(str)), " ")
+}
+
+// HasNonLatinLetters returns true if the string contains any letters outside the Latin script.
+func HasNonLatinLetters(str string) bool {
+	for _, r := range str {
+		if unicode.IsLetter(r) && !unicode.Is(unicode.Latin, r) {
+			return true
+		}
+	}
+	return false
+}
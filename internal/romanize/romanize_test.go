@@ -0,0 +1,29 @@
+package romanize
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestRomanize(t *testing.T) {
+	for _, tc := range []struct {
+		input    string
+		expected string
+	}{
+		{"Брат 2", "Brat 2"},
+		{"Москва слезам не верит", "Moskva slezam ne verit"},
+		{"北京", "Bei Jing"},
+		{"Amélie", "Amelie"},
+		{"The Matrix", "The Matrix"},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			assert.Equal(t, tc.expected, Romanize(tc.input))
+		})
+	}
+}
+
+func TestHasNonLatinLetters(t *testing.T) {
+	assert.True(t, HasNonLatinLetters("Брат"))
+	assert.True(t, HasNonLatinLetters("となりのトトロ 1988"))
+	assert.False(t, HasNonLatinLetters("Amélie 2001"))
+}
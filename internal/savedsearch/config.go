@@ -0,0 +1,17 @@
+package savedsearch
+
+type Config struct {
+	// SmtpHost is the mail server used to notify saved searches that have an email address; email notifications are disabled if empty.
+	SmtpHost     string
+	SmtpPort     uint
+	SmtpUsername string
+	SmtpPassword string
+	// SmtpFrom is the sender address of notification emails.
+	SmtpFrom string
+}
+
+func NewDefaultConfig() Config {
+	return Config{
+		SmtpPort: 587,
+	}
+}
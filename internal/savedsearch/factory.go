@@ -0,0 +1,59 @@
+package savedsearch
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/search"
+	"github.com/bitmagnet-io/bitmagnet/internal/torrentexport"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"net/http"
+	"time"
+)
+
+type Params struct {
+	fx.In
+	Config       Config
+	SearchConfig search.Config
+	Dao          lazy.Lazy[*dao.Query]
+	Search       lazy.Lazy[search.Search]
+	TrackerList  lazy.Lazy[torrentexport.TrackerList]
+	Logger       *zap.SugaredLogger
+}
+
+type Result struct {
+	fx.Out
+	Manager lazy.Lazy[Manager]
+}
+
+func New(p Params) Result {
+	return Result{
+		Manager: lazy.New(func() (Manager, error) {
+			d, err := p.Dao.Get()
+			if err != nil {
+				return nil, err
+			}
+			s, err := p.Search.Get()
+			if err != nil {
+				return nil, err
+			}
+			tl, err := p.TrackerList.Get()
+			if err != nil {
+				return nil, err
+			}
+			return manager{
+				dao:          d,
+				search:       s,
+				searchConfig: p.SearchConfig,
+				notifier: notifier{
+					config: p.Config,
+					httpClient: &http.Client{
+						Timeout: time.Second * 30,
+					},
+					trackerList: tl,
+				},
+				logger: p.Logger.Named("saved_search"),
+			}, nil
+		}),
+	}
+}
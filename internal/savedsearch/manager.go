@@ -0,0 +1,230 @@
+package savedsearch
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/query"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/search"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"go.uber.org/zap"
+	"gorm.io/gorm/clause"
+	"net/mail"
+	"net/url"
+	"time"
+)
+
+// Manager maintains named searches that are evaluated against newly classified torrents.
+// When a torrent matches a saved search for the first time, the saved search's webhook URL and email address are notified.
+type Manager interface {
+	List(ctx context.Context) ([]model.SavedSearch, error)
+	// Save creates a saved search, or replaces the saved search of the same name.
+	Save(ctx context.Context, s model.SavedSearch) (model.SavedSearch, error)
+	Delete(ctx context.Context, ids ...int64) error
+	// Search runs a saved search; further options such as a limit are applied after the saved search's own.
+	Search(ctx context.Context, id int64, options ...query.Option) (search.TorrentContentResult, error)
+	// Evaluate matches persisted torrent contents against every saved search, and notifies the saved searches with new matches.
+	Evaluate(ctx context.Context, tcs []model.TorrentContent) error
+}
+
+type manager struct {
+	dao          *dao.Query
+	search       search.Search
+	searchConfig search.Config
+	notifier     notifier
+	logger       *zap.SugaredLogger
+}
+
+func (m manager) List(ctx context.Context) ([]model.SavedSearch, error) {
+	savedSearches, err := m.dao.SavedSearch.WithContext(ctx).Order(m.dao.SavedSearch.Name).Find()
+	if err != nil {
+		return nil, err
+	}
+	result := make([]model.SavedSearch, 0, len(savedSearches))
+	for _, s := range savedSearches {
+		result = append(result, *s)
+	}
+	return result, nil
+}
+
+func (m manager) Save(ctx context.Context, s model.SavedSearch) (model.SavedSearch, error) {
+	if err := validate(&s); err != nil {
+		return model.SavedSearch{}, err
+	}
+	if err := m.dao.SavedSearch.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: string(m.dao.SavedSearch.Name.ColumnName())}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			string(m.dao.SavedSearch.QueryString.ColumnName()),
+			string(m.dao.SavedSearch.Facets.ColumnName()),
+			string(m.dao.SavedSearch.OrderBy.ColumnName()),
+			string(m.dao.SavedSearch.OrderDesc.ColumnName()),
+			string(m.dao.SavedSearch.WebhookURL.ColumnName()),
+			string(m.dao.SavedSearch.Email.ColumnName()),
+			string(m.dao.SavedSearch.UpdatedAt.ColumnName()),
+		}),
+	}).Create(&s); err != nil {
+		return model.SavedSearch{}, err
+	}
+	saved, err := m.dao.SavedSearch.WithContext(ctx).Where(m.dao.SavedSearch.Name.Eq(s.Name)).First()
+	if err != nil {
+		return model.SavedSearch{}, err
+	}
+	return *saved, nil
+}
+
+func validate(s *model.SavedSearch) error {
+	if s.Name == "" {
+		return errors.New("a saved search must have a name")
+	}
+	if s.OrderBy == "" {
+		s.OrderBy = model.SavedSearchOrderByUpdatedAt
+		s.OrderDesc = true
+	} else if !s.OrderBy.IsValid() {
+		return fmt.Errorf("invalid order by: %s", s.OrderBy)
+	}
+	if s.Facets == nil {
+		s.Facets = []model.SavedSearchFacet{}
+	}
+	if _, err := Option(*s, search.NewDefaultConfig()); err != nil {
+		return err
+	}
+	if s.WebhookURL.Valid {
+		if u, err := url.Parse(s.WebhookURL.String); err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+			return fmt.Errorf("invalid webhook URL: %s", s.WebhookURL.String)
+		}
+	}
+	if s.Email.Valid {
+		if _, err := mail.ParseAddress(s.Email.String); err != nil {
+			return fmt.Errorf("invalid email address: %w", err)
+		}
+	}
+	return nil
+}
+
+func (m manager) Delete(ctx context.Context, ids ...int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	_, err := m.dao.SavedSearch.WithContext(ctx).Where(m.dao.SavedSearch.ID.In(ids...)).Delete()
+	return err
+}
+
+func (m manager) Search(ctx context.Context, id int64, options ...query.Option) (search.TorrentContentResult, error) {
+	s, err := m.dao.SavedSearch.WithContext(ctx).Where(m.dao.SavedSearch.ID.Eq(id)).First()
+	if err != nil {
+		return search.TorrentContentResult{}, err
+	}
+	option, err := Option(*s, m.searchConfig)
+	if err != nil {
+		return search.TorrentContentResult{}, err
+	}
+	return m.search.TorrentContent(ctx, append([]query.Option{option}, options...)...)
+}
+
+func (m manager) Evaluate(ctx context.Context, tcs []model.TorrentContent) error {
+	if len(tcs) == 0 {
+		return nil
+	}
+	savedSearches, err := m.dao.SavedSearch.WithContext(ctx).Find()
+	if err != nil {
+		return err
+	}
+	if len(savedSearches) == 0 {
+		return nil
+	}
+	infoHashes := make([]protocol.ID, 0, len(tcs))
+	seen := make(map[protocol.ID]struct{}, len(tcs))
+	for _, tc := range tcs {
+		if _, ok := seen[tc.InfoHash]; !ok {
+			seen[tc.InfoHash] = struct{}{}
+			infoHashes = append(infoHashes, tc.InfoHash)
+		}
+	}
+	for _, s := range savedSearches {
+		// a failing saved search is logged rather than returned, so that it doesn't cause the batch to be reprocessed
+		if evalErr := m.evaluate(ctx, *s, infoHashes); evalErr != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			m.logger.Errorw("failed to evaluate saved search", "id", s.ID, "name", s.Name, "error", evalErr)
+		}
+	}
+	return nil
+}
+
+func (m manager) evaluate(ctx context.Context, s model.SavedSearch, infoHashes []protocol.ID) error {
+	option, err := Option(s, m.searchConfig)
+	if err != nil {
+		return err
+	}
+	result, err := m.search.TorrentContent(
+		ctx,
+		option,
+		query.Where(search.TorrentInfoHashCriteria(infoHashes...)),
+		query.Limit(uint(len(infoHashes))),
+	)
+	if err != nil {
+		return err
+	}
+	if len(result.Items) == 0 {
+		return nil
+	}
+	matchedHashes := make([]driver.Valuer, 0, len(result.Items))
+	for _, item := range result.Items {
+		matchedHashes = append(matchedHashes, item.InfoHash)
+	}
+	existing, err := m.dao.SavedSearchMatch.WithContext(ctx).Where(
+		m.dao.SavedSearchMatch.SavedSearchID.Eq(s.ID),
+		m.dao.SavedSearchMatch.InfoHash.In(matchedHashes...),
+	).Find()
+	if err != nil {
+		return err
+	}
+	seen := make(map[protocol.ID]struct{}, len(result.Items))
+	for _, match := range existing {
+		seen[match.InfoHash] = struct{}{}
+	}
+	var newTcs []model.TorrentContent
+	var matches []*model.SavedSearchMatch
+	for _, item := range result.Items {
+		if _, ok := seen[item.InfoHash]; ok {
+			continue
+		}
+		seen[item.InfoHash] = struct{}{}
+		newTcs = append(newTcs, item.TorrentContent)
+		matches = append(matches, &model.SavedSearchMatch{
+			SavedSearchID: s.ID,
+			InfoHash:      item.InfoHash,
+		})
+	}
+	if len(matches) == 0 {
+		return nil
+	}
+	now := time.Now()
+	if err := m.dao.Transaction(func(tx *dao.Query) error {
+		if err := tx.SavedSearchMatch.WithContext(ctx).Clauses(clause.OnConflict{
+			DoNothing: true,
+		}).CreateInBatches(matches, 100); err != nil {
+			return err
+		}
+		_, err := tx.SavedSearch.WithContext(ctx).Where(
+			tx.SavedSearch.ID.Eq(s.ID),
+		).UpdateColumn(tx.SavedSearch.LastMatchedAt, now)
+		return err
+	}); err != nil {
+		return err
+	}
+	m.logger.Infow("saved search has new matches", "id", s.ID, "name", s.Name, "count", len(newTcs))
+	if s.WebhookURL.Valid || (s.Email.Valid && m.notifier.config.SmtpHost != "") {
+		// notifications shouldn't hold up processing, and a failure shouldn't cause it to be retried
+		go func() {
+			if err := m.notifier.notify(s, newTcs); err != nil {
+				m.logger.Errorw("failed to notify saved search", "id", s.ID, "name", s.Name, "error", err)
+			}
+		}()
+	}
+	return nil
+}
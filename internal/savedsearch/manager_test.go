@@ -0,0 +1,34 @@
+package savedsearch
+
+import (
+	"errors"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	s := model.SavedSearch{Name: "test"}
+	assert.NoError(t, validate(&s))
+	assert.Equal(t, model.SavedSearchOrderByUpdatedAt, s.OrderBy, "order should default to most recently updated")
+	assert.True(t, s.OrderDesc)
+	assert.NotNil(t, s.Facets, "facets should be saved as an empty list rather than null")
+
+	assert.Error(t, validate(&model.SavedSearch{}), "a name is required")
+	assert.Error(t, validate(&model.SavedSearch{Name: "test", OrderBy: "seeders"}))
+	assert.Error(t, validate(&model.SavedSearch{Name: "test", WebhookURL: model.NewNullString("ftp://example.com")}))
+	assert.Error(t, validate(&model.SavedSearch{Name: "test", Email: model.NewNullString("not an email")}))
+	assert.NoError(t, validate(&model.SavedSearch{
+		Name:       "test",
+		WebhookURL: model.NewNullString("https://example.com/hook"),
+		Email:      model.NewNullString("someone@example.com"),
+	}))
+
+	err := validate(&model.SavedSearch{
+		Name:   "test",
+		Facets: []model.SavedSearchFacet{{Key: "seeders", Values: []string{"1"}}},
+	})
+	assert.True(t, errors.Is(err, ErrUnknownFacet))
+}
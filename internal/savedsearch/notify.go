@@ -0,0 +1,136 @@
+package savedsearch
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/torrentexport"
+	"mime"
+	"net"
+	"net/http"
+	"net/smtp"
+	"strconv"
+	"strings"
+)
+
+// Notification is the body of the request sent to the webhook URL of a saved search with new matches.
+type Notification struct {
+	Event       string                  `json:"event"`
+	SavedSearch NotificationSavedSearch `json:"savedSearch"`
+	Torrents    []NotificationTorrent   `json:"torrents"`
+}
+
+type NotificationSavedSearch struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+type NotificationTorrent struct {
+	InfoHash        string `json:"infoHash"`
+	Name            string `json:"name"`
+	Size            uint64 `json:"size"`
+	ContentType     string `json:"contentType,omitempty"`
+	Title           string `json:"title"`
+	VideoResolution string `json:"videoResolution,omitempty"`
+	MagnetUri       string `json:"magnetUri"`
+}
+
+func newNotification(s model.SavedSearch, tcs []model.TorrentContent, trackers []string) Notification {
+	n := Notification{
+		Event: "matched",
+		SavedSearch: NotificationSavedSearch{
+			ID:   s.ID,
+			Name: s.Name,
+		},
+		Torrents: make([]NotificationTorrent, 0, len(tcs)),
+	}
+	for _, tc := range tcs {
+		t := NotificationTorrent{
+			InfoHash:  tc.InfoHash.String(),
+			Name:      tc.Torrent.Name,
+			Size:      tc.Torrent.Size,
+			Title:     tc.Title(),
+			MagnetUri: tc.Torrent.MagnetUri(trackers...),
+		}
+		if tc.ContentType.Valid {
+			t.ContentType = tc.ContentType.ContentType.String()
+		}
+		if tc.VideoResolution.Valid {
+			t.VideoResolution = tc.VideoResolution.VideoResolution.Label()
+		}
+		n.Torrents = append(n.Torrents, t)
+	}
+	return n
+}
+
+type notifier struct {
+	config      Config
+	httpClient  *http.Client
+	trackerList torrentexport.TrackerList
+}
+
+func (n notifier) notify(s model.SavedSearch, tcs []model.TorrentContent) error {
+	notification := newNotification(s, tcs, n.trackerList.Trackers())
+	var errs []error
+	if s.WebhookURL.Valid {
+		if err := n.post(s.WebhookURL.String, notification); err != nil {
+			errs = append(errs, fmt.Errorf("webhook: %w", err))
+		}
+	}
+	if s.Email.Valid && n.config.SmtpHost != "" {
+		if err := n.email(s.Email.String, notification); err != nil {
+			errs = append(errs, fmt.Errorf("email: %w", err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (n notifier) post(url string, notification Notification) error {
+	body, err := json.Marshal(notification)
+	if err != nil {
+		return err
+	}
+	res, err := n.httpClient.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %d", res.StatusCode)
+	}
+	return nil
+}
+
+func (n notifier) email(to string, notification Notification) error {
+	var auth smtp.Auth
+	if n.config.SmtpUsername != "" {
+		auth = smtp.PlainAuth("", n.config.SmtpUsername, n.config.SmtpPassword, n.config.SmtpHost)
+	}
+	return smtp.SendMail(
+		net.JoinHostPort(n.config.SmtpHost, strconv.FormatUint(uint64(n.config.SmtpPort), 10)),
+		auth,
+		n.config.SmtpFrom,
+		[]string{to},
+		newEmailMessage(n.config.SmtpFrom, to, notification),
+	)
+}
+
+func newEmailMessage(from, to string, notification Notification) []byte {
+	var b strings.Builder
+	b.WriteString("From: " + from + "\r\n")
+	b.WriteString("To: " + to + "\r\n")
+	subject := fmt.Sprintf("%d new matches for saved search %q", len(notification.Torrents), notification.SavedSearch.Name)
+	b.WriteString("Subject: " + mime.QEncoding.Encode("utf-8", subject) + "\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	b.WriteString("\r\n")
+	for _, t := range notification.Torrents {
+		b.WriteString(t.Title + "\r\n")
+		if t.Name != t.Title {
+			b.WriteString(t.Name + "\r\n")
+		}
+		b.WriteString(t.MagnetUri + "\r\n\r\n")
+	}
+	return []byte(b.String())
+}
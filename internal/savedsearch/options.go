@@ -0,0 +1,96 @@
+package savedsearch
+
+import (
+	"errors"
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/query"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/search"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"gorm.io/gorm/clause"
+)
+
+var ErrUnknownFacet = errors.New("unknown facet")
+
+var facets = map[string]func(...query.FacetOption) query.Facet{
+	search.TorrentContentTypeFacetKey: search.TorrentContentTypeFacet,
+	search.TorrentSourceFacetKey:      search.TorrentSourceFacet,
+	search.TorrentTagFacetKey:         search.TorrentTagsFacet,
+	search.TorrentFileTypeFacetKey:    search.TorrentFileTypeFacet,
+	search.LanguageFacetKey:           search.TorrentContentLanguageFacet,
+	search.ContentGenreFacetKey:       search.TorrentContentGenreFacet,
+	search.ReleaseYearFacetKey:        search.ReleaseYearFacet,
+	search.VideoResolutionFacetKey:    search.VideoResolutionFacet,
+	search.VideoSourceFacetKey:        search.VideoSourceFacet,
+	search.VideoCodecFacetKey:         search.VideoCodecFacet,
+	search.Video3dFacetKey:            search.Video3dFacet,
+	search.VideoModifierFacetKey:      search.VideoModifierFacet,
+	search.HdrFormatFacetKey:          search.HdrFormatFacet,
+	search.AudioFormatFacetKey:        search.AudioFormatFacet,
+	search.ReleaseTokenFacetKey:       search.TorrentContentReleaseTokenFacet,
+}
+
+// NewFacet returns the saved form of a facet's filter.
+func NewFacet(f query.Facet) model.SavedSearchFacet {
+	return model.SavedSearchFacet{
+		Key:    f.Key(),
+		Logic:  f.Logic(),
+		Values: f.Filter().Values(),
+	}
+}
+
+// Option returns the search options of a saved search: its query string, facet filters and ordering,
+// with relevance weighed according to the search config. Torrents of ignored content and quarantined torrents never match.
+func Option(s model.SavedSearch, searchConfig search.Config) (query.Option, error) {
+	options := []query.Option{
+		search.TorrentContentDefaultOption(),
+		query.Where(search.TorrentContentNotIgnoredCriteria()),
+		query.Where(search.TorrentContentQuarantinedCriteria(false)),
+	}
+	if s.QueryString.Valid && s.QueryString.String != "" {
+		options = append(options, query.QueryString(s.QueryString.String))
+	}
+	qFacets := make([]query.Facet, 0, len(s.Facets))
+	for _, f := range s.Facets {
+		fn, ok := facets[f.Key]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownFacet, f.Key)
+		}
+		filter := make(query.FacetFilter, len(f.Values))
+		for _, v := range f.Values {
+			filter[v] = struct{}{}
+		}
+		qFacets = append(qFacets, fn(query.FacetUsesLogic(f.Logic), query.FacetHasFilter(filter)))
+	}
+	if len(qFacets) > 0 {
+		options = append(options, query.WithFacet(qFacets...))
+	}
+	if orderBy, ok := orderByOption(s, searchConfig); ok {
+		options = append(options, orderBy)
+	}
+	return query.Options(options...), nil
+}
+
+// orderByOption returns the ordering of a saved search, which takes precedence over the default ordering
+// of most recently updated first. Relevance is only meaningful with a query string, and is always most relevant first.
+func orderByOption(s model.SavedSearch, searchConfig search.Config) (query.Option, bool) {
+	switch s.OrderBy {
+	case model.SavedSearchOrderByRelevance:
+		if s.QueryString.Valid && s.QueryString.String != "" {
+			return search.TorrentContentOrderByRelevance(searchConfig), true
+		}
+	case model.SavedSearchOrderByUpdatedAt:
+		if !s.OrderDesc {
+			return query.OrderBy(clause.OrderByColumn{
+				Column:  clause.Column{Table: clause.CurrentTable, Name: "updated_at"},
+				Reorder: true,
+			}), true
+		}
+	case model.SavedSearchOrderBySize:
+		return query.OrderBy(clause.OrderByColumn{
+			Column:  clause.Column{Table: model.TableNameTorrent, Name: "size"},
+			Desc:    s.OrderDesc,
+			Reorder: true,
+		}), true
+	}
+	return nil, false
+}
@@ -0,0 +1,17 @@
+package savedsearchfx
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/config/configfx"
+	"github.com/bitmagnet-io/bitmagnet/internal/savedsearch"
+	"go.uber.org/fx"
+)
+
+func New() fx.Option {
+	return fx.Module(
+		"saved_search",
+		configfx.NewConfigModule[savedsearch.Config]("saved_searches", savedsearch.NewDefaultConfig()),
+		fx.Provide(
+			savedsearch.New,
+		),
+	)
+}
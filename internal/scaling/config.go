@@ -0,0 +1,40 @@
+package scaling
+
+import "time"
+
+type Config struct {
+	// Profiles name sets of workers that are scaled together, which can be run with `worker run --keys=<profile>`.
+	Profiles map[string][]string
+	// LeaderElection ensures that each of the singleton workers runs in only one process at a time, however many processes
+	// are started with it; the other processes stand by to take over if the leading process stops.
+	LeaderElection bool `mapstructure:"leader_election"`
+	// Singletons are the keys of the workers that must only run in one process at a time.
+	Singletons []string
+	// LeaseTTL is how long a process leads without renewing its lease, after which another process may take over.
+	LeaseTTL time.Duration `mapstructure:"lease_ttl"`
+}
+
+func NewDefaultConfig() Config {
+	singletons := []string{
+		"blocklist",
+		"content_refresh",
+		"dump_import",
+		"files_index",
+		"index_stats",
+		"maintenance",
+		"retention",
+		"torznab_import",
+		"tracker_scraper",
+		"webhook_dispatcher",
+	}
+	return Config{
+		Profiles: map[string][]string{
+			"http":      {"http_server"},
+			"processor": {"queue_server"},
+			"scheduler": singletons,
+		},
+		LeaderElection: true,
+		Singletons:     singletons,
+		LeaseTTL:       30 * time.Second,
+	}
+}
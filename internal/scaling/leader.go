@@ -0,0 +1,127 @@
+package scaling
+
+import (
+	"context"
+	"github.com/prometheus/client_golang/prometheus"
+	redis "github.com/redis/go-redis/v9"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"time"
+)
+
+// leaseKeyPrefix is the prefix of the Redis keys holding the lease of each singleton worker
+const leaseKeyPrefix = "bitmagnet:lease:"
+
+// renewScript extends a lease if it's still held by the holder
+var renewScript = redis.NewScript(`
+if redis.call("get", KEYS[1]) == ARGV[1] then
+	return redis.call("pexpire", KEYS[1], ARGV[2])
+end
+return 0`)
+
+// releaseScript deletes a lease if it's still held by the holder
+var releaseScript = redis.NewScript(`
+if redis.call("get", KEYS[1]) == ARGV[1] then
+	return redis.call("del", KEYS[1])
+end
+return 0`)
+
+type lease struct {
+	key    string
+	holder string
+	ttl    time.Duration
+	redis  *redis.Client
+}
+
+// acquire acquires the lease if it's free, or renews it if it's already held, returning whether it's held.
+func (l lease) acquire(ctx context.Context, held bool) (bool, error) {
+	if held {
+		n, err := renewScript.Run(ctx, l.redis, []string{l.key}, l.holder, l.ttl.Milliseconds()).Int()
+		return n == 1, err
+	}
+	return l.redis.SetNX(ctx, l.key, l.holder, l.ttl).Result()
+}
+
+func (l lease) release(ctx context.Context) error {
+	return releaseScript.Run(ctx, l.redis, []string{l.key}, l.holder).Err()
+}
+
+// elected starts the hook of a singleton worker when its lease is acquired, and stops it when the lease is lost.
+// The lease is renewed at a third of its TTL; if a renewal fails the worker is stopped, as another process may take over
+// once the lease expires.
+type elected struct {
+	lease   lease
+	hook    fx.Hook
+	leading prometheus.Gauge
+	logger  *zap.SugaredLogger
+	stopped chan struct{}
+	done    chan struct{}
+}
+
+func (e *elected) run() {
+	defer close(e.done)
+	ticker := time.NewTicker(e.lease.ttl / 3)
+	defer ticker.Stop()
+	leading := false
+	for {
+		held, err := e.acquire(leading)
+		if err != nil {
+			e.logger.Warnw("failed to acquire lease", "error", err)
+		}
+		if held && !leading {
+			if err := e.start(); err != nil {
+				e.logger.Errorw("failed to start worker", "error", err)
+				e.release()
+			} else {
+				e.logger.Infow("acquired lease, started worker")
+				leading = true
+				e.leading.Set(1)
+			}
+		} else if !held && leading {
+			e.logger.Warnw("lost lease, stopping worker")
+			e.stop()
+			leading = false
+			e.leading.Set(0)
+		}
+		select {
+		case <-e.stopped:
+			if leading {
+				e.stop()
+				e.release()
+				e.leading.Set(0)
+			}
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (e *elected) acquire(held bool) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), e.lease.ttl/3)
+	defer cancel()
+	return e.lease.acquire(ctx, held)
+}
+
+func (e *elected) release() {
+	ctx, cancel := context.WithTimeout(context.Background(), e.lease.ttl/3)
+	defer cancel()
+	if err := e.lease.release(ctx); err != nil {
+		e.logger.Warnw("failed to release lease", "error", err)
+	}
+}
+
+func (e *elected) start() error {
+	if e.hook.OnStart == nil {
+		return nil
+	}
+	return e.hook.OnStart(context.Background())
+}
+
+func (e *elected) stop() {
+	if e.hook.OnStop == nil {
+		return
+	}
+	if err := e.hook.OnStop(context.Background()); err != nil {
+		e.logger.Errorw("failed to stop worker", "error", err)
+	}
+}
@@ -0,0 +1,121 @@
+package scaling
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/worker"
+	"github.com/prometheus/client_golang/prometheus"
+	redis "github.com/redis/go-redis/v9"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"os"
+	"sort"
+)
+
+type Params struct {
+	fx.In
+	Config Config
+	Redis  lazy.Lazy[*redis.Client]
+	Logger *zap.SugaredLogger
+}
+
+type Result struct {
+	fx.Out
+	Decorators []worker.Decorator   `group:"worker_decorators,flatten"`
+	Profiles   []worker.Profile     `group:"worker_profiles,flatten"`
+	Leader     prometheus.Collector `group:"prometheus_collectors"`
+}
+
+// New provides the configured worker profiles and, if leader election is enabled, decorates each singleton worker
+// so that it only runs while this process holds its lease.
+func New(p Params) (Result, error) {
+	leader := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "bitmagnet",
+		Subsystem: "scaling",
+		Name:      "leader",
+		Help:      "Whether this process leads, and so runs, each enabled singleton worker.",
+	}, []string{"worker"})
+	profiles := make([]worker.Profile, 0, len(p.Config.Profiles))
+	for name, keys := range p.Config.Profiles {
+		profiles = append(profiles, worker.Profile{Name: name, Keys: keys})
+	}
+	sort.Slice(profiles, func(i, j int) bool {
+		return profiles[i].Name < profiles[j].Name
+	})
+	var decorators []worker.Decorator
+	if p.Config.LeaderElection {
+		if p.Config.LeaseTTL <= 0 {
+			return Result{}, fmt.Errorf("invalid lease TTL: %s", p.Config.LeaseTTL)
+		}
+		holder, err := newHolderID()
+		if err != nil {
+			return Result{}, err
+		}
+		logger := p.Logger.Named("scaling")
+		for _, key := range p.Config.Singletons {
+			key := key
+			decorators = append(decorators, worker.Decorator{
+				Key: key,
+				Decorate: func(hook fx.Hook) fx.Hook {
+					var e *elected
+					return fx.Hook{
+						OnStart: func(context.Context) error {
+							r, err := p.Redis.Get()
+							if err != nil {
+								return err
+							}
+							e = &elected{
+								lease: lease{
+									key:    leaseKeyPrefix + key,
+									holder: holder,
+									ttl:    p.Config.LeaseTTL,
+									redis:  r,
+								},
+								hook:    hook,
+								leading: leader.With(prometheus.Labels{"worker": key}),
+								logger:  logger.With("worker", key),
+								stopped: make(chan struct{}),
+								done:    make(chan struct{}),
+							}
+							go e.run()
+							return nil
+						},
+						OnStop: func(ctx context.Context) error {
+							if e == nil {
+								return nil
+							}
+							close(e.stopped)
+							select {
+							case <-e.done:
+								return nil
+							case <-ctx.Done():
+								return ctx.Err()
+							}
+						},
+					}
+				},
+			})
+		}
+	}
+	return Result{
+		Decorators: decorators,
+		Profiles:   profiles,
+		Leader:     leader,
+	}, nil
+}
+
+// newHolderID returns an ID identifying this process as the holder of leases.
+func newHolderID() (string, error) {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return "", err
+	}
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s:%d:%s", hostname, os.Getpid(), hex.EncodeToString(b)), nil
+}
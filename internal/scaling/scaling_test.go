@@ -0,0 +1,30 @@
+package scaling
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"testing"
+)
+
+func TestNew(t *testing.T) {
+	config := NewDefaultConfig()
+	result, err := New(Params{Config: config, Logger: zap.NewNop().Sugar()})
+	require.NoError(t, err)
+	names := make([]string, 0, len(result.Profiles))
+	for _, p := range result.Profiles {
+		names = append(names, p.Name)
+	}
+	assert.Equal(t, []string{"http", "processor", "scheduler"}, names)
+	require.Len(t, result.Decorators, len(config.Singletons))
+	assert.Equal(t, config.Singletons[0], result.Decorators[0].Key)
+}
+
+func TestNew__leaderElectionDisabled(t *testing.T) {
+	config := NewDefaultConfig()
+	config.LeaderElection = false
+	result, err := New(Params{Config: config, Logger: zap.NewNop().Sugar()})
+	require.NoError(t, err)
+	assert.Empty(t, result.Decorators)
+	assert.Len(t, result.Profiles, 3)
+}
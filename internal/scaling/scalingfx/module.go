@@ -0,0 +1,17 @@
+package scalingfx
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/config/configfx"
+	"github.com/bitmagnet-io/bitmagnet/internal/scaling"
+	"go.uber.org/fx"
+)
+
+func New() fx.Option {
+	return fx.Module(
+		"scaling",
+		configfx.NewConfigModule[scaling.Config]("scaling", scaling.NewDefaultConfig()),
+		fx.Provide(
+			scaling.New,
+		),
+	)
+}
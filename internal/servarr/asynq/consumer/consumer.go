@@ -0,0 +1,67 @@
+package consumer
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/query"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/search"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/queue/consumer"
+	"github.com/bitmagnet-io/bitmagnet/internal/servarr"
+	"go.uber.org/fx"
+)
+
+type Params struct {
+	fx.In
+	Search lazy.Lazy[search.Search]
+	Pusher lazy.Lazy[servarr.Pusher]
+}
+
+type Result struct {
+	fx.Out
+	Consumer lazy.Lazy[consumer.Consumer] `group:"queue_consumers"`
+}
+
+func New(p Params) Result {
+	return Result{
+		Consumer: lazy.New(func() (consumer.Consumer, error) {
+			s, err := p.Search.Get()
+			if err != nil {
+				return nil, err
+			}
+			pusher, err := p.Pusher.Get()
+			if err != nil {
+				return nil, err
+			}
+			return consumer.New[servarr.MessageParams](
+				servarr.MessageName,
+				cns{s, pusher},
+			), nil
+		}),
+	}
+}
+
+type cns struct {
+	search search.Search
+	pusher servarr.Pusher
+}
+
+// Handle loads the releases to push, which are skipped if they've since been removed or quarantined.
+func (c cns) Handle(ctx context.Context, params servarr.MessageParams) error {
+	result, err := c.search.TorrentContent(
+		ctx,
+		search.TorrentContentDefaultHydrate(),
+		search.TorrentContentCoreJoins(),
+		query.Where(search.TorrentInfoHashCriteria(params.InfoHashes...)),
+	)
+	if err != nil {
+		return err
+	}
+	tcs := make([]model.TorrentContent, 0, len(result.Items))
+	for _, item := range result.Items {
+		if !item.Quarantined {
+			tcs = append(tcs, item.TorrentContent)
+		}
+	}
+	return c.pusher.Push(ctx, tcs)
+}
@@ -0,0 +1,16 @@
+package producer
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/queue/producer"
+	"github.com/bitmagnet-io/bitmagnet/internal/servarr"
+	"github.com/hibiken/asynq"
+)
+
+func New() producer.Producer[servarr.MessageParams] {
+	return producer.New[servarr.MessageParams](
+		servarr.MessageName,
+		asynq.Queue(servarr.MessageName),
+		// failed pushes are logged rather than returned, so a message only fails if the releases couldn't be loaded
+		asynq.MaxRetry(3),
+	)
+}
@@ -0,0 +1,33 @@
+package publisher
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/bitmagnet-io/bitmagnet/internal/queue/producer"
+	"github.com/bitmagnet-io/bitmagnet/internal/queue/publisher"
+	"github.com/bitmagnet-io/bitmagnet/internal/servarr"
+	"github.com/hibiken/asynq"
+	"go.uber.org/fx"
+)
+
+type Params struct {
+	fx.In
+	Client   lazy.Lazy[*asynq.Client]
+	Producer producer.Producer[servarr.MessageParams]
+}
+
+type Result struct {
+	fx.Out
+	Publisher lazy.Lazy[publisher.Publisher[servarr.MessageParams]]
+}
+
+func New(p Params) Result {
+	return Result{
+		Publisher: lazy.New(func() (publisher.Publisher[servarr.MessageParams], error) {
+			client, err := p.Client.Get()
+			if err != nil {
+				return nil, err
+			}
+			return publisher.New[servarr.MessageParams](client, p.Producer), nil
+		}),
+	}
+}
@@ -0,0 +1,34 @@
+package servarr
+
+import "time"
+
+type Config struct {
+	// Targets maps target names to the Radarr and Sonarr instances that newly classified releases are pushed to.
+	Targets map[string]TargetConfig
+	// Timeout applies to each push request.
+	Timeout time.Duration
+}
+
+type TargetConfig struct {
+	// Type is either radarr, which is pushed movies, or sonarr, which is pushed TV shows.
+	Type string `mapstructure:"type"`
+	// URL is the base URL of the instance, for example http://radarr:7878.
+	URL    string `mapstructure:"url"`
+	APIKey string `mapstructure:"api_key"`
+	// MinVideoResolution and MaxVideoResolution limit the resolutions pushed (e.g. V1080p); any resolution is pushed if empty.
+	MinVideoResolution string `mapstructure:"min_video_resolution"`
+	MaxVideoResolution string `mapstructure:"max_video_resolution"`
+	// VideoSources, if set, limits pushed releases to the given sources (e.g. BluRay, WEBDL).
+	VideoSources []string `mapstructure:"video_sources"`
+	// MinSize and MaxSize limit the size of pushed releases in bytes; zero is unlimited.
+	MinSize uint64 `mapstructure:"min_size"`
+	MaxSize uint64 `mapstructure:"max_size"`
+	// IncludeUnmatched pushes releases that weren't matched to any movie or TV show; Radarr and Sonarr will attempt to parse them.
+	IncludeUnmatched bool `mapstructure:"include_unmatched"`
+}
+
+func NewDefaultConfig() Config {
+	return Config{
+		Timeout: time.Second * 10,
+	}
+}
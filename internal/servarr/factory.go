@@ -0,0 +1,68 @@
+package servarr
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/queue/publisher"
+	"github.com/bitmagnet-io/bitmagnet/internal/torrentexport"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"net/http"
+)
+
+type Params struct {
+	fx.In
+	Config      Config
+	Dao         lazy.Lazy[*dao.Query]
+	TrackerList lazy.Lazy[torrentexport.TrackerList]
+	Publisher   lazy.Lazy[publisher.Publisher[MessageParams]]
+	Logger      *zap.SugaredLogger
+}
+
+type Result struct {
+	fx.Out
+	Pusher    lazy.Lazy[Pusher]
+	Scheduler lazy.Lazy[Scheduler]
+}
+
+func New(p Params) Result {
+	return Result{
+		Scheduler: lazy.New(func() (Scheduler, error) {
+			targets, err := newTargets(p.Config.Targets)
+			if err != nil {
+				return nil, err
+			}
+			pub, err := p.Publisher.Get()
+			if err != nil {
+				return nil, err
+			}
+			return scheduler{
+				targets:   targets,
+				publisher: pub,
+			}, nil
+		}),
+		Pusher: lazy.New(func() (Pusher, error) {
+			d, err := p.Dao.Get()
+			if err != nil {
+				return nil, err
+			}
+			tl, err := p.TrackerList.Get()
+			if err != nil {
+				return nil, err
+			}
+			targets, err := newTargets(p.Config.Targets)
+			if err != nil {
+				return nil, err
+			}
+			return pusher{
+				dao:     d,
+				targets: targets,
+				httpClient: &http.Client{
+					Timeout: p.Config.Timeout,
+				},
+				trackerList: tl,
+				logger:      p.Logger.Named("servarr"),
+			}, nil
+		}),
+	}
+}
@@ -0,0 +1,12 @@
+package servarr
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+)
+
+// MessageName is the type of queue message that pushes releases, and the name of the queue it's published to.
+const MessageName = "servarr_push"
+
+type MessageParams struct {
+	InfoHashes []protocol.ID
+}
@@ -0,0 +1,97 @@
+package servarr
+
+import (
+	"context"
+	"database/sql/driver"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"github.com/bitmagnet-io/bitmagnet/internal/torrentexport"
+	"go.uber.org/zap"
+	"gorm.io/gorm/clause"
+	"net/http"
+	"strings"
+)
+
+// Pusher pushes newly classified releases to the configured Radarr and Sonarr instances,
+// so that they can be grabbed without waiting for the next torznab poll.
+type Pusher interface {
+	// Push sends each release accepted by a target's filters to that target; each release is pushed to a target at most once.
+	// Failed pushes are logged rather than returned, so that they don't cause the batch to be reprocessed.
+	Push(ctx context.Context, tcs []model.TorrentContent) error
+}
+
+type pusher struct {
+	dao         *dao.Query
+	targets     []target
+	httpClient  *http.Client
+	trackerList torrentexport.TrackerList
+	logger      *zap.SugaredLogger
+}
+
+func (p pusher) Push(ctx context.Context, tcs []model.TorrentContent) error {
+	for _, t := range p.targets {
+		var accepted []model.TorrentContent
+		for _, tc := range tcs {
+			if t.accepts(tc) {
+				accepted = append(accepted, tc)
+			}
+		}
+		if len(accepted) == 0 {
+			continue
+		}
+		if err := p.push(ctx, t, accepted); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			p.logger.Errorw("failed to push releases", "target", t.name, "error", err)
+		}
+	}
+	return nil
+}
+
+func (p pusher) push(ctx context.Context, t target, tcs []model.TorrentContent) error {
+	infoHashes := make([]driver.Valuer, 0, len(tcs))
+	for _, tc := range tcs {
+		infoHashes = append(infoHashes, tc.InfoHash)
+	}
+	existing, err := p.dao.ServarrPush.WithContext(ctx).Where(
+		p.dao.ServarrPush.Target.Eq(t.name),
+		p.dao.ServarrPush.InfoHash.In(infoHashes...),
+	).Find()
+	if err != nil {
+		return err
+	}
+	seen := make(map[protocol.ID]struct{}, len(tcs))
+	for _, e := range existing {
+		seen[e.InfoHash] = struct{}{}
+	}
+	for _, tc := range tcs {
+		if _, ok := seen[tc.InfoHash]; ok {
+			continue
+		}
+		seen[tc.InfoHash] = struct{}{}
+		d, pushErr := t.push(ctx, p.httpClient, newRelease(tc, p.trackerList.Trackers()))
+		if pushErr != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			// the push isn't recorded, so it's retried if the torrent is processed again
+			p.logger.Warnw("failed to push release", "target", t.name, "info_hash", tc.InfoHash, "error", pushErr)
+			continue
+		}
+		p.logger.Debugw("pushed release", "target", t.name, "info_hash", tc.InfoHash, "approved", d.Approved)
+		record := &model.ServarrPush{
+			Target:   t.name,
+			InfoHash: tc.InfoHash,
+			Approved: d.Approved,
+		}
+		if len(d.Rejections) > 0 {
+			record.Rejections = model.NewNullString(strings.Join(d.Rejections, "; "))
+		}
+		if err := p.dao.ServarrPush.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
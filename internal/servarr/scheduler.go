@@ -0,0 +1,36 @@
+package servarr
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"github.com/bitmagnet-io/bitmagnet/internal/queue/publisher"
+)
+
+// Scheduler queues releases to be pushed, so that the processing of a batch isn't held up by requests to the targets.
+type Scheduler interface {
+	// Schedule queues the releases accepted by any target to be pushed by the Pusher.
+	Schedule(ctx context.Context, tcs []model.TorrentContent) error
+}
+
+type scheduler struct {
+	targets   []target
+	publisher publisher.Publisher[MessageParams]
+}
+
+func (s scheduler) Schedule(ctx context.Context, tcs []model.TorrentContent) error {
+	var infoHashes []protocol.ID
+	for _, tc := range tcs {
+		for _, t := range s.targets {
+			if t.accepts(tc) {
+				infoHashes = append(infoHashes, tc.InfoHash)
+				break
+			}
+		}
+	}
+	if len(infoHashes) == 0 {
+		return nil
+	}
+	_, err := s.publisher.Publish(ctx, MessageParams{InfoHashes: infoHashes})
+	return err
+}
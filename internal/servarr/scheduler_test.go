@@ -0,0 +1,46 @@
+package servarr
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+type stubPublisher struct {
+	published []MessageParams
+}
+
+func (p *stubPublisher) Publish(_ context.Context, payload MessageParams, _ ...asynq.Option) (*asynq.TaskInfo, error) {
+	p.published = append(p.published, payload)
+	return &asynq.TaskInfo{}, nil
+}
+
+func TestScheduler(t *testing.T) {
+	t.Parallel()
+
+	radarr, err := newTarget("radarr", TargetConfig{Type: "radarr", URL: "http://radarr:7878", APIKey: "key"})
+	require.NoError(t, err)
+	pub := &stubPublisher{}
+	s := scheduler{targets: []target{radarr}, publisher: pub}
+
+	movie := model.TorrentContent{
+		InfoHash:    protocol.ID{1},
+		ContentType: model.NewNullContentType(model.ContentTypeMovie),
+		ContentID:   model.NewNullString("278"),
+	}
+	tv := model.TorrentContent{
+		InfoHash:    protocol.ID{2},
+		ContentType: model.NewNullContentType(model.ContentTypeTvShow),
+		ContentID:   model.NewNullString("1399"),
+	}
+
+	require.NoError(t, s.Schedule(context.Background(), []model.TorrentContent{tv}))
+	assert.Empty(t, pub.published, "nothing should be published if no target accepts the releases")
+
+	require.NoError(t, s.Schedule(context.Background(), []model.TorrentContent{movie, tv}))
+	assert.Equal(t, []MessageParams{{InfoHashes: []protocol.ID{movie.InfoHash}}}, pub.published)
+}
@@ -0,0 +1,23 @@
+package servarrfx
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/config/configfx"
+	"github.com/bitmagnet-io/bitmagnet/internal/servarr"
+	"github.com/bitmagnet-io/bitmagnet/internal/servarr/asynq/consumer"
+	"github.com/bitmagnet-io/bitmagnet/internal/servarr/asynq/producer"
+	"github.com/bitmagnet-io/bitmagnet/internal/servarr/asynq/publisher"
+	"go.uber.org/fx"
+)
+
+func New() fx.Option {
+	return fx.Module(
+		"servarr",
+		configfx.NewConfigModule[servarr.Config]("servarr", servarr.NewDefaultConfig()),
+		fx.Provide(
+			servarr.New,
+			consumer.New,
+			producer.New,
+			publisher.New,
+		),
+	)
+}
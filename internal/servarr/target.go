@@ -0,0 +1,222 @@
+package servarr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	typeRadarr = "radarr"
+	typeSonarr = "sonarr"
+)
+
+// target is a configured Radarr or Sonarr instance, with the filters deciding which releases it is pushed.
+type target struct {
+	name               string
+	pushURL            string
+	apiKey             string
+	contentType        model.ContentType
+	minVideoResolution model.NullVideoResolution
+	maxVideoResolution model.NullVideoResolution
+	videoSources       []model.VideoSource
+	minSize            uint64
+	maxSize            uint64
+	includeUnmatched   bool
+}
+
+func newTargets(configs map[string]TargetConfig) ([]target, error) {
+	targets := make([]target, 0, len(configs))
+	for name, c := range configs {
+		t, err := newTarget(name, c)
+		if err != nil {
+			return nil, fmt.Errorf("servarr target %s: %w", name, err)
+		}
+		targets = append(targets, t)
+	}
+	slices.SortFunc(targets, func(a, b target) int {
+		return strings.Compare(a.name, b.name)
+	})
+	return targets, nil
+}
+
+func newTarget(name string, c TargetConfig) (target, error) {
+	t := target{
+		name:             name,
+		apiKey:           c.APIKey,
+		minSize:          c.MinSize,
+		maxSize:          c.MaxSize,
+		includeUnmatched: c.IncludeUnmatched,
+	}
+	switch c.Type {
+	case typeRadarr:
+		t.contentType = model.ContentTypeMovie
+	case typeSonarr:
+		t.contentType = model.ContentTypeTvShow
+	default:
+		return target{}, fmt.Errorf("invalid type %q, expected %s or %s", c.Type, typeRadarr, typeSonarr)
+	}
+	u, err := url.Parse(c.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return target{}, fmt.Errorf("invalid URL: %s", c.URL)
+	}
+	t.pushURL = strings.TrimSuffix(u.String(), "/") + "/api/v3/release/push"
+	if c.APIKey == "" {
+		return target{}, errors.New("missing API key")
+	}
+	if c.MinVideoResolution != "" {
+		r, err := model.ParseVideoResolution(c.MinVideoResolution)
+		if err != nil {
+			return target{}, err
+		}
+		t.minVideoResolution = model.NewNullVideoResolution(r)
+	}
+	if c.MaxVideoResolution != "" {
+		r, err := model.ParseVideoResolution(c.MaxVideoResolution)
+		if err != nil {
+			return target{}, err
+		}
+		t.maxVideoResolution = model.NewNullVideoResolution(r)
+	}
+	for _, s := range c.VideoSources {
+		source, err := model.ParseVideoSource(s)
+		if err != nil {
+			return target{}, err
+		}
+		t.videoSources = append(t.videoSources, source)
+	}
+	return t, nil
+}
+
+// accepts returns true if the release passes the target's filters.
+func (t target) accepts(tc model.TorrentContent) bool {
+	if !tc.ContentType.Valid || tc.ContentType.ContentType != t.contentType {
+		return false
+	}
+	if !t.includeUnmatched && !tc.ContentID.Valid {
+		return false
+	}
+	if t.minVideoResolution.Valid &&
+		(!tc.VideoResolution.Valid || !tc.VideoResolution.VideoResolution.AtLeast(t.minVideoResolution.VideoResolution)) {
+		return false
+	}
+	if t.maxVideoResolution.Valid &&
+		(!tc.VideoResolution.Valid || !t.maxVideoResolution.VideoResolution.AtLeast(tc.VideoResolution.VideoResolution)) {
+		return false
+	}
+	if len(t.videoSources) > 0 && (!tc.VideoSource.Valid || !slices.Contains(t.videoSources, tc.VideoSource.VideoSource)) {
+		return false
+	}
+	if t.minSize > 0 && tc.Torrent.Size < t.minSize {
+		return false
+	}
+	if t.maxSize > 0 && tc.Torrent.Size > t.maxSize {
+		return false
+	}
+	return true
+}
+
+// release is the body of a release push request, common to the v3 APIs of Radarr and Sonarr.
+type release struct {
+	Title       string    `json:"title"`
+	InfoHash    string    `json:"infoHash"`
+	MagnetURL   string    `json:"magnetUrl"`
+	DownloadURL string    `json:"downloadUrl"`
+	Protocol    string    `json:"protocol"`
+	Indexer     string    `json:"indexer"`
+	Size        uint64    `json:"size"`
+	Seeders     *uint     `json:"seeders,omitempty"`
+	Leechers    *uint     `json:"leechers,omitempty"`
+	PublishDate time.Time `json:"publishDate"`
+	ImdbID      string    `json:"imdbId,omitempty"`
+	TmdbID      int       `json:"tmdbId,omitempty"`
+	TvdbID      int       `json:"tvdbId,omitempty"`
+}
+
+func newRelease(tc model.TorrentContent, trackers []string) release {
+	magnet := tc.Torrent.MagnetUri(trackers...)
+	r := release{
+		Title:       tc.Torrent.Name,
+		InfoHash:    tc.InfoHash.String(),
+		MagnetURL:   magnet,
+		DownloadURL: magnet,
+		Protocol:    "torrent",
+		Indexer:     "bitmagnet",
+		Size:        tc.Torrent.Size,
+		PublishDate: tc.Torrent.CreatedAt,
+	}
+	if seeders := tc.Torrent.Seeders(); seeders.Valid {
+		r.Seeders = &seeders.Uint
+	}
+	if leechers := tc.Torrent.Leechers(); leechers.Valid {
+		r.Leechers = &leechers.Uint
+	}
+	if id, ok := tc.Content.Identifier("imdb"); ok {
+		r.ImdbID = id
+	}
+	if id, ok := tc.Content.Identifier("tmdb"); ok {
+		r.TmdbID, _ = strconv.Atoi(id)
+	}
+	if id, ok := tc.Content.Identifier("tvdb"); ok {
+		r.TvdbID, _ = strconv.Atoi(id)
+	}
+	return r
+}
+
+// decision is the part of the push response describing whether the release was accepted for download.
+type decision struct {
+	Approved   bool     `json:"approved"`
+	Rejections []string `json:"rejections"`
+}
+
+func (t target) push(ctx context.Context, client *http.Client, r release) (decision, error) {
+	body, err := json.Marshal(r)
+	if err != nil {
+		return decision{}, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.pushURL, bytes.NewReader(body))
+	if err != nil {
+		return decision{}, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Api-Key", t.apiKey)
+	res, err := client.Do(req)
+	if err != nil {
+		return decision{}, err
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+	resBody, err := io.ReadAll(io.LimitReader(res.Body, 1<<20))
+	if err != nil {
+		return decision{}, err
+	}
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return decision{}, fmt.Errorf("unexpected status %d: %s", res.StatusCode, strings.TrimSpace(string(resBody)))
+	}
+	// the response is a list of decisions, one per parsed release:
+	var decisions []decision
+	if err := json.Unmarshal(resBody, &decisions); err != nil {
+		var single decision
+		if singleErr := json.Unmarshal(resBody, &single); singleErr != nil {
+			return decision{}, fmt.Errorf("invalid response: %w", err)
+		}
+		decisions = []decision{single}
+	}
+	var d decision
+	for _, each := range decisions {
+		d.Approved = d.Approved || each.Approved
+		d.Rejections = append(d.Rejections, each.Rejections...)
+	}
+	return d, nil
+}
@@ -0,0 +1,83 @@
+package servarr
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestTargetAccepts(t *testing.T) {
+	t.Parallel()
+
+	target, err := newTarget("radarr", TargetConfig{
+		Type:               "radarr",
+		URL:                "http://radarr:7878/",
+		APIKey:             "key",
+		MinVideoResolution: "V1080p",
+		MaxVideoResolution: "V2160p",
+		VideoSources:       []string{"BluRay", "WEBDL"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "http://radarr:7878/api/v3/release/push", target.pushURL)
+
+	movie := func(res model.VideoResolution, source model.VideoSource) model.TorrentContent {
+		return model.TorrentContent{
+			ContentType:     model.NewNullContentType(model.ContentTypeMovie),
+			ContentID:       model.NewNullString("278"),
+			VideoResolution: model.NewNullVideoResolution(res),
+			VideoSource:     model.NewNullVideoSource(source),
+		}
+	}
+
+	assert.True(t, target.accepts(movie(model.VideoResolutionV1080p, model.VideoSourceBluRay)))
+	assert.True(t, target.accepts(movie(model.VideoResolutionV2160p, model.VideoSourceWEBDL)))
+	assert.False(t, target.accepts(movie(model.VideoResolutionV720p, model.VideoSourceBluRay)))
+	assert.False(t, target.accepts(movie(model.VideoResolutionV4320p, model.VideoSourceBluRay)))
+	assert.False(t, target.accepts(movie(model.VideoResolutionV1080p, model.VideoSourceCAM)))
+	unmatched := movie(model.VideoResolutionV1080p, model.VideoSourceBluRay)
+	unmatched.ContentID = model.NullString{}
+	assert.False(t, target.accepts(unmatched))
+	tv := movie(model.VideoResolutionV1080p, model.VideoSourceBluRay)
+	tv.ContentType = model.NewNullContentType(model.ContentTypeTvShow)
+	assert.False(t, target.accepts(tv))
+
+	for _, invalid := range []TargetConfig{
+		{Type: "lidarr", URL: "http://lidarr", APIKey: "key"},
+		{Type: "sonarr", URL: "sonarr:8989", APIKey: "key"},
+		{Type: "sonarr", URL: "http://sonarr:8989"},
+		{Type: "sonarr", URL: "http://sonarr:8989", APIKey: "key", MinVideoResolution: "big"},
+	} {
+		_, err := newTarget("invalid", invalid)
+		assert.Error(t, err)
+	}
+}
+
+func TestTargetPush(t *testing.T) {
+	t.Parallel()
+
+	var received release
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v3/release/push", r.URL.Path)
+		assert.Equal(t, "key", r.Header.Get("X-Api-Key"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		_, _ = w.Write([]byte(`[{"approved":false,"rejections":["Unknown Movie"]}]`))
+	}))
+	defer server.Close()
+
+	target, err := newTarget("radarr", TargetConfig{Type: "radarr", URL: server.URL, APIKey: "key"})
+	assert.NoError(t, err)
+	tc := model.TorrentContent{
+		Torrent: model.Torrent{Name: "Movie.2020.1080p.BluRay", Size: 1000},
+	}
+	d, err := target.push(context.Background(), server.Client(), newRelease(tc, nil))
+	assert.NoError(t, err)
+	assert.False(t, d.Approved)
+	assert.Equal(t, []string{"Unknown Movie"}, d.Rejections)
+	assert.Equal(t, "Movie.2020.1080p.BluRay", received.Title)
+	assert.Equal(t, "torrent", received.Protocol)
+	assert.Equal(t, tc.Torrent.MagnetUri(), received.MagnetURL)
+}
@@ -0,0 +1,32 @@
+package spam
+
+type Config struct {
+	// Enabled turns on spam detection; suspect torrents are quarantined, excluding them from default search results.
+	Enabled bool
+	// NamePatterns are regular expressions of known spam name templates, matched case-insensitively against the names of
+	// torrents and their files; setting them replaces the default patterns.
+	NamePatterns []string `mapstructure:"name_patterns"`
+	// ExecutableExtensions are the extensions of files that are treated as malware in torrents of video content.
+	ExecutableExtensions []string `mapstructure:"executable_extensions"`
+	// PaddedVideoSize is the size in bytes below which the video files of a video torrent are considered a decoy, when
+	// other files make up most of the torrent.
+	PaddedVideoSize uint64 `mapstructure:"padded_video_size"`
+}
+
+const mb = 1_000_000
+
+func NewDefaultConfig() Config {
+	return Config{
+		Enabled: true,
+		NamePatterns: []string{
+			`(?:download|watch)[ ._-]+(?:the[ ._-]+)?full[ ._-]+movie`,
+			`full[ ._-]+movie[ ._-]+(?:download|free|online)`,
+			`(?:^|[^a-z])(?:click|download)[ ._-]+here(?:$|[^a-z])`,
+			`visit[ ._-]+(?:us[ ._-]+at|our[ ._-]+(?:web)?site)`,
+			`(?:codec|player)[ ._-]+(?:required|needed|install)`,
+			`free[ ._-]+(?:download|movies?)[ ._-]+(?:at|on|from)(?:$|[^a-z])`,
+		},
+		ExecutableExtensions: []string{"exe", "scr", "bat", "cmd", "com", "msi", "lnk", "pif", "vbs", "wsf", "hta", "ps1"},
+		PaddedVideoSize:      20 * mb,
+	}
+}
@@ -0,0 +1,132 @@
+package spam
+
+import (
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// Detector applies heuristics for spam and malware to classified torrents.
+type Detector interface {
+	// Detect returns the reasons a torrent content is probably spam or malware, or nil if none are found.
+	Detect(tc model.TorrentContent) model.SpamReasons
+}
+
+type detector struct {
+	nameRegexps          []*regexp.Regexp
+	executableExtensions map[string]struct{}
+	paddedVideoSize      uint64
+}
+
+func newDetector(config Config) (detector, error) {
+	d := detector{
+		nameRegexps:          make([]*regexp.Regexp, 0, len(config.NamePatterns)),
+		executableExtensions: make(map[string]struct{}, len(config.ExecutableExtensions)),
+		paddedVideoSize:      config.PaddedVideoSize,
+	}
+	for _, p := range config.NamePatterns {
+		re, err := regexp.Compile("(?i)" + p)
+		if err != nil {
+			return detector{}, fmt.Errorf("invalid spam name pattern %q: %w", p, err)
+		}
+		d.nameRegexps = append(d.nameRegexps, re)
+	}
+	for _, ext := range config.ExecutableExtensions {
+		d.executableExtensions[strings.ToLower(strings.TrimPrefix(ext, "."))] = struct{}{}
+	}
+	return d, nil
+}
+
+// passwordRegex matches the base names of text files or links that hold or ask for the password of an archive, such as
+// password.txt, which is typically only given on a website that serves ads or malware.
+var passwordRegex = regexp.MustCompile(`(?i)(?:^|[^\p{L}\p{N}])(?:password|passwd|pwd)s?(?:[^\p{L}\p{N}][^/]*)?\.(?:txt|url|html?)$`)
+
+// rarVolumeRegex matches the extensions of the volumes of a split RAR archive, such as r00.
+var rarVolumeRegex = regexp.MustCompile(`(?i)\.r\d{2}$`)
+
+// paddingFileRegex matches the padding files added by some clients to align files to pieces, as described in BEP 47.
+var paddingFileRegex = regexp.MustCompile(`(?:^|/)(?:\.pad/|_____padding_file_)`)
+
+type file struct {
+	path string
+	size uint64
+}
+
+func (d detector) Detect(tc model.TorrentContent) model.SpamReasons {
+	t := tc.Torrent
+	var files []file
+	switch t.FilesStatus {
+	case model.FilesStatusMulti:
+		for _, f := range t.Files {
+			if !paddingFileRegex.MatchString(f.Path) {
+				files = append(files, file{path: f.Path, size: f.Size})
+			}
+		}
+	case model.FilesStatusSingle:
+		files = []file{{path: t.Name, size: t.Size}}
+	}
+	isVideo := tc.ContentType.Valid &&
+		(tc.ContentType.ContentType.IsVideo() || tc.ContentType.ContentType == model.ContentTypeSport)
+	// software and games are commonly released as archives alongside a key or password file
+	isSoftware := tc.ContentType.Valid &&
+		(tc.ContentType.ContentType == model.ContentTypeSoftware || tc.ContentType.ContentType == model.ContentTypeGame)
+	var reasons model.SpamReasons
+	if d.matchesName(t.Name) {
+		reasons = reasons.Add(model.SpamReasonSpamName)
+	}
+	// the directories containing archives and password files, as a password file only applies to archives beside it
+	archiveDirs := make(map[string]struct{})
+	passwordDirs := make(map[string]struct{})
+	var videoSize, largestVideo, totalSize uint64
+	for _, f := range files {
+		ext := strings.ToLower(strings.TrimPrefix(path.Ext(f.path), "."))
+		ft := model.FileTypeFromExtension(ext)
+		switch {
+		case ft.Valid && ft.FileType == model.FileTypeArchive || rarVolumeRegex.MatchString(f.path):
+			archiveDirs[path.Dir(f.path)] = struct{}{}
+		case ft.Valid && ft.FileType == model.FileTypeVideo:
+			videoSize += f.size
+			largestVideo = max(largestVideo, f.size)
+		}
+		if _, ok := d.executableExtensions[ext]; ok && isVideo {
+			reasons = reasons.Add(model.SpamReasonExecutableInVideo)
+		}
+		if passwordRegex.MatchString(path.Base(f.path)) {
+			passwordDirs[path.Dir(f.path)] = struct{}{}
+		}
+		if d.matchesName(path.Base(f.path)) {
+			reasons = reasons.Add(model.SpamReasonSpamName)
+		}
+		totalSize += f.size
+	}
+	if !isSoftware {
+		for dir := range passwordDirs {
+			if _, ok := archiveDirs[dir]; ok {
+				reasons = reasons.Add(model.SpamReasonPasswordProtectedArchive)
+				break
+			}
+		}
+	}
+	// a tiny video padded out with other files is a decoy for a download of the expected size
+	if isVideo && videoSize > 0 && largestVideo < d.paddedVideoSize && videoSize < totalSize/10 {
+		reasons = reasons.Add(model.SpamReasonPaddedFiles)
+	}
+	return reasons
+}
+
+func (d detector) matchesName(name string) bool {
+	for _, re := range d.nameRegexps {
+		if re.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
+
+type disabledDetector struct{}
+
+func (disabledDetector) Detect(model.TorrentContent) model.SpamReasons {
+	return nil
+}
@@ -0,0 +1,154 @@
+package spam
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func multiFile(name string, files map[string]uint64) model.Torrent {
+	t := model.Torrent{Name: name, FilesStatus: model.FilesStatusMulti}
+	for p, size := range files {
+		t.Files = append(t.Files, model.TorrentFile{Path: p, Size: size})
+		t.Size += size
+	}
+	return t
+}
+
+func TestDetect(t *testing.T) {
+	t.Parallel()
+
+	d, err := newDetector(NewDefaultConfig())
+	require.NoError(t, err)
+
+	movie := model.NewNullContentType(model.ContentTypeMovie)
+	software := model.NewNullContentType(model.ContentTypeSoftware)
+	game := model.NewNullContentType(model.ContentTypeGame)
+
+	tests := []struct {
+		name        string
+		contentType model.NullContentType
+		torrent     model.Torrent
+		expected    model.SpamReasons
+	}{
+		{
+			name:        "clean movie",
+			contentType: movie,
+			torrent: multiFile("The.Movie.2023.1080p.BluRay.x264-GRP", map[string]uint64{
+				"The.Movie.2023.1080p.BluRay.x264-GRP/The.Movie.2023.1080p.BluRay.x264-GRP.mkv": 8000 * mb,
+				"The.Movie.2023.1080p.BluRay.x264-GRP/Sample/sample.mkv":                        30 * mb,
+				"The.Movie.2023.1080p.BluRay.x264-GRP/The.Movie.nfo":                            1000,
+			}),
+		},
+		{
+			name:        "executable in movie",
+			contentType: movie,
+			torrent: multiFile("The.Movie.2023.1080p.WEB-DL", map[string]uint64{
+				"The.Movie.2023.1080p.WEB-DL/The.Movie.2023.1080p.WEB-DL.mkv": 2000 * mb,
+				"The.Movie.2023.1080p.WEB-DL/Codec/Setup.EXE":                 2 * mb,
+			}),
+			expected: model.SpamReasons{model.SpamReasonExecutableInVideo},
+		},
+		{
+			name:        "executable in software",
+			contentType: software,
+			torrent: multiFile("Some.App.v1.2", map[string]uint64{
+				"Some.App.v1.2/setup.exe": 50 * mb,
+			}),
+		},
+		{
+			name:        "password protected archive",
+			contentType: movie,
+			torrent: multiFile("The.Movie.2023.2160p.WEB-DL", map[string]uint64{
+				"The.Movie.2023.2160p.WEB-DL/The.Movie.2023.2160p.WEB-DL.rar": 3000 * mb,
+				"The.Movie.2023.2160p.WEB-DL/Password.txt":                    100,
+			}),
+			expected: model.SpamReasons{model.SpamReasonPasswordProtectedArchive},
+		},
+		{
+			name:        "password in torrent name",
+			contentType: movie,
+			torrent: multiFile("The.Movie.2023.2160p.WEB-DL.Password.Protected", map[string]uint64{
+				"The.Movie.2023.2160p.WEB-DL/The.Movie.2023.2160p.WEB-DL.rar": 3000 * mb,
+			}),
+		},
+		{
+			name:        "password file not beside the archive",
+			contentType: movie,
+			torrent: multiFile("The.Movie.2023.2160p.WEB-DL", map[string]uint64{
+				"The.Movie.2023.2160p.WEB-DL/Extras/Extras.zip":               2 * mb,
+				"The.Movie.2023.2160p.WEB-DL/The.Movie.2023.2160p.WEB-DL.mkv": 3000 * mb,
+				"The.Movie.2023.2160p.WEB-DL/Passwords Manager Tutorial.mp4":  200 * mb,
+				"The.Movie.2023.2160p.WEB-DL/Subs/password_for_subs_here.txt": 100,
+			}),
+		},
+		{
+			name:        "password protected game archive",
+			contentType: game,
+			torrent: multiFile("Some.Game.v1.2", map[string]uint64{
+				"Some.Game.v1.2/Some.Game.v1.2.rar": 5000 * mb,
+				"Some.Game.v1.2/password.txt":       100,
+			}),
+		},
+		{
+			name:        "padded video",
+			contentType: movie,
+			torrent: multiFile("The.Movie.2023.1080p.BluRay", map[string]uint64{
+				"The.Movie.2023.1080p.BluRay/The.Movie.2023.1080p.BluRay.mp4": 3 * mb,
+				"The.Movie.2023.1080p.BluRay/data.bin":                        1500 * mb,
+			}),
+			expected: model.SpamReasons{model.SpamReasonPaddedFiles},
+		},
+		{
+			name:        "padding files are ignored",
+			contentType: movie,
+			torrent: multiFile("The.Movie.2023.1080p.BluRay", map[string]uint64{
+				"The.Movie.2023.1080p.BluRay/The.Movie.2023.1080p.BluRay.mkv": 4000 * mb,
+				".pad/1048576": 1048576,
+			}),
+		},
+		{
+			name:        "spam names",
+			contentType: movie,
+			torrent: multiFile("The Movie 2023 Download Full Movie Free", map[string]uint64{
+				"The Movie 2023/The Movie 2023.mkv":                 1200 * mb,
+				"The Movie 2023/Visit our website for more.url":     100,
+				"The Movie 2023/Download here for HD quality.txt":   100,
+				"The Movie 2023/Watch the full movie in 4K.lnk.exe": 1000,
+			}),
+			expected: model.SpamReasons{model.SpamReasonExecutableInVideo, model.SpamReasonSpamName},
+		},
+		{
+			name:        "single file",
+			contentType: movie,
+			torrent: model.Torrent{
+				Name:        "The.Movie.2023.1080p.WEB-DL.mkv.exe",
+				FilesStatus: model.FilesStatusSingle,
+				Size:        2 * mb,
+			},
+			expected: model.SpamReasons{model.SpamReasonExecutableInVideo},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			reasons := d.Detect(model.TorrentContent{
+				ContentType: test.contentType,
+				Torrent:     test.torrent,
+			})
+			assert.Equal(t, test.expected, reasons)
+		})
+	}
+}
+
+func TestInvalidNamePattern(t *testing.T) {
+	t.Parallel()
+
+	config := NewDefaultConfig()
+	config.NamePatterns = []string{"("}
+	_, err := New(Params{Config: config})
+	assert.Error(t, err)
+}
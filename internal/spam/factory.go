@@ -0,0 +1,24 @@
+package spam
+
+import "go.uber.org/fx"
+
+type Params struct {
+	fx.In
+	Config Config
+}
+
+type Result struct {
+	fx.Out
+	Detector Detector
+}
+
+func New(p Params) (Result, error) {
+	if !p.Config.Enabled {
+		return Result{Detector: disabledDetector{}}, nil
+	}
+	d, err := newDetector(p.Config)
+	if err != nil {
+		return Result{}, err
+	}
+	return Result{Detector: d}, nil
+}
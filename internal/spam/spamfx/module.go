@@ -0,0 +1,17 @@
+package spamfx
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/config/configfx"
+	"github.com/bitmagnet-io/bitmagnet/internal/spam"
+	"go.uber.org/fx"
+)
+
+func New() fx.Option {
+	return fx.Module(
+		"spam",
+		configfx.NewConfigModule[spam.Config]("spam", spam.NewDefaultConfig()),
+		fx.Provide(
+			spam.New,
+		),
+	)
+}
@@ -0,0 +1,69 @@
+package takedown
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"strings"
+)
+
+// ListManual is the list that entries submitted individually are added to
+const ListManual = "manual"
+
+// Entry identifies either a single torrent by its info hash, or all torrents of a content item.
+type Entry struct {
+	InfoHash   model.Maybe[protocol.ID]
+	ContentRef model.Maybe[model.ContentRef]
+	Reason     model.NullString
+	Reference  model.NullString
+}
+
+// ParseEntry parses an entry from a 40 character hex info hash, or a content reference as accepted by model.ParseContentRef.
+func ParseEntry(str string) (Entry, error) {
+	str = strings.TrimSpace(str)
+	if len(str) == 40 {
+		if infoHash, err := protocol.ParseID(str); err == nil {
+			return Entry{InfoHash: model.MaybeValid(infoHash)}, nil
+		}
+	}
+	ref, err := model.ParseContentRef(str)
+	if err != nil {
+		return Entry{}, err
+	}
+	return Entry{ContentRef: model.MaybeValid(ref)}, nil
+}
+
+func (e Entry) String() string {
+	if e.InfoHash.Valid {
+		return e.InfoHash.Val.String()
+	}
+	return e.ContentRef.Val.String()
+}
+
+func (e Entry) matchesContent(c model.Content) bool {
+	if !e.ContentRef.Valid {
+		return false
+	}
+	ref := e.ContentRef.Val
+	if !ref.Type.IsNil() && ref.Type != c.Type {
+		return false
+	}
+	id, ok := c.Identifier(ref.Source)
+	return ok && id == ref.ID
+}
+
+func entryFromModel(t model.Takedown) Entry {
+	e := Entry{
+		Reason:    t.Reason,
+		Reference: t.Reference,
+	}
+	if t.InfoHash != nil {
+		e.InfoHash = model.MaybeValid(*t.InfoHash)
+	} else {
+		e.ContentRef = model.MaybeValid(model.ContentRef{
+			Type:   t.ContentType.ContentType,
+			Source: t.ContentSource.String,
+			ID:     t.ContentID.String,
+		})
+	}
+	return e
+}
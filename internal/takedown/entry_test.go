@@ -0,0 +1,48 @@
+package takedown
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseEntry(t *testing.T) {
+	t.Parallel()
+
+	infoHashEntry, err := ParseEntry("d2474e86c95b19b8bcfdb92bc12c9d44667cfa36")
+	assert.NoError(t, err)
+	assert.True(t, infoHashEntry.InfoHash.Valid)
+	assert.False(t, infoHashEntry.ContentRef.Valid)
+	assert.Equal(t, "d2474e86c95b19b8bcfdb92bc12c9d44667cfa36", infoHashEntry.String())
+
+	contentEntry, err := ParseEntry("movie:tmdb:278")
+	assert.NoError(t, err)
+	assert.False(t, contentEntry.InfoHash.Valid)
+	assert.Equal(t, model.ContentRef{Type: model.ContentTypeMovie, Source: "tmdb", ID: "278"}, contentEntry.ContentRef.Val)
+
+	_, err = ParseEntry("")
+	assert.Error(t, err)
+}
+
+func TestEntryMatchesContent(t *testing.T) {
+	t.Parallel()
+
+	content := model.Content{Type: model.ContentTypeMovie, Source: "tmdb", ID: "278"}
+
+	for _, tc := range []struct {
+		input    string
+		expected bool
+	}{
+		{"tmdb:278", true},
+		{"movie:tmdb:278", true},
+		{"tv_show:tmdb:278", false},
+		{"tmdb:279", false},
+		{"d2474e86c95b19b8bcfdb92bc12c9d44667cfa36", false},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			e, err := ParseEntry(tc.input)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, e.matchesContent(content))
+		})
+	}
+}
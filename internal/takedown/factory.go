@@ -0,0 +1,48 @@
+package takedown
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/search"
+	"github.com/bitmagnet-io/bitmagnet/internal/events"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+type Params struct {
+	fx.In
+	Dao      lazy.Lazy[*dao.Query]
+	Search   lazy.Lazy[search.Search]
+	EventBus lazy.Lazy[events.Bus]
+	Logger   *zap.SugaredLogger
+}
+
+type Result struct {
+	fx.Out
+	Manager lazy.Lazy[Manager]
+}
+
+func New(p Params) Result {
+	return Result{
+		Manager: lazy.New(func() (Manager, error) {
+			d, err := p.Dao.Get()
+			if err != nil {
+				return nil, err
+			}
+			s, err := p.Search.Get()
+			if err != nil {
+				return nil, err
+			}
+			eb, err := p.EventBus.Get()
+			if err != nil {
+				return nil, err
+			}
+			return manager{
+				dao:      d,
+				search:   s,
+				eventBus: eb,
+				logger:   p.Logger.Named("takedown"),
+			}, nil
+		}),
+	}
+}
@@ -0,0 +1,309 @@
+package takedown
+
+import (
+	"context"
+	"database/sql/driver"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/query"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/search"
+	"github.com/bitmagnet-io/bitmagnet/internal/events"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"go.uber.org/zap"
+)
+
+const batchSize = 100
+
+// Manager maintains the takedown list. Torrents matching a takedown are deleted and their info hashes blocked,
+// which prevents them being re-indexed by the DHT crawler or importer. Torrents that are later classified as
+// taken down content are removed by the processor via Enforce. Every action is recorded in the takedown log.
+type Manager interface {
+	// Submit adds entries to the named list and removes any matching torrents; entries already on the takedown list are skipped.
+	Submit(ctx context.Context, list string, entries ...Entry) (SubmitResult, error)
+	// Withdraw removes entries from the takedown list. Info hashes that were blocked remain blocked.
+	Withdraw(ctx context.Context, ids ...int64) error
+	// Enforce removes any torrent contents matching a takedown, deleting and blocking their torrents, and returns the remainder.
+	Enforce(ctx context.Context, tcs []model.TorrentContent) ([]model.TorrentContent, error)
+}
+
+type SubmitResult struct {
+	Takedowns       []model.Takedown
+	Duplicates      uint
+	RemovedTorrents uint
+}
+
+type manager struct {
+	dao      *dao.Query
+	search   search.Search
+	eventBus events.Bus
+	logger   *zap.SugaredLogger
+}
+
+func (m manager) Submit(ctx context.Context, list string, entries ...Entry) (SubmitResult, error) {
+	result := SubmitResult{}
+	for i := 0; i < len(entries); i += batchSize {
+		batchResult, err := m.submitBatch(ctx, list, entries[i:min(i+batchSize, len(entries))])
+		result.Takedowns = append(result.Takedowns, batchResult.Takedowns...)
+		result.Duplicates += batchResult.Duplicates
+		result.RemovedTorrents += batchResult.RemovedTorrents
+		if err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
+func (m manager) submitBatch(ctx context.Context, list string, entries []Entry) (SubmitResult, error) {
+	existing, err := m.findTakedowns(ctx, entries)
+	if err != nil {
+		return SubmitResult{}, err
+	}
+	result := SubmitResult{}
+	seen := make(map[string]struct{}, len(existing)+len(entries))
+	for _, t := range existing {
+		seen[entryFromModel(*t).String()] = struct{}{}
+	}
+	takedowns := make([]*model.Takedown, 0, len(entries))
+	for _, e := range entries {
+		key := e.String()
+		if _, ok := seen[key]; ok {
+			result.Duplicates++
+			continue
+		}
+		seen[key] = struct{}{}
+		t := &model.Takedown{
+			List:      list,
+			Reason:    e.Reason,
+			Reference: e.Reference,
+		}
+		if e.InfoHash.Valid {
+			t.InfoHash = e.InfoHash.Addr()
+		} else {
+			t.ContentType = model.NullContentType{ContentType: e.ContentRef.Val.Type, Valid: !e.ContentRef.Val.Type.IsNil()}
+			t.ContentSource = model.NewNullString(e.ContentRef.Val.Source)
+			t.ContentID = model.NewNullString(e.ContentRef.Val.ID)
+		}
+		takedowns = append(takedowns, t)
+	}
+	if len(takedowns) == 0 {
+		return result, nil
+	}
+	if err := m.dao.Takedown.WithContext(ctx).CreateInBatches(takedowns, batchSize); err != nil {
+		return result, err
+	}
+	removed, err := m.remove(ctx, takedowns)
+	if err != nil {
+		return result, err
+	}
+	logEntries := make([]*model.TakedownLog, 0, len(takedowns))
+	for _, t := range takedowns {
+		result.Takedowns = append(result.Takedowns, *t)
+		result.RemovedTorrents += removed[t.ID]
+		logEntries = append(logEntries, newLogEntry(*t, model.TakedownActionSubmitted, removed[t.ID]))
+	}
+	return result, m.log(ctx, logEntries...)
+}
+
+// remove deletes and blocks the torrents matching the given takedowns, returning the number of torrents removed for each.
+func (m manager) remove(ctx context.Context, takedowns []*model.Takedown) (map[int64]uint, error) {
+	removed := make(map[int64]uint, len(takedowns))
+	hashesToBlock := make([]protocol.ID, 0, len(takedowns))
+	hashValuers := make([]driver.Valuer, 0, len(takedowns))
+	hashTakedowns := make(map[protocol.ID]int64, len(takedowns))
+	var deletedHashes []protocol.ID
+	var contentEntries []Entry
+	contentTakedowns := make([]int64, 0)
+	for _, t := range takedowns {
+		if t.InfoHash != nil {
+			hashesToBlock = append(hashesToBlock, *t.InfoHash)
+			hashValuers = append(hashValuers, *t.InfoHash)
+			hashTakedowns[*t.InfoHash] = t.ID
+		} else {
+			contentEntries = append(contentEntries, entryFromModel(*t))
+			contentTakedowns = append(contentTakedowns, t.ID)
+		}
+	}
+	if len(hashValuers) > 0 {
+		var existingHashes []protocol.ID
+		if err := m.dao.Torrent.WithContext(ctx).Where(
+			m.dao.Torrent.InfoHash.In(hashValuers...),
+		).Pluck(m.dao.Torrent.InfoHash, &existingHashes); err != nil {
+			return nil, err
+		}
+		for _, h := range existingHashes {
+			removed[hashTakedowns[h]]++
+		}
+		deletedHashes = append(deletedHashes, existingHashes...)
+	}
+	if len(contentEntries) > 0 {
+		refs := make([]model.ContentRef, 0, len(contentEntries))
+		for _, e := range contentEntries {
+			refs = append(refs, e.ContentRef.Val)
+		}
+		contentResult, err := m.search.Content(
+			ctx,
+			query.Where(search.ContentIdentifierCriteria(refs...)),
+			search.ContentDefaultPreload(),
+			query.Limit(uint(len(refs)*2)),
+		)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range contentResult.Items {
+			var infoHashes []protocol.ID
+			if err := m.dao.TorrentContent.WithContext(ctx).Where(
+				m.dao.TorrentContent.ContentType.Eq(c.Type.String()),
+				m.dao.TorrentContent.ContentSource.Eq(c.Source),
+				m.dao.TorrentContent.ContentID.Eq(c.ID),
+			).Distinct(m.dao.TorrentContent.InfoHash).Pluck(m.dao.TorrentContent.InfoHash, &infoHashes); err != nil {
+				return nil, err
+			}
+			for i, e := range contentEntries {
+				if e.matchesContent(c.Content) {
+					removed[contentTakedowns[i]] += uint(len(infoHashes))
+				}
+			}
+			hashesToBlock = append(hashesToBlock, infoHashes...)
+			deletedHashes = append(deletedHashes, infoHashes...)
+		}
+	}
+	if len(hashesToBlock) > 0 {
+		if _, err := m.dao.DeleteAndBlockTorrents(ctx, hashesToBlock); err != nil {
+			return nil, err
+		}
+		m.eventBus.Publish(ctx, events.NewDeletedEvents(deletedHashes...)...)
+	}
+	return removed, nil
+}
+
+func (m manager) Withdraw(ctx context.Context, ids ...int64) error {
+	takedowns, err := m.dao.Takedown.WithContext(ctx).Where(m.dao.Takedown.ID.In(ids...)).Find()
+	if err != nil {
+		return err
+	}
+	if len(takedowns) == 0 {
+		return nil
+	}
+	if _, err := m.dao.Takedown.WithContext(ctx).Where(m.dao.Takedown.ID.In(ids...)).Delete(); err != nil {
+		return err
+	}
+	logEntries := make([]*model.TakedownLog, 0, len(takedowns))
+	for _, t := range takedowns {
+		logEntries = append(logEntries, newLogEntry(*t, model.TakedownActionWithdrawn, 0))
+	}
+	return m.log(ctx, logEntries...)
+}
+
+func (m manager) Enforce(ctx context.Context, tcs []model.TorrentContent) ([]model.TorrentContent, error) {
+	entries := make([]Entry, 0, len(tcs))
+	for _, tc := range tcs {
+		entries = append(entries, Entry{InfoHash: model.MaybeValid(tc.InfoHash)})
+		if tc.ContentID.Valid {
+			entries = append(entries, Entry{ContentRef: model.MaybeValid(tc.Content.Ref())})
+			for _, attr := range tc.Content.Attributes {
+				if attr.Key == "id" {
+					entries = append(entries, Entry{ContentRef: model.MaybeValid(model.ContentRef{Source: attr.Source, ID: attr.Value})})
+				}
+			}
+		}
+	}
+	takedowns, err := m.findTakedowns(ctx, entries)
+	if err != nil || len(takedowns) == 0 {
+		return tcs, err
+	}
+	kept := make([]model.TorrentContent, 0, len(tcs))
+	removed := make(map[int]uint, len(takedowns))
+	var hashesToBlock []protocol.ID
+	for _, tc := range tcs {
+		match := -1
+		for i, t := range takedowns {
+			e := entryFromModel(*t)
+			if (e.InfoHash.Valid && e.InfoHash.Val == tc.InfoHash) || (tc.ContentID.Valid && e.matchesContent(tc.Content)) {
+				match = i
+				break
+			}
+		}
+		if match < 0 {
+			kept = append(kept, tc)
+			continue
+		}
+		removed[match]++
+		hashesToBlock = append(hashesToBlock, tc.InfoHash)
+	}
+	if len(hashesToBlock) == 0 {
+		return kept, nil
+	}
+	if _, err := m.dao.DeleteAndBlockTorrents(ctx, hashesToBlock); err != nil {
+		return nil, err
+	}
+	m.eventBus.Publish(ctx, events.NewDeletedEvents(hashesToBlock...)...)
+	logEntries := make([]*model.TakedownLog, 0, len(removed))
+	for i, n := range removed {
+		logEntries = append(logEntries, newLogEntry(*takedowns[i], model.TakedownActionEnforced, n))
+	}
+	if err := m.log(ctx, logEntries...); err != nil {
+		m.logger.Errorw("failed to log enforced takedowns", "error", err)
+	}
+	return kept, nil
+}
+
+// findTakedowns returns the takedowns for any of the given entries; content entries without a type match takedowns of any type.
+func (m manager) findTakedowns(ctx context.Context, entries []Entry) ([]*model.Takedown, error) {
+	var hashes []driver.Valuer
+	var ids []string
+	for _, e := range entries {
+		if e.InfoHash.Valid {
+			hashes = append(hashes, e.InfoHash.Val)
+		} else if e.ContentRef.Valid {
+			ids = append(ids, e.ContentRef.Val.ID)
+		}
+	}
+	var found []*model.Takedown
+	if len(hashes) > 0 {
+		result, err := m.dao.Takedown.WithContext(ctx).Where(m.dao.Takedown.InfoHash.In(hashes...)).Find()
+		if err != nil {
+			return nil, err
+		}
+		found = append(found, result...)
+	}
+	if len(ids) > 0 {
+		result, err := m.dao.Takedown.WithContext(ctx).Where(m.dao.Takedown.ContentID.In(ids...)).Find()
+		if err != nil {
+			return nil, err
+		}
+		// the ID query is a superset; check the source and type of each match
+		for _, t := range result {
+			te := entryFromModel(*t)
+			for _, e := range entries {
+				if e.ContentRef.Valid && e.ContentRef.Val.Source == te.ContentRef.Val.Source && e.ContentRef.Val.ID == te.ContentRef.Val.ID &&
+					(te.ContentRef.Val.Type.IsNil() || e.ContentRef.Val.Type.IsNil() || te.ContentRef.Val.Type == e.ContentRef.Val.Type) {
+					found = append(found, t)
+					break
+				}
+			}
+		}
+	}
+	return found, nil
+}
+
+func (m manager) log(ctx context.Context, entries ...*model.TakedownLog) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	return m.dao.TakedownLog.WithContext(ctx).CreateInBatches(entries, batchSize)
+}
+
+func newLogEntry(t model.Takedown, action model.TakedownAction, removedTorrents uint) *model.TakedownLog {
+	return &model.TakedownLog{
+		TakedownID:      t.ID,
+		Action:          action,
+		InfoHash:        t.InfoHash,
+		ContentType:     t.ContentType,
+		ContentSource:   t.ContentSource,
+		ContentID:       t.ContentID,
+		List:            t.List,
+		RemovedTorrents: removedTorrents,
+		Reason:          t.Reason,
+		Reference:       t.Reference,
+	}
+}
@@ -0,0 +1,15 @@
+package takedownfx
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/takedown"
+	"go.uber.org/fx"
+)
+
+func New() fx.Option {
+	return fx.Module(
+		"takedown",
+		fx.Provide(
+			takedown.New,
+		),
+	)
+}
@@ -0,0 +1,81 @@
+package taskrun
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Aggregator records the runs of a task that runs too often for each run to be recorded, such as the processing of a
+// queue message, as a single run per interval. The aggregated run counts the items of all runs finished within its
+// interval, and fails if any of them failed.
+type Aggregator struct {
+	recorder Recorder
+	kind     string
+	interval time.Duration
+	mutex    sync.Mutex
+	run      Run
+	timer    *time.Timer
+	failed   int
+	lastErr  error
+	closed   bool
+}
+
+func NewAggregator(recorder Recorder, kind string, interval time.Duration) *Aggregator {
+	return &Aggregator{
+		recorder: recorder,
+		kind:     kind,
+		interval: interval,
+	}
+}
+
+// Record counts a finished run of n items in the run of the current interval, which is started if there is none.
+// Runs finished after the aggregator is closed aren't recorded.
+func (a *Aggregator) Record(ctx context.Context, n int, err error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	if a.closed {
+		return
+	}
+	if a.run == nil {
+		// the aggregated run outlives the context of the run that started it:
+		a.run = a.recorder.Start(context.WithoutCancel(ctx), a.kind)
+		a.timer = time.AfterFunc(a.interval, a.finish)
+	}
+	a.run.Add(n)
+	if err != nil {
+		a.failed++
+		a.lastErr = err
+	}
+}
+
+// Close finishes the run of the current interval and stops its timer, so that the run isn't left in progress on
+// shutdown; it has the signature of an fx hook.
+func (a *Aggregator) Close(context.Context) error {
+	a.mutex.Lock()
+	a.closed = true
+	if a.timer != nil {
+		a.timer.Stop()
+	}
+	a.mutex.Unlock()
+	a.finish()
+	return nil
+}
+
+// finish finishes the run of the current interval, so that the run isn't left in progress while there is nothing to do
+func (a *Aggregator) finish() {
+	a.mutex.Lock()
+	r, failed, lastErr := a.run, a.failed, a.lastErr
+	a.run, a.timer, a.failed, a.lastErr = nil, nil, 0, nil
+	a.mutex.Unlock()
+	// the run was already finished by Close, or by a timer that fired concurrently:
+	if r == nil {
+		return
+	}
+	var err error
+	if failed > 0 {
+		err = fmt.Errorf("%d runs failed, the last with: %w", failed, lastErr)
+	}
+	r.Finish(err)
+}
@@ -0,0 +1,106 @@
+package taskrun
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+	"time"
+)
+
+type stubRecorder struct {
+	mutex sync.Mutex
+	runs  []*stubRun
+}
+
+func (r *stubRecorder) Start(context.Context, string) Run {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	run := &stubRun{}
+	r.runs = append(r.runs, run)
+	return run
+}
+
+func (r *stubRecorder) StartTarget(ctx context.Context, kind string, _ string) Run {
+	return r.Start(ctx, kind)
+}
+
+func (r *stubRecorder) started() []*stubRun {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return append([]*stubRun(nil), r.runs...)
+}
+
+type stubRun struct {
+	mutex    sync.Mutex
+	items    int
+	finished bool
+	err      error
+}
+
+func (*stubRun) ID() int64 { return 1 }
+
+func (*stubRun) SetTotal(int64) {}
+
+func (r *stubRun) Add(n int) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.items += n
+}
+
+func (r *stubRun) Finish(err error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.finished = true
+	r.err = err
+}
+
+func (r *stubRun) isFinished() bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.finished
+}
+
+func TestAggregator(t *testing.T) {
+	t.Parallel()
+
+	recorder := &stubRecorder{}
+	aggregator := NewAggregator(recorder, KindProcess, 50*time.Millisecond)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	aggregator.Record(ctx, 2, nil)
+	aggregator.Record(ctx, 3, errors.New("first"))
+	aggregator.Record(ctx, 1, errors.New("second"))
+	runs := recorder.started()
+	assert.Len(t, runs, 1)
+	assert.Eventually(t, runs[0].isFinished, time.Second, 10*time.Millisecond)
+	assert.Equal(t, 6, runs[0].items)
+	assert.EqualError(t, runs[0].err, "2 runs failed, the last with: second")
+
+	aggregator.Record(ctx, 4, nil)
+	runs = recorder.started()
+	assert.Len(t, runs, 2)
+	assert.Eventually(t, runs[1].isFinished, time.Second, 10*time.Millisecond)
+	assert.Equal(t, 4, runs[1].items)
+	assert.NoError(t, runs[1].err)
+}
+
+func TestAggregatorClose(t *testing.T) {
+	t.Parallel()
+
+	recorder := &stubRecorder{}
+	aggregator := NewAggregator(recorder, KindProcess, time.Hour)
+
+	aggregator.Record(context.Background(), 2, nil)
+	assert.NoError(t, aggregator.Close(context.Background()))
+	runs := recorder.started()
+	assert.Len(t, runs, 1)
+	assert.True(t, runs[0].isFinished(), "closing should finish the run without waiting for the interval")
+	assert.Equal(t, 2, runs[0].items)
+
+	aggregator.Record(context.Background(), 3, nil)
+	assert.Len(t, recorder.started(), 1, "runs finished after closing shouldn't be recorded")
+	assert.NoError(t, aggregator.Close(context.Background()))
+}
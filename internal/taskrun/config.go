@@ -0,0 +1,14 @@
+package taskrun
+
+import "time"
+
+type Config struct {
+	// Retention is how long task runs are kept before being pruned
+	Retention time.Duration
+}
+
+func NewDefaultConfig() Config {
+	return Config{
+		Retention: 7 * 24 * time.Hour,
+	}
+}
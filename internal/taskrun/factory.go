@@ -0,0 +1,36 @@
+package taskrun
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+type Params struct {
+	fx.In
+	Config Config
+	Dao    lazy.Lazy[*dao.Query]
+	Logger *zap.SugaredLogger
+}
+
+type Result struct {
+	fx.Out
+	Recorder lazy.Lazy[Recorder]
+}
+
+func New(p Params) Result {
+	return Result{
+		Recorder: lazy.New(func() (Recorder, error) {
+			d, err := p.Dao.Get()
+			if err != nil {
+				return nil, err
+			}
+			return &recorder{
+				dao:       d,
+				retention: p.Config.Retention,
+				logger:    p.Logger.Named("task_runs"),
+			}, nil
+		}),
+	}
+}
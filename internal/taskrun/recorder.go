@@ -0,0 +1,166 @@
+package taskrun
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"go.uber.org/zap"
+	"sync"
+	"time"
+)
+
+const (
+	KindImport           = "import"
+	KindReprocess        = "reprocess"
+	KindProcess          = "process"
+	KindWarm             = "search_warm"
+	KindTrackerScrape    = "tracker_scrape"
+	KindBlocklistRefresh = "blocklist_refresh"
+	KindRetentionPrune   = "retention_prune"
+	KindVacuum           = "vacuum"
+	KindReindex          = "reindex"
+	KindContentRefresh   = "content_refresh"
+	KindDumpImport       = "dump_import"
+)
+
+// Recorder records the history of background task runs in the task_runs table.
+// Failing to record a run never fails the task itself; errors are logged instead.
+type Recorder interface {
+	Start(ctx context.Context, kind string) Run
+	// StartTarget starts a run of a task that acts on a named target, such as a table.
+	StartTarget(ctx context.Context, kind string, target string) Run
+}
+
+// Run is a started task run, which should be finished exactly once.
+type Run interface {
+	// ID returns the ID of the recorded run, or 0 if the start of the run couldn't be recorded.
+	ID() int64
+	// SetTotal records the number of items the run is expected to process, so that its progress can be followed.
+	SetTotal(n int64)
+	// Add counts processed items; the count is saved periodically while the run is in progress.
+	Add(n int)
+	Finish(err error)
+}
+
+type recorder struct {
+	dao        *dao.Query
+	retention  time.Duration
+	logger     *zap.SugaredLogger
+	mutex      sync.Mutex
+	lastPruned time.Time
+}
+
+const (
+	pruneInterval    = time.Hour
+	progressInterval = 5 * time.Second
+	finishTimeout    = 10 * time.Second
+)
+
+func (r *recorder) Start(ctx context.Context, kind string) Run {
+	return r.start(ctx, kind, model.NullString{})
+}
+
+func (r *recorder) StartTarget(ctx context.Context, kind string, target string) Run {
+	return r.start(ctx, kind, model.NewNullString(target))
+}
+
+func (r *recorder) start(ctx context.Context, kind string, target model.NullString) Run {
+	r.prune(ctx)
+	m := &model.TaskRun{
+		Kind:      kind,
+		Target:    target,
+		Status:    model.TaskRunStatusRunning,
+		StartedAt: time.Now(),
+	}
+	if err := r.dao.TaskRun.WithContext(ctx).Create(m); err != nil {
+		r.logger.Errorw("error recording task run start", "kind", kind, "error", err)
+		return noopRun{}
+	}
+	return &run{
+		recorder:  r,
+		model:     m,
+		lastSaved: m.StartedAt,
+	}
+}
+
+// prune deletes expired task runs, at most once per pruneInterval
+func (r *recorder) prune(ctx context.Context) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if time.Since(r.lastPruned) < pruneInterval {
+		return
+	}
+	r.lastPruned = time.Now()
+	if _, err := r.dao.TaskRun.WithContext(ctx).Where(
+		r.dao.TaskRun.StartedAt.Lt(time.Now().Add(-r.retention)),
+	).Delete(); err != nil {
+		r.logger.Errorw("error pruning task runs", "error", err)
+	}
+}
+
+type run struct {
+	recorder  *recorder
+	mutex     sync.Mutex
+	model     *model.TaskRun
+	finished  bool
+	lastSaved time.Time
+}
+
+func (r *run) ID() int64 {
+	return r.model.ID
+}
+
+func (r *run) SetTotal(n int64) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.model.TotalCount = &n
+	r.save()
+}
+
+func (r *run) Add(n int) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.model.ItemCount += int64(n)
+	if !r.finished && time.Since(r.lastSaved) >= progressInterval {
+		r.save()
+	}
+}
+
+func (r *run) Finish(err error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if r.finished {
+		return
+	}
+	r.finished = true
+	finishedAt := time.Now()
+	r.model.FinishedAt = &finishedAt
+	if err == nil {
+		r.model.Status = model.TaskRunStatusSucceeded
+	} else {
+		r.model.Status = model.TaskRunStatusFailed
+		r.model.Error = model.NewNullString(err.Error())
+	}
+	r.save()
+}
+
+// save saves the run; the caller must hold the mutex
+func (r *run) save() {
+	r.lastSaved = time.Now()
+	// the task's context may well have been cancelled by now:
+	ctx, cancel := context.WithTimeout(context.Background(), finishTimeout)
+	defer cancel()
+	if saveErr := r.recorder.dao.TaskRun.WithContext(ctx).Save(r.model); saveErr != nil {
+		r.recorder.logger.Errorw("error recording task run", "kind", r.model.Kind, "status", r.model.Status, "error", saveErr)
+	}
+}
+
+type noopRun struct{}
+
+func (noopRun) ID() int64 { return 0 }
+
+func (noopRun) SetTotal(int64) {}
+
+func (noopRun) Add(int) {}
+
+func (noopRun) Finish(error) {}
@@ -0,0 +1,221 @@
+package taskrun
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+	"gorm.io/gorm/utils/tests"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type statement struct {
+	query string
+	args  []driver.Value
+}
+
+// recordingConnector connects to a database that records the statements executed against it, in which every query
+// returns a row with an id of 1, and every statement fails if err is set.
+type recordingConnector struct {
+	mutex      sync.Mutex
+	statements []statement
+	err        error
+}
+
+func (c *recordingConnector) Connect(context.Context) (driver.Conn, error) {
+	return recordingConn{c}, nil
+}
+
+func (c *recordingConnector) Driver() driver.Driver {
+	return nil
+}
+
+func (c *recordingConnector) record(query string, args []driver.Value) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.statements = append(c.statements, statement{query, args})
+	return c.err
+}
+
+// executed returns the statements executed so far that contain the given SQL.
+func (c *recordingConnector) executed(fragment string) []statement {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	var result []statement
+	for _, s := range c.statements {
+		if strings.Contains(s.query, fragment) {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+type recordingConn struct {
+	connector *recordingConnector
+}
+
+func (c recordingConn) Prepare(query string) (driver.Stmt, error) {
+	return recordingStmt{c.connector, query}, nil
+}
+
+func (recordingConn) Close() error {
+	return nil
+}
+
+func (c recordingConn) Begin() (driver.Tx, error) {
+	return c, nil
+}
+
+func (recordingConn) Commit() error {
+	return nil
+}
+
+func (recordingConn) Rollback() error {
+	return nil
+}
+
+type recordingStmt struct {
+	connector *recordingConnector
+	query     string
+}
+
+func (recordingStmt) Close() error {
+	return nil
+}
+
+func (recordingStmt) NumInput() int {
+	return -1
+}
+
+func (s recordingStmt) Exec(args []driver.Value) (driver.Result, error) {
+	if err := s.connector.record(s.query, args); err != nil {
+		return nil, err
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func (s recordingStmt) Query(args []driver.Value) (driver.Rows, error) {
+	if err := s.connector.record(s.query, args); err != nil {
+		return nil, err
+	}
+	return &idRows{}, nil
+}
+
+type idRows struct {
+	done bool
+}
+
+func (*idRows) Columns() []string {
+	return []string{"id"}
+}
+
+func (*idRows) Close() error {
+	return nil
+}
+
+func (r *idRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	r.done = true
+	dest[0] = int64(1)
+	return nil
+}
+
+func newTestRecorder(t *testing.T) (*recorder, *recordingConnector) {
+	t.Helper()
+	connector := &recordingConnector{}
+	db, err := gorm.Open(tests.DummyDialector{}, &gorm.Config{
+		ConnPool:               sql.OpenDB(connector),
+		DisableAutomaticPing:   true,
+		SkipDefaultTransaction: true,
+		Logger:                 logger.Discard,
+	})
+	require.NoError(t, err)
+	return &recorder{
+		dao:       dao.Use(db),
+		retention: 24 * time.Hour,
+		logger:    zap.NewNop().Sugar(),
+	}, connector
+}
+
+func TestRecorder_Run(t *testing.T) {
+	t.Parallel()
+
+	r, connector := newTestRecorder(t)
+	ctx := context.Background()
+
+	started := r.StartTarget(ctx, KindVacuum, "torrents")
+	require.IsType(t, &run{}, started)
+	assert.Equal(t, int64(1), started.ID())
+	inserts := connector.executed("INSERT")
+	require.Len(t, inserts, 1)
+	assert.Contains(t, inserts[0].args, KindVacuum)
+	assert.Contains(t, inserts[0].args, "torrents")
+	assert.Contains(t, inserts[0].args, string(model.TaskRunStatusRunning))
+
+	// progress is saved at most once per progressInterval; the run is saved by an upsert:
+	started.Add(2)
+	assert.Empty(t, connector.executed("ON CONFLICT"))
+	started.(*run).lastSaved = time.Now().Add(-progressInterval)
+	started.Add(3)
+	assert.Len(t, connector.executed("ON CONFLICT"), 1)
+	assert.Equal(t, int64(5), started.(*run).model.ItemCount)
+
+	started.Finish(errors.New("failed"))
+	started.Finish(nil)
+	saves := connector.executed("ON CONFLICT")
+	require.Len(t, saves, 2)
+	assert.Contains(t, saves[1].args, string(model.TaskRunStatusFailed))
+	assert.Contains(t, saves[1].args, "failed")
+	assert.Equal(t, model.TaskRunStatusFailed, started.(*run).model.Status)
+	assert.NotNil(t, started.(*run).model.FinishedAt)
+
+	// the count isn't saved once the run is finished:
+	started.(*run).lastSaved = time.Now().Add(-progressInterval)
+	started.Add(1)
+	assert.Len(t, connector.executed("ON CONFLICT"), 2)
+}
+
+func TestRecorder_StartError(t *testing.T) {
+	t.Parallel()
+
+	r, connector := newTestRecorder(t)
+	connector.err = errors.New("connection refused")
+
+	started := r.Start(context.Background(), KindProcess)
+	assert.Equal(t, noopRun{}, started)
+	assert.Equal(t, int64(0), started.ID())
+}
+
+func TestRecorder_Prune(t *testing.T) {
+	t.Parallel()
+
+	r, connector := newTestRecorder(t)
+	ctx := context.Background()
+
+	r.Start(ctx, KindImport)
+	r.Start(ctx, KindImport)
+	deletes := connector.executed("DELETE")
+	require.Len(t, deletes, 1)
+	assert.Contains(t, deletes[0].query, "started_at")
+	require.Len(t, deletes[0].args, 1)
+	cutoff, ok := deletes[0].args[0].(time.Time)
+	require.True(t, ok)
+	assert.WithinDuration(t, time.Now().Add(-r.retention), cutoff, time.Minute)
+
+	r.lastPruned = time.Now().Add(-pruneInterval)
+	r.Start(ctx, KindImport)
+	assert.Len(t, connector.executed("DELETE"), 2)
+}
@@ -0,0 +1,17 @@
+package taskrunfx
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/config/configfx"
+	"github.com/bitmagnet-io/bitmagnet/internal/taskrun"
+	"go.uber.org/fx"
+)
+
+func New() fx.Option {
+	return fx.Module(
+		"task_runs",
+		configfx.NewConfigModule[taskrun.Config]("task_runs", taskrun.NewDefaultConfig()),
+		fx.Provide(
+			taskrun.New,
+		),
+	)
+}
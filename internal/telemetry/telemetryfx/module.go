@@ -1,17 +1,21 @@
 package telemetryfx
 
 import (
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/config/configfx"
 	"github.com/bitmagnet-io/bitmagnet/internal/telemetry/httpserver"
 	"github.com/bitmagnet-io/bitmagnet/internal/telemetry/prometheus"
+	"github.com/bitmagnet-io/bitmagnet/internal/telemetry/tracing"
 	"go.uber.org/fx"
 )
 
 func New() fx.Option {
 	return fx.Module(
 		"telemetry",
+		configfx.NewConfigModule[tracing.Config]("tracing", tracing.NewDefaultConfig()),
 		fx.Provide(
 			httpserver.New,
 			prometheus.New,
+			tracing.New,
 		),
 	)
 }
@@ -0,0 +1,19 @@
+package tracing
+
+type Config struct {
+	// Enabled exports traces of the processor pipeline over OTLP.
+	Enabled bool
+	// Endpoint is the host and port of the OTLP HTTP receiver, such as an OpenTelemetry collector or Jaeger.
+	// If empty, the standard OTEL_EXPORTER_OTLP_ENDPOINT environment variable is used, defaulting to localhost:4318.
+	Endpoint string
+	// Insecure sends traces over plain HTTP rather than HTTPS.
+	Insecure bool
+	// SampleRatio is the fraction of traces to sample, from 0 to 1.
+	SampleRatio float64 `validate:"gte=0,lte=1"`
+}
+
+func NewDefaultConfig() Config {
+	return Config{
+		SampleRatio: 1,
+	}
+}
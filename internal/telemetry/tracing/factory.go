@@ -0,0 +1,83 @@
+package tracing
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/version"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+type Params struct {
+	fx.In
+	Config Config
+	Logger *zap.SugaredLogger
+}
+
+type Result struct {
+	fx.Out
+	AppHook fx.Hook `group:"app_hooks"`
+}
+
+// New installs the global tracer provider when tracing is enabled; until then, and otherwise,
+// spans are started with the default no-op provider, which adds next to no overhead.
+func New(p Params) Result {
+	if !p.Config.Enabled {
+		return Result{}
+	}
+	var tp *sdktrace.TracerProvider
+	return Result{
+		AppHook: fx.Hook{
+			OnStart: func(ctx context.Context) error {
+				var opts []otlptracehttp.Option
+				if p.Config.Endpoint != "" {
+					opts = append(opts, otlptracehttp.WithEndpoint(p.Config.Endpoint))
+				}
+				if p.Config.Insecure {
+					opts = append(opts, otlptracehttp.WithInsecure())
+				}
+				exporter, err := otlptracehttp.New(ctx, opts...)
+				if err != nil {
+					return err
+				}
+				serviceVersion := version.GitTag
+				if serviceVersion == "" {
+					serviceVersion = "dev"
+				}
+				res, err := resource.Merge(
+					resource.Default(),
+					resource.NewWithAttributes(
+						semconv.SchemaURL,
+						semconv.ServiceName("bitmagnet"),
+						semconv.ServiceVersion(serviceVersion),
+					),
+				)
+				if err != nil {
+					return err
+				}
+				tp = sdktrace.NewTracerProvider(
+					sdktrace.WithBatcher(exporter),
+					sdktrace.WithResource(res),
+					sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(p.Config.SampleRatio))),
+				)
+				otel.SetTracerProvider(tp)
+				otel.SetTextMapPropagator(propagation.TraceContext{})
+				otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+					p.Logger.Named("tracing").Warnw("tracing error", "error", err)
+				}))
+				return nil
+			},
+			OnStop: func(ctx context.Context) error {
+				if tp == nil {
+					return nil
+				}
+				return tp.Shutdown(ctx)
+			},
+		},
+	}
+}
@@ -0,0 +1,25 @@
+package tracing
+
+import (
+	"errors"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// End ends a span, recording an error unless it's one of the expected errors, such as a classifier's no match error.
+func End(span trace.Span, err error, expected ...error) {
+	if err != nil {
+		isExpected := false
+		for _, e := range expected {
+			if errors.Is(err, e) {
+				isExpected = true
+				break
+			}
+		}
+		if !isExpected {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+	}
+	span.End()
+}
@@ -0,0 +1,25 @@
+package torrentexport
+
+import "time"
+
+type Config struct {
+	// Trackers is the list of tracker announce URLs added to generated magnet links and torrent files.
+	Trackers []string
+	// TrackersURL is the URL of a remote list of tracker announce URLs, one per line, that are added after the
+	// configured trackers; no remote list is fetched if empty.
+	TrackersURL string
+	// TrackersRefreshInterval is the time to wait between fetches of the remote trackers list.
+	TrackersRefreshInterval time.Duration
+}
+
+func NewDefaultConfig() Config {
+	return Config{
+		Trackers: []string{
+			"udp://tracker.opentrackr.org:1337/announce",
+			"udp://open.stealth.si:80/announce",
+			"udp://tracker.torrent.eu.org:451/announce",
+			"udp://exodus.desync.com:6969/announce",
+		},
+		TrackersRefreshInterval: time.Hour * 24,
+	}
+}
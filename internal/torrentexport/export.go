@@ -0,0 +1,78 @@
+package torrentexport
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/anacrolix/torrent/bencode"
+	mi "github.com/anacrolix/torrent/metainfo"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"strings"
+)
+
+// ErrMetaInfoNotStored is returned when a torrent file can't be reconstructed, because the pieces or the files
+// of the torrent aren't stored, or because the original metainfo had fields that aren't stored.
+var ErrMetaInfoNotStored = errors.New("the metainfo of the torrent isn't stored")
+
+// CanReconstruct returns true if the pieces and files of a torrent are stored,
+// so that its torrent file can likely be reconstructed.
+func CanReconstruct(t model.Torrent) bool {
+	return len(t.Pieces) > 0 && t.PieceLength.Valid &&
+		(t.FilesStatus == model.FilesStatusSingle || t.FilesStatus == model.FilesStatusMulti)
+}
+
+// TorrentFile reconstructs the torrent file of a torrent with the trackers added; the files of a multi-file
+// torrent must be loaded. The reconstructed info dictionary is checked against the info hash.
+func TorrentFile(t model.Torrent, trackers []string) ([]byte, error) {
+	if !CanReconstruct(t) {
+		return nil, ErrMetaInfoNotStored
+	}
+	info := mi.Info{
+		Name:        t.Name,
+		PieceLength: int64(t.PieceLength.Uint64),
+		Pieces:      t.Pieces,
+	}
+	if t.Private {
+		info.Private = &t.Private
+	}
+	if t.FilesStatus == model.FilesStatusSingle {
+		info.Length = int64(t.Size)
+	} else {
+		if len(t.Files) == 0 {
+			return nil, ErrMetaInfoNotStored
+		}
+		files := make([]mi.FileInfo, len(t.Files))
+		for _, f := range t.Files {
+			if int(f.Index) >= len(files) {
+				return nil, ErrMetaInfoNotStored
+			}
+			files[f.Index] = mi.FileInfo{
+				Length: int64(f.Size),
+				Path:   strings.Split(f.Path, "/"),
+			}
+		}
+		info.Files = files
+	}
+	infoBytes, err := bencode.Marshal(info)
+	if err != nil {
+		return nil, err
+	}
+	if protocol.ID(mi.HashBytes(infoBytes)) != t.InfoHash {
+		return nil, fmt.Errorf("%w: the reconstructed info doesn't match the info hash", ErrMetaInfoNotStored)
+	}
+	metaInfo := mi.MetaInfo{
+		InfoBytes: infoBytes,
+	}
+	if len(trackers) > 0 {
+		metaInfo.Announce = trackers[0]
+		for _, tr := range trackers {
+			metaInfo.AnnounceList = append(metaInfo.AnnounceList, []string{tr})
+		}
+	}
+	var buf bytes.Buffer
+	if err := metaInfo.Write(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
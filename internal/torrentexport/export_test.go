@@ -0,0 +1,55 @@
+package torrentexport
+
+import (
+	"github.com/anacrolix/torrent/bencode"
+	mi "github.com/anacrolix/torrent/metainfo"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestTorrentFile(t *testing.T) {
+	t.Parallel()
+	info := mi.Info{
+		Name:        "Some.Show.S01",
+		PieceLength: 16384,
+		Pieces:      make([]byte, 40),
+		Files: []mi.FileInfo{
+			{Length: 20000, Path: []string{"Some.Show.S01E01.mkv"}},
+			{Length: 100, Path: []string{"Subs", "English.srt"}},
+		},
+	}
+	infoBytes, err := bencode.Marshal(info)
+	require.NoError(t, err)
+	torrent := model.Torrent{
+		InfoHash:    protocol.ID(mi.HashBytes(infoBytes)),
+		Name:        info.Name,
+		Size:        uint64(info.TotalLength()),
+		PieceLength: model.NewNullUint64(uint64(info.PieceLength)),
+		Pieces:      info.Pieces,
+		FilesStatus: model.FilesStatusMulti,
+		Files: []model.TorrentFile{
+			{Index: 1, Path: "Subs/English.srt", Size: 100},
+			{Index: 0, Path: "Some.Show.S01E01.mkv", Size: 20000},
+		},
+	}
+
+	b, err := TorrentFile(torrent, []string{"udp://a/announce", "udp://b/announce"})
+	require.NoError(t, err)
+	var metaInfo mi.MetaInfo
+	require.NoError(t, bencode.Unmarshal(b, &metaInfo))
+	assert.Equal(t, torrent.InfoHash, protocol.ID(metaInfo.HashInfoBytes()))
+	assert.Equal(t, "udp://a/announce", metaInfo.Announce)
+	assert.Equal(t, mi.AnnounceList{{"udp://a/announce"}, {"udp://b/announce"}}, metaInfo.AnnounceList)
+
+	torrent.Files[0].Size = 99
+	_, err = TorrentFile(torrent, nil)
+	assert.ErrorIs(t, err, ErrMetaInfoNotStored)
+
+	torrent.Pieces = nil
+	assert.False(t, CanReconstruct(torrent))
+	_, err = TorrentFile(torrent, nil)
+	assert.ErrorIs(t, err, ErrMetaInfoNotStored)
+}
@@ -0,0 +1,60 @@
+package torrentexport
+
+import (
+	"context"
+	"errors"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"gorm.io/gorm"
+)
+
+var ErrNotFound = errors.New("torrent not found")
+
+// Exporter builds magnet links and torrent files of stored torrents, with the trackers of the tracker list added.
+type Exporter interface {
+	MagnetURI(ctx context.Context, infoHash protocol.ID) (string, error)
+	// TorrentFile returns the name and content of the reconstructed torrent file of a torrent,
+	// or ErrMetaInfoNotStored if it can't be reconstructed.
+	TorrentFile(ctx context.Context, infoHash protocol.ID) (string, []byte, error)
+}
+
+type exporter struct {
+	trackerList TrackerList
+	dao         *dao.Query
+}
+
+func (e exporter) MagnetURI(ctx context.Context, infoHash protocol.ID) (string, error) {
+	t, err := e.torrent(ctx, infoHash, false)
+	if err != nil {
+		return "", err
+	}
+	return t.MagnetUri(e.trackerList.Trackers()...), nil
+}
+
+func (e exporter) TorrentFile(ctx context.Context, infoHash protocol.ID) (string, []byte, error) {
+	t, err := e.torrent(ctx, infoHash, true)
+	if err != nil {
+		return "", nil, err
+	}
+	b, err := TorrentFile(t, e.trackerList.Trackers())
+	if err != nil {
+		return "", nil, err
+	}
+	return t.Name + ".torrent", b, nil
+}
+
+func (e exporter) torrent(ctx context.Context, infoHash protocol.ID, withFiles bool) (model.Torrent, error) {
+	q := e.dao.Torrent.WithContext(ctx)
+	if withFiles {
+		q = q.Preload(e.dao.Torrent.Files.Order(e.dao.TorrentFile.Index))
+	}
+	t, err := q.Where(e.dao.Torrent.InfoHash.Eq(infoHash)).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return model.Torrent{}, ErrNotFound
+	}
+	if err != nil {
+		return model.Torrent{}, err
+	}
+	return *t, nil
+}
@@ -0,0 +1,71 @@
+package torrentexport
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/httpserver"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"net/http"
+	"time"
+)
+
+type Params struct {
+	fx.In
+	Config Config
+	Dao    lazy.Lazy[*dao.Query]
+	Logger *zap.SugaredLogger
+}
+
+type Result struct {
+	fx.Out
+	TrackerList lazy.Lazy[TrackerList]
+	Exporter    lazy.Lazy[Exporter]
+	Option      httpserver.Option `group:"http_server_options"`
+	AppHook     fx.Hook           `group:"app_hooks"`
+}
+
+func New(p Params) Result {
+	logger := p.Logger.Named("torrent_export")
+	// the remote trackers list is refreshed from when the tracker list is first used until shutdown
+	ctx, cancel := context.WithCancel(context.Background())
+	ltl := lazy.New(func() (TrackerList, error) {
+		l := newTrackerList(p.Config.Trackers)
+		if p.Config.TrackersURL != "" {
+			l.url = p.Config.TrackersURL
+			l.httpClient = &http.Client{Timeout: time.Second * 30}
+			l.logger = logger
+			go l.run(ctx, p.Config.TrackersRefreshInterval)
+		}
+		return l, nil
+	})
+	le := lazy.New(func() (Exporter, error) {
+		d, err := p.Dao.Get()
+		if err != nil {
+			return nil, err
+		}
+		tl, err := ltl.Get()
+		if err != nil {
+			return nil, err
+		}
+		return exporter{
+			trackerList: tl,
+			dao:         d,
+		}, nil
+	})
+	return Result{
+		TrackerList: ltl,
+		Exporter:    le,
+		Option: builder{
+			exporter: le,
+			logger:   logger,
+		},
+		AppHook: fx.Hook{
+			OnStop: func(context.Context) error {
+				cancel()
+				return nil
+			},
+		},
+	}
+}
@@ -0,0 +1,82 @@
+package torrentexport
+
+import (
+	"errors"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"mime"
+	"net/http"
+)
+
+type builder struct {
+	exporter lazy.Lazy[Exporter]
+	logger   *zap.SugaredLogger
+}
+
+func (builder) Key() string {
+	return "torrent_export"
+}
+
+// Apply registers GET /torrents/<info hash>/magnet, which redirects to the magnet link so that it opens in the
+// registered torrent client, and GET /torrents/<info hash>/torrent, which downloads the reconstructed torrent file.
+func (b builder) Apply(e *gin.Engine) error {
+	ex, err := b.exporter.Get()
+	if err != nil {
+		return err
+	}
+	h := handler{
+		exporter: ex,
+		logger:   b.logger,
+	}
+	e.GET("/torrents/:infoHash/magnet", h.magnet)
+	e.GET("/torrents/:infoHash/torrent", h.torrentFile)
+	return nil
+}
+
+type handler struct {
+	exporter Exporter
+	logger   *zap.SugaredLogger
+}
+
+func (h handler) magnet(c *gin.Context) {
+	infoHash, err := protocol.ParseID(c.Param("infoHash"))
+	if err != nil {
+		c.String(http.StatusBadRequest, "invalid info hash")
+		return
+	}
+	uri, err := h.exporter.MagnetURI(c.Request.Context(), infoHash)
+	if err != nil {
+		h.error(c, err)
+		return
+	}
+	c.Redirect(http.StatusFound, uri)
+}
+
+func (h handler) torrentFile(c *gin.Context) {
+	infoHash, err := protocol.ParseID(c.Param("infoHash"))
+	if err != nil {
+		c.String(http.StatusBadRequest, "invalid info hash")
+		return
+	}
+	name, b, err := h.exporter.TorrentFile(c.Request.Context(), infoHash)
+	if err != nil {
+		h.error(c, err)
+		return
+	}
+	c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": name}))
+	c.Data(http.StatusOK, "application/x-bittorrent", b)
+}
+
+func (h handler) error(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, ErrNotFound):
+		c.String(http.StatusNotFound, err.Error())
+	case errors.Is(err, ErrMetaInfoNotStored):
+		c.String(http.StatusUnprocessableEntity, err.Error())
+	default:
+		h.logger.Errorw("failed to export torrent", "error", err)
+		c.Status(http.StatusInternalServerError)
+	}
+}
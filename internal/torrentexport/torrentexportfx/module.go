@@ -0,0 +1,17 @@
+package torrentexportfx
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/config/configfx"
+	"github.com/bitmagnet-io/bitmagnet/internal/torrentexport"
+	"go.uber.org/fx"
+)
+
+func New() fx.Option {
+	return fx.Module(
+		"torrent_export",
+		configfx.NewConfigModule[torrentexport.Config]("torrent_export", torrentexport.NewDefaultConfig()),
+		fx.Provide(
+			torrentexport.New,
+		),
+	)
+}
@@ -0,0 +1,119 @@
+package torrentexport
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"go.uber.org/zap"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// TrackerList provides the trackers added to generated magnet links and torrent files.
+type TrackerList interface {
+	// Trackers returns the configured trackers followed by those of the remote trackers list, without duplicates.
+	Trackers() []string
+}
+
+type trackerList struct {
+	configured []string
+	url        string
+	httpClient *http.Client
+	logger     *zap.SugaredLogger
+	mutex      sync.RWMutex
+	trackers   []string
+}
+
+func newTrackerList(configured []string) *trackerList {
+	l := &trackerList{configured: configured}
+	l.trackers = l.merge(nil)
+	return l
+}
+
+func (l *trackerList) Trackers() []string {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+	return l.trackers
+}
+
+// run fetches the remote trackers list, then refetches it at each interval until the context is cancelled;
+// a failed fetch keeps the previously fetched trackers.
+func (l *trackerList) run(ctx context.Context, interval time.Duration) {
+	for {
+		if err := l.refresh(ctx); err != nil && ctx.Err() == nil {
+			l.logger.Errorw("failed to fetch trackers list", "url", l.url, "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+	}
+}
+
+func (l *trackerList) refresh(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, l.url, nil)
+	if err != nil {
+		return err
+	}
+	res, err := l.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d", res.StatusCode)
+	}
+	remote, err := parseTrackers(res.Body)
+	if err != nil {
+		return err
+	}
+	trackers := l.merge(remote)
+	l.mutex.Lock()
+	l.trackers = trackers
+	l.mutex.Unlock()
+	l.logger.Debugw("fetched trackers list", "url", l.url, "count", len(remote))
+	return nil
+}
+
+func (l *trackerList) merge(remote []string) []string {
+	seen := make(map[string]struct{}, len(l.configured)+len(remote))
+	trackers := make([]string, 0, len(l.configured)+len(remote))
+	for _, lst := range [][]string{l.configured, remote} {
+		for _, tr := range lst {
+			if _, ok := seen[tr]; !ok {
+				seen[tr] = struct{}{}
+				trackers = append(trackers, tr)
+			}
+		}
+	}
+	return trackers
+}
+
+// parseTrackers parses a list of tracker announce URLs, one per line; blank lines, comments starting with #
+// and lines that aren't UDP or HTTP(S) URLs are skipped.
+func parseTrackers(r io.Reader) ([]string, error) {
+	var trackers []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		u, err := url.Parse(line)
+		if err != nil || u.Host == "" {
+			continue
+		}
+		switch u.Scheme {
+		case "udp", "http", "https":
+			trackers = append(trackers, line)
+		}
+	}
+	return trackers, scanner.Err()
+}
@@ -0,0 +1,28 @@
+package torrentexport
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestTrackerListRefresh(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("udp://a:1/announce\n\n# comment\nhttps://b/announce\nudp://c:3/announce\nnot a tracker\nws://d/announce\n"))
+	}))
+	defer server.Close()
+
+	l := newTrackerList([]string{"udp://c:3/announce"})
+	assert.Equal(t, []string{"udp://c:3/announce"}, l.Trackers())
+
+	l.url = server.URL
+	l.httpClient = server.Client()
+	l.logger = zap.NewNop().Sugar()
+	require.NoError(t, l.refresh(context.Background()))
+	assert.Equal(t, []string{"udp://c:3/announce", "udp://a:1/announce", "https://b/announce"}, l.Trackers())
+}
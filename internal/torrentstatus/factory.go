@@ -0,0 +1,29 @@
+package torrentstatus
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/httpserver"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+type Params struct {
+	fx.In
+	Dao    lazy.Lazy[*dao.Query]
+	Logger *zap.SugaredLogger
+}
+
+type Result struct {
+	fx.Out
+	Option httpserver.Option `group:"http_server_options"`
+}
+
+func New(p Params) Result {
+	return Result{
+		Option: builder{
+			dao:    p.Dao,
+			logger: p.Logger.Named("torrent_status"),
+		},
+	}
+}
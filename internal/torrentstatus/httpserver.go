@@ -0,0 +1,84 @@
+package torrentstatus
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+)
+
+type builder struct {
+	dao    lazy.Lazy[*dao.Query]
+	logger *zap.SugaredLogger
+}
+
+func (builder) Key() string {
+	return "torrent_status"
+}
+
+// Apply registers HEAD /torrents/<info hash>, which responds with 200 if the torrent exists or 404 if not, and
+// GET /torrents/<info hash>/status, which responds with the status as JSON. Both include the status in headers.
+func (b builder) Apply(e *gin.Engine) error {
+	d, err := b.dao.Get()
+	if err != nil {
+		return err
+	}
+	h := handler{
+		dao:    d,
+		logger: b.logger,
+	}
+	e.HEAD("/torrents/:infoHash", h.head)
+	e.GET("/torrents/:infoHash/status", h.get)
+	return nil
+}
+
+type handler struct {
+	dao    *dao.Query
+	logger *zap.SugaredLogger
+}
+
+func (h handler) head(c *gin.Context) {
+	status, ok := h.status(c)
+	if !ok {
+		return
+	}
+	if status.Exists {
+		c.Status(http.StatusOK)
+	} else {
+		c.Status(http.StatusNotFound)
+	}
+}
+
+func (h handler) get(c *gin.Context) {
+	status, ok := h.status(c)
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, status)
+}
+
+// status looks up the status of the requested torrent and sets its headers, or responds with an error.
+func (h handler) status(c *gin.Context) (model.TorrentStatus, bool) {
+	infoHash, err := protocol.ParseID(c.Param("infoHash"))
+	if err != nil {
+		c.String(http.StatusBadRequest, "invalid info hash")
+		return model.TorrentStatus{}, false
+	}
+	status, err := h.dao.TorrentStatus(c.Request.Context(), infoHash)
+	if err != nil {
+		h.logger.Errorw("failed to get torrent status", "error", err)
+		c.Status(http.StatusInternalServerError)
+		return model.TorrentStatus{}, false
+	}
+	c.Header("X-Bitmagnet-Exists", strconv.FormatBool(status.Exists))
+	c.Header("X-Bitmagnet-Classified", strconv.FormatBool(status.Classified))
+	if status.ContentType.Valid {
+		c.Header("X-Bitmagnet-Content-Type", status.ContentType.ContentType.String())
+	}
+	c.Header("X-Bitmagnet-Quarantined", strconv.FormatBool(status.Quarantined))
+	return status, true
+}
@@ -0,0 +1,15 @@
+package torrentstatusfx
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/torrentstatus"
+	"go.uber.org/fx"
+)
+
+func New() fx.Option {
+	return fx.Module(
+		"torrent_status",
+		fx.Provide(
+			torrentstatus.New,
+		),
+	)
+}
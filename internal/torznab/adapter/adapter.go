@@ -1,36 +1,77 @@
 package adapter
 
 import (
+	"context"
 	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
 	"github.com/bitmagnet-io/bitmagnet/internal/database/search"
+	"github.com/bitmagnet-io/bitmagnet/internal/events"
+	"github.com/bitmagnet-io/bitmagnet/internal/torrentexport"
 	"github.com/bitmagnet-io/bitmagnet/internal/torznab"
 	"go.uber.org/fx"
+	"go.uber.org/zap"
 )
 
 type Params struct {
 	fx.In
-	Search lazy.Lazy[search.Search]
+	Config       torznab.Config
+	Search       lazy.Lazy[search.Search]
+	SearchConfig search.Config
+	TrackerList  lazy.Lazy[torrentexport.TrackerList]
+	EventBus     lazy.Lazy[events.Bus]
+	Logger       *zap.SugaredLogger
 }
 
 type Result struct {
 	fx.Out
-	Client lazy.Lazy[torznab.Client]
+	Client  lazy.Lazy[torznab.Client]
+	AppHook fx.Hook `group:"app_hooks"`
 }
 
 func New(p Params) Result {
+	logger := p.Logger.Named("torznab")
+	// cached results are invalidated by torrent events from when the client is first used until shutdown
+	ctx, cancel := context.WithCancel(context.Background())
 	return Result{
 		Client: lazy.New[torznab.Client](func() (torznab.Client, error) {
 			s, err := p.Search.Get()
 			if err != nil {
 				return nil, err
 			}
-			return adapter{
+			tl, err := p.TrackerList.Get()
+			if err != nil {
+				return nil, err
+			}
+			a := adapter{
 				title:        "bitmagnet",
 				maxLimit:     100,
 				defaultLimit: 100,
 				search:       s,
-			}, nil
+				searchConfig: p.SearchConfig,
+				trackerList:  tl,
+			}
+			if p.Config.CacheTTL <= 0 || p.Config.CacheMaxEntries == 0 {
+				return a, nil
+			}
+			eb, err := p.EventBus.Get()
+			if err != nil {
+				return nil, err
+			}
+			eventsChan, err := eb.Subscribe(ctx)
+			if err != nil {
+				// without events cached results couldn't be invalidated, so searches are left uncached
+				logger.Warnw("failed to subscribe to torrent events, disabling the search cache", "error", err)
+				return a, nil
+			}
+			c := newCachingClient(a, p.Config, logger.Named("cache"))
+			go c.run(ctx, eventsChan)
+			return c, nil
 		}),
+		AppHook: fx.Hook{
+			OnStop: func(context.Context) error {
+				cancel()
+				return nil
+			},
+		},
 	}
 }
 
@@ -39,4 +80,6 @@ type adapter struct {
 	maxLimit     uint
 	defaultLimit uint
 	search       search.Search
+	searchConfig search.Config
+	trackerList  torrentexport.TrackerList
 }
@@ -0,0 +1,194 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/bitmagnet-io/bitmagnet/internal/events"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/torznab"
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	"go.uber.org/zap"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// cachingClient caches search results for a short time, as clients such as Radarr and Sonarr repeat the same searches
+// for recent releases every few minutes. Cached results are invalidated when a torrent that could appear in them is
+// classified or deleted.
+type cachingClient struct {
+	torznab.Client
+	lru    *expirable.LRU[string, cachedResult]
+	ttl    time.Duration
+	now    func() time.Time
+	logger *zap.SugaredLogger
+	mu     sync.Mutex
+	// generation is incremented when any cached result may be outdated
+	generation uint64
+	// scopes are the generations of the cached results of each scope
+	scopes map[cacheScope]*scopeGeneration
+	// disabled is set if events can no longer be received, so that outdated results would not be invalidated
+	disabled bool
+}
+
+type cachedResult struct {
+	result     torznab.SearchResult
+	generation uint64
+}
+
+// scopeGeneration is incremented when the cached results of a scope may be outdated by a classified torrent. While
+// torrents are being classified continuously, it is incremented at most once per TTL, as otherwise nothing could stay
+// cached: an increment within a TTL of the last is held as pending until the TTL has passed.
+type scopeGeneration struct {
+	generation  uint64
+	incremented time.Time
+	pending     bool
+}
+
+func (g *scopeGeneration) increment(now time.Time, ttl time.Duration) {
+	if now.Sub(g.incremented) < ttl {
+		g.pending = true
+		return
+	}
+	g.generation++
+	g.incremented = now
+	g.pending = false
+}
+
+// current returns the generation, applying a pending increment whose TTL has passed.
+func (g *scopeGeneration) current(now time.Time, ttl time.Duration) uint64 {
+	if g.pending && now.Sub(g.incremented) >= ttl {
+		g.increment(now, ttl)
+	}
+	return g.generation
+}
+
+// cacheScope is the content type that a search is restricted to, or empty for searches of all content types.
+type cacheScope model.ContentType
+
+func newCachingClient(client torznab.Client, config torznab.Config, logger *zap.SugaredLogger) *cachingClient {
+	return &cachingClient{
+		Client: client,
+		lru:    expirable.NewLRU[string, cachedResult](int(config.CacheMaxEntries), nil, config.CacheTTL),
+		ttl:    config.CacheTTL,
+		now:    time.Now,
+		logger: logger,
+		scopes: make(map[cacheScope]*scopeGeneration),
+	}
+}
+
+func (c *cachingClient) Search(ctx context.Context, req torznab.SearchRequest) (torznab.SearchResult, error) {
+	key, scope := cacheKey(req)
+	generation, ok := c.currentGeneration(scope)
+	if !ok {
+		return c.Client.Search(ctx, req)
+	}
+	if cached, ok := c.lru.Get(key); ok && cached.generation == generation {
+		c.logger.Debugw("cache hit", "key", key)
+		return cached.result, nil
+	}
+	result, err := c.Client.Search(ctx, req)
+	if err != nil {
+		return result, err
+	}
+	// the generation from before the search is stored, so that a result is invalidated by events received during it
+	c.lru.Add(key, cachedResult{result: result, generation: generation})
+	return result, nil
+}
+
+func (c *cachingClient) currentGeneration(scope cacheScope) (uint64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.disabled {
+		return 0, false
+	}
+	return c.generation + c.scope(scope).current(c.now(), c.ttl), true
+}
+
+func (c *cachingClient) scope(scope cacheScope) *scopeGeneration {
+	g, ok := c.scopes[scope]
+	if !ok {
+		g = &scopeGeneration{}
+		c.scopes[scope] = g
+	}
+	return g
+}
+
+// run invalidates cached results on torrent events until the events channel is closed.
+func (c *cachingClient) run(ctx context.Context, eventsChan <-chan events.Event) {
+	for e := range eventsChan {
+		c.invalidate(e)
+	}
+	if ctx.Err() == nil {
+		c.logger.Warnw("torrent events subscription closed, disabling the search cache")
+		c.mu.Lock()
+		c.disabled = true
+		c.mu.Unlock()
+		c.lru.Purge()
+	}
+}
+
+// invalidate outdates the cached results that the torrent of an event could appear in: a classified torrent appears in
+// searches of its content type and of all content types, and a deleted torrent could appear in any search.
+func (c *cachingClient) invalidate(e events.Event) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	switch e.Type {
+	case model.TorrentEventTypeClassified:
+		now := c.now()
+		c.scope("").increment(now, c.ttl)
+		if e.ContentType.Valid {
+			c.scope(cacheScope(e.ContentType.ContentType)).increment(now, c.ttl)
+		}
+	case model.TorrentEventTypeDeleted:
+		c.generation++
+	}
+}
+
+// cacheKey returns the key of a normalized search request, so that requests differing only in the case and spacing
+// of the query or the order of categories share a cached result, and the scope of its content type.
+func cacheKey(req torznab.SearchRequest) (string, cacheScope) {
+	req.Query = strings.ToLower(strings.Join(strings.Fields(req.Query), " "))
+	req.Cats = normalizedInts(req.Cats)
+	req.Attrs = normalizedStrings(req.Attrs)
+	key, _ := json.Marshal(req)
+	var scope cacheScope
+	switch req.Type {
+	case torznab.FunctionMovie:
+		scope = cacheScope(model.ContentTypeMovie)
+	case torznab.FunctionTv:
+		scope = cacheScope(model.ContentTypeTvShow)
+	case torznab.FunctionMusic:
+		scope = cacheScope(model.ContentTypeMusic)
+	case torznab.FunctionBook:
+		scope = cacheScope(model.ContentTypeBook)
+	}
+	return string(key), scope
+}
+
+func normalizedInts(values []int) []int {
+	seen := make(map[int]struct{}, len(values))
+	result := make([]int, 0, len(values))
+	for _, v := range values {
+		if _, ok := seen[v]; !ok {
+			seen[v] = struct{}{}
+			result = append(result, v)
+		}
+	}
+	sort.Ints(result)
+	return result
+}
+
+func normalizedStrings(values []string) []string {
+	seen := make(map[string]struct{}, len(values))
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		if _, ok := seen[v]; !ok {
+			seen[v] = struct{}{}
+			result = append(result, v)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
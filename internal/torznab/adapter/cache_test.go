@@ -0,0 +1,96 @@
+package adapter
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/events"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/torznab"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"testing"
+	"time"
+)
+
+type countingClient struct {
+	torznab.Client
+	searches int
+}
+
+func (c *countingClient) Search(context.Context, torznab.SearchRequest) (torznab.SearchResult, error) {
+	c.searches++
+	return torznab.SearchResult{}, nil
+}
+
+func TestCacheKey(t *testing.T) {
+	t.Parallel()
+
+	key, scope := cacheKey(torznab.SearchRequest{Type: torznab.FunctionMovie, Query: " The  Matrix ", Cats: []int{2040, 2000, 2040}})
+	otherKey, _ := cacheKey(torznab.SearchRequest{Type: torznab.FunctionMovie, Query: "the matrix", Cats: []int{2000, 2040}})
+	assert.Equal(t, key, otherKey)
+	assert.Equal(t, cacheScope(model.ContentTypeMovie), scope)
+
+	otherKey, _ = cacheKey(torznab.SearchRequest{Type: torznab.FunctionMovie, Query: "the matrix", Cats: []int{2000}})
+	assert.NotEqual(t, key, otherKey)
+	otherKey, _ = cacheKey(torznab.SearchRequest{Type: torznab.FunctionMovie, Query: "the matrix", Cats: []int{2000, 2040}, BestRelease: true})
+	assert.NotEqual(t, key, otherKey)
+
+	_, scope = cacheKey(torznab.SearchRequest{Type: torznab.FunctionSearch})
+	assert.Equal(t, cacheScope(""), scope)
+}
+
+func TestCachingClient(t *testing.T) {
+	t.Parallel()
+
+	inner := &countingClient{}
+	c := newCachingClient(inner, torznab.Config{CacheTTL: time.Minute, CacheMaxEntries: 10}, zap.NewNop().Sugar())
+	ctx := context.Background()
+	tvReq := torznab.SearchRequest{Type: torznab.FunctionTv}
+	searchReq := torznab.SearchRequest{Type: torznab.FunctionSearch}
+	search := func(req torznab.SearchRequest) {
+		_, err := c.Search(ctx, req)
+		require.NoError(t, err)
+	}
+
+	search(tvReq)
+	search(tvReq)
+	search(searchReq)
+	assert.Equal(t, 2, inner.searches)
+
+	// a classified movie outdates searches of all content types, but not of TV shows
+	c.invalidate(events.Event{
+		Type:        model.TorrentEventTypeClassified,
+		ContentType: model.NewNullContentType(model.ContentTypeMovie),
+	})
+	search(tvReq)
+	search(searchReq)
+	assert.Equal(t, 3, inner.searches)
+
+	// further classified torrents within the TTL are coalesced into an invalidation once it has passed
+	now := time.Now()
+	c.now = func() time.Time { return now }
+	c.invalidate(events.Event{
+		Type:        model.TorrentEventTypeClassified,
+		ContentType: model.NewNullContentType(model.ContentTypeMovie),
+	})
+	search(searchReq)
+	search(searchReq)
+	assert.Equal(t, 3, inner.searches)
+	now = now.Add(time.Minute)
+	search(searchReq)
+	search(searchReq)
+	assert.Equal(t, 4, inner.searches)
+
+	c.invalidate(events.Event{Type: model.TorrentEventTypeDeleted})
+	search(tvReq)
+	search(searchReq)
+	assert.Equal(t, 6, inner.searches)
+
+	// without events, searches are no longer cached
+	eventsChan := make(chan events.Event)
+	close(eventsChan)
+	c.run(ctx, eventsChan)
+	search(tvReq)
+	search(tvReq)
+	assert.Equal(t, 8, inner.searches)
+}
@@ -25,6 +25,8 @@ func (a adapter) Caps(context.Context) (torznab.Caps, error) {
 				SupportedParams: strings.Join([]string{
 					torznab.ParamQuery,
 					torznab.ParamImdbId,
+					torznab.ParamTmdbId,
+					torznab.ParamTvdbId,
 					torznab.ParamSeason,
 					torznab.ParamEpisode,
 				}, ","),
@@ -34,6 +36,7 @@ func (a adapter) Caps(context.Context) (torznab.Caps, error) {
 				SupportedParams: strings.Join([]string{
 					torznab.ParamQuery,
 					torznab.ParamImdbId,
+					torznab.ParamTmdbId,
 				}, ","),
 			},
 			MusicSearch: torznab.CapsSearch{
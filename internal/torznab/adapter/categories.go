@@ -0,0 +1,266 @@
+package adapter
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/database/query"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/search"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/torznab"
+	"slices"
+)
+
+// categoryMapping describes the classified attributes a torznab subcategory corresponds to.
+// The same mappings are used to filter requested categories and to categorise search results,
+// so that an item returned for a category will also be returned when filtering by it.
+type categoryMapping struct {
+	category     torznab.Category
+	contentType  model.ContentType
+	resolutions  []model.VideoResolution
+	video3d      []model.Video3d
+	codecs       []model.VideoCodec
+	sources      []model.VideoSource
+	extensions   []string
+	notFileTypes []model.FileType
+}
+
+var (
+	sdResolutions = []model.VideoResolution{
+		model.VideoResolutionV360p,
+		model.VideoResolutionV480p,
+		model.VideoResolutionV540p,
+		model.VideoResolutionV576p,
+	}
+	hdResolutions = []model.VideoResolution{
+		model.VideoResolutionV720p,
+		model.VideoResolutionV1080p,
+		model.VideoResolutionV1440p,
+	}
+	uhdResolutions = []model.VideoResolution{
+		model.VideoResolutionV2160p,
+		model.VideoResolutionV4320p,
+	}
+)
+
+// contentTypeCategories maps each content type to its top level category.
+var contentTypeCategories = map[model.ContentType]torznab.Category{
+	model.ContentTypeMovie:    torznab.CategoryMovies,
+	model.ContentTypeTvShow:   torznab.CategoryTV,
+	model.ContentTypeMusic:    torznab.CategoryAudio,
+	model.ContentTypeBook:     torznab.CategoryBooks,
+	model.ContentTypeSoftware: torznab.CategoryPC,
+	model.ContentTypeGame:     torznab.CategoryPC,
+	model.ContentTypeXxx:      torznab.CategoryXXX,
+	model.ContentTypeSport:    torznab.CategoryTV,
+}
+
+// categoryMappings are ordered by precedence: a result is assigned the first subcategory it matches.
+var categoryMappings = []categoryMapping{
+	{
+		category:    torznab.CategoryMovies3D,
+		contentType: model.ContentTypeMovie,
+		video3d:     []model.Video3d{model.Video3dV3D, model.Video3dV3DSBS, model.Video3dV3DOU},
+	},
+	{
+		category:    torznab.CategoryMoviesUHD,
+		contentType: model.ContentTypeMovie,
+		resolutions: uhdResolutions,
+	},
+	{
+		category:    torznab.CategoryMoviesHD,
+		contentType: model.ContentTypeMovie,
+		resolutions: hdResolutions,
+	},
+	{
+		category:    torznab.CategoryMoviesSD,
+		contentType: model.ContentTypeMovie,
+		resolutions: sdResolutions,
+	},
+	{
+		category:    torznab.CategoryTVUHD,
+		contentType: model.ContentTypeTvShow,
+		resolutions: uhdResolutions,
+	},
+	{
+		category:    torznab.CategoryTVHD,
+		contentType: model.ContentTypeTvShow,
+		resolutions: hdResolutions,
+	},
+	{
+		category:    torznab.CategoryTVSD,
+		contentType: model.ContentTypeTvShow,
+		resolutions: sdResolutions,
+	},
+	{
+		category:    torznab.CategoryTVSport,
+		contentType: model.ContentTypeSport,
+	},
+	{
+		category:    torznab.CategoryAudioLossless,
+		contentType: model.ContentTypeMusic,
+		extensions:  []string{"flac", "alac", "ape", "wav", "wv"},
+	},
+	{
+		category:    torznab.CategoryAudioMP3,
+		contentType: model.ContentTypeMusic,
+		extensions:  []string{"mp3"},
+	},
+	{
+		category:    torznab.CategoryAudioAudiobook,
+		contentType: model.ContentTypeBook,
+		extensions:  []string{"m4b", "mp3", "m4a", "aac", "ogg", "flac"},
+	},
+	{
+		category:    torznab.CategoryBooksComics,
+		contentType: model.ContentTypeBook,
+		extensions:  []string{"cbr", "cbz", "cb7"},
+	},
+	{
+		category:    torznab.CategoryBooksEBook,
+		contentType: model.ContentTypeBook,
+		extensions:  []string{"epub", "mobi", "azw", "azw3", "pdf", "djvu"},
+	},
+	{
+		category:    torznab.CategoryPCGames,
+		contentType: model.ContentTypeGame,
+	},
+	{
+		category:    torznab.CategoryPCMobileAndroid,
+		contentType: model.ContentTypeSoftware,
+		extensions:  []string{"apk"},
+	},
+	{
+		category:    torznab.CategoryPCMobileiOS,
+		contentType: model.ContentTypeSoftware,
+		extensions:  []string{"ipa"},
+	},
+	{
+		category:    torznab.CategoryPCMac,
+		contentType: model.ContentTypeSoftware,
+		extensions:  []string{"dmg", "pkg"},
+	},
+	{
+		category:    torznab.CategoryPCISO,
+		contentType: model.ContentTypeSoftware,
+		extensions:  []string{"iso"},
+	},
+	{
+		category:    torznab.CategoryXXXx264,
+		contentType: model.ContentTypeXxx,
+		codecs:      []model.VideoCodec{model.VideoCodecH264, model.VideoCodecX264, model.VideoCodecX265},
+	},
+	{
+		category:    torznab.CategoryXXXXviD,
+		contentType: model.ContentTypeXxx,
+		codecs:      []model.VideoCodec{model.VideoCodecXviD, model.VideoCodecDivX},
+	},
+	{
+		category:    torznab.CategoryXXXDVD,
+		contentType: model.ContentTypeXxx,
+		sources:     []model.VideoSource{model.VideoSourceDVD},
+	},
+	{
+		category:     torznab.CategoryXXXImgSet,
+		contentType:  model.ContentTypeXxx,
+		extensions:   model.FileTypeImage.Extensions(),
+		notFileTypes: []model.FileType{model.FileTypeVideo},
+	},
+	{
+		category:    torznab.CategoryXXXOther,
+		contentType: model.ContentTypeXxx,
+	},
+}
+
+func (m categoryMapping) criteria() query.Criteria {
+	criteria := []query.Criteria{search.ContentTypeCriteria(m.contentType)}
+	if len(m.resolutions) > 0 {
+		criteria = append(criteria, search.VideoResolutionCriteria(m.resolutions...))
+	}
+	if len(m.video3d) > 0 {
+		criteria = append(criteria, search.Video3dCriteria(m.video3d...))
+	}
+	if len(m.codecs) > 0 {
+		criteria = append(criteria, search.VideoCodecCriteria(m.codecs...))
+	}
+	if len(m.sources) > 0 {
+		criteria = append(criteria, search.VideoSourceCriteria(m.sources...))
+	}
+	if len(m.extensions) > 0 {
+		criteria = append(criteria, search.TorrentFileExtensionCriteria(m.extensions...))
+	}
+	if len(m.notFileTypes) > 0 {
+		criteria = append(criteria, query.Not(search.TorrentFileTypeCriteria(m.notFileTypes...)))
+	}
+	return query.And(criteria...)
+}
+
+func (m categoryMapping) matches(item model.TorrentContent) bool {
+	if !item.ContentType.Valid || item.ContentType.ContentType != m.contentType {
+		return false
+	}
+	if len(m.resolutions) > 0 && (!item.VideoResolution.Valid || !slices.Contains(m.resolutions, item.VideoResolution.VideoResolution)) {
+		return false
+	}
+	if len(m.video3d) > 0 && (!item.Video3d.Valid || !slices.Contains(m.video3d, item.Video3d.Video3d)) {
+		return false
+	}
+	if len(m.codecs) > 0 && (!item.VideoCodec.Valid || !slices.Contains(m.codecs, item.VideoCodec.VideoCodec)) {
+		return false
+	}
+	if len(m.sources) > 0 && (!item.VideoSource.Valid || !slices.Contains(m.sources, item.VideoSource.VideoSource)) {
+		return false
+	}
+	if len(m.extensions) > 0 && !slices.ContainsFunc(item.Torrent.FileExtensions(), func(ext string) bool {
+		return slices.Contains(m.extensions, ext)
+	}) {
+		return false
+	}
+	if len(m.notFileTypes) > 0 && item.Torrent.HasFileType(m.notFileTypes...).Bool {
+		return false
+	}
+	return true
+}
+
+// categoryCriteria returns the search criteria for a requested category ID, or false if the category is not supported.
+func categoryCriteria(id int) (query.Criteria, bool) {
+	for _, m := range categoryMappings {
+		if m.category.ID == id {
+			return m.criteria(), true
+		}
+	}
+	var contentTypes []model.ContentType
+	for contentType, category := range contentTypeCategories {
+		if category.ID == id {
+			contentTypes = append(contentTypes, contentType)
+		}
+	}
+	slices.Sort(contentTypes)
+	var criteria []query.Criteria
+	if len(contentTypes) > 0 {
+		criteria = append(criteria, search.ContentTypeCriteria(contentTypes...))
+	}
+	// include subcategories of other content types filed under this category, e.g. audiobooks under Audio:
+	for _, m := range categoryMappings {
+		if m.category.ID/1000*1000 == id && !slices.Contains(contentTypes, m.contentType) {
+			criteria = append(criteria, m.criteria())
+		}
+	}
+	if len(criteria) == 0 {
+		return nil, false
+	}
+	return query.Or(criteria...), true
+}
+
+// itemCategoryIDs returns the IDs of the categories a search result belongs to,
+// being the top level category followed by the most specific matching subcategory, if any.
+func itemCategoryIDs(item model.TorrentContent) []int {
+	for _, m := range categoryMappings {
+		if m.matches(item) {
+			return []int{m.category.ID / 1000 * 1000, m.category.ID}
+		}
+	}
+	if item.ContentType.Valid {
+		if category, ok := contentTypeCategories[item.ContentType.ContentType]; ok {
+			return []int{category.ID}
+		}
+	}
+	return []int{torznab.CategoryOther.ID}
+}
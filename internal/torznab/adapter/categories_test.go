@@ -0,0 +1,136 @@
+package adapter
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/torznab"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestItemCategoryIDs(t *testing.T) {
+	t.Parallel()
+
+	withFiles := func(paths ...string) model.Torrent {
+		torrent := model.Torrent{FilesStatus: model.FilesStatusMulti}
+		for _, p := range paths {
+			torrent.Files = append(torrent.Files, model.TorrentFile{Path: p})
+		}
+		return torrent
+	}
+
+	for _, tc := range []struct {
+		name     string
+		item     model.TorrentContent
+		expected []int
+	}{
+		{
+			name:     "unclassified",
+			item:     model.TorrentContent{},
+			expected: []int{torznab.CategoryOther.ID},
+		},
+		{
+			name: "movie without resolution",
+			item: model.TorrentContent{
+				ContentType: model.NewNullContentType(model.ContentTypeMovie),
+			},
+			expected: []int{torznab.CategoryMovies.ID},
+		},
+		{
+			name: "movie 2160p",
+			item: model.TorrentContent{
+				ContentType:     model.NewNullContentType(model.ContentTypeMovie),
+				VideoResolution: model.NewNullVideoResolution(model.VideoResolutionV2160p),
+			},
+			expected: []int{torznab.CategoryMovies.ID, torznab.CategoryMoviesUHD.ID},
+		},
+		{
+			name: "movie 3D takes precedence over resolution",
+			item: model.TorrentContent{
+				ContentType:     model.NewNullContentType(model.ContentTypeMovie),
+				VideoResolution: model.NewNullVideoResolution(model.VideoResolutionV1080p),
+				Video3d:         model.NewNullVideo3d(model.Video3dV3DSBS),
+			},
+			expected: []int{torznab.CategoryMovies.ID, torznab.CategoryMovies3D.ID},
+		},
+		{
+			name: "tv 576p",
+			item: model.TorrentContent{
+				ContentType:     model.NewNullContentType(model.ContentTypeTvShow),
+				VideoResolution: model.NewNullVideoResolution(model.VideoResolutionV576p),
+			},
+			expected: []int{torznab.CategoryTV.ID, torznab.CategoryTVSD.ID},
+		},
+		{
+			name: "sport",
+			item: model.TorrentContent{
+				ContentType:     model.NewNullContentType(model.ContentTypeSport),
+				VideoResolution: model.NewNullVideoResolution(model.VideoResolutionV1080p),
+			},
+			expected: []int{torznab.CategoryTV.ID, torznab.CategoryTVSport.ID},
+		},
+		{
+			name: "lossless music",
+			item: model.TorrentContent{
+				ContentType: model.NewNullContentType(model.ContentTypeMusic),
+				Torrent:     withFiles("Album/01.flac", "Album/cover.jpg"),
+			},
+			expected: []int{torznab.CategoryAudio.ID, torznab.CategoryAudioLossless.ID},
+		},
+		{
+			name: "audiobook",
+			item: model.TorrentContent{
+				ContentType: model.NewNullContentType(model.ContentTypeBook),
+				Torrent:     withFiles("Book/Book.m4b"),
+			},
+			expected: []int{torznab.CategoryAudio.ID, torznab.CategoryAudioAudiobook.ID},
+		},
+		{
+			name: "ebook",
+			item: model.TorrentContent{
+				ContentType: model.NewNullContentType(model.ContentTypeBook),
+				Torrent:     model.Torrent{Name: "Book.epub", FilesStatus: model.FilesStatusSingle},
+			},
+			expected: []int{torznab.CategoryBooks.ID, torznab.CategoryBooksEBook.ID},
+		},
+		{
+			name: "pc game",
+			item: model.TorrentContent{
+				ContentType: model.NewNullContentType(model.ContentTypeGame),
+			},
+			expected: []int{torznab.CategoryPC.ID, torznab.CategoryPCGames.ID},
+		},
+		{
+			name: "xxx image set",
+			item: model.TorrentContent{
+				ContentType: model.NewNullContentType(model.ContentTypeXxx),
+				Torrent:     withFiles("Set/001.jpg", "Set/002.jpg"),
+			},
+			expected: []int{torznab.CategoryXXX.ID, torznab.CategoryXXXImgSet.ID},
+		},
+		{
+			name: "xxx video with cover image",
+			item: model.TorrentContent{
+				ContentType: model.NewNullContentType(model.ContentTypeXxx),
+				Torrent:     withFiles("Video/video.mp4", "Video/cover.jpg"),
+			},
+			expected: []int{torznab.CategoryXXX.ID, torznab.CategoryXXXOther.ID},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, itemCategoryIDs(tc.item))
+		})
+	}
+}
+
+func TestCategoryCriteria(t *testing.T) {
+	t.Parallel()
+
+	for _, category := range torznab.TopLevelCategories {
+		_, ok := categoryCriteria(category.ID)
+		assert.Equal(t, category.ID != torznab.CategoryOther.ID, ok, category.Name)
+		for _, subcat := range category.Subcat {
+			_, ok := categoryCriteria(subcat.ID)
+			assert.True(t, ok, subcat.Name)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package adapter
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/bitmagnet-io/bitmagnet/internal/database/query"
 	"github.com/bitmagnet-io/bitmagnet/internal/database/search"
@@ -20,6 +21,12 @@ func (a adapter) Search(ctx context.Context, req torznab.SearchRequest) (torznab
 	}
 	searchResult, searchErr := a.search.TorrentContent(ctx, options...)
 	if searchErr != nil {
+		if errors.Is(searchErr, query.ErrInvalidCursor) {
+			return torznab.SearchResult{}, torznab.Error{
+				Code:        201,
+				Description: fmt.Sprintf("incorrect parameter (%s)", torznab.ParamCursor),
+			}
+		}
 		return torznab.SearchResult{}, searchErr
 	}
 	return a.transformSearchResult(req, searchResult), nil
@@ -45,87 +52,36 @@ func (a adapter) searchRequestOptions(r torznab.SearchRequest) ([]query.Option,
 		}
 	}
 	if r.Query != "" {
-		options = append(options, query.QueryString(r.Query), query.OrderByQueryStringRank())
+		options = append(options, query.QueryString(r.Query), a.searchConfig.QueryStringOrder())
 	}
 	var catsCriteria []query.Criteria
 	for _, cat := range r.Cats {
-		var catCriteria []query.Criteria
-		if torznab.CategoryMovies.Has(cat) {
-			if r.Type != torznab.FunctionMovie {
-				catCriteria = append(catCriteria, search.ContentTypeCriteria(model.ContentTypeMovie))
-			}
-			if torznab.CategoryMoviesSD.ID == cat {
-				catCriteria = append(catCriteria, search.VideoResolutionCriteria(model.VideoResolutionV480p))
-			} else if torznab.CategoryMoviesHD.ID == cat {
-				catCriteria = append(catCriteria, search.VideoResolutionCriteria(
-					model.VideoResolutionV720p,
-					model.VideoResolutionV1080p,
-					model.VideoResolutionV1440p,
-					model.VideoResolutionV2160p,
-				))
-			} else if torznab.CategoryMoviesUHD.ID == cat {
-				catCriteria = append(catCriteria, search.VideoResolutionCriteria(model.VideoResolutionV2160p))
-			} else if torznab.CategoryMovies3D.ID == cat {
-				catCriteria = append(catCriteria, search.Video3dCriteria(
-					model.Video3dV3D,
-					model.Video3dV3DSBS,
-					model.Video3dV3DOU,
-				))
-			}
-		} else if torznab.CategoryTV.Has(cat) {
-			if r.Type != torznab.FunctionTv {
-				catCriteria = append(catCriteria, search.ContentTypeCriteria(model.ContentTypeTvShow))
-			}
-			if torznab.CategoryTVSD.ID == cat {
-				catCriteria = append(catCriteria, search.VideoResolutionCriteria(model.VideoResolutionV480p))
-			} else if torznab.CategoryTVHD.ID == cat {
-				catCriteria = append(catCriteria, search.VideoResolutionCriteria(
-					model.VideoResolutionV720p,
-					model.VideoResolutionV1080p,
-					model.VideoResolutionV1440p,
-					model.VideoResolutionV2160p,
-				))
-			} else if torznab.CategoryTVUHD.ID == cat {
-				catCriteria = append(catCriteria, search.VideoResolutionCriteria(model.VideoResolutionV2160p))
-			}
-		} else if torznab.CategoryAudio.Has(cat) {
-			if r.Type != torznab.FunctionMusic {
-				catCriteria = append(catCriteria, search.ContentTypeCriteria(model.ContentTypeMusic))
-			}
-		} else if torznab.CategoryBooks.Has(cat) {
-			if r.Type != torznab.FunctionBook {
-				catCriteria = append(catCriteria, search.ContentTypeCriteria(model.ContentTypeBook))
-			}
-		}
-		if len(catCriteria) > 0 {
-			catsCriteria = append(catsCriteria, query.And(catCriteria...))
+		if catCriteria, ok := categoryCriteria(cat); ok {
+			catsCriteria = append(catsCriteria, catCriteria)
 		}
 	}
 	if len(catsCriteria) > 0 {
 		options = append(options, query.Where(query.Or(catsCriteria...)))
 	}
-	if r.ImdbId.Valid {
-		imdbId := r.ImdbId.String
-		if !strings.HasPrefix(imdbId, "tt") {
-			imdbId = "tt" + imdbId
-		}
-		var refs []model.ContentRef
-		if r.Type != torznab.FunctionTv {
-			refs = append(refs, model.ContentRef{
-				Type:   model.ContentTypeMovie,
-				Source: "imdb",
-				ID:     imdbId,
-			})
-		}
-		if r.Type != torznab.FunctionMovie {
-			refs = append(refs, model.ContentRef{
-				Type:   model.ContentTypeTvShow,
-				Source: "imdb",
-				ID:     imdbId,
-			})
-		}
+	if refs := identifierRefs(r); len(refs) > 0 {
 		options = append(options, query.Where(search.ContentIdentifierCriteria(refs...)))
 	}
+	if !r.AirDate.IsNil() {
+		options = append(options, query.Where(search.TorrentContentAirDateCriteria(r.AirDate)))
+	} else if r.Season.Valid {
+		options = append(options, query.Where(search.TorrentContentEpisodeCriteria(r.Season.Int, r.Episode)))
+	}
+	if r.BestRelease {
+		options = append(options, query.Where(search.BestReleaseCriteria()))
+	}
+	// quarantined torrents are only found through the review API
+	options = append(options, query.Where(search.TorrentContentQuarantinedCriteria(false)))
+	if r.ExcludeProbablyFake {
+		options = append(options, query.Where(search.TorrentContentProbablyFakeCriteria(false)))
+	}
+	if r.ReportThreshold > 0 {
+		options = append(options, query.Where(search.TorrentContentMaxReportsCriteria(r.ReportThreshold-1)))
+	}
 	limit := a.defaultLimit
 	if r.Limit.Valid {
 		limit = r.Limit.Uint
@@ -134,13 +90,50 @@ func (a adapter) searchRequestOptions(r torznab.SearchRequest) ([]query.Option,
 		}
 	}
 	options = append(options, query.Limit(limit))
-	if r.Offset.Valid {
+	if r.Cursor.Valid {
+		options = append(options, query.Cursor(r.Cursor.String))
+	} else if r.Offset.Valid {
 		options = append(options, query.Offset(r.Offset.Uint))
 	}
-	// todo: Season and episodes
 	return options, nil
 }
 
+// identifierRefs returns the content references for any IDs in the request; a match on any of them is a match for the request.
+// IDs are matched against movies and TV shows according to the request type, and TVDB IDs against TV shows only.
+func identifierRefs(r torznab.SearchRequest) []model.ContentRef {
+	var contentTypes []model.ContentType
+	if r.Type != torznab.FunctionTv {
+		contentTypes = append(contentTypes, model.ContentTypeMovie)
+	}
+	if r.Type != torznab.FunctionMovie {
+		contentTypes = append(contentTypes, model.ContentTypeTvShow)
+	}
+	var refs []model.ContentRef
+	addRefs := func(source string, id string, types ...model.ContentType) {
+		for _, t := range types {
+			refs = append(refs, model.ContentRef{
+				Type:   t,
+				Source: source,
+				ID:     id,
+			})
+		}
+	}
+	if r.ImdbId.Valid {
+		imdbId := r.ImdbId.String
+		if !strings.HasPrefix(imdbId, "tt") {
+			imdbId = "tt" + imdbId
+		}
+		addRefs("imdb", imdbId, contentTypes...)
+	}
+	if r.TmdbId.Valid {
+		addRefs("tmdb", r.TmdbId.String, contentTypes...)
+	}
+	if r.TvdbId.Valid && r.Type != torznab.FunctionMovie {
+		addRefs("tvdb", r.TvdbId.String, model.ContentTypeTvShow)
+	}
+	return refs
+}
+
 func (a adapter) transformSearchResult(req torznab.SearchRequest, res search.TorrentContentResult) torznab.SearchResult {
 	entries := make([]torznab.SearchResultItem, 0, len(res.Items))
 	for _, item := range res.Items {
@@ -157,28 +150,14 @@ func (a adapter) transformSearchResult(req torznab.SearchRequest, res search.Tor
 		if date.IsZero() {
 			date = item.CreatedAt
 		}
-		categoryId := torznab.CategoryOther.ID
-		if item.ContentType.Valid {
-			switch item.ContentType.ContentType {
-			case model.ContentTypeMovie:
-				categoryId = torznab.CategoryMovies.ID
-			case model.ContentTypeTvShow:
-				categoryId = torznab.CategoryTV.ID
-			case model.ContentTypeMusic:
-				categoryId = torznab.CategoryAudio.ID
-			case model.ContentTypeBook:
-				categoryId = torznab.CategoryBooks.ID
-			case model.ContentTypeSoftware:
-				categoryId = torznab.CategoryPC.ID
-			case model.ContentTypeGame:
-				categoryId = torznab.CategoryPCGames.ID
-			}
-		}
-		attrs := []torznab.SearchResultItemTorznabAttr{
-			{
+		var attrs []torznab.SearchResultItemTorznabAttr
+		for _, categoryId := range itemCategoryIDs(item.TorrentContent) {
+			attrs = append(attrs, torznab.SearchResultItemTorznabAttr{
 				AttrName:  torznab.AttrCategory,
 				AttrValue: strconv.Itoa(categoryId),
-			},
+			})
+		}
+		attrs = append(attrs, []torznab.SearchResultItemTorznabAttr{
 			{
 				AttrName:  torznab.AttrSize,
 				AttrValue: strconv.FormatUint(item.Torrent.Size, 10),
@@ -187,7 +166,7 @@ func (a adapter) transformSearchResult(req torznab.SearchRequest, res search.Tor
 				AttrName:  torznab.AttrPublishDate,
 				AttrValue: date.Format(torznab.RssDateDefaultFormat),
 			},
-		}
+		}...)
 		if seeders := item.Torrent.Seeders(); seeders.Valid {
 			attrs = append(attrs, torznab.SearchResultItemTorznabAttr{
 				AttrName:  torznab.AttrSeeders,
@@ -225,6 +204,20 @@ func (a adapter) transformSearchResult(req torznab.SearchRequest, res search.Tor
 					AttrValue: strconv.Itoa(seasons[0].Episodes[0]),
 				})
 			}
+			pack := "0"
+			if item.Episodes.IsPack() {
+				pack = "1"
+			}
+			attrs = append(attrs, torznab.SearchResultItemTorznabAttr{
+				AttrName:  torznab.AttrPack,
+				AttrValue: pack,
+			})
+		}
+		if item.ProbablyFake {
+			attrs = append(attrs, torznab.SearchResultItemTorznabAttr{
+				AttrName:  torznab.AttrProbablyFake,
+				AttrValue: "1",
+			})
 		}
 		if item.VideoCodec.Valid {
 			attrs = append(attrs, torznab.SearchResultItemTorznabAttr{
@@ -238,18 +231,54 @@ func (a adapter) transformSearchResult(req torznab.SearchRequest, res search.Tor
 				AttrValue: item.VideoResolution.VideoResolution.Label(),
 			})
 		}
+		if len(item.HdrFormats) > 0 {
+			attrs = append(attrs, torznab.SearchResultItemTorznabAttr{
+				AttrName:  torznab.AttrHdr,
+				AttrValue: strings.Join(item.HdrFormats.Labels(), ", "),
+			})
+		}
+		if len(item.AudioFormats) > 0 {
+			attrs = append(attrs, torznab.SearchResultItemTorznabAttr{
+				AttrName:  torznab.AttrAudio,
+				AttrValue: strings.Join(item.AudioFormats.Labels(), ", "),
+			})
+		}
 		if item.ReleaseGroup.Valid {
 			attrs = append(attrs, torznab.SearchResultItemTorznabAttr{
 				AttrName:  torznab.AttrTeam,
 				AttrValue: item.ReleaseGroup.String,
 			})
 		}
+		if len(item.Languages) > 0 {
+			attrs = append(attrs, torznab.SearchResultItemTorznabAttr{
+				AttrName:  torznab.AttrLanguage,
+				AttrValue: languageNames(item.Languages),
+			})
+		}
+		if len(item.SubtitleLanguages) > 0 {
+			attrs = append(attrs, torznab.SearchResultItemTorznabAttr{
+				AttrName:  torznab.AttrSubs,
+				AttrValue: languageNames(item.SubtitleLanguages),
+			})
+		}
 		if imdbId, ok := item.Content.Identifier("imdb"); ok {
 			attrs = append(attrs, torznab.SearchResultItemTorznabAttr{
 				AttrName:  torznab.AttrImdb,
 				AttrValue: imdbId[2:],
 			})
 		}
+		if tmdbId, ok := item.Content.Identifier("tmdb"); ok {
+			attrs = append(attrs, torznab.SearchResultItemTorznabAttr{
+				AttrName:  torznab.AttrTmdb,
+				AttrValue: tmdbId,
+			})
+		}
+		if tvdbId, ok := item.Content.Identifier("tvdb"); ok {
+			attrs = append(attrs, torznab.SearchResultItemTorznabAttr{
+				AttrName:  torznab.AttrTvdb,
+				AttrValue: tvdbId,
+			})
+		}
 		entries = append(entries, torznab.SearchResultItem{
 			Title:    item.Torrent.Name,
 			Size:     item.Torrent.Size,
@@ -257,7 +286,7 @@ func (a adapter) transformSearchResult(req torznab.SearchRequest, res search.Tor
 			GUID:     item.InfoHash.String(),
 			PubDate:  torznab.RssDate(date),
 			Enclosure: torznab.SearchResultItemEnclosure{
-				URL:    item.Torrent.MagnetUri(),
+				URL:    item.Torrent.MagnetUri(a.trackerList.Trackers()...),
 				Type:   "application/x-bittorrent;x-scheme-handler/magnet",
 				Length: strconv.FormatUint(item.Torrent.Size, 10),
 			},
@@ -270,8 +299,17 @@ func (a adapter) transformSearchResult(req torznab.SearchRequest, res search.Tor
 			Response: torznab.SearchResultResponse{
 				Offset: req.Offset.Uint,
 				//Total:  res.TotalCount,
+				Cursor: res.NextCursor,
 			},
 			Items: entries,
 		},
 	}
 }
+
+func languageNames(languages model.Languages) string {
+	names := make([]string, 0, len(languages))
+	for _, lang := range languages.Slice() {
+		names = append(names, lang.Name())
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,73 @@
+package adapter
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/torznab"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestIdentifierRefs(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name     string
+		req      torznab.SearchRequest
+		expected []model.ContentRef
+	}{
+		{
+			name: "no identifiers",
+			req:  torznab.SearchRequest{Type: torznab.FunctionMovie, Query: "x"},
+		},
+		{
+			name: "movie imdb and tmdb",
+			req: torznab.SearchRequest{
+				Type:   torznab.FunctionMovie,
+				ImdbId: model.NewNullString("0111161"),
+				TmdbId: model.NewNullString("278"),
+			},
+			expected: []model.ContentRef{
+				{Type: model.ContentTypeMovie, Source: "imdb", ID: "tt0111161"},
+				{Type: model.ContentTypeMovie, Source: "tmdb", ID: "278"},
+			},
+		},
+		{
+			name: "tv tvdb",
+			req: torznab.SearchRequest{
+				Type:   torznab.FunctionTv,
+				TvdbId: model.NewNullString("81189"),
+			},
+			expected: []model.ContentRef{
+				{Type: model.ContentTypeTvShow, Source: "tvdb", ID: "81189"},
+			},
+		},
+		{
+			name: "movie ignores tvdb",
+			req: torznab.SearchRequest{
+				Type:   torznab.FunctionMovie,
+				TvdbId: model.NewNullString("81189"),
+			},
+		},
+		{
+			name: "search matches both types",
+			req: torznab.SearchRequest{
+				Type:   torznab.FunctionSearch,
+				ImdbId: model.NewNullString("tt0903747"),
+			},
+			expected: []model.ContentRef{
+				{Type: model.ContentTypeMovie, Source: "imdb", ID: "tt0903747"},
+				{Type: model.ContentTypeTvShow, Source: "imdb", ID: "tt0903747"},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, identifierRefs(tc.req))
+		})
+	}
+}
+
+func TestLanguageNames(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "English, French", languageNames(model.Languages{"fr": {}, "en": {}}))
+}
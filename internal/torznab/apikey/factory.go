@@ -0,0 +1,39 @@
+package apikey
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/auth"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/torznab"
+	"go.uber.org/fx"
+	"golang.org/x/time/rate"
+)
+
+type Params struct {
+	fx.In
+	Config     torznab.Config
+	AuthConfig auth.Config
+	Dao        lazy.Lazy[*dao.Query]
+}
+
+type Result struct {
+	fx.Out
+	Manager lazy.Lazy[Manager]
+}
+
+func New(p Params) Result {
+	return Result{
+		Manager: lazy.New(func() (Manager, error) {
+			d, err := p.Dao.Get()
+			if err != nil {
+				return nil, err
+			}
+			return &manager{
+				dao:        d,
+				configKeys: p.Config.APIKeys,
+				required:   p.AuthConfig.Enabled,
+				limiters:   make(map[string]*rate.Limiter),
+			}, nil
+		}),
+	}
+}
@@ -0,0 +1,192 @@
+package apikey
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/torznab"
+	"golang.org/x/time/rate"
+	"gorm.io/gorm"
+	"sync"
+	"time"
+)
+
+var (
+	ErrMissingKey  = errors.New("missing API key")
+	ErrInvalidKey  = errors.New("invalid API key")
+	ErrRateLimited = errors.New("API key rate limit exceeded")
+)
+
+// lastUsedResolution avoids writing the last used time of a key on every request.
+const lastUsedResolution = time.Minute
+
+// Key identifies the API key a request was authenticated with.
+type Key struct {
+	Name      string
+	RateLimit model.NullUint
+}
+
+// Manager authenticates torznab requests against the configured API keys and the keys stored in the database.
+type Manager interface {
+	List(ctx context.Context) ([]model.TorznabAPIKey, error)
+	// Create stores a new API key with a randomly generated value.
+	Create(ctx context.Context, name string, rateLimit model.NullUint) (model.TorznabAPIKey, error)
+	Delete(ctx context.Context, names ...string) error
+	// Authenticate returns the key with the given value; a zero Key is returned if no API keys exist and user authentication is disabled,
+	// in which case requests are unauthenticated.
+	// ErrRateLimited is returned if the key has exceeded its rate limit.
+	Authenticate(ctx context.Context, value string) (Key, error)
+}
+
+type manager struct {
+	dao        *dao.Query
+	configKeys map[string]torznab.APIKeyConfig
+	// required is set when user authentication is enabled, in which case requests without a valid key are rejected even if no keys exist.
+	required bool
+	mu       sync.Mutex
+	limiters map[string]*rate.Limiter
+}
+
+func (m *manager) List(ctx context.Context) ([]model.TorznabAPIKey, error) {
+	keys, err := m.dao.TorznabAPIKey.WithContext(ctx).Order(m.dao.TorznabAPIKey.Name).Find()
+	if err != nil {
+		return nil, err
+	}
+	result := make([]model.TorznabAPIKey, 0, len(keys))
+	for _, k := range keys {
+		result = append(result, *k)
+	}
+	return result, nil
+}
+
+func (m *manager) Create(ctx context.Context, name string, rateLimit model.NullUint) (model.TorznabAPIKey, error) {
+	if name == "" {
+		return model.TorznabAPIKey{}, errors.New("an API key must have a name")
+	}
+	if _, ok := m.configKeys[name]; ok {
+		return model.TorznabAPIKey{}, errors.New("an API key with this name is already configured")
+	}
+	if count, err := m.dao.TorznabAPIKey.WithContext(ctx).Where(m.dao.TorznabAPIKey.Name.Eq(name)).Count(); err != nil {
+		return model.TorznabAPIKey{}, err
+	} else if count > 0 {
+		return model.TorznabAPIKey{}, errors.New("an API key with this name already exists")
+	}
+	value, err := generateKey()
+	if err != nil {
+		return model.TorznabAPIKey{}, err
+	}
+	key := model.TorznabAPIKey{
+		Name:      name,
+		Key:       value,
+		RateLimit: rateLimit,
+	}
+	if err := m.dao.TorznabAPIKey.WithContext(ctx).Create(&key); err != nil {
+		return model.TorznabAPIKey{}, err
+	}
+	return key, nil
+}
+
+func (m *manager) Delete(ctx context.Context, names ...string) error {
+	if _, err := m.dao.TorznabAPIKey.WithContext(ctx).Where(m.dao.TorznabAPIKey.Name.In(names...)).Delete(); err != nil {
+		return err
+	}
+	m.mu.Lock()
+	for _, name := range names {
+		delete(m.limiters, name)
+	}
+	m.mu.Unlock()
+	return nil
+}
+
+func (m *manager) Authenticate(ctx context.Context, value string) (Key, error) {
+	if value == "" {
+		if enabled, err := m.enabled(ctx); err != nil {
+			return Key{}, err
+		} else if enabled {
+			return Key{}, ErrMissingKey
+		}
+		return Key{}, nil
+	}
+	key, err := m.find(ctx, value)
+	if errors.Is(err, ErrInvalidKey) {
+		// any key is accepted until the first one is created, as clients may send a placeholder:
+		if enabled, enabledErr := m.enabled(ctx); enabledErr != nil {
+			return Key{}, enabledErr
+		} else if !enabled {
+			return Key{}, nil
+		}
+	}
+	if err != nil {
+		return Key{}, err
+	}
+	if key.RateLimit.Valid && key.RateLimit.Uint > 0 && !m.limiter(key).Allow() {
+		return key, ErrRateLimited
+	}
+	return key, nil
+}
+
+// enabled returns true if any API keys are configured or stored, in which case requests must be authenticated.
+func (m *manager) enabled(ctx context.Context) (bool, error) {
+	if m.required || len(m.configKeys) > 0 {
+		return true, nil
+	}
+	count, err := m.dao.TorznabAPIKey.WithContext(ctx).Count()
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (m *manager) find(ctx context.Context, value string) (Key, error) {
+	for name, k := range m.configKeys {
+		if subtle.ConstantTimeCompare([]byte(k.Key), []byte(value)) == 1 {
+			return Key{
+				Name:      name,
+				RateLimit: model.NullUint{Uint: k.RateLimit, Valid: k.RateLimit > 0},
+			}, nil
+		}
+	}
+	stored, err := m.dao.TorznabAPIKey.WithContext(ctx).Where(m.dao.TorznabAPIKey.Key.Eq(value)).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return Key{}, ErrInvalidKey
+		}
+		return Key{}, err
+	}
+	if now := time.Now(); stored.LastUsedAt == nil || now.Sub(*stored.LastUsedAt) > lastUsedResolution {
+		if _, err := m.dao.TorznabAPIKey.WithContext(ctx).Where(
+			m.dao.TorznabAPIKey.Name.Eq(stored.Name),
+		).UpdateSimple(m.dao.TorznabAPIKey.LastUsedAt.Value(now)); err != nil {
+			return Key{}, err
+		}
+	}
+	return Key{
+		Name:      stored.Name,
+		RateLimit: stored.RateLimit,
+	}, nil
+}
+
+// limiter returns the rate limiter of a key, replacing it if the key's rate limit has changed.
+func (m *manager) limiter(key Key) *rate.Limiter {
+	limit := rate.Limit(float64(key.RateLimit.Uint) / time.Minute.Seconds())
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	l, ok := m.limiters[key.Name]
+	if !ok || l.Limit() != limit {
+		l = rate.NewLimiter(limit, int(key.RateLimit.Uint))
+		m.limiters[key.Name] = l
+	}
+	return l
+}
+
+func generateKey() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
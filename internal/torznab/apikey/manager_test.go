@@ -0,0 +1,51 @@
+package apikey
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/torznab"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/time/rate"
+	"testing"
+)
+
+func TestAuthenticateConfigKey(t *testing.T) {
+	t.Parallel()
+
+	m := &manager{
+		configKeys: map[string]torznab.APIKeyConfig{
+			"friend": {Key: "abc123", RateLimit: 2},
+			"me":     {Key: "def456"},
+		},
+		limiters: make(map[string]*rate.Limiter),
+	}
+	ctx := context.Background()
+
+	_, err := m.Authenticate(ctx, "")
+	assert.ErrorIs(t, err, ErrMissingKey)
+
+	for i := 0; i < 2; i++ {
+		key, err := m.Authenticate(ctx, "abc123")
+		assert.NoError(t, err)
+		assert.Equal(t, "friend", key.Name)
+	}
+	key, err := m.Authenticate(ctx, "abc123")
+	assert.ErrorIs(t, err, ErrRateLimited)
+	assert.Equal(t, "friend", key.Name)
+
+	for i := 0; i < 5; i++ {
+		key, err := m.Authenticate(ctx, "def456")
+		assert.NoError(t, err)
+		assert.Equal(t, "me", key.Name)
+	}
+}
+
+func TestGenerateKey(t *testing.T) {
+	t.Parallel()
+
+	a, err := generateKey()
+	assert.NoError(t, err)
+	assert.Len(t, a, 32)
+	b, err := generateKey()
+	assert.NoError(t, err)
+	assert.NotEqual(t, a, b)
+}
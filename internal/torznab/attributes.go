@@ -12,9 +12,25 @@ const (
 	AttrYear    = "year"
 	AttrSeason  = "season"
 	AttrEpisode = "episode"
+	// AttrPack is 1 for a season pack, episode range or multi-season bundle, and 0 for a single episode;
+	// it isn't a standard Torznab attribute
+	AttrPack = "pack"
+	// AttrProbablyFake is 1 for a torrent whose size is implausible for its content, and is omitted otherwise;
+	// it isn't a standard Torznab attribute
+	AttrProbablyFake = "probablyfake"
 	// AttrVideo is the video codec
 	AttrVideo      = "video"
 	AttrResolution = "resolution"
-	AttrTeam       = "team"
-	AttrImdb       = "imdb"
+	// AttrAudio is the audio formats, separated by commas
+	AttrAudio = "audio"
+	// AttrHdr is the HDR formats, separated by commas; it isn't a standard Torznab attribute
+	AttrHdr  = "hdr"
+	AttrTeam = "team"
+	// AttrLanguage is the audio languages, separated by commas
+	AttrLanguage = "language"
+	// AttrSubs is the subtitle languages, separated by commas
+	AttrSubs = "subs"
+	AttrImdb = "imdb"
+	AttrTmdb = "tmdbid"
+	AttrTvdb = "tvdbid"
 )
@@ -3,165 +3,295 @@
 package torznab
 
 var categoriesMap = map[int]Category{
-  2000: {
-    ID: 2000,
-    Name: "Movies",
-    Subcat: []Subcategory{
-      {
-        ID: 2030,
-        Name: "Movies/SD",
-      },
-      {
-        ID: 2040,
-        Name: "Movies/HD",
-      },
-      {
-        ID: 2045,
-        Name: "Movies/UHD",
-      },
-      {
-        ID: 2060,
-        Name: "Movies/3D",
-      },
-    },
-  },
-  2030: {
-    ID: 2030,
-    Name: "Movies/SD",
-    Subcat: []Subcategory{
-    },
-  },
-  2040: {
-    ID: 2040,
-    Name: "Movies/HD",
-    Subcat: []Subcategory{
-    },
-  },
-  2045: {
-    ID: 2045,
-    Name: "Movies/UHD",
-    Subcat: []Subcategory{
-    },
-  },
-  2060: {
-    ID: 2060,
-    Name: "Movies/3D",
-    Subcat: []Subcategory{
-    },
-  },
-  3000: {
-    ID: 3000,
-    Name: "Audio",
-    Subcat: []Subcategory{
-    },
-  },
-  4000: {
-    ID: 4000,
-    Name: "PC",
-    Subcat: []Subcategory{
-      {
-        ID: 4050,
-        Name: "PC/Games",
-      },
-    },
-  },
-  4050: {
-    ID: 4050,
-    Name: "PC/Games",
-    Subcat: []Subcategory{
-    },
-  },
-  5000: {
-    ID: 5000,
-    Name: "TV",
-    Subcat: []Subcategory{
-      {
-        ID: 5030,
-        Name: "TV/SD",
-      },
-      {
-        ID: 5040,
-        Name: "TV/HD",
-      },
-      {
-        ID: 5045,
-        Name: "TV/UHD",
-      },
-    },
-  },
-  5030: {
-    ID: 5030,
-    Name: "TV/SD",
-    Subcat: []Subcategory{
-    },
-  },
-  5040: {
-    ID: 5040,
-    Name: "TV/HD",
-    Subcat: []Subcategory{
-    },
-  },
-  5045: {
-    ID: 5045,
-    Name: "TV/UHD",
-    Subcat: []Subcategory{
-    },
-  },
-  6000: {
-    ID: 6000,
-    Name: "XXX",
-    Subcat: []Subcategory{
-      {
-        ID: 6070,
-        Name: "XXX/Other",
-      },
-    },
-  },
-  6070: {
-    ID: 6070,
-    Name: "XXX/Other",
-    Subcat: []Subcategory{
-    },
-  },
-  7000: {
-    ID: 7000,
-    Name: "Books",
-    Subcat: []Subcategory{
-    },
-  },
-  8000: {
-    ID: 8000,
-    Name: "Other",
-    Subcat: []Subcategory{
-    },
-  },
+	2000: {
+		ID:   2000,
+		Name: "Movies",
+		Subcat: []Subcategory{
+			{
+				ID:   2030,
+				Name: "Movies/SD",
+			},
+			{
+				ID:   2040,
+				Name: "Movies/HD",
+			},
+			{
+				ID:   2045,
+				Name: "Movies/UHD",
+			},
+			{
+				ID:   2060,
+				Name: "Movies/3D",
+			},
+		},
+	},
+	2030: {
+		ID:     2030,
+		Name:   "Movies/SD",
+		Subcat: []Subcategory{},
+	},
+	2040: {
+		ID:     2040,
+		Name:   "Movies/HD",
+		Subcat: []Subcategory{},
+	},
+	2045: {
+		ID:     2045,
+		Name:   "Movies/UHD",
+		Subcat: []Subcategory{},
+	},
+	2060: {
+		ID:     2060,
+		Name:   "Movies/3D",
+		Subcat: []Subcategory{},
+	},
+	3000: {
+		ID:   3000,
+		Name: "Audio",
+		Subcat: []Subcategory{
+			{
+				ID:   3010,
+				Name: "Audio/MP3",
+			},
+			{
+				ID:   3030,
+				Name: "Audio/Audiobook",
+			},
+			{
+				ID:   3040,
+				Name: "Audio/Lossless",
+			},
+		},
+	},
+	3010: {
+		ID:     3010,
+		Name:   "Audio/MP3",
+		Subcat: []Subcategory{},
+	},
+	3030: {
+		ID:     3030,
+		Name:   "Audio/Audiobook",
+		Subcat: []Subcategory{},
+	},
+	3040: {
+		ID:     3040,
+		Name:   "Audio/Lossless",
+		Subcat: []Subcategory{},
+	},
+	4000: {
+		ID:   4000,
+		Name: "PC",
+		Subcat: []Subcategory{
+			{
+				ID:   4020,
+				Name: "PC/ISO",
+			},
+			{
+				ID:   4030,
+				Name: "PC/Mac",
+			},
+			{
+				ID:   4050,
+				Name: "PC/Games",
+			},
+			{
+				ID:   4060,
+				Name: "PC/Mobile-iOS",
+			},
+			{
+				ID:   4070,
+				Name: "PC/Mobile-Android",
+			},
+		},
+	},
+	4020: {
+		ID:     4020,
+		Name:   "PC/ISO",
+		Subcat: []Subcategory{},
+	},
+	4030: {
+		ID:     4030,
+		Name:   "PC/Mac",
+		Subcat: []Subcategory{},
+	},
+	4050: {
+		ID:     4050,
+		Name:   "PC/Games",
+		Subcat: []Subcategory{},
+	},
+	4060: {
+		ID:     4060,
+		Name:   "PC/Mobile-iOS",
+		Subcat: []Subcategory{},
+	},
+	4070: {
+		ID:     4070,
+		Name:   "PC/Mobile-Android",
+		Subcat: []Subcategory{},
+	},
+	5000: {
+		ID:   5000,
+		Name: "TV",
+		Subcat: []Subcategory{
+			{
+				ID:   5030,
+				Name: "TV/SD",
+			},
+			{
+				ID:   5040,
+				Name: "TV/HD",
+			},
+			{
+				ID:   5045,
+				Name: "TV/UHD",
+			},
+			{
+				ID:   5060,
+				Name: "TV/Sport",
+			},
+		},
+	},
+	5030: {
+		ID:     5030,
+		Name:   "TV/SD",
+		Subcat: []Subcategory{},
+	},
+	5040: {
+		ID:     5040,
+		Name:   "TV/HD",
+		Subcat: []Subcategory{},
+	},
+	5045: {
+		ID:     5045,
+		Name:   "TV/UHD",
+		Subcat: []Subcategory{},
+	},
+	5060: {
+		ID:     5060,
+		Name:   "TV/Sport",
+		Subcat: []Subcategory{},
+	},
+	6000: {
+		ID:   6000,
+		Name: "XXX",
+		Subcat: []Subcategory{
+			{
+				ID:   6010,
+				Name: "XXX/DVD",
+			},
+			{
+				ID:   6030,
+				Name: "XXX/XviD",
+			},
+			{
+				ID:   6040,
+				Name: "XXX/x264",
+			},
+			{
+				ID:   6060,
+				Name: "XXX/ImgSet",
+			},
+			{
+				ID:   6070,
+				Name: "XXX/Other",
+			},
+		},
+	},
+	6010: {
+		ID:     6010,
+		Name:   "XXX/DVD",
+		Subcat: []Subcategory{},
+	},
+	6030: {
+		ID:     6030,
+		Name:   "XXX/XviD",
+		Subcat: []Subcategory{},
+	},
+	6040: {
+		ID:     6040,
+		Name:   "XXX/x264",
+		Subcat: []Subcategory{},
+	},
+	6060: {
+		ID:     6060,
+		Name:   "XXX/ImgSet",
+		Subcat: []Subcategory{},
+	},
+	6070: {
+		ID:     6070,
+		Name:   "XXX/Other",
+		Subcat: []Subcategory{},
+	},
+	7000: {
+		ID:   7000,
+		Name: "Books",
+		Subcat: []Subcategory{
+			{
+				ID:   7020,
+				Name: "Books/EBook",
+			},
+			{
+				ID:   7030,
+				Name: "Books/Comics",
+			},
+		},
+	},
+	7020: {
+		ID:     7020,
+		Name:   "Books/EBook",
+		Subcat: []Subcategory{},
+	},
+	7030: {
+		ID:     7030,
+		Name:   "Books/Comics",
+		Subcat: []Subcategory{},
+	},
+	8000: {
+		ID:     8000,
+		Name:   "Other",
+		Subcat: []Subcategory{},
+	},
 }
 
 var (
-  CategoryMovies    = categoriesMap[2000]
-  CategoryMoviesSD  = categoriesMap[2030]
-  CategoryMoviesHD  = categoriesMap[2040]
-  CategoryMoviesUHD = categoriesMap[2045]
-  CategoryMovies3D  = categoriesMap[2060]
-  CategoryAudio     = categoriesMap[3000]
-  CategoryPC        = categoriesMap[4000]
-  CategoryPCGames   = categoriesMap[4050]
-  CategoryTV        = categoriesMap[5000]
-  CategoryTVSD      = categoriesMap[5030]
-  CategoryTVHD      = categoriesMap[5040]
-  CategoryTVUHD     = categoriesMap[5045]
-  CategoryXXX       = categoriesMap[6000]
-  CategoryXXXOther  = categoriesMap[6070]
-  CategoryBooks     = categoriesMap[7000]
-  CategoryOther     = categoriesMap[8000]
+	CategoryMovies          = categoriesMap[2000]
+	CategoryMoviesSD        = categoriesMap[2030]
+	CategoryMoviesHD        = categoriesMap[2040]
+	CategoryMoviesUHD       = categoriesMap[2045]
+	CategoryMovies3D        = categoriesMap[2060]
+	CategoryAudio           = categoriesMap[3000]
+	CategoryAudioMP3        = categoriesMap[3010]
+	CategoryAudioAudiobook  = categoriesMap[3030]
+	CategoryAudioLossless   = categoriesMap[3040]
+	CategoryPC              = categoriesMap[4000]
+	CategoryPCISO           = categoriesMap[4020]
+	CategoryPCMac           = categoriesMap[4030]
+	CategoryPCGames         = categoriesMap[4050]
+	CategoryPCMobileiOS     = categoriesMap[4060]
+	CategoryPCMobileAndroid = categoriesMap[4070]
+	CategoryTV              = categoriesMap[5000]
+	CategoryTVSD            = categoriesMap[5030]
+	CategoryTVHD            = categoriesMap[5040]
+	CategoryTVUHD           = categoriesMap[5045]
+	CategoryTVSport         = categoriesMap[5060]
+	CategoryXXX             = categoriesMap[6000]
+	CategoryXXXDVD          = categoriesMap[6010]
+	CategoryXXXXviD         = categoriesMap[6030]
+	CategoryXXXx264         = categoriesMap[6040]
+	CategoryXXXImgSet       = categoriesMap[6060]
+	CategoryXXXOther        = categoriesMap[6070]
+	CategoryBooks           = categoriesMap[7000]
+	CategoryBooksEBook      = categoriesMap[7020]
+	CategoryBooksComics     = categoriesMap[7030]
+	CategoryOther           = categoriesMap[8000]
 )
 
 var TopLevelCategories = []Category{
-  CategoryMovies,
-  CategoryAudio,
-  CategoryPC,
-  CategoryTV,
-  CategoryXXX,
-  CategoryBooks,
-  CategoryOther,
+	CategoryMovies,
+	CategoryAudio,
+	CategoryPC,
+	CategoryTV,
+	CategoryXXX,
+	CategoryBooks,
+	CategoryOther,
 }
@@ -0,0 +1,38 @@
+package torznab
+
+import "time"
+
+type Config struct {
+	// APIKeys maps names to API keys accepted on the torznab endpoint, in addition to any keys created via GraphQL.
+	// Requests must include a valid apikey parameter when any keys exist.
+	APIKeys map[string]APIKeyConfig
+	// LogRequests logs each torznab request with the name of the API key used.
+	LogRequests bool
+	// BestReleaseOnly returns only the best release of each content item by default; requests can override it with the best parameter.
+	BestReleaseOnly bool `mapstructure:"best_release_only"`
+	// ExcludeProbablyFake excludes torrents flagged by the classifier as probably fake by default; requests can override it
+	// with the fake parameter.
+	ExcludeProbablyFake bool `mapstructure:"exclude_probably_fake"`
+	// ReportThreshold excludes torrents reported as fake or mislabeled by at least this many users; no torrents are
+	// excluded if zero.
+	ReportThreshold uint `mapstructure:"report_threshold"`
+	// CacheTTL is how long search results are cached for, unless invalidated sooner by a torrent being classified or
+	// deleted; results aren't cached if zero. While torrents are classified continuously, results are invalidated at most
+	// once per TTL.
+	CacheTTL time.Duration `mapstructure:"cache_ttl"`
+	// CacheMaxEntries is the maximum number of cached search results.
+	CacheMaxEntries uint `mapstructure:"cache_max_entries"`
+}
+
+type APIKeyConfig struct {
+	Key string `mapstructure:"key"`
+	// RateLimit is the maximum number of requests per minute allowed with the key; requests are not limited if zero.
+	RateLimit uint `mapstructure:"rate_limit"`
+}
+
+func NewDefaultConfig() Config {
+	return Config{
+		CacheTTL:        time.Minute * 2,
+		CacheMaxEntries: 1000,
+	}
+}
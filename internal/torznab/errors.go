@@ -24,6 +24,8 @@ type Error struct {
 	// 203 Function not available. (Optional function is not implemented).
 	// 300 No such item.
 	// 300 Item already exists.
+	// 500 Request limit reached
+	// 501 Download limit reached
 	// 900 Unknown error
 	// 910 API Disabled
 	Code        int    `xml:"error,attr"`
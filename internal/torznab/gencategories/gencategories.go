@@ -4,6 +4,7 @@ import (
 	_ "embed"
 	"github.com/bitmagnet-io/bitmagnet/internal/maps"
 	"github.com/bitmagnet-io/bitmagnet/internal/torznab"
+	"go/format"
 	"os"
 	"path"
 	"runtime"
@@ -41,7 +42,7 @@ func main() {
 		}
 		out += "    },\n"
 		out += "  },\n"
-		varName := "Category" + strings.Replace(category.Name, "/", "", -1)
+		varName := "Category" + strings.NewReplacer("/", "", "-", "").Replace(category.Name)
 		varNames = append(varNames, struct {
 			name string
 			id   int
@@ -68,11 +69,13 @@ func main() {
 		out += "  " + topLevelName + ",\n"
 	}
 	out += "}\n"
+	formatted, formatErr := format.Source([]byte(out))
+	checkErr(formatErr)
 	_, filename, _, _ := runtime.Caller(0)
 	outFile := path.Dir(path.Dir(filename)) + "/categories.gen.go"
 	f, fErr := os.Create(outFile)
 	checkErr(fErr)
-	_, wErr := f.WriteString(out)
+	_, wErr := f.Write(formatted)
 	checkErr(wErr)
 }
 
@@ -3,18 +3,25 @@ package httpserver
 import (
 	"errors"
 	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/auth"
 	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/httpserver"
 	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
 	"github.com/bitmagnet-io/bitmagnet/internal/model"
 	"github.com/bitmagnet-io/bitmagnet/internal/torznab"
+	"github.com/bitmagnet-io/bitmagnet/internal/torznab/apikey"
 	"github.com/gin-gonic/gin"
 	"go.uber.org/fx"
+	"go.uber.org/zap"
 	"strconv"
+	"time"
 )
 
 type Params struct {
 	fx.In
-	Client lazy.Lazy[torznab.Client]
+	Config  torznab.Config
+	Client  lazy.Lazy[torznab.Client]
+	APIKeys lazy.Lazy[apikey.Manager]
+	Logger  *zap.SugaredLogger
 }
 
 type Result struct {
@@ -25,13 +32,19 @@ type Result struct {
 func New(p Params) Result {
 	return Result{
 		Option: builder{
-			client: p.Client,
+			config:  p.Config,
+			client:  p.Client,
+			apiKeys: p.APIKeys,
+			logger:  p.Logger.Named("torznab"),
 		},
 	}
 }
 
 type builder struct {
-	client lazy.Lazy[torznab.Client]
+	config  torznab.Config
+	client  lazy.Lazy[torznab.Client]
+	apiKeys lazy.Lazy[apikey.Manager]
+	logger  *zap.SugaredLogger
 }
 
 func (builder) Key() string {
@@ -43,21 +56,44 @@ func (b builder) Apply(e *gin.Engine) error {
 	if err != nil {
 		return err
 	}
+	apiKeys, err := b.apiKeys.Get()
+	if err != nil {
+		return err
+	}
 	e.GET("/torznab/*any", func(c *gin.Context) {
+		keyName := ""
+		if b.config.LogRequests {
+			start := time.Now()
+			defer func() {
+				b.logger.Infow(
+					"request",
+					"apikey", keyName,
+					"client_ip", c.ClientIP(),
+					"type", c.Query(torznab.ParamType),
+					"query", c.Query(torznab.ParamQuery),
+					"cat", c.QueryArray(torznab.ParamCat),
+					"status", c.Writer.Status(),
+					"duration", time.Since(start),
+				)
+			}()
+		}
 		writeInternalError := func(err error) {
 			_ = c.AbortWithError(500, err)
 			_, _ = c.Writer.WriteString(err.Error() + "\n")
 		}
-		writeXml := func(obj torznab.Xmler) {
+		writeXmlStatus := func(status int, obj torznab.Xmler) {
 			body, err := obj.Xml()
 			if err != nil {
 				writeInternalError(fmt.Errorf("failed to encode xml: %w", err))
 				return
 			}
-			c.Status(200)
+			c.Status(status)
 			c.Header("Content-Type", "application/xml; charset=utf-8")
 			_, _ = c.Writer.Write(body)
 		}
+		writeXml := func(obj torznab.Xmler) {
+			writeXmlStatus(200, obj)
+		}
 		writeErr := func(err error) {
 			torznabErr := &torznab.Error{}
 			if ok := errors.As(err, torznabErr); ok {
@@ -83,6 +119,33 @@ func (b builder) Apply(e *gin.Engine) error {
 			writeXml(caps)
 			return
 		}
+		var key apikey.Key
+		var authErr error
+		if user, ok := auth.UserFromContext(c.Request.Context()); ok {
+			// the request was authenticated as a user rather than with an API key
+			keyName = user.Name
+		} else {
+			key, authErr = apiKeys.Authenticate(c, c.Query(torznab.ParamApiKey))
+			keyName = key.Name
+		}
+		switch {
+		case errors.Is(authErr, apikey.ErrMissingKey), errors.Is(authErr, apikey.ErrInvalidKey):
+			writeXmlStatus(401, torznab.Error{
+				Code:        100,
+				Description: "incorrect user credentials",
+			})
+			return
+		case errors.Is(authErr, apikey.ErrRateLimited):
+			c.Header("Retry-After", "60")
+			writeXmlStatus(429, torznab.Error{
+				Code:        500,
+				Description: "request limit reached",
+			})
+			return
+		case authErr != nil:
+			writeErr(fmt.Errorf("failed to authenticate: %w", authErr))
+			return
+		}
 		var cats []int
 		for _, cat := range c.QueryArray(torznab.ParamCat) {
 			if intCat, err := strconv.Atoi(cat); err == nil {
@@ -94,6 +157,31 @@ func (b builder) Apply(e *gin.Engine) error {
 			imdbId.Valid = true
 			imdbId.String = qImdbId
 		}
+		tmdbId := model.NullString{}
+		if qTmdbId := c.Query(torznab.ParamTmdbId); qTmdbId != "" {
+			tmdbId.Valid = true
+			tmdbId.String = qTmdbId
+		}
+		tvdbId := model.NullString{}
+		if qTvdbId := c.Query(torznab.ParamTvdbId); qTvdbId != "" {
+			tvdbId.Valid = true
+			tvdbId.String = qTvdbId
+		}
+		season := model.NullInt{}
+		episode := model.NullInt{}
+		airDate := model.Date{}
+		if d, dateErr := time.Parse("2006 01/02", c.Query(torznab.ParamSeason)+" "+c.Query(torznab.ParamEpisode)); dateErr == nil {
+			airDate = model.NewDateFromTime(d)
+		} else {
+			if intSeason, seasonErr := strconv.Atoi(c.Query(torznab.ParamSeason)); seasonErr == nil && intSeason >= 0 {
+				season.Valid = true
+				season.Int = intSeason
+			}
+			if intEpisode, episodeErr := strconv.Atoi(c.Query(torznab.ParamEpisode)); episodeErr == nil && intEpisode >= 0 {
+				episode.Valid = true
+				episode.Int = intEpisode
+			}
+		}
 		limit := model.NullUint{}
 		if intLimit, limitErr := strconv.Atoi(c.Query(torznab.ParamLimit)); limitErr == nil && intLimit > 0 {
 			limit.Valid = true
@@ -104,14 +192,35 @@ func (b builder) Apply(e *gin.Engine) error {
 			offset.Valid = true
 			offset.Uint = uint(intOffset)
 		}
+		cursor := model.NullString{}
+		if qCursor := c.Query(torznab.ParamCursor); qCursor != "" {
+			cursor.Valid = true
+			cursor.String = qCursor
+		}
+		bestRelease := b.config.BestReleaseOnly
+		if qBest, bestErr := strconv.ParseBool(c.Query(torznab.ParamBest)); bestErr == nil {
+			bestRelease = qBest
+		}
+		excludeProbablyFake := b.config.ExcludeProbablyFake
+		if qFake, fakeErr := strconv.ParseBool(c.Query(torznab.ParamFake)); fakeErr == nil {
+			excludeProbablyFake = !qFake
+		}
 		result, searchErr := client.Search(c, torznab.SearchRequest{
-			Query:  c.Query(torznab.ParamQuery),
-			Type:   tp,
-			Cats:   cats,
-			ImdbId: imdbId,
-			Limit:  limit,
-			Offset: offset,
-			// todo season, episode
+			Query:               c.Query(torznab.ParamQuery),
+			Type:                tp,
+			Cats:                cats,
+			ImdbId:              imdbId,
+			TmdbId:              tmdbId,
+			TvdbId:              tvdbId,
+			Season:              season,
+			Episode:             episode,
+			AirDate:             airDate,
+			Limit:               limit,
+			Offset:              offset,
+			Cursor:              cursor,
+			BestRelease:         bestRelease,
+			ExcludeProbablyFake: excludeProbablyFake,
+			ReportThreshold:     b.config.ReportThreshold,
 		})
 		if searchErr != nil {
 			writeErr(fmt.Errorf("failed to search: %w", searchErr))
@@ -1,12 +1,20 @@
 package torznab
 
 const (
+	ParamApiKey  = "apikey"
 	ParamType    = "t"
 	ParamQuery   = "q"
 	ParamCat     = "cat"
 	ParamImdbId  = "imdbid"
+	ParamTmdbId  = "tmdbid"
+	ParamTvdbId  = "tvdbid"
 	ParamSeason  = "season"
 	ParamEpisode = "ep"
 	ParamLimit   = "limit"
 	ParamOffset  = "offset"
+	ParamCursor  = "cursor"
+	// ParamBest is a non-standard parameter that returns only the best release of each content item when true.
+	ParamBest = "best"
+	// ParamFake is a non-standard parameter that includes torrents flagged as probably fake when true, and excludes them when false.
+	ParamFake = "fake"
 )
@@ -5,14 +5,26 @@ import (
 )
 
 type SearchRequest struct {
-	Query    string
-	Type     string
-	Cats     []int
-	ImdbId   model.NullString
-	Season   model.NullInt
-	Episode  model.NullInt
+	Query   string
+	Type    string
+	Cats    []int
+	ImdbId  model.NullString
+	TmdbId  model.NullString
+	TvdbId  model.NullString
+	Season  model.NullInt
+	Episode model.NullInt
+	// AirDate is the air date of a daily show, which is requested with the year as the season and the month and day
+	// as the episode, e.g. season=2024&ep=05/17
+	AirDate  model.Date
 	Attrs    []string
 	Extended bool
 	Limit    model.NullUint
 	Offset   model.NullUint
+	Cursor   model.NullString
+	// BestRelease returns only the best release of each content item.
+	BestRelease bool
+	// ExcludeProbablyFake excludes torrents flagged as probably fake, as their size is implausible for their content.
+	ExcludeProbablyFake bool
+	// ReportThreshold excludes torrents reported as fake or mislabeled by at least this many users, unless zero.
+	ReportThreshold uint
 }
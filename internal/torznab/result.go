@@ -102,6 +102,8 @@ type SearchResultChannel struct {
 type SearchResultResponse struct {
 	Offset uint `xml:"offset,attr,omitempty"`
 	Total  uint `xml:"total,attr,omitempty"`
+	// Cursor is a non-standard attribute that can be passed as the cursor parameter to fetch the next page.
+	Cursor string `xml:"cursor,attr,omitempty"`
 }
 
 type SearchResultItem struct {
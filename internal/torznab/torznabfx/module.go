@@ -1,7 +1,10 @@
 package torznabfx
 
 import (
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/config/configfx"
+	"github.com/bitmagnet-io/bitmagnet/internal/torznab"
 	"github.com/bitmagnet-io/bitmagnet/internal/torznab/adapter"
+	"github.com/bitmagnet-io/bitmagnet/internal/torznab/apikey"
 	"github.com/bitmagnet-io/bitmagnet/internal/torznab/httpserver"
 	"go.uber.org/fx"
 )
@@ -9,8 +12,10 @@ import (
 func New() fx.Option {
 	return fx.Module(
 		"torznab",
+		configfx.NewConfigModule[torznab.Config]("torznab", torznab.NewDefaultConfig()),
 		fx.Provide(
 			adapter.New,
+			apikey.New,
 			httpserver.New,
 		),
 	)
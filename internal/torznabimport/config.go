@@ -0,0 +1,32 @@
+package torznabimport
+
+import "time"
+
+type Config struct {
+	// Indexers maps names to the Torznab feeds of Jackett or Prowlarr indexers whose results are imported; the name is
+	// used as the source of the imported torrents.
+	Indexers map[string]IndexerConfig
+	// Interval is the time between polls of the indexers; it's at least a minute, so that the indexers aren't flooded.
+	Interval time.Duration `validate:"gte=1m"`
+}
+
+type IndexerConfig struct {
+	// URL is the Torznab API endpoint of the indexer, such as http://jackett:9117/api/v2.0/indexers/all/results/torznab/api
+	// for all Jackett indexers, or http://prowlarr:9696/1/api for a Prowlarr indexer.
+	URL    string `mapstructure:"url"`
+	APIKey string `mapstructure:"api_key"`
+	// Categories restricts the results to these Torznab categories.
+	Categories []int
+	// Query is searched for; the most recent results are imported if empty.
+	Query string
+	// Limit is the maximum number of results requested on each poll.
+	Limit uint
+	// Private marks the imported torrents as private, for private trackers.
+	Private bool
+}
+
+func NewDefaultConfig() Config {
+	return Config{
+		Interval: time.Minute * 30,
+	}
+}
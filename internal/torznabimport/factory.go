@@ -0,0 +1,66 @@
+package torznabimport
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/worker"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/importer"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"net/http"
+	"time"
+)
+
+type Params struct {
+	fx.In
+	Config   Config
+	Dao      lazy.Lazy[*dao.Query]
+	Importer lazy.Lazy[importer.Importer]
+	Logger   *zap.SugaredLogger
+}
+
+type Result struct {
+	fx.Out
+	Worker worker.Worker `group:"workers"`
+}
+
+func New(p Params) Result {
+	var pl *poller
+	return Result{
+		Worker: worker.NewWorker(
+			"torznab_import",
+			fx.Hook{
+				OnStart: func(context.Context) error {
+					if len(p.Config.Indexers) == 0 {
+						return nil
+					}
+					d, err := p.Dao.Get()
+					if err != nil {
+						return err
+					}
+					i, err := p.Importer.Get()
+					if err != nil {
+						return err
+					}
+					pl = &poller{
+						config:     p.Config,
+						httpClient: &http.Client{Timeout: time.Minute},
+						dao:        d,
+						importer:   i,
+						logger:     p.Logger.Named("torznab_import"),
+						stopped:    make(chan struct{}),
+					}
+					go pl.start()
+					return nil
+				},
+				OnStop: func(context.Context) error {
+					if pl != nil {
+						close(pl.stopped)
+					}
+					return nil
+				},
+			},
+		),
+	}
+}
@@ -0,0 +1,204 @@
+package torznabimport
+
+import (
+	"encoding/base32"
+	"encoding/hex"
+	"encoding/xml"
+	"github.com/bitmagnet-io/bitmagnet/internal/importer"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const torznabNamespace = "http://torznab.com/schemas/2015/feed"
+
+type feed struct {
+	Channel struct {
+		Items []feedItem `xml:"item"`
+	} `xml:"channel"`
+}
+
+type feedItem struct {
+	Title      string   `xml:"title"`
+	Link       string   `xml:"link"`
+	PubDate    string   `xml:"pubDate"`
+	Size       uint64   `xml:"size"`
+	Categories []string `xml:"category"`
+	Enclosure  struct {
+		URL    string `xml:"url,attr"`
+		Length uint64 `xml:"length,attr"`
+	} `xml:"enclosure"`
+	Attrs []struct {
+		Name  string `xml:"name,attr"`
+		Value string `xml:"value,attr"`
+	} `xml:"http://torznab.com/schemas/2015/feed attr"`
+}
+
+func parseFeed(data []byte) ([]feedItem, error) {
+	var f feed
+	if err := xml.Unmarshal(data, &f); err != nil {
+		return nil, err
+	}
+	return f.Channel.Items, nil
+}
+
+// attrs returns the values of the torznab attributes of the item by name, which may be repeated.
+func (i feedItem) attrs() map[string][]string {
+	attrs := make(map[string][]string, len(i.Attrs))
+	for _, a := range i.Attrs {
+		name := strings.ToLower(a.Name)
+		attrs[name] = append(attrs[name], a.Value)
+	}
+	return attrs
+}
+
+// importItem maps a result to an import item, with hints of its content type and identifiers; false is returned if
+// the result has no info hash, such as results of private trackers that only link to a torrent file.
+func (i feedItem) importItem(source string, private bool) (importer.Item, bool) {
+	attrs := i.attrs()
+	infoHash, ok := resultInfoHash(attrs, i.Link, i.Enclosure.URL)
+	if !ok || i.Title == "" {
+		return importer.Item{}, false
+	}
+	item := importer.Item{
+		Source:   source,
+		InfoHash: infoHash,
+		Name:     i.Title,
+		Size:     i.Size,
+		Private:  private,
+	}
+	if item.Size == 0 {
+		if size, err := strconv.ParseUint(first(attrs["size"]), 10, 64); err == nil {
+			item.Size = size
+		} else {
+			item.Size = i.Enclosure.Length
+		}
+	}
+	if t, err := time.Parse(time.RFC1123Z, i.PubDate); err == nil {
+		item.PublishedAt = t
+	} else if t, err := time.Parse(time.RFC1123, i.PubDate); err == nil {
+		item.PublishedAt = t
+	} else {
+		item.PublishedAt = time.Now()
+	}
+	for _, cat := range append(attrs["category"], i.Categories...) {
+		if id, err := strconv.Atoi(cat); err == nil {
+			if contentType, ok := categoryContentType(id); ok {
+				item.ContentType = model.NewNullContentType(contentType)
+				break
+			}
+		}
+	}
+	if item.ContentType.Valid {
+		item.ContentSource, item.ContentID = contentRef(item.ContentType.ContentType, attrs)
+	}
+	return item, true
+}
+
+// resultInfoHash returns the info hash of the infohash attribute, or of the magnet link of the result.
+func resultInfoHash(attrs map[string][]string, links ...string) (protocol.ID, bool) {
+	if id, err := protocol.ParseID(first(attrs["infohash"])); err == nil {
+		return id, true
+	}
+	for _, link := range append(attrs["magneturl"], links...) {
+		if id, ok := magnetInfoHash(link); ok {
+			return id, true
+		}
+	}
+	return protocol.ID{}, false
+}
+
+func magnetInfoHash(link string) (protocol.ID, bool) {
+	u, err := url.Parse(link)
+	if err != nil || u.Scheme != "magnet" {
+		return protocol.ID{}, false
+	}
+	for _, xt := range u.Query()["xt"] {
+		hash, ok := strings.CutPrefix(strings.ToLower(xt), "urn:btih:")
+		if !ok {
+			continue
+		}
+		var b []byte
+		switch len(hash) {
+		case 40:
+			b, err = hex.DecodeString(hash)
+		case 32:
+			b, err = base32.StdEncoding.DecodeString(strings.ToUpper(hash))
+		default:
+			continue
+		}
+		if err == nil {
+			if id, idErr := protocol.NewIDFromByteSlice(b); idErr == nil {
+				return id, true
+			}
+		}
+	}
+	return protocol.ID{}, false
+}
+
+// categoryContentType maps a Torznab category to the content type of its top level category; categories such as
+// Console and Other aren't mapped, as they don't correspond to a single content type.
+func categoryContentType(category int) (model.ContentType, bool) {
+	switch {
+	case category == 5060:
+		return model.ContentTypeSport, true
+	case category == 3030:
+		// audiobooks
+		return model.ContentTypeBook, true
+	case category == 4050:
+		return model.ContentTypeGame, true
+	}
+	switch category / 1000 * 1000 {
+	case 2000:
+		return model.ContentTypeMovie, true
+	case 3000:
+		return model.ContentTypeMusic, true
+	case 4000:
+		return model.ContentTypeSoftware, true
+	case 5000:
+		return model.ContentTypeTvShow, true
+	case 6000:
+		return model.ContentTypeXxx, true
+	case 7000:
+		return model.ContentTypeBook, true
+	}
+	return "", false
+}
+
+// contentRef returns the most specific identifier of the content of a movie or TV show that the classifier can look up.
+func contentRef(contentType model.ContentType, attrs map[string][]string) (model.NullString, model.NullString) {
+	if contentType != model.ContentTypeMovie && contentType != model.ContentTypeTvShow {
+		return model.NullString{}, model.NullString{}
+	}
+	if id := first(attrs["tmdbid"]); id != "" && id != "0" {
+		return model.NewNullString("tmdb"), model.NewNullString(id)
+	}
+	for _, name := range []string{"imdbid", "imdb"} {
+		if id := strings.TrimPrefix(first(attrs[name]), "tt"); id != "" && strings.Trim(id, "0") != "" {
+			if n, err := strconv.Atoi(id); err == nil {
+				return model.NewNullString("imdb"), model.NewNullString("tt" + leftPad(strconv.Itoa(n), 7))
+			}
+		}
+	}
+	if id := first(attrs["tvdbid"]); contentType == model.ContentTypeTvShow && id != "" && id != "0" {
+		return model.NewNullString("tvdb"), model.NewNullString(id)
+	}
+	return model.NullString{}, model.NullString{}
+}
+
+func leftPad(s string, n int) string {
+	if len(s) >= n {
+		return s
+	}
+	return strings.Repeat("0", n-len(s)) + s
+}
+
+func first(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
@@ -0,0 +1,86 @@
+package torznabimport
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+const testFeed = `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0" xmlns:torznab="http://torznab.com/schemas/2015/feed">
+  <channel>
+    <item>
+      <title>The.Matrix.1999.1080p.BluRay.x264-GROUP</title>
+      <link>https://jackett/dl/1</link>
+      <pubDate>Sat, 01 Jun 2024 12:00:00 +0000</pubDate>
+      <size>12345</size>
+      <category>2040</category>
+      <torznab:attr name="category" value="2000" />
+      <torznab:attr name="infohash" value="AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA" />
+      <torznab:attr name="imdbid" value="133093" />
+    </item>
+    <item>
+      <title>Some.Show.S01E01.720p</title>
+      <link>magnet:?xt=urn:btih:VVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVV&amp;dn=x</link>
+      <enclosure url="https://jackett/dl/2" length="678" type="application/x-bittorrent" />
+      <category>5040</category>
+      <torznab:attr name="tvdbid" value="81189" />
+    </item>
+    <item>
+      <title>Torrent.File.Only</title>
+      <link>https://jackett/dl/3</link>
+    </item>
+  </channel>
+</rss>`
+
+func TestFeedImportItems(t *testing.T) {
+	t.Parallel()
+
+	items, err := parseFeed([]byte(testFeed))
+	require.NoError(t, err)
+	require.Len(t, items, 3)
+
+	movie, ok := items[0].importItem("jackett", true)
+	require.True(t, ok)
+	assert.Equal(t, protocol.MustParseID("aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"), movie.InfoHash)
+	assert.Equal(t, "jackett", movie.Source)
+	assert.True(t, movie.Private)
+	assert.Equal(t, uint64(12345), movie.Size)
+	assert.Equal(t, 2024, movie.PublishedAt.Year())
+	assert.Equal(t, model.NewNullContentType(model.ContentTypeMovie), movie.ContentType)
+	assert.Equal(t, model.NewNullString("imdb"), movie.ContentSource)
+	assert.Equal(t, model.NewNullString("tt0133093"), movie.ContentID)
+
+	show, ok := items[1].importItem("jackett", false)
+	require.True(t, ok)
+	assert.Equal(t, protocol.MustParseID("ad6b5ad6b5ad6b5ad6b5ad6b5ad6b5ad6b5ad6b5"), show.InfoHash)
+	assert.Equal(t, uint64(678), show.Size)
+	assert.Equal(t, model.NewNullContentType(model.ContentTypeTvShow), show.ContentType)
+	assert.Equal(t, model.NewNullString("tvdb"), show.ContentSource)
+	assert.Equal(t, model.NewNullString("81189"), show.ContentID)
+
+	_, ok = items[2].importItem("jackett", false)
+	assert.False(t, ok)
+}
+
+func TestCategoryContentType(t *testing.T) {
+	t.Parallel()
+
+	for category, expected := range map[int]model.ContentType{
+		2000: model.ContentTypeMovie,
+		3030: model.ContentTypeBook,
+		3040: model.ContentTypeMusic,
+		4050: model.ContentTypeGame,
+		5060: model.ContentTypeSport,
+		6010: model.ContentTypeXxx,
+		7020: model.ContentTypeBook,
+	} {
+		contentType, ok := categoryContentType(category)
+		assert.True(t, ok)
+		assert.Equal(t, expected, contentType, category)
+	}
+	_, ok := categoryContentType(8000)
+	assert.False(t, ok)
+}
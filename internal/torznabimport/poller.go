@@ -0,0 +1,151 @@
+package torznabimport
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/importer"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"go.uber.org/zap"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// poller imports the results of the indexers when it starts, and then at the configured interval.
+type poller struct {
+	config     Config
+	httpClient *http.Client
+	dao        *dao.Query
+	importer   importer.Importer
+	logger     *zap.SugaredLogger
+	stopped    chan struct{}
+}
+
+func (p *poller) start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		names := make([]string, 0, len(p.config.Indexers))
+		for name := range p.config.Indexers {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for {
+			for _, name := range names {
+				if n, err := p.poll(ctx, name, p.config.Indexers[name]); err != nil {
+					if ctx.Err() != nil {
+						return
+					}
+					p.logger.Errorw("failed to import indexer results", "indexer", name, "error", err)
+				} else {
+					p.logger.Debugw("imported indexer results", "indexer", name, "count", n)
+				}
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(p.config.Interval):
+			}
+		}
+	}()
+	<-p.stopped
+}
+
+// poll imports the results of an indexer that aren't already known, returning the number of imported torrents.
+// Known torrents are skipped so that the files and metadata discovered for them aren't replaced by the result.
+func (p *poller) poll(ctx context.Context, name string, indexer IndexerConfig) (int, error) {
+	items, err := p.search(ctx, indexer)
+	if err != nil {
+		return 0, err
+	}
+	importItems := make(map[protocol.ID]importer.Item, len(items))
+	valuers := make([]driver.Valuer, 0, len(items))
+	for _, fi := range items {
+		if item, ok := fi.importItem(name, indexer.Private); ok {
+			if _, ok := importItems[item.InfoHash]; !ok {
+				importItems[item.InfoHash] = item
+				valuers = append(valuers, item.InfoHash)
+			}
+		}
+	}
+	if len(importItems) == 0 {
+		return 0, nil
+	}
+	var knownHashes []protocol.ID
+	if err := p.dao.Torrent.WithContext(ctx).Where(
+		p.dao.Torrent.InfoHash.In(valuers...),
+	).Pluck(p.dao.Torrent.InfoHash, &knownHashes); err != nil {
+		return 0, err
+	}
+	for _, infoHash := range knownHashes {
+		delete(importItems, infoHash)
+	}
+	if len(importItems) == 0 {
+		return 0, nil
+	}
+	i := p.importer.New(ctx, importer.Info{
+		ID: fmt.Sprintf("torznab_import:%s:%d", name, time.Now().Unix()),
+	})
+	for _, item := range importItems {
+		if err := i.Import(item); err != nil {
+			_ = i.Close()
+			return 0, err
+		}
+	}
+	i.Drain()
+	if err := i.Close(); err != nil {
+		return 0, err
+	}
+	return len(importItems), nil
+}
+
+func (p *poller) search(ctx context.Context, indexer IndexerConfig) ([]feedItem, error) {
+	u, err := url.Parse(indexer.URL)
+	if err != nil {
+		return nil, err
+	}
+	query := u.Query()
+	query.Set("t", "search")
+	if indexer.APIKey != "" {
+		query.Set("apikey", indexer.APIKey)
+	}
+	if indexer.Query != "" {
+		query.Set("q", indexer.Query)
+	}
+	if len(indexer.Categories) > 0 {
+		cats := make([]string, 0, len(indexer.Categories))
+		for _, cat := range indexer.Categories {
+			cats = append(cats, strconv.Itoa(cat))
+		}
+		query.Set("cat", strings.Join(cats, ","))
+	}
+	if indexer.Limit > 0 {
+		query.Set("limit", strconv.FormatUint(uint64(indexer.Limit), 10))
+	}
+	u.RawQuery = query.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", res.StatusCode)
+	}
+	body, err := io.ReadAll(io.LimitReader(res.Body, 32<<20))
+	if err != nil {
+		return nil, err
+	}
+	return parseFeed(body)
+}
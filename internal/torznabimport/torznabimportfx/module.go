@@ -0,0 +1,17 @@
+package torznabimportfx
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/config/configfx"
+	"github.com/bitmagnet-io/bitmagnet/internal/torznabimport"
+	"go.uber.org/fx"
+)
+
+func New() fx.Option {
+	return fx.Module(
+		"torznab_import",
+		configfx.NewConfigModule[torznabimport.Config]("torznab_import", torznabimport.NewDefaultConfig()),
+		fx.Provide(
+			torznabimport.New,
+		),
+	)
+}
@@ -0,0 +1,31 @@
+package trackerscraper
+
+import "time"
+
+type Config struct {
+	// Trackers is the list of tracker announce URLs to scrape; both UDP and HTTP(S) trackers are supported.
+	Trackers []string
+	// Interval is the time to wait between scrape cycles.
+	Interval time.Duration
+	// BatchSize is the maximum number of torrents scraped per cycle.
+	BatchSize uint
+	// RescrapeThreshold is the amount of time that must pass before a torrent is rescraped.
+	RescrapeThreshold time.Duration
+	// Timeout applies to each individual scrape request.
+	Timeout time.Duration
+}
+
+func NewDefaultConfig() Config {
+	return Config{
+		Trackers: []string{
+			"udp://tracker.opentrackr.org:1337/announce",
+			"udp://open.stealth.si:80/announce",
+			"udp://tracker.torrent.eu.org:451/announce",
+			"udp://exodus.desync.com:6969/announce",
+		},
+		Interval:          time.Minute,
+		BatchSize:         1000,
+		RescrapeThreshold: time.Hour * 24,
+		Timeout:           time.Second * 15,
+	}
+}
@@ -0,0 +1,65 @@
+package trackerscraper
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/worker"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol/tracker"
+	"github.com/bitmagnet-io/bitmagnet/internal/taskrun"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+type Params struct {
+	fx.In
+	Config          Config
+	Dao             lazy.Lazy[*dao.Query]
+	TaskRunRecorder lazy.Lazy[taskrun.Recorder]
+	Logger          *zap.SugaredLogger
+}
+
+type Result struct {
+	fx.Out
+	Worker worker.Worker `group:"workers"`
+}
+
+func New(params Params) Result {
+	var s scraper
+	return Result{
+		Worker: worker.NewWorker(
+			"tracker_scraper",
+			fx.Hook{
+				OnStart: func(context.Context) error {
+					d, err := params.Dao.Get()
+					if err != nil {
+						return err
+					}
+					tr, err := params.TaskRunRecorder.Get()
+					if err != nil {
+						return err
+					}
+					s = scraper{
+						trackers:          params.Config.Trackers,
+						interval:          params.Config.Interval,
+						batchSize:         params.Config.BatchSize,
+						rescrapeThreshold: params.Config.RescrapeThreshold,
+						trackerScraper:    tracker.NewScraper(params.Config.Timeout),
+						dao:               d,
+						taskRunRecorder:   tr,
+						stopped:           make(chan struct{}),
+						logger:            params.Logger.Named("tracker_scraper"),
+					}
+					go s.start()
+					return nil
+				},
+				OnStop: func(context.Context) error {
+					if s.stopped != nil {
+						close(s.stopped)
+					}
+					return nil
+				},
+			},
+		),
+	}
+}
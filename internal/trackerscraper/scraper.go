@@ -0,0 +1,135 @@
+package trackerscraper
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol/tracker"
+	"github.com/bitmagnet-io/bitmagnet/internal/taskrun"
+	"go.uber.org/zap"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+// SourceKey is the torrent source under which tracker scrape results are stored
+const SourceKey = "tracker"
+
+type scraper struct {
+	trackers          []string
+	interval          time.Duration
+	batchSize         uint
+	rescrapeThreshold time.Duration
+	trackerScraper    tracker.Scraper
+	dao               *dao.Query
+	taskRunRecorder   taskrun.Recorder
+	stopped           chan struct{}
+	logger            *zap.SugaredLogger
+}
+
+func (s scraper) start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		for {
+			n, err := s.runCycle(ctx)
+			if err != nil {
+				s.logger.Errorw("error scraping trackers", "error", err)
+			}
+			// when there's a backlog, start the next cycle immediately
+			if err == nil && n >= int(s.batchSize) {
+				continue
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(s.interval):
+			}
+		}
+	}()
+	<-s.stopped
+}
+
+// runCycle scrapes the next batch of torrents due for scraping, returning the number of torrents scraped
+func (s scraper) runCycle(ctx context.Context) (int, error) {
+	infoHashes, err := s.dueInfoHashes(ctx)
+	if err != nil || len(infoHashes) == 0 {
+		return 0, err
+	}
+	run := s.taskRunRecorder.Start(ctx, taskrun.KindTrackerScrape)
+	results := s.scrape(ctx, infoHashes)
+	sources := make([]*model.TorrentsTorrentSource, 0, len(infoHashes))
+	now := time.Now()
+	for _, h := range infoHashes {
+		src := &model.TorrentsTorrentSource{
+			Source:   SourceKey,
+			InfoHash: h,
+		}
+		// hashes unknown to all trackers are persisted with null counts, so that they aren't rescraped until due
+		if r, ok := results[h]; ok {
+			src.SetSeeders(model.NewNullUint(r.Seeders), now)
+			src.Leechers = model.NewNullUint(r.Leechers)
+		}
+		sources = append(sources, src)
+	}
+	if persistErr := s.dao.TorrentsTorrentSource.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "source"}, {Name: "info_hash"}},
+		DoUpdates: append(
+			clause.AssignmentColumns([]string{"seeders", "leechers", "updated_at"}),
+			s.dao.LastSeededAtAssignment(),
+		),
+	}).CreateInBatches(sources, 100); persistErr != nil {
+		run.Finish(persistErr)
+		return 0, persistErr
+	}
+	run.Add(len(sources))
+	run.Finish(nil)
+	s.logger.Debugw("scraped trackers", "count", len(sources), "found", len(results))
+	return len(sources), nil
+}
+
+func (s scraper) dueInfoHashes(ctx context.Context) ([]protocol.ID, error) {
+	var infoHashes []protocol.ID
+	if err := s.dao.Torrent.WithContext(ctx).UnderlyingDB().
+		Joins(
+			"LEFT JOIN torrents_torrent_sources ON torrents_torrent_sources.info_hash = torrents.info_hash AND torrents_torrent_sources.source = ?",
+			SourceKey,
+		).
+		Where("torrents.private = false").
+		Where(
+			"torrents_torrent_sources.info_hash IS NULL OR torrents_torrent_sources.updated_at < ?",
+			time.Now().Add(-s.rescrapeThreshold),
+		).
+		Order("torrents_torrent_sources.updated_at NULLS FIRST").
+		Limit(int(s.batchSize)).
+		Pluck("torrents.info_hash", &infoHashes).Error; err != nil {
+		return nil, err
+	}
+	return infoHashes, nil
+}
+
+// scrape queries all configured trackers, keeping the highest counts reported for each hash
+func (s scraper) scrape(ctx context.Context, infoHashes []protocol.ID) map[protocol.ID]tracker.ScrapeResult {
+	results := make(map[protocol.ID]tracker.ScrapeResult, len(infoHashes))
+	for _, trackerUrl := range s.trackers {
+		for i := 0; i < len(infoHashes); i += tracker.MaxUdpScrapeHashes {
+			if ctx.Err() != nil {
+				return results
+			}
+			chunk := infoHashes[i:min(i+tracker.MaxUdpScrapeHashes, len(infoHashes))]
+			trackerResults, err := s.trackerScraper.Scrape(ctx, trackerUrl, chunk...)
+			if err != nil {
+				s.logger.Debugw("error scraping tracker", "tracker", trackerUrl, "error", err)
+				// give up on this tracker for this cycle
+				break
+			}
+			for h, r := range trackerResults {
+				existing, ok := results[h]
+				if !ok || r.Seeders+r.Leechers > existing.Seeders+existing.Leechers {
+					results[h] = r
+				}
+			}
+		}
+	}
+	return results
+}
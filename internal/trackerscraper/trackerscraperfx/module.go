@@ -0,0 +1,17 @@
+package trackerscraperfx
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/config/configfx"
+	"github.com/bitmagnet-io/bitmagnet/internal/trackerscraper"
+	"go.uber.org/fx"
+)
+
+func New() fx.Option {
+	return fx.Module(
+		"tracker_scraper",
+		configfx.NewConfigModule[trackerscraper.Config]("tracker_scraper", trackerscraper.NewDefaultConfig()),
+		fx.Provide(
+			trackerscraper.New,
+		),
+	)
+}
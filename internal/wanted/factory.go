@@ -0,0 +1,46 @@
+package wanted
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/torrentexport"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"net/http"
+	"time"
+)
+
+type Params struct {
+	fx.In
+	Dao         lazy.Lazy[*dao.Query]
+	TrackerList lazy.Lazy[torrentexport.TrackerList]
+	Logger      *zap.SugaredLogger
+}
+
+type Result struct {
+	fx.Out
+	Manager lazy.Lazy[Manager]
+}
+
+func New(p Params) Result {
+	return Result{
+		Manager: lazy.New(func() (Manager, error) {
+			d, err := p.Dao.Get()
+			if err != nil {
+				return nil, err
+			}
+			tl, err := p.TrackerList.Get()
+			if err != nil {
+				return nil, err
+			}
+			return manager{
+				dao: d,
+				httpClient: &http.Client{
+					Timeout: time.Second * 30,
+				},
+				trackerList: tl,
+				logger:      p.Logger.Named("wanted"),
+			}, nil
+		}),
+	}
+}
@@ -0,0 +1,201 @@
+package wanted
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/torrentexport"
+	"go.uber.org/zap"
+	"net/http"
+	"time"
+)
+
+// Manager maintains the list of wanted content registered by external requesters such as Overseerr.
+// When a matching torrent is classified, the wanted item is marked as fulfilled and its callback URL is notified.
+type Manager interface {
+	// Want adds wanted items, replacing any unfulfilled items previously added for the same request.
+	Want(ctx context.Context, items ...model.WantedItem) error
+	// Unwant removes the wanted items of a request.
+	Unwant(ctx context.Context, requester string, requestID string) error
+	// Fulfill marks the wanted items matching any of the torrent contents as fulfilled, and notifies their callback URLs.
+	Fulfill(ctx context.Context, tcs []model.TorrentContent) error
+}
+
+type manager struct {
+	dao         *dao.Query
+	httpClient  *http.Client
+	trackerList torrentexport.TrackerList
+	logger      *zap.SugaredLogger
+}
+
+func (m manager) Want(ctx context.Context, items ...model.WantedItem) error {
+	if len(items) == 0 {
+		return nil
+	}
+	return m.dao.Transaction(func(tx *dao.Query) error {
+		for _, item := range items {
+			if !item.RequestID.Valid {
+				continue
+			}
+			if _, err := tx.WantedItem.WithContext(ctx).Where(
+				tx.WantedItem.Requester.Eq(item.Requester),
+				tx.WantedItem.RequestID.Eq(item.RequestID.String),
+				tx.WantedItem.FulfilledAt.IsNull(),
+			).Delete(); err != nil {
+				return err
+			}
+		}
+		ptrs := make([]*model.WantedItem, 0, len(items))
+		for i := range items {
+			ptrs = append(ptrs, &items[i])
+		}
+		return tx.WantedItem.WithContext(ctx).CreateInBatches(ptrs, 100)
+	})
+}
+
+func (m manager) Unwant(ctx context.Context, requester string, requestID string) error {
+	_, err := m.dao.WantedItem.WithContext(ctx).Where(
+		m.dao.WantedItem.Requester.Eq(requester),
+		m.dao.WantedItem.RequestID.Eq(requestID),
+	).Delete()
+	return err
+}
+
+func (m manager) Fulfill(ctx context.Context, tcs []model.TorrentContent) error {
+	var ids []string
+	for _, tc := range tcs {
+		if tc.ContentID.Valid {
+			ids = append(ids, tc.ContentID.String)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	// the ID query is a superset; matches are checked for each item
+	items, err := m.dao.WantedItem.WithContext(ctx).Where(
+		m.dao.WantedItem.ContentID.In(ids...),
+		m.dao.WantedItem.FulfilledAt.IsNull(),
+	).Find()
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	for _, item := range items {
+		for _, tc := range tcs {
+			if !itemMatches(*item, tc) {
+				continue
+			}
+			infoHash := tc.InfoHash
+			item.FulfilledInfoHash = &infoHash
+			item.FulfilledAt = &now
+			if _, err := m.dao.WantedItem.WithContext(ctx).Where(
+				m.dao.WantedItem.ID.Eq(item.ID),
+			).Updates(map[string]interface{}{
+				string(m.dao.WantedItem.FulfilledInfoHash.ColumnName()): infoHash,
+				string(m.dao.WantedItem.FulfilledAt.ColumnName()):       now,
+			}); err != nil {
+				return err
+			}
+			m.logger.Infow("fulfilled wanted item", "id", item.ID, "requester", item.Requester, "infoHash", infoHash.String())
+			if item.CallbackURL.Valid {
+				// the callback shouldn't hold up processing, and a failure shouldn't cause it to be retried
+				go m.notify(*item, tc)
+			}
+			break
+		}
+	}
+	return nil
+}
+
+// itemMatches returns true if the torrent content is of the wanted content, at least the wanted resolution,
+// and if a season is wanted, includes the whole season.
+func itemMatches(item model.WantedItem, tc model.TorrentContent) bool {
+	if !tc.ContentType.Valid || tc.ContentType.ContentType != item.ContentType ||
+		tc.ContentSource.String != item.ContentSource || tc.ContentID.String != item.ContentID {
+		return false
+	}
+	if item.MinVideoResolution.Valid &&
+		(!tc.VideoResolution.Valid || !tc.VideoResolution.VideoResolution.AtLeast(item.MinVideoResolution.VideoResolution)) {
+		return false
+	}
+	if item.Season.Valid {
+		episodes, ok := tc.Episodes[int(item.Season.Uint)]
+		if !ok || len(episodes) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Notification is the body of the request sent to the callback URL of a fulfilled wanted item.
+type Notification struct {
+	Event         string              `json:"event"`
+	Requester     string              `json:"requester"`
+	RequestID     string              `json:"requestId,omitempty"`
+	ContentType   string              `json:"contentType"`
+	ContentSource string              `json:"contentSource"`
+	ContentID     string              `json:"contentId"`
+	Season        *uint               `json:"season,omitempty"`
+	Title         string              `json:"title,omitempty"`
+	Torrent       NotificationTorrent `json:"torrent"`
+}
+
+type NotificationTorrent struct {
+	InfoHash        string `json:"infoHash"`
+	Name            string `json:"name"`
+	Size            uint64 `json:"size"`
+	VideoResolution string `json:"videoResolution,omitempty"`
+	MagnetUri       string `json:"magnetUri"`
+}
+
+func newNotification(item model.WantedItem, tc model.TorrentContent, trackers []string) Notification {
+	n := Notification{
+		Event:         "available",
+		Requester:     item.Requester,
+		RequestID:     item.RequestID.String,
+		ContentType:   item.ContentType.String(),
+		ContentSource: item.ContentSource,
+		ContentID:     item.ContentID,
+		Title:         item.Title.String,
+		Torrent: NotificationTorrent{
+			InfoHash:  tc.InfoHash.String(),
+			Name:      tc.Torrent.Name,
+			Size:      tc.Torrent.Size,
+			MagnetUri: tc.Torrent.MagnetUri(trackers...),
+		},
+	}
+	if item.Season.Valid {
+		season := item.Season.Uint
+		n.Season = &season
+	}
+	if tc.VideoResolution.Valid {
+		n.Torrent.VideoResolution = tc.VideoResolution.VideoResolution.Label()
+	}
+	return n
+}
+
+func (m manager) notify(item model.WantedItem, tc model.TorrentContent) {
+	body, err := json.Marshal(newNotification(item, tc, m.trackerList.Trackers()))
+	if err != nil {
+		m.logger.Errorw("failed to encode wanted item notification", "id", item.ID, "error", err)
+		return
+	}
+	if err := m.post(item.CallbackURL.String, body); err != nil {
+		m.logger.Errorw("failed to notify wanted item callback", "id", item.ID, "url", item.CallbackURL.String, "error", err)
+	}
+}
+
+func (m manager) post(url string, body []byte) error {
+	res, err := m.httpClient.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %d", res.StatusCode)
+	}
+	return nil
+}
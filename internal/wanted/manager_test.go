@@ -0,0 +1,40 @@
+package wanted
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestItemMatches(t *testing.T) {
+	t.Parallel()
+
+	tc := func(resolution model.VideoResolution, episodes model.Episodes) model.TorrentContent {
+		return model.TorrentContent{
+			ContentType:     model.NewNullContentType(model.ContentTypeTvShow),
+			ContentSource:   model.NewNullString("tmdb"),
+			ContentID:       model.NewNullString("1399"),
+			VideoResolution: model.NewNullVideoResolution(resolution),
+			Episodes:        episodes,
+		}
+	}
+	item := model.WantedItem{
+		ContentType:        model.ContentTypeTvShow,
+		ContentSource:      "tmdb",
+		ContentID:          "1399",
+		MinVideoResolution: model.NewNullVideoResolution(model.VideoResolutionV1080p),
+	}
+	seasonItem := item
+	seasonItem.Season = model.NewNullUint(2)
+
+	assert.True(t, itemMatches(item, tc(model.VideoResolutionV1080p, nil)))
+	assert.True(t, itemMatches(item, tc(model.VideoResolutionV2160p, nil)))
+	assert.False(t, itemMatches(item, tc(model.VideoResolutionV720p, nil)), "a lower resolution shouldn't match")
+	assert.True(t, itemMatches(seasonItem, tc(model.VideoResolutionV1080p, model.Episodes{}.AddSeason(2))))
+	assert.False(t, itemMatches(seasonItem, tc(model.VideoResolutionV1080p, model.Episodes{}.AddSeason(1))))
+	assert.False(t, itemMatches(seasonItem, tc(model.VideoResolutionV1080p, model.Episodes{}.AddEpisode(2, 1))),
+		"a single episode shouldn't fulfill a season")
+	other := tc(model.VideoResolutionV1080p, nil)
+	other.ContentID = model.NewNullString("1400")
+	assert.False(t, itemMatches(item, other))
+}
@@ -0,0 +1,16 @@
+package overseerr
+
+type Config struct {
+	// AuthorizationHeader must match the Authorization header configured in the Overseerr webhook agent;
+	// the webhook isn't served if it's empty.
+	AuthorizationHeader string
+	// MinVideoResolution is the lowest resolution of torrent that fulfills a request (e.g. V1080p); any resolution is accepted if empty.
+	// It can be overridden with a min_resolution key in the webhook payload template.
+	MinVideoResolution string
+	// CallbackUrl is sent a POST request when a torrent fulfilling a request is classified.
+	CallbackUrl string
+}
+
+func NewDefaultConfig() Config {
+	return Config{}
+}
@@ -0,0 +1,96 @@
+package overseerr
+
+import (
+	"crypto/subtle"
+	"errors"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/httpserver"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/bitmagnet-io/bitmagnet/internal/wanted"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"net/http"
+)
+
+type Params struct {
+	fx.In
+	Config  Config
+	Manager lazy.Lazy[wanted.Manager]
+	Logger  *zap.SugaredLogger
+}
+
+type Result struct {
+	fx.Out
+	Option httpserver.Option `group:"http_server_options"`
+}
+
+// New accepts notifications from the Overseerr (or Jellyseerr) webhook agent at /overseerr/webhook. The webhook is
+// only served if an authorization header is configured, as it's otherwise open to anyone.
+func New(p Params) Result {
+	return Result{
+		Option: &builder{
+			config:  p.Config,
+			manager: p.Manager,
+			logger:  p.Logger.Named("overseerr"),
+		},
+	}
+}
+
+type builder struct {
+	config  Config
+	manager lazy.Lazy[wanted.Manager]
+	logger  *zap.SugaredLogger
+}
+
+func (builder) Key() string {
+	return "overseerr"
+}
+
+func (b builder) Apply(e *gin.Engine) error {
+	if b.config.AuthorizationHeader == "" {
+		b.logger.Warnw("overseerr webhook disabled, as no authorization header is configured")
+		return nil
+	}
+	m, err := b.manager.Get()
+	if err != nil {
+		return err
+	}
+	e.POST("/overseerr/webhook", func(ctx *gin.Context) {
+		b.handle(ctx, m)
+	})
+	return nil
+}
+
+func (b builder) handle(ctx *gin.Context, m wanted.Manager) {
+	if subtle.ConstantTimeCompare([]byte(ctx.GetHeader("Authorization")), []byte(b.config.AuthorizationHeader)) != 1 {
+		ctx.Status(http.StatusUnauthorized)
+		return
+	}
+	var payload webhookPayload
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		ctx.String(http.StatusBadRequest, err.Error())
+		return
+	}
+	a, requestId, items, err := parseWebhook(payload, b.config)
+	if err != nil {
+		if errors.Is(err, errInvalidPayload) {
+			ctx.String(http.StatusBadRequest, err.Error())
+		} else {
+			ctx.Status(http.StatusInternalServerError)
+		}
+		return
+	}
+	switch a {
+	case actionWant:
+		err = m.Want(ctx, items...)
+	case actionUnwant:
+		err = m.Unwant(ctx, Requester, requestId)
+	}
+	if err != nil {
+		b.logger.Errorw("failed to handle webhook", "notificationType", payload.NotificationType, "error", err)
+		ctx.Status(http.StatusInternalServerError)
+		return
+	}
+	b.logger.Debugw("handled webhook", "notificationType", payload.NotificationType, "requestId", requestId)
+	ctx.Status(http.StatusNoContent)
+}
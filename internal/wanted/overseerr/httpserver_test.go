@@ -0,0 +1,68 @@
+package overseerr
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/wanted"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type stubManager struct {
+	wanted []model.WantedItem
+}
+
+func (m *stubManager) Want(_ context.Context, items ...model.WantedItem) error {
+	m.wanted = append(m.wanted, items...)
+	return nil
+}
+
+func (*stubManager) Unwant(context.Context, string, string) error {
+	return nil
+}
+
+func (*stubManager) Fulfill(context.Context, []model.TorrentContent) error {
+	return nil
+}
+
+func TestWebhookAuthorization(t *testing.T) {
+	t.Parallel()
+
+	const body = `{"notification_type": "MEDIA_APPROVED", "media": {"media_type": "movie", "tmdbId": "603"}}`
+
+	serve := func(config Config, authorization string) (int, *stubManager) {
+		m := &stubManager{}
+		e := gin.New()
+		require.NoError(t, New(Params{
+			Config:  config,
+			Manager: lazy.New(func() (wanted.Manager, error) { return m, nil }),
+			Logger:  zap.NewNop().Sugar(),
+		}).Option.Apply(e))
+		req := httptest.NewRequest(http.MethodPost, "/overseerr/webhook", strings.NewReader(body))
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		w := httptest.NewRecorder()
+		e.ServeHTTP(w, req)
+		return w.Code, m
+	}
+
+	code, m := serve(Config{}, "")
+	assert.Equal(t, http.StatusNotFound, code, "the webhook shouldn't be served without an authorization header")
+	assert.Empty(t, m.wanted)
+
+	code, m = serve(Config{AuthorizationHeader: "secret"}, "wrong")
+	assert.Equal(t, http.StatusUnauthorized, code)
+	assert.Empty(t, m.wanted)
+
+	code, m = serve(Config{AuthorizationHeader: "secret"}, "secret")
+	assert.Equal(t, http.StatusNoContent, code)
+	assert.Len(t, m.wanted, 1)
+}
@@ -0,0 +1,139 @@
+package overseerr
+
+import (
+	"errors"
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"strconv"
+	"strings"
+)
+
+// Requester identifies wanted items registered by Overseerr, or by Jellyseerr which sends the same payload.
+const Requester = "overseerr"
+
+// webhookPayload is the subset of the default Overseerr webhook payload that's used,
+// plus some optional keys that can be added to the payload template.
+type webhookPayload struct {
+	NotificationType string `json:"notification_type"`
+	Subject          string `json:"subject"`
+	Media            *struct {
+		MediaType string `json:"media_type"`
+		TmdbId    string `json:"tmdbId"`
+	} `json:"media"`
+	Request *struct {
+		RequestId string `json:"request_id"`
+	} `json:"request"`
+	Extra []struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	} `json:"extra"`
+	MinResolution string `json:"min_resolution"`
+}
+
+type action int
+
+const (
+	actionIgnore action = iota
+	actionWant
+	actionUnwant
+)
+
+var errInvalidPayload = errors.New("invalid payload")
+
+// parseWebhook determines what to do about a webhook notification. Approved requests are wanted,
+// and requests that are declined or made available by other means are no longer wanted.
+func parseWebhook(p webhookPayload, config Config) (action, string, []model.WantedItem, error) {
+	var a action
+	switch p.NotificationType {
+	case "MEDIA_APPROVED", "MEDIA_AUTO_APPROVED":
+		a = actionWant
+	case "MEDIA_DECLINED", "MEDIA_AVAILABLE":
+		a = actionUnwant
+	default:
+		return actionIgnore, "", nil, nil
+	}
+	if p.Media == nil || p.Media.TmdbId == "" {
+		return actionIgnore, "", nil, fmt.Errorf("%w: missing media", errInvalidPayload)
+	}
+	requestId := "tmdb:" + p.Media.TmdbId
+	if p.Request != nil && p.Request.RequestId != "" {
+		requestId = p.Request.RequestId
+	}
+	if a == actionUnwant {
+		return a, requestId, nil, nil
+	}
+	var ct model.ContentType
+	switch p.Media.MediaType {
+	case "movie":
+		ct = model.ContentTypeMovie
+	case "tv":
+		ct = model.ContentTypeTvShow
+	default:
+		return actionIgnore, "", nil, fmt.Errorf("%w: unsupported media type %q", errInvalidPayload, p.Media.MediaType)
+	}
+	minResolution := model.NullVideoResolution{}
+	if res := coalesce(p.MinResolution, config.MinVideoResolution); res != "" {
+		parsed, err := model.ParseVideoResolution(res)
+		if err != nil {
+			return actionIgnore, "", nil, fmt.Errorf("%w: %w", errInvalidPayload, err)
+		}
+		minResolution = model.NewNullVideoResolution(parsed)
+	}
+	item := model.WantedItem{
+		ContentType:        ct,
+		ContentSource:      "tmdb",
+		ContentID:          p.Media.TmdbId,
+		Title:              model.NewNullString(p.Subject),
+		MinVideoResolution: minResolution,
+		Requester:          Requester,
+		RequestID:          model.NewNullString(requestId),
+	}
+	// the callback isn't taken from the payload, so that a webhook caller can't have notifications posted anywhere
+	if config.CallbackUrl != "" {
+		item.CallbackURL = model.NewNullString(config.CallbackUrl)
+	}
+	if ct != model.ContentTypeTvShow {
+		return a, requestId, []model.WantedItem{item}, nil
+	}
+	seasons, err := requestedSeasons(p)
+	if err != nil {
+		return actionIgnore, "", nil, err
+	}
+	// a request for a whole show registers a single item fulfilled by a torrent of any season
+	if len(seasons) == 0 {
+		return a, requestId, []model.WantedItem{item}, nil
+	}
+	items := make([]model.WantedItem, 0, len(seasons))
+	for _, s := range seasons {
+		seasonItem := item
+		seasonItem.Season = model.NewNullUint(s)
+		items = append(items, seasonItem)
+	}
+	return a, requestId, items, nil
+}
+
+func requestedSeasons(p webhookPayload) ([]uint, error) {
+	var seasons []uint
+	for _, e := range p.Extra {
+		if e.Name != "Requested Seasons" {
+			continue
+		}
+		for _, s := range strings.Split(e.Value, ",") {
+			season, err := strconv.ParseUint(strings.TrimSpace(s), 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("%w: invalid season %q", errInvalidPayload, s)
+			}
+			seasons = append(seasons, uint(season))
+		}
+	}
+	return seasons, nil
+}
+
+func coalesce(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
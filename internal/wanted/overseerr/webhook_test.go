@@ -0,0 +1,67 @@
+package overseerr
+
+import (
+	"encoding/json"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestParseWebhook(t *testing.T) {
+	t.Parallel()
+
+	parse := func(body string, config Config) (action, string, []model.WantedItem, error) {
+		var p webhookPayload
+		require.NoError(t, json.Unmarshal([]byte(body), &p))
+		return parseWebhook(p, config)
+	}
+
+	a, requestId, items, err := parse(`{
+		"notification_type": "MEDIA_AUTO_APPROVED",
+		"subject": "The Matrix (1999)",
+		"media": {"media_type": "movie", "tmdbId": "603", "tvdbId": "", "status": "PENDING"},
+		"request": {"request_id": "12"},
+		"extra": []
+	}`, Config{MinVideoResolution: "V1080p", CallbackUrl: "http://localhost/callback"})
+	require.NoError(t, err)
+	assert.Equal(t, actionWant, a)
+	assert.Equal(t, "12", requestId)
+	assert.Equal(t, []model.WantedItem{{
+		ContentType:        model.ContentTypeMovie,
+		ContentSource:      "tmdb",
+		ContentID:          "603",
+		Title:              model.NewNullString("The Matrix (1999)"),
+		MinVideoResolution: model.NewNullVideoResolution(model.VideoResolutionV1080p),
+		Requester:          Requester,
+		RequestID:          model.NewNullString("12"),
+		CallbackURL:        model.NewNullString("http://localhost/callback"),
+	}}, items)
+
+	a, _, items, err = parse(`{
+		"notification_type": "MEDIA_APPROVED",
+		"media": {"media_type": "tv", "tmdbId": "1399"},
+		"request": {"request_id": "13"},
+		"extra": [{"name": "Requested Seasons", "value": "1, 2"}],
+		"min_resolution": "V2160p",
+		"callback_url": "http://attacker.example/callback"
+	}`, Config{MinVideoResolution: "V1080p"})
+	require.NoError(t, err)
+	assert.Equal(t, actionWant, a)
+	require.Len(t, items, 2)
+	assert.Equal(t, model.NewNullUint(2), items[1].Season)
+	assert.Equal(t, model.VideoResolutionV2160p, items[1].MinVideoResolution.VideoResolution, "the payload should override the config")
+	assert.False(t, items[1].CallbackURL.Valid, "the callback shouldn't be taken from the payload")
+
+	a, requestId, _, err = parse(`{"notification_type": "MEDIA_AVAILABLE", "media": {"media_type": "movie", "tmdbId": "603"}}`, Config{})
+	require.NoError(t, err)
+	assert.Equal(t, actionUnwant, a)
+	assert.Equal(t, "tmdb:603", requestId)
+
+	a, _, _, err = parse(`{"notification_type": "TEST_NOTIFICATION"}`, Config{})
+	require.NoError(t, err)
+	assert.Equal(t, actionIgnore, a)
+
+	_, _, _, err = parse(`{"notification_type": "MEDIA_APPROVED", "media": {"media_type": "music", "tmdbId": "1"}}`, Config{})
+	assert.ErrorIs(t, err, errInvalidPayload)
+}
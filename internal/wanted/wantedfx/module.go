@@ -0,0 +1,19 @@
+package wantedfx
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/config/configfx"
+	"github.com/bitmagnet-io/bitmagnet/internal/wanted"
+	"github.com/bitmagnet-io/bitmagnet/internal/wanted/overseerr"
+	"go.uber.org/fx"
+)
+
+func New() fx.Option {
+	return fx.Module(
+		"wanted",
+		configfx.NewConfigModule[overseerr.Config]("overseerr", overseerr.NewDefaultConfig()),
+		fx.Provide(
+			wanted.New,
+			overseerr.New,
+		),
+	)
+}
@@ -0,0 +1,41 @@
+package watchlist
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/webhook"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+type Params struct {
+	fx.In
+	Dao        lazy.Lazy[*dao.Query]
+	Dispatcher lazy.Lazy[webhook.Dispatcher]
+	Logger     *zap.SugaredLogger
+}
+
+type Result struct {
+	fx.Out
+	Manager lazy.Lazy[Manager]
+}
+
+func New(p Params) Result {
+	return Result{
+		Manager: lazy.New(func() (Manager, error) {
+			d, err := p.Dao.Get()
+			if err != nil {
+				return nil, err
+			}
+			disp, err := p.Dispatcher.Get()
+			if err != nil {
+				return nil, err
+			}
+			return manager{
+				dao:        d,
+				dispatcher: disp,
+				logger:     p.Logger.Named("watchlist"),
+			}, nil
+		}),
+	}
+}
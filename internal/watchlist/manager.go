@@ -0,0 +1,208 @@
+package watchlist
+
+import (
+	"context"
+	"errors"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/search"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/bitmagnet-io/bitmagnet/internal/protocol"
+	"github.com/bitmagnet-io/bitmagnet/internal/webhook"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Manager maintains the watching and ignored flags of content items.
+// Torrents of ignored content are excluded from saved searches, and when a better release of watched content
+// is classified, a better_release webhook event is dispatched.
+type Manager interface {
+	// Set updates the flags of a content item; a nil flag is left unchanged. Watching an item records its
+	// current best release, so that only better releases are alerted.
+	Set(ctx context.Context, ref model.ContentRef, watching, ignored *bool) (model.ContentFlag, error)
+	// List returns the flagged content items, optionally only those with the given flag values.
+	List(ctx context.Context, watching, ignored *bool) ([]model.ContentFlag, error)
+	// Alert dispatches an event for each watched content item with a better release among the torrent contents.
+	Alert(ctx context.Context, tcs []model.TorrentContent) error
+}
+
+type manager struct {
+	dao        *dao.Query
+	dispatcher webhook.Dispatcher
+	logger     *zap.SugaredLogger
+}
+
+func (m manager) Set(ctx context.Context, ref model.ContentRef, watching, ignored *bool) (model.ContentFlag, error) {
+	flag := model.ContentFlag{
+		ContentType:   ref.Type,
+		ContentSource: ref.Source,
+		ContentID:     ref.ID,
+	}
+	existing, err := m.find(ctx, ref)
+	if err != nil {
+		return model.ContentFlag{}, err
+	}
+	if existing != nil {
+		flag = *existing
+	}
+	if watching != nil {
+		if *watching && !flag.Watching {
+			best, err := m.bestRelease(ctx, ref)
+			if err != nil {
+				return model.ContentFlag{}, err
+			}
+			flag.BestInfoHash = best
+		}
+		flag.Watching = *watching
+	}
+	if ignored != nil {
+		flag.Ignored = *ignored
+	}
+	if !flag.Watching && !flag.Ignored {
+		if existing != nil {
+			if _, err := m.query(ctx, ref).Delete(); err != nil {
+				return model.ContentFlag{}, err
+			}
+		}
+		return flag, nil
+	}
+	if err := m.dao.ContentFlag.WithContext(ctx).Clauses(clause.OnConflict{
+		UpdateAll: true,
+	}).Create(&flag); err != nil {
+		return model.ContentFlag{}, err
+	}
+	return flag, nil
+}
+
+func (m manager) List(ctx context.Context, watching, ignored *bool) ([]model.ContentFlag, error) {
+	q := m.dao.ContentFlag.WithContext(ctx)
+	if watching != nil {
+		q = q.Where(m.dao.ContentFlag.Watching.Is(*watching))
+	}
+	if ignored != nil {
+		q = q.Where(m.dao.ContentFlag.Ignored.Is(*ignored))
+	}
+	flags, err := q.Order(m.dao.ContentFlag.UpdatedAt.Desc()).Find()
+	if err != nil {
+		return nil, err
+	}
+	result := make([]model.ContentFlag, 0, len(flags))
+	for _, f := range flags {
+		result = append(result, *f)
+	}
+	return result, nil
+}
+
+func (m manager) Alert(ctx context.Context, tcs []model.TorrentContent) error {
+	candidates := make(map[model.ContentRef]model.TorrentContent)
+	for _, tc := range tcs {
+		ref := tc.ContentRef()
+		if !ref.Valid {
+			continue
+		}
+		if c, ok := candidates[ref.Val]; !ok || search.CompareReleaseQuality(tc, c) < 0 {
+			candidates[ref.Val] = tc
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	ids := make([]string, 0, len(candidates))
+	for ref := range candidates {
+		ids = append(ids, ref.ID)
+	}
+	flags, err := m.dao.ContentFlag.WithContext(ctx).Where(
+		m.dao.ContentFlag.Watching.Is(true),
+		m.dao.ContentFlag.ContentID.In(ids...),
+	).Find()
+	if err != nil {
+		return err
+	}
+	var events []webhook.Event
+	for _, f := range flags {
+		ref := model.ContentRef{Type: f.ContentType, Source: f.ContentSource, ID: f.ContentID}
+		tc, ok := candidates[ref]
+		if !ok || (f.BestInfoHash != nil && *f.BestInfoHash == tc.InfoHash) {
+			continue
+		}
+		var previous *model.TorrentContent
+		if f.BestInfoHash != nil {
+			previous, err = m.release(ctx, ref, *f.BestInfoHash)
+			if err != nil {
+				return err
+			}
+			if previous != nil && search.CompareReleaseQuality(tc, *previous) >= 0 {
+				continue
+			}
+		}
+		if _, err := m.query(ctx, ref).Update(m.dao.ContentFlag.BestInfoHash, tc.InfoHash); err != nil {
+			return err
+		}
+		m.logger.Infow("better release of watched content", "ref", ref, "infoHash", tc.InfoHash)
+		events = append(events, webhook.NewBetterReleaseEvent(tc, previous))
+	}
+	if len(events) == 0 || !m.dispatcher.Subscribed(model.WebhookEventTypeBetterRelease) {
+		return nil
+	}
+	return m.dispatcher.Dispatch(ctx, events...)
+}
+
+func (m manager) query(ctx context.Context, ref model.ContentRef) dao.IContentFlagDo {
+	return m.dao.ContentFlag.WithContext(ctx).Where(
+		m.dao.ContentFlag.ContentType.Eq(ref.Type.String()),
+		m.dao.ContentFlag.ContentSource.Eq(ref.Source),
+		m.dao.ContentFlag.ContentID.Eq(ref.ID),
+	)
+}
+
+func (m manager) find(ctx context.Context, ref model.ContentRef) (*model.ContentFlag, error) {
+	flag, err := m.query(ctx, ref).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return flag, err
+}
+
+func (m manager) releases(ctx context.Context, ref model.ContentRef) dao.ITorrentContentDo {
+	return m.dao.TorrentContent.WithContext(ctx).Preload(
+		m.dao.TorrentContent.Torrent.RelationField,
+	).Preload(
+		m.dao.TorrentContent.Content.RelationField,
+	).Where(
+		m.dao.TorrentContent.ContentType.Eq(ref.Type.String()),
+		m.dao.TorrentContent.ContentSource.Eq(ref.Source),
+		m.dao.TorrentContent.ContentID.Eq(ref.ID),
+	)
+}
+
+// release returns the torrent content of a release of the content, or nil if it no longer exists.
+func (m manager) release(ctx context.Context, ref model.ContentRef, infoHash protocol.ID) (*model.TorrentContent, error) {
+	tc, err := m.releases(ctx, ref).Where(m.dao.TorrentContent.InfoHash.Eq(infoHash)).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return tc, err
+}
+
+// bestRelease returns the info hash of the best known release of the content, or nil if there are none.
+func (m manager) bestRelease(ctx context.Context, ref model.ContentRef) (*protocol.ID, error) {
+	tcs, err := m.dao.TorrentContent.WithContext(ctx).Select(
+		m.dao.TorrentContent.InfoHash,
+		m.dao.TorrentContent.VideoResolution,
+		m.dao.TorrentContent.VideoCodec,
+	).Where(
+		m.dao.TorrentContent.ContentType.Eq(ref.Type.String()),
+		m.dao.TorrentContent.ContentSource.Eq(ref.Source),
+		m.dao.TorrentContent.ContentID.Eq(ref.ID),
+	).Find()
+	if err != nil || len(tcs) == 0 {
+		return nil, err
+	}
+	best := tcs[0]
+	for _, tc := range tcs[1:] {
+		if search.CompareReleaseQuality(*tc, *best) < 0 {
+			best = tc
+		}
+	}
+	return &best.InfoHash, nil
+}
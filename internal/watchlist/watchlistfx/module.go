@@ -0,0 +1,15 @@
+package watchlistfx
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/watchlist"
+	"go.uber.org/fx"
+)
+
+func New() fx.Option {
+	return fx.Module(
+		"watchlist",
+		fx.Provide(
+			watchlist.New,
+		),
+	)
+}
@@ -0,0 +1,42 @@
+package webhook
+
+import "time"
+
+type Config struct {
+	// Endpoints maps endpoint names to the webhooks that events are delivered to.
+	Endpoints map[string]EndpointConfig
+	// MaxAttempts is the number of times a delivery is attempted before it's marked as failed.
+	MaxAttempts uint
+	// RetryBackoff is the time to wait before the first retry of a failed delivery, doubling with each further attempt.
+	RetryBackoff time.Duration
+	// Timeout applies to each delivery request.
+	Timeout time.Duration
+	// PollInterval is the time to wait between checks for pending deliveries.
+	PollInterval time.Duration
+	// HealthCheckInterval is the time to wait between health checks when any endpoint subscribes to health_degraded events.
+	HealthCheckInterval time.Duration
+	// Retention is how long delivery logs are kept before being pruned.
+	Retention time.Duration
+}
+
+type EndpointConfig struct {
+	// URL is a Go template executed with the event, for example https://example.com/hook?type={{.Type}}.
+	URL string `mapstructure:"url"`
+	// Secret signs the request body with HMAC-SHA256; the signature is sent in the X-Bitmagnet-Signature header.
+	Secret string `mapstructure:"secret"`
+	// Events is the list of event types delivered to the endpoint; all events are delivered if empty.
+	Events []string `mapstructure:"events"`
+	// Headers are added to each request, for example an Authorization header.
+	Headers map[string]string `mapstructure:"headers"`
+}
+
+func NewDefaultConfig() Config {
+	return Config{
+		MaxAttempts:         5,
+		RetryBackoff:        time.Second * 30,
+		Timeout:             time.Second * 15,
+		PollInterval:        time.Second * 5,
+		HealthCheckInterval: time.Minute,
+		Retention:           7 * 24 * time.Hour,
+	}
+}
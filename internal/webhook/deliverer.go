@@ -0,0 +1,180 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"go.uber.org/zap"
+	"gorm.io/gorm/clause"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	deliveryBatchSize   = 100
+	deliveryConcurrency = 10
+	pruneInterval       = time.Hour
+	// maxErrorLength truncates response bodies recorded as delivery errors
+	maxErrorLength = 1000
+)
+
+type deliverer struct {
+	dao          *dao.Query
+	endpoints    map[string]endpoint
+	httpClient   *http.Client
+	maxAttempts  uint
+	retryBackoff time.Duration
+	retention    time.Duration
+	logger       *zap.SugaredLogger
+}
+
+// deliverDue makes the next batch of due deliveries, returning the number attempted
+func (d deliverer) deliverDue(ctx context.Context) (int, error) {
+	deliveries, err := d.claimDue(ctx)
+	if err != nil || len(deliveries) == 0 {
+		return 0, err
+	}
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, deliveryConcurrency)
+	for _, delivery := range deliveries {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(delivery *model.WebhookDelivery) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			d.deliver(ctx, delivery)
+		}(delivery)
+	}
+	wg.Wait()
+	return len(deliveries), nil
+}
+
+// claimDue selects due deliveries and pushes back their next attempt time,
+// so that workers in other processes don't make the same deliveries in the meantime
+func (d deliverer) claimDue(ctx context.Context) ([]*model.WebhookDelivery, error) {
+	var deliveries []*model.WebhookDelivery
+	if err := d.dao.Transaction(func(tx *dao.Query) error {
+		now := time.Now()
+		result, err := tx.WebhookDelivery.WithContext(ctx).Clauses(clause.Locking{
+			Strength: "UPDATE",
+			Options:  "SKIP LOCKED",
+		}).Where(
+			tx.WebhookDelivery.Status.Eq(model.WebhookDeliveryStatusPending),
+			tx.WebhookDelivery.NextAttemptAt.Lte(now),
+		).Order(tx.WebhookDelivery.NextAttemptAt).Limit(deliveryBatchSize).Find()
+		if err != nil || len(result) == 0 {
+			return err
+		}
+		ids := make([]int64, 0, len(result))
+		for _, r := range result {
+			ids = append(ids, r.ID)
+		}
+		if _, err := tx.WebhookDelivery.WithContext(ctx).Where(
+			tx.WebhookDelivery.ID.In(ids...),
+		).UpdateColumn(tx.WebhookDelivery.NextAttemptAt, now.Add(d.claimDuration())); err != nil {
+			return err
+		}
+		deliveries = result
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+// claimDuration is long enough for a batch of deliveries to time out
+func (d deliverer) claimDuration() time.Duration {
+	return d.httpClient.Timeout*deliveryBatchSize/deliveryConcurrency + time.Minute
+}
+
+func (d deliverer) deliver(ctx context.Context, delivery *model.WebhookDelivery) {
+	delivery.Attempts++
+	var deliveryErr error
+	if e, ok := d.endpoints[delivery.Endpoint]; !ok {
+		// the endpoint has been removed from the config since the delivery was dispatched
+		delivery.Attempts = int64(d.maxAttempts)
+		deliveryErr = fmt.Errorf("endpoint %s is no longer configured", delivery.Endpoint)
+	} else {
+		status, err := d.send(ctx, e, delivery)
+		if status > 0 {
+			delivery.ResponseStatus = model.NewNullUint(uint(status))
+		}
+		deliveryErr = err
+	}
+	now := time.Now()
+	if deliveryErr == nil {
+		delivery.Status = model.WebhookDeliveryStatusSucceeded
+		delivery.Error = model.NullString{}
+		delivery.DeliveredAt = &now
+	} else {
+		delivery.Error = model.NewNullString(deliveryErr.Error())
+		if delivery.Attempts >= int64(d.maxAttempts) {
+			delivery.Status = model.WebhookDeliveryStatusFailed
+			d.logger.Warnw("webhook delivery failed", "id", delivery.ID, "endpoint", delivery.Endpoint, "error", deliveryErr)
+		} else {
+			delivery.NextAttemptAt = now.Add(backoff(d.retryBackoff, delivery.Attempts))
+		}
+	}
+	if _, err := d.dao.WebhookDelivery.WithContext(ctx).Where(
+		d.dao.WebhookDelivery.ID.Eq(delivery.ID),
+	).Select(
+		d.dao.WebhookDelivery.Status,
+		d.dao.WebhookDelivery.Attempts,
+		d.dao.WebhookDelivery.ResponseStatus,
+		d.dao.WebhookDelivery.Error,
+		d.dao.WebhookDelivery.NextAttemptAt,
+		d.dao.WebhookDelivery.DeliveredAt,
+		d.dao.WebhookDelivery.UpdatedAt,
+	).Updates(delivery); err != nil {
+		d.logger.Errorw("failed to save webhook delivery", "id", delivery.ID, "error", err)
+	}
+}
+
+// send posts the delivery's payload to its URL, returning the response status if a response was received
+func (d deliverer) send(ctx context.Context, e endpoint, delivery *model.WebhookDelivery) (int, error) {
+	body := []byte(delivery.Payload)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	for k, v := range e.headers {
+		req.Header.Set(k, v)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(headerEvent, delivery.EventType.String())
+	req.Header.Set(headerDelivery, strconv.FormatInt(delivery.ID, 10))
+	if signature := e.sign(body); signature != "" {
+		req.Header.Set(headerSignature, signature)
+	}
+	res, err := d.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		resBody, _ := io.ReadAll(io.LimitReader(res.Body, maxErrorLength))
+		return res.StatusCode, fmt.Errorf("unexpected status %d: %s", res.StatusCode, resBody)
+	}
+	return res.StatusCode, nil
+}
+
+// backoff returns the time to wait before the next attempt, doubling the base duration after each failed attempt
+func backoff(base time.Duration, attempts int64) time.Duration {
+	return base * time.Duration(1<<min(max(attempts-1, 0), 16))
+}
+
+func (d deliverer) prune(ctx context.Context) {
+	if _, err := d.dao.WebhookDelivery.WithContext(ctx).Where(
+		d.dao.WebhookDelivery.CreatedAt.Lt(time.Now().Add(-d.retention)),
+		d.dao.WebhookDelivery.Status.Neq(model.WebhookDeliveryStatusPending),
+	).Delete(); err != nil {
+		d.logger.Errorw("error pruning webhook deliveries", "error", err)
+	}
+}
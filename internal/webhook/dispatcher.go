@@ -0,0 +1,72 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"go.uber.org/zap"
+	"time"
+)
+
+// Dispatcher records deliveries of events to the endpoints that subscribe to them;
+// the deliveries are made, and retried on failure, by the webhook worker.
+type Dispatcher interface {
+	// Dispatch records a pending delivery of each event to each subscribed endpoint.
+	Dispatch(ctx context.Context, events ...Event) error
+	// Subscribed returns true if any endpoint subscribes to the event type, so that building unwanted events can be avoided.
+	Subscribed(t model.WebhookEventType) bool
+}
+
+type dispatcher struct {
+	dao       *dao.Query
+	endpoints map[string]endpoint
+	logger    *zap.SugaredLogger
+}
+
+func (d dispatcher) Subscribed(t model.WebhookEventType) bool {
+	for _, e := range d.endpoints {
+		if e.subscribes(t) {
+			return true
+		}
+	}
+	return false
+}
+
+func (d dispatcher) Dispatch(ctx context.Context, events ...Event) error {
+	now := time.Now()
+	var deliveries []*model.WebhookDelivery
+	for _, event := range events {
+		var payload []byte
+		for _, e := range d.endpoints {
+			if !e.subscribes(event.Type) {
+				continue
+			}
+			if payload == nil {
+				p, err := json.Marshal(event)
+				if err != nil {
+					return err
+				}
+				payload = p
+			}
+			url, err := e.renderURL(event)
+			if err != nil {
+				// a template that fails for one event shouldn't prevent delivery to other endpoints
+				d.logger.Errorw("failed to render webhook URL", "endpoint", e.name, "type", event.Type, "error", err)
+				continue
+			}
+			deliveries = append(deliveries, &model.WebhookDelivery{
+				Endpoint:      e.name,
+				EventType:     event.Type,
+				URL:           url,
+				Payload:       string(payload),
+				Status:        model.WebhookDeliveryStatusPending,
+				NextAttemptAt: now,
+			})
+		}
+	}
+	if len(deliveries) == 0 {
+		return nil
+	}
+	return d.dao.WebhookDelivery.WithContext(ctx).CreateInBatches(deliveries, 100)
+}
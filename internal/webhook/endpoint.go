@@ -0,0 +1,91 @@
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"text/template"
+)
+
+const (
+	headerEvent     = "X-Bitmagnet-Event"
+	headerDelivery  = "X-Bitmagnet-Delivery"
+	headerSignature = "X-Bitmagnet-Signature"
+)
+
+type endpoint struct {
+	name    string
+	url     *template.Template
+	secret  string
+	events  map[model.WebhookEventType]struct{}
+	headers map[string]string
+}
+
+func newEndpoints(configs map[string]EndpointConfig) (map[string]endpoint, error) {
+	endpoints := make(map[string]endpoint, len(configs))
+	for name, cfg := range configs {
+		e, err := newEndpoint(name, cfg)
+		if err != nil {
+			return nil, err
+		}
+		endpoints[name] = e
+	}
+	return endpoints, nil
+}
+
+func newEndpoint(name string, cfg EndpointConfig) (endpoint, error) {
+	if cfg.URL == "" {
+		return endpoint{}, fmt.Errorf("webhook endpoint %s has no URL", name)
+	}
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(cfg.URL)
+	if err != nil {
+		return endpoint{}, fmt.Errorf("invalid URL template for webhook endpoint %s: %w", name, err)
+	}
+	e := endpoint{
+		name:    name,
+		url:     tmpl,
+		secret:  cfg.Secret,
+		headers: cfg.Headers,
+	}
+	if len(cfg.Events) > 0 {
+		e.events = make(map[model.WebhookEventType]struct{}, len(cfg.Events))
+		for _, s := range cfg.Events {
+			t, err := model.ParseWebhookEventType(s)
+			if err != nil {
+				return endpoint{}, fmt.Errorf("invalid event for webhook endpoint %s: %w", name, err)
+			}
+			e.events[t] = struct{}{}
+		}
+	}
+	return e, nil
+}
+
+// subscribes returns true if events of the type are delivered to the endpoint
+func (e endpoint) subscribes(t model.WebhookEventType) bool {
+	if e.events == nil {
+		return true
+	}
+	_, ok := e.events[t]
+	return ok
+}
+
+func (e endpoint) renderURL(event Event) (string, error) {
+	var b bytes.Buffer
+	if err := e.url.Execute(&b, event); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// sign returns the signature header value for the body, or an empty string if the endpoint has no secret
+func (e endpoint) sign(body []byte) string {
+	if e.secret == "" {
+		return ""
+	}
+	mac := hmac.New(sha256.New, []byte(e.secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
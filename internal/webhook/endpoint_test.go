@@ -0,0 +1,104 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestNewEndpoint(t *testing.T) {
+	t.Parallel()
+
+	e, err := newEndpoint("test", EndpointConfig{
+		URL:    "https://example.com/hook?type={{.Type}}",
+		Events: []string{"import_finished", "HEALTH_DEGRADED"},
+	})
+	require.NoError(t, err)
+	assert.True(t, e.subscribes(model.WebhookEventTypeImportFinished))
+	assert.True(t, e.subscribes(model.WebhookEventTypeHealthDegraded))
+	assert.False(t, e.subscribes(model.WebhookEventTypeTorrentDiscovered))
+	url, err := e.renderURL(NewImportFinishedEvent("abc", 1, nil))
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.com/hook?type=import_finished", url)
+
+	all, err := newEndpoint("all", EndpointConfig{URL: "https://example.com/hook"})
+	require.NoError(t, err)
+	assert.True(t, all.subscribes(model.WebhookEventTypeTorrentClassified), "an endpoint without an event filter should receive all events")
+
+	_, err = newEndpoint("test", EndpointConfig{})
+	assert.Error(t, err, "a URL is required")
+	_, err = newEndpoint("test", EndpointConfig{URL: "https://example.com/{{.Type"})
+	assert.Error(t, err)
+	_, err = newEndpoint("test", EndpointConfig{URL: "https://example.com", Events: []string{"torrent_exploded"}})
+	assert.Error(t, err)
+}
+
+func TestBackoff(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, time.Second*30, backoff(time.Second*30, 1))
+	assert.Equal(t, time.Second*60, backoff(time.Second*30, 2))
+	assert.Equal(t, time.Second*120, backoff(time.Second*30, 3))
+}
+
+func TestSend(t *testing.T) {
+	t.Parallel()
+
+	payload := `{"type":"import_finished"}`
+	var received *http.Request
+	var receivedBody []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		receivedBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	e, err := newEndpoint("test", EndpointConfig{
+		URL:     server.URL,
+		Secret:  "s3cret",
+		Headers: map[string]string{"Authorization": "Bearer token"},
+	})
+	require.NoError(t, err)
+	d := deliverer{httpClient: server.Client()}
+	status, err := d.send(context.Background(), e, &model.WebhookDelivery{
+		ID:        42,
+		EventType: model.WebhookEventTypeImportFinished,
+		URL:       server.URL,
+		Payload:   payload,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, status)
+	assert.Equal(t, payload, string(receivedBody))
+	assert.Equal(t, "import_finished", received.Header.Get(headerEvent))
+	assert.Equal(t, "42", received.Header.Get(headerDelivery))
+	assert.Equal(t, "Bearer token", received.Header.Get("Authorization"))
+	mac := hmac.New(sha256.New, []byte("s3cret"))
+	mac.Write([]byte(payload))
+	assert.Equal(t, "sha256="+hex.EncodeToString(mac.Sum(nil)), received.Header.Get(headerSignature))
+}
+
+func TestSendUnexpectedStatus(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	e, err := newEndpoint("test", EndpointConfig{URL: server.URL})
+	require.NoError(t, err)
+	d := deliverer{httpClient: server.Client()}
+	status, err := d.send(context.Background(), e, &model.WebhookDelivery{URL: server.URL, Payload: "{}"})
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, status)
+}
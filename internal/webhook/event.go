@@ -0,0 +1,133 @@
+package webhook
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/events"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/hellofresh/health-go/v5"
+	"time"
+)
+
+// Event is the body of the request delivered to webhook endpoints; only the field relating to the event type is set.
+type Event struct {
+	Type    model.WebhookEventType `json:"type"`
+	Time    time.Time              `json:"time"`
+	Torrent *Torrent               `json:"torrent,omitempty"`
+	// Previous is the release that a better release of watched content supersedes, if there was one.
+	Previous *Torrent `json:"previous,omitempty"`
+	Import   *Import  `json:"import,omitempty"`
+	Health   *Health  `json:"health,omitempty"`
+}
+
+type Torrent struct {
+	InfoHash      string `json:"infoHash"`
+	Name          string `json:"name"`
+	Size          uint64 `json:"size"`
+	ContentType   string `json:"contentType,omitempty"`
+	ContentSource string `json:"contentSource,omitempty"`
+	ContentID     string `json:"contentId,omitempty"`
+	Title         string `json:"title,omitempty"`
+	// VideoResolution and VideoCodec are only set for better release events.
+	VideoResolution string `json:"videoResolution,omitempty"`
+	VideoCodec      string `json:"videoCodec,omitempty"`
+}
+
+type Import struct {
+	ID        string `json:"id"`
+	ItemCount int    `json:"itemCount"`
+	Error     string `json:"error,omitempty"`
+}
+
+type Health struct {
+	Status   string            `json:"status"`
+	Failures map[string]string `json:"failures"`
+}
+
+// NewTorrentEvent converts a discovered or classified torrent event; other torrent events aren't delivered to webhooks.
+func NewTorrentEvent(e events.Event) (Event, bool) {
+	var t model.WebhookEventType
+	switch e.Type {
+	case model.TorrentEventTypeDiscovered:
+		t = model.WebhookEventTypeTorrentDiscovered
+	case model.TorrentEventTypeClassified:
+		t = model.WebhookEventTypeTorrentClassified
+	default:
+		return Event{}, false
+	}
+	torrent := Torrent{
+		InfoHash:      e.InfoHash.String(),
+		Name:          e.Name,
+		Size:          e.Size,
+		ContentSource: e.ContentSource.String,
+		ContentID:     e.ContentID.String,
+		Title:         e.Title.String,
+	}
+	if e.ContentType.Valid {
+		torrent.ContentType = e.ContentType.ContentType.String()
+	}
+	return Event{
+		Type:    t,
+		Time:    e.Time,
+		Torrent: &torrent,
+	}, true
+}
+
+// NewBetterReleaseEvent returns the event of a better release of watched content being classified;
+// previous is nil if no earlier release of the content was known.
+func NewBetterReleaseEvent(tc model.TorrentContent, previous *model.TorrentContent) Event {
+	e := Event{
+		Type:    model.WebhookEventTypeBetterRelease,
+		Time:    time.Now(),
+		Torrent: newReleaseTorrent(tc),
+	}
+	if previous != nil {
+		e.Previous = newReleaseTorrent(*previous)
+	}
+	return e
+}
+
+func newReleaseTorrent(tc model.TorrentContent) *Torrent {
+	t := Torrent{
+		InfoHash:      tc.InfoHash.String(),
+		Name:          tc.Torrent.Name,
+		Size:          tc.Torrent.Size,
+		ContentSource: tc.ContentSource.String,
+		ContentID:     tc.ContentID.String,
+		Title:         tc.Title(),
+	}
+	if tc.ContentType.Valid {
+		t.ContentType = tc.ContentType.ContentType.String()
+	}
+	if tc.VideoResolution.Valid {
+		t.VideoResolution = tc.VideoResolution.VideoResolution.Label()
+	}
+	if tc.VideoCodec.Valid {
+		t.VideoCodec = tc.VideoCodec.VideoCodec.Label()
+	}
+	return &t
+}
+
+func NewImportFinishedEvent(id string, itemCount int, err error) Event {
+	i := Import{
+		ID:        id,
+		ItemCount: itemCount,
+	}
+	if err != nil {
+		i.Error = err.Error()
+	}
+	return Event{
+		Type:   model.WebhookEventTypeImportFinished,
+		Time:   time.Now(),
+		Import: &i,
+	}
+}
+
+func NewHealthDegradedEvent(check health.Check) Event {
+	return Event{
+		Type: model.WebhookEventTypeHealthDegraded,
+		Time: time.Now(),
+		Health: &Health{
+			Status:   string(check.Status),
+			Failures: check.Failures,
+		},
+	}
+}
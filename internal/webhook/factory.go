@@ -0,0 +1,106 @@
+package webhook
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/lazy"
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/worker"
+	"github.com/bitmagnet-io/bitmagnet/internal/database/dao"
+	"github.com/bitmagnet-io/bitmagnet/internal/events"
+	"github.com/hellofresh/health-go/v5"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"net/http"
+)
+
+type Params struct {
+	fx.In
+	Config   Config
+	Dao      lazy.Lazy[*dao.Query]
+	EventBus lazy.Lazy[events.Bus]
+	Health   *health.Health
+	Logger   *zap.SugaredLogger
+}
+
+type Result struct {
+	fx.Out
+	Dispatcher lazy.Lazy[Dispatcher]
+	Worker     worker.Worker `group:"workers"`
+}
+
+func New(p Params) Result {
+	logger := p.Logger.Named("webhook")
+	endpoints := lazy.New(func() (map[string]endpoint, error) {
+		return newEndpoints(p.Config.Endpoints)
+	})
+	ld := lazy.New(func() (Dispatcher, error) {
+		d, err := p.Dao.Get()
+		if err != nil {
+			return nil, err
+		}
+		e, err := endpoints.Get()
+		if err != nil {
+			return nil, err
+		}
+		return dispatcher{
+			dao:       d,
+			endpoints: e,
+			logger:    logger,
+		}, nil
+	})
+	var cancel context.CancelFunc
+	return Result{
+		Dispatcher: ld,
+		Worker: worker.NewWorker(
+			"webhook_dispatcher",
+			fx.Hook{
+				OnStart: func(context.Context) error {
+					d, err := p.Dao.Get()
+					if err != nil {
+						return err
+					}
+					e, err := endpoints.Get()
+					if err != nil {
+						return err
+					}
+					disp, err := ld.Get()
+					if err != nil {
+						return err
+					}
+					eb, err := p.EventBus.Get()
+					if err != nil {
+						return err
+					}
+					w := webhookWorker{
+						dispatcher: disp,
+						deliverer: deliverer{
+							dao:       d,
+							endpoints: e,
+							httpClient: &http.Client{
+								Timeout: p.Config.Timeout,
+							},
+							maxAttempts:  max(p.Config.MaxAttempts, 1),
+							retryBackoff: p.Config.RetryBackoff,
+							retention:    p.Config.Retention,
+							logger:       logger,
+						},
+						eventBus:            eb,
+						health:              p.Health,
+						pollInterval:        p.Config.PollInterval,
+						healthCheckInterval: p.Config.HealthCheckInterval,
+						logger:              logger,
+					}
+					// the start context is only valid for the duration of the start hook
+					var ctx context.Context
+					ctx, cancel = context.WithCancel(context.Background())
+					return w.start(ctx)
+				},
+				OnStop: func(context.Context) error {
+					if cancel != nil {
+						cancel()
+					}
+					return nil
+				},
+			},
+		),
+	}
+}
@@ -0,0 +1,17 @@
+package webhookfx
+
+import (
+	"github.com/bitmagnet-io/bitmagnet/internal/boilerplate/config/configfx"
+	"github.com/bitmagnet-io/bitmagnet/internal/webhook"
+	"go.uber.org/fx"
+)
+
+func New() fx.Option {
+	return fx.Module(
+		"webhook",
+		configfx.NewConfigModule[webhook.Config]("webhooks", webhook.NewDefaultConfig()),
+		fx.Provide(
+			webhook.New,
+		),
+	)
+}
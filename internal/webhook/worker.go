@@ -0,0 +1,94 @@
+package webhook
+
+import (
+	"context"
+	"github.com/bitmagnet-io/bitmagnet/internal/events"
+	"github.com/bitmagnet-io/bitmagnet/internal/model"
+	"github.com/hellofresh/health-go/v5"
+	"go.uber.org/zap"
+	"time"
+)
+
+// webhookWorker relays torrent events and health changes to the dispatcher, and makes pending deliveries.
+// Deliveries can safely be made by workers in several processes, but torrent events are relayed by every worker
+// that receives them, so the worker should only be enabled in one process.
+type webhookWorker struct {
+	dispatcher          Dispatcher
+	deliverer           deliverer
+	eventBus            events.Bus
+	health              *health.Health
+	pollInterval        time.Duration
+	healthCheckInterval time.Duration
+	logger              *zap.SugaredLogger
+}
+
+func (w webhookWorker) start(ctx context.Context) error {
+	if w.dispatcher.Subscribed(model.WebhookEventTypeTorrentDiscovered) ||
+		w.dispatcher.Subscribed(model.WebhookEventTypeTorrentClassified) {
+		ch, err := w.eventBus.Subscribe(ctx)
+		if err != nil {
+			return err
+		}
+		go w.relayTorrentEvents(ctx, ch)
+	}
+	if w.dispatcher.Subscribed(model.WebhookEventTypeHealthDegraded) {
+		go w.monitorHealth(ctx)
+	}
+	go w.deliverPending(ctx)
+	return nil
+}
+
+func (w webhookWorker) relayTorrentEvents(ctx context.Context, ch <-chan events.Event) {
+	for e := range ch {
+		event, ok := NewTorrentEvent(e)
+		if !ok || !w.dispatcher.Subscribed(event.Type) {
+			continue
+		}
+		if err := w.dispatcher.Dispatch(ctx, event); err != nil && ctx.Err() == nil {
+			w.logger.Errorw("failed to dispatch torrent event", "type", event.Type, "error", err)
+		}
+	}
+}
+
+// monitorHealth dispatches a health_degraded event whenever the health check result changes from OK
+func (w webhookWorker) monitorHealth(ctx context.Context) {
+	ok := true
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(w.healthCheckInterval):
+		}
+		check := w.health.Measure(ctx)
+		wasOk := ok
+		ok = check.Status == health.StatusOK
+		if wasOk && !ok {
+			if err := w.dispatcher.Dispatch(ctx, NewHealthDegradedEvent(check)); err != nil && ctx.Err() == nil {
+				w.logger.Errorw("failed to dispatch health event", "error", err)
+			}
+		}
+	}
+}
+
+func (w webhookWorker) deliverPending(ctx context.Context) {
+	var lastPruned time.Time
+	for {
+		if time.Since(lastPruned) >= pruneInterval {
+			w.deliverer.prune(ctx)
+			lastPruned = time.Now()
+		}
+		n, err := w.deliverer.deliverDue(ctx)
+		if err != nil && ctx.Err() == nil {
+			w.logger.Errorw("error making webhook deliveries", "error", err)
+		}
+		// when there's a backlog, continue immediately
+		if err == nil && n >= deliveryBatchSize {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(w.pollInterval):
+		}
+	}
+}
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+
+create table task_runs
+(
+  id          bigserial primary key,
+  kind        text                     not null,
+  status      text                     not null,
+  started_at  timestamp with time zone not null,
+  finished_at timestamp with time zone null,
+  item_count  bigint                   not null default 0,
+  error       text                     null,
+  created_at  timestamp with time zone not null,
+  updated_at  timestamp with time zone not null
+);
+
+create index on task_runs (started_at);
+create index on task_runs (kind, started_at);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+drop table task_runs;
+
+-- +goose StatementEnd
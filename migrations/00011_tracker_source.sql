@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+
+insert into torrent_sources (key, name, created_at, updated_at)
+values ('tracker', 'Tracker scrape', now(), now())
+on conflict (key) do nothing;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+delete from torrents_torrent_sources where source = 'tracker';
+delete from torrent_sources where key = 'tracker';
+
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+
+alter table torrents_torrent_sources add column health real;
+
+create index on torrents_torrent_sources (source, updated_at);
+create index on torrents_torrent_sources (health);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+alter table torrents_torrent_sources drop column health;
+
+drop index if exists torrents_torrent_sources_source_updated_at_idx;
+
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+
+create table metainfo_attempts
+(
+  info_hash       bytea                    not null primary key references torrents on delete cascade,
+  attempts        integer                  not null default 0,
+  last_attempt_at timestamp with time zone null,
+  next_attempt_at timestamp with time zone not null,
+  created_at      timestamp with time zone not null,
+  updated_at      timestamp with time zone not null
+);
+
+create index on metainfo_attempts (next_attempt_at);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+drop table metainfo_attempts;
+
+-- +goose StatementEnd
@@ -0,0 +1,48 @@
+-- +goose Up
+-- +goose StatementBegin
+
+create table takedowns
+(
+  id             bigserial primary key,
+  info_hash      bytea                    null,
+  content_type   text                     null,
+  content_source text                     null,
+  content_id     text                     null,
+  list           text                     not null,
+  reason         text                     null,
+  reference      text                     null,
+  created_at     timestamp with time zone not null,
+  updated_at     timestamp with time zone not null,
+  check (info_hash is not null or (content_source is not null and content_id is not null))
+);
+
+create unique index on takedowns (info_hash) where info_hash is not null;
+create unique index on takedowns (content_source, content_id, coalesce(content_type, '')) where content_id is not null;
+create index on takedowns (list);
+
+create table takedown_log
+(
+  id               bigserial primary key,
+  takedown_id      bigint                   not null,
+  action           text                     not null,
+  info_hash        bytea                    null,
+  content_type     text                     null,
+  content_source   text                     null,
+  content_id       text                     null,
+  list             text                     not null,
+  removed_torrents integer                  not null default 0,
+  created_at       timestamp with time zone not null
+);
+
+create index on takedown_log (created_at);
+create index on takedown_log (takedown_id);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+drop table takedown_log;
+drop table takedowns;
+
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+
+alter table torrent_contents add column classifier_version integer not null default 0;
+
+create index on torrent_contents (classifier_version);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+alter table torrent_contents drop column classifier_version;
+
+-- +goose StatementEnd
@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+
+create table wanted_items
+(
+  id                   bigserial primary key,
+  content_type         text                     not null,
+  content_source       text                     not null,
+  content_id           text                     not null,
+  season               integer                  null,
+  title                text                     null,
+  min_video_resolution text                     null,
+  requester            text                     not null,
+  request_id           text                     null,
+  callback_url         text                     null,
+  fulfilled_info_hash  bytea                    null,
+  fulfilled_at         timestamp with time zone null,
+  created_at           timestamp with time zone not null,
+  updated_at           timestamp with time zone not null
+);
+
+create index on wanted_items (content_id) where fulfilled_at is null;
+create index on wanted_items (requester, request_id);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+drop table wanted_items;
+
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+
+alter table torrent_hints add column override boolean not null default false;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+alter table torrent_hints drop column override;
+
+-- +goose StatementEnd
@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+
+create table saved_searches
+(
+  id              bigserial primary key,
+  name            text                     not null unique,
+  query_string    text                     null,
+  facets          jsonb                    not null,
+  order_by        text                     not null,
+  order_desc      boolean                  not null,
+  webhook_url     text                     null,
+  email           text                     null,
+  last_matched_at timestamp with time zone null,
+  created_at      timestamp with time zone not null,
+  updated_at      timestamp with time zone not null
+);
+
+create table saved_search_matches
+(
+  saved_search_id bigint                   not null references saved_searches on delete cascade,
+  info_hash       bytea                    not null references torrents on delete cascade,
+  created_at      timestamp with time zone not null,
+  primary key (saved_search_id, info_hash)
+);
+
+create index on saved_search_matches (info_hash);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+drop table saved_search_matches;
+drop table saved_searches;
+
+-- +goose StatementEnd
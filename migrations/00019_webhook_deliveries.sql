@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+
+create table webhook_deliveries
+(
+  id              bigserial primary key,
+  endpoint        text                     not null,
+  event_type      text                     not null,
+  url             text                     not null,
+  payload         jsonb                    not null,
+  status          text                     not null,
+  attempts        integer                  not null,
+  response_status integer                  null,
+  error           text                     null,
+  next_attempt_at timestamp with time zone not null,
+  delivered_at    timestamp with time zone null,
+  created_at      timestamp with time zone not null,
+  updated_at      timestamp with time zone not null
+);
+
+create index on webhook_deliveries (next_attempt_at) where status = 'pending';
+create index on webhook_deliveries (created_at);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+drop table webhook_deliveries;
+
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+
+create table torznab_api_keys
+(
+  name         text                     primary key,
+  key          text                     not null unique,
+  rate_limit   integer                  null,
+  last_used_at timestamp with time zone null,
+  created_at   timestamp with time zone not null,
+  updated_at   timestamp with time zone not null
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+drop table torznab_api_keys;
+
+-- +goose StatementEnd
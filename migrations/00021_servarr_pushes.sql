@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+
+create table servarr_pushes
+(
+  target     text                     not null,
+  info_hash  bytea                    not null references torrents on delete cascade,
+  approved   boolean                  not null,
+  rejections text                     null,
+  created_at timestamp with time zone not null,
+  primary key (target, info_hash)
+);
+
+create index on servarr_pushes (info_hash);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+drop table servarr_pushes;
+
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+
+create table torrent_downloads
+(
+  info_hash  bytea                    not null references torrents on delete cascade,
+  client     text                     not null,
+  category   text                     null,
+  save_path  text                     null,
+  created_at timestamp with time zone not null,
+  updated_at timestamp with time zone not null,
+  primary key (info_hash, client)
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+drop table torrent_downloads;
+
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+
+create table audit_logs
+(
+  id         bigserial primary key,
+  actor      text                     null,
+  client_ip  text                     null,
+  action     text                     not null,
+  payload    jsonb                    not null,
+  error      text                     null,
+  created_at timestamp with time zone not null
+);
+
+create index on audit_logs (created_at);
+create index on audit_logs (actor, created_at);
+create index on audit_logs (action, created_at);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+drop table audit_logs;
+
+-- +goose StatementEnd
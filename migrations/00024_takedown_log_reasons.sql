@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+
+alter table takedown_log add column reason text null;
+alter table takedown_log add column reference text null;
+
+update takedown_log
+set reason    = takedowns.reason,
+    reference = takedowns.reference
+from takedowns
+where takedowns.id = takedown_log.takedown_id;
+
+create index on takedown_log (info_hash);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+drop index if exists takedown_log_info_hash_idx;
+alter table takedown_log drop column reference;
+alter table takedown_log drop column reason;
+
+-- +goose StatementEnd
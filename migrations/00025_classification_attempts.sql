@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+
+create table classification_attempts
+(
+  info_hash  bytea                    not null primary key references torrents on delete cascade,
+  attempts   integer                  not null default 0,
+  created_at timestamp with time zone not null,
+  updated_at timestamp with time zone not null
+);
+
+create index on classification_attempts (attempts);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+drop table classification_attempts;
+
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+
+alter table torrent_files add column tsv tsvector;
+
+CREATE INDEX on torrent_files USING GIN(tsv);
+
+-- file paths are tokenized by the application, so existing files are indexed in batches by the files_index worker;
+-- this index finds the files left to index, and is empty once they've been indexed, as new files are indexed on creation
+CREATE INDEX torrent_files_unindexed_idx on torrent_files (info_hash) WHERE tsv IS NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+alter table torrent_files drop column tsv;
+
+-- +goose StatementEnd
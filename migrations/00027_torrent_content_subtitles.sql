@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+
+alter table torrent_contents add column subtitle_languages jsonb;
+alter table torrent_contents add column subtitled boolean not null default false;
+alter table torrent_contents add column multi_audio boolean not null default false;
+
+CREATE INDEX on torrent_contents USING GIN(subtitle_languages);
+create index on torrent_contents (subtitled);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+alter table torrent_contents drop column subtitle_languages;
+alter table torrent_contents drop column subtitled;
+alter table torrent_contents drop column multi_audio;
+
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- indexes for fuzzy search by trigram word similarity
+CREATE INDEX torrents_name_trgm_idx on torrents USING GIN(name gin_trgm_ops);
+CREATE INDEX content_title_trgm_idx on content USING GIN(title gin_trgm_ops);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+drop index if exists torrents_name_trgm_idx;
+drop index if exists content_title_trgm_idx;
+
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+
+alter table task_runs add column target text;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+alter table task_runs drop column target;
+
+-- +goose StatementEnd
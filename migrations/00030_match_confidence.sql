@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+
+alter table torrent_contents add column match_confidence real;
+
+create index on torrent_contents (match_confidence) where match_confidence is not null;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+alter table torrent_contents drop column match_confidence;
+
+-- +goose StatementEnd
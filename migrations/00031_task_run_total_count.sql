@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+
+alter table task_runs add column total_count bigint;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+alter table task_runs drop column total_count;
+
+-- +goose StatementEnd
@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+
+create table content_people
+(
+  content_type   text                     not null,
+  content_source text                     not null references metadata_sources on delete cascade,
+  content_id     text                     not null,
+  source         text                     not null references metadata_sources on delete cascade,
+  credit_id      text                     not null,
+  person_id      text                     not null,
+  name           text                     not null,
+  role           text                     not null,
+  job            text,
+  department     text,
+  character      text,
+  position       integer                  not null,
+  created_at     timestamp with time zone not null,
+  updated_at     timestamp with time zone not null,
+  primary key (content_type, content_source, content_id, source, credit_id),
+  foreign key (content_type, content_source, content_id) references content (type, source, id) on delete cascade
+);
+create index on content_people (source, person_id);
+create index on content_people (lower(name));
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+drop table if exists content_people;
+
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- for finding the least recently updated content to refresh
+create index content_updated_at_idx on content (updated_at);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+drop index if exists content_updated_at_idx;
+
+-- +goose StatementEnd
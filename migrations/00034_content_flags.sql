@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+
+create table content_flags
+(
+  content_type      text                     not null,
+  content_source    text                     not null,
+  content_id        text                     not null,
+  watching          boolean                  not null default false,
+  ignored           boolean                  not null default false,
+  best_info_hash    bytea,
+  created_at        timestamp with time zone not null,
+  updated_at        timestamp with time zone not null,
+  primary key (content_type, content_source, content_id),
+  foreign key (content_type, content_source, content_id) references content (type, source, id) on delete cascade
+);
+create index on content_flags (watching) where watching;
+create index on content_flags (ignored) where ignored;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+drop table if exists content_flags;
+
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+
+alter table torrents_torrent_sources add column discovery_method text;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+alter table torrents_torrent_sources drop column if exists discovery_method;
+
+-- +goose StatementEnd
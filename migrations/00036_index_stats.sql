@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+
+create table index_stats
+(
+  bucket           timestamp with time zone not null,
+  content_type     text                     not null,
+  discovered_count bigint                   not null,
+  discovered_size  bigint                   not null,
+  classified_count bigint                   not null,
+  primary key (bucket, content_type)
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+drop table if exists index_stats;
+
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+
+alter table torrent_contents add column release_tokens jsonb;
+
+CREATE INDEX on torrent_contents USING GIN(release_tokens);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+alter table torrent_contents drop column release_tokens;
+
+-- +goose StatementEnd
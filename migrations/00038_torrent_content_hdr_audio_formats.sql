@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+
+alter table torrent_contents add column hdr_formats jsonb;
+alter table torrent_contents add column audio_formats jsonb;
+
+CREATE INDEX on torrent_contents USING GIN(hdr_formats);
+CREATE INDEX on torrent_contents USING GIN(audio_formats);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+alter table torrent_contents drop column hdr_formats;
+alter table torrent_contents drop column audio_formats;
+
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+
+create index torrent_contents_episodes_gin_idx on torrent_contents using gin(episodes);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+drop index if exists torrent_contents_episodes_gin_idx;
+
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+
+alter table torrent_contents add column air_date date;
+
+create index on torrent_contents (air_date);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+alter table torrent_contents drop column air_date;
+
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+
+alter table torrent_contents add column sport_event jsonb;
+
+create index on torrent_contents ((sport_event->>'league'));
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+alter table torrent_contents drop column sport_event;
+
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+
+alter table torrent_contents add column file_contents jsonb;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+alter table torrent_contents drop column file_contents;
+
+-- +goose StatementEnd
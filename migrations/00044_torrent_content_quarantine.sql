@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+
+alter table torrent_contents add column spam_reasons jsonb;
+alter table torrent_contents add column quarantined boolean not null default false;
+alter table torrent_contents add column quarantine_override boolean;
+
+CREATE INDEX on torrent_contents (quarantined) where quarantined;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+alter table torrent_contents drop column quarantine_override;
+alter table torrent_contents drop column quarantined;
+alter table torrent_contents drop column spam_reasons;
+
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+
+create table torrent_reports
+(
+  info_hash  bytea                    not null references torrents on delete cascade,
+  reporter   text                     not null,
+  reason     text                     not null,
+  comment    text                     null,
+  created_at timestamp with time zone not null,
+  updated_at timestamp with time zone not null,
+  primary key (info_hash, reporter)
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+drop table torrent_reports;
+
+-- +goose StatementEnd
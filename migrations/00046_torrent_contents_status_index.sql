@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+
+create index torrent_contents_info_hash_status_idx on torrent_contents (info_hash) include (content_type, quarantined);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+drop index if exists torrent_contents_info_hash_status_idx;
+
+-- +goose StatementEnd
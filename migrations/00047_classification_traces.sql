@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+
+create table classification_traces
+(
+  info_hash          bytea                    not null primary key references torrents on delete cascade,
+  classifier_version integer                  not null,
+  classifiers        jsonb                    not null,
+  tokens             jsonb                    not null,
+  lookups            jsonb                    not null,
+  created_at         timestamp with time zone not null,
+  updated_at         timestamp with time zone not null
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+drop table classification_traces;
+
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+
+create table classifier_shadow_divergences
+(
+  info_hash  bytea                    not null primary key references torrents on delete cascade,
+  fields     jsonb                    not null,
+  active     jsonb                    not null,
+  shadow     jsonb                    not null,
+  created_at timestamp with time zone not null,
+  updated_at timestamp with time zone not null
+);
+
+create index on classifier_shadow_divergences (updated_at);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+drop table classifier_shadow_divergences;
+
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- it isn't known when existing sources were last seeded, so they're given the time of the migration, which is added
+-- without rewriting the table; the default is then dropped so that sources that have never been seeded have no time
+alter table torrents_torrent_sources add column last_seeded_at timestamp with time zone default now();
+alter table torrents_torrent_sources alter column last_seeded_at drop default;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+alter table torrents_torrent_sources drop column last_seeded_at;
+
+-- +goose StatementEnd
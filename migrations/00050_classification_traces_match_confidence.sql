@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+
+alter table classification_traces add column match_confidence real;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+alter table classification_traces drop column match_confidence;
+
+-- +goose StatementEnd
@@ -215,4 +215,4 @@ ${$i`
     }
   }
 }
-    `;let OF=(()=>{var e;class t{constructor(n){this.apollo=n}torrentContentSearch(n){return this.apollo.query({query:NJ,variables:n,fetchPolicy:uc}).pipe(J(r=>r.data.torrentContent.search))}torrentDelete(n){return this.apollo.mutate({mutation:RJ,variables:n,fetchPolicy:uc}).pipe(J(()=>{}))}torrentPutTags(n){return this.apollo.mutate({mutation:FJ,variables:n,fetchPolicy:uc}).pipe(J(()=>{}))}torrentSetTags(n){return this.apollo.mutate({mutation:PJ,variables:n,fetchPolicy:uc}).pipe(J(()=>{}))}torrentDeleteTags(n){return this.apollo.mutate({mutation:OJ,variables:n,fetchPolicy:uc}).pipe(J(()=>{}))}torrentSuggestTags(n){return this.apollo.query({query:LJ,variables:n,fetchPolicy:uc}).pipe(J(r=>r.data.torrent.suggestTags))}}return(e=t).\u0275fac=function(n){return new(n||e)(D(RF))},e.\u0275prov=V({token:e,factory:e.\u0275fac}),t})();const uc="no-cache";function BJ(e,t){if(1&e){const i=Ut();y(0,"div",2)(1,"button",3),$("click",function(){return Ye(i),Ke(B().action())}),A(2),w()()}if(2&e){const i=B();x(2),Ue(" ",i.data.action," ")}}const VJ=["label"];function jJ(e,t){}const HJ=Math.pow(2,31)-1;class tw{constructor(t,i){this._overlayRef=i,this._afterDismissed=new Y,this._afterOpened=new Y,this._onAction=new Y,this._dismissedByAction=!1,this.containerInstance=t,t._onExit.subscribe(()=>this._finishDismiss())}dismiss(){this._afterDismissed.closed||this.containerInstance.exit(),clearTimeout(this._durationTimeoutId)}dismissWithAction(){this._onAction.closed||(this._dismissedByAction=!0,this._onAction.next(),this._onAction.complete(),this.dismiss()),clearTimeout(this._durationTimeoutId)}closeWithAction(){this.dismissWithAction()}_dismissAfter(t){this._durationTimeoutId=setTimeout(()=>this.dismiss(),Math.min(t,HJ))}_open(){this._afterOpened.closed||(this._afterOpened.next(),this._afterOpened.complete())}_finishDismiss(){this._overlayRef.dispose(),this._onAction.closed||this._onAction.complete(),this._afterDismissed.next({dismissedByAction:this._dismissedByAction}),this._afterDismissed.complete(),this._dismissedByAction=!1}afterDismissed(){return this._afterDismissed}afterOpened(){return this.containerInstance._onEnter}onAction(){return this._onAction}}const FF=new M("MatSnackBarData");class Qp{constructor(){this.politeness="assertive",this.announcementMessage="",this.duration=0,this.data=null,this.horizontalPosition="center",this.verticalPosition="bottom"}}let zJ=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275dir=T({type:e,selectors:[["","matSnackBarLabel",""]],hostAttrs:[1,"mat-mdc-snack-bar-label","mdc-snackbar__label"]}),t})(),UJ=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275dir=T({type:e,selectors:[["","matSnackBarActions",""]],hostAttrs:[1,"mat-mdc-snack-bar-actions","mdc-snackbar__actions"]}),t})(),$J=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275dir=T({type:e,selectors:[["","matSnackBarAction",""]],hostAttrs:[1,"mat-mdc-snack-bar-action","mdc-snackbar__action"]}),t})(),qJ=(()=>{var e;class t{constructor(n,r){this.snackBarRef=n,this.data=r}action(){this.snackBarRef.dismissWithAction()}get hasAction(){return!!this.data.action}}return(e=t).\u0275fac=function(n){return new(n||e)(m(tw),m(FF))},e.\u0275cmp=fe({type:e,selectors:[["simple-snack-bar"]],hostAttrs:[1,"mat-mdc-simple-snack-bar"],exportAs:["matSnackBar"],decls:3,vars:2,consts:[["matSnackBarLabel",""],["matSnackBarActions","",4,"ngIf"],["matSnackBarActions",""],["mat-button","","matSnackBarAction","",3,"click"]],template:function(n,r){1&n&&(y(0,"div",0),A(1),w(),R(2,BJ,3,1,"div",1)),2&n&&(x(1),Ue(" ",r.data.message,"\n"),x(1),E("ngIf",r.hasAction))},dependencies:[_i,g1,zJ,UJ,$J],styles:[".mat-mdc-simple-snack-bar{display:flex}"],encapsulation:2,changeDetection:0}),t})();const GJ={snackBarState:ni("state",[qt("void, hidden",je({transform:"scale(0.8)",opacity:0})),qt("visible",je({transform:"scale(1)",opacity:1})),Bt("* => visible",Lt("150ms cubic-bezier(0, 0, 0.2, 1)")),Bt("* => void, * => hidden",Lt("75ms cubic-bezier(0.4, 0.0, 1, 1)",je({opacity:0})))])};let WJ=0,QJ=(()=>{var e;class t extends ey{constructor(n,r,o,s,a){super(),this._ngZone=n,this._elementRef=r,this._changeDetectorRef=o,this._platform=s,this.snackBarConfig=a,this._document=j(he),this._trackedModals=new Set,this._announceDelay=150,this._destroyed=!1,this._onAnnounce=new Y,this._onExit=new Y,this._onEnter=new Y,this._animationState="void",this._liveElementId="mat-snack-bar-container-live-"+WJ++,this.attachDomPortal=c=>{this._assertNotAttached();const l=this._portalOutlet.attachDomPortal(c);return this._afterPortalAttached(),l},this._live="assertive"!==a.politeness||a.announcementMessage?"off"===a.politeness?"off":"polite":"assertive",this._platform.FIREFOX&&("polite"===this._live&&(this._role="status"),"assertive"===this._live&&(this._role="alert"))}attachComponentPortal(n){this._assertNotAttached();const r=this._portalOutlet.attachComponentPortal(n);return this._afterPortalAttached(),r}attachTemplatePortal(n){this._assertNotAttached();const r=this._portalOutlet.attachTemplatePortal(n);return this._afterPortalAttached(),r}onAnimationEnd(n){const{fromState:r,toState:o}=n;if(("void"===o&&"void"!==r||"hidden"===o)&&this._completeExit(),"visible"===o){const s=this._onEnter;this._ngZone.run(()=>{s.next(),s.complete()})}}enter(){this._destroyed||(this._animationState="visible",this._changeDetectorRef.detectChanges(),this._screenReaderAnnounce())}exit(){return this._ngZone.run(()=>{this._animationState="hidden",this._elementRef.nativeElement.setAttribute("mat-exit",""),clearTimeout(this._announceTimeoutId)}),this._onExit}ngOnDestroy(){this._destroyed=!0,this._clearFromModals(),this._completeExit()}_completeExit(){this._ngZone.onMicrotaskEmpty.pipe(Xe(1)).subscribe(()=>{this._ngZone.run(()=>{this._onExit.next(),this._onExit.complete()})})}_afterPortalAttached(){const n=this._elementRef.nativeElement,r=this.snackBarConfig.panelClass;r&&(Array.isArray(r)?r.forEach(o=>n.classList.add(o)):n.classList.add(r)),this._exposeToModals()}_exposeToModals(){const n=this._liveElementId,r=this._document.querySelectorAll('body > .cdk-overlay-container [aria-modal="true"]');for(let o=0;o<r.length;o++){const s=r[o],a=s.getAttribute("aria-owns");this._trackedModals.add(s),a?-1===a.indexOf(n)&&s.setAttribute("aria-owns",a+" "+n):s.setAttribute("aria-owns",n)}}_clearFromModals(){this._trackedModals.forEach(n=>{const r=n.getAttribute("aria-owns");if(r){const o=r.replace(this._liveElementId,"").trim();o.length>0?n.setAttribute("aria-owns",o):n.removeAttribute("aria-owns")}}),this._trackedModals.clear()}_assertNotAttached(){this._portalOutlet.hasAttached()}_screenReaderAnnounce(){this._announceTimeoutId||this._ngZone.runOutsideAngular(()=>{this._announceTimeoutId=setTimeout(()=>{const n=this._elementRef.nativeElement.querySelector("[aria-hidden]"),r=this._elementRef.nativeElement.querySelector("[aria-live]");if(n&&r){let o=null;this._platform.isBrowser&&document.activeElement instanceof HTMLElement&&n.contains(document.activeElement)&&(o=document.activeElement),n.removeAttribute("aria-hidden"),r.appendChild(n),o?.focus(),this._onAnnounce.next(),this._onAnnounce.complete()}},this._announceDelay)})}}return(e=t).\u0275fac=function(n){return new(n||e)(m(G),m(W),m(Fe),m(nt),m(Qp))},e.\u0275dir=T({type:e,viewQuery:function(n,r){if(1&n&&ke(La,7),2&n){let o;H(o=z())&&(r._portalOutlet=o.first)}},features:[P]}),t})(),YJ=(()=>{var e;class t extends QJ{_afterPortalAttached(){super._afterPortalAttached();const n=this._label.nativeElement,r="mdc-snackbar__label";n.classList.toggle(r,!n.querySelector(`.${r}`))}}return(e=t).\u0275fac=function(){let i;return function(r){return(i||(i=be(e)))(r||e)}}(),e.\u0275cmp=fe({type:e,selectors:[["mat-snack-bar-container"]],viewQuery:function(n,r){if(1&n&&ke(VJ,7),2&n){let o;H(o=z())&&(r._label=o.first)}},hostAttrs:[1,"mdc-snackbar","mat-mdc-snack-bar-container","mdc-snackbar--open"],hostVars:1,hostBindings:function(n,r){1&n&&gh("@state.done",function(s){return r.onAnimationEnd(s)}),2&n&&yh("@state",r._animationState)},features:[P],decls:6,vars:3,consts:[[1,"mdc-snackbar__surface"],[1,"mat-mdc-snack-bar-label"],["label",""],["aria-hidden","true"],["cdkPortalOutlet",""]],template:function(n,r){1&n&&(y(0,"div",0)(1,"div",1,2)(3,"div",3),R(4,jJ,0,0,"ng-template",4),w(),ie(5,"div"),w()()),2&n&&(x(5),de("aria-live",r._live)("role",r._role)("id",r._liveElementId))},dependencies:[La],styles:['.mdc-snackbar{display:none;position:fixed;right:0;bottom:0;left:0;align-items:center;justify-content:center;box-sizing:border-box;pointer-events:none;-webkit-tap-highlight-color:rgba(0,0,0,0)}.mdc-snackbar--opening,.mdc-snackbar--open,.mdc-snackbar--closing{display:flex}.mdc-snackbar--open .mdc-snackbar__label,.mdc-snackbar--open .mdc-snackbar__actions{visibility:visible}.mdc-snackbar__surface{padding-left:0;padding-right:8px;display:flex;align-items:center;justify-content:flex-start;box-sizing:border-box;transform:scale(0.8);opacity:0}.mdc-snackbar__surface::before{position:absolute;box-sizing:border-box;width:100%;height:100%;top:0;left:0;border:1px solid rgba(0,0,0,0);border-radius:inherit;content:"";pointer-events:none}@media screen and (forced-colors: active){.mdc-snackbar__surface::before{border-color:CanvasText}}[dir=rtl] .mdc-snackbar__surface,.mdc-snackbar__surface[dir=rtl]{padding-left:8px;padding-right:0}.mdc-snackbar--open .mdc-snackbar__surface{transform:scale(1);opacity:1;pointer-events:auto}.mdc-snackbar--closing .mdc-snackbar__surface{transform:scale(1)}.mdc-snackbar__label{padding-left:16px;padding-right:8px;width:100%;flex-grow:1;box-sizing:border-box;margin:0;visibility:hidden;padding-top:14px;padding-bottom:14px}[dir=rtl] .mdc-snackbar__label,.mdc-snackbar__label[dir=rtl]{padding-left:8px;padding-right:16px}.mdc-snackbar__label::before{display:inline;content:attr(data-mdc-snackbar-label-text)}.mdc-snackbar__actions{display:flex;flex-shrink:0;align-items:center;box-sizing:border-box;visibility:hidden}.mdc-snackbar__action+.mdc-snackbar__dismiss{margin-left:8px;margin-right:0}[dir=rtl] .mdc-snackbar__action+.mdc-snackbar__dismiss,.mdc-snackbar__action+.mdc-snackbar__dismiss[dir=rtl]{margin-left:0;margin-right:8px}.mat-mdc-snack-bar-container{margin:8px;--mdc-snackbar-container-shape:4px;position:static}.mat-mdc-snack-bar-container .mdc-snackbar__surface{min-width:344px}@media(max-width: 480px),(max-width: 344px){.mat-mdc-snack-bar-container .mdc-snackbar__surface{min-width:100%}}@media(max-width: 480px),(max-width: 344px){.mat-mdc-snack-bar-container{width:100vw}}.mat-mdc-snack-bar-container .mdc-snackbar__surface{max-width:672px}.mat-mdc-snack-bar-container .mdc-snackbar__surface{box-shadow:0px 3px 5px -1px rgba(0, 0, 0, 0.2), 0px 6px 10px 0px rgba(0, 0, 0, 0.14), 0px 1px 18px 0px rgba(0, 0, 0, 0.12)}.mat-mdc-snack-bar-container .mdc-snackbar__surface{background-color:var(--mdc-snackbar-container-color)}.mat-mdc-snack-bar-container .mdc-snackbar__surface{border-radius:var(--mdc-snackbar-container-shape)}.mat-mdc-snack-bar-container .mdc-snackbar__label{color:var(--mdc-snackbar-supporting-text-color)}.mat-mdc-snack-bar-container .mdc-snackbar__label{font-size:var(--mdc-snackbar-supporting-text-size);font-family:var(--mdc-snackbar-supporting-text-font);font-weight:var(--mdc-snackbar-supporting-text-weight);line-height:var(--mdc-snackbar-supporting-text-line-height)}.mat-mdc-snack-bar-container .mat-mdc-button.mat-mdc-snack-bar-action:not(:disabled){color:var(--mat-snack-bar-button-color);--mat-mdc-button-persistent-ripple-color: currentColor}.mat-mdc-snack-bar-container .mat-mdc-button.mat-mdc-snack-bar-action:not(:disabled) .mat-ripple-element{background-color:currentColor;opacity:.1}.mat-mdc-snack-bar-container .mdc-snackbar__label::before{display:none}.mat-mdc-snack-bar-handset,.mat-mdc-snack-bar-container,.mat-mdc-snack-bar-label{flex:1 1 auto}.mat-mdc-snack-bar-handset .mdc-snackbar__surface{width:100%}'],encapsulation:2,data:{animation:[GJ.snackBarState]}}),t})(),PF=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275mod=le({type:e}),e.\u0275inj=ae({imports:[ed,qf,vn,jf,ve,ve]}),t})();const NF=new M("mat-snack-bar-default-options",{providedIn:"root",factory:function KJ(){return new Qp}});let XJ=(()=>{var e;class t{get _openedSnackBarRef(){const n=this._parentSnackBar;return n?n._openedSnackBarRef:this._snackBarRefAtThisLevel}set _openedSnackBarRef(n){this._parentSnackBar?this._parentSnackBar._openedSnackBarRef=n:this._snackBarRefAtThisLevel=n}constructor(n,r,o,s,a,c){this._overlay=n,this._live=r,this._injector=o,this._breakpointObserver=s,this._parentSnackBar=a,this._defaultConfig=c,this._snackBarRefAtThisLevel=null}openFromComponent(n,r){return this._attach(n,r)}openFromTemplate(n,r){return this._attach(n,r)}open(n,r="",o){const s={...this._defaultConfig,...o};return s.data={message:n,action:r},s.announcementMessage===n&&(s.announcementMessage=void 0),this.openFromComponent(this.simpleSnackBarComponent,s)}dismiss(){this._openedSnackBarRef&&this._openedSnackBarRef.dismiss()}ngOnDestroy(){this._snackBarRefAtThisLevel&&this._snackBarRefAtThisLevel.dismiss()}_attachSnackBarContainer(n,r){const s=jt.create({parent:r&&r.viewContainerRef&&r.viewContainerRef.injector||this._injector,providers:[{provide:Qp,useValue:r}]}),a=new $f(this.snackBarContainerComponent,r.viewContainerRef,s),c=n.attach(a);return c.instance.snackBarConfig=r,c.instance}_attach(n,r){const o={...new Qp,...this._defaultConfig,...r},s=this._createOverlay(o),a=this._attachSnackBarContainer(s,o),c=new tw(a,s);if(n instanceof ct){const l=new co(n,null,{$implicit:o.data,snackBarRef:c});c.instance=a.attachTemplatePortal(l)}else{const l=this._createInjector(o,c),d=new $f(n,void 0,l),u=a.attachComponentPortal(d);c.instance=u.instance}return this._breakpointObserver.observe("(max-width: 599.98px) and (orientation: portrait)").pipe(ce(s.detachments())).subscribe(l=>{s.overlayElement.classList.toggle(this.handsetCssClass,l.matches)}),o.announcementMessage&&a._onAnnounce.subscribe(()=>{this._live.announce(o.announcementMessage,o.politeness)}),this._animateSnackBar(c,o),this._openedSnackBarRef=c,this._openedSnackBarRef}_animateSnackBar(n,r){n.afterDismissed().subscribe(()=>{this._openedSnackBarRef==n&&(this._openedSnackBarRef=null),r.announcementMessage&&this._live.clear()}),this._openedSnackBarRef?(this._openedSnackBarRef.afterDismissed().subscribe(()=>{n.containerInstance.enter()}),this._openedSnackBarRef.dismiss()):n.containerInstance.enter(),r.duration&&r.duration>0&&n.afterOpened().subscribe(()=>n._dismissAfter(r.duration))}_createOverlay(n){const r=new Jl;r.direction=n.direction;let o=this._overlay.position().global();const s="rtl"===n.direction,a="left"===n.horizontalPosition||"start"===n.horizontalPosition&&!s||"end"===n.horizontalPosition&&s,c=!a&&"center"!==n.horizontalPosition;return a?o.left("0"):c?o.right("0"):o.centerHorizontally(),"top"===n.verticalPosition?o.top("0"):o.bottom("0"),r.positionStrategy=o,this._overlay.create(r)}_createInjector(n,r){return jt.create({parent:n&&n.viewContainerRef&&n.viewContainerRef.injector||this._injector,providers:[{provide:tw,useValue:r},{provide:FF,useValue:n.data}]})}}return(e=t).\u0275fac=function(n){return new(n||e)(D(wi),D(Bv),D(jt),D(Av),D(e,12),D(NF))},e.\u0275prov=V({token:e,factory:e.\u0275fac}),t})(),ZJ=(()=>{var e;class t extends XJ{constructor(n,r,o,s,a,c){super(n,r,o,s,a,c),this.simpleSnackBarComponent=qJ,this.snackBarContainerComponent=YJ,this.handsetCssClass="mat-mdc-snack-bar-handset"}}return(e=t).\u0275fac=function(n){return new(n||e)(D(wi),D(Bv),D(jt),D(Av),D(e,12),D(NF))},e.\u0275prov=V({token:e,factory:e.\u0275fac,providedIn:PF}),t})(),LF=(()=>{var e;class t{constructor(n){this.snackBar=n,this.expiry=1e4}addError(n,r=this.expiry){this.snackBar.open(n,"Dismiss",{duration:r,panelClass:["snack-bar-error"]})}}return(e=t).\u0275fac=function(n){return new(n||e)(D(ZJ))},e.\u0275prov=V({token:e,factory:e.\u0275fac}),t})();class JJ{constructor(t,i){this._document=i;const n=this._textarea=this._document.createElement("textarea"),r=n.style;r.position="fixed",r.top=r.opacity="0",r.left="-999em",n.setAttribute("aria-hidden","true"),n.value=t,n.readOnly=!0,(this._document.fullscreenElement||this._document.body).appendChild(n)}copy(){const t=this._textarea;let i=!1;try{if(t){const n=this._document.activeElement;t.select(),t.setSelectionRange(0,t.value.length),i=this._document.execCommand("copy"),n&&n.focus()}}catch{}return i}destroy(){const t=this._textarea;t&&(t.remove(),this._textarea=void 0)}}let eee=(()=>{var e;class t{constructor(n){this._document=n}copy(n){const r=this.beginCopy(n),o=r.copy();return r.destroy(),o}beginCopy(n){return new JJ(n,this._document)}}return(e=t).\u0275fac=function(n){return new(n||e)(D(he))},e.\u0275prov=V({token:e,factory:e.\u0275fac,providedIn:"root"}),t})();const tee=new M("CDK_COPY_TO_CLIPBOARD_CONFIG");let nee=(()=>{var e;class t{constructor(n,r,o){this._clipboard=n,this._ngZone=r,this.text="",this.attempts=1,this.copied=new U,this._pending=new Set,o&&null!=o.attempts&&(this.attempts=o.attempts)}copy(n=this.attempts){if(n>1){let r=n;const o=this._clipboard.beginCopy(this.text);this._pending.add(o);const s=()=>{const a=o.copy();a||! --r||this._destroyed?(this._currentTimeout=null,this._pending.delete(o),o.destroy(),this.copied.emit(a)):this._currentTimeout=this._ngZone.runOutsideAngular(()=>setTimeout(s,1))};s()}else this.copied.emit(this._clipboard.copy(this.text))}ngOnDestroy(){this._currentTimeout&&clearTimeout(this._currentTimeout),this._pending.forEach(n=>n.destroy()),this._pending.clear(),this._destroyed=!0}}return(e=t).\u0275fac=function(n){return new(n||e)(m(eee),m(G),m(tee,8))},e.\u0275dir=T({type:e,selectors:[["","cdkCopyToClipboard",""]],hostBindings:function(n,r){1&n&&$("click",function(){return r.copy()})},inputs:{text:["cdkCopyToClipboard","text"],attempts:["cdkCopyToClipboardAttempts","attempts"]},outputs:{copied:"cdkCopyToClipboardCopied"}}),t})(),iee=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275mod=le({type:e}),e.\u0275inj=ae({}),t})();class ree extends Y{constructor(t=1/0,i=1/0,n=kv){super(),this._bufferSize=t,this._windowTime=i,this._timestampProvider=n,this._buffer=[],this._infiniteTimeWindow=!0,this._infiniteTimeWindow=i===1/0,this._bufferSize=Math.max(1,t),this._windowTime=Math.max(1,i)}next(t){const{isStopped:i,_buffer:n,_infiniteTimeWindow:r,_timestampProvider:o,_windowTime:s}=this;i||(n.push(t),!r&&n.push(o.now()+s)),this._trimBuffer(),super.next(t)}_subscribe(t){this._throwIfClosed(),this._trimBuffer();const i=this._innerSubscribe(t),{_infiniteTimeWindow:n,_buffer:r}=this,o=r.slice();for(let s=0;s<o.length&&!t.closed;s+=n?1:2)t.next(o[s]);return this._checkFinalizedStatuses(t),i}_trimBuffer(){const{_bufferSize:t,_timestampProvider:i,_buffer:n,_infiniteTimeWindow:r}=this,o=(r?1:2)*t;if(t<1/0&&o<n.length&&n.splice(0,n.length-o),!r){const s=i.now();let a=0;for(let c=1;c<n.length&&n[c]<=s;c+=2)a=c;a&&n.splice(0,a+1)}}}class see{constructor(t){this._box=t,this._destroyed=new Y,this._resizeSubject=new Y,this._elementObservables=new Map,typeof ResizeObserver<"u"&&(this._resizeObserver=new ResizeObserver(i=>this._resizeSubject.next(i)))}observe(t){return this._elementObservables.has(t)||this._elementObservables.set(t,new Me(i=>{const n=this._resizeSubject.subscribe(i);return this._resizeObserver?.observe(t,{box:this._box}),()=>{this._resizeObserver?.unobserve(t),n.unsubscribe(),this._elementObservables.delete(t)}}).pipe(Ve(i=>i.some(n=>n.target===t)),function oee(e,t,i){let n,r=!1;return e&&"object"==typeof e?({bufferSize:n=1/0,windowTime:t=1/0,refCount:r=!1,scheduler:i}=e):n=e??1/0,iu({connector:()=>new ree(n,t,i),resetOnError:!0,resetOnComplete:!1,resetOnRefCountZero:r})}({bufferSize:1,refCount:!0}),ce(this._destroyed))),this._elementObservables.get(t)}destroy(){this._destroyed.next(),this._destroyed.complete(),this._resizeSubject.complete(),this._elementObservables.clear()}}let aee=(()=>{var e;class t{constructor(){this._observers=new Map,this._ngZone=j(G)}ngOnDestroy(){for(const[,n]of this._observers)n.destroy();this._observers.clear()}observe(n,r){const o=r?.box||"content-box";return this._observers.has(o)||this._observers.set(o,new see(o)),this._observers.get(o).observe(n)}}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275prov=V({token:e,factory:e.\u0275fac,providedIn:"root"}),t})();const cee=["notch"],lee=["matFormFieldNotchedOutline",""],dee=["*"],uee=["textField"],hee=["iconPrefixContainer"],fee=["textPrefixContainer"];function pee(e,t){1&e&&ie(0,"span",19)}function mee(e,t){if(1&e&&(y(0,"label",17),X(1,1),R(2,pee,1,0,"span",18),w()),2&e){const i=B(2);E("floating",i._shouldLabelFloat())("monitorResize",i._hasOutline())("id",i._labelId),de("for",i._control.id),x(2),E("ngIf",!i.hideRequiredMarker&&i._control.required)}}function gee(e,t){1&e&&R(0,mee,3,5,"label",16),2&e&&E("ngIf",B()._hasFloatingLabel())}function _ee(e,t){1&e&&ie(0,"div",20)}function bee(e,t){}function vee(e,t){1&e&&R(0,bee,0,0,"ng-template",22),2&e&&(B(2),E("ngTemplateOutlet",hn(1)))}function yee(e,t){if(1&e&&(y(0,"div",21),R(1,vee,1,1,"ng-template",9),w()),2&e){const i=B();E("matFormFieldNotchedOutlineOpen",i._shouldLabelFloat()),x(1),E("ngIf",!i._forceDisplayInfixLabel())}}function wee(e,t){1&e&&(y(0,"div",23,24),X(2,2),w())}function xee(e,t){1&e&&(y(0,"div",25,26),X(2,3),w())}function Cee(e,t){}function Dee(e,t){1&e&&R(0,Cee,0,0,"ng-template",22),2&e&&(B(),E("ngTemplateOutlet",hn(1)))}function Eee(e,t){1&e&&(y(0,"div",27),X(1,4),w())}function See(e,t){1&e&&(y(0,"div",28),X(1,5),w())}function kee(e,t){1&e&&ie(0,"div",29)}function Tee(e,t){1&e&&(y(0,"div",30),X(1,6),w()),2&e&&E("@transitionMessages",B()._subscriptAnimationState)}function Mee(e,t){if(1&e&&(y(0,"mat-hint",34),A(1),w()),2&e){const i=B(2);E("id",i._hintLabelId),x(1),bt(i.hintLabel)}}function Iee(e,t){if(1&e&&(y(0,"div",31),R(1,Mee,2,2,"mat-hint",32),X(2,7),ie(3,"div",33),X(4,8),w()),2&e){const i=B();E("@transitionMessages",i._subscriptAnimationState),x(1),E("ngIf",i.hintLabel)}}const Aee=["*",[["mat-label"]],[["","matPrefix",""],["","matIconPrefix",""]],[["","matTextPrefix",""]],[["","matTextSuffix",""]],[["","matSuffix",""],["","matIconSuffix",""]],[["mat-error"],["","matError",""]],[["mat-hint",3,"align","end"]],[["mat-hint","align","end"]]],Ree=["*","mat-label","[matPrefix], [matIconPrefix]","[matTextPrefix]","[matTextSuffix]","[matSuffix], [matIconSuffix]","mat-error, [matError]","mat-hint:not([align='end'])","mat-hint[align='end']"];let nw=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275dir=T({type:e,selectors:[["mat-label"]]}),t})();const Oee=new M("MatError");let Fee=0,BF=(()=>{var e;class t{constructor(){this.align="start",this.id="mat-mdc-hint-"+Fee++}}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275dir=T({type:e,selectors:[["mat-hint"]],hostAttrs:[1,"mat-mdc-form-field-hint","mat-mdc-form-field-bottom-align"],hostVars:4,hostBindings:function(n,r){2&n&&(pi("id",r.id),de("align",null),se("mat-mdc-form-field-hint-end","end"===r.align))},inputs:{align:"align",id:"id"}}),t})();const Pee=new M("MatPrefix"),VF=new M("MatSuffix");let Nee=(()=>{var e;class t{constructor(){this._isText=!1}set _isTextSelector(n){this._isText=!0}}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275dir=T({type:e,selectors:[["","matSuffix",""],["","matIconSuffix",""],["","matTextSuffix",""]],inputs:{_isTextSelector:["matTextSuffix","_isTextSelector"]},features:[Z([{provide:VF,useExisting:e}])]}),t})();const jF=new M("FloatingLabelParent");let HF=(()=>{var e;class t{get floating(){return this._floating}set floating(n){this._floating=n,this.monitorResize&&this._handleResize()}get monitorResize(){return this._monitorResize}set monitorResize(n){this._monitorResize=n,this._monitorResize?this._subscribeToResize():this._resizeSubscription.unsubscribe()}constructor(n){this._elementRef=n,this._floating=!1,this._monitorResize=!1,this._resizeObserver=j(aee),this._ngZone=j(G),this._parent=j(jF),this._resizeSubscription=new Ae}ngOnDestroy(){this._resizeSubscription.unsubscribe()}getWidth(){return function Lee(e){if(null!==e.offsetParent)return e.scrollWidth;const i=e.cloneNode(!0);i.style.setProperty("position","absolute"),i.style.setProperty("transform","translate(-9999px, -9999px)"),document.documentElement.appendChild(i);const n=i.scrollWidth;return i.remove(),n}(this._elementRef.nativeElement)}get element(){return this._elementRef.nativeElement}_handleResize(){setTimeout(()=>this._parent._handleLabelResized())}_subscribeToResize(){this._resizeSubscription.unsubscribe(),this._ngZone.runOutsideAngular(()=>{this._resizeSubscription=this._resizeObserver.observe(this._elementRef.nativeElement,{box:"border-box"}).subscribe(()=>this._handleResize())})}}return(e=t).\u0275fac=function(n){return new(n||e)(m(W))},e.\u0275dir=T({type:e,selectors:[["label","matFormFieldFloatingLabel",""]],hostAttrs:[1,"mdc-floating-label","mat-mdc-floating-label"],hostVars:2,hostBindings:function(n,r){2&n&&se("mdc-floating-label--float-above",r.floating)},inputs:{floating:"floating",monitorResize:"monitorResize"}}),t})();const zF="mdc-line-ripple--active",Yp="mdc-line-ripple--deactivating";let UF=(()=>{var e;class t{constructor(n,r){this._elementRef=n,this._handleTransitionEnd=o=>{const s=this._elementRef.nativeElement.classList,a=s.contains(Yp);"opacity"===o.propertyName&&a&&s.remove(zF,Yp)},r.runOutsideAngular(()=>{n.nativeElement.addEventListener("transitionend",this._handleTransitionEnd)})}activate(){const n=this._elementRef.nativeElement.classList;n.remove(Yp),n.add(zF)}deactivate(){this._elementRef.nativeElement.classList.add(Yp)}ngOnDestroy(){this._elementRef.nativeElement.removeEventListener("transitionend",this._handleTransitionEnd)}}return(e=t).\u0275fac=function(n){return new(n||e)(m(W),m(G))},e.\u0275dir=T({type:e,selectors:[["div","matFormFieldLineRipple",""]],hostAttrs:[1,"mdc-line-ripple"]}),t})(),$F=(()=>{var e;class t{constructor(n,r){this._elementRef=n,this._ngZone=r,this.open=!1}ngAfterViewInit(){const n=this._elementRef.nativeElement.querySelector(".mdc-floating-label");n?(this._elementRef.nativeElement.classList.add("mdc-notched-outline--upgraded"),"function"==typeof requestAnimationFrame&&(n.style.transitionDuration="0s",this._ngZone.runOutsideAngular(()=>{requestAnimationFrame(()=>n.style.transitionDuration="")}))):this._elementRef.nativeElement.classList.add("mdc-notched-outline--no-label")}_setNotchWidth(n){this._notch.nativeElement.style.width=this.open&&n?`calc(${n}px * var(--mat-mdc-form-field-floating-label-scale, 0.75) + 9px)`:""}}return(e=t).\u0275fac=function(n){return new(n||e)(m(W),m(G))},e.\u0275cmp=fe({type:e,selectors:[["div","matFormFieldNotchedOutline",""]],viewQuery:function(n,r){if(1&n&&ke(cee,5),2&n){let o;H(o=z())&&(r._notch=o.first)}},hostAttrs:[1,"mdc-notched-outline"],hostVars:2,hostBindings:function(n,r){2&n&&se("mdc-notched-outline--notched",r.open)},inputs:{open:["matFormFieldNotchedOutlineOpen","open"]},attrs:lee,ngContentSelectors:dee,decls:5,vars:0,consts:[[1,"mdc-notched-outline__leading"],[1,"mdc-notched-outline__notch"],["notch",""],[1,"mdc-notched-outline__trailing"]],template:function(n,r){1&n&&(ze(),ie(0,"div",0),y(1,"div",1,2),X(3),w(),ie(4,"div",3))},encapsulation:2,changeDetection:0}),t})();const Bee={transitionMessages:ni("transitionMessages",[qt("enter",je({opacity:1,transform:"translateY(0%)"})),Bt("void => enter",[je({opacity:0,transform:"translateY(-5px)"}),Lt("300ms cubic-bezier(0.55, 0, 0.55, 0.2)")])])};let Kp=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275dir=T({type:e}),t})();const Vd=new M("MatFormField"),Vee=new M("MAT_FORM_FIELD_DEFAULT_OPTIONS");let qF=0,QF=(()=>{var e;class t{get hideRequiredMarker(){return this._hideRequiredMarker}set hideRequiredMarker(n){this._hideRequiredMarker=Q(n)}get floatLabel(){return this._floatLabel||this._defaults?.floatLabel||"auto"}set floatLabel(n){n!==this._floatLabel&&(this._floatLabel=n,this._changeDetectorRef.markForCheck())}get appearance(){return this._appearance}set appearance(n){const r=this._appearance;this._appearance=n||this._defaults?.appearance||"fill","outline"===this._appearance&&this._appearance!==r&&(this._needsOutlineLabelOffsetUpdateOnStable=!0)}get subscriptSizing(){return this._subscriptSizing||this._defaults?.subscriptSizing||"fixed"}set subscriptSizing(n){this._subscriptSizing=n||this._defaults?.subscriptSizing||"fixed"}get hintLabel(){return this._hintLabel}set hintLabel(n){this._hintLabel=n,this._processHints()}get _control(){return this._explicitFormFieldControl||this._formFieldControl}set _control(n){this._explicitFormFieldControl=n}constructor(n,r,o,s,a,c,l,d){this._elementRef=n,this._changeDetectorRef=r,this._ngZone=o,this._dir=s,this._platform=a,this._defaults=c,this._animationMode=l,this._hideRequiredMarker=!1,this.color="primary",this._appearance="fill",this._subscriptSizing=null,this._hintLabel="",this._hasIconPrefix=!1,this._hasTextPrefix=!1,this._hasIconSuffix=!1,this._hasTextSuffix=!1,this._labelId="mat-mdc-form-field-label-"+qF++,this._hintLabelId="mat-mdc-hint-"+qF++,this._subscriptAnimationState="",this._destroyed=new Y,this._isFocused=null,this._needsOutlineLabelOffsetUpdateOnStable=!1,c&&(c.appearance&&(this.appearance=c.appearance),this._hideRequiredMarker=!!c?.hideRequiredMarker,c.color&&(this.color=c.color))}ngAfterViewInit(){this._updateFocusState(),this._subscriptAnimationState="enter",this._changeDetectorRef.detectChanges()}ngAfterContentInit(){this._assertFormFieldControl(),this._initializeControl(),this._initializeSubscript(),this._initializePrefixAndSuffix(),this._initializeOutlineLabelOffsetSubscriptions()}ngAfterContentChecked(){this._assertFormFieldControl()}ngOnDestroy(){this._destroyed.next(),this._destroyed.complete()}getLabelId(){return this._hasFloatingLabel()?this._labelId:null}getConnectedOverlayOrigin(){return this._textField||this._elementRef}_animateAndLockLabel(){this._hasFloatingLabel()&&(this.floatLabel="always")}_initializeControl(){const n=this._control;n.controlType&&this._elementRef.nativeElement.classList.add(`mat-mdc-form-field-type-${n.controlType}`),n.stateChanges.subscribe(()=>{this._updateFocusState(),this._syncDescribedByIds(),this._changeDetectorRef.markForCheck()}),n.ngControl&&n.ngControl.valueChanges&&n.ngControl.valueChanges.pipe(ce(this._destroyed)).subscribe(()=>this._changeDetectorRef.markForCheck())}_checkPrefixAndSuffixTypes(){this._hasIconPrefix=!!this._prefixChildren.find(n=>!n._isText),this._hasTextPrefix=!!this._prefixChildren.find(n=>n._isText),this._hasIconSuffix=!!this._suffixChildren.find(n=>!n._isText),this._hasTextSuffix=!!this._suffixChildren.find(n=>n._isText)}_initializePrefixAndSuffix(){this._checkPrefixAndSuffixTypes(),Ot(this._prefixChildren.changes,this._suffixChildren.changes).subscribe(()=>{this._checkPrefixAndSuffixTypes(),this._changeDetectorRef.markForCheck()})}_initializeSubscript(){this._hintChildren.changes.subscribe(()=>{this._processHints(),this._changeDetectorRef.markForCheck()}),this._errorChildren.changes.subscribe(()=>{this._syncDescribedByIds(),this._changeDetectorRef.markForCheck()}),this._validateHints(),this._syncDescribedByIds()}_assertFormFieldControl(){}_updateFocusState(){this._control.focused&&!this._isFocused?(this._isFocused=!0,this._lineRipple?.activate()):!this._control.focused&&(this._isFocused||null===this._isFocused)&&(this._isFocused=!1,this._lineRipple?.deactivate()),this._textField?.nativeElement.classList.toggle("mdc-text-field--focused",this._control.focused)}_initializeOutlineLabelOffsetSubscriptions(){this._prefixChildren.changes.subscribe(()=>this._needsOutlineLabelOffsetUpdateOnStable=!0),this._ngZone.runOutsideAngular(()=>{this._ngZone.onStable.pipe(ce(this._destroyed)).subscribe(()=>{this._needsOutlineLabelOffsetUpdateOnStable&&(this._needsOutlineLabelOffsetUpdateOnStable=!1,this._updateOutlineLabelOffset())})}),this._dir.change.pipe(ce(this._destroyed)).subscribe(()=>this._needsOutlineLabelOffsetUpdateOnStable=!0)}_shouldAlwaysFloat(){return"always"===this.floatLabel}_hasOutline(){return"outline"===this.appearance}_forceDisplayInfixLabel(){return!this._platform.isBrowser&&this._prefixChildren.length&&!this._shouldLabelFloat()}_hasFloatingLabel(){return!!this._labelChildNonStatic||!!this._labelChildStatic}_shouldLabelFloat(){return this._control.shouldLabelFloat||this._shouldAlwaysFloat()}_shouldForward(n){const r=this._control?this._control.ngControl:null;return r&&r[n]}_getDisplayedMessages(){return this._errorChildren&&this._errorChildren.length>0&&this._control.errorState?"error":"hint"}_handleLabelResized(){this._refreshOutlineNotchWidth()}_refreshOutlineNotchWidth(){this._hasOutline()&&this._floatingLabel&&this._shouldLabelFloat()?this._notchedOutline?._setNotchWidth(this._floatingLabel.getWidth()):this._notchedOutline?._setNotchWidth(0)}_processHints(){this._validateHints(),this._syncDescribedByIds()}_validateHints(){}_syncDescribedByIds(){if(this._control){let n=[];if(this._control.userAriaDescribedBy&&"string"==typeof this._control.userAriaDescribedBy&&n.push(...this._control.userAriaDescribedBy.split(" ")),"hint"===this._getDisplayedMessages()){const r=this._hintChildren?this._hintChildren.find(s=>"start"===s.align):null,o=this._hintChildren?this._hintChildren.find(s=>"end"===s.align):null;r?n.push(r.id):this._hintLabel&&n.push(this._hintLabelId),o&&n.push(o.id)}else this._errorChildren&&n.push(...this._errorChildren.map(r=>r.id));this._control.setDescribedByIds(n)}}_updateOutlineLabelOffset(){if(!this._platform.isBrowser||!this._hasOutline()||!this._floatingLabel)return;const n=this._floatingLabel.element;if(!this._iconPrefixContainer&&!this._textPrefixContainer)return void(n.style.transform="");if(!this._isAttachedToDom())return void(this._needsOutlineLabelOffsetUpdateOnStable=!0);const r=this._iconPrefixContainer?.nativeElement,o=this._textPrefixContainer?.nativeElement,s=r?.getBoundingClientRect().width??0,a=o?.getBoundingClientRect().width??0;n.style.transform=`var(\n        --mat-mdc-form-field-label-transform,\n        translateY(-50%) translateX(calc(${"rtl"===this._dir.value?"-1":"1"} * (${s+a}px + var(--mat-mdc-form-field-label-offset-x, 0px))))\n    )`}_isAttachedToDom(){const n=this._elementRef.nativeElement;if(n.getRootNode){const r=n.getRootNode();return r&&r!==n}return document.documentElement.contains(n)}}return(e=t).\u0275fac=function(n){return new(n||e)(m(W),m(Fe),m(G),m(fn),m(nt),m(Vee,8),m(_t,8),m(he))},e.\u0275cmp=fe({type:e,selectors:[["mat-form-field"]],contentQueries:function(n,r,o){if(1&n&&(Ee(o,nw,5),Ee(o,nw,7),Ee(o,Kp,5),Ee(o,Pee,5),Ee(o,VF,5),Ee(o,Oee,5),Ee(o,BF,5)),2&n){let s;H(s=z())&&(r._labelChildNonStatic=s.first),H(s=z())&&(r._labelChildStatic=s.first),H(s=z())&&(r._formFieldControl=s.first),H(s=z())&&(r._prefixChildren=s),H(s=z())&&(r._suffixChildren=s),H(s=z())&&(r._errorChildren=s),H(s=z())&&(r._hintChildren=s)}},viewQuery:function(n,r){if(1&n&&(ke(uee,5),ke(hee,5),ke(fee,5),ke(HF,5),ke($F,5),ke(UF,5)),2&n){let o;H(o=z())&&(r._textField=o.first),H(o=z())&&(r._iconPrefixContainer=o.first),H(o=z())&&(r._textPrefixContainer=o.first),H(o=z())&&(r._floatingLabel=o.first),H(o=z())&&(r._notchedOutline=o.first),H(o=z())&&(r._lineRipple=o.first)}},hostAttrs:[1,"mat-mdc-form-field"],hostVars:42,hostBindings:function(n,r){2&n&&se("mat-mdc-form-field-label-always-float",r._shouldAlwaysFloat())("mat-mdc-form-field-has-icon-prefix",r._hasIconPrefix)("mat-mdc-form-field-has-icon-suffix",r._hasIconSuffix)("mat-form-field-invalid",r._control.errorState)("mat-form-field-disabled",r._control.disabled)("mat-form-field-autofilled",r._control.autofilled)("mat-form-field-no-animations","NoopAnimations"===r._animationMode)("mat-form-field-appearance-fill","fill"==r.appearance)("mat-form-field-appearance-outline","outline"==r.appearance)("mat-form-field-hide-placeholder",r._hasFloatingLabel()&&!r._shouldLabelFloat())("mat-focused",r._control.focused)("mat-primary","accent"!==r.color&&"warn"!==r.color)("mat-accent","accent"===r.color)("mat-warn","warn"===r.color)("ng-untouched",r._shouldForward("untouched"))("ng-touched",r._shouldForward("touched"))("ng-pristine",r._shouldForward("pristine"))("ng-dirty",r._shouldForward("dirty"))("ng-valid",r._shouldForward("valid"))("ng-invalid",r._shouldForward("invalid"))("ng-pending",r._shouldForward("pending"))},inputs:{hideRequiredMarker:"hideRequiredMarker",color:"color",floatLabel:"floatLabel",appearance:"appearance",subscriptSizing:"subscriptSizing",hintLabel:"hintLabel"},exportAs:["matFormField"],features:[Z([{provide:Vd,useExisting:e},{provide:jF,useExisting:e}])],ngContentSelectors:Ree,decls:18,vars:23,consts:[["labelTemplate",""],[1,"mat-mdc-text-field-wrapper","mdc-text-field",3,"click"],["textField",""],["class","mat-mdc-form-field-focus-overlay",4,"ngIf"],[1,"mat-mdc-form-field-flex"],["matFormFieldNotchedOutline","",3,"matFormFieldNotchedOutlineOpen",4,"ngIf"],["class","mat-mdc-form-field-icon-prefix",4,"ngIf"],["class","mat-mdc-form-field-text-prefix",4,"ngIf"],[1,"mat-mdc-form-field-infix"],[3,"ngIf"],["class","mat-mdc-form-field-text-suffix",4,"ngIf"],["class","mat-mdc-form-field-icon-suffix",4,"ngIf"],["matFormFieldLineRipple","",4,"ngIf"],[1,"mat-mdc-form-field-subscript-wrapper","mat-mdc-form-field-bottom-align",3,"ngSwitch"],["class","mat-mdc-form-field-error-wrapper",4,"ngSwitchCase"],["class","mat-mdc-form-field-hint-wrapper",4,"ngSwitchCase"],["matFormFieldFloatingLabel","",3,"floating","monitorResize","id",4,"ngIf"],["matFormFieldFloatingLabel","",3,"floating","monitorResize","id"],["aria-hidden","true","class","mat-mdc-form-field-required-marker mdc-floating-label--required",4,"ngIf"],["aria-hidden","true",1,"mat-mdc-form-field-required-marker","mdc-floating-label--required"],[1,"mat-mdc-form-field-focus-overlay"],["matFormFieldNotchedOutline","",3,"matFormFieldNotchedOutlineOpen"],[3,"ngTemplateOutlet"],[1,"mat-mdc-form-field-icon-prefix"],["iconPrefixContainer",""],[1,"mat-mdc-form-field-text-prefix"],["textPrefixContainer",""],[1,"mat-mdc-form-field-text-suffix"],[1,"mat-mdc-form-field-icon-suffix"],["matFormFieldLineRipple",""],[1,"mat-mdc-form-field-error-wrapper"],[1,"mat-mdc-form-field-hint-wrapper"],[3,"id",4,"ngIf"],[1,"mat-mdc-form-field-hint-spacer"],[3,"id"]],template:function(n,r){1&n&&(ze(Aee),R(0,gee,1,1,"ng-template",null,0,kh),y(2,"div",1,2),$("click",function(s){return r._control.onContainerClick(s)}),R(4,_ee,1,0,"div",3),y(5,"div",4),R(6,yee,2,2,"div",5),R(7,wee,3,0,"div",6),R(8,xee,3,0,"div",7),y(9,"div",8),R(10,Dee,1,1,"ng-template",9),X(11),w(),R(12,Eee,2,0,"div",10),R(13,See,2,0,"div",11),w(),R(14,kee,1,0,"div",12),w(),y(15,"div",13),R(16,Tee,2,1,"div",14),R(17,Iee,5,2,"div",15),w()),2&n&&(x(2),se("mdc-text-field--filled",!r._hasOutline())("mdc-text-field--outlined",r._hasOutline())("mdc-text-field--no-label",!r._hasFloatingLabel())("mdc-text-field--disabled",r._control.disabled)("mdc-text-field--invalid",r._control.errorState),x(2),E("ngIf",!r._hasOutline()&&!r._control.disabled),x(2),E("ngIf",r._hasOutline()),x(1),E("ngIf",r._hasIconPrefix),x(1),E("ngIf",r._hasTextPrefix),x(2),E("ngIf",!r._hasOutline()||r._forceDisplayInfixLabel()),x(2),E("ngIf",r._hasTextSuffix),x(1),E("ngIf",r._hasIconSuffix),x(1),E("ngIf",!r._hasOutline()),x(1),se("mat-mdc-form-field-subscript-dynamic-size","dynamic"===r.subscriptSizing),E("ngSwitch",r._getDisplayedMessages()),x(1),E("ngSwitchCase","error"),x(1),E("ngSwitchCase","hint"))},dependencies:[_i,QT,Sa,Qh,BF,HF,$F,UF],styles:['.mdc-text-field{border-top-left-radius:4px;border-top-left-radius:var(--mdc-shape-small, 4px);border-top-right-radius:4px;border-top-right-radius:var(--mdc-shape-small, 4px);border-bottom-right-radius:0;border-bottom-left-radius:0;display:inline-flex;align-items:baseline;padding:0 16px;position:relative;box-sizing:border-box;overflow:hidden;will-change:opacity,transform,color}.mdc-text-field .mdc-floating-label{top:50%;transform:translateY(-50%);pointer-events:none}.mdc-text-field__input{height:28px;width:100%;min-width:0;border:none;border-radius:0;background:none;appearance:none;padding:0}.mdc-text-field__input::-ms-clear{display:none}.mdc-text-field__input::-webkit-calendar-picker-indicator{display:none}.mdc-text-field__input:focus{outline:none}.mdc-text-field__input:invalid{box-shadow:none}@media all{.mdc-text-field__input::placeholder{opacity:0}}@media all{.mdc-text-field__input:-ms-input-placeholder{opacity:0}}@media all{.mdc-text-field--no-label .mdc-text-field__input::placeholder,.mdc-text-field--focused .mdc-text-field__input::placeholder{opacity:1}}@media all{.mdc-text-field--no-label .mdc-text-field__input:-ms-input-placeholder,.mdc-text-field--focused .mdc-text-field__input:-ms-input-placeholder{opacity:1}}.mdc-text-field__affix{height:28px;opacity:0;white-space:nowrap}.mdc-text-field--label-floating .mdc-text-field__affix,.mdc-text-field--no-label .mdc-text-field__affix{opacity:1}@supports(-webkit-hyphens: none){.mdc-text-field--outlined .mdc-text-field__affix{align-items:center;align-self:center;display:inline-flex;height:100%}}.mdc-text-field__affix--prefix{padding-left:0;padding-right:2px}[dir=rtl] .mdc-text-field__affix--prefix,.mdc-text-field__affix--prefix[dir=rtl]{padding-left:2px;padding-right:0}.mdc-text-field--end-aligned .mdc-text-field__affix--prefix{padding-left:0;padding-right:12px}[dir=rtl] .mdc-text-field--end-aligned .mdc-text-field__affix--prefix,.mdc-text-field--end-aligned .mdc-text-field__affix--prefix[dir=rtl]{padding-left:12px;padding-right:0}.mdc-text-field__affix--suffix{padding-left:12px;padding-right:0}[dir=rtl] .mdc-text-field__affix--suffix,.mdc-text-field__affix--suffix[dir=rtl]{padding-left:0;padding-right:12px}.mdc-text-field--end-aligned .mdc-text-field__affix--suffix{padding-left:2px;padding-right:0}[dir=rtl] .mdc-text-field--end-aligned .mdc-text-field__affix--suffix,.mdc-text-field--end-aligned .mdc-text-field__affix--suffix[dir=rtl]{padding-left:0;padding-right:2px}.mdc-text-field--filled{height:56px}.mdc-text-field--filled::before{display:inline-block;width:0;height:40px;content:"";vertical-align:0}.mdc-text-field--filled .mdc-floating-label{left:16px;right:initial}[dir=rtl] .mdc-text-field--filled .mdc-floating-label,.mdc-text-field--filled .mdc-floating-label[dir=rtl]{left:initial;right:16px}.mdc-text-field--filled .mdc-floating-label--float-above{transform:translateY(-106%) scale(0.75)}.mdc-text-field--filled.mdc-text-field--no-label .mdc-text-field__input{height:100%}.mdc-text-field--filled.mdc-text-field--no-label .mdc-floating-label{display:none}.mdc-text-field--filled.mdc-text-field--no-label::before{display:none}@supports(-webkit-hyphens: none){.mdc-text-field--filled.mdc-text-field--no-label .mdc-text-field__affix{align-items:center;align-self:center;display:inline-flex;height:100%}}.mdc-text-field--outlined{height:56px;overflow:visible}.mdc-text-field--outlined .mdc-floating-label--float-above{transform:translateY(-37.25px) scale(1)}.mdc-text-field--outlined .mdc-floating-label--float-above{font-size:.75rem}.mdc-text-field--outlined.mdc-notched-outline--upgraded .mdc-floating-label--float-above,.mdc-text-field--outlined .mdc-notched-outline--upgraded .mdc-floating-label--float-above{transform:translateY(-34.75px) scale(0.75)}.mdc-text-field--outlined.mdc-notched-outline--upgraded .mdc-floating-label--float-above,.mdc-text-field--outlined .mdc-notched-outline--upgraded .mdc-floating-label--float-above{font-size:1rem}.mdc-text-field--outlined .mdc-text-field__input{height:100%}.mdc-text-field--outlined .mdc-notched-outline .mdc-notched-outline__leading{border-top-left-radius:4px;border-top-left-radius:var(--mdc-shape-small, 4px);border-top-right-radius:0;border-bottom-right-radius:0;border-bottom-left-radius:4px;border-bottom-left-radius:var(--mdc-shape-small, 4px)}[dir=rtl] .mdc-text-field--outlined .mdc-notched-outline .mdc-notched-outline__leading,.mdc-text-field--outlined .mdc-notched-outline .mdc-notched-outline__leading[dir=rtl]{border-top-left-radius:0;border-top-right-radius:4px;border-top-right-radius:var(--mdc-shape-small, 4px);border-bottom-right-radius:4px;border-bottom-right-radius:var(--mdc-shape-small, 4px);border-bottom-left-radius:0}@supports(top: max(0%)){.mdc-text-field--outlined .mdc-notched-outline .mdc-notched-outline__leading{width:max(12px, var(--mdc-shape-small, 4px))}}@supports(top: max(0%)){.mdc-text-field--outlined .mdc-notched-outline .mdc-notched-outline__notch{max-width:calc(100% - max(12px, var(--mdc-shape-small, 4px))*2)}}.mdc-text-field--outlined .mdc-notched-outline .mdc-notched-outline__trailing{border-top-left-radius:0;border-top-right-radius:4px;border-top-right-radius:var(--mdc-shape-small, 4px);border-bottom-right-radius:4px;border-bottom-right-radius:var(--mdc-shape-small, 4px);border-bottom-left-radius:0}[dir=rtl] .mdc-text-field--outlined .mdc-notched-outline .mdc-notched-outline__trailing,.mdc-text-field--outlined .mdc-notched-outline .mdc-notched-outline__trailing[dir=rtl]{border-top-left-radius:4px;border-top-left-radius:var(--mdc-shape-small, 4px);border-top-right-radius:0;border-bottom-right-radius:0;border-bottom-left-radius:4px;border-bottom-left-radius:var(--mdc-shape-small, 4px)}@supports(top: max(0%)){.mdc-text-field--outlined{padding-left:max(16px, calc(var(--mdc-shape-small, 4px) + 4px))}}@supports(top: max(0%)){.mdc-text-field--outlined{padding-right:max(16px, var(--mdc-shape-small, 4px))}}@supports(top: max(0%)){.mdc-text-field--outlined+.mdc-text-field-helper-line{padding-left:max(16px, calc(var(--mdc-shape-small, 4px) + 4px))}}@supports(top: max(0%)){.mdc-text-field--outlined+.mdc-text-field-helper-line{padding-right:max(16px, var(--mdc-shape-small, 4px))}}.mdc-text-field--outlined.mdc-text-field--with-leading-icon{padding-left:0}@supports(top: max(0%)){.mdc-text-field--outlined.mdc-text-field--with-leading-icon{padding-right:max(16px, var(--mdc-shape-small, 4px))}}[dir=rtl] .mdc-text-field--outlined.mdc-text-field--with-leading-icon,.mdc-text-field--outlined.mdc-text-field--with-leading-icon[dir=rtl]{padding-right:0}@supports(top: max(0%)){[dir=rtl] .mdc-text-field--outlined.mdc-text-field--with-leading-icon,.mdc-text-field--outlined.mdc-text-field--with-leading-icon[dir=rtl]{padding-left:max(16px, var(--mdc-shape-small, 4px))}}.mdc-text-field--outlined.mdc-text-field--with-trailing-icon{padding-right:0}@supports(top: max(0%)){.mdc-text-field--outlined.mdc-text-field--with-trailing-icon{padding-left:max(16px, calc(var(--mdc-shape-small, 4px) + 4px))}}[dir=rtl] .mdc-text-field--outlined.mdc-text-field--with-trailing-icon,.mdc-text-field--outlined.mdc-text-field--with-trailing-icon[dir=rtl]{padding-left:0}@supports(top: max(0%)){[dir=rtl] .mdc-text-field--outlined.mdc-text-field--with-trailing-icon,.mdc-text-field--outlined.mdc-text-field--with-trailing-icon[dir=rtl]{padding-right:max(16px, calc(var(--mdc-shape-small, 4px) + 4px))}}.mdc-text-field--outlined.mdc-text-field--with-leading-icon.mdc-text-field--with-trailing-icon{padding-left:0;padding-right:0}.mdc-text-field--outlined .mdc-notched-outline--notched .mdc-notched-outline__notch{padding-top:1px}.mdc-text-field--outlined .mdc-floating-label{left:4px;right:initial}[dir=rtl] .mdc-text-field--outlined .mdc-floating-label,.mdc-text-field--outlined .mdc-floating-label[dir=rtl]{left:initial;right:4px}.mdc-text-field--outlined .mdc-text-field__input{display:flex;border:none !important;background-color:rgba(0,0,0,0)}.mdc-text-field--outlined .mdc-notched-outline{z-index:1}.mdc-text-field--textarea{flex-direction:column;align-items:center;width:auto;height:auto;padding:0}.mdc-text-field--textarea .mdc-floating-label{top:19px}.mdc-text-field--textarea .mdc-floating-label:not(.mdc-floating-label--float-above){transform:none}.mdc-text-field--textarea .mdc-text-field__input{flex-grow:1;height:auto;min-height:1.5rem;overflow-x:hidden;overflow-y:auto;box-sizing:border-box;resize:none;padding:0 16px}.mdc-text-field--textarea.mdc-text-field--filled::before{display:none}.mdc-text-field--textarea.mdc-text-field--filled .mdc-floating-label--float-above{transform:translateY(-10.25px) scale(0.75)}.mdc-text-field--textarea.mdc-text-field--filled .mdc-text-field__input{margin-top:23px;margin-bottom:9px}.mdc-text-field--textarea.mdc-text-field--filled.mdc-text-field--no-label .mdc-text-field__input{margin-top:16px;margin-bottom:16px}.mdc-text-field--textarea.mdc-text-field--outlined .mdc-notched-outline--notched .mdc-notched-outline__notch{padding-top:0}.mdc-text-field--textarea.mdc-text-field--outlined .mdc-floating-label--float-above{transform:translateY(-27.25px) scale(1)}.mdc-text-field--textarea.mdc-text-field--outlined .mdc-floating-label--float-above{font-size:.75rem}.mdc-text-field--textarea.mdc-text-field--outlined.mdc-notched-outline--upgraded .mdc-floating-label--float-above,.mdc-text-field--textarea.mdc-text-field--outlined .mdc-notched-outline--upgraded .mdc-floating-label--float-above{transform:translateY(-24.75px) scale(0.75)}.mdc-text-field--textarea.mdc-text-field--outlined.mdc-notched-outline--upgraded .mdc-floating-label--float-above,.mdc-text-field--textarea.mdc-text-field--outlined .mdc-notched-outline--upgraded .mdc-floating-label--float-above{font-size:1rem}.mdc-text-field--textarea.mdc-text-field--outlined .mdc-text-field__input{margin-top:16px;margin-bottom:16px}.mdc-text-field--textarea.mdc-text-field--outlined .mdc-floating-label{top:18px}.mdc-text-field--textarea.mdc-text-field--with-internal-counter .mdc-text-field__input{margin-bottom:2px}.mdc-text-field--textarea.mdc-text-field--with-internal-counter .mdc-text-field-character-counter{align-self:flex-end;padding:0 16px}.mdc-text-field--textarea.mdc-text-field--with-internal-counter .mdc-text-field-character-counter::after{display:inline-block;width:0;height:16px;content:"";vertical-align:-16px}.mdc-text-field--textarea.mdc-text-field--with-internal-counter .mdc-text-field-character-counter::before{display:none}.mdc-text-field__resizer{align-self:stretch;display:inline-flex;flex-direction:column;flex-grow:1;max-height:100%;max-width:100%;min-height:56px;min-width:fit-content;min-width:-moz-available;min-width:-webkit-fill-available;overflow:hidden;resize:both}.mdc-text-field--filled .mdc-text-field__resizer{transform:translateY(-1px)}.mdc-text-field--filled .mdc-text-field__resizer .mdc-text-field__input,.mdc-text-field--filled .mdc-text-field__resizer .mdc-text-field-character-counter{transform:translateY(1px)}.mdc-text-field--outlined .mdc-text-field__resizer{transform:translateX(-1px) translateY(-1px)}[dir=rtl] .mdc-text-field--outlined .mdc-text-field__resizer,.mdc-text-field--outlined .mdc-text-field__resizer[dir=rtl]{transform:translateX(1px) translateY(-1px)}.mdc-text-field--outlined .mdc-text-field__resizer .mdc-text-field__input,.mdc-text-field--outlined .mdc-text-field__resizer .mdc-text-field-character-counter{transform:translateX(1px) translateY(1px)}[dir=rtl] .mdc-text-field--outlined .mdc-text-field__resizer .mdc-text-field__input,[dir=rtl] .mdc-text-field--outlined .mdc-text-field__resizer .mdc-text-field-character-counter,.mdc-text-field--outlined .mdc-text-field__resizer .mdc-text-field__input[dir=rtl],.mdc-text-field--outlined .mdc-text-field__resizer .mdc-text-field-character-counter[dir=rtl]{transform:translateX(-1px) translateY(1px)}.mdc-text-field--with-leading-icon{padding-left:0;padding-right:16px}[dir=rtl] .mdc-text-field--with-leading-icon,.mdc-text-field--with-leading-icon[dir=rtl]{padding-left:16px;padding-right:0}.mdc-text-field--with-leading-icon.mdc-text-field--filled .mdc-floating-label{max-width:calc(100% - 48px);left:48px;right:initial}[dir=rtl] .mdc-text-field--with-leading-icon.mdc-text-field--filled .mdc-floating-label,.mdc-text-field--with-leading-icon.mdc-text-field--filled .mdc-floating-label[dir=rtl]{left:initial;right:48px}.mdc-text-field--with-leading-icon.mdc-text-field--filled .mdc-floating-label--float-above{max-width:calc(100% / 0.75 - 64px / 0.75)}.mdc-text-field--with-leading-icon.mdc-text-field--outlined .mdc-floating-label{left:36px;right:initial}[dir=rtl] .mdc-text-field--with-leading-icon.mdc-text-field--outlined .mdc-floating-label,.mdc-text-field--with-leading-icon.mdc-text-field--outlined .mdc-floating-label[dir=rtl]{left:initial;right:36px}.mdc-text-field--with-leading-icon.mdc-text-field--outlined :not(.mdc-notched-outline--notched) .mdc-notched-outline__notch{max-width:calc(100% - 60px)}.mdc-text-field--with-leading-icon.mdc-text-field--outlined .mdc-floating-label--float-above{transform:translateY(-37.25px) translateX(-32px) scale(1)}[dir=rtl] .mdc-text-field--with-leading-icon.mdc-text-field--outlined .mdc-floating-label--float-above,.mdc-text-field--with-leading-icon.mdc-text-field--outlined .mdc-floating-label--float-above[dir=rtl]{transform:translateY(-37.25px) translateX(32px) scale(1)}.mdc-text-field--with-leading-icon.mdc-text-field--outlined .mdc-floating-label--float-above{font-size:.75rem}.mdc-text-field--with-leading-icon.mdc-text-field--outlined.mdc-notched-outline--upgraded .mdc-floating-label--float-above,.mdc-text-field--with-leading-icon.mdc-text-field--outlined .mdc-notched-outline--upgraded .mdc-floating-label--float-above{transform:translateY(-34.75px) translateX(-32px) scale(0.75)}[dir=rtl] .mdc-text-field--with-leading-icon.mdc-text-field--outlined.mdc-notched-outline--upgraded .mdc-floating-label--float-above,[dir=rtl] .mdc-text-field--with-leading-icon.mdc-text-field--outlined .mdc-notched-outline--upgraded .mdc-floating-label--float-above,.mdc-text-field--with-leading-icon.mdc-text-field--outlined.mdc-notched-outline--upgraded .mdc-floating-label--float-above[dir=rtl],.mdc-text-field--with-leading-icon.mdc-text-field--outlined .mdc-notched-outline--upgraded .mdc-floating-label--float-above[dir=rtl]{transform:translateY(-34.75px) translateX(32px) scale(0.75)}.mdc-text-field--with-leading-icon.mdc-text-field--outlined.mdc-notched-outline--upgraded .mdc-floating-label--float-above,.mdc-text-field--with-leading-icon.mdc-text-field--outlined .mdc-notched-outline--upgraded .mdc-floating-label--float-above{font-size:1rem}.mdc-text-field--with-trailing-icon{padding-left:16px;padding-right:0}[dir=rtl] .mdc-text-field--with-trailing-icon,.mdc-text-field--with-trailing-icon[dir=rtl]{padding-left:0;padding-right:16px}.mdc-text-field--with-trailing-icon.mdc-text-field--filled .mdc-floating-label{max-width:calc(100% - 64px)}.mdc-text-field--with-trailing-icon.mdc-text-field--filled .mdc-floating-label--float-above{max-width:calc(100% / 0.75 - 64px / 0.75)}.mdc-text-field--with-trailing-icon.mdc-text-field--outlined :not(.mdc-notched-outline--notched) .mdc-notched-outline__notch{max-width:calc(100% - 60px)}.mdc-text-field--with-leading-icon.mdc-text-field--with-trailing-icon{padding-left:0;padding-right:0}.mdc-text-field--with-leading-icon.mdc-text-field--with-trailing-icon.mdc-text-field--filled .mdc-floating-label{max-width:calc(100% - 96px)}.mdc-text-field--with-leading-icon.mdc-text-field--with-trailing-icon.mdc-text-field--filled .mdc-floating-label--float-above{max-width:calc(100% / 0.75 - 96px / 0.75)}.mdc-text-field-helper-line{display:flex;justify-content:space-between;box-sizing:border-box}.mdc-text-field+.mdc-text-field-helper-line{padding-right:16px;padding-left:16px}.mdc-form-field>.mdc-text-field+label{align-self:flex-start}.mdc-text-field--focused .mdc-notched-outline__leading,.mdc-text-field--focused .mdc-notched-outline__notch,.mdc-text-field--focused .mdc-notched-outline__trailing{border-width:2px}.mdc-text-field--focused+.mdc-text-field-helper-line .mdc-text-field-helper-text:not(.mdc-text-field-helper-text--validation-msg){opacity:1}.mdc-text-field--focused.mdc-text-field--outlined .mdc-notched-outline--notched .mdc-notched-outline__notch{padding-top:2px}.mdc-text-field--focused.mdc-text-field--outlined.mdc-text-field--textarea .mdc-notched-outline--notched .mdc-notched-outline__notch{padding-top:0}.mdc-text-field--invalid+.mdc-text-field-helper-line .mdc-text-field-helper-text--validation-msg{opacity:1}.mdc-text-field--disabled{pointer-events:none}@media screen and (forced-colors: active){.mdc-text-field--disabled .mdc-text-field__input{background-color:Window}.mdc-text-field--disabled .mdc-floating-label{z-index:1}}.mdc-text-field--disabled .mdc-floating-label{cursor:default}.mdc-text-field--disabled.mdc-text-field--filled .mdc-text-field__ripple{display:none}.mdc-text-field--disabled .mdc-text-field__input{pointer-events:auto}.mdc-text-field--end-aligned .mdc-text-field__input{text-align:right}[dir=rtl] .mdc-text-field--end-aligned .mdc-text-field__input,.mdc-text-field--end-aligned .mdc-text-field__input[dir=rtl]{text-align:left}[dir=rtl] .mdc-text-field--ltr-text .mdc-text-field__input,[dir=rtl] .mdc-text-field--ltr-text .mdc-text-field__affix,.mdc-text-field--ltr-text[dir=rtl] .mdc-text-field__input,.mdc-text-field--ltr-text[dir=rtl] .mdc-text-field__affix{direction:ltr}[dir=rtl] .mdc-text-field--ltr-text .mdc-text-field__affix--prefix,.mdc-text-field--ltr-text[dir=rtl] .mdc-text-field__affix--prefix{padding-left:0;padding-right:2px}[dir=rtl] .mdc-text-field--ltr-text .mdc-text-field__affix--suffix,.mdc-text-field--ltr-text[dir=rtl] .mdc-text-field__affix--suffix{padding-left:12px;padding-right:0}[dir=rtl] .mdc-text-field--ltr-text .mdc-text-field__icon--leading,.mdc-text-field--ltr-text[dir=rtl] .mdc-text-field__icon--leading{order:1}[dir=rtl] .mdc-text-field--ltr-text .mdc-text-field__affix--suffix,.mdc-text-field--ltr-text[dir=rtl] .mdc-text-field__affix--suffix{order:2}[dir=rtl] .mdc-text-field--ltr-text .mdc-text-field__input,.mdc-text-field--ltr-text[dir=rtl] .mdc-text-field__input{order:3}[dir=rtl] .mdc-text-field--ltr-text .mdc-text-field__affix--prefix,.mdc-text-field--ltr-text[dir=rtl] .mdc-text-field__affix--prefix{order:4}[dir=rtl] .mdc-text-field--ltr-text .mdc-text-field__icon--trailing,.mdc-text-field--ltr-text[dir=rtl] .mdc-text-field__icon--trailing{order:5}[dir=rtl] .mdc-text-field--ltr-text.mdc-text-field--end-aligned .mdc-text-field__input,.mdc-text-field--ltr-text.mdc-text-field--end-aligned[dir=rtl] .mdc-text-field__input{text-align:right}[dir=rtl] .mdc-text-field--ltr-text.mdc-text-field--end-aligned .mdc-text-field__affix--prefix,.mdc-text-field--ltr-text.mdc-text-field--end-aligned[dir=rtl] .mdc-text-field__affix--prefix{padding-right:12px}[dir=rtl] .mdc-text-field--ltr-text.mdc-text-field--end-aligned .mdc-text-field__affix--suffix,.mdc-text-field--ltr-text.mdc-text-field--end-aligned[dir=rtl] .mdc-text-field__affix--suffix{padding-left:2px}.mdc-floating-label{position:absolute;left:0;-webkit-transform-origin:left top;transform-origin:left top;line-height:1.15rem;text-align:left;text-overflow:ellipsis;white-space:nowrap;cursor:text;overflow:hidden;will-change:transform}[dir=rtl] .mdc-floating-label,.mdc-floating-label[dir=rtl]{right:0;left:auto;-webkit-transform-origin:right top;transform-origin:right top;text-align:right}.mdc-floating-label--float-above{cursor:auto}.mdc-floating-label--required:not(.mdc-floating-label--hide-required-marker)::after{margin-left:1px;margin-right:0px;content:"*"}[dir=rtl] .mdc-floating-label--required:not(.mdc-floating-label--hide-required-marker)::after,.mdc-floating-label--required:not(.mdc-floating-label--hide-required-marker)[dir=rtl]::after{margin-left:0;margin-right:1px}.mdc-notched-outline{display:flex;position:absolute;top:0;right:0;left:0;box-sizing:border-box;width:100%;max-width:100%;height:100%;text-align:left;pointer-events:none}[dir=rtl] .mdc-notched-outline,.mdc-notched-outline[dir=rtl]{text-align:right}.mdc-notched-outline__leading,.mdc-notched-outline__notch,.mdc-notched-outline__trailing{box-sizing:border-box;height:100%;pointer-events:none}.mdc-notched-outline__trailing{flex-grow:1}.mdc-notched-outline__notch{flex:0 0 auto;width:auto}.mdc-notched-outline .mdc-floating-label{display:inline-block;position:relative;max-width:100%}.mdc-notched-outline .mdc-floating-label--float-above{text-overflow:clip}.mdc-notched-outline--upgraded .mdc-floating-label--float-above{max-width:133.3333333333%}.mdc-notched-outline--notched .mdc-notched-outline__notch{padding-left:0;padding-right:8px;border-top:none}[dir=rtl] .mdc-notched-outline--notched .mdc-notched-outline__notch,.mdc-notched-outline--notched .mdc-notched-outline__notch[dir=rtl]{padding-left:8px;padding-right:0}.mdc-notched-outline--no-label .mdc-notched-outline__notch{display:none}.mdc-line-ripple::before,.mdc-line-ripple::after{position:absolute;bottom:0;left:0;width:100%;border-bottom-style:solid;content:""}.mdc-line-ripple::before{z-index:1}.mdc-line-ripple::after{transform:scaleX(0);opacity:0;z-index:2}.mdc-line-ripple--active::after{transform:scaleX(1);opacity:1}.mdc-line-ripple--deactivating::after{opacity:0}.mdc-floating-label--float-above{transform:translateY(-106%) scale(0.75)}.mdc-notched-outline__leading,.mdc-notched-outline__notch,.mdc-notched-outline__trailing{border-top:1px solid;border-bottom:1px solid}.mdc-notched-outline__leading{border-left:1px solid;border-right:none;width:12px}[dir=rtl] .mdc-notched-outline__leading,.mdc-notched-outline__leading[dir=rtl]{border-left:none;border-right:1px solid}.mdc-notched-outline__trailing{border-left:none;border-right:1px solid}[dir=rtl] .mdc-notched-outline__trailing,.mdc-notched-outline__trailing[dir=rtl]{border-left:1px solid;border-right:none}.mdc-notched-outline__notch{max-width:calc(100% - 12px * 2)}.mdc-line-ripple::before{border-bottom-width:1px}.mdc-line-ripple::after{border-bottom-width:2px}.mdc-text-field--filled{--mdc-filled-text-field-active-indicator-height:1px;--mdc-filled-text-field-focus-active-indicator-height:2px;--mdc-filled-text-field-container-shape:4px;border-top-left-radius:var(--mdc-filled-text-field-container-shape);border-top-right-radius:var(--mdc-filled-text-field-container-shape);border-bottom-right-radius:0;border-bottom-left-radius:0}.mdc-text-field--filled:not(.mdc-text-field--disabled) .mdc-text-field__input{caret-color:var(--mdc-filled-text-field-caret-color)}.mdc-text-field--filled.mdc-text-field--invalid:not(.mdc-text-field--disabled) .mdc-text-field__input{caret-color:var(--mdc-filled-text-field-error-caret-color)}.mdc-text-field--filled:not(.mdc-text-field--disabled) .mdc-text-field__input{color:var(--mdc-filled-text-field-input-text-color)}.mdc-text-field--filled.mdc-text-field--disabled .mdc-text-field__input{color:var(--mdc-filled-text-field-disabled-input-text-color)}.mdc-text-field--filled:not(.mdc-text-field--disabled) .mdc-floating-label,.mdc-text-field--filled:not(.mdc-text-field--disabled) .mdc-floating-label--float-above{color:var(--mdc-filled-text-field-label-text-color)}.mdc-text-field--filled:not(.mdc-text-field--disabled).mdc-text-field--focused .mdc-floating-label,.mdc-text-field--filled:not(.mdc-text-field--disabled).mdc-text-field--focused .mdc-floating-label--float-above{color:var(--mdc-filled-text-field-focus-label-text-color)}.mdc-text-field--filled.mdc-text-field--disabled .mdc-floating-label,.mdc-text-field--filled.mdc-text-field--disabled .mdc-floating-label--float-above{color:var(--mdc-filled-text-field-disabled-label-text-color)}.mdc-text-field--filled.mdc-text-field--invalid:not(.mdc-text-field--disabled) .mdc-floating-label,.mdc-text-field--filled.mdc-text-field--invalid:not(.mdc-text-field--disabled) .mdc-floating-label--float-above{color:var(--mdc-filled-text-field-error-label-text-color)}.mdc-text-field--filled.mdc-text-field--invalid:not(.mdc-text-field--disabled).mdc-text-field--focused .mdc-floating-label,.mdc-text-field--filled.mdc-text-field--invalid:not(.mdc-text-field--disabled).mdc-text-field--focused .mdc-floating-label--float-above{color:var(--mdc-filled-text-field-error-focus-label-text-color)}.mdc-text-field--filled .mdc-floating-label{font-family:var(--mdc-filled-text-field-label-text-font);font-size:var(--mdc-filled-text-field-label-text-size);font-weight:var(--mdc-filled-text-field-label-text-weight);letter-spacing:var(--mdc-filled-text-field-label-text-tracking)}@media all{.mdc-text-field--filled:not(.mdc-text-field--disabled) .mdc-text-field__input::placeholder{color:var(--mdc-filled-text-field-input-text-placeholder-color)}}@media all{.mdc-text-field--filled:not(.mdc-text-field--disabled) .mdc-text-field__input:-ms-input-placeholder{color:var(--mdc-filled-text-field-input-text-placeholder-color)}}.mdc-text-field--filled:not(.mdc-text-field--disabled){background-color:var(--mdc-filled-text-field-container-color)}.mdc-text-field--filled.mdc-text-field--disabled{background-color:var(--mdc-filled-text-field-disabled-container-color)}.mdc-text-field--filled:not(.mdc-text-field--disabled) .mdc-line-ripple::before{border-bottom-color:var(--mdc-filled-text-field-active-indicator-color)}.mdc-text-field--filled:not(.mdc-text-field--disabled):not(.mdc-text-field--focused):hover .mdc-line-ripple::before{border-bottom-color:var(--mdc-filled-text-field-hover-active-indicator-color)}.mdc-text-field--filled:not(.mdc-text-field--disabled) .mdc-line-ripple::after{border-bottom-color:var(--mdc-filled-text-field-focus-active-indicator-color)}.mdc-text-field--filled.mdc-text-field--disabled .mdc-line-ripple::before{border-bottom-color:var(--mdc-filled-text-field-disabled-active-indicator-color)}.mdc-text-field--filled.mdc-text-field--invalid:not(.mdc-text-field--disabled) .mdc-line-ripple::before{border-bottom-color:var(--mdc-filled-text-field-error-active-indicator-color)}.mdc-text-field--filled.mdc-text-field--invalid:not(.mdc-text-field--disabled):not(.mdc-text-field--focused):hover .mdc-line-ripple::before{border-bottom-color:var(--mdc-filled-text-field-error-hover-active-indicator-color)}.mdc-text-field--filled.mdc-text-field--invalid:not(.mdc-text-field--disabled) .mdc-line-ripple::after{border-bottom-color:var(--mdc-filled-text-field-error-focus-active-indicator-color)}.mdc-text-field--filled .mdc-line-ripple::before{border-bottom-width:var(--mdc-filled-text-field-active-indicator-height)}.mdc-text-field--filled .mdc-line-ripple::after{border-bottom-width:var(--mdc-filled-text-field-focus-active-indicator-height)}.mdc-text-field--outlined{--mdc-outlined-text-field-outline-width:1px;--mdc-outlined-text-field-focus-outline-width:2px;--mdc-outlined-text-field-container-shape:4px}.mdc-text-field--outlined:not(.mdc-text-field--disabled) .mdc-text-field__input{caret-color:var(--mdc-outlined-text-field-caret-color)}.mdc-text-field--outlined.mdc-text-field--invalid:not(.mdc-text-field--disabled) .mdc-text-field__input{caret-color:var(--mdc-outlined-text-field-error-caret-color)}.mdc-text-field--outlined:not(.mdc-text-field--disabled) .mdc-text-field__input{color:var(--mdc-outlined-text-field-input-text-color)}.mdc-text-field--outlined.mdc-text-field--disabled .mdc-text-field__input{color:var(--mdc-outlined-text-field-disabled-input-text-color)}.mdc-text-field--outlined:not(.mdc-text-field--disabled) .mdc-floating-label,.mdc-text-field--outlined:not(.mdc-text-field--disabled) .mdc-floating-label--float-above{color:var(--mdc-outlined-text-field-label-text-color)}.mdc-text-field--outlined:not(.mdc-text-field--disabled).mdc-text-field--focused .mdc-floating-label,.mdc-text-field--outlined:not(.mdc-text-field--disabled).mdc-text-field--focused .mdc-floating-label--float-above{color:var(--mdc-outlined-text-field-focus-label-text-color)}.mdc-text-field--outlined.mdc-text-field--disabled .mdc-floating-label,.mdc-text-field--outlined.mdc-text-field--disabled .mdc-floating-label--float-above{color:var(--mdc-outlined-text-field-disabled-label-text-color)}.mdc-text-field--outlined.mdc-text-field--invalid:not(.mdc-text-field--disabled) .mdc-floating-label,.mdc-text-field--outlined.mdc-text-field--invalid:not(.mdc-text-field--disabled) .mdc-floating-label--float-above{color:var(--mdc-outlined-text-field-error-label-text-color)}.mdc-text-field--outlined.mdc-text-field--invalid:not(.mdc-text-field--disabled).mdc-text-field--focused .mdc-floating-label,.mdc-text-field--outlined.mdc-text-field--invalid:not(.mdc-text-field--disabled).mdc-text-field--focused .mdc-floating-label--float-above{color:var(--mdc-outlined-text-field-error-focus-label-text-color)}.mdc-text-field--outlined .mdc-floating-label{font-family:var(--mdc-outlined-text-field-label-text-font);font-size:var(--mdc-outlined-text-field-label-text-size);font-weight:var(--mdc-outlined-text-field-label-text-weight);letter-spacing:var(--mdc-outlined-text-field-label-text-tracking)}@media all{.mdc-text-field--outlined:not(.mdc-text-field--disabled) .mdc-text-field__input::placeholder{color:var(--mdc-outlined-text-field-input-text-placeholder-color)}}@media all{.mdc-text-field--outlined:not(.mdc-text-field--disabled) .mdc-text-field__input:-ms-input-placeholder{color:var(--mdc-outlined-text-field-input-text-placeholder-color)}}.mdc-text-field--outlined .mdc-notched-outline .mdc-notched-outline__leading{border-top-left-radius:var(--mdc-outlined-text-field-container-shape);border-top-right-radius:0;border-bottom-right-radius:0;border-bottom-left-radius:var(--mdc-outlined-text-field-container-shape)}[dir=rtl] .mdc-text-field--outlined .mdc-notched-outline .mdc-notched-outline__leading,.mdc-text-field--outlined .mdc-notched-outline .mdc-notched-outline__leading[dir=rtl]{border-top-left-radius:0;border-top-right-radius:var(--mdc-outlined-text-field-container-shape);border-bottom-right-radius:var(--mdc-outlined-text-field-container-shape);border-bottom-left-radius:0}@supports(top: max(0%)){.mdc-text-field--outlined .mdc-notched-outline .mdc-notched-outline__leading{width:max(12px, var(--mdc-outlined-text-field-container-shape))}}@supports(top: max(0%)){.mdc-text-field--outlined .mdc-notched-outline .mdc-notched-outline__notch{max-width:calc(100% - max(12px, var(--mdc-outlined-text-field-container-shape))*2)}}.mdc-text-field--outlined .mdc-notched-outline .mdc-notched-outline__trailing{border-top-left-radius:0;border-top-right-radius:var(--mdc-outlined-text-field-container-shape);border-bottom-right-radius:var(--mdc-outlined-text-field-container-shape);border-bottom-left-radius:0}[dir=rtl] .mdc-text-field--outlined .mdc-notched-outline .mdc-notched-outline__trailing,.mdc-text-field--outlined .mdc-notched-outline .mdc-notched-outline__trailing[dir=rtl]{border-top-left-radius:var(--mdc-outlined-text-field-container-shape);border-top-right-radius:0;border-bottom-right-radius:0;border-bottom-left-radius:var(--mdc-outlined-text-field-container-shape)}@supports(top: max(0%)){.mdc-text-field--outlined{padding-left:max(16px, calc(var(--mdc-outlined-text-field-container-shape) + 4px))}}@supports(top: max(0%)){.mdc-text-field--outlined{padding-right:max(16px, var(--mdc-outlined-text-field-container-shape))}}@supports(top: max(0%)){.mdc-text-field--outlined+.mdc-text-field-helper-line{padding-left:max(16px, calc(var(--mdc-outlined-text-field-container-shape) + 4px))}}@supports(top: max(0%)){.mdc-text-field--outlined+.mdc-text-field-helper-line{padding-right:max(16px, var(--mdc-outlined-text-field-container-shape))}}.mdc-text-field--outlined.mdc-text-field--with-leading-icon{padding-left:0}@supports(top: max(0%)){.mdc-text-field--outlined.mdc-text-field--with-leading-icon{padding-right:max(16px, var(--mdc-outlined-text-field-container-shape))}}[dir=rtl] .mdc-text-field--outlined.mdc-text-field--with-leading-icon,.mdc-text-field--outlined.mdc-text-field--with-leading-icon[dir=rtl]{padding-right:0}@supports(top: max(0%)){[dir=rtl] .mdc-text-field--outlined.mdc-text-field--with-leading-icon,.mdc-text-field--outlined.mdc-text-field--with-leading-icon[dir=rtl]{padding-left:max(16px, var(--mdc-outlined-text-field-container-shape))}}.mdc-text-field--outlined.mdc-text-field--with-trailing-icon{padding-right:0}@supports(top: max(0%)){.mdc-text-field--outlined.mdc-text-field--with-trailing-icon{padding-left:max(16px, calc(var(--mdc-outlined-text-field-container-shape) + 4px))}}[dir=rtl] .mdc-text-field--outlined.mdc-text-field--with-trailing-icon,.mdc-text-field--outlined.mdc-text-field--with-trailing-icon[dir=rtl]{padding-left:0}@supports(top: max(0%)){[dir=rtl] .mdc-text-field--outlined.mdc-text-field--with-trailing-icon,.mdc-text-field--outlined.mdc-text-field--with-trailing-icon[dir=rtl]{padding-right:max(16px, calc(var(--mdc-outlined-text-field-container-shape) + 4px))}}.mdc-text-field--outlined.mdc-text-field--with-leading-icon.mdc-text-field--with-trailing-icon{padding-left:0;padding-right:0}.mdc-text-field--outlined:not(.mdc-text-field--disabled) .mdc-notched-outline__leading,.mdc-text-field--outlined:not(.mdc-text-field--disabled) .mdc-notched-outline__notch,.mdc-text-field--outlined:not(.mdc-text-field--disabled) .mdc-notched-outline__trailing{border-color:var(--mdc-outlined-text-field-outline-color)}.mdc-text-field--outlined:not(.mdc-text-field--disabled):not(.mdc-text-field--focused):hover .mdc-notched-outline .mdc-notched-outline__leading,.mdc-text-field--outlined:not(.mdc-text-field--disabled):not(.mdc-text-field--focused):hover .mdc-notched-outline .mdc-notched-outline__notch,.mdc-text-field--outlined:not(.mdc-text-field--disabled):not(.mdc-text-field--focused):hover .mdc-notched-outline .mdc-notched-outline__trailing{border-color:var(--mdc-outlined-text-field-hover-outline-color)}.mdc-text-field--outlined:not(.mdc-text-field--disabled).mdc-text-field--focused .mdc-notched-outline__leading,.mdc-text-field--outlined:not(.mdc-text-field--disabled).mdc-text-field--focused .mdc-notched-outline__notch,.mdc-text-field--outlined:not(.mdc-text-field--disabled).mdc-text-field--focused .mdc-notched-outline__trailing{border-color:var(--mdc-outlined-text-field-focus-outline-color)}.mdc-text-field--outlined.mdc-text-field--disabled .mdc-notched-outline__leading,.mdc-text-field--outlined.mdc-text-field--disabled .mdc-notched-outline__notch,.mdc-text-field--outlined.mdc-text-field--disabled .mdc-notched-outline__trailing{border-color:var(--mdc-outlined-text-field-disabled-outline-color)}.mdc-text-field--outlined.mdc-text-field--invalid:not(.mdc-text-field--disabled) .mdc-notched-outline__leading,.mdc-text-field--outlined.mdc-text-field--invalid:not(.mdc-text-field--disabled) .mdc-notched-outline__notch,.mdc-text-field--outlined.mdc-text-field--invalid:not(.mdc-text-field--disabled) .mdc-notched-outline__trailing{border-color:var(--mdc-outlined-text-field-error-outline-color)}.mdc-text-field--outlined.mdc-text-field--invalid:not(.mdc-text-field--disabled):not(.mdc-text-field--focused):hover .mdc-notched-outline .mdc-notched-outline__leading,.mdc-text-field--outlined.mdc-text-field--invalid:not(.mdc-text-field--disabled):not(.mdc-text-field--focused):hover .mdc-notched-outline .mdc-notched-outline__notch,.mdc-text-field--outlined.mdc-text-field--invalid:not(.mdc-text-field--disabled):not(.mdc-text-field--focused):hover .mdc-notched-outline .mdc-notched-outline__trailing{border-color:var(--mdc-outlined-text-field-error-hover-outline-color)}.mdc-text-field--outlined.mdc-text-field--invalid:not(.mdc-text-field--disabled).mdc-text-field--focused .mdc-notched-outline__leading,.mdc-text-field--outlined.mdc-text-field--invalid:not(.mdc-text-field--disabled).mdc-text-field--focused .mdc-notched-outline__notch,.mdc-text-field--outlined.mdc-text-field--invalid:not(.mdc-text-field--disabled).mdc-text-field--focused .mdc-notched-outline__trailing{border-color:var(--mdc-outlined-text-field-error-focus-outline-color)}.mdc-text-field--outlined:not(.mdc-text-field--disabled) .mdc-notched-outline .mdc-notched-outline__leading,.mdc-text-field--outlined:not(.mdc-text-field--disabled) .mdc-notched-outline .mdc-notched-outline__notch,.mdc-text-field--outlined:not(.mdc-text-field--disabled) .mdc-notched-outline .mdc-notched-outline__trailing{border-width:var(--mdc-outlined-text-field-outline-width)}.mdc-text-field--outlined:not(.mdc-text-field--disabled).mdc-text-field--focused .mdc-notched-outline .mdc-notched-outline__leading,.mdc-text-field--outlined:not(.mdc-text-field--disabled).mdc-text-field--focused .mdc-notched-outline .mdc-notched-outline__notch,.mdc-text-field--outlined:not(.mdc-text-field--disabled).mdc-text-field--focused .mdc-notched-outline .mdc-notched-outline__trailing{border-width:var(--mdc-outlined-text-field-focus-outline-width)}.mat-mdc-form-field-textarea-control{vertical-align:middle;resize:vertical;box-sizing:border-box;height:auto;margin:0;padding:0;border:none;overflow:auto}.mat-mdc-form-field-input-control.mat-mdc-form-field-input-control{-moz-osx-font-smoothing:grayscale;-webkit-font-smoothing:antialiased;font:inherit;letter-spacing:inherit;text-decoration:inherit;text-transform:inherit;border:none}.mat-mdc-form-field .mat-mdc-floating-label.mdc-floating-label{-moz-osx-font-smoothing:grayscale;-webkit-font-smoothing:antialiased;line-height:normal;pointer-events:all}.mdc-text-field--no-label:not(.mdc-text-field--textarea) .mat-mdc-form-field-input-control.mdc-text-field__input,.mat-mdc-text-field-wrapper .mat-mdc-form-field-input-control{height:auto}.mat-mdc-text-field-wrapper .mat-mdc-form-field-input-control.mdc-text-field__input[type=color]{height:23px}.mat-mdc-text-field-wrapper{height:auto;flex:auto}.mat-mdc-form-field-has-icon-prefix .mat-mdc-text-field-wrapper{padding-left:0;--mat-mdc-form-field-label-offset-x: -16px}.mat-mdc-form-field-has-icon-suffix .mat-mdc-text-field-wrapper{padding-right:0}[dir=rtl] .mat-mdc-text-field-wrapper{padding-left:16px;padding-right:16px}[dir=rtl] .mat-mdc-form-field-has-icon-suffix .mat-mdc-text-field-wrapper{padding-left:0}[dir=rtl] .mat-mdc-form-field-has-icon-prefix .mat-mdc-text-field-wrapper{padding-right:0}.mat-form-field-disabled .mdc-text-field__input::placeholder{color:var(--mat-form-field-disabled-input-text-placeholder-color)}.mat-form-field-disabled .mdc-text-field__input::-moz-placeholder{color:var(--mat-form-field-disabled-input-text-placeholder-color)}.mat-form-field-disabled .mdc-text-field__input::-webkit-input-placeholder{color:var(--mat-form-field-disabled-input-text-placeholder-color)}.mat-form-field-disabled .mdc-text-field__input:-ms-input-placeholder{color:var(--mat-form-field-disabled-input-text-placeholder-color)}.mat-mdc-form-field-label-always-float .mdc-text-field__input::placeholder{transition-delay:40ms;transition-duration:110ms;opacity:1}.mat-mdc-text-field-wrapper .mat-mdc-form-field-infix .mat-mdc-floating-label{left:auto;right:auto}.mat-mdc-text-field-wrapper.mdc-text-field--outlined .mdc-text-field__input{display:inline-block}.mat-mdc-form-field .mat-mdc-text-field-wrapper.mdc-text-field .mdc-notched-outline__notch{padding-top:0}.mat-mdc-text-field-wrapper::before{content:none}.mat-mdc-form-field-subscript-wrapper{box-sizing:border-box;width:100%;position:relative}.mat-mdc-form-field-hint-wrapper,.mat-mdc-form-field-error-wrapper{position:absolute;top:0;left:0;right:0;padding:0 16px}.mat-mdc-form-field-subscript-dynamic-size .mat-mdc-form-field-hint-wrapper,.mat-mdc-form-field-subscript-dynamic-size .mat-mdc-form-field-error-wrapper{position:static}.mat-mdc-form-field-bottom-align::before{content:"";display:inline-block;height:16px}.mat-mdc-form-field-bottom-align.mat-mdc-form-field-subscript-dynamic-size::before{content:unset}.mat-mdc-form-field-hint-end{order:1}.mat-mdc-form-field-hint-wrapper{display:flex}.mat-mdc-form-field-hint-spacer{flex:1 0 1em}.mat-mdc-form-field-error{display:block}.mat-mdc-form-field-focus-overlay{top:0;left:0;right:0;bottom:0;position:absolute;opacity:0;pointer-events:none}select.mat-mdc-form-field-input-control{-moz-appearance:none;-webkit-appearance:none;background-color:rgba(0,0,0,0);display:inline-flex;box-sizing:border-box}select.mat-mdc-form-field-input-control:not(:disabled){cursor:pointer}.mat-mdc-form-field-type-mat-native-select .mat-mdc-form-field-infix::after{content:"";width:0;height:0;border-left:5px solid rgba(0,0,0,0);border-right:5px solid rgba(0,0,0,0);border-top:5px solid;position:absolute;right:0;top:50%;margin-top:-2.5px;pointer-events:none}[dir=rtl] .mat-mdc-form-field-type-mat-native-select .mat-mdc-form-field-infix::after{right:auto;left:0}.mat-mdc-form-field-type-mat-native-select .mat-mdc-form-field-input-control{padding-right:15px}[dir=rtl] .mat-mdc-form-field-type-mat-native-select .mat-mdc-form-field-input-control{padding-right:0;padding-left:15px}.cdk-high-contrast-active .mat-form-field-appearance-fill .mat-mdc-text-field-wrapper{outline:solid 1px}.cdk-high-contrast-active .mat-form-field-appearance-fill.mat-form-field-disabled .mat-mdc-text-field-wrapper{outline-color:GrayText}.cdk-high-contrast-active .mat-form-field-appearance-fill.mat-focused .mat-mdc-text-field-wrapper{outline:dashed 3px}.cdk-high-contrast-active .mat-mdc-form-field.mat-focused .mdc-notched-outline{border:dashed 3px}.mat-mdc-form-field-input-control[type=date],.mat-mdc-form-field-input-control[type=datetime],.mat-mdc-form-field-input-control[type=datetime-local],.mat-mdc-form-field-input-control[type=month],.mat-mdc-form-field-input-control[type=week],.mat-mdc-form-field-input-control[type=time]{line-height:1}.mat-mdc-form-field-input-control::-webkit-datetime-edit{line-height:1;padding:0;margin-bottom:-2px}.mat-mdc-form-field{--mat-mdc-form-field-floating-label-scale: 0.75;display:inline-flex;flex-direction:column;min-width:0;text-align:left;-moz-osx-font-smoothing:grayscale;-webkit-font-smoothing:antialiased;font-family:var(--mat-form-field-container-text-font);line-height:var(--mat-form-field-container-text-line-height);font-size:var(--mat-form-field-container-text-size);letter-spacing:var(--mat-form-field-container-text-tracking);font-weight:var(--mat-form-field-container-text-weight)}[dir=rtl] .mat-mdc-form-field{text-align:right}.mat-mdc-form-field .mdc-text-field--outlined .mdc-floating-label--float-above{font-size:calc(var(--mat-form-field-outlined-label-text-populated-size) * var(--mat-mdc-form-field-floating-label-scale))}.mat-mdc-form-field .mdc-text-field--outlined .mdc-notched-outline--upgraded .mdc-floating-label--float-above{font-size:var(--mat-form-field-outlined-label-text-populated-size)}.mat-mdc-form-field-flex{display:inline-flex;align-items:baseline;box-sizing:border-box;width:100%}.mat-mdc-text-field-wrapper{width:100%}.mat-mdc-form-field-icon-prefix,.mat-mdc-form-field-icon-suffix{align-self:center;line-height:0;pointer-events:auto;position:relative;z-index:1}.mat-mdc-form-field-icon-prefix,[dir=rtl] .mat-mdc-form-field-icon-suffix{padding:0 4px 0 0}.mat-mdc-form-field-icon-suffix,[dir=rtl] .mat-mdc-form-field-icon-prefix{padding:0 0 0 4px}.mat-mdc-form-field-icon-prefix>.mat-icon,.mat-mdc-form-field-icon-suffix>.mat-icon{padding:12px;box-sizing:content-box}.mat-mdc-form-field-subscript-wrapper .mat-icon,.mat-mdc-form-field label .mat-icon{width:1em;height:1em;font-size:inherit}.mat-mdc-form-field-infix{flex:auto;min-width:0;width:180px;position:relative;box-sizing:border-box}.mat-mdc-form-field .mdc-notched-outline__notch{margin-left:-1px;-webkit-clip-path:inset(-9em -999em -9em 1px);clip-path:inset(-9em -999em -9em 1px)}[dir=rtl] .mat-mdc-form-field .mdc-notched-outline__notch{margin-left:0;margin-right:-1px;-webkit-clip-path:inset(-9em 1px -9em -999em);clip-path:inset(-9em 1px -9em -999em)}.mat-mdc-form-field:not(.mat-form-field-no-animations) .mdc-text-field__input{transition:opacity 150ms 0ms cubic-bezier(0.4, 0, 0.2, 1)}@media all{.mat-mdc-form-field:not(.mat-form-field-no-animations) .mdc-text-field__input::placeholder{transition:opacity 67ms 0ms cubic-bezier(0.4, 0, 0.2, 1)}}@media all{.mat-mdc-form-field:not(.mat-form-field-no-animations) .mdc-text-field__input:-ms-input-placeholder{transition:opacity 67ms 0ms cubic-bezier(0.4, 0, 0.2, 1)}}@media all{.mdc-text-field--no-label .mat-mdc-form-field:not(.mat-form-field-no-animations) .mdc-text-field__input::placeholder,.mdc-text-field--focused .mat-mdc-form-field:not(.mat-form-field-no-animations) .mdc-text-field__input::placeholder{transition-delay:40ms;transition-duration:110ms}}@media all{.mdc-text-field--no-label .mat-mdc-form-field:not(.mat-form-field-no-animations) .mdc-text-field__input:-ms-input-placeholder,.mdc-text-field--focused .mat-mdc-form-field:not(.mat-form-field-no-animations) .mdc-text-field__input:-ms-input-placeholder{transition-delay:40ms;transition-duration:110ms}}.mat-mdc-form-field:not(.mat-form-field-no-animations) .mdc-text-field__affix{transition:opacity 150ms 0ms cubic-bezier(0.4, 0, 0.2, 1)}.mat-mdc-form-field:not(.mat-form-field-no-animations) .mdc-text-field--filled.mdc-ripple-upgraded--background-focused .mdc-text-field__ripple::before,.mat-mdc-form-field:not(.mat-form-field-no-animations) .mdc-text-field--filled:not(.mdc-ripple-upgraded):focus .mdc-text-field__ripple::before{transition-duration:75ms}.mat-mdc-form-field:not(.mat-form-field-no-animations) .mdc-text-field--outlined .mdc-floating-label--shake{animation:mdc-floating-label-shake-float-above-text-field-outlined 250ms 1}@keyframes mdc-floating-label-shake-float-above-text-field-outlined{0%{transform:translateX(calc(0% - 0%)) translateY(calc(0% - 34.75px)) scale(0.75)}33%{animation-timing-function:cubic-bezier(0.5, 0, 0.701732, 0.495819);transform:translateX(calc(4% - 0%)) translateY(calc(0% - 34.75px)) scale(0.75)}66%{animation-timing-function:cubic-bezier(0.302435, 0.381352, 0.55, 0.956352);transform:translateX(calc(-4% - 0%)) translateY(calc(0% - 34.75px)) scale(0.75)}100%{transform:translateX(calc(0% - 0%)) translateY(calc(0% - 34.75px)) scale(0.75)}}.mat-mdc-form-field:not(.mat-form-field-no-animations) .mdc-text-field--textarea{transition:none}.mat-mdc-form-field:not(.mat-form-field-no-animations) .mdc-text-field--textarea.mdc-text-field--filled .mdc-floating-label--shake{animation:mdc-floating-label-shake-float-above-textarea-filled 250ms 1}@keyframes mdc-floating-label-shake-float-above-textarea-filled{0%{transform:translateX(calc(0% - 0%)) translateY(calc(0% - 10.25px)) scale(0.75)}33%{animation-timing-function:cubic-bezier(0.5, 0, 0.701732, 0.495819);transform:translateX(calc(4% - 0%)) translateY(calc(0% - 10.25px)) scale(0.75)}66%{animation-timing-function:cubic-bezier(0.302435, 0.381352, 0.55, 0.956352);transform:translateX(calc(-4% - 0%)) translateY(calc(0% - 10.25px)) scale(0.75)}100%{transform:translateX(calc(0% - 0%)) translateY(calc(0% - 10.25px)) scale(0.75)}}.mat-mdc-form-field:not(.mat-form-field-no-animations) .mdc-text-field--textarea.mdc-text-field--outlined .mdc-floating-label--shake{animation:mdc-floating-label-shake-float-above-textarea-outlined 250ms 1}@keyframes mdc-floating-label-shake-float-above-textarea-outlined{0%{transform:translateX(calc(0% - 0%)) translateY(calc(0% - 24.75px)) scale(0.75)}33%{animation-timing-function:cubic-bezier(0.5, 0, 0.701732, 0.495819);transform:translateX(calc(4% - 0%)) translateY(calc(0% - 24.75px)) scale(0.75)}66%{animation-timing-function:cubic-bezier(0.302435, 0.381352, 0.55, 0.956352);transform:translateX(calc(-4% - 0%)) translateY(calc(0% - 24.75px)) scale(0.75)}100%{transform:translateX(calc(0% - 0%)) translateY(calc(0% - 24.75px)) scale(0.75)}}.mat-mdc-form-field:not(.mat-form-field-no-animations) .mdc-text-field--with-leading-icon.mdc-text-field--outlined .mdc-floating-label--shake{animation:mdc-floating-label-shake-float-above-text-field-outlined-leading-icon 250ms 1}@keyframes mdc-floating-label-shake-float-above-text-field-outlined-leading-icon{0%{transform:translateX(calc(0% - 32px)) translateY(calc(0% - 34.75px)) scale(0.75)}33%{animation-timing-function:cubic-bezier(0.5, 0, 0.701732, 0.495819);transform:translateX(calc(4% - 32px)) translateY(calc(0% - 34.75px)) scale(0.75)}66%{animation-timing-function:cubic-bezier(0.302435, 0.381352, 0.55, 0.956352);transform:translateX(calc(-4% - 32px)) translateY(calc(0% - 34.75px)) scale(0.75)}100%{transform:translateX(calc(0% - 32px)) translateY(calc(0% - 34.75px)) scale(0.75)}}[dir=rtl] .mat-mdc-form-field:not(.mat-form-field-no-animations) .mdc-text-field--with-leading-icon.mdc-text-field--outlined .mdc-floating-label--shake,.mat-mdc-form-field:not(.mat-form-field-no-animations) .mdc-text-field--with-leading-icon.mdc-text-field--outlined[dir=rtl] .mdc-floating-label--shake{animation:mdc-floating-label-shake-float-above-text-field-outlined-leading-icon 250ms 1}@keyframes mdc-floating-label-shake-float-above-text-field-outlined-leading-icon-rtl{0%{transform:translateX(calc(0% - -32px)) translateY(calc(0% - 34.75px)) scale(0.75)}33%{animation-timing-function:cubic-bezier(0.5, 0, 0.701732, 0.495819);transform:translateX(calc(4% - -32px)) translateY(calc(0% - 34.75px)) scale(0.75)}66%{animation-timing-function:cubic-bezier(0.302435, 0.381352, 0.55, 0.956352);transform:translateX(calc(-4% - -32px)) translateY(calc(0% - 34.75px)) scale(0.75)}100%{transform:translateX(calc(0% - -32px)) translateY(calc(0% - 34.75px)) scale(0.75)}}.mat-mdc-form-field:not(.mat-form-field-no-animations) .mdc-floating-label{transition:transform 150ms cubic-bezier(0.4, 0, 0.2, 1),color 150ms cubic-bezier(0.4, 0, 0.2, 1)}.mdc-floating-label--shake{animation:mdc-floating-label-shake-float-above-standard 250ms 1}@keyframes mdc-floating-label-shake-float-above-standard{0%{transform:translateX(calc(0% - 0%)) translateY(calc(0% - 106%)) scale(0.75)}33%{animation-timing-function:cubic-bezier(0.5, 0, 0.701732, 0.495819);transform:translateX(calc(4% - 0%)) translateY(calc(0% - 106%)) scale(0.75)}66%{animation-timing-function:cubic-bezier(0.302435, 0.381352, 0.55, 0.956352);transform:translateX(calc(-4% - 0%)) translateY(calc(0% - 106%)) scale(0.75)}100%{transform:translateX(calc(0% - 0%)) translateY(calc(0% - 106%)) scale(0.75)}}.mat-mdc-form-field:not(.mat-form-field-no-animations) .mdc-line-ripple::after{transition:transform 180ms cubic-bezier(0.4, 0, 0.2, 1),opacity 180ms cubic-bezier(0.4, 0, 0.2, 1)}.mdc-notched-outline .mdc-floating-label{max-width:calc(100% + 1px)}.mdc-notched-outline--upgraded .mdc-floating-label--float-above{max-width:calc(133.3333333333% + 1px)}'],encapsulation:2,data:{animation:[Bee.transitionMessages]},changeDetection:0}),t})(),jd=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275mod=le({type:e}),e.\u0275inj=ae({imports:[ve,vn,Mv,ve]}),t})();const zee=["panel"];function Uee(e,t){if(1&e){const i=Ut();y(0,"div",0,1),$("@panelAnimation.done",function(r){return Ye(i),Ke(B()._animationDone.next(r))}),X(2),w()}if(2&e){const i=t.id,n=B();E("id",n.id)("ngClass",n._classList)("@panelAnimation",n.isOpen?"visible":"hidden"),de("aria-label",n.ariaLabel||null)("aria-labelledby",n._getPanelAriaLabelledby(i))}}const $ee=["*"],qee=ni("panelAnimation",[qt("void, hidden",je({opacity:0,transform:"scaleY(0.8)"})),Bt(":enter, hidden => visible",[Q$([Lt("0.03s linear",je({opacity:1})),Lt("0.12s cubic-bezier(0, 0, 0.2, 1)",je({transform:"scaleY(1)"}))])]),Bt(":leave, visible => hidden",[Lt("0.075s linear",je({opacity:0}))])]);let Gee=0;class Wee{constructor(t,i){this.source=t,this.option=i}}const Qee=so(class{}),YF=new M("mat-autocomplete-default-options",{providedIn:"root",factory:function Yee(){return{autoActiveFirstOption:!1,autoSelectActiveOption:!1,hideSingleSelectionIndicator:!1,requireSelection:!1}}});let Kee=(()=>{var e;class t extends Qee{get isOpen(){return this._isOpen&&this.showPanel}_setColor(n){this._color=n,this._setThemeClasses(this._classList)}get autoActiveFirstOption(){return this._autoActiveFirstOption}set autoActiveFirstOption(n){this._autoActiveFirstOption=Q(n)}get autoSelectActiveOption(){return this._autoSelectActiveOption}set autoSelectActiveOption(n){this._autoSelectActiveOption=Q(n)}get requireSelection(){return this._requireSelection}set requireSelection(n){this._requireSelection=Q(n)}set classList(n){this._classList=n&&n.length?function u7(e,t=/\s+/){const i=[];if(null!=e){const n=Array.isArray(e)?e:`${e}`.split(t);for(const r of n){const o=`${r}`.trim();o&&i.push(o)}}return i}(n).reduce((r,o)=>(r[o]=!0,r),{}):{},this._setVisibilityClasses(this._classList),this._setThemeClasses(this._classList),this._elementRef.nativeElement.className=""}constructor(n,r,o,s){super(),this._changeDetectorRef=n,this._elementRef=r,this._defaults=o,this._activeOptionChanges=Ae.EMPTY,this.showPanel=!1,this._isOpen=!1,this.displayWith=null,this.optionSelected=new U,this.opened=new U,this.closed=new U,this.optionActivated=new U,this._classList={},this.id="mat-autocomplete-"+Gee++,this.inertGroups=s?.SAFARI||!1,this._autoActiveFirstOption=!!o.autoActiveFirstOption,this._autoSelectActiveOption=!!o.autoSelectActiveOption,this._requireSelection=!!o.requireSelection}ngAfterContentInit(){this._keyManager=new NI(this.options).withWrap().skipPredicate(this._skipPredicate),this._activeOptionChanges=this._keyManager.change.subscribe(n=>{this.isOpen&&this.optionActivated.emit({source:this,option:this.options.toArray()[n]||null})}),this._setVisibility()}ngOnDestroy(){this._keyManager?.destroy(),this._activeOptionChanges.unsubscribe()}_setScrollTop(n){this.panel&&(this.panel.nativeElement.scrollTop=n)}_getScrollTop(){return this.panel?this.panel.nativeElement.scrollTop:0}_setVisibility(){this.showPanel=!!this.options.length,this._setVisibilityClasses(this._classList),this._changeDetectorRef.markForCheck()}_emitSelectEvent(n){const r=new Wee(this,n);this.optionSelected.emit(r)}_getPanelAriaLabelledby(n){return this.ariaLabel?null:this.ariaLabelledby?(n?n+" ":"")+this.ariaLabelledby:n}_setVisibilityClasses(n){n[this._visibleClass]=this.showPanel,n[this._hiddenClass]=!this.showPanel}_setThemeClasses(n){n["mat-primary"]="primary"===this._color,n["mat-warn"]="warn"===this._color,n["mat-accent"]="accent"===this._color}_skipPredicate(n){return n.disabled}}return(e=t).\u0275fac=function(n){return new(n||e)(m(Fe),m(W),m(YF),m(nt))},e.\u0275dir=T({type:e,viewQuery:function(n,r){if(1&n&&(ke(ct,7),ke(zee,5)),2&n){let o;H(o=z())&&(r.template=o.first),H(o=z())&&(r.panel=o.first)}},inputs:{ariaLabel:["aria-label","ariaLabel"],ariaLabelledby:["aria-labelledby","ariaLabelledby"],displayWith:"displayWith",autoActiveFirstOption:"autoActiveFirstOption",autoSelectActiveOption:"autoSelectActiveOption",requireSelection:"requireSelection",panelWidth:"panelWidth",classList:["class","classList"]},outputs:{optionSelected:"optionSelected",opened:"opened",closed:"closed",optionActivated:"optionActivated"},features:[P]}),t})(),Xee=(()=>{var e;class t extends Kee{constructor(){super(...arguments),this._visibleClass="mat-mdc-autocomplete-visible",this._hiddenClass="mat-mdc-autocomplete-hidden",this._animationDone=new U,this._hideSingleSelectionIndicator=this._defaults.hideSingleSelectionIndicator??!1}get hideSingleSelectionIndicator(){return this._hideSingleSelectionIndicator}set hideSingleSelectionIndicator(n){this._hideSingleSelectionIndicator=Q(n),this._syncParentProperties()}_syncParentProperties(){if(this.options)for(const n of this.options)n._changeDetectorRef.markForCheck()}ngOnDestroy(){super.ngOnDestroy(),this._animationDone.complete()}_skipPredicate(n){return!1}}return(e=t).\u0275fac=function(){let i;return function(r){return(i||(i=be(e)))(r||e)}}(),e.\u0275cmp=fe({type:e,selectors:[["mat-autocomplete"]],contentQueries:function(n,r,o){if(1&n&&(Ee(o,zv,5),Ee(o,Nf,5)),2&n){let s;H(s=z())&&(r.optionGroups=s),H(s=z())&&(r.options=s)}},hostAttrs:["ngSkipHydration","",1,"mat-mdc-autocomplete"],inputs:{disableRipple:"disableRipple",hideSingleSelectionIndicator:"hideSingleSelectionIndicator"},exportAs:["matAutocomplete"],features:[Z([{provide:Hv,useExisting:e}]),P],ngContentSelectors:$ee,decls:1,vars:0,consts:[["role","listbox",1,"mat-mdc-autocomplete-panel","mdc-menu-surface","mdc-menu-surface--open",3,"id","ngClass"],["panel",""]],template:function(n,r){1&n&&(ze(),R(0,Uee,3,5,"ng-template"))},dependencies:[Ea],styles:["div.mat-mdc-autocomplete-panel{box-shadow:0px 5px 5px -3px rgba(0, 0, 0, 0.2), 0px 8px 10px 1px rgba(0, 0, 0, 0.14), 0px 3px 14px 2px rgba(0, 0, 0, 0.12);width:100%;max-height:256px;visibility:hidden;transform-origin:center top;overflow:auto;padding:8px 0;border-radius:4px;box-sizing:border-box;position:static;background-color:var(--mat-autocomplete-background-color)}.cdk-high-contrast-active div.mat-mdc-autocomplete-panel{outline:solid 1px}.cdk-overlay-pane:not(.mat-mdc-autocomplete-panel-above) div.mat-mdc-autocomplete-panel{border-top-left-radius:0;border-top-right-radius:0}.mat-mdc-autocomplete-panel-above div.mat-mdc-autocomplete-panel{border-bottom-left-radius:0;border-bottom-right-radius:0;transform-origin:center bottom}div.mat-mdc-autocomplete-panel.mat-mdc-autocomplete-visible{visibility:visible}div.mat-mdc-autocomplete-panel.mat-mdc-autocomplete-hidden{visibility:hidden}mat-autocomplete{display:none}"],encapsulation:2,data:{animation:[qee]},changeDetection:0}),t})();const Zee={provide:Gn,useExisting:He(()=>XF),multi:!0},KF=new M("mat-autocomplete-scroll-strategy"),ete={provide:KF,deps:[wi],useFactory:function Jee(e){return()=>e.scrollStrategies.reposition()}};let tte=(()=>{var e;class t{get autocompleteDisabled(){return this._autocompleteDisabled}set autocompleteDisabled(n){this._autocompleteDisabled=Q(n)}constructor(n,r,o,s,a,c,l,d,u,h,f){this._element=n,this._overlay=r,this._viewContainerRef=o,this._zone=s,this._changeDetectorRef=a,this._dir=l,this._formField=d,this._document=u,this._viewportRuler=h,this._defaults=f,this._componentDestroyed=!1,this._autocompleteDisabled=!1,this._manuallyFloatingLabel=!1,this._viewportSubscription=Ae.EMPTY,this._canOpenOnNextFocus=!0,this._closeKeyEventStream=new Y,this._windowBlurHandler=()=>{this._canOpenOnNextFocus=this._document.activeElement!==this._element.nativeElement||this.panelOpen},this._onChange=()=>{},this._onTouched=()=>{},this.position="auto",this.autocompleteAttribute="off",this._overlayAttached=!1,this.optionSelections=Kf(()=>{const p=this.autocomplete?this.autocomplete.options:null;return p?p.changes.pipe(tn(p),Vt(()=>Ot(...p.map(g=>g.onSelectionChange)))):this._zone.onStable.pipe(Xe(1),Vt(()=>this.optionSelections))}),this._handlePanelKeydown=p=>{(27===p.keyCode&&!An(p)||38===p.keyCode&&An(p,"altKey"))&&(this._pendingAutoselectedOption&&(this._updateNativeInputValue(this._valueBeforeAutoSelection??""),this._pendingAutoselectedOption=null),this._closeKeyEventStream.next(),this._resetActiveItem(),p.stopPropagation(),p.preventDefault())},this._trackedModal=null,this._scrollStrategy=c}ngAfterViewInit(){const n=this._getWindow();typeof n<"u"&&this._zone.runOutsideAngular(()=>n.addEventListener("blur",this._windowBlurHandler))}ngOnChanges(n){n.position&&this._positionStrategy&&(this._setStrategyPositions(this._positionStrategy),this.panelOpen&&this._overlayRef.updatePosition())}ngOnDestroy(){const n=this._getWindow();typeof n<"u"&&n.removeEventListener("blur",this._windowBlurHandler),this._viewportSubscription.unsubscribe(),this._componentDestroyed=!0,this._destroyPanel(),this._closeKeyEventStream.complete(),this._clearFromModal()}get panelOpen(){return this._overlayAttached&&this.autocomplete.showPanel}openPanel(){this._attachOverlay(),this._floatLabel()}closePanel(){this._resetLabel(),this._overlayAttached&&(this.panelOpen&&this._zone.run(()=>{this.autocomplete.closed.emit()}),this.autocomplete._isOpen=this._overlayAttached=!1,this._pendingAutoselectedOption=null,this._overlayRef&&this._overlayRef.hasAttached()&&(this._overlayRef.detach(),this._closingActionsSubscription.unsubscribe()),this._updatePanelState(),this._componentDestroyed||this._changeDetectorRef.detectChanges())}updatePosition(){this._overlayAttached&&this._overlayRef.updatePosition()}get panelClosingActions(){return Ot(this.optionSelections,this.autocomplete._keyManager.tabOut.pipe(Ve(()=>this._overlayAttached)),this._closeKeyEventStream,this._getOutsideClickStream(),this._overlayRef?this._overlayRef.detachments().pipe(Ve(()=>this._overlayAttached)):re()).pipe(J(n=>n instanceof e1?n:null))}get activeOption(){return this.autocomplete&&this.autocomplete._keyManager?this.autocomplete._keyManager.activeItem:null}_getOutsideClickStream(){return Ot(Vi(this._document,"click"),Vi(this._document,"auxclick"),Vi(this._document,"touchend")).pipe(Ve(n=>{const r=Ir(n),o=this._formField?this._formField._elementRef.nativeElement:null,s=this.connectedTo?this.connectedTo.elementRef.nativeElement:null;return this._overlayAttached&&r!==this._element.nativeElement&&this._document.activeElement!==this._element.nativeElement&&(!o||!o.contains(r))&&(!s||!s.contains(r))&&!!this._overlayRef&&!this._overlayRef.overlayElement.contains(r)}))}writeValue(n){Promise.resolve(null).then(()=>this._assignOptionValue(n))}registerOnChange(n){this._onChange=n}registerOnTouched(n){this._onTouched=n}setDisabledState(n){this._element.nativeElement.disabled=n}_handleKeydown(n){const r=n.keyCode,o=An(n);if(27===r&&!o&&n.preventDefault(),this.activeOption&&13===r&&this.panelOpen&&!o)this.activeOption._selectViaInteraction(),this._resetActiveItem(),n.preventDefault();else if(this.autocomplete){const s=this.autocomplete._keyManager.activeItem,a=38===r||40===r;9===r||a&&!o&&this.panelOpen?this.autocomplete._keyManager.onKeydown(n):a&&this._canOpen()&&this.openPanel(),(a||this.autocomplete._keyManager.activeItem!==s)&&(this._scrollToOption(this.autocomplete._keyManager.activeItemIndex||0),this.autocomplete.autoSelectActiveOption&&this.activeOption&&(this._pendingAutoselectedOption||(this._valueBeforeAutoSelection=this._element.nativeElement.value),this._pendingAutoselectedOption=this.activeOption,this._assignOptionValue(this.activeOption.value)))}}_handleInput(n){let r=n.target,o=r.value;"number"===r.type&&(o=""==o?null:parseFloat(o)),this._previousValue!==o&&(this._previousValue=o,this._pendingAutoselectedOption=null,(!this.autocomplete||!this.autocomplete.requireSelection)&&this._onChange(o),o||this._clearPreviousSelectedOption(null,!1),this._canOpen()&&this._document.activeElement===n.target&&this.openPanel())}_handleFocus(){this._canOpenOnNextFocus?this._canOpen()&&(this._previousValue=this._element.nativeElement.value,this._attachOverlay(),this._floatLabel(!0)):this._canOpenOnNextFocus=!0}_handleClick(){this._canOpen()&&!this.panelOpen&&this.openPanel()}_floatLabel(n=!1){this._formField&&"auto"===this._formField.floatLabel&&(n?this._formField._animateAndLockLabel():this._formField.floatLabel="always",this._manuallyFloatingLabel=!0)}_resetLabel(){this._manuallyFloatingLabel&&(this._formField&&(this._formField.floatLabel="auto"),this._manuallyFloatingLabel=!1)}_subscribeToClosingActions(){return Ot(this._zone.onStable.pipe(Xe(1)),this.autocomplete.options.changes.pipe(it(()=>this._positionStrategy.reapplyLastPosition()),Zv(0))).pipe(Vt(()=>(this._zone.run(()=>{const o=this.panelOpen;this._resetActiveItem(),this._updatePanelState(),this._changeDetectorRef.detectChanges(),this.panelOpen&&this._overlayRef.updatePosition(),o!==this.panelOpen&&(this.panelOpen?this._emitOpened():this.autocomplete.closed.emit())}),this.panelClosingActions)),Xe(1)).subscribe(o=>this._setValueAndClose(o))}_emitOpened(){this._valueOnOpen=this._element.nativeElement.value,this.autocomplete.opened.emit()}_destroyPanel(){this._overlayRef&&(this.closePanel(),this._overlayRef.dispose(),this._overlayRef=null)}_assignOptionValue(n){const r=this.autocomplete&&this.autocomplete.displayWith?this.autocomplete.displayWith(n):n;this._updateNativeInputValue(r??"")}_updateNativeInputValue(n){this._formField?this._formField._control.value=n:this._element.nativeElement.value=n,this._previousValue=n}_setValueAndClose(n){const r=this.autocomplete,o=n?n.source:this._pendingAutoselectedOption;o?(this._clearPreviousSelectedOption(o),this._assignOptionValue(o.value),this._onChange(o.value),r._emitSelectEvent(o),this._element.nativeElement.focus()):r.requireSelection&&this._element.nativeElement.value!==this._valueOnOpen&&(this._clearPreviousSelectedOption(null),this._assignOptionValue(null),r._animationDone?r._animationDone.pipe(Xe(1)).subscribe(()=>this._onChange(null)):this._onChange(null)),this.closePanel()}_clearPreviousSelectedOption(n,r){this.autocomplete?.options?.forEach(o=>{o!==n&&o.selected&&o.deselect(r)})}_attachOverlay(){let n=this._overlayRef;n?(this._positionStrategy.setOrigin(this._getConnectedElement()),n.updateSize({width:this._getPanelWidth()})):(this._portal=new co(this.autocomplete.template,this._viewContainerRef,{id:this._formField?.getLabelId()}),n=this._overlay.create(this._getOverlayConfig()),this._overlayRef=n,this._viewportSubscription=this._viewportRuler.change().subscribe(()=>{this.panelOpen&&n&&n.updateSize({width:this._getPanelWidth()})})),n&&!n.hasAttached()&&(n.attach(this._portal),this._closingActionsSubscription=this._subscribeToClosingActions());const r=this.panelOpen;this.autocomplete._isOpen=this._overlayAttached=!0,this.autocomplete._setColor(this._formField?.color),this._updatePanelState(),this._applyModalPanelOwnership(),this.panelOpen&&r!==this.panelOpen&&this._emitOpened()}_updatePanelState(){if(this.autocomplete._setVisibility(),this.panelOpen){const n=this._overlayRef;this._keydownSubscription||(this._keydownSubscription=n.keydownEvents().subscribe(this._handlePanelKeydown)),this._outsideClickSubscription||(this._outsideClickSubscription=n.outsidePointerEvents().subscribe())}else this._keydownSubscription?.unsubscribe(),this._outsideClickSubscription?.unsubscribe(),this._keydownSubscription=this._outsideClickSubscription=null}_getOverlayConfig(){return new Jl({positionStrategy:this._getOverlayPosition(),scrollStrategy:this._scrollStrategy(),width:this._getPanelWidth(),direction:this._dir??void 0,panelClass:this._defaults?.overlayPanelClass})}_getOverlayPosition(){const n=this._overlay.position().flexibleConnectedTo(this._getConnectedElement()).withFlexibleDimensions(!1).withPush(!1);return this._setStrategyPositions(n),this._positionStrategy=n,n}_setStrategyPositions(n){const r=[{originX:"start",originY:"bottom",overlayX:"start",overlayY:"top"},{originX:"end",originY:"bottom",overlayX:"end",overlayY:"top"}],o=this._aboveClass,s=[{originX:"start",originY:"top",overlayX:"start",overlayY:"bottom",panelClass:o},{originX:"end",originY:"top",overlayX:"end",overlayY:"bottom",panelClass:o}];let a;a="above"===this.position?s:"below"===this.position?r:[...r,...s],n.withPositions(a)}_getConnectedElement(){return this.connectedTo?this.connectedTo.elementRef:this._formField?this._formField.getConnectedOverlayOrigin():this._element}_getPanelWidth(){return this.autocomplete.panelWidth||this._getHostWidth()}_getHostWidth(){return this._getConnectedElement().nativeElement.getBoundingClientRect().width}_resetActiveItem(){const n=this.autocomplete;if(n.autoActiveFirstOption){let r=-1;for(let o=0;o<n.options.length;o++)if(!n.options.get(o).disabled){r=o;break}n._keyManager.setActiveItem(r)}else n._keyManager.setActiveItem(-1)}_canOpen(){const n=this._element.nativeElement;return!n.readOnly&&!n.disabled&&!this._autocompleteDisabled}_getWindow(){return this._document?.defaultView||window}_scrollToOption(n){const r=this.autocomplete,o=t1(n,r.options,r.optionGroups);if(0===n&&1===o)r._setScrollTop(0);else if(r.panel){const s=r.options.toArray()[n];if(s){const a=s._getHostElement(),c=n1(a.offsetTop,a.offsetHeight,r._getScrollTop(),r.panel.nativeElement.offsetHeight);r._setScrollTop(c)}}}_applyModalPanelOwnership(){const n=this._element.nativeElement.closest('body > .cdk-overlay-container [aria-modal="true"]');if(!n)return;const r=this.autocomplete.id;this._trackedModal&&ql(this._trackedModal,"aria-owns",r),Rv(n,"aria-owns",r),this._trackedModal=n}_clearFromModal(){this._trackedModal&&(ql(this._trackedModal,"aria-owns",this.autocomplete.id),this._trackedModal=null)}}return(e=t).\u0275fac=function(n){return new(n||e)(m(W),m(wi),m(pt),m(G),m(Fe),m(KF),m(fn,8),m(Vd,9),m(he,8),m(Rr),m(YF,8))},e.\u0275dir=T({type:e,inputs:{autocomplete:["matAutocomplete","autocomplete"],position:["matAutocompletePosition","position"],connectedTo:["matAutocompleteConnectedTo","connectedTo"],autocompleteAttribute:["autocomplete","autocompleteAttribute"],autocompleteDisabled:["matAutocompleteDisabled","autocompleteDisabled"]},features:[kt]}),t})(),XF=(()=>{var e;class t extends tte{constructor(){super(...arguments),this._aboveClass="mat-mdc-autocomplete-panel-above"}}return(e=t).\u0275fac=function(){let i;return function(r){return(i||(i=be(e)))(r||e)}}(),e.\u0275dir=T({type:e,selectors:[["input","matAutocomplete",""],["textarea","matAutocomplete",""]],hostAttrs:[1,"mat-mdc-autocomplete-trigger"],hostVars:7,hostBindings:function(n,r){1&n&&$("focusin",function(){return r._handleFocus()})("blur",function(){return r._onTouched()})("input",function(s){return r._handleInput(s)})("keydown",function(s){return r._handleKeydown(s)})("click",function(){return r._handleClick()}),2&n&&de("autocomplete",r.autocompleteAttribute)("role",r.autocompleteDisabled?null:"combobox")("aria-autocomplete",r.autocompleteDisabled?null:"list")("aria-activedescendant",r.panelOpen&&r.activeOption?r.activeOption.id:null)("aria-expanded",r.autocompleteDisabled?null:r.panelOpen.toString())("aria-controls",r.autocompleteDisabled||!r.panelOpen||null==r.autocomplete?null:r.autocomplete.id)("aria-haspopup",r.autocompleteDisabled?null:"listbox")},exportAs:["matAutocompleteTrigger"],features:[Z([Zee]),P]}),t})(),nte=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275mod=le({type:e}),e.\u0275inj=ae({providers:[ete],imports:[ed,Yl,ve,vn,lo,Yl,ve]}),t})();const ite=["*"],cte=new M("MAT_CARD_CONFIG");let ZF=(()=>{var e;class t{constructor(n){this.appearance=n?.appearance||"raised"}}return(e=t).\u0275fac=function(n){return new(n||e)(m(cte,8))},e.\u0275cmp=fe({type:e,selectors:[["mat-card"]],hostAttrs:[1,"mat-mdc-card","mdc-card"],hostVars:4,hostBindings:function(n,r){2&n&&se("mat-mdc-card-outlined","outlined"===r.appearance)("mdc-card--outlined","outlined"===r.appearance)},inputs:{appearance:"appearance"},exportAs:["matCard"],ngContentSelectors:ite,decls:1,vars:0,template:function(n,r){1&n&&(ze(),X(0))},styles:['.mdc-card{display:flex;flex-direction:column;box-sizing:border-box}.mdc-card::after{position:absolute;box-sizing:border-box;width:100%;height:100%;top:0;left:0;border:1px solid rgba(0,0,0,0);border-radius:inherit;content:"";pointer-events:none;pointer-events:none}@media screen and (forced-colors: active){.mdc-card::after{border-color:CanvasText}}.mdc-card--outlined::after{border:none}.mdc-card__content{border-radius:inherit;height:100%}.mdc-card__media{position:relative;box-sizing:border-box;background-repeat:no-repeat;background-position:center;background-size:cover}.mdc-card__media::before{display:block;content:""}.mdc-card__media:first-child{border-top-left-radius:inherit;border-top-right-radius:inherit}.mdc-card__media:last-child{border-bottom-left-radius:inherit;border-bottom-right-radius:inherit}.mdc-card__media--square::before{margin-top:100%}.mdc-card__media--16-9::before{margin-top:56.25%}.mdc-card__media-content{position:absolute;top:0;right:0;bottom:0;left:0;box-sizing:border-box}.mdc-card__primary-action{display:flex;flex-direction:column;box-sizing:border-box;position:relative;outline:none;color:inherit;text-decoration:none;cursor:pointer;overflow:hidden}.mdc-card__primary-action:first-child{border-top-left-radius:inherit;border-top-right-radius:inherit}.mdc-card__primary-action:last-child{border-bottom-left-radius:inherit;border-bottom-right-radius:inherit}.mdc-card__actions{display:flex;flex-direction:row;align-items:center;box-sizing:border-box;min-height:52px;padding:8px}.mdc-card__actions--full-bleed{padding:0}.mdc-card__action-buttons,.mdc-card__action-icons{display:flex;flex-direction:row;align-items:center;box-sizing:border-box}.mdc-card__action-icons{color:rgba(0, 0, 0, 0.6);flex-grow:1;justify-content:flex-end}.mdc-card__action-buttons+.mdc-card__action-icons{margin-left:16px;margin-right:0}[dir=rtl] .mdc-card__action-buttons+.mdc-card__action-icons,.mdc-card__action-buttons+.mdc-card__action-icons[dir=rtl]{margin-left:0;margin-right:16px}.mdc-card__action{display:inline-flex;flex-direction:row;align-items:center;box-sizing:border-box;justify-content:center;cursor:pointer;user-select:none}.mdc-card__action:focus{outline:none}.mdc-card__action--button{margin-left:0;margin-right:8px;padding:0 8px}[dir=rtl] .mdc-card__action--button,.mdc-card__action--button[dir=rtl]{margin-left:8px;margin-right:0}.mdc-card__action--button:last-child{margin-left:0;margin-right:0}[dir=rtl] .mdc-card__action--button:last-child,.mdc-card__action--button:last-child[dir=rtl]{margin-left:0;margin-right:0}.mdc-card__actions--full-bleed .mdc-card__action--button{justify-content:space-between;width:100%;height:auto;max-height:none;margin:0;padding:8px 16px;text-align:left}[dir=rtl] .mdc-card__actions--full-bleed .mdc-card__action--button,.mdc-card__actions--full-bleed .mdc-card__action--button[dir=rtl]{text-align:right}.mdc-card__action--icon{margin:-6px 0;padding:12px}.mdc-card__action--icon:not(:disabled){color:rgba(0, 0, 0, 0.6)}.mat-mdc-card{border-radius:var(--mdc-elevated-card-container-shape);background-color:var(--mdc-elevated-card-container-color);border-width:0;border-style:solid;border-color:var(--mdc-elevated-card-container-color);box-shadow:var(--mdc-elevated-card-container-elevation);--mdc-elevated-card-container-shape:4px;--mdc-outlined-card-container-shape:4px;--mdc-outlined-card-outline-width:1px}.mat-mdc-card .mdc-card::after{border-radius:var(--mdc-elevated-card-container-shape)}.mat-mdc-card-outlined{border-width:var(--mdc-outlined-card-outline-width);border-style:solid;border-color:var(--mdc-outlined-card-outline-color);border-radius:var(--mdc-outlined-card-container-shape);background-color:var(--mdc-outlined-card-container-color);box-shadow:var(--mdc-outlined-card-container-elevation)}.mat-mdc-card-outlined .mdc-card::after{border-radius:var(--mdc-outlined-card-container-shape)}.mat-mdc-card-title{font-family:var(--mat-card-title-text-font);line-height:var(--mat-card-title-text-line-height);font-size:var(--mat-card-title-text-size);letter-spacing:var(--mat-card-title-text-tracking);font-weight:var(--mat-card-title-text-weight)}.mat-mdc-card-subtitle{color:var(--mat-card-subtitle-text-color);font-family:var(--mat-card-subtitle-text-font);line-height:var(--mat-card-subtitle-text-line-height);font-size:var(--mat-card-subtitle-text-size);letter-spacing:var(--mat-card-subtitle-text-tracking);font-weight:var(--mat-card-subtitle-text-weight)}.mat-mdc-card{position:relative}.mat-mdc-card-title,.mat-mdc-card-subtitle{display:block;margin:0}.mat-mdc-card-avatar~.mat-mdc-card-header-text .mat-mdc-card-title,.mat-mdc-card-avatar~.mat-mdc-card-header-text .mat-mdc-card-subtitle{padding:16px 16px 0}.mat-mdc-card-header{display:flex;padding:16px 16px 0}.mat-mdc-card-content{display:block;padding:0 16px}.mat-mdc-card-content:first-child{padding-top:16px}.mat-mdc-card-content:last-child{padding-bottom:16px}.mat-mdc-card-title-group{display:flex;justify-content:space-between;width:100%}.mat-mdc-card-avatar{height:40px;width:40px;border-radius:50%;flex-shrink:0;margin-bottom:16px;object-fit:cover}.mat-mdc-card-avatar~.mat-mdc-card-header-text .mat-mdc-card-subtitle,.mat-mdc-card-avatar~.mat-mdc-card-header-text .mat-mdc-card-title{line-height:normal}.mat-mdc-card-sm-image{width:80px;height:80px}.mat-mdc-card-md-image{width:112px;height:112px}.mat-mdc-card-lg-image{width:152px;height:152px}.mat-mdc-card-xl-image{width:240px;height:240px}.mat-mdc-card-subtitle~.mat-mdc-card-title,.mat-mdc-card-title~.mat-mdc-card-subtitle,.mat-mdc-card-header .mat-mdc-card-header-text .mat-mdc-card-title,.mat-mdc-card-header .mat-mdc-card-header-text .mat-mdc-card-subtitle,.mat-mdc-card-title-group .mat-mdc-card-title,.mat-mdc-card-title-group .mat-mdc-card-subtitle{padding-top:0}.mat-mdc-card-content>:last-child:not(.mat-mdc-card-footer){margin-bottom:0}.mat-mdc-card-actions-align-end{justify-content:flex-end}'],encapsulation:2,changeDetection:0}),t})(),JF=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275dir=T({type:e,selectors:[["mat-card-content"]],hostAttrs:[1,"mat-mdc-card-content"]}),t})(),eP=(()=>{var e;class t{constructor(){this.align="start"}}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275dir=T({type:e,selectors:[["mat-card-actions"]],hostAttrs:[1,"mat-mdc-card-actions","mdc-card__actions"],hostVars:2,hostBindings:function(n,r){2&n&&se("mat-mdc-card-actions-align-end","end"===r.align)},inputs:{align:"align"},exportAs:["matCardActions"]}),t})(),hte=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275mod=le({type:e}),e.\u0275inj=ae({imports:[ve,vn,ve]}),t})();const fte=["input"],pte=["label"],mte=["*"],gte=new M("mat-checkbox-default-options",{providedIn:"root",factory:tP});function tP(){return{color:"accent",clickAction:"check-indeterminate"}}const _te={provide:Gn,useExisting:He(()=>iP),multi:!0};class bte{}let vte=0;const nP=tP(),yte=ns(ts(so(es(class{constructor(e){this._elementRef=e}}))));let wte=(()=>{var e;class t extends yte{get inputId(){return`${this.id||this._uniqueId}-input`}get required(){return this._required}set required(n){this._required=Q(n)}constructor(n,r,o,s,a,c,l){super(r),this._changeDetectorRef=o,this._ngZone=s,this._animationMode=c,this._options=l,this.ariaLabel="",this.ariaLabelledby=null,this.labelPosition="after",this.name=null,this.change=new U,this.indeterminateChange=new U,this._onTouched=()=>{},this._currentAnimationClass="",this._currentCheckState=0,this._controlValueAccessorChangeFn=()=>{},this._checked=!1,this._disabled=!1,this._indeterminate=!1,this._options=this._options||nP,this.color=this.defaultColor=this._options.color||nP.color,this.tabIndex=parseInt(a)||0,this.id=this._uniqueId=`${n}${++vte}`}ngAfterViewInit(){this._syncIndeterminate(this._indeterminate)}get checked(){return this._checked}set checked(n){const r=Q(n);r!=this.checked&&(this._checked=r,this._changeDetectorRef.markForCheck())}get disabled(){return this._disabled}set disabled(n){const r=Q(n);r!==this.disabled&&(this._disabled=r,this._changeDetectorRef.markForCheck())}get indeterminate(){return this._indeterminate}set indeterminate(n){const r=n!=this._indeterminate;this._indeterminate=Q(n),r&&(this._transitionCheckState(this._indeterminate?3:this.checked?1:2),this.indeterminateChange.emit(this._indeterminate)),this._syncIndeterminate(this._indeterminate)}_isRippleDisabled(){return this.disableRipple||this.disabled}_onLabelTextChange(){this._changeDetectorRef.detectChanges()}writeValue(n){this.checked=!!n}registerOnChange(n){this._controlValueAccessorChangeFn=n}registerOnTouched(n){this._onTouched=n}setDisabledState(n){this.disabled=n}_transitionCheckState(n){let r=this._currentCheckState,o=this._getAnimationTargetElement();if(r!==n&&o&&(this._currentAnimationClass&&o.classList.remove(this._currentAnimationClass),this._currentAnimationClass=this._getAnimationClassForCheckStateTransition(r,n),this._currentCheckState=n,this._currentAnimationClass.length>0)){o.classList.add(this._currentAnimationClass);const s=this._currentAnimationClass;this._ngZone.runOutsideAngular(()=>{setTimeout(()=>{o.classList.remove(s)},1e3)})}}_emitChangeEvent(){this._controlValueAccessorChangeFn(this.checked),this.change.emit(this._createChangeEvent(this.checked)),this._inputElement&&(this._inputElement.nativeElement.checked=this.checked)}toggle(){this.checked=!this.checked,this._controlValueAccessorChangeFn(this.checked)}_handleInputClick(){const n=this._options?.clickAction;this.disabled||"noop"===n?!this.disabled&&"noop"===n&&(this._inputElement.nativeElement.checked=this.checked,this._inputElement.nativeElement.indeterminate=this.indeterminate):(this.indeterminate&&"check"!==n&&Promise.resolve().then(()=>{this._indeterminate=!1,this.indeterminateChange.emit(this._indeterminate)}),this._checked=!this._checked,this._transitionCheckState(this._checked?1:2),this._emitChangeEvent())}_onInteractionEvent(n){n.stopPropagation()}_onBlur(){Promise.resolve().then(()=>{this._onTouched(),this._changeDetectorRef.markForCheck()})}_getAnimationClassForCheckStateTransition(n,r){if("NoopAnimations"===this._animationMode)return"";switch(n){case 0:if(1===r)return this._animationClasses.uncheckedToChecked;if(3==r)return this._checked?this._animationClasses.checkedToIndeterminate:this._animationClasses.uncheckedToIndeterminate;break;case 2:return 1===r?this._animationClasses.uncheckedToChecked:this._animationClasses.uncheckedToIndeterminate;case 1:return 2===r?this._animationClasses.checkedToUnchecked:this._animationClasses.checkedToIndeterminate;case 3:return 1===r?this._animationClasses.indeterminateToChecked:this._animationClasses.indeterminateToUnchecked}return""}_syncIndeterminate(n){const r=this._inputElement;r&&(r.nativeElement.indeterminate=n)}}return(e=t).\u0275fac=function(n){jo()},e.\u0275dir=T({type:e,viewQuery:function(n,r){if(1&n&&(ke(fte,5),ke(pte,5),ke(ao,5)),2&n){let o;H(o=z())&&(r._inputElement=o.first),H(o=z())&&(r._labelElement=o.first),H(o=z())&&(r.ripple=o.first)}},inputs:{ariaLabel:["aria-label","ariaLabel"],ariaLabelledby:["aria-labelledby","ariaLabelledby"],ariaDescribedby:["aria-describedby","ariaDescribedby"],id:"id",required:"required",labelPosition:"labelPosition",name:"name",value:"value",checked:"checked",disabled:"disabled",indeterminate:"indeterminate"},outputs:{change:"change",indeterminateChange:"indeterminateChange"},features:[P]}),t})(),iP=(()=>{var e;class t extends wte{constructor(n,r,o,s,a,c){super("mat-mdc-checkbox-",n,r,o,s,a,c),this._animationClasses={uncheckedToChecked:"mdc-checkbox--anim-unchecked-checked",uncheckedToIndeterminate:"mdc-checkbox--anim-unchecked-indeterminate",checkedToUnchecked:"mdc-checkbox--anim-checked-unchecked",checkedToIndeterminate:"mdc-checkbox--anim-checked-indeterminate",indeterminateToChecked:"mdc-checkbox--anim-indeterminate-checked",indeterminateToUnchecked:"mdc-checkbox--anim-indeterminate-unchecked"}}focus(){this._inputElement.nativeElement.focus()}_createChangeEvent(n){const r=new bte;return r.source=this,r.checked=n,r}_getAnimationTargetElement(){return this._inputElement?.nativeElement}_onInputClick(){super._handleInputClick()}_onTouchTargetClick(){super._handleInputClick(),this.disabled||this._inputElement.nativeElement.focus()}_preventBubblingFromLabel(n){n.target&&this._labelElement.nativeElement.contains(n.target)&&n.stopPropagation()}}return(e=t).\u0275fac=function(n){return new(n||e)(m(W),m(Fe),m(G),ui("tabindex"),m(_t,8),m(gte,8))},e.\u0275cmp=fe({type:e,selectors:[["mat-checkbox"]],hostAttrs:[1,"mat-mdc-checkbox"],hostVars:12,hostBindings:function(n,r){2&n&&(pi("id",r.id),de("tabindex",null)("aria-label",null)("aria-labelledby",null),se("_mat-animation-noopable","NoopAnimations"===r._animationMode)("mdc-checkbox--disabled",r.disabled)("mat-mdc-checkbox-disabled",r.disabled)("mat-mdc-checkbox-checked",r.checked))},inputs:{disableRipple:"disableRipple",color:"color",tabIndex:"tabIndex"},exportAs:["matCheckbox"],features:[Z([_te]),P],ngContentSelectors:mte,decls:15,vars:19,consts:[[1,"mdc-form-field",3,"click"],[1,"mdc-checkbox"],["checkbox",""],[1,"mat-mdc-checkbox-touch-target",3,"click"],["type","checkbox",1,"mdc-checkbox__native-control",3,"checked","indeterminate","disabled","id","required","tabIndex","blur","click","change"],["input",""],[1,"mdc-checkbox__ripple"],[1,"mdc-checkbox__background"],["focusable","false","viewBox","0 0 24 24","aria-hidden","true",1,"mdc-checkbox__checkmark"],["fill","none","d","M1.73,12.91 8.1,19.28 22.79,4.59",1,"mdc-checkbox__checkmark-path"],[1,"mdc-checkbox__mixedmark"],["mat-ripple","",1,"mat-mdc-checkbox-ripple","mat-mdc-focus-indicator",3,"matRippleTrigger","matRippleDisabled","matRippleCentered"],[1,"mdc-label",3,"for"],["label",""]],template:function(n,r){if(1&n&&(ze(),y(0,"div",0),$("click",function(s){return r._preventBubblingFromLabel(s)}),y(1,"div",1,2)(3,"div",3),$("click",function(){return r._onTouchTargetClick()}),w(),y(4,"input",4,5),$("blur",function(){return r._onBlur()})("click",function(){return r._onInputClick()})("change",function(s){return r._onInteractionEvent(s)}),w(),ie(6,"div",6),y(7,"div",7),zs(),y(8,"svg",8),ie(9,"path",9),w(),ag(),ie(10,"div",10),w(),ie(11,"div",11),w(),y(12,"label",12,13),X(14),w()()),2&n){const o=hn(2);se("mdc-form-field--align-end","before"==r.labelPosition),x(4),se("mdc-checkbox--selected",r.checked),E("checked",r.checked)("indeterminate",r.indeterminate)("disabled",r.disabled)("id",r.inputId)("required",r.required)("tabIndex",r.tabIndex),de("aria-label",r.ariaLabel||null)("aria-labelledby",r.ariaLabelledby)("aria-describedby",r.ariaDescribedby)("name",r.name)("value",r.value),x(7),E("matRippleTrigger",o)("matRippleDisabled",r.disableRipple||r.disabled)("matRippleCentered",!0),x(1),E("for",r.inputId)}},dependencies:[ao],styles:['.mdc-touch-target-wrapper{display:inline}@keyframes mdc-checkbox-unchecked-checked-checkmark-path{0%,50%{stroke-dashoffset:29.7833385}50%{animation-timing-function:cubic-bezier(0, 0, 0.2, 1)}100%{stroke-dashoffset:0}}@keyframes mdc-checkbox-unchecked-indeterminate-mixedmark{0%,68.2%{transform:scaleX(0)}68.2%{animation-timing-function:cubic-bezier(0, 0, 0, 1)}100%{transform:scaleX(1)}}@keyframes mdc-checkbox-checked-unchecked-checkmark-path{from{animation-timing-function:cubic-bezier(0.4, 0, 1, 1);opacity:1;stroke-dashoffset:0}to{opacity:0;stroke-dashoffset:-29.7833385}}@keyframes mdc-checkbox-checked-indeterminate-checkmark{from{animation-timing-function:cubic-bezier(0, 0, 0.2, 1);transform:rotate(0deg);opacity:1}to{transform:rotate(45deg);opacity:0}}@keyframes mdc-checkbox-indeterminate-checked-checkmark{from{animation-timing-function:cubic-bezier(0.14, 0, 0, 1);transform:rotate(45deg);opacity:0}to{transform:rotate(360deg);opacity:1}}@keyframes mdc-checkbox-checked-indeterminate-mixedmark{from{animation-timing-function:mdc-animation-deceleration-curve-timing-function;transform:rotate(-45deg);opacity:0}to{transform:rotate(0deg);opacity:1}}@keyframes mdc-checkbox-indeterminate-checked-mixedmark{from{animation-timing-function:cubic-bezier(0.14, 0, 0, 1);transform:rotate(0deg);opacity:1}to{transform:rotate(315deg);opacity:0}}@keyframes mdc-checkbox-indeterminate-unchecked-mixedmark{0%{animation-timing-function:linear;transform:scaleX(1);opacity:1}32.8%,100%{transform:scaleX(0);opacity:0}}.mdc-checkbox{display:inline-block;position:relative;flex:0 0 18px;box-sizing:content-box;width:18px;height:18px;line-height:0;white-space:nowrap;cursor:pointer;vertical-align:bottom}.mdc-checkbox[hidden]{display:none}.mdc-checkbox.mdc-ripple-upgraded--background-focused .mdc-checkbox__focus-ring,.mdc-checkbox:not(.mdc-ripple-upgraded):focus .mdc-checkbox__focus-ring{pointer-events:none;border:2px solid rgba(0,0,0,0);border-radius:6px;box-sizing:content-box;position:absolute;top:50%;left:50%;transform:translate(-50%, -50%);height:100%;width:100%}@media screen and (forced-colors: active){.mdc-checkbox.mdc-ripple-upgraded--background-focused .mdc-checkbox__focus-ring,.mdc-checkbox:not(.mdc-ripple-upgraded):focus .mdc-checkbox__focus-ring{border-color:CanvasText}}.mdc-checkbox.mdc-ripple-upgraded--background-focused .mdc-checkbox__focus-ring::after,.mdc-checkbox:not(.mdc-ripple-upgraded):focus .mdc-checkbox__focus-ring::after{content:"";border:2px solid rgba(0,0,0,0);border-radius:8px;display:block;position:absolute;top:50%;left:50%;transform:translate(-50%, -50%);height:calc(100% + 4px);width:calc(100% + 4px)}@media screen and (forced-colors: active){.mdc-checkbox.mdc-ripple-upgraded--background-focused .mdc-checkbox__focus-ring::after,.mdc-checkbox:not(.mdc-ripple-upgraded):focus .mdc-checkbox__focus-ring::after{border-color:CanvasText}}@media all and (-ms-high-contrast: none){.mdc-checkbox .mdc-checkbox__focus-ring{display:none}}@media screen and (forced-colors: active),(-ms-high-contrast: active){.mdc-checkbox__mixedmark{margin:0 1px}}.mdc-checkbox--disabled{cursor:default;pointer-events:none}.mdc-checkbox__background{display:inline-flex;position:absolute;align-items:center;justify-content:center;box-sizing:border-box;width:18px;height:18px;border:2px solid currentColor;border-radius:2px;background-color:rgba(0,0,0,0);pointer-events:none;will-change:background-color,border-color;transition:background-color 90ms 0ms cubic-bezier(0.4, 0, 0.6, 1),border-color 90ms 0ms cubic-bezier(0.4, 0, 0.6, 1)}.mdc-checkbox__checkmark{position:absolute;top:0;right:0;bottom:0;left:0;width:100%;opacity:0;transition:opacity 180ms 0ms cubic-bezier(0.4, 0, 0.6, 1)}.mdc-checkbox--upgraded .mdc-checkbox__checkmark{opacity:1}.mdc-checkbox__checkmark-path{transition:stroke-dashoffset 180ms 0ms cubic-bezier(0.4, 0, 0.6, 1);stroke:currentColor;stroke-width:3.12px;stroke-dashoffset:29.7833385;stroke-dasharray:29.7833385}.mdc-checkbox__mixedmark{width:100%;height:0;transform:scaleX(0) rotate(0deg);border-width:1px;border-style:solid;opacity:0;transition:opacity 90ms 0ms cubic-bezier(0.4, 0, 0.6, 1),transform 90ms 0ms cubic-bezier(0.4, 0, 0.6, 1)}.mdc-checkbox--anim-unchecked-checked .mdc-checkbox__background,.mdc-checkbox--anim-unchecked-indeterminate .mdc-checkbox__background,.mdc-checkbox--anim-checked-unchecked .mdc-checkbox__background,.mdc-checkbox--anim-indeterminate-unchecked .mdc-checkbox__background{animation-duration:180ms;animation-timing-function:linear}.mdc-checkbox--anim-unchecked-checked .mdc-checkbox__checkmark-path{animation:mdc-checkbox-unchecked-checked-checkmark-path 180ms linear 0s;transition:none}.mdc-checkbox--anim-unchecked-indeterminate .mdc-checkbox__mixedmark{animation:mdc-checkbox-unchecked-indeterminate-mixedmark 90ms linear 0s;transition:none}.mdc-checkbox--anim-checked-unchecked .mdc-checkbox__checkmark-path{animation:mdc-checkbox-checked-unchecked-checkmark-path 90ms linear 0s;transition:none}.mdc-checkbox--anim-checked-indeterminate .mdc-checkbox__checkmark{animation:mdc-checkbox-checked-indeterminate-checkmark 90ms linear 0s;transition:none}.mdc-checkbox--anim-checked-indeterminate .mdc-checkbox__mixedmark{animation:mdc-checkbox-checked-indeterminate-mixedmark 90ms linear 0s;transition:none}.mdc-checkbox--anim-indeterminate-checked .mdc-checkbox__checkmark{animation:mdc-checkbox-indeterminate-checked-checkmark 500ms linear 0s;transition:none}.mdc-checkbox--anim-indeterminate-checked .mdc-checkbox__mixedmark{animation:mdc-checkbox-indeterminate-checked-mixedmark 500ms linear 0s;transition:none}.mdc-checkbox--anim-indeterminate-unchecked .mdc-checkbox__mixedmark{animation:mdc-checkbox-indeterminate-unchecked-mixedmark 300ms linear 0s;transition:none}.mdc-checkbox__native-control:checked~.mdc-checkbox__background,.mdc-checkbox__native-control:indeterminate~.mdc-checkbox__background,.mdc-checkbox__native-control[data-indeterminate=true]~.mdc-checkbox__background{transition:border-color 90ms 0ms cubic-bezier(0, 0, 0.2, 1),background-color 90ms 0ms cubic-bezier(0, 0, 0.2, 1)}.mdc-checkbox__native-control:checked~.mdc-checkbox__background .mdc-checkbox__checkmark-path,.mdc-checkbox__native-control:indeterminate~.mdc-checkbox__background .mdc-checkbox__checkmark-path,.mdc-checkbox__native-control[data-indeterminate=true]~.mdc-checkbox__background .mdc-checkbox__checkmark-path{stroke-dashoffset:0}.mdc-checkbox__native-control{position:absolute;margin:0;padding:0;opacity:0;cursor:inherit}.mdc-checkbox__native-control:disabled{cursor:default;pointer-events:none}.mdc-checkbox--touch{margin:calc((var(--mdc-checkbox-state-layer-size) - var(--mdc-checkbox-state-layer-size)) / 2)}.mdc-checkbox--touch .mdc-checkbox__native-control{top:calc((var(--mdc-checkbox-state-layer-size) - var(--mdc-checkbox-state-layer-size)) / 2);right:calc((var(--mdc-checkbox-state-layer-size) - var(--mdc-checkbox-state-layer-size)) / 2);left:calc((var(--mdc-checkbox-state-layer-size) - var(--mdc-checkbox-state-layer-size)) / 2);width:var(--mdc-checkbox-state-layer-size);height:var(--mdc-checkbox-state-layer-size)}.mdc-checkbox__native-control:checked~.mdc-checkbox__background .mdc-checkbox__checkmark{transition:opacity 180ms 0ms cubic-bezier(0, 0, 0.2, 1),transform 180ms 0ms cubic-bezier(0, 0, 0.2, 1);opacity:1}.mdc-checkbox__native-control:checked~.mdc-checkbox__background .mdc-checkbox__mixedmark{transform:scaleX(1) rotate(-45deg)}.mdc-checkbox__native-control:indeterminate~.mdc-checkbox__background .mdc-checkbox__checkmark,.mdc-checkbox__native-control[data-indeterminate=true]~.mdc-checkbox__background .mdc-checkbox__checkmark{transform:rotate(45deg);opacity:0;transition:opacity 90ms 0ms cubic-bezier(0.4, 0, 0.6, 1),transform 90ms 0ms cubic-bezier(0.4, 0, 0.6, 1)}.mdc-checkbox__native-control:indeterminate~.mdc-checkbox__background .mdc-checkbox__mixedmark,.mdc-checkbox__native-control[data-indeterminate=true]~.mdc-checkbox__background .mdc-checkbox__mixedmark{transform:scaleX(1) rotate(0deg);opacity:1}.mdc-checkbox.mdc-checkbox--upgraded .mdc-checkbox__background,.mdc-checkbox.mdc-checkbox--upgraded .mdc-checkbox__checkmark,.mdc-checkbox.mdc-checkbox--upgraded .mdc-checkbox__checkmark-path,.mdc-checkbox.mdc-checkbox--upgraded .mdc-checkbox__mixedmark{transition:none}.mdc-form-field{display:inline-flex;align-items:center;vertical-align:middle}.mdc-form-field[hidden]{display:none}.mdc-form-field>label{margin-left:0;margin-right:auto;padding-left:4px;padding-right:0;order:0}[dir=rtl] .mdc-form-field>label,.mdc-form-field>label[dir=rtl]{margin-left:auto;margin-right:0}[dir=rtl] .mdc-form-field>label,.mdc-form-field>label[dir=rtl]{padding-left:0;padding-right:4px}.mdc-form-field--nowrap>label{text-overflow:ellipsis;overflow:hidden;white-space:nowrap}.mdc-form-field--align-end>label{margin-left:auto;margin-right:0;padding-left:0;padding-right:4px;order:-1}[dir=rtl] .mdc-form-field--align-end>label,.mdc-form-field--align-end>label[dir=rtl]{margin-left:0;margin-right:auto}[dir=rtl] .mdc-form-field--align-end>label,.mdc-form-field--align-end>label[dir=rtl]{padding-left:4px;padding-right:0}.mdc-form-field--space-between{justify-content:space-between}.mdc-form-field--space-between>label{margin:0}[dir=rtl] .mdc-form-field--space-between>label,.mdc-form-field--space-between>label[dir=rtl]{margin:0}.mdc-checkbox{padding:calc((var(--mdc-checkbox-state-layer-size) - 18px) / 2);margin:calc((var(--mdc-checkbox-state-layer-size) - var(--mdc-checkbox-state-layer-size)) / 2)}.mdc-checkbox .mdc-checkbox__native-control[disabled]:not(:checked):not(:indeterminate):not([data-indeterminate=true])~.mdc-checkbox__background{border-color:var(--mdc-checkbox-disabled-unselected-icon-color);background-color:transparent}.mdc-checkbox .mdc-checkbox__native-control[disabled]:checked~.mdc-checkbox__background,.mdc-checkbox .mdc-checkbox__native-control[disabled]:indeterminate~.mdc-checkbox__background,.mdc-checkbox .mdc-checkbox__native-control[data-indeterminate=true][disabled]~.mdc-checkbox__background{border-color:transparent;background-color:var(--mdc-checkbox-disabled-selected-icon-color)}.mdc-checkbox .mdc-checkbox__native-control:enabled~.mdc-checkbox__background .mdc-checkbox__checkmark{color:var(--mdc-checkbox-selected-checkmark-color)}.mdc-checkbox .mdc-checkbox__native-control:enabled~.mdc-checkbox__background .mdc-checkbox__mixedmark{border-color:var(--mdc-checkbox-selected-checkmark-color)}.mdc-checkbox .mdc-checkbox__native-control:disabled~.mdc-checkbox__background .mdc-checkbox__checkmark{color:var(--mdc-checkbox-disabled-selected-checkmark-color)}.mdc-checkbox .mdc-checkbox__native-control:disabled~.mdc-checkbox__background .mdc-checkbox__mixedmark{border-color:var(--mdc-checkbox-disabled-selected-checkmark-color)}.mdc-checkbox .mdc-checkbox__native-control:enabled:not(:checked):not(:indeterminate):not([data-indeterminate=true])~.mdc-checkbox__background{border-color:var(--mdc-checkbox-unselected-icon-color);background-color:transparent}.mdc-checkbox .mdc-checkbox__native-control:enabled:checked~.mdc-checkbox__background,.mdc-checkbox .mdc-checkbox__native-control:enabled:indeterminate~.mdc-checkbox__background,.mdc-checkbox .mdc-checkbox__native-control[data-indeterminate=true]:enabled~.mdc-checkbox__background{border-color:var(--mdc-checkbox-selected-icon-color);background-color:var(--mdc-checkbox-selected-icon-color)}@keyframes mdc-checkbox-fade-in-background-8A000000FFF4433600000000FFF44336{0%{border-color:var(--mdc-checkbox-unselected-icon-color);background-color:transparent}50%{border-color:var(--mdc-checkbox-selected-icon-color);background-color:var(--mdc-checkbox-selected-icon-color)}}@keyframes mdc-checkbox-fade-out-background-8A000000FFF4433600000000FFF44336{0%,80%{border-color:var(--mdc-checkbox-selected-icon-color);background-color:var(--mdc-checkbox-selected-icon-color)}100%{border-color:var(--mdc-checkbox-unselected-icon-color);background-color:transparent}}.mdc-checkbox.mdc-checkbox--anim-unchecked-checked .mdc-checkbox__native-control:enabled~.mdc-checkbox__background,.mdc-checkbox.mdc-checkbox--anim-unchecked-indeterminate .mdc-checkbox__native-control:enabled~.mdc-checkbox__background{animation-name:mdc-checkbox-fade-in-background-8A000000FFF4433600000000FFF44336}.mdc-checkbox.mdc-checkbox--anim-checked-unchecked .mdc-checkbox__native-control:enabled~.mdc-checkbox__background,.mdc-checkbox.mdc-checkbox--anim-indeterminate-unchecked .mdc-checkbox__native-control:enabled~.mdc-checkbox__background{animation-name:mdc-checkbox-fade-out-background-8A000000FFF4433600000000FFF44336}.mdc-checkbox:hover .mdc-checkbox__native-control:enabled:not(:checked):not(:indeterminate):not([data-indeterminate=true])~.mdc-checkbox__background{border-color:var(--mdc-checkbox-unselected-hover-icon-color);background-color:transparent}.mdc-checkbox:hover .mdc-checkbox__native-control:enabled:checked~.mdc-checkbox__background,.mdc-checkbox:hover .mdc-checkbox__native-control:enabled:indeterminate~.mdc-checkbox__background,.mdc-checkbox:hover .mdc-checkbox__native-control[data-indeterminate=true]:enabled~.mdc-checkbox__background{border-color:var(--mdc-checkbox-selected-hover-icon-color);background-color:var(--mdc-checkbox-selected-hover-icon-color)}@keyframes mdc-checkbox-fade-in-background-FF212121FFF4433600000000FFF44336{0%{border-color:var(--mdc-checkbox-unselected-hover-icon-color);background-color:transparent}50%{border-color:var(--mdc-checkbox-selected-hover-icon-color);background-color:var(--mdc-checkbox-selected-hover-icon-color)}}@keyframes mdc-checkbox-fade-out-background-FF212121FFF4433600000000FFF44336{0%,80%{border-color:var(--mdc-checkbox-selected-hover-icon-color);background-color:var(--mdc-checkbox-selected-hover-icon-color)}100%{border-color:var(--mdc-checkbox-unselected-hover-icon-color);background-color:transparent}}.mdc-checkbox:hover.mdc-checkbox--anim-unchecked-checked .mdc-checkbox__native-control:enabled~.mdc-checkbox__background,.mdc-checkbox:hover.mdc-checkbox--anim-unchecked-indeterminate .mdc-checkbox__native-control:enabled~.mdc-checkbox__background{animation-name:mdc-checkbox-fade-in-background-FF212121FFF4433600000000FFF44336}.mdc-checkbox:hover.mdc-checkbox--anim-checked-unchecked .mdc-checkbox__native-control:enabled~.mdc-checkbox__background,.mdc-checkbox:hover.mdc-checkbox--anim-indeterminate-unchecked .mdc-checkbox__native-control:enabled~.mdc-checkbox__background{animation-name:mdc-checkbox-fade-out-background-FF212121FFF4433600000000FFF44336}.mdc-checkbox:not(:disabled):active .mdc-checkbox__native-control:enabled:not(:checked):not(:indeterminate):not([data-indeterminate=true])~.mdc-checkbox__background{border-color:var(--mdc-checkbox-unselected-pressed-icon-color);background-color:transparent}.mdc-checkbox:not(:disabled):active .mdc-checkbox__native-control:enabled:checked~.mdc-checkbox__background,.mdc-checkbox:not(:disabled):active .mdc-checkbox__native-control:enabled:indeterminate~.mdc-checkbox__background,.mdc-checkbox:not(:disabled):active .mdc-checkbox__native-control[data-indeterminate=true]:enabled~.mdc-checkbox__background{border-color:var(--mdc-checkbox-selected-pressed-icon-color);background-color:var(--mdc-checkbox-selected-pressed-icon-color)}@keyframes mdc-checkbox-fade-in-background-8A000000FFF4433600000000FFF44336{0%{border-color:var(--mdc-checkbox-unselected-pressed-icon-color);background-color:transparent}50%{border-color:var(--mdc-checkbox-selected-pressed-icon-color);background-color:var(--mdc-checkbox-selected-pressed-icon-color)}}@keyframes mdc-checkbox-fade-out-background-8A000000FFF4433600000000FFF44336{0%,80%{border-color:var(--mdc-checkbox-selected-pressed-icon-color);background-color:var(--mdc-checkbox-selected-pressed-icon-color)}100%{border-color:var(--mdc-checkbox-unselected-pressed-icon-color);background-color:transparent}}.mdc-checkbox:not(:disabled):active.mdc-checkbox--anim-unchecked-checked .mdc-checkbox__native-control:enabled~.mdc-checkbox__background,.mdc-checkbox:not(:disabled):active.mdc-checkbox--anim-unchecked-indeterminate .mdc-checkbox__native-control:enabled~.mdc-checkbox__background{animation-name:mdc-checkbox-fade-in-background-8A000000FFF4433600000000FFF44336}.mdc-checkbox:not(:disabled):active.mdc-checkbox--anim-checked-unchecked .mdc-checkbox__native-control:enabled~.mdc-checkbox__background,.mdc-checkbox:not(:disabled):active.mdc-checkbox--anim-indeterminate-unchecked .mdc-checkbox__native-control:enabled~.mdc-checkbox__background{animation-name:mdc-checkbox-fade-out-background-8A000000FFF4433600000000FFF44336}.mdc-checkbox .mdc-checkbox__background{top:calc((var(--mdc-checkbox-state-layer-size) - 18px) / 2);left:calc((var(--mdc-checkbox-state-layer-size) - 18px) / 2)}.mdc-checkbox .mdc-checkbox__native-control{top:calc((var(--mdc-checkbox-state-layer-size) - var(--mdc-checkbox-state-layer-size)) / 2);right:calc((var(--mdc-checkbox-state-layer-size) - var(--mdc-checkbox-state-layer-size)) / 2);left:calc((var(--mdc-checkbox-state-layer-size) - var(--mdc-checkbox-state-layer-size)) / 2);width:var(--mdc-checkbox-state-layer-size);height:var(--mdc-checkbox-state-layer-size)}.mdc-checkbox .mdc-checkbox__native-control:enabled:focus:focus:not(:checked):not(:indeterminate)~.mdc-checkbox__background{border-color:var(--mdc-checkbox-unselected-focus-icon-color)}.mdc-checkbox .mdc-checkbox__native-control:enabled:focus:checked~.mdc-checkbox__background,.mdc-checkbox .mdc-checkbox__native-control:enabled:focus:indeterminate~.mdc-checkbox__background{border-color:var(--mdc-checkbox-selected-focus-icon-color);background-color:var(--mdc-checkbox-selected-focus-icon-color)}.mdc-checkbox:hover .mdc-checkbox__ripple{opacity:var(--mdc-checkbox-unselected-hover-state-layer-opacity);background-color:var(--mdc-checkbox-unselected-hover-state-layer-color)}.mdc-checkbox:hover .mat-mdc-checkbox-ripple .mat-ripple-element{background-color:var(--mdc-checkbox-unselected-hover-state-layer-color)}.mdc-checkbox .mdc-checkbox__native-control:focus~.mdc-checkbox__ripple{opacity:var(--mdc-checkbox-unselected-focus-state-layer-opacity);background-color:var(--mdc-checkbox-unselected-focus-state-layer-color)}.mdc-checkbox .mdc-checkbox__native-control:focus~.mat-mdc-checkbox-ripple .mat-ripple-element{background-color:var(--mdc-checkbox-unselected-focus-state-layer-color)}.mdc-checkbox:active .mdc-checkbox__native-control~.mdc-checkbox__ripple{opacity:var(--mdc-checkbox-unselected-pressed-state-layer-opacity);background-color:var(--mdc-checkbox-unselected-pressed-state-layer-color)}.mdc-checkbox:active .mdc-checkbox__native-control~.mat-mdc-checkbox-ripple .mat-ripple-element{background-color:var(--mdc-checkbox-unselected-pressed-state-layer-color)}.mdc-checkbox:hover .mdc-checkbox__native-control:checked~.mdc-checkbox__ripple{opacity:var(--mdc-checkbox-selected-hover-state-layer-opacity);background-color:var(--mdc-checkbox-selected-hover-state-layer-color)}.mdc-checkbox:hover .mdc-checkbox__native-control:checked~.mat-mdc-checkbox-ripple .mat-ripple-element{background-color:var(--mdc-checkbox-selected-hover-state-layer-color)}.mdc-checkbox .mdc-checkbox__native-control:focus:checked~.mdc-checkbox__ripple{opacity:var(--mdc-checkbox-selected-focus-state-layer-opacity);background-color:var(--mdc-checkbox-selected-focus-state-layer-color)}.mdc-checkbox .mdc-checkbox__native-control:focus:checked~.mat-mdc-checkbox-ripple .mat-ripple-element{background-color:var(--mdc-checkbox-selected-focus-state-layer-color)}.mdc-checkbox:active .mdc-checkbox__native-control:checked~.mdc-checkbox__ripple{opacity:var(--mdc-checkbox-selected-pressed-state-layer-opacity);background-color:var(--mdc-checkbox-selected-pressed-state-layer-color)}.mdc-checkbox:active .mdc-checkbox__native-control:checked~.mat-mdc-checkbox-ripple .mat-ripple-element{background-color:var(--mdc-checkbox-selected-pressed-state-layer-color)}html{--mdc-checkbox-disabled-selected-checkmark-color:#fff;--mdc-checkbox-selected-focus-state-layer-opacity:0.16;--mdc-checkbox-selected-hover-state-layer-opacity:0.04;--mdc-checkbox-selected-pressed-state-layer-opacity:0.16;--mdc-checkbox-unselected-focus-state-layer-opacity:0.16;--mdc-checkbox-unselected-hover-state-layer-opacity:0.04;--mdc-checkbox-unselected-pressed-state-layer-opacity:0.16}.mat-mdc-checkbox{display:inline-block;position:relative;-webkit-tap-highlight-color:rgba(0,0,0,0)}.mat-mdc-checkbox .mdc-checkbox__background{-webkit-print-color-adjust:exact;color-adjust:exact}.mat-mdc-checkbox._mat-animation-noopable *,.mat-mdc-checkbox._mat-animation-noopable *::before{transition:none !important;animation:none !important}.mat-mdc-checkbox label{cursor:pointer}.mat-mdc-checkbox.mat-mdc-checkbox-disabled label{cursor:default}.mat-mdc-checkbox label:empty{display:none}.cdk-high-contrast-active .mat-mdc-checkbox.mat-mdc-checkbox-disabled{opacity:.5}.cdk-high-contrast-active .mat-mdc-checkbox .mdc-checkbox__checkmark{--mdc-checkbox-selected-checkmark-color: CanvasText;--mdc-checkbox-disabled-selected-checkmark-color: CanvasText}.mat-mdc-checkbox .mdc-checkbox__ripple{opacity:0}.mat-mdc-checkbox-ripple,.mdc-checkbox__ripple{top:0;left:0;right:0;bottom:0;position:absolute;border-radius:50%;pointer-events:none}.mat-mdc-checkbox-ripple:not(:empty),.mdc-checkbox__ripple:not(:empty){transform:translateZ(0)}.mat-mdc-checkbox-ripple .mat-ripple-element{opacity:.1}.mat-mdc-checkbox-touch-target{position:absolute;top:50%;height:48px;left:50%;width:48px;transform:translate(-50%, -50%)}.mat-mdc-checkbox-ripple::before{border-radius:50%}.mdc-checkbox__native-control:focus~.mat-mdc-focus-indicator::before{content:""}'],encapsulation:2,changeDetection:0}),t})(),rP=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275mod=le({type:e}),e.\u0275inj=ae({}),t})(),Dte=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275mod=le({type:e}),e.\u0275inj=ae({imports:[ve,is,rP,ve,rP]}),t})();function Ete(e,t){1&e&&(y(0,"span",7),X(1,1),w())}function Ste(e,t){1&e&&(y(0,"span",8),X(1,2),w())}const oP=["*",[["mat-chip-avatar"],["","matChipAvatar",""]],[["mat-chip-trailing-icon"],["","matChipRemove",""],["","matChipTrailingIcon",""]]],sP=["*","mat-chip-avatar, [matChipAvatar]","mat-chip-trailing-icon,[matChipRemove],[matChipTrailingIcon]"];function Mte(e,t){1&e&&(Ht(0),ie(1,"span",8),zt())}function Ite(e,t){1&e&&(y(0,"span",9),X(1),w())}function Ate(e,t){1&e&&(Ht(0),X(1,1),zt())}function Rte(e,t){1&e&&X(0,2,["*ngIf","contentEditInput; else defaultMatChipEditInput"])}function Ote(e,t){1&e&&ie(0,"span",12)}function Fte(e,t){if(1&e&&(Ht(0),R(1,Rte,1,0,"ng-content",10),R(2,Ote,1,0,"ng-template",null,11,kh),zt()),2&e){const i=hn(3),n=B();x(1),E("ngIf",n.contentEditInput)("ngIfElse",i)}}function Pte(e,t){1&e&&(y(0,"span",13),X(1,3),w())}const Nte=[[["mat-chip-avatar"],["","matChipAvatar",""]],"*",[["","matChipEditInput",""]],[["mat-chip-trailing-icon"],["","matChipRemove",""],["","matChipTrailingIcon",""]]],Lte=["mat-chip-avatar, [matChipAvatar]","*","[matChipEditInput]","mat-chip-trailing-icon,[matChipRemove],[matChipTrailingIcon]"],iw=["*"],Xp=new M("mat-chips-default-options"),rw=new M("MatChipAvatar"),ow=new M("MatChipTrailingIcon"),sw=new M("MatChipRemove"),Zp=new M("MatChip");class Bte{}const Vte=ns(Bte,-1);let hc=(()=>{var e;class t extends Vte{get disabled(){return this._disabled||this._parentChip.disabled}set disabled(n){this._disabled=Q(n)}_getDisabledAttribute(){return this.disabled&&!this._allowFocusWhenDisabled?"":null}_getTabindex(){return this.disabled&&!this._allowFocusWhenDisabled||!this.isInteractive?null:this.tabIndex.toString()}constructor(n,r){super(),this._elementRef=n,this._parentChip=r,this.isInteractive=!0,this._isPrimary=!0,this._disabled=!1,this._allowFocusWhenDisabled=!1,"BUTTON"===n.nativeElement.nodeName&&n.nativeElement.setAttribute("type","button")}focus(){this._elementRef.nativeElement.focus()}_handleClick(n){!this.disabled&&this.isInteractive&&this._isPrimary&&(n.preventDefault(),this._parentChip._handlePrimaryActionInteraction())}_handleKeydown(n){(13===n.keyCode||32===n.keyCode)&&!this.disabled&&this.isInteractive&&this._isPrimary&&!this._parentChip._isEditing&&(n.preventDefault(),this._parentChip._handlePrimaryActionInteraction())}}return(e=t).\u0275fac=function(n){return new(n||e)(m(W),m(Zp))},e.\u0275dir=T({type:e,selectors:[["","matChipAction",""]],hostAttrs:[1,"mdc-evolution-chip__action","mat-mdc-chip-action"],hostVars:9,hostBindings:function(n,r){1&n&&$("click",function(s){return r._handleClick(s)})("keydown",function(s){return r._handleKeydown(s)}),2&n&&(de("tabindex",r._getTabindex())("disabled",r._getDisabledAttribute())("aria-disabled",r.disabled),se("mdc-evolution-chip__action--primary",r._isPrimary)("mdc-evolution-chip__action--presentational",!r.isInteractive)("mdc-evolution-chip__action--trailing",!r._isPrimary))},inputs:{disabled:"disabled",tabIndex:"tabIndex",isInteractive:"isInteractive",_allowFocusWhenDisabled:"_allowFocusWhenDisabled"},features:[P]}),t})(),lP=(()=>{var e;class t extends hc{constructor(){super(...arguments),this._isPrimary=!1}_handleClick(n){this.disabled||(n.stopPropagation(),n.preventDefault(),this._parentChip.remove())}_handleKeydown(n){(13===n.keyCode||32===n.keyCode)&&!this.disabled&&(n.stopPropagation(),n.preventDefault(),this._parentChip.remove())}}return(e=t).\u0275fac=function(){let i;return function(r){return(i||(i=be(e)))(r||e)}}(),e.\u0275dir=T({type:e,selectors:[["","matChipRemove",""]],hostAttrs:["role","button",1,"mat-mdc-chip-remove","mat-mdc-chip-trailing-icon","mat-mdc-focus-indicator","mdc-evolution-chip__icon","mdc-evolution-chip__icon--trailing"],hostVars:1,hostBindings:function(n,r){2&n&&de("aria-hidden",null)},features:[Z([{provide:sw,useExisting:e}]),P]}),t})(),zte=0;const Ute=ns(ts(so(es(class{constructor(e){this._elementRef=e}})),"primary"),-1);let vs=(()=>{var e;class t extends Ute{_hasFocus(){return this._hasFocusInternal}get value(){return void 0!==this._value?this._value:this._textElement.textContent.trim()}set value(n){this._value=n}get removable(){return this._removable}set removable(n){this._removable=Q(n)}get highlighted(){return this._highlighted}set highlighted(n){this._highlighted=Q(n)}get ripple(){return this._rippleLoader?.getRipple(this._elementRef.nativeElement)}set ripple(n){this._rippleLoader?.attachRipple(this._elementRef.nativeElement,n)}constructor(n,r,o,s,a,c,l,d){super(r),this._changeDetectorRef=n,this._ngZone=o,this._focusMonitor=s,this._globalRippleOptions=l,this._onFocus=new Y,this._onBlur=new Y,this.role=null,this._hasFocusInternal=!1,this.id="mat-mdc-chip-"+zte++,this.ariaLabel=null,this.ariaDescription=null,this._ariaDescriptionId=`${this.id}-aria-description`,this._removable=!0,this._highlighted=!1,this.removed=new U,this.destroyed=new U,this.basicChipAttrName="mat-basic-chip",this._rippleLoader=j(a1),this._document=a,this._animationsDisabled="NoopAnimations"===c,null!=d&&(this.tabIndex=parseInt(d)??this.defaultTabIndex),this._monitorFocus(),this._rippleLoader?.configureRipple(this._elementRef.nativeElement,{className:"mat-mdc-chip-ripple",disabled:this._isRippleDisabled()})}ngOnInit(){const n=this._elementRef.nativeElement;this._isBasicChip=n.hasAttribute(this.basicChipAttrName)||n.tagName.toLowerCase()===this.basicChipAttrName}ngAfterViewInit(){this._textElement=this._elementRef.nativeElement.querySelector(".mat-mdc-chip-action-label"),this._pendingFocus&&(this._pendingFocus=!1,this.focus())}ngAfterContentInit(){this._actionChanges=Ot(this._allLeadingIcons.changes,this._allTrailingIcons.changes,this._allRemoveIcons.changes).subscribe(()=>this._changeDetectorRef.markForCheck())}ngDoCheck(){this._rippleLoader.setDisabled(this._elementRef.nativeElement,this._isRippleDisabled())}ngOnDestroy(){this._focusMonitor.stopMonitoring(this._elementRef),this._actionChanges?.unsubscribe(),this.destroyed.emit({chip:this}),this.destroyed.complete()}remove(){this.removable&&this.removed.emit({chip:this})}_isRippleDisabled(){return this.disabled||this.disableRipple||this._animationsDisabled||this._isBasicChip||!!this._globalRippleOptions?.disabled}_hasTrailingIcon(){return!(!this.trailingIcon&&!this.removeIcon)}_handleKeydown(n){(8===n.keyCode||46===n.keyCode)&&(n.preventDefault(),this.remove())}focus(){this.disabled||(this.primaryAction?this.primaryAction.focus():this._pendingFocus=!0)}_getSourceAction(n){return this._getActions().find(r=>{const o=r._elementRef.nativeElement;return o===n||o.contains(n)})}_getActions(){const n=[];return this.primaryAction&&n.push(this.primaryAction),this.removeIcon&&n.push(this.removeIcon),this.trailingIcon&&n.push(this.trailingIcon),n}_handlePrimaryActionInteraction(){}_monitorFocus(){this._focusMonitor.monitor(this._elementRef,!0).subscribe(n=>{const r=null!==n;r!==this._hasFocusInternal&&(this._hasFocusInternal=r,r?this._onFocus.next({chip:this}):this._ngZone.onStable.pipe(Xe(1)).subscribe(()=>this._ngZone.run(()=>this._onBlur.next({chip:this}))))})}}return(e=t).\u0275fac=function(n){return new(n||e)(m(Fe),m(W),m(G),m(ar),m(he),m(_t,8),m(Pf,8),ui("tabindex"))},e.\u0275cmp=fe({type:e,selectors:[["mat-basic-chip"],["","mat-basic-chip",""],["mat-chip"],["","mat-chip",""]],contentQueries:function(n,r,o){if(1&n&&(Ee(o,rw,5),Ee(o,ow,5),Ee(o,sw,5),Ee(o,rw,5),Ee(o,ow,5),Ee(o,sw,5)),2&n){let s;H(s=z())&&(r.leadingIcon=s.first),H(s=z())&&(r.trailingIcon=s.first),H(s=z())&&(r.removeIcon=s.first),H(s=z())&&(r._allLeadingIcons=s),H(s=z())&&(r._allTrailingIcons=s),H(s=z())&&(r._allRemoveIcons=s)}},viewQuery:function(n,r){if(1&n&&ke(hc,5),2&n){let o;H(o=z())&&(r.primaryAction=o.first)}},hostAttrs:[1,"mat-mdc-chip"],hostVars:30,hostBindings:function(n,r){1&n&&$("keydown",function(s){return r._handleKeydown(s)}),2&n&&(pi("id",r.id),de("role",r.role)("tabindex",r.role?r.tabIndex:null)("aria-label",r.ariaLabel),se("mdc-evolution-chip",!r._isBasicChip)("mdc-evolution-chip--disabled",r.disabled)("mdc-evolution-chip--with-trailing-action",r._hasTrailingIcon())("mdc-evolution-chip--with-primary-graphic",r.leadingIcon)("mdc-evolution-chip--with-primary-icon",r.leadingIcon)("mdc-evolution-chip--with-avatar",r.leadingIcon)("mat-mdc-chip-with-avatar",r.leadingIcon)("mat-mdc-chip-highlighted",r.highlighted)("mat-mdc-chip-disabled",r.disabled)("mat-mdc-basic-chip",r._isBasicChip)("mat-mdc-standard-chip",!r._isBasicChip)("mat-mdc-chip-with-trailing-icon",r._hasTrailingIcon())("_mat-animation-noopable",r._animationsDisabled))},inputs:{color:"color",disabled:"disabled",disableRipple:"disableRipple",tabIndex:"tabIndex",role:"role",id:"id",ariaLabel:["aria-label","ariaLabel"],ariaDescription:["aria-description","ariaDescription"],value:"value",removable:"removable",highlighted:"highlighted"},outputs:{removed:"removed",destroyed:"destroyed"},exportAs:["matChip"],features:[Z([{provide:Zp,useExisting:e}]),P],ngContentSelectors:sP,decls:8,vars:3,consts:[[1,"mat-mdc-chip-focus-overlay"],[1,"mdc-evolution-chip__cell","mdc-evolution-chip__cell--primary"],["matChipAction","",3,"isInteractive"],["class","mdc-evolution-chip__graphic mat-mdc-chip-graphic",4,"ngIf"],[1,"mdc-evolution-chip__text-label","mat-mdc-chip-action-label"],[1,"mat-mdc-chip-primary-focus-indicator","mat-mdc-focus-indicator"],["class","mdc-evolution-chip__cell mdc-evolution-chip__cell--trailing",4,"ngIf"],[1,"mdc-evolution-chip__graphic","mat-mdc-chip-graphic"],[1,"mdc-evolution-chip__cell","mdc-evolution-chip__cell--trailing"]],template:function(n,r){1&n&&(ze(oP),ie(0,"span",0),y(1,"span",1)(2,"span",2),R(3,Ete,2,0,"span",3),y(4,"span",4),X(5),ie(6,"span",5),w()()(),R(7,Ste,2,0,"span",6)),2&n&&(x(2),E("isInteractive",!1),x(1),E("ngIf",r.leadingIcon),x(4),E("ngIf",r._hasTrailingIcon()))},dependencies:[_i,hc],styles:['.mdc-evolution-chip,.mdc-evolution-chip__cell,.mdc-evolution-chip__action{display:inline-flex;align-items:center}.mdc-evolution-chip{position:relative;max-width:100%}.mdc-evolution-chip .mdc-elevation-overlay{width:100%;height:100%;top:0;left:0}.mdc-evolution-chip__cell,.mdc-evolution-chip__action{height:100%}.mdc-evolution-chip__cell--primary{overflow-x:hidden}.mdc-evolution-chip__cell--trailing{flex:1 0 auto}.mdc-evolution-chip__action{align-items:center;background:none;border:none;box-sizing:content-box;cursor:pointer;display:inline-flex;justify-content:center;outline:none;padding:0;text-decoration:none;color:inherit}.mdc-evolution-chip__action--presentational{cursor:auto}.mdc-evolution-chip--disabled,.mdc-evolution-chip__action:disabled{pointer-events:none}.mdc-evolution-chip__action--primary{overflow-x:hidden}.mdc-evolution-chip__action--trailing{position:relative;overflow:visible}.mdc-evolution-chip__action--primary:before{box-sizing:border-box;content:"";height:100%;left:0;position:absolute;pointer-events:none;top:0;width:100%;z-index:1}.mdc-evolution-chip--touch{margin-top:8px;margin-bottom:8px}.mdc-evolution-chip__action-touch{position:absolute;top:50%;height:48px;left:0;right:0;transform:translateY(-50%)}.mdc-evolution-chip__text-label{white-space:nowrap;user-select:none;text-overflow:ellipsis;overflow:hidden}.mdc-evolution-chip__graphic{align-items:center;display:inline-flex;justify-content:center;overflow:hidden;pointer-events:none;position:relative;flex:1 0 auto}.mdc-evolution-chip__checkmark{position:absolute;opacity:0;top:50%;left:50%}.mdc-evolution-chip--selectable:not(.mdc-evolution-chip--selected):not(.mdc-evolution-chip--with-primary-icon) .mdc-evolution-chip__graphic{width:0}.mdc-evolution-chip__checkmark-background{opacity:0}.mdc-evolution-chip__checkmark-svg{display:block}.mdc-evolution-chip__checkmark-path{stroke-width:2px;stroke-dasharray:29.7833385;stroke-dashoffset:29.7833385;stroke:currentColor}.mdc-evolution-chip--selecting .mdc-evolution-chip__graphic{transition:width 150ms 0ms cubic-bezier(0.4, 0, 0.2, 1)}.mdc-evolution-chip--selecting .mdc-evolution-chip__checkmark{transition:transform 150ms 0ms cubic-bezier(0.4, 0, 0.2, 1);transform:translate(-75%, -50%)}.mdc-evolution-chip--selecting .mdc-evolution-chip__checkmark-path{transition:stroke-dashoffset 150ms 45ms cubic-bezier(0.4, 0, 0.2, 1)}.mdc-evolution-chip--deselecting .mdc-evolution-chip__graphic{transition:width 100ms 0ms cubic-bezier(0.4, 0, 0.2, 1)}.mdc-evolution-chip--deselecting .mdc-evolution-chip__checkmark{transition:opacity 50ms 0ms linear,transform 100ms 0ms cubic-bezier(0.4, 0, 0.2, 1);transform:translate(-75%, -50%)}.mdc-evolution-chip--deselecting .mdc-evolution-chip__checkmark-path{stroke-dashoffset:0}.mdc-evolution-chip--selecting-with-primary-icon .mdc-evolution-chip__icon--primary{transition:opacity 75ms 0ms cubic-bezier(0.4, 0, 0.2, 1)}.mdc-evolution-chip--selecting-with-primary-icon .mdc-evolution-chip__checkmark-path{transition:stroke-dashoffset 150ms 75ms cubic-bezier(0.4, 0, 0.2, 1)}.mdc-evolution-chip--deselecting-with-primary-icon .mdc-evolution-chip__icon--primary{transition:opacity 150ms 75ms cubic-bezier(0.4, 0, 0.2, 1)}.mdc-evolution-chip--deselecting-with-primary-icon .mdc-evolution-chip__checkmark{transition:opacity 75ms 0ms cubic-bezier(0.4, 0, 0.2, 1);transform:translate(-50%, -50%)}.mdc-evolution-chip--deselecting-with-primary-icon .mdc-evolution-chip__checkmark-path{stroke-dashoffset:0}.mdc-evolution-chip--selected .mdc-evolution-chip__icon--primary{opacity:0}.mdc-evolution-chip--selected .mdc-evolution-chip__checkmark{transform:translate(-50%, -50%);opacity:1}.mdc-evolution-chip--selected .mdc-evolution-chip__checkmark-path{stroke-dashoffset:0}@keyframes mdc-evolution-chip-enter{from{transform:scale(0.8);opacity:.4}to{transform:scale(1);opacity:1}}.mdc-evolution-chip--enter{animation:mdc-evolution-chip-enter 100ms 0ms cubic-bezier(0, 0, 0.2, 1)}@keyframes mdc-evolution-chip-exit{from{opacity:1}to{opacity:0}}.mdc-evolution-chip--exit{animation:mdc-evolution-chip-exit 75ms 0ms cubic-bezier(0.4, 0, 1, 1)}.mdc-evolution-chip--hidden{opacity:0;pointer-events:none;transition:width 150ms 0ms cubic-bezier(0.4, 0, 1, 1)}.mat-mdc-standard-chip{border-radius:var(--mdc-chip-container-shape-radius);height:var(--mdc-chip-container-height);--mdc-chip-container-shape-family:rounded;--mdc-chip-container-shape-radius:16px 16px 16px 16px;--mdc-chip-with-avatar-avatar-shape-family:rounded;--mdc-chip-with-avatar-avatar-shape-radius:14px 14px 14px 14px;--mdc-chip-with-avatar-avatar-size:28px;--mdc-chip-with-icon-icon-size:18px}.mat-mdc-standard-chip .mdc-evolution-chip__ripple{border-radius:var(--mdc-chip-container-shape-radius)}.mat-mdc-standard-chip .mdc-evolution-chip__action--primary:before{border-radius:var(--mdc-chip-container-shape-radius)}.mat-mdc-standard-chip .mdc-evolution-chip__icon--primary{border-radius:var(--mdc-chip-with-avatar-avatar-shape-radius)}.mat-mdc-standard-chip.mdc-evolution-chip--selectable:not(.mdc-evolution-chip--with-primary-icon){--mdc-chip-graphic-selected-width:var(--mdc-chip-with-avatar-avatar-size)}.mat-mdc-standard-chip .mdc-evolution-chip__graphic{height:var(--mdc-chip-with-avatar-avatar-size);width:var(--mdc-chip-with-avatar-avatar-size);font-size:var(--mdc-chip-with-avatar-avatar-size)}.mat-mdc-standard-chip:not(.mdc-evolution-chip--disabled){background-color:var(--mdc-chip-elevated-container-color)}.mat-mdc-standard-chip.mdc-evolution-chip--disabled{background-color:var(--mdc-chip-elevated-disabled-container-color)}.mat-mdc-standard-chip.mdc-evolution-chip--selected.mdc-evolution-chip--disabled{background-color:var(--mdc-chip-elevated-disabled-container-color)}.mat-mdc-standard-chip .mdc-evolution-chip__text-label{font-family:var(--mdc-chip-label-text-font);line-height:var(--mdc-chip-label-text-line-height);font-size:var(--mdc-chip-label-text-size);font-weight:var(--mdc-chip-label-text-weight);letter-spacing:var(--mdc-chip-label-text-tracking)}.mat-mdc-standard-chip:not(.mdc-evolution-chip--disabled) .mdc-evolution-chip__text-label{color:var(--mdc-chip-label-text-color)}.mat-mdc-standard-chip.mdc-evolution-chip--disabled .mdc-evolution-chip__text-label{color:var(--mdc-chip-disabled-label-text-color)}.mat-mdc-standard-chip.mdc-evolution-chip--selected.mdc-evolution-chip--disabled .mdc-evolution-chip__text-label{color:var(--mdc-chip-disabled-label-text-color)}.mat-mdc-standard-chip .mdc-evolution-chip__icon--primary{height:var(--mdc-chip-with-icon-icon-size);width:var(--mdc-chip-with-icon-icon-size);font-size:var(--mdc-chip-with-icon-icon-size)}.mat-mdc-standard-chip:not(.mdc-evolution-chip--disabled) .mdc-evolution-chip__icon--primary{color:var(--mdc-chip-with-icon-icon-color)}.mat-mdc-standard-chip.mdc-evolution-chip--disabled .mdc-evolution-chip__icon--primary{color:var(--mdc-chip-with-icon-disabled-icon-color)}.mat-mdc-standard-chip:not(.mdc-evolution-chip--disabled) .mdc-evolution-chip__checkmark{color:var(--mdc-chip-with-icon-selected-icon-color)}.mat-mdc-standard-chip.mdc-evolution-chip--disabled .mdc-evolution-chip__checkmark{color:var(--mdc-chip-with-icon-disabled-icon-color)}.mat-mdc-standard-chip:not(.mdc-evolution-chip--disabled) .mdc-evolution-chip__icon--trailing{color:var(--mdc-chip-with-trailing-icon-trailing-icon-color)}.mat-mdc-standard-chip.mdc-evolution-chip--disabled .mdc-evolution-chip__icon--trailing{color:var(--mdc-chip-with-trailing-icon-disabled-trailing-icon-color)}.mat-mdc-standard-chip .mdc-evolution-chip__action--primary.mdc-ripple-upgraded--background-focused .mdc-evolution-chip__ripple::before,.mat-mdc-standard-chip .mdc-evolution-chip__action--primary:not(.mdc-ripple-upgraded):focus .mdc-evolution-chip__ripple::before{transition-duration:75ms;opacity:var(--mdc-chip-focus-state-layer-opacity)}.mat-mdc-chip-focus-overlay{background:var(--mdc-chip-focus-state-layer-color);opacity:var(--mdc-chip-focus-state-layer-opacity)}.mat-mdc-standard-chip .mdc-evolution-chip__checkmark{height:20px;width:20px}.mat-mdc-standard-chip .mdc-evolution-chip__icon--trailing{height:18px;width:18px;font-size:18px}.mat-mdc-standard-chip .mdc-evolution-chip__action--primary{padding-left:12px;padding-right:12px}[dir=rtl] .mat-mdc-standard-chip .mdc-evolution-chip__action--primary,.mat-mdc-standard-chip .mdc-evolution-chip__action--primary[dir=rtl]{padding-left:12px;padding-right:12px}.mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic .mdc-evolution-chip__graphic{padding-left:6px;padding-right:6px}[dir=rtl] .mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic .mdc-evolution-chip__graphic,.mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic .mdc-evolution-chip__graphic[dir=rtl]{padding-left:6px;padding-right:6px}.mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic .mdc-evolution-chip__action--primary{padding-left:0;padding-right:12px}[dir=rtl] .mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic .mdc-evolution-chip__action--primary,.mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic .mdc-evolution-chip__action--primary[dir=rtl]{padding-left:12px;padding-right:0}.mat-mdc-standard-chip.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--trailing{padding-left:8px;padding-right:8px}[dir=rtl] .mat-mdc-standard-chip.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--trailing,.mat-mdc-standard-chip.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--trailing[dir=rtl]{padding-left:8px;padding-right:8px}.mat-mdc-standard-chip.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__ripple--trailing{left:8px;right:initial}[dir=rtl] .mat-mdc-standard-chip.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__ripple--trailing,.mat-mdc-standard-chip.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__ripple--trailing[dir=rtl]{left:initial;right:8px}.mat-mdc-standard-chip.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--primary{padding-left:12px;padding-right:0}[dir=rtl] .mat-mdc-standard-chip.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--primary,.mat-mdc-standard-chip.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--primary[dir=rtl]{padding-left:0;padding-right:12px}.mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__graphic{padding-left:6px;padding-right:6px}[dir=rtl] .mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__graphic,.mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__graphic[dir=rtl]{padding-left:6px;padding-right:6px}.mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--trailing{padding-left:8px;padding-right:8px}[dir=rtl] .mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--trailing,.mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--trailing[dir=rtl]{padding-left:8px;padding-right:8px}.mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__ripple--trailing{left:8px;right:initial}[dir=rtl] .mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__ripple--trailing,.mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__ripple--trailing[dir=rtl]{left:initial;right:8px}.mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--primary{padding-left:0;padding-right:0}[dir=rtl] .mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--primary,.mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--primary[dir=rtl]{padding-left:0;padding-right:0}.mat-mdc-standard-chip.mdc-evolution-chip--disabled .mdc-evolution-chip__checkmark{color:var(--mdc-chip-with-icon-selected-icon-color, currentColor)}.mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic .mdc-evolution-chip__graphic{padding-left:4px;padding-right:8px}[dir=rtl] .mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic .mdc-evolution-chip__graphic,.mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic .mdc-evolution-chip__graphic[dir=rtl]{padding-left:8px;padding-right:4px}.mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic .mdc-evolution-chip__action--primary{padding-left:0;padding-right:12px}[dir=rtl] .mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic .mdc-evolution-chip__action--primary,.mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic .mdc-evolution-chip__action--primary[dir=rtl]{padding-left:12px;padding-right:0}.mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__graphic{padding-left:4px;padding-right:8px}[dir=rtl] .mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__graphic,.mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__graphic[dir=rtl]{padding-left:8px;padding-right:4px}.mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--trailing{padding-left:8px;padding-right:8px}[dir=rtl] .mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--trailing,.mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--trailing[dir=rtl]{padding-left:8px;padding-right:8px}.mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__ripple--trailing{left:8px;right:initial}[dir=rtl] .mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__ripple--trailing,.mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__ripple--trailing[dir=rtl]{left:initial;right:8px}.mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--primary{padding-left:0;padding-right:0}[dir=rtl] .mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--primary,.mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--primary[dir=rtl]{padding-left:0;padding-right:0}.mat-mdc-standard-chip{-webkit-tap-highlight-color:rgba(0,0,0,0)}.cdk-high-contrast-active .mat-mdc-standard-chip{outline:solid 1px}.cdk-high-contrast-active .mat-mdc-standard-chip .mdc-evolution-chip__checkmark-path{stroke:CanvasText !important}.mat-mdc-standard-chip.mdc-evolution-chip--disabled{opacity:.4}.mat-mdc-standard-chip .mdc-evolution-chip__cell--primary,.mat-mdc-standard-chip .mdc-evolution-chip__action--primary,.mat-mdc-standard-chip .mat-mdc-chip-action-label{overflow:visible}.mat-mdc-standard-chip .mdc-evolution-chip__cell--primary{flex-basis:100%}.mat-mdc-standard-chip .mdc-evolution-chip__action--primary{font:inherit;letter-spacing:inherit;white-space:inherit}.mat-mdc-standard-chip .mat-mdc-chip-graphic,.mat-mdc-standard-chip .mat-mdc-chip-trailing-icon{box-sizing:content-box}.mat-mdc-standard-chip._mat-animation-noopable,.mat-mdc-standard-chip._mat-animation-noopable .mdc-evolution-chip__graphic,.mat-mdc-standard-chip._mat-animation-noopable .mdc-evolution-chip__checkmark,.mat-mdc-standard-chip._mat-animation-noopable .mdc-evolution-chip__checkmark-path{transition-duration:1ms;animation-duration:1ms}.mat-mdc-basic-chip .mdc-evolution-chip__action--primary{font:inherit}.mat-mdc-chip-focus-overlay{top:0;left:0;right:0;bottom:0;position:absolute;pointer-events:none;opacity:0;border-radius:inherit;transition:opacity 150ms linear}._mat-animation-noopable .mat-mdc-chip-focus-overlay{transition:none}.mat-mdc-basic-chip .mat-mdc-chip-focus-overlay{display:none}.mat-mdc-chip:hover .mat-mdc-chip-focus-overlay{opacity:.04}.mat-mdc-chip.cdk-focused .mat-mdc-chip-focus-overlay{opacity:.12}.mat-mdc-chip .mat-ripple.mat-mdc-chip-ripple{top:0;left:0;right:0;bottom:0;position:absolute;pointer-events:none;border-radius:inherit}.mat-mdc-chip-avatar{text-align:center;line-height:1;color:var(--mdc-chip-with-icon-icon-color, currentColor)}.mat-mdc-chip{position:relative;z-index:0}.mat-mdc-chip-action-label{text-align:left;z-index:1}[dir=rtl] .mat-mdc-chip-action-label{text-align:right}.mat-mdc-chip.mdc-evolution-chip--with-trailing-action .mat-mdc-chip-action-label{position:relative}.mat-mdc-chip-action-label .mat-mdc-chip-primary-focus-indicator{position:absolute;top:0;right:0;bottom:0;left:0;pointer-events:none}.mat-mdc-chip-action-label .mat-mdc-focus-indicator::before{margin:calc(calc(var(--mat-mdc-focus-indicator-border-width, 3px) + 2px) * -1)}.mat-mdc-chip-remove{opacity:.54}.mat-mdc-chip-remove:focus{opacity:1}.mat-mdc-chip-remove::before{margin:calc(var(--mat-mdc-focus-indicator-border-width, 3px) * -1);left:8px;right:8px}.mat-mdc-chip-remove .mat-icon{width:inherit;height:inherit;font-size:inherit;box-sizing:content-box}.mat-chip-edit-input{cursor:text;display:inline-block;color:inherit;outline:0}.cdk-high-contrast-active .mat-mdc-chip-selected:not(.mat-mdc-chip-multiple){outline-width:3px}.mat-mdc-chip-action:focus .mat-mdc-focus-indicator::before{content:""}'],encapsulation:2,changeDetection:0}),t})(),Jp=(()=>{var e;class t{constructor(n,r){this._elementRef=n,this._document=r}initialize(n){this.getNativeElement().focus(),this.setValue(n)}getNativeElement(){return this._elementRef.nativeElement}setValue(n){this.getNativeElement().textContent=n,this._moveCursorToEndOfInput()}getValue(){return this.getNativeElement().textContent||""}_moveCursorToEndOfInput(){const n=this._document.createRange();n.selectNodeContents(this.getNativeElement()),n.collapse(!1);const r=window.getSelection();r.removeAllRanges(),r.addRange(n)}}return(e=t).\u0275fac=function(n){return new(n||e)(m(W),m(he))},e.\u0275dir=T({type:e,selectors:[["span","matChipEditInput",""]],hostAttrs:["role","textbox","tabindex","-1","contenteditable","true",1,"mat-chip-edit-input"]}),t})(),aw=(()=>{var e;class t extends vs{constructor(n,r,o,s,a,c,l,d){super(n,r,o,s,a,c,l,d),this.basicChipAttrName="mat-basic-chip-row",this._editStartPending=!1,this.editable=!1,this.edited=new U,this._isEditing=!1,this.role="row",this._onBlur.pipe(ce(this.destroyed)).subscribe(()=>{this._isEditing&&!this._editStartPending&&this._onEditFinish()})}_hasTrailingIcon(){return!this._isEditing&&super._hasTrailingIcon()}_handleFocus(){!this._isEditing&&!this.disabled&&this.focus()}_handleKeydown(n){13!==n.keyCode||this.disabled?this._isEditing?n.stopPropagation():super._handleKeydown(n):this._isEditing?(n.preventDefault(),this._onEditFinish()):this.editable&&this._startEditing(n)}_handleDoubleclick(n){!this.disabled&&this.editable&&this._startEditing(n)}_startEditing(n){if(!this.primaryAction||this.removeIcon&&this._getSourceAction(n.target)===this.removeIcon)return;const r=this.value;this._isEditing=this._editStartPending=!0,this._changeDetectorRef.detectChanges(),setTimeout(()=>{this._getEditInput().initialize(r),this._editStartPending=!1})}_onEditFinish(){this._isEditing=this._editStartPending=!1,this.edited.emit({chip:this,value:this._getEditInput().getValue()}),(this._document.activeElement===this._getEditInput().getNativeElement()||this._document.activeElement===this._document.body)&&this.primaryAction.focus()}_isRippleDisabled(){return super._isRippleDisabled()||this._isEditing}_getEditInput(){return this.contentEditInput||this.defaultEditInput}}return(e=t).\u0275fac=function(n){return new(n||e)(m(Fe),m(W),m(G),m(ar),m(he),m(_t,8),m(Pf,8),ui("tabindex"))},e.\u0275cmp=fe({type:e,selectors:[["mat-chip-row"],["","mat-chip-row",""],["mat-basic-chip-row"],["","mat-basic-chip-row",""]],contentQueries:function(n,r,o){if(1&n&&Ee(o,Jp,5),2&n){let s;H(s=z())&&(r.contentEditInput=s.first)}},viewQuery:function(n,r){if(1&n&&ke(Jp,5),2&n){let o;H(o=z())&&(r.defaultEditInput=o.first)}},hostAttrs:[1,"mat-mdc-chip","mat-mdc-chip-row","mdc-evolution-chip"],hostVars:27,hostBindings:function(n,r){1&n&&$("focus",function(s){return r._handleFocus(s)})("dblclick",function(s){return r._handleDoubleclick(s)}),2&n&&(pi("id",r.id),de("tabindex",r.disabled?null:-1)("aria-label",null)("aria-description",null)("role",r.role),se("mat-mdc-chip-with-avatar",r.leadingIcon)("mat-mdc-chip-disabled",r.disabled)("mat-mdc-chip-editing",r._isEditing)("mat-mdc-chip-editable",r.editable)("mdc-evolution-chip--disabled",r.disabled)("mdc-evolution-chip--with-trailing-action",r._hasTrailingIcon())("mdc-evolution-chip--with-primary-graphic",r.leadingIcon)("mdc-evolution-chip--with-primary-icon",r.leadingIcon)("mdc-evolution-chip--with-avatar",r.leadingIcon)("mat-mdc-chip-highlighted",r.highlighted)("mat-mdc-chip-with-trailing-icon",r._hasTrailingIcon()))},inputs:{color:"color",disabled:"disabled",disableRipple:"disableRipple",tabIndex:"tabIndex",editable:"editable"},outputs:{edited:"edited"},features:[Z([{provide:vs,useExisting:e},{provide:Zp,useExisting:e}]),P],ngContentSelectors:Lte,decls:10,vars:12,consts:[[4,"ngIf"],["role","gridcell","matChipAction","",1,"mdc-evolution-chip__cell","mdc-evolution-chip__cell--primary",3,"tabIndex","disabled"],["class","mdc-evolution-chip__graphic mat-mdc-chip-graphic",4,"ngIf"],[1,"mdc-evolution-chip__text-label","mat-mdc-chip-action-label",3,"ngSwitch"],[4,"ngSwitchCase"],["aria-hidden","true",1,"mat-mdc-chip-primary-focus-indicator","mat-mdc-focus-indicator"],["class","mdc-evolution-chip__cell mdc-evolution-chip__cell--trailing","role","gridcell",4,"ngIf"],[1,"cdk-visually-hidden",3,"id"],[1,"mat-mdc-chip-focus-overlay"],[1,"mdc-evolution-chip__graphic","mat-mdc-chip-graphic"],[4,"ngIf","ngIfElse"],["defaultMatChipEditInput",""],["matChipEditInput",""],["role","gridcell",1,"mdc-evolution-chip__cell","mdc-evolution-chip__cell--trailing"]],template:function(n,r){1&n&&(ze(Nte),R(0,Mte,2,0,"ng-container",0),y(1,"span",1),R(2,Ite,2,0,"span",2),y(3,"span",3),R(4,Ate,2,0,"ng-container",4),R(5,Fte,4,2,"ng-container",4),ie(6,"span",5),w()(),R(7,Pte,2,0,"span",6),y(8,"span",7),A(9),w()),2&n&&(E("ngIf",!r._isEditing),x(1),E("tabIndex",r.tabIndex)("disabled",r.disabled),de("aria-label",r.ariaLabel)("aria-describedby",r._ariaDescriptionId),x(1),E("ngIf",r.leadingIcon),x(1),E("ngSwitch",r._isEditing),x(1),E("ngSwitchCase",!1),x(1),E("ngSwitchCase",!0),x(2),E("ngIf",r._hasTrailingIcon()),x(1),E("id",r._ariaDescriptionId),x(1),bt(r.ariaDescription))},dependencies:[_i,Sa,Qh,hc,Jp],styles:['.mdc-evolution-chip,.mdc-evolution-chip__cell,.mdc-evolution-chip__action{display:inline-flex;align-items:center}.mdc-evolution-chip{position:relative;max-width:100%}.mdc-evolution-chip .mdc-elevation-overlay{width:100%;height:100%;top:0;left:0}.mdc-evolution-chip__cell,.mdc-evolution-chip__action{height:100%}.mdc-evolution-chip__cell--primary{overflow-x:hidden}.mdc-evolution-chip__cell--trailing{flex:1 0 auto}.mdc-evolution-chip__action{align-items:center;background:none;border:none;box-sizing:content-box;cursor:pointer;display:inline-flex;justify-content:center;outline:none;padding:0;text-decoration:none;color:inherit}.mdc-evolution-chip__action--presentational{cursor:auto}.mdc-evolution-chip--disabled,.mdc-evolution-chip__action:disabled{pointer-events:none}.mdc-evolution-chip__action--primary{overflow-x:hidden}.mdc-evolution-chip__action--trailing{position:relative;overflow:visible}.mdc-evolution-chip__action--primary:before{box-sizing:border-box;content:"";height:100%;left:0;position:absolute;pointer-events:none;top:0;width:100%;z-index:1}.mdc-evolution-chip--touch{margin-top:8px;margin-bottom:8px}.mdc-evolution-chip__action-touch{position:absolute;top:50%;height:48px;left:0;right:0;transform:translateY(-50%)}.mdc-evolution-chip__text-label{white-space:nowrap;user-select:none;text-overflow:ellipsis;overflow:hidden}.mdc-evolution-chip__graphic{align-items:center;display:inline-flex;justify-content:center;overflow:hidden;pointer-events:none;position:relative;flex:1 0 auto}.mdc-evolution-chip__checkmark{position:absolute;opacity:0;top:50%;left:50%}.mdc-evolution-chip--selectable:not(.mdc-evolution-chip--selected):not(.mdc-evolution-chip--with-primary-icon) .mdc-evolution-chip__graphic{width:0}.mdc-evolution-chip__checkmark-background{opacity:0}.mdc-evolution-chip__checkmark-svg{display:block}.mdc-evolution-chip__checkmark-path{stroke-width:2px;stroke-dasharray:29.7833385;stroke-dashoffset:29.7833385;stroke:currentColor}.mdc-evolution-chip--selecting .mdc-evolution-chip__graphic{transition:width 150ms 0ms cubic-bezier(0.4, 0, 0.2, 1)}.mdc-evolution-chip--selecting .mdc-evolution-chip__checkmark{transition:transform 150ms 0ms cubic-bezier(0.4, 0, 0.2, 1);transform:translate(-75%, -50%)}.mdc-evolution-chip--selecting .mdc-evolution-chip__checkmark-path{transition:stroke-dashoffset 150ms 45ms cubic-bezier(0.4, 0, 0.2, 1)}.mdc-evolution-chip--deselecting .mdc-evolution-chip__graphic{transition:width 100ms 0ms cubic-bezier(0.4, 0, 0.2, 1)}.mdc-evolution-chip--deselecting .mdc-evolution-chip__checkmark{transition:opacity 50ms 0ms linear,transform 100ms 0ms cubic-bezier(0.4, 0, 0.2, 1);transform:translate(-75%, -50%)}.mdc-evolution-chip--deselecting .mdc-evolution-chip__checkmark-path{stroke-dashoffset:0}.mdc-evolution-chip--selecting-with-primary-icon .mdc-evolution-chip__icon--primary{transition:opacity 75ms 0ms cubic-bezier(0.4, 0, 0.2, 1)}.mdc-evolution-chip--selecting-with-primary-icon .mdc-evolution-chip__checkmark-path{transition:stroke-dashoffset 150ms 75ms cubic-bezier(0.4, 0, 0.2, 1)}.mdc-evolution-chip--deselecting-with-primary-icon .mdc-evolution-chip__icon--primary{transition:opacity 150ms 75ms cubic-bezier(0.4, 0, 0.2, 1)}.mdc-evolution-chip--deselecting-with-primary-icon .mdc-evolution-chip__checkmark{transition:opacity 75ms 0ms cubic-bezier(0.4, 0, 0.2, 1);transform:translate(-50%, -50%)}.mdc-evolution-chip--deselecting-with-primary-icon .mdc-evolution-chip__checkmark-path{stroke-dashoffset:0}.mdc-evolution-chip--selected .mdc-evolution-chip__icon--primary{opacity:0}.mdc-evolution-chip--selected .mdc-evolution-chip__checkmark{transform:translate(-50%, -50%);opacity:1}.mdc-evolution-chip--selected .mdc-evolution-chip__checkmark-path{stroke-dashoffset:0}@keyframes mdc-evolution-chip-enter{from{transform:scale(0.8);opacity:.4}to{transform:scale(1);opacity:1}}.mdc-evolution-chip--enter{animation:mdc-evolution-chip-enter 100ms 0ms cubic-bezier(0, 0, 0.2, 1)}@keyframes mdc-evolution-chip-exit{from{opacity:1}to{opacity:0}}.mdc-evolution-chip--exit{animation:mdc-evolution-chip-exit 75ms 0ms cubic-bezier(0.4, 0, 1, 1)}.mdc-evolution-chip--hidden{opacity:0;pointer-events:none;transition:width 150ms 0ms cubic-bezier(0.4, 0, 1, 1)}.mat-mdc-standard-chip{border-radius:var(--mdc-chip-container-shape-radius);height:var(--mdc-chip-container-height);--mdc-chip-container-shape-family:rounded;--mdc-chip-container-shape-radius:16px 16px 16px 16px;--mdc-chip-with-avatar-avatar-shape-family:rounded;--mdc-chip-with-avatar-avatar-shape-radius:14px 14px 14px 14px;--mdc-chip-with-avatar-avatar-size:28px;--mdc-chip-with-icon-icon-size:18px}.mat-mdc-standard-chip .mdc-evolution-chip__ripple{border-radius:var(--mdc-chip-container-shape-radius)}.mat-mdc-standard-chip .mdc-evolution-chip__action--primary:before{border-radius:var(--mdc-chip-container-shape-radius)}.mat-mdc-standard-chip .mdc-evolution-chip__icon--primary{border-radius:var(--mdc-chip-with-avatar-avatar-shape-radius)}.mat-mdc-standard-chip.mdc-evolution-chip--selectable:not(.mdc-evolution-chip--with-primary-icon){--mdc-chip-graphic-selected-width:var(--mdc-chip-with-avatar-avatar-size)}.mat-mdc-standard-chip .mdc-evolution-chip__graphic{height:var(--mdc-chip-with-avatar-avatar-size);width:var(--mdc-chip-with-avatar-avatar-size);font-size:var(--mdc-chip-with-avatar-avatar-size)}.mat-mdc-standard-chip:not(.mdc-evolution-chip--disabled){background-color:var(--mdc-chip-elevated-container-color)}.mat-mdc-standard-chip.mdc-evolution-chip--disabled{background-color:var(--mdc-chip-elevated-disabled-container-color)}.mat-mdc-standard-chip.mdc-evolution-chip--selected.mdc-evolution-chip--disabled{background-color:var(--mdc-chip-elevated-disabled-container-color)}.mat-mdc-standard-chip .mdc-evolution-chip__text-label{font-family:var(--mdc-chip-label-text-font);line-height:var(--mdc-chip-label-text-line-height);font-size:var(--mdc-chip-label-text-size);font-weight:var(--mdc-chip-label-text-weight);letter-spacing:var(--mdc-chip-label-text-tracking)}.mat-mdc-standard-chip:not(.mdc-evolution-chip--disabled) .mdc-evolution-chip__text-label{color:var(--mdc-chip-label-text-color)}.mat-mdc-standard-chip.mdc-evolution-chip--disabled .mdc-evolution-chip__text-label{color:var(--mdc-chip-disabled-label-text-color)}.mat-mdc-standard-chip.mdc-evolution-chip--selected.mdc-evolution-chip--disabled .mdc-evolution-chip__text-label{color:var(--mdc-chip-disabled-label-text-color)}.mat-mdc-standard-chip .mdc-evolution-chip__icon--primary{height:var(--mdc-chip-with-icon-icon-size);width:var(--mdc-chip-with-icon-icon-size);font-size:var(--mdc-chip-with-icon-icon-size)}.mat-mdc-standard-chip:not(.mdc-evolution-chip--disabled) .mdc-evolution-chip__icon--primary{color:var(--mdc-chip-with-icon-icon-color)}.mat-mdc-standard-chip.mdc-evolution-chip--disabled .mdc-evolution-chip__icon--primary{color:var(--mdc-chip-with-icon-disabled-icon-color)}.mat-mdc-standard-chip:not(.mdc-evolution-chip--disabled) .mdc-evolution-chip__checkmark{color:var(--mdc-chip-with-icon-selected-icon-color)}.mat-mdc-standard-chip.mdc-evolution-chip--disabled .mdc-evolution-chip__checkmark{color:var(--mdc-chip-with-icon-disabled-icon-color)}.mat-mdc-standard-chip:not(.mdc-evolution-chip--disabled) .mdc-evolution-chip__icon--trailing{color:var(--mdc-chip-with-trailing-icon-trailing-icon-color)}.mat-mdc-standard-chip.mdc-evolution-chip--disabled .mdc-evolution-chip__icon--trailing{color:var(--mdc-chip-with-trailing-icon-disabled-trailing-icon-color)}.mat-mdc-standard-chip .mdc-evolution-chip__action--primary.mdc-ripple-upgraded--background-focused .mdc-evolution-chip__ripple::before,.mat-mdc-standard-chip .mdc-evolution-chip__action--primary:not(.mdc-ripple-upgraded):focus .mdc-evolution-chip__ripple::before{transition-duration:75ms;opacity:var(--mdc-chip-focus-state-layer-opacity)}.mat-mdc-chip-focus-overlay{background:var(--mdc-chip-focus-state-layer-color);opacity:var(--mdc-chip-focus-state-layer-opacity)}.mat-mdc-standard-chip .mdc-evolution-chip__checkmark{height:20px;width:20px}.mat-mdc-standard-chip .mdc-evolution-chip__icon--trailing{height:18px;width:18px;font-size:18px}.mat-mdc-standard-chip .mdc-evolution-chip__action--primary{padding-left:12px;padding-right:12px}[dir=rtl] .mat-mdc-standard-chip .mdc-evolution-chip__action--primary,.mat-mdc-standard-chip .mdc-evolution-chip__action--primary[dir=rtl]{padding-left:12px;padding-right:12px}.mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic .mdc-evolution-chip__graphic{padding-left:6px;padding-right:6px}[dir=rtl] .mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic .mdc-evolution-chip__graphic,.mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic .mdc-evolution-chip__graphic[dir=rtl]{padding-left:6px;padding-right:6px}.mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic .mdc-evolution-chip__action--primary{padding-left:0;padding-right:12px}[dir=rtl] .mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic .mdc-evolution-chip__action--primary,.mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic .mdc-evolution-chip__action--primary[dir=rtl]{padding-left:12px;padding-right:0}.mat-mdc-standard-chip.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--trailing{padding-left:8px;padding-right:8px}[dir=rtl] .mat-mdc-standard-chip.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--trailing,.mat-mdc-standard-chip.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--trailing[dir=rtl]{padding-left:8px;padding-right:8px}.mat-mdc-standard-chip.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__ripple--trailing{left:8px;right:initial}[dir=rtl] .mat-mdc-standard-chip.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__ripple--trailing,.mat-mdc-standard-chip.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__ripple--trailing[dir=rtl]{left:initial;right:8px}.mat-mdc-standard-chip.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--primary{padding-left:12px;padding-right:0}[dir=rtl] .mat-mdc-standard-chip.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--primary,.mat-mdc-standard-chip.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--primary[dir=rtl]{padding-left:0;padding-right:12px}.mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__graphic{padding-left:6px;padding-right:6px}[dir=rtl] .mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__graphic,.mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__graphic[dir=rtl]{padding-left:6px;padding-right:6px}.mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--trailing{padding-left:8px;padding-right:8px}[dir=rtl] .mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--trailing,.mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--trailing[dir=rtl]{padding-left:8px;padding-right:8px}.mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__ripple--trailing{left:8px;right:initial}[dir=rtl] .mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__ripple--trailing,.mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__ripple--trailing[dir=rtl]{left:initial;right:8px}.mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--primary{padding-left:0;padding-right:0}[dir=rtl] .mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--primary,.mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--primary[dir=rtl]{padding-left:0;padding-right:0}.mat-mdc-standard-chip.mdc-evolution-chip--disabled .mdc-evolution-chip__checkmark{color:var(--mdc-chip-with-icon-selected-icon-color, currentColor)}.mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic .mdc-evolution-chip__graphic{padding-left:4px;padding-right:8px}[dir=rtl] .mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic .mdc-evolution-chip__graphic,.mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic .mdc-evolution-chip__graphic[dir=rtl]{padding-left:8px;padding-right:4px}.mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic .mdc-evolution-chip__action--primary{padding-left:0;padding-right:12px}[dir=rtl] .mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic .mdc-evolution-chip__action--primary,.mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic .mdc-evolution-chip__action--primary[dir=rtl]{padding-left:12px;padding-right:0}.mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__graphic{padding-left:4px;padding-right:8px}[dir=rtl] .mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__graphic,.mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__graphic[dir=rtl]{padding-left:8px;padding-right:4px}.mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--trailing{padding-left:8px;padding-right:8px}[dir=rtl] .mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--trailing,.mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--trailing[dir=rtl]{padding-left:8px;padding-right:8px}.mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__ripple--trailing{left:8px;right:initial}[dir=rtl] .mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__ripple--trailing,.mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__ripple--trailing[dir=rtl]{left:initial;right:8px}.mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--primary{padding-left:0;padding-right:0}[dir=rtl] .mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--primary,.mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--primary[dir=rtl]{padding-left:0;padding-right:0}.mat-mdc-standard-chip{-webkit-tap-highlight-color:rgba(0,0,0,0)}.cdk-high-contrast-active .mat-mdc-standard-chip{outline:solid 1px}.cdk-high-contrast-active .mat-mdc-standard-chip .mdc-evolution-chip__checkmark-path{stroke:CanvasText !important}.mat-mdc-standard-chip.mdc-evolution-chip--disabled{opacity:.4}.mat-mdc-standard-chip .mdc-evolution-chip__cell--primary,.mat-mdc-standard-chip .mdc-evolution-chip__action--primary,.mat-mdc-standard-chip .mat-mdc-chip-action-label{overflow:visible}.mat-mdc-standard-chip .mdc-evolution-chip__cell--primary{flex-basis:100%}.mat-mdc-standard-chip .mdc-evolution-chip__action--primary{font:inherit;letter-spacing:inherit;white-space:inherit}.mat-mdc-standard-chip .mat-mdc-chip-graphic,.mat-mdc-standard-chip .mat-mdc-chip-trailing-icon{box-sizing:content-box}.mat-mdc-standard-chip._mat-animation-noopable,.mat-mdc-standard-chip._mat-animation-noopable .mdc-evolution-chip__graphic,.mat-mdc-standard-chip._mat-animation-noopable .mdc-evolution-chip__checkmark,.mat-mdc-standard-chip._mat-animation-noopable .mdc-evolution-chip__checkmark-path{transition-duration:1ms;animation-duration:1ms}.mat-mdc-basic-chip .mdc-evolution-chip__action--primary{font:inherit}.mat-mdc-chip-focus-overlay{top:0;left:0;right:0;bottom:0;position:absolute;pointer-events:none;opacity:0;border-radius:inherit;transition:opacity 150ms linear}._mat-animation-noopable .mat-mdc-chip-focus-overlay{transition:none}.mat-mdc-basic-chip .mat-mdc-chip-focus-overlay{display:none}.mat-mdc-chip:hover .mat-mdc-chip-focus-overlay{opacity:.04}.mat-mdc-chip.cdk-focused .mat-mdc-chip-focus-overlay{opacity:.12}.mat-mdc-chip .mat-ripple.mat-mdc-chip-ripple{top:0;left:0;right:0;bottom:0;position:absolute;pointer-events:none;border-radius:inherit}.mat-mdc-chip-avatar{text-align:center;line-height:1;color:var(--mdc-chip-with-icon-icon-color, currentColor)}.mat-mdc-chip{position:relative;z-index:0}.mat-mdc-chip-action-label{text-align:left;z-index:1}[dir=rtl] .mat-mdc-chip-action-label{text-align:right}.mat-mdc-chip.mdc-evolution-chip--with-trailing-action .mat-mdc-chip-action-label{position:relative}.mat-mdc-chip-action-label .mat-mdc-chip-primary-focus-indicator{position:absolute;top:0;right:0;bottom:0;left:0;pointer-events:none}.mat-mdc-chip-action-label .mat-mdc-focus-indicator::before{margin:calc(calc(var(--mat-mdc-focus-indicator-border-width, 3px) + 2px) * -1)}.mat-mdc-chip-remove{opacity:.54}.mat-mdc-chip-remove:focus{opacity:1}.mat-mdc-chip-remove::before{margin:calc(var(--mat-mdc-focus-indicator-border-width, 3px) * -1);left:8px;right:8px}.mat-mdc-chip-remove .mat-icon{width:inherit;height:inherit;font-size:inherit;box-sizing:content-box}.mat-chip-edit-input{cursor:text;display:inline-block;color:inherit;outline:0}.cdk-high-contrast-active .mat-mdc-chip-selected:not(.mat-mdc-chip-multiple){outline-width:3px}.mat-mdc-chip-action:focus .mat-mdc-focus-indicator::before{content:""}'],encapsulation:2,changeDetection:0}),t})();class $te{constructor(t){}}const qte=ns($te);let em=(()=>{var e;class t extends qte{get chipFocusChanges(){return this._getChipStream(n=>n._onFocus)}get chipDestroyedChanges(){return this._getChipStream(n=>n.destroyed)}get disabled(){return this._disabled}set disabled(n){this._disabled=Q(n),this._syncChipsState()}get empty(){return!this._chips||0===this._chips.length}get role(){return this._explicitRole?this._explicitRole:this.empty?null:this._defaultRole}set role(n){this._explicitRole=n}get focused(){return this._hasFocusedChip()}constructor(n,r,o){super(n),this._elementRef=n,this._changeDetectorRef=r,this._dir=o,this._lastDestroyedFocusedChipIndex=null,this._destroyed=new Y,this._defaultRole="presentation",this._disabled=!1,this._explicitRole=null,this._chipActions=new Cr}ngAfterViewInit(){this._setUpFocusManagement(),this._trackChipSetChanges(),this._trackDestroyedFocusedChip()}ngOnDestroy(){this._keyManager?.destroy(),this._chipActions.destroy(),this._destroyed.next(),this._destroyed.complete()}_hasFocusedChip(){return this._chips&&this._chips.some(n=>n._hasFocus())}_syncChipsState(){this._chips&&this._chips.forEach(n=>{n.disabled=this._disabled,n._changeDetectorRef.markForCheck()})}focus(){}_handleKeydown(n){this._originatesFromChip(n)&&this._keyManager.onKeydown(n)}_isValidIndex(n){return n>=0&&n<this._chips.length}_allowFocusEscape(){if(-1!==this.tabIndex){const n=this.tabIndex;this.tabIndex=-1,setTimeout(()=>this.tabIndex=n)}}_getChipStream(n){return this._chips.changes.pipe(tn(null),Vt(()=>Ot(...this._chips.map(n))))}_originatesFromChip(n){let r=n.target;for(;r&&r!==this._elementRef.nativeElement;){if(r.classList.contains("mat-mdc-chip"))return!0;r=r.parentElement}return!1}_setUpFocusManagement(){this._chips.changes.pipe(tn(this._chips)).subscribe(n=>{const r=[];n.forEach(o=>o._getActions().forEach(s=>r.push(s))),this._chipActions.reset(r),this._chipActions.notifyOnChanges()}),this._keyManager=new Pv(this._chipActions).withVerticalOrientation().withHorizontalOrientation(this._dir?this._dir.value:"ltr").withHomeAndEnd().skipPredicate(n=>this._skipPredicate(n)),this.chipFocusChanges.pipe(ce(this._destroyed)).subscribe(({chip:n})=>{const r=n._getSourceAction(document.activeElement);r&&this._keyManager.updateActiveItem(r)}),this._dir?.change.pipe(ce(this._destroyed)).subscribe(n=>this._keyManager.withHorizontalOrientation(n))}_skipPredicate(n){return!n.isInteractive||n.disabled}_trackChipSetChanges(){this._chips.changes.pipe(tn(null),ce(this._destroyed)).subscribe(()=>{this.disabled&&Promise.resolve().then(()=>this._syncChipsState()),this._redirectDestroyedChipFocus()})}_trackDestroyedFocusedChip(){this.chipDestroyedChanges.pipe(ce(this._destroyed)).subscribe(n=>{const o=this._chips.toArray().indexOf(n.chip);this._isValidIndex(o)&&n.chip._hasFocus()&&(this._lastDestroyedFocusedChipIndex=o)})}_redirectDestroyedChipFocus(){if(null!=this._lastDestroyedFocusedChipIndex){if(this._chips.length){const n=Math.min(this._lastDestroyedFocusedChipIndex,this._chips.length-1),r=this._chips.toArray()[n];r.disabled?1===this._chips.length?this.focus():this._keyManager.setPreviousItemActive():r.focus()}else this.focus();this._lastDestroyedFocusedChipIndex=null}}}return(e=t).\u0275fac=function(n){return new(n||e)(m(W),m(Fe),m(fn,8))},e.\u0275cmp=fe({type:e,selectors:[["mat-chip-set"]],contentQueries:function(n,r,o){if(1&n&&Ee(o,vs,5),2&n){let s;H(s=z())&&(r._chips=s)}},hostAttrs:[1,"mat-mdc-chip-set","mdc-evolution-chip-set"],hostVars:1,hostBindings:function(n,r){1&n&&$("keydown",function(s){return r._handleKeydown(s)}),2&n&&de("role",r.role)},inputs:{disabled:"disabled",role:"role"},features:[P],ngContentSelectors:iw,decls:2,vars:0,consts:[["role","presentation",1,"mdc-evolution-chip-set__chips"]],template:function(n,r){1&n&&(ze(),y(0,"div",0),X(1),w())},styles:[".mdc-evolution-chip-set{display:flex}.mdc-evolution-chip-set:focus{outline:none}.mdc-evolution-chip-set__chips{display:flex;flex-flow:wrap;min-width:0}.mdc-evolution-chip-set--overflow .mdc-evolution-chip-set__chips{flex-flow:nowrap}.mdc-evolution-chip-set .mdc-evolution-chip-set__chips{margin-left:-8px;margin-right:0}[dir=rtl] .mdc-evolution-chip-set .mdc-evolution-chip-set__chips,.mdc-evolution-chip-set .mdc-evolution-chip-set__chips[dir=rtl]{margin-left:0;margin-right:-8px}.mdc-evolution-chip-set .mdc-evolution-chip{margin-left:8px;margin-right:0}[dir=rtl] .mdc-evolution-chip-set .mdc-evolution-chip,.mdc-evolution-chip-set .mdc-evolution-chip[dir=rtl]{margin-left:0;margin-right:8px}.mdc-evolution-chip-set .mdc-evolution-chip{margin-top:4px;margin-bottom:4px}.mat-mdc-chip-set .mdc-evolution-chip-set__chips{min-width:100%}.mat-mdc-chip-set-stacked{flex-direction:column;align-items:flex-start}.mat-mdc-chip-set-stacked .mat-mdc-chip{width:100%}.mat-mdc-chip-set-stacked .mdc-evolution-chip__graphic{flex-grow:0}.mat-mdc-chip-set-stacked .mdc-evolution-chip__action--primary{flex-basis:100%;justify-content:start}input.mat-mdc-chip-input{flex:1 0 150px;margin-left:8px}[dir=rtl] input.mat-mdc-chip-input{margin-left:0;margin-right:8px}"],encapsulation:2,changeDetection:0}),t})();class Qte{constructor(t,i){this.source=t,this.value=i}}class Yte extends em{constructor(t,i,n,r,o,s,a){super(t,i,n),this._defaultErrorStateMatcher=r,this._parentForm=o,this._parentFormGroup=s,this.ngControl=a,this.stateChanges=new Y}}const Kte=jv(Yte);let hP=(()=>{var e;class t extends Kte{get disabled(){return this.ngControl?!!this.ngControl.disabled:this._disabled}set disabled(n){this._disabled=Q(n),this._syncChipsState()}get id(){return this._chipInput.id}get empty(){return(!this._chipInput||this._chipInput.empty)&&(!this._chips||0===this._chips.length)}get placeholder(){return this._chipInput?this._chipInput.placeholder:this._placeholder}set placeholder(n){this._placeholder=n,this.stateChanges.next()}get focused(){return this._chipInput.focused||this._hasFocusedChip()}get required(){return this._required??this.ngControl?.control?.hasValidator(Ay.required)??!1}set required(n){this._required=Q(n),this.stateChanges.next()}get shouldLabelFloat(){return!this.empty||this.focused}get value(){return this._value}set value(n){this._value=n}get chipBlurChanges(){return this._getChipStream(n=>n._onBlur)}constructor(n,r,o,s,a,c,l){super(n,r,o,c,s,a,l),this.controlType="mat-chip-grid",this._defaultRole="grid",this._ariaDescribedbyIds=[],this._onTouched=()=>{},this._onChange=()=>{},this._value=[],this.change=new U,this.valueChange=new U,this._chips=void 0,this.ngControl&&(this.ngControl.valueAccessor=this)}ngAfterContentInit(){this.chipBlurChanges.pipe(ce(this._destroyed)).subscribe(()=>{this._blur(),this.stateChanges.next()}),Ot(this.chipFocusChanges,this._chips.changes).pipe(ce(this._destroyed)).subscribe(()=>this.stateChanges.next())}ngAfterViewInit(){super.ngAfterViewInit()}ngDoCheck(){this.ngControl&&this.updateErrorState()}ngOnDestroy(){super.ngOnDestroy(),this.stateChanges.complete()}registerInput(n){this._chipInput=n,this._chipInput.setDescribedByIds(this._ariaDescribedbyIds)}onContainerClick(n){!this.disabled&&!this._originatesFromChip(n)&&this.focus()}focus(){this.disabled||this._chipInput.focused||(!this._chips.length||this._chips.first.disabled?Promise.resolve().then(()=>this._chipInput.focus()):this._chips.length&&this._keyManager.setFirstItemActive(),this.stateChanges.next())}setDescribedByIds(n){this._ariaDescribedbyIds=n,this._chipInput?.setDescribedByIds(n)}writeValue(n){this._value=n}registerOnChange(n){this._onChange=n}registerOnTouched(n){this._onTouched=n}setDisabledState(n){this.disabled=n,this.stateChanges.next()}_blur(){this.disabled||setTimeout(()=>{this.focused||(this._propagateChanges(),this._markAsTouched())})}_allowFocusEscape(){this._chipInput.focused||super._allowFocusEscape()}_handleKeydown(n){9===n.keyCode?this._chipInput.focused&&An(n,"shiftKey")&&this._chips.length&&!this._chips.last.disabled?(n.preventDefault(),this._keyManager.activeItem?this._keyManager.setActiveItem(this._keyManager.activeItem):this._focusLastChip()):super._allowFocusEscape():this._chipInput.focused||super._handleKeydown(n),this.stateChanges.next()}_focusLastChip(){this._chips.length&&this._chips.last.focus()}_propagateChanges(){const n=this._chips.length?this._chips.toArray().map(r=>r.value):[];this._value=n,this.change.emit(new Qte(this,n)),this.valueChange.emit(n),this._onChange(n),this._changeDetectorRef.markForCheck()}_markAsTouched(){this._onTouched(),this._changeDetectorRef.markForCheck(),this.stateChanges.next()}}return(e=t).\u0275fac=function(n){return new(n||e)(m(W),m(Fe),m(fn,8),m(Ya,8),m(Xa,8),m(Ff),m(Hi,10))},e.\u0275cmp=fe({type:e,selectors:[["mat-chip-grid"]],contentQueries:function(n,r,o){if(1&n&&Ee(o,aw,5),2&n){let s;H(s=z())&&(r._chips=s)}},hostAttrs:[1,"mat-mdc-chip-set","mat-mdc-chip-grid","mdc-evolution-chip-set"],hostVars:10,hostBindings:function(n,r){1&n&&$("focus",function(){return r.focus()})("blur",function(){return r._blur()}),2&n&&(pi("tabIndex",r._chips&&0===r._chips.length?-1:r.tabIndex),de("role",r.role)("aria-disabled",r.disabled.toString())("aria-invalid",r.errorState),se("mat-mdc-chip-list-disabled",r.disabled)("mat-mdc-chip-list-invalid",r.errorState)("mat-mdc-chip-list-required",r.required))},inputs:{tabIndex:"tabIndex",disabled:"disabled",placeholder:"placeholder",required:"required",value:"value",errorStateMatcher:"errorStateMatcher"},outputs:{change:"change",valueChange:"valueChange"},features:[Z([{provide:Kp,useExisting:e}]),P],ngContentSelectors:iw,decls:2,vars:0,consts:[["role","presentation",1,"mdc-evolution-chip-set__chips"]],template:function(n,r){1&n&&(ze(),y(0,"div",0),X(1),w())},styles:[".mdc-evolution-chip-set{display:flex}.mdc-evolution-chip-set:focus{outline:none}.mdc-evolution-chip-set__chips{display:flex;flex-flow:wrap;min-width:0}.mdc-evolution-chip-set--overflow .mdc-evolution-chip-set__chips{flex-flow:nowrap}.mdc-evolution-chip-set .mdc-evolution-chip-set__chips{margin-left:-8px;margin-right:0}[dir=rtl] .mdc-evolution-chip-set .mdc-evolution-chip-set__chips,.mdc-evolution-chip-set .mdc-evolution-chip-set__chips[dir=rtl]{margin-left:0;margin-right:-8px}.mdc-evolution-chip-set .mdc-evolution-chip{margin-left:8px;margin-right:0}[dir=rtl] .mdc-evolution-chip-set .mdc-evolution-chip,.mdc-evolution-chip-set .mdc-evolution-chip[dir=rtl]{margin-left:0;margin-right:8px}.mdc-evolution-chip-set .mdc-evolution-chip{margin-top:4px;margin-bottom:4px}.mat-mdc-chip-set .mdc-evolution-chip-set__chips{min-width:100%}.mat-mdc-chip-set-stacked{flex-direction:column;align-items:flex-start}.mat-mdc-chip-set-stacked .mat-mdc-chip{width:100%}.mat-mdc-chip-set-stacked .mdc-evolution-chip__graphic{flex-grow:0}.mat-mdc-chip-set-stacked .mdc-evolution-chip__action--primary{flex-basis:100%;justify-content:start}input.mat-mdc-chip-input{flex:1 0 150px;margin-left:8px}[dir=rtl] input.mat-mdc-chip-input{margin-left:0;margin-right:8px}"],encapsulation:2,changeDetection:0}),t})(),Xte=0,fP=(()=>{var e;class t{set chipGrid(n){n&&(this._chipGrid=n,this._chipGrid.registerInput(this))}get addOnBlur(){return this._addOnBlur}set addOnBlur(n){this._addOnBlur=Q(n)}get disabled(){return this._disabled||this._chipGrid&&this._chipGrid.disabled}set disabled(n){this._disabled=Q(n)}get empty(){return!this.inputElement.value}constructor(n,r,o){this._elementRef=n,this.focused=!1,this._addOnBlur=!1,this.chipEnd=new U,this.placeholder="",this.id="mat-mdc-chip-list-input-"+Xte++,this._disabled=!1,this.inputElement=this._elementRef.nativeElement,this.separatorKeyCodes=r.separatorKeyCodes,o&&this.inputElement.classList.add("mat-mdc-form-field-input-control")}ngOnChanges(){this._chipGrid.stateChanges.next()}ngOnDestroy(){this.chipEnd.complete()}ngAfterContentInit(){this._focusLastChipOnBackspace=this.empty}_keydown(n){if(n){if(8===n.keyCode&&this._focusLastChipOnBackspace)return this._chipGrid._focusLastChip(),void n.preventDefault();this._focusLastChipOnBackspace=!1}this._emitChipEnd(n)}_keyup(n){!this._focusLastChipOnBackspace&&8===n.keyCode&&this.empty&&(this._focusLastChipOnBackspace=!0,n.preventDefault())}_blur(){this.addOnBlur&&this._emitChipEnd(),this.focused=!1,this._chipGrid.focused||this._chipGrid._blur(),this._chipGrid.stateChanges.next()}_focus(){this.focused=!0,this._focusLastChipOnBackspace=this.empty,this._chipGrid.stateChanges.next()}_emitChipEnd(n){(!n||this._isSeparatorKey(n))&&(this.chipEnd.emit({input:this.inputElement,value:this.inputElement.value,chipInput:this}),n?.preventDefault())}_onInput(){this._chipGrid.stateChanges.next()}focus(){this.inputElement.focus()}clear(){this.inputElement.value="",this._focusLastChipOnBackspace=!0}setDescribedByIds(n){const r=this._elementRef.nativeElement;n.length?r.setAttribute("aria-describedby",n.join(" ")):r.removeAttribute("aria-describedby")}_isSeparatorKey(n){return!An(n)&&new Set(this.separatorKeyCodes).has(n.keyCode)}}return(e=t).\u0275fac=function(n){return new(n||e)(m(W),m(Xp),m(Vd,8))},e.\u0275dir=T({type:e,selectors:[["input","matChipInputFor",""]],hostAttrs:[1,"mat-mdc-chip-input","mat-mdc-input-element","mdc-text-field__input","mat-input-element"],hostVars:6,hostBindings:function(n,r){1&n&&$("keydown",function(s){return r._keydown(s)})("keyup",function(s){return r._keyup(s)})("blur",function(){return r._blur()})("focus",function(){return r._focus()})("input",function(){return r._onInput()}),2&n&&(pi("id",r.id),de("disabled",r.disabled||null)("placeholder",r.placeholder||null)("aria-invalid",r._chipGrid&&r._chipGrid.ngControl?r._chipGrid.ngControl.invalid:null)("aria-required",r._chipGrid&&r._chipGrid.required||null)("required",r._chipGrid&&r._chipGrid.required||null))},inputs:{chipGrid:["matChipInputFor","chipGrid"],addOnBlur:["matChipInputAddOnBlur","addOnBlur"],separatorKeyCodes:["matChipInputSeparatorKeyCodes","separatorKeyCodes"],placeholder:"placeholder",id:"id",disabled:"disabled"},outputs:{chipEnd:"matChipInputTokenEnd"},exportAs:["matChipInput","matChipInputFor"],features:[kt]}),t})(),Zte=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275mod=le({type:e}),e.\u0275inj=ae({providers:[Ff,{provide:Xp,useValue:{separatorKeyCodes:[13]}}],imports:[ve,vn,is,ve]}),t})(),Jte=(()=>{var e;class t{constructor(){this._vertical=!1,this._inset=!1}get vertical(){return this._vertical}set vertical(n){this._vertical=Q(n)}get inset(){return this._inset}set inset(n){this._inset=Q(n)}}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275cmp=fe({type:e,selectors:[["mat-divider"]],hostAttrs:["role","separator",1,"mat-divider"],hostVars:7,hostBindings:function(n,r){2&n&&(de("aria-orientation",r.vertical?"vertical":"horizontal"),se("mat-divider-vertical",r.vertical)("mat-divider-horizontal",!r.vertical)("mat-divider-inset",r.inset))},inputs:{vertical:"vertical",inset:"inset"},decls:0,vars:0,template:function(n,r){},styles:[".mat-divider{--mat-divider-width:1px;display:block;margin:0;border-top-style:solid;border-top-color:var(--mat-divider-color);border-top-width:var(--mat-divider-width)}.mat-divider.mat-divider-vertical{border-top:0;border-right-style:solid;border-right-color:var(--mat-divider-color);border-right-width:var(--mat-divider-width)}.mat-divider.mat-divider-inset{margin-left:80px}[dir=rtl] .mat-divider.mat-divider-inset{margin-left:auto;margin-right:80px}"],encapsulation:2,changeDetection:0}),t})(),ene=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275mod=le({type:e}),e.\u0275inj=ae({imports:[ve,ve]}),t})();const pP=new M("CdkAccordion");let tne=0,nne=(()=>{var e;class t{get expanded(){return this._expanded}set expanded(n){n=Q(n),this._expanded!==n&&(this._expanded=n,this.expandedChange.emit(n),n?(this.opened.emit(),this._expansionDispatcher.notify(this.id,this.accordion?this.accordion.id:this.id)):this.closed.emit(),this._changeDetectorRef.markForCheck())}get disabled(){return this._disabled}set disabled(n){this._disabled=Q(n)}constructor(n,r,o){this.accordion=n,this._changeDetectorRef=r,this._expansionDispatcher=o,this._openCloseAllSubscription=Ae.EMPTY,this.closed=new U,this.opened=new U,this.destroyed=new U,this.expandedChange=new U,this.id="cdk-accordion-child-"+tne++,this._expanded=!1,this._disabled=!1,this._removeUniqueSelectionListener=()=>{},this._removeUniqueSelectionListener=o.listen((s,a)=>{this.accordion&&!this.accordion.multi&&this.accordion.id===a&&this.id!==s&&(this.expanded=!1)}),this.accordion&&(this._openCloseAllSubscription=this._subscribeToOpenCloseAllActions())}ngOnDestroy(){this.opened.complete(),this.closed.complete(),this.destroyed.emit(),this.destroyed.complete(),this._removeUniqueSelectionListener(),this._openCloseAllSubscription.unsubscribe()}toggle(){this.disabled||(this.expanded=!this.expanded)}close(){this.disabled||(this.expanded=!1)}open(){this.disabled||(this.expanded=!0)}_subscribeToOpenCloseAllActions(){return this.accordion._openCloseAllActions.subscribe(n=>{this.disabled||(this.expanded=n)})}}return(e=t).\u0275fac=function(n){return new(n||e)(m(pP,12),m(Fe),m(Jy))},e.\u0275dir=T({type:e,selectors:[["cdk-accordion-item"],["","cdkAccordionItem",""]],inputs:{expanded:"expanded",disabled:"disabled"},outputs:{closed:"closed",opened:"opened",destroyed:"destroyed",expandedChange:"expandedChange"},exportAs:["cdkAccordionItem"],features:[Z([{provide:pP,useValue:void 0}])]}),t})(),ine=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275mod=le({type:e}),e.\u0275inj=ae({}),t})();const rne=["body"];function one(e,t){}const sne=[[["mat-expansion-panel-header"]],"*",[["mat-action-row"]]],ane=["mat-expansion-panel-header","*","mat-action-row"];function cne(e,t){1&e&&ie(0,"span",2),2&e&&E("@indicatorRotate",B()._getExpandedState())}const lne=[[["mat-panel-title"]],[["mat-panel-description"]],"*"],dne=["mat-panel-title","mat-panel-description","*"],mP=new M("MAT_ACCORDION"),gP="225ms cubic-bezier(0.4,0.0,0.2,1)",_P={indicatorRotate:ni("indicatorRotate",[qt("collapsed, void",je({transform:"rotate(0deg)"})),qt("expanded",je({transform:"rotate(180deg)"})),Bt("expanded <=> collapsed, void => collapsed",Lt(gP))]),bodyExpansion:ni("bodyExpansion",[qt("collapsed, void",je({height:"0px",visibility:"hidden"})),qt("expanded",je({height:"*",visibility:""})),Bt("expanded <=> collapsed, void => collapsed",Lt(gP))])},bP=new M("MAT_EXPANSION_PANEL");let une=(()=>{var e;class t{constructor(n,r){this._template=n,this._expansionPanel=r}}return(e=t).\u0275fac=function(n){return new(n||e)(m(ct),m(bP,8))},e.\u0275dir=T({type:e,selectors:[["ng-template","matExpansionPanelContent",""]]}),t})(),hne=0;const vP=new M("MAT_EXPANSION_PANEL_DEFAULT_OPTIONS");let yP=(()=>{var e;class t extends nne{get hideToggle(){return this._hideToggle||this.accordion&&this.accordion.hideToggle}set hideToggle(n){this._hideToggle=Q(n)}get togglePosition(){return this._togglePosition||this.accordion&&this.accordion.togglePosition}set togglePosition(n){this._togglePosition=n}constructor(n,r,o,s,a,c,l){super(n,r,o),this._viewContainerRef=s,this._animationMode=c,this._hideToggle=!1,this.afterExpand=new U,this.afterCollapse=new U,this._inputChanges=new Y,this._headerId="mat-expansion-panel-header-"+hne++,this._bodyAnimationDone=new Y,this.accordion=n,this._document=a,this._bodyAnimationDone.pipe(Is((d,u)=>d.fromState===u.fromState&&d.toState===u.toState)).subscribe(d=>{"void"!==d.fromState&&("expanded"===d.toState?this.afterExpand.emit():"collapsed"===d.toState&&this.afterCollapse.emit())}),l&&(this.hideToggle=l.hideToggle)}_hasSpacing(){return!!this.accordion&&this.expanded&&"default"===this.accordion.displayMode}_getExpandedState(){return this.expanded?"expanded":"collapsed"}toggle(){this.expanded=!this.expanded}close(){this.expanded=!1}open(){this.expanded=!0}ngAfterContentInit(){this._lazyContent&&this._lazyContent._expansionPanel===this&&this.opened.pipe(tn(null),Ve(()=>this.expanded&&!this._portal),Xe(1)).subscribe(()=>{this._portal=new co(this._lazyContent._template,this._viewContainerRef)})}ngOnChanges(n){this._inputChanges.next(n)}ngOnDestroy(){super.ngOnDestroy(),this._bodyAnimationDone.complete(),this._inputChanges.complete()}_containsFocus(){if(this._body){const n=this._document.activeElement,r=this._body.nativeElement;return n===r||r.contains(n)}return!1}}return(e=t).\u0275fac=function(n){return new(n||e)(m(mP,12),m(Fe),m(Jy),m(pt),m(he),m(_t,8),m(vP,8))},e.\u0275cmp=fe({type:e,selectors:[["mat-expansion-panel"]],contentQueries:function(n,r,o){if(1&n&&Ee(o,une,5),2&n){let s;H(s=z())&&(r._lazyContent=s.first)}},viewQuery:function(n,r){if(1&n&&ke(rne,5),2&n){let o;H(o=z())&&(r._body=o.first)}},hostAttrs:[1,"mat-expansion-panel"],hostVars:6,hostBindings:function(n,r){2&n&&se("mat-expanded",r.expanded)("_mat-animation-noopable","NoopAnimations"===r._animationMode)("mat-expansion-panel-spacing",r._hasSpacing())},inputs:{disabled:"disabled",expanded:"expanded",hideToggle:"hideToggle",togglePosition:"togglePosition"},outputs:{opened:"opened",closed:"closed",expandedChange:"expandedChange",afterExpand:"afterExpand",afterCollapse:"afterCollapse"},exportAs:["matExpansionPanel"],features:[Z([{provide:mP,useValue:void 0},{provide:bP,useExisting:e}]),P,kt],ngContentSelectors:ane,decls:7,vars:4,consts:[["role","region",1,"mat-expansion-panel-content",3,"id"],["body",""],[1,"mat-expansion-panel-body"],[3,"cdkPortalOutlet"]],template:function(n,r){1&n&&(ze(sne),X(0),y(1,"div",0,1),$("@bodyExpansion.done",function(s){return r._bodyAnimationDone.next(s)}),y(3,"div",2),X(4,1),R(5,one,0,0,"ng-template",3),w(),X(6,2),w()),2&n&&(x(1),E("@bodyExpansion",r._getExpandedState())("id",r.id),de("aria-labelledby",r._headerId),x(4),E("cdkPortalOutlet",r._portal))},dependencies:[La],styles:['.mat-expansion-panel{--mat-expansion-container-shape:4px;box-sizing:content-box;display:block;margin:0;overflow:hidden;transition:margin 225ms cubic-bezier(0.4, 0, 0.2, 1),box-shadow 280ms cubic-bezier(0.4, 0, 0.2, 1);position:relative;background:var(--mat-expansion-container-background-color);color:var(--mat-expansion-container-text-color);border-radius:var(--mat-expansion-container-shape)}.mat-expansion-panel:not([class*=mat-elevation-z]){box-shadow:0px 3px 1px -2px rgba(0, 0, 0, 0.2), 0px 2px 2px 0px rgba(0, 0, 0, 0.14), 0px 1px 5px 0px rgba(0, 0, 0, 0.12)}.mat-accordion .mat-expansion-panel:not(.mat-expanded),.mat-accordion .mat-expansion-panel:not(.mat-expansion-panel-spacing){border-radius:0}.mat-accordion .mat-expansion-panel:first-of-type{border-top-right-radius:var(--mat-expansion-container-shape);border-top-left-radius:var(--mat-expansion-container-shape)}.mat-accordion .mat-expansion-panel:last-of-type{border-bottom-right-radius:var(--mat-expansion-container-shape);border-bottom-left-radius:var(--mat-expansion-container-shape)}.cdk-high-contrast-active .mat-expansion-panel{outline:solid 1px}.mat-expansion-panel.ng-animate-disabled,.ng-animate-disabled .mat-expansion-panel,.mat-expansion-panel._mat-animation-noopable{transition:none}.mat-expansion-panel-content{display:flex;flex-direction:column;overflow:visible;font-family:var(--mat-expansion-container-text-font);font-size:var(--mat-expansion-container-text-size);font-weight:var(--mat-expansion-container-text-weight);line-height:var(--mat-expansion-container-text-line-height);letter-spacing:var(--mat-expansion-container-text-tracking)}.mat-expansion-panel-content[style*="visibility: hidden"] *{visibility:hidden !important}.mat-expansion-panel-body{padding:0 24px 16px}.mat-expansion-panel-spacing{margin:16px 0}.mat-accordion>.mat-expansion-panel-spacing:first-child,.mat-accordion>*:first-child:not(.mat-expansion-panel) .mat-expansion-panel-spacing{margin-top:0}.mat-accordion>.mat-expansion-panel-spacing:last-child,.mat-accordion>*:last-child:not(.mat-expansion-panel) .mat-expansion-panel-spacing{margin-bottom:0}.mat-action-row{border-top-style:solid;border-top-width:1px;display:flex;flex-direction:row;justify-content:flex-end;padding:16px 8px 16px 24px;border-top-color:var(--mat-expansion-actions-divider-color)}.mat-action-row .mat-button-base,.mat-action-row .mat-mdc-button-base{margin-left:8px}[dir=rtl] .mat-action-row .mat-button-base,[dir=rtl] .mat-action-row .mat-mdc-button-base{margin-left:0;margin-right:8px}'],encapsulation:2,data:{animation:[_P.bodyExpansion]},changeDetection:0}),t})();class fne{}const pne=ns(fne);let mne=(()=>{var e;class t extends pne{constructor(n,r,o,s,a,c,l){super(),this.panel=n,this._element=r,this._focusMonitor=o,this._changeDetectorRef=s,this._animationMode=c,this._parentChangeSubscription=Ae.EMPTY;const d=n.accordion?n.accordion._stateChanges.pipe(Ve(u=>!(!u.hideToggle&&!u.togglePosition))):Rt;this.tabIndex=parseInt(l||"")||0,this._parentChangeSubscription=Ot(n.opened,n.closed,d,n._inputChanges.pipe(Ve(u=>!!(u.hideToggle||u.disabled||u.togglePosition)))).subscribe(()=>this._changeDetectorRef.markForCheck()),n.closed.pipe(Ve(()=>n._containsFocus())).subscribe(()=>o.focusVia(r,"program")),a&&(this.expandedHeight=a.expandedHeight,this.collapsedHeight=a.collapsedHeight)}get disabled(){return this.panel.disabled}_toggle(){this.disabled||this.panel.toggle()}_isExpanded(){return this.panel.expanded}_getExpandedState(){return this.panel._getExpandedState()}_getPanelId(){return this.panel.id}_getTogglePosition(){return this.panel.togglePosition}_showToggle(){return!this.panel.hideToggle&&!this.panel.disabled}_getHeaderHeight(){const n=this._isExpanded();return n&&this.expandedHeight?this.expandedHeight:!n&&this.collapsedHeight?this.collapsedHeight:null}_keydown(n){switch(n.keyCode){case 32:case 13:An(n)||(n.preventDefault(),this._toggle());break;default:return void(this.panel.accordion&&this.panel.accordion._handleHeaderKeydown(n))}}focus(n,r){n?this._focusMonitor.focusVia(this._element,n,r):this._element.nativeElement.focus(r)}ngAfterViewInit(){this._focusMonitor.monitor(this._element).subscribe(n=>{n&&this.panel.accordion&&this.panel.accordion._handleHeaderFocus(this)})}ngOnDestroy(){this._parentChangeSubscription.unsubscribe(),this._focusMonitor.stopMonitoring(this._element)}}return(e=t).\u0275fac=function(n){return new(n||e)(m(yP,1),m(W),m(ar),m(Fe),m(vP,8),m(_t,8),ui("tabindex"))},e.\u0275cmp=fe({type:e,selectors:[["mat-expansion-panel-header"]],hostAttrs:["role","button",1,"mat-expansion-panel-header","mat-focus-indicator"],hostVars:15,hostBindings:function(n,r){1&n&&$("click",function(){return r._toggle()})("keydown",function(s){return r._keydown(s)}),2&n&&(de("id",r.panel._headerId)("tabindex",r.tabIndex)("aria-controls",r._getPanelId())("aria-expanded",r._isExpanded())("aria-disabled",r.panel.disabled),kn("height",r._getHeaderHeight()),se("mat-expanded",r._isExpanded())("mat-expansion-toggle-indicator-after","after"===r._getTogglePosition())("mat-expansion-toggle-indicator-before","before"===r._getTogglePosition())("_mat-animation-noopable","NoopAnimations"===r._animationMode))},inputs:{tabIndex:"tabIndex",expandedHeight:"expandedHeight",collapsedHeight:"collapsedHeight"},features:[P],ngContentSelectors:dne,decls:5,vars:3,consts:[[1,"mat-content"],["class","mat-expansion-indicator",4,"ngIf"],[1,"mat-expansion-indicator"]],template:function(n,r){1&n&&(ze(lne),y(0,"span",0),X(1),X(2,1),X(3,2),w(),R(4,cne,1,1,"span",1)),2&n&&(se("mat-content-hide-toggle",!r._showToggle()),x(4),E("ngIf",r._showToggle()))},dependencies:[_i],styles:['.mat-expansion-panel-header{display:flex;flex-direction:row;align-items:center;padding:0 24px;border-radius:inherit;transition:height 225ms cubic-bezier(0.4, 0, 0.2, 1);height:var(--mat-expansion-header-collapsed-state-height);font-family:var(--mat-expansion-header-text-font);font-size:var(--mat-expansion-header-text-size);font-weight:var(--mat-expansion-header-text-weight);line-height:var(--mat-expansion-header-text-line-height);letter-spacing:var(--mat-expansion-header-text-tracking)}.mat-expansion-panel-header.mat-expanded{height:var(--mat-expansion-header-expanded-state-height)}.mat-expansion-panel-header[aria-disabled=true]{color:var(--mat-expansion-header-disabled-state-text-color)}.mat-expansion-panel-header:not([aria-disabled=true]){cursor:pointer}.mat-expansion-panel:not(.mat-expanded) .mat-expansion-panel-header:not([aria-disabled=true]):hover{background:var(--mat-expansion-header-hover-state-layer-color)}@media(hover: none){.mat-expansion-panel:not(.mat-expanded) .mat-expansion-panel-header:not([aria-disabled=true]):hover{background:var(--mat-expansion-container-background-color)}}.mat-expansion-panel .mat-expansion-panel-header:not([aria-disabled=true]).cdk-keyboard-focused,.mat-expansion-panel .mat-expansion-panel-header:not([aria-disabled=true]).cdk-program-focused{background:var(--mat-expansion-header-focus-state-layer-color)}.mat-expansion-panel-header._mat-animation-noopable{transition:none}.mat-expansion-panel-header:focus,.mat-expansion-panel-header:hover{outline:none}.mat-expansion-panel-header.mat-expanded:focus,.mat-expansion-panel-header.mat-expanded:hover{background:inherit}.mat-expansion-panel-header.mat-expansion-toggle-indicator-before{flex-direction:row-reverse}.mat-expansion-panel-header.mat-expansion-toggle-indicator-before .mat-expansion-indicator{margin:0 16px 0 0}[dir=rtl] .mat-expansion-panel-header.mat-expansion-toggle-indicator-before .mat-expansion-indicator{margin:0 0 0 16px}.mat-content{display:flex;flex:1;flex-direction:row;overflow:hidden}.mat-content.mat-content-hide-toggle{margin-right:8px}[dir=rtl] .mat-content.mat-content-hide-toggle{margin-right:0;margin-left:8px}.mat-expansion-toggle-indicator-before .mat-content.mat-content-hide-toggle{margin-left:24px;margin-right:0}[dir=rtl] .mat-expansion-toggle-indicator-before .mat-content.mat-content-hide-toggle{margin-right:24px;margin-left:0}.mat-expansion-panel-header-title{color:var(--mat-expansion-header-text-color)}.mat-expansion-panel-header-title,.mat-expansion-panel-header-description{display:flex;flex-grow:1;flex-basis:0;margin-right:16px;align-items:center}[dir=rtl] .mat-expansion-panel-header-title,[dir=rtl] .mat-expansion-panel-header-description{margin-right:0;margin-left:16px}.mat-expansion-panel-header[aria-disabled=true] .mat-expansion-panel-header-title,.mat-expansion-panel-header[aria-disabled=true] .mat-expansion-panel-header-description{color:inherit}.mat-expansion-panel-header-description{flex-grow:2;color:var(--mat-expansion-header-description-color)}.mat-expansion-indicator::after{border-style:solid;border-width:0 2px 2px 0;content:"";display:inline-block;padding:3px;transform:rotate(45deg);vertical-align:middle;color:var(--mat-expansion-header-indicator-color)}.cdk-high-contrast-active .mat-expansion-panel-content{border-top:1px solid;border-top-left-radius:0;border-top-right-radius:0}'],encapsulation:2,data:{animation:[_P.indicatorRotate]},changeDetection:0}),t})(),gne=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275dir=T({type:e,selectors:[["mat-panel-title"]],hostAttrs:[1,"mat-expansion-panel-header-title"]}),t})(),_ne=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275mod=le({type:e}),e.\u0275inj=ae({imports:[vn,ve,ine,qf]}),t})();const wP=ro({passive:!0});let bne=(()=>{var e;class t{constructor(n,r){this._platform=n,this._ngZone=r,this._monitoredElements=new Map}monitor(n){if(!this._platform.isBrowser)return Rt;const r=Ar(n),o=this._monitoredElements.get(r);if(o)return o.subject;const s=new Y,a="cdk-text-field-autofilled",c=l=>{"cdk-text-field-autofill-start"!==l.animationName||r.classList.contains(a)?"cdk-text-field-autofill-end"===l.animationName&&r.classList.contains(a)&&(r.classList.remove(a),this._ngZone.run(()=>s.next({target:l.target,isAutofilled:!1}))):(r.classList.add(a),this._ngZone.run(()=>s.next({target:l.target,isAutofilled:!0})))};return this._ngZone.runOutsideAngular(()=>{r.addEventListener("animationstart",c,wP),r.classList.add("cdk-text-field-autofill-monitored")}),this._monitoredElements.set(r,{subject:s,unlisten:()=>{r.removeEventListener("animationstart",c,wP)}}),s}stopMonitoring(n){const r=Ar(n),o=this._monitoredElements.get(r);o&&(o.unlisten(),o.subject.complete(),r.classList.remove("cdk-text-field-autofill-monitored"),r.classList.remove("cdk-text-field-autofilled"),this._monitoredElements.delete(r))}ngOnDestroy(){this._monitoredElements.forEach((n,r)=>this.stopMonitoring(r))}}return(e=t).\u0275fac=function(n){return new(n||e)(D(nt),D(G))},e.\u0275prov=V({token:e,factory:e.\u0275fac,providedIn:"root"}),t})(),vne=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275mod=le({type:e}),e.\u0275inj=ae({}),t})();const yne=new M("MAT_INPUT_VALUE_ACCESSOR"),wne=["button","checkbox","file","hidden","image","radio","range","reset","submit"];let xne=0;const Cne=jv(class{constructor(e,t,i,n){this._defaultErrorStateMatcher=e,this._parentForm=t,this._parentFormGroup=i,this.ngControl=n,this.stateChanges=new Y}});let Dne=(()=>{var e;class t extends Cne{get disabled(){return this._disabled}set disabled(n){this._disabled=Q(n),this.focused&&(this.focused=!1,this.stateChanges.next())}get id(){return this._id}set id(n){this._id=n||this._uid}get required(){return this._required??this.ngControl?.control?.hasValidator(Ay.required)??!1}set required(n){this._required=Q(n)}get type(){return this._type}set type(n){this._type=n||"text",this._validateType(),!this._isTextarea&&CI().has(this._type)&&(this._elementRef.nativeElement.type=this._type)}get value(){return this._inputValueAccessor.value}set value(n){n!==this.value&&(this._inputValueAccessor.value=n,this.stateChanges.next())}get readonly(){return this._readonly}set readonly(n){this._readonly=Q(n)}constructor(n,r,o,s,a,c,l,d,u,h){super(c,s,a,o),this._elementRef=n,this._platform=r,this._autofillMonitor=d,this._formField=h,this._uid="mat-input-"+xne++,this.focused=!1,this.stateChanges=new Y,this.controlType="mat-input",this.autofilled=!1,this._disabled=!1,this._type="text",this._readonly=!1,this._neverEmptyInputTypes=["date","datetime","datetime-local","month","time","week"].filter(g=>CI().has(g)),this._iOSKeyupListener=g=>{const b=g.target;!b.value&&0===b.selectionStart&&0===b.selectionEnd&&(b.setSelectionRange(1,1),b.setSelectionRange(0,0))};const f=this._elementRef.nativeElement,p=f.nodeName.toLowerCase();this._inputValueAccessor=l||f,this._previousNativeValue=this.value,this.id=this.id,r.IOS&&u.runOutsideAngular(()=>{n.nativeElement.addEventListener("keyup",this._iOSKeyupListener)}),this._isServer=!this._platform.isBrowser,this._isNativeSelect="select"===p,this._isTextarea="textarea"===p,this._isInFormField=!!h,this._isNativeSelect&&(this.controlType=f.multiple?"mat-native-select-multiple":"mat-native-select")}ngAfterViewInit(){this._platform.isBrowser&&this._autofillMonitor.monitor(this._elementRef.nativeElement).subscribe(n=>{this.autofilled=n.isAutofilled,this.stateChanges.next()})}ngOnChanges(){this.stateChanges.next()}ngOnDestroy(){this.stateChanges.complete(),this._platform.isBrowser&&this._autofillMonitor.stopMonitoring(this._elementRef.nativeElement),this._platform.IOS&&this._elementRef.nativeElement.removeEventListener("keyup",this._iOSKeyupListener)}ngDoCheck(){this.ngControl&&(this.updateErrorState(),null!==this.ngControl.disabled&&this.ngControl.disabled!==this.disabled&&(this.disabled=this.ngControl.disabled,this.stateChanges.next())),this._dirtyCheckNativeValue(),this._dirtyCheckPlaceholder()}focus(n){this._elementRef.nativeElement.focus(n)}_focusChanged(n){n!==this.focused&&(this.focused=n,this.stateChanges.next())}_onInput(){}_dirtyCheckNativeValue(){const n=this._elementRef.nativeElement.value;this._previousNativeValue!==n&&(this._previousNativeValue=n,this.stateChanges.next())}_dirtyCheckPlaceholder(){const n=this._getPlaceholder();if(n!==this._previousPlaceholder){const r=this._elementRef.nativeElement;this._previousPlaceholder=n,n?r.setAttribute("placeholder",n):r.removeAttribute("placeholder")}}_getPlaceholder(){return this.placeholder||null}_validateType(){wne.indexOf(this._type)}_isNeverEmpty(){return this._neverEmptyInputTypes.indexOf(this._type)>-1}_isBadInput(){let n=this._elementRef.nativeElement.validity;return n&&n.badInput}get empty(){return!(this._isNeverEmpty()||this._elementRef.nativeElement.value||this._isBadInput()||this.autofilled)}get shouldLabelFloat(){if(this._isNativeSelect){const n=this._elementRef.nativeElement,r=n.options[0];return this.focused||n.multiple||!this.empty||!!(n.selectedIndex>-1&&r&&r.label)}return this.focused||!this.empty}setDescribedByIds(n){n.length?this._elementRef.nativeElement.setAttribute("aria-describedby",n.join(" ")):this._elementRef.nativeElement.removeAttribute("aria-describedby")}onContainerClick(){this.focused||this.focus()}_isInlineSelect(){const n=this._elementRef.nativeElement;return this._isNativeSelect&&(n.multiple||n.size>1)}}return(e=t).\u0275fac=function(n){return new(n||e)(m(W),m(nt),m(Hi,10),m(Ya,8),m(Xa,8),m(Ff),m(yne,10),m(bne),m(G),m(Vd,8))},e.\u0275dir=T({type:e,selectors:[["input","matInput",""],["textarea","matInput",""],["select","matNativeControl",""],["input","matNativeControl",""],["textarea","matNativeControl",""]],hostAttrs:[1,"mat-mdc-input-element"],hostVars:18,hostBindings:function(n,r){1&n&&$("focus",function(){return r._focusChanged(!0)})("blur",function(){return r._focusChanged(!1)})("input",function(){return r._onInput()}),2&n&&(pi("id",r.id)("disabled",r.disabled)("required",r.required),de("name",r.name||null)("readonly",r.readonly&&!r._isNativeSelect||null)("aria-invalid",r.empty&&r.required?null:r.errorState)("aria-required",r.required)("id",r.id),se("mat-input-server",r._isServer)("mat-mdc-form-field-textarea-control",r._isInFormField&&r._isTextarea)("mat-mdc-form-field-input-control",r._isInFormField)("mdc-text-field__input",r._isInFormField)("mat-mdc-native-select-inline",r._isInlineSelect()))},inputs:{disabled:"disabled",id:"id",placeholder:"placeholder",name:"name",required:"required",type:"type",errorStateMatcher:"errorStateMatcher",userAriaDescribedBy:["aria-describedby","userAriaDescribedBy"],value:"value",readonly:"readonly"},exportAs:["matInput"],features:[Z([{provide:Kp,useExisting:e}]),P,kt]}),t})(),Ene=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275mod=le({type:e}),e.\u0275inj=ae({imports:[ve,jd,jd,vne,ve]}),t})();const Sne=new M("MAT_PROGRESS_BAR_DEFAULT_OPTIONS"),Tne=ts(class{constructor(e){this._elementRef=e}},"primary");let Mne=(()=>{var e;class t extends Tne{constructor(n,r,o,s,a){super(n),this._ngZone=r,this._changeDetectorRef=o,this._animationMode=s,this._isNoopAnimation=!1,this._value=0,this._bufferValue=0,this.animationEnd=new U,this._mode="determinate",this._transitionendHandler=c=>{0===this.animationEnd.observers.length||!c.target||!c.target.classList.contains("mdc-linear-progress__primary-bar")||("determinate"===this.mode||"buffer"===this.mode)&&this._ngZone.run(()=>this.animationEnd.next({value:this.value}))},this._isNoopAnimation="NoopAnimations"===s,a&&(a.color&&(this.color=this.defaultColor=a.color),this.mode=a.mode||this.mode)}get value(){return this._value}set value(n){this._value=xP(Bi(n)),this._changeDetectorRef.markForCheck()}get bufferValue(){return this._bufferValue||0}set bufferValue(n){this._bufferValue=xP(Bi(n)),this._changeDetectorRef.markForCheck()}get mode(){return this._mode}set mode(n){this._mode=n,this._changeDetectorRef.markForCheck()}ngAfterViewInit(){this._ngZone.runOutsideAngular(()=>{this._elementRef.nativeElement.addEventListener("transitionend",this._transitionendHandler)})}ngOnDestroy(){this._elementRef.nativeElement.removeEventListener("transitionend",this._transitionendHandler)}_getPrimaryBarTransform(){return`scaleX(${this._isIndeterminate()?1:this.value/100})`}_getBufferBarFlexBasis(){return`${"buffer"===this.mode?this.bufferValue:100}%`}_isIndeterminate(){return"indeterminate"===this.mode||"query"===this.mode}}return(e=t).\u0275fac=function(n){return new(n||e)(m(W),m(G),m(Fe),m(_t,8),m(Sne,8))},e.\u0275cmp=fe({type:e,selectors:[["mat-progress-bar"]],hostAttrs:["role","progressbar","aria-valuemin","0","aria-valuemax","100","tabindex","-1",1,"mat-mdc-progress-bar","mdc-linear-progress"],hostVars:8,hostBindings:function(n,r){2&n&&(de("aria-valuenow",r._isIndeterminate()?null:r.value)("mode",r.mode),se("_mat-animation-noopable",r._isNoopAnimation)("mdc-linear-progress--animation-ready",!r._isNoopAnimation)("mdc-linear-progress--indeterminate",r._isIndeterminate()))},inputs:{color:"color",value:"value",bufferValue:"bufferValue",mode:"mode"},outputs:{animationEnd:"animationEnd"},exportAs:["matProgressBar"],features:[P],decls:7,vars:4,consts:[["aria-hidden","true",1,"mdc-linear-progress__buffer"],[1,"mdc-linear-progress__buffer-bar"],[1,"mdc-linear-progress__buffer-dots"],["aria-hidden","true",1,"mdc-linear-progress__bar","mdc-linear-progress__primary-bar"],[1,"mdc-linear-progress__bar-inner"],["aria-hidden","true",1,"mdc-linear-progress__bar","mdc-linear-progress__secondary-bar"]],template:function(n,r){1&n&&(y(0,"div",0),ie(1,"div",1)(2,"div",2),w(),y(3,"div",3),ie(4,"span",4),w(),y(5,"div",5),ie(6,"span",4),w()),2&n&&(x(1),kn("flex-basis",r._getBufferBarFlexBasis()),x(2),kn("transform",r._getPrimaryBarTransform()))},styles:["@keyframes mdc-linear-progress-primary-indeterminate-translate{0%{transform:translateX(0)}20%{animation-timing-function:cubic-bezier(0.5, 0, 0.701732, 0.495819);transform:translateX(0)}59.15%{animation-timing-function:cubic-bezier(0.302435, 0.381352, 0.55, 0.956352);transform:translateX(var(--mdc-linear-progress-primary-half))}100%{transform:translateX(var(--mdc-linear-progress-primary-full))}}@keyframes mdc-linear-progress-primary-indeterminate-scale{0%{transform:scaleX(0.08)}36.65%{animation-timing-function:cubic-bezier(0.334731, 0.12482, 0.785844, 1);transform:scaleX(0.08)}69.15%{animation-timing-function:cubic-bezier(0.06, 0.11, 0.6, 1);transform:scaleX(0.661479)}100%{transform:scaleX(0.08)}}@keyframes mdc-linear-progress-secondary-indeterminate-translate{0%{animation-timing-function:cubic-bezier(0.15, 0, 0.515058, 0.409685);transform:translateX(0)}25%{animation-timing-function:cubic-bezier(0.31033, 0.284058, 0.8, 0.733712);transform:translateX(var(--mdc-linear-progress-secondary-quarter))}48.35%{animation-timing-function:cubic-bezier(0.4, 0.627035, 0.6, 0.902026);transform:translateX(var(--mdc-linear-progress-secondary-half))}100%{transform:translateX(var(--mdc-linear-progress-secondary-full))}}@keyframes mdc-linear-progress-secondary-indeterminate-scale{0%{animation-timing-function:cubic-bezier(0.205028, 0.057051, 0.57661, 0.453971);transform:scaleX(0.08)}19.15%{animation-timing-function:cubic-bezier(0.152313, 0.196432, 0.648374, 1.004315);transform:scaleX(0.457104)}44.15%{animation-timing-function:cubic-bezier(0.257759, -0.003163, 0.211762, 1.38179);transform:scaleX(0.72796)}100%{transform:scaleX(0.08)}}@keyframes mdc-linear-progress-primary-indeterminate-translate-reverse{0%{transform:translateX(0)}20%{animation-timing-function:cubic-bezier(0.5, 0, 0.701732, 0.495819);transform:translateX(0)}59.15%{animation-timing-function:cubic-bezier(0.302435, 0.381352, 0.55, 0.956352);transform:translateX(var(--mdc-linear-progress-primary-half-neg))}100%{transform:translateX(var(--mdc-linear-progress-primary-full-neg))}}@keyframes mdc-linear-progress-secondary-indeterminate-translate-reverse{0%{animation-timing-function:cubic-bezier(0.15, 0, 0.515058, 0.409685);transform:translateX(0)}25%{animation-timing-function:cubic-bezier(0.31033, 0.284058, 0.8, 0.733712);transform:translateX(var(--mdc-linear-progress-secondary-quarter-neg))}48.35%{animation-timing-function:cubic-bezier(0.4, 0.627035, 0.6, 0.902026);transform:translateX(var(--mdc-linear-progress-secondary-half-neg))}100%{transform:translateX(var(--mdc-linear-progress-secondary-full-neg))}}@keyframes mdc-linear-progress-buffering-reverse{from{transform:translateX(-10px)}}.mdc-linear-progress{position:relative;width:100%;transform:translateZ(0);outline:1px solid rgba(0,0,0,0);overflow-x:hidden;transition:opacity 250ms 0ms cubic-bezier(0.4, 0, 0.6, 1)}@media screen and (forced-colors: active){.mdc-linear-progress{outline-color:CanvasText}}.mdc-linear-progress__bar{position:absolute;top:0;bottom:0;margin:auto 0;width:100%;animation:none;transform-origin:top left;transition:transform 250ms 0ms cubic-bezier(0.4, 0, 0.6, 1)}.mdc-linear-progress__bar-inner{display:inline-block;position:absolute;width:100%;animation:none;border-top-style:solid}.mdc-linear-progress__buffer{display:flex;position:absolute;top:0;bottom:0;margin:auto 0;width:100%;overflow:hidden}.mdc-linear-progress__buffer-dots{background-repeat:repeat-x;flex:auto;transform:rotate(180deg);-webkit-mask-image:url(\"data:image/svg+xml,%3Csvg version='1.1' xmlns='http://www.w3.org/2000/svg' xmlns:xlink='http://www.w3.org/1999/xlink' x='0px' y='0px' enable-background='new 0 0 5 2' xml:space='preserve' viewBox='0 0 5 2' preserveAspectRatio='xMinYMin slice'%3E%3Ccircle cx='1' cy='1' r='1'/%3E%3C/svg%3E\");mask-image:url(\"data:image/svg+xml,%3Csvg version='1.1' xmlns='http://www.w3.org/2000/svg' xmlns:xlink='http://www.w3.org/1999/xlink' x='0px' y='0px' enable-background='new 0 0 5 2' xml:space='preserve' viewBox='0 0 5 2' preserveAspectRatio='xMinYMin slice'%3E%3Ccircle cx='1' cy='1' r='1'/%3E%3C/svg%3E\");animation:mdc-linear-progress-buffering 250ms infinite linear}.mdc-linear-progress__buffer-bar{flex:0 1 100%;transition:flex-basis 250ms 0ms cubic-bezier(0.4, 0, 0.6, 1)}.mdc-linear-progress__primary-bar{transform:scaleX(0)}.mdc-linear-progress__secondary-bar{display:none}.mdc-linear-progress--indeterminate .mdc-linear-progress__bar{transition:none}.mdc-linear-progress--indeterminate .mdc-linear-progress__primary-bar{left:-145.166611%}.mdc-linear-progress--indeterminate .mdc-linear-progress__secondary-bar{left:-54.888891%;display:block}.mdc-linear-progress--indeterminate.mdc-linear-progress--animation-ready .mdc-linear-progress__primary-bar{animation:mdc-linear-progress-primary-indeterminate-translate 2s infinite linear}.mdc-linear-progress--indeterminate.mdc-linear-progress--animation-ready .mdc-linear-progress__primary-bar>.mdc-linear-progress__bar-inner{animation:mdc-linear-progress-primary-indeterminate-scale 2s infinite linear}.mdc-linear-progress--indeterminate.mdc-linear-progress--animation-ready .mdc-linear-progress__secondary-bar{animation:mdc-linear-progress-secondary-indeterminate-translate 2s infinite linear}.mdc-linear-progress--indeterminate.mdc-linear-progress--animation-ready .mdc-linear-progress__secondary-bar>.mdc-linear-progress__bar-inner{animation:mdc-linear-progress-secondary-indeterminate-scale 2s infinite linear}[dir=rtl] .mdc-linear-progress:not([dir=ltr]) .mdc-linear-progress__bar,.mdc-linear-progress[dir=rtl]:not([dir=ltr]) .mdc-linear-progress__bar{right:0;-webkit-transform-origin:center right;transform-origin:center right}[dir=rtl] .mdc-linear-progress:not([dir=ltr]).mdc-linear-progress--animation-ready .mdc-linear-progress__primary-bar,.mdc-linear-progress[dir=rtl]:not([dir=ltr]).mdc-linear-progress--animation-ready .mdc-linear-progress__primary-bar{animation-name:mdc-linear-progress-primary-indeterminate-translate-reverse}[dir=rtl] .mdc-linear-progress:not([dir=ltr]).mdc-linear-progress--animation-ready .mdc-linear-progress__secondary-bar,.mdc-linear-progress[dir=rtl]:not([dir=ltr]).mdc-linear-progress--animation-ready .mdc-linear-progress__secondary-bar{animation-name:mdc-linear-progress-secondary-indeterminate-translate-reverse}[dir=rtl] .mdc-linear-progress:not([dir=ltr]) .mdc-linear-progress__buffer-dots,.mdc-linear-progress[dir=rtl]:not([dir=ltr]) .mdc-linear-progress__buffer-dots{animation:mdc-linear-progress-buffering-reverse 250ms infinite linear;transform:rotate(0)}[dir=rtl] .mdc-linear-progress:not([dir=ltr]).mdc-linear-progress--indeterminate .mdc-linear-progress__primary-bar,.mdc-linear-progress[dir=rtl]:not([dir=ltr]).mdc-linear-progress--indeterminate .mdc-linear-progress__primary-bar{right:-145.166611%;left:auto}[dir=rtl] .mdc-linear-progress:not([dir=ltr]).mdc-linear-progress--indeterminate .mdc-linear-progress__secondary-bar,.mdc-linear-progress[dir=rtl]:not([dir=ltr]).mdc-linear-progress--indeterminate .mdc-linear-progress__secondary-bar{right:-54.888891%;left:auto}.mdc-linear-progress--closed{opacity:0}.mdc-linear-progress--closed-animation-off .mdc-linear-progress__buffer-dots{animation:none}.mdc-linear-progress--closed-animation-off.mdc-linear-progress--indeterminate .mdc-linear-progress__bar,.mdc-linear-progress--closed-animation-off.mdc-linear-progress--indeterminate .mdc-linear-progress__bar .mdc-linear-progress__bar-inner{animation:none}@keyframes mdc-linear-progress-buffering{from{transform:rotate(180deg) translateX(calc(var(--mdc-linear-progress-track-height) * -2.5))}}.mdc-linear-progress__bar-inner{border-color:var(--mdc-linear-progress-active-indicator-color)}@media(forced-colors: active){.mdc-linear-progress__buffer-dots{background-color:ButtonBorder}}@media all and (-ms-high-contrast: none),(-ms-high-contrast: active){.mdc-linear-progress__buffer-dots{background-color:rgba(0,0,0,0);background-image:url(\"data:image/svg+xml,%3Csvg version='1.1' xmlns='http://www.w3.org/2000/svg' xmlns:xlink='http://www.w3.org/1999/xlink' x='0px' y='0px' enable-background='new 0 0 5 2' xml:space='preserve' viewBox='0 0 5 2' preserveAspectRatio='none slice'%3E%3Ccircle cx='1' cy='1' r='1' fill=''/%3E%3C/svg%3E\")}}.mdc-linear-progress{height:max(var(--mdc-linear-progress-track-height), var(--mdc-linear-progress-active-indicator-height))}@media all and (-ms-high-contrast: none),(-ms-high-contrast: active){.mdc-linear-progress{height:4px}}.mdc-linear-progress__bar{height:var(--mdc-linear-progress-active-indicator-height)}.mdc-linear-progress__bar-inner{border-top-width:var(--mdc-linear-progress-active-indicator-height)}.mdc-linear-progress__buffer{height:var(--mdc-linear-progress-track-height)}@media all and (-ms-high-contrast: none),(-ms-high-contrast: active){.mdc-linear-progress__buffer-dots{background-size:10px var(--mdc-linear-progress-track-height)}}.mdc-linear-progress__buffer{border-radius:var(--mdc-linear-progress-track-shape)}.mat-mdc-progress-bar{--mdc-linear-progress-active-indicator-height:4px;--mdc-linear-progress-track-height:4px;--mdc-linear-progress-track-shape:0}.mat-mdc-progress-bar{display:block;text-align:left;--mdc-linear-progress-primary-half: 83.67142%;--mdc-linear-progress-primary-full: 200.611057%;--mdc-linear-progress-secondary-quarter: 37.651913%;--mdc-linear-progress-secondary-half: 84.386165%;--mdc-linear-progress-secondary-full: 160.277782%;--mdc-linear-progress-primary-half-neg: -83.67142%;--mdc-linear-progress-primary-full-neg: -200.611057%;--mdc-linear-progress-secondary-quarter-neg: -37.651913%;--mdc-linear-progress-secondary-half-neg: -84.386165%;--mdc-linear-progress-secondary-full-neg: -160.277782%}[dir=rtl] .mat-mdc-progress-bar{text-align:right}.mat-mdc-progress-bar[mode=query]{transform:scaleX(-1)}.mat-mdc-progress-bar._mat-animation-noopable .mdc-linear-progress__buffer-dots,.mat-mdc-progress-bar._mat-animation-noopable .mdc-linear-progress__primary-bar,.mat-mdc-progress-bar._mat-animation-noopable .mdc-linear-progress__secondary-bar,.mat-mdc-progress-bar._mat-animation-noopable .mdc-linear-progress__bar-inner.mdc-linear-progress__bar-inner{animation:none}.mat-mdc-progress-bar._mat-animation-noopable .mdc-linear-progress__primary-bar,.mat-mdc-progress-bar._mat-animation-noopable .mdc-linear-progress__buffer-bar{transition:transform 1ms}"],encapsulation:2,changeDetection:0}),t})();function xP(e,t=0,i=100){return Math.max(t,Math.min(i,e))}let Ine=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275mod=le({type:e}),e.\u0275inj=ae({imports:[ve]}),t})();const Ane=["input"],Rne=["*"];let CP=0;class DP{constructor(t,i){this.source=t,this.value=i}}const One={provide:Gn,useExisting:He(()=>SP),multi:!0},EP=new M("MatRadioGroup"),Fne=new M("mat-radio-default-options",{providedIn:"root",factory:function Pne(){return{color:"accent"}}});let Nne=(()=>{var e;class t{get name(){return this._name}set name(n){this._name=n,this._updateRadioButtonNames()}get labelPosition(){return this._labelPosition}set labelPosition(n){this._labelPosition="before"===n?"before":"after",this._markRadiosForCheck()}get value(){return this._value}set value(n){this._value!==n&&(this._value=n,this._updateSelectedRadioFromValue(),this._checkSelectedRadioButton())}_checkSelectedRadioButton(){this._selected&&!this._selected.checked&&(this._selected.checked=!0)}get selected(){return this._selected}set selected(n){this._selected=n,this.value=n?n.value:null,this._checkSelectedRadioButton()}get disabled(){return this._disabled}set disabled(n){this._disabled=Q(n),this._markRadiosForCheck()}get required(){return this._required}set required(n){this._required=Q(n),this._markRadiosForCheck()}constructor(n){this._changeDetector=n,this._value=null,this._name="mat-radio-group-"+CP++,this._selected=null,this._isInitialized=!1,this._labelPosition="after",this._disabled=!1,this._required=!1,this._controlValueAccessorChangeFn=()=>{},this.onTouched=()=>{},this.change=new U}ngAfterContentInit(){this._isInitialized=!0,this._buttonChanges=this._radios.changes.subscribe(()=>{this.selected&&!this._radios.find(n=>n===this.selected)&&(this._selected=null)})}ngOnDestroy(){this._buttonChanges?.unsubscribe()}_touch(){this.onTouched&&this.onTouched()}_updateRadioButtonNames(){this._radios&&this._radios.forEach(n=>{n.name=this.name,n._markForCheck()})}_updateSelectedRadioFromValue(){this._radios&&(null===this._selected||this._selected.value!==this._value)&&(this._selected=null,this._radios.forEach(r=>{r.checked=this.value===r.value,r.checked&&(this._selected=r)}))}_emitChangeEvent(){this._isInitialized&&this.change.emit(new DP(this._selected,this._value))}_markRadiosForCheck(){this._radios&&this._radios.forEach(n=>n._markForCheck())}writeValue(n){this.value=n,this._changeDetector.markForCheck()}registerOnChange(n){this._controlValueAccessorChangeFn=n}registerOnTouched(n){this.onTouched=n}setDisabledState(n){this.disabled=n,this._changeDetector.markForCheck()}}return(e=t).\u0275fac=function(n){return new(n||e)(m(Fe))},e.\u0275dir=T({type:e,inputs:{color:"color",name:"name",labelPosition:"labelPosition",value:"value",selected:"selected",disabled:"disabled",required:"required"},outputs:{change:"change"}}),t})();class Lne{constructor(t){this._elementRef=t}}const Bne=so(ns(Lne));let Vne=(()=>{var e;class t extends Bne{get checked(){return this._checked}set checked(n){const r=Q(n);this._checked!==r&&(this._checked=r,r&&this.radioGroup&&this.radioGroup.value!==this.value?this.radioGroup.selected=this:!r&&this.radioGroup&&this.radioGroup.value===this.value&&(this.radioGroup.selected=null),r&&this._radioDispatcher.notify(this.id,this.name),this._changeDetector.markForCheck())}get value(){return this._value}set value(n){this._value!==n&&(this._value=n,null!==this.radioGroup&&(this.checked||(this.checked=this.radioGroup.value===n),this.checked&&(this.radioGroup.selected=this)))}get labelPosition(){return this._labelPosition||this.radioGroup&&this.radioGroup.labelPosition||"after"}set labelPosition(n){this._labelPosition=n}get disabled(){return this._disabled||null!==this.radioGroup&&this.radioGroup.disabled}set disabled(n){this._setDisabled(Q(n))}get required(){return this._required||this.radioGroup&&this.radioGroup.required}set required(n){this._required=Q(n)}get color(){return this._color||this.radioGroup&&this.radioGroup.color||this._providerOverride&&this._providerOverride.color||"accent"}set color(n){this._color=n}get inputId(){return`${this.id||this._uniqueId}-input`}constructor(n,r,o,s,a,c,l,d){super(r),this._changeDetector=o,this._focusMonitor=s,this._radioDispatcher=a,this._providerOverride=l,this._uniqueId="mat-radio-"+ ++CP,this.id=this._uniqueId,this.change=new U,this._checked=!1,this._value=null,this._removeUniqueSelectionListener=()=>{},this.radioGroup=n,this._noopAnimations="NoopAnimations"===c,d&&(this.tabIndex=Bi(d,0))}focus(n,r){r?this._focusMonitor.focusVia(this._inputElement,r,n):this._inputElement.nativeElement.focus(n)}_markForCheck(){this._changeDetector.markForCheck()}ngOnInit(){this.radioGroup&&(this.checked=this.radioGroup.value===this._value,this.checked&&(this.radioGroup.selected=this),this.name=this.radioGroup.name),this._removeUniqueSelectionListener=this._radioDispatcher.listen((n,r)=>{n!==this.id&&r===this.name&&(this.checked=!1)})}ngDoCheck(){this._updateTabIndex()}ngAfterViewInit(){this._updateTabIndex(),this._focusMonitor.monitor(this._elementRef,!0).subscribe(n=>{!n&&this.radioGroup&&this.radioGroup._touch()})}ngOnDestroy(){this._focusMonitor.stopMonitoring(this._elementRef),this._removeUniqueSelectionListener()}_emitChangeEvent(){this.change.emit(new DP(this,this._value))}_isRippleDisabled(){return this.disableRipple||this.disabled}_onInputClick(n){n.stopPropagation()}_onInputInteraction(n){if(n.stopPropagation(),!this.checked&&!this.disabled){const r=this.radioGroup&&this.value!==this.radioGroup.value;this.checked=!0,this._emitChangeEvent(),this.radioGroup&&(this.radioGroup._controlValueAccessorChangeFn(this.value),r&&this.radioGroup._emitChangeEvent())}}_onTouchTargetClick(n){this._onInputInteraction(n),this.disabled||this._inputElement.nativeElement.focus()}_setDisabled(n){this._disabled!==n&&(this._disabled=n,this._changeDetector.markForCheck())}_updateTabIndex(){const n=this.radioGroup;let r;if(r=n&&n.selected&&!this.disabled?n.selected===this?this.tabIndex:-1:this.tabIndex,r!==this._previousTabIndex){const o=this._inputElement?.nativeElement;o&&(o.setAttribute("tabindex",r+""),this._previousTabIndex=r)}}}return(e=t).\u0275fac=function(n){jo()},e.\u0275dir=T({type:e,viewQuery:function(n,r){if(1&n&&ke(Ane,5),2&n){let o;H(o=z())&&(r._inputElement=o.first)}},inputs:{id:"id",name:"name",ariaLabel:["aria-label","ariaLabel"],ariaLabelledby:["aria-labelledby","ariaLabelledby"],ariaDescribedby:["aria-describedby","ariaDescribedby"],checked:"checked",value:"value",labelPosition:"labelPosition",disabled:"disabled",required:"required",color:"color"},outputs:{change:"change"},features:[P]}),t})(),SP=(()=>{var e;class t extends Nne{}return(e=t).\u0275fac=function(){let i;return function(r){return(i||(i=be(e)))(r||e)}}(),e.\u0275dir=T({type:e,selectors:[["mat-radio-group"]],contentQueries:function(n,r,o){if(1&n&&Ee(o,kP,5),2&n){let s;H(s=z())&&(r._radios=s)}},hostAttrs:["role","radiogroup",1,"mat-mdc-radio-group"],exportAs:["matRadioGroup"],features:[Z([One,{provide:EP,useExisting:e}]),P]}),t})(),kP=(()=>{var e;class t extends Vne{constructor(n,r,o,s,a,c,l,d){super(n,r,o,s,a,c,l,d)}}return(e=t).\u0275fac=function(n){return new(n||e)(m(EP,8),m(W),m(Fe),m(ar),m(Jy),m(_t,8),m(Fne,8),ui("tabindex"))},e.\u0275cmp=fe({type:e,selectors:[["mat-radio-button"]],hostAttrs:[1,"mat-mdc-radio-button"],hostVars:15,hostBindings:function(n,r){1&n&&$("focus",function(){return r._inputElement.nativeElement.focus()}),2&n&&(de("id",r.id)("tabindex",null)("aria-label",null)("aria-labelledby",null)("aria-describedby",null),se("mat-primary","primary"===r.color)("mat-accent","accent"===r.color)("mat-warn","warn"===r.color)("mat-mdc-radio-checked",r.checked)("_mat-animation-noopable",r._noopAnimations))},inputs:{disableRipple:"disableRipple",tabIndex:"tabIndex"},exportAs:["matRadioButton"],features:[P],ngContentSelectors:Rne,decls:13,vars:17,consts:[[1,"mdc-form-field"],["formField",""],[1,"mdc-radio"],[1,"mat-mdc-radio-touch-target",3,"click"],["type","radio",1,"mdc-radio__native-control",3,"id","checked","disabled","required","change"],["input",""],[1,"mdc-radio__background"],[1,"mdc-radio__outer-circle"],[1,"mdc-radio__inner-circle"],["mat-ripple","",1,"mat-radio-ripple","mat-mdc-focus-indicator",3,"matRippleTrigger","matRippleDisabled","matRippleCentered"],[1,"mat-ripple-element","mat-radio-persistent-ripple"],[1,"mdc-label",3,"for"]],template:function(n,r){if(1&n&&(ze(),y(0,"div",0,1)(2,"div",2)(3,"div",3),$("click",function(s){return r._onTouchTargetClick(s)}),w(),y(4,"input",4,5),$("change",function(s){return r._onInputInteraction(s)}),w(),y(6,"div",6),ie(7,"div",7)(8,"div",8),w(),y(9,"div",9),ie(10,"div",10),w()(),y(11,"label",11),X(12),w()()),2&n){const o=hn(1);se("mdc-form-field--align-end","before"==r.labelPosition),x(2),se("mdc-radio--disabled",r.disabled),x(2),E("id",r.inputId)("checked",r.checked)("disabled",r.disabled)("required",r.required),de("name",r.name)("value",r.value)("aria-label",r.ariaLabel)("aria-labelledby",r.ariaLabelledby)("aria-describedby",r.ariaDescribedby),x(5),E("matRippleTrigger",o)("matRippleDisabled",r._isRippleDisabled())("matRippleCentered",!0),x(2),E("for",r.inputId)}},dependencies:[ao],styles:['.mdc-radio{display:inline-block;position:relative;flex:0 0 auto;box-sizing:content-box;width:20px;height:20px;cursor:pointer;will-change:opacity,transform,border-color,color}.mdc-radio[hidden]{display:none}.mdc-radio__background{display:inline-block;position:relative;box-sizing:border-box;width:20px;height:20px}.mdc-radio__background::before{position:absolute;transform:scale(0, 0);border-radius:50%;opacity:0;pointer-events:none;content:"";transition:opacity 120ms 0ms cubic-bezier(0.4, 0, 0.6, 1),transform 120ms 0ms cubic-bezier(0.4, 0, 0.6, 1)}.mdc-radio__outer-circle{position:absolute;top:0;left:0;box-sizing:border-box;width:100%;height:100%;border-width:2px;border-style:solid;border-radius:50%;transition:border-color 120ms 0ms cubic-bezier(0.4, 0, 0.6, 1)}.mdc-radio__inner-circle{position:absolute;top:0;left:0;box-sizing:border-box;width:100%;height:100%;transform:scale(0, 0);border-width:10px;border-style:solid;border-radius:50%;transition:transform 120ms 0ms cubic-bezier(0.4, 0, 0.6, 1),border-color 120ms 0ms cubic-bezier(0.4, 0, 0.6, 1)}.mdc-radio__native-control{position:absolute;margin:0;padding:0;opacity:0;cursor:inherit;z-index:1}.mdc-radio--touch{margin-top:4px;margin-bottom:4px;margin-right:4px;margin-left:4px}.mdc-radio--touch .mdc-radio__native-control{top:calc((40px - 48px) / 2);right:calc((40px - 48px) / 2);left:calc((40px - 48px) / 2);width:48px;height:48px}.mdc-radio.mdc-ripple-upgraded--background-focused .mdc-radio__focus-ring,.mdc-radio:not(.mdc-ripple-upgraded):focus .mdc-radio__focus-ring{pointer-events:none;border:2px solid rgba(0,0,0,0);border-radius:6px;box-sizing:content-box;position:absolute;top:50%;left:50%;transform:translate(-50%, -50%);height:100%;width:100%}@media screen and (forced-colors: active){.mdc-radio.mdc-ripple-upgraded--background-focused .mdc-radio__focus-ring,.mdc-radio:not(.mdc-ripple-upgraded):focus .mdc-radio__focus-ring{border-color:CanvasText}}.mdc-radio.mdc-ripple-upgraded--background-focused .mdc-radio__focus-ring::after,.mdc-radio:not(.mdc-ripple-upgraded):focus .mdc-radio__focus-ring::after{content:"";border:2px solid rgba(0,0,0,0);border-radius:8px;display:block;position:absolute;top:50%;left:50%;transform:translate(-50%, -50%);height:calc(100% + 4px);width:calc(100% + 4px)}@media screen and (forced-colors: active){.mdc-radio.mdc-ripple-upgraded--background-focused .mdc-radio__focus-ring::after,.mdc-radio:not(.mdc-ripple-upgraded):focus .mdc-radio__focus-ring::after{border-color:CanvasText}}.mdc-radio__native-control:checked+.mdc-radio__background,.mdc-radio__native-control:disabled+.mdc-radio__background{transition:opacity 120ms 0ms cubic-bezier(0, 0, 0.2, 1),transform 120ms 0ms cubic-bezier(0, 0, 0.2, 1)}.mdc-radio__native-control:checked+.mdc-radio__background .mdc-radio__outer-circle,.mdc-radio__native-control:disabled+.mdc-radio__background .mdc-radio__outer-circle{transition:border-color 120ms 0ms cubic-bezier(0, 0, 0.2, 1)}.mdc-radio__native-control:checked+.mdc-radio__background .mdc-radio__inner-circle,.mdc-radio__native-control:disabled+.mdc-radio__background .mdc-radio__inner-circle{transition:transform 120ms 0ms cubic-bezier(0, 0, 0.2, 1),border-color 120ms 0ms cubic-bezier(0, 0, 0.2, 1)}.mdc-radio--disabled{cursor:default;pointer-events:none}.mdc-radio__native-control:checked+.mdc-radio__background .mdc-radio__inner-circle{transform:scale(0.5);transition:transform 120ms 0ms cubic-bezier(0, 0, 0.2, 1),border-color 120ms 0ms cubic-bezier(0, 0, 0.2, 1)}.mdc-radio__native-control:disabled+.mdc-radio__background,[aria-disabled=true] .mdc-radio__native-control+.mdc-radio__background{cursor:default}.mdc-radio__native-control:focus+.mdc-radio__background::before{transform:scale(1);opacity:.12;transition:opacity 120ms 0ms cubic-bezier(0, 0, 0.2, 1),transform 120ms 0ms cubic-bezier(0, 0, 0.2, 1)}.mdc-form-field{display:inline-flex;align-items:center;vertical-align:middle}.mdc-form-field[hidden]{display:none}.mdc-form-field>label{margin-left:0;margin-right:auto;padding-left:4px;padding-right:0;order:0}[dir=rtl] .mdc-form-field>label,.mdc-form-field>label[dir=rtl]{margin-left:auto;margin-right:0}[dir=rtl] .mdc-form-field>label,.mdc-form-field>label[dir=rtl]{padding-left:0;padding-right:4px}.mdc-form-field--nowrap>label{text-overflow:ellipsis;overflow:hidden;white-space:nowrap}.mdc-form-field--align-end>label{margin-left:auto;margin-right:0;padding-left:0;padding-right:4px;order:-1}[dir=rtl] .mdc-form-field--align-end>label,.mdc-form-field--align-end>label[dir=rtl]{margin-left:0;margin-right:auto}[dir=rtl] .mdc-form-field--align-end>label,.mdc-form-field--align-end>label[dir=rtl]{padding-left:4px;padding-right:0}.mdc-form-field--space-between{justify-content:space-between}.mdc-form-field--space-between>label{margin:0}[dir=rtl] .mdc-form-field--space-between>label,.mdc-form-field--space-between>label[dir=rtl]{margin:0}.mat-mdc-radio-button{--mdc-radio-disabled-selected-icon-opacity:0.38;--mdc-radio-disabled-unselected-icon-opacity:0.38;--mdc-radio-state-layer-size:40px;-webkit-tap-highlight-color:rgba(0,0,0,0)}.mat-mdc-radio-button .mdc-radio{padding:calc((var(--mdc-radio-state-layer-size) - 20px) / 2)}.mat-mdc-radio-button .mdc-radio [aria-disabled=true] .mdc-radio__native-control:checked+.mdc-radio__background .mdc-radio__outer-circle,.mat-mdc-radio-button .mdc-radio .mdc-radio__native-control:disabled:checked+.mdc-radio__background .mdc-radio__outer-circle{border-color:var(--mdc-radio-disabled-selected-icon-color)}.mat-mdc-radio-button .mdc-radio [aria-disabled=true] .mdc-radio__native-control+.mdc-radio__background .mdc-radio__inner-circle,.mat-mdc-radio-button .mdc-radio .mdc-radio__native-control:disabled+.mdc-radio__background .mdc-radio__inner-circle{border-color:var(--mdc-radio-disabled-selected-icon-color)}.mat-mdc-radio-button .mdc-radio [aria-disabled=true] .mdc-radio__native-control:checked+.mdc-radio__background .mdc-radio__outer-circle,.mat-mdc-radio-button .mdc-radio .mdc-radio__native-control:disabled:checked+.mdc-radio__background .mdc-radio__outer-circle{opacity:var(--mdc-radio-disabled-selected-icon-opacity)}.mat-mdc-radio-button .mdc-radio [aria-disabled=true] .mdc-radio__native-control+.mdc-radio__background .mdc-radio__inner-circle,.mat-mdc-radio-button .mdc-radio .mdc-radio__native-control:disabled+.mdc-radio__background .mdc-radio__inner-circle{opacity:var(--mdc-radio-disabled-selected-icon-opacity)}.mat-mdc-radio-button .mdc-radio [aria-disabled=true] .mdc-radio__native-control:not(:checked)+.mdc-radio__background .mdc-radio__outer-circle,.mat-mdc-radio-button .mdc-radio .mdc-radio__native-control:disabled:not(:checked)+.mdc-radio__background .mdc-radio__outer-circle{border-color:var(--mdc-radio-disabled-unselected-icon-color)}.mat-mdc-radio-button .mdc-radio [aria-disabled=true] .mdc-radio__native-control:not(:checked)+.mdc-radio__background .mdc-radio__outer-circle,.mat-mdc-radio-button .mdc-radio .mdc-radio__native-control:disabled:not(:checked)+.mdc-radio__background .mdc-radio__outer-circle{opacity:var(--mdc-radio-disabled-unselected-icon-opacity)}.mat-mdc-radio-button .mdc-radio.mdc-ripple-upgraded--background-focused .mdc-radio__native-control:enabled:checked+.mdc-radio__background .mdc-radio__outer-circle,.mat-mdc-radio-button .mdc-radio:not(.mdc-ripple-upgraded):focus .mdc-radio__native-control:enabled:checked+.mdc-radio__background .mdc-radio__outer-circle{border-color:var(--mdc-radio-selected-focus-icon-color)}.mat-mdc-radio-button .mdc-radio.mdc-ripple-upgraded--background-focused .mdc-radio__native-control:enabled+.mdc-radio__background .mdc-radio__inner-circle,.mat-mdc-radio-button .mdc-radio:not(.mdc-ripple-upgraded):focus .mdc-radio__native-control:enabled+.mdc-radio__background .mdc-radio__inner-circle{border-color:var(--mdc-radio-selected-focus-icon-color)}.mat-mdc-radio-button .mdc-radio:hover .mdc-radio__native-control:enabled:checked+.mdc-radio__background .mdc-radio__outer-circle{border-color:var(--mdc-radio-selected-hover-icon-color)}.mat-mdc-radio-button .mdc-radio:hover .mdc-radio__native-control:enabled+.mdc-radio__background .mdc-radio__inner-circle{border-color:var(--mdc-radio-selected-hover-icon-color)}.mat-mdc-radio-button .mdc-radio .mdc-radio__native-control:enabled:checked+.mdc-radio__background .mdc-radio__outer-circle{border-color:var(--mdc-radio-selected-icon-color)}.mat-mdc-radio-button .mdc-radio .mdc-radio__native-control:enabled+.mdc-radio__background .mdc-radio__inner-circle{border-color:var(--mdc-radio-selected-icon-color)}.mat-mdc-radio-button .mdc-radio:not(:disabled):active .mdc-radio__native-control:enabled:checked+.mdc-radio__background .mdc-radio__outer-circle{border-color:var(--mdc-radio-selected-pressed-icon-color)}.mat-mdc-radio-button .mdc-radio:not(:disabled):active .mdc-radio__native-control:enabled+.mdc-radio__background .mdc-radio__inner-circle{border-color:var(--mdc-radio-selected-pressed-icon-color)}.mat-mdc-radio-button .mdc-radio:hover .mdc-radio__native-control:enabled:not(:checked)+.mdc-radio__background .mdc-radio__outer-circle{border-color:var(--mdc-radio-unselected-hover-icon-color)}.mat-mdc-radio-button .mdc-radio .mdc-radio__native-control:enabled:not(:checked)+.mdc-radio__background .mdc-radio__outer-circle{border-color:var(--mdc-radio-unselected-icon-color)}.mat-mdc-radio-button .mdc-radio:not(:disabled):active .mdc-radio__native-control:enabled:not(:checked)+.mdc-radio__background .mdc-radio__outer-circle{border-color:var(--mdc-radio-unselected-pressed-icon-color)}.mat-mdc-radio-button .mdc-radio .mdc-radio__background::before{top:calc(-1 * (var(--mdc-radio-state-layer-size) - 20px) / 2);left:calc(-1 * (var(--mdc-radio-state-layer-size) - 20px) / 2);width:var(--mdc-radio-state-layer-size);height:var(--mdc-radio-state-layer-size)}.mat-mdc-radio-button .mdc-radio .mdc-radio__native-control{top:calc((var(--mdc-radio-state-layer-size) - var(--mdc-radio-state-layer-size)) / 2);right:calc((var(--mdc-radio-state-layer-size) - var(--mdc-radio-state-layer-size)) / 2);left:calc((var(--mdc-radio-state-layer-size) - var(--mdc-radio-state-layer-size)) / 2);width:var(--mdc-radio-state-layer-size);height:var(--mdc-radio-state-layer-size)}.mat-mdc-radio-button .mdc-radio .mdc-radio__background::before{background-color:var(--mat-radio-ripple-color)}.mat-mdc-radio-button .mdc-radio:hover .mdc-radio__native-control:not([disabled]):not(:focus)~.mdc-radio__background::before{opacity:.04;transform:scale(1)}.mat-mdc-radio-button.mat-mdc-radio-checked .mdc-radio__background::before{background-color:var(--mat-radio-checked-ripple-color)}.mat-mdc-radio-button.mat-mdc-radio-checked .mat-ripple-element{background-color:var(--mat-radio-checked-ripple-color)}.mat-mdc-radio-button .mdc-radio--disabled+label{color:var(--mat-radio-disabled-label-color)}.mat-mdc-radio-button .mat-radio-ripple{top:0;left:0;right:0;bottom:0;position:absolute;pointer-events:none;border-radius:50%}.mat-mdc-radio-button .mat-radio-ripple .mat-ripple-element{opacity:.14}.mat-mdc-radio-button .mat-radio-ripple::before{border-radius:50%}.mat-mdc-radio-button._mat-animation-noopable .mdc-radio__background::before,.mat-mdc-radio-button._mat-animation-noopable .mdc-radio__outer-circle,.mat-mdc-radio-button._mat-animation-noopable .mdc-radio__inner-circle{transition:none !important}.mat-mdc-radio-button .mdc-radio .mdc-radio__native-control:focus:enabled:not(:checked)~.mdc-radio__background .mdc-radio__outer-circle{border-color:var(--mdc-radio-unselected-focus-icon-color, black)}.mat-mdc-radio-button.cdk-focused .mat-mdc-focus-indicator::before{content:""}.mat-mdc-radio-touch-target{position:absolute;top:50%;height:48px;left:50%;width:48px;transform:translate(-50%, -50%)}[dir=rtl] .mat-mdc-radio-touch-target{left:0;right:50%;transform:translate(50%, -50%)}'],encapsulation:2,changeDetection:0}),t})(),jne=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275mod=le({type:e}),e.\u0275inj=ae({imports:[ve,vn,is,ve]}),t})();const TP=["*"],Hne=["content"];function zne(e,t){if(1&e){const i=Ut();y(0,"div",2),$("click",function(){return Ye(i),Ke(B()._onBackdropClicked())}),w()}2&e&&se("mat-drawer-shown",B()._isShowingBackdrop())}function Une(e,t){1&e&&(y(0,"mat-drawer-content"),X(1,2),w())}const $ne=[[["mat-drawer"]],[["mat-drawer-content"]],"*"],qne=["mat-drawer","mat-drawer-content","*"],Gne={transformDrawer:ni("transform",[qt("open, open-instant",je({transform:"none",visibility:"visible"})),qt("void",je({"box-shadow":"none",visibility:"hidden"})),Bt("void => open-instant",Lt("0ms")),Bt("void <=> open, open-instant => void",Lt("400ms cubic-bezier(0.25, 0.8, 0.25, 1)"))])},Wne=new M("MAT_DRAWER_DEFAULT_AUTOSIZE",{providedIn:"root",factory:function Qne(){return!1}}),MP=new M("MAT_DRAWER_CONTAINER");let tm=(()=>{var e;class t extends ty{constructor(n,r,o,s,a){super(o,s,a),this._changeDetectorRef=n,this._container=r}ngAfterContentInit(){this._container._contentMarginChanges.subscribe(()=>{this._changeDetectorRef.markForCheck()})}}return(e=t).\u0275fac=function(n){return new(n||e)(m(Fe),m(He(()=>AP)),m(W),m(Gf),m(G))},e.\u0275cmp=fe({type:e,selectors:[["mat-drawer-content"]],hostAttrs:["ngSkipHydration","",1,"mat-drawer-content"],hostVars:4,hostBindings:function(n,r){2&n&&kn("margin-left",r._container._contentMargins.left,"px")("margin-right",r._container._contentMargins.right,"px")},features:[Z([{provide:ty,useExisting:e}]),P],ngContentSelectors:TP,decls:1,vars:0,template:function(n,r){1&n&&(ze(),X(0))},encapsulation:2,changeDetection:0}),t})(),IP=(()=>{var e;class t{get position(){return this._position}set position(n){(n="end"===n?"end":"start")!==this._position&&(this._isAttached&&this._updatePositionInParent(n),this._position=n,this.onPositionChanged.emit())}get mode(){return this._mode}set mode(n){this._mode=n,this._updateFocusTrapState(),this._modeChanged.next()}get disableClose(){return this._disableClose}set disableClose(n){this._disableClose=Q(n)}get autoFocus(){return this._autoFocus??("side"===this.mode?"dialog":"first-tabbable")}set autoFocus(n){("true"===n||"false"===n||null==n)&&(n=Q(n)),this._autoFocus=n}get opened(){return this._opened}set opened(n){this.toggle(Q(n))}constructor(n,r,o,s,a,c,l,d){this._elementRef=n,this._focusTrapFactory=r,this._focusMonitor=o,this._platform=s,this._ngZone=a,this._interactivityChecker=c,this._doc=l,this._container=d,this._elementFocusedBeforeDrawerWasOpened=null,this._enableAnimations=!1,this._position="start",this._mode="over",this._disableClose=!1,this._opened=!1,this._animationStarted=new Y,this._animationEnd=new Y,this._animationState="void",this.openedChange=new U(!0),this._openedStream=this.openedChange.pipe(Ve(u=>u),J(()=>{})),this.openedStart=this._animationStarted.pipe(Ve(u=>u.fromState!==u.toState&&0===u.toState.indexOf("open")),Uf(void 0)),this._closedStream=this.openedChange.pipe(Ve(u=>!u),J(()=>{})),this.closedStart=this._animationStarted.pipe(Ve(u=>u.fromState!==u.toState&&"void"===u.toState),Uf(void 0)),this._destroyed=new Y,this.onPositionChanged=new U,this._modeChanged=new Y,this.openedChange.subscribe(u=>{u?(this._doc&&(this._elementFocusedBeforeDrawerWasOpened=this._doc.activeElement),this._takeFocus()):this._isFocusWithinDrawer()&&this._restoreFocus(this._openedVia||"program")}),this._ngZone.runOutsideAngular(()=>{Vi(this._elementRef.nativeElement,"keydown").pipe(Ve(u=>27===u.keyCode&&!this.disableClose&&!An(u)),ce(this._destroyed)).subscribe(u=>this._ngZone.run(()=>{this.close(),u.stopPropagation(),u.preventDefault()}))}),this._animationEnd.pipe(Is((u,h)=>u.fromState===h.fromState&&u.toState===h.toState)).subscribe(u=>{const{fromState:h,toState:f}=u;(0===f.indexOf("open")&&"void"===h||"void"===f&&0===h.indexOf("open"))&&this.openedChange.emit(this._opened)})}_forceFocus(n,r){this._interactivityChecker.isFocusable(n)||(n.tabIndex=-1,this._ngZone.runOutsideAngular(()=>{const o=()=>{n.removeEventListener("blur",o),n.removeEventListener("mousedown",o),n.removeAttribute("tabindex")};n.addEventListener("blur",o),n.addEventListener("mousedown",o)})),n.focus(r)}_focusByCssSelector(n,r){let o=this._elementRef.nativeElement.querySelector(n);o&&this._forceFocus(o,r)}_takeFocus(){if(!this._focusTrap)return;const n=this._elementRef.nativeElement;switch(this.autoFocus){case!1:case"dialog":return;case!0:case"first-tabbable":this._focusTrap.focusInitialElementWhenReady().then(r=>{!r&&"function"==typeof this._elementRef.nativeElement.focus&&n.focus()});break;case"first-heading":this._focusByCssSelector('h1, h2, h3, h4, h5, h6, [role="heading"]');break;default:this._focusByCssSelector(this.autoFocus)}}_restoreFocus(n){"dialog"!==this.autoFocus&&(this._elementFocusedBeforeDrawerWasOpened?this._focusMonitor.focusVia(this._elementFocusedBeforeDrawerWasOpened,n):this._elementRef.nativeElement.blur(),this._elementFocusedBeforeDrawerWasOpened=null)}_isFocusWithinDrawer(){const n=this._doc.activeElement;return!!n&&this._elementRef.nativeElement.contains(n)}ngAfterViewInit(){this._isAttached=!0,this._focusTrap=this._focusTrapFactory.create(this._elementRef.nativeElement),this._updateFocusTrapState(),"end"===this._position&&this._updatePositionInParent("end")}ngAfterContentChecked(){this._platform.isBrowser&&(this._enableAnimations=!0)}ngOnDestroy(){this._focusTrap&&this._focusTrap.destroy(),this._anchor?.remove(),this._anchor=null,this._animationStarted.complete(),this._animationEnd.complete(),this._modeChanged.complete(),this._destroyed.next(),this._destroyed.complete()}open(n){return this.toggle(!0,n)}close(){return this.toggle(!1)}_closeViaBackdropClick(){return this._setOpen(!1,!0,"mouse")}toggle(n=!this.opened,r){n&&r&&(this._openedVia=r);const o=this._setOpen(n,!n&&this._isFocusWithinDrawer(),this._openedVia||"program");return n||(this._openedVia=null),o}_setOpen(n,r,o){return this._opened=n,n?this._animationState=this._enableAnimations?"open":"open-instant":(this._animationState="void",r&&this._restoreFocus(o)),this._updateFocusTrapState(),new Promise(s=>{this.openedChange.pipe(Xe(1)).subscribe(a=>s(a?"open":"close"))})}_getWidth(){return this._elementRef.nativeElement&&this._elementRef.nativeElement.offsetWidth||0}_updateFocusTrapState(){this._focusTrap&&(this._focusTrap.enabled=!!this._container?.hasBackdrop)}_updatePositionInParent(n){const r=this._elementRef.nativeElement,o=r.parentNode;"end"===n?(this._anchor||(this._anchor=this._doc.createComment("mat-drawer-anchor"),o.insertBefore(this._anchor,r)),o.appendChild(r)):this._anchor&&this._anchor.parentNode.insertBefore(r,this._anchor)}}return(e=t).\u0275fac=function(n){return new(n||e)(m(W),m(V7),m(ar),m(nt),m(G),m(LI),m(he,8),m(MP,8))},e.\u0275cmp=fe({type:e,selectors:[["mat-drawer"]],viewQuery:function(n,r){if(1&n&&ke(Hne,5),2&n){let o;H(o=z())&&(r._content=o.first)}},hostAttrs:["tabIndex","-1","ngSkipHydration","",1,"mat-drawer"],hostVars:12,hostBindings:function(n,r){1&n&&gh("@transform.start",function(s){return r._animationStarted.next(s)})("@transform.done",function(s){return r._animationEnd.next(s)}),2&n&&(de("align",null),yh("@transform",r._animationState),se("mat-drawer-end","end"===r.position)("mat-drawer-over","over"===r.mode)("mat-drawer-push","push"===r.mode)("mat-drawer-side","side"===r.mode)("mat-drawer-opened",r.opened))},inputs:{position:"position",mode:"mode",disableClose:"disableClose",autoFocus:"autoFocus",opened:"opened"},outputs:{openedChange:"openedChange",_openedStream:"opened",openedStart:"openedStart",_closedStream:"closed",closedStart:"closedStart",onPositionChanged:"positionChanged"},exportAs:["matDrawer"],ngContentSelectors:TP,decls:3,vars:0,consts:[["cdkScrollable","",1,"mat-drawer-inner-container"],["content",""]],template:function(n,r){1&n&&(ze(),y(0,"div",0,1),X(2),w())},dependencies:[ty],encapsulation:2,data:{animation:[Gne.transformDrawer]},changeDetection:0}),t})(),AP=(()=>{var e;class t{get start(){return this._start}get end(){return this._end}get autosize(){return this._autosize}set autosize(n){this._autosize=Q(n)}get hasBackdrop(){return this._drawerHasBackdrop(this._start)||this._drawerHasBackdrop(this._end)}set hasBackdrop(n){this._backdropOverride=null==n?null:Q(n)}get scrollable(){return this._userContent||this._content}constructor(n,r,o,s,a,c=!1,l){this._dir=n,this._element=r,this._ngZone=o,this._changeDetectorRef=s,this._animationMode=l,this._drawers=new Cr,this.backdropClick=new U,this._destroyed=new Y,this._doCheckSubject=new Y,this._contentMargins={left:null,right:null},this._contentMarginChanges=new Y,n&&n.change.pipe(ce(this._destroyed)).subscribe(()=>{this._validateDrawers(),this.updateContentMargins()}),a.change().pipe(ce(this._destroyed)).subscribe(()=>this.updateContentMargins()),this._autosize=c}ngAfterContentInit(){this._allDrawers.changes.pipe(tn(this._allDrawers),ce(this._destroyed)).subscribe(n=>{this._drawers.reset(n.filter(r=>!r._container||r._container===this)),this._drawers.notifyOnChanges()}),this._drawers.changes.pipe(tn(null)).subscribe(()=>{this._validateDrawers(),this._drawers.forEach(n=>{this._watchDrawerToggle(n),this._watchDrawerPosition(n),this._watchDrawerMode(n)}),(!this._drawers.length||this._isDrawerOpen(this._start)||this._isDrawerOpen(this._end))&&this.updateContentMargins(),this._changeDetectorRef.markForCheck()}),this._ngZone.runOutsideAngular(()=>{this._doCheckSubject.pipe(Tf(10),ce(this._destroyed)).subscribe(()=>this.updateContentMargins())})}ngOnDestroy(){this._contentMarginChanges.complete(),this._doCheckSubject.complete(),this._drawers.destroy(),this._destroyed.next(),this._destroyed.complete()}open(){this._drawers.forEach(n=>n.open())}close(){this._drawers.forEach(n=>n.close())}updateContentMargins(){let n=0,r=0;if(this._left&&this._left.opened)if("side"==this._left.mode)n+=this._left._getWidth();else if("push"==this._left.mode){const o=this._left._getWidth();n+=o,r-=o}if(this._right&&this._right.opened)if("side"==this._right.mode)r+=this._right._getWidth();else if("push"==this._right.mode){const o=this._right._getWidth();r+=o,n-=o}n=n||null,r=r||null,(n!==this._contentMargins.left||r!==this._contentMargins.right)&&(this._contentMargins={left:n,right:r},this._ngZone.run(()=>this._contentMarginChanges.next(this._contentMargins)))}ngDoCheck(){this._autosize&&this._isPushed()&&this._ngZone.runOutsideAngular(()=>this._doCheckSubject.next())}_watchDrawerToggle(n){n._animationStarted.pipe(Ve(r=>r.fromState!==r.toState),ce(this._drawers.changes)).subscribe(r=>{"open-instant"!==r.toState&&"NoopAnimations"!==this._animationMode&&this._element.nativeElement.classList.add("mat-drawer-transition"),this.updateContentMargins(),this._changeDetectorRef.markForCheck()}),"side"!==n.mode&&n.openedChange.pipe(ce(this._drawers.changes)).subscribe(()=>this._setContainerClass(n.opened))}_watchDrawerPosition(n){n&&n.onPositionChanged.pipe(ce(this._drawers.changes)).subscribe(()=>{this._ngZone.onMicrotaskEmpty.pipe(Xe(1)).subscribe(()=>{this._validateDrawers()})})}_watchDrawerMode(n){n&&n._modeChanged.pipe(ce(Ot(this._drawers.changes,this._destroyed))).subscribe(()=>{this.updateContentMargins(),this._changeDetectorRef.markForCheck()})}_setContainerClass(n){const r=this._element.nativeElement.classList,o="mat-drawer-container-has-open";n?r.add(o):r.remove(o)}_validateDrawers(){this._start=this._end=null,this._drawers.forEach(n=>{"end"==n.position?this._end=n:this._start=n}),this._right=this._left=null,this._dir&&"rtl"===this._dir.value?(this._left=this._end,this._right=this._start):(this._left=this._start,this._right=this._end)}_isPushed(){return this._isDrawerOpen(this._start)&&"over"!=this._start.mode||this._isDrawerOpen(this._end)&&"over"!=this._end.mode}_onBackdropClicked(){this.backdropClick.emit(),this._closeModalDrawersViaBackdrop()}_closeModalDrawersViaBackdrop(){[this._start,this._end].filter(n=>n&&!n.disableClose&&this._drawerHasBackdrop(n)).forEach(n=>n._closeViaBackdropClick())}_isShowingBackdrop(){return this._isDrawerOpen(this._start)&&this._drawerHasBackdrop(this._start)||this._isDrawerOpen(this._end)&&this._drawerHasBackdrop(this._end)}_isDrawerOpen(n){return null!=n&&n.opened}_drawerHasBackdrop(n){return null==this._backdropOverride?!!n&&"side"!==n.mode:this._backdropOverride}}return(e=t).\u0275fac=function(n){return new(n||e)(m(fn,8),m(W),m(G),m(Fe),m(Rr),m(Wne),m(_t,8))},e.\u0275cmp=fe({type:e,selectors:[["mat-drawer-container"]],contentQueries:function(n,r,o){if(1&n&&(Ee(o,tm,5),Ee(o,IP,5)),2&n){let s;H(s=z())&&(r._content=s.first),H(s=z())&&(r._allDrawers=s)}},viewQuery:function(n,r){if(1&n&&ke(tm,5),2&n){let o;H(o=z())&&(r._userContent=o.first)}},hostAttrs:["ngSkipHydration","",1,"mat-drawer-container"],hostVars:2,hostBindings:function(n,r){2&n&&se("mat-drawer-container-explicit-backdrop",r._backdropOverride)},inputs:{autosize:"autosize",hasBackdrop:"hasBackdrop"},outputs:{backdropClick:"backdropClick"},exportAs:["matDrawerContainer"],features:[Z([{provide:MP,useExisting:e}])],ngContentSelectors:qne,decls:4,vars:2,consts:[["class","mat-drawer-backdrop",3,"mat-drawer-shown","click",4,"ngIf"],[4,"ngIf"],[1,"mat-drawer-backdrop",3,"click"]],template:function(n,r){1&n&&(ze($ne),R(0,zne,1,2,"div",0),X(1),X(2,1),R(3,Une,2,0,"mat-drawer-content",1)),2&n&&(E("ngIf",r.hasBackdrop),x(3),E("ngIf",!r._content))},dependencies:[_i,tm],styles:['.mat-drawer-container{position:relative;z-index:1;color:var(--mat-sidenav-content-text-color);background-color:var(--mat-sidenav-content-background-color);box-sizing:border-box;-webkit-overflow-scrolling:touch;display:block;overflow:hidden}.mat-drawer-container[fullscreen]{top:0;left:0;right:0;bottom:0;position:absolute}.mat-drawer-container[fullscreen].mat-drawer-container-has-open{overflow:hidden}.mat-drawer-container.mat-drawer-container-explicit-backdrop .mat-drawer-side{z-index:3}.mat-drawer-container.ng-animate-disabled .mat-drawer-backdrop,.mat-drawer-container.ng-animate-disabled .mat-drawer-content,.ng-animate-disabled .mat-drawer-container .mat-drawer-backdrop,.ng-animate-disabled .mat-drawer-container .mat-drawer-content{transition:none}.mat-drawer-backdrop{top:0;left:0;right:0;bottom:0;position:absolute;display:block;z-index:3;visibility:hidden}.mat-drawer-backdrop.mat-drawer-shown{visibility:visible;background-color:var(--mat-sidenav-scrim-color)}.mat-drawer-transition .mat-drawer-backdrop{transition-duration:400ms;transition-timing-function:cubic-bezier(0.25, 0.8, 0.25, 1);transition-property:background-color,visibility}.cdk-high-contrast-active .mat-drawer-backdrop{opacity:.5}.mat-drawer-content{position:relative;z-index:1;display:block;height:100%;overflow:auto}.mat-drawer-transition .mat-drawer-content{transition-duration:400ms;transition-timing-function:cubic-bezier(0.25, 0.8, 0.25, 1);transition-property:transform,margin-left,margin-right}.mat-drawer{box-shadow:0px 8px 10px -5px rgba(0, 0, 0, 0.2), 0px 16px 24px 2px rgba(0, 0, 0, 0.14), 0px 6px 30px 5px rgba(0, 0, 0, 0.12);position:relative;z-index:4;--mat-sidenav-container-shape:0;color:var(--mat-sidenav-container-text-color);background-color:var(--mat-sidenav-container-background-color);border-top-right-radius:var(--mat-sidenav-container-shape);border-bottom-right-radius:var(--mat-sidenav-container-shape);display:block;position:absolute;top:0;bottom:0;z-index:3;outline:0;box-sizing:border-box;overflow-y:auto;transform:translate3d(-100%, 0, 0)}.cdk-high-contrast-active .mat-drawer,.cdk-high-contrast-active [dir=rtl] .mat-drawer.mat-drawer-end{border-right:solid 1px currentColor}.cdk-high-contrast-active [dir=rtl] .mat-drawer,.cdk-high-contrast-active .mat-drawer.mat-drawer-end{border-left:solid 1px currentColor;border-right:none}.mat-drawer.mat-drawer-side{z-index:2}.mat-drawer.mat-drawer-end{right:0;transform:translate3d(100%, 0, 0);border-top-left-radius:var(--mat-sidenav-container-shape);border-bottom-left-radius:var(--mat-sidenav-container-shape);border-top-right-radius:0;border-bottom-right-radius:0}[dir=rtl] .mat-drawer{border-top-left-radius:var(--mat-sidenav-container-shape);border-bottom-left-radius:var(--mat-sidenav-container-shape);border-top-right-radius:0;border-bottom-right-radius:0;transform:translate3d(100%, 0, 0)}[dir=rtl] .mat-drawer.mat-drawer-end{border-top-right-radius:var(--mat-sidenav-container-shape);border-bottom-right-radius:var(--mat-sidenav-container-shape);border-top-left-radius:0;border-bottom-left-radius:0;left:0;right:auto;transform:translate3d(-100%, 0, 0)}.mat-drawer[style*="visibility: hidden"]{display:none}.mat-drawer-side{box-shadow:none;border-right-color:var(--mat-sidenav-container-divider-color);border-right-width:1px;border-right-style:solid}.mat-drawer-side.mat-drawer-end{border-left-color:var(--mat-sidenav-container-divider-color);border-left-width:1px;border-left-style:solid;border-right:none}[dir=rtl] .mat-drawer-side{border-left-color:var(--mat-sidenav-container-divider-color);border-left-width:1px;border-left-style:solid;border-right:none}[dir=rtl] .mat-drawer-side.mat-drawer-end{border-right-color:var(--mat-sidenav-container-divider-color);border-right-width:1px;border-right-style:solid;border-left:none}.mat-drawer-inner-container{width:100%;height:100%;overflow:auto;-webkit-overflow-scrolling:touch}.mat-sidenav-fixed{position:fixed}'],encapsulation:2,changeDetection:0}),t})(),Yne=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275mod=le({type:e}),e.\u0275inj=ae({imports:[vn,ve,lo,lo,ve]}),t})();const Kne=[[["caption"]],[["colgroup"],["col"]]],Xne=["caption","colgroup, col"];function cw(e){return class extends e{get sticky(){return this._sticky}set sticky(t){const i=this._sticky;this._sticky=Q(t),this._hasStickyChanged=i!==this._sticky}hasStickyChanged(){const t=this._hasStickyChanged;return this._hasStickyChanged=!1,t}resetStickyChanged(){this._hasStickyChanged=!1}constructor(...t){super(...t),this._sticky=!1,this._hasStickyChanged=!1}}}const fc=new M("CDK_TABLE");let pc=(()=>{var e;class t{constructor(n){this.template=n}}return(e=t).\u0275fac=function(n){return new(n||e)(m(ct))},e.\u0275dir=T({type:e,selectors:[["","cdkCellDef",""]]}),t})(),mc=(()=>{var e;class t{constructor(n){this.template=n}}return(e=t).\u0275fac=function(n){return new(n||e)(m(ct))},e.\u0275dir=T({type:e,selectors:[["","cdkHeaderCellDef",""]]}),t})(),nm=(()=>{var e;class t{constructor(n){this.template=n}}return(e=t).\u0275fac=function(n){return new(n||e)(m(ct))},e.\u0275dir=T({type:e,selectors:[["","cdkFooterCellDef",""]]}),t})();class tie{}const nie=cw(tie);let Lr=(()=>{var e;class t extends nie{get name(){return this._name}set name(n){this._setNameInput(n)}get stickyEnd(){return this._stickyEnd}set stickyEnd(n){const r=this._stickyEnd;this._stickyEnd=Q(n),this._hasStickyChanged=r!==this._stickyEnd}constructor(n){super(),this._table=n,this._stickyEnd=!1}_updateColumnCssClassName(){this._columnCssClassName=[`cdk-column-${this.cssClassFriendlyName}`]}_setNameInput(n){n&&(this._name=n,this.cssClassFriendlyName=n.replace(/[^a-z0-9_-]/gi,"-"),this._updateColumnCssClassName())}}return(e=t).\u0275fac=function(n){return new(n||e)(m(fc,8))},e.\u0275dir=T({type:e,selectors:[["","cdkColumnDef",""]],contentQueries:function(n,r,o){if(1&n&&(Ee(o,pc,5),Ee(o,mc,5),Ee(o,nm,5)),2&n){let s;H(s=z())&&(r.cell=s.first),H(s=z())&&(r.headerCell=s.first),H(s=z())&&(r.footerCell=s.first)}},inputs:{sticky:"sticky",name:["cdkColumnDef","name"],stickyEnd:"stickyEnd"},features:[Z([{provide:"MAT_SORT_HEADER_COLUMN_DEF",useExisting:e}]),P]}),t})();class lw{constructor(t,i){i.nativeElement.classList.add(...t._columnCssClassName)}}let dw=(()=>{var e;class t extends lw{constructor(n,r){super(n,r)}}return(e=t).\u0275fac=function(n){return new(n||e)(m(Lr),m(W))},e.\u0275dir=T({type:e,selectors:[["cdk-header-cell"],["th","cdk-header-cell",""]],hostAttrs:["role","columnheader",1,"cdk-header-cell"],features:[P]}),t})(),uw=(()=>{var e;class t extends lw{constructor(n,r){if(super(n,r),1===n._table?._elementRef.nativeElement.nodeType){const o=n._table._elementRef.nativeElement.getAttribute("role");r.nativeElement.setAttribute("role","grid"===o||"treegrid"===o?"gridcell":"cell")}}}return(e=t).\u0275fac=function(n){return new(n||e)(m(Lr),m(W))},e.\u0275dir=T({type:e,selectors:[["cdk-cell"],["td","cdk-cell",""]],hostAttrs:[1,"cdk-cell"],features:[P]}),t})();class OP{constructor(){this.tasks=[],this.endTasks=[]}}const hw=new M("_COALESCED_STYLE_SCHEDULER");let FP=(()=>{var e;class t{constructor(n){this._ngZone=n,this._currentSchedule=null,this._destroyed=new Y}schedule(n){this._createScheduleIfNeeded(),this._currentSchedule.tasks.push(n)}scheduleEnd(n){this._createScheduleIfNeeded(),this._currentSchedule.endTasks.push(n)}ngOnDestroy(){this._destroyed.next(),this._destroyed.complete()}_createScheduleIfNeeded(){this._currentSchedule||(this._currentSchedule=new OP,this._getScheduleObservable().pipe(ce(this._destroyed)).subscribe(()=>{for(;this._currentSchedule.tasks.length||this._currentSchedule.endTasks.length;){const n=this._currentSchedule;this._currentSchedule=new OP;for(const r of n.tasks)r();for(const r of n.endTasks)r()}this._currentSchedule=null}))}_getScheduleObservable(){return this._ngZone.isStable?Et(Promise.resolve(void 0)):this._ngZone.onStable.pipe(Xe(1))}}return(e=t).\u0275fac=function(n){return new(n||e)(D(G))},e.\u0275prov=V({token:e,factory:e.\u0275fac}),t})(),fw=(()=>{var e;class t{constructor(n,r){this.template=n,this._differs=r}ngOnChanges(n){if(!this._columnsDiffer){const r=n.columns&&n.columns.currentValue||[];this._columnsDiffer=this._differs.find(r).create(),this._columnsDiffer.diff(r)}}getColumnsDiff(){return this._columnsDiffer.diff(this.columns)}extractCellTemplate(n){return this instanceof Hd?n.headerCell.template:this instanceof zd?n.footerCell.template:n.cell.template}}return(e=t).\u0275fac=function(n){return new(n||e)(m(ct),m(Dr))},e.\u0275dir=T({type:e,features:[kt]}),t})();class iie extends fw{}const rie=cw(iie);let Hd=(()=>{var e;class t extends rie{constructor(n,r,o){super(n,r),this._table=o}ngOnChanges(n){super.ngOnChanges(n)}}return(e=t).\u0275fac=function(n){return new(n||e)(m(ct),m(Dr),m(fc,8))},e.\u0275dir=T({type:e,selectors:[["","cdkHeaderRowDef",""]],inputs:{columns:["cdkHeaderRowDef","columns"],sticky:["cdkHeaderRowDefSticky","sticky"]},features:[P,kt]}),t})();class oie extends fw{}const sie=cw(oie);let zd=(()=>{var e;class t extends sie{constructor(n,r,o){super(n,r),this._table=o}ngOnChanges(n){super.ngOnChanges(n)}}return(e=t).\u0275fac=function(n){return new(n||e)(m(ct),m(Dr),m(fc,8))},e.\u0275dir=T({type:e,selectors:[["","cdkFooterRowDef",""]],inputs:{columns:["cdkFooterRowDef","columns"],sticky:["cdkFooterRowDefSticky","sticky"]},features:[P,kt]}),t})(),im=(()=>{var e;class t extends fw{constructor(n,r,o){super(n,r),this._table=o}}return(e=t).\u0275fac=function(n){return new(n||e)(m(ct),m(Dr),m(fc,8))},e.\u0275dir=T({type:e,selectors:[["","cdkRowDef",""]],inputs:{columns:["cdkRowDefColumns","columns"],when:["cdkRowDefWhen","when"]},features:[P]}),t})(),Br=(()=>{var e;class t{constructor(n){this._viewContainer=n,t.mostRecentCellOutlet=this}ngOnDestroy(){t.mostRecentCellOutlet===this&&(t.mostRecentCellOutlet=null)}}return(e=t).mostRecentCellOutlet=null,e.\u0275fac=function(n){return new(n||e)(m(pt))},e.\u0275dir=T({type:e,selectors:[["","cdkCellOutlet",""]]}),t})(),pw=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275cmp=fe({type:e,selectors:[["cdk-header-row"],["tr","cdk-header-row",""]],hostAttrs:["role","row",1,"cdk-header-row"],decls:1,vars:0,consts:[["cdkCellOutlet",""]],template:function(n,r){1&n&&nr(0,0)},dependencies:[Br],encapsulation:2}),t})(),gw=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275cmp=fe({type:e,selectors:[["cdk-row"],["tr","cdk-row",""]],hostAttrs:["role","row",1,"cdk-row"],decls:1,vars:0,consts:[["cdkCellOutlet",""]],template:function(n,r){1&n&&nr(0,0)},dependencies:[Br],encapsulation:2}),t})(),rm=(()=>{var e;class t{constructor(n){this.templateRef=n,this._contentClassName="cdk-no-data-row"}}return(e=t).\u0275fac=function(n){return new(n||e)(m(ct))},e.\u0275dir=T({type:e,selectors:[["ng-template","cdkNoDataRow",""]]}),t})();const PP=["top","bottom","left","right"];class aie{constructor(t,i,n,r,o=!0,s=!0,a){this._isNativeHtmlTable=t,this._stickCellCss=i,this.direction=n,this._coalescedStyleScheduler=r,this._isBrowser=o,this._needsPositionStickyOnElement=s,this._positionListener=a,this._cachedCellWidths=[],this._borderCellCss={top:`${i}-border-elem-top`,bottom:`${i}-border-elem-bottom`,left:`${i}-border-elem-left`,right:`${i}-border-elem-right`}}clearStickyPositioning(t,i){const n=[];for(const r of t)if(r.nodeType===r.ELEMENT_NODE){n.push(r);for(let o=0;o<r.children.length;o++)n.push(r.children[o])}this._coalescedStyleScheduler.schedule(()=>{for(const r of n)this._removeStickyStyle(r,i)})}updateStickyColumns(t,i,n,r=!0){if(!t.length||!this._isBrowser||!i.some(h=>h)&&!n.some(h=>h))return void(this._positionListener&&(this._positionListener.stickyColumnsUpdated({sizes:[]}),this._positionListener.stickyEndColumnsUpdated({sizes:[]})));const o=t[0],s=o.children.length,a=this._getCellWidths(o,r),c=this._getStickyStartColumnPositions(a,i),l=this._getStickyEndColumnPositions(a,n),d=i.lastIndexOf(!0),u=n.indexOf(!0);this._coalescedStyleScheduler.schedule(()=>{const h="rtl"===this.direction,f=h?"right":"left",p=h?"left":"right";for(const g of t)for(let b=0;b<s;b++){const _=g.children[b];i[b]&&this._addStickyStyle(_,f,c[b],b===d),n[b]&&this._addStickyStyle(_,p,l[b],b===u)}this._positionListener&&(this._positionListener.stickyColumnsUpdated({sizes:-1===d?[]:a.slice(0,d+1).map((g,b)=>i[b]?g:null)}),this._positionListener.stickyEndColumnsUpdated({sizes:-1===u?[]:a.slice(u).map((g,b)=>n[b+u]?g:null).reverse()}))})}stickRows(t,i,n){if(!this._isBrowser)return;const r="bottom"===n?t.slice().reverse():t,o="bottom"===n?i.slice().reverse():i,s=[],a=[],c=[];for(let d=0,u=0;d<r.length;d++){if(!o[d])continue;s[d]=u;const h=r[d];c[d]=this._isNativeHtmlTable?Array.from(h.children):[h];const f=h.getBoundingClientRect().height;u+=f,a[d]=f}const l=o.lastIndexOf(!0);this._coalescedStyleScheduler.schedule(()=>{for(let d=0;d<r.length;d++){if(!o[d])continue;const u=s[d],h=d===l;for(const f of c[d])this._addStickyStyle(f,n,u,h)}"top"===n?this._positionListener?.stickyHeaderRowsUpdated({sizes:a,offsets:s,elements:c}):this._positionListener?.stickyFooterRowsUpdated({sizes:a,offsets:s,elements:c})})}updateStickyFooterContainer(t,i){if(!this._isNativeHtmlTable)return;const n=t.querySelector("tfoot");this._coalescedStyleScheduler.schedule(()=>{i.some(r=>!r)?this._removeStickyStyle(n,["bottom"]):this._addStickyStyle(n,"bottom",0,!1)})}_removeStickyStyle(t,i){for(const r of i)t.style[r]="",t.classList.remove(this._borderCellCss[r]);PP.some(r=>-1===i.indexOf(r)&&t.style[r])?t.style.zIndex=this._getCalculatedZIndex(t):(t.style.zIndex="",this._needsPositionStickyOnElement&&(t.style.position=""),t.classList.remove(this._stickCellCss))}_addStickyStyle(t,i,n,r){t.classList.add(this._stickCellCss),r&&t.classList.add(this._borderCellCss[i]),t.style[i]=`${n}px`,t.style.zIndex=this._getCalculatedZIndex(t),this._needsPositionStickyOnElement&&(t.style.cssText+="position: -webkit-sticky; position: sticky; ")}_getCalculatedZIndex(t){const i={top:100,bottom:10,left:1,right:1};let n=0;for(const r of PP)t.style[r]&&(n+=i[r]);return n?`${n}`:""}_getCellWidths(t,i=!0){if(!i&&this._cachedCellWidths.length)return this._cachedCellWidths;const n=[],r=t.children;for(let o=0;o<r.length;o++)n.push(r[o].getBoundingClientRect().width);return this._cachedCellWidths=n,n}_getStickyStartColumnPositions(t,i){const n=[];let r=0;for(let o=0;o<t.length;o++)i[o]&&(n[o]=r,r+=t[o]);return n}_getStickyEndColumnPositions(t,i){const n=[];let r=0;for(let o=t.length;o>0;o--)i[o]&&(n[o]=r,r+=t[o]);return n}}const _w=new M("CDK_SPL");let om=(()=>{var e;class t{constructor(n,r){this.viewContainer=n,this.elementRef=r}}return(e=t).\u0275fac=function(n){return new(n||e)(m(pt),m(W))},e.\u0275dir=T({type:e,selectors:[["","rowOutlet",""]]}),t})(),sm=(()=>{var e;class t{constructor(n,r){this.viewContainer=n,this.elementRef=r}}return(e=t).\u0275fac=function(n){return new(n||e)(m(pt),m(W))},e.\u0275dir=T({type:e,selectors:[["","headerRowOutlet",""]]}),t})(),am=(()=>{var e;class t{constructor(n,r){this.viewContainer=n,this.elementRef=r}}return(e=t).\u0275fac=function(n){return new(n||e)(m(pt),m(W))},e.\u0275dir=T({type:e,selectors:[["","footerRowOutlet",""]]}),t})(),cm=(()=>{var e;class t{constructor(n,r){this.viewContainer=n,this.elementRef=r}}return(e=t).\u0275fac=function(n){return new(n||e)(m(pt),m(W))},e.\u0275dir=T({type:e,selectors:[["","noDataRowOutlet",""]]}),t})(),lm=(()=>{var e;class t{get trackBy(){return this._trackByFn}set trackBy(n){this._trackByFn=n}get dataSource(){return this._dataSource}set dataSource(n){this._dataSource!==n&&this._switchDataSource(n)}get multiTemplateDataRows(){return this._multiTemplateDataRows}set multiTemplateDataRows(n){this._multiTemplateDataRows=Q(n),this._rowOutlet&&this._rowOutlet.viewContainer.length&&(this._forceRenderDataRows(),this.updateStickyColumnStyles())}get fixedLayout(){return this._fixedLayout}set fixedLayout(n){this._fixedLayout=Q(n),this._forceRecalculateCellWidths=!0,this._stickyColumnStylesNeedReset=!0}constructor(n,r,o,s,a,c,l,d,u,h,f,p){this._differs=n,this._changeDetectorRef=r,this._elementRef=o,this._dir=a,this._platform=l,this._viewRepeater=d,this._coalescedStyleScheduler=u,this._viewportRuler=h,this._stickyPositioningListener=f,this._ngZone=p,this._onDestroy=new Y,this._columnDefsByName=new Map,this._customColumnDefs=new Set,this._customRowDefs=new Set,this._customHeaderRowDefs=new Set,this._customFooterRowDefs=new Set,this._headerRowDefChanged=!0,this._footerRowDefChanged=!0,this._stickyColumnStylesNeedReset=!0,this._forceRecalculateCellWidths=!0,this._cachedRenderRowsMap=new Map,this.stickyCssClass="cdk-table-sticky",this.needsPositionStickyOnElement=!0,this._isShowingNoDataRow=!1,this._multiTemplateDataRows=!1,this._fixedLayout=!1,this.contentChanged=new U,this.viewChange=new dt({start:0,end:Number.MAX_VALUE}),s||this._elementRef.nativeElement.setAttribute("role","table"),this._document=c,this._isNativeHtmlTable="TABLE"===this._elementRef.nativeElement.nodeName}ngOnInit(){this._setupStickyStyler(),this._isNativeHtmlTable&&this._applyNativeTableSections(),this._dataDiffer=this._differs.find([]).create((n,r)=>this.trackBy?this.trackBy(r.dataIndex,r.data):r),this._viewportRuler.change().pipe(ce(this._onDestroy)).subscribe(()=>{this._forceRecalculateCellWidths=!0})}ngAfterContentChecked(){this._cacheRowDefs(),this._cacheColumnDefs();const r=this._renderUpdatedColumns()||this._headerRowDefChanged||this._footerRowDefChanged;this._stickyColumnStylesNeedReset=this._stickyColumnStylesNeedReset||r,this._forceRecalculateCellWidths=r,this._headerRowDefChanged&&(this._forceRenderHeaderRows(),this._headerRowDefChanged=!1),this._footerRowDefChanged&&(this._forceRenderFooterRows(),this._footerRowDefChanged=!1),this.dataSource&&this._rowDefs.length>0&&!this._renderChangeSubscription?this._observeRenderChanges():this._stickyColumnStylesNeedReset&&this.updateStickyColumnStyles(),this._checkStickyStates()}ngOnDestroy(){[this._rowOutlet.viewContainer,this._headerRowOutlet.viewContainer,this._footerRowOutlet.viewContainer,this._cachedRenderRowsMap,this._customColumnDefs,this._customRowDefs,this._customHeaderRowDefs,this._customFooterRowDefs,this._columnDefsByName].forEach(n=>{n.clear()}),this._headerRowDefs=[],this._footerRowDefs=[],this._defaultRowDef=null,this._onDestroy.next(),this._onDestroy.complete(),Zy(this.dataSource)&&this.dataSource.disconnect(this)}renderRows(){this._renderRows=this._getAllRenderRows();const n=this._dataDiffer.diff(this._renderRows);if(!n)return this._updateNoDataRow(),void this.contentChanged.next();const r=this._rowOutlet.viewContainer;this._viewRepeater.applyChanges(n,r,(o,s,a)=>this._getEmbeddedViewArgs(o.item,a),o=>o.item.data,o=>{1===o.operation&&o.context&&this._renderCellTemplateForItem(o.record.item.rowDef,o.context)}),this._updateRowIndexContext(),n.forEachIdentityChange(o=>{r.get(o.currentIndex).context.$implicit=o.item.data}),this._updateNoDataRow(),this._ngZone&&G.isInAngularZone()?this._ngZone.onStable.pipe(Xe(1),ce(this._onDestroy)).subscribe(()=>{this.updateStickyColumnStyles()}):this.updateStickyColumnStyles(),this.contentChanged.next()}addColumnDef(n){this._customColumnDefs.add(n)}removeColumnDef(n){this._customColumnDefs.delete(n)}addRowDef(n){this._customRowDefs.add(n)}removeRowDef(n){this._customRowDefs.delete(n)}addHeaderRowDef(n){this._customHeaderRowDefs.add(n),this._headerRowDefChanged=!0}removeHeaderRowDef(n){this._customHeaderRowDefs.delete(n),this._headerRowDefChanged=!0}addFooterRowDef(n){this._customFooterRowDefs.add(n),this._footerRowDefChanged=!0}removeFooterRowDef(n){this._customFooterRowDefs.delete(n),this._footerRowDefChanged=!0}setNoDataRow(n){this._customNoDataRow=n}updateStickyHeaderRowStyles(){const n=this._getRenderedRows(this._headerRowOutlet),o=this._elementRef.nativeElement.querySelector("thead");o&&(o.style.display=n.length?"":"none");const s=this._headerRowDefs.map(a=>a.sticky);this._stickyStyler.clearStickyPositioning(n,["top"]),this._stickyStyler.stickRows(n,s,"top"),this._headerRowDefs.forEach(a=>a.resetStickyChanged())}updateStickyFooterRowStyles(){const n=this._getRenderedRows(this._footerRowOutlet),o=this._elementRef.nativeElement.querySelector("tfoot");o&&(o.style.display=n.length?"":"none");const s=this._footerRowDefs.map(a=>a.sticky);this._stickyStyler.clearStickyPositioning(n,["bottom"]),this._stickyStyler.stickRows(n,s,"bottom"),this._stickyStyler.updateStickyFooterContainer(this._elementRef.nativeElement,s),this._footerRowDefs.forEach(a=>a.resetStickyChanged())}updateStickyColumnStyles(){const n=this._getRenderedRows(this._headerRowOutlet),r=this._getRenderedRows(this._rowOutlet),o=this._getRenderedRows(this._footerRowOutlet);(this._isNativeHtmlTable&&!this._fixedLayout||this._stickyColumnStylesNeedReset)&&(this._stickyStyler.clearStickyPositioning([...n,...r,...o],["left","right"]),this._stickyColumnStylesNeedReset=!1),n.forEach((s,a)=>{this._addStickyColumnStyles([s],this._headerRowDefs[a])}),this._rowDefs.forEach(s=>{const a=[];for(let c=0;c<r.length;c++)this._renderRows[c].rowDef===s&&a.push(r[c]);this._addStickyColumnStyles(a,s)}),o.forEach((s,a)=>{this._addStickyColumnStyles([s],this._footerRowDefs[a])}),Array.from(this._columnDefsByName.values()).forEach(s=>s.resetStickyChanged())}_getAllRenderRows(){const n=[],r=this._cachedRenderRowsMap;this._cachedRenderRowsMap=new Map;for(let o=0;o<this._data.length;o++){let s=this._data[o];const a=this._getRenderRowsForData(s,o,r.get(s));this._cachedRenderRowsMap.has(s)||this._cachedRenderRowsMap.set(s,new WeakMap);for(let c=0;c<a.length;c++){let l=a[c];const d=this._cachedRenderRowsMap.get(l.data);d.has(l.rowDef)?d.get(l.rowDef).push(l):d.set(l.rowDef,[l]),n.push(l)}}return n}_getRenderRowsForData(n,r,o){return this._getRowDefs(n,r).map(a=>{const c=o&&o.has(a)?o.get(a):[];if(c.length){const l=c.shift();return l.dataIndex=r,l}return{data:n,rowDef:a,dataIndex:r}})}_cacheColumnDefs(){this._columnDefsByName.clear(),dm(this._getOwnDefs(this._contentColumnDefs),this._customColumnDefs).forEach(r=>{this._columnDefsByName.has(r.name),this._columnDefsByName.set(r.name,r)})}_cacheRowDefs(){this._headerRowDefs=dm(this._getOwnDefs(this._contentHeaderRowDefs),this._customHeaderRowDefs),this._footerRowDefs=dm(this._getOwnDefs(this._contentFooterRowDefs),this._customFooterRowDefs),this._rowDefs=dm(this._getOwnDefs(this._contentRowDefs),this._customRowDefs);const n=this._rowDefs.filter(r=>!r.when);this._defaultRowDef=n[0]}_renderUpdatedColumns(){const n=(a,c)=>a||!!c.getColumnsDiff(),r=this._rowDefs.reduce(n,!1);r&&this._forceRenderDataRows();const o=this._headerRowDefs.reduce(n,!1);o&&this._forceRenderHeaderRows();const s=this._footerRowDefs.reduce(n,!1);return s&&this._forceRenderFooterRows(),r||o||s}_switchDataSource(n){this._data=[],Zy(this.dataSource)&&this.dataSource.disconnect(this),this._renderChangeSubscription&&(this._renderChangeSubscription.unsubscribe(),this._renderChangeSubscription=null),n||(this._dataDiffer&&this._dataDiffer.diff([]),this._rowOutlet.viewContainer.clear()),this._dataSource=n}_observeRenderChanges(){if(!this.dataSource)return;let n;Zy(this.dataSource)?n=this.dataSource.connect(this):B1(this.dataSource)?n=this.dataSource:Array.isArray(this.dataSource)&&(n=re(this.dataSource)),this._renderChangeSubscription=n.pipe(ce(this._onDestroy)).subscribe(r=>{this._data=r||[],this.renderRows()})}_forceRenderHeaderRows(){this._headerRowOutlet.viewContainer.length>0&&this._headerRowOutlet.viewContainer.clear(),this._headerRowDefs.forEach((n,r)=>this._renderRow(this._headerRowOutlet,n,r)),this.updateStickyHeaderRowStyles()}_forceRenderFooterRows(){this._footerRowOutlet.viewContainer.length>0&&this._footerRowOutlet.viewContainer.clear(),this._footerRowDefs.forEach((n,r)=>this._renderRow(this._footerRowOutlet,n,r)),this.updateStickyFooterRowStyles()}_addStickyColumnStyles(n,r){const o=Array.from(r.columns||[]).map(c=>this._columnDefsByName.get(c)),s=o.map(c=>c.sticky),a=o.map(c=>c.stickyEnd);this._stickyStyler.updateStickyColumns(n,s,a,!this._fixedLayout||this._forceRecalculateCellWidths)}_getRenderedRows(n){const r=[];for(let o=0;o<n.viewContainer.length;o++){const s=n.viewContainer.get(o);r.push(s.rootNodes[0])}return r}_getRowDefs(n,r){if(1==this._rowDefs.length)return[this._rowDefs[0]];let o=[];if(this.multiTemplateDataRows)o=this._rowDefs.filter(s=>!s.when||s.when(r,n));else{let s=this._rowDefs.find(a=>a.when&&a.when(r,n))||this._defaultRowDef;s&&o.push(s)}return o}_getEmbeddedViewArgs(n,r){return{templateRef:n.rowDef.template,context:{$implicit:n.data},index:r}}_renderRow(n,r,o,s={}){const a=n.viewContainer.createEmbeddedView(r.template,s,o);return this._renderCellTemplateForItem(r,s),a}_renderCellTemplateForItem(n,r){for(let o of this._getCellTemplates(n))Br.mostRecentCellOutlet&&Br.mostRecentCellOutlet._viewContainer.createEmbeddedView(o,r);this._changeDetectorRef.markForCheck()}_updateRowIndexContext(){const n=this._rowOutlet.viewContainer;for(let r=0,o=n.length;r<o;r++){const a=n.get(r).context;a.count=o,a.first=0===r,a.last=r===o-1,a.even=r%2==0,a.odd=!a.even,this.multiTemplateDataRows?(a.dataIndex=this._renderRows[r].dataIndex,a.renderIndex=r):a.index=this._renderRows[r].dataIndex}}_getCellTemplates(n){return n&&n.columns?Array.from(n.columns,r=>{const o=this._columnDefsByName.get(r);return n.extractCellTemplate(o)}):[]}_applyNativeTableSections(){const n=this._document.createDocumentFragment(),r=[{tag:"thead",outlets:[this._headerRowOutlet]},{tag:"tbody",outlets:[this._rowOutlet,this._noDataRowOutlet]},{tag:"tfoot",outlets:[this._footerRowOutlet]}];for(const o of r){const s=this._document.createElement(o.tag);s.setAttribute("role","rowgroup");for(const a of o.outlets)s.appendChild(a.elementRef.nativeElement);n.appendChild(s)}this._elementRef.nativeElement.appendChild(n)}_forceRenderDataRows(){this._dataDiffer.diff([]),this._rowOutlet.viewContainer.clear(),this.renderRows()}_checkStickyStates(){const n=(r,o)=>r||o.hasStickyChanged();this._headerRowDefs.reduce(n,!1)&&this.updateStickyHeaderRowStyles(),this._footerRowDefs.reduce(n,!1)&&this.updateStickyFooterRowStyles(),Array.from(this._columnDefsByName.values()).reduce(n,!1)&&(this._stickyColumnStylesNeedReset=!0,this.updateStickyColumnStyles())}_setupStickyStyler(){this._stickyStyler=new aie(this._isNativeHtmlTable,this.stickyCssClass,this._dir?this._dir.value:"ltr",this._coalescedStyleScheduler,this._platform.isBrowser,this.needsPositionStickyOnElement,this._stickyPositioningListener),(this._dir?this._dir.change:re()).pipe(ce(this._onDestroy)).subscribe(r=>{this._stickyStyler.direction=r,this.updateStickyColumnStyles()})}_getOwnDefs(n){return n.filter(r=>!r._table||r._table===this)}_updateNoDataRow(){const n=this._customNoDataRow||this._noDataRow;if(!n)return;const r=0===this._rowOutlet.viewContainer.length;if(r===this._isShowingNoDataRow)return;const o=this._noDataRowOutlet.viewContainer;if(r){const s=o.createEmbeddedView(n.templateRef),a=s.rootNodes[0];1===s.rootNodes.length&&a?.nodeType===this._document.ELEMENT_NODE&&(a.setAttribute("role","row"),a.classList.add(n._contentClassName))}else o.clear();this._isShowingNoDataRow=r,this._changeDetectorRef.markForCheck()}}return(e=t).\u0275fac=function(n){return new(n||e)(m(Dr),m(Fe),m(W),ui("role"),m(fn,8),m(he),m(nt),m(wd),m(hw),m(Rr),m(_w,12),m(G,8))},e.\u0275cmp=fe({type:e,selectors:[["cdk-table"],["table","cdk-table",""]],contentQueries:function(n,r,o){if(1&n&&(Ee(o,rm,5),Ee(o,Lr,5),Ee(o,im,5),Ee(o,Hd,5),Ee(o,zd,5)),2&n){let s;H(s=z())&&(r._noDataRow=s.first),H(s=z())&&(r._contentColumnDefs=s),H(s=z())&&(r._contentRowDefs=s),H(s=z())&&(r._contentHeaderRowDefs=s),H(s=z())&&(r._contentFooterRowDefs=s)}},viewQuery:function(n,r){if(1&n&&(ke(om,7),ke(sm,7),ke(am,7),ke(cm,7)),2&n){let o;H(o=z())&&(r._rowOutlet=o.first),H(o=z())&&(r._headerRowOutlet=o.first),H(o=z())&&(r._footerRowOutlet=o.first),H(o=z())&&(r._noDataRowOutlet=o.first)}},hostAttrs:["ngSkipHydration","",1,"cdk-table"],hostVars:2,hostBindings:function(n,r){2&n&&se("cdk-table-fixed-layout",r.fixedLayout)},inputs:{trackBy:"trackBy",dataSource:"dataSource",multiTemplateDataRows:"multiTemplateDataRows",fixedLayout:"fixedLayout"},outputs:{contentChanged:"contentChanged"},exportAs:["cdkTable"],features:[Z([{provide:fc,useExisting:e},{provide:wd,useClass:UR},{provide:hw,useClass:FP},{provide:_w,useValue:null}])],ngContentSelectors:Xne,decls:6,vars:0,consts:[["headerRowOutlet",""],["rowOutlet",""],["noDataRowOutlet",""],["footerRowOutlet",""]],template:function(n,r){1&n&&(ze(Kne),X(0),X(1,1),nr(2,0)(3,1)(4,2)(5,3))},dependencies:[om,sm,am,cm],styles:[".cdk-table-fixed-layout{table-layout:fixed}"],encapsulation:2}),t})();function dm(e,t){return e.concat(Array.from(t))}let lie=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275mod=le({type:e}),e.\u0275inj=ae({imports:[ny]}),t})();const die=[[["caption"]],[["colgroup"],["col"]]],uie=["caption","colgroup, col"];let LP=(()=>{var e;class t extends lm{constructor(){super(...arguments),this.stickyCssClass="mat-mdc-table-sticky",this.needsPositionStickyOnElement=!1}ngOnInit(){super.ngOnInit(),this._isNativeHtmlTable&&this._elementRef.nativeElement.querySelector("tbody").classList.add("mdc-data-table__content")}}return(e=t).\u0275fac=function(){let i;return function(r){return(i||(i=be(e)))(r||e)}}(),e.\u0275cmp=fe({type:e,selectors:[["mat-table"],["table","mat-table",""]],hostAttrs:["ngSkipHydration","",1,"mat-mdc-table","mdc-data-table__table"],hostVars:2,hostBindings:function(n,r){2&n&&se("mdc-table-fixed-layout",r.fixedLayout)},exportAs:["matTable"],features:[Z([{provide:lm,useExisting:e},{provide:fc,useExisting:e},{provide:hw,useClass:FP},{provide:wd,useClass:UR},{provide:_w,useValue:null}]),P],ngContentSelectors:uie,decls:6,vars:0,consts:[["headerRowOutlet",""],["rowOutlet",""],["noDataRowOutlet",""],["footerRowOutlet",""]],template:function(n,r){1&n&&(ze(die),X(0),X(1,1),nr(2,0)(3,1)(4,2)(5,3))},dependencies:[om,sm,am,cm],styles:[".mat-mdc-table-sticky{position:sticky !important}.mdc-data-table{-webkit-overflow-scrolling:touch;display:inline-flex;flex-direction:column;box-sizing:border-box;position:relative}.mdc-data-table__table-container{-webkit-overflow-scrolling:touch;overflow-x:auto;width:100%}.mdc-data-table__table{min-width:100%;border:0;white-space:nowrap;border-spacing:0;table-layout:fixed}.mdc-data-table__cell{box-sizing:border-box;overflow:hidden;text-align:left;text-overflow:ellipsis}[dir=rtl] .mdc-data-table__cell,.mdc-data-table__cell[dir=rtl]{text-align:right}.mdc-data-table__cell--numeric{text-align:right}[dir=rtl] .mdc-data-table__cell--numeric,.mdc-data-table__cell--numeric[dir=rtl]{text-align:left}.mdc-data-table__header-cell{box-sizing:border-box;text-overflow:ellipsis;overflow:hidden;outline:none;text-align:left}[dir=rtl] .mdc-data-table__header-cell,.mdc-data-table__header-cell[dir=rtl]{text-align:right}.mdc-data-table__header-cell--numeric{text-align:right}[dir=rtl] .mdc-data-table__header-cell--numeric,.mdc-data-table__header-cell--numeric[dir=rtl]{text-align:left}.mdc-data-table__header-cell-wrapper{align-items:center;display:inline-flex;vertical-align:middle}.mdc-data-table__cell,.mdc-data-table__header-cell{padding:0 16px 0 16px}.mdc-data-table__header-cell--checkbox,.mdc-data-table__cell--checkbox{padding-left:4px;padding-right:0}[dir=rtl] .mdc-data-table__header-cell--checkbox,[dir=rtl] .mdc-data-table__cell--checkbox,.mdc-data-table__header-cell--checkbox[dir=rtl],.mdc-data-table__cell--checkbox[dir=rtl]{padding-left:0;padding-right:4px}mat-table{display:block}mat-header-row{min-height:56px}mat-row,mat-footer-row{min-height:48px}mat-row,mat-header-row,mat-footer-row{display:flex;border-width:0;border-bottom-width:1px;border-style:solid;align-items:center;box-sizing:border-box}mat-cell:first-of-type,mat-header-cell:first-of-type,mat-footer-cell:first-of-type{padding-left:24px}[dir=rtl] mat-cell:first-of-type:not(:only-of-type),[dir=rtl] mat-header-cell:first-of-type:not(:only-of-type),[dir=rtl] mat-footer-cell:first-of-type:not(:only-of-type){padding-left:0;padding-right:24px}mat-cell:last-of-type,mat-header-cell:last-of-type,mat-footer-cell:last-of-type{padding-right:24px}[dir=rtl] mat-cell:last-of-type:not(:only-of-type),[dir=rtl] mat-header-cell:last-of-type:not(:only-of-type),[dir=rtl] mat-footer-cell:last-of-type:not(:only-of-type){padding-right:0;padding-left:24px}mat-cell,mat-header-cell,mat-footer-cell{flex:1;display:flex;align-items:center;overflow:hidden;word-wrap:break-word;min-height:inherit}.mat-mdc-table{--mat-table-row-item-outline-width:1px;table-layout:auto;white-space:normal;background-color:var(--mat-table-background-color)}.mat-mdc-header-row{-moz-osx-font-smoothing:grayscale;-webkit-font-smoothing:antialiased;height:var(--mat-table-header-container-height, 56px);color:var(--mat-table-header-headline-color, rgba(0, 0, 0, 0.87));font-family:var(--mat-table-header-headline-font, Roboto, sans-serif);line-height:var(--mat-table-header-headline-line-height);font-size:var(--mat-table-header-headline-size, 14px);font-weight:var(--mat-table-header-headline-weight, 500)}.mat-mdc-row{height:var(--mat-table-row-item-container-height, 52px);color:var(--mat-table-row-item-label-text-color, rgba(0, 0, 0, 0.87))}.mat-mdc-row,.mdc-data-table__content{-moz-osx-font-smoothing:grayscale;-webkit-font-smoothing:antialiased;font-family:var(--mat-table-row-item-label-text-font, Roboto, sans-serif);line-height:var(--mat-table-row-item-label-text-line-height);font-size:var(--mat-table-row-item-label-text-size, 14px);font-weight:var(--mat-table-row-item-label-text-weight)}.mat-mdc-footer-row{-moz-osx-font-smoothing:grayscale;-webkit-font-smoothing:antialiased;height:var(--mat-table-footer-container-height, 52px);color:var(--mat-table-row-item-label-text-color, rgba(0, 0, 0, 0.87));font-family:var(--mat-table-footer-supporting-text-font, Roboto, sans-serif);line-height:var(--mat-table-footer-supporting-text-line-height);font-size:var(--mat-table-footer-supporting-text-size, 14px);font-weight:var(--mat-table-footer-supporting-text-weight);letter-spacing:var(--mat-table-footer-supporting-text-tracking)}.mat-mdc-header-cell{border-bottom-color:var(--mat-table-row-item-outline-color, rgba(0, 0, 0, 0.12));border-bottom-width:var(--mat-table-row-item-outline-width, 1px);border-bottom-style:solid;letter-spacing:var(--mat-table-header-headline-tracking);font-weight:inherit;line-height:inherit}.mat-mdc-cell{border-bottom-color:var(--mat-table-row-item-outline-color, rgba(0, 0, 0, 0.12));border-bottom-width:var(--mat-table-row-item-outline-width, 1px);border-bottom-style:solid;letter-spacing:var(--mat-table-row-item-label-text-tracking);line-height:inherit}.mdc-data-table__row:last-child .mat-mdc-cell{border-bottom:none}.mat-mdc-footer-cell{letter-spacing:var(--mat-table-row-item-label-text-tracking)}mat-row.mat-mdc-row,mat-header-row.mat-mdc-header-row,mat-footer-row.mat-mdc-footer-row{border-bottom:none}.mat-mdc-table tbody,.mat-mdc-table tfoot,.mat-mdc-table thead,.mat-mdc-cell,.mat-mdc-footer-cell,.mat-mdc-header-row,.mat-mdc-row,.mat-mdc-footer-row,.mat-mdc-table .mat-mdc-header-cell{background:inherit}.mat-mdc-table mat-header-row.mat-mdc-header-row,.mat-mdc-table mat-row.mat-mdc-row,.mat-mdc-table mat-footer-row.mat-mdc-footer-cell{height:unset}mat-header-cell.mat-mdc-header-cell,mat-cell.mat-mdc-cell,mat-footer-cell.mat-mdc-footer-cell{align-self:stretch}"],encapsulation:2}),t})(),bw=(()=>{var e;class t extends pc{}return(e=t).\u0275fac=function(){let i;return function(r){return(i||(i=be(e)))(r||e)}}(),e.\u0275dir=T({type:e,selectors:[["","matCellDef",""]],features:[Z([{provide:pc,useExisting:e}]),P]}),t})(),vw=(()=>{var e;class t extends mc{}return(e=t).\u0275fac=function(){let i;return function(r){return(i||(i=be(e)))(r||e)}}(),e.\u0275dir=T({type:e,selectors:[["","matHeaderCellDef",""]],features:[Z([{provide:mc,useExisting:e}]),P]}),t})(),yw=(()=>{var e;class t extends Lr{get name(){return this._name}set name(n){this._setNameInput(n)}_updateColumnCssClassName(){super._updateColumnCssClassName(),this._columnCssClassName.push(`mat-column-${this.cssClassFriendlyName}`)}}return(e=t).\u0275fac=function(){let i;return function(r){return(i||(i=be(e)))(r||e)}}(),e.\u0275dir=T({type:e,selectors:[["","matColumnDef",""]],inputs:{sticky:"sticky",name:["matColumnDef","name"]},features:[Z([{provide:Lr,useExisting:e},{provide:"MAT_SORT_HEADER_COLUMN_DEF",useExisting:e}]),P]}),t})(),ww=(()=>{var e;class t extends dw{}return(e=t).\u0275fac=function(){let i;return function(r){return(i||(i=be(e)))(r||e)}}(),e.\u0275dir=T({type:e,selectors:[["mat-header-cell"],["th","mat-header-cell",""]],hostAttrs:["role","columnheader",1,"mat-mdc-header-cell","mdc-data-table__header-cell"],features:[P]}),t})(),xw=(()=>{var e;class t extends uw{}return(e=t).\u0275fac=function(){let i;return function(r){return(i||(i=be(e)))(r||e)}}(),e.\u0275dir=T({type:e,selectors:[["mat-cell"],["td","mat-cell",""]],hostAttrs:[1,"mat-mdc-cell","mdc-data-table__cell"],features:[P]}),t})(),BP=(()=>{var e;class t extends Hd{}return(e=t).\u0275fac=function(){let i;return function(r){return(i||(i=be(e)))(r||e)}}(),e.\u0275dir=T({type:e,selectors:[["","matHeaderRowDef",""]],inputs:{columns:["matHeaderRowDef","columns"],sticky:["matHeaderRowDefSticky","sticky"]},features:[Z([{provide:Hd,useExisting:e}]),P]}),t})(),VP=(()=>{var e;class t extends im{}return(e=t).\u0275fac=function(){let i;return function(r){return(i||(i=be(e)))(r||e)}}(),e.\u0275dir=T({type:e,selectors:[["","matRowDef",""]],inputs:{columns:["matRowDefColumns","columns"],when:["matRowDefWhen","when"]},features:[Z([{provide:im,useExisting:e}]),P]}),t})(),jP=(()=>{var e;class t extends pw{}return(e=t).\u0275fac=function(){let i;return function(r){return(i||(i=be(e)))(r||e)}}(),e.\u0275cmp=fe({type:e,selectors:[["mat-header-row"],["tr","mat-header-row",""]],hostAttrs:["role","row",1,"mat-mdc-header-row","mdc-data-table__header-row"],exportAs:["matHeaderRow"],features:[Z([{provide:pw,useExisting:e}]),P],decls:1,vars:0,consts:[["cdkCellOutlet",""]],template:function(n,r){1&n&&nr(0,0)},dependencies:[Br],encapsulation:2}),t})(),HP=(()=>{var e;class t extends gw{}return(e=t).\u0275fac=function(){let i;return function(r){return(i||(i=be(e)))(r||e)}}(),e.\u0275cmp=fe({type:e,selectors:[["mat-row"],["tr","mat-row",""]],hostAttrs:["role","row",1,"mat-mdc-row","mdc-data-table__row"],exportAs:["matRow"],features:[Z([{provide:gw,useExisting:e}]),P],decls:1,vars:0,consts:[["cdkCellOutlet",""]],template:function(n,r){1&n&&nr(0,0)},dependencies:[Br],encapsulation:2}),t})(),vie=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275mod=le({type:e}),e.\u0275inj=ae({imports:[ve,lie,ve]}),t})();function wie(e,t){}const xie=function(e){return{animationDuration:e}},Cie=function(e,t){return{value:e,params:t}};function Die(e,t){1&e&&X(0)}const zP=["*"],Eie=["tabListContainer"],Sie=["tabList"],kie=["tabListInner"],Tie=["nextPaginator"],Mie=["previousPaginator"],Iie=["tabBodyWrapper"],Aie=["tabHeader"];function Rie(e,t){}function Oie(e,t){1&e&&R(0,Rie,0,0,"ng-template",14),2&e&&E("cdkPortalOutlet",B().$implicit.templateLabel)}function Fie(e,t){1&e&&A(0),2&e&&bt(B().$implicit.textLabel)}function Pie(e,t){if(1&e){const i=Ut();y(0,"div",6,7),$("click",function(){const r=Ye(i),o=r.$implicit,s=r.index,a=B(),c=hn(1);return Ke(a._handleClick(o,c,s))})("cdkFocusChange",function(r){const s=Ye(i).index;return Ke(B()._tabFocusChanged(r,s))}),ie(2,"span",8)(3,"div",9),y(4,"span",10)(5,"span",11),R(6,Oie,1,1,"ng-template",12),R(7,Fie,1,1,"ng-template",null,13,kh),w()()()}if(2&e){const i=t.$implicit,n=t.index,r=hn(1),o=hn(8),s=B();se("mdc-tab--active",s.selectedIndex===n),E("id",s._getTabLabelId(n))("ngClass",i.labelClass)("disabled",i.disabled)("fitInkBarToContent",s.fitInkBarToContent),de("tabIndex",s._getTabIndex(n))("aria-posinset",n+1)("aria-setsize",s._tabs.length)("aria-controls",s._getTabContentId(n))("aria-selected",s.selectedIndex===n)("aria-label",i.ariaLabel||null)("aria-labelledby",!i.ariaLabel&&i.ariaLabelledby?i.ariaLabelledby:null),x(3),E("matRippleTrigger",r)("matRippleDisabled",i.disabled||s.disableRipple),x(3),E("ngIf",i.templateLabel)("ngIfElse",o)}}function Nie(e,t){if(1&e){const i=Ut();y(0,"mat-tab-body",15),$("_onCentered",function(){return Ye(i),Ke(B()._removeTabBodyWrapperHeight())})("_onCentering",function(r){return Ye(i),Ke(B()._setTabBodyWrapperHeight(r))}),w()}if(2&e){const i=t.$implicit,n=t.index,r=B();se("mat-mdc-tab-body-active",r.selectedIndex===n),E("id",r._getTabContentId(n))("ngClass",i.bodyClass)("content",i.content)("position",i.position)("origin",i.origin)("animationDuration",r.animationDuration)("preserveContent",r.preserveContent),de("tabindex",null!=r.contentTabIndex&&r.selectedIndex===n?r.contentTabIndex:null)("aria-labelledby",r._getTabLabelId(n))("aria-hidden",r.selectedIndex!==n)}}const Lie={translateTab:ni("translateTab",[qt("center, void, left-origin-center, right-origin-center",je({transform:"none"})),qt("left",je({transform:"translate3d(-100%, 0, 0)",minHeight:"1px",visibility:"hidden"})),qt("right",je({transform:"translate3d(100%, 0, 0)",minHeight:"1px",visibility:"hidden"})),Bt("* => left, * => right, left => center, right => center",Lt("{{animationDuration}} cubic-bezier(0.35, 0, 0.25, 1)")),Bt("void => left-origin-center",[je({transform:"translate3d(-100%, 0, 0)",visibility:"hidden"}),Lt("{{animationDuration}} cubic-bezier(0.35, 0, 0.25, 1)")]),Bt("void => right-origin-center",[je({transform:"translate3d(100%, 0, 0)",visibility:"hidden"}),Lt("{{animationDuration}} cubic-bezier(0.35, 0, 0.25, 1)")])])};let Bie=(()=>{var e;class t extends La{constructor(n,r,o,s){super(n,r,s),this._host=o,this._centeringSub=Ae.EMPTY,this._leavingSub=Ae.EMPTY}ngOnInit(){super.ngOnInit(),this._centeringSub=this._host._beforeCentering.pipe(tn(this._host._isCenterPosition(this._host._position))).subscribe(n=>{n&&!this.hasAttached()&&this.attach(this._host._content)}),this._leavingSub=this._host._afterLeavingCenter.subscribe(()=>{this._host.preserveContent||this.detach()})}ngOnDestroy(){super.ngOnDestroy(),this._centeringSub.unsubscribe(),this._leavingSub.unsubscribe()}}return(e=t).\u0275fac=function(n){return new(n||e)(m(Bo),m(pt),m(He(()=>UP)),m(he))},e.\u0275dir=T({type:e,selectors:[["","matTabBodyHost",""]],features:[P]}),t})(),Vie=(()=>{var e;class t{set position(n){this._positionIndex=n,this._computePositionAnimationState()}constructor(n,r,o){this._elementRef=n,this._dir=r,this._dirChangeSubscription=Ae.EMPTY,this._translateTabComplete=new Y,this._onCentering=new U,this._beforeCentering=new U,this._afterLeavingCenter=new U,this._onCentered=new U(!0),this.animationDuration="500ms",this.preserveContent=!1,r&&(this._dirChangeSubscription=r.change.subscribe(s=>{this._computePositionAnimationState(s),o.markForCheck()})),this._translateTabComplete.pipe(Is((s,a)=>s.fromState===a.fromState&&s.toState===a.toState)).subscribe(s=>{this._isCenterPosition(s.toState)&&this._isCenterPosition(this._position)&&this._onCentered.emit(),this._isCenterPosition(s.fromState)&&!this._isCenterPosition(this._position)&&this._afterLeavingCenter.emit()})}ngOnInit(){"center"==this._position&&null!=this.origin&&(this._position=this._computePositionFromOrigin(this.origin))}ngOnDestroy(){this._dirChangeSubscription.unsubscribe(),this._translateTabComplete.complete()}_onTranslateTabStarted(n){const r=this._isCenterPosition(n.toState);this._beforeCentering.emit(r),r&&this._onCentering.emit(this._elementRef.nativeElement.clientHeight)}_getLayoutDirection(){return this._dir&&"rtl"===this._dir.value?"rtl":"ltr"}_isCenterPosition(n){return"center"==n||"left-origin-center"==n||"right-origin-center"==n}_computePositionAnimationState(n=this._getLayoutDirection()){this._position=this._positionIndex<0?"ltr"==n?"left":"right":this._positionIndex>0?"ltr"==n?"right":"left":"center"}_computePositionFromOrigin(n){const r=this._getLayoutDirection();return"ltr"==r&&n<=0||"rtl"==r&&n>0?"left-origin-center":"right-origin-center"}}return(e=t).\u0275fac=function(n){return new(n||e)(m(W),m(fn,8),m(Fe))},e.\u0275dir=T({type:e,inputs:{_content:["content","_content"],origin:"origin",animationDuration:"animationDuration",preserveContent:"preserveContent",position:"position"},outputs:{_onCentering:"_onCentering",_beforeCentering:"_beforeCentering",_afterLeavingCenter:"_afterLeavingCenter",_onCentered:"_onCentered"}}),t})(),UP=(()=>{var e;class t extends Vie{constructor(n,r,o){super(n,r,o)}}return(e=t).\u0275fac=function(n){return new(n||e)(m(W),m(fn,8),m(Fe))},e.\u0275cmp=fe({type:e,selectors:[["mat-tab-body"]],viewQuery:function(n,r){if(1&n&&ke(La,5),2&n){let o;H(o=z())&&(r._portalHost=o.first)}},hostAttrs:[1,"mat-mdc-tab-body"],features:[P],decls:3,vars:6,consts:[["cdkScrollable","",1,"mat-mdc-tab-body-content"],["content",""],["matTabBodyHost",""]],template:function(n,r){1&n&&(y(0,"div",0,1),$("@translateTab.start",function(s){return r._onTranslateTabStarted(s)})("@translateTab.done",function(s){return r._translateTabComplete.next(s)}),R(2,wie,0,0,"ng-template",2),w()),2&n&&E("@translateTab",function dk(e,t,i,n,r){return hk(F(),Dn(),e,t,i,n,r)}(3,Cie,r._position,function lk(e,t,i,n){return uk(F(),Dn(),e,t,i,n)}(1,xie,r.animationDuration)))},dependencies:[Bie],styles:['.mat-mdc-tab-body{top:0;left:0;right:0;bottom:0;position:absolute;display:block;overflow:hidden;outline:0;flex-basis:100%}.mat-mdc-tab-body.mat-mdc-tab-body-active{position:relative;overflow-x:hidden;overflow-y:auto;z-index:1;flex-grow:1}.mat-mdc-tab-group.mat-mdc-tab-group-dynamic-height .mat-mdc-tab-body.mat-mdc-tab-body-active{overflow-y:hidden}.mat-mdc-tab-body-content{height:100%;overflow:auto}.mat-mdc-tab-group-dynamic-height .mat-mdc-tab-body-content{overflow:hidden}.mat-mdc-tab-body-content[style*="visibility: hidden"]{display:none}'],encapsulation:2,data:{animation:[Lie.translateTab]}}),t})();const jie=new M("MatTabContent");let Hie=(()=>{var e;class t{constructor(n){this.template=n}}return(e=t).\u0275fac=function(n){return new(n||e)(m(ct))},e.\u0275dir=T({type:e,selectors:[["","matTabContent",""]],features:[Z([{provide:jie,useExisting:e}])]}),t})();const zie=new M("MatTabLabel"),$P=new M("MAT_TAB");let qP=(()=>{var e;class t extends i9{constructor(n,r,o){super(n,r),this._closestTab=o}}return(e=t).\u0275fac=function(n){return new(n||e)(m(ct),m(pt),m($P,8))},e.\u0275dir=T({type:e,selectors:[["","mat-tab-label",""],["","matTabLabel",""]],features:[Z([{provide:zie,useExisting:e}]),P]}),t})();const Cw="mdc-tab-indicator--active",GP="mdc-tab-indicator--no-transition";class Uie{constructor(t){this._items=t}hide(){this._items.forEach(t=>t.deactivateInkBar())}alignToElement(t){const i=this._items.find(r=>r.elementRef.nativeElement===t),n=this._currentItem;if(i!==n&&(n?.deactivateInkBar(),i)){const r=n?.elementRef.nativeElement.getBoundingClientRect?.();i.activateInkBar(r),this._currentItem=i}}}function $ie(e){return class extends e{constructor(...t){super(...t),this._fitToContent=!1}get fitInkBarToContent(){return this._fitToContent}set fitInkBarToContent(t){const i=Q(t);this._fitToContent!==i&&(this._fitToContent=i,this._inkBarElement&&this._appendInkBarElement())}activateInkBar(t){const i=this.elementRef.nativeElement;if(!t||!i.getBoundingClientRect||!this._inkBarContentElement)return void i.classList.add(Cw);const n=i.getBoundingClientRect(),r=t.width/n.width,o=t.left-n.left;i.classList.add(GP),this._inkBarContentElement.style.setProperty("transform",`translateX(${o}px) scaleX(${r})`),i.getBoundingClientRect(),i.classList.remove(GP),i.classList.add(Cw),this._inkBarContentElement.style.setProperty("transform","")}deactivateInkBar(){this.elementRef.nativeElement.classList.remove(Cw)}ngOnInit(){this._createInkBarElement()}ngOnDestroy(){this._inkBarElement?.remove(),this._inkBarElement=this._inkBarContentElement=null}_createInkBarElement(){const t=this.elementRef.nativeElement.ownerDocument||document;this._inkBarElement=t.createElement("span"),this._inkBarContentElement=t.createElement("span"),this._inkBarElement.className="mdc-tab-indicator",this._inkBarContentElement.className="mdc-tab-indicator__content mdc-tab-indicator__content--underline",this._inkBarElement.appendChild(this._inkBarContentElement),this._appendInkBarElement()}_appendInkBarElement(){(this._fitToContent?this.elementRef.nativeElement.querySelector(".mdc-tab__content"):this.elementRef.nativeElement).appendChild(this._inkBarElement)}}}const Gie=es(class{}),Wie=$ie((()=>{var e;class t extends Gie{constructor(n){super(),this.elementRef=n}focus(){this.elementRef.nativeElement.focus()}getOffsetLeft(){return this.elementRef.nativeElement.offsetLeft}getOffsetWidth(){return this.elementRef.nativeElement.offsetWidth}}return(e=t).\u0275fac=function(n){return new(n||e)(m(W))},e.\u0275dir=T({type:e,features:[P]}),t})());let WP=(()=>{var e;class t extends Wie{}return(e=t).\u0275fac=function(){let i;return function(r){return(i||(i=be(e)))(r||e)}}(),e.\u0275dir=T({type:e,selectors:[["","matTabLabelWrapper",""]],hostVars:3,hostBindings:function(n,r){2&n&&(de("aria-disabled",!!r.disabled),se("mat-mdc-tab-disabled",r.disabled))},inputs:{disabled:"disabled",fitInkBarToContent:"fitInkBarToContent"},features:[P]}),t})();const Qie=es(class{}),QP=new M("MAT_TAB_GROUP");let Yie=(()=>{var e;class t extends Qie{get content(){return this._contentPortal}constructor(n,r){super(),this._viewContainerRef=n,this._closestTabGroup=r,this.textLabel="",this._contentPortal=null,this._stateChanges=new Y,this.position=null,this.origin=null,this.isActive=!1}ngOnChanges(n){(n.hasOwnProperty("textLabel")||n.hasOwnProperty("disabled"))&&this._stateChanges.next()}ngOnDestroy(){this._stateChanges.complete()}ngOnInit(){this._contentPortal=new co(this._explicitContent||this._implicitContent,this._viewContainerRef)}_setTemplateLabelInput(n){n&&n._closestTab===this&&(this._templateLabel=n)}}return(e=t).\u0275fac=function(n){return new(n||e)(m(pt),m(QP,8))},e.\u0275dir=T({type:e,viewQuery:function(n,r){if(1&n&&ke(ct,7),2&n){let o;H(o=z())&&(r._implicitContent=o.first)}},inputs:{textLabel:["label","textLabel"],ariaLabel:["aria-label","ariaLabel"],ariaLabelledby:["aria-labelledby","ariaLabelledby"],labelClass:"labelClass",bodyClass:"bodyClass"},features:[P,kt]}),t})(),YP=(()=>{var e;class t extends Yie{constructor(){super(...arguments),this._explicitContent=void 0}get templateLabel(){return this._templateLabel}set templateLabel(n){this._setTemplateLabelInput(n)}}return(e=t).\u0275fac=function(){let i;return function(r){return(i||(i=be(e)))(r||e)}}(),e.\u0275cmp=fe({type:e,selectors:[["mat-tab"]],contentQueries:function(n,r,o){if(1&n&&(Ee(o,Hie,7,ct),Ee(o,qP,5)),2&n){let s;H(s=z())&&(r._explicitContent=s.first),H(s=z())&&(r.templateLabel=s.first)}},inputs:{disabled:"disabled"},exportAs:["matTab"],features:[Z([{provide:$P,useExisting:e}]),P],ngContentSelectors:zP,decls:1,vars:0,template:function(n,r){1&n&&(ze(),R(0,Die,1,0,"ng-template"))},encapsulation:2}),t})();const KP=ro({passive:!0});let Zie=(()=>{var e;class t{get disablePagination(){return this._disablePagination}set disablePagination(n){this._disablePagination=Q(n)}get selectedIndex(){return this._selectedIndex}set selectedIndex(n){n=Bi(n),this._selectedIndex!=n&&(this._selectedIndexChanged=!0,this._selectedIndex=n,this._keyManager&&this._keyManager.updateActiveItem(n))}constructor(n,r,o,s,a,c,l){this._elementRef=n,this._changeDetectorRef=r,this._viewportRuler=o,this._dir=s,this._ngZone=a,this._platform=c,this._animationMode=l,this._scrollDistance=0,this._selectedIndexChanged=!1,this._destroyed=new Y,this._showPaginationControls=!1,this._disableScrollAfter=!0,this._disableScrollBefore=!0,this._stopScrolling=new Y,this._disablePagination=!1,this._selectedIndex=0,this.selectFocusedIndex=new U,this.indexFocused=new U,a.runOutsideAngular(()=>{Vi(n.nativeElement,"mouseleave").pipe(ce(this._destroyed)).subscribe(()=>{this._stopInterval()})})}ngAfterViewInit(){Vi(this._previousPaginator.nativeElement,"touchstart",KP).pipe(ce(this._destroyed)).subscribe(()=>{this._handlePaginatorPress("before")}),Vi(this._nextPaginator.nativeElement,"touchstart",KP).pipe(ce(this._destroyed)).subscribe(()=>{this._handlePaginatorPress("after")})}ngAfterContentInit(){const n=this._dir?this._dir.change:re("ltr"),r=this._viewportRuler.change(150),o=()=>{this.updatePagination(),this._alignInkBarToSelectedTab()};this._keyManager=new Pv(this._items).withHorizontalOrientation(this._getLayoutDirection()).withHomeAndEnd().withWrap().skipPredicate(()=>!1),this._keyManager.updateActiveItem(this._selectedIndex),this._ngZone.onStable.pipe(Xe(1)).subscribe(o),Ot(n,r,this._items.changes,this._itemsResized()).pipe(ce(this._destroyed)).subscribe(()=>{this._ngZone.run(()=>{Promise.resolve().then(()=>{this._scrollDistance=Math.max(0,Math.min(this._getMaxScrollDistance(),this._scrollDistance)),o()})}),this._keyManager.withHorizontalOrientation(this._getLayoutDirection())}),this._keyManager.change.subscribe(s=>{this.indexFocused.emit(s),this._setTabFocus(s)})}_itemsResized(){return"function"!=typeof ResizeObserver?Rt:this._items.changes.pipe(tn(this._items),Vt(n=>new Me(r=>this._ngZone.runOutsideAngular(()=>{const o=new ResizeObserver(s=>r.next(s));return n.forEach(s=>o.observe(s.elementRef.nativeElement)),()=>{o.disconnect()}}))),Tv(1),Ve(n=>n.some(r=>r.contentRect.width>0&&r.contentRect.height>0)))}ngAfterContentChecked(){this._tabLabelCount!=this._items.length&&(this.updatePagination(),this._tabLabelCount=this._items.length,this._changeDetectorRef.markForCheck()),this._selectedIndexChanged&&(this._scrollToLabel(this._selectedIndex),this._checkScrollingControls(),this._alignInkBarToSelectedTab(),this._selectedIndexChanged=!1,this._changeDetectorRef.markForCheck()),this._scrollDistanceChanged&&(this._updateTabScrollPosition(),this._scrollDistanceChanged=!1,this._changeDetectorRef.markForCheck())}ngOnDestroy(){this._keyManager?.destroy(),this._destroyed.next(),this._destroyed.complete(),this._stopScrolling.complete()}_handleKeydown(n){if(!An(n))switch(n.keyCode){case 13:case 32:if(this.focusIndex!==this.selectedIndex){const r=this._items.get(this.focusIndex);r&&!r.disabled&&(this.selectFocusedIndex.emit(this.focusIndex),this._itemSelected(n))}break;default:this._keyManager.onKeydown(n)}}_onContentChanges(){const n=this._elementRef.nativeElement.textContent;n!==this._currentTextContent&&(this._currentTextContent=n||"",this._ngZone.run(()=>{this.updatePagination(),this._alignInkBarToSelectedTab(),this._changeDetectorRef.markForCheck()}))}updatePagination(){this._checkPaginationEnabled(),this._checkScrollingControls(),this._updateTabScrollPosition()}get focusIndex(){return this._keyManager?this._keyManager.activeItemIndex:0}set focusIndex(n){!this._isValidIndex(n)||this.focusIndex===n||!this._keyManager||this._keyManager.setActiveItem(n)}_isValidIndex(n){return!this._items||!!this._items.toArray()[n]}_setTabFocus(n){if(this._showPaginationControls&&this._scrollToLabel(n),this._items&&this._items.length){this._items.toArray()[n].focus();const r=this._tabListContainer.nativeElement;r.scrollLeft="ltr"==this._getLayoutDirection()?0:r.scrollWidth-r.offsetWidth}}_getLayoutDirection(){return this._dir&&"rtl"===this._dir.value?"rtl":"ltr"}_updateTabScrollPosition(){if(this.disablePagination)return;const n=this.scrollDistance,r="ltr"===this._getLayoutDirection()?-n:n;this._tabList.nativeElement.style.transform=`translateX(${Math.round(r)}px)`,(this._platform.TRIDENT||this._platform.EDGE)&&(this._tabListContainer.nativeElement.scrollLeft=0)}get scrollDistance(){return this._scrollDistance}set scrollDistance(n){this._scrollTo(n)}_scrollHeader(n){return this._scrollTo(this._scrollDistance+("before"==n?-1:1)*this._tabListContainer.nativeElement.offsetWidth/3)}_handlePaginatorClick(n){this._stopInterval(),this._scrollHeader(n)}_scrollToLabel(n){if(this.disablePagination)return;const r=this._items?this._items.toArray()[n]:null;if(!r)return;const o=this._tabListContainer.nativeElement.offsetWidth,{offsetLeft:s,offsetWidth:a}=r.elementRef.nativeElement;let c,l;"ltr"==this._getLayoutDirection()?(c=s,l=c+a):(l=this._tabListInner.nativeElement.offsetWidth-s,c=l-a);const d=this.scrollDistance,u=this.scrollDistance+o;c<d?this.scrollDistance-=d-c:l>u&&(this.scrollDistance+=Math.min(l-u,c-d))}_checkPaginationEnabled(){if(this.disablePagination)this._showPaginationControls=!1;else{const n=this._tabListInner.nativeElement.scrollWidth>this._elementRef.nativeElement.offsetWidth;n||(this.scrollDistance=0),n!==this._showPaginationControls&&this._changeDetectorRef.markForCheck(),this._showPaginationControls=n}}_checkScrollingControls(){this.disablePagination?this._disableScrollAfter=this._disableScrollBefore=!0:(this._disableScrollBefore=0==this.scrollDistance,this._disableScrollAfter=this.scrollDistance==this._getMaxScrollDistance(),this._changeDetectorRef.markForCheck())}_getMaxScrollDistance(){return this._tabListInner.nativeElement.scrollWidth-this._tabListContainer.nativeElement.offsetWidth||0}_alignInkBarToSelectedTab(){const n=this._items&&this._items.length?this._items.toArray()[this.selectedIndex]:null,r=n?n.elementRef.nativeElement:null;r?this._inkBar.alignToElement(r):this._inkBar.hide()}_stopInterval(){this._stopScrolling.next()}_handlePaginatorPress(n,r){r&&null!=r.button&&0!==r.button||(this._stopInterval(),Xv(650,100).pipe(ce(Ot(this._stopScrolling,this._destroyed))).subscribe(()=>{const{maxScrollDistance:o,distance:s}=this._scrollHeader(n);(0===s||s>=o)&&this._stopInterval()}))}_scrollTo(n){if(this.disablePagination)return{maxScrollDistance:0,distance:0};const r=this._getMaxScrollDistance();return this._scrollDistance=Math.max(0,Math.min(r,n)),this._scrollDistanceChanged=!0,this._checkScrollingControls(),{maxScrollDistance:r,distance:this._scrollDistance}}}return(e=t).\u0275fac=function(n){return new(n||e)(m(W),m(Fe),m(Rr),m(fn,8),m(G),m(nt),m(_t,8))},e.\u0275dir=T({type:e,inputs:{disablePagination:"disablePagination"}}),t})(),Jie=(()=>{var e;class t extends Zie{get disableRipple(){return this._disableRipple}set disableRipple(n){this._disableRipple=Q(n)}constructor(n,r,o,s,a,c,l){super(n,r,o,s,a,c,l),this._disableRipple=!1}_itemSelected(n){n.preventDefault()}}return(e=t).\u0275fac=function(n){return new(n||e)(m(W),m(Fe),m(Rr),m(fn,8),m(G),m(nt),m(_t,8))},e.\u0275dir=T({type:e,inputs:{disableRipple:"disableRipple"},features:[P]}),t})(),ere=(()=>{var e;class t extends Jie{constructor(n,r,o,s,a,c,l){super(n,r,o,s,a,c,l)}ngAfterContentInit(){this._inkBar=new Uie(this._items),super.ngAfterContentInit()}}return(e=t).\u0275fac=function(n){return new(n||e)(m(W),m(Fe),m(Rr),m(fn,8),m(G),m(nt),m(_t,8))},e.\u0275cmp=fe({type:e,selectors:[["mat-tab-header"]],contentQueries:function(n,r,o){if(1&n&&Ee(o,WP,4),2&n){let s;H(s=z())&&(r._items=s)}},viewQuery:function(n,r){if(1&n&&(ke(Eie,7),ke(Sie,7),ke(kie,7),ke(Tie,5),ke(Mie,5)),2&n){let o;H(o=z())&&(r._tabListContainer=o.first),H(o=z())&&(r._tabList=o.first),H(o=z())&&(r._tabListInner=o.first),H(o=z())&&(r._nextPaginator=o.first),H(o=z())&&(r._previousPaginator=o.first)}},hostAttrs:[1,"mat-mdc-tab-header"],hostVars:4,hostBindings:function(n,r){2&n&&se("mat-mdc-tab-header-pagination-controls-enabled",r._showPaginationControls)("mat-mdc-tab-header-rtl","rtl"==r._getLayoutDirection())},inputs:{selectedIndex:"selectedIndex"},outputs:{selectFocusedIndex:"selectFocusedIndex",indexFocused:"indexFocused"},features:[P],ngContentSelectors:zP,decls:13,vars:10,consts:[["aria-hidden","true","type","button","mat-ripple","","tabindex","-1",1,"mat-mdc-tab-header-pagination","mat-mdc-tab-header-pagination-before",3,"matRippleDisabled","disabled","click","mousedown","touchend"],["previousPaginator",""],[1,"mat-mdc-tab-header-pagination-chevron"],[1,"mat-mdc-tab-label-container",3,"keydown"],["tabListContainer",""],["role","tablist",1,"mat-mdc-tab-list",3,"cdkObserveContent"],["tabList",""],[1,"mat-mdc-tab-labels"],["tabListInner",""],["aria-hidden","true","type","button","mat-ripple","","tabindex","-1",1,"mat-mdc-tab-header-pagination","mat-mdc-tab-header-pagination-after",3,"matRippleDisabled","disabled","mousedown","click","touchend"],["nextPaginator",""]],template:function(n,r){1&n&&(ze(),y(0,"button",0,1),$("click",function(){return r._handlePaginatorClick("before")})("mousedown",function(s){return r._handlePaginatorPress("before",s)})("touchend",function(){return r._stopInterval()}),ie(2,"div",2),w(),y(3,"div",3,4),$("keydown",function(s){return r._handleKeydown(s)}),y(5,"div",5,6),$("cdkObserveContent",function(){return r._onContentChanges()}),y(7,"div",7,8),X(9),w()()(),y(10,"button",9,10),$("mousedown",function(s){return r._handlePaginatorPress("after",s)})("click",function(){return r._handlePaginatorClick("after")})("touchend",function(){return r._stopInterval()}),ie(12,"div",2),w()),2&n&&(se("mat-mdc-tab-header-pagination-disabled",r._disableScrollBefore),E("matRippleDisabled",r._disableScrollBefore||r.disableRipple)("disabled",r._disableScrollBefore||null),x(3),se("_mat-animation-noopable","NoopAnimations"===r._animationMode),x(7),se("mat-mdc-tab-header-pagination-disabled",r._disableScrollAfter),E("matRippleDisabled",r._disableScrollAfter||r.disableRipple)("disabled",r._disableScrollAfter||null))},dependencies:[ao,f7],styles:[".mat-mdc-tab-header{display:flex;overflow:hidden;position:relative;flex-shrink:0;--mdc-tab-indicator-active-indicator-height:2px;--mdc-tab-indicator-active-indicator-shape:0;--mdc-secondary-navigation-tab-container-height:48px}.mdc-tab-indicator .mdc-tab-indicator__content{transition-duration:var(--mat-tab-animation-duration, 250ms)}.mat-mdc-tab-header-pagination{-webkit-user-select:none;user-select:none;position:relative;display:none;justify-content:center;align-items:center;min-width:32px;cursor:pointer;z-index:2;-webkit-tap-highlight-color:rgba(0,0,0,0);touch-action:none;box-sizing:content-box;background:none;border:none;outline:0;padding:0}.mat-mdc-tab-header-pagination::-moz-focus-inner{border:0}.mat-mdc-tab-header-pagination .mat-ripple-element{opacity:.12;background-color:var(--mat-tab-header-inactive-ripple-color)}.mat-mdc-tab-header-pagination-controls-enabled .mat-mdc-tab-header-pagination{display:flex}.mat-mdc-tab-header-pagination-before,.mat-mdc-tab-header-rtl .mat-mdc-tab-header-pagination-after{padding-left:4px}.mat-mdc-tab-header-pagination-before .mat-mdc-tab-header-pagination-chevron,.mat-mdc-tab-header-rtl .mat-mdc-tab-header-pagination-after .mat-mdc-tab-header-pagination-chevron{transform:rotate(-135deg)}.mat-mdc-tab-header-rtl .mat-mdc-tab-header-pagination-before,.mat-mdc-tab-header-pagination-after{padding-right:4px}.mat-mdc-tab-header-rtl .mat-mdc-tab-header-pagination-before .mat-mdc-tab-header-pagination-chevron,.mat-mdc-tab-header-pagination-after .mat-mdc-tab-header-pagination-chevron{transform:rotate(45deg)}.mat-mdc-tab-header-pagination-chevron{border-style:solid;border-width:2px 2px 0 0;height:8px;width:8px;border-color:var(--mat-tab-header-pagination-icon-color)}.mat-mdc-tab-header-pagination-disabled{box-shadow:none;cursor:default;pointer-events:none}.mat-mdc-tab-header-pagination-disabled .mat-mdc-tab-header-pagination-chevron{opacity:.4}.mat-mdc-tab-list{flex-grow:1;position:relative;transition:transform 500ms cubic-bezier(0.35, 0, 0.25, 1)}._mat-animation-noopable .mat-mdc-tab-list{transition:none}._mat-animation-noopable span.mdc-tab-indicator__content,._mat-animation-noopable span.mdc-tab__text-label{transition:none}.mat-mdc-tab-label-container{display:flex;flex-grow:1;overflow:hidden;z-index:1}.mat-mdc-tab-labels{display:flex;flex:1 0 auto}[mat-align-tabs=center]>.mat-mdc-tab-header .mat-mdc-tab-labels{justify-content:center}[mat-align-tabs=end]>.mat-mdc-tab-header .mat-mdc-tab-labels{justify-content:flex-end}.mat-mdc-tab::before{margin:5px}.cdk-high-contrast-active .mat-mdc-tab[aria-disabled=true]{color:GrayText}"],encapsulation:2}),t})();const XP=new M("MAT_TABS_CONFIG");let tre=0;const nre=ts(so(class{constructor(e){this._elementRef=e}}),"primary");let ire=(()=>{var e;class t extends nre{get dynamicHeight(){return this._dynamicHeight}set dynamicHeight(n){this._dynamicHeight=Q(n)}get selectedIndex(){return this._selectedIndex}set selectedIndex(n){this._indexToSelect=Bi(n,null)}get animationDuration(){return this._animationDuration}set animationDuration(n){this._animationDuration=/^\d+$/.test(n+"")?n+"ms":n}get contentTabIndex(){return this._contentTabIndex}set contentTabIndex(n){this._contentTabIndex=Bi(n,null)}get disablePagination(){return this._disablePagination}set disablePagination(n){this._disablePagination=Q(n)}get preserveContent(){return this._preserveContent}set preserveContent(n){this._preserveContent=Q(n)}get backgroundColor(){return this._backgroundColor}set backgroundColor(n){const r=this._elementRef.nativeElement.classList;r.remove("mat-tabs-with-background",`mat-background-${this.backgroundColor}`),n&&r.add("mat-tabs-with-background",`mat-background-${n}`),this._backgroundColor=n}constructor(n,r,o,s){super(n),this._changeDetectorRef=r,this._animationMode=s,this._tabs=new Cr,this._indexToSelect=0,this._lastFocusedTabIndex=null,this._tabBodyWrapperHeight=0,this._tabsSubscription=Ae.EMPTY,this._tabLabelSubscription=Ae.EMPTY,this._dynamicHeight=!1,this._selectedIndex=null,this.headerPosition="above",this._disablePagination=!1,this._preserveContent=!1,this.selectedIndexChange=new U,this.focusChange=new U,this.animationDone=new U,this.selectedTabChange=new U(!0),this._groupId=tre++,this.animationDuration=o&&o.animationDuration?o.animationDuration:"500ms",this.disablePagination=!(!o||null==o.disablePagination)&&o.disablePagination,this.dynamicHeight=!(!o||null==o.dynamicHeight)&&o.dynamicHeight,this.contentTabIndex=o?.contentTabIndex??null,this.preserveContent=!!o?.preserveContent}ngAfterContentChecked(){const n=this._indexToSelect=this._clampTabIndex(this._indexToSelect);if(this._selectedIndex!=n){const r=null==this._selectedIndex;if(!r){this.selectedTabChange.emit(this._createChangeEvent(n));const o=this._tabBodyWrapper.nativeElement;o.style.minHeight=o.clientHeight+"px"}Promise.resolve().then(()=>{this._tabs.forEach((o,s)=>o.isActive=s===n),r||(this.selectedIndexChange.emit(n),this._tabBodyWrapper.nativeElement.style.minHeight="")})}this._tabs.forEach((r,o)=>{r.position=o-n,null!=this._selectedIndex&&0==r.position&&!r.origin&&(r.origin=n-this._selectedIndex)}),this._selectedIndex!==n&&(this._selectedIndex=n,this._lastFocusedTabIndex=null,this._changeDetectorRef.markForCheck())}ngAfterContentInit(){this._subscribeToAllTabChanges(),this._subscribeToTabLabels(),this._tabsSubscription=this._tabs.changes.subscribe(()=>{const n=this._clampTabIndex(this._indexToSelect);if(n===this._selectedIndex){const r=this._tabs.toArray();let o;for(let s=0;s<r.length;s++)if(r[s].isActive){this._indexToSelect=this._selectedIndex=s,this._lastFocusedTabIndex=null,o=r[s];break}!o&&r[n]&&Promise.resolve().then(()=>{r[n].isActive=!0,this.selectedTabChange.emit(this._createChangeEvent(n))})}this._changeDetectorRef.markForCheck()})}_subscribeToAllTabChanges(){this._allTabs.changes.pipe(tn(this._allTabs)).subscribe(n=>{this._tabs.reset(n.filter(r=>r._closestTabGroup===this||!r._closestTabGroup)),this._tabs.notifyOnChanges()})}ngOnDestroy(){this._tabs.destroy(),this._tabsSubscription.unsubscribe(),this._tabLabelSubscription.unsubscribe()}realignInkBar(){this._tabHeader&&this._tabHeader._alignInkBarToSelectedTab()}updatePagination(){this._tabHeader&&this._tabHeader.updatePagination()}focusTab(n){const r=this._tabHeader;r&&(r.focusIndex=n)}_focusChanged(n){this._lastFocusedTabIndex=n,this.focusChange.emit(this._createChangeEvent(n))}_createChangeEvent(n){const r=new ore;return r.index=n,this._tabs&&this._tabs.length&&(r.tab=this._tabs.toArray()[n]),r}_subscribeToTabLabels(){this._tabLabelSubscription&&this._tabLabelSubscription.unsubscribe(),this._tabLabelSubscription=Ot(...this._tabs.map(n=>n._stateChanges)).subscribe(()=>this._changeDetectorRef.markForCheck())}_clampTabIndex(n){return Math.min(this._tabs.length-1,Math.max(n||0,0))}_getTabLabelId(n){return`mat-tab-label-${this._groupId}-${n}`}_getTabContentId(n){return`mat-tab-content-${this._groupId}-${n}`}_setTabBodyWrapperHeight(n){if(!this._dynamicHeight||!this._tabBodyWrapperHeight)return;const r=this._tabBodyWrapper.nativeElement;r.style.height=this._tabBodyWrapperHeight+"px",this._tabBodyWrapper.nativeElement.offsetHeight&&(r.style.height=n+"px")}_removeTabBodyWrapperHeight(){const n=this._tabBodyWrapper.nativeElement;this._tabBodyWrapperHeight=n.clientHeight,n.style.height="",this.animationDone.emit()}_handleClick(n,r,o){r.focusIndex=o,n.disabled||(this.selectedIndex=o)}_getTabIndex(n){return n===(this._lastFocusedTabIndex??this.selectedIndex)?0:-1}_tabFocusChanged(n,r){n&&"mouse"!==n&&"touch"!==n&&(this._tabHeader.focusIndex=r)}}return(e=t).\u0275fac=function(n){return new(n||e)(m(W),m(Fe),m(XP,8),m(_t,8))},e.\u0275dir=T({type:e,inputs:{dynamicHeight:"dynamicHeight",selectedIndex:"selectedIndex",headerPosition:"headerPosition",animationDuration:"animationDuration",contentTabIndex:"contentTabIndex",disablePagination:"disablePagination",preserveContent:"preserveContent",backgroundColor:"backgroundColor"},outputs:{selectedIndexChange:"selectedIndexChange",focusChange:"focusChange",animationDone:"animationDone",selectedTabChange:"selectedTabChange"},features:[P]}),t})(),rre=(()=>{var e;class t extends ire{get fitInkBarToContent(){return this._fitInkBarToContent}set fitInkBarToContent(n){this._fitInkBarToContent=Q(n),this._changeDetectorRef.markForCheck()}get stretchTabs(){return this._stretchTabs}set stretchTabs(n){this._stretchTabs=Q(n)}constructor(n,r,o,s){super(n,r,o,s),this._fitInkBarToContent=!1,this._stretchTabs=!0,this.fitInkBarToContent=!(!o||null==o.fitInkBarToContent)&&o.fitInkBarToContent,this.stretchTabs=!o||null==o.stretchTabs||o.stretchTabs}}return(e=t).\u0275fac=function(n){return new(n||e)(m(W),m(Fe),m(XP,8),m(_t,8))},e.\u0275cmp=fe({type:e,selectors:[["mat-tab-group"]],contentQueries:function(n,r,o){if(1&n&&Ee(o,YP,5),2&n){let s;H(s=z())&&(r._allTabs=s)}},viewQuery:function(n,r){if(1&n&&(ke(Iie,5),ke(Aie,5)),2&n){let o;H(o=z())&&(r._tabBodyWrapper=o.first),H(o=z())&&(r._tabHeader=o.first)}},hostAttrs:["ngSkipHydration","",1,"mat-mdc-tab-group"],hostVars:8,hostBindings:function(n,r){2&n&&(kn("--mat-tab-animation-duration",r.animationDuration),se("mat-mdc-tab-group-dynamic-height",r.dynamicHeight)("mat-mdc-tab-group-inverted-header","below"===r.headerPosition)("mat-mdc-tab-group-stretch-tabs",r.stretchTabs))},inputs:{color:"color",disableRipple:"disableRipple",fitInkBarToContent:"fitInkBarToContent",stretchTabs:["mat-stretch-tabs","stretchTabs"]},exportAs:["matTabGroup"],features:[Z([{provide:QP,useExisting:e}]),P],decls:6,vars:7,consts:[[3,"selectedIndex","disableRipple","disablePagination","indexFocused","selectFocusedIndex"],["tabHeader",""],["class","mdc-tab mat-mdc-tab mat-mdc-focus-indicator","role","tab","matTabLabelWrapper","","cdkMonitorElementFocus","",3,"id","mdc-tab--active","ngClass","disabled","fitInkBarToContent","click","cdkFocusChange",4,"ngFor","ngForOf"],[1,"mat-mdc-tab-body-wrapper"],["tabBodyWrapper",""],["role","tabpanel",3,"id","mat-mdc-tab-body-active","ngClass","content","position","origin","animationDuration","preserveContent","_onCentered","_onCentering",4,"ngFor","ngForOf"],["role","tab","matTabLabelWrapper","","cdkMonitorElementFocus","",1,"mdc-tab","mat-mdc-tab","mat-mdc-focus-indicator",3,"id","ngClass","disabled","fitInkBarToContent","click","cdkFocusChange"],["tabNode",""],[1,"mdc-tab__ripple"],["mat-ripple","",1,"mat-mdc-tab-ripple",3,"matRippleTrigger","matRippleDisabled"],[1,"mdc-tab__content"],[1,"mdc-tab__text-label"],[3,"ngIf","ngIfElse"],["tabTextLabel",""],[3,"cdkPortalOutlet"],["role","tabpanel",3,"id","ngClass","content","position","origin","animationDuration","preserveContent","_onCentered","_onCentering"]],template:function(n,r){1&n&&(y(0,"mat-tab-header",0,1),$("indexFocused",function(s){return r._focusChanged(s)})("selectFocusedIndex",function(s){return r.selectedIndex=s}),R(2,Pie,9,17,"div",2),w(),y(3,"div",3,4),R(5,Nie,1,12,"mat-tab-body",5),w()),2&n&&(E("selectedIndex",r.selectedIndex||0)("disableRipple",r.disableRipple)("disablePagination",r.disablePagination),x(2),E("ngForOf",r._tabs),x(1),se("_mat-animation-noopable","NoopAnimations"===r._animationMode),x(2),E("ngForOf",r._tabs))},dependencies:[Ea,Wh,_i,La,ao,Q7,UP,WP,ere],styles:['.mdc-tab{min-width:90px;padding-right:24px;padding-left:24px;display:flex;flex:1 0 auto;justify-content:center;box-sizing:border-box;margin:0;padding-top:0;padding-bottom:0;border:none;outline:none;text-align:center;white-space:nowrap;cursor:pointer;-webkit-appearance:none;z-index:1}.mdc-tab::-moz-focus-inner{padding:0;border:0}.mdc-tab[hidden]{display:none}.mdc-tab--min-width{flex:0 1 auto}.mdc-tab__content{display:flex;align-items:center;justify-content:center;height:inherit;pointer-events:none}.mdc-tab__text-label{transition:150ms color linear;display:inline-block;line-height:1;z-index:2}.mdc-tab__icon{transition:150ms color linear;z-index:2}.mdc-tab--stacked .mdc-tab__content{flex-direction:column;align-items:center;justify-content:center}.mdc-tab--stacked .mdc-tab__text-label{padding-top:6px;padding-bottom:4px}.mdc-tab--active .mdc-tab__text-label,.mdc-tab--active .mdc-tab__icon{transition-delay:100ms}.mdc-tab:not(.mdc-tab--stacked) .mdc-tab__icon+.mdc-tab__text-label{padding-left:8px;padding-right:0}[dir=rtl] .mdc-tab:not(.mdc-tab--stacked) .mdc-tab__icon+.mdc-tab__text-label,.mdc-tab:not(.mdc-tab--stacked) .mdc-tab__icon+.mdc-tab__text-label[dir=rtl]{padding-left:0;padding-right:8px}.mdc-tab-indicator{display:flex;position:absolute;top:0;left:0;justify-content:center;width:100%;height:100%;pointer-events:none;z-index:1}.mdc-tab-indicator__content{transform-origin:left;opacity:0}.mdc-tab-indicator__content--underline{align-self:flex-end;box-sizing:border-box;width:100%;border-top-style:solid}.mdc-tab-indicator__content--icon{align-self:center;margin:0 auto}.mdc-tab-indicator--active .mdc-tab-indicator__content{opacity:1}.mdc-tab-indicator .mdc-tab-indicator__content{transition:250ms transform cubic-bezier(0.4, 0, 0.2, 1)}.mdc-tab-indicator--no-transition .mdc-tab-indicator__content{transition:none}.mdc-tab-indicator--fade .mdc-tab-indicator__content{transition:150ms opacity linear}.mdc-tab-indicator--active.mdc-tab-indicator--fade .mdc-tab-indicator__content{transition-delay:100ms}.mat-mdc-tab-ripple{position:absolute;top:0;left:0;bottom:0;right:0;pointer-events:none}.mat-mdc-tab{-webkit-tap-highlight-color:rgba(0,0,0,0);-webkit-font-smoothing:antialiased;-moz-osx-font-smoothing:grayscale;text-decoration:none;background:none;font-family:var(--mat-tab-header-label-text-font);font-size:var(--mat-tab-header-label-text-size);letter-spacing:var(--mat-tab-header-label-text-tracking);line-height:var(--mat-tab-header-label-text-line-height);font-weight:var(--mat-tab-header-label-text-weight)}.mat-mdc-tab .mdc-tab-indicator__content--underline{border-color:var(--mdc-tab-indicator-active-indicator-color)}.mat-mdc-tab .mdc-tab-indicator__content--underline{border-top-width:var(--mdc-tab-indicator-active-indicator-height)}.mat-mdc-tab .mdc-tab-indicator__content--underline{border-radius:var(--mdc-tab-indicator-active-indicator-shape)}.mat-mdc-tab:not(.mdc-tab--stacked){height:var(--mdc-secondary-navigation-tab-container-height)}.mat-mdc-tab:not(:disabled).mdc-tab--active .mdc-tab__icon{fill:currentColor}.mat-mdc-tab:not(:disabled):hover.mdc-tab--active .mdc-tab__icon{fill:currentColor}.mat-mdc-tab:not(:disabled):focus.mdc-tab--active .mdc-tab__icon{fill:currentColor}.mat-mdc-tab:not(:disabled):active.mdc-tab--active .mdc-tab__icon{fill:currentColor}.mat-mdc-tab:disabled.mdc-tab--active .mdc-tab__icon{fill:currentColor}.mat-mdc-tab:not(:disabled):not(.mdc-tab--active) .mdc-tab__icon{fill:currentColor}.mat-mdc-tab:not(:disabled):hover:not(.mdc-tab--active) .mdc-tab__icon{fill:currentColor}.mat-mdc-tab:not(:disabled):focus:not(.mdc-tab--active) .mdc-tab__icon{fill:currentColor}.mat-mdc-tab:not(:disabled):active:not(.mdc-tab--active) .mdc-tab__icon{fill:currentColor}.mat-mdc-tab:disabled:not(.mdc-tab--active) .mdc-tab__icon{fill:currentColor}.mat-mdc-tab.mdc-tab{flex-grow:0}.mat-mdc-tab:hover .mdc-tab__text-label{color:var(--mat-tab-header-inactive-hover-label-text-color)}.mat-mdc-tab:focus .mdc-tab__text-label{color:var(--mat-tab-header-inactive-focus-label-text-color)}.mat-mdc-tab.mdc-tab--active .mdc-tab__text-label{color:var(--mat-tab-header-active-label-text-color)}.mat-mdc-tab.mdc-tab--active .mdc-tab__ripple::before,.mat-mdc-tab.mdc-tab--active .mat-ripple-element{background-color:var(--mat-tab-header-active-ripple-color)}.mat-mdc-tab.mdc-tab--active:hover .mdc-tab__text-label{color:var(--mat-tab-header-active-hover-label-text-color)}.mat-mdc-tab.mdc-tab--active:hover .mdc-tab-indicator__content--underline{border-color:var(--mat-tab-header-active-hover-indicator-color)}.mat-mdc-tab.mdc-tab--active:focus .mdc-tab__text-label{color:var(--mat-tab-header-active-focus-label-text-color)}.mat-mdc-tab.mdc-tab--active:focus .mdc-tab-indicator__content--underline{border-color:var(--mat-tab-header-active-focus-indicator-color)}.mat-mdc-tab.mat-mdc-tab-disabled{opacity:.4;pointer-events:none}.mat-mdc-tab.mat-mdc-tab-disabled .mdc-tab__content{pointer-events:none}.mat-mdc-tab.mat-mdc-tab-disabled .mdc-tab__ripple::before,.mat-mdc-tab.mat-mdc-tab-disabled .mat-ripple-element{background-color:var(--mat-tab-header-disabled-ripple-color)}.mat-mdc-tab .mdc-tab__ripple::before{content:"";display:block;position:absolute;top:0;left:0;right:0;bottom:0;opacity:0;pointer-events:none;background-color:var(--mat-tab-header-inactive-ripple-color)}.mat-mdc-tab .mdc-tab__text-label{color:var(--mat-tab-header-inactive-label-text-color);display:inline-flex;align-items:center}.mat-mdc-tab .mdc-tab__content{position:relative;pointer-events:auto}.mat-mdc-tab:hover .mdc-tab__ripple::before{opacity:.04}.mat-mdc-tab.cdk-program-focused .mdc-tab__ripple::before,.mat-mdc-tab.cdk-keyboard-focused .mdc-tab__ripple::before{opacity:.12}.mat-mdc-tab .mat-ripple-element{opacity:.12;background-color:var(--mat-tab-header-inactive-ripple-color)}.mat-mdc-tab-group.mat-mdc-tab-group-stretch-tabs>.mat-mdc-tab-header .mat-mdc-tab{flex-grow:1}.mat-mdc-tab-group{display:flex;flex-direction:column;max-width:100%}.mat-mdc-tab-group.mat-tabs-with-background>.mat-mdc-tab-header,.mat-mdc-tab-group.mat-tabs-with-background>.mat-mdc-tab-header-pagination{background-color:var(--mat-tab-header-with-background-background-color)}.mat-mdc-tab-group.mat-tabs-with-background.mat-primary>.mat-mdc-tab-header .mat-mdc-tab .mdc-tab__text-label{color:var(--mat-tab-header-with-background-foreground-color)}.mat-mdc-tab-group.mat-tabs-with-background.mat-primary>.mat-mdc-tab-header .mdc-tab-indicator__content--underline{border-color:var(--mat-tab-header-with-background-foreground-color)}.mat-mdc-tab-group.mat-tabs-with-background:not(.mat-primary)>.mat-mdc-tab-header .mat-mdc-tab:not(.mdc-tab--active) .mdc-tab__text-label{color:var(--mat-tab-header-with-background-foreground-color)}.mat-mdc-tab-group.mat-tabs-with-background:not(.mat-primary)>.mat-mdc-tab-header .mat-mdc-tab:not(.mdc-tab--active) .mdc-tab-indicator__content--underline{border-color:var(--mat-tab-header-with-background-foreground-color)}.mat-mdc-tab-group.mat-tabs-with-background>.mat-mdc-tab-header .mat-mdc-tab-header-pagination-chevron,.mat-mdc-tab-group.mat-tabs-with-background>.mat-mdc-tab-header .mat-mdc-focus-indicator::before,.mat-mdc-tab-group.mat-tabs-with-background>.mat-mdc-tab-header-pagination .mat-mdc-tab-header-pagination-chevron,.mat-mdc-tab-group.mat-tabs-with-background>.mat-mdc-tab-header-pagination .mat-mdc-focus-indicator::before{border-color:var(--mat-tab-header-with-background-foreground-color)}.mat-mdc-tab-group.mat-tabs-with-background>.mat-mdc-tab-header .mat-ripple-element,.mat-mdc-tab-group.mat-tabs-with-background>.mat-mdc-tab-header .mdc-tab__ripple::before,.mat-mdc-tab-group.mat-tabs-with-background>.mat-mdc-tab-header-pagination .mat-ripple-element,.mat-mdc-tab-group.mat-tabs-with-background>.mat-mdc-tab-header-pagination .mdc-tab__ripple::before{background-color:var(--mat-tab-header-with-background-foreground-color)}.mat-mdc-tab-group.mat-tabs-with-background>.mat-mdc-tab-header .mat-mdc-tab-header-pagination-chevron,.mat-mdc-tab-group.mat-tabs-with-background>.mat-mdc-tab-header-pagination .mat-mdc-tab-header-pagination-chevron{color:var(--mat-tab-header-with-background-foreground-color)}.mat-mdc-tab-group.mat-mdc-tab-group-inverted-header{flex-direction:column-reverse}.mat-mdc-tab-group.mat-mdc-tab-group-inverted-header .mdc-tab-indicator__content--underline{align-self:flex-start}.mat-mdc-tab-body-wrapper{position:relative;overflow:hidden;display:flex;transition:height 500ms cubic-bezier(0.35, 0, 0.25, 1)}.mat-mdc-tab-body-wrapper._mat-animation-noopable{transition:none !important;animation:none !important}'],encapsulation:2}),t})();class ore{}let sre=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275mod=le({type:e}),e.\u0275inj=ae({imports:[vn,ve,qf,is,Mv,$I,ve]}),t})();const are=["trigger"],cre=["panel"];function lre(e,t){if(1&e&&(y(0,"span",10),A(1),w()),2&e){const i=B();x(1),bt(i.placeholder)}}function dre(e,t){if(1&e&&(y(0,"span",14),A(1),w()),2&e){const i=B(2);x(1),bt(i.triggerValue)}}function ure(e,t){1&e&&X(0,0,["*ngSwitchCase","true"])}function hre(e,t){1&e&&(y(0,"span",11),R(1,dre,2,1,"span",12),R(2,ure,1,0,"ng-content",13),w()),2&e&&(E("ngSwitch",!!B().customTrigger),x(2),E("ngSwitchCase",!0))}function fre(e,t){if(1&e){const i=Ut();zs(),ag(),y(0,"div",15,16),$("@transformPanel.done",function(r){return Ye(i),Ke(B()._panelDoneAnimatingStream.next(r.toState))})("keydown",function(r){return Ye(i),Ke(B()._handleKeydown(r))}),X(2,1),w()}if(2&e){const i=B();(function mS(e,t,i){Fi(Xn,rr,da(F(),e,t,i),!0)})("mat-mdc-select-panel mdc-menu-surface mdc-menu-surface--open ",i._getPanelTheme(),""),E("ngClass",i.panelClass)("@transformPanel","showing"),de("id",i.id+"-panel")("aria-multiselectable",i.multiple)("aria-label",i.ariaLabel||null)("aria-labelledby",i._getPanelAriaLabelledby())}}const pre=[[["mat-select-trigger"]],"*"],mre=["mat-select-trigger","*"],gre={transformPanelWrap:ni("transformPanelWrap",[Bt("* => void",K$("@transformPanel",[Y$()],{optional:!0}))]),transformPanel:ni("transformPanel",[qt("void",je({opacity:0,transform:"scale(1, 0.8)"})),Bt("void => showing",Lt("120ms cubic-bezier(0, 0, 0.2, 1)",je({opacity:1,transform:"scale(1, 1)"}))),Bt("* => void",Lt("100ms linear",je({opacity:0})))])};let ZP=0;const JP=new M("mat-select-scroll-strategy"),bre=new M("MAT_SELECT_CONFIG"),vre={provide:JP,deps:[wi],useFactory:function _re(e){return()=>e.scrollStrategies.reposition()}},yre=new M("MatSelectTrigger");class wre{constructor(t,i){this.source=t,this.value=i}}const xre=so(ns(es(jv(class{constructor(e,t,i,n,r){this._elementRef=e,this._defaultErrorStateMatcher=t,this._parentForm=i,this._parentFormGroup=n,this.ngControl=r,this.stateChanges=new Y}}))));let Cre=(()=>{var e;class t extends xre{get focused(){return this._focused||this._panelOpen}get placeholder(){return this._placeholder}set placeholder(n){this._placeholder=n,this.stateChanges.next()}get required(){return this._required??this.ngControl?.control?.hasValidator(Ay.required)??!1}set required(n){this._required=Q(n),this.stateChanges.next()}get multiple(){return this._multiple}set multiple(n){this._multiple=Q(n)}get disableOptionCentering(){return this._disableOptionCentering}set disableOptionCentering(n){this._disableOptionCentering=Q(n)}get compareWith(){return this._compareWith}set compareWith(n){this._compareWith=n,this._selectionModel&&this._initializeSelection()}get value(){return this._value}set value(n){this._assignValue(n)&&this._onChange(n)}get typeaheadDebounceInterval(){return this._typeaheadDebounceInterval}set typeaheadDebounceInterval(n){this._typeaheadDebounceInterval=Bi(n)}get id(){return this._id}set id(n){this._id=n||this._uid,this.stateChanges.next()}constructor(n,r,o,s,a,c,l,d,u,h,f,p,g,b){super(a,s,l,d,h),this._viewportRuler=n,this._changeDetectorRef=r,this._ngZone=o,this._dir=c,this._parentFormField=u,this._liveAnnouncer=g,this._defaultOptions=b,this._panelOpen=!1,this._compareWith=(_,v)=>_===v,this._uid="mat-select-"+ZP++,this._triggerAriaLabelledBy=null,this._destroy=new Y,this._onChange=()=>{},this._onTouched=()=>{},this._valueId="mat-select-value-"+ZP++,this._panelDoneAnimatingStream=new Y,this._overlayPanelClass=this._defaultOptions?.overlayPanelClass||"",this._focused=!1,this.controlType="mat-select",this._multiple=!1,this._disableOptionCentering=this._defaultOptions?.disableOptionCentering??!1,this.ariaLabel="",this.optionSelectionChanges=Kf(()=>{const _=this.options;return _?_.changes.pipe(tn(_),Vt(()=>Ot(..._.map(v=>v.onSelectionChange)))):this._ngZone.onStable.pipe(Xe(1),Vt(()=>this.optionSelectionChanges))}),this.openedChange=new U,this._openedStream=this.openedChange.pipe(Ve(_=>_),J(()=>{})),this._closedStream=this.openedChange.pipe(Ve(_=>!_),J(()=>{})),this.selectionChange=new U,this.valueChange=new U,this._trackedModal=null,this.ngControl&&(this.ngControl.valueAccessor=this),null!=b?.typeaheadDebounceInterval&&(this._typeaheadDebounceInterval=b.typeaheadDebounceInterval),this._scrollStrategyFactory=p,this._scrollStrategy=this._scrollStrategyFactory(),this.tabIndex=parseInt(f)||0,this.id=this.id}ngOnInit(){this._selectionModel=new qR(this.multiple),this.stateChanges.next(),this._panelDoneAnimatingStream.pipe(Is(),ce(this._destroy)).subscribe(()=>this._panelDoneAnimating(this.panelOpen))}ngAfterContentInit(){this._initKeyManager(),this._selectionModel.changed.pipe(ce(this._destroy)).subscribe(n=>{n.added.forEach(r=>r.select()),n.removed.forEach(r=>r.deselect())}),this.options.changes.pipe(tn(null),ce(this._destroy)).subscribe(()=>{this._resetOptions(),this._initializeSelection()})}ngDoCheck(){const n=this._getTriggerAriaLabelledby(),r=this.ngControl;if(n!==this._triggerAriaLabelledBy){const o=this._elementRef.nativeElement;this._triggerAriaLabelledBy=n,n?o.setAttribute("aria-labelledby",n):o.removeAttribute("aria-labelledby")}r&&(this._previousControl!==r.control&&(void 0!==this._previousControl&&null!==r.disabled&&r.disabled!==this.disabled&&(this.disabled=r.disabled),this._previousControl=r.control),this.updateErrorState())}ngOnChanges(n){(n.disabled||n.userAriaDescribedBy)&&this.stateChanges.next(),n.typeaheadDebounceInterval&&this._keyManager&&this._keyManager.withTypeAhead(this._typeaheadDebounceInterval)}ngOnDestroy(){this._keyManager?.destroy(),this._destroy.next(),this._destroy.complete(),this.stateChanges.complete(),this._clearFromModal()}toggle(){this.panelOpen?this.close():this.open()}open(){this._canOpen()&&(this._applyModalPanelOwnership(),this._panelOpen=!0,this._keyManager.withHorizontalOrientation(null),this._highlightCorrectOption(),this._changeDetectorRef.markForCheck())}_applyModalPanelOwnership(){const n=this._elementRef.nativeElement.closest('body > .cdk-overlay-container [aria-modal="true"]');if(!n)return;const r=`${this.id}-panel`;this._trackedModal&&ql(this._trackedModal,"aria-owns",r),Rv(n,"aria-owns",r),this._trackedModal=n}_clearFromModal(){this._trackedModal&&(ql(this._trackedModal,"aria-owns",`${this.id}-panel`),this._trackedModal=null)}close(){this._panelOpen&&(this._panelOpen=!1,this._keyManager.withHorizontalOrientation(this._isRtl()?"rtl":"ltr"),this._changeDetectorRef.markForCheck(),this._onTouched())}writeValue(n){this._assignValue(n)}registerOnChange(n){this._onChange=n}registerOnTouched(n){this._onTouched=n}setDisabledState(n){this.disabled=n,this._changeDetectorRef.markForCheck(),this.stateChanges.next()}get panelOpen(){return this._panelOpen}get selected(){return this.multiple?this._selectionModel?.selected||[]:this._selectionModel?.selected[0]}get triggerValue(){if(this.empty)return"";if(this._multiple){const n=this._selectionModel.selected.map(r=>r.viewValue);return this._isRtl()&&n.reverse(),n.join(", ")}return this._selectionModel.selected[0].viewValue}_isRtl(){return!!this._dir&&"rtl"===this._dir.value}_handleKeydown(n){this.disabled||(this.panelOpen?this._handleOpenKeydown(n):this._handleClosedKeydown(n))}_handleClosedKeydown(n){const r=n.keyCode,o=40===r||38===r||37===r||39===r,s=13===r||32===r,a=this._keyManager;if(!a.isTyping()&&s&&!An(n)||(this.multiple||n.altKey)&&o)n.preventDefault(),this.open();else if(!this.multiple){const c=this.selected;a.onKeydown(n);const l=this.selected;l&&c!==l&&this._liveAnnouncer.announce(l.viewValue,1e4)}}_handleOpenKeydown(n){const r=this._keyManager,o=n.keyCode,s=40===o||38===o,a=r.isTyping();if(s&&n.altKey)n.preventDefault(),this.close();else if(a||13!==o&&32!==o||!r.activeItem||An(n))if(!a&&this._multiple&&65===o&&n.ctrlKey){n.preventDefault();const c=this.options.some(l=>!l.disabled&&!l.selected);this.options.forEach(l=>{l.disabled||(c?l.select():l.deselect())})}else{const c=r.activeItemIndex;r.onKeydown(n),this._multiple&&s&&n.shiftKey&&r.activeItem&&r.activeItemIndex!==c&&r.activeItem._selectViaInteraction()}else n.preventDefault(),r.activeItem._selectViaInteraction()}_onFocus(){this.disabled||(this._focused=!0,this.stateChanges.next())}_onBlur(){this._focused=!1,this._keyManager?.cancelTypeahead(),!this.disabled&&!this.panelOpen&&(this._onTouched(),this._changeDetectorRef.markForCheck(),this.stateChanges.next())}_onAttached(){this._overlayDir.positionChange.pipe(Xe(1)).subscribe(()=>{this._changeDetectorRef.detectChanges(),this._positioningSettled()})}_getPanelTheme(){return this._parentFormField?`mat-${this._parentFormField.color}`:""}get empty(){return!this._selectionModel||this._selectionModel.isEmpty()}_initializeSelection(){Promise.resolve().then(()=>{this.ngControl&&(this._value=this.ngControl.value),this._setSelectionByValue(this._value),this.stateChanges.next()})}_setSelectionByValue(n){if(this.options.forEach(r=>r.setInactiveStyles()),this._selectionModel.clear(),this.multiple&&n)Array.isArray(n),n.forEach(r=>this._selectOptionByValue(r)),this._sortValues();else{const r=this._selectOptionByValue(n);r?this._keyManager.updateActiveItem(r):this.panelOpen||this._keyManager.updateActiveItem(-1)}this._changeDetectorRef.markForCheck()}_selectOptionByValue(n){const r=this.options.find(o=>{if(this._selectionModel.isSelected(o))return!1;try{return null!=o.value&&this._compareWith(o.value,n)}catch{return!1}});return r&&this._selectionModel.select(r),r}_assignValue(n){return!!(n!==this._value||this._multiple&&Array.isArray(n))&&(this.options&&this._setSelectionByValue(n),this._value=n,!0)}_skipPredicate(n){return n.disabled}_initKeyManager(){this._keyManager=new NI(this.options).withTypeAhead(this._typeaheadDebounceInterval).withVerticalOrientation().withHorizontalOrientation(this._isRtl()?"rtl":"ltr").withHomeAndEnd().withPageUpDown().withAllowedModifierKeys(["shiftKey"]).skipPredicate(this._skipPredicate),this._keyManager.tabOut.subscribe(()=>{this.panelOpen&&(!this.multiple&&this._keyManager.activeItem&&this._keyManager.activeItem._selectViaInteraction(),this.focus(),this.close())}),this._keyManager.change.subscribe(()=>{this._panelOpen&&this.panel?this._scrollOptionIntoView(this._keyManager.activeItemIndex||0):!this._panelOpen&&!this.multiple&&this._keyManager.activeItem&&this._keyManager.activeItem._selectViaInteraction()})}_resetOptions(){const n=Ot(this.options.changes,this._destroy);this.optionSelectionChanges.pipe(ce(n)).subscribe(r=>{this._onSelect(r.source,r.isUserInput),r.isUserInput&&!this.multiple&&this._panelOpen&&(this.close(),this.focus())}),Ot(...this.options.map(r=>r._stateChanges)).pipe(ce(n)).subscribe(()=>{this._changeDetectorRef.detectChanges(),this.stateChanges.next()})}_onSelect(n,r){const o=this._selectionModel.isSelected(n);null!=n.value||this._multiple?(o!==n.selected&&(n.selected?this._selectionModel.select(n):this._selectionModel.deselect(n)),r&&this._keyManager.setActiveItem(n),this.multiple&&(this._sortValues(),r&&this.focus())):(n.deselect(),this._selectionModel.clear(),null!=this.value&&this._propagateChanges(n.value)),o!==this._selectionModel.isSelected(n)&&this._propagateChanges(),this.stateChanges.next()}_sortValues(){if(this.multiple){const n=this.options.toArray();this._selectionModel.sort((r,o)=>this.sortComparator?this.sortComparator(r,o,n):n.indexOf(r)-n.indexOf(o)),this.stateChanges.next()}}_propagateChanges(n){let r=null;r=this.multiple?this.selected.map(o=>o.value):this.selected?this.selected.value:n,this._value=r,this.valueChange.emit(r),this._onChange(r),this.selectionChange.emit(this._getChangeEvent(r)),this._changeDetectorRef.markForCheck()}_highlightCorrectOption(){if(this._keyManager)if(this.empty){let n=-1;for(let r=0;r<this.options.length;r++)if(!this.options.get(r).disabled){n=r;break}this._keyManager.setActiveItem(n)}else this._keyManager.setActiveItem(this._selectionModel.selected[0])}_canOpen(){return!this._panelOpen&&!this.disabled&&this.options?.length>0}focus(n){this._elementRef.nativeElement.focus(n)}_getPanelAriaLabelledby(){if(this.ariaLabel)return null;const n=this._parentFormField?.getLabelId();return this.ariaLabelledby?(n?n+" ":"")+this.ariaLabelledby:n}_getAriaActiveDescendant(){return this.panelOpen&&this._keyManager&&this._keyManager.activeItem?this._keyManager.activeItem.id:null}_getTriggerAriaLabelledby(){if(this.ariaLabel)return null;const n=this._parentFormField?.getLabelId();let r=(n?n+" ":"")+this._valueId;return this.ariaLabelledby&&(r+=" "+this.ariaLabelledby),r}_panelDoneAnimating(n){this.openedChange.emit(n)}setDescribedByIds(n){n.length?this._elementRef.nativeElement.setAttribute("aria-describedby",n.join(" ")):this._elementRef.nativeElement.removeAttribute("aria-describedby")}onContainerClick(){this.focus(),this.open()}get shouldLabelFloat(){return this._panelOpen||!this.empty||this._focused&&!!this._placeholder}}return(e=t).\u0275fac=function(n){return new(n||e)(m(Rr),m(Fe),m(G),m(Ff),m(W),m(fn,8),m(Ya,8),m(Xa,8),m(Vd,8),m(Hi,10),ui("tabindex"),m(JP),m(Bv),m(bre,8))},e.\u0275dir=T({type:e,viewQuery:function(n,r){if(1&n&&(ke(are,5),ke(cre,5),ke(F1,5)),2&n){let o;H(o=z())&&(r.trigger=o.first),H(o=z())&&(r.panel=o.first),H(o=z())&&(r._overlayDir=o.first)}},inputs:{userAriaDescribedBy:["aria-describedby","userAriaDescribedBy"],panelClass:"panelClass",placeholder:"placeholder",required:"required",multiple:"multiple",disableOptionCentering:"disableOptionCentering",compareWith:"compareWith",value:"value",ariaLabel:["aria-label","ariaLabel"],ariaLabelledby:["aria-labelledby","ariaLabelledby"],errorStateMatcher:"errorStateMatcher",typeaheadDebounceInterval:"typeaheadDebounceInterval",sortComparator:"sortComparator",id:"id"},outputs:{openedChange:"openedChange",_openedStream:"opened",_closedStream:"closed",selectionChange:"selectionChange",valueChange:"valueChange"},features:[P,kt]}),t})(),Dre=(()=>{var e;class t extends Cre{constructor(){super(...arguments),this.panelWidth=this._defaultOptions&&typeof this._defaultOptions.panelWidth<"u"?this._defaultOptions.panelWidth:"auto",this._positions=[{originX:"start",originY:"bottom",overlayX:"start",overlayY:"top"},{originX:"end",originY:"bottom",overlayX:"end",overlayY:"top"},{originX:"start",originY:"top",overlayX:"start",overlayY:"bottom",panelClass:"mat-mdc-select-panel-above"},{originX:"end",originY:"top",overlayX:"end",overlayY:"bottom",panelClass:"mat-mdc-select-panel-above"}],this._hideSingleSelectionIndicator=this._defaultOptions?.hideSingleSelectionIndicator??!1,this._skipPredicate=n=>!this.panelOpen&&n.disabled}get shouldLabelFloat(){return this.panelOpen||!this.empty||this.focused&&!!this.placeholder}ngOnInit(){super.ngOnInit(),this._viewportRuler.change().pipe(ce(this._destroy)).subscribe(()=>{this.panelOpen&&(this._overlayWidth=this._getOverlayWidth(this._preferredOverlayOrigin),this._changeDetectorRef.detectChanges())})}open(){this._parentFormField&&(this._preferredOverlayOrigin=this._parentFormField.getConnectedOverlayOrigin()),this._overlayWidth=this._getOverlayWidth(this._preferredOverlayOrigin),super.open(),this.stateChanges.next()}close(){super.close(),this.stateChanges.next()}_scrollOptionIntoView(n){const r=this.options.toArray()[n];if(r){const o=this.panel.nativeElement,s=t1(n,this.options,this.optionGroups),a=r._getHostElement();o.scrollTop=0===n&&1===s?0:n1(a.offsetTop,a.offsetHeight,o.scrollTop,o.offsetHeight)}}_positioningSettled(){this._scrollOptionIntoView(this._keyManager.activeItemIndex||0)}_getChangeEvent(n){return new wre(this,n)}_getOverlayWidth(n){return"auto"===this.panelWidth?(n instanceof ry?n.elementRef:n||this._elementRef).nativeElement.getBoundingClientRect().width:null===this.panelWidth?"":this.panelWidth}get hideSingleSelectionIndicator(){return this._hideSingleSelectionIndicator}set hideSingleSelectionIndicator(n){this._hideSingleSelectionIndicator=Q(n),this._syncParentProperties()}_syncParentProperties(){if(this.options)for(const n of this.options)n._changeDetectorRef.markForCheck()}}return(e=t).\u0275fac=function(){let i;return function(r){return(i||(i=be(e)))(r||e)}}(),e.\u0275cmp=fe({type:e,selectors:[["mat-select"]],contentQueries:function(n,r,o){if(1&n&&(Ee(o,yre,5),Ee(o,Nf,5),Ee(o,zv,5)),2&n){let s;H(s=z())&&(r.customTrigger=s.first),H(s=z())&&(r.options=s),H(s=z())&&(r.optionGroups=s)}},hostAttrs:["role","combobox","aria-autocomplete","none","aria-haspopup","listbox","ngSkipHydration","",1,"mat-mdc-select"],hostVars:19,hostBindings:function(n,r){1&n&&$("keydown",function(s){return r._handleKeydown(s)})("focus",function(){return r._onFocus()})("blur",function(){return r._onBlur()}),2&n&&(de("id",r.id)("tabindex",r.tabIndex)("aria-controls",r.panelOpen?r.id+"-panel":null)("aria-expanded",r.panelOpen)("aria-label",r.ariaLabel||null)("aria-required",r.required.toString())("aria-disabled",r.disabled.toString())("aria-invalid",r.errorState)("aria-activedescendant",r._getAriaActiveDescendant()),se("mat-mdc-select-disabled",r.disabled)("mat-mdc-select-invalid",r.errorState)("mat-mdc-select-required",r.required)("mat-mdc-select-empty",r.empty)("mat-mdc-select-multiple",r.multiple))},inputs:{disabled:"disabled",disableRipple:"disableRipple",tabIndex:"tabIndex",panelWidth:"panelWidth",hideSingleSelectionIndicator:"hideSingleSelectionIndicator"},exportAs:["matSelect"],features:[Z([{provide:Kp,useExisting:e},{provide:Hv,useExisting:e}]),P],ngContentSelectors:mre,decls:11,vars:10,consts:[["cdk-overlay-origin","",1,"mat-mdc-select-trigger",3,"click"],["fallbackOverlayOrigin","cdkOverlayOrigin","trigger",""],[1,"mat-mdc-select-value",3,"ngSwitch"],["class","mat-mdc-select-placeholder mat-mdc-select-min-line",4,"ngSwitchCase"],["class","mat-mdc-select-value-text",3,"ngSwitch",4,"ngSwitchCase"],[1,"mat-mdc-select-arrow-wrapper"],[1,"mat-mdc-select-arrow"],["viewBox","0 0 24 24","width","24px","height","24px","focusable","false","aria-hidden","true"],["d","M7 10l5 5 5-5z"],["cdk-connected-overlay","","cdkConnectedOverlayLockPosition","","cdkConnectedOverlayHasBackdrop","","cdkConnectedOverlayBackdropClass","cdk-overlay-transparent-backdrop",3,"cdkConnectedOverlayPanelClass","cdkConnectedOverlayScrollStrategy","cdkConnectedOverlayOrigin","cdkConnectedOverlayOpen","cdkConnectedOverlayPositions","cdkConnectedOverlayWidth","backdropClick","attach","detach"],[1,"mat-mdc-select-placeholder","mat-mdc-select-min-line"],[1,"mat-mdc-select-value-text",3,"ngSwitch"],["class","mat-mdc-select-min-line",4,"ngSwitchDefault"],[4,"ngSwitchCase"],[1,"mat-mdc-select-min-line"],["role","listbox","tabindex","-1",3,"ngClass","keydown"],["panel",""]],template:function(n,r){if(1&n&&(ze(pre),y(0,"div",0,1),$("click",function(){return r.toggle()}),y(3,"div",2),R(4,lre,2,1,"span",3),R(5,hre,3,2,"span",4),w(),y(6,"div",5)(7,"div",6),zs(),y(8,"svg",7),ie(9,"path",8),w()()()(),R(10,fre,3,9,"ng-template",9),$("backdropClick",function(){return r.close()})("attach",function(){return r._onAttached()})("detach",function(){return r.close()})),2&n){const o=hn(1);x(3),E("ngSwitch",r.empty),de("id",r._valueId),x(1),E("ngSwitchCase",!0),x(1),E("ngSwitchCase",!1),x(5),E("cdkConnectedOverlayPanelClass",r._overlayPanelClass)("cdkConnectedOverlayScrollStrategy",r._scrollStrategy)("cdkConnectedOverlayOrigin",r._preferredOverlayOrigin||o)("cdkConnectedOverlayOpen",r.panelOpen)("cdkConnectedOverlayPositions",r._positions)("cdkConnectedOverlayWidth",r._overlayWidth)}},dependencies:[Ea,Sa,Qh,GT,F1,ry],styles:['.mat-mdc-select{display:inline-block;width:100%;outline:none;-moz-osx-font-smoothing:grayscale;-webkit-font-smoothing:antialiased;color:var(--mat-select-enabled-trigger-text-color);font-family:var(--mat-select-trigger-text-font);line-height:var(--mat-select-trigger-text-line-height);font-size:var(--mat-select-trigger-text-size);font-weight:var(--mat-select-trigger-text-weight);letter-spacing:var(--mat-select-trigger-text-tracking)}.mat-mdc-select-disabled{color:var(--mat-select-disabled-trigger-text-color)}.mat-mdc-select-trigger{display:inline-flex;align-items:center;cursor:pointer;position:relative;box-sizing:border-box;width:100%}.mat-mdc-select-disabled .mat-mdc-select-trigger{-webkit-user-select:none;user-select:none;cursor:default}.mat-mdc-select-value{width:100%;overflow:hidden;text-overflow:ellipsis;white-space:nowrap}.mat-mdc-select-value-text{white-space:nowrap;overflow:hidden;text-overflow:ellipsis}.mat-mdc-select-arrow-wrapper{height:24px;flex-shrink:0;display:inline-flex;align-items:center}.mat-form-field-appearance-fill .mat-mdc-select-arrow-wrapper{transform:translateY(-8px)}.mat-form-field-appearance-fill .mdc-text-field--no-label .mat-mdc-select-arrow-wrapper{transform:none}.mat-mdc-select-arrow{width:10px;height:5px;position:relative;color:var(--mat-select-enabled-arrow-color)}.mat-mdc-form-field.mat-focused .mat-mdc-select-arrow{color:var(--mat-select-focused-arrow-color)}.mat-mdc-form-field .mat-mdc-select.mat-mdc-select-invalid .mat-mdc-select-arrow{color:var(--mat-select-invalid-arrow-color)}.mat-mdc-form-field .mat-mdc-select.mat-mdc-select-disabled .mat-mdc-select-arrow{color:var(--mat-select-disabled-arrow-color)}.mat-mdc-select-arrow svg{fill:currentColor;position:absolute;top:50%;left:50%;transform:translate(-50%, -50%)}.cdk-high-contrast-active .mat-mdc-select-arrow svg{fill:CanvasText}.mat-mdc-select-disabled .cdk-high-contrast-active .mat-mdc-select-arrow svg{fill:GrayText}div.mat-mdc-select-panel{box-shadow:0px 5px 5px -3px rgba(0, 0, 0, 0.2), 0px 8px 10px 1px rgba(0, 0, 0, 0.14), 0px 3px 14px 2px rgba(0, 0, 0, 0.12);width:100%;max-height:275px;outline:0;overflow:auto;padding:8px 0;border-radius:4px;box-sizing:border-box;position:static;background-color:var(--mat-select-panel-background-color)}.cdk-high-contrast-active div.mat-mdc-select-panel{outline:solid 1px}.cdk-overlay-pane:not(.mat-mdc-select-panel-above) div.mat-mdc-select-panel{border-top-left-radius:0;border-top-right-radius:0;transform-origin:top center}.mat-mdc-select-panel-above div.mat-mdc-select-panel{border-bottom-left-radius:0;border-bottom-right-radius:0;transform-origin:bottom center}.mat-mdc-select-placeholder{transition:color 400ms 133.3333333333ms cubic-bezier(0.25, 0.8, 0.25, 1);color:var(--mat-select-placeholder-text-color)}._mat-animation-noopable .mat-mdc-select-placeholder{transition:none}.mat-form-field-hide-placeholder .mat-mdc-select-placeholder{color:rgba(0,0,0,0);-webkit-text-fill-color:rgba(0,0,0,0);transition:none;display:block}.mat-mdc-form-field-type-mat-select:not(.mat-form-field-disabled) .mat-mdc-text-field-wrapper{cursor:pointer}.mat-mdc-form-field-type-mat-select.mat-form-field-appearance-fill .mat-mdc-floating-label{max-width:calc(100% - 18px)}.mat-mdc-form-field-type-mat-select.mat-form-field-appearance-fill .mdc-floating-label--float-above{max-width:calc(100% / 0.75 - 24px)}.mat-mdc-form-field-type-mat-select.mat-form-field-appearance-outline .mdc-notched-outline__notch{max-width:calc(100% - 60px)}.mat-mdc-form-field-type-mat-select.mat-form-field-appearance-outline .mdc-text-field--label-floating .mdc-notched-outline__notch{max-width:calc(100% - 24px)}.mat-mdc-select-min-line:empty::before{content:" ";white-space:pre;width:1px;display:inline-block;visibility:hidden}'],encapsulation:2,data:{animation:[gre.transformPanel]},changeDetection:0}),t})(),eN=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275mod=le({type:e}),e.\u0275inj=ae({providers:[vre],imports:[vn,ed,Yl,ve,lo,jd,Yl,ve]}),t})();const Ere=["tooltip"],tN=new M("mat-tooltip-scroll-strategy"),Tre={provide:tN,deps:[wi],useFactory:function kre(e){return()=>e.scrollStrategies.reposition({scrollThrottle:20})}},Ire=new M("mat-tooltip-default-options",{providedIn:"root",factory:function Mre(){return{showDelay:0,hideDelay:0,touchendHideDelay:1500}}}),nN="tooltip-panel",iN=ro({passive:!0});let Nre=(()=>{var e;class t{get position(){return this._position}set position(n){n!==this._position&&(this._position=n,this._overlayRef&&(this._updatePosition(this._overlayRef),this._tooltipInstance?.show(0),this._overlayRef.updatePosition()))}get positionAtOrigin(){return this._positionAtOrigin}set positionAtOrigin(n){this._positionAtOrigin=Q(n),this._detach(),this._overlayRef=null}get disabled(){return this._disabled}set disabled(n){this._disabled=Q(n),this._disabled?this.hide(0):this._setupPointerEnterEventsIfNeeded()}get showDelay(){return this._showDelay}set showDelay(n){this._showDelay=Bi(n)}get hideDelay(){return this._hideDelay}set hideDelay(n){this._hideDelay=Bi(n),this._tooltipInstance&&(this._tooltipInstance._mouseLeaveHideDelay=this._hideDelay)}get message(){return this._message}set message(n){this._ariaDescriber.removeDescription(this._elementRef.nativeElement,this._message,"tooltip"),this._message=null!=n?String(n).trim():"",!this._message&&this._isTooltipVisible()?this.hide(0):(this._setupPointerEnterEventsIfNeeded(),this._updateTooltipMessage(),this._ngZone.runOutsideAngular(()=>{Promise.resolve().then(()=>{this._ariaDescriber.describe(this._elementRef.nativeElement,this.message,"tooltip")})}))}get tooltipClass(){return this._tooltipClass}set tooltipClass(n){this._tooltipClass=n,this._tooltipInstance&&this._setTooltipClass(this._tooltipClass)}constructor(n,r,o,s,a,c,l,d,u,h,f,p){this._overlay=n,this._elementRef=r,this._scrollDispatcher=o,this._viewContainerRef=s,this._ngZone=a,this._platform=c,this._ariaDescriber=l,this._focusMonitor=d,this._dir=h,this._defaultOptions=f,this._position="below",this._positionAtOrigin=!1,this._disabled=!1,this._viewInitialized=!1,this._pointerExitEventsInitialized=!1,this._viewportMargin=8,this._cssClassPrefix="mat",this.touchGestures="auto",this._message="",this._passiveListeners=[],this._destroyed=new Y,this._scrollStrategy=u,this._document=p,f&&(this._showDelay=f.showDelay,this._hideDelay=f.hideDelay,f.position&&(this.position=f.position),f.positionAtOrigin&&(this.positionAtOrigin=f.positionAtOrigin),f.touchGestures&&(this.touchGestures=f.touchGestures)),h.change.pipe(ce(this._destroyed)).subscribe(()=>{this._overlayRef&&this._updatePosition(this._overlayRef)})}ngAfterViewInit(){this._viewInitialized=!0,this._setupPointerEnterEventsIfNeeded(),this._focusMonitor.monitor(this._elementRef).pipe(ce(this._destroyed)).subscribe(n=>{n?"keyboard"===n&&this._ngZone.run(()=>this.show()):this._ngZone.run(()=>this.hide(0))})}ngOnDestroy(){const n=this._elementRef.nativeElement;clearTimeout(this._touchstartTimeout),this._overlayRef&&(this._overlayRef.dispose(),this._tooltipInstance=null),this._passiveListeners.forEach(([r,o])=>{n.removeEventListener(r,o,iN)}),this._passiveListeners.length=0,this._destroyed.next(),this._destroyed.complete(),this._ariaDescriber.removeDescription(n,this.message,"tooltip"),this._focusMonitor.stopMonitoring(n)}show(n=this.showDelay,r){if(this.disabled||!this.message||this._isTooltipVisible())return void this._tooltipInstance?._cancelPendingAnimations();const o=this._createOverlay(r);this._detach(),this._portal=this._portal||new $f(this._tooltipComponent,this._viewContainerRef);const s=this._tooltipInstance=o.attach(this._portal).instance;s._triggerElement=this._elementRef.nativeElement,s._mouseLeaveHideDelay=this._hideDelay,s.afterHidden().pipe(ce(this._destroyed)).subscribe(()=>this._detach()),this._setTooltipClass(this._tooltipClass),this._updateTooltipMessage(),s.show(n)}hide(n=this.hideDelay){const r=this._tooltipInstance;r&&(r.isVisible()?r.hide(n):(r._cancelPendingAnimations(),this._detach()))}toggle(n){this._isTooltipVisible()?this.hide():this.show(void 0,n)}_isTooltipVisible(){return!!this._tooltipInstance&&this._tooltipInstance.isVisible()}_createOverlay(n){if(this._overlayRef){const s=this._overlayRef.getConfig().positionStrategy;if((!this.positionAtOrigin||!n)&&s._origin instanceof W)return this._overlayRef;this._detach()}const r=this._scrollDispatcher.getAncestorScrollContainers(this._elementRef),o=this._overlay.position().flexibleConnectedTo(this.positionAtOrigin&&n||this._elementRef).withTransformOriginOn(`.${this._cssClassPrefix}-tooltip`).withFlexibleDimensions(!1).withViewportMargin(this._viewportMargin).withScrollableContainers(r);return o.positionChanges.pipe(ce(this._destroyed)).subscribe(s=>{this._updateCurrentPositionClass(s.connectionPair),this._tooltipInstance&&s.scrollableViewProperties.isOverlayClipped&&this._tooltipInstance.isVisible()&&this._ngZone.run(()=>this.hide(0))}),this._overlayRef=this._overlay.create({direction:this._dir,positionStrategy:o,panelClass:`${this._cssClassPrefix}-${nN}`,scrollStrategy:this._scrollStrategy()}),this._updatePosition(this._overlayRef),this._overlayRef.detachments().pipe(ce(this._destroyed)).subscribe(()=>this._detach()),this._overlayRef.outsidePointerEvents().pipe(ce(this._destroyed)).subscribe(()=>this._tooltipInstance?._handleBodyInteraction()),this._overlayRef.keydownEvents().pipe(ce(this._destroyed)).subscribe(s=>{this._isTooltipVisible()&&27===s.keyCode&&!An(s)&&(s.preventDefault(),s.stopPropagation(),this._ngZone.run(()=>this.hide(0)))}),this._defaultOptions?.disableTooltipInteractivity&&this._overlayRef.addPanelClass(`${this._cssClassPrefix}-tooltip-panel-non-interactive`),this._overlayRef}_detach(){this._overlayRef&&this._overlayRef.hasAttached()&&this._overlayRef.detach(),this._tooltipInstance=null}_updatePosition(n){const r=n.getConfig().positionStrategy,o=this._getOrigin(),s=this._getOverlayPosition();r.withPositions([this._addOffset({...o.main,...s.main}),this._addOffset({...o.fallback,...s.fallback})])}_addOffset(n){return n}_getOrigin(){const n=!this._dir||"ltr"==this._dir.value,r=this.position;let o;"above"==r||"below"==r?o={originX:"center",originY:"above"==r?"top":"bottom"}:"before"==r||"left"==r&&n||"right"==r&&!n?o={originX:"start",originY:"center"}:("after"==r||"right"==r&&n||"left"==r&&!n)&&(o={originX:"end",originY:"center"});const{x:s,y:a}=this._invertPosition(o.originX,o.originY);return{main:o,fallback:{originX:s,originY:a}}}_getOverlayPosition(){const n=!this._dir||"ltr"==this._dir.value,r=this.position;let o;"above"==r?o={overlayX:"center",overlayY:"bottom"}:"below"==r?o={overlayX:"center",overlayY:"top"}:"before"==r||"left"==r&&n||"right"==r&&!n?o={overlayX:"end",overlayY:"center"}:("after"==r||"right"==r&&n||"left"==r&&!n)&&(o={overlayX:"start",overlayY:"center"});const{x:s,y:a}=this._invertPosition(o.overlayX,o.overlayY);return{main:o,fallback:{overlayX:s,overlayY:a}}}_updateTooltipMessage(){this._tooltipInstance&&(this._tooltipInstance.message=this.message,this._tooltipInstance._markForCheck(),this._ngZone.onMicrotaskEmpty.pipe(Xe(1),ce(this._destroyed)).subscribe(()=>{this._tooltipInstance&&this._overlayRef.updatePosition()}))}_setTooltipClass(n){this._tooltipInstance&&(this._tooltipInstance.tooltipClass=n,this._tooltipInstance._markForCheck())}_invertPosition(n,r){return"above"===this.position||"below"===this.position?"top"===r?r="bottom":"bottom"===r&&(r="top"):"end"===n?n="start":"start"===n&&(n="end"),{x:n,y:r}}_updateCurrentPositionClass(n){const{overlayY:r,originX:o,originY:s}=n;let a;if(a="center"===r?this._dir&&"rtl"===this._dir.value?"end"===o?"left":"right":"start"===o?"left":"right":"bottom"===r&&"top"===s?"above":"below",a!==this._currentPosition){const c=this._overlayRef;if(c){const l=`${this._cssClassPrefix}-${nN}-`;c.removePanelClass(l+this._currentPosition),c.addPanelClass(l+a)}this._currentPosition=a}}_setupPointerEnterEventsIfNeeded(){this._disabled||!this.message||!this._viewInitialized||this._passiveListeners.length||(this._platformSupportsMouseEvents()?this._passiveListeners.push(["mouseenter",n=>{let r;this._setupPointerExitEventsIfNeeded(),void 0!==n.x&&void 0!==n.y&&(r=n),this.show(void 0,r)}]):"off"!==this.touchGestures&&(this._disableNativeGesturesIfNecessary(),this._passiveListeners.push(["touchstart",n=>{const r=n.targetTouches?.[0],o=r?{x:r.clientX,y:r.clientY}:void 0;this._setupPointerExitEventsIfNeeded(),clearTimeout(this._touchstartTimeout),this._touchstartTimeout=setTimeout(()=>this.show(void 0,o),500)}])),this._addListeners(this._passiveListeners))}_setupPointerExitEventsIfNeeded(){if(this._pointerExitEventsInitialized)return;this._pointerExitEventsInitialized=!0;const n=[];if(this._platformSupportsMouseEvents())n.push(["mouseleave",r=>{const o=r.relatedTarget;(!o||!this._overlayRef?.overlayElement.contains(o))&&this.hide()}],["wheel",r=>this._wheelListener(r)]);else if("off"!==this.touchGestures){this._disableNativeGesturesIfNecessary();const r=()=>{clearTimeout(this._touchstartTimeout),this.hide(this._defaultOptions.touchendHideDelay)};n.push(["touchend",r],["touchcancel",r])}this._addListeners(n),this._passiveListeners.push(...n)}_addListeners(n){n.forEach(([r,o])=>{this._elementRef.nativeElement.addEventListener(r,o,iN)})}_platformSupportsMouseEvents(){return!this._platform.IOS&&!this._platform.ANDROID}_wheelListener(n){if(this._isTooltipVisible()){const r=this._document.elementFromPoint(n.clientX,n.clientY),o=this._elementRef.nativeElement;r!==o&&!o.contains(r)&&this.hide()}}_disableNativeGesturesIfNecessary(){const n=this.touchGestures;if("off"!==n){const r=this._elementRef.nativeElement,o=r.style;("on"===n||"INPUT"!==r.nodeName&&"TEXTAREA"!==r.nodeName)&&(o.userSelect=o.msUserSelect=o.webkitUserSelect=o.MozUserSelect="none"),("on"===n||!r.draggable)&&(o.webkitUserDrag="none"),o.touchAction="none",o.webkitTapHighlightColor="transparent"}}}return(e=t).\u0275fac=function(n){jo()},e.\u0275dir=T({type:e,inputs:{position:["matTooltipPosition","position"],positionAtOrigin:["matTooltipPositionAtOrigin","positionAtOrigin"],disabled:["matTooltipDisabled","disabled"],showDelay:["matTooltipShowDelay","showDelay"],hideDelay:["matTooltipHideDelay","hideDelay"],touchGestures:["matTooltipTouchGestures","touchGestures"],message:["matTooltip","message"],tooltipClass:["matTooltipClass","tooltipClass"]}}),t})(),Lre=(()=>{var e;class t extends Nre{constructor(n,r,o,s,a,c,l,d,u,h,f,p){super(n,r,o,s,a,c,l,d,u,h,f,p),this._tooltipComponent=Vre,this._cssClassPrefix="mat-mdc",this._viewportMargin=8}_addOffset(n){const o=!this._dir||"ltr"==this._dir.value;return"top"===n.originY?n.offsetY=-8:"bottom"===n.originY?n.offsetY=8:"start"===n.originX?n.offsetX=o?-8:8:"end"===n.originX&&(n.offsetX=o?8:-8),n}}return(e=t).\u0275fac=function(n){return new(n||e)(m(wi),m(W),m(Gf),m(pt),m(G),m(nt),m(k7),m(ar),m(tN),m(fn,8),m(Ire,8),m(he))},e.\u0275dir=T({type:e,selectors:[["","matTooltip",""]],hostAttrs:[1,"mat-mdc-tooltip-trigger"],hostVars:2,hostBindings:function(n,r){2&n&&se("mat-mdc-tooltip-disabled",r.disabled)},exportAs:["matTooltip"],features:[P]}),t})(),Bre=(()=>{var e;class t{constructor(n,r){this._changeDetectorRef=n,this._closeOnInteraction=!1,this._isVisible=!1,this._onHide=new Y,this._animationsDisabled="NoopAnimations"===r}show(n){null!=this._hideTimeoutId&&clearTimeout(this._hideTimeoutId),this._showTimeoutId=setTimeout(()=>{this._toggleVisibility(!0),this._showTimeoutId=void 0},n)}hide(n){null!=this._showTimeoutId&&clearTimeout(this._showTimeoutId),this._hideTimeoutId=setTimeout(()=>{this._toggleVisibility(!1),this._hideTimeoutId=void 0},n)}afterHidden(){return this._onHide}isVisible(){return this._isVisible}ngOnDestroy(){this._cancelPendingAnimations(),this._onHide.complete(),this._triggerElement=null}_handleBodyInteraction(){this._closeOnInteraction&&this.hide(0)}_markForCheck(){this._changeDetectorRef.markForCheck()}_handleMouseLeave({relatedTarget:n}){(!n||!this._triggerElement.contains(n))&&(this.isVisible()?this.hide(this._mouseLeaveHideDelay):this._finalizeAnimation(!1))}_onShow(){}_handleAnimationEnd({animationName:n}){(n===this._showAnimation||n===this._hideAnimation)&&this._finalizeAnimation(n===this._showAnimation)}_cancelPendingAnimations(){null!=this._showTimeoutId&&clearTimeout(this._showTimeoutId),null!=this._hideTimeoutId&&clearTimeout(this._hideTimeoutId),this._showTimeoutId=this._hideTimeoutId=void 0}_finalizeAnimation(n){n?this._closeOnInteraction=!0:this.isVisible()||this._onHide.next()}_toggleVisibility(n){const r=this._tooltip.nativeElement,o=this._showAnimation,s=this._hideAnimation;if(r.classList.remove(n?s:o),r.classList.add(n?o:s),this._isVisible=n,n&&!this._animationsDisabled&&"function"==typeof getComputedStyle){const a=getComputedStyle(r);("0s"===a.getPropertyValue("animation-duration")||"none"===a.getPropertyValue("animation-name"))&&(this._animationsDisabled=!0)}n&&this._onShow(),this._animationsDisabled&&(r.classList.add("_mat-animation-noopable"),this._finalizeAnimation(n))}}return(e=t).\u0275fac=function(n){return new(n||e)(m(Fe),m(_t,8))},e.\u0275dir=T({type:e}),t})(),Vre=(()=>{var e;class t extends Bre{constructor(n,r,o){super(n,o),this._elementRef=r,this._isMultiline=!1,this._showAnimation="mat-mdc-tooltip-show",this._hideAnimation="mat-mdc-tooltip-hide"}_onShow(){this._isMultiline=this._isTooltipMultiline(),this._markForCheck()}_isTooltipMultiline(){const n=this._elementRef.nativeElement.getBoundingClientRect();return n.height>24&&n.width>=200}}return(e=t).\u0275fac=function(n){return new(n||e)(m(Fe),m(W),m(_t,8))},e.\u0275cmp=fe({type:e,selectors:[["mat-tooltip-component"]],viewQuery:function(n,r){if(1&n&&ke(Ere,7),2&n){let o;H(o=z())&&(r._tooltip=o.first)}},hostAttrs:["aria-hidden","true"],hostVars:2,hostBindings:function(n,r){1&n&&$("mouseleave",function(s){return r._handleMouseLeave(s)}),2&n&&kn("zoom",r.isVisible()?1:null)},features:[P],decls:4,vars:4,consts:[[1,"mdc-tooltip","mdc-tooltip--shown","mat-mdc-tooltip",3,"ngClass","animationend"],["tooltip",""],[1,"mdc-tooltip__surface","mdc-tooltip__surface-animation"]],template:function(n,r){1&n&&(y(0,"div",0,1),$("animationend",function(s){return r._handleAnimationEnd(s)}),y(2,"div",2),A(3),w()()),2&n&&(se("mdc-tooltip--multiline",r._isMultiline),E("ngClass",r.tooltipClass),x(3),bt(r.message))},dependencies:[Ea],styles:['.mdc-tooltip__surface{word-break:break-all;word-break:var(--mdc-tooltip-word-break, normal);overflow-wrap:anywhere}.mdc-tooltip--showing-transition .mdc-tooltip__surface-animation{transition:opacity 150ms 0ms cubic-bezier(0, 0, 0.2, 1),transform 150ms 0ms cubic-bezier(0, 0, 0.2, 1)}.mdc-tooltip--hide-transition .mdc-tooltip__surface-animation{transition:opacity 75ms 0ms cubic-bezier(0.4, 0, 1, 1)}.mdc-tooltip{position:fixed;display:none;z-index:9}.mdc-tooltip-wrapper--rich{position:relative}.mdc-tooltip--shown,.mdc-tooltip--showing,.mdc-tooltip--hide{display:inline-flex}.mdc-tooltip--shown.mdc-tooltip--rich,.mdc-tooltip--showing.mdc-tooltip--rich,.mdc-tooltip--hide.mdc-tooltip--rich{display:inline-block;left:-320px;position:absolute}.mdc-tooltip__surface{line-height:16px;padding:4px 8px;min-width:40px;max-width:200px;min-height:24px;max-height:40vh;box-sizing:border-box;overflow:hidden;text-align:center}.mdc-tooltip__surface::before{position:absolute;box-sizing:border-box;width:100%;height:100%;top:0;left:0;border:1px solid rgba(0,0,0,0);border-radius:inherit;content:"";pointer-events:none}@media screen and (forced-colors: active){.mdc-tooltip__surface::before{border-color:CanvasText}}.mdc-tooltip--rich .mdc-tooltip__surface{align-items:flex-start;display:flex;flex-direction:column;min-height:24px;min-width:40px;max-width:320px;position:relative}.mdc-tooltip--multiline .mdc-tooltip__surface{text-align:left}[dir=rtl] .mdc-tooltip--multiline .mdc-tooltip__surface,.mdc-tooltip--multiline .mdc-tooltip__surface[dir=rtl]{text-align:right}.mdc-tooltip__surface .mdc-tooltip__title{margin:0 8px}.mdc-tooltip__surface .mdc-tooltip__content{max-width:calc(200px - (2 * 8px));margin:8px;text-align:left}[dir=rtl] .mdc-tooltip__surface .mdc-tooltip__content,.mdc-tooltip__surface .mdc-tooltip__content[dir=rtl]{text-align:right}.mdc-tooltip--rich .mdc-tooltip__surface .mdc-tooltip__content{max-width:calc(320px - (2 * 8px));align-self:stretch}.mdc-tooltip__surface .mdc-tooltip__content-link{text-decoration:none}.mdc-tooltip--rich-actions,.mdc-tooltip__content,.mdc-tooltip__title{z-index:1}.mdc-tooltip__surface-animation{opacity:0;transform:scale(0.8);will-change:transform,opacity}.mdc-tooltip--shown .mdc-tooltip__surface-animation{transform:scale(1);opacity:1}.mdc-tooltip--hide .mdc-tooltip__surface-animation{transform:scale(1)}.mdc-tooltip__caret-surface-top,.mdc-tooltip__caret-surface-bottom{position:absolute;height:24px;width:24px;transform:rotate(35deg) skewY(20deg) scaleX(0.9396926208)}.mdc-tooltip__caret-surface-top .mdc-elevation-overlay,.mdc-tooltip__caret-surface-bottom .mdc-elevation-overlay{width:100%;height:100%;top:0;left:0}.mdc-tooltip__caret-surface-bottom{box-shadow:0px 3px 1px -2px rgba(0, 0, 0, 0.2), 0px 2px 2px 0px rgba(0, 0, 0, 0.14), 0px 1px 5px 0px rgba(0, 0, 0, 0.12);outline:1px solid rgba(0,0,0,0);z-index:-1}@media screen and (forced-colors: active){.mdc-tooltip__caret-surface-bottom{outline-color:CanvasText}}.mat-mdc-tooltip{--mdc-plain-tooltip-container-shape:4px;--mdc-plain-tooltip-supporting-text-line-height:16px}.mat-mdc-tooltip .mdc-tooltip__surface{background-color:var(--mdc-plain-tooltip-container-color)}.mat-mdc-tooltip .mdc-tooltip__surface{border-radius:var(--mdc-plain-tooltip-container-shape)}.mat-mdc-tooltip .mdc-tooltip__caret-surface-top,.mat-mdc-tooltip .mdc-tooltip__caret-surface-bottom{border-radius:var(--mdc-plain-tooltip-container-shape)}.mat-mdc-tooltip .mdc-tooltip__surface{color:var(--mdc-plain-tooltip-supporting-text-color)}.mat-mdc-tooltip .mdc-tooltip__surface{font-family:var(--mdc-plain-tooltip-supporting-text-font);line-height:var(--mdc-plain-tooltip-supporting-text-line-height);font-size:var(--mdc-plain-tooltip-supporting-text-size);font-weight:var(--mdc-plain-tooltip-supporting-text-weight);letter-spacing:var(--mdc-plain-tooltip-supporting-text-tracking)}.mat-mdc-tooltip{position:relative;transform:scale(0)}.mat-mdc-tooltip::before{content:"";top:0;right:0;bottom:0;left:0;z-index:-1;position:absolute}.mat-mdc-tooltip-panel-below .mat-mdc-tooltip::before{top:-8px}.mat-mdc-tooltip-panel-above .mat-mdc-tooltip::before{bottom:-8px}.mat-mdc-tooltip-panel-right .mat-mdc-tooltip::before{left:-8px}.mat-mdc-tooltip-panel-left .mat-mdc-tooltip::before{right:-8px}.mat-mdc-tooltip._mat-animation-noopable{animation:none;transform:scale(1)}.mat-mdc-tooltip-panel-non-interactive{pointer-events:none}@keyframes mat-mdc-tooltip-show{0%{opacity:0;transform:scale(0.8)}100%{opacity:1;transform:scale(1)}}@keyframes mat-mdc-tooltip-hide{0%{opacity:1;transform:scale(1)}100%{opacity:0;transform:scale(0.8)}}.mat-mdc-tooltip-show{animation:mat-mdc-tooltip-show 150ms cubic-bezier(0, 0, 0.2, 1) forwards}.mat-mdc-tooltip-hide{animation:mat-mdc-tooltip-hide 75ms cubic-bezier(0.4, 0, 1, 1) forwards}'],encapsulation:2,changeDetection:0}),t})(),jre=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275mod=le({type:e}),e.\u0275inj=ae({providers:[Tre],imports:[$I,vn,ed,ve,ve,lo]}),t})();function Hre(e,t){if(1&e&&(y(0,"mat-option",9),A(1),w()),2&e){const i=t.$implicit;E("value",i),x(1),Ue(" ",i," ")}}let zre=(()=>{var e;class t{constructor(){this.pageIndex=0,this.pageSize=10,this.pageSizes=[10,20,50,100],this.pageLength=0,this.totalLength=null,this.totalLessThanOrEqual=!1,this.page=new U}get firstItemIndex(){return this.pageIndex*this.pageSize+1}get lastItemIndex(){return this.pageIndex*this.pageSize+this.pageLength}get hasTotalLength(){return"number"==typeof this.totalLength}get hasPreviousPage(){return this.pageIndex>0}get hasNextPage(){return this.firstItemIndex+this.pageSize<=this.totalLength}emitChange(){this.page.emit({pageIndex:this.pageIndex,pageSize:this.pageSize})}}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275cmp=fe({type:e,selectors:[["app-paginator"]],inputs:{pageIndex:["pageIndex","pageIndex",yb],pageSize:["pageSize","pageSize",yb],pageSizes:"pageSizes",pageLength:["pageLength","pageLength",yb],totalLength:"totalLength",totalLessThanOrEqual:"totalLessThanOrEqual"},outputs:{page:"page"},features:[C_],decls:21,vars:14,consts:[[1,"paginator"],[1,"field-items-per-page"],[3,"value","valueChange"],[3,"value",4,"ngFor","ngForOf"],[1,"paginator-description"],[1,"paginator-navigation"],["mat-icon-button","","matTooltip","First page",3,"disabled","click"],["mat-icon-button","","matTooltip","Previous page",3,"disabled","click"],["mat-icon-button","","matTooltip","Next page",3,"disabled","click"],[3,"value"]],template:function(n,r){1&n&&(y(0,"div",0)(1,"mat-form-field",1)(2,"mat-label"),A(3,"Items per page"),w(),y(4,"mat-select",2),$("valueChange",function(s){return r.pageSize=s,r.pageIndex=0,r.emitChange()}),R(5,Hre,2,2,"mat-option",3),w()(),y(6,"p",4),A(7),en(8,"number"),en(9,"number"),en(10,"number"),w(),y(11,"div",5)(12,"button",6),$("click",function(){return r.pageIndex=0,r.emitChange()}),y(13,"mat-icon"),A(14,"first_page"),w()(),y(15,"button",7),$("click",function(){return r.pageIndex=r.pageIndex-1,r.emitChange()}),y(16,"mat-icon"),A(17,"navigate_before"),w()(),y(18,"button",8),$("click",function(){return r.pageIndex=r.pageIndex+1,r.emitChange()}),y(19,"mat-icon"),A(20,"navigate_next"),w()()()()),2&n&&(x(4),E("value",r.pageSize),x(1),E("ngForOf",r.pageSizes),x(2),L_(" ",nn(8,8,r.firstItemIndex)," - ",nn(9,10,r.lastItemIndex),"",r.hasTotalLength?" of "+(r.totalLessThanOrEqual?"\u2264 ":"")+nn(10,12,r.totalLength):""," "),x(5),E("disabled",!r.hasPreviousPage),x(3),E("disabled",!r.hasPreviousPage),x(3),E("disabled",!r.hasNextPage))},dependencies:[Wh,QF,nw,Nf,Dre,Qv,qv,Lre,Pb],styles:[".paginator[_ngcontent-%COMP%] > *[_ngcontent-%COMP%]{display:inline-block;vertical-align:middle}.paginator[_ngcontent-%COMP%]   p[_ngcontent-%COMP%]{margin:0 20px}.paginator[_ngcontent-%COMP%]   .field-items-per-page[_ngcontent-%COMP%]{width:140px}.paginator[_ngcontent-%COMP%]     .mat-mdc-form-field-subscript-wrapper{display:none}"]}),t})();var Ure=xs(622);let $re=(()=>{class e{static transformOne(i,n){return Ure(i,n)}transform(i,n){return Array.isArray(i)?i.map(r=>e.transformOne(r,n)):e.transformOne(i,n)}}return e.\u0275fac=function(i){return new(i||e)},e.\u0275pipe=wn({name:"filesize",type:e,pure:!0}),e})(),qre=(()=>{class e{}return e.\u0275fac=function(i){return new(i||e)},e.\u0275mod=le({type:e}),e.\u0275inj=ae({}),e})();function Gre(e,t){if(1&e&&(y(0,"mat-radio-button",50)(1,"mat-icon"),A(2),w(),A(3),y(4,"small"),A(5),en(6,"number"),en(7,"async"),w()()),2&e){const i=t.$implicit,n=B();E("value",i.key),x(2),bt(i.value.icon),x(1),Ue(" ",i.value.plural," "),x(2),Uo("",n.isDeepFiltered?"\u2264 ":"","",nn(6,5,nn(7,7,n.search.contentTypeCount(i.key))),"")}}function Wre(e,t){if(1&e){const i=Ut();y(0,"mat-checkbox",55),$("change",function(r){const s=Ye(i).$implicit,a=B(2).$implicit,c=B();return r.checked||a.isEmpty()?a.select(s.value):a.deselect(s.value),Ke(c.loadResult())}),A(1),y(2,"small"),A(3),en(4,"number"),w()()}if(2&e){const i=t.$implicit,n=B(2).$implicit,r=B();kn("display","block"),E("checked",n.isEmpty()||n.isSelected(i.value))("color","accent"),x(1),Ue(" ",i.label," "),x(2),Uo("",r.isDeepFiltered?"\u2264 ":"","",nn(4,7,i.count),"")}}function Qre(e,t){1&e&&(y(0,"span",56),A(1,"No aggregation results"),w())}function Yre(e,t){if(1&e){const i=Ut();y(0,"mat-expansion-panel",52),$("opened",function(){Ye(i);const r=B().$implicit,o=B();return r.activate(),Ke(o.loadResult())})("closed",function(){Ye(i);const r=B().$implicit,o=B();return r.deactivateAndReset(),Ke(o.loadResult())}),y(1,"mat-expansion-panel-header")(2,"mat-panel-title")(3,"mat-icon"),A(4),w(),A(5),w()(),y(6,"section"),R(7,Wre,5,9,"mat-checkbox",53),R(8,Qre,2,0,"span",54),en(9,"async"),w()()}if(2&e){const i=B().$implicit,n=B();E("expanded",i.isActive()),x(4),bt(i.icon),x(1),Ue(" ",i.name,""),x(1),bh(i.isEmpty()?"empty":"active"),x(1),E("ngForOf",i.aggregations),x(1),E("ngIf",!(nn(9,7,n.search.loading$)||null!=i.aggregations&&i.aggregations.length))}}function Kre(e,t){if(1&e&&(Ht(0),R(1,Yre,10,9,"mat-expansion-panel",51),zt()),2&e){const i=t.$implicit,n=B();x(1),E("ngIf",i.isRelevant(n.contentType.value))}}function Xre(e,t){if(1&e){const i=Ut();y(0,"button",57),$("click",function(){Ye(i);const r=B();return r.queryString.reset(),r.search.setQueryString(""),r.search.firstPage(),Ke(r.search.loadResult())}),y(1,"mat-icon"),A(2,"close"),w()()}}function Zre(e,t){1&e&&(y(0,"mat-icon"),A(1,"sell"),w(),A(2," Edit tags "))}function Jre(e,t){if(1&e){const i=Ut();y(0,"mat-chip-row",58),$("edited",function(r){const s=Ye(i).$implicit;return Ke(B().renameTag(s,r.value))})("removed",function(){const o=Ye(i).$implicit;return Ke(B().deleteTag(o))}),A(1),y(2,"button",59)(3,"mat-icon"),A(4,"cancel"),w()()()}if(2&e){const i=t.$implicit;E("editable",!0)("aria-description","press enter to edit"),x(1),Ue(" ",i," "),x(1),de("aria-label","remove "+i)}}function eoe(e,t){if(1&e&&(y(0,"mat-option",50),A(1),w()),2&e){const i=t.$implicit;E("value",i),x(1),bt(i)}}function toe(e,t){1&e&&(y(0,"mat-icon"),A(1,"delete_forever"),w(),A(2," Delete "))}function noe(e,t){1&e&&(y(0,"mat-icon",60),A(1,"close"),w())}function ioe(e,t){1&e&&(y(0,"mat-tab"),R(1,noe,2,0,"ng-template",18),w())}function roe(e,t){1&e&&ie(0,"mat-progress-bar",61)}function ooe(e,t){if(1&e){const i=Ut();y(0,"th",62)(1,"mat-checkbox",63),$("change",function(){return Ye(i),Ke(B().toggleAllRows())}),w()()}if(2&e){const i=B();x(1),E("checked",i.selectedItems.hasValue()&&i.isAllSelected())("indeterminate",i.selectedItems.hasValue()&&!i.isAllSelected())("aria-label",i.checkboxLabel())}}function soe(e,t){if(1&e){const i=Ut();y(0,"td",64)(1,"mat-checkbox",65),$("click",function(r){return r.stopPropagation()})("change",function(r){const s=Ye(i).$implicit,a=B();return Ke(r?a.selectedItems.toggle(s):null)}),w()()}if(2&e){const i=t.$implicit,n=B();x(1),E("checked",n.selectedItems.isSelected(i))("aria-label",n.checkboxLabel(i))}}function aoe(e,t){1&e&&(y(0,"th",62),A(1,"Summary"),w())}function coe(e,t){if(1&e&&(y(0,"mat-chip",69),A(1),w()),2&e){const i=t.$implicit;x(1),Ue(" ",i," ")}}function loe(e,t){if(1&e&&(Ht(0),A(1),zt()),2&e){const i=t.$implicit,n=t.index;x(1),Uo(" ",n>0?", ":"","",i.name," ")}}function doe(e,t){if(1&e&&(y(0,"mat-chip"),R(1,loe,2,2,"ng-container",7),w()),2&e){const i=t.$implicit;x(1),E("ngForOf",i)}}function uoe(e,t){if(1&e&&(y(0,"mat-chip"),A(1),w()),2&e){const i=t.ngIf;x(1),bt(i)}}function hoe(e,t){if(1&e&&(y(0,"mat-chip"),A(1),w()),2&e){const i=t.ngIf;x(1),bt(i)}}function foe(e,t){if(1&e&&(y(0,"mat-chip"),A(1),w()),2&e){const i=t.ngIf;x(1),bt(i)}}function poe(e,t){if(1&e&&(y(0,"mat-chip"),A(1),w()),2&e){const i=t.ngIf;x(1),bt(i)}}function moe(e,t){if(1&e&&(y(0,"mat-chip"),A(1),w()),2&e){const i=t.ngIf;x(1),bt(i)}}function goe(e,t){if(1&e){const i=Ut();y(0,"td",66),$("click",function(r){const s=Ye(i).$implicit;return B().expandedItem.toggle(s.id),Ke(r.stopPropagation())}),y(1,"mat-icon"),A(2),w(),y(3,"span",67),A(4),w(),y(5,"mat-chip-set"),R(6,coe,2,1,"mat-chip",68),R(7,doe,2,1,"mat-chip",31),R(8,uoe,2,1,"mat-chip",31),R(9,hoe,2,1,"mat-chip",31),R(10,foe,2,1,"mat-chip",31),R(11,poe,2,1,"mat-chip",31),R(12,moe,2,1,"mat-chip",31),w()()}if(2&e){const i=t.$implicit,n=B();let r,o;x(1),de("title",null!==(r=null==(r=n.search.contentTypeInfo(i.contentType))?null:r.singular)&&void 0!==r?r:"Unknown"),x(1),bt(null!==(o=null==(o=n.search.contentTypeInfo(i.contentType))?null:o.icon)&&void 0!==o?o:"question_mark"),x(2),bt(n.item(i).title),x(2),E("ngForOf",n.item(i).torrent.tagNames),x(1),E("ngIf",n.item(i).languages),x(1),E("ngIf",null==n.item(i).video3d?null:n.item(i).video3d.slice(1)),x(1),E("ngIf",null==n.item(i).videoResolution?null:n.item(i).videoResolution.slice(1)),x(1),E("ngIf",n.item(i).videoSource),x(1),E("ngIf",n.item(i).videoCodec),x(1),E("ngIf",n.item(i).videoModifier)}}function _oe(e,t){1&e&&(y(0,"th",62),A(1,"Size"),w())}function boe(e,t){if(1&e&&(y(0,"td",64),A(1),en(2,"filesize"),w()),2&e){const i=t.$implicit,n=B();x(1),Ue(" ",nn(2,1,n.item(i).torrent.size)," ")}}function voe(e,t){1&e&&(y(0,"th",62)(1,"abbr",70),A(2,"S / L"),w()())}function yoe(e,t){if(1&e&&(y(0,"td",64),A(1),w()),2&e){const i=t.$implicit,n=B();let r;x(1),Uo(" ",null!==(r=n.item(i).torrent.seeders)&&void 0!==r?r:"?"," / ",null!==(r=n.item(i).torrent.leechers)&&void 0!==r?r:"?"," ")}}function woe(e,t){1&e&&(y(0,"th",71),A(1," Magnet "),w())}function xoe(e,t){if(1&e&&(y(0,"td",64)(1,"a",72),ie(2,"mat-icon",73),w()()),2&e){const i=t.$implicit,n=B();x(1),A_("href",n.item(i).torrent.magnetUri,tl)}}function Coe(e,t){1&e&&ie(0,"img",81),2&e&&E("src","https://image.tmdb.org/t/p/w300/"+t.ngIf,tl)}function Doe(e,t){if(1&e&&(y(0,"span"),A(1),w()),2&e){const i=t.$implicit,n=t.index;x(1),bt((n>0?", ":"")+i.name)}}function Eoe(e,t){if(1&e&&(y(0,"p")(1,"strong"),A(2,"Title:"),w(),A(3),w()),2&e){const i=B().$implicit,n=B();x(3),Ue(" ",null==n.item(i).content?null:n.item(i).content.title," ")}}function Soe(e,t){if(1&e&&(Ht(0),A(1),zt()),2&e){const i=t.$implicit,n=t.index,r=B(2).$implicit,o=B();x(1),Ue(" ",(n>0?", ":"")+i.name+(i.id===(null==o.item(r).content||null==o.item(r).content.originalLanguage?null:o.item(r).content.originalLanguage.id)?" (original)":"")," ")}}function koe(e,t){if(1&e&&(y(0,"p")(1,"strong"),A(2,"Language:"),w(),A(3,"\xa0"),R(4,Soe,2,1,"ng-container",7),w()),2&e){const i=B().$implicit,n=B();x(4),E("ngForOf",n.item(i).languages)}}function Toe(e,t){if(1&e&&(y(0,"p")(1,"strong"),A(2,"Original release date:"),w(),A(3),w()),2&e){const i=B().$implicit,n=B();let r;x(3),Ue(" ",null!==(r=null==n.item(i).content?null:n.item(i).content.releaseDate)&&void 0!==r?r:null==n.item(i).content?null:n.item(i).content.releaseYear," ")}}function Moe(e,t){if(1&e&&(y(0,"p")(1,"strong"),A(2,"Episodes:"),w(),A(3),w()),2&e){const i=B().$implicit,n=B();x(3),Ue(" ",n.item(i).episodes.label," ")}}function Ioe(e,t){if(1&e&&(y(0,"p"),A(1),w()),2&e){const i=B().$implicit,n=B();x(1),Ue(" ",n.item(i).content.overview," ")}}function Aoe(e,t){if(1&e&&(Ht(0),y(1,"p")(2,"strong"),A(3,"Genres:"),w(),A(4),w(),zt()),2&e){const i=t.$implicit;x(4),Ue(" ",i.join(", "),"")}}function Roe(e,t){if(1&e&&(Ht(0),A(1),zt()),2&e){const i=B(2).$implicit,n=B();x(1),Ue("(",null==n.item(i).content?null:n.item(i).content.voteCount," votes)")}}function Ooe(e,t){if(1&e&&(y(0,"p")(1,"strong"),A(2,"Rating:"),w(),A(3),R(4,Roe,2,1,"ng-container",31),w()),2&e){const i=B().$implicit,n=B();x(3),Ue(" ",null==n.item(i).content?null:n.item(i).content.voteAverage," / 10 "),x(1),E("ngIf",null!=(null==n.item(i).content?null:n.item(i).content.voteCount))}}function Foe(e,t){if(1&e&&(Ht(0),A(1),y(2,"a",82),A(3),w(),zt()),2&e){const i=t.$implicit,n=t.index;x(1),Ue(" ",n>0?", ":"",""),x(1),E("href",i.url,tl),x(1),bt(i.metadataSource.name)}}function Poe(e,t){if(1&e&&(y(0,"p")(1,"strong"),A(2,"External links:"),w(),A(3,"\xa0 "),R(4,Foe,4,3,"ng-container",7),w()),2&e){const i=t.$implicit;x(4),E("ngForOf",i)}}function Noe(e,t){1&e&&(y(0,"mat-icon"),A(1,"file_present"),w(),A(2," Files "))}function Loe(e,t){1&e&&(y(0,"p"),A(1," No file information available. "),w())}function Boe(e,t){1&e&&(y(0,"p"),A(1," Files information was not saved as the number of files is over the configured threshold. "),w())}function Voe(e,t){if(1&e&&(y(0,"span")(1,"strong"),A(2,"File type: "),w(),A(3),ie(4,"br"),w()),2&e){const i=t.$implicit;x(3),Ue(" ",i.charAt(0).toUpperCase()+i.slice(1),"")}}function joe(e,t){if(1&e&&(y(0,"p")(1,"strong"),A(2,"Single file:"),w(),A(3),ie(4,"br"),R(5,Voe,5,1,"span",31),y(6,"strong"),A(7,"File size:"),w(),A(8),en(9,"filesize"),w()),2&e){const i=B(2).$implicit,n=B();x(3),Ue(" ",n.item(i).torrent.name,""),x(2),E("ngIf",n.item(i).torrent.fileType),x(3),Ue(" ",nn(9,3,n.item(i).torrent.size)," ")}}function Hoe(e,t){if(1&e&&(y(0,"tr")(1,"td",84),A(2),w(),y(3,"td"),A(4),w(),y(5,"td",85),A(6),en(7,"filesize"),w()()),2&e){const i=t.$implicit;x(2),Ue(" ",i.path," "),x(2),Ue(" ",i.fileType?i.fileType.charAt(0).toUpperCase()+i.fileType.slice(1):"Unknown"," "),x(2),Ue(" ",nn(7,3,i.size)," ")}}function zoe(e,t){if(1&e&&(y(0,"table")(1,"thead")(2,"tr")(3,"th"),A(4,"Path"),w(),y(5,"th"),A(6,"Type"),w(),y(7,"th"),A(8,"Size"),w()()(),y(9,"tbody"),R(10,Hoe,8,5,"tr",7),w()()),2&e){const i=B(2).$implicit,n=B();x(10),E("ngForOf",n.item(i).torrent.files)}}function Uoe(e,t){if(1&e&&(y(0,"mat-card",83),R(1,Loe,2,0,"p",31),R(2,Boe,2,0,"p",31),R(3,joe,10,5,"p",31),R(4,zoe,11,1,"table",31),w()),2&e){const i=B().$implicit,n=B();x(1),E("ngIf","no_info"===n.item(i).torrent.filesStatus),x(1),E("ngIf","over_threshold"===n.item(i).torrent.filesStatus),x(1),E("ngIf","single"===n.item(i).torrent.filesStatus),x(1),E("ngIf",null==n.item(i).torrent.files?null:n.item(i).torrent.files.length)}}function $oe(e,t){1&e&&(y(0,"mat-icon"),A(1,"sell"),w(),A(2," Edit tags "))}function qoe(e,t){if(1&e){const i=Ut();y(0,"mat-chip-row",58),$("edited",function(r){const s=Ye(i).$implicit;return Ke(B(3).expandedItem.renameTag(s,r.value))})("removed",function(){const o=Ye(i).$implicit;return Ke(B(3).expandedItem.deleteTag(o))}),A(1),y(2,"button",59)(3,"mat-icon"),A(4,"cancel"),w()()()}if(2&e){const i=t.$implicit;E("editable",!0)("aria-description","press enter to edit"),x(1),Ue(" ",i," "),x(1),de("aria-label","remove "+i)}}function Goe(e,t){if(1&e&&(y(0,"mat-option",50),A(1),w()),2&e){const i=t.$implicit;E("value",i),x(1),bt(i)}}function Woe(e,t){if(1&e){const i=Ut();y(0,"mat-card")(1,"mat-form-field",19)(2,"mat-chip-grid",20,21),R(4,qoe,5,4,"mat-chip-row",22),w(),y(5,"input",86),$("matChipInputTokenEnd",function(r){Ye(i);const o=B(2);return Ke(r.value&&o.expandedItem.addTag(r.value))}),w(),y(6,"mat-autocomplete",24,25),$("optionSelected",function(r){return Ye(i),Ke(B(2).expandedItem.addTag(r.option.viewValue))}),R(8,Goe,2,2,"mat-option",6),w()()()}if(2&e){const i=hn(3),n=hn(7),r=B().$implicit,o=B();x(4),E("ngForOf",o.item(r).torrent.tagNames),x(1),E("formControl",o.expandedItem.newTagCtrl)("matAutocomplete",n)("matChipInputFor",i)("matChipInputSeparatorKeyCodes",o.separatorKeysCodes)("value",o.expandedItem.newTagCtrl.value),x(3),E("ngForOf",o.expandedItem.suggestedTags)}}function Qoe(e,t){1&e&&(y(0,"mat-icon"),A(1,"delete_forever"),w(),A(2," Delete "))}function Yoe(e,t){if(1&e){const i=Ut();y(0,"mat-card")(1,"mat-card-content",87)(2,"p")(3,"strong"),A(4,"Are you sure you want to delete this torrent?"),w(),ie(5,"br"),A(6,"This action cannot be undone. "),w()(),y(7,"mat-card-actions",26)(8,"button",88),$("click",function(){return Ye(i),Ke(B(2).expandedItem.delete())}),y(9,"mat-icon"),A(10,"delete_forever"),w(),A(11,"Delete "),w()()()}}function Koe(e,t){1&e&&(y(0,"mat-icon",60),A(1,"close"),w())}function Xoe(e,t){1&e&&(y(0,"mat-tab"),R(1,Koe,2,0,"ng-template",18),w())}function Zoe(e,t){if(1&e){const i=Ut();y(0,"td",64)(1,"div",74),R(2,Coe,1,1,"img",75),y(3,"h2"),A(4),w(),y(5,"p")(6,"strong"),A(7,"Info hash:"),w(),y(8,"span",76),A(9),w()(),y(10,"p")(11,"strong"),A(12,"Source:"),w(),A(13,"\xa0"),R(14,Doe,2,1,"span",7),w(),R(15,Eoe,4,1,"p",31),R(16,koe,5,1,"p",31),R(17,Toe,4,1,"p",31),R(18,Moe,4,1,"p",31),R(19,Ioe,2,1,"p",31),R(20,Aoe,5,1,"ng-container",31),R(21,Ooe,5,2,"p",31),R(22,Poe,5,1,"p",31),ie(23,"mat-divider",77),y(24,"mat-tab-group",78),$("focusChange",function(r){return Ye(i),Ke(B().expandedItem.selectTab(4==r.index?0:r.index))}),ie(25,"mat-tab",79),y(26,"mat-tab"),R(27,Noe,3,0,"ng-template",18),R(28,Uoe,5,4,"mat-card",80),w(),y(29,"mat-tab"),R(30,$oe,3,0,"ng-template",18),R(31,Woe,9,7,"mat-card",31),w(),y(32,"mat-tab"),R(33,Qoe,3,0,"ng-template",18),R(34,Yoe,12,0,"mat-card",31),w(),R(35,Xoe,2,0,"mat-tab",31),w()()()}if(2&e){const i=t.$implicit,n=B();de("colspan",n.displayedColumns.length),x(1),E("@detailExpand",n.expandedItem.id===i.id?"expanded":"collapsed"),x(1),E("ngIf",n.getAttribute(n.item(i),"poster_path","tmdb")),x(2),bt(n.item(i).torrent.name),x(4),E("cdkCopyToClipboard",n.item(i).infoHash),x(1),bt(n.item(i).infoHash),x(5),E("ngForOf",n.item(i).torrent.sources),x(1),E("ngIf",n.item(i).content),x(1),E("ngIf",null==n.item(i).languages?null:n.item(i).languages.length),x(1),E("ngIf",null==n.item(i).content?null:n.item(i).content.releaseYear),x(1),E("ngIf",n.item(i).episodes),x(1),E("ngIf",null==n.item(i).content?null:n.item(i).content.overview),x(1),E("ngIf",n.getCollections(i,"genre")),x(1),E("ngIf",null!=(null==n.item(i).content?null:n.item(i).content.voteAverage)),x(1),E("ngIf",null==n.item(i).content?null:n.item(i).content.externalLinks),x(2),E("selectedIndex",n.expandedItem.selectedTabIndex)("mat-stretch-tabs",!1),x(1),E("aria-labelledby","hidden"),x(3),E("ngIf",n.expandedItem.id===i.id),x(3),E("ngIf",n.expandedItem.id===i.id),x(3),E("ngIf",n.expandedItem.id===i.id),x(1),E("ngIf",n.expandedItem.selectedTabIndex>0)}}function Joe(e,t){1&e&&ie(0,"tr",89)}function ese(e,t){if(1&e&&ie(0,"tr",90),2&e){const i=t.$implicit,n=B();bh("summary-row "+(i.id===n.expandedItem.id?"expanded":"collapsed"))}}function tse(e,t){1&e&&ie(0,"tr",91)}const nse=function(){return["expandedDetail"]};let ise=(()=>{var e;class t{constructor(n,r){this.graphQLService=n,this.errorsService=r,this.search=new gK(this.graphQLService,this.errorsService),this.displayedColumns=["select","summary","size","peers","magnet"],this.queryString=new Ka(""),this.items=Array(),this.contentType=new Ka(void 0),this.separatorKeysCodes=[13,188],this.selectedItems=new qR(!0,[]),this.selectedTabIndex=0,this.newTagCtrl=new Ka(""),this.editedTags=Array(),this.suggestedTags=Array(),this.expandedItem=new class{constructor(o){this.ds=o,this.itemSubject=new dt(void 0),this.newTagCtrl=new Ka(""),this.editedTags=Array(),this.suggestedTags=Array(),this.selectedTabIndex=0,o.search.items$.subscribe(s=>{const a=this.itemSubject.getValue();if(!a)return;const c=s.find(l=>l.id===a.id);this.editedTags=c?.torrent.tagNames??[],this.itemSubject.next(c)}),this.newTagCtrl.valueChanges.subscribe(s=>(s&&(s=rN(s),this.newTagCtrl.setValue(s,{emitEvent:!1})),o.graphQLService.torrentSuggestTags({query:{prefix:s,exclusions:this.itemSubject.getValue()?.torrent.tagNames}}).pipe(it(a=>{this.suggestedTags.splice(0,this.suggestedTags.length,...a.suggestions.map(c=>c.name))})).subscribe()))}get id(){return this.itemSubject.getValue()?.id}toggle(o){o===this.id&&(o=void 0);const s=this.ds.items.find(c=>c.id===o);this.itemSubject.getValue()?.id!==o&&(this.itemSubject.next(s),this.editedTags=s?.torrent.tagNames??[],this.newTagCtrl.reset(),this.selectedTabIndex=0)}selectTab(o){this.selectedTabIndex=o}addTag(o){this.editTags(s=>[...s,o]),this.saveTags()}renameTag(o,s){this.editTags(a=>a.map(c=>c===o?s:c)),this.saveTags()}deleteTag(o){this.editTags(s=>s.filter(a=>a!==o)),this.saveTags()}editTags(o){this.itemSubject.getValue()&&(this.editedTags=o(this.editedTags),this.newTagCtrl.reset())}saveTags(){const o=this.itemSubject.getValue();o&&this.ds.graphQLService.torrentSetTags({infoHashes:[o.infoHash],tagNames:this.editedTags}).pipe(Rn(s=>(this.ds.errorsService.addError(`Error saving tags: ${s.message}`),Rt))).pipe(it(()=>{this.editedTags=[],this.ds.search.loadResult(!1)})).subscribe()}delete(){const o=this.itemSubject.getValue();o&&this.ds.deleteTorrents([o.infoHash])}}(this),this.search.items$.subscribe(o=>{this.items=o,this.selectedItems.setSelection(...o.filter(({id:s})=>this.selectedItems.selected.some(({id:a})=>a===s)))})}ngAfterContentInit(){this.loadResult()}ngAfterViewInit(){this.contentType.valueChanges.subscribe(n=>{this.search.selectContentType(n)}),this.newTagCtrl.valueChanges.subscribe(n=>{n&&this.newTagCtrl.setValue(rN(n),{emitEvent:!1}),this.updateSuggestedTags()}),this.updateSuggestedTags()}get isDeepFiltered(){return this.search.isDeepFiltered}loadResult(n=!0){this.search.loadResult(n)}item(n){return n}originalOrder(){return 0}getAttribute(n,r,o){return n.content?.attributes?.find(s=>s.key===r&&(void 0===o||s.source===o))?.value}getCollections(n,r){const o=n.content?.collections?.filter(s=>s.type===r).map(s=>s.name);return o?.length?o.sort():void 0}isAllSelected(){return this.items.every(n=>this.selectedItems.isSelected(n))}toggleAllRows(){this.isAllSelected()?this.selectedItems.clear():this.selectedItems.select(...this.items)}checkboxLabel(n){return n?`${this.selectedItems.isSelected(n)?"deselect":"select"} ${n.torrent.name}`:(this.isAllSelected()?"deselect":"select")+" all"}selectTab(n){this.selectedTabIndex=n}addTag(n){this.editedTags.includes(n)||this.editedTags.push(n),this.newTagCtrl.reset(),this.updateSuggestedTags()}deleteTag(n){this.editedTags=this.editedTags.filter(r=>r!==n),this.updateSuggestedTags()}renameTag(n,r){this.editedTags=this.editedTags.map(o=>o===n?r:o),this.updateSuggestedTags()}putTags(){const n=this.selectedItems.selected.map(r=>r.infoHash);if(n.length)return this.newTagCtrl.value&&this.addTag(this.newTagCtrl.value),this.graphQLService.torrentPutTags({infoHashes:n,tagNames:this.editedTags}).pipe(Rn(r=>(this.errorsService.addError(`Error putting tags: ${r.message}`),Rt))).pipe(it(()=>{this.search.loadResult(!1)})).subscribe()}setTags(){const n=this.selectedItems.selected.map(r=>r.infoHash);if(n.length)return this.newTagCtrl.value&&this.addTag(this.newTagCtrl.value),this.graphQLService.torrentSetTags({infoHashes:n,tagNames:this.editedTags}).pipe(Rn(r=>(this.errorsService.addError(`Error setting tags: ${r.message}`),Rt))).pipe(it(()=>{this.search.loadResult(!1)})).subscribe()}deleteTags(){const n=this.selectedItems.selected.map(r=>r.infoHash);if(n.length)return this.newTagCtrl.value&&this.addTag(this.newTagCtrl.value),this.graphQLService.torrentDeleteTags({infoHashes:n,tagNames:this.editedTags}).pipe(Rn(r=>(this.errorsService.addError(`Error deleting tags: ${r.message}`),Rt))).pipe(it(()=>{this.search.loadResult(!1)})).subscribe()}updateSuggestedTags(){return this.graphQLService.torrentSuggestTags({query:{prefix:this.newTagCtrl.value,exclusions:this.editedTags}}).pipe(it(n=>{this.suggestedTags.splice(0,this.suggestedTags.length,...n.suggestions.map(r=>r.name))})).subscribe()}selectedInfoHashes(){return this.selectedItems.selected.map(n=>n.infoHash)}deleteTorrents(n){this.graphQLService.torrentDelete({infoHashes:n}).pipe(Rn(r=>(this.errorsService.addError(`Error deleting torrents: ${r.message}`),Rt))).pipe(it(()=>{this.search.loadResult(!1)})).subscribe()}}return(e=t).\u0275fac=function(n){return new(n||e)(m(OF),m(LF))},e.\u0275cmp=fe({type:e,selectors:[["app-torrent-content"]],decls:101,vars:60,consts:[[1,"example-container"],["opened","",3,"mode"],["drawer",""],[1,"panel-content-type",3,"expanded"],[3,"formControl"],["fontSet","material-icons"],[3,"value",4,"ngFor","ngForOf"],[4,"ngFor","ngForOf"],[1,"results"],[1,"search-form"],["fontSet","material-icons",3,"click"],[1,"field-search-query"],["matInput","","placeholder","Search",3,"formControl","keyup.enter"],["matSuffix","","mat-icon-button","","aria-label","Clear",3,"click",4,"ngIf"],[1,"button-refresh"],["mat-mini-fab","","title","Refresh results","color","primary",3,"click"],["animationDuration","0",1,"tab-group-bulk-actions",3,"selectedIndex","mat-stretch-tabs","focusChange"],[1,"bulk-tab-placeholder",3,"aria-labelledby"],["mat-tab-label",""],[1,"form-edit-tags"],["aria-label","Enter tags"],["chipGrid",""],[3,"editable","aria-description","edited","removed",4,"ngFor","ngForOf"],["placeholder","Tag...",3,"formControl","matAutocomplete","matChipInputFor","matChipInputSeparatorKeyCodes","value","matChipInputTokenEnd"],[3,"optionSelected"],["auto","matAutocomplete"],[1,"button-row"],["mat-stroked-button","","color","primary","title","Replace tags of the selected torrents",3,"disabled","click"],["mat-stroked-button","","color","primary","title","Add tags to the selected torrents",3,"disabled","click"],["mat-stroked-button","","color","primary","title","Remove tags from the selected torrents",3,"disabled","click"],["mat-stroked-button","","color","warn",3,"disabled","click"],[4,"ngIf"],[1,"progress-bar-container",2,"height","10px"],["mode","indeterminate",4,"ngIf"],["mat-table","",1,"table-results",3,"dataSource","multiTemplateDataRows"],["matColumnDef","select"],["mat-header-cell","",4,"matHeaderCellDef"],["mat-cell","",4,"matCellDef"],["matColumnDef","summary"],["mat-cell","",3,"click",4,"matCellDef"],["matColumnDef","size"],["matColumnDef","peers"],["matColumnDef","magnet"],["mat-header-cell","","style","text-align: center",4,"matHeaderCellDef"],["matColumnDef","expandedDetail"],["mat-header-row","",4,"matHeaderRowDef"],["mat-row","",3,"class",4,"matRowDef","matRowDefColumns"],["mat-row","","class","expanded-detail-row",4,"matRowDef","matRowDefColumns"],[1,"spacer"],[3,"pageIndex","pageSize","pageLength","totalLength","totalLessThanOrEqual","page"],[3,"value"],[3,"expanded","opened","closed",4,"ngIf"],[3,"expanded","opened","closed"],[3,"checked","color","display","change",4,"ngFor","ngForOf"],["class","empty",4,"ngIf"],[3,"checked","color","change"],[1,"empty"],["matSuffix","","mat-icon-button","","aria-label","Clear",3,"click"],[3,"editable","aria-description","edited","removed"],["matChipRemove",""],[2,"margin-right","0"],["mode","indeterminate"],["mat-header-cell",""],[3,"checked","indeterminate","aria-label","change"],["mat-cell",""],[3,"checked","aria-label","click","change"],["mat-cell","",3,"click"],[1,"title"],["class","chip-primary",4,"ngFor","ngForOf"],[1,"chip-primary"],["title","Seeders / Leechers"],["mat-header-cell","",2,"text-align","center"],[3,"href"],["svgIcon","magnet"],[1,"item-detail"],["class","poster",3,"src",4,"ngIf"],["title","Copy to clipboard",1,"info-hash",3,"cdkCopyToClipboard"],[2,"clear","both"],["animationDuration","0",3,"selectedIndex","mat-stretch-tabs","focusChange"],[3,"aria-labelledby"],["class","torrent-files",4,"ngIf"],[1,"poster",3,"src"],["target","_blank",3,"href"],[1,"torrent-files"],[1,"table-torrent-files-td-file"],[1,"table-torrent-files-td-size"],["placeholder","New tag...",3,"formControl","matAutocomplete","matChipInputFor","matChipInputSeparatorKeyCodes","value","matChipInputTokenEnd"],[2,"margin-top","10px"],["mat-stroked-button","","color","warn",3,"click"],["mat-header-row",""],["mat-row",""],["mat-row","",1,"expanded-detail-row"]],template:function(n,r){if(1&n){const o=Ut();y(0,"mat-drawer-container",0)(1,"mat-drawer",1,2)(3,"mat-expansion-panel",3)(4,"mat-expansion-panel-header")(5,"mat-panel-title")(6,"mat-icon"),A(7,"interests"),w(),A(8," Content Type "),w()(),y(9,"section")(10,"mat-radio-group",4)(11,"mat-radio-button")(12,"mat-icon",5),A(13,"emergency"),w(),A(14,"All "),y(15,"small"),A(16),en(17,"number"),en(18,"async"),w()(),R(19,Gre,8,9,"mat-radio-button",6),en(20,"keyvalue"),w()()(),R(21,Kre,2,1,"ng-container",7),w(),y(22,"mat-drawer-content")(23,"div",8)(24,"div",9)(25,"mat-icon",10),$("click",function(){return Ye(o),Ke(hn(2).toggle())}),A(26),w(),y(27,"mat-form-field",11)(28,"input",12),$("keyup.enter",function(){let a;return r.search.setQueryString(null!==(a=r.queryString.value)&&void 0!==a?a:""),r.search.firstPage(),r.search.loadResult()}),w(),R(29,Xre,3,0,"button",13),w(),y(30,"div",14)(31,"button",15),$("click",function(){return r.loadResult(!1)}),y(32,"mat-icon"),A(33,"sync"),w()()()(),ie(34,"mat-divider"),y(35,"mat-tab-group",16),$("focusChange",function(a){return r.selectTab(3==a.index?0:a.index)}),ie(36,"mat-tab",17),y(37,"mat-tab"),R(38,Zre,3,0,"ng-template",18),y(39,"mat-card")(40,"mat-form-field",19)(41,"mat-chip-grid",20,21),R(43,Jre,5,4,"mat-chip-row",22),w(),y(44,"input",23),$("matChipInputTokenEnd",function(a){return a.value&&r.addTag(a.value)}),w(),y(45,"mat-autocomplete",24,25),$("optionSelected",function(a){return r.addTag(a.option.viewValue)}),R(47,eoe,2,2,"mat-option",6),w()(),y(48,"mat-card-actions",26)(49,"button",27),$("click",function(){return r.setTags()}),A(50," Set tags "),w(),y(51,"button",28),$("click",function(){return r.putTags()}),A(52," Put tags "),w(),y(53,"button",29),$("click",function(){return r.deleteTags()}),A(54," Delete tags "),w()()()(),y(55,"mat-tab"),R(56,toe,3,0,"ng-template",18),y(57,"mat-card")(58,"mat-card-content")(59,"p")(60,"strong"),A(61,"Are you sure you want to delete the selected torrents?"),w(),ie(62,"br"),A(63,"This action cannot be undone. "),w()(),y(64,"mat-card-actions",26)(65,"button",30),$("click",function(){return r.deleteTorrents(r.selectedInfoHashes())}),y(66,"mat-icon"),A(67,"delete_forever"),w(),A(68,"Delete "),w()()()(),R(69,ioe,2,0,"mat-tab",31),w(),ie(70,"mat-divider"),y(71,"div",32),R(72,roe,1,0,"mat-progress-bar",33),en(73,"async"),w(),y(74,"table",34),Ht(75,35),R(76,ooe,2,3,"th",36),R(77,soe,2,2,"td",37),zt(),Ht(78,38),R(79,aoe,2,0,"th",36),R(80,goe,13,10,"td",39),zt(),Ht(81,40),R(82,_oe,2,0,"th",36),R(83,boe,3,3,"td",37),zt(),Ht(84,41),R(85,voe,3,0,"th",36),R(86,yoe,2,2,"td",37),zt(),Ht(87,42),R(88,woe,2,0,"th",43),R(89,xoe,3,1,"td",37),zt(),Ht(90,44),R(91,Zoe,36,22,"td",37),zt(),R(92,Joe,1,0,"tr",45),R(93,ese,1,2,"tr",46),R(94,tse,1,0,"tr",47),w(),ie(95,"span",48),y(96,"app-paginator",49),$("page",function(a){return r.search.handlePageEvent(a)}),en(97,"async"),en(98,"async"),en(99,"async"),en(100,"async"),w()()()()}if(2&n){const o=hn(2),s=hn(42),a=hn(46);x(1),E("mode","side"),x(2),E("expanded",!0),x(7),E("formControl",r.contentType),x(6),Uo("",r.isDeepFiltered?"\u2264 ":"","",nn(17,42,nn(18,44,r.search.overallTotalCount$)),""),x(3),E("ngForOf",function gk(e,t,i,n){const r=e+Oe,o=F(),s=Vs(o,r);return El(o,r)?hk(o,Dn(),t,s.transform,i,n,s):s.transform(i,n)}(20,46,r.search.contentTypes,r.originalOrder)),x(2),E("ngForOf",r.search.facets),x(1),kn("z-index",100)("overflow","visible"),x(3),bh("toggle-drawer "+(o.opened?"opened":"closed")),x(1),bt(o.opened?"arrow_circle_left":"arrow_circle_right"),x(2),E("formControl",r.queryString),x(1),E("ngIf",r.queryString.value),x(6),E("selectedIndex",r.selectedTabIndex)("mat-stretch-tabs",!1),x(1),E("aria-labelledby","hidden"),x(7),E("ngForOf",r.editedTags),x(1),E("formControl",r.newTagCtrl)("matAutocomplete",a)("matChipInputFor",s)("matChipInputSeparatorKeyCodes",r.separatorKeysCodes)("value",r.newTagCtrl.value),x(3),E("ngForOf",r.suggestedTags),x(2),E("disabled",!r.selectedItems.hasValue()),x(2),E("disabled",!r.selectedItems.hasValue()||!r.editedTags.length&&!r.newTagCtrl.value),x(2),E("disabled",!r.selectedItems.hasValue()||!r.editedTags.length&&!r.newTagCtrl.value),x(12),E("disabled",!r.selectedItems.hasValue()),x(4),E("ngIf",r.selectedTabIndex>0),x(3),E("ngIf",nn(73,49,r.search.loading$)),x(2),E("dataSource",r.search)("multiTemplateDataRows",!0),x(18),E("matHeaderRowDef",r.displayedColumns),x(1),E("matRowDefColumns",r.displayedColumns),x(1),E("matRowDefColumns",function ck(e,t,i){const n=Dn()+e,r=F();return r[n]===De?tr(r,n,i?t.call(i):t()):function fl(e,t){return e[t]}(r,n)}(59,nse)),x(2),E("pageIndex",nn(97,51,r.search.pageIndex$))("pageSize",nn(98,53,r.search.pageSize$))("pageLength",nn(99,55,r.search.pageLength$))("totalLength",nn(100,57,r.search.maxTotalCount$))("totalLessThanOrEqual",r.search.isDeepFiltered)}},dependencies:[Wh,_i,nee,Xee,Nf,XF,g1,Qv,LG,ZF,eP,JF,iP,vs,hP,fP,lP,aw,em,Jte,yP,mne,gne,QF,Nee,qv,Dne,Mne,SP,kP,IP,AP,tm,LP,vw,BP,yw,bw,VP,ww,xw,jP,HP,qP,YP,rre,zre,pp,fR,qy,YT,Pb,KT,$re],styles:[".mat-drawer-container[_ngcontent-%COMP%]{min-height:100%}.mat-drawer.mat-drawer-side[_ngcontent-%COMP%]{width:300px}.mat-drawer.mat-drawer-side[_ngcontent-%COMP%]   h4[_ngcontent-%COMP%]{margin-bottom:0}.mat-drawer.mat-drawer-side[_ngcontent-%COMP%]   .panel-content-type[_ngcontent-%COMP%]   mat-radio-button[_ngcontent-%COMP%]{width:100%;display:block}.mat-drawer.mat-drawer-side[_ngcontent-%COMP%]   .panel-content-type[_ngcontent-%COMP%]   mat-radio-button[_ngcontent-%COMP%]     .mdc-radio{display:none}.mat-drawer.mat-drawer-side[_ngcontent-%COMP%]   .panel-content-type[_ngcontent-%COMP%]   mat-radio-button[_ngcontent-%COMP%]     .mdc-form-field, .mat-drawer.mat-drawer-side[_ngcontent-%COMP%]   .panel-content-type[_ngcontent-%COMP%]   mat-radio-button[_ngcontent-%COMP%]     label{cursor:pointer;display:block;height:40px}.mat-drawer.mat-drawer-side[_ngcontent-%COMP%]   .panel-content-type[_ngcontent-%COMP%]   mat-radio-button[_ngcontent-%COMP%]   mat-icon[_ngcontent-%COMP%]{margin-right:10px;position:relative;top:5px}.mat-drawer.mat-drawer-side[_ngcontent-%COMP%]   .panel-content-type[_ngcontent-%COMP%]     .mat-mdc-radio-checked label{color:#e91e63}.mat-drawer.mat-drawer-side[_ngcontent-%COMP%]   section[_ngcontent-%COMP%]{margin-bottom:10px}.mat-drawer.mat-drawer-side[_ngcontent-%COMP%]   section[_ngcontent-%COMP%]   mat-checkbox[_ngcontent-%COMP%]{position:relative;left:-10px}.mat-drawer.mat-drawer-side[_ngcontent-%COMP%]   section.empty[_ngcontent-%COMP%]     .mdc-checkbox__background{background-color:#d3d3d3;border-color:#d3d3d3}  mat-checkbox label small,   mat-radio-button label small{margin-left:8px}.mat-expansion-panel-header[_ngcontent-%COMP%]{white-space:nowrap}.mat-expansion-panel-header[_ngcontent-%COMP%]   .mat-icon[_ngcontent-%COMP%]{overflow:visible;margin-right:20px}.search-form[_ngcontent-%COMP%]   .toggle-drawer[_ngcontent-%COMP%]{cursor:pointer;width:30px;height:30px;font-size:30px;left:-10px;margin-right:10px;position:relative;top:-25px;display:inline-block}.search-form[_ngcontent-%COMP%]   mat-form-field[_ngcontent-%COMP%]{display:inline-block;margin-right:20px}.search-form[_ngcontent-%COMP%]   mat-form-field.field-search-query[_ngcontent-%COMP%]{width:300px}.search-form[_ngcontent-%COMP%]   mat-form-field.field-auto-refresh[_ngcontent-%COMP%]{width:130px}.search-form[_ngcontent-%COMP%]   .button-refresh[_ngcontent-%COMP%]{display:inline-block;width:50px;vertical-align:top;padding-top:10px}.mat-column-select[_ngcontent-%COMP%]{padding-right:10px;width:30px}.mat-column-summary[_ngcontent-%COMP%]{padding-left:0}.mat-column-summary[_ngcontent-%COMP%]   button[_ngcontent-%COMP%]{padding-left:0;padding-right:0}th.mat-column-summary[_ngcontent-%COMP%]{padding-left:10px}td.mat-column-summary[_ngcontent-%COMP%]{vertical-align:middle;cursor:pointer}td.mat-column-summary[_ngcontent-%COMP%]   .title[_ngcontent-%COMP%]{display:inline-block;line-height:30px;word-wrap:break-word;max-width:900px}td.mat-column-summary[_ngcontent-%COMP%]   .mat-icon[_ngcontent-%COMP%]{display:inline-block;position:relative;top:5px;margin-right:10px}td.mat-column-summary[_ngcontent-%COMP%]   mat-chip-set[_ngcontent-%COMP%]{display:inline-block;margin-left:30px;position:relative;top:-2px;margin-bottom:4px}td.mat-column-summary[_ngcontent-%COMP%]   mat-chip-set[_ngcontent-%COMP%]   mat-chip[_ngcontent-%COMP%]{margin:0 10px 0 0}tr.expanded-detail-row[_ngcontent-%COMP%]{height:0}tr.mat-mdc-row.expanded[_ngcontent-%COMP%]   td.mat-column-summary[_ngcontent-%COMP%]   mat-icon[_ngcontent-%COMP%]{color:#e91e63}tr.mat-mdc-row.expanded[_ngcontent-%COMP%]   td[_ngcontent-%COMP%]{border-bottom:0}tr.mat-mdc-row.expanded[_ngcontent-%COMP%] + .expanded-detail-row[_ngcontent-%COMP%] > td[_ngcontent-%COMP%]{padding-bottom:10px}.mat-mdc-row.summary-row[_ngcontent-%COMP%]:hover   .mat-mdc-cell[_ngcontent-%COMP%]{background-color:#f5f5f5}.mat-mdc-row.summary-row[_ngcontent-%COMP%]:hover + tr.expanded-detail-row[_ngcontent-%COMP%]{background-color:#f5f5f5}.mat-column-magnet[_ngcontent-%COMP%]{text-align:center}.mat-column-magnet[_ngcontent-%COMP%]   .mat-icon[_ngcontent-%COMP%]{position:relative;top:5px}.item-detail[_ngcontent-%COMP%]{width:100%;overflow:hidden}.item-detail[_ngcontent-%COMP%]   h2[_ngcontent-%COMP%]{margin-top:10px;max-width:900px;word-wrap:break-word}.item-detail[_ngcontent-%COMP%]   .poster[_ngcontent-%COMP%]{float:right;margin:10px;border:1px solid currentColor}.item-detail[_ngcontent-%COMP%]   .info-hash[_ngcontent-%COMP%]{padding-left:5px;cursor:crosshair;text-decoration:underline;text-decoration-style:dotted}  .mdc-tab__text-label mat-icon{margin-right:10px}  div[aria-labelledby=hidden]{display:none}.results[_ngcontent-%COMP%]{padding-top:20px}.form-edit-tags[_ngcontent-%COMP%]{width:100%}.mat-mdc-standard-chip[_ngcontent-%COMP%]:not(.mdc-evolution-chip--disabled).chip-primary{background-color:#c5cae9}.mat-mdc-standard-chip[_ngcontent-%COMP%]:not(.mdc-evolution-chip--disabled).chip-primary   .mat-mdc-standard-chip[_ngcontent-%COMP%]:not(.mdc-evolution-chip--disabled).mdc-evolution-chip__text-label{color:#fff}.torrent-files[_ngcontent-%COMP%]{padding-top:10px;max-height:800px;overflow:scroll}.torrent-files[_ngcontent-%COMP%]   table[_ngcontent-%COMP%]{margin-bottom:10px;width:800px}.torrent-files[_ngcontent-%COMP%]   td[_ngcontent-%COMP%]{padding-right:20px;border-bottom:1px solid rgba(0,0,0,.12)}.torrent-files[_ngcontent-%COMP%]   tr[_ngcontent-%COMP%]:hover   td[_ngcontent-%COMP%]{background-color:#f5f5f5}.button-row[_ngcontent-%COMP%]   .mat-mdc-button-base[_ngcontent-%COMP%]{margin-left:8px}.form-edit-tags[_ngcontent-%COMP%]     .mat-mdc-form-field-subscript-wrapper{display:none}app-paginator[_ngcontent-%COMP%]{float:right;margin-top:10px;margin-bottom:20px}"],data:{animation:[ni("detailExpand",[qt("collapsed",je({height:"0px",minHeight:"0"})),qt("expanded",je({height:"*"})),Bt("expanded <=> collapsed",Lt("225ms cubic-bezier(0.4, 0.0, 0.2, 1)"))])]}}),t})();const rN=e=>e.toLowerCase().replaceAll(/[^a-z0-9\-]/g,"-").replace(/^-+/,"").replaceAll(/-+/g,"-");let rse=(()=>{var e;class t{constructor(){this.title="bitmagnet"}}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275cmp=fe({type:e,selectors:[["app-root"]],decls:19,vars:3,consts:[["color","primary"],["svgIcon","magnet",2,"position","relative","top","1px"],[2,"margin-left","10px"],[1,"spacer"],["mat-icon-button","","aria-label","Menu",3,"matMenuTriggerFor"],["menu","matMenu"],["mat-menu-item","","href","https://bitmagnet.io","target","_blank"],["mat-menu-item","","href","https://discord.gg/6mFNszX8qM","target","_blank"],["mat-menu-item","","href","https://github.com/bitmagnet-io/bitmagnet","target","_blank"]],template:function(n,r){if(1&n&&(y(0,"p")(1,"mat-toolbar",0),ie(2,"mat-icon",1),y(3,"span",2),A(4,"bitmagnet"),w(),ie(5,"span",3),y(6,"button",4)(7,"mat-icon"),A(8,"menu"),w()(),y(9,"mat-menu",null,5)(11,"a",6),A(12,"bitmagnet.io"),w(),y(13,"a",7),A(14,"bitmagnet on Discord"),w(),y(15,"a",8),A(16,"bitmagnet on GitHub"),w()()()(),ie(17,"app-torrent-content")(18,"router-outlet")),2&n){const o=hn(10);kn("margin-bottom",0),x(6),E("matMenuTriggerFor",o)}},dependencies:[wy,Qv,qv,U9,Wf,W9,zG,ise],styles:[".spacer[_ngcontent-%COMP%]{display:inline-flex;flex:1 1 auto}svg[_ngcontent-%COMP%]   .fill[_ngcontent-%COMP%]{fill:#3f51b5}  .mat-mdc-snack-bar-container.snack-bar-error>div{background-color:#f44336;color:#fff}  .mat-mdc-snack-bar-container.snack-bar-error>div .mdc-button__label{color:#fff}"]}),t})();class ose extends tc{constructor(t,i){super(),wt(this,"httpClient",void 0),wt(this,"options",void 0),wt(this,"requester",void 0),wt(this,"print",yO),this.httpClient=t,this.options=i,this.options.operationPrinter&&(this.print=this.options.operationPrinter),this.requester=n=>new vt(r=>{const o=n.getContext(),s=(v,C)=>function ys(...e){const t=e.find(i=>typeof i<"u");return typeof t>"u"?e[e.length-1]:t}(o[v],this.options[v],C);let a=s("method","POST");const c=s("includeQuery",!0),l=s("includeExtensions",!1),d=s("uri","graphql"),u=s("withCredentials"),h=s("useMultipart"),f=!0===this.options.useGETForQueries,p=n.query.definitions.some(v=>"OperationDefinition"===v.kind&&"query"===v.operation);f&&p&&(a="GET");const g={method:a,url:"function"==typeof d?d(n):d,body:{operationName:n.operationName,variables:n.variables},options:{withCredentials:u,useMultipart:h,headers:this.options.headers}};l&&(g.body.extensions=n.extensions),c&&(g.body.query=this.print(n.query));const b=function aN(e){let t=e.headers&&e.headers instanceof bi?e.headers:new bi(e.headers);if(e.clientAwareness){const{name:i,version:n}=e.clientAwareness;i&&!t.has("apollographql-client-name")&&(t=t.set("apollographql-client-name",i)),n&&!t.has("apollographql-client-version")&&(t=t.set("apollographql-client-version",n))}return t}(o);g.options.headers=((e,t)=>e&&t?t.keys().reduce((n,r)=>n.set(r,t.getAll(r)),e):t||e)(g.options.headers,b);const _=((e,t,i)=>{const n=-1!==["POST","PUT","PATCH"].indexOf(e.method.toUpperCase()),o=e.body.length;let a,s=e.options&&e.options.useMultipart;if(s){if(o)return new Me(l=>l.error(new Error("File upload is not available when combined with Batching")));if(!n)return new Me(l=>l.error(new Error("File upload is not available when GET is used")));if(!i)return new Me(l=>l.error(new Error('To use File upload you need to pass "extractFiles" function from "extract-files" library to HttpLink\'s options')));a=i(e.body),s=!!a.files.size}let c={};if(o){if(!n)return new Me(l=>l.error(new Error("Batching is not available for GET requests")));c={body:e.body}}else c=n?{body:s?a.clone:e.body}:{params:Object.keys(e.body).reduce((u,h)=>{const f=e.body[h];return u[h]=-1!==["variables","extensions"].indexOf(h.toLowerCase())?JSON.stringify(f):f,u},{})};if(s&&n){const l=new FormData;l.append("operations",JSON.stringify(c.body));const d={},u=a.files;let h=0;u.forEach(f=>{d[++h]=f}),l.append("map",JSON.stringify(d)),h=0,u.forEach((f,p)=>{l.append(++h+"",p,p.name)}),c.body=l}return t.request(e.method,e.url,{observe:"response",responseType:"json",reportProgress:!1,...c,...e.options})})(g,this.httpClient,this.options.extractFiles).subscribe({next:v=>{n.setContext({response:v}),r.next(v.body)},error:v=>r.error(v),complete:()=>r.complete()});return()=>{_.closed||_.unsubscribe()}})}request(t){return this.requester(t)}}let sse=(()=>{var e;class t{constructor(n){wt(this,"httpClient",void 0),this.httpClient=n}create(n){return new ose(this.httpClient,n)}}return e=t,wt(t,"\u0275fac",function(n){return new(n||e)(D(nf))}),wt(t,"\u0275prov",V({token:e,factory:e.\u0275fac,providedIn:"root"})),t})();var ase=function(){function e(){this.assumeImmutableResults=!1,this.getFragmentDoc=zp(AK)}return e.prototype.batch=function(t){var r,i=this;return this.performTransaction(function(){return r=t.update(i)},"string"==typeof t.optimistic?t.optimistic:!1===t.optimistic?null:void 0),r},e.prototype.recordOptimisticTransaction=function(t,i){this.performTransaction(t,i)},e.prototype.transformDocument=function(t){return t},e.prototype.transformForLink=function(t){return t},e.prototype.identify=function(t){},e.prototype.gc=function(){return[]},e.prototype.modify=function(t){return!1},e.prototype.readQuery=function(t,i){return void 0===i&&(i=!!t.optimistic),this.read(k(k({},t),{rootId:t.id||"ROOT_QUERY",optimistic:i}))},e.prototype.readFragment=function(t,i){return void 0===i&&(i=!!t.optimistic),this.read(k(k({},t),{query:this.getFragmentDoc(t.fragment,t.fragmentName),rootId:t.id,optimistic:i}))},e.prototype.writeQuery=function(t){var i=t.id,n=t.data,r=si(t,["id","data"]);return this.write(Object.assign(r,{dataId:i||"ROOT_QUERY",result:n}))},e.prototype.writeFragment=function(t){var i=t.id,n=t.data,r=t.fragment,o=t.fragmentName,s=si(t,["id","data","fragment","fragmentName"]);return this.write(Object.assign(s,{query:this.getFragmentDoc(r,o),dataId:i,result:n}))},e.prototype.updateQuery=function(t,i){return this.batch({update:function(n){var r=n.readQuery(t),o=i(r);return null==o?r:(n.writeQuery(k(k({},t),{data:o})),o)}})},e.prototype.updateFragment=function(t,i){return this.batch({update:function(n){var r=n.readFragment(t),o=i(r);return null==o?r:(n.writeFragment(k(k({},t),{data:o})),o)}})},e}(),cN=function(e){function t(i,n,r,o){var s,a=e.call(this,i)||this;if(a.message=i,a.path=n,a.query=r,a.variables=o,Array.isArray(a.path)){a.missing=a.message;for(var c=a.path.length-1;c>=0;--c)a.missing=((s={})[a.path[c]]=a.missing,s)}else a.missing=a.path;return a.__proto__=t.prototype,a}return Ln(t,e),t}(Error);function Dw(e){return!1!==globalThis.__DEV__&&function cse(e){var t=new Set([e]);return t.forEach(function(i){xt(i)&&function lse(e){if(!1!==globalThis.__DEV__&&!Object.isFrozen(e))try{Object.freeze(e)}catch(t){if(t instanceof TypeError)return null;throw t}return e}(i)===i&&Object.getOwnPropertyNames(i).forEach(function(n){xt(i[n])&&t.add(i[n])})}),e}(e),e}var on=Object.prototype.hasOwnProperty;function Ud(e){return null==e}function lN(e,t){var i=e.__typename,n=e.id,r=e._id;if("string"==typeof i&&(t&&(t.keyObject=Ud(n)?Ud(r)?void 0:{_id:r}:{id:n}),Ud(n)&&!Ud(r)&&(n=r),!Ud(n)))return"".concat(i,":").concat("number"==typeof n||"string"==typeof n?n:JSON.stringify(n))}var dN={dataIdFromObject:lN,addTypename:!0,resultCaching:!0,canonizeResults:!1};function uN(e){var t=e.canonizeResults;return void 0===t?dN.canonizeResults:t}var hN=/^[_a-z][_0-9a-z]*/i;function yo(e){var t=e.match(hN);return t?t[0]:e}function Ew(e,t,i){return!!xt(t)&&(Mt(t)?t.every(function(n){return Ew(e,n,i)}):e.selections.every(function(n){if(go(n)&&Ad(n,i)){var r=mo(n);return on.call(t,r)&&(!n.selectionSet||Ew(n.selectionSet,t[r],i))}return!0}))}function gc(e){return xt(e)&&!ot(e)&&!Mt(e)}function fN(e,t){var i=Ip(Op(e));return{fragmentMap:i,lookupFragment:function(n){var r=i[n];return!r&&t&&(r=t.lookup(n)),r||null}}}var hm=Object.create(null),Sw=function(){return hm},pN=Object.create(null),$d=function(){function e(t,i){var n=this;this.policies=t,this.group=i,this.data=Object.create(null),this.rootIds=Object.create(null),this.refs=Object.create(null),this.getFieldValue=function(r,o){return Dw(ot(r)?n.get(r.__ref,o):r&&r[o])},this.canRead=function(r){return ot(r)?n.has(r.__ref):"object"==typeof r},this.toReference=function(r,o){if("string"==typeof r)return Ja(r);if(ot(r))return r;var s=n.policies.identify(r)[0];if(s){var a=Ja(s);return o&&n.merge(s,r),a}}}return e.prototype.toObject=function(){return k({},this.data)},e.prototype.has=function(t){return void 0!==this.lookup(t,!0)},e.prototype.get=function(t,i){if(this.group.depend(t,i),on.call(this.data,t)){var n=this.data[t];if(n&&on.call(n,i))return n[i]}return"__typename"===i&&on.call(this.policies.rootTypenamesById,t)?this.policies.rootTypenamesById[t]:this instanceof wo?this.parent.get(t,i):void 0},e.prototype.lookup=function(t,i){return i&&this.group.depend(t,"__exists"),on.call(this.data,t)?this.data[t]:this instanceof wo?this.parent.lookup(t,i):this.policies.rootTypenamesById[t]?Object.create(null):void 0},e.prototype.merge=function(t,i){var r,n=this;ot(t)&&(t=t.__ref),ot(i)&&(i=i.__ref);var o="string"==typeof t?this.lookup(r=t):t,s="string"==typeof i?this.lookup(r=i):i;if(s){ye("string"==typeof r,1);var a=new _o(pse).merge(o,s);if(this.data[r]=a,a!==o&&(delete this.refs[r],this.group.caching)){var c=Object.create(null);o||(c.__exists=1),Object.keys(s).forEach(function(l){if(!o||o[l]!==a[l]){c[l]=1;var d=yo(l);d!==l&&!n.policies.hasKeyArgs(a.__typename,d)&&(c[d]=1),void 0===a[l]&&!(n instanceof wo)&&delete a[l]}}),c.__typename&&!(o&&o.__typename)&&this.policies.rootTypenamesById[r]===a.__typename&&delete c.__typename,Object.keys(c).forEach(function(l){return n.group.dirty(r,l)})}}},e.prototype.modify=function(t,i){var n=this,r=this.lookup(t);if(r){var o=Object.create(null),s=!1,a=!0,c={DELETE:hm,INVALIDATE:pN,isReference:ot,toReference:this.toReference,canRead:this.canRead,readField:function(l,d){return n.policies.readField("string"==typeof l?{fieldName:l,from:d||Ja(t)}:l,{store:n})}};if(Object.keys(r).forEach(function(l){var d=yo(l),u=r[l];if(void 0!==u){var h="function"==typeof i?i:i[l]||i[d];if(h){var f=h===Sw?hm:h(Dw(u),k(k({},c),{fieldName:d,storeFieldName:l,storage:n.getStorage(t,l)}));f===pN?n.group.dirty(t,l):(f===hm&&(f=void 0),f!==u&&(o[l]=f,s=!0,u=f))}void 0!==u&&(a=!1)}}),s)return this.merge(t,o),a&&(this instanceof wo?this.data[t]=void 0:delete this.data[t],this.group.dirty(t,"__exists")),!0}return!1},e.prototype.delete=function(t,i,n){var r,o=this.lookup(t);if(o){var s=this.getFieldValue(o,"__typename"),a=i&&n?this.policies.getStoreFieldName({typename:s,fieldName:i,args:n}):i;return this.modify(t,a?((r={})[a]=Sw,r):Sw)}return!1},e.prototype.evict=function(t,i){var n=!1;return t.id&&(on.call(this.data,t.id)&&(n=this.delete(t.id,t.fieldName,t.args)),this instanceof wo&&this!==i&&(n=this.parent.evict(t,i)||n),(t.fieldName||n)&&this.group.dirty(t.id,t.fieldName||"__exists")),n},e.prototype.clear=function(){this.replace(null)},e.prototype.extract=function(){var t=this,i=this.toObject(),n=[];return this.getRootIdSet().forEach(function(r){on.call(t.policies.rootTypenamesById,r)||n.push(r)}),n.length&&(i.__META={extraRootIds:n.sort()}),i},e.prototype.replace=function(t){var i=this;if(Object.keys(this.data).forEach(function(o){t&&on.call(t,o)||i.delete(o)}),t){var n=t.__META,r=si(t,["__META"]);Object.keys(r).forEach(function(o){i.merge(o,r[o])}),n&&n.extraRootIds.forEach(this.retain,this)}},e.prototype.retain=function(t){return this.rootIds[t]=(this.rootIds[t]||0)+1},e.prototype.release=function(t){if(this.rootIds[t]>0){var i=--this.rootIds[t];return i||delete this.rootIds[t],i}return 0},e.prototype.getRootIdSet=function(t){return void 0===t&&(t=new Set),Object.keys(this.rootIds).forEach(t.add,t),this instanceof wo?this.parent.getRootIdSet(t):Object.keys(this.policies.rootTypenamesById).forEach(t.add,t),t},e.prototype.gc=function(){var t=this,i=this.getRootIdSet(),n=this.toObject();i.forEach(function(s){on.call(n,s)&&(Object.keys(t.findChildRefIds(s)).forEach(i.add,i),delete n[s])});var r=Object.keys(n);if(r.length){for(var o=this;o instanceof wo;)o=o.parent;r.forEach(function(s){return o.delete(s)})}return r},e.prototype.findChildRefIds=function(t){if(!on.call(this.refs,t)){var i=this.refs[t]=Object.create(null),n=this.data[t];if(!n)return i;var r=new Set([n]);r.forEach(function(o){ot(o)&&(i[o.__ref]=!0),xt(o)&&Object.keys(o).forEach(function(s){var a=o[s];xt(a)&&r.add(a)})})}return this.refs[t]},e.prototype.makeCacheKey=function(){return this.group.keyMaker.lookupArray(arguments)},e}(),mN=function(){function e(t,i){void 0===i&&(i=null),this.caching=t,this.parent=i,this.d=null,this.resetCaching()}return e.prototype.resetCaching=function(){this.d=this.caching?aF():null,this.keyMaker=new bo(Nr)},e.prototype.depend=function(t,i){if(this.d){this.d(kw(t,i));var n=yo(i);n!==i&&this.d(kw(t,n)),this.parent&&this.parent.depend(t,i)}},e.prototype.dirty=function(t,i){this.d&&this.d.dirty(kw(t,i),"__exists"===i?"forget":"setDirty")},e}();function kw(e,t){return t+"#"+e}function gN(e,t){qd(e)&&e.group.depend(t,"__exists")}!function(e){var t=function(i){function n(r){var s=r.resultCaching,c=r.seed,l=i.call(this,r.policies,new mN(void 0===s||s))||this;return l.stump=new fse(l),l.storageTrie=new bo(Nr),c&&l.replace(c),l}return Ln(n,i),n.prototype.addLayer=function(r,o){return this.stump.addLayer(r,o)},n.prototype.removeLayer=function(){return this},n.prototype.getStorage=function(){return this.storageTrie.lookupArray(arguments)},n}(e);e.Root=t}($d||($d={}));var wo=function(e){function t(i,n,r,o){var s=e.call(this,n.policies,o)||this;return s.id=i,s.parent=n,s.replay=r,s.group=o,r(s),s}return Ln(t,e),t.prototype.addLayer=function(i,n){return new t(i,this,n,this.group)},t.prototype.removeLayer=function(i){var n=this,r=this.parent.removeLayer(i);return i===this.id?(this.group.caching&&Object.keys(this.data).forEach(function(o){var s=n.data[o],a=r.lookup(o);a?s?s!==a&&Object.keys(s).forEach(function(c){It(s[c],a[c])||n.group.dirty(o,c)}):(n.group.dirty(o,"__exists"),Object.keys(a).forEach(function(c){n.group.dirty(o,c)})):n.delete(o)}),r):r===this.parent?this:r.addLayer(this.id,this.replay)},t.prototype.toObject=function(){return k(k({},this.parent.toObject()),this.data)},t.prototype.findChildRefIds=function(i){var n=this.parent.findChildRefIds(i);return on.call(this.data,i)?k(k({},n),e.prototype.findChildRefIds.call(this,i)):n},t.prototype.getStorage=function(){for(var i=this.parent;i.parent;)i=i.parent;return i.getStorage.apply(i,arguments)},t}($d),fse=function(e){function t(i){return e.call(this,"EntityStore.Stump",i,function(){},new mN(i.group.caching,i.group))||this}return Ln(t,e),t.prototype.removeLayer=function(){return this},t.prototype.merge=function(){return this.parent.merge.apply(this.parent,arguments)},t}(wo);function pse(e,t,i){var n=e[i],r=t[i];return It(n,r)?n:r}function qd(e){return!!(e instanceof $d&&e.group.caching)}function _N(e){return[e.selectionSet,e.objectOrReference,e.context,e.context.canonizeResults]}var mse=function(){function e(t){var i=this;this.knownResults=new(Nr?WeakMap:Map),this.config=sc(t,{addTypename:!1!==t.addTypename,canonizeResults:uN(t)}),this.canon=t.canon||new A0,this.executeSelectionSet=zp(function(n){var r,o=n.context.canonizeResults,s=_N(n);s[3]=!o;var a=(r=i.executeSelectionSet).peek.apply(r,s);return a?o?k(k({},a),{result:i.canon.admit(a.result)}):a:(gN(n.context.store,n.enclosingRef.__ref),i.execSelectionSetImpl(n))},{max:this.config.resultCacheMaxSize,keyArgs:_N,makeCacheKey:function(n,r,o,s){if(qd(o.store))return o.store.makeCacheKey(n,ot(r)?r.__ref:r,o.varString,s)}}),this.executeSubSelectedArray=zp(function(n){return gN(n.context.store,n.enclosingRef.__ref),i.execSubSelectedArrayImpl(n)},{max:this.config.resultCacheMaxSize,makeCacheKey:function(n){var r=n.field,o=n.array,s=n.context;if(qd(s.store))return s.store.makeCacheKey(r,o,s.varString)}})}return e.prototype.resetCanon=function(){this.canon=new A0},e.prototype.diffQueryAgainstStore=function(t){var i=t.store,n=t.query,r=t.rootId,o=void 0===r?"ROOT_QUERY":r,s=t.variables,a=t.returnPartialData,c=void 0===a||a,l=t.canonizeResults,d=void 0===l?this.config.canonizeResults:l,u=this.config.cache.policies;s=k(k({},g0(oO(n))),s);var p,h=Ja(o),f=this.executeSelectionSet({selectionSet:Td(n).selectionSet,objectOrReference:h,enclosingRef:h,context:k({store:i,query:n,policies:u,variables:s,varString:_s(s),canonizeResults:d},fN(n,this.config.fragments))});if(f.missing&&(p=[new cN(gse(f.missing),f.missing,n,s)],!c))throw p[0];return{result:f.result,complete:!p,missing:p}},e.prototype.isFresh=function(t,i,n,r){if(qd(r.store)&&this.knownResults.get(t)===n){var o=this.executeSelectionSet.peek(n,i,r,this.canon.isKnown(t));if(o&&t===o.result)return!0}return!1},e.prototype.execSelectionSetImpl=function(t){var i=this,n=t.selectionSet,r=t.objectOrReference,o=t.enclosingRef,s=t.context;if(ot(r)&&!s.policies.rootTypenamesById[r.__ref]&&!s.store.has(r.__ref))return{result:this.canon.empty,missing:"Dangling reference to missing ".concat(r.__ref," object")};var h,a=s.variables,c=s.policies,d=s.store.getFieldValue(r,"__typename"),u=[],f=new _o;function p(C,S){var N;return C.missing&&(h=f.merge(h,((N={})[S]=C.missing,N))),C.result}this.config.addTypename&&"string"==typeof d&&!c.rootIdsByTypename[d]&&u.push({__typename:d});var g=new Set(n.selections);g.forEach(function(C){var S,N;if(Ad(C,a))if(go(C)){var L=c.readField({fieldName:C.name.value,field:C,variables:s.variables,from:r},s),q=mo(C);void 0===L?T0.added(C)||(h=f.merge(h,((S={})[q]="Can't find field '".concat(C.name.value,"' on ").concat(ot(r)?r.__ref+" object":"object "+JSON.stringify(r,null,2)),S))):Mt(L)?L=p(i.executeSubSelectedArray({field:C,array:L,enclosingRef:o,context:s}),q):C.selectionSet?null!=L&&(L=p(i.executeSelectionSet({selectionSet:C.selectionSet,objectOrReference:L,enclosingRef:ot(L)?L:o,context:s}),q)):s.canonizeResults&&(L=i.canon.pass(L)),void 0!==L&&u.push(((N={})[q]=L,N))}else{var oe=Ap(C,s.lookupFragment);if(!oe&&C.kind===te.FRAGMENT_SPREAD)throw Fn(7,C.name.value);oe&&c.fragmentMatches(oe,d)&&oe.selectionSet.selections.forEach(g.add,g)}});var _={result:C0(u),missing:h},v=s.canonizeResults?this.canon.admit(_):Dw(_);return v.result&&this.knownResults.set(v.result,n),v},e.prototype.execSubSelectedArrayImpl=function(t){var a,i=this,n=t.field,r=t.array,o=t.enclosingRef,s=t.context,c=new _o;function l(d,u){var h;return d.missing&&(a=c.merge(a,((h={})[u]=d.missing,h))),d.result}return n.selectionSet&&(r=r.filter(s.store.canRead)),r=r.map(function(d,u){return null===d?null:Mt(d)?l(i.executeSubSelectedArray({field:n,array:d,enclosingRef:o,context:s}),u):n.selectionSet?l(i.executeSelectionSet({selectionSet:n.selectionSet,objectOrReference:d,enclosingRef:ot(d)?d:o,context:s}),u):(!1!==globalThis.__DEV__&&function _se(e,t,i){if(!t.selectionSet){var n=new Set([i]);n.forEach(function(r){xt(r)&&(ye(!ot(r),8,function use(e,t){return ot(t)?e.get(t.__ref,"__typename"):t&&t.__typename}(e,r),t.name.value),Object.values(r).forEach(n.add,n))})}}(s.store,n,d),d)}),{result:s.canonizeResults?this.canon.admit(r):r,missing:a}},e}();function gse(e){try{JSON.stringify(e,function(t,i){if("string"==typeof i)throw i;return i})}catch(t){return t}}var bN=Object.create(null);function Tw(e){var t=JSON.stringify(e);return bN[t]||(bN[t]=Object.create(null))}function vN(e){var t=Tw(e);return t.keyFieldsFn||(t.keyFieldsFn=function(i,n){var r=function(s,a){return n.readField(a,s)},o=n.keyObject=Mw(e,function(s){var a=_c(n.storeObject,s,r);return void 0===a&&i!==n.storeObject&&on.call(i,s[0])&&(a=_c(i,s,xN)),ye(void 0!==a,2,s.join("."),i),a});return"".concat(n.typename,":").concat(JSON.stringify(o))})}function yN(e){var t=Tw(e);return t.keyArgsFn||(t.keyArgsFn=function(i,n){var r=n.field,o=n.variables,s=n.fieldName,a=Mw(e,function(l){var d=l[0],u=d.charAt(0);if("@"!==u)if("$"!==u){if(i)return _c(i,l)}else{var g=d.slice(1);if(o&&on.call(o,g)){var b=l.slice(0);return b[0]=g,_c(o,b)}}else if(r&&dr(r.directives)){var h=d.slice(1),f=r.directives.find(function(_){return _.name.value===h}),p=f&&Rp(f,o);return p&&_c(p,l.slice(1))}}),c=JSON.stringify(a);return(i||"{}"!==c)&&(s+=":"+c),s})}function Mw(e,t){var i=new _o;return wN(e).reduce(function(n,r){var o,s=t(r);if(void 0!==s){for(var a=r.length-1;a>=0;--a)(o={})[r[a]]=s,s=o;n=i.merge(n,s)}return n},Object.create(null))}function wN(e){var t=Tw(e);if(!t.paths){var i=t.paths=[],n=[];e.forEach(function(r,o){Mt(r)?(wN(r).forEach(function(s){return i.push(n.concat(s))}),n.length=0):(n.push(r),Mt(e[o+1])||(i.push(n.slice(0)),n.length=0))})}return t.paths}function xN(e,t){return e[t]}function _c(e,t,i){return i=i||xN,CN(t.reduce(function n(r,o){return Mt(r)?r.map(function(s){return n(s,o)}):r&&i(r,o)},e))}function CN(e){return xt(e)?Mt(e)?e.map(CN):Mw(Object.keys(e).sort(),function(t){return _c(e,t)}):e}function Iw(e){return void 0!==e.args?e.args:e.field?Rp(e.field,e.variables):null}f0.setStringify(_s);var bse=function(){},DN=function(e,t){return t.fieldName},EN=function(e,t,i){return(0,i.mergeObjects)(e,t)},SN=function(e,t){return t},vse=function(){function e(t){this.config=t,this.typePolicies=Object.create(null),this.toBeAdded=Object.create(null),this.supertypeMap=new Map,this.fuzzySubtypes=new Map,this.rootIdsByTypename=Object.create(null),this.rootTypenamesById=Object.create(null),this.usingPossibleTypes=!1,this.config=k({dataIdFromObject:lN},t),this.cache=this.config.cache,this.setRootTypename("Query"),this.setRootTypename("Mutation"),this.setRootTypename("Subscription"),t.possibleTypes&&this.addPossibleTypes(t.possibleTypes),t.typePolicies&&this.addTypePolicies(t.typePolicies)}return e.prototype.identify=function(t,i){var n,r=this,o=i&&(i.typename||(null===(n=i.storeObject)||void 0===n?void 0:n.__typename))||t.__typename;if(o===this.rootTypenamesById.ROOT_QUERY)return["ROOT_QUERY"];for(var c,s=i&&i.storeObject||t,a=k(k({},i),{typename:o,storeObject:s,readField:i&&i.readField||function(){var h=Aw(arguments,s);return r.readField(h,{store:r.cache.data,variables:h.variables})}}),l=o&&this.getTypePolicy(o),d=l&&l.keyFn||this.config.dataIdFromObject;d;){var u=d(k(k({},t),s),a);if(!Mt(u)){c=u;break}d=vN(u)}return c=c?String(c):void 0,a.keyObject?[c,a.keyObject]:[c]},e.prototype.addTypePolicies=function(t){var i=this;Object.keys(t).forEach(function(n){var r=t[n],o=r.queryType,s=r.mutationType,a=r.subscriptionType,c=si(r,["queryType","mutationType","subscriptionType"]);o&&i.setRootTypename("Query",n),s&&i.setRootTypename("Mutation",n),a&&i.setRootTypename("Subscription",n),on.call(i.toBeAdded,n)?i.toBeAdded[n].push(c):i.toBeAdded[n]=[c]})},e.prototype.updateTypePolicy=function(t,i){var n=this,r=this.getTypePolicy(t),o=i.keyFields,s=i.fields;function a(c,l){c.merge="function"==typeof l?l:!0===l?EN:!1===l?SN:c.merge}a(r,i.merge),r.keyFn=!1===o?bse:Mt(o)?vN(o):"function"==typeof o?o:r.keyFn,s&&Object.keys(s).forEach(function(c){var l=n.getFieldPolicy(t,c,!0),d=s[c];if("function"==typeof d)l.read=d;else{var u=d.keyArgs,h=d.read,f=d.merge;l.keyFn=!1===u?DN:Mt(u)?yN(u):"function"==typeof u?u:l.keyFn,"function"==typeof h&&(l.read=h),a(l,f)}l.read&&l.merge&&(l.keyFn=l.keyFn||DN)})},e.prototype.setRootTypename=function(t,i){void 0===i&&(i=t);var n="ROOT_"+t.toUpperCase(),r=this.rootTypenamesById[n];i!==r&&(ye(!r||r===t,3,t),r&&delete this.rootIdsByTypename[r],this.rootIdsByTypename[i]=n,this.rootTypenamesById[n]=i)},e.prototype.addPossibleTypes=function(t){var i=this;this.usingPossibleTypes=!0,Object.keys(t).forEach(function(n){i.getSupertypeSet(n,!0),t[n].forEach(function(r){i.getSupertypeSet(r,!0).add(n);var o=r.match(hN);(!o||o[0]!==r)&&i.fuzzySubtypes.set(r,new RegExp(r))})})},e.prototype.getTypePolicy=function(t){var i=this;if(!on.call(this.typePolicies,t)){var n=this.typePolicies[t]=Object.create(null);n.fields=Object.create(null);var r=this.supertypeMap.get(t);!r&&this.fuzzySubtypes.size&&(r=this.getSupertypeSet(t,!0),this.fuzzySubtypes.forEach(function(s,a){if(s.test(t)){var c=i.supertypeMap.get(a);c&&c.forEach(function(l){return r.add(l)})}})),r&&r.size&&r.forEach(function(s){var a=i.getTypePolicy(s),c=a.fields,l=si(a,["fields"]);Object.assign(n,l),Object.assign(n.fields,c)})}var o=this.toBeAdded[t];return o&&o.length&&o.splice(0).forEach(function(s){i.updateTypePolicy(t,s)}),this.typePolicies[t]},e.prototype.getFieldPolicy=function(t,i,n){if(t){var r=this.getTypePolicy(t).fields;return r[i]||n&&(r[i]=Object.create(null))}},e.prototype.getSupertypeSet=function(t,i){var n=this.supertypeMap.get(t);return!n&&i&&this.supertypeMap.set(t,n=new Set),n},e.prototype.fragmentMatches=function(t,i,n,r){var o=this;if(!t.typeCondition)return!0;if(!i)return!1;var s=t.typeCondition.name.value;if(i===s)return!0;if(this.usingPossibleTypes&&this.supertypeMap.has(s))for(var a=this.getSupertypeSet(i,!0),c=[a],l=function(p){var g=o.getSupertypeSet(p,!1);g&&g.size&&c.indexOf(g)<0&&c.push(g)},d=!(!n||!this.fuzzySubtypes.size),u=!1,h=0;h<c.length;++h){var f=c[h];if(f.has(s))return a.has(s)||(u&&!1!==globalThis.__DEV__&&ye.warn(4,i,s),a.add(s)),!0;f.forEach(l),d&&h===c.length-1&&Ew(t.selectionSet,n,r)&&(d=!1,u=!0,this.fuzzySubtypes.forEach(function(p,g){var b=i.match(p);b&&b[0]===i&&l(g)}))}return!1},e.prototype.hasKeyArgs=function(t,i){var n=this.getFieldPolicy(t,i,!1);return!(!n||!n.keyFn)},e.prototype.getStoreFieldName=function(t){var o,i=t.typename,n=t.fieldName,r=this.getFieldPolicy(i,n,!1),s=r&&r.keyFn;if(s&&i)for(var a={typename:i,fieldName:n,field:t.field||null,variables:t.variables},c=Iw(t);s;){var l=s(c,a);if(!Mt(l)){o=l||n;break}s=yN(l)}return void 0===o&&(o=t.field?function zK(e,t){var i=null;e.directives&&(i={},e.directives.forEach(function(r){i[r.name.value]={},r.arguments&&r.arguments.forEach(function(o){return ec(i[r.name.value],o.name,o.value,t)})}));var n=null;return e.arguments&&e.arguments.length&&(n={},e.arguments.forEach(function(r){return ec(n,r.name,r.value,t)})),f0(e.name.value,n,i)}(t.field,t.variables):f0(n,Iw(t))),!1===o?n:n===yo(o)?o:n+":"+o},e.prototype.readField=function(t,i){var n=t.from;if(n&&(t.field||t.fieldName)){if(void 0===t.typename){var o=i.store.getFieldValue(n,"__typename");o&&(t.typename=o)}var s=this.getStoreFieldName(t),a=yo(s),c=i.store.getFieldValue(n,s),l=this.getFieldPolicy(t.typename,a,!1),d=l&&l.read;if(d){var u=kN(this,n,t,i,i.store.getStorage(ot(n)?n.__ref:n,s));return q0.withValue(this.cache,d,[c,u])}return c}},e.prototype.getReadFunction=function(t,i){var n=this.getFieldPolicy(t,i,!1);return n&&n.read},e.prototype.getMergeFunction=function(t,i,n){var r=this.getFieldPolicy(t,i,!1),o=r&&r.merge;return!o&&n&&(o=(r=this.getTypePolicy(n))&&r.merge),o},e.prototype.runMergeFunction=function(t,i,n,r,o){var s=n.field,a=n.typename,c=n.merge;return c===EN?TN(r.store)(t,i):c===SN?i:(r.overwrite&&(t=void 0),c(t,i,kN(this,void 0,{typename:a,fieldName:s.name.value,field:s,variables:r.variables},r,o||Object.create(null))))},e}();function kN(e,t,i,n,r){var o=e.getStoreFieldName(i),s=yo(o),a=i.variables||n.variables,c=n.store,l=c.toReference,d=c.canRead;return{args:Iw(i),field:i.field||null,fieldName:s,storeFieldName:o,variables:a,isReference:ot,toReference:l,storage:r,cache:e.cache,canRead:d,readField:function(){return e.readField(Aw(arguments,t,a),n)},mergeObjects:TN(n.store)}}function Aw(e,t,i){var s,n=e[0];return"string"==typeof n?s={fieldName:n,from:e.length>1?e[1]:t}:(s=k({},n),on.call(s,"from")||(s.from=t)),!1!==globalThis.__DEV__&&void 0===s.from&&!1!==globalThis.__DEV__&&ye.warn(5,ZR(Array.from(e))),void 0===s.variables&&(s.variables=i),s}function TN(e){return function(i,n){if(Mt(i)||Mt(n))throw Fn(6);if(xt(i)&&xt(n)){var r=e.getFieldValue(i,"__typename"),o=e.getFieldValue(n,"__typename");if(r&&o&&r!==o)return n;if(ot(i)&&gc(n))return e.merge(i.__ref,n),i;if(gc(i)&&ot(n))return e.merge(i,n.__ref),n;if(gc(i)&&gc(n))return k(k({},i),n)}return n}}function Rw(e,t,i){var n="".concat(t).concat(i),r=e.flavors.get(n);return r||e.flavors.set(n,r=e.clientOnly===t&&e.deferred===i?e:k(k({},e),{clientOnly:t,deferred:i})),r}var yse=function(){function e(t,i,n){this.cache=t,this.reader=i,this.fragments=n}return e.prototype.writeToStore=function(t,i){var n=this,r=i.query,o=i.result,s=i.dataId,a=i.variables,c=i.overwrite,l=kd(r),d=function hse(){return new _o}();a=k(k({},g0(l)),a);var u=k(k({store:t,written:Object.create(null),merge:function(f,p){return d.merge(f,p)},variables:a,varString:_s(a)},fN(r,this.fragments)),{overwrite:!!c,incomingById:new Map,clientOnly:!1,deferred:!1,flavors:new Map}),h=this.processSelectionSet({result:o||Object.create(null),dataId:s,selectionSet:l.selectionSet,mergeTree:{map:new Map},context:u});if(!ot(h))throw Fn(9,o);return u.incomingById.forEach(function(f,p){var g=f.storeObject,b=f.mergeTree,_=f.fieldNodeSet,v=Ja(p);if(b&&b.map.size){var C=n.applyMerges(b,v,g,u);if(ot(C))return;g=C}if(!1!==globalThis.__DEV__&&!u.overwrite){var S=Object.create(null);_.forEach(function(q){q.selectionSet&&(S[q.name.value]=!0)}),Object.keys(g).forEach(function(q){(function(q){return!0===S[yo(q)]})(q)&&!function(q){var oe=b&&b.map.get(q);return!!(oe&&oe.info&&oe.info.merge)}(q)&&function wse(e,t,i,n){var r=function(u){var h=n.getFieldValue(u,i);return"object"==typeof h&&h},o=r(e);if(o){var s=r(t);if(s&&!ot(o)&&!It(o,s)&&!Object.keys(o).every(function(u){return void 0!==n.getFieldValue(s,u)})){var a=n.getFieldValue(e,"__typename")||n.getFieldValue(t,"__typename"),c=yo(i),l="".concat(a,".").concat(c);if(!RN.has(l)){RN.add(l);var d=[];!Mt(o)&&!Mt(s)&&[o,s].forEach(function(u){var h=n.getFieldValue(u,"__typename");"string"==typeof h&&!d.includes(h)&&d.push(h)}),!1!==globalThis.__DEV__&&ye.warn(12,c,a,d.length?"either ensure all objects of type "+d.join(" and ")+" have an ID or a custom merge function, or ":"",l,o,s)}}}}(v,g,q,u.store)})}t.merge(p,g)}),t.retain(h.__ref),h},e.prototype.processSelectionSet=function(t){var i=this,n=t.dataId,r=t.result,o=t.selectionSet,s=t.context,a=t.mergeTree,c=this.cache.policies,l=Object.create(null),d=n&&c.rootTypenamesById[n]||p0(r,o,s.fragmentMap)||n&&s.store.get(n,"__typename");"string"==typeof d&&(l.__typename=d);var u=function(){var C=Aw(arguments,l,s.variables);if(ot(C.from)){var S=s.incomingById.get(C.from.__ref);if(S){var N=c.readField(k(k({},C),{from:S.storeObject}),s);if(void 0!==N)return N}}return c.readField(C,s)},h=new Set;this.flattenFields(o,r,s,d).forEach(function(C,S){var N,L=mo(S),q=r[L];if(h.add(S),void 0!==q){var oe=c.getStoreFieldName({typename:d,fieldName:S.name.value,field:S,variables:C.variables}),Ne=IN(a,oe),qe=i.processFieldValue(q,S,S.selectionSet?Rw(C,!1,!1):C,Ne),At=void 0;S.selectionSet&&(ot(qe)||gc(qe))&&(At=u("__typename",qe));var Pn=c.getMergeFunction(d,S.name.value,At);Pn?Ne.info={field:S,typename:d,merge:Pn}:AN(a,oe),l=C.merge(l,((N={})[oe]=qe,N))}else!1!==globalThis.__DEV__&&!C.clientOnly&&!C.deferred&&!T0.added(S)&&!c.getReadFunction(d,S.name.value)&&!1!==globalThis.__DEV__&&ye.error(10,mo(S),r)});try{var f=c.identify(r,{typename:d,selectionSet:o,fragmentMap:s.fragmentMap,storeObject:l,readField:u}),g=f[1];n=n||f[0],g&&(l=s.merge(l,g))}catch(C){if(!n)throw C}if("string"==typeof n){var b=Ja(n),_=s.written[n]||(s.written[n]=[]);if(_.indexOf(o)>=0||(_.push(o),this.reader&&this.reader.isFresh(r,b,o,s)))return b;var v=s.incomingById.get(n);return v?(v.storeObject=s.merge(v.storeObject,l),v.mergeTree=Ow(v.mergeTree,a),h.forEach(function(C){return v.fieldNodeSet.add(C)})):s.incomingById.set(n,{storeObject:l,mergeTree:fm(a)?void 0:a,fieldNodeSet:h}),b}return l},e.prototype.processFieldValue=function(t,i,n,r){var o=this;return i.selectionSet&&null!==t?Mt(t)?t.map(function(s,a){var c=o.processFieldValue(s,i,n,IN(r,a));return AN(r,a),c}):this.processSelectionSet({result:t,selectionSet:i.selectionSet,context:n,mergeTree:r}):!1!==globalThis.__DEV__?HO(t):t},e.prototype.flattenFields=function(t,i,n,r){void 0===r&&(r=p0(i,t,n.fragmentMap));var o=new Map,s=this.cache.policies,a=new bo(!1);return function c(l,d){var u=a.lookup(l,d.clientOnly,d.deferred);u.visited||(u.visited=!0,l.selections.forEach(function(h){if(Ad(h,n.variables)){var f=d.clientOnly,p=d.deferred;if(!(f&&p)&&dr(h.directives)&&h.directives.forEach(function(_){var v=_.name.value;if("client"===v&&(f=!0),"defer"===v){var C=Rp(_,n.variables);(!C||!1!==C.if)&&(p=!0)}}),go(h)){var g=o.get(h);g&&(f=f&&g.clientOnly,p=p&&g.deferred),o.set(h,Rw(n,f,p))}else{var b=Ap(h,n.lookupFragment);if(!b&&h.kind===te.FRAGMENT_SPREAD)throw Fn(11,h.name.value);b&&s.fragmentMatches(b,r,i,n.variables)&&c(b.selectionSet,Rw(n,f,p))}}}))}(t,n),o},e.prototype.applyMerges=function(t,i,n,r,o){var s,a=this;if(t.map.size&&!ot(n)){var c=Mt(n)||!ot(i)&&!gc(i)?void 0:i,l=n;c&&!o&&(o=[ot(c)?c.__ref:c]);var d,u=function(h,f){return Mt(h)?"number"==typeof f?h[f]:void 0:r.store.getFieldValue(h,String(f))};t.map.forEach(function(h,f){var p=u(c,f),g=u(l,f);if(void 0!==g){o&&o.push(f);var b=a.applyMerges(h,p,g,r,o);b!==g&&(d=d||new Map).set(f,b),o&&ye(o.pop()===f)}}),d&&(n=Mt(l)?l.slice(0):k({},l),d.forEach(function(h,f){n[f]=h}))}return t.info?this.cache.policies.runMergeFunction(i,n,t.info,r,o&&(s=r.store).getStorage.apply(s,o)):n},e}(),MN=[];function IN(e,t){var i=e.map;return i.has(t)||i.set(t,MN.pop()||{map:new Map}),i.get(t)}function Ow(e,t){if(e===t||!t||fm(t))return e;if(!e||fm(e))return t;var i=e.info&&t.info?k(k({},e.info),t.info):e.info||t.info,n=e.map.size&&t.map.size,o={info:i,map:n?new Map:e.map.size?e.map:t.map};if(n){var s=new Set(t.map.keys());e.map.forEach(function(a,c){o.map.set(c,Ow(a,t.map.get(c))),s.delete(c)}),s.forEach(function(a){o.map.set(a,Ow(t.map.get(a),e.map.get(a)))})}return o}function fm(e){return!e||!(e.info||e.map.size)}function AN(e,t){var i=e.map,n=i.get(t);n&&fm(n)&&(MN.push(n),i.delete(t))}var RN=new Set,xse=function(e){function t(i){void 0===i&&(i={});var n=e.call(this)||this;return n.watches=new Set,n.addTypenameTransform=new BO(T0),n.assumeImmutableResults=!0,n.makeVar=HZ,n.txCount=0,n.config=function dse(e){return sc(dN,e)}(i),n.addTypename=!!n.config.addTypename,n.policies=new vse({cache:n,dataIdFromObject:n.config.dataIdFromObject,possibleTypes:n.config.possibleTypes,typePolicies:n.config.typePolicies}),n.init(),n}return Ln(t,e),t.prototype.init=function(){var i=this.data=new $d.Root({policies:this.policies,resultCaching:this.config.resultCaching});this.optimisticData=i.stump,this.resetResultCache()},t.prototype.resetResultCache=function(i){var n=this,r=this.storeReader,o=this.config.fragments;this.storeWriter=new yse(this,this.storeReader=new mse({cache:this,addTypename:this.addTypename,resultCacheMaxSize:this.config.resultCacheMaxSize,canonizeResults:uN(this.config),canon:i?void 0:r&&r.canon,fragments:o}),o),this.maybeBroadcastWatch=zp(function(s,a){return n.broadcastWatch(s,a)},{max:this.config.resultCacheMaxSize,makeCacheKey:function(s){var a=s.optimistic?n.optimisticData:n.data;if(qd(a))return a.makeCacheKey(s.query,s.callback,_s({optimistic:s.optimistic,id:s.id,variables:s.variables}))}}),new Set([this.data.group,this.optimisticData.group]).forEach(function(s){return s.resetCaching()})},t.prototype.restore=function(i){return this.init(),i&&this.data.replace(i),this},t.prototype.extract=function(i){return void 0===i&&(i=!1),(i?this.optimisticData:this.data).extract()},t.prototype.read=function(i){var n=i.returnPartialData,r=void 0!==n&&n;try{return this.storeReader.diffQueryAgainstStore(k(k({},i),{store:i.optimistic?this.optimisticData:this.data,config:this.config,returnPartialData:r})).result||null}catch(o){if(o instanceof cN)return null;throw o}},t.prototype.write=function(i){try{return++this.txCount,this.storeWriter.writeToStore(this.data,i)}finally{! --this.txCount&&!1!==i.broadcast&&this.broadcastWatches()}},t.prototype.modify=function(i){if(on.call(i,"id")&&!i.id)return!1;var n=i.optimistic?this.optimisticData:this.data;try{return++this.txCount,n.modify(i.id||"ROOT_QUERY",i.fields)}finally{! --this.txCount&&!1!==i.broadcast&&this.broadcastWatches()}},t.prototype.diff=function(i){return this.storeReader.diffQueryAgainstStore(k(k({},i),{store:i.optimistic?this.optimisticData:this.data,rootId:i.id||"ROOT_QUERY",config:this.config}))},t.prototype.watch=function(i){var n=this;return this.watches.size||function jZ(e){Nd(e).vars.forEach(function(t){return t.attachCache(e)})}(this),this.watches.add(i),i.immediate&&this.maybeBroadcastWatch(i),function(){n.watches.delete(i)&&!n.watches.size&&dF(n),n.maybeBroadcastWatch.forget(i)}},t.prototype.gc=function(i){_s.reset();var n=this.optimisticData.gc();return i&&!this.txCount&&(i.resetResultCache?this.resetResultCache(i.resetResultIdentities):i.resetResultIdentities&&this.storeReader.resetCanon()),n},t.prototype.retain=function(i,n){return(n?this.optimisticData:this.data).retain(i)},t.prototype.release=function(i,n){return(n?this.optimisticData:this.data).release(i)},t.prototype.identify=function(i){if(ot(i))return i.__ref;try{return this.policies.identify(i)[0]}catch(n){!1!==globalThis.__DEV__&&ye.warn(n)}},t.prototype.evict=function(i){if(!i.id){if(on.call(i,"id"))return!1;i=k(k({},i),{id:"ROOT_QUERY"})}try{return++this.txCount,this.optimisticData.evict(i,this.data)}finally{! --this.txCount&&!1!==i.broadcast&&this.broadcastWatches()}},t.prototype.reset=function(i){var n=this;return this.init(),_s.reset(),i&&i.discardWatches?(this.watches.forEach(function(r){return n.maybeBroadcastWatch.forget(r)}),this.watches.clear(),dF(this)):this.broadcastWatches(),Promise.resolve()},t.prototype.removeOptimistic=function(i){var n=this.optimisticData.removeLayer(i);n!==this.optimisticData&&(this.optimisticData=n,this.broadcastWatches())},t.prototype.batch=function(i){var l,n=this,r=i.update,o=i.optimistic,s=void 0===o||o,a=i.removeOptimistic,c=i.onWatchUpdated,d=function(h){var p=n.data,g=n.optimisticData;++n.txCount,h&&(n.data=n.optimisticData=h);try{return l=r(n)}finally{--n.txCount,n.data=p,n.optimisticData=g}},u=new Set;return c&&!this.txCount&&this.broadcastWatches(k(k({},i),{onWatchUpdated:function(h){return u.add(h),!1}})),"string"==typeof s?this.optimisticData=this.optimisticData.addLayer(s,d):!1===s?d(this.data):d(),"string"==typeof a&&(this.optimisticData=this.optimisticData.removeLayer(a)),c&&u.size?(this.broadcastWatches(k(k({},i),{onWatchUpdated:function(h,f){var p=c.call(this,h,f);return!1!==p&&u.delete(h),p}})),u.size&&u.forEach(function(h){return n.maybeBroadcastWatch.dirty(h)})):this.broadcastWatches(i),l},t.prototype.performTransaction=function(i,n){return this.batch({update:i,optimistic:n||null!==n})},t.prototype.transformDocument=function(i){return this.addTypenameToDocument(this.addFragmentsToDocument(i))},t.prototype.broadcastWatches=function(i){var n=this;this.txCount||this.watches.forEach(function(r){return n.maybeBroadcastWatch(r,i)})},t.prototype.addFragmentsToDocument=function(i){var n=this.config.fragments;return n?n.transform(i):i},t.prototype.addTypenameToDocument=function(i){return this.addTypename?this.addTypenameTransform.transformDocument(i):i},t.prototype.broadcastWatch=function(i,n){var r=i.lastDiff,o=this.diff(i);n&&(i.optimistic&&"string"==typeof n.optimistic&&(o.fromOptimisticTransaction=!0),n.onWatchUpdated&&!1===n.onWatchUpdated.call(this,i,o,r))||(!r||!It(r.result,o.result))&&i.callback(i.lastDiff=o,r)},t}(ase);const Cse=window.location.protocol+"//"+window.location.hostname+":"+window.location.port+"/graphql";function Dse(e){return{link:e.create({uri:Cse}),cache:new xse({typePolicies:{Query:{fields:{search:{merge:(t,i)=>({...t,...i})}}}}})}}let Ese=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275mod=le({type:e}),e.\u0275inj=ae({providers:[{provide:IF,useFactory:Dse,deps:[sse]},OF],imports:[EJ]}),t})(),Sse=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275mod=le({type:e}),e.\u0275inj=ae({imports:[vn,jd,Yl,eN,jf,Gv,jre]}),t})(),kse=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275mod=le({type:e}),e.\u0275inj=ae({imports:[wI,iee,vn,nte,jf,hte,Dte,Zte,ene,_ne,jd,Gv,Ene,Ine,jne,eN,Yne,PF,vie,sre,qre,Sse,hK]}),t})(),Tse=(()=>{var e;class t{constructor(n,r){n.setDefaultFontSetClass("material-icons-outlined"),n.addSvgIcon("magnet",r.bypassSecurityTrustResourceUrl("assets/magnet.svg"))}}return(e=t).\u0275fac=function(n){return new(n||e)(D(Xl),D(Zh))},e.\u0275mod=le({type:e,bootstrap:[rse]}),e.\u0275inj=ae({providers:[LF],imports:[pY,wI,_M,Ese,H$,jf,GG,Gv,Q9,UG,kse]}),t})();d$().bootstrapModule(Tse).catch(e=>console.error(e))},622:function(yc){yc.exports=function(){"use strict";function xo(ki){return(xo="function"==typeof Symbol&&"symbol"==typeof Symbol.iterator?function(st){return typeof st}:function(st){return st&&"function"==typeof Symbol&&st.constructor===Symbol&&st!==Symbol.prototype?"symbol":typeof st})(ki)}var Co="iec",hr="jedec",Eo={symbol:{iec:{bits:["bit","Kibit","Mibit","Gibit","Tibit","Pibit","Eibit","Zibit","Yibit"],bytes:["B","KiB","MiB","GiB","TiB","PiB","EiB","ZiB","YiB"]},jedec:{bits:["bit","Kbit","Mbit","Gbit","Tbit","Pbit","Ebit","Zbit","Ybit"],bytes:["B","KB","MB","GB","TB","PB","EB","ZB","YB"]}},fullform:{iec:["","kibi","mebi","gibi","tebi","pebi","exbi","zebi","yobi"],jedec:["","kilo","mega","giga","tera","peta","exa","zetta","yotta"]}};function Si(ki){var st=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{},Ds=st.bits,So=void 0!==Ds&&Ds,Es=st.pad,bm=void 0!==Es&&Es,Vr=st.base,Nn=void 0===Vr?-1:Vr,Yd=st.round,ko=void 0===Yd?2:Yd,Kd=st.locale,jr=void 0===Kd?"":Kd,Ti=st.localeOptions,vm=void 0===Ti?{}:Ti,xc=st.separator,Me=void 0===xc?"":xc,Cc=st.spacer,ym=void 0===Cc?" ":Cc,Xd=st.symbols,wm=void 0===Xd?{}:Xd,Y=st.standard,Wn=void 0===Y?"":Y,Dc=st.output,at=void 0===Dc?"string":Dc,Ze=st.fullform,xm=void 0!==Ze&&Ze,J=st.fullforms,Ss=void 0===J?[]:J,Ln=st.exponent,k=void 0===Ln?-1:Ln,si=st.roundingMethod,Cm=void 0===si?"round":si,Zd=st.precision,Ec=void 0===Zd?0:Zd,Ct=k,To=Number(ki),Je=[],ks=0,Bn="";-1===Nn&&0===Wn.length?(Nn=10,Wn=hr):-1===Nn&&Wn.length>0?Nn=(Wn=Wn===Co?Co:hr)===Co?2:10:Wn=10==(Nn=2===Nn?2:10)||Wn===hr?hr:Co;var Vn=10===Nn?1e3:1024,Sc=!0===xm,Jd=To<0,Ts=Math[Cm];if(isNaN(ki))throw new TypeError("Invalid number");if("function"!==xo(Ts))throw new TypeError("Invalid rounding method");if(Jd&&(To=-To),(-1===Ct||isNaN(Ct))&&(Ct=Math.floor(Math.log(To)/Math.log(Vn)))<0&&(Ct=0),Ct>8&&(Ec>0&&(Ec+=8-Ct),Ct=8),"exponent"===at)return Ct;if(0===To)Je[0]=0,Bn=Je[1]=Eo.symbol[Wn][So?"bits":"bytes"][Ct];else{ks=To/(2===Nn?Math.pow(2,10*Ct):Math.pow(1e3,Ct)),So&&(ks*=8)>=Vn&&Ct<8&&(ks/=Vn,Ct++);var kc=Math.pow(10,Ct>0?ko:0);Je[0]=Ts(ks*kc)/kc,Je[0]===Vn&&Ct<8&&-1===k&&(Je[0]=1,Ct++),Bn=Je[1]=10===Nn&&1===Ct?So?"kbit":"kB":Eo.symbol[Wn][So?"bits":"bytes"][Ct]}if(Jd&&(Je[0]=-Je[0]),Ec>0&&(Je[0]=Je[0].toPrecision(Ec)),Je[1]=wm[Je[1]]||Je[1],!0===jr?Je[0]=Je[0].toLocaleString():jr.length>0?Je[0]=Je[0].toLocaleString(jr,vm):Me.length>0&&(Je[0]=Je[0].toString().replace(".",Me)),bm&&!1===Number.isInteger(Je[0])&&ko>0){var eu=Me||".",tu=Je[0].toString().split(eu),ai=tu[1]||"",Mi=ai.length,nu=ko-Mi;Je[0]="".concat(tu[0]).concat(eu).concat(ai.padEnd(Mi+nu,"0"))}return Sc&&(Je[1]=Ss[Ct]?Ss[Ct]:Eo.fullform[Wn][Ct]+(So?"bit":"byte")+(1===Je[0]?"":"s")),"array"===at?Je:"object"===at?{value:Je[0],symbol:Je[1],exponent:Ct,unit:Bn}:Je.join(ym)}return Si.partial=function(ki){return function(st){return Si(st,ki)}},Si}()}},yc=>{yc(yc.s=25)}]);
\ No newline at end of file
+    `;let OF=(()=>{var e;class t{constructor(n){this.apollo=n}torrentContentSearch(n){return this.apollo.query({query:NJ,variables:n,fetchPolicy:uc}).pipe(J(r=>r.data.torrentContent.search))}torrentDelete(n){return this.apollo.mutate({mutation:RJ,variables:n,fetchPolicy:uc}).pipe(J(()=>{}))}torrentPutTags(n){return this.apollo.mutate({mutation:FJ,variables:n,fetchPolicy:uc}).pipe(J(()=>{}))}torrentSetTags(n){return this.apollo.mutate({mutation:PJ,variables:n,fetchPolicy:uc}).pipe(J(()=>{}))}torrentDeleteTags(n){return this.apollo.mutate({mutation:OJ,variables:n,fetchPolicy:uc}).pipe(J(()=>{}))}torrentSuggestTags(n){return this.apollo.query({query:LJ,variables:n,fetchPolicy:uc}).pipe(J(r=>r.data.torrent.suggestTags))}}return(e=t).\u0275fac=function(n){return new(n||e)(D(RF))},e.\u0275prov=V({token:e,factory:e.\u0275fac}),t})();const uc="no-cache";function BJ(e,t){if(1&e){const i=Ut();y(0,"div",2)(1,"button",3),$("click",function(){return Ye(i),Ke(B().action())}),A(2),w()()}if(2&e){const i=B();x(2),Ue(" ",i.data.action," ")}}const VJ=["label"];function jJ(e,t){}const HJ=Math.pow(2,31)-1;class tw{constructor(t,i){this._overlayRef=i,this._afterDismissed=new Y,this._afterOpened=new Y,this._onAction=new Y,this._dismissedByAction=!1,this.containerInstance=t,t._onExit.subscribe(()=>this._finishDismiss())}dismiss(){this._afterDismissed.closed||this.containerInstance.exit(),clearTimeout(this._durationTimeoutId)}dismissWithAction(){this._onAction.closed||(this._dismissedByAction=!0,this._onAction.next(),this._onAction.complete(),this.dismiss()),clearTimeout(this._durationTimeoutId)}closeWithAction(){this.dismissWithAction()}_dismissAfter(t){this._durationTimeoutId=setTimeout(()=>this.dismiss(),Math.min(t,HJ))}_open(){this._afterOpened.closed||(this._afterOpened.next(),this._afterOpened.complete())}_finishDismiss(){this._overlayRef.dispose(),this._onAction.closed||this._onAction.complete(),this._afterDismissed.next({dismissedByAction:this._dismissedByAction}),this._afterDismissed.complete(),this._dismissedByAction=!1}afterDismissed(){return this._afterDismissed}afterOpened(){return this.containerInstance._onEnter}onAction(){return this._onAction}}const FF=new M("MatSnackBarData");class Qp{constructor(){this.politeness="assertive",this.announcementMessage="",this.duration=0,this.data=null,this.horizontalPosition="center",this.verticalPosition="bottom"}}let zJ=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275dir=T({type:e,selectors:[["","matSnackBarLabel",""]],hostAttrs:[1,"mat-mdc-snack-bar-label","mdc-snackbar__label"]}),t})(),UJ=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275dir=T({type:e,selectors:[["","matSnackBarActions",""]],hostAttrs:[1,"mat-mdc-snack-bar-actions","mdc-snackbar__actions"]}),t})(),$J=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275dir=T({type:e,selectors:[["","matSnackBarAction",""]],hostAttrs:[1,"mat-mdc-snack-bar-action","mdc-snackbar__action"]}),t})(),qJ=(()=>{var e;class t{constructor(n,r){this.snackBarRef=n,this.data=r}action(){this.snackBarRef.dismissWithAction()}get hasAction(){return!!this.data.action}}return(e=t).\u0275fac=function(n){return new(n||e)(m(tw),m(FF))},e.\u0275cmp=fe({type:e,selectors:[["simple-snack-bar"]],hostAttrs:[1,"mat-mdc-simple-snack-bar"],exportAs:["matSnackBar"],decls:3,vars:2,consts:[["matSnackBarLabel",""],["matSnackBarActions","",4,"ngIf"],["matSnackBarActions",""],["mat-button","","matSnackBarAction","",3,"click"]],template:function(n,r){1&n&&(y(0,"div",0),A(1),w(),R(2,BJ,3,1,"div",1)),2&n&&(x(1),Ue(" ",r.data.message,"\n"),x(1),E("ngIf",r.hasAction))},dependencies:[_i,g1,zJ,UJ,$J],styles:[".mat-mdc-simple-snack-bar{display:flex}"],encapsulation:2,changeDetection:0}),t})();const GJ={snackBarState:ni("state",[qt("void, hidden",je({transform:"scale(0.8)",opacity:0})),qt("visible",je({transform:"scale(1)",opacity:1})),Bt("* => visible",Lt("150ms cubic-bezier(0, 0, 0.2, 1)")),Bt("* => void, * => hidden",Lt("75ms cubic-bezier(0.4, 0.0, 1, 1)",je({opacity:0})))])};let WJ=0,QJ=(()=>{var e;class t extends ey{constructor(n,r,o,s,a){super(),this._ngZone=n,this._elementRef=r,this._changeDetectorRef=o,this._platform=s,this.snackBarConfig=a,this._document=j(he),this._trackedModals=new Set,this._announceDelay=150,this._destroyed=!1,this._onAnnounce=new Y,this._onExit=new Y,this._onEnter=new Y,this._animationState="void",this._liveElementId="mat-snack-bar-container-live-"+WJ++,this.attachDomPortal=c=>{this._assertNotAttached();const l=this._portalOutlet.attachDomPortal(c);return this._afterPortalAttached(),l},this._live="assertive"!==a.politeness||a.announcementMessage?"off"===a.politeness?"off":"polite":"assertive",this._platform.FIREFOX&&("polite"===this._live&&(this._role="status"),"assertive"===this._live&&(this._role="alert"))}attachComponentPortal(n){this._assertNotAttached();const r=this._portalOutlet.attachComponentPortal(n);return this._afterPortalAttached(),r}attachTemplatePortal(n){this._assertNotAttached();const r=this._portalOutlet.attachTemplatePortal(n);return this._afterPortalAttached(),r}onAnimationEnd(n){const{fromState:r,toState:o}=n;if(("void"===o&&"void"!==r||"hidden"===o)&&this._completeExit(),"visible"===o){const s=this._onEnter;this._ngZone.run(()=>{s.next(),s.complete()})}}enter(){this._destroyed||(this._animationState="visible",this._changeDetectorRef.detectChanges(),this._screenReaderAnnounce())}exit(){return this._ngZone.run(()=>{this._animationState="hidden",this._elementRef.nativeElement.setAttribute("mat-exit",""),clearTimeout(this._announceTimeoutId)}),this._onExit}ngOnDestroy(){this._destroyed=!0,this._clearFromModals(),this._completeExit()}_completeExit(){this._ngZone.onMicrotaskEmpty.pipe(Xe(1)).subscribe(()=>{this._ngZone.run(()=>{this._onExit.next(),this._onExit.complete()})})}_afterPortalAttached(){const n=this._elementRef.nativeElement,r=this.snackBarConfig.panelClass;r&&(Array.isArray(r)?r.forEach(o=>n.classList.add(o)):n.classList.add(r)),this._exposeToModals()}_exposeToModals(){const n=this._liveElementId,r=this._document.querySelectorAll('body > .cdk-overlay-container [aria-modal="true"]');for(let o=0;o<r.length;o++){const s=r[o],a=s.getAttribute("aria-owns");this._trackedModals.add(s),a?-1===a.indexOf(n)&&s.setAttribute("aria-owns",a+" "+n):s.setAttribute("aria-owns",n)}}_clearFromModals(){this._trackedModals.forEach(n=>{const r=n.getAttribute("aria-owns");if(r){const o=r.replace(this._liveElementId,"").trim();o.length>0?n.setAttribute("aria-owns",o):n.removeAttribute("aria-owns")}}),this._trackedModals.clear()}_assertNotAttached(){this._portalOutlet.hasAttached()}_screenReaderAnnounce(){this._announceTimeoutId||this._ngZone.runOutsideAngular(()=>{this._announceTimeoutId=setTimeout(()=>{const n=this._elementRef.nativeElement.querySelector("[aria-hidden]"),r=this._elementRef.nativeElement.querySelector("[aria-live]");if(n&&r){let o=null;this._platform.isBrowser&&document.activeElement instanceof HTMLElement&&n.contains(document.activeElement)&&(o=document.activeElement),n.removeAttribute("aria-hidden"),r.appendChild(n),o?.focus(),this._onAnnounce.next(),this._onAnnounce.complete()}},this._announceDelay)})}}return(e=t).\u0275fac=function(n){return new(n||e)(m(G),m(W),m(Fe),m(nt),m(Qp))},e.\u0275dir=T({type:e,viewQuery:function(n,r){if(1&n&&ke(La,7),2&n){let o;H(o=z())&&(r._portalOutlet=o.first)}},features:[P]}),t})(),YJ=(()=>{var e;class t extends QJ{_afterPortalAttached(){super._afterPortalAttached();const n=this._label.nativeElement,r="mdc-snackbar__label";n.classList.toggle(r,!n.querySelector(`.${r}`))}}return(e=t).\u0275fac=function(){let i;return function(r){return(i||(i=be(e)))(r||e)}}(),e.\u0275cmp=fe({type:e,selectors:[["mat-snack-bar-container"]],viewQuery:function(n,r){if(1&n&&ke(VJ,7),2&n){let o;H(o=z())&&(r._label=o.first)}},hostAttrs:[1,"mdc-snackbar","mat-mdc-snack-bar-container","mdc-snackbar--open"],hostVars:1,hostBindings:function(n,r){1&n&&gh("@state.done",function(s){return r.onAnimationEnd(s)}),2&n&&yh("@state",r._animationState)},features:[P],decls:6,vars:3,consts:[[1,"mdc-snackbar__surface"],[1,"mat-mdc-snack-bar-label"],["label",""],["aria-hidden","true"],["cdkPortalOutlet",""]],template:function(n,r){1&n&&(y(0,"div",0)(1,"div",1,2)(3,"div",3),R(4,jJ,0,0,"ng-template",4),w(),ie(5,"div"),w()()),2&n&&(x(5),de("aria-live",r._live)("role",r._role)("id",r._liveElementId))},dependencies:[La],styles:['.mdc-snackbar{display:none;position:fixed;right:0;bottom:0;left:0;align-items:center;justify-content:center;box-sizing:border-box;pointer-events:none;-webkit-tap-highlight-color:rgba(0,0,0,0)}.mdc-snackbar--opening,.mdc-snackbar--open,.mdc-snackbar--closing{display:flex}.mdc-snackbar--open .mdc-snackbar__label,.mdc-snackbar--open .mdc-snackbar__actions{visibility:visible}.mdc-snackbar__surface{padding-left:0;padding-right:8px;display:flex;align-items:center;justify-content:flex-start;box-sizing:border-box;transform:scale(0.8);opacity:0}.mdc-snackbar__surface::before{position:absolute;box-sizing:border-box;width:100%;height:100%;top:0;left:0;border:1px solid rgba(0,0,0,0);border-radius:inherit;content:"";pointer-events:none}@media screen and (forced-colors: active){.mdc-snackbar__surface::before{border-color:CanvasText}}[dir=rtl] .mdc-snackbar__surface,.mdc-snackbar__surface[dir=rtl]{padding-left:8px;padding-right:0}.mdc-snackbar--open .mdc-snackbar__surface{transform:scale(1);opacity:1;pointer-events:auto}.mdc-snackbar--closing .mdc-snackbar__surface{transform:scale(1)}.mdc-snackbar__label{padding-left:16px;padding-right:8px;width:100%;flex-grow:1;box-sizing:border-box;margin:0;visibility:hidden;padding-top:14px;padding-bottom:14px}[dir=rtl] .mdc-snackbar__label,.mdc-snackbar__label[dir=rtl]{padding-left:8px;padding-right:16px}.mdc-snackbar__label::before{display:inline;content:attr(data-mdc-snackbar-label-text)}.mdc-snackbar__actions{display:flex;flex-shrink:0;align-items:center;box-sizing:border-box;visibility:hidden}.mdc-snackbar__action+.mdc-snackbar__dismiss{margin-left:8px;margin-right:0}[dir=rtl] .mdc-snackbar__action+.mdc-snackbar__dismiss,.mdc-snackbar__action+.mdc-snackbar__dismiss[dir=rtl]{margin-left:0;margin-right:8px}.mat-mdc-snack-bar-container{margin:8px;--mdc-snackbar-container-shape:4px;position:static}.mat-mdc-snack-bar-container .mdc-snackbar__surface{min-width:344px}@media(max-width: 480px),(max-width: 344px){.mat-mdc-snack-bar-container .mdc-snackbar__surface{min-width:100%}}@media(max-width: 480px),(max-width: 344px){.mat-mdc-snack-bar-container{width:100vw}}.mat-mdc-snack-bar-container .mdc-snackbar__surface{max-width:672px}.mat-mdc-snack-bar-container .mdc-snackbar__surface{box-shadow:0px 3px 5px -1px rgba(0, 0, 0, 0.2), 0px 6px 10px 0px rgba(0, 0, 0, 0.14), 0px 1px 18px 0px rgba(0, 0, 0, 0.12)}.mat-mdc-snack-bar-container .mdc-snackbar__surface{background-color:var(--mdc-snackbar-container-color)}.mat-mdc-snack-bar-container .mdc-snackbar__surface{border-radius:var(--mdc-snackbar-container-shape)}.mat-mdc-snack-bar-container .mdc-snackbar__label{color:var(--mdc-snackbar-supporting-text-color)}.mat-mdc-snack-bar-container .mdc-snackbar__label{font-size:var(--mdc-snackbar-supporting-text-size);font-family:var(--mdc-snackbar-supporting-text-font);font-weight:var(--mdc-snackbar-supporting-text-weight);line-height:var(--mdc-snackbar-supporting-text-line-height)}.mat-mdc-snack-bar-container .mat-mdc-button.mat-mdc-snack-bar-action:not(:disabled){color:var(--mat-snack-bar-button-color);--mat-mdc-button-persistent-ripple-color: currentColor}.mat-mdc-snack-bar-container .mat-mdc-button.mat-mdc-snack-bar-action:not(:disabled) .mat-ripple-element{background-color:currentColor;opacity:.1}.mat-mdc-snack-bar-container .mdc-snackbar__label::before{display:none}.mat-mdc-snack-bar-handset,.mat-mdc-snack-bar-container,.mat-mdc-snack-bar-label{flex:1 1 auto}.mat-mdc-snack-bar-handset .mdc-snackbar__surface{width:100%}'],encapsulation:2,data:{animation:[GJ.snackBarState]}}),t})(),PF=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275mod=le({type:e}),e.\u0275inj=ae({imports:[ed,qf,vn,jf,ve,ve]}),t})();const NF=new M("mat-snack-bar-default-options",{providedIn:"root",factory:function KJ(){return new Qp}});let XJ=(()=>{var e;class t{get _openedSnackBarRef(){const n=this._parentSnackBar;return n?n._openedSnackBarRef:this._snackBarRefAtThisLevel}set _openedSnackBarRef(n){this._parentSnackBar?this._parentSnackBar._openedSnackBarRef=n:this._snackBarRefAtThisLevel=n}constructor(n,r,o,s,a,c){this._overlay=n,this._live=r,this._injector=o,this._breakpointObserver=s,this._parentSnackBar=a,this._defaultConfig=c,this._snackBarRefAtThisLevel=null}openFromComponent(n,r){return this._attach(n,r)}openFromTemplate(n,r){return this._attach(n,r)}open(n,r="",o){const s={...this._defaultConfig,...o};return s.data={message:n,action:r},s.announcementMessage===n&&(s.announcementMessage=void 0),this.openFromComponent(this.simpleSnackBarComponent,s)}dismiss(){this._openedSnackBarRef&&this._openedSnackBarRef.dismiss()}ngOnDestroy(){this._snackBarRefAtThisLevel&&this._snackBarRefAtThisLevel.dismiss()}_attachSnackBarContainer(n,r){const s=jt.create({parent:r&&r.viewContainerRef&&r.viewContainerRef.injector||this._injector,providers:[{provide:Qp,useValue:r}]}),a=new $f(this.snackBarContainerComponent,r.viewContainerRef,s),c=n.attach(a);return c.instance.snackBarConfig=r,c.instance}_attach(n,r){const o={...new Qp,...this._defaultConfig,...r},s=this._createOverlay(o),a=this._attachSnackBarContainer(s,o),c=new tw(a,s);if(n instanceof ct){const l=new co(n,null,{$implicit:o.data,snackBarRef:c});c.instance=a.attachTemplatePortal(l)}else{const l=this._createInjector(o,c),d=new $f(n,void 0,l),u=a.attachComponentPortal(d);c.instance=u.instance}return this._breakpointObserver.observe("(max-width: 599.98px) and (orientation: portrait)").pipe(ce(s.detachments())).subscribe(l=>{s.overlayElement.classList.toggle(this.handsetCssClass,l.matches)}),o.announcementMessage&&a._onAnnounce.subscribe(()=>{this._live.announce(o.announcementMessage,o.politeness)}),this._animateSnackBar(c,o),this._openedSnackBarRef=c,this._openedSnackBarRef}_animateSnackBar(n,r){n.afterDismissed().subscribe(()=>{this._openedSnackBarRef==n&&(this._openedSnackBarRef=null),r.announcementMessage&&this._live.clear()}),this._openedSnackBarRef?(this._openedSnackBarRef.afterDismissed().subscribe(()=>{n.containerInstance.enter()}),this._openedSnackBarRef.dismiss()):n.containerInstance.enter(),r.duration&&r.duration>0&&n.afterOpened().subscribe(()=>n._dismissAfter(r.duration))}_createOverlay(n){const r=new Jl;r.direction=n.direction;let o=this._overlay.position().global();const s="rtl"===n.direction,a="left"===n.horizontalPosition||"start"===n.horizontalPosition&&!s||"end"===n.horizontalPosition&&s,c=!a&&"center"!==n.horizontalPosition;return a?o.left("0"):c?o.right("0"):o.centerHorizontally(),"top"===n.verticalPosition?o.top("0"):o.bottom("0"),r.positionStrategy=o,this._overlay.create(r)}_createInjector(n,r){return jt.create({parent:n&&n.viewContainerRef&&n.viewContainerRef.injector||this._injector,providers:[{provide:tw,useValue:r},{provide:FF,useValue:n.data}]})}}return(e=t).\u0275fac=function(n){return new(n||e)(D(wi),D(Bv),D(jt),D(Av),D(e,12),D(NF))},e.\u0275prov=V({token:e,factory:e.\u0275fac}),t})(),ZJ=(()=>{var e;class t extends XJ{constructor(n,r,o,s,a,c){super(n,r,o,s,a,c),this.simpleSnackBarComponent=qJ,this.snackBarContainerComponent=YJ,this.handsetCssClass="mat-mdc-snack-bar-handset"}}return(e=t).\u0275fac=function(n){return new(n||e)(D(wi),D(Bv),D(jt),D(Av),D(e,12),D(NF))},e.\u0275prov=V({token:e,factory:e.\u0275fac,providedIn:PF}),t})(),LF=(()=>{var e;class t{constructor(n){this.snackBar=n,this.expiry=1e4}addError(n,r=this.expiry){this.snackBar.open(n,"Dismiss",{duration:r,panelClass:["snack-bar-error"]})}}return(e=t).\u0275fac=function(n){return new(n||e)(D(ZJ))},e.\u0275prov=V({token:e,factory:e.\u0275fac}),t})();class JJ{constructor(t,i){this._document=i;const n=this._textarea=this._document.createElement("textarea"),r=n.style;r.position="fixed",r.top=r.opacity="0",r.left="-999em",n.setAttribute("aria-hidden","true"),n.value=t,n.readOnly=!0,(this._document.fullscreenElement||this._document.body).appendChild(n)}copy(){const t=this._textarea;let i=!1;try{if(t){const n=this._document.activeElement;t.select(),t.setSelectionRange(0,t.value.length),i=this._document.execCommand("copy"),n&&n.focus()}}catch{}return i}destroy(){const t=this._textarea;t&&(t.remove(),this._textarea=void 0)}}let eee=(()=>{var e;class t{constructor(n){this._document=n}copy(n){const r=this.beginCopy(n),o=r.copy();return r.destroy(),o}beginCopy(n){return new JJ(n,this._document)}}return(e=t).\u0275fac=function(n){return new(n||e)(D(he))},e.\u0275prov=V({token:e,factory:e.\u0275fac,providedIn:"root"}),t})();const tee=new M("CDK_COPY_TO_CLIPBOARD_CONFIG");let nee=(()=>{var e;class t{constructor(n,r,o){this._clipboard=n,this._ngZone=r,this.text="",this.attempts=1,this.copied=new U,this._pending=new Set,o&&null!=o.attempts&&(this.attempts=o.attempts)}copy(n=this.attempts){if(n>1){let r=n;const o=this._clipboard.beginCopy(this.text);this._pending.add(o);const s=()=>{const a=o.copy();a||! --r||this._destroyed?(this._currentTimeout=null,this._pending.delete(o),o.destroy(),this.copied.emit(a)):this._currentTimeout=this._ngZone.runOutsideAngular(()=>setTimeout(s,1))};s()}else this.copied.emit(this._clipboard.copy(this.text))}ngOnDestroy(){this._currentTimeout&&clearTimeout(this._currentTimeout),this._pending.forEach(n=>n.destroy()),this._pending.clear(),this._destroyed=!0}}return(e=t).\u0275fac=function(n){return new(n||e)(m(eee),m(G),m(tee,8))},e.\u0275dir=T({type:e,selectors:[["","cdkCopyToClipboard",""]],hostBindings:function(n,r){1&n&&$("click",function(){return r.copy()})},inputs:{text:["cdkCopyToClipboard","text"],attempts:["cdkCopyToClipboardAttempts","attempts"]},outputs:{copied:"cdkCopyToClipboardCopied"}}),t})(),iee=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275mod=le({type:e}),e.\u0275inj=ae({}),t})();class ree extends Y{constructor(t=1/0,i=1/0,n=kv){super(),this._bufferSize=t,this._windowTime=i,this._timestampProvider=n,this._buffer=[],this._infiniteTimeWindow=!0,this._infiniteTimeWindow=i===1/0,this._bufferSize=Math.max(1,t),this._windowTime=Math.max(1,i)}next(t){const{isStopped:i,_buffer:n,_infiniteTimeWindow:r,_timestampProvider:o,_windowTime:s}=this;i||(n.push(t),!r&&n.push(o.now()+s)),this._trimBuffer(),super.next(t)}_subscribe(t){this._throwIfClosed(),this._trimBuffer();const i=this._innerSubscribe(t),{_infiniteTimeWindow:n,_buffer:r}=this,o=r.slice();for(let s=0;s<o.length&&!t.closed;s+=n?1:2)t.next(o[s]);return this._checkFinalizedStatuses(t),i}_trimBuffer(){const{_bufferSize:t,_timestampProvider:i,_buffer:n,_infiniteTimeWindow:r}=this,o=(r?1:2)*t;if(t<1/0&&o<n.length&&n.splice(0,n.length-o),!r){const s=i.now();let a=0;for(let c=1;c<n.length&&n[c]<=s;c+=2)a=c;a&&n.splice(0,a+1)}}}class see{constructor(t){this._box=t,this._destroyed=new Y,this._resizeSubject=new Y,this._elementObservables=new Map,typeof ResizeObserver<"u"&&(this._resizeObserver=new ResizeObserver(i=>this._resizeSubject.next(i)))}observe(t){return this._elementObservables.has(t)||this._elementObservables.set(t,new Me(i=>{const n=this._resizeSubject.subscribe(i);return this._resizeObserver?.observe(t,{box:this._box}),()=>{this._resizeObserver?.unobserve(t),n.unsubscribe(),this._elementObservables.delete(t)}}).pipe(Ve(i=>i.some(n=>n.target===t)),function oee(e,t,i){let n,r=!1;return e&&"object"==typeof e?({bufferSize:n=1/0,windowTime:t=1/0,refCount:r=!1,scheduler:i}=e):n=e??1/0,iu({connector:()=>new ree(n,t,i),resetOnError:!0,resetOnComplete:!1,resetOnRefCountZero:r})}({bufferSize:1,refCount:!0}),ce(this._destroyed))),this._elementObservables.get(t)}destroy(){this._destroyed.next(),this._destroyed.complete(),this._resizeSubject.complete(),this._elementObservables.clear()}}let aee=(()=>{var e;class t{constructor(){this._observers=new Map,this._ngZone=j(G)}ngOnDestroy(){for(const[,n]of this._observers)n.destroy();this._observers.clear()}observe(n,r){const o=r?.box||"content-box";return this._observers.has(o)||this._observers.set(o,new see(o)),this._observers.get(o).observe(n)}}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275prov=V({token:e,factory:e.\u0275fac,providedIn:"root"}),t})();const cee=["notch"],lee=["matFormFieldNotchedOutline",""],dee=["*"],uee=["textField"],hee=["iconPrefixContainer"],fee=["textPrefixContainer"];function pee(e,t){1&e&&ie(0,"span",19)}function mee(e,t){if(1&e&&(y(0,"label",17),X(1,1),R(2,pee,1,0,"span",18),w()),2&e){const i=B(2);E("floating",i._shouldLabelFloat())("monitorResize",i._hasOutline())("id",i._labelId),de("for",i._control.id),x(2),E("ngIf",!i.hideRequiredMarker&&i._control.required)}}function gee(e,t){1&e&&R(0,mee,3,5,"label",16),2&e&&E("ngIf",B()._hasFloatingLabel())}function _ee(e,t){1&e&&ie(0,"div",20)}function bee(e,t){}function vee(e,t){1&e&&R(0,bee,0,0,"ng-template",22),2&e&&(B(2),E("ngTemplateOutlet",hn(1)))}function yee(e,t){if(1&e&&(y(0,"div",21),R(1,vee,1,1,"ng-template",9),w()),2&e){const i=B();E("matFormFieldNotchedOutlineOpen",i._shouldLabelFloat()),x(1),E("ngIf",!i._forceDisplayInfixLabel())}}function wee(e,t){1&e&&(y(0,"div",23,24),X(2,2),w())}function xee(e,t){1&e&&(y(0,"div",25,26),X(2,3),w())}function Cee(e,t){}function Dee(e,t){1&e&&R(0,Cee,0,0,"ng-template",22),2&e&&(B(),E("ngTemplateOutlet",hn(1)))}function Eee(e,t){1&e&&(y(0,"div",27),X(1,4),w())}function See(e,t){1&e&&(y(0,"div",28),X(1,5),w())}function kee(e,t){1&e&&ie(0,"div",29)}function Tee(e,t){1&e&&(y(0,"div",30),X(1,6),w()),2&e&&E("@transitionMessages",B()._subscriptAnimationState)}function Mee(e,t){if(1&e&&(y(0,"mat-hint",34),A(1),w()),2&e){const i=B(2);E("id",i._hintLabelId),x(1),bt(i.hintLabel)}}function Iee(e,t){if(1&e&&(y(0,"div",31),R(1,Mee,2,2,"mat-hint",32),X(2,7),ie(3,"div",33),X(4,8),w()),2&e){const i=B();E("@transitionMessages",i._subscriptAnimationState),x(1),E("ngIf",i.hintLabel)}}const Aee=["*",[["mat-label"]],[["","matPrefix",""],["","matIconPrefix",""]],[["","matTextPrefix",""]],[["","matTextSuffix",""]],[["","matSuffix",""],["","matIconSuffix",""]],[["mat-error"],["","matError",""]],[["mat-hint",3,"align","end"]],[["mat-hint","align","end"]]],Ree=["*","mat-label","[matPrefix], [matIconPrefix]","[matTextPrefix]","[matTextSuffix]","[matSuffix], [matIconSuffix]","mat-error, [matError]","mat-hint:not([align='end'])","mat-hint[align='end']"];let nw=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275dir=T({type:e,selectors:[["mat-label"]]}),t})();const Oee=new M("MatError");let Fee=0,BF=(()=>{var e;class t{constructor(){this.align="start",this.id="mat-mdc-hint-"+Fee++}}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275dir=T({type:e,selectors:[["mat-hint"]],hostAttrs:[1,"mat-mdc-form-field-hint","mat-mdc-form-field-bottom-align"],hostVars:4,hostBindings:function(n,r){2&n&&(pi("id",r.id),de("align",null),se("mat-mdc-form-field-hint-end","end"===r.align))},inputs:{align:"align",id:"id"}}),t})();const Pee=new M("MatPrefix"),VF=new M("MatSuffix");let Nee=(()=>{var e;class t{constructor(){this._isText=!1}set _isTextSelector(n){this._isText=!0}}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275dir=T({type:e,selectors:[["","matSuffix",""],["","matIconSuffix",""],["","matTextSuffix",""]],inputs:{_isTextSelector:["matTextSuffix","_isTextSelector"]},features:[Z([{provide:VF,useExisting:e}])]}),t})();const jF=new M("FloatingLabelParent");let HF=(()=>{var e;class t{get floating(){return this._floating}set floating(n){this._floating=n,this.monitorResize&&this._handleResize()}get monitorResize(){return this._monitorResize}set monitorResize(n){this._monitorResize=n,this._monitorResize?this._subscribeToResize():this._resizeSubscription.unsubscribe()}constructor(n){this._elementRef=n,this._floating=!1,this._monitorResize=!1,this._resizeObserver=j(aee),this._ngZone=j(G),this._parent=j(jF),this._resizeSubscription=new Ae}ngOnDestroy(){this._resizeSubscription.unsubscribe()}getWidth(){return function Lee(e){if(null!==e.offsetParent)return e.scrollWidth;const i=e.cloneNode(!0);i.style.setProperty("position","absolute"),i.style.setProperty("transform","translate(-9999px, -9999px)"),document.documentElement.appendChild(i);const n=i.scrollWidth;return i.remove(),n}(this._elementRef.nativeElement)}get element(){return this._elementRef.nativeElement}_handleResize(){setTimeout(()=>this._parent._handleLabelResized())}_subscribeToResize(){this._resizeSubscription.unsubscribe(),this._ngZone.runOutsideAngular(()=>{this._resizeSubscription=this._resizeObserver.observe(this._elementRef.nativeElement,{box:"border-box"}).subscribe(()=>this._handleResize())})}}return(e=t).\u0275fac=function(n){return new(n||e)(m(W))},e.\u0275dir=T({type:e,selectors:[["label","matFormFieldFloatingLabel",""]],hostAttrs:[1,"mdc-floating-label","mat-mdc-floating-label"],hostVars:2,hostBindings:function(n,r){2&n&&se("mdc-floating-label--float-above",r.floating)},inputs:{floating:"floating",monitorResize:"monitorResize"}}),t})();const zF="mdc-line-ripple--active",Yp="mdc-line-ripple--deactivating";let UF=(()=>{var e;class t{constructor(n,r){this._elementRef=n,this._handleTransitionEnd=o=>{const s=this._elementRef.nativeElement.classList,a=s.contains(Yp);"opacity"===o.propertyName&&a&&s.remove(zF,Yp)},r.runOutsideAngular(()=>{n.nativeElement.addEventListener("transitionend",this._handleTransitionEnd)})}activate(){const n=this._elementRef.nativeElement.classList;n.remove(Yp),n.add(zF)}deactivate(){this._elementRef.nativeElement.classList.add(Yp)}ngOnDestroy(){this._elementRef.nativeElement.removeEventListener("transitionend",this._handleTransitionEnd)}}return(e=t).\u0275fac=function(n){return new(n||e)(m(W),m(G))},e.\u0275dir=T({type:e,selectors:[["div","matFormFieldLineRipple",""]],hostAttrs:[1,"mdc-line-ripple"]}),t})(),$F=(()=>{var e;class t{constructor(n,r){this._elementRef=n,this._ngZone=r,this.open=!1}ngAfterViewInit(){const n=this._elementRef.nativeElement.querySelector(".mdc-floating-label");n?(this._elementRef.nativeElement.classList.add("mdc-notched-outline--upgraded"),"function"==typeof requestAnimationFrame&&(n.style.transitionDuration="0s",this._ngZone.runOutsideAngular(()=>{requestAnimationFrame(()=>n.style.transitionDuration="")}))):this._elementRef.nativeElement.classList.add("mdc-notched-outline--no-label")}_setNotchWidth(n){this._notch.nativeElement.style.width=this.open&&n?`calc(${n}px * var(--mat-mdc-form-field-floating-label-scale, 0.75) + 9px)`:""}}return(e=t).\u0275fac=function(n){return new(n||e)(m(W),m(G))},e.\u0275cmp=fe({type:e,selectors:[["div","matFormFieldNotchedOutline",""]],viewQuery:function(n,r){if(1&n&&ke(cee,5),2&n){let o;H(o=z())&&(r._notch=o.first)}},hostAttrs:[1,"mdc-notched-outline"],hostVars:2,hostBindings:function(n,r){2&n&&se("mdc-notched-outline--notched",r.open)},inputs:{open:["matFormFieldNotchedOutlineOpen","open"]},attrs:lee,ngContentSelectors:dee,decls:5,vars:0,consts:[[1,"mdc-notched-outline__leading"],[1,"mdc-notched-outline__notch"],["notch",""],[1,"mdc-notched-outline__trailing"]],template:function(n,r){1&n&&(ze(),ie(0,"div",0),y(1,"div",1,2),X(3),w(),ie(4,"div",3))},encapsulation:2,changeDetection:0}),t})();const Bee={transitionMessages:ni("transitionMessages",[qt("enter",je({opacity:1,transform:"translateY(0%)"})),Bt("void => enter",[je({opacity:0,transform:"translateY(-5px)"}),Lt("300ms cubic-bezier(0.55, 0, 0.55, 0.2)")])])};let Kp=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275dir=T({type:e}),t})();const Vd=new M("MatFormField"),Vee=new M("MAT_FORM_FIELD_DEFAULT_OPTIONS");let qF=0,QF=(()=>{var e;class t{get hideRequiredMarker(){return this._hideRequiredMarker}set hideRequiredMarker(n){this._hideRequiredMarker=Q(n)}get floatLabel(){return this._floatLabel||this._defaults?.floatLabel||"auto"}set floatLabel(n){n!==this._floatLabel&&(this._floatLabel=n,this._changeDetectorRef.markForCheck())}get appearance(){return this._appearance}set appearance(n){const r=this._appearance;this._appearance=n||this._defaults?.appearance||"fill","outline"===this._appearance&&this._appearance!==r&&(this._needsOutlineLabelOffsetUpdateOnStable=!0)}get subscriptSizing(){return this._subscriptSizing||this._defaults?.subscriptSizing||"fixed"}set subscriptSizing(n){this._subscriptSizing=n||this._defaults?.subscriptSizing||"fixed"}get hintLabel(){return this._hintLabel}set hintLabel(n){this._hintLabel=n,this._processHints()}get _control(){return this._explicitFormFieldControl||this._formFieldControl}set _control(n){this._explicitFormFieldControl=n}constructor(n,r,o,s,a,c,l,d){this._elementRef=n,this._changeDetectorRef=r,this._ngZone=o,this._dir=s,this._platform=a,this._defaults=c,this._animationMode=l,this._hideRequiredMarker=!1,this.color="primary",this._appearance="fill",this._subscriptSizing=null,this._hintLabel="",this._hasIconPrefix=!1,this._hasTextPrefix=!1,this._hasIconSuffix=!1,this._hasTextSuffix=!1,this._labelId="mat-mdc-form-field-label-"+qF++,this._hintLabelId="mat-mdc-hint-"+qF++,this._subscriptAnimationState="",this._destroyed=new Y,this._isFocused=null,this._needsOutlineLabelOffsetUpdateOnStable=!1,c&&(c.appearance&&(this.appearance=c.appearance),this._hideRequiredMarker=!!c?.hideRequiredMarker,c.color&&(this.color=c.color))}ngAfterViewInit(){this._updateFocusState(),this._subscriptAnimationState="enter",this._changeDetectorRef.detectChanges()}ngAfterContentInit(){this._assertFormFieldControl(),this._initializeControl(),this._initializeSubscript(),this._initializePrefixAndSuffix(),this._initializeOutlineLabelOffsetSubscriptions()}ngAfterContentChecked(){this._assertFormFieldControl()}ngOnDestroy(){this._destroyed.next(),this._destroyed.complete()}getLabelId(){return this._hasFloatingLabel()?this._labelId:null}getConnectedOverlayOrigin(){return this._textField||this._elementRef}_animateAndLockLabel(){this._hasFloatingLabel()&&(this.floatLabel="always")}_initializeControl(){const n=this._control;n.controlType&&this._elementRef.nativeElement.classList.add(`mat-mdc-form-field-type-${n.controlType}`),n.stateChanges.subscribe(()=>{this._updateFocusState(),this._syncDescribedByIds(),this._changeDetectorRef.markForCheck()}),n.ngControl&&n.ngControl.valueChanges&&n.ngControl.valueChanges.pipe(ce(this._destroyed)).subscribe(()=>this._changeDetectorRef.markForCheck())}_checkPrefixAndSuffixTypes(){this._hasIconPrefix=!!this._prefixChildren.find(n=>!n._isText),this._hasTextPrefix=!!this._prefixChildren.find(n=>n._isText),this._hasIconSuffix=!!this._suffixChildren.find(n=>!n._isText),this._hasTextSuffix=!!this._suffixChildren.find(n=>n._isText)}_initializePrefixAndSuffix(){this._checkPrefixAndSuffixTypes(),Ot(this._prefixChildren.changes,this._suffixChildren.changes).subscribe(()=>{this._checkPrefixAndSuffixTypes(),this._changeDetectorRef.markForCheck()})}_initializeSubscript(){this._hintChildren.changes.subscribe(()=>{this._processHints(),this._changeDetectorRef.markForCheck()}),this._errorChildren.changes.subscribe(()=>{this._syncDescribedByIds(),this._changeDetectorRef.markForCheck()}),this._validateHints(),this._syncDescribedByIds()}_assertFormFieldControl(){}_updateFocusState(){this._control.focused&&!this._isFocused?(this._isFocused=!0,this._lineRipple?.activate()):!this._control.focused&&(this._isFocused||null===this._isFocused)&&(this._isFocused=!1,this._lineRipple?.deactivate()),this._textField?.nativeElement.classList.toggle("mdc-text-field--focused",this._control.focused)}_initializeOutlineLabelOffsetSubscriptions(){this._prefixChildren.changes.subscribe(()=>this._needsOutlineLabelOffsetUpdateOnStable=!0),this._ngZone.runOutsideAngular(()=>{this._ngZone.onStable.pipe(ce(this._destroyed)).subscribe(()=>{this._needsOutlineLabelOffsetUpdateOnStable&&(this._needsOutlineLabelOffsetUpdateOnStable=!1,this._updateOutlineLabelOffset())})}),this._dir.change.pipe(ce(this._destroyed)).subscribe(()=>this._needsOutlineLabelOffsetUpdateOnStable=!0)}_shouldAlwaysFloat(){return"always"===this.floatLabel}_hasOutline(){return"outline"===this.appearance}_forceDisplayInfixLabel(){return!this._platform.isBrowser&&this._prefixChildren.length&&!this._shouldLabelFloat()}_hasFloatingLabel(){return!!this._labelChildNonStatic||!!this._labelChildStatic}_shouldLabelFloat(){return this._control.shouldLabelFloat||this._shouldAlwaysFloat()}_shouldForward(n){const r=this._control?this._control.ngControl:null;return r&&r[n]}_getDisplayedMessages(){return this._errorChildren&&this._errorChildren.length>0&&this._control.errorState?"error":"hint"}_handleLabelResized(){this._refreshOutlineNotchWidth()}_refreshOutlineNotchWidth(){this._hasOutline()&&this._floatingLabel&&this._shouldLabelFloat()?this._notchedOutline?._setNotchWidth(this._floatingLabel.getWidth()):this._notchedOutline?._setNotchWidth(0)}_processHints(){this._validateHints(),this._syncDescribedByIds()}_validateHints(){}_syncDescribedByIds(){if(this._control){let n=[];if(this._control.userAriaDescribedBy&&"string"==typeof this._control.userAriaDescribedBy&&n.push(...this._control.userAriaDescribedBy.split(" ")),"hint"===this._getDisplayedMessages()){const r=this._hintChildren?this._hintChildren.find(s=>"start"===s.align):null,o=this._hintChildren?this._hintChildren.find(s=>"end"===s.align):null;r?n.push(r.id):this._hintLabel&&n.push(this._hintLabelId),o&&n.push(o.id)}else this._errorChildren&&n.push(...this._errorChildren.map(r=>r.id));this._control.setDescribedByIds(n)}}_updateOutlineLabelOffset(){if(!this._platform.isBrowser||!this._hasOutline()||!this._floatingLabel)return;const n=this._floatingLabel.element;if(!this._iconPrefixContainer&&!this._textPrefixContainer)return void(n.style.transform="");if(!this._isAttachedToDom())return void(this._needsOutlineLabelOffsetUpdateOnStable=!0);const r=this._iconPrefixContainer?.nativeElement,o=this._textPrefixContainer?.nativeElement,s=r?.getBoundingClientRect().width??0,a=o?.getBoundingClientRect().width??0;n.style.transform=`var(\n        --mat-mdc-form-field-label-transform,\n        translateY(-50%) translateX(calc(${"rtl"===this._dir.value?"-1":"1"} * (${s+a}px + var(--mat-mdc-form-field-label-offset-x, 0px))))\n    )`}_isAttachedToDom(){const n=this._elementRef.nativeElement;if(n.getRootNode){const r=n.getRootNode();return r&&r!==n}return document.documentElement.contains(n)}}return(e=t).\u0275fac=function(n){return new(n||e)(m(W),m(Fe),m(G),m(fn),m(nt),m(Vee,8),m(_t,8),m(he))},e.\u0275cmp=fe({type:e,selectors:[["mat-form-field"]],contentQueries:function(n,r,o){if(1&n&&(Ee(o,nw,5),Ee(o,nw,7),Ee(o,Kp,5),Ee(o,Pee,5),Ee(o,VF,5),Ee(o,Oee,5),Ee(o,BF,5)),2&n){let s;H(s=z())&&(r._labelChildNonStatic=s.first),H(s=z())&&(r._labelChildStatic=s.first),H(s=z())&&(r._formFieldControl=s.first),H(s=z())&&(r._prefixChildren=s),H(s=z())&&(r._suffixChildren=s),H(s=z())&&(r._errorChildren=s),H(s=z())&&(r._hintChildren=s)}},viewQuery:function(n,r){if(1&n&&(ke(uee,5),ke(hee,5),ke(fee,5),ke(HF,5),ke($F,5),ke(UF,5)),2&n){let o;H(o=z())&&(r._textField=o.first),H(o=z())&&(r._iconPrefixContainer=o.first),H(o=z())&&(r._textPrefixContainer=o.first),H(o=z())&&(r._floatingLabel=o.first),H(o=z())&&(r._notchedOutline=o.first),H(o=z())&&(r._lineRipple=o.first)}},hostAttrs:[1,"mat-mdc-form-field"],hostVars:42,hostBindings:function(n,r){2&n&&se("mat-mdc-form-field-label-always-float",r._shouldAlwaysFloat())("mat-mdc-form-field-has-icon-prefix",r._hasIconPrefix)("mat-mdc-form-field-has-icon-suffix",r._hasIconSuffix)("mat-form-field-invalid",r._control.errorState)("mat-form-field-disabled",r._control.disabled)("mat-form-field-autofilled",r._control.autofilled)("mat-form-field-no-animations","NoopAnimations"===r._animationMode)("mat-form-field-appearance-fill","fill"==r.appearance)("mat-form-field-appearance-outline","outline"==r.appearance)("mat-form-field-hide-placeholder",r._hasFloatingLabel()&&!r._shouldLabelFloat())("mat-focused",r._control.focused)("mat-primary","accent"!==r.color&&"warn"!==r.color)("mat-accent","accent"===r.color)("mat-warn","warn"===r.color)("ng-untouched",r._shouldForward("untouched"))("ng-touched",r._shouldForward("touched"))("ng-pristine",r._shouldForward("pristine"))("ng-dirty",r._shouldForward("dirty"))("ng-valid",r._shouldForward("valid"))("ng-invalid",r._shouldForward("invalid"))("ng-pending",r._shouldForward("pending"))},inputs:{hideRequiredMarker:"hideRequiredMarker",color:"color",floatLabel:"floatLabel",appearance:"appearance",subscriptSizing:"subscriptSizing",hintLabel:"hintLabel"},exportAs:["matFormField"],features:[Z([{provide:Vd,useExisting:e},{provide:jF,useExisting:e}])],ngContentSelectors:Ree,decls:18,vars:23,consts:[["labelTemplate",""],[1,"mat-mdc-text-field-wrapper","mdc-text-field",3,"click"],["textField",""],["class","mat-mdc-form-field-focus-overlay",4,"ngIf"],[1,"mat-mdc-form-field-flex"],["matFormFieldNotchedOutline","",3,"matFormFieldNotchedOutlineOpen",4,"ngIf"],["class","mat-mdc-form-field-icon-prefix",4,"ngIf"],["class","mat-mdc-form-field-text-prefix",4,"ngIf"],[1,"mat-mdc-form-field-infix"],[3,"ngIf"],["class","mat-mdc-form-field-text-suffix",4,"ngIf"],["class","mat-mdc-form-field-icon-suffix",4,"ngIf"],["matFormFieldLineRipple","",4,"ngIf"],[1,"mat-mdc-form-field-subscript-wrapper","mat-mdc-form-field-bottom-align",3,"ngSwitch"],["class","mat-mdc-form-field-error-wrapper",4,"ngSwitchCase"],["class","mat-mdc-form-field-hint-wrapper",4,"ngSwitchCase"],["matFormFieldFloatingLabel","",3,"floating","monitorResize","id",4,"ngIf"],["matFormFieldFloatingLabel","",3,"floating","monitorResize","id"],["aria-hidden","true","class","mat-mdc-form-field-required-marker mdc-floating-label--required",4,"ngIf"],["aria-hidden","true",1,"mat-mdc-form-field-required-marker","mdc-floating-label--required"],[1,"mat-mdc-form-field-focus-overlay"],["matFormFieldNotchedOutline","",3,"matFormFieldNotchedOutlineOpen"],[3,"ngTemplateOutlet"],[1,"mat-mdc-form-field-icon-prefix"],["iconPrefixContainer",""],[1,"mat-mdc-form-field-text-prefix"],["textPrefixContainer",""],[1,"mat-mdc-form-field-text-suffix"],[1,"mat-mdc-form-field-icon-suffix"],["matFormFieldLineRipple",""],[1,"mat-mdc-form-field-error-wrapper"],[1,"mat-mdc-form-field-hint-wrapper"],[3,"id",4,"ngIf"],[1,"mat-mdc-form-field-hint-spacer"],[3,"id"]],template:function(n,r){1&n&&(ze(Aee),R(0,gee,1,1,"ng-template",null,0,kh),y(2,"div",1,2),$("click",function(s){return r._control.onContainerClick(s)}),R(4,_ee,1,0,"div",3),y(5,"div",4),R(6,yee,2,2,"div",5),R(7,wee,3,0,"div",6),R(8,xee,3,0,"div",7),y(9,"div",8),R(10,Dee,1,1,"ng-template",9),X(11),w(),R(12,Eee,2,0,"div",10),R(13,See,2,0,"div",11),w(),R(14,kee,1,0,"div",12),w(),y(15,"div",13),R(16,Tee,2,1,"div",14),R(17,Iee,5,2,"div",15),w()),2&n&&(x(2),se("mdc-text-field--filled",!r._hasOutline())("mdc-text-field--outlined",r._hasOutline())("mdc-text-field--no-label",!r._hasFloatingLabel())("mdc-text-field--disabled",r._control.disabled)("mdc-text-field--invalid",r._control.errorState),x(2),E("ngIf",!r._hasOutline()&&!r._control.disabled),x(2),E("ngIf",r._hasOutline()),x(1),E("ngIf",r._hasIconPrefix),x(1),E("ngIf",r._hasTextPrefix),x(2),E("ngIf",!r._hasOutline()||r._forceDisplayInfixLabel()),x(2),E("ngIf",r._hasTextSuffix),x(1),E("ngIf",r._hasIconSuffix),x(1),E("ngIf",!r._hasOutline()),x(1),se("mat-mdc-form-field-subscript-dynamic-size","dynamic"===r.subscriptSizing),E("ngSwitch",r._getDisplayedMessages()),x(1),E("ngSwitchCase","error"),x(1),E("ngSwitchCase","hint"))},dependencies:[_i,QT,Sa,Qh,BF,HF,$F,UF],styles:['.mdc-text-field{border-top-left-radius:4px;border-top-left-radius:var(--mdc-shape-small, 4px);border-top-right-radius:4px;border-top-right-radius:var(--mdc-shape-small, 4px);border-bottom-right-radius:0;border-bottom-left-radius:0;display:inline-flex;align-items:baseline;padding:0 16px;position:relative;box-sizing:border-box;overflow:hidden;will-change:opacity,transform,color}.mdc-text-field .mdc-floating-label{top:50%;transform:translateY(-50%);pointer-events:none}.mdc-text-field__input{height:28px;width:100%;min-width:0;border:none;border-radius:0;background:none;appearance:none;padding:0}.mdc-text-field__input::-ms-clear{display:none}.mdc-text-field__input::-webkit-calendar-picker-indicator{display:none}.mdc-text-field__input:focus{outline:none}.mdc-text-field__input:invalid{box-shadow:none}@media all{.mdc-text-field__input::placeholder{opacity:0}}@media all{.mdc-text-field__input:-ms-input-placeholder{opacity:0}}@media all{.mdc-text-field--no-label .mdc-text-field__input::placeholder,.mdc-text-field--focused .mdc-text-field__input::placeholder{opacity:1}}@media all{.mdc-text-field--no-label .mdc-text-field__input:-ms-input-placeholder,.mdc-text-field--focused .mdc-text-field__input:-ms-input-placeholder{opacity:1}}.mdc-text-field__affix{height:28px;opacity:0;white-space:nowrap}.mdc-text-field--label-floating .mdc-text-field__affix,.mdc-text-field--no-label .mdc-text-field__affix{opacity:1}@supports(-webkit-hyphens: none){.mdc-text-field--outlined .mdc-text-field__affix{align-items:center;align-self:center;display:inline-flex;height:100%}}.mdc-text-field__affix--prefix{padding-left:0;padding-right:2px}[dir=rtl] .mdc-text-field__affix--prefix,.mdc-text-field__affix--prefix[dir=rtl]{padding-left:2px;padding-right:0}.mdc-text-field--end-aligned .mdc-text-field__affix--prefix{padding-left:0;padding-right:12px}[dir=rtl] .mdc-text-field--end-aligned .mdc-text-field__affix--prefix,.mdc-text-field--end-aligned .mdc-text-field__affix--prefix[dir=rtl]{padding-left:12px;padding-right:0}.mdc-text-field__affix--suffix{padding-left:12px;padding-right:0}[dir=rtl] .mdc-text-field__affix--suffix,.mdc-text-field__affix--suffix[dir=rtl]{padding-left:0;padding-right:12px}.mdc-text-field--end-aligned .mdc-text-field__affix--suffix{padding-left:2px;padding-right:0}[dir=rtl] .mdc-text-field--end-aligned .mdc-text-field__affix--suffix,.mdc-text-field--end-aligned .mdc-text-field__affix--suffix[dir=rtl]{padding-left:0;padding-right:2px}.mdc-text-field--filled{height:56px}.mdc-text-field--filled::before{display:inline-block;width:0;height:40px;content:"";vertical-align:0}.mdc-text-field--filled .mdc-floating-label{left:16px;right:initial}[dir=rtl] .mdc-text-field--filled .mdc-floating-label,.mdc-text-field--filled .mdc-floating-label[dir=rtl]{left:initial;right:16px}.mdc-text-field--filled .mdc-floating-label--float-above{transform:translateY(-106%) scale(0.75)}.mdc-text-field--filled.mdc-text-field--no-label .mdc-text-field__input{height:100%}.mdc-text-field--filled.mdc-text-field--no-label .mdc-floating-label{display:none}.mdc-text-field--filled.mdc-text-field--no-label::before{display:none}@supports(-webkit-hyphens: none){.mdc-text-field--filled.mdc-text-field--no-label .mdc-text-field__affix{align-items:center;align-self:center;display:inline-flex;height:100%}}.mdc-text-field--outlined{height:56px;overflow:visible}.mdc-text-field--outlined .mdc-floating-label--float-above{transform:translateY(-37.25px) scale(1)}.mdc-text-field--outlined .mdc-floating-label--float-above{font-size:.75rem}.mdc-text-field--outlined.mdc-notched-outline--upgraded .mdc-floating-label--float-above,.mdc-text-field--outlined .mdc-notched-outline--upgraded .mdc-floating-label--float-above{transform:translateY(-34.75px) scale(0.75)}.mdc-text-field--outlined.mdc-notched-outline--upgraded .mdc-floating-label--float-above,.mdc-text-field--outlined .mdc-notched-outline--upgraded .mdc-floating-label--float-above{font-size:1rem}.mdc-text-field--outlined .mdc-text-field__input{height:100%}.mdc-text-field--outlined .mdc-notched-outline .mdc-notched-outline__leading{border-top-left-radius:4px;border-top-left-radius:var(--mdc-shape-small, 4px);border-top-right-radius:0;border-bottom-right-radius:0;border-bottom-left-radius:4px;border-bottom-left-radius:var(--mdc-shape-small, 4px)}[dir=rtl] .mdc-text-field--outlined .mdc-notched-outline .mdc-notched-outline__leading,.mdc-text-field--outlined .mdc-notched-outline .mdc-notched-outline__leading[dir=rtl]{border-top-left-radius:0;border-top-right-radius:4px;border-top-right-radius:var(--mdc-shape-small, 4px);border-bottom-right-radius:4px;border-bottom-right-radius:var(--mdc-shape-small, 4px);border-bottom-left-radius:0}@supports(top: max(0%)){.mdc-text-field--outlined .mdc-notched-outline .mdc-notched-outline__leading{width:max(12px, var(--mdc-shape-small, 4px))}}@supports(top: max(0%)){.mdc-text-field--outlined .mdc-notched-outline .mdc-notched-outline__notch{max-width:calc(100% - max(12px, var(--mdc-shape-small, 4px))*2)}}.mdc-text-field--outlined .mdc-notched-outline .mdc-notched-outline__trailing{border-top-left-radius:0;border-top-right-radius:4px;border-top-right-radius:var(--mdc-shape-small, 4px);border-bottom-right-radius:4px;border-bottom-right-radius:var(--mdc-shape-small, 4px);border-bottom-left-radius:0}[dir=rtl] .mdc-text-field--outlined .mdc-notched-outline .mdc-notched-outline__trailing,.mdc-text-field--outlined .mdc-notched-outline .mdc-notched-outline__trailing[dir=rtl]{border-top-left-radius:4px;border-top-left-radius:var(--mdc-shape-small, 4px);border-top-right-radius:0;border-bottom-right-radius:0;border-bottom-left-radius:4px;border-bottom-left-radius:var(--mdc-shape-small, 4px)}@supports(top: max(0%)){.mdc-text-field--outlined{padding-left:max(16px, calc(var(--mdc-shape-small, 4px) + 4px))}}@supports(top: max(0%)){.mdc-text-field--outlined{padding-right:max(16px, var(--mdc-shape-small, 4px))}}@supports(top: max(0%)){.mdc-text-field--outlined+.mdc-text-field-helper-line{padding-left:max(16px, calc(var(--mdc-shape-small, 4px) + 4px))}}@supports(top: max(0%)){.mdc-text-field--outlined+.mdc-text-field-helper-line{padding-right:max(16px, var(--mdc-shape-small, 4px))}}.mdc-text-field--outlined.mdc-text-field--with-leading-icon{padding-left:0}@supports(top: max(0%)){.mdc-text-field--outlined.mdc-text-field--with-leading-icon{padding-right:max(16px, var(--mdc-shape-small, 4px))}}[dir=rtl] .mdc-text-field--outlined.mdc-text-field--with-leading-icon,.mdc-text-field--outlined.mdc-text-field--with-leading-icon[dir=rtl]{padding-right:0}@supports(top: max(0%)){[dir=rtl] .mdc-text-field--outlined.mdc-text-field--with-leading-icon,.mdc-text-field--outlined.mdc-text-field--with-leading-icon[dir=rtl]{padding-left:max(16px, var(--mdc-shape-small, 4px))}}.mdc-text-field--outlined.mdc-text-field--with-trailing-icon{padding-right:0}@supports(top: max(0%)){.mdc-text-field--outlined.mdc-text-field--with-trailing-icon{padding-left:max(16px, calc(var(--mdc-shape-small, 4px) + 4px))}}[dir=rtl] .mdc-text-field--outlined.mdc-text-field--with-trailing-icon,.mdc-text-field--outlined.mdc-text-field--with-trailing-icon[dir=rtl]{padding-left:0}@supports(top: max(0%)){[dir=rtl] .mdc-text-field--outlined.mdc-text-field--with-trailing-icon,.mdc-text-field--outlined.mdc-text-field--with-trailing-icon[dir=rtl]{padding-right:max(16px, calc(var(--mdc-shape-small, 4px) + 4px))}}.mdc-text-field--outlined.mdc-text-field--with-leading-icon.mdc-text-field--with-trailing-icon{padding-left:0;padding-right:0}.mdc-text-field--outlined .mdc-notched-outline--notched .mdc-notched-outline__notch{padding-top:1px}.mdc-text-field--outlined .mdc-floating-label{left:4px;right:initial}[dir=rtl] .mdc-text-field--outlined .mdc-floating-label,.mdc-text-field--outlined .mdc-floating-label[dir=rtl]{left:initial;right:4px}.mdc-text-field--outlined .mdc-text-field__input{display:flex;border:none !important;background-color:rgba(0,0,0,0)}.mdc-text-field--outlined .mdc-notched-outline{z-index:1}.mdc-text-field--textarea{flex-direction:column;align-items:center;width:auto;height:auto;padding:0}.mdc-text-field--textarea .mdc-floating-label{top:19px}.mdc-text-field--textarea .mdc-floating-label:not(.mdc-floating-label--float-above){transform:none}.mdc-text-field--textarea .mdc-text-field__input{flex-grow:1;height:auto;min-height:1.5rem;overflow-x:hidden;overflow-y:auto;box-sizing:border-box;resize:none;padding:0 16px}.mdc-text-field--textarea.mdc-text-field--filled::before{display:none}.mdc-text-field--textarea.mdc-text-field--filled .mdc-floating-label--float-above{transform:translateY(-10.25px) scale(0.75)}.mdc-text-field--textarea.mdc-text-field--filled .mdc-text-field__input{margin-top:23px;margin-bottom:9px}.mdc-text-field--textarea.mdc-text-field--filled.mdc-text-field--no-label .mdc-text-field__input{margin-top:16px;margin-bottom:16px}.mdc-text-field--textarea.mdc-text-field--outlined .mdc-notched-outline--notched .mdc-notched-outline__notch{padding-top:0}.mdc-text-field--textarea.mdc-text-field--outlined .mdc-floating-label--float-above{transform:translateY(-27.25px) scale(1)}.mdc-text-field--textarea.mdc-text-field--outlined .mdc-floating-label--float-above{font-size:.75rem}.mdc-text-field--textarea.mdc-text-field--outlined.mdc-notched-outline--upgraded .mdc-floating-label--float-above,.mdc-text-field--textarea.mdc-text-field--outlined .mdc-notched-outline--upgraded .mdc-floating-label--float-above{transform:translateY(-24.75px) scale(0.75)}.mdc-text-field--textarea.mdc-text-field--outlined.mdc-notched-outline--upgraded .mdc-floating-label--float-above,.mdc-text-field--textarea.mdc-text-field--outlined .mdc-notched-outline--upgraded .mdc-floating-label--float-above{font-size:1rem}.mdc-text-field--textarea.mdc-text-field--outlined .mdc-text-field__input{margin-top:16px;margin-bottom:16px}.mdc-text-field--textarea.mdc-text-field--outlined .mdc-floating-label{top:18px}.mdc-text-field--textarea.mdc-text-field--with-internal-counter .mdc-text-field__input{margin-bottom:2px}.mdc-text-field--textarea.mdc-text-field--with-internal-counter .mdc-text-field-character-counter{align-self:flex-end;padding:0 16px}.mdc-text-field--textarea.mdc-text-field--with-internal-counter .mdc-text-field-character-counter::after{display:inline-block;width:0;height:16px;content:"";vertical-align:-16px}.mdc-text-field--textarea.mdc-text-field--with-internal-counter .mdc-text-field-character-counter::before{display:none}.mdc-text-field__resizer{align-self:stretch;display:inline-flex;flex-direction:column;flex-grow:1;max-height:100%;max-width:100%;min-height:56px;min-width:fit-content;min-width:-moz-available;min-width:-webkit-fill-available;overflow:hidden;resize:both}.mdc-text-field--filled .mdc-text-field__resizer{transform:translateY(-1px)}.mdc-text-field--filled .mdc-text-field__resizer .mdc-text-field__input,.mdc-text-field--filled .mdc-text-field__resizer .mdc-text-field-character-counter{transform:translateY(1px)}.mdc-text-field--outlined .mdc-text-field__resizer{transform:translateX(-1px) translateY(-1px)}[dir=rtl] .mdc-text-field--outlined .mdc-text-field__resizer,.mdc-text-field--outlined .mdc-text-field__resizer[dir=rtl]{transform:translateX(1px) translateY(-1px)}.mdc-text-field--outlined .mdc-text-field__resizer .mdc-text-field__input,.mdc-text-field--outlined .mdc-text-field__resizer .mdc-text-field-character-counter{transform:translateX(1px) translateY(1px)}[dir=rtl] .mdc-text-field--outlined .mdc-text-field__resizer .mdc-text-field__input,[dir=rtl] .mdc-text-field--outlined .mdc-text-field__resizer .mdc-text-field-character-counter,.mdc-text-field--outlined .mdc-text-field__resizer .mdc-text-field__input[dir=rtl],.mdc-text-field--outlined .mdc-text-field__resizer .mdc-text-field-character-counter[dir=rtl]{transform:translateX(-1px) translateY(1px)}.mdc-text-field--with-leading-icon{padding-left:0;padding-right:16px}[dir=rtl] .mdc-text-field--with-leading-icon,.mdc-text-field--with-leading-icon[dir=rtl]{padding-left:16px;padding-right:0}.mdc-text-field--with-leading-icon.mdc-text-field--filled .mdc-floating-label{max-width:calc(100% - 48px);left:48px;right:initial}[dir=rtl] .mdc-text-field--with-leading-icon.mdc-text-field--filled .mdc-floating-label,.mdc-text-field--with-leading-icon.mdc-text-field--filled .mdc-floating-label[dir=rtl]{left:initial;right:48px}.mdc-text-field--with-leading-icon.mdc-text-field--filled .mdc-floating-label--float-above{max-width:calc(100% / 0.75 - 64px / 0.75)}.mdc-text-field--with-leading-icon.mdc-text-field--outlined .mdc-floating-label{left:36px;right:initial}[dir=rtl] .mdc-text-field--with-leading-icon.mdc-text-field--outlined .mdc-floating-label,.mdc-text-field--with-leading-icon.mdc-text-field--outlined .mdc-floating-label[dir=rtl]{left:initial;right:36px}.mdc-text-field--with-leading-icon.mdc-text-field--outlined :not(.mdc-notched-outline--notched) .mdc-notched-outline__notch{max-width:calc(100% - 60px)}.mdc-text-field--with-leading-icon.mdc-text-field--outlined .mdc-floating-label--float-above{transform:translateY(-37.25px) translateX(-32px) scale(1)}[dir=rtl] .mdc-text-field--with-leading-icon.mdc-text-field--outlined .mdc-floating-label--float-above,.mdc-text-field--with-leading-icon.mdc-text-field--outlined .mdc-floating-label--float-above[dir=rtl]{transform:translateY(-37.25px) translateX(32px) scale(1)}.mdc-text-field--with-leading-icon.mdc-text-field--outlined .mdc-floating-label--float-above{font-size:.75rem}.mdc-text-field--with-leading-icon.mdc-text-field--outlined.mdc-notched-outline--upgraded .mdc-floating-label--float-above,.mdc-text-field--with-leading-icon.mdc-text-field--outlined .mdc-notched-outline--upgraded .mdc-floating-label--float-above{transform:translateY(-34.75px) translateX(-32px) scale(0.75)}[dir=rtl] .mdc-text-field--with-leading-icon.mdc-text-field--outlined.mdc-notched-outline--upgraded .mdc-floating-label--float-above,[dir=rtl] .mdc-text-field--with-leading-icon.mdc-text-field--outlined .mdc-notched-outline--upgraded .mdc-floating-label--float-above,.mdc-text-field--with-leading-icon.mdc-text-field--outlined.mdc-notched-outline--upgraded .mdc-floating-label--float-above[dir=rtl],.mdc-text-field--with-leading-icon.mdc-text-field--outlined .mdc-notched-outline--upgraded .mdc-floating-label--float-above[dir=rtl]{transform:translateY(-34.75px) translateX(32px) scale(0.75)}.mdc-text-field--with-leading-icon.mdc-text-field--outlined.mdc-notched-outline--upgraded .mdc-floating-label--float-above,.mdc-text-field--with-leading-icon.mdc-text-field--outlined .mdc-notched-outline--upgraded .mdc-floating-label--float-above{font-size:1rem}.mdc-text-field--with-trailing-icon{padding-left:16px;padding-right:0}[dir=rtl] .mdc-text-field--with-trailing-icon,.mdc-text-field--with-trailing-icon[dir=rtl]{padding-left:0;padding-right:16px}.mdc-text-field--with-trailing-icon.mdc-text-field--filled .mdc-floating-label{max-width:calc(100% - 64px)}.mdc-text-field--with-trailing-icon.mdc-text-field--filled .mdc-floating-label--float-above{max-width:calc(100% / 0.75 - 64px / 0.75)}.mdc-text-field--with-trailing-icon.mdc-text-field--outlined :not(.mdc-notched-outline--notched) .mdc-notched-outline__notch{max-width:calc(100% - 60px)}.mdc-text-field--with-leading-icon.mdc-text-field--with-trailing-icon{padding-left:0;padding-right:0}.mdc-text-field--with-leading-icon.mdc-text-field--with-trailing-icon.mdc-text-field--filled .mdc-floating-label{max-width:calc(100% - 96px)}.mdc-text-field--with-leading-icon.mdc-text-field--with-trailing-icon.mdc-text-field--filled .mdc-floating-label--float-above{max-width:calc(100% / 0.75 - 96px / 0.75)}.mdc-text-field-helper-line{display:flex;justify-content:space-between;box-sizing:border-box}.mdc-text-field+.mdc-text-field-helper-line{padding-right:16px;padding-left:16px}.mdc-form-field>.mdc-text-field+label{align-self:flex-start}.mdc-text-field--focused .mdc-notched-outline__leading,.mdc-text-field--focused .mdc-notched-outline__notch,.mdc-text-field--focused .mdc-notched-outline__trailing{border-width:2px}.mdc-text-field--focused+.mdc-text-field-helper-line .mdc-text-field-helper-text:not(.mdc-text-field-helper-text--validation-msg){opacity:1}.mdc-text-field--focused.mdc-text-field--outlined .mdc-notched-outline--notched .mdc-notched-outline__notch{padding-top:2px}.mdc-text-field--focused.mdc-text-field--outlined.mdc-text-field--textarea .mdc-notched-outline--notched .mdc-notched-outline__notch{padding-top:0}.mdc-text-field--invalid+.mdc-text-field-helper-line .mdc-text-field-helper-text--validation-msg{opacity:1}.mdc-text-field--disabled{pointer-events:none}@media screen and (forced-colors: active){.mdc-text-field--disabled .mdc-text-field__input{background-color:Window}.mdc-text-field--disabled .mdc-floating-label{z-index:1}}.mdc-text-field--disabled .mdc-floating-label{cursor:default}.mdc-text-field--disabled.mdc-text-field--filled .mdc-text-field__ripple{display:none}.mdc-text-field--disabled .mdc-text-field__input{pointer-events:auto}.mdc-text-field--end-aligned .mdc-text-field__input{text-align:right}[dir=rtl] .mdc-text-field--end-aligned .mdc-text-field__input,.mdc-text-field--end-aligned .mdc-text-field__input[dir=rtl]{text-align:left}[dir=rtl] .mdc-text-field--ltr-text .mdc-text-field__input,[dir=rtl] .mdc-text-field--ltr-text .mdc-text-field__affix,.mdc-text-field--ltr-text[dir=rtl] .mdc-text-field__input,.mdc-text-field--ltr-text[dir=rtl] .mdc-text-field__affix{direction:ltr}[dir=rtl] .mdc-text-field--ltr-text .mdc-text-field__affix--prefix,.mdc-text-field--ltr-text[dir=rtl] .mdc-text-field__affix--prefix{padding-left:0;padding-right:2px}[dir=rtl] .mdc-text-field--ltr-text .mdc-text-field__affix--suffix,.mdc-text-field--ltr-text[dir=rtl] .mdc-text-field__affix--suffix{padding-left:12px;padding-right:0}[dir=rtl] .mdc-text-field--ltr-text .mdc-text-field__icon--leading,.mdc-text-field--ltr-text[dir=rtl] .mdc-text-field__icon--leading{order:1}[dir=rtl] .mdc-text-field--ltr-text .mdc-text-field__affix--suffix,.mdc-text-field--ltr-text[dir=rtl] .mdc-text-field__affix--suffix{order:2}[dir=rtl] .mdc-text-field--ltr-text .mdc-text-field__input,.mdc-text-field--ltr-text[dir=rtl] .mdc-text-field__input{order:3}[dir=rtl] .mdc-text-field--ltr-text .mdc-text-field__affix--prefix,.mdc-text-field--ltr-text[dir=rtl] .mdc-text-field__affix--prefix{order:4}[dir=rtl] .mdc-text-field--ltr-text .mdc-text-field__icon--trailing,.mdc-text-field--ltr-text[dir=rtl] .mdc-text-field__icon--trailing{order:5}[dir=rtl] .mdc-text-field--ltr-text.mdc-text-field--end-aligned .mdc-text-field__input,.mdc-text-field--ltr-text.mdc-text-field--end-aligned[dir=rtl] .mdc-text-field__input{text-align:right}[dir=rtl] .mdc-text-field--ltr-text.mdc-text-field--end-aligned .mdc-text-field__affix--prefix,.mdc-text-field--ltr-text.mdc-text-field--end-aligned[dir=rtl] .mdc-text-field__affix--prefix{padding-right:12px}[dir=rtl] .mdc-text-field--ltr-text.mdc-text-field--end-aligned .mdc-text-field__affix--suffix,.mdc-text-field--ltr-text.mdc-text-field--end-aligned[dir=rtl] .mdc-text-field__affix--suffix{padding-left:2px}.mdc-floating-label{position:absolute;left:0;-webkit-transform-origin:left top;transform-origin:left top;line-height:1.15rem;text-align:left;text-overflow:ellipsis;white-space:nowrap;cursor:text;overflow:hidden;will-change:transform}[dir=rtl] .mdc-floating-label,.mdc-floating-label[dir=rtl]{right:0;left:auto;-webkit-transform-origin:right top;transform-origin:right top;text-align:right}.mdc-floating-label--float-above{cursor:auto}.mdc-floating-label--required:not(.mdc-floating-label--hide-required-marker)::after{margin-left:1px;margin-right:0px;content:"*"}[dir=rtl] .mdc-floating-label--required:not(.mdc-floating-label--hide-required-marker)::after,.mdc-floating-label--required:not(.mdc-floating-label--hide-required-marker)[dir=rtl]::after{margin-left:0;margin-right:1px}.mdc-notched-outline{display:flex;position:absolute;top:0;right:0;left:0;box-sizing:border-box;width:100%;max-width:100%;height:100%;text-align:left;pointer-events:none}[dir=rtl] .mdc-notched-outline,.mdc-notched-outline[dir=rtl]{text-align:right}.mdc-notched-outline__leading,.mdc-notched-outline__notch,.mdc-notched-outline__trailing{box-sizing:border-box;height:100%;pointer-events:none}.mdc-notched-outline__trailing{flex-grow:1}.mdc-notched-outline__notch{flex:0 0 auto;width:auto}.mdc-notched-outline .mdc-floating-label{display:inline-block;position:relative;max-width:100%}.mdc-notched-outline .mdc-floating-label--float-above{text-overflow:clip}.mdc-notched-outline--upgraded .mdc-floating-label--float-above{max-width:133.3333333333%}.mdc-notched-outline--notched .mdc-notched-outline__notch{padding-left:0;padding-right:8px;border-top:none}[dir=rtl] .mdc-notched-outline--notched .mdc-notched-outline__notch,.mdc-notched-outline--notched .mdc-notched-outline__notch[dir=rtl]{padding-left:8px;padding-right:0}.mdc-notched-outline--no-label .mdc-notched-outline__notch{display:none}.mdc-line-ripple::before,.mdc-line-ripple::after{position:absolute;bottom:0;left:0;width:100%;border-bottom-style:solid;content:""}.mdc-line-ripple::before{z-index:1}.mdc-line-ripple::after{transform:scaleX(0);opacity:0;z-index:2}.mdc-line-ripple--active::after{transform:scaleX(1);opacity:1}.mdc-line-ripple--deactivating::after{opacity:0}.mdc-floating-label--float-above{transform:translateY(-106%) scale(0.75)}.mdc-notched-outline__leading,.mdc-notched-outline__notch,.mdc-notched-outline__trailing{border-top:1px solid;border-bottom:1px solid}.mdc-notched-outline__leading{border-left:1px solid;border-right:none;width:12px}[dir=rtl] .mdc-notched-outline__leading,.mdc-notched-outline__leading[dir=rtl]{border-left:none;border-right:1px solid}.mdc-notched-outline__trailing{border-left:none;border-right:1px solid}[dir=rtl] .mdc-notched-outline__trailing,.mdc-notched-outline__trailing[dir=rtl]{border-left:1px solid;border-right:none}.mdc-notched-outline__notch{max-width:calc(100% - 12px * 2)}.mdc-line-ripple::before{border-bottom-width:1px}.mdc-line-ripple::after{border-bottom-width:2px}.mdc-text-field--filled{--mdc-filled-text-field-active-indicator-height:1px;--mdc-filled-text-field-focus-active-indicator-height:2px;--mdc-filled-text-field-container-shape:4px;border-top-left-radius:var(--mdc-filled-text-field-container-shape);border-top-right-radius:var(--mdc-filled-text-field-container-shape);border-bottom-right-radius:0;border-bottom-left-radius:0}.mdc-text-field--filled:not(.mdc-text-field--disabled) .mdc-text-field__input{caret-color:var(--mdc-filled-text-field-caret-color)}.mdc-text-field--filled.mdc-text-field--invalid:not(.mdc-text-field--disabled) .mdc-text-field__input{caret-color:var(--mdc-filled-text-field-error-caret-color)}.mdc-text-field--filled:not(.mdc-text-field--disabled) .mdc-text-field__input{color:var(--mdc-filled-text-field-input-text-color)}.mdc-text-field--filled.mdc-text-field--disabled .mdc-text-field__input{color:var(--mdc-filled-text-field-disabled-input-text-color)}.mdc-text-field--filled:not(.mdc-text-field--disabled) .mdc-floating-label,.mdc-text-field--filled:not(.mdc-text-field--disabled) .mdc-floating-label--float-above{color:var(--mdc-filled-text-field-label-text-color)}.mdc-text-field--filled:not(.mdc-text-field--disabled).mdc-text-field--focused .mdc-floating-label,.mdc-text-field--filled:not(.mdc-text-field--disabled).mdc-text-field--focused .mdc-floating-label--float-above{color:var(--mdc-filled-text-field-focus-label-text-color)}.mdc-text-field--filled.mdc-text-field--disabled .mdc-floating-label,.mdc-text-field--filled.mdc-text-field--disabled .mdc-floating-label--float-above{color:var(--mdc-filled-text-field-disabled-label-text-color)}.mdc-text-field--filled.mdc-text-field--invalid:not(.mdc-text-field--disabled) .mdc-floating-label,.mdc-text-field--filled.mdc-text-field--invalid:not(.mdc-text-field--disabled) .mdc-floating-label--float-above{color:var(--mdc-filled-text-field-error-label-text-color)}.mdc-text-field--filled.mdc-text-field--invalid:not(.mdc-text-field--disabled).mdc-text-field--focused .mdc-floating-label,.mdc-text-field--filled.mdc-text-field--invalid:not(.mdc-text-field--disabled).mdc-text-field--focused .mdc-floating-label--float-above{color:var(--mdc-filled-text-field-error-focus-label-text-color)}.mdc-text-field--filled .mdc-floating-label{font-family:var(--mdc-filled-text-field-label-text-font);font-size:var(--mdc-filled-text-field-label-text-size);font-weight:var(--mdc-filled-text-field-label-text-weight);letter-spacing:var(--mdc-filled-text-field-label-text-tracking)}@media all{.mdc-text-field--filled:not(.mdc-text-field--disabled) .mdc-text-field__input::placeholder{color:var(--mdc-filled-text-field-input-text-placeholder-color)}}@media all{.mdc-text-field--filled:not(.mdc-text-field--disabled) .mdc-text-field__input:-ms-input-placeholder{color:var(--mdc-filled-text-field-input-text-placeholder-color)}}.mdc-text-field--filled:not(.mdc-text-field--disabled){background-color:var(--mdc-filled-text-field-container-color)}.mdc-text-field--filled.mdc-text-field--disabled{background-color:var(--mdc-filled-text-field-disabled-container-color)}.mdc-text-field--filled:not(.mdc-text-field--disabled) .mdc-line-ripple::before{border-bottom-color:var(--mdc-filled-text-field-active-indicator-color)}.mdc-text-field--filled:not(.mdc-text-field--disabled):not(.mdc-text-field--focused):hover .mdc-line-ripple::before{border-bottom-color:var(--mdc-filled-text-field-hover-active-indicator-color)}.mdc-text-field--filled:not(.mdc-text-field--disabled) .mdc-line-ripple::after{border-bottom-color:var(--mdc-filled-text-field-focus-active-indicator-color)}.mdc-text-field--filled.mdc-text-field--disabled .mdc-line-ripple::before{border-bottom-color:var(--mdc-filled-text-field-disabled-active-indicator-color)}.mdc-text-field--filled.mdc-text-field--invalid:not(.mdc-text-field--disabled) .mdc-line-ripple::before{border-bottom-color:var(--mdc-filled-text-field-error-active-indicator-color)}.mdc-text-field--filled.mdc-text-field--invalid:not(.mdc-text-field--disabled):not(.mdc-text-field--focused):hover .mdc-line-ripple::before{border-bottom-color:var(--mdc-filled-text-field-error-hover-active-indicator-color)}.mdc-text-field--filled.mdc-text-field--invalid:not(.mdc-text-field--disabled) .mdc-line-ripple::after{border-bottom-color:var(--mdc-filled-text-field-error-focus-active-indicator-color)}.mdc-text-field--filled .mdc-line-ripple::before{border-bottom-width:var(--mdc-filled-text-field-active-indicator-height)}.mdc-text-field--filled .mdc-line-ripple::after{border-bottom-width:var(--mdc-filled-text-field-focus-active-indicator-height)}.mdc-text-field--outlined{--mdc-outlined-text-field-outline-width:1px;--mdc-outlined-text-field-focus-outline-width:2px;--mdc-outlined-text-field-container-shape:4px}.mdc-text-field--outlined:not(.mdc-text-field--disabled) .mdc-text-field__input{caret-color:var(--mdc-outlined-text-field-caret-color)}.mdc-text-field--outlined.mdc-text-field--invalid:not(.mdc-text-field--disabled) .mdc-text-field__input{caret-color:var(--mdc-outlined-text-field-error-caret-color)}.mdc-text-field--outlined:not(.mdc-text-field--disabled) .mdc-text-field__input{color:var(--mdc-outlined-text-field-input-text-color)}.mdc-text-field--outlined.mdc-text-field--disabled .mdc-text-field__input{color:var(--mdc-outlined-text-field-disabled-input-text-color)}.mdc-text-field--outlined:not(.mdc-text-field--disabled) .mdc-floating-label,.mdc-text-field--outlined:not(.mdc-text-field--disabled) .mdc-floating-label--float-above{color:var(--mdc-outlined-text-field-label-text-color)}.mdc-text-field--outlined:not(.mdc-text-field--disabled).mdc-text-field--focused .mdc-floating-label,.mdc-text-field--outlined:not(.mdc-text-field--disabled).mdc-text-field--focused .mdc-floating-label--float-above{color:var(--mdc-outlined-text-field-focus-label-text-color)}.mdc-text-field--outlined.mdc-text-field--disabled .mdc-floating-label,.mdc-text-field--outlined.mdc-text-field--disabled .mdc-floating-label--float-above{color:var(--mdc-outlined-text-field-disabled-label-text-color)}.mdc-text-field--outlined.mdc-text-field--invalid:not(.mdc-text-field--disabled) .mdc-floating-label,.mdc-text-field--outlined.mdc-text-field--invalid:not(.mdc-text-field--disabled) .mdc-floating-label--float-above{color:var(--mdc-outlined-text-field-error-label-text-color)}.mdc-text-field--outlined.mdc-text-field--invalid:not(.mdc-text-field--disabled).mdc-text-field--focused .mdc-floating-label,.mdc-text-field--outlined.mdc-text-field--invalid:not(.mdc-text-field--disabled).mdc-text-field--focused .mdc-floating-label--float-above{color:var(--mdc-outlined-text-field-error-focus-label-text-color)}.mdc-text-field--outlined .mdc-floating-label{font-family:var(--mdc-outlined-text-field-label-text-font);font-size:var(--mdc-outlined-text-field-label-text-size);font-weight:var(--mdc-outlined-text-field-label-text-weight);letter-spacing:var(--mdc-outlined-text-field-label-text-tracking)}@media all{.mdc-text-field--outlined:not(.mdc-text-field--disabled) .mdc-text-field__input::placeholder{color:var(--mdc-outlined-text-field-input-text-placeholder-color)}}@media all{.mdc-text-field--outlined:not(.mdc-text-field--disabled) .mdc-text-field__input:-ms-input-placeholder{color:var(--mdc-outlined-text-field-input-text-placeholder-color)}}.mdc-text-field--outlined .mdc-notched-outline .mdc-notched-outline__leading{border-top-left-radius:var(--mdc-outlined-text-field-container-shape);border-top-right-radius:0;border-bottom-right-radius:0;border-bottom-left-radius:var(--mdc-outlined-text-field-container-shape)}[dir=rtl] .mdc-text-field--outlined .mdc-notched-outline .mdc-notched-outline__leading,.mdc-text-field--outlined .mdc-notched-outline .mdc-notched-outline__leading[dir=rtl]{border-top-left-radius:0;border-top-right-radius:var(--mdc-outlined-text-field-container-shape);border-bottom-right-radius:var(--mdc-outlined-text-field-container-shape);border-bottom-left-radius:0}@supports(top: max(0%)){.mdc-text-field--outlined .mdc-notched-outline .mdc-notched-outline__leading{width:max(12px, var(--mdc-outlined-text-field-container-shape))}}@supports(top: max(0%)){.mdc-text-field--outlined .mdc-notched-outline .mdc-notched-outline__notch{max-width:calc(100% - max(12px, var(--mdc-outlined-text-field-container-shape))*2)}}.mdc-text-field--outlined .mdc-notched-outline .mdc-notched-outline__trailing{border-top-left-radius:0;border-top-right-radius:var(--mdc-outlined-text-field-container-shape);border-bottom-right-radius:var(--mdc-outlined-text-field-container-shape);border-bottom-left-radius:0}[dir=rtl] .mdc-text-field--outlined .mdc-notched-outline .mdc-notched-outline__trailing,.mdc-text-field--outlined .mdc-notched-outline .mdc-notched-outline__trailing[dir=rtl]{border-top-left-radius:var(--mdc-outlined-text-field-container-shape);border-top-right-radius:0;border-bottom-right-radius:0;border-bottom-left-radius:var(--mdc-outlined-text-field-container-shape)}@supports(top: max(0%)){.mdc-text-field--outlined{padding-left:max(16px, calc(var(--mdc-outlined-text-field-container-shape) + 4px))}}@supports(top: max(0%)){.mdc-text-field--outlined{padding-right:max(16px, var(--mdc-outlined-text-field-container-shape))}}@supports(top: max(0%)){.mdc-text-field--outlined+.mdc-text-field-helper-line{padding-left:max(16px, calc(var(--mdc-outlined-text-field-container-shape) + 4px))}}@supports(top: max(0%)){.mdc-text-field--outlined+.mdc-text-field-helper-line{padding-right:max(16px, var(--mdc-outlined-text-field-container-shape))}}.mdc-text-field--outlined.mdc-text-field--with-leading-icon{padding-left:0}@supports(top: max(0%)){.mdc-text-field--outlined.mdc-text-field--with-leading-icon{padding-right:max(16px, var(--mdc-outlined-text-field-container-shape))}}[dir=rtl] .mdc-text-field--outlined.mdc-text-field--with-leading-icon,.mdc-text-field--outlined.mdc-text-field--with-leading-icon[dir=rtl]{padding-right:0}@supports(top: max(0%)){[dir=rtl] .mdc-text-field--outlined.mdc-text-field--with-leading-icon,.mdc-text-field--outlined.mdc-text-field--with-leading-icon[dir=rtl]{padding-left:max(16px, var(--mdc-outlined-text-field-container-shape))}}.mdc-text-field--outlined.mdc-text-field--with-trailing-icon{padding-right:0}@supports(top: max(0%)){.mdc-text-field--outlined.mdc-text-field--with-trailing-icon{padding-left:max(16px, calc(var(--mdc-outlined-text-field-container-shape) + 4px))}}[dir=rtl] .mdc-text-field--outlined.mdc-text-field--with-trailing-icon,.mdc-text-field--outlined.mdc-text-field--with-trailing-icon[dir=rtl]{padding-left:0}@supports(top: max(0%)){[dir=rtl] .mdc-text-field--outlined.mdc-text-field--with-trailing-icon,.mdc-text-field--outlined.mdc-text-field--with-trailing-icon[dir=rtl]{padding-right:max(16px, calc(var(--mdc-outlined-text-field-container-shape) + 4px))}}.mdc-text-field--outlined.mdc-text-field--with-leading-icon.mdc-text-field--with-trailing-icon{padding-left:0;padding-right:0}.mdc-text-field--outlined:not(.mdc-text-field--disabled) .mdc-notched-outline__leading,.mdc-text-field--outlined:not(.mdc-text-field--disabled) .mdc-notched-outline__notch,.mdc-text-field--outlined:not(.mdc-text-field--disabled) .mdc-notched-outline__trailing{border-color:var(--mdc-outlined-text-field-outline-color)}.mdc-text-field--outlined:not(.mdc-text-field--disabled):not(.mdc-text-field--focused):hover .mdc-notched-outline .mdc-notched-outline__leading,.mdc-text-field--outlined:not(.mdc-text-field--disabled):not(.mdc-text-field--focused):hover .mdc-notched-outline .mdc-notched-outline__notch,.mdc-text-field--outlined:not(.mdc-text-field--disabled):not(.mdc-text-field--focused):hover .mdc-notched-outline .mdc-notched-outline__trailing{border-color:var(--mdc-outlined-text-field-hover-outline-color)}.mdc-text-field--outlined:not(.mdc-text-field--disabled).mdc-text-field--focused .mdc-notched-outline__leading,.mdc-text-field--outlined:not(.mdc-text-field--disabled).mdc-text-field--focused .mdc-notched-outline__notch,.mdc-text-field--outlined:not(.mdc-text-field--disabled).mdc-text-field--focused .mdc-notched-outline__trailing{border-color:var(--mdc-outlined-text-field-focus-outline-color)}.mdc-text-field--outlined.mdc-text-field--disabled .mdc-notched-outline__leading,.mdc-text-field--outlined.mdc-text-field--disabled .mdc-notched-outline__notch,.mdc-text-field--outlined.mdc-text-field--disabled .mdc-notched-outline__trailing{border-color:var(--mdc-outlined-text-field-disabled-outline-color)}.mdc-text-field--outlined.mdc-text-field--invalid:not(.mdc-text-field--disabled) .mdc-notched-outline__leading,.mdc-text-field--outlined.mdc-text-field--invalid:not(.mdc-text-field--disabled) .mdc-notched-outline__notch,.mdc-text-field--outlined.mdc-text-field--invalid:not(.mdc-text-field--disabled) .mdc-notched-outline__trailing{border-color:var(--mdc-outlined-text-field-error-outline-color)}.mdc-text-field--outlined.mdc-text-field--invalid:not(.mdc-text-field--disabled):not(.mdc-text-field--focused):hover .mdc-notched-outline .mdc-notched-outline__leading,.mdc-text-field--outlined.mdc-text-field--invalid:not(.mdc-text-field--disabled):not(.mdc-text-field--focused):hover .mdc-notched-outline .mdc-notched-outline__notch,.mdc-text-field--outlined.mdc-text-field--invalid:not(.mdc-text-field--disabled):not(.mdc-text-field--focused):hover .mdc-notched-outline .mdc-notched-outline__trailing{border-color:var(--mdc-outlined-text-field-error-hover-outline-color)}.mdc-text-field--outlined.mdc-text-field--invalid:not(.mdc-text-field--disabled).mdc-text-field--focused .mdc-notched-outline__leading,.mdc-text-field--outlined.mdc-text-field--invalid:not(.mdc-text-field--disabled).mdc-text-field--focused .mdc-notched-outline__notch,.mdc-text-field--outlined.mdc-text-field--invalid:not(.mdc-text-field--disabled).mdc-text-field--focused .mdc-notched-outline__trailing{border-color:var(--mdc-outlined-text-field-error-focus-outline-color)}.mdc-text-field--outlined:not(.mdc-text-field--disabled) .mdc-notched-outline .mdc-notched-outline__leading,.mdc-text-field--outlined:not(.mdc-text-field--disabled) .mdc-notched-outline .mdc-notched-outline__notch,.mdc-text-field--outlined:not(.mdc-text-field--disabled) .mdc-notched-outline .mdc-notched-outline__trailing{border-width:var(--mdc-outlined-text-field-outline-width)}.mdc-text-field--outlined:not(.mdc-text-field--disabled).mdc-text-field--focused .mdc-notched-outline .mdc-notched-outline__leading,.mdc-text-field--outlined:not(.mdc-text-field--disabled).mdc-text-field--focused .mdc-notched-outline .mdc-notched-outline__notch,.mdc-text-field--outlined:not(.mdc-text-field--disabled).mdc-text-field--focused .mdc-notched-outline .mdc-notched-outline__trailing{border-width:var(--mdc-outlined-text-field-focus-outline-width)}.mat-mdc-form-field-textarea-control{vertical-align:middle;resize:vertical;box-sizing:border-box;height:auto;margin:0;padding:0;border:none;overflow:auto}.mat-mdc-form-field-input-control.mat-mdc-form-field-input-control{-moz-osx-font-smoothing:grayscale;-webkit-font-smoothing:antialiased;font:inherit;letter-spacing:inherit;text-decoration:inherit;text-transform:inherit;border:none}.mat-mdc-form-field .mat-mdc-floating-label.mdc-floating-label{-moz-osx-font-smoothing:grayscale;-webkit-font-smoothing:antialiased;line-height:normal;pointer-events:all}.mdc-text-field--no-label:not(.mdc-text-field--textarea) .mat-mdc-form-field-input-control.mdc-text-field__input,.mat-mdc-text-field-wrapper .mat-mdc-form-field-input-control{height:auto}.mat-mdc-text-field-wrapper .mat-mdc-form-field-input-control.mdc-text-field__input[type=color]{height:23px}.mat-mdc-text-field-wrapper{height:auto;flex:auto}.mat-mdc-form-field-has-icon-prefix .mat-mdc-text-field-wrapper{padding-left:0;--mat-mdc-form-field-label-offset-x: -16px}.mat-mdc-form-field-has-icon-suffix .mat-mdc-text-field-wrapper{padding-right:0}[dir=rtl] .mat-mdc-text-field-wrapper{padding-left:16px;padding-right:16px}[dir=rtl] .mat-mdc-form-field-has-icon-suffix .mat-mdc-text-field-wrapper{padding-left:0}[dir=rtl] .mat-mdc-form-field-has-icon-prefix .mat-mdc-text-field-wrapper{padding-right:0}.mat-form-field-disabled .mdc-text-field__input::placeholder{color:var(--mat-form-field-disabled-input-text-placeholder-color)}.mat-form-field-disabled .mdc-text-field__input::-moz-placeholder{color:var(--mat-form-field-disabled-input-text-placeholder-color)}.mat-form-field-disabled .mdc-text-field__input::-webkit-input-placeholder{color:var(--mat-form-field-disabled-input-text-placeholder-color)}.mat-form-field-disabled .mdc-text-field__input:-ms-input-placeholder{color:var(--mat-form-field-disabled-input-text-placeholder-color)}.mat-mdc-form-field-label-always-float .mdc-text-field__input::placeholder{transition-delay:40ms;transition-duration:110ms;opacity:1}.mat-mdc-text-field-wrapper .mat-mdc-form-field-infix .mat-mdc-floating-label{left:auto;right:auto}.mat-mdc-text-field-wrapper.mdc-text-field--outlined .mdc-text-field__input{display:inline-block}.mat-mdc-form-field .mat-mdc-text-field-wrapper.mdc-text-field .mdc-notched-outline__notch{padding-top:0}.mat-mdc-text-field-wrapper::before{content:none}.mat-mdc-form-field-subscript-wrapper{box-sizing:border-box;width:100%;position:relative}.mat-mdc-form-field-hint-wrapper,.mat-mdc-form-field-error-wrapper{position:absolute;top:0;left:0;right:0;padding:0 16px}.mat-mdc-form-field-subscript-dynamic-size .mat-mdc-form-field-hint-wrapper,.mat-mdc-form-field-subscript-dynamic-size .mat-mdc-form-field-error-wrapper{position:static}.mat-mdc-form-field-bottom-align::before{content:"";display:inline-block;height:16px}.mat-mdc-form-field-bottom-align.mat-mdc-form-field-subscript-dynamic-size::before{content:unset}.mat-mdc-form-field-hint-end{order:1}.mat-mdc-form-field-hint-wrapper{display:flex}.mat-mdc-form-field-hint-spacer{flex:1 0 1em}.mat-mdc-form-field-error{display:block}.mat-mdc-form-field-focus-overlay{top:0;left:0;right:0;bottom:0;position:absolute;opacity:0;pointer-events:none}select.mat-mdc-form-field-input-control{-moz-appearance:none;-webkit-appearance:none;background-color:rgba(0,0,0,0);display:inline-flex;box-sizing:border-box}select.mat-mdc-form-field-input-control:not(:disabled){cursor:pointer}.mat-mdc-form-field-type-mat-native-select .mat-mdc-form-field-infix::after{content:"";width:0;height:0;border-left:5px solid rgba(0,0,0,0);border-right:5px solid rgba(0,0,0,0);border-top:5px solid;position:absolute;right:0;top:50%;margin-top:-2.5px;pointer-events:none}[dir=rtl] .mat-mdc-form-field-type-mat-native-select .mat-mdc-form-field-infix::after{right:auto;left:0}.mat-mdc-form-field-type-mat-native-select .mat-mdc-form-field-input-control{padding-right:15px}[dir=rtl] .mat-mdc-form-field-type-mat-native-select .mat-mdc-form-field-input-control{padding-right:0;padding-left:15px}.cdk-high-contrast-active .mat-form-field-appearance-fill .mat-mdc-text-field-wrapper{outline:solid 1px}.cdk-high-contrast-active .mat-form-field-appearance-fill.mat-form-field-disabled .mat-mdc-text-field-wrapper{outline-color:GrayText}.cdk-high-contrast-active .mat-form-field-appearance-fill.mat-focused .mat-mdc-text-field-wrapper{outline:dashed 3px}.cdk-high-contrast-active .mat-mdc-form-field.mat-focused .mdc-notched-outline{border:dashed 3px}.mat-mdc-form-field-input-control[type=date],.mat-mdc-form-field-input-control[type=datetime],.mat-mdc-form-field-input-control[type=datetime-local],.mat-mdc-form-field-input-control[type=month],.mat-mdc-form-field-input-control[type=week],.mat-mdc-form-field-input-control[type=time]{line-height:1}.mat-mdc-form-field-input-control::-webkit-datetime-edit{line-height:1;padding:0;margin-bottom:-2px}.mat-mdc-form-field{--mat-mdc-form-field-floating-label-scale: 0.75;display:inline-flex;flex-direction:column;min-width:0;text-align:left;-moz-osx-font-smoothing:grayscale;-webkit-font-smoothing:antialiased;font-family:var(--mat-form-field-container-text-font);line-height:var(--mat-form-field-container-text-line-height);font-size:var(--mat-form-field-container-text-size);letter-spacing:var(--mat-form-field-container-text-tracking);font-weight:var(--mat-form-field-container-text-weight)}[dir=rtl] .mat-mdc-form-field{text-align:right}.mat-mdc-form-field .mdc-text-field--outlined .mdc-floating-label--float-above{font-size:calc(var(--mat-form-field-outlined-label-text-populated-size) * var(--mat-mdc-form-field-floating-label-scale))}.mat-mdc-form-field .mdc-text-field--outlined .mdc-notched-outline--upgraded .mdc-floating-label--float-above{font-size:var(--mat-form-field-outlined-label-text-populated-size)}.mat-mdc-form-field-flex{display:inline-flex;align-items:baseline;box-sizing:border-box;width:100%}.mat-mdc-text-field-wrapper{width:100%}.mat-mdc-form-field-icon-prefix,.mat-mdc-form-field-icon-suffix{align-self:center;line-height:0;pointer-events:auto;position:relative;z-index:1}.mat-mdc-form-field-icon-prefix,[dir=rtl] .mat-mdc-form-field-icon-suffix{padding:0 4px 0 0}.mat-mdc-form-field-icon-suffix,[dir=rtl] .mat-mdc-form-field-icon-prefix{padding:0 0 0 4px}.mat-mdc-form-field-icon-prefix>.mat-icon,.mat-mdc-form-field-icon-suffix>.mat-icon{padding:12px;box-sizing:content-box}.mat-mdc-form-field-subscript-wrapper .mat-icon,.mat-mdc-form-field label .mat-icon{width:1em;height:1em;font-size:inherit}.mat-mdc-form-field-infix{flex:auto;min-width:0;width:180px;position:relative;box-sizing:border-box}.mat-mdc-form-field .mdc-notched-outline__notch{margin-left:-1px;-webkit-clip-path:inset(-9em -999em -9em 1px);clip-path:inset(-9em -999em -9em 1px)}[dir=rtl] .mat-mdc-form-field .mdc-notched-outline__notch{margin-left:0;margin-right:-1px;-webkit-clip-path:inset(-9em 1px -9em -999em);clip-path:inset(-9em 1px -9em -999em)}.mat-mdc-form-field:not(.mat-form-field-no-animations) .mdc-text-field__input{transition:opacity 150ms 0ms cubic-bezier(0.4, 0, 0.2, 1)}@media all{.mat-mdc-form-field:not(.mat-form-field-no-animations) .mdc-text-field__input::placeholder{transition:opacity 67ms 0ms cubic-bezier(0.4, 0, 0.2, 1)}}@media all{.mat-mdc-form-field:not(.mat-form-field-no-animations) .mdc-text-field__input:-ms-input-placeholder{transition:opacity 67ms 0ms cubic-bezier(0.4, 0, 0.2, 1)}}@media all{.mdc-text-field--no-label .mat-mdc-form-field:not(.mat-form-field-no-animations) .mdc-text-field__input::placeholder,.mdc-text-field--focused .mat-mdc-form-field:not(.mat-form-field-no-animations) .mdc-text-field__input::placeholder{transition-delay:40ms;transition-duration:110ms}}@media all{.mdc-text-field--no-label .mat-mdc-form-field:not(.mat-form-field-no-animations) .mdc-text-field__input:-ms-input-placeholder,.mdc-text-field--focused .mat-mdc-form-field:not(.mat-form-field-no-animations) .mdc-text-field__input:-ms-input-placeholder{transition-delay:40ms;transition-duration:110ms}}.mat-mdc-form-field:not(.mat-form-field-no-animations) .mdc-text-field__affix{transition:opacity 150ms 0ms cubic-bezier(0.4, 0, 0.2, 1)}.mat-mdc-form-field:not(.mat-form-field-no-animations) .mdc-text-field--filled.mdc-ripple-upgraded--background-focused .mdc-text-field__ripple::before,.mat-mdc-form-field:not(.mat-form-field-no-animations) .mdc-text-field--filled:not(.mdc-ripple-upgraded):focus .mdc-text-field__ripple::before{transition-duration:75ms}.mat-mdc-form-field:not(.mat-form-field-no-animations) .mdc-text-field--outlined .mdc-floating-label--shake{animation:mdc-floating-label-shake-float-above-text-field-outlined 250ms 1}@keyframes mdc-floating-label-shake-float-above-text-field-outlined{0%{transform:translateX(calc(0% - 0%)) translateY(calc(0% - 34.75px)) scale(0.75)}33%{animation-timing-function:cubic-bezier(0.5, 0, 0.701732, 0.495819);transform:translateX(calc(4% - 0%)) translateY(calc(0% - 34.75px)) scale(0.75)}66%{animation-timing-function:cubic-bezier(0.302435, 0.381352, 0.55, 0.956352);transform:translateX(calc(-4% - 0%)) translateY(calc(0% - 34.75px)) scale(0.75)}100%{transform:translateX(calc(0% - 0%)) translateY(calc(0% - 34.75px)) scale(0.75)}}.mat-mdc-form-field:not(.mat-form-field-no-animations) .mdc-text-field--textarea{transition:none}.mat-mdc-form-field:not(.mat-form-field-no-animations) .mdc-text-field--textarea.mdc-text-field--filled .mdc-floating-label--shake{animation:mdc-floating-label-shake-float-above-textarea-filled 250ms 1}@keyframes mdc-floating-label-shake-float-above-textarea-filled{0%{transform:translateX(calc(0% - 0%)) translateY(calc(0% - 10.25px)) scale(0.75)}33%{animation-timing-function:cubic-bezier(0.5, 0, 0.701732, 0.495819);transform:translateX(calc(4% - 0%)) translateY(calc(0% - 10.25px)) scale(0.75)}66%{animation-timing-function:cubic-bezier(0.302435, 0.381352, 0.55, 0.956352);transform:translateX(calc(-4% - 0%)) translateY(calc(0% - 10.25px)) scale(0.75)}100%{transform:translateX(calc(0% - 0%)) translateY(calc(0% - 10.25px)) scale(0.75)}}.mat-mdc-form-field:not(.mat-form-field-no-animations) .mdc-text-field--textarea.mdc-text-field--outlined .mdc-floating-label--shake{animation:mdc-floating-label-shake-float-above-textarea-outlined 250ms 1}@keyframes mdc-floating-label-shake-float-above-textarea-outlined{0%{transform:translateX(calc(0% - 0%)) translateY(calc(0% - 24.75px)) scale(0.75)}33%{animation-timing-function:cubic-bezier(0.5, 0, 0.701732, 0.495819);transform:translateX(calc(4% - 0%)) translateY(calc(0% - 24.75px)) scale(0.75)}66%{animation-timing-function:cubic-bezier(0.302435, 0.381352, 0.55, 0.956352);transform:translateX(calc(-4% - 0%)) translateY(calc(0% - 24.75px)) scale(0.75)}100%{transform:translateX(calc(0% - 0%)) translateY(calc(0% - 24.75px)) scale(0.75)}}.mat-mdc-form-field:not(.mat-form-field-no-animations) .mdc-text-field--with-leading-icon.mdc-text-field--outlined .mdc-floating-label--shake{animation:mdc-floating-label-shake-float-above-text-field-outlined-leading-icon 250ms 1}@keyframes mdc-floating-label-shake-float-above-text-field-outlined-leading-icon{0%{transform:translateX(calc(0% - 32px)) translateY(calc(0% - 34.75px)) scale(0.75)}33%{animation-timing-function:cubic-bezier(0.5, 0, 0.701732, 0.495819);transform:translateX(calc(4% - 32px)) translateY(calc(0% - 34.75px)) scale(0.75)}66%{animation-timing-function:cubic-bezier(0.302435, 0.381352, 0.55, 0.956352);transform:translateX(calc(-4% - 32px)) translateY(calc(0% - 34.75px)) scale(0.75)}100%{transform:translateX(calc(0% - 32px)) translateY(calc(0% - 34.75px)) scale(0.75)}}[dir=rtl] .mat-mdc-form-field:not(.mat-form-field-no-animations) .mdc-text-field--with-leading-icon.mdc-text-field--outlined .mdc-floating-label--shake,.mat-mdc-form-field:not(.mat-form-field-no-animations) .mdc-text-field--with-leading-icon.mdc-text-field--outlined[dir=rtl] .mdc-floating-label--shake{animation:mdc-floating-label-shake-float-above-text-field-outlined-leading-icon 250ms 1}@keyframes mdc-floating-label-shake-float-above-text-field-outlined-leading-icon-rtl{0%{transform:translateX(calc(0% - -32px)) translateY(calc(0% - 34.75px)) scale(0.75)}33%{animation-timing-function:cubic-bezier(0.5, 0, 0.701732, 0.495819);transform:translateX(calc(4% - -32px)) translateY(calc(0% - 34.75px)) scale(0.75)}66%{animation-timing-function:cubic-bezier(0.302435, 0.381352, 0.55, 0.956352);transform:translateX(calc(-4% - -32px)) translateY(calc(0% - 34.75px)) scale(0.75)}100%{transform:translateX(calc(0% - -32px)) translateY(calc(0% - 34.75px)) scale(0.75)}}.mat-mdc-form-field:not(.mat-form-field-no-animations) .mdc-floating-label{transition:transform 150ms cubic-bezier(0.4, 0, 0.2, 1),color 150ms cubic-bezier(0.4, 0, 0.2, 1)}.mdc-floating-label--shake{animation:mdc-floating-label-shake-float-above-standard 250ms 1}@keyframes mdc-floating-label-shake-float-above-standard{0%{transform:translateX(calc(0% - 0%)) translateY(calc(0% - 106%)) scale(0.75)}33%{animation-timing-function:cubic-bezier(0.5, 0, 0.701732, 0.495819);transform:translateX(calc(4% - 0%)) translateY(calc(0% - 106%)) scale(0.75)}66%{animation-timing-function:cubic-bezier(0.302435, 0.381352, 0.55, 0.956352);transform:translateX(calc(-4% - 0%)) translateY(calc(0% - 106%)) scale(0.75)}100%{transform:translateX(calc(0% - 0%)) translateY(calc(0% - 106%)) scale(0.75)}}.mat-mdc-form-field:not(.mat-form-field-no-animations) .mdc-line-ripple::after{transition:transform 180ms cubic-bezier(0.4, 0, 0.2, 1),opacity 180ms cubic-bezier(0.4, 0, 0.2, 1)}.mdc-notched-outline .mdc-floating-label{max-width:calc(100% + 1px)}.mdc-notched-outline--upgraded .mdc-floating-label--float-above{max-width:calc(133.3333333333% + 1px)}'],encapsulation:2,data:{animation:[Bee.transitionMessages]},changeDetection:0}),t})(),jd=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275mod=le({type:e}),e.\u0275inj=ae({imports:[ve,vn,Mv,ve]}),t})();const zee=["panel"];function Uee(e,t){if(1&e){const i=Ut();y(0,"div",0,1),$("@panelAnimation.done",function(r){return Ye(i),Ke(B()._animationDone.next(r))}),X(2),w()}if(2&e){const i=t.id,n=B();E("id",n.id)("ngClass",n._classList)("@panelAnimation",n.isOpen?"visible":"hidden"),de("aria-label",n.ariaLabel||null)("aria-labelledby",n._getPanelAriaLabelledby(i))}}const $ee=["*"],qee=ni("panelAnimation",[qt("void, hidden",je({opacity:0,transform:"scaleY(0.8)"})),Bt(":enter, hidden => visible",[Q$([Lt("0.03s linear",je({opacity:1})),Lt("0.12s cubic-bezier(0, 0, 0.2, 1)",je({transform:"scaleY(1)"}))])]),Bt(":leave, visible => hidden",[Lt("0.075s linear",je({opacity:0}))])]);let Gee=0;class Wee{constructor(t,i){this.source=t,this.option=i}}const Qee=so(class{}),YF=new M("mat-autocomplete-default-options",{providedIn:"root",factory:function Yee(){return{autoActiveFirstOption:!1,autoSelectActiveOption:!1,hideSingleSelectionIndicator:!1,requireSelection:!1}}});let Kee=(()=>{var e;class t extends Qee{get isOpen(){return this._isOpen&&this.showPanel}_setColor(n){this._color=n,this._setThemeClasses(this._classList)}get autoActiveFirstOption(){return this._autoActiveFirstOption}set autoActiveFirstOption(n){this._autoActiveFirstOption=Q(n)}get autoSelectActiveOption(){return this._autoSelectActiveOption}set autoSelectActiveOption(n){this._autoSelectActiveOption=Q(n)}get requireSelection(){return this._requireSelection}set requireSelection(n){this._requireSelection=Q(n)}set classList(n){this._classList=n&&n.length?function u7(e,t=/\s+/){const i=[];if(null!=e){const n=Array.isArray(e)?e:`${e}`.split(t);for(const r of n){const o=`${r}`.trim();o&&i.push(o)}}return i}(n).reduce((r,o)=>(r[o]=!0,r),{}):{},this._setVisibilityClasses(this._classList),this._setThemeClasses(this._classList),this._elementRef.nativeElement.className=""}constructor(n,r,o,s){super(),this._changeDetectorRef=n,this._elementRef=r,this._defaults=o,this._activeOptionChanges=Ae.EMPTY,this.showPanel=!1,this._isOpen=!1,this.displayWith=null,this.optionSelected=new U,this.opened=new U,this.closed=new U,this.optionActivated=new U,this._classList={},this.id="mat-autocomplete-"+Gee++,this.inertGroups=s?.SAFARI||!1,this._autoActiveFirstOption=!!o.autoActiveFirstOption,this._autoSelectActiveOption=!!o.autoSelectActiveOption,this._requireSelection=!!o.requireSelection}ngAfterContentInit(){this._keyManager=new NI(this.options).withWrap().skipPredicate(this._skipPredicate),this._activeOptionChanges=this._keyManager.change.subscribe(n=>{this.isOpen&&this.optionActivated.emit({source:this,option:this.options.toArray()[n]||null})}),this._setVisibility()}ngOnDestroy(){this._keyManager?.destroy(),this._activeOptionChanges.unsubscribe()}_setScrollTop(n){this.panel&&(this.panel.nativeElement.scrollTop=n)}_getScrollTop(){return this.panel?this.panel.nativeElement.scrollTop:0}_setVisibility(){this.showPanel=!!this.options.length,this._setVisibilityClasses(this._classList),this._changeDetectorRef.markForCheck()}_emitSelectEvent(n){const r=new Wee(this,n);this.optionSelected.emit(r)}_getPanelAriaLabelledby(n){return this.ariaLabel?null:this.ariaLabelledby?(n?n+" ":"")+this.ariaLabelledby:n}_setVisibilityClasses(n){n[this._visibleClass]=this.showPanel,n[this._hiddenClass]=!this.showPanel}_setThemeClasses(n){n["mat-primary"]="primary"===this._color,n["mat-warn"]="warn"===this._color,n["mat-accent"]="accent"===this._color}_skipPredicate(n){return n.disabled}}return(e=t).\u0275fac=function(n){return new(n||e)(m(Fe),m(W),m(YF),m(nt))},e.\u0275dir=T({type:e,viewQuery:function(n,r){if(1&n&&(ke(ct,7),ke(zee,5)),2&n){let o;H(o=z())&&(r.template=o.first),H(o=z())&&(r.panel=o.first)}},inputs:{ariaLabel:["aria-label","ariaLabel"],ariaLabelledby:["aria-labelledby","ariaLabelledby"],displayWith:"displayWith",autoActiveFirstOption:"autoActiveFirstOption",autoSelectActiveOption:"autoSelectActiveOption",requireSelection:"requireSelection",panelWidth:"panelWidth",classList:["class","classList"]},outputs:{optionSelected:"optionSelected",opened:"opened",closed:"closed",optionActivated:"optionActivated"},features:[P]}),t})(),Xee=(()=>{var e;class t extends Kee{constructor(){super(...arguments),this._visibleClass="mat-mdc-autocomplete-visible",this._hiddenClass="mat-mdc-autocomplete-hidden",this._animationDone=new U,this._hideSingleSelectionIndicator=this._defaults.hideSingleSelectionIndicator??!1}get hideSingleSelectionIndicator(){return this._hideSingleSelectionIndicator}set hideSingleSelectionIndicator(n){this._hideSingleSelectionIndicator=Q(n),this._syncParentProperties()}_syncParentProperties(){if(this.options)for(const n of this.options)n._changeDetectorRef.markForCheck()}ngOnDestroy(){super.ngOnDestroy(),this._animationDone.complete()}_skipPredicate(n){return!1}}return(e=t).\u0275fac=function(){let i;return function(r){return(i||(i=be(e)))(r||e)}}(),e.\u0275cmp=fe({type:e,selectors:[["mat-autocomplete"]],contentQueries:function(n,r,o){if(1&n&&(Ee(o,zv,5),Ee(o,Nf,5)),2&n){let s;H(s=z())&&(r.optionGroups=s),H(s=z())&&(r.options=s)}},hostAttrs:["ngSkipHydration","",1,"mat-mdc-autocomplete"],inputs:{disableRipple:"disableRipple",hideSingleSelectionIndicator:"hideSingleSelectionIndicator"},exportAs:["matAutocomplete"],features:[Z([{provide:Hv,useExisting:e}]),P],ngContentSelectors:$ee,decls:1,vars:0,consts:[["role","listbox",1,"mat-mdc-autocomplete-panel","mdc-menu-surface","mdc-menu-surface--open",3,"id","ngClass"],["panel",""]],template:function(n,r){1&n&&(ze(),R(0,Uee,3,5,"ng-template"))},dependencies:[Ea],styles:["div.mat-mdc-autocomplete-panel{box-shadow:0px 5px 5px -3px rgba(0, 0, 0, 0.2), 0px 8px 10px 1px rgba(0, 0, 0, 0.14), 0px 3px 14px 2px rgba(0, 0, 0, 0.12);width:100%;max-height:256px;visibility:hidden;transform-origin:center top;overflow:auto;padding:8px 0;border-radius:4px;box-sizing:border-box;position:static;background-color:var(--mat-autocomplete-background-color)}.cdk-high-contrast-active div.mat-mdc-autocomplete-panel{outline:solid 1px}.cdk-overlay-pane:not(.mat-mdc-autocomplete-panel-above) div.mat-mdc-autocomplete-panel{border-top-left-radius:0;border-top-right-radius:0}.mat-mdc-autocomplete-panel-above div.mat-mdc-autocomplete-panel{border-bottom-left-radius:0;border-bottom-right-radius:0;transform-origin:center bottom}div.mat-mdc-autocomplete-panel.mat-mdc-autocomplete-visible{visibility:visible}div.mat-mdc-autocomplete-panel.mat-mdc-autocomplete-hidden{visibility:hidden}mat-autocomplete{display:none}"],encapsulation:2,data:{animation:[qee]},changeDetection:0}),t})();const Zee={provide:Gn,useExisting:He(()=>XF),multi:!0},KF=new M("mat-autocomplete-scroll-strategy"),ete={provide:KF,deps:[wi],useFactory:function Jee(e){return()=>e.scrollStrategies.reposition()}};let tte=(()=>{var e;class t{get autocompleteDisabled(){return this._autocompleteDisabled}set autocompleteDisabled(n){this._autocompleteDisabled=Q(n)}constructor(n,r,o,s,a,c,l,d,u,h,f){this._element=n,this._overlay=r,this._viewContainerRef=o,this._zone=s,this._changeDetectorRef=a,this._dir=l,this._formField=d,this._document=u,this._viewportRuler=h,this._defaults=f,this._componentDestroyed=!1,this._autocompleteDisabled=!1,this._manuallyFloatingLabel=!1,this._viewportSubscription=Ae.EMPTY,this._canOpenOnNextFocus=!0,this._closeKeyEventStream=new Y,this._windowBlurHandler=()=>{this._canOpenOnNextFocus=this._document.activeElement!==this._element.nativeElement||this.panelOpen},this._onChange=()=>{},this._onTouched=()=>{},this.position="auto",this.autocompleteAttribute="off",this._overlayAttached=!1,this.optionSelections=Kf(()=>{const p=this.autocomplete?this.autocomplete.options:null;return p?p.changes.pipe(tn(p),Vt(()=>Ot(...p.map(g=>g.onSelectionChange)))):this._zone.onStable.pipe(Xe(1),Vt(()=>this.optionSelections))}),this._handlePanelKeydown=p=>{(27===p.keyCode&&!An(p)||38===p.keyCode&&An(p,"altKey"))&&(this._pendingAutoselectedOption&&(this._updateNativeInputValue(this._valueBeforeAutoSelection??""),this._pendingAutoselectedOption=null),this._closeKeyEventStream.next(),this._resetActiveItem(),p.stopPropagation(),p.preventDefault())},this._trackedModal=null,this._scrollStrategy=c}ngAfterViewInit(){const n=this._getWindow();typeof n<"u"&&this._zone.runOutsideAngular(()=>n.addEventListener("blur",this._windowBlurHandler))}ngOnChanges(n){n.position&&this._positionStrategy&&(this._setStrategyPositions(this._positionStrategy),this.panelOpen&&this._overlayRef.updatePosition())}ngOnDestroy(){const n=this._getWindow();typeof n<"u"&&n.removeEventListener("blur",this._windowBlurHandler),this._viewportSubscription.unsubscribe(),this._componentDestroyed=!0,this._destroyPanel(),this._closeKeyEventStream.complete(),this._clearFromModal()}get panelOpen(){return this._overlayAttached&&this.autocomplete.showPanel}openPanel(){this._attachOverlay(),this._floatLabel()}closePanel(){this._resetLabel(),this._overlayAttached&&(this.panelOpen&&this._zone.run(()=>{this.autocomplete.closed.emit()}),this.autocomplete._isOpen=this._overlayAttached=!1,this._pendingAutoselectedOption=null,this._overlayRef&&this._overlayRef.hasAttached()&&(this._overlayRef.detach(),this._closingActionsSubscription.unsubscribe()),this._updatePanelState(),this._componentDestroyed||this._changeDetectorRef.detectChanges())}updatePosition(){this._overlayAttached&&this._overlayRef.updatePosition()}get panelClosingActions(){return Ot(this.optionSelections,this.autocomplete._keyManager.tabOut.pipe(Ve(()=>this._overlayAttached)),this._closeKeyEventStream,this._getOutsideClickStream(),this._overlayRef?this._overlayRef.detachments().pipe(Ve(()=>this._overlayAttached)):re()).pipe(J(n=>n instanceof e1?n:null))}get activeOption(){return this.autocomplete&&this.autocomplete._keyManager?this.autocomplete._keyManager.activeItem:null}_getOutsideClickStream(){return Ot(Vi(this._document,"click"),Vi(this._document,"auxclick"),Vi(this._document,"touchend")).pipe(Ve(n=>{const r=Ir(n),o=this._formField?this._formField._elementRef.nativeElement:null,s=this.connectedTo?this.connectedTo.elementRef.nativeElement:null;return this._overlayAttached&&r!==this._element.nativeElement&&this._document.activeElement!==this._element.nativeElement&&(!o||!o.contains(r))&&(!s||!s.contains(r))&&!!this._overlayRef&&!this._overlayRef.overlayElement.contains(r)}))}writeValue(n){Promise.resolve(null).then(()=>this._assignOptionValue(n))}registerOnChange(n){this._onChange=n}registerOnTouched(n){this._onTouched=n}setDisabledState(n){this._element.nativeElement.disabled=n}_handleKeydown(n){const r=n.keyCode,o=An(n);if(27===r&&!o&&n.preventDefault(),this.activeOption&&13===r&&this.panelOpen&&!o)this.activeOption._selectViaInteraction(),this._resetActiveItem(),n.preventDefault();else if(this.autocomplete){const s=this.autocomplete._keyManager.activeItem,a=38===r||40===r;9===r||a&&!o&&this.panelOpen?this.autocomplete._keyManager.onKeydown(n):a&&this._canOpen()&&this.openPanel(),(a||this.autocomplete._keyManager.activeItem!==s)&&(this._scrollToOption(this.autocomplete._keyManager.activeItemIndex||0),this.autocomplete.autoSelectActiveOption&&this.activeOption&&(this._pendingAutoselectedOption||(this._valueBeforeAutoSelection=this._element.nativeElement.value),this._pendingAutoselectedOption=this.activeOption,this._assignOptionValue(this.activeOption.value)))}}_handleInput(n){let r=n.target,o=r.value;"number"===r.type&&(o=""==o?null:parseFloat(o)),this._previousValue!==o&&(this._previousValue=o,this._pendingAutoselectedOption=null,(!this.autocomplete||!this.autocomplete.requireSelection)&&this._onChange(o),o||this._clearPreviousSelectedOption(null,!1),this._canOpen()&&this._document.activeElement===n.target&&this.openPanel())}_handleFocus(){this._canOpenOnNextFocus?this._canOpen()&&(this._previousValue=this._element.nativeElement.value,this._attachOverlay(),this._floatLabel(!0)):this._canOpenOnNextFocus=!0}_handleClick(){this._canOpen()&&!this.panelOpen&&this.openPanel()}_floatLabel(n=!1){this._formField&&"auto"===this._formField.floatLabel&&(n?this._formField._animateAndLockLabel():this._formField.floatLabel="always",this._manuallyFloatingLabel=!0)}_resetLabel(){this._manuallyFloatingLabel&&(this._formField&&(this._formField.floatLabel="auto"),this._manuallyFloatingLabel=!1)}_subscribeToClosingActions(){return Ot(this._zone.onStable.pipe(Xe(1)),this.autocomplete.options.changes.pipe(it(()=>this._positionStrategy.reapplyLastPosition()),Zv(0))).pipe(Vt(()=>(this._zone.run(()=>{const o=this.panelOpen;this._resetActiveItem(),this._updatePanelState(),this._changeDetectorRef.detectChanges(),this.panelOpen&&this._overlayRef.updatePosition(),o!==this.panelOpen&&(this.panelOpen?this._emitOpened():this.autocomplete.closed.emit())}),this.panelClosingActions)),Xe(1)).subscribe(o=>this._setValueAndClose(o))}_emitOpened(){this._valueOnOpen=this._element.nativeElement.value,this.autocomplete.opened.emit()}_destroyPanel(){this._overlayRef&&(this.closePanel(),this._overlayRef.dispose(),this._overlayRef=null)}_assignOptionValue(n){const r=this.autocomplete&&this.autocomplete.displayWith?this.autocomplete.displayWith(n):n;this._updateNativeInputValue(r??"")}_updateNativeInputValue(n){this._formField?this._formField._control.value=n:this._element.nativeElement.value=n,this._previousValue=n}_setValueAndClose(n){const r=this.autocomplete,o=n?n.source:this._pendingAutoselectedOption;o?(this._clearPreviousSelectedOption(o),this._assignOptionValue(o.value),this._onChange(o.value),r._emitSelectEvent(o),this._element.nativeElement.focus()):r.requireSelection&&this._element.nativeElement.value!==this._valueOnOpen&&(this._clearPreviousSelectedOption(null),this._assignOptionValue(null),r._animationDone?r._animationDone.pipe(Xe(1)).subscribe(()=>this._onChange(null)):this._onChange(null)),this.closePanel()}_clearPreviousSelectedOption(n,r){this.autocomplete?.options?.forEach(o=>{o!==n&&o.selected&&o.deselect(r)})}_attachOverlay(){let n=this._overlayRef;n?(this._positionStrategy.setOrigin(this._getConnectedElement()),n.updateSize({width:this._getPanelWidth()})):(this._portal=new co(this.autocomplete.template,this._viewContainerRef,{id:this._formField?.getLabelId()}),n=this._overlay.create(this._getOverlayConfig()),this._overlayRef=n,this._viewportSubscription=this._viewportRuler.change().subscribe(()=>{this.panelOpen&&n&&n.updateSize({width:this._getPanelWidth()})})),n&&!n.hasAttached()&&(n.attach(this._portal),this._closingActionsSubscription=this._subscribeToClosingActions());const r=this.panelOpen;this.autocomplete._isOpen=this._overlayAttached=!0,this.autocomplete._setColor(this._formField?.color),this._updatePanelState(),this._applyModalPanelOwnership(),this.panelOpen&&r!==this.panelOpen&&this._emitOpened()}_updatePanelState(){if(this.autocomplete._setVisibility(),this.panelOpen){const n=this._overlayRef;this._keydownSubscription||(this._keydownSubscription=n.keydownEvents().subscribe(this._handlePanelKeydown)),this._outsideClickSubscription||(this._outsideClickSubscription=n.outsidePointerEvents().subscribe())}else this._keydownSubscription?.unsubscribe(),this._outsideClickSubscription?.unsubscribe(),this._keydownSubscription=this._outsideClickSubscription=null}_getOverlayConfig(){return new Jl({positionStrategy:this._getOverlayPosition(),scrollStrategy:this._scrollStrategy(),width:this._getPanelWidth(),direction:this._dir??void 0,panelClass:this._defaults?.overlayPanelClass})}_getOverlayPosition(){const n=this._overlay.position().flexibleConnectedTo(this._getConnectedElement()).withFlexibleDimensions(!1).withPush(!1);return this._setStrategyPositions(n),this._positionStrategy=n,n}_setStrategyPositions(n){const r=[{originX:"start",originY:"bottom",overlayX:"start",overlayY:"top"},{originX:"end",originY:"bottom",overlayX:"end",overlayY:"top"}],o=this._aboveClass,s=[{originX:"start",originY:"top",overlayX:"start",overlayY:"bottom",panelClass:o},{originX:"end",originY:"top",overlayX:"end",overlayY:"bottom",panelClass:o}];let a;a="above"===this.position?s:"below"===this.position?r:[...r,...s],n.withPositions(a)}_getConnectedElement(){return this.connectedTo?this.connectedTo.elementRef:this._formField?this._formField.getConnectedOverlayOrigin():this._element}_getPanelWidth(){return this.autocomplete.panelWidth||this._getHostWidth()}_getHostWidth(){return this._getConnectedElement().nativeElement.getBoundingClientRect().width}_resetActiveItem(){const n=this.autocomplete;if(n.autoActiveFirstOption){let r=-1;for(let o=0;o<n.options.length;o++)if(!n.options.get(o).disabled){r=o;break}n._keyManager.setActiveItem(r)}else n._keyManager.setActiveItem(-1)}_canOpen(){const n=this._element.nativeElement;return!n.readOnly&&!n.disabled&&!this._autocompleteDisabled}_getWindow(){return this._document?.defaultView||window}_scrollToOption(n){const r=this.autocomplete,o=t1(n,r.options,r.optionGroups);if(0===n&&1===o)r._setScrollTop(0);else if(r.panel){const s=r.options.toArray()[n];if(s){const a=s._getHostElement(),c=n1(a.offsetTop,a.offsetHeight,r._getScrollTop(),r.panel.nativeElement.offsetHeight);r._setScrollTop(c)}}}_applyModalPanelOwnership(){const n=this._element.nativeElement.closest('body > .cdk-overlay-container [aria-modal="true"]');if(!n)return;const r=this.autocomplete.id;this._trackedModal&&ql(this._trackedModal,"aria-owns",r),Rv(n,"aria-owns",r),this._trackedModal=n}_clearFromModal(){this._trackedModal&&(ql(this._trackedModal,"aria-owns",this.autocomplete.id),this._trackedModal=null)}}return(e=t).\u0275fac=function(n){return new(n||e)(m(W),m(wi),m(pt),m(G),m(Fe),m(KF),m(fn,8),m(Vd,9),m(he,8),m(Rr),m(YF,8))},e.\u0275dir=T({type:e,inputs:{autocomplete:["matAutocomplete","autocomplete"],position:["matAutocompletePosition","position"],connectedTo:["matAutocompleteConnectedTo","connectedTo"],autocompleteAttribute:["autocomplete","autocompleteAttribute"],autocompleteDisabled:["matAutocompleteDisabled","autocompleteDisabled"]},features:[kt]}),t})(),XF=(()=>{var e;class t extends tte{constructor(){super(...arguments),this._aboveClass="mat-mdc-autocomplete-panel-above"}}return(e=t).\u0275fac=function(){let i;return function(r){return(i||(i=be(e)))(r||e)}}(),e.\u0275dir=T({type:e,selectors:[["input","matAutocomplete",""],["textarea","matAutocomplete",""]],hostAttrs:[1,"mat-mdc-autocomplete-trigger"],hostVars:7,hostBindings:function(n,r){1&n&&$("focusin",function(){return r._handleFocus()})("blur",function(){return r._onTouched()})("input",function(s){return r._handleInput(s)})("keydown",function(s){return r._handleKeydown(s)})("click",function(){return r._handleClick()}),2&n&&de("autocomplete",r.autocompleteAttribute)("role",r.autocompleteDisabled?null:"combobox")("aria-autocomplete",r.autocompleteDisabled?null:"list")("aria-activedescendant",r.panelOpen&&r.activeOption?r.activeOption.id:null)("aria-expanded",r.autocompleteDisabled?null:r.panelOpen.toString())("aria-controls",r.autocompleteDisabled||!r.panelOpen||null==r.autocomplete?null:r.autocomplete.id)("aria-haspopup",r.autocompleteDisabled?null:"listbox")},exportAs:["matAutocompleteTrigger"],features:[Z([Zee]),P]}),t})(),nte=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275mod=le({type:e}),e.\u0275inj=ae({providers:[ete],imports:[ed,Yl,ve,vn,lo,Yl,ve]}),t})();const ite=["*"],cte=new M("MAT_CARD_CONFIG");let ZF=(()=>{var e;class t{constructor(n){this.appearance=n?.appearance||"raised"}}return(e=t).\u0275fac=function(n){return new(n||e)(m(cte,8))},e.\u0275cmp=fe({type:e,selectors:[["mat-card"]],hostAttrs:[1,"mat-mdc-card","mdc-card"],hostVars:4,hostBindings:function(n,r){2&n&&se("mat-mdc-card-outlined","outlined"===r.appearance)("mdc-card--outlined","outlined"===r.appearance)},inputs:{appearance:"appearance"},exportAs:["matCard"],ngContentSelectors:ite,decls:1,vars:0,template:function(n,r){1&n&&(ze(),X(0))},styles:['.mdc-card{display:flex;flex-direction:column;box-sizing:border-box}.mdc-card::after{position:absolute;box-sizing:border-box;width:100%;height:100%;top:0;left:0;border:1px solid rgba(0,0,0,0);border-radius:inherit;content:"";pointer-events:none;pointer-events:none}@media screen and (forced-colors: active){.mdc-card::after{border-color:CanvasText}}.mdc-card--outlined::after{border:none}.mdc-card__content{border-radius:inherit;height:100%}.mdc-card__media{position:relative;box-sizing:border-box;background-repeat:no-repeat;background-position:center;background-size:cover}.mdc-card__media::before{display:block;content:""}.mdc-card__media:first-child{border-top-left-radius:inherit;border-top-right-radius:inherit}.mdc-card__media:last-child{border-bottom-left-radius:inherit;border-bottom-right-radius:inherit}.mdc-card__media--square::before{margin-top:100%}.mdc-card__media--16-9::before{margin-top:56.25%}.mdc-card__media-content{position:absolute;top:0;right:0;bottom:0;left:0;box-sizing:border-box}.mdc-card__primary-action{display:flex;flex-direction:column;box-sizing:border-box;position:relative;outline:none;color:inherit;text-decoration:none;cursor:pointer;overflow:hidden}.mdc-card__primary-action:first-child{border-top-left-radius:inherit;border-top-right-radius:inherit}.mdc-card__primary-action:last-child{border-bottom-left-radius:inherit;border-bottom-right-radius:inherit}.mdc-card__actions{display:flex;flex-direction:row;align-items:center;box-sizing:border-box;min-height:52px;padding:8px}.mdc-card__actions--full-bleed{padding:0}.mdc-card__action-buttons,.mdc-card__action-icons{display:flex;flex-direction:row;align-items:center;box-sizing:border-box}.mdc-card__action-icons{color:rgba(0, 0, 0, 0.6);flex-grow:1;justify-content:flex-end}.mdc-card__action-buttons+.mdc-card__action-icons{margin-left:16px;margin-right:0}[dir=rtl] .mdc-card__action-buttons+.mdc-card__action-icons,.mdc-card__action-buttons+.mdc-card__action-icons[dir=rtl]{margin-left:0;margin-right:16px}.mdc-card__action{display:inline-flex;flex-direction:row;align-items:center;box-sizing:border-box;justify-content:center;cursor:pointer;user-select:none}.mdc-card__action:focus{outline:none}.mdc-card__action--button{margin-left:0;margin-right:8px;padding:0 8px}[dir=rtl] .mdc-card__action--button,.mdc-card__action--button[dir=rtl]{margin-left:8px;margin-right:0}.mdc-card__action--button:last-child{margin-left:0;margin-right:0}[dir=rtl] .mdc-card__action--button:last-child,.mdc-card__action--button:last-child[dir=rtl]{margin-left:0;margin-right:0}.mdc-card__actions--full-bleed .mdc-card__action--button{justify-content:space-between;width:100%;height:auto;max-height:none;margin:0;padding:8px 16px;text-align:left}[dir=rtl] .mdc-card__actions--full-bleed .mdc-card__action--button,.mdc-card__actions--full-bleed .mdc-card__action--button[dir=rtl]{text-align:right}.mdc-card__action--icon{margin:-6px 0;padding:12px}.mdc-card__action--icon:not(:disabled){color:rgba(0, 0, 0, 0.6)}.mat-mdc-card{border-radius:var(--mdc-elevated-card-container-shape);background-color:var(--mdc-elevated-card-container-color);border-width:0;border-style:solid;border-color:var(--mdc-elevated-card-container-color);box-shadow:var(--mdc-elevated-card-container-elevation);--mdc-elevated-card-container-shape:4px;--mdc-outlined-card-container-shape:4px;--mdc-outlined-card-outline-width:1px}.mat-mdc-card .mdc-card::after{border-radius:var(--mdc-elevated-card-container-shape)}.mat-mdc-card-outlined{border-width:var(--mdc-outlined-card-outline-width);border-style:solid;border-color:var(--mdc-outlined-card-outline-color);border-radius:var(--mdc-outlined-card-container-shape);background-color:var(--mdc-outlined-card-container-color);box-shadow:var(--mdc-outlined-card-container-elevation)}.mat-mdc-card-outlined .mdc-card::after{border-radius:var(--mdc-outlined-card-container-shape)}.mat-mdc-card-title{font-family:var(--mat-card-title-text-font);line-height:var(--mat-card-title-text-line-height);font-size:var(--mat-card-title-text-size);letter-spacing:var(--mat-card-title-text-tracking);font-weight:var(--mat-card-title-text-weight)}.mat-mdc-card-subtitle{color:var(--mat-card-subtitle-text-color);font-family:var(--mat-card-subtitle-text-font);line-height:var(--mat-card-subtitle-text-line-height);font-size:var(--mat-card-subtitle-text-size);letter-spacing:var(--mat-card-subtitle-text-tracking);font-weight:var(--mat-card-subtitle-text-weight)}.mat-mdc-card{position:relative}.mat-mdc-card-title,.mat-mdc-card-subtitle{display:block;margin:0}.mat-mdc-card-avatar~.mat-mdc-card-header-text .mat-mdc-card-title,.mat-mdc-card-avatar~.mat-mdc-card-header-text .mat-mdc-card-subtitle{padding:16px 16px 0}.mat-mdc-card-header{display:flex;padding:16px 16px 0}.mat-mdc-card-content{display:block;padding:0 16px}.mat-mdc-card-content:first-child{padding-top:16px}.mat-mdc-card-content:last-child{padding-bottom:16px}.mat-mdc-card-title-group{display:flex;justify-content:space-between;width:100%}.mat-mdc-card-avatar{height:40px;width:40px;border-radius:50%;flex-shrink:0;margin-bottom:16px;object-fit:cover}.mat-mdc-card-avatar~.mat-mdc-card-header-text .mat-mdc-card-subtitle,.mat-mdc-card-avatar~.mat-mdc-card-header-text .mat-mdc-card-title{line-height:normal}.mat-mdc-card-sm-image{width:80px;height:80px}.mat-mdc-card-md-image{width:112px;height:112px}.mat-mdc-card-lg-image{width:152px;height:152px}.mat-mdc-card-xl-image{width:240px;height:240px}.mat-mdc-card-subtitle~.mat-mdc-card-title,.mat-mdc-card-title~.mat-mdc-card-subtitle,.mat-mdc-card-header .mat-mdc-card-header-text .mat-mdc-card-title,.mat-mdc-card-header .mat-mdc-card-header-text .mat-mdc-card-subtitle,.mat-mdc-card-title-group .mat-mdc-card-title,.mat-mdc-card-title-group .mat-mdc-card-subtitle{padding-top:0}.mat-mdc-card-content>:last-child:not(.mat-mdc-card-footer){margin-bottom:0}.mat-mdc-card-actions-align-end{justify-content:flex-end}'],encapsulation:2,changeDetection:0}),t})(),JF=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275dir=T({type:e,selectors:[["mat-card-content"]],hostAttrs:[1,"mat-mdc-card-content"]}),t})(),eP=(()=>{var e;class t{constructor(){this.align="start"}}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275dir=T({type:e,selectors:[["mat-card-actions"]],hostAttrs:[1,"mat-mdc-card-actions","mdc-card__actions"],hostVars:2,hostBindings:function(n,r){2&n&&se("mat-mdc-card-actions-align-end","end"===r.align)},inputs:{align:"align"},exportAs:["matCardActions"]}),t})(),hte=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275mod=le({type:e}),e.\u0275inj=ae({imports:[ve,vn,ve]}),t})();const fte=["input"],pte=["label"],mte=["*"],gte=new M("mat-checkbox-default-options",{providedIn:"root",factory:tP});function tP(){return{color:"accent",clickAction:"check-indeterminate"}}const _te={provide:Gn,useExisting:He(()=>iP),multi:!0};class bte{}let vte=0;const nP=tP(),yte=ns(ts(so(es(class{constructor(e){this._elementRef=e}}))));let wte=(()=>{var e;class t extends yte{get inputId(){return`${this.id||this._uniqueId}-input`}get required(){return this._required}set required(n){this._required=Q(n)}constructor(n,r,o,s,a,c,l){super(r),this._changeDetectorRef=o,this._ngZone=s,this._animationMode=c,this._options=l,this.ariaLabel="",this.ariaLabelledby=null,this.labelPosition="after",this.name=null,this.change=new U,this.indeterminateChange=new U,this._onTouched=()=>{},this._currentAnimationClass="",this._currentCheckState=0,this._controlValueAccessorChangeFn=()=>{},this._checked=!1,this._disabled=!1,this._indeterminate=!1,this._options=this._options||nP,this.color=this.defaultColor=this._options.color||nP.color,this.tabIndex=parseInt(a)||0,this.id=this._uniqueId=`${n}${++vte}`}ngAfterViewInit(){this._syncIndeterminate(this._indeterminate)}get checked(){return this._checked}set checked(n){const r=Q(n);r!=this.checked&&(this._checked=r,this._changeDetectorRef.markForCheck())}get disabled(){return this._disabled}set disabled(n){const r=Q(n);r!==this.disabled&&(this._disabled=r,this._changeDetectorRef.markForCheck())}get indeterminate(){return this._indeterminate}set indeterminate(n){const r=n!=this._indeterminate;this._indeterminate=Q(n),r&&(this._transitionCheckState(this._indeterminate?3:this.checked?1:2),this.indeterminateChange.emit(this._indeterminate)),this._syncIndeterminate(this._indeterminate)}_isRippleDisabled(){return this.disableRipple||this.disabled}_onLabelTextChange(){this._changeDetectorRef.detectChanges()}writeValue(n){this.checked=!!n}registerOnChange(n){this._controlValueAccessorChangeFn=n}registerOnTouched(n){this._onTouched=n}setDisabledState(n){this.disabled=n}_transitionCheckState(n){let r=this._currentCheckState,o=this._getAnimationTargetElement();if(r!==n&&o&&(this._currentAnimationClass&&o.classList.remove(this._currentAnimationClass),this._currentAnimationClass=this._getAnimationClassForCheckStateTransition(r,n),this._currentCheckState=n,this._currentAnimationClass.length>0)){o.classList.add(this._currentAnimationClass);const s=this._currentAnimationClass;this._ngZone.runOutsideAngular(()=>{setTimeout(()=>{o.classList.remove(s)},1e3)})}}_emitChangeEvent(){this._controlValueAccessorChangeFn(this.checked),this.change.emit(this._createChangeEvent(this.checked)),this._inputElement&&(this._inputElement.nativeElement.checked=this.checked)}toggle(){this.checked=!this.checked,this._controlValueAccessorChangeFn(this.checked)}_handleInputClick(){const n=this._options?.clickAction;this.disabled||"noop"===n?!this.disabled&&"noop"===n&&(this._inputElement.nativeElement.checked=this.checked,this._inputElement.nativeElement.indeterminate=this.indeterminate):(this.indeterminate&&"check"!==n&&Promise.resolve().then(()=>{this._indeterminate=!1,this.indeterminateChange.emit(this._indeterminate)}),this._checked=!this._checked,this._transitionCheckState(this._checked?1:2),this._emitChangeEvent())}_onInteractionEvent(n){n.stopPropagation()}_onBlur(){Promise.resolve().then(()=>{this._onTouched(),this._changeDetectorRef.markForCheck()})}_getAnimationClassForCheckStateTransition(n,r){if("NoopAnimations"===this._animationMode)return"";switch(n){case 0:if(1===r)return this._animationClasses.uncheckedToChecked;if(3==r)return this._checked?this._animationClasses.checkedToIndeterminate:this._animationClasses.uncheckedToIndeterminate;break;case 2:return 1===r?this._animationClasses.uncheckedToChecked:this._animationClasses.uncheckedToIndeterminate;case 1:return 2===r?this._animationClasses.checkedToUnchecked:this._animationClasses.checkedToIndeterminate;case 3:return 1===r?this._animationClasses.indeterminateToChecked:this._animationClasses.indeterminateToUnchecked}return""}_syncIndeterminate(n){const r=this._inputElement;r&&(r.nativeElement.indeterminate=n)}}return(e=t).\u0275fac=function(n){jo()},e.\u0275dir=T({type:e,viewQuery:function(n,r){if(1&n&&(ke(fte,5),ke(pte,5),ke(ao,5)),2&n){let o;H(o=z())&&(r._inputElement=o.first),H(o=z())&&(r._labelElement=o.first),H(o=z())&&(r.ripple=o.first)}},inputs:{ariaLabel:["aria-label","ariaLabel"],ariaLabelledby:["aria-labelledby","ariaLabelledby"],ariaDescribedby:["aria-describedby","ariaDescribedby"],id:"id",required:"required",labelPosition:"labelPosition",name:"name",value:"value",checked:"checked",disabled:"disabled",indeterminate:"indeterminate"},outputs:{change:"change",indeterminateChange:"indeterminateChange"},features:[P]}),t})(),iP=(()=>{var e;class t extends wte{constructor(n,r,o,s,a,c){super("mat-mdc-checkbox-",n,r,o,s,a,c),this._animationClasses={uncheckedToChecked:"mdc-checkbox--anim-unchecked-checked",uncheckedToIndeterminate:"mdc-checkbox--anim-unchecked-indeterminate",checkedToUnchecked:"mdc-checkbox--anim-checked-unchecked",checkedToIndeterminate:"mdc-checkbox--anim-checked-indeterminate",indeterminateToChecked:"mdc-checkbox--anim-indeterminate-checked",indeterminateToUnchecked:"mdc-checkbox--anim-indeterminate-unchecked"}}focus(){this._inputElement.nativeElement.focus()}_createChangeEvent(n){const r=new bte;return r.source=this,r.checked=n,r}_getAnimationTargetElement(){return this._inputElement?.nativeElement}_onInputClick(){super._handleInputClick()}_onTouchTargetClick(){super._handleInputClick(),this.disabled||this._inputElement.nativeElement.focus()}_preventBubblingFromLabel(n){n.target&&this._labelElement.nativeElement.contains(n.target)&&n.stopPropagation()}}return(e=t).\u0275fac=function(n){return new(n||e)(m(W),m(Fe),m(G),ui("tabindex"),m(_t,8),m(gte,8))},e.\u0275cmp=fe({type:e,selectors:[["mat-checkbox"]],hostAttrs:[1,"mat-mdc-checkbox"],hostVars:12,hostBindings:function(n,r){2&n&&(pi("id",r.id),de("tabindex",null)("aria-label",null)("aria-labelledby",null),se("_mat-animation-noopable","NoopAnimations"===r._animationMode)("mdc-checkbox--disabled",r.disabled)("mat-mdc-checkbox-disabled",r.disabled)("mat-mdc-checkbox-checked",r.checked))},inputs:{disableRipple:"disableRipple",color:"color",tabIndex:"tabIndex"},exportAs:["matCheckbox"],features:[Z([_te]),P],ngContentSelectors:mte,decls:15,vars:19,consts:[[1,"mdc-form-field",3,"click"],[1,"mdc-checkbox"],["checkbox",""],[1,"mat-mdc-checkbox-touch-target",3,"click"],["type","checkbox",1,"mdc-checkbox__native-control",3,"checked","indeterminate","disabled","id","required","tabIndex","blur","click","change"],["input",""],[1,"mdc-checkbox__ripple"],[1,"mdc-checkbox__background"],["focusable","false","viewBox","0 0 24 24","aria-hidden","true",1,"mdc-checkbox__checkmark"],["fill","none","d","M1.73,12.91 8.1,19.28 22.79,4.59",1,"mdc-checkbox__checkmark-path"],[1,"mdc-checkbox__mixedmark"],["mat-ripple","",1,"mat-mdc-checkbox-ripple","mat-mdc-focus-indicator",3,"matRippleTrigger","matRippleDisabled","matRippleCentered"],[1,"mdc-label",3,"for"],["label",""]],template:function(n,r){if(1&n&&(ze(),y(0,"div",0),$("click",function(s){return r._preventBubblingFromLabel(s)}),y(1,"div",1,2)(3,"div",3),$("click",function(){return r._onTouchTargetClick()}),w(),y(4,"input",4,5),$("blur",function(){return r._onBlur()})("click",function(){return r._onInputClick()})("change",function(s){return r._onInteractionEvent(s)}),w(),ie(6,"div",6),y(7,"div",7),zs(),y(8,"svg",8),ie(9,"path",9),w(),ag(),ie(10,"div",10),w(),ie(11,"div",11),w(),y(12,"label",12,13),X(14),w()()),2&n){const o=hn(2);se("mdc-form-field--align-end","before"==r.labelPosition),x(4),se("mdc-checkbox--selected",r.checked),E("checked",r.checked)("indeterminate",r.indeterminate)("disabled",r.disabled)("id",r.inputId)("required",r.required)("tabIndex",r.tabIndex),de("aria-label",r.ariaLabel||null)("aria-labelledby",r.ariaLabelledby)("aria-describedby",r.ariaDescribedby)("name",r.name)("value",r.value),x(7),E("matRippleTrigger",o)("matRippleDisabled",r.disableRipple||r.disabled)("matRippleCentered",!0),x(1),E("for",r.inputId)}},dependencies:[ao],styles:['.mdc-touch-target-wrapper{display:inline}@keyframes mdc-checkbox-unchecked-checked-checkmark-path{0%,50%{stroke-dashoffset:29.7833385}50%{animation-timing-function:cubic-bezier(0, 0, 0.2, 1)}100%{stroke-dashoffset:0}}@keyframes mdc-checkbox-unchecked-indeterminate-mixedmark{0%,68.2%{transform:scaleX(0)}68.2%{animation-timing-function:cubic-bezier(0, 0, 0, 1)}100%{transform:scaleX(1)}}@keyframes mdc-checkbox-checked-unchecked-checkmark-path{from{animation-timing-function:cubic-bezier(0.4, 0, 1, 1);opacity:1;stroke-dashoffset:0}to{opacity:0;stroke-dashoffset:-29.7833385}}@keyframes mdc-checkbox-checked-indeterminate-checkmark{from{animation-timing-function:cubic-bezier(0, 0, 0.2, 1);transform:rotate(0deg);opacity:1}to{transform:rotate(45deg);opacity:0}}@keyframes mdc-checkbox-indeterminate-checked-checkmark{from{animation-timing-function:cubic-bezier(0.14, 0, 0, 1);transform:rotate(45deg);opacity:0}to{transform:rotate(360deg);opacity:1}}@keyframes mdc-checkbox-checked-indeterminate-mixedmark{from{animation-timing-function:mdc-animation-deceleration-curve-timing-function;transform:rotate(-45deg);opacity:0}to{transform:rotate(0deg);opacity:1}}@keyframes mdc-checkbox-indeterminate-checked-mixedmark{from{animation-timing-function:cubic-bezier(0.14, 0, 0, 1);transform:rotate(0deg);opacity:1}to{transform:rotate(315deg);opacity:0}}@keyframes mdc-checkbox-indeterminate-unchecked-mixedmark{0%{animation-timing-function:linear;transform:scaleX(1);opacity:1}32.8%,100%{transform:scaleX(0);opacity:0}}.mdc-checkbox{display:inline-block;position:relative;flex:0 0 18px;box-sizing:content-box;width:18px;height:18px;line-height:0;white-space:nowrap;cursor:pointer;vertical-align:bottom}.mdc-checkbox[hidden]{display:none}.mdc-checkbox.mdc-ripple-upgraded--background-focused .mdc-checkbox__focus-ring,.mdc-checkbox:not(.mdc-ripple-upgraded):focus .mdc-checkbox__focus-ring{pointer-events:none;border:2px solid rgba(0,0,0,0);border-radius:6px;box-sizing:content-box;position:absolute;top:50%;left:50%;transform:translate(-50%, -50%);height:100%;width:100%}@media screen and (forced-colors: active){.mdc-checkbox.mdc-ripple-upgraded--background-focused .mdc-checkbox__focus-ring,.mdc-checkbox:not(.mdc-ripple-upgraded):focus .mdc-checkbox__focus-ring{border-color:CanvasText}}.mdc-checkbox.mdc-ripple-upgraded--background-focused .mdc-checkbox__focus-ring::after,.mdc-checkbox:not(.mdc-ripple-upgraded):focus .mdc-checkbox__focus-ring::after{content:"";border:2px solid rgba(0,0,0,0);border-radius:8px;display:block;position:absolute;top:50%;left:50%;transform:translate(-50%, -50%);height:calc(100% + 4px);width:calc(100% + 4px)}@media screen and (forced-colors: active){.mdc-checkbox.mdc-ripple-upgraded--background-focused .mdc-checkbox__focus-ring::after,.mdc-checkbox:not(.mdc-ripple-upgraded):focus .mdc-checkbox__focus-ring::after{border-color:CanvasText}}@media all and (-ms-high-contrast: none){.mdc-checkbox .mdc-checkbox__focus-ring{display:none}}@media screen and (forced-colors: active),(-ms-high-contrast: active){.mdc-checkbox__mixedmark{margin:0 1px}}.mdc-checkbox--disabled{cursor:default;pointer-events:none}.mdc-checkbox__background{display:inline-flex;position:absolute;align-items:center;justify-content:center;box-sizing:border-box;width:18px;height:18px;border:2px solid currentColor;border-radius:2px;background-color:rgba(0,0,0,0);pointer-events:none;will-change:background-color,border-color;transition:background-color 90ms 0ms cubic-bezier(0.4, 0, 0.6, 1),border-color 90ms 0ms cubic-bezier(0.4, 0, 0.6, 1)}.mdc-checkbox__checkmark{position:absolute;top:0;right:0;bottom:0;left:0;width:100%;opacity:0;transition:opacity 180ms 0ms cubic-bezier(0.4, 0, 0.6, 1)}.mdc-checkbox--upgraded .mdc-checkbox__checkmark{opacity:1}.mdc-checkbox__checkmark-path{transition:stroke-dashoffset 180ms 0ms cubic-bezier(0.4, 0, 0.6, 1);stroke:currentColor;stroke-width:3.12px;stroke-dashoffset:29.7833385;stroke-dasharray:29.7833385}.mdc-checkbox__mixedmark{width:100%;height:0;transform:scaleX(0) rotate(0deg);border-width:1px;border-style:solid;opacity:0;transition:opacity 90ms 0ms cubic-bezier(0.4, 0, 0.6, 1),transform 90ms 0ms cubic-bezier(0.4, 0, 0.6, 1)}.mdc-checkbox--anim-unchecked-checked .mdc-checkbox__background,.mdc-checkbox--anim-unchecked-indeterminate .mdc-checkbox__background,.mdc-checkbox--anim-checked-unchecked .mdc-checkbox__background,.mdc-checkbox--anim-indeterminate-unchecked .mdc-checkbox__background{animation-duration:180ms;animation-timing-function:linear}.mdc-checkbox--anim-unchecked-checked .mdc-checkbox__checkmark-path{animation:mdc-checkbox-unchecked-checked-checkmark-path 180ms linear 0s;transition:none}.mdc-checkbox--anim-unchecked-indeterminate .mdc-checkbox__mixedmark{animation:mdc-checkbox-unchecked-indeterminate-mixedmark 90ms linear 0s;transition:none}.mdc-checkbox--anim-checked-unchecked .mdc-checkbox__checkmark-path{animation:mdc-checkbox-checked-unchecked-checkmark-path 90ms linear 0s;transition:none}.mdc-checkbox--anim-checked-indeterminate .mdc-checkbox__checkmark{animation:mdc-checkbox-checked-indeterminate-checkmark 90ms linear 0s;transition:none}.mdc-checkbox--anim-checked-indeterminate .mdc-checkbox__mixedmark{animation:mdc-checkbox-checked-indeterminate-mixedmark 90ms linear 0s;transition:none}.mdc-checkbox--anim-indeterminate-checked .mdc-checkbox__checkmark{animation:mdc-checkbox-indeterminate-checked-checkmark 500ms linear 0s;transition:none}.mdc-checkbox--anim-indeterminate-checked .mdc-checkbox__mixedmark{animation:mdc-checkbox-indeterminate-checked-mixedmark 500ms linear 0s;transition:none}.mdc-checkbox--anim-indeterminate-unchecked .mdc-checkbox__mixedmark{animation:mdc-checkbox-indeterminate-unchecked-mixedmark 300ms linear 0s;transition:none}.mdc-checkbox__native-control:checked~.mdc-checkbox__background,.mdc-checkbox__native-control:indeterminate~.mdc-checkbox__background,.mdc-checkbox__native-control[data-indeterminate=true]~.mdc-checkbox__background{transition:border-color 90ms 0ms cubic-bezier(0, 0, 0.2, 1),background-color 90ms 0ms cubic-bezier(0, 0, 0.2, 1)}.mdc-checkbox__native-control:checked~.mdc-checkbox__background .mdc-checkbox__checkmark-path,.mdc-checkbox__native-control:indeterminate~.mdc-checkbox__background .mdc-checkbox__checkmark-path,.mdc-checkbox__native-control[data-indeterminate=true]~.mdc-checkbox__background .mdc-checkbox__checkmark-path{stroke-dashoffset:0}.mdc-checkbox__native-control{position:absolute;margin:0;padding:0;opacity:0;cursor:inherit}.mdc-checkbox__native-control:disabled{cursor:default;pointer-events:none}.mdc-checkbox--touch{margin:calc((var(--mdc-checkbox-state-layer-size) - var(--mdc-checkbox-state-layer-size)) / 2)}.mdc-checkbox--touch .mdc-checkbox__native-control{top:calc((var(--mdc-checkbox-state-layer-size) - var(--mdc-checkbox-state-layer-size)) / 2);right:calc((var(--mdc-checkbox-state-layer-size) - var(--mdc-checkbox-state-layer-size)) / 2);left:calc((var(--mdc-checkbox-state-layer-size) - var(--mdc-checkbox-state-layer-size)) / 2);width:var(--mdc-checkbox-state-layer-size);height:var(--mdc-checkbox-state-layer-size)}.mdc-checkbox__native-control:checked~.mdc-checkbox__background .mdc-checkbox__checkmark{transition:opacity 180ms 0ms cubic-bezier(0, 0, 0.2, 1),transform 180ms 0ms cubic-bezier(0, 0, 0.2, 1);opacity:1}.mdc-checkbox__native-control:checked~.mdc-checkbox__background .mdc-checkbox__mixedmark{transform:scaleX(1) rotate(-45deg)}.mdc-checkbox__native-control:indeterminate~.mdc-checkbox__background .mdc-checkbox__checkmark,.mdc-checkbox__native-control[data-indeterminate=true]~.mdc-checkbox__background .mdc-checkbox__checkmark{transform:rotate(45deg);opacity:0;transition:opacity 90ms 0ms cubic-bezier(0.4, 0, 0.6, 1),transform 90ms 0ms cubic-bezier(0.4, 0, 0.6, 1)}.mdc-checkbox__native-control:indeterminate~.mdc-checkbox__background .mdc-checkbox__mixedmark,.mdc-checkbox__native-control[data-indeterminate=true]~.mdc-checkbox__background .mdc-checkbox__mixedmark{transform:scaleX(1) rotate(0deg);opacity:1}.mdc-checkbox.mdc-checkbox--upgraded .mdc-checkbox__background,.mdc-checkbox.mdc-checkbox--upgraded .mdc-checkbox__checkmark,.mdc-checkbox.mdc-checkbox--upgraded .mdc-checkbox__checkmark-path,.mdc-checkbox.mdc-checkbox--upgraded .mdc-checkbox__mixedmark{transition:none}.mdc-form-field{display:inline-flex;align-items:center;vertical-align:middle}.mdc-form-field[hidden]{display:none}.mdc-form-field>label{margin-left:0;margin-right:auto;padding-left:4px;padding-right:0;order:0}[dir=rtl] .mdc-form-field>label,.mdc-form-field>label[dir=rtl]{margin-left:auto;margin-right:0}[dir=rtl] .mdc-form-field>label,.mdc-form-field>label[dir=rtl]{padding-left:0;padding-right:4px}.mdc-form-field--nowrap>label{text-overflow:ellipsis;overflow:hidden;white-space:nowrap}.mdc-form-field--align-end>label{margin-left:auto;margin-right:0;padding-left:0;padding-right:4px;order:-1}[dir=rtl] .mdc-form-field--align-end>label,.mdc-form-field--align-end>label[dir=rtl]{margin-left:0;margin-right:auto}[dir=rtl] .mdc-form-field--align-end>label,.mdc-form-field--align-end>label[dir=rtl]{padding-left:4px;padding-right:0}.mdc-form-field--space-between{justify-content:space-between}.mdc-form-field--space-between>label{margin:0}[dir=rtl] .mdc-form-field--space-between>label,.mdc-form-field--space-between>label[dir=rtl]{margin:0}.mdc-checkbox{padding:calc((var(--mdc-checkbox-state-layer-size) - 18px) / 2);margin:calc((var(--mdc-checkbox-state-layer-size) - var(--mdc-checkbox-state-layer-size)) / 2)}.mdc-checkbox .mdc-checkbox__native-control[disabled]:not(:checked):not(:indeterminate):not([data-indeterminate=true])~.mdc-checkbox__background{border-color:var(--mdc-checkbox-disabled-unselected-icon-color);background-color:transparent}.mdc-checkbox .mdc-checkbox__native-control[disabled]:checked~.mdc-checkbox__background,.mdc-checkbox .mdc-checkbox__native-control[disabled]:indeterminate~.mdc-checkbox__background,.mdc-checkbox .mdc-checkbox__native-control[data-indeterminate=true][disabled]~.mdc-checkbox__background{border-color:transparent;background-color:var(--mdc-checkbox-disabled-selected-icon-color)}.mdc-checkbox .mdc-checkbox__native-control:enabled~.mdc-checkbox__background .mdc-checkbox__checkmark{color:var(--mdc-checkbox-selected-checkmark-color)}.mdc-checkbox .mdc-checkbox__native-control:enabled~.mdc-checkbox__background .mdc-checkbox__mixedmark{border-color:var(--mdc-checkbox-selected-checkmark-color)}.mdc-checkbox .mdc-checkbox__native-control:disabled~.mdc-checkbox__background .mdc-checkbox__checkmark{color:var(--mdc-checkbox-disabled-selected-checkmark-color)}.mdc-checkbox .mdc-checkbox__native-control:disabled~.mdc-checkbox__background .mdc-checkbox__mixedmark{border-color:var(--mdc-checkbox-disabled-selected-checkmark-color)}.mdc-checkbox .mdc-checkbox__native-control:enabled:not(:checked):not(:indeterminate):not([data-indeterminate=true])~.mdc-checkbox__background{border-color:var(--mdc-checkbox-unselected-icon-color);background-color:transparent}.mdc-checkbox .mdc-checkbox__native-control:enabled:checked~.mdc-checkbox__background,.mdc-checkbox .mdc-checkbox__native-control:enabled:indeterminate~.mdc-checkbox__background,.mdc-checkbox .mdc-checkbox__native-control[data-indeterminate=true]:enabled~.mdc-checkbox__background{border-color:var(--mdc-checkbox-selected-icon-color);background-color:var(--mdc-checkbox-selected-icon-color)}@keyframes mdc-checkbox-fade-in-background-8A000000FFF4433600000000FFF44336{0%{border-color:var(--mdc-checkbox-unselected-icon-color);background-color:transparent}50%{border-color:var(--mdc-checkbox-selected-icon-color);background-color:var(--mdc-checkbox-selected-icon-color)}}@keyframes mdc-checkbox-fade-out-background-8A000000FFF4433600000000FFF44336{0%,80%{border-color:var(--mdc-checkbox-selected-icon-color);background-color:var(--mdc-checkbox-selected-icon-color)}100%{border-color:var(--mdc-checkbox-unselected-icon-color);background-color:transparent}}.mdc-checkbox.mdc-checkbox--anim-unchecked-checked .mdc-checkbox__native-control:enabled~.mdc-checkbox__background,.mdc-checkbox.mdc-checkbox--anim-unchecked-indeterminate .mdc-checkbox__native-control:enabled~.mdc-checkbox__background{animation-name:mdc-checkbox-fade-in-background-8A000000FFF4433600000000FFF44336}.mdc-checkbox.mdc-checkbox--anim-checked-unchecked .mdc-checkbox__native-control:enabled~.mdc-checkbox__background,.mdc-checkbox.mdc-checkbox--anim-indeterminate-unchecked .mdc-checkbox__native-control:enabled~.mdc-checkbox__background{animation-name:mdc-checkbox-fade-out-background-8A000000FFF4433600000000FFF44336}.mdc-checkbox:hover .mdc-checkbox__native-control:enabled:not(:checked):not(:indeterminate):not([data-indeterminate=true])~.mdc-checkbox__background{border-color:var(--mdc-checkbox-unselected-hover-icon-color);background-color:transparent}.mdc-checkbox:hover .mdc-checkbox__native-control:enabled:checked~.mdc-checkbox__background,.mdc-checkbox:hover .mdc-checkbox__native-control:enabled:indeterminate~.mdc-checkbox__background,.mdc-checkbox:hover .mdc-checkbox__native-control[data-indeterminate=true]:enabled~.mdc-checkbox__background{border-color:var(--mdc-checkbox-selected-hover-icon-color);background-color:var(--mdc-checkbox-selected-hover-icon-color)}@keyframes mdc-checkbox-fade-in-background-FF212121FFF4433600000000FFF44336{0%{border-color:var(--mdc-checkbox-unselected-hover-icon-color);background-color:transparent}50%{border-color:var(--mdc-checkbox-selected-hover-icon-color);background-color:var(--mdc-checkbox-selected-hover-icon-color)}}@keyframes mdc-checkbox-fade-out-background-FF212121FFF4433600000000FFF44336{0%,80%{border-color:var(--mdc-checkbox-selected-hover-icon-color);background-color:var(--mdc-checkbox-selected-hover-icon-color)}100%{border-color:var(--mdc-checkbox-unselected-hover-icon-color);background-color:transparent}}.mdc-checkbox:hover.mdc-checkbox--anim-unchecked-checked .mdc-checkbox__native-control:enabled~.mdc-checkbox__background,.mdc-checkbox:hover.mdc-checkbox--anim-unchecked-indeterminate .mdc-checkbox__native-control:enabled~.mdc-checkbox__background{animation-name:mdc-checkbox-fade-in-background-FF212121FFF4433600000000FFF44336}.mdc-checkbox:hover.mdc-checkbox--anim-checked-unchecked .mdc-checkbox__native-control:enabled~.mdc-checkbox__background,.mdc-checkbox:hover.mdc-checkbox--anim-indeterminate-unchecked .mdc-checkbox__native-control:enabled~.mdc-checkbox__background{animation-name:mdc-checkbox-fade-out-background-FF212121FFF4433600000000FFF44336}.mdc-checkbox:not(:disabled):active .mdc-checkbox__native-control:enabled:not(:checked):not(:indeterminate):not([data-indeterminate=true])~.mdc-checkbox__background{border-color:var(--mdc-checkbox-unselected-pressed-icon-color);background-color:transparent}.mdc-checkbox:not(:disabled):active .mdc-checkbox__native-control:enabled:checked~.mdc-checkbox__background,.mdc-checkbox:not(:disabled):active .mdc-checkbox__native-control:enabled:indeterminate~.mdc-checkbox__background,.mdc-checkbox:not(:disabled):active .mdc-checkbox__native-control[data-indeterminate=true]:enabled~.mdc-checkbox__background{border-color:var(--mdc-checkbox-selected-pressed-icon-color);background-color:var(--mdc-checkbox-selected-pressed-icon-color)}@keyframes mdc-checkbox-fade-in-background-8A000000FFF4433600000000FFF44336{0%{border-color:var(--mdc-checkbox-unselected-pressed-icon-color);background-color:transparent}50%{border-color:var(--mdc-checkbox-selected-pressed-icon-color);background-color:var(--mdc-checkbox-selected-pressed-icon-color)}}@keyframes mdc-checkbox-fade-out-background-8A000000FFF4433600000000FFF44336{0%,80%{border-color:var(--mdc-checkbox-selected-pressed-icon-color);background-color:var(--mdc-checkbox-selected-pressed-icon-color)}100%{border-color:var(--mdc-checkbox-unselected-pressed-icon-color);background-color:transparent}}.mdc-checkbox:not(:disabled):active.mdc-checkbox--anim-unchecked-checked .mdc-checkbox__native-control:enabled~.mdc-checkbox__background,.mdc-checkbox:not(:disabled):active.mdc-checkbox--anim-unchecked-indeterminate .mdc-checkbox__native-control:enabled~.mdc-checkbox__background{animation-name:mdc-checkbox-fade-in-background-8A000000FFF4433600000000FFF44336}.mdc-checkbox:not(:disabled):active.mdc-checkbox--anim-checked-unchecked .mdc-checkbox__native-control:enabled~.mdc-checkbox__background,.mdc-checkbox:not(:disabled):active.mdc-checkbox--anim-indeterminate-unchecked .mdc-checkbox__native-control:enabled~.mdc-checkbox__background{animation-name:mdc-checkbox-fade-out-background-8A000000FFF4433600000000FFF44336}.mdc-checkbox .mdc-checkbox__background{top:calc((var(--mdc-checkbox-state-layer-size) - 18px) / 2);left:calc((var(--mdc-checkbox-state-layer-size) - 18px) / 2)}.mdc-checkbox .mdc-checkbox__native-control{top:calc((var(--mdc-checkbox-state-layer-size) - var(--mdc-checkbox-state-layer-size)) / 2);right:calc((var(--mdc-checkbox-state-layer-size) - var(--mdc-checkbox-state-layer-size)) / 2);left:calc((var(--mdc-checkbox-state-layer-size) - var(--mdc-checkbox-state-layer-size)) / 2);width:var(--mdc-checkbox-state-layer-size);height:var(--mdc-checkbox-state-layer-size)}.mdc-checkbox .mdc-checkbox__native-control:enabled:focus:focus:not(:checked):not(:indeterminate)~.mdc-checkbox__background{border-color:var(--mdc-checkbox-unselected-focus-icon-color)}.mdc-checkbox .mdc-checkbox__native-control:enabled:focus:checked~.mdc-checkbox__background,.mdc-checkbox .mdc-checkbox__native-control:enabled:focus:indeterminate~.mdc-checkbox__background{border-color:var(--mdc-checkbox-selected-focus-icon-color);background-color:var(--mdc-checkbox-selected-focus-icon-color)}.mdc-checkbox:hover .mdc-checkbox__ripple{opacity:var(--mdc-checkbox-unselected-hover-state-layer-opacity);background-color:var(--mdc-checkbox-unselected-hover-state-layer-color)}.mdc-checkbox:hover .mat-mdc-checkbox-ripple .mat-ripple-element{background-color:var(--mdc-checkbox-unselected-hover-state-layer-color)}.mdc-checkbox .mdc-checkbox__native-control:focus~.mdc-checkbox__ripple{opacity:var(--mdc-checkbox-unselected-focus-state-layer-opacity);background-color:var(--mdc-checkbox-unselected-focus-state-layer-color)}.mdc-checkbox .mdc-checkbox__native-control:focus~.mat-mdc-checkbox-ripple .mat-ripple-element{background-color:var(--mdc-checkbox-unselected-focus-state-layer-color)}.mdc-checkbox:active .mdc-checkbox__native-control~.mdc-checkbox__ripple{opacity:var(--mdc-checkbox-unselected-pressed-state-layer-opacity);background-color:var(--mdc-checkbox-unselected-pressed-state-layer-color)}.mdc-checkbox:active .mdc-checkbox__native-control~.mat-mdc-checkbox-ripple .mat-ripple-element{background-color:var(--mdc-checkbox-unselected-pressed-state-layer-color)}.mdc-checkbox:hover .mdc-checkbox__native-control:checked~.mdc-checkbox__ripple{opacity:var(--mdc-checkbox-selected-hover-state-layer-opacity);background-color:var(--mdc-checkbox-selected-hover-state-layer-color)}.mdc-checkbox:hover .mdc-checkbox__native-control:checked~.mat-mdc-checkbox-ripple .mat-ripple-element{background-color:var(--mdc-checkbox-selected-hover-state-layer-color)}.mdc-checkbox .mdc-checkbox__native-control:focus:checked~.mdc-checkbox__ripple{opacity:var(--mdc-checkbox-selected-focus-state-layer-opacity);background-color:var(--mdc-checkbox-selected-focus-state-layer-color)}.mdc-checkbox .mdc-checkbox__native-control:focus:checked~.mat-mdc-checkbox-ripple .mat-ripple-element{background-color:var(--mdc-checkbox-selected-focus-state-layer-color)}.mdc-checkbox:active .mdc-checkbox__native-control:checked~.mdc-checkbox__ripple{opacity:var(--mdc-checkbox-selected-pressed-state-layer-opacity);background-color:var(--mdc-checkbox-selected-pressed-state-layer-color)}.mdc-checkbox:active .mdc-checkbox__native-control:checked~.mat-mdc-checkbox-ripple .mat-ripple-element{background-color:var(--mdc-checkbox-selected-pressed-state-layer-color)}html{--mdc-checkbox-disabled-selected-checkmark-color:#fff;--mdc-checkbox-selected-focus-state-layer-opacity:0.16;--mdc-checkbox-selected-hover-state-layer-opacity:0.04;--mdc-checkbox-selected-pressed-state-layer-opacity:0.16;--mdc-checkbox-unselected-focus-state-layer-opacity:0.16;--mdc-checkbox-unselected-hover-state-layer-opacity:0.04;--mdc-checkbox-unselected-pressed-state-layer-opacity:0.16}.mat-mdc-checkbox{display:inline-block;position:relative;-webkit-tap-highlight-color:rgba(0,0,0,0)}.mat-mdc-checkbox .mdc-checkbox__background{-webkit-print-color-adjust:exact;color-adjust:exact}.mat-mdc-checkbox._mat-animation-noopable *,.mat-mdc-checkbox._mat-animation-noopable *::before{transition:none !important;animation:none !important}.mat-mdc-checkbox label{cursor:pointer}.mat-mdc-checkbox.mat-mdc-checkbox-disabled label{cursor:default}.mat-mdc-checkbox label:empty{display:none}.cdk-high-contrast-active .mat-mdc-checkbox.mat-mdc-checkbox-disabled{opacity:.5}.cdk-high-contrast-active .mat-mdc-checkbox .mdc-checkbox__checkmark{--mdc-checkbox-selected-checkmark-color: CanvasText;--mdc-checkbox-disabled-selected-checkmark-color: CanvasText}.mat-mdc-checkbox .mdc-checkbox__ripple{opacity:0}.mat-mdc-checkbox-ripple,.mdc-checkbox__ripple{top:0;left:0;right:0;bottom:0;position:absolute;border-radius:50%;pointer-events:none}.mat-mdc-checkbox-ripple:not(:empty),.mdc-checkbox__ripple:not(:empty){transform:translateZ(0)}.mat-mdc-checkbox-ripple .mat-ripple-element{opacity:.1}.mat-mdc-checkbox-touch-target{position:absolute;top:50%;height:48px;left:50%;width:48px;transform:translate(-50%, -50%)}.mat-mdc-checkbox-ripple::before{border-radius:50%}.mdc-checkbox__native-control:focus~.mat-mdc-focus-indicator::before{content:""}'],encapsulation:2,changeDetection:0}),t})(),rP=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275mod=le({type:e}),e.\u0275inj=ae({}),t})(),Dte=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275mod=le({type:e}),e.\u0275inj=ae({imports:[ve,is,rP,ve,rP]}),t})();function Ete(e,t){1&e&&(y(0,"span",7),X(1,1),w())}function Ste(e,t){1&e&&(y(0,"span",8),X(1,2),w())}const oP=["*",[["mat-chip-avatar"],["","matChipAvatar",""]],[["mat-chip-trailing-icon"],["","matChipRemove",""],["","matChipTrailingIcon",""]]],sP=["*","mat-chip-avatar, [matChipAvatar]","mat-chip-trailing-icon,[matChipRemove],[matChipTrailingIcon]"];function Mte(e,t){1&e&&(Ht(0),ie(1,"span",8),zt())}function Ite(e,t){1&e&&(y(0,"span",9),X(1),w())}function Ate(e,t){1&e&&(Ht(0),X(1,1),zt())}function Rte(e,t){1&e&&X(0,2,["*ngIf","contentEditInput; else defaultMatChipEditInput"])}function Ote(e,t){1&e&&ie(0,"span",12)}function Fte(e,t){if(1&e&&(Ht(0),R(1,Rte,1,0,"ng-content",10),R(2,Ote,1,0,"ng-template",null,11,kh),zt()),2&e){const i=hn(3),n=B();x(1),E("ngIf",n.contentEditInput)("ngIfElse",i)}}function Pte(e,t){1&e&&(y(0,"span",13),X(1,3),w())}const Nte=[[["mat-chip-avatar"],["","matChipAvatar",""]],"*",[["","matChipEditInput",""]],[["mat-chip-trailing-icon"],["","matChipRemove",""],["","matChipTrailingIcon",""]]],Lte=["mat-chip-avatar, [matChipAvatar]","*","[matChipEditInput]","mat-chip-trailing-icon,[matChipRemove],[matChipTrailingIcon]"],iw=["*"],Xp=new M("mat-chips-default-options"),rw=new M("MatChipAvatar"),ow=new M("MatChipTrailingIcon"),sw=new M("MatChipRemove"),Zp=new M("MatChip");class Bte{}const Vte=ns(Bte,-1);let hc=(()=>{var e;class t extends Vte{get disabled(){return this._disabled||this._parentChip.disabled}set disabled(n){this._disabled=Q(n)}_getDisabledAttribute(){return this.disabled&&!this._allowFocusWhenDisabled?"":null}_getTabindex(){return this.disabled&&!this._allowFocusWhenDisabled||!this.isInteractive?null:this.tabIndex.toString()}constructor(n,r){super(),this._elementRef=n,this._parentChip=r,this.isInteractive=!0,this._isPrimary=!0,this._disabled=!1,this._allowFocusWhenDisabled=!1,"BUTTON"===n.nativeElement.nodeName&&n.nativeElement.setAttribute("type","button")}focus(){this._elementRef.nativeElement.focus()}_handleClick(n){!this.disabled&&this.isInteractive&&this._isPrimary&&(n.preventDefault(),this._parentChip._handlePrimaryActionInteraction())}_handleKeydown(n){(13===n.keyCode||32===n.keyCode)&&!this.disabled&&this.isInteractive&&this._isPrimary&&!this._parentChip._isEditing&&(n.preventDefault(),this._parentChip._handlePrimaryActionInteraction())}}return(e=t).\u0275fac=function(n){return new(n||e)(m(W),m(Zp))},e.\u0275dir=T({type:e,selectors:[["","matChipAction",""]],hostAttrs:[1,"mdc-evolution-chip__action","mat-mdc-chip-action"],hostVars:9,hostBindings:function(n,r){1&n&&$("click",function(s){return r._handleClick(s)})("keydown",function(s){return r._handleKeydown(s)}),2&n&&(de("tabindex",r._getTabindex())("disabled",r._getDisabledAttribute())("aria-disabled",r.disabled),se("mdc-evolution-chip__action--primary",r._isPrimary)("mdc-evolution-chip__action--presentational",!r.isInteractive)("mdc-evolution-chip__action--trailing",!r._isPrimary))},inputs:{disabled:"disabled",tabIndex:"tabIndex",isInteractive:"isInteractive",_allowFocusWhenDisabled:"_allowFocusWhenDisabled"},features:[P]}),t})(),lP=(()=>{var e;class t extends hc{constructor(){super(...arguments),this._isPrimary=!1}_handleClick(n){this.disabled||(n.stopPropagation(),n.preventDefault(),this._parentChip.remove())}_handleKeydown(n){(13===n.keyCode||32===n.keyCode)&&!this.disabled&&(n.stopPropagation(),n.preventDefault(),this._parentChip.remove())}}return(e=t).\u0275fac=function(){let i;return function(r){return(i||(i=be(e)))(r||e)}}(),e.\u0275dir=T({type:e,selectors:[["","matChipRemove",""]],hostAttrs:["role","button",1,"mat-mdc-chip-remove","mat-mdc-chip-trailing-icon","mat-mdc-focus-indicator","mdc-evolution-chip__icon","mdc-evolution-chip__icon--trailing"],hostVars:1,hostBindings:function(n,r){2&n&&de("aria-hidden",null)},features:[Z([{provide:sw,useExisting:e}]),P]}),t})(),zte=0;const Ute=ns(ts(so(es(class{constructor(e){this._elementRef=e}})),"primary"),-1);let vs=(()=>{var e;class t extends Ute{_hasFocus(){return this._hasFocusInternal}get value(){return void 0!==this._value?this._value:this._textElement.textContent.trim()}set value(n){this._value=n}get removable(){return this._removable}set removable(n){this._removable=Q(n)}get highlighted(){return this._highlighted}set highlighted(n){this._highlighted=Q(n)}get ripple(){return this._rippleLoader?.getRipple(this._elementRef.nativeElement)}set ripple(n){this._rippleLoader?.attachRipple(this._elementRef.nativeElement,n)}constructor(n,r,o,s,a,c,l,d){super(r),this._changeDetectorRef=n,this._ngZone=o,this._focusMonitor=s,this._globalRippleOptions=l,this._onFocus=new Y,this._onBlur=new Y,this.role=null,this._hasFocusInternal=!1,this.id="mat-mdc-chip-"+zte++,this.ariaLabel=null,this.ariaDescription=null,this._ariaDescriptionId=`${this.id}-aria-description`,this._removable=!0,this._highlighted=!1,this.removed=new U,this.destroyed=new U,this.basicChipAttrName="mat-basic-chip",this._rippleLoader=j(a1),this._document=a,this._animationsDisabled="NoopAnimations"===c,null!=d&&(this.tabIndex=parseInt(d)??this.defaultTabIndex),this._monitorFocus(),this._rippleLoader?.configureRipple(this._elementRef.nativeElement,{className:"mat-mdc-chip-ripple",disabled:this._isRippleDisabled()})}ngOnInit(){const n=this._elementRef.nativeElement;this._isBasicChip=n.hasAttribute(this.basicChipAttrName)||n.tagName.toLowerCase()===this.basicChipAttrName}ngAfterViewInit(){this._textElement=this._elementRef.nativeElement.querySelector(".mat-mdc-chip-action-label"),this._pendingFocus&&(this._pendingFocus=!1,this.focus())}ngAfterContentInit(){this._actionChanges=Ot(this._allLeadingIcons.changes,this._allTrailingIcons.changes,this._allRemoveIcons.changes).subscribe(()=>this._changeDetectorRef.markForCheck())}ngDoCheck(){this._rippleLoader.setDisabled(this._elementRef.nativeElement,this._isRippleDisabled())}ngOnDestroy(){this._focusMonitor.stopMonitoring(this._elementRef),this._actionChanges?.unsubscribe(),this.destroyed.emit({chip:this}),this.destroyed.complete()}remove(){this.removable&&this.removed.emit({chip:this})}_isRippleDisabled(){return this.disabled||this.disableRipple||this._animationsDisabled||this._isBasicChip||!!this._globalRippleOptions?.disabled}_hasTrailingIcon(){return!(!this.trailingIcon&&!this.removeIcon)}_handleKeydown(n){(8===n.keyCode||46===n.keyCode)&&(n.preventDefault(),this.remove())}focus(){this.disabled||(this.primaryAction?this.primaryAction.focus():this._pendingFocus=!0)}_getSourceAction(n){return this._getActions().find(r=>{const o=r._elementRef.nativeElement;return o===n||o.contains(n)})}_getActions(){const n=[];return this.primaryAction&&n.push(this.primaryAction),this.removeIcon&&n.push(this.removeIcon),this.trailingIcon&&n.push(this.trailingIcon),n}_handlePrimaryActionInteraction(){}_monitorFocus(){this._focusMonitor.monitor(this._elementRef,!0).subscribe(n=>{const r=null!==n;r!==this._hasFocusInternal&&(this._hasFocusInternal=r,r?this._onFocus.next({chip:this}):this._ngZone.onStable.pipe(Xe(1)).subscribe(()=>this._ngZone.run(()=>this._onBlur.next({chip:this}))))})}}return(e=t).\u0275fac=function(n){return new(n||e)(m(Fe),m(W),m(G),m(ar),m(he),m(_t,8),m(Pf,8),ui("tabindex"))},e.\u0275cmp=fe({type:e,selectors:[["mat-basic-chip"],["","mat-basic-chip",""],["mat-chip"],["","mat-chip",""]],contentQueries:function(n,r,o){if(1&n&&(Ee(o,rw,5),Ee(o,ow,5),Ee(o,sw,5),Ee(o,rw,5),Ee(o,ow,5),Ee(o,sw,5)),2&n){let s;H(s=z())&&(r.leadingIcon=s.first),H(s=z())&&(r.trailingIcon=s.first),H(s=z())&&(r.removeIcon=s.first),H(s=z())&&(r._allLeadingIcons=s),H(s=z())&&(r._allTrailingIcons=s),H(s=z())&&(r._allRemoveIcons=s)}},viewQuery:function(n,r){if(1&n&&ke(hc,5),2&n){let o;H(o=z())&&(r.primaryAction=o.first)}},hostAttrs:[1,"mat-mdc-chip"],hostVars:30,hostBindings:function(n,r){1&n&&$("keydown",function(s){return r._handleKeydown(s)}),2&n&&(pi("id",r.id),de("role",r.role)("tabindex",r.role?r.tabIndex:null)("aria-label",r.ariaLabel),se("mdc-evolution-chip",!r._isBasicChip)("mdc-evolution-chip--disabled",r.disabled)("mdc-evolution-chip--with-trailing-action",r._hasTrailingIcon())("mdc-evolution-chip--with-primary-graphic",r.leadingIcon)("mdc-evolution-chip--with-primary-icon",r.leadingIcon)("mdc-evolution-chip--with-avatar",r.leadingIcon)("mat-mdc-chip-with-avatar",r.leadingIcon)("mat-mdc-chip-highlighted",r.highlighted)("mat-mdc-chip-disabled",r.disabled)("mat-mdc-basic-chip",r._isBasicChip)("mat-mdc-standard-chip",!r._isBasicChip)("mat-mdc-chip-with-trailing-icon",r._hasTrailingIcon())("_mat-animation-noopable",r._animationsDisabled))},inputs:{color:"color",disabled:"disabled",disableRipple:"disableRipple",tabIndex:"tabIndex",role:"role",id:"id",ariaLabel:["aria-label","ariaLabel"],ariaDescription:["aria-description","ariaDescription"],value:"value",removable:"removable",highlighted:"highlighted"},outputs:{removed:"removed",destroyed:"destroyed"},exportAs:["matChip"],features:[Z([{provide:Zp,useExisting:e}]),P],ngContentSelectors:sP,decls:8,vars:3,consts:[[1,"mat-mdc-chip-focus-overlay"],[1,"mdc-evolution-chip__cell","mdc-evolution-chip__cell--primary"],["matChipAction","",3,"isInteractive"],["class","mdc-evolution-chip__graphic mat-mdc-chip-graphic",4,"ngIf"],[1,"mdc-evolution-chip__text-label","mat-mdc-chip-action-label"],[1,"mat-mdc-chip-primary-focus-indicator","mat-mdc-focus-indicator"],["class","mdc-evolution-chip__cell mdc-evolution-chip__cell--trailing",4,"ngIf"],[1,"mdc-evolution-chip__graphic","mat-mdc-chip-graphic"],[1,"mdc-evolution-chip__cell","mdc-evolution-chip__cell--trailing"]],template:function(n,r){1&n&&(ze(oP),ie(0,"span",0),y(1,"span",1)(2,"span",2),R(3,Ete,2,0,"span",3),y(4,"span",4),X(5),ie(6,"span",5),w()()(),R(7,Ste,2,0,"span",6)),2&n&&(x(2),E("isInteractive",!1),x(1),E("ngIf",r.leadingIcon),x(4),E("ngIf",r._hasTrailingIcon()))},dependencies:[_i,hc],styles:['.mdc-evolution-chip,.mdc-evolution-chip__cell,.mdc-evolution-chip__action{display:inline-flex;align-items:center}.mdc-evolution-chip{position:relative;max-width:100%}.mdc-evolution-chip .mdc-elevation-overlay{width:100%;height:100%;top:0;left:0}.mdc-evolution-chip__cell,.mdc-evolution-chip__action{height:100%}.mdc-evolution-chip__cell--primary{overflow-x:hidden}.mdc-evolution-chip__cell--trailing{flex:1 0 auto}.mdc-evolution-chip__action{align-items:center;background:none;border:none;box-sizing:content-box;cursor:pointer;display:inline-flex;justify-content:center;outline:none;padding:0;text-decoration:none;color:inherit}.mdc-evolution-chip__action--presentational{cursor:auto}.mdc-evolution-chip--disabled,.mdc-evolution-chip__action:disabled{pointer-events:none}.mdc-evolution-chip__action--primary{overflow-x:hidden}.mdc-evolution-chip__action--trailing{position:relative;overflow:visible}.mdc-evolution-chip__action--primary:before{box-sizing:border-box;content:"";height:100%;left:0;position:absolute;pointer-events:none;top:0;width:100%;z-index:1}.mdc-evolution-chip--touch{margin-top:8px;margin-bottom:8px}.mdc-evolution-chip__action-touch{position:absolute;top:50%;height:48px;left:0;right:0;transform:translateY(-50%)}.mdc-evolution-chip__text-label{white-space:nowrap;user-select:none;text-overflow:ellipsis;overflow:hidden}.mdc-evolution-chip__graphic{align-items:center;display:inline-flex;justify-content:center;overflow:hidden;pointer-events:none;position:relative;flex:1 0 auto}.mdc-evolution-chip__checkmark{position:absolute;opacity:0;top:50%;left:50%}.mdc-evolution-chip--selectable:not(.mdc-evolution-chip--selected):not(.mdc-evolution-chip--with-primary-icon) .mdc-evolution-chip__graphic{width:0}.mdc-evolution-chip__checkmark-background{opacity:0}.mdc-evolution-chip__checkmark-svg{display:block}.mdc-evolution-chip__checkmark-path{stroke-width:2px;stroke-dasharray:29.7833385;stroke-dashoffset:29.7833385;stroke:currentColor}.mdc-evolution-chip--selecting .mdc-evolution-chip__graphic{transition:width 150ms 0ms cubic-bezier(0.4, 0, 0.2, 1)}.mdc-evolution-chip--selecting .mdc-evolution-chip__checkmark{transition:transform 150ms 0ms cubic-bezier(0.4, 0, 0.2, 1);transform:translate(-75%, -50%)}.mdc-evolution-chip--selecting .mdc-evolution-chip__checkmark-path{transition:stroke-dashoffset 150ms 45ms cubic-bezier(0.4, 0, 0.2, 1)}.mdc-evolution-chip--deselecting .mdc-evolution-chip__graphic{transition:width 100ms 0ms cubic-bezier(0.4, 0, 0.2, 1)}.mdc-evolution-chip--deselecting .mdc-evolution-chip__checkmark{transition:opacity 50ms 0ms linear,transform 100ms 0ms cubic-bezier(0.4, 0, 0.2, 1);transform:translate(-75%, -50%)}.mdc-evolution-chip--deselecting .mdc-evolution-chip__checkmark-path{stroke-dashoffset:0}.mdc-evolution-chip--selecting-with-primary-icon .mdc-evolution-chip__icon--primary{transition:opacity 75ms 0ms cubic-bezier(0.4, 0, 0.2, 1)}.mdc-evolution-chip--selecting-with-primary-icon .mdc-evolution-chip__checkmark-path{transition:stroke-dashoffset 150ms 75ms cubic-bezier(0.4, 0, 0.2, 1)}.mdc-evolution-chip--deselecting-with-primary-icon .mdc-evolution-chip__icon--primary{transition:opacity 150ms 75ms cubic-bezier(0.4, 0, 0.2, 1)}.mdc-evolution-chip--deselecting-with-primary-icon .mdc-evolution-chip__checkmark{transition:opacity 75ms 0ms cubic-bezier(0.4, 0, 0.2, 1);transform:translate(-50%, -50%)}.mdc-evolution-chip--deselecting-with-primary-icon .mdc-evolution-chip__checkmark-path{stroke-dashoffset:0}.mdc-evolution-chip--selected .mdc-evolution-chip__icon--primary{opacity:0}.mdc-evolution-chip--selected .mdc-evolution-chip__checkmark{transform:translate(-50%, -50%);opacity:1}.mdc-evolution-chip--selected .mdc-evolution-chip__checkmark-path{stroke-dashoffset:0}@keyframes mdc-evolution-chip-enter{from{transform:scale(0.8);opacity:.4}to{transform:scale(1);opacity:1}}.mdc-evolution-chip--enter{animation:mdc-evolution-chip-enter 100ms 0ms cubic-bezier(0, 0, 0.2, 1)}@keyframes mdc-evolution-chip-exit{from{opacity:1}to{opacity:0}}.mdc-evolution-chip--exit{animation:mdc-evolution-chip-exit 75ms 0ms cubic-bezier(0.4, 0, 1, 1)}.mdc-evolution-chip--hidden{opacity:0;pointer-events:none;transition:width 150ms 0ms cubic-bezier(0.4, 0, 1, 1)}.mat-mdc-standard-chip{border-radius:var(--mdc-chip-container-shape-radius);height:var(--mdc-chip-container-height);--mdc-chip-container-shape-family:rounded;--mdc-chip-container-shape-radius:16px 16px 16px 16px;--mdc-chip-with-avatar-avatar-shape-family:rounded;--mdc-chip-with-avatar-avatar-shape-radius:14px 14px 14px 14px;--mdc-chip-with-avatar-avatar-size:28px;--mdc-chip-with-icon-icon-size:18px}.mat-mdc-standard-chip .mdc-evolution-chip__ripple{border-radius:var(--mdc-chip-container-shape-radius)}.mat-mdc-standard-chip .mdc-evolution-chip__action--primary:before{border-radius:var(--mdc-chip-container-shape-radius)}.mat-mdc-standard-chip .mdc-evolution-chip__icon--primary{border-radius:var(--mdc-chip-with-avatar-avatar-shape-radius)}.mat-mdc-standard-chip.mdc-evolution-chip--selectable:not(.mdc-evolution-chip--with-primary-icon){--mdc-chip-graphic-selected-width:var(--mdc-chip-with-avatar-avatar-size)}.mat-mdc-standard-chip .mdc-evolution-chip__graphic{height:var(--mdc-chip-with-avatar-avatar-size);width:var(--mdc-chip-with-avatar-avatar-size);font-size:var(--mdc-chip-with-avatar-avatar-size)}.mat-mdc-standard-chip:not(.mdc-evolution-chip--disabled){background-color:var(--mdc-chip-elevated-container-color)}.mat-mdc-standard-chip.mdc-evolution-chip--disabled{background-color:var(--mdc-chip-elevated-disabled-container-color)}.mat-mdc-standard-chip.mdc-evolution-chip--selected.mdc-evolution-chip--disabled{background-color:var(--mdc-chip-elevated-disabled-container-color)}.mat-mdc-standard-chip .mdc-evolution-chip__text-label{font-family:var(--mdc-chip-label-text-font);line-height:var(--mdc-chip-label-text-line-height);font-size:var(--mdc-chip-label-text-size);font-weight:var(--mdc-chip-label-text-weight);letter-spacing:var(--mdc-chip-label-text-tracking)}.mat-mdc-standard-chip:not(.mdc-evolution-chip--disabled) .mdc-evolution-chip__text-label{color:var(--mdc-chip-label-text-color)}.mat-mdc-standard-chip.mdc-evolution-chip--disabled .mdc-evolution-chip__text-label{color:var(--mdc-chip-disabled-label-text-color)}.mat-mdc-standard-chip.mdc-evolution-chip--selected.mdc-evolution-chip--disabled .mdc-evolution-chip__text-label{color:var(--mdc-chip-disabled-label-text-color)}.mat-mdc-standard-chip .mdc-evolution-chip__icon--primary{height:var(--mdc-chip-with-icon-icon-size);width:var(--mdc-chip-with-icon-icon-size);font-size:var(--mdc-chip-with-icon-icon-size)}.mat-mdc-standard-chip:not(.mdc-evolution-chip--disabled) .mdc-evolution-chip__icon--primary{color:var(--mdc-chip-with-icon-icon-color)}.mat-mdc-standard-chip.mdc-evolution-chip--disabled .mdc-evolution-chip__icon--primary{color:var(--mdc-chip-with-icon-disabled-icon-color)}.mat-mdc-standard-chip:not(.mdc-evolution-chip--disabled) .mdc-evolution-chip__checkmark{color:var(--mdc-chip-with-icon-selected-icon-color)}.mat-mdc-standard-chip.mdc-evolution-chip--disabled .mdc-evolution-chip__checkmark{color:var(--mdc-chip-with-icon-disabled-icon-color)}.mat-mdc-standard-chip:not(.mdc-evolution-chip--disabled) .mdc-evolution-chip__icon--trailing{color:var(--mdc-chip-with-trailing-icon-trailing-icon-color)}.mat-mdc-standard-chip.mdc-evolution-chip--disabled .mdc-evolution-chip__icon--trailing{color:var(--mdc-chip-with-trailing-icon-disabled-trailing-icon-color)}.mat-mdc-standard-chip .mdc-evolution-chip__action--primary.mdc-ripple-upgraded--background-focused .mdc-evolution-chip__ripple::before,.mat-mdc-standard-chip .mdc-evolution-chip__action--primary:not(.mdc-ripple-upgraded):focus .mdc-evolution-chip__ripple::before{transition-duration:75ms;opacity:var(--mdc-chip-focus-state-layer-opacity)}.mat-mdc-chip-focus-overlay{background:var(--mdc-chip-focus-state-layer-color);opacity:var(--mdc-chip-focus-state-layer-opacity)}.mat-mdc-standard-chip .mdc-evolution-chip__checkmark{height:20px;width:20px}.mat-mdc-standard-chip .mdc-evolution-chip__icon--trailing{height:18px;width:18px;font-size:18px}.mat-mdc-standard-chip .mdc-evolution-chip__action--primary{padding-left:12px;padding-right:12px}[dir=rtl] .mat-mdc-standard-chip .mdc-evolution-chip__action--primary,.mat-mdc-standard-chip .mdc-evolution-chip__action--primary[dir=rtl]{padding-left:12px;padding-right:12px}.mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic .mdc-evolution-chip__graphic{padding-left:6px;padding-right:6px}[dir=rtl] .mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic .mdc-evolution-chip__graphic,.mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic .mdc-evolution-chip__graphic[dir=rtl]{padding-left:6px;padding-right:6px}.mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic .mdc-evolution-chip__action--primary{padding-left:0;padding-right:12px}[dir=rtl] .mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic .mdc-evolution-chip__action--primary,.mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic .mdc-evolution-chip__action--primary[dir=rtl]{padding-left:12px;padding-right:0}.mat-mdc-standard-chip.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--trailing{padding-left:8px;padding-right:8px}[dir=rtl] .mat-mdc-standard-chip.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--trailing,.mat-mdc-standard-chip.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--trailing[dir=rtl]{padding-left:8px;padding-right:8px}.mat-mdc-standard-chip.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__ripple--trailing{left:8px;right:initial}[dir=rtl] .mat-mdc-standard-chip.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__ripple--trailing,.mat-mdc-standard-chip.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__ripple--trailing[dir=rtl]{left:initial;right:8px}.mat-mdc-standard-chip.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--primary{padding-left:12px;padding-right:0}[dir=rtl] .mat-mdc-standard-chip.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--primary,.mat-mdc-standard-chip.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--primary[dir=rtl]{padding-left:0;padding-right:12px}.mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__graphic{padding-left:6px;padding-right:6px}[dir=rtl] .mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__graphic,.mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__graphic[dir=rtl]{padding-left:6px;padding-right:6px}.mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--trailing{padding-left:8px;padding-right:8px}[dir=rtl] .mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--trailing,.mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--trailing[dir=rtl]{padding-left:8px;padding-right:8px}.mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__ripple--trailing{left:8px;right:initial}[dir=rtl] .mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__ripple--trailing,.mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__ripple--trailing[dir=rtl]{left:initial;right:8px}.mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--primary{padding-left:0;padding-right:0}[dir=rtl] .mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--primary,.mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--primary[dir=rtl]{padding-left:0;padding-right:0}.mat-mdc-standard-chip.mdc-evolution-chip--disabled .mdc-evolution-chip__checkmark{color:var(--mdc-chip-with-icon-selected-icon-color, currentColor)}.mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic .mdc-evolution-chip__graphic{padding-left:4px;padding-right:8px}[dir=rtl] .mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic .mdc-evolution-chip__graphic,.mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic .mdc-evolution-chip__graphic[dir=rtl]{padding-left:8px;padding-right:4px}.mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic .mdc-evolution-chip__action--primary{padding-left:0;padding-right:12px}[dir=rtl] .mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic .mdc-evolution-chip__action--primary,.mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic .mdc-evolution-chip__action--primary[dir=rtl]{padding-left:12px;padding-right:0}.mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__graphic{padding-left:4px;padding-right:8px}[dir=rtl] .mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__graphic,.mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__graphic[dir=rtl]{padding-left:8px;padding-right:4px}.mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--trailing{padding-left:8px;padding-right:8px}[dir=rtl] .mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--trailing,.mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--trailing[dir=rtl]{padding-left:8px;padding-right:8px}.mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__ripple--trailing{left:8px;right:initial}[dir=rtl] .mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__ripple--trailing,.mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__ripple--trailing[dir=rtl]{left:initial;right:8px}.mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--primary{padding-left:0;padding-right:0}[dir=rtl] .mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--primary,.mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--primary[dir=rtl]{padding-left:0;padding-right:0}.mat-mdc-standard-chip{-webkit-tap-highlight-color:rgba(0,0,0,0)}.cdk-high-contrast-active .mat-mdc-standard-chip{outline:solid 1px}.cdk-high-contrast-active .mat-mdc-standard-chip .mdc-evolution-chip__checkmark-path{stroke:CanvasText !important}.mat-mdc-standard-chip.mdc-evolution-chip--disabled{opacity:.4}.mat-mdc-standard-chip .mdc-evolution-chip__cell--primary,.mat-mdc-standard-chip .mdc-evolution-chip__action--primary,.mat-mdc-standard-chip .mat-mdc-chip-action-label{overflow:visible}.mat-mdc-standard-chip .mdc-evolution-chip__cell--primary{flex-basis:100%}.mat-mdc-standard-chip .mdc-evolution-chip__action--primary{font:inherit;letter-spacing:inherit;white-space:inherit}.mat-mdc-standard-chip .mat-mdc-chip-graphic,.mat-mdc-standard-chip .mat-mdc-chip-trailing-icon{box-sizing:content-box}.mat-mdc-standard-chip._mat-animation-noopable,.mat-mdc-standard-chip._mat-animation-noopable .mdc-evolution-chip__graphic,.mat-mdc-standard-chip._mat-animation-noopable .mdc-evolution-chip__checkmark,.mat-mdc-standard-chip._mat-animation-noopable .mdc-evolution-chip__checkmark-path{transition-duration:1ms;animation-duration:1ms}.mat-mdc-basic-chip .mdc-evolution-chip__action--primary{font:inherit}.mat-mdc-chip-focus-overlay{top:0;left:0;right:0;bottom:0;position:absolute;pointer-events:none;opacity:0;border-radius:inherit;transition:opacity 150ms linear}._mat-animation-noopable .mat-mdc-chip-focus-overlay{transition:none}.mat-mdc-basic-chip .mat-mdc-chip-focus-overlay{display:none}.mat-mdc-chip:hover .mat-mdc-chip-focus-overlay{opacity:.04}.mat-mdc-chip.cdk-focused .mat-mdc-chip-focus-overlay{opacity:.12}.mat-mdc-chip .mat-ripple.mat-mdc-chip-ripple{top:0;left:0;right:0;bottom:0;position:absolute;pointer-events:none;border-radius:inherit}.mat-mdc-chip-avatar{text-align:center;line-height:1;color:var(--mdc-chip-with-icon-icon-color, currentColor)}.mat-mdc-chip{position:relative;z-index:0}.mat-mdc-chip-action-label{text-align:left;z-index:1}[dir=rtl] .mat-mdc-chip-action-label{text-align:right}.mat-mdc-chip.mdc-evolution-chip--with-trailing-action .mat-mdc-chip-action-label{position:relative}.mat-mdc-chip-action-label .mat-mdc-chip-primary-focus-indicator{position:absolute;top:0;right:0;bottom:0;left:0;pointer-events:none}.mat-mdc-chip-action-label .mat-mdc-focus-indicator::before{margin:calc(calc(var(--mat-mdc-focus-indicator-border-width, 3px) + 2px) * -1)}.mat-mdc-chip-remove{opacity:.54}.mat-mdc-chip-remove:focus{opacity:1}.mat-mdc-chip-remove::before{margin:calc(var(--mat-mdc-focus-indicator-border-width, 3px) * -1);left:8px;right:8px}.mat-mdc-chip-remove .mat-icon{width:inherit;height:inherit;font-size:inherit;box-sizing:content-box}.mat-chip-edit-input{cursor:text;display:inline-block;color:inherit;outline:0}.cdk-high-contrast-active .mat-mdc-chip-selected:not(.mat-mdc-chip-multiple){outline-width:3px}.mat-mdc-chip-action:focus .mat-mdc-focus-indicator::before{content:""}'],encapsulation:2,changeDetection:0}),t})(),Jp=(()=>{var e;class t{constructor(n,r){this._elementRef=n,this._document=r}initialize(n){this.getNativeElement().focus(),this.setValue(n)}getNativeElement(){return this._elementRef.nativeElement}setValue(n){this.getNativeElement().textContent=n,this._moveCursorToEndOfInput()}getValue(){return this.getNativeElement().textContent||""}_moveCursorToEndOfInput(){const n=this._document.createRange();n.selectNodeContents(this.getNativeElement()),n.collapse(!1);const r=window.getSelection();r.removeAllRanges(),r.addRange(n)}}return(e=t).\u0275fac=function(n){return new(n||e)(m(W),m(he))},e.\u0275dir=T({type:e,selectors:[["span","matChipEditInput",""]],hostAttrs:["role","textbox","tabindex","-1","contenteditable","true",1,"mat-chip-edit-input"]}),t})(),aw=(()=>{var e;class t extends vs{constructor(n,r,o,s,a,c,l,d){super(n,r,o,s,a,c,l,d),this.basicChipAttrName="mat-basic-chip-row",this._editStartPending=!1,this.editable=!1,this.edited=new U,this._isEditing=!1,this.role="row",this._onBlur.pipe(ce(this.destroyed)).subscribe(()=>{this._isEditing&&!this._editStartPending&&this._onEditFinish()})}_hasTrailingIcon(){return!this._isEditing&&super._hasTrailingIcon()}_handleFocus(){!this._isEditing&&!this.disabled&&this.focus()}_handleKeydown(n){13!==n.keyCode||this.disabled?this._isEditing?n.stopPropagation():super._handleKeydown(n):this._isEditing?(n.preventDefault(),this._onEditFinish()):this.editable&&this._startEditing(n)}_handleDoubleclick(n){!this.disabled&&this.editable&&this._startEditing(n)}_startEditing(n){if(!this.primaryAction||this.removeIcon&&this._getSourceAction(n.target)===this.removeIcon)return;const r=this.value;this._isEditing=this._editStartPending=!0,this._changeDetectorRef.detectChanges(),setTimeout(()=>{this._getEditInput().initialize(r),this._editStartPending=!1})}_onEditFinish(){this._isEditing=this._editStartPending=!1,this.edited.emit({chip:this,value:this._getEditInput().getValue()}),(this._document.activeElement===this._getEditInput().getNativeElement()||this._document.activeElement===this._document.body)&&this.primaryAction.focus()}_isRippleDisabled(){return super._isRippleDisabled()||this._isEditing}_getEditInput(){return this.contentEditInput||this.defaultEditInput}}return(e=t).\u0275fac=function(n){return new(n||e)(m(Fe),m(W),m(G),m(ar),m(he),m(_t,8),m(Pf,8),ui("tabindex"))},e.\u0275cmp=fe({type:e,selectors:[["mat-chip-row"],["","mat-chip-row",""],["mat-basic-chip-row"],["","mat-basic-chip-row",""]],contentQueries:function(n,r,o){if(1&n&&Ee(o,Jp,5),2&n){let s;H(s=z())&&(r.contentEditInput=s.first)}},viewQuery:function(n,r){if(1&n&&ke(Jp,5),2&n){let o;H(o=z())&&(r.defaultEditInput=o.first)}},hostAttrs:[1,"mat-mdc-chip","mat-mdc-chip-row","mdc-evolution-chip"],hostVars:27,hostBindings:function(n,r){1&n&&$("focus",function(s){return r._handleFocus(s)})("dblclick",function(s){return r._handleDoubleclick(s)}),2&n&&(pi("id",r.id),de("tabindex",r.disabled?null:-1)("aria-label",null)("aria-description",null)("role",r.role),se("mat-mdc-chip-with-avatar",r.leadingIcon)("mat-mdc-chip-disabled",r.disabled)("mat-mdc-chip-editing",r._isEditing)("mat-mdc-chip-editable",r.editable)("mdc-evolution-chip--disabled",r.disabled)("mdc-evolution-chip--with-trailing-action",r._hasTrailingIcon())("mdc-evolution-chip--with-primary-graphic",r.leadingIcon)("mdc-evolution-chip--with-primary-icon",r.leadingIcon)("mdc-evolution-chip--with-avatar",r.leadingIcon)("mat-mdc-chip-highlighted",r.highlighted)("mat-mdc-chip-with-trailing-icon",r._hasTrailingIcon()))},inputs:{color:"color",disabled:"disabled",disableRipple:"disableRipple",tabIndex:"tabIndex",editable:"editable"},outputs:{edited:"edited"},features:[Z([{provide:vs,useExisting:e},{provide:Zp,useExisting:e}]),P],ngContentSelectors:Lte,decls:10,vars:12,consts:[[4,"ngIf"],["role","gridcell","matChipAction","",1,"mdc-evolution-chip__cell","mdc-evolution-chip__cell--primary",3,"tabIndex","disabled"],["class","mdc-evolution-chip__graphic mat-mdc-chip-graphic",4,"ngIf"],[1,"mdc-evolution-chip__text-label","mat-mdc-chip-action-label",3,"ngSwitch"],[4,"ngSwitchCase"],["aria-hidden","true",1,"mat-mdc-chip-primary-focus-indicator","mat-mdc-focus-indicator"],["class","mdc-evolution-chip__cell mdc-evolution-chip__cell--trailing","role","gridcell",4,"ngIf"],[1,"cdk-visually-hidden",3,"id"],[1,"mat-mdc-chip-focus-overlay"],[1,"mdc-evolution-chip__graphic","mat-mdc-chip-graphic"],[4,"ngIf","ngIfElse"],["defaultMatChipEditInput",""],["matChipEditInput",""],["role","gridcell",1,"mdc-evolution-chip__cell","mdc-evolution-chip__cell--trailing"]],template:function(n,r){1&n&&(ze(Nte),R(0,Mte,2,0,"ng-container",0),y(1,"span",1),R(2,Ite,2,0,"span",2),y(3,"span",3),R(4,Ate,2,0,"ng-container",4),R(5,Fte,4,2,"ng-container",4),ie(6,"span",5),w()(),R(7,Pte,2,0,"span",6),y(8,"span",7),A(9),w()),2&n&&(E("ngIf",!r._isEditing),x(1),E("tabIndex",r.tabIndex)("disabled",r.disabled),de("aria-label",r.ariaLabel)("aria-describedby",r._ariaDescriptionId),x(1),E("ngIf",r.leadingIcon),x(1),E("ngSwitch",r._isEditing),x(1),E("ngSwitchCase",!1),x(1),E("ngSwitchCase",!0),x(2),E("ngIf",r._hasTrailingIcon()),x(1),E("id",r._ariaDescriptionId),x(1),bt(r.ariaDescription))},dependencies:[_i,Sa,Qh,hc,Jp],styles:['.mdc-evolution-chip,.mdc-evolution-chip__cell,.mdc-evolution-chip__action{display:inline-flex;align-items:center}.mdc-evolution-chip{position:relative;max-width:100%}.mdc-evolution-chip .mdc-elevation-overlay{width:100%;height:100%;top:0;left:0}.mdc-evolution-chip__cell,.mdc-evolution-chip__action{height:100%}.mdc-evolution-chip__cell--primary{overflow-x:hidden}.mdc-evolution-chip__cell--trailing{flex:1 0 auto}.mdc-evolution-chip__action{align-items:center;background:none;border:none;box-sizing:content-box;cursor:pointer;display:inline-flex;justify-content:center;outline:none;padding:0;text-decoration:none;color:inherit}.mdc-evolution-chip__action--presentational{cursor:auto}.mdc-evolution-chip--disabled,.mdc-evolution-chip__action:disabled{pointer-events:none}.mdc-evolution-chip__action--primary{overflow-x:hidden}.mdc-evolution-chip__action--trailing{position:relative;overflow:visible}.mdc-evolution-chip__action--primary:before{box-sizing:border-box;content:"";height:100%;left:0;position:absolute;pointer-events:none;top:0;width:100%;z-index:1}.mdc-evolution-chip--touch{margin-top:8px;margin-bottom:8px}.mdc-evolution-chip__action-touch{position:absolute;top:50%;height:48px;left:0;right:0;transform:translateY(-50%)}.mdc-evolution-chip__text-label{white-space:nowrap;user-select:none;text-overflow:ellipsis;overflow:hidden}.mdc-evolution-chip__graphic{align-items:center;display:inline-flex;justify-content:center;overflow:hidden;pointer-events:none;position:relative;flex:1 0 auto}.mdc-evolution-chip__checkmark{position:absolute;opacity:0;top:50%;left:50%}.mdc-evolution-chip--selectable:not(.mdc-evolution-chip--selected):not(.mdc-evolution-chip--with-primary-icon) .mdc-evolution-chip__graphic{width:0}.mdc-evolution-chip__checkmark-background{opacity:0}.mdc-evolution-chip__checkmark-svg{display:block}.mdc-evolution-chip__checkmark-path{stroke-width:2px;stroke-dasharray:29.7833385;stroke-dashoffset:29.7833385;stroke:currentColor}.mdc-evolution-chip--selecting .mdc-evolution-chip__graphic{transition:width 150ms 0ms cubic-bezier(0.4, 0, 0.2, 1)}.mdc-evolution-chip--selecting .mdc-evolution-chip__checkmark{transition:transform 150ms 0ms cubic-bezier(0.4, 0, 0.2, 1);transform:translate(-75%, -50%)}.mdc-evolution-chip--selecting .mdc-evolution-chip__checkmark-path{transition:stroke-dashoffset 150ms 45ms cubic-bezier(0.4, 0, 0.2, 1)}.mdc-evolution-chip--deselecting .mdc-evolution-chip__graphic{transition:width 100ms 0ms cubic-bezier(0.4, 0, 0.2, 1)}.mdc-evolution-chip--deselecting .mdc-evolution-chip__checkmark{transition:opacity 50ms 0ms linear,transform 100ms 0ms cubic-bezier(0.4, 0, 0.2, 1);transform:translate(-75%, -50%)}.mdc-evolution-chip--deselecting .mdc-evolution-chip__checkmark-path{stroke-dashoffset:0}.mdc-evolution-chip--selecting-with-primary-icon .mdc-evolution-chip__icon--primary{transition:opacity 75ms 0ms cubic-bezier(0.4, 0, 0.2, 1)}.mdc-evolution-chip--selecting-with-primary-icon .mdc-evolution-chip__checkmark-path{transition:stroke-dashoffset 150ms 75ms cubic-bezier(0.4, 0, 0.2, 1)}.mdc-evolution-chip--deselecting-with-primary-icon .mdc-evolution-chip__icon--primary{transition:opacity 150ms 75ms cubic-bezier(0.4, 0, 0.2, 1)}.mdc-evolution-chip--deselecting-with-primary-icon .mdc-evolution-chip__checkmark{transition:opacity 75ms 0ms cubic-bezier(0.4, 0, 0.2, 1);transform:translate(-50%, -50%)}.mdc-evolution-chip--deselecting-with-primary-icon .mdc-evolution-chip__checkmark-path{stroke-dashoffset:0}.mdc-evolution-chip--selected .mdc-evolution-chip__icon--primary{opacity:0}.mdc-evolution-chip--selected .mdc-evolution-chip__checkmark{transform:translate(-50%, -50%);opacity:1}.mdc-evolution-chip--selected .mdc-evolution-chip__checkmark-path{stroke-dashoffset:0}@keyframes mdc-evolution-chip-enter{from{transform:scale(0.8);opacity:.4}to{transform:scale(1);opacity:1}}.mdc-evolution-chip--enter{animation:mdc-evolution-chip-enter 100ms 0ms cubic-bezier(0, 0, 0.2, 1)}@keyframes mdc-evolution-chip-exit{from{opacity:1}to{opacity:0}}.mdc-evolution-chip--exit{animation:mdc-evolution-chip-exit 75ms 0ms cubic-bezier(0.4, 0, 1, 1)}.mdc-evolution-chip--hidden{opacity:0;pointer-events:none;transition:width 150ms 0ms cubic-bezier(0.4, 0, 1, 1)}.mat-mdc-standard-chip{border-radius:var(--mdc-chip-container-shape-radius);height:var(--mdc-chip-container-height);--mdc-chip-container-shape-family:rounded;--mdc-chip-container-shape-radius:16px 16px 16px 16px;--mdc-chip-with-avatar-avatar-shape-family:rounded;--mdc-chip-with-avatar-avatar-shape-radius:14px 14px 14px 14px;--mdc-chip-with-avatar-avatar-size:28px;--mdc-chip-with-icon-icon-size:18px}.mat-mdc-standard-chip .mdc-evolution-chip__ripple{border-radius:var(--mdc-chip-container-shape-radius)}.mat-mdc-standard-chip .mdc-evolution-chip__action--primary:before{border-radius:var(--mdc-chip-container-shape-radius)}.mat-mdc-standard-chip .mdc-evolution-chip__icon--primary{border-radius:var(--mdc-chip-with-avatar-avatar-shape-radius)}.mat-mdc-standard-chip.mdc-evolution-chip--selectable:not(.mdc-evolution-chip--with-primary-icon){--mdc-chip-graphic-selected-width:var(--mdc-chip-with-avatar-avatar-size)}.mat-mdc-standard-chip .mdc-evolution-chip__graphic{height:var(--mdc-chip-with-avatar-avatar-size);width:var(--mdc-chip-with-avatar-avatar-size);font-size:var(--mdc-chip-with-avatar-avatar-size)}.mat-mdc-standard-chip:not(.mdc-evolution-chip--disabled){background-color:var(--mdc-chip-elevated-container-color)}.mat-mdc-standard-chip.mdc-evolution-chip--disabled{background-color:var(--mdc-chip-elevated-disabled-container-color)}.mat-mdc-standard-chip.mdc-evolution-chip--selected.mdc-evolution-chip--disabled{background-color:var(--mdc-chip-elevated-disabled-container-color)}.mat-mdc-standard-chip .mdc-evolution-chip__text-label{font-family:var(--mdc-chip-label-text-font);line-height:var(--mdc-chip-label-text-line-height);font-size:var(--mdc-chip-label-text-size);font-weight:var(--mdc-chip-label-text-weight);letter-spacing:var(--mdc-chip-label-text-tracking)}.mat-mdc-standard-chip:not(.mdc-evolution-chip--disabled) .mdc-evolution-chip__text-label{color:var(--mdc-chip-label-text-color)}.mat-mdc-standard-chip.mdc-evolution-chip--disabled .mdc-evolution-chip__text-label{color:var(--mdc-chip-disabled-label-text-color)}.mat-mdc-standard-chip.mdc-evolution-chip--selected.mdc-evolution-chip--disabled .mdc-evolution-chip__text-label{color:var(--mdc-chip-disabled-label-text-color)}.mat-mdc-standard-chip .mdc-evolution-chip__icon--primary{height:var(--mdc-chip-with-icon-icon-size);width:var(--mdc-chip-with-icon-icon-size);font-size:var(--mdc-chip-with-icon-icon-size)}.mat-mdc-standard-chip:not(.mdc-evolution-chip--disabled) .mdc-evolution-chip__icon--primary{color:var(--mdc-chip-with-icon-icon-color)}.mat-mdc-standard-chip.mdc-evolution-chip--disabled .mdc-evolution-chip__icon--primary{color:var(--mdc-chip-with-icon-disabled-icon-color)}.mat-mdc-standard-chip:not(.mdc-evolution-chip--disabled) .mdc-evolution-chip__checkmark{color:var(--mdc-chip-with-icon-selected-icon-color)}.mat-mdc-standard-chip.mdc-evolution-chip--disabled .mdc-evolution-chip__checkmark{color:var(--mdc-chip-with-icon-disabled-icon-color)}.mat-mdc-standard-chip:not(.mdc-evolution-chip--disabled) .mdc-evolution-chip__icon--trailing{color:var(--mdc-chip-with-trailing-icon-trailing-icon-color)}.mat-mdc-standard-chip.mdc-evolution-chip--disabled .mdc-evolution-chip__icon--trailing{color:var(--mdc-chip-with-trailing-icon-disabled-trailing-icon-color)}.mat-mdc-standard-chip .mdc-evolution-chip__action--primary.mdc-ripple-upgraded--background-focused .mdc-evolution-chip__ripple::before,.mat-mdc-standard-chip .mdc-evolution-chip__action--primary:not(.mdc-ripple-upgraded):focus .mdc-evolution-chip__ripple::before{transition-duration:75ms;opacity:var(--mdc-chip-focus-state-layer-opacity)}.mat-mdc-chip-focus-overlay{background:var(--mdc-chip-focus-state-layer-color);opacity:var(--mdc-chip-focus-state-layer-opacity)}.mat-mdc-standard-chip .mdc-evolution-chip__checkmark{height:20px;width:20px}.mat-mdc-standard-chip .mdc-evolution-chip__icon--trailing{height:18px;width:18px;font-size:18px}.mat-mdc-standard-chip .mdc-evolution-chip__action--primary{padding-left:12px;padding-right:12px}[dir=rtl] .mat-mdc-standard-chip .mdc-evolution-chip__action--primary,.mat-mdc-standard-chip .mdc-evolution-chip__action--primary[dir=rtl]{padding-left:12px;padding-right:12px}.mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic .mdc-evolution-chip__graphic{padding-left:6px;padding-right:6px}[dir=rtl] .mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic .mdc-evolution-chip__graphic,.mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic .mdc-evolution-chip__graphic[dir=rtl]{padding-left:6px;padding-right:6px}.mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic .mdc-evolution-chip__action--primary{padding-left:0;padding-right:12px}[dir=rtl] .mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic .mdc-evolution-chip__action--primary,.mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic .mdc-evolution-chip__action--primary[dir=rtl]{padding-left:12px;padding-right:0}.mat-mdc-standard-chip.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--trailing{padding-left:8px;padding-right:8px}[dir=rtl] .mat-mdc-standard-chip.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--trailing,.mat-mdc-standard-chip.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--trailing[dir=rtl]{padding-left:8px;padding-right:8px}.mat-mdc-standard-chip.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__ripple--trailing{left:8px;right:initial}[dir=rtl] .mat-mdc-standard-chip.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__ripple--trailing,.mat-mdc-standard-chip.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__ripple--trailing[dir=rtl]{left:initial;right:8px}.mat-mdc-standard-chip.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--primary{padding-left:12px;padding-right:0}[dir=rtl] .mat-mdc-standard-chip.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--primary,.mat-mdc-standard-chip.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--primary[dir=rtl]{padding-left:0;padding-right:12px}.mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__graphic{padding-left:6px;padding-right:6px}[dir=rtl] .mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__graphic,.mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__graphic[dir=rtl]{padding-left:6px;padding-right:6px}.mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--trailing{padding-left:8px;padding-right:8px}[dir=rtl] .mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--trailing,.mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--trailing[dir=rtl]{padding-left:8px;padding-right:8px}.mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__ripple--trailing{left:8px;right:initial}[dir=rtl] .mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__ripple--trailing,.mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__ripple--trailing[dir=rtl]{left:initial;right:8px}.mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--primary{padding-left:0;padding-right:0}[dir=rtl] .mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--primary,.mat-mdc-standard-chip.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--primary[dir=rtl]{padding-left:0;padding-right:0}.mat-mdc-standard-chip.mdc-evolution-chip--disabled .mdc-evolution-chip__checkmark{color:var(--mdc-chip-with-icon-selected-icon-color, currentColor)}.mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic .mdc-evolution-chip__graphic{padding-left:4px;padding-right:8px}[dir=rtl] .mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic .mdc-evolution-chip__graphic,.mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic .mdc-evolution-chip__graphic[dir=rtl]{padding-left:8px;padding-right:4px}.mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic .mdc-evolution-chip__action--primary{padding-left:0;padding-right:12px}[dir=rtl] .mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic .mdc-evolution-chip__action--primary,.mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic .mdc-evolution-chip__action--primary[dir=rtl]{padding-left:12px;padding-right:0}.mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__graphic{padding-left:4px;padding-right:8px}[dir=rtl] .mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__graphic,.mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__graphic[dir=rtl]{padding-left:8px;padding-right:4px}.mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--trailing{padding-left:8px;padding-right:8px}[dir=rtl] .mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--trailing,.mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--trailing[dir=rtl]{padding-left:8px;padding-right:8px}.mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__ripple--trailing{left:8px;right:initial}[dir=rtl] .mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__ripple--trailing,.mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__ripple--trailing[dir=rtl]{left:initial;right:8px}.mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--primary{padding-left:0;padding-right:0}[dir=rtl] .mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--primary,.mdc-evolution-chip--with-avatar.mdc-evolution-chip--with-primary-graphic.mdc-evolution-chip--with-trailing-action .mdc-evolution-chip__action--primary[dir=rtl]{padding-left:0;padding-right:0}.mat-mdc-standard-chip{-webkit-tap-highlight-color:rgba(0,0,0,0)}.cdk-high-contrast-active .mat-mdc-standard-chip{outline:solid 1px}.cdk-high-contrast-active .mat-mdc-standard-chip .mdc-evolution-chip__checkmark-path{stroke:CanvasText !important}.mat-mdc-standard-chip.mdc-evolution-chip--disabled{opacity:.4}.mat-mdc-standard-chip .mdc-evolution-chip__cell--primary,.mat-mdc-standard-chip .mdc-evolution-chip__action--primary,.mat-mdc-standard-chip .mat-mdc-chip-action-label{overflow:visible}.mat-mdc-standard-chip .mdc-evolution-chip__cell--primary{flex-basis:100%}.mat-mdc-standard-chip .mdc-evolution-chip__action--primary{font:inherit;letter-spacing:inherit;white-space:inherit}.mat-mdc-standard-chip .mat-mdc-chip-graphic,.mat-mdc-standard-chip .mat-mdc-chip-trailing-icon{box-sizing:content-box}.mat-mdc-standard-chip._mat-animation-noopable,.mat-mdc-standard-chip._mat-animation-noopable .mdc-evolution-chip__graphic,.mat-mdc-standard-chip._mat-animation-noopable .mdc-evolution-chip__checkmark,.mat-mdc-standard-chip._mat-animation-noopable .mdc-evolution-chip__checkmark-path{transition-duration:1ms;animation-duration:1ms}.mat-mdc-basic-chip .mdc-evolution-chip__action--primary{font:inherit}.mat-mdc-chip-focus-overlay{top:0;left:0;right:0;bottom:0;position:absolute;pointer-events:none;opacity:0;border-radius:inherit;transition:opacity 150ms linear}._mat-animation-noopable .mat-mdc-chip-focus-overlay{transition:none}.mat-mdc-basic-chip .mat-mdc-chip-focus-overlay{display:none}.mat-mdc-chip:hover .mat-mdc-chip-focus-overlay{opacity:.04}.mat-mdc-chip.cdk-focused .mat-mdc-chip-focus-overlay{opacity:.12}.mat-mdc-chip .mat-ripple.mat-mdc-chip-ripple{top:0;left:0;right:0;bottom:0;position:absolute;pointer-events:none;border-radius:inherit}.mat-mdc-chip-avatar{text-align:center;line-height:1;color:var(--mdc-chip-with-icon-icon-color, currentColor)}.mat-mdc-chip{position:relative;z-index:0}.mat-mdc-chip-action-label{text-align:left;z-index:1}[dir=rtl] .mat-mdc-chip-action-label{text-align:right}.mat-mdc-chip.mdc-evolution-chip--with-trailing-action .mat-mdc-chip-action-label{position:relative}.mat-mdc-chip-action-label .mat-mdc-chip-primary-focus-indicator{position:absolute;top:0;right:0;bottom:0;left:0;pointer-events:none}.mat-mdc-chip-action-label .mat-mdc-focus-indicator::before{margin:calc(calc(var(--mat-mdc-focus-indicator-border-width, 3px) + 2px) * -1)}.mat-mdc-chip-remove{opacity:.54}.mat-mdc-chip-remove:focus{opacity:1}.mat-mdc-chip-remove::before{margin:calc(var(--mat-mdc-focus-indicator-border-width, 3px) * -1);left:8px;right:8px}.mat-mdc-chip-remove .mat-icon{width:inherit;height:inherit;font-size:inherit;box-sizing:content-box}.mat-chip-edit-input{cursor:text;display:inline-block;color:inherit;outline:0}.cdk-high-contrast-active .mat-mdc-chip-selected:not(.mat-mdc-chip-multiple){outline-width:3px}.mat-mdc-chip-action:focus .mat-mdc-focus-indicator::before{content:""}'],encapsulation:2,changeDetection:0}),t})();class $te{constructor(t){}}const qte=ns($te);let em=(()=>{var e;class t extends qte{get chipFocusChanges(){return this._getChipStream(n=>n._onFocus)}get chipDestroyedChanges(){return this._getChipStream(n=>n.destroyed)}get disabled(){return this._disabled}set disabled(n){this._disabled=Q(n),this._syncChipsState()}get empty(){return!this._chips||0===this._chips.length}get role(){return this._explicitRole?this._explicitRole:this.empty?null:this._defaultRole}set role(n){this._explicitRole=n}get focused(){return this._hasFocusedChip()}constructor(n,r,o){super(n),this._elementRef=n,this._changeDetectorRef=r,this._dir=o,this._lastDestroyedFocusedChipIndex=null,this._destroyed=new Y,this._defaultRole="presentation",this._disabled=!1,this._explicitRole=null,this._chipActions=new Cr}ngAfterViewInit(){this._setUpFocusManagement(),this._trackChipSetChanges(),this._trackDestroyedFocusedChip()}ngOnDestroy(){this._keyManager?.destroy(),this._chipActions.destroy(),this._destroyed.next(),this._destroyed.complete()}_hasFocusedChip(){return this._chips&&this._chips.some(n=>n._hasFocus())}_syncChipsState(){this._chips&&this._chips.forEach(n=>{n.disabled=this._disabled,n._changeDetectorRef.markForCheck()})}focus(){}_handleKeydown(n){this._originatesFromChip(n)&&this._keyManager.onKeydown(n)}_isValidIndex(n){return n>=0&&n<this._chips.length}_allowFocusEscape(){if(-1!==this.tabIndex){const n=this.tabIndex;this.tabIndex=-1,setTimeout(()=>this.tabIndex=n)}}_getChipStream(n){return this._chips.changes.pipe(tn(null),Vt(()=>Ot(...this._chips.map(n))))}_originatesFromChip(n){let r=n.target;for(;r&&r!==this._elementRef.nativeElement;){if(r.classList.contains("mat-mdc-chip"))return!0;r=r.parentElement}return!1}_setUpFocusManagement(){this._chips.changes.pipe(tn(this._chips)).subscribe(n=>{const r=[];n.forEach(o=>o._getActions().forEach(s=>r.push(s))),this._chipActions.reset(r),this._chipActions.notifyOnChanges()}),this._keyManager=new Pv(this._chipActions).withVerticalOrientation().withHorizontalOrientation(this._dir?this._dir.value:"ltr").withHomeAndEnd().skipPredicate(n=>this._skipPredicate(n)),this.chipFocusChanges.pipe(ce(this._destroyed)).subscribe(({chip:n})=>{const r=n._getSourceAction(document.activeElement);r&&this._keyManager.updateActiveItem(r)}),this._dir?.change.pipe(ce(this._destroyed)).subscribe(n=>this._keyManager.withHorizontalOrientation(n))}_skipPredicate(n){return!n.isInteractive||n.disabled}_trackChipSetChanges(){this._chips.changes.pipe(tn(null),ce(this._destroyed)).subscribe(()=>{this.disabled&&Promise.resolve().then(()=>this._syncChipsState()),this._redirectDestroyedChipFocus()})}_trackDestroyedFocusedChip(){this.chipDestroyedChanges.pipe(ce(this._destroyed)).subscribe(n=>{const o=this._chips.toArray().indexOf(n.chip);this._isValidIndex(o)&&n.chip._hasFocus()&&(this._lastDestroyedFocusedChipIndex=o)})}_redirectDestroyedChipFocus(){if(null!=this._lastDestroyedFocusedChipIndex){if(this._chips.length){const n=Math.min(this._lastDestroyedFocusedChipIndex,this._chips.length-1),r=this._chips.toArray()[n];r.disabled?1===this._chips.length?this.focus():this._keyManager.setPreviousItemActive():r.focus()}else this.focus();this._lastDestroyedFocusedChipIndex=null}}}return(e=t).\u0275fac=function(n){return new(n||e)(m(W),m(Fe),m(fn,8))},e.\u0275cmp=fe({type:e,selectors:[["mat-chip-set"]],contentQueries:function(n,r,o){if(1&n&&Ee(o,vs,5),2&n){let s;H(s=z())&&(r._chips=s)}},hostAttrs:[1,"mat-mdc-chip-set","mdc-evolution-chip-set"],hostVars:1,hostBindings:function(n,r){1&n&&$("keydown",function(s){return r._handleKeydown(s)}),2&n&&de("role",r.role)},inputs:{disabled:"disabled",role:"role"},features:[P],ngContentSelectors:iw,decls:2,vars:0,consts:[["role","presentation",1,"mdc-evolution-chip-set__chips"]],template:function(n,r){1&n&&(ze(),y(0,"div",0),X(1),w())},styles:[".mdc-evolution-chip-set{display:flex}.mdc-evolution-chip-set:focus{outline:none}.mdc-evolution-chip-set__chips{display:flex;flex-flow:wrap;min-width:0}.mdc-evolution-chip-set--overflow .mdc-evolution-chip-set__chips{flex-flow:nowrap}.mdc-evolution-chip-set .mdc-evolution-chip-set__chips{margin-left:-8px;margin-right:0}[dir=rtl] .mdc-evolution-chip-set .mdc-evolution-chip-set__chips,.mdc-evolution-chip-set .mdc-evolution-chip-set__chips[dir=rtl]{margin-left:0;margin-right:-8px}.mdc-evolution-chip-set .mdc-evolution-chip{margin-left:8px;margin-right:0}[dir=rtl] .mdc-evolution-chip-set .mdc-evolution-chip,.mdc-evolution-chip-set .mdc-evolution-chip[dir=rtl]{margin-left:0;margin-right:8px}.mdc-evolution-chip-set .mdc-evolution-chip{margin-top:4px;margin-bottom:4px}.mat-mdc-chip-set .mdc-evolution-chip-set__chips{min-width:100%}.mat-mdc-chip-set-stacked{flex-direction:column;align-items:flex-start}.mat-mdc-chip-set-stacked .mat-mdc-chip{width:100%}.mat-mdc-chip-set-stacked .mdc-evolution-chip__graphic{flex-grow:0}.mat-mdc-chip-set-stacked .mdc-evolution-chip__action--primary{flex-basis:100%;justify-content:start}input.mat-mdc-chip-input{flex:1 0 150px;margin-left:8px}[dir=rtl] input.mat-mdc-chip-input{margin-left:0;margin-right:8px}"],encapsulation:2,changeDetection:0}),t})();class Qte{constructor(t,i){this.source=t,this.value=i}}class Yte extends em{constructor(t,i,n,r,o,s,a){super(t,i,n),this._defaultErrorStateMatcher=r,this._parentForm=o,this._parentFormGroup=s,this.ngControl=a,this.stateChanges=new Y}}const Kte=jv(Yte);let hP=(()=>{var e;class t extends Kte{get disabled(){return this.ngControl?!!this.ngControl.disabled:this._disabled}set disabled(n){this._disabled=Q(n),this._syncChipsState()}get id(){return this._chipInput.id}get empty(){return(!this._chipInput||this._chipInput.empty)&&(!this._chips||0===this._chips.length)}get placeholder(){return this._chipInput?this._chipInput.placeholder:this._placeholder}set placeholder(n){this._placeholder=n,this.stateChanges.next()}get focused(){return this._chipInput.focused||this._hasFocusedChip()}get required(){return this._required??this.ngControl?.control?.hasValidator(Ay.required)??!1}set required(n){this._required=Q(n),this.stateChanges.next()}get shouldLabelFloat(){return!this.empty||this.focused}get value(){return this._value}set value(n){this._value=n}get chipBlurChanges(){return this._getChipStream(n=>n._onBlur)}constructor(n,r,o,s,a,c,l){super(n,r,o,c,s,a,l),this.controlType="mat-chip-grid",this._defaultRole="grid",this._ariaDescribedbyIds=[],this._onTouched=()=>{},this._onChange=()=>{},this._value=[],this.change=new U,this.valueChange=new U,this._chips=void 0,this.ngControl&&(this.ngControl.valueAccessor=this)}ngAfterContentInit(){this.chipBlurChanges.pipe(ce(this._destroyed)).subscribe(()=>{this._blur(),this.stateChanges.next()}),Ot(this.chipFocusChanges,this._chips.changes).pipe(ce(this._destroyed)).subscribe(()=>this.stateChanges.next())}ngAfterViewInit(){super.ngAfterViewInit()}ngDoCheck(){this.ngControl&&this.updateErrorState()}ngOnDestroy(){super.ngOnDestroy(),this.stateChanges.complete()}registerInput(n){this._chipInput=n,this._chipInput.setDescribedByIds(this._ariaDescribedbyIds)}onContainerClick(n){!this.disabled&&!this._originatesFromChip(n)&&this.focus()}focus(){this.disabled||this._chipInput.focused||(!this._chips.length||this._chips.first.disabled?Promise.resolve().then(()=>this._chipInput.focus()):this._chips.length&&this._keyManager.setFirstItemActive(),this.stateChanges.next())}setDescribedByIds(n){this._ariaDescribedbyIds=n,this._chipInput?.setDescribedByIds(n)}writeValue(n){this._value=n}registerOnChange(n){this._onChange=n}registerOnTouched(n){this._onTouched=n}setDisabledState(n){this.disabled=n,this.stateChanges.next()}_blur(){this.disabled||setTimeout(()=>{this.focused||(this._propagateChanges(),this._markAsTouched())})}_allowFocusEscape(){this._chipInput.focused||super._allowFocusEscape()}_handleKeydown(n){9===n.keyCode?this._chipInput.focused&&An(n,"shiftKey")&&this._chips.length&&!this._chips.last.disabled?(n.preventDefault(),this._keyManager.activeItem?this._keyManager.setActiveItem(this._keyManager.activeItem):this._focusLastChip()):super._allowFocusEscape():this._chipInput.focused||super._handleKeydown(n),this.stateChanges.next()}_focusLastChip(){this._chips.length&&this._chips.last.focus()}_propagateChanges(){const n=this._chips.length?this._chips.toArray().map(r=>r.value):[];this._value=n,this.change.emit(new Qte(this,n)),this.valueChange.emit(n),this._onChange(n),this._changeDetectorRef.markForCheck()}_markAsTouched(){this._onTouched(),this._changeDetectorRef.markForCheck(),this.stateChanges.next()}}return(e=t).\u0275fac=function(n){return new(n||e)(m(W),m(Fe),m(fn,8),m(Ya,8),m(Xa,8),m(Ff),m(Hi,10))},e.\u0275cmp=fe({type:e,selectors:[["mat-chip-grid"]],contentQueries:function(n,r,o){if(1&n&&Ee(o,aw,5),2&n){let s;H(s=z())&&(r._chips=s)}},hostAttrs:[1,"mat-mdc-chip-set","mat-mdc-chip-grid","mdc-evolution-chip-set"],hostVars:10,hostBindings:function(n,r){1&n&&$("focus",function(){return r.focus()})("blur",function(){return r._blur()}),2&n&&(pi("tabIndex",r._chips&&0===r._chips.length?-1:r.tabIndex),de("role",r.role)("aria-disabled",r.disabled.toString())("aria-invalid",r.errorState),se("mat-mdc-chip-list-disabled",r.disabled)("mat-mdc-chip-list-invalid",r.errorState)("mat-mdc-chip-list-required",r.required))},inputs:{tabIndex:"tabIndex",disabled:"disabled",placeholder:"placeholder",required:"required",value:"value",errorStateMatcher:"errorStateMatcher"},outputs:{change:"change",valueChange:"valueChange"},features:[Z([{provide:Kp,useExisting:e}]),P],ngContentSelectors:iw,decls:2,vars:0,consts:[["role","presentation",1,"mdc-evolution-chip-set__chips"]],template:function(n,r){1&n&&(ze(),y(0,"div",0),X(1),w())},styles:[".mdc-evolution-chip-set{display:flex}.mdc-evolution-chip-set:focus{outline:none}.mdc-evolution-chip-set__chips{display:flex;flex-flow:wrap;min-width:0}.mdc-evolution-chip-set--overflow .mdc-evolution-chip-set__chips{flex-flow:nowrap}.mdc-evolution-chip-set .mdc-evolution-chip-set__chips{margin-left:-8px;margin-right:0}[dir=rtl] .mdc-evolution-chip-set .mdc-evolution-chip-set__chips,.mdc-evolution-chip-set .mdc-evolution-chip-set__chips[dir=rtl]{margin-left:0;margin-right:-8px}.mdc-evolution-chip-set .mdc-evolution-chip{margin-left:8px;margin-right:0}[dir=rtl] .mdc-evolution-chip-set .mdc-evolution-chip,.mdc-evolution-chip-set .mdc-evolution-chip[dir=rtl]{margin-left:0;margin-right:8px}.mdc-evolution-chip-set .mdc-evolution-chip{margin-top:4px;margin-bottom:4px}.mat-mdc-chip-set .mdc-evolution-chip-set__chips{min-width:100%}.mat-mdc-chip-set-stacked{flex-direction:column;align-items:flex-start}.mat-mdc-chip-set-stacked .mat-mdc-chip{width:100%}.mat-mdc-chip-set-stacked .mdc-evolution-chip__graphic{flex-grow:0}.mat-mdc-chip-set-stacked .mdc-evolution-chip__action--primary{flex-basis:100%;justify-content:start}input.mat-mdc-chip-input{flex:1 0 150px;margin-left:8px}[dir=rtl] input.mat-mdc-chip-input{margin-left:0;margin-right:8px}"],encapsulation:2,changeDetection:0}),t})(),Xte=0,fP=(()=>{var e;class t{set chipGrid(n){n&&(this._chipGrid=n,this._chipGrid.registerInput(this))}get addOnBlur(){return this._addOnBlur}set addOnBlur(n){this._addOnBlur=Q(n)}get disabled(){return this._disabled||this._chipGrid&&this._chipGrid.disabled}set disabled(n){this._disabled=Q(n)}get empty(){return!this.inputElement.value}constructor(n,r,o){this._elementRef=n,this.focused=!1,this._addOnBlur=!1,this.chipEnd=new U,this.placeholder="",this.id="mat-mdc-chip-list-input-"+Xte++,this._disabled=!1,this.inputElement=this._elementRef.nativeElement,this.separatorKeyCodes=r.separatorKeyCodes,o&&this.inputElement.classList.add("mat-mdc-form-field-input-control")}ngOnChanges(){this._chipGrid.stateChanges.next()}ngOnDestroy(){this.chipEnd.complete()}ngAfterContentInit(){this._focusLastChipOnBackspace=this.empty}_keydown(n){if(n){if(8===n.keyCode&&this._focusLastChipOnBackspace)return this._chipGrid._focusLastChip(),void n.preventDefault();this._focusLastChipOnBackspace=!1}this._emitChipEnd(n)}_keyup(n){!this._focusLastChipOnBackspace&&8===n.keyCode&&this.empty&&(this._focusLastChipOnBackspace=!0,n.preventDefault())}_blur(){this.addOnBlur&&this._emitChipEnd(),this.focused=!1,this._chipGrid.focused||this._chipGrid._blur(),this._chipGrid.stateChanges.next()}_focus(){this.focused=!0,this._focusLastChipOnBackspace=this.empty,this._chipGrid.stateChanges.next()}_emitChipEnd(n){(!n||this._isSeparatorKey(n))&&(this.chipEnd.emit({input:this.inputElement,value:this.inputElement.value,chipInput:this}),n?.preventDefault())}_onInput(){this._chipGrid.stateChanges.next()}focus(){this.inputElement.focus()}clear(){this.inputElement.value="",this._focusLastChipOnBackspace=!0}setDescribedByIds(n){const r=this._elementRef.nativeElement;n.length?r.setAttribute("aria-describedby",n.join(" ")):r.removeAttribute("aria-describedby")}_isSeparatorKey(n){return!An(n)&&new Set(this.separatorKeyCodes).has(n.keyCode)}}return(e=t).\u0275fac=function(n){return new(n||e)(m(W),m(Xp),m(Vd,8))},e.\u0275dir=T({type:e,selectors:[["input","matChipInputFor",""]],hostAttrs:[1,"mat-mdc-chip-input","mat-mdc-input-element","mdc-text-field__input","mat-input-element"],hostVars:6,hostBindings:function(n,r){1&n&&$("keydown",function(s){return r._keydown(s)})("keyup",function(s){return r._keyup(s)})("blur",function(){return r._blur()})("focus",function(){return r._focus()})("input",function(){return r._onInput()}),2&n&&(pi("id",r.id),de("disabled",r.disabled||null)("placeholder",r.placeholder||null)("aria-invalid",r._chipGrid&&r._chipGrid.ngControl?r._chipGrid.ngControl.invalid:null)("aria-required",r._chipGrid&&r._chipGrid.required||null)("required",r._chipGrid&&r._chipGrid.required||null))},inputs:{chipGrid:["matChipInputFor","chipGrid"],addOnBlur:["matChipInputAddOnBlur","addOnBlur"],separatorKeyCodes:["matChipInputSeparatorKeyCodes","separatorKeyCodes"],placeholder:"placeholder",id:"id",disabled:"disabled"},outputs:{chipEnd:"matChipInputTokenEnd"},exportAs:["matChipInput","matChipInputFor"],features:[kt]}),t})(),Zte=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275mod=le({type:e}),e.\u0275inj=ae({providers:[Ff,{provide:Xp,useValue:{separatorKeyCodes:[13]}}],imports:[ve,vn,is,ve]}),t})(),Jte=(()=>{var e;class t{constructor(){this._vertical=!1,this._inset=!1}get vertical(){return this._vertical}set vertical(n){this._vertical=Q(n)}get inset(){return this._inset}set inset(n){this._inset=Q(n)}}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275cmp=fe({type:e,selectors:[["mat-divider"]],hostAttrs:["role","separator",1,"mat-divider"],hostVars:7,hostBindings:function(n,r){2&n&&(de("aria-orientation",r.vertical?"vertical":"horizontal"),se("mat-divider-vertical",r.vertical)("mat-divider-horizontal",!r.vertical)("mat-divider-inset",r.inset))},inputs:{vertical:"vertical",inset:"inset"},decls:0,vars:0,template:function(n,r){},styles:[".mat-divider{--mat-divider-width:1px;display:block;margin:0;border-top-style:solid;border-top-color:var(--mat-divider-color);border-top-width:var(--mat-divider-width)}.mat-divider.mat-divider-vertical{border-top:0;border-right-style:solid;border-right-color:var(--mat-divider-color);border-right-width:var(--mat-divider-width)}.mat-divider.mat-divider-inset{margin-left:80px}[dir=rtl] .mat-divider.mat-divider-inset{margin-left:auto;margin-right:80px}"],encapsulation:2,changeDetection:0}),t})(),ene=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275mod=le({type:e}),e.\u0275inj=ae({imports:[ve,ve]}),t})();const pP=new M("CdkAccordion");let tne=0,nne=(()=>{var e;class t{get expanded(){return this._expanded}set expanded(n){n=Q(n),this._expanded!==n&&(this._expanded=n,this.expandedChange.emit(n),n?(this.opened.emit(),this._expansionDispatcher.notify(this.id,this.accordion?this.accordion.id:this.id)):this.closed.emit(),this._changeDetectorRef.markForCheck())}get disabled(){return this._disabled}set disabled(n){this._disabled=Q(n)}constructor(n,r,o){this.accordion=n,this._changeDetectorRef=r,this._expansionDispatcher=o,this._openCloseAllSubscription=Ae.EMPTY,this.closed=new U,this.opened=new U,this.destroyed=new U,this.expandedChange=new U,this.id="cdk-accordion-child-"+tne++,this._expanded=!1,this._disabled=!1,this._removeUniqueSelectionListener=()=>{},this._removeUniqueSelectionListener=o.listen((s,a)=>{this.accordion&&!this.accordion.multi&&this.accordion.id===a&&this.id!==s&&(this.expanded=!1)}),this.accordion&&(this._openCloseAllSubscription=this._subscribeToOpenCloseAllActions())}ngOnDestroy(){this.opened.complete(),this.closed.complete(),this.destroyed.emit(),this.destroyed.complete(),this._removeUniqueSelectionListener(),this._openCloseAllSubscription.unsubscribe()}toggle(){this.disabled||(this.expanded=!this.expanded)}close(){this.disabled||(this.expanded=!1)}open(){this.disabled||(this.expanded=!0)}_subscribeToOpenCloseAllActions(){return this.accordion._openCloseAllActions.subscribe(n=>{this.disabled||(this.expanded=n)})}}return(e=t).\u0275fac=function(n){return new(n||e)(m(pP,12),m(Fe),m(Jy))},e.\u0275dir=T({type:e,selectors:[["cdk-accordion-item"],["","cdkAccordionItem",""]],inputs:{expanded:"expanded",disabled:"disabled"},outputs:{closed:"closed",opened:"opened",destroyed:"destroyed",expandedChange:"expandedChange"},exportAs:["cdkAccordionItem"],features:[Z([{provide:pP,useValue:void 0}])]}),t})(),ine=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275mod=le({type:e}),e.\u0275inj=ae({}),t})();const rne=["body"];function one(e,t){}const sne=[[["mat-expansion-panel-header"]],"*",[["mat-action-row"]]],ane=["mat-expansion-panel-header","*","mat-action-row"];function cne(e,t){1&e&&ie(0,"span",2),2&e&&E("@indicatorRotate",B()._getExpandedState())}const lne=[[["mat-panel-title"]],[["mat-panel-description"]],"*"],dne=["mat-panel-title","mat-panel-description","*"],mP=new M("MAT_ACCORDION"),gP="225ms cubic-bezier(0.4,0.0,0.2,1)",_P={indicatorRotate:ni("indicatorRotate",[qt("collapsed, void",je({transform:"rotate(0deg)"})),qt("expanded",je({transform:"rotate(180deg)"})),Bt("expanded <=> collapsed, void => collapsed",Lt(gP))]),bodyExpansion:ni("bodyExpansion",[qt("collapsed, void",je({height:"0px",visibility:"hidden"})),qt("expanded",je({height:"*",visibility:""})),Bt("expanded <=> collapsed, void => collapsed",Lt(gP))])},bP=new M("MAT_EXPANSION_PANEL");let une=(()=>{var e;class t{constructor(n,r){this._template=n,this._expansionPanel=r}}return(e=t).\u0275fac=function(n){return new(n||e)(m(ct),m(bP,8))},e.\u0275dir=T({type:e,selectors:[["ng-template","matExpansionPanelContent",""]]}),t})(),hne=0;const vP=new M("MAT_EXPANSION_PANEL_DEFAULT_OPTIONS");let yP=(()=>{var e;class t extends nne{get hideToggle(){return this._hideToggle||this.accordion&&this.accordion.hideToggle}set hideToggle(n){this._hideToggle=Q(n)}get togglePosition(){return this._togglePosition||this.accordion&&this.accordion.togglePosition}set togglePosition(n){this._togglePosition=n}constructor(n,r,o,s,a,c,l){super(n,r,o),this._viewContainerRef=s,this._animationMode=c,this._hideToggle=!1,this.afterExpand=new U,this.afterCollapse=new U,this._inputChanges=new Y,this._headerId="mat-expansion-panel-header-"+hne++,this._bodyAnimationDone=new Y,this.accordion=n,this._document=a,this._bodyAnimationDone.pipe(Is((d,u)=>d.fromState===u.fromState&&d.toState===u.toState)).subscribe(d=>{"void"!==d.fromState&&("expanded"===d.toState?this.afterExpand.emit():"collapsed"===d.toState&&this.afterCollapse.emit())}),l&&(this.hideToggle=l.hideToggle)}_hasSpacing(){return!!this.accordion&&this.expanded&&"default"===this.accordion.displayMode}_getExpandedState(){return this.expanded?"expanded":"collapsed"}toggle(){this.expanded=!this.expanded}close(){this.expanded=!1}open(){this.expanded=!0}ngAfterContentInit(){this._lazyContent&&this._lazyContent._expansionPanel===this&&this.opened.pipe(tn(null),Ve(()=>this.expanded&&!this._portal),Xe(1)).subscribe(()=>{this._portal=new co(this._lazyContent._template,this._viewContainerRef)})}ngOnChanges(n){this._inputChanges.next(n)}ngOnDestroy(){super.ngOnDestroy(),this._bodyAnimationDone.complete(),this._inputChanges.complete()}_containsFocus(){if(this._body){const n=this._document.activeElement,r=this._body.nativeElement;return n===r||r.contains(n)}return!1}}return(e=t).\u0275fac=function(n){return new(n||e)(m(mP,12),m(Fe),m(Jy),m(pt),m(he),m(_t,8),m(vP,8))},e.\u0275cmp=fe({type:e,selectors:[["mat-expansion-panel"]],contentQueries:function(n,r,o){if(1&n&&Ee(o,une,5),2&n){let s;H(s=z())&&(r._lazyContent=s.first)}},viewQuery:function(n,r){if(1&n&&ke(rne,5),2&n){let o;H(o=z())&&(r._body=o.first)}},hostAttrs:[1,"mat-expansion-panel"],hostVars:6,hostBindings:function(n,r){2&n&&se("mat-expanded",r.expanded)("_mat-animation-noopable","NoopAnimations"===r._animationMode)("mat-expansion-panel-spacing",r._hasSpacing())},inputs:{disabled:"disabled",expanded:"expanded",hideToggle:"hideToggle",togglePosition:"togglePosition"},outputs:{opened:"opened",closed:"closed",expandedChange:"expandedChange",afterExpand:"afterExpand",afterCollapse:"afterCollapse"},exportAs:["matExpansionPanel"],features:[Z([{provide:mP,useValue:void 0},{provide:bP,useExisting:e}]),P,kt],ngContentSelectors:ane,decls:7,vars:4,consts:[["role","region",1,"mat-expansion-panel-content",3,"id"],["body",""],[1,"mat-expansion-panel-body"],[3,"cdkPortalOutlet"]],template:function(n,r){1&n&&(ze(sne),X(0),y(1,"div",0,1),$("@bodyExpansion.done",function(s){return r._bodyAnimationDone.next(s)}),y(3,"div",2),X(4,1),R(5,one,0,0,"ng-template",3),w(),X(6,2),w()),2&n&&(x(1),E("@bodyExpansion",r._getExpandedState())("id",r.id),de("aria-labelledby",r._headerId),x(4),E("cdkPortalOutlet",r._portal))},dependencies:[La],styles:['.mat-expansion-panel{--mat-expansion-container-shape:4px;box-sizing:content-box;display:block;margin:0;overflow:hidden;transition:margin 225ms cubic-bezier(0.4, 0, 0.2, 1),box-shadow 280ms cubic-bezier(0.4, 0, 0.2, 1);position:relative;background:var(--mat-expansion-container-background-color);color:var(--mat-expansion-container-text-color);border-radius:var(--mat-expansion-container-shape)}.mat-expansion-panel:not([class*=mat-elevation-z]){box-shadow:0px 3px 1px -2px rgba(0, 0, 0, 0.2), 0px 2px 2px 0px rgba(0, 0, 0, 0.14), 0px 1px 5px 0px rgba(0, 0, 0, 0.12)}.mat-accordion .mat-expansion-panel:not(.mat-expanded),.mat-accordion .mat-expansion-panel:not(.mat-expansion-panel-spacing){border-radius:0}.mat-accordion .mat-expansion-panel:first-of-type{border-top-right-radius:var(--mat-expansion-container-shape);border-top-left-radius:var(--mat-expansion-container-shape)}.mat-accordion .mat-expansion-panel:last-of-type{border-bottom-right-radius:var(--mat-expansion-container-shape);border-bottom-left-radius:var(--mat-expansion-container-shape)}.cdk-high-contrast-active .mat-expansion-panel{outline:solid 1px}.mat-expansion-panel.ng-animate-disabled,.ng-animate-disabled .mat-expansion-panel,.mat-expansion-panel._mat-animation-noopable{transition:none}.mat-expansion-panel-content{display:flex;flex-direction:column;overflow:visible;font-family:var(--mat-expansion-container-text-font);font-size:var(--mat-expansion-container-text-size);font-weight:var(--mat-expansion-container-text-weight);line-height:var(--mat-expansion-container-text-line-height);letter-spacing:var(--mat-expansion-container-text-tracking)}.mat-expansion-panel-content[style*="visibility: hidden"] *{visibility:hidden !important}.mat-expansion-panel-body{padding:0 24px 16px}.mat-expansion-panel-spacing{margin:16px 0}.mat-accordion>.mat-expansion-panel-spacing:first-child,.mat-accordion>*:first-child:not(.mat-expansion-panel) .mat-expansion-panel-spacing{margin-top:0}.mat-accordion>.mat-expansion-panel-spacing:last-child,.mat-accordion>*:last-child:not(.mat-expansion-panel) .mat-expansion-panel-spacing{margin-bottom:0}.mat-action-row{border-top-style:solid;border-top-width:1px;display:flex;flex-direction:row;justify-content:flex-end;padding:16px 8px 16px 24px;border-top-color:var(--mat-expansion-actions-divider-color)}.mat-action-row .mat-button-base,.mat-action-row .mat-mdc-button-base{margin-left:8px}[dir=rtl] .mat-action-row .mat-button-base,[dir=rtl] .mat-action-row .mat-mdc-button-base{margin-left:0;margin-right:8px}'],encapsulation:2,data:{animation:[_P.bodyExpansion]},changeDetection:0}),t})();class fne{}const pne=ns(fne);let mne=(()=>{var e;class t extends pne{constructor(n,r,o,s,a,c,l){super(),this.panel=n,this._element=r,this._focusMonitor=o,this._changeDetectorRef=s,this._animationMode=c,this._parentChangeSubscription=Ae.EMPTY;const d=n.accordion?n.accordion._stateChanges.pipe(Ve(u=>!(!u.hideToggle&&!u.togglePosition))):Rt;this.tabIndex=parseInt(l||"")||0,this._parentChangeSubscription=Ot(n.opened,n.closed,d,n._inputChanges.pipe(Ve(u=>!!(u.hideToggle||u.disabled||u.togglePosition)))).subscribe(()=>this._changeDetectorRef.markForCheck()),n.closed.pipe(Ve(()=>n._containsFocus())).subscribe(()=>o.focusVia(r,"program")),a&&(this.expandedHeight=a.expandedHeight,this.collapsedHeight=a.collapsedHeight)}get disabled(){return this.panel.disabled}_toggle(){this.disabled||this.panel.toggle()}_isExpanded(){return this.panel.expanded}_getExpandedState(){return this.panel._getExpandedState()}_getPanelId(){return this.panel.id}_getTogglePosition(){return this.panel.togglePosition}_showToggle(){return!this.panel.hideToggle&&!this.panel.disabled}_getHeaderHeight(){const n=this._isExpanded();return n&&this.expandedHeight?this.expandedHeight:!n&&this.collapsedHeight?this.collapsedHeight:null}_keydown(n){switch(n.keyCode){case 32:case 13:An(n)||(n.preventDefault(),this._toggle());break;default:return void(this.panel.accordion&&this.panel.accordion._handleHeaderKeydown(n))}}focus(n,r){n?this._focusMonitor.focusVia(this._element,n,r):this._element.nativeElement.focus(r)}ngAfterViewInit(){this._focusMonitor.monitor(this._element).subscribe(n=>{n&&this.panel.accordion&&this.panel.accordion._handleHeaderFocus(this)})}ngOnDestroy(){this._parentChangeSubscription.unsubscribe(),this._focusMonitor.stopMonitoring(this._element)}}return(e=t).\u0275fac=function(n){return new(n||e)(m(yP,1),m(W),m(ar),m(Fe),m(vP,8),m(_t,8),ui("tabindex"))},e.\u0275cmp=fe({type:e,selectors:[["mat-expansion-panel-header"]],hostAttrs:["role","button",1,"mat-expansion-panel-header","mat-focus-indicator"],hostVars:15,hostBindings:function(n,r){1&n&&$("click",function(){return r._toggle()})("keydown",function(s){return r._keydown(s)}),2&n&&(de("id",r.panel._headerId)("tabindex",r.tabIndex)("aria-controls",r._getPanelId())("aria-expanded",r._isExpanded())("aria-disabled",r.panel.disabled),kn("height",r._getHeaderHeight()),se("mat-expanded",r._isExpanded())("mat-expansion-toggle-indicator-after","after"===r._getTogglePosition())("mat-expansion-toggle-indicator-before","before"===r._getTogglePosition())("_mat-animation-noopable","NoopAnimations"===r._animationMode))},inputs:{tabIndex:"tabIndex",expandedHeight:"expandedHeight",collapsedHeight:"collapsedHeight"},features:[P],ngContentSelectors:dne,decls:5,vars:3,consts:[[1,"mat-content"],["class","mat-expansion-indicator",4,"ngIf"],[1,"mat-expansion-indicator"]],template:function(n,r){1&n&&(ze(lne),y(0,"span",0),X(1),X(2,1),X(3,2),w(),R(4,cne,1,1,"span",1)),2&n&&(se("mat-content-hide-toggle",!r._showToggle()),x(4),E("ngIf",r._showToggle()))},dependencies:[_i],styles:['.mat-expansion-panel-header{display:flex;flex-direction:row;align-items:center;padding:0 24px;border-radius:inherit;transition:height 225ms cubic-bezier(0.4, 0, 0.2, 1);height:var(--mat-expansion-header-collapsed-state-height);font-family:var(--mat-expansion-header-text-font);font-size:var(--mat-expansion-header-text-size);font-weight:var(--mat-expansion-header-text-weight);line-height:var(--mat-expansion-header-text-line-height);letter-spacing:var(--mat-expansion-header-text-tracking)}.mat-expansion-panel-header.mat-expanded{height:var(--mat-expansion-header-expanded-state-height)}.mat-expansion-panel-header[aria-disabled=true]{color:var(--mat-expansion-header-disabled-state-text-color)}.mat-expansion-panel-header:not([aria-disabled=true]){cursor:pointer}.mat-expansion-panel:not(.mat-expanded) .mat-expansion-panel-header:not([aria-disabled=true]):hover{background:var(--mat-expansion-header-hover-state-layer-color)}@media(hover: none){.mat-expansion-panel:not(.mat-expanded) .mat-expansion-panel-header:not([aria-disabled=true]):hover{background:var(--mat-expansion-container-background-color)}}.mat-expansion-panel .mat-expansion-panel-header:not([aria-disabled=true]).cdk-keyboard-focused,.mat-expansion-panel .mat-expansion-panel-header:not([aria-disabled=true]).cdk-program-focused{background:var(--mat-expansion-header-focus-state-layer-color)}.mat-expansion-panel-header._mat-animation-noopable{transition:none}.mat-expansion-panel-header:focus,.mat-expansion-panel-header:hover{outline:none}.mat-expansion-panel-header.mat-expanded:focus,.mat-expansion-panel-header.mat-expanded:hover{background:inherit}.mat-expansion-panel-header.mat-expansion-toggle-indicator-before{flex-direction:row-reverse}.mat-expansion-panel-header.mat-expansion-toggle-indicator-before .mat-expansion-indicator{margin:0 16px 0 0}[dir=rtl] .mat-expansion-panel-header.mat-expansion-toggle-indicator-before .mat-expansion-indicator{margin:0 0 0 16px}.mat-content{display:flex;flex:1;flex-direction:row;overflow:hidden}.mat-content.mat-content-hide-toggle{margin-right:8px}[dir=rtl] .mat-content.mat-content-hide-toggle{margin-right:0;margin-left:8px}.mat-expansion-toggle-indicator-before .mat-content.mat-content-hide-toggle{margin-left:24px;margin-right:0}[dir=rtl] .mat-expansion-toggle-indicator-before .mat-content.mat-content-hide-toggle{margin-right:24px;margin-left:0}.mat-expansion-panel-header-title{color:var(--mat-expansion-header-text-color)}.mat-expansion-panel-header-title,.mat-expansion-panel-header-description{display:flex;flex-grow:1;flex-basis:0;margin-right:16px;align-items:center}[dir=rtl] .mat-expansion-panel-header-title,[dir=rtl] .mat-expansion-panel-header-description{margin-right:0;margin-left:16px}.mat-expansion-panel-header[aria-disabled=true] .mat-expansion-panel-header-title,.mat-expansion-panel-header[aria-disabled=true] .mat-expansion-panel-header-description{color:inherit}.mat-expansion-panel-header-description{flex-grow:2;color:var(--mat-expansion-header-description-color)}.mat-expansion-indicator::after{border-style:solid;border-width:0 2px 2px 0;content:"";display:inline-block;padding:3px;transform:rotate(45deg);vertical-align:middle;color:var(--mat-expansion-header-indicator-color)}.cdk-high-contrast-active .mat-expansion-panel-content{border-top:1px solid;border-top-left-radius:0;border-top-right-radius:0}'],encapsulation:2,data:{animation:[_P.indicatorRotate]},changeDetection:0}),t})(),gne=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275dir=T({type:e,selectors:[["mat-panel-title"]],hostAttrs:[1,"mat-expansion-panel-header-title"]}),t})(),_ne=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275mod=le({type:e}),e.\u0275inj=ae({imports:[vn,ve,ine,qf]}),t})();const wP=ro({passive:!0});let bne=(()=>{var e;class t{constructor(n,r){this._platform=n,this._ngZone=r,this._monitoredElements=new Map}monitor(n){if(!this._platform.isBrowser)return Rt;const r=Ar(n),o=this._monitoredElements.get(r);if(o)return o.subject;const s=new Y,a="cdk-text-field-autofilled",c=l=>{"cdk-text-field-autofill-start"!==l.animationName||r.classList.contains(a)?"cdk-text-field-autofill-end"===l.animationName&&r.classList.contains(a)&&(r.classList.remove(a),this._ngZone.run(()=>s.next({target:l.target,isAutofilled:!1}))):(r.classList.add(a),this._ngZone.run(()=>s.next({target:l.target,isAutofilled:!0})))};return this._ngZone.runOutsideAngular(()=>{r.addEventListener("animationstart",c,wP),r.classList.add("cdk-text-field-autofill-monitored")}),this._monitoredElements.set(r,{subject:s,unlisten:()=>{r.removeEventListener("animationstart",c,wP)}}),s}stopMonitoring(n){const r=Ar(n),o=this._monitoredElements.get(r);o&&(o.unlisten(),o.subject.complete(),r.classList.remove("cdk-text-field-autofill-monitored"),r.classList.remove("cdk-text-field-autofilled"),this._monitoredElements.delete(r))}ngOnDestroy(){this._monitoredElements.forEach((n,r)=>this.stopMonitoring(r))}}return(e=t).\u0275fac=function(n){return new(n||e)(D(nt),D(G))},e.\u0275prov=V({token:e,factory:e.\u0275fac,providedIn:"root"}),t})(),vne=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275mod=le({type:e}),e.\u0275inj=ae({}),t})();const yne=new M("MAT_INPUT_VALUE_ACCESSOR"),wne=["button","checkbox","file","hidden","image","radio","range","reset","submit"];let xne=0;const Cne=jv(class{constructor(e,t,i,n){this._defaultErrorStateMatcher=e,this._parentForm=t,this._parentFormGroup=i,this.ngControl=n,this.stateChanges=new Y}});let Dne=(()=>{var e;class t extends Cne{get disabled(){return this._disabled}set disabled(n){this._disabled=Q(n),this.focused&&(this.focused=!1,this.stateChanges.next())}get id(){return this._id}set id(n){this._id=n||this._uid}get required(){return this._required??this.ngControl?.control?.hasValidator(Ay.required)??!1}set required(n){this._required=Q(n)}get type(){return this._type}set type(n){this._type=n||"text",this._validateType(),!this._isTextarea&&CI().has(this._type)&&(this._elementRef.nativeElement.type=this._type)}get value(){return this._inputValueAccessor.value}set value(n){n!==this.value&&(this._inputValueAccessor.value=n,this.stateChanges.next())}get readonly(){return this._readonly}set readonly(n){this._readonly=Q(n)}constructor(n,r,o,s,a,c,l,d,u,h){super(c,s,a,o),this._elementRef=n,this._platform=r,this._autofillMonitor=d,this._formField=h,this._uid="mat-input-"+xne++,this.focused=!1,this.stateChanges=new Y,this.controlType="mat-input",this.autofilled=!1,this._disabled=!1,this._type="text",this._readonly=!1,this._neverEmptyInputTypes=["date","datetime","datetime-local","month","time","week"].filter(g=>CI().has(g)),this._iOSKeyupListener=g=>{const b=g.target;!b.value&&0===b.selectionStart&&0===b.selectionEnd&&(b.setSelectionRange(1,1),b.setSelectionRange(0,0))};const f=this._elementRef.nativeElement,p=f.nodeName.toLowerCase();this._inputValueAccessor=l||f,this._previousNativeValue=this.value,this.id=this.id,r.IOS&&u.runOutsideAngular(()=>{n.nativeElement.addEventListener("keyup",this._iOSKeyupListener)}),this._isServer=!this._platform.isBrowser,this._isNativeSelect="select"===p,this._isTextarea="textarea"===p,this._isInFormField=!!h,this._isNativeSelect&&(this.controlType=f.multiple?"mat-native-select-multiple":"mat-native-select")}ngAfterViewInit(){this._platform.isBrowser&&this._autofillMonitor.monitor(this._elementRef.nativeElement).subscribe(n=>{this.autofilled=n.isAutofilled,this.stateChanges.next()})}ngOnChanges(){this.stateChanges.next()}ngOnDestroy(){this.stateChanges.complete(),this._platform.isBrowser&&this._autofillMonitor.stopMonitoring(this._elementRef.nativeElement),this._platform.IOS&&this._elementRef.nativeElement.removeEventListener("keyup",this._iOSKeyupListener)}ngDoCheck(){this.ngControl&&(this.updateErrorState(),null!==this.ngControl.disabled&&this.ngControl.disabled!==this.disabled&&(this.disabled=this.ngControl.disabled,this.stateChanges.next())),this._dirtyCheckNativeValue(),this._dirtyCheckPlaceholder()}focus(n){this._elementRef.nativeElement.focus(n)}_focusChanged(n){n!==this.focused&&(this.focused=n,this.stateChanges.next())}_onInput(){}_dirtyCheckNativeValue(){const n=this._elementRef.nativeElement.value;this._previousNativeValue!==n&&(this._previousNativeValue=n,this.stateChanges.next())}_dirtyCheckPlaceholder(){const n=this._getPlaceholder();if(n!==this._previousPlaceholder){const r=this._elementRef.nativeElement;this._previousPlaceholder=n,n?r.setAttribute("placeholder",n):r.removeAttribute("placeholder")}}_getPlaceholder(){return this.placeholder||null}_validateType(){wne.indexOf(this._type)}_isNeverEmpty(){return this._neverEmptyInputTypes.indexOf(this._type)>-1}_isBadInput(){let n=this._elementRef.nativeElement.validity;return n&&n.badInput}get empty(){return!(this._isNeverEmpty()||this._elementRef.nativeElement.value||this._isBadInput()||this.autofilled)}get shouldLabelFloat(){if(this._isNativeSelect){const n=this._elementRef.nativeElement,r=n.options[0];return this.focused||n.multiple||!this.empty||!!(n.selectedIndex>-1&&r&&r.label)}return this.focused||!this.empty}setDescribedByIds(n){n.length?this._elementRef.nativeElement.setAttribute("aria-describedby",n.join(" ")):this._elementRef.nativeElement.removeAttribute("aria-describedby")}onContainerClick(){this.focused||this.focus()}_isInlineSelect(){const n=this._elementRef.nativeElement;return this._isNativeSelect&&(n.multiple||n.size>1)}}return(e=t).\u0275fac=function(n){return new(n||e)(m(W),m(nt),m(Hi,10),m(Ya,8),m(Xa,8),m(Ff),m(yne,10),m(bne),m(G),m(Vd,8))},e.\u0275dir=T({type:e,selectors:[["input","matInput",""],["textarea","matInput",""],["select","matNativeControl",""],["input","matNativeControl",""],["textarea","matNativeControl",""]],hostAttrs:[1,"mat-mdc-input-element"],hostVars:18,hostBindings:function(n,r){1&n&&$("focus",function(){return r._focusChanged(!0)})("blur",function(){return r._focusChanged(!1)})("input",function(){return r._onInput()}),2&n&&(pi("id",r.id)("disabled",r.disabled)("required",r.required),de("name",r.name||null)("readonly",r.readonly&&!r._isNativeSelect||null)("aria-invalid",r.empty&&r.required?null:r.errorState)("aria-required",r.required)("id",r.id),se("mat-input-server",r._isServer)("mat-mdc-form-field-textarea-control",r._isInFormField&&r._isTextarea)("mat-mdc-form-field-input-control",r._isInFormField)("mdc-text-field__input",r._isInFormField)("mat-mdc-native-select-inline",r._isInlineSelect()))},inputs:{disabled:"disabled",id:"id",placeholder:"placeholder",name:"name",required:"required",type:"type",errorStateMatcher:"errorStateMatcher",userAriaDescribedBy:["aria-describedby","userAriaDescribedBy"],value:"value",readonly:"readonly"},exportAs:["matInput"],features:[Z([{provide:Kp,useExisting:e}]),P,kt]}),t})(),Ene=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275mod=le({type:e}),e.\u0275inj=ae({imports:[ve,jd,jd,vne,ve]}),t})();const Sne=new M("MAT_PROGRESS_BAR_DEFAULT_OPTIONS"),Tne=ts(class{constructor(e){this._elementRef=e}},"primary");let Mne=(()=>{var e;class t extends Tne{constructor(n,r,o,s,a){super(n),this._ngZone=r,this._changeDetectorRef=o,this._animationMode=s,this._isNoopAnimation=!1,this._value=0,this._bufferValue=0,this.animationEnd=new U,this._mode="determinate",this._transitionendHandler=c=>{0===this.animationEnd.observers.length||!c.target||!c.target.classList.contains("mdc-linear-progress__primary-bar")||("determinate"===this.mode||"buffer"===this.mode)&&this._ngZone.run(()=>this.animationEnd.next({value:this.value}))},this._isNoopAnimation="NoopAnimations"===s,a&&(a.color&&(this.color=this.defaultColor=a.color),this.mode=a.mode||this.mode)}get value(){return this._value}set value(n){this._value=xP(Bi(n)),this._changeDetectorRef.markForCheck()}get bufferValue(){return this._bufferValue||0}set bufferValue(n){this._bufferValue=xP(Bi(n)),this._changeDetectorRef.markForCheck()}get mode(){return this._mode}set mode(n){this._mode=n,this._changeDetectorRef.markForCheck()}ngAfterViewInit(){this._ngZone.runOutsideAngular(()=>{this._elementRef.nativeElement.addEventListener("transitionend",this._transitionendHandler)})}ngOnDestroy(){this._elementRef.nativeElement.removeEventListener("transitionend",this._transitionendHandler)}_getPrimaryBarTransform(){return`scaleX(${this._isIndeterminate()?1:this.value/100})`}_getBufferBarFlexBasis(){return`${"buffer"===this.mode?this.bufferValue:100}%`}_isIndeterminate(){return"indeterminate"===this.mode||"query"===this.mode}}return(e=t).\u0275fac=function(n){return new(n||e)(m(W),m(G),m(Fe),m(_t,8),m(Sne,8))},e.\u0275cmp=fe({type:e,selectors:[["mat-progress-bar"]],hostAttrs:["role","progressbar","aria-valuemin","0","aria-valuemax","100","tabindex","-1",1,"mat-mdc-progress-bar","mdc-linear-progress"],hostVars:8,hostBindings:function(n,r){2&n&&(de("aria-valuenow",r._isIndeterminate()?null:r.value)("mode",r.mode),se("_mat-animation-noopable",r._isNoopAnimation)("mdc-linear-progress--animation-ready",!r._isNoopAnimation)("mdc-linear-progress--indeterminate",r._isIndeterminate()))},inputs:{color:"color",value:"value",bufferValue:"bufferValue",mode:"mode"},outputs:{animationEnd:"animationEnd"},exportAs:["matProgressBar"],features:[P],decls:7,vars:4,consts:[["aria-hidden","true",1,"mdc-linear-progress__buffer"],[1,"mdc-linear-progress__buffer-bar"],[1,"mdc-linear-progress__buffer-dots"],["aria-hidden","true",1,"mdc-linear-progress__bar","mdc-linear-progress__primary-bar"],[1,"mdc-linear-progress__bar-inner"],["aria-hidden","true",1,"mdc-linear-progress__bar","mdc-linear-progress__secondary-bar"]],template:function(n,r){1&n&&(y(0,"div",0),ie(1,"div",1)(2,"div",2),w(),y(3,"div",3),ie(4,"span",4),w(),y(5,"div",5),ie(6,"span",4),w()),2&n&&(x(1),kn("flex-basis",r._getBufferBarFlexBasis()),x(2),kn("transform",r._getPrimaryBarTransform()))},styles:["@keyframes mdc-linear-progress-primary-indeterminate-translate{0%{transform:translateX(0)}20%{animation-timing-function:cubic-bezier(0.5, 0, 0.701732, 0.495819);transform:translateX(0)}59.15%{animation-timing-function:cubic-bezier(0.302435, 0.381352, 0.55, 0.956352);transform:translateX(var(--mdc-linear-progress-primary-half))}100%{transform:translateX(var(--mdc-linear-progress-primary-full))}}@keyframes mdc-linear-progress-primary-indeterminate-scale{0%{transform:scaleX(0.08)}36.65%{animation-timing-function:cubic-bezier(0.334731, 0.12482, 0.785844, 1);transform:scaleX(0.08)}69.15%{animation-timing-function:cubic-bezier(0.06, 0.11, 0.6, 1);transform:scaleX(0.661479)}100%{transform:scaleX(0.08)}}@keyframes mdc-linear-progress-secondary-indeterminate-translate{0%{animation-timing-function:cubic-bezier(0.15, 0, 0.515058, 0.409685);transform:translateX(0)}25%{animation-timing-function:cubic-bezier(0.31033, 0.284058, 0.8, 0.733712);transform:translateX(var(--mdc-linear-progress-secondary-quarter))}48.35%{animation-timing-function:cubic-bezier(0.4, 0.627035, 0.6, 0.902026);transform:translateX(var(--mdc-linear-progress-secondary-half))}100%{transform:translateX(var(--mdc-linear-progress-secondary-full))}}@keyframes mdc-linear-progress-secondary-indeterminate-scale{0%{animation-timing-function:cubic-bezier(0.205028, 0.057051, 0.57661, 0.453971);transform:scaleX(0.08)}19.15%{animation-timing-function:cubic-bezier(0.152313, 0.196432, 0.648374, 1.004315);transform:scaleX(0.457104)}44.15%{animation-timing-function:cubic-bezier(0.257759, -0.003163, 0.211762, 1.38179);transform:scaleX(0.72796)}100%{transform:scaleX(0.08)}}@keyframes mdc-linear-progress-primary-indeterminate-translate-reverse{0%{transform:translateX(0)}20%{animation-timing-function:cubic-bezier(0.5, 0, 0.701732, 0.495819);transform:translateX(0)}59.15%{animation-timing-function:cubic-bezier(0.302435, 0.381352, 0.55, 0.956352);transform:translateX(var(--mdc-linear-progress-primary-half-neg))}100%{transform:translateX(var(--mdc-linear-progress-primary-full-neg))}}@keyframes mdc-linear-progress-secondary-indeterminate-translate-reverse{0%{animation-timing-function:cubic-bezier(0.15, 0, 0.515058, 0.409685);transform:translateX(0)}25%{animation-timing-function:cubic-bezier(0.31033, 0.284058, 0.8, 0.733712);transform:translateX(var(--mdc-linear-progress-secondary-quarter-neg))}48.35%{animation-timing-function:cubic-bezier(0.4, 0.627035, 0.6, 0.902026);transform:translateX(var(--mdc-linear-progress-secondary-half-neg))}100%{transform:translateX(var(--mdc-linear-progress-secondary-full-neg))}}@keyframes mdc-linear-progress-buffering-reverse{from{transform:translateX(-10px)}}.mdc-linear-progress{position:relative;width:100%;transform:translateZ(0);outline:1px solid rgba(0,0,0,0);overflow-x:hidden;transition:opacity 250ms 0ms cubic-bezier(0.4, 0, 0.6, 1)}@media screen and (forced-colors: active){.mdc-linear-progress{outline-color:CanvasText}}.mdc-linear-progress__bar{position:absolute;top:0;bottom:0;margin:auto 0;width:100%;animation:none;transform-origin:top left;transition:transform 250ms 0ms cubic-bezier(0.4, 0, 0.6, 1)}.mdc-linear-progress__bar-inner{display:inline-block;position:absolute;width:100%;animation:none;border-top-style:solid}.mdc-linear-progress__buffer{display:flex;position:absolute;top:0;bottom:0;margin:auto 0;width:100%;overflow:hidden}.mdc-linear-progress__buffer-dots{background-repeat:repeat-x;flex:auto;transform:rotate(180deg);-webkit-mask-image:url(\"data:image/svg+xml,%3Csvg version='1.1' xmlns='http://www.w3.org/2000/svg' xmlns:xlink='http://www.w3.org/1999/xlink' x='0px' y='0px' enable-background='new 0 0 5 2' xml:space='preserve' viewBox='0 0 5 2' preserveAspectRatio='xMinYMin slice'%3E%3Ccircle cx='1' cy='1' r='1'/%3E%3C/svg%3E\");mask-image:url(\"data:image/svg+xml,%3Csvg version='1.1' xmlns='http://www.w3.org/2000/svg' xmlns:xlink='http://www.w3.org/1999/xlink' x='0px' y='0px' enable-background='new 0 0 5 2' xml:space='preserve' viewBox='0 0 5 2' preserveAspectRatio='xMinYMin slice'%3E%3Ccircle cx='1' cy='1' r='1'/%3E%3C/svg%3E\");animation:mdc-linear-progress-buffering 250ms infinite linear}.mdc-linear-progress__buffer-bar{flex:0 1 100%;transition:flex-basis 250ms 0ms cubic-bezier(0.4, 0, 0.6, 1)}.mdc-linear-progress__primary-bar{transform:scaleX(0)}.mdc-linear-progress__secondary-bar{display:none}.mdc-linear-progress--indeterminate .mdc-linear-progress__bar{transition:none}.mdc-linear-progress--indeterminate .mdc-linear-progress__primary-bar{left:-145.166611%}.mdc-linear-progress--indeterminate .mdc-linear-progress__secondary-bar{left:-54.888891%;display:block}.mdc-linear-progress--indeterminate.mdc-linear-progress--animation-ready .mdc-linear-progress__primary-bar{animation:mdc-linear-progress-primary-indeterminate-translate 2s infinite linear}.mdc-linear-progress--indeterminate.mdc-linear-progress--animation-ready .mdc-linear-progress__primary-bar>.mdc-linear-progress__bar-inner{animation:mdc-linear-progress-primary-indeterminate-scale 2s infinite linear}.mdc-linear-progress--indeterminate.mdc-linear-progress--animation-ready .mdc-linear-progress__secondary-bar{animation:mdc-linear-progress-secondary-indeterminate-translate 2s infinite linear}.mdc-linear-progress--indeterminate.mdc-linear-progress--animation-ready .mdc-linear-progress__secondary-bar>.mdc-linear-progress__bar-inner{animation:mdc-linear-progress-secondary-indeterminate-scale 2s infinite linear}[dir=rtl] .mdc-linear-progress:not([dir=ltr]) .mdc-linear-progress__bar,.mdc-linear-progress[dir=rtl]:not([dir=ltr]) .mdc-linear-progress__bar{right:0;-webkit-transform-origin:center right;transform-origin:center right}[dir=rtl] .mdc-linear-progress:not([dir=ltr]).mdc-linear-progress--animation-ready .mdc-linear-progress__primary-bar,.mdc-linear-progress[dir=rtl]:not([dir=ltr]).mdc-linear-progress--animation-ready .mdc-linear-progress__primary-bar{animation-name:mdc-linear-progress-primary-indeterminate-translate-reverse}[dir=rtl] .mdc-linear-progress:not([dir=ltr]).mdc-linear-progress--animation-ready .mdc-linear-progress__secondary-bar,.mdc-linear-progress[dir=rtl]:not([dir=ltr]).mdc-linear-progress--animation-ready .mdc-linear-progress__secondary-bar{animation-name:mdc-linear-progress-secondary-indeterminate-translate-reverse}[dir=rtl] .mdc-linear-progress:not([dir=ltr]) .mdc-linear-progress__buffer-dots,.mdc-linear-progress[dir=rtl]:not([dir=ltr]) .mdc-linear-progress__buffer-dots{animation:mdc-linear-progress-buffering-reverse 250ms infinite linear;transform:rotate(0)}[dir=rtl] .mdc-linear-progress:not([dir=ltr]).mdc-linear-progress--indeterminate .mdc-linear-progress__primary-bar,.mdc-linear-progress[dir=rtl]:not([dir=ltr]).mdc-linear-progress--indeterminate .mdc-linear-progress__primary-bar{right:-145.166611%;left:auto}[dir=rtl] .mdc-linear-progress:not([dir=ltr]).mdc-linear-progress--indeterminate .mdc-linear-progress__secondary-bar,.mdc-linear-progress[dir=rtl]:not([dir=ltr]).mdc-linear-progress--indeterminate .mdc-linear-progress__secondary-bar{right:-54.888891%;left:auto}.mdc-linear-progress--closed{opacity:0}.mdc-linear-progress--closed-animation-off .mdc-linear-progress__buffer-dots{animation:none}.mdc-linear-progress--closed-animation-off.mdc-linear-progress--indeterminate .mdc-linear-progress__bar,.mdc-linear-progress--closed-animation-off.mdc-linear-progress--indeterminate .mdc-linear-progress__bar .mdc-linear-progress__bar-inner{animation:none}@keyframes mdc-linear-progress-buffering{from{transform:rotate(180deg) translateX(calc(var(--mdc-linear-progress-track-height) * -2.5))}}.mdc-linear-progress__bar-inner{border-color:var(--mdc-linear-progress-active-indicator-color)}@media(forced-colors: active){.mdc-linear-progress__buffer-dots{background-color:ButtonBorder}}@media all and (-ms-high-contrast: none),(-ms-high-contrast: active){.mdc-linear-progress__buffer-dots{background-color:rgba(0,0,0,0);background-image:url(\"data:image/svg+xml,%3Csvg version='1.1' xmlns='http://www.w3.org/2000/svg' xmlns:xlink='http://www.w3.org/1999/xlink' x='0px' y='0px' enable-background='new 0 0 5 2' xml:space='preserve' viewBox='0 0 5 2' preserveAspectRatio='none slice'%3E%3Ccircle cx='1' cy='1' r='1' fill=''/%3E%3C/svg%3E\")}}.mdc-linear-progress{height:max(var(--mdc-linear-progress-track-height), var(--mdc-linear-progress-active-indicator-height))}@media all and (-ms-high-contrast: none),(-ms-high-contrast: active){.mdc-linear-progress{height:4px}}.mdc-linear-progress__bar{height:var(--mdc-linear-progress-active-indicator-height)}.mdc-linear-progress__bar-inner{border-top-width:var(--mdc-linear-progress-active-indicator-height)}.mdc-linear-progress__buffer{height:var(--mdc-linear-progress-track-height)}@media all and (-ms-high-contrast: none),(-ms-high-contrast: active){.mdc-linear-progress__buffer-dots{background-size:10px var(--mdc-linear-progress-track-height)}}.mdc-linear-progress__buffer{border-radius:var(--mdc-linear-progress-track-shape)}.mat-mdc-progress-bar{--mdc-linear-progress-active-indicator-height:4px;--mdc-linear-progress-track-height:4px;--mdc-linear-progress-track-shape:0}.mat-mdc-progress-bar{display:block;text-align:left;--mdc-linear-progress-primary-half: 83.67142%;--mdc-linear-progress-primary-full: 200.611057%;--mdc-linear-progress-secondary-quarter: 37.651913%;--mdc-linear-progress-secondary-half: 84.386165%;--mdc-linear-progress-secondary-full: 160.277782%;--mdc-linear-progress-primary-half-neg: -83.67142%;--mdc-linear-progress-primary-full-neg: -200.611057%;--mdc-linear-progress-secondary-quarter-neg: -37.651913%;--mdc-linear-progress-secondary-half-neg: -84.386165%;--mdc-linear-progress-secondary-full-neg: -160.277782%}[dir=rtl] .mat-mdc-progress-bar{text-align:right}.mat-mdc-progress-bar[mode=query]{transform:scaleX(-1)}.mat-mdc-progress-bar._mat-animation-noopable .mdc-linear-progress__buffer-dots,.mat-mdc-progress-bar._mat-animation-noopable .mdc-linear-progress__primary-bar,.mat-mdc-progress-bar._mat-animation-noopable .mdc-linear-progress__secondary-bar,.mat-mdc-progress-bar._mat-animation-noopable .mdc-linear-progress__bar-inner.mdc-linear-progress__bar-inner{animation:none}.mat-mdc-progress-bar._mat-animation-noopable .mdc-linear-progress__primary-bar,.mat-mdc-progress-bar._mat-animation-noopable .mdc-linear-progress__buffer-bar{transition:transform 1ms}"],encapsulation:2,changeDetection:0}),t})();function xP(e,t=0,i=100){return Math.max(t,Math.min(i,e))}let Ine=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275mod=le({type:e}),e.\u0275inj=ae({imports:[ve]}),t})();const Ane=["input"],Rne=["*"];let CP=0;class DP{constructor(t,i){this.source=t,this.value=i}}const One={provide:Gn,useExisting:He(()=>SP),multi:!0},EP=new M("MatRadioGroup"),Fne=new M("mat-radio-default-options",{providedIn:"root",factory:function Pne(){return{color:"accent"}}});let Nne=(()=>{var e;class t{get name(){return this._name}set name(n){this._name=n,this._updateRadioButtonNames()}get labelPosition(){return this._labelPosition}set labelPosition(n){this._labelPosition="before"===n?"before":"after",this._markRadiosForCheck()}get value(){return this._value}set value(n){this._value!==n&&(this._value=n,this._updateSelectedRadioFromValue(),this._checkSelectedRadioButton())}_checkSelectedRadioButton(){this._selected&&!this._selected.checked&&(this._selected.checked=!0)}get selected(){return this._selected}set selected(n){this._selected=n,this.value=n?n.value:null,this._checkSelectedRadioButton()}get disabled(){return this._disabled}set disabled(n){this._disabled=Q(n),this._markRadiosForCheck()}get required(){return this._required}set required(n){this._required=Q(n),this._markRadiosForCheck()}constructor(n){this._changeDetector=n,this._value=null,this._name="mat-radio-group-"+CP++,this._selected=null,this._isInitialized=!1,this._labelPosition="after",this._disabled=!1,this._required=!1,this._controlValueAccessorChangeFn=()=>{},this.onTouched=()=>{},this.change=new U}ngAfterContentInit(){this._isInitialized=!0,this._buttonChanges=this._radios.changes.subscribe(()=>{this.selected&&!this._radios.find(n=>n===this.selected)&&(this._selected=null)})}ngOnDestroy(){this._buttonChanges?.unsubscribe()}_touch(){this.onTouched&&this.onTouched()}_updateRadioButtonNames(){this._radios&&this._radios.forEach(n=>{n.name=this.name,n._markForCheck()})}_updateSelectedRadioFromValue(){this._radios&&(null===this._selected||this._selected.value!==this._value)&&(this._selected=null,this._radios.forEach(r=>{r.checked=this.value===r.value,r.checked&&(this._selected=r)}))}_emitChangeEvent(){this._isInitialized&&this.change.emit(new DP(this._selected,this._value))}_markRadiosForCheck(){this._radios&&this._radios.forEach(n=>n._markForCheck())}writeValue(n){this.value=n,this._changeDetector.markForCheck()}registerOnChange(n){this._controlValueAccessorChangeFn=n}registerOnTouched(n){this.onTouched=n}setDisabledState(n){this.disabled=n,this._changeDetector.markForCheck()}}return(e=t).\u0275fac=function(n){return new(n||e)(m(Fe))},e.\u0275dir=T({type:e,inputs:{color:"color",name:"name",labelPosition:"labelPosition",value:"value",selected:"selected",disabled:"disabled",required:"required"},outputs:{change:"change"}}),t})();class Lne{constructor(t){this._elementRef=t}}const Bne=so(ns(Lne));let Vne=(()=>{var e;class t extends Bne{get checked(){return this._checked}set checked(n){const r=Q(n);this._checked!==r&&(this._checked=r,r&&this.radioGroup&&this.radioGroup.value!==this.value?this.radioGroup.selected=this:!r&&this.radioGroup&&this.radioGroup.value===this.value&&(this.radioGroup.selected=null),r&&this._radioDispatcher.notify(this.id,this.name),this._changeDetector.markForCheck())}get value(){return this._value}set value(n){this._value!==n&&(this._value=n,null!==this.radioGroup&&(this.checked||(this.checked=this.radioGroup.value===n),this.checked&&(this.radioGroup.selected=this)))}get labelPosition(){return this._labelPosition||this.radioGroup&&this.radioGroup.labelPosition||"after"}set labelPosition(n){this._labelPosition=n}get disabled(){return this._disabled||null!==this.radioGroup&&this.radioGroup.disabled}set disabled(n){this._setDisabled(Q(n))}get required(){return this._required||this.radioGroup&&this.radioGroup.required}set required(n){this._required=Q(n)}get color(){return this._color||this.radioGroup&&this.radioGroup.color||this._providerOverride&&this._providerOverride.color||"accent"}set color(n){this._color=n}get inputId(){return`${this.id||this._uniqueId}-input`}constructor(n,r,o,s,a,c,l,d){super(r),this._changeDetector=o,this._focusMonitor=s,this._radioDispatcher=a,this._providerOverride=l,this._uniqueId="mat-radio-"+ ++CP,this.id=this._uniqueId,this.change=new U,this._checked=!1,this._value=null,this._removeUniqueSelectionListener=()=>{},this.radioGroup=n,this._noopAnimations="NoopAnimations"===c,d&&(this.tabIndex=Bi(d,0))}focus(n,r){r?this._focusMonitor.focusVia(this._inputElement,r,n):this._inputElement.nativeElement.focus(n)}_markForCheck(){this._changeDetector.markForCheck()}ngOnInit(){this.radioGroup&&(this.checked=this.radioGroup.value===this._value,this.checked&&(this.radioGroup.selected=this),this.name=this.radioGroup.name),this._removeUniqueSelectionListener=this._radioDispatcher.listen((n,r)=>{n!==this.id&&r===this.name&&(this.checked=!1)})}ngDoCheck(){this._updateTabIndex()}ngAfterViewInit(){this._updateTabIndex(),this._focusMonitor.monitor(this._elementRef,!0).subscribe(n=>{!n&&this.radioGroup&&this.radioGroup._touch()})}ngOnDestroy(){this._focusMonitor.stopMonitoring(this._elementRef),this._removeUniqueSelectionListener()}_emitChangeEvent(){this.change.emit(new DP(this,this._value))}_isRippleDisabled(){return this.disableRipple||this.disabled}_onInputClick(n){n.stopPropagation()}_onInputInteraction(n){if(n.stopPropagation(),!this.checked&&!this.disabled){const r=this.radioGroup&&this.value!==this.radioGroup.value;this.checked=!0,this._emitChangeEvent(),this.radioGroup&&(this.radioGroup._controlValueAccessorChangeFn(this.value),r&&this.radioGroup._emitChangeEvent())}}_onTouchTargetClick(n){this._onInputInteraction(n),this.disabled||this._inputElement.nativeElement.focus()}_setDisabled(n){this._disabled!==n&&(this._disabled=n,this._changeDetector.markForCheck())}_updateTabIndex(){const n=this.radioGroup;let r;if(r=n&&n.selected&&!this.disabled?n.selected===this?this.tabIndex:-1:this.tabIndex,r!==this._previousTabIndex){const o=this._inputElement?.nativeElement;o&&(o.setAttribute("tabindex",r+""),this._previousTabIndex=r)}}}return(e=t).\u0275fac=function(n){jo()},e.\u0275dir=T({type:e,viewQuery:function(n,r){if(1&n&&ke(Ane,5),2&n){let o;H(o=z())&&(r._inputElement=o.first)}},inputs:{id:"id",name:"name",ariaLabel:["aria-label","ariaLabel"],ariaLabelledby:["aria-labelledby","ariaLabelledby"],ariaDescribedby:["aria-describedby","ariaDescribedby"],checked:"checked",value:"value",labelPosition:"labelPosition",disabled:"disabled",required:"required",color:"color"},outputs:{change:"change"},features:[P]}),t})(),SP=(()=>{var e;class t extends Nne{}return(e=t).\u0275fac=function(){let i;return function(r){return(i||(i=be(e)))(r||e)}}(),e.\u0275dir=T({type:e,selectors:[["mat-radio-group"]],contentQueries:function(n,r,o){if(1&n&&Ee(o,kP,5),2&n){let s;H(s=z())&&(r._radios=s)}},hostAttrs:["role","radiogroup",1,"mat-mdc-radio-group"],exportAs:["matRadioGroup"],features:[Z([One,{provide:EP,useExisting:e}]),P]}),t})(),kP=(()=>{var e;class t extends Vne{constructor(n,r,o,s,a,c,l,d){super(n,r,o,s,a,c,l,d)}}return(e=t).\u0275fac=function(n){return new(n||e)(m(EP,8),m(W),m(Fe),m(ar),m(Jy),m(_t,8),m(Fne,8),ui("tabindex"))},e.\u0275cmp=fe({type:e,selectors:[["mat-radio-button"]],hostAttrs:[1,"mat-mdc-radio-button"],hostVars:15,hostBindings:function(n,r){1&n&&$("focus",function(){return r._inputElement.nativeElement.focus()}),2&n&&(de("id",r.id)("tabindex",null)("aria-label",null)("aria-labelledby",null)("aria-describedby",null),se("mat-primary","primary"===r.color)("mat-accent","accent"===r.color)("mat-warn","warn"===r.color)("mat-mdc-radio-checked",r.checked)("_mat-animation-noopable",r._noopAnimations))},inputs:{disableRipple:"disableRipple",tabIndex:"tabIndex"},exportAs:["matRadioButton"],features:[P],ngContentSelectors:Rne,decls:13,vars:17,consts:[[1,"mdc-form-field"],["formField",""],[1,"mdc-radio"],[1,"mat-mdc-radio-touch-target",3,"click"],["type","radio",1,"mdc-radio__native-control",3,"id","checked","disabled","required","change"],["input",""],[1,"mdc-radio__background"],[1,"mdc-radio__outer-circle"],[1,"mdc-radio__inner-circle"],["mat-ripple","",1,"mat-radio-ripple","mat-mdc-focus-indicator",3,"matRippleTrigger","matRippleDisabled","matRippleCentered"],[1,"mat-ripple-element","mat-radio-persistent-ripple"],[1,"mdc-label",3,"for"]],template:function(n,r){if(1&n&&(ze(),y(0,"div",0,1)(2,"div",2)(3,"div",3),$("click",function(s){return r._onTouchTargetClick(s)}),w(),y(4,"input",4,5),$("change",function(s){return r._onInputInteraction(s)}),w(),y(6,"div",6),ie(7,"div",7)(8,"div",8),w(),y(9,"div",9),ie(10,"div",10),w()(),y(11,"label",11),X(12),w()()),2&n){const o=hn(1);se("mdc-form-field--align-end","before"==r.labelPosition),x(2),se("mdc-radio--disabled",r.disabled),x(2),E("id",r.inputId)("checked",r.checked)("disabled",r.disabled)("required",r.required),de("name",r.name)("value",r.value)("aria-label",r.ariaLabel)("aria-labelledby",r.ariaLabelledby)("aria-describedby",r.ariaDescribedby),x(5),E("matRippleTrigger",o)("matRippleDisabled",r._isRippleDisabled())("matRippleCentered",!0),x(2),E("for",r.inputId)}},dependencies:[ao],styles:['.mdc-radio{display:inline-block;position:relative;flex:0 0 auto;box-sizing:content-box;width:20px;height:20px;cursor:pointer;will-change:opacity,transform,border-color,color}.mdc-radio[hidden]{display:none}.mdc-radio__background{display:inline-block;position:relative;box-sizing:border-box;width:20px;height:20px}.mdc-radio__background::before{position:absolute;transform:scale(0, 0);border-radius:50%;opacity:0;pointer-events:none;content:"";transition:opacity 120ms 0ms cubic-bezier(0.4, 0, 0.6, 1),transform 120ms 0ms cubic-bezier(0.4, 0, 0.6, 1)}.mdc-radio__outer-circle{position:absolute;top:0;left:0;box-sizing:border-box;width:100%;height:100%;border-width:2px;border-style:solid;border-radius:50%;transition:border-color 120ms 0ms cubic-bezier(0.4, 0, 0.6, 1)}.mdc-radio__inner-circle{position:absolute;top:0;left:0;box-sizing:border-box;width:100%;height:100%;transform:scale(0, 0);border-width:10px;border-style:solid;border-radius:50%;transition:transform 120ms 0ms cubic-bezier(0.4, 0, 0.6, 1),border-color 120ms 0ms cubic-bezier(0.4, 0, 0.6, 1)}.mdc-radio__native-control{position:absolute;margin:0;padding:0;opacity:0;cursor:inherit;z-index:1}.mdc-radio--touch{margin-top:4px;margin-bottom:4px;margin-right:4px;margin-left:4px}.mdc-radio--touch .mdc-radio__native-control{top:calc((40px - 48px) / 2);right:calc((40px - 48px) / 2);left:calc((40px - 48px) / 2);width:48px;height:48px}.mdc-radio.mdc-ripple-upgraded--background-focused .mdc-radio__focus-ring,.mdc-radio:not(.mdc-ripple-upgraded):focus .mdc-radio__focus-ring{pointer-events:none;border:2px solid rgba(0,0,0,0);border-radius:6px;box-sizing:content-box;position:absolute;top:50%;left:50%;transform:translate(-50%, -50%);height:100%;width:100%}@media screen and (forced-colors: active){.mdc-radio.mdc-ripple-upgraded--background-focused .mdc-radio__focus-ring,.mdc-radio:not(.mdc-ripple-upgraded):focus .mdc-radio__focus-ring{border-color:CanvasText}}.mdc-radio.mdc-ripple-upgraded--background-focused .mdc-radio__focus-ring::after,.mdc-radio:not(.mdc-ripple-upgraded):focus .mdc-radio__focus-ring::after{content:"";border:2px solid rgba(0,0,0,0);border-radius:8px;display:block;position:absolute;top:50%;left:50%;transform:translate(-50%, -50%);height:calc(100% + 4px);width:calc(100% + 4px)}@media screen and (forced-colors: active){.mdc-radio.mdc-ripple-upgraded--background-focused .mdc-radio__focus-ring::after,.mdc-radio:not(.mdc-ripple-upgraded):focus .mdc-radio__focus-ring::after{border-color:CanvasText}}.mdc-radio__native-control:checked+.mdc-radio__background,.mdc-radio__native-control:disabled+.mdc-radio__background{transition:opacity 120ms 0ms cubic-bezier(0, 0, 0.2, 1),transform 120ms 0ms cubic-bezier(0, 0, 0.2, 1)}.mdc-radio__native-control:checked+.mdc-radio__background .mdc-radio__outer-circle,.mdc-radio__native-control:disabled+.mdc-radio__background .mdc-radio__outer-circle{transition:border-color 120ms 0ms cubic-bezier(0, 0, 0.2, 1)}.mdc-radio__native-control:checked+.mdc-radio__background .mdc-radio__inner-circle,.mdc-radio__native-control:disabled+.mdc-radio__background .mdc-radio__inner-circle{transition:transform 120ms 0ms cubic-bezier(0, 0, 0.2, 1),border-color 120ms 0ms cubic-bezier(0, 0, 0.2, 1)}.mdc-radio--disabled{cursor:default;pointer-events:none}.mdc-radio__native-control:checked+.mdc-radio__background .mdc-radio__inner-circle{transform:scale(0.5);transition:transform 120ms 0ms cubic-bezier(0, 0, 0.2, 1),border-color 120ms 0ms cubic-bezier(0, 0, 0.2, 1)}.mdc-radio__native-control:disabled+.mdc-radio__background,[aria-disabled=true] .mdc-radio__native-control+.mdc-radio__background{cursor:default}.mdc-radio__native-control:focus+.mdc-radio__background::before{transform:scale(1);opacity:.12;transition:opacity 120ms 0ms cubic-bezier(0, 0, 0.2, 1),transform 120ms 0ms cubic-bezier(0, 0, 0.2, 1)}.mdc-form-field{display:inline-flex;align-items:center;vertical-align:middle}.mdc-form-field[hidden]{display:none}.mdc-form-field>label{margin-left:0;margin-right:auto;padding-left:4px;padding-right:0;order:0}[dir=rtl] .mdc-form-field>label,.mdc-form-field>label[dir=rtl]{margin-left:auto;margin-right:0}[dir=rtl] .mdc-form-field>label,.mdc-form-field>label[dir=rtl]{padding-left:0;padding-right:4px}.mdc-form-field--nowrap>label{text-overflow:ellipsis;overflow:hidden;white-space:nowrap}.mdc-form-field--align-end>label{margin-left:auto;margin-right:0;padding-left:0;padding-right:4px;order:-1}[dir=rtl] .mdc-form-field--align-end>label,.mdc-form-field--align-end>label[dir=rtl]{margin-left:0;margin-right:auto}[dir=rtl] .mdc-form-field--align-end>label,.mdc-form-field--align-end>label[dir=rtl]{padding-left:4px;padding-right:0}.mdc-form-field--space-between{justify-content:space-between}.mdc-form-field--space-between>label{margin:0}[dir=rtl] .mdc-form-field--space-between>label,.mdc-form-field--space-between>label[dir=rtl]{margin:0}.mat-mdc-radio-button{--mdc-radio-disabled-selected-icon-opacity:0.38;--mdc-radio-disabled-unselected-icon-opacity:0.38;--mdc-radio-state-layer-size:40px;-webkit-tap-highlight-color:rgba(0,0,0,0)}.mat-mdc-radio-button .mdc-radio{padding:calc((var(--mdc-radio-state-layer-size) - 20px) / 2)}.mat-mdc-radio-button .mdc-radio [aria-disabled=true] .mdc-radio__native-control:checked+.mdc-radio__background .mdc-radio__outer-circle,.mat-mdc-radio-button .mdc-radio .mdc-radio__native-control:disabled:checked+.mdc-radio__background .mdc-radio__outer-circle{border-color:var(--mdc-radio-disabled-selected-icon-color)}.mat-mdc-radio-button .mdc-radio [aria-disabled=true] .mdc-radio__native-control+.mdc-radio__background .mdc-radio__inner-circle,.mat-mdc-radio-button .mdc-radio .mdc-radio__native-control:disabled+.mdc-radio__background .mdc-radio__inner-circle{border-color:var(--mdc-radio-disabled-selected-icon-color)}.mat-mdc-radio-button .mdc-radio [aria-disabled=true] .mdc-radio__native-control:checked+.mdc-radio__background .mdc-radio__outer-circle,.mat-mdc-radio-button .mdc-radio .mdc-radio__native-control:disabled:checked+.mdc-radio__background .mdc-radio__outer-circle{opacity:var(--mdc-radio-disabled-selected-icon-opacity)}.mat-mdc-radio-button .mdc-radio [aria-disabled=true] .mdc-radio__native-control+.mdc-radio__background .mdc-radio__inner-circle,.mat-mdc-radio-button .mdc-radio .mdc-radio__native-control:disabled+.mdc-radio__background .mdc-radio__inner-circle{opacity:var(--mdc-radio-disabled-selected-icon-opacity)}.mat-mdc-radio-button .mdc-radio [aria-disabled=true] .mdc-radio__native-control:not(:checked)+.mdc-radio__background .mdc-radio__outer-circle,.mat-mdc-radio-button .mdc-radio .mdc-radio__native-control:disabled:not(:checked)+.mdc-radio__background .mdc-radio__outer-circle{border-color:var(--mdc-radio-disabled-unselected-icon-color)}.mat-mdc-radio-button .mdc-radio [aria-disabled=true] .mdc-radio__native-control:not(:checked)+.mdc-radio__background .mdc-radio__outer-circle,.mat-mdc-radio-button .mdc-radio .mdc-radio__native-control:disabled:not(:checked)+.mdc-radio__background .mdc-radio__outer-circle{opacity:var(--mdc-radio-disabled-unselected-icon-opacity)}.mat-mdc-radio-button .mdc-radio.mdc-ripple-upgraded--background-focused .mdc-radio__native-control:enabled:checked+.mdc-radio__background .mdc-radio__outer-circle,.mat-mdc-radio-button .mdc-radio:not(.mdc-ripple-upgraded):focus .mdc-radio__native-control:enabled:checked+.mdc-radio__background .mdc-radio__outer-circle{border-color:var(--mdc-radio-selected-focus-icon-color)}.mat-mdc-radio-button .mdc-radio.mdc-ripple-upgraded--background-focused .mdc-radio__native-control:enabled+.mdc-radio__background .mdc-radio__inner-circle,.mat-mdc-radio-button .mdc-radio:not(.mdc-ripple-upgraded):focus .mdc-radio__native-control:enabled+.mdc-radio__background .mdc-radio__inner-circle{border-color:var(--mdc-radio-selected-focus-icon-color)}.mat-mdc-radio-button .mdc-radio:hover .mdc-radio__native-control:enabled:checked+.mdc-radio__background .mdc-radio__outer-circle{border-color:var(--mdc-radio-selected-hover-icon-color)}.mat-mdc-radio-button .mdc-radio:hover .mdc-radio__native-control:enabled+.mdc-radio__background .mdc-radio__inner-circle{border-color:var(--mdc-radio-selected-hover-icon-color)}.mat-mdc-radio-button .mdc-radio .mdc-radio__native-control:enabled:checked+.mdc-radio__background .mdc-radio__outer-circle{border-color:var(--mdc-radio-selected-icon-color)}.mat-mdc-radio-button .mdc-radio .mdc-radio__native-control:enabled+.mdc-radio__background .mdc-radio__inner-circle{border-color:var(--mdc-radio-selected-icon-color)}.mat-mdc-radio-button .mdc-radio:not(:disabled):active .mdc-radio__native-control:enabled:checked+.mdc-radio__background .mdc-radio__outer-circle{border-color:var(--mdc-radio-selected-pressed-icon-color)}.mat-mdc-radio-button .mdc-radio:not(:disabled):active .mdc-radio__native-control:enabled+.mdc-radio__background .mdc-radio__inner-circle{border-color:var(--mdc-radio-selected-pressed-icon-color)}.mat-mdc-radio-button .mdc-radio:hover .mdc-radio__native-control:enabled:not(:checked)+.mdc-radio__background .mdc-radio__outer-circle{border-color:var(--mdc-radio-unselected-hover-icon-color)}.mat-mdc-radio-button .mdc-radio .mdc-radio__native-control:enabled:not(:checked)+.mdc-radio__background .mdc-radio__outer-circle{border-color:var(--mdc-radio-unselected-icon-color)}.mat-mdc-radio-button .mdc-radio:not(:disabled):active .mdc-radio__native-control:enabled:not(:checked)+.mdc-radio__background .mdc-radio__outer-circle{border-color:var(--mdc-radio-unselected-pressed-icon-color)}.mat-mdc-radio-button .mdc-radio .mdc-radio__background::before{top:calc(-1 * (var(--mdc-radio-state-layer-size) - 20px) / 2);left:calc(-1 * (var(--mdc-radio-state-layer-size) - 20px) / 2);width:var(--mdc-radio-state-layer-size);height:var(--mdc-radio-state-layer-size)}.mat-mdc-radio-button .mdc-radio .mdc-radio__native-control{top:calc((var(--mdc-radio-state-layer-size) - var(--mdc-radio-state-layer-size)) / 2);right:calc((var(--mdc-radio-state-layer-size) - var(--mdc-radio-state-layer-size)) / 2);left:calc((var(--mdc-radio-state-layer-size) - var(--mdc-radio-state-layer-size)) / 2);width:var(--mdc-radio-state-layer-size);height:var(--mdc-radio-state-layer-size)}.mat-mdc-radio-button .mdc-radio .mdc-radio__background::before{background-color:var(--mat-radio-ripple-color)}.mat-mdc-radio-button .mdc-radio:hover .mdc-radio__native-control:not([disabled]):not(:focus)~.mdc-radio__background::before{opacity:.04;transform:scale(1)}.mat-mdc-radio-button.mat-mdc-radio-checked .mdc-radio__background::before{background-color:var(--mat-radio-checked-ripple-color)}.mat-mdc-radio-button.mat-mdc-radio-checked .mat-ripple-element{background-color:var(--mat-radio-checked-ripple-color)}.mat-mdc-radio-button .mdc-radio--disabled+label{color:var(--mat-radio-disabled-label-color)}.mat-mdc-radio-button .mat-radio-ripple{top:0;left:0;right:0;bottom:0;position:absolute;pointer-events:none;border-radius:50%}.mat-mdc-radio-button .mat-radio-ripple .mat-ripple-element{opacity:.14}.mat-mdc-radio-button .mat-radio-ripple::before{border-radius:50%}.mat-mdc-radio-button._mat-animation-noopable .mdc-radio__background::before,.mat-mdc-radio-button._mat-animation-noopable .mdc-radio__outer-circle,.mat-mdc-radio-button._mat-animation-noopable .mdc-radio__inner-circle{transition:none !important}.mat-mdc-radio-button .mdc-radio .mdc-radio__native-control:focus:enabled:not(:checked)~.mdc-radio__background .mdc-radio__outer-circle{border-color:var(--mdc-radio-unselected-focus-icon-color, black)}.mat-mdc-radio-button.cdk-focused .mat-mdc-focus-indicator::before{content:""}.mat-mdc-radio-touch-target{position:absolute;top:50%;height:48px;left:50%;width:48px;transform:translate(-50%, -50%)}[dir=rtl] .mat-mdc-radio-touch-target{left:0;right:50%;transform:translate(50%, -50%)}'],encapsulation:2,changeDetection:0}),t})(),jne=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275mod=le({type:e}),e.\u0275inj=ae({imports:[ve,vn,is,ve]}),t})();const TP=["*"],Hne=["content"];function zne(e,t){if(1&e){const i=Ut();y(0,"div",2),$("click",function(){return Ye(i),Ke(B()._onBackdropClicked())}),w()}2&e&&se("mat-drawer-shown",B()._isShowingBackdrop())}function Une(e,t){1&e&&(y(0,"mat-drawer-content"),X(1,2),w())}const $ne=[[["mat-drawer"]],[["mat-drawer-content"]],"*"],qne=["mat-drawer","mat-drawer-content","*"],Gne={transformDrawer:ni("transform",[qt("open, open-instant",je({transform:"none",visibility:"visible"})),qt("void",je({"box-shadow":"none",visibility:"hidden"})),Bt("void => open-instant",Lt("0ms")),Bt("void <=> open, open-instant => void",Lt("400ms cubic-bezier(0.25, 0.8, 0.25, 1)"))])},Wne=new M("MAT_DRAWER_DEFAULT_AUTOSIZE",{providedIn:"root",factory:function Qne(){return!1}}),MP=new M("MAT_DRAWER_CONTAINER");let tm=(()=>{var e;class t extends ty{constructor(n,r,o,s,a){super(o,s,a),this._changeDetectorRef=n,this._container=r}ngAfterContentInit(){this._container._contentMarginChanges.subscribe(()=>{this._changeDetectorRef.markForCheck()})}}return(e=t).\u0275fac=function(n){return new(n||e)(m(Fe),m(He(()=>AP)),m(W),m(Gf),m(G))},e.\u0275cmp=fe({type:e,selectors:[["mat-drawer-content"]],hostAttrs:["ngSkipHydration","",1,"mat-drawer-content"],hostVars:4,hostBindings:function(n,r){2&n&&kn("margin-left",r._container._contentMargins.left,"px")("margin-right",r._container._contentMargins.right,"px")},features:[Z([{provide:ty,useExisting:e}]),P],ngContentSelectors:TP,decls:1,vars:0,template:function(n,r){1&n&&(ze(),X(0))},encapsulation:2,changeDetection:0}),t})(),IP=(()=>{var e;class t{get position(){return this._position}set position(n){(n="end"===n?"end":"start")!==this._position&&(this._isAttached&&this._updatePositionInParent(n),this._position=n,this.onPositionChanged.emit())}get mode(){return this._mode}set mode(n){this._mode=n,this._updateFocusTrapState(),this._modeChanged.next()}get disableClose(){return this._disableClose}set disableClose(n){this._disableClose=Q(n)}get autoFocus(){return this._autoFocus??("side"===this.mode?"dialog":"first-tabbable")}set autoFocus(n){("true"===n||"false"===n||null==n)&&(n=Q(n)),this._autoFocus=n}get opened(){return this._opened}set opened(n){this.toggle(Q(n))}constructor(n,r,o,s,a,c,l,d){this._elementRef=n,this._focusTrapFactory=r,this._focusMonitor=o,this._platform=s,this._ngZone=a,this._interactivityChecker=c,this._doc=l,this._container=d,this._elementFocusedBeforeDrawerWasOpened=null,this._enableAnimations=!1,this._position="start",this._mode="over",this._disableClose=!1,this._opened=!1,this._animationStarted=new Y,this._animationEnd=new Y,this._animationState="void",this.openedChange=new U(!0),this._openedStream=this.openedChange.pipe(Ve(u=>u),J(()=>{})),this.openedStart=this._animationStarted.pipe(Ve(u=>u.fromState!==u.toState&&0===u.toState.indexOf("open")),Uf(void 0)),this._closedStream=this.openedChange.pipe(Ve(u=>!u),J(()=>{})),this.closedStart=this._animationStarted.pipe(Ve(u=>u.fromState!==u.toState&&"void"===u.toState),Uf(void 0)),this._destroyed=new Y,this.onPositionChanged=new U,this._modeChanged=new Y,this.openedChange.subscribe(u=>{u?(this._doc&&(this._elementFocusedBeforeDrawerWasOpened=this._doc.activeElement),this._takeFocus()):this._isFocusWithinDrawer()&&this._restoreFocus(this._openedVia||"program")}),this._ngZone.runOutsideAngular(()=>{Vi(this._elementRef.nativeElement,"keydown").pipe(Ve(u=>27===u.keyCode&&!this.disableClose&&!An(u)),ce(this._destroyed)).subscribe(u=>this._ngZone.run(()=>{this.close(),u.stopPropagation(),u.preventDefault()}))}),this._animationEnd.pipe(Is((u,h)=>u.fromState===h.fromState&&u.toState===h.toState)).subscribe(u=>{const{fromState:h,toState:f}=u;(0===f.indexOf("open")&&"void"===h||"void"===f&&0===h.indexOf("open"))&&this.openedChange.emit(this._opened)})}_forceFocus(n,r){this._interactivityChecker.isFocusable(n)||(n.tabIndex=-1,this._ngZone.runOutsideAngular(()=>{const o=()=>{n.removeEventListener("blur",o),n.removeEventListener("mousedown",o),n.removeAttribute("tabindex")};n.addEventListener("blur",o),n.addEventListener("mousedown",o)})),n.focus(r)}_focusByCssSelector(n,r){let o=this._elementRef.nativeElement.querySelector(n);o&&this._forceFocus(o,r)}_takeFocus(){if(!this._focusTrap)return;const n=this._elementRef.nativeElement;switch(this.autoFocus){case!1:case"dialog":return;case!0:case"first-tabbable":this._focusTrap.focusInitialElementWhenReady().then(r=>{!r&&"function"==typeof this._elementRef.nativeElement.focus&&n.focus()});break;case"first-heading":this._focusByCssSelector('h1, h2, h3, h4, h5, h6, [role="heading"]');break;default:this._focusByCssSelector(this.autoFocus)}}_restoreFocus(n){"dialog"!==this.autoFocus&&(this._elementFocusedBeforeDrawerWasOpened?this._focusMonitor.focusVia(this._elementFocusedBeforeDrawerWasOpened,n):this._elementRef.nativeElement.blur(),this._elementFocusedBeforeDrawerWasOpened=null)}_isFocusWithinDrawer(){const n=this._doc.activeElement;return!!n&&this._elementRef.nativeElement.contains(n)}ngAfterViewInit(){this._isAttached=!0,this._focusTrap=this._focusTrapFactory.create(this._elementRef.nativeElement),this._updateFocusTrapState(),"end"===this._position&&this._updatePositionInParent("end")}ngAfterContentChecked(){this._platform.isBrowser&&(this._enableAnimations=!0)}ngOnDestroy(){this._focusTrap&&this._focusTrap.destroy(),this._anchor?.remove(),this._anchor=null,this._animationStarted.complete(),this._animationEnd.complete(),this._modeChanged.complete(),this._destroyed.next(),this._destroyed.complete()}open(n){return this.toggle(!0,n)}close(){return this.toggle(!1)}_closeViaBackdropClick(){return this._setOpen(!1,!0,"mouse")}toggle(n=!this.opened,r){n&&r&&(this._openedVia=r);const o=this._setOpen(n,!n&&this._isFocusWithinDrawer(),this._openedVia||"program");return n||(this._openedVia=null),o}_setOpen(n,r,o){return this._opened=n,n?this._animationState=this._enableAnimations?"open":"open-instant":(this._animationState="void",r&&this._restoreFocus(o)),this._updateFocusTrapState(),new Promise(s=>{this.openedChange.pipe(Xe(1)).subscribe(a=>s(a?"open":"close"))})}_getWidth(){return this._elementRef.nativeElement&&this._elementRef.nativeElement.offsetWidth||0}_updateFocusTrapState(){this._focusTrap&&(this._focusTrap.enabled=!!this._container?.hasBackdrop)}_updatePositionInParent(n){const r=this._elementRef.nativeElement,o=r.parentNode;"end"===n?(this._anchor||(this._anchor=this._doc.createComment("mat-drawer-anchor"),o.insertBefore(this._anchor,r)),o.appendChild(r)):this._anchor&&this._anchor.parentNode.insertBefore(r,this._anchor)}}return(e=t).\u0275fac=function(n){return new(n||e)(m(W),m(V7),m(ar),m(nt),m(G),m(LI),m(he,8),m(MP,8))},e.\u0275cmp=fe({type:e,selectors:[["mat-drawer"]],viewQuery:function(n,r){if(1&n&&ke(Hne,5),2&n){let o;H(o=z())&&(r._content=o.first)}},hostAttrs:["tabIndex","-1","ngSkipHydration","",1,"mat-drawer"],hostVars:12,hostBindings:function(n,r){1&n&&gh("@transform.start",function(s){return r._animationStarted.next(s)})("@transform.done",function(s){return r._animationEnd.next(s)}),2&n&&(de("align",null),yh("@transform",r._animationState),se("mat-drawer-end","end"===r.position)("mat-drawer-over","over"===r.mode)("mat-drawer-push","push"===r.mode)("mat-drawer-side","side"===r.mode)("mat-drawer-opened",r.opened))},inputs:{position:"position",mode:"mode",disableClose:"disableClose",autoFocus:"autoFocus",opened:"opened"},outputs:{openedChange:"openedChange",_openedStream:"opened",openedStart:"openedStart",_closedStream:"closed",closedStart:"closedStart",onPositionChanged:"positionChanged"},exportAs:["matDrawer"],ngContentSelectors:TP,decls:3,vars:0,consts:[["cdkScrollable","",1,"mat-drawer-inner-container"],["content",""]],template:function(n,r){1&n&&(ze(),y(0,"div",0,1),X(2),w())},dependencies:[ty],encapsulation:2,data:{animation:[Gne.transformDrawer]},changeDetection:0}),t})(),AP=(()=>{var e;class t{get start(){return this._start}get end(){return this._end}get autosize(){return this._autosize}set autosize(n){this._autosize=Q(n)}get hasBackdrop(){return this._drawerHasBackdrop(this._start)||this._drawerHasBackdrop(this._end)}set hasBackdrop(n){this._backdropOverride=null==n?null:Q(n)}get scrollable(){return this._userContent||this._content}constructor(n,r,o,s,a,c=!1,l){this._dir=n,this._element=r,this._ngZone=o,this._changeDetectorRef=s,this._animationMode=l,this._drawers=new Cr,this.backdropClick=new U,this._destroyed=new Y,this._doCheckSubject=new Y,this._contentMargins={left:null,right:null},this._contentMarginChanges=new Y,n&&n.change.pipe(ce(this._destroyed)).subscribe(()=>{this._validateDrawers(),this.updateContentMargins()}),a.change().pipe(ce(this._destroyed)).subscribe(()=>this.updateContentMargins()),this._autosize=c}ngAfterContentInit(){this._allDrawers.changes.pipe(tn(this._allDrawers),ce(this._destroyed)).subscribe(n=>{this._drawers.reset(n.filter(r=>!r._container||r._container===this)),this._drawers.notifyOnChanges()}),this._drawers.changes.pipe(tn(null)).subscribe(()=>{this._validateDrawers(),this._drawers.forEach(n=>{this._watchDrawerToggle(n),this._watchDrawerPosition(n),this._watchDrawerMode(n)}),(!this._drawers.length||this._isDrawerOpen(this._start)||this._isDrawerOpen(this._end))&&this.updateContentMargins(),this._changeDetectorRef.markForCheck()}),this._ngZone.runOutsideAngular(()=>{this._doCheckSubject.pipe(Tf(10),ce(this._destroyed)).subscribe(()=>this.updateContentMargins())})}ngOnDestroy(){this._contentMarginChanges.complete(),this._doCheckSubject.complete(),this._drawers.destroy(),this._destroyed.next(),this._destroyed.complete()}open(){this._drawers.forEach(n=>n.open())}close(){this._drawers.forEach(n=>n.close())}updateContentMargins(){let n=0,r=0;if(this._left&&this._left.opened)if("side"==this._left.mode)n+=this._left._getWidth();else if("push"==this._left.mode){const o=this._left._getWidth();n+=o,r-=o}if(this._right&&this._right.opened)if("side"==this._right.mode)r+=this._right._getWidth();else if("push"==this._right.mode){const o=this._right._getWidth();r+=o,n-=o}n=n||null,r=r||null,(n!==this._contentMargins.left||r!==this._contentMargins.right)&&(this._contentMargins={left:n,right:r},this._ngZone.run(()=>this._contentMarginChanges.next(this._contentMargins)))}ngDoCheck(){this._autosize&&this._isPushed()&&this._ngZone.runOutsideAngular(()=>this._doCheckSubject.next())}_watchDrawerToggle(n){n._animationStarted.pipe(Ve(r=>r.fromState!==r.toState),ce(this._drawers.changes)).subscribe(r=>{"open-instant"!==r.toState&&"NoopAnimations"!==this._animationMode&&this._element.nativeElement.classList.add("mat-drawer-transition"),this.updateContentMargins(),this._changeDetectorRef.markForCheck()}),"side"!==n.mode&&n.openedChange.pipe(ce(this._drawers.changes)).subscribe(()=>this._setContainerClass(n.opened))}_watchDrawerPosition(n){n&&n.onPositionChanged.pipe(ce(this._drawers.changes)).subscribe(()=>{this._ngZone.onMicrotaskEmpty.pipe(Xe(1)).subscribe(()=>{this._validateDrawers()})})}_watchDrawerMode(n){n&&n._modeChanged.pipe(ce(Ot(this._drawers.changes,this._destroyed))).subscribe(()=>{this.updateContentMargins(),this._changeDetectorRef.markForCheck()})}_setContainerClass(n){const r=this._element.nativeElement.classList,o="mat-drawer-container-has-open";n?r.add(o):r.remove(o)}_validateDrawers(){this._start=this._end=null,this._drawers.forEach(n=>{"end"==n.position?this._end=n:this._start=n}),this._right=this._left=null,this._dir&&"rtl"===this._dir.value?(this._left=this._end,this._right=this._start):(this._left=this._start,this._right=this._end)}_isPushed(){return this._isDrawerOpen(this._start)&&"over"!=this._start.mode||this._isDrawerOpen(this._end)&&"over"!=this._end.mode}_onBackdropClicked(){this.backdropClick.emit(),this._closeModalDrawersViaBackdrop()}_closeModalDrawersViaBackdrop(){[this._start,this._end].filter(n=>n&&!n.disableClose&&this._drawerHasBackdrop(n)).forEach(n=>n._closeViaBackdropClick())}_isShowingBackdrop(){return this._isDrawerOpen(this._start)&&this._drawerHasBackdrop(this._start)||this._isDrawerOpen(this._end)&&this._drawerHasBackdrop(this._end)}_isDrawerOpen(n){return null!=n&&n.opened}_drawerHasBackdrop(n){return null==this._backdropOverride?!!n&&"side"!==n.mode:this._backdropOverride}}return(e=t).\u0275fac=function(n){return new(n||e)(m(fn,8),m(W),m(G),m(Fe),m(Rr),m(Wne),m(_t,8))},e.\u0275cmp=fe({type:e,selectors:[["mat-drawer-container"]],contentQueries:function(n,r,o){if(1&n&&(Ee(o,tm,5),Ee(o,IP,5)),2&n){let s;H(s=z())&&(r._content=s.first),H(s=z())&&(r._allDrawers=s)}},viewQuery:function(n,r){if(1&n&&ke(tm,5),2&n){let o;H(o=z())&&(r._userContent=o.first)}},hostAttrs:["ngSkipHydration","",1,"mat-drawer-container"],hostVars:2,hostBindings:function(n,r){2&n&&se("mat-drawer-container-explicit-backdrop",r._backdropOverride)},inputs:{autosize:"autosize",hasBackdrop:"hasBackdrop"},outputs:{backdropClick:"backdropClick"},exportAs:["matDrawerContainer"],features:[Z([{provide:MP,useExisting:e}])],ngContentSelectors:qne,decls:4,vars:2,consts:[["class","mat-drawer-backdrop",3,"mat-drawer-shown","click",4,"ngIf"],[4,"ngIf"],[1,"mat-drawer-backdrop",3,"click"]],template:function(n,r){1&n&&(ze($ne),R(0,zne,1,2,"div",0),X(1),X(2,1),R(3,Une,2,0,"mat-drawer-content",1)),2&n&&(E("ngIf",r.hasBackdrop),x(3),E("ngIf",!r._content))},dependencies:[_i,tm],styles:['.mat-drawer-container{position:relative;z-index:1;color:var(--mat-sidenav-content-text-color);background-color:var(--mat-sidenav-content-background-color);box-sizing:border-box;-webkit-overflow-scrolling:touch;display:block;overflow:hidden}.mat-drawer-container[fullscreen]{top:0;left:0;right:0;bottom:0;position:absolute}.mat-drawer-container[fullscreen].mat-drawer-container-has-open{overflow:hidden}.mat-drawer-container.mat-drawer-container-explicit-backdrop .mat-drawer-side{z-index:3}.mat-drawer-container.ng-animate-disabled .mat-drawer-backdrop,.mat-drawer-container.ng-animate-disabled .mat-drawer-content,.ng-animate-disabled .mat-drawer-container .mat-drawer-backdrop,.ng-animate-disabled .mat-drawer-container .mat-drawer-content{transition:none}.mat-drawer-backdrop{top:0;left:0;right:0;bottom:0;position:absolute;display:block;z-index:3;visibility:hidden}.mat-drawer-backdrop.mat-drawer-shown{visibility:visible;background-color:var(--mat-sidenav-scrim-color)}.mat-drawer-transition .mat-drawer-backdrop{transition-duration:400ms;transition-timing-function:cubic-bezier(0.25, 0.8, 0.25, 1);transition-property:background-color,visibility}.cdk-high-contrast-active .mat-drawer-backdrop{opacity:.5}.mat-drawer-content{position:relative;z-index:1;display:block;height:100%;overflow:auto}.mat-drawer-transition .mat-drawer-content{transition-duration:400ms;transition-timing-function:cubic-bezier(0.25, 0.8, 0.25, 1);transition-property:transform,margin-left,margin-right}.mat-drawer{box-shadow:0px 8px 10px -5px rgba(0, 0, 0, 0.2), 0px 16px 24px 2px rgba(0, 0, 0, 0.14), 0px 6px 30px 5px rgba(0, 0, 0, 0.12);position:relative;z-index:4;--mat-sidenav-container-shape:0;color:var(--mat-sidenav-container-text-color);background-color:var(--mat-sidenav-container-background-color);border-top-right-radius:var(--mat-sidenav-container-shape);border-bottom-right-radius:var(--mat-sidenav-container-shape);display:block;position:absolute;top:0;bottom:0;z-index:3;outline:0;box-sizing:border-box;overflow-y:auto;transform:translate3d(-100%, 0, 0)}.cdk-high-contrast-active .mat-drawer,.cdk-high-contrast-active [dir=rtl] .mat-drawer.mat-drawer-end{border-right:solid 1px currentColor}.cdk-high-contrast-active [dir=rtl] .mat-drawer,.cdk-high-contrast-active .mat-drawer.mat-drawer-end{border-left:solid 1px currentColor;border-right:none}.mat-drawer.mat-drawer-side{z-index:2}.mat-drawer.mat-drawer-end{right:0;transform:translate3d(100%, 0, 0);border-top-left-radius:var(--mat-sidenav-container-shape);border-bottom-left-radius:var(--mat-sidenav-container-shape);border-top-right-radius:0;border-bottom-right-radius:0}[dir=rtl] .mat-drawer{border-top-left-radius:var(--mat-sidenav-container-shape);border-bottom-left-radius:var(--mat-sidenav-container-shape);border-top-right-radius:0;border-bottom-right-radius:0;transform:translate3d(100%, 0, 0)}[dir=rtl] .mat-drawer.mat-drawer-end{border-top-right-radius:var(--mat-sidenav-container-shape);border-bottom-right-radius:var(--mat-sidenav-container-shape);border-top-left-radius:0;border-bottom-left-radius:0;left:0;right:auto;transform:translate3d(-100%, 0, 0)}.mat-drawer[style*="visibility: hidden"]{display:none}.mat-drawer-side{box-shadow:none;border-right-color:var(--mat-sidenav-container-divider-color);border-right-width:1px;border-right-style:solid}.mat-drawer-side.mat-drawer-end{border-left-color:var(--mat-sidenav-container-divider-color);border-left-width:1px;border-left-style:solid;border-right:none}[dir=rtl] .mat-drawer-side{border-left-color:var(--mat-sidenav-container-divider-color);border-left-width:1px;border-left-style:solid;border-right:none}[dir=rtl] .mat-drawer-side.mat-drawer-end{border-right-color:var(--mat-sidenav-container-divider-color);border-right-width:1px;border-right-style:solid;border-left:none}.mat-drawer-inner-container{width:100%;height:100%;overflow:auto;-webkit-overflow-scrolling:touch}.mat-sidenav-fixed{position:fixed}'],encapsulation:2,changeDetection:0}),t})(),Yne=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275mod=le({type:e}),e.\u0275inj=ae({imports:[vn,ve,lo,lo,ve]}),t})();const Kne=[[["caption"]],[["colgroup"],["col"]]],Xne=["caption","colgroup, col"];function cw(e){return class extends e{get sticky(){return this._sticky}set sticky(t){const i=this._sticky;this._sticky=Q(t),this._hasStickyChanged=i!==this._sticky}hasStickyChanged(){const t=this._hasStickyChanged;return this._hasStickyChanged=!1,t}resetStickyChanged(){this._hasStickyChanged=!1}constructor(...t){super(...t),this._sticky=!1,this._hasStickyChanged=!1}}}const fc=new M("CDK_TABLE");let pc=(()=>{var e;class t{constructor(n){this.template=n}}return(e=t).\u0275fac=function(n){return new(n||e)(m(ct))},e.\u0275dir=T({type:e,selectors:[["","cdkCellDef",""]]}),t})(),mc=(()=>{var e;class t{constructor(n){this.template=n}}return(e=t).\u0275fac=function(n){return new(n||e)(m(ct))},e.\u0275dir=T({type:e,selectors:[["","cdkHeaderCellDef",""]]}),t})(),nm=(()=>{var e;class t{constructor(n){this.template=n}}return(e=t).\u0275fac=function(n){return new(n||e)(m(ct))},e.\u0275dir=T({type:e,selectors:[["","cdkFooterCellDef",""]]}),t})();class tie{}const nie=cw(tie);let Lr=(()=>{var e;class t extends nie{get name(){return this._name}set name(n){this._setNameInput(n)}get stickyEnd(){return this._stickyEnd}set stickyEnd(n){const r=this._stickyEnd;this._stickyEnd=Q(n),this._hasStickyChanged=r!==this._stickyEnd}constructor(n){super(),this._table=n,this._stickyEnd=!1}_updateColumnCssClassName(){this._columnCssClassName=[`cdk-column-${this.cssClassFriendlyName}`]}_setNameInput(n){n&&(this._name=n,this.cssClassFriendlyName=n.replace(/[^a-z0-9_-]/gi,"-"),this._updateColumnCssClassName())}}return(e=t).\u0275fac=function(n){return new(n||e)(m(fc,8))},e.\u0275dir=T({type:e,selectors:[["","cdkColumnDef",""]],contentQueries:function(n,r,o){if(1&n&&(Ee(o,pc,5),Ee(o,mc,5),Ee(o,nm,5)),2&n){let s;H(s=z())&&(r.cell=s.first),H(s=z())&&(r.headerCell=s.first),H(s=z())&&(r.footerCell=s.first)}},inputs:{sticky:"sticky",name:["cdkColumnDef","name"],stickyEnd:"stickyEnd"},features:[Z([{provide:"MAT_SORT_HEADER_COLUMN_DEF",useExisting:e}]),P]}),t})();class lw{constructor(t,i){i.nativeElement.classList.add(...t._columnCssClassName)}}let dw=(()=>{var e;class t extends lw{constructor(n,r){super(n,r)}}return(e=t).\u0275fac=function(n){return new(n||e)(m(Lr),m(W))},e.\u0275dir=T({type:e,selectors:[["cdk-header-cell"],["th","cdk-header-cell",""]],hostAttrs:["role","columnheader",1,"cdk-header-cell"],features:[P]}),t})(),uw=(()=>{var e;class t extends lw{constructor(n,r){if(super(n,r),1===n._table?._elementRef.nativeElement.nodeType){const o=n._table._elementRef.nativeElement.getAttribute("role");r.nativeElement.setAttribute("role","grid"===o||"treegrid"===o?"gridcell":"cell")}}}return(e=t).\u0275fac=function(n){return new(n||e)(m(Lr),m(W))},e.\u0275dir=T({type:e,selectors:[["cdk-cell"],["td","cdk-cell",""]],hostAttrs:[1,"cdk-cell"],features:[P]}),t})();class OP{constructor(){this.tasks=[],this.endTasks=[]}}const hw=new M("_COALESCED_STYLE_SCHEDULER");let FP=(()=>{var e;class t{constructor(n){this._ngZone=n,this._currentSchedule=null,this._destroyed=new Y}schedule(n){this._createScheduleIfNeeded(),this._currentSchedule.tasks.push(n)}scheduleEnd(n){this._createScheduleIfNeeded(),this._currentSchedule.endTasks.push(n)}ngOnDestroy(){this._destroyed.next(),this._destroyed.complete()}_createScheduleIfNeeded(){this._currentSchedule||(this._currentSchedule=new OP,this._getScheduleObservable().pipe(ce(this._destroyed)).subscribe(()=>{for(;this._currentSchedule.tasks.length||this._currentSchedule.endTasks.length;){const n=this._currentSchedule;this._currentSchedule=new OP;for(const r of n.tasks)r();for(const r of n.endTasks)r()}this._currentSchedule=null}))}_getScheduleObservable(){return this._ngZone.isStable?Et(Promise.resolve(void 0)):this._ngZone.onStable.pipe(Xe(1))}}return(e=t).\u0275fac=function(n){return new(n||e)(D(G))},e.\u0275prov=V({token:e,factory:e.\u0275fac}),t})(),fw=(()=>{var e;class t{constructor(n,r){this.template=n,this._differs=r}ngOnChanges(n){if(!this._columnsDiffer){const r=n.columns&&n.columns.currentValue||[];this._columnsDiffer=this._differs.find(r).create(),this._columnsDiffer.diff(r)}}getColumnsDiff(){return this._columnsDiffer.diff(this.columns)}extractCellTemplate(n){return this instanceof Hd?n.headerCell.template:this instanceof zd?n.footerCell.template:n.cell.template}}return(e=t).\u0275fac=function(n){return new(n||e)(m(ct),m(Dr))},e.\u0275dir=T({type:e,features:[kt]}),t})();class iie extends fw{}const rie=cw(iie);let Hd=(()=>{var e;class t extends rie{constructor(n,r,o){super(n,r),this._table=o}ngOnChanges(n){super.ngOnChanges(n)}}return(e=t).\u0275fac=function(n){return new(n||e)(m(ct),m(Dr),m(fc,8))},e.\u0275dir=T({type:e,selectors:[["","cdkHeaderRowDef",""]],inputs:{columns:["cdkHeaderRowDef","columns"],sticky:["cdkHeaderRowDefSticky","sticky"]},features:[P,kt]}),t})();class oie extends fw{}const sie=cw(oie);let zd=(()=>{var e;class t extends sie{constructor(n,r,o){super(n,r),this._table=o}ngOnChanges(n){super.ngOnChanges(n)}}return(e=t).\u0275fac=function(n){return new(n||e)(m(ct),m(Dr),m(fc,8))},e.\u0275dir=T({type:e,selectors:[["","cdkFooterRowDef",""]],inputs:{columns:["cdkFooterRowDef","columns"],sticky:["cdkFooterRowDefSticky","sticky"]},features:[P,kt]}),t})(),im=(()=>{var e;class t extends fw{constructor(n,r,o){super(n,r),this._table=o}}return(e=t).\u0275fac=function(n){return new(n||e)(m(ct),m(Dr),m(fc,8))},e.\u0275dir=T({type:e,selectors:[["","cdkRowDef",""]],inputs:{columns:["cdkRowDefColumns","columns"],when:["cdkRowDefWhen","when"]},features:[P]}),t})(),Br=(()=>{var e;class t{constructor(n){this._viewContainer=n,t.mostRecentCellOutlet=this}ngOnDestroy(){t.mostRecentCellOutlet===this&&(t.mostRecentCellOutlet=null)}}return(e=t).mostRecentCellOutlet=null,e.\u0275fac=function(n){return new(n||e)(m(pt))},e.\u0275dir=T({type:e,selectors:[["","cdkCellOutlet",""]]}),t})(),pw=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275cmp=fe({type:e,selectors:[["cdk-header-row"],["tr","cdk-header-row",""]],hostAttrs:["role","row",1,"cdk-header-row"],decls:1,vars:0,consts:[["cdkCellOutlet",""]],template:function(n,r){1&n&&nr(0,0)},dependencies:[Br],encapsulation:2}),t})(),gw=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275cmp=fe({type:e,selectors:[["cdk-row"],["tr","cdk-row",""]],hostAttrs:["role","row",1,"cdk-row"],decls:1,vars:0,consts:[["cdkCellOutlet",""]],template:function(n,r){1&n&&nr(0,0)},dependencies:[Br],encapsulation:2}),t})(),rm=(()=>{var e;class t{constructor(n){this.templateRef=n,this._contentClassName="cdk-no-data-row"}}return(e=t).\u0275fac=function(n){return new(n||e)(m(ct))},e.\u0275dir=T({type:e,selectors:[["ng-template","cdkNoDataRow",""]]}),t})();const PP=["top","bottom","left","right"];class aie{constructor(t,i,n,r,o=!0,s=!0,a){this._isNativeHtmlTable=t,this._stickCellCss=i,this.direction=n,this._coalescedStyleScheduler=r,this._isBrowser=o,this._needsPositionStickyOnElement=s,this._positionListener=a,this._cachedCellWidths=[],this._borderCellCss={top:`${i}-border-elem-top`,bottom:`${i}-border-elem-bottom`,left:`${i}-border-elem-left`,right:`${i}-border-elem-right`}}clearStickyPositioning(t,i){const n=[];for(const r of t)if(r.nodeType===r.ELEMENT_NODE){n.push(r);for(let o=0;o<r.children.length;o++)n.push(r.children[o])}this._coalescedStyleScheduler.schedule(()=>{for(const r of n)this._removeStickyStyle(r,i)})}updateStickyColumns(t,i,n,r=!0){if(!t.length||!this._isBrowser||!i.some(h=>h)&&!n.some(h=>h))return void(this._positionListener&&(this._positionListener.stickyColumnsUpdated({sizes:[]}),this._positionListener.stickyEndColumnsUpdated({sizes:[]})));const o=t[0],s=o.children.length,a=this._getCellWidths(o,r),c=this._getStickyStartColumnPositions(a,i),l=this._getStickyEndColumnPositions(a,n),d=i.lastIndexOf(!0),u=n.indexOf(!0);this._coalescedStyleScheduler.schedule(()=>{const h="rtl"===this.direction,f=h?"right":"left",p=h?"left":"right";for(const g of t)for(let b=0;b<s;b++){const _=g.children[b];i[b]&&this._addStickyStyle(_,f,c[b],b===d),n[b]&&this._addStickyStyle(_,p,l[b],b===u)}this._positionListener&&(this._positionListener.stickyColumnsUpdated({sizes:-1===d?[]:a.slice(0,d+1).map((g,b)=>i[b]?g:null)}),this._positionListener.stickyEndColumnsUpdated({sizes:-1===u?[]:a.slice(u).map((g,b)=>n[b+u]?g:null).reverse()}))})}stickRows(t,i,n){if(!this._isBrowser)return;const r="bottom"===n?t.slice().reverse():t,o="bottom"===n?i.slice().reverse():i,s=[],a=[],c=[];for(let d=0,u=0;d<r.length;d++){if(!o[d])continue;s[d]=u;const h=r[d];c[d]=this._isNativeHtmlTable?Array.from(h.children):[h];const f=h.getBoundingClientRect().height;u+=f,a[d]=f}const l=o.lastIndexOf(!0);this._coalescedStyleScheduler.schedule(()=>{for(let d=0;d<r.length;d++){if(!o[d])continue;const u=s[d],h=d===l;for(const f of c[d])this._addStickyStyle(f,n,u,h)}"top"===n?this._positionListener?.stickyHeaderRowsUpdated({sizes:a,offsets:s,elements:c}):this._positionListener?.stickyFooterRowsUpdated({sizes:a,offsets:s,elements:c})})}updateStickyFooterContainer(t,i){if(!this._isNativeHtmlTable)return;const n=t.querySelector("tfoot");this._coalescedStyleScheduler.schedule(()=>{i.some(r=>!r)?this._removeStickyStyle(n,["bottom"]):this._addStickyStyle(n,"bottom",0,!1)})}_removeStickyStyle(t,i){for(const r of i)t.style[r]="",t.classList.remove(this._borderCellCss[r]);PP.some(r=>-1===i.indexOf(r)&&t.style[r])?t.style.zIndex=this._getCalculatedZIndex(t):(t.style.zIndex="",this._needsPositionStickyOnElement&&(t.style.position=""),t.classList.remove(this._stickCellCss))}_addStickyStyle(t,i,n,r){t.classList.add(this._stickCellCss),r&&t.classList.add(this._borderCellCss[i]),t.style[i]=`${n}px`,t.style.zIndex=this._getCalculatedZIndex(t),this._needsPositionStickyOnElement&&(t.style.cssText+="position: -webkit-sticky; position: sticky; ")}_getCalculatedZIndex(t){const i={top:100,bottom:10,left:1,right:1};let n=0;for(const r of PP)t.style[r]&&(n+=i[r]);return n?`${n}`:""}_getCellWidths(t,i=!0){if(!i&&this._cachedCellWidths.length)return this._cachedCellWidths;const n=[],r=t.children;for(let o=0;o<r.length;o++)n.push(r[o].getBoundingClientRect().width);return this._cachedCellWidths=n,n}_getStickyStartColumnPositions(t,i){const n=[];let r=0;for(let o=0;o<t.length;o++)i[o]&&(n[o]=r,r+=t[o]);return n}_getStickyEndColumnPositions(t,i){const n=[];let r=0;for(let o=t.length;o>0;o--)i[o]&&(n[o]=r,r+=t[o]);return n}}const _w=new M("CDK_SPL");let om=(()=>{var e;class t{constructor(n,r){this.viewContainer=n,this.elementRef=r}}return(e=t).\u0275fac=function(n){return new(n||e)(m(pt),m(W))},e.\u0275dir=T({type:e,selectors:[["","rowOutlet",""]]}),t})(),sm=(()=>{var e;class t{constructor(n,r){this.viewContainer=n,this.elementRef=r}}return(e=t).\u0275fac=function(n){return new(n||e)(m(pt),m(W))},e.\u0275dir=T({type:e,selectors:[["","headerRowOutlet",""]]}),t})(),am=(()=>{var e;class t{constructor(n,r){this.viewContainer=n,this.elementRef=r}}return(e=t).\u0275fac=function(n){return new(n||e)(m(pt),m(W))},e.\u0275dir=T({type:e,selectors:[["","footerRowOutlet",""]]}),t})(),cm=(()=>{var e;class t{constructor(n,r){this.viewContainer=n,this.elementRef=r}}return(e=t).\u0275fac=function(n){return new(n||e)(m(pt),m(W))},e.\u0275dir=T({type:e,selectors:[["","noDataRowOutlet",""]]}),t})(),lm=(()=>{var e;class t{get trackBy(){return this._trackByFn}set trackBy(n){this._trackByFn=n}get dataSource(){return this._dataSource}set dataSource(n){this._dataSource!==n&&this._switchDataSource(n)}get multiTemplateDataRows(){return this._multiTemplateDataRows}set multiTemplateDataRows(n){this._multiTemplateDataRows=Q(n),this._rowOutlet&&this._rowOutlet.viewContainer.length&&(this._forceRenderDataRows(),this.updateStickyColumnStyles())}get fixedLayout(){return this._fixedLayout}set fixedLayout(n){this._fixedLayout=Q(n),this._forceRecalculateCellWidths=!0,this._stickyColumnStylesNeedReset=!0}constructor(n,r,o,s,a,c,l,d,u,h,f,p){this._differs=n,this._changeDetectorRef=r,this._elementRef=o,this._dir=a,this._platform=l,this._viewRepeater=d,this._coalescedStyleScheduler=u,this._viewportRuler=h,this._stickyPositioningListener=f,this._ngZone=p,this._onDestroy=new Y,this._columnDefsByName=new Map,this._customColumnDefs=new Set,this._customRowDefs=new Set,this._customHeaderRowDefs=new Set,this._customFooterRowDefs=new Set,this._headerRowDefChanged=!0,this._footerRowDefChanged=!0,this._stickyColumnStylesNeedReset=!0,this._forceRecalculateCellWidths=!0,this._cachedRenderRowsMap=new Map,this.stickyCssClass="cdk-table-sticky",this.needsPositionStickyOnElement=!0,this._isShowingNoDataRow=!1,this._multiTemplateDataRows=!1,this._fixedLayout=!1,this.contentChanged=new U,this.viewChange=new dt({start:0,end:Number.MAX_VALUE}),s||this._elementRef.nativeElement.setAttribute("role","table"),this._document=c,this._isNativeHtmlTable="TABLE"===this._elementRef.nativeElement.nodeName}ngOnInit(){this._setupStickyStyler(),this._isNativeHtmlTable&&this._applyNativeTableSections(),this._dataDiffer=this._differs.find([]).create((n,r)=>this.trackBy?this.trackBy(r.dataIndex,r.data):r),this._viewportRuler.change().pipe(ce(this._onDestroy)).subscribe(()=>{this._forceRecalculateCellWidths=!0})}ngAfterContentChecked(){this._cacheRowDefs(),this._cacheColumnDefs();const r=this._renderUpdatedColumns()||this._headerRowDefChanged||this._footerRowDefChanged;this._stickyColumnStylesNeedReset=this._stickyColumnStylesNeedReset||r,this._forceRecalculateCellWidths=r,this._headerRowDefChanged&&(this._forceRenderHeaderRows(),this._headerRowDefChanged=!1),this._footerRowDefChanged&&(this._forceRenderFooterRows(),this._footerRowDefChanged=!1),this.dataSource&&this._rowDefs.length>0&&!this._renderChangeSubscription?this._observeRenderChanges():this._stickyColumnStylesNeedReset&&this.updateStickyColumnStyles(),this._checkStickyStates()}ngOnDestroy(){[this._rowOutlet.viewContainer,this._headerRowOutlet.viewContainer,this._footerRowOutlet.viewContainer,this._cachedRenderRowsMap,this._customColumnDefs,this._customRowDefs,this._customHeaderRowDefs,this._customFooterRowDefs,this._columnDefsByName].forEach(n=>{n.clear()}),this._headerRowDefs=[],this._footerRowDefs=[],this._defaultRowDef=null,this._onDestroy.next(),this._onDestroy.complete(),Zy(this.dataSource)&&this.dataSource.disconnect(this)}renderRows(){this._renderRows=this._getAllRenderRows();const n=this._dataDiffer.diff(this._renderRows);if(!n)return this._updateNoDataRow(),void this.contentChanged.next();const r=this._rowOutlet.viewContainer;this._viewRepeater.applyChanges(n,r,(o,s,a)=>this._getEmbeddedViewArgs(o.item,a),o=>o.item.data,o=>{1===o.operation&&o.context&&this._renderCellTemplateForItem(o.record.item.rowDef,o.context)}),this._updateRowIndexContext(),n.forEachIdentityChange(o=>{r.get(o.currentIndex).context.$implicit=o.item.data}),this._updateNoDataRow(),this._ngZone&&G.isInAngularZone()?this._ngZone.onStable.pipe(Xe(1),ce(this._onDestroy)).subscribe(()=>{this.updateStickyColumnStyles()}):this.updateStickyColumnStyles(),this.contentChanged.next()}addColumnDef(n){this._customColumnDefs.add(n)}removeColumnDef(n){this._customColumnDefs.delete(n)}addRowDef(n){this._customRowDefs.add(n)}removeRowDef(n){this._customRowDefs.delete(n)}addHeaderRowDef(n){this._customHeaderRowDefs.add(n),this._headerRowDefChanged=!0}removeHeaderRowDef(n){this._customHeaderRowDefs.delete(n),this._headerRowDefChanged=!0}addFooterRowDef(n){this._customFooterRowDefs.add(n),this._footerRowDefChanged=!0}removeFooterRowDef(n){this._customFooterRowDefs.delete(n),this._footerRowDefChanged=!0}setNoDataRow(n){this._customNoDataRow=n}updateStickyHeaderRowStyles(){const n=this._getRenderedRows(this._headerRowOutlet),o=this._elementRef.nativeElement.querySelector("thead");o&&(o.style.display=n.length?"":"none");const s=this._headerRowDefs.map(a=>a.sticky);this._stickyStyler.clearStickyPositioning(n,["top"]),this._stickyStyler.stickRows(n,s,"top"),this._headerRowDefs.forEach(a=>a.resetStickyChanged())}updateStickyFooterRowStyles(){const n=this._getRenderedRows(this._footerRowOutlet),o=this._elementRef.nativeElement.querySelector("tfoot");o&&(o.style.display=n.length?"":"none");const s=this._footerRowDefs.map(a=>a.sticky);this._stickyStyler.clearStickyPositioning(n,["bottom"]),this._stickyStyler.stickRows(n,s,"bottom"),this._stickyStyler.updateStickyFooterContainer(this._elementRef.nativeElement,s),this._footerRowDefs.forEach(a=>a.resetStickyChanged())}updateStickyColumnStyles(){const n=this._getRenderedRows(this._headerRowOutlet),r=this._getRenderedRows(this._rowOutlet),o=this._getRenderedRows(this._footerRowOutlet);(this._isNativeHtmlTable&&!this._fixedLayout||this._stickyColumnStylesNeedReset)&&(this._stickyStyler.clearStickyPositioning([...n,...r,...o],["left","right"]),this._stickyColumnStylesNeedReset=!1),n.forEach((s,a)=>{this._addStickyColumnStyles([s],this._headerRowDefs[a])}),this._rowDefs.forEach(s=>{const a=[];for(let c=0;c<r.length;c++)this._renderRows[c].rowDef===s&&a.push(r[c]);this._addStickyColumnStyles(a,s)}),o.forEach((s,a)=>{this._addStickyColumnStyles([s],this._footerRowDefs[a])}),Array.from(this._columnDefsByName.values()).forEach(s=>s.resetStickyChanged())}_getAllRenderRows(){const n=[],r=this._cachedRenderRowsMap;this._cachedRenderRowsMap=new Map;for(let o=0;o<this._data.length;o++){let s=this._data[o];const a=this._getRenderRowsForData(s,o,r.get(s));this._cachedRenderRowsMap.has(s)||this._cachedRenderRowsMap.set(s,new WeakMap);for(let c=0;c<a.length;c++){let l=a[c];const d=this._cachedRenderRowsMap.get(l.data);d.has(l.rowDef)?d.get(l.rowDef).push(l):d.set(l.rowDef,[l]),n.push(l)}}return n}_getRenderRowsForData(n,r,o){return this._getRowDefs(n,r).map(a=>{const c=o&&o.has(a)?o.get(a):[];if(c.length){const l=c.shift();return l.dataIndex=r,l}return{data:n,rowDef:a,dataIndex:r}})}_cacheColumnDefs(){this._columnDefsByName.clear(),dm(this._getOwnDefs(this._contentColumnDefs),this._customColumnDefs).forEach(r=>{this._columnDefsByName.has(r.name),this._columnDefsByName.set(r.name,r)})}_cacheRowDefs(){this._headerRowDefs=dm(this._getOwnDefs(this._contentHeaderRowDefs),this._customHeaderRowDefs),this._footerRowDefs=dm(this._getOwnDefs(this._contentFooterRowDefs),this._customFooterRowDefs),this._rowDefs=dm(this._getOwnDefs(this._contentRowDefs),this._customRowDefs);const n=this._rowDefs.filter(r=>!r.when);this._defaultRowDef=n[0]}_renderUpdatedColumns(){const n=(a,c)=>a||!!c.getColumnsDiff(),r=this._rowDefs.reduce(n,!1);r&&this._forceRenderDataRows();const o=this._headerRowDefs.reduce(n,!1);o&&this._forceRenderHeaderRows();const s=this._footerRowDefs.reduce(n,!1);return s&&this._forceRenderFooterRows(),r||o||s}_switchDataSource(n){this._data=[],Zy(this.dataSource)&&this.dataSource.disconnect(this),this._renderChangeSubscription&&(this._renderChangeSubscription.unsubscribe(),this._renderChangeSubscription=null),n||(this._dataDiffer&&this._dataDiffer.diff([]),this._rowOutlet.viewContainer.clear()),this._dataSource=n}_observeRenderChanges(){if(!this.dataSource)return;let n;Zy(this.dataSource)?n=this.dataSource.connect(this):B1(this.dataSource)?n=this.dataSource:Array.isArray(this.dataSource)&&(n=re(this.dataSource)),this._renderChangeSubscription=n.pipe(ce(this._onDestroy)).subscribe(r=>{this._data=r||[],this.renderRows()})}_forceRenderHeaderRows(){this._headerRowOutlet.viewContainer.length>0&&this._headerRowOutlet.viewContainer.clear(),this._headerRowDefs.forEach((n,r)=>this._renderRow(this._headerRowOutlet,n,r)),this.updateStickyHeaderRowStyles()}_forceRenderFooterRows(){this._footerRowOutlet.viewContainer.length>0&&this._footerRowOutlet.viewContainer.clear(),this._footerRowDefs.forEach((n,r)=>this._renderRow(this._footerRowOutlet,n,r)),this.updateStickyFooterRowStyles()}_addStickyColumnStyles(n,r){const o=Array.from(r.columns||[]).map(c=>this._columnDefsByName.get(c)),s=o.map(c=>c.sticky),a=o.map(c=>c.stickyEnd);this._stickyStyler.updateStickyColumns(n,s,a,!this._fixedLayout||this._forceRecalculateCellWidths)}_getRenderedRows(n){const r=[];for(let o=0;o<n.viewContainer.length;o++){const s=n.viewContainer.get(o);r.push(s.rootNodes[0])}return r}_getRowDefs(n,r){if(1==this._rowDefs.length)return[this._rowDefs[0]];let o=[];if(this.multiTemplateDataRows)o=this._rowDefs.filter(s=>!s.when||s.when(r,n));else{let s=this._rowDefs.find(a=>a.when&&a.when(r,n))||this._defaultRowDef;s&&o.push(s)}return o}_getEmbeddedViewArgs(n,r){return{templateRef:n.rowDef.template,context:{$implicit:n.data},index:r}}_renderRow(n,r,o,s={}){const a=n.viewContainer.createEmbeddedView(r.template,s,o);return this._renderCellTemplateForItem(r,s),a}_renderCellTemplateForItem(n,r){for(let o of this._getCellTemplates(n))Br.mostRecentCellOutlet&&Br.mostRecentCellOutlet._viewContainer.createEmbeddedView(o,r);this._changeDetectorRef.markForCheck()}_updateRowIndexContext(){const n=this._rowOutlet.viewContainer;for(let r=0,o=n.length;r<o;r++){const a=n.get(r).context;a.count=o,a.first=0===r,a.last=r===o-1,a.even=r%2==0,a.odd=!a.even,this.multiTemplateDataRows?(a.dataIndex=this._renderRows[r].dataIndex,a.renderIndex=r):a.index=this._renderRows[r].dataIndex}}_getCellTemplates(n){return n&&n.columns?Array.from(n.columns,r=>{const o=this._columnDefsByName.get(r);return n.extractCellTemplate(o)}):[]}_applyNativeTableSections(){const n=this._document.createDocumentFragment(),r=[{tag:"thead",outlets:[this._headerRowOutlet]},{tag:"tbody",outlets:[this._rowOutlet,this._noDataRowOutlet]},{tag:"tfoot",outlets:[this._footerRowOutlet]}];for(const o of r){const s=this._document.createElement(o.tag);s.setAttribute("role","rowgroup");for(const a of o.outlets)s.appendChild(a.elementRef.nativeElement);n.appendChild(s)}this._elementRef.nativeElement.appendChild(n)}_forceRenderDataRows(){this._dataDiffer.diff([]),this._rowOutlet.viewContainer.clear(),this.renderRows()}_checkStickyStates(){const n=(r,o)=>r||o.hasStickyChanged();this._headerRowDefs.reduce(n,!1)&&this.updateStickyHeaderRowStyles(),this._footerRowDefs.reduce(n,!1)&&this.updateStickyFooterRowStyles(),Array.from(this._columnDefsByName.values()).reduce(n,!1)&&(this._stickyColumnStylesNeedReset=!0,this.updateStickyColumnStyles())}_setupStickyStyler(){this._stickyStyler=new aie(this._isNativeHtmlTable,this.stickyCssClass,this._dir?this._dir.value:"ltr",this._coalescedStyleScheduler,this._platform.isBrowser,this.needsPositionStickyOnElement,this._stickyPositioningListener),(this._dir?this._dir.change:re()).pipe(ce(this._onDestroy)).subscribe(r=>{this._stickyStyler.direction=r,this.updateStickyColumnStyles()})}_getOwnDefs(n){return n.filter(r=>!r._table||r._table===this)}_updateNoDataRow(){const n=this._customNoDataRow||this._noDataRow;if(!n)return;const r=0===this._rowOutlet.viewContainer.length;if(r===this._isShowingNoDataRow)return;const o=this._noDataRowOutlet.viewContainer;if(r){const s=o.createEmbeddedView(n.templateRef),a=s.rootNodes[0];1===s.rootNodes.length&&a?.nodeType===this._document.ELEMENT_NODE&&(a.setAttribute("role","row"),a.classList.add(n._contentClassName))}else o.clear();this._isShowingNoDataRow=r,this._changeDetectorRef.markForCheck()}}return(e=t).\u0275fac=function(n){return new(n||e)(m(Dr),m(Fe),m(W),ui("role"),m(fn,8),m(he),m(nt),m(wd),m(hw),m(Rr),m(_w,12),m(G,8))},e.\u0275cmp=fe({type:e,selectors:[["cdk-table"],["table","cdk-table",""]],contentQueries:function(n,r,o){if(1&n&&(Ee(o,rm,5),Ee(o,Lr,5),Ee(o,im,5),Ee(o,Hd,5),Ee(o,zd,5)),2&n){let s;H(s=z())&&(r._noDataRow=s.first),H(s=z())&&(r._contentColumnDefs=s),H(s=z())&&(r._contentRowDefs=s),H(s=z())&&(r._contentHeaderRowDefs=s),H(s=z())&&(r._contentFooterRowDefs=s)}},viewQuery:function(n,r){if(1&n&&(ke(om,7),ke(sm,7),ke(am,7),ke(cm,7)),2&n){let o;H(o=z())&&(r._rowOutlet=o.first),H(o=z())&&(r._headerRowOutlet=o.first),H(o=z())&&(r._footerRowOutlet=o.first),H(o=z())&&(r._noDataRowOutlet=o.first)}},hostAttrs:["ngSkipHydration","",1,"cdk-table"],hostVars:2,hostBindings:function(n,r){2&n&&se("cdk-table-fixed-layout",r.fixedLayout)},inputs:{trackBy:"trackBy",dataSource:"dataSource",multiTemplateDataRows:"multiTemplateDataRows",fixedLayout:"fixedLayout"},outputs:{contentChanged:"contentChanged"},exportAs:["cdkTable"],features:[Z([{provide:fc,useExisting:e},{provide:wd,useClass:UR},{provide:hw,useClass:FP},{provide:_w,useValue:null}])],ngContentSelectors:Xne,decls:6,vars:0,consts:[["headerRowOutlet",""],["rowOutlet",""],["noDataRowOutlet",""],["footerRowOutlet",""]],template:function(n,r){1&n&&(ze(Kne),X(0),X(1,1),nr(2,0)(3,1)(4,2)(5,3))},dependencies:[om,sm,am,cm],styles:[".cdk-table-fixed-layout{table-layout:fixed}"],encapsulation:2}),t})();function dm(e,t){return e.concat(Array.from(t))}let lie=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275mod=le({type:e}),e.\u0275inj=ae({imports:[ny]}),t})();const die=[[["caption"]],[["colgroup"],["col"]]],uie=["caption","colgroup, col"];let LP=(()=>{var e;class t extends lm{constructor(){super(...arguments),this.stickyCssClass="mat-mdc-table-sticky",this.needsPositionStickyOnElement=!1}ngOnInit(){super.ngOnInit(),this._isNativeHtmlTable&&this._elementRef.nativeElement.querySelector("tbody").classList.add("mdc-data-table__content")}}return(e=t).\u0275fac=function(){let i;return function(r){return(i||(i=be(e)))(r||e)}}(),e.\u0275cmp=fe({type:e,selectors:[["mat-table"],["table","mat-table",""]],hostAttrs:["ngSkipHydration","",1,"mat-mdc-table","mdc-data-table__table"],hostVars:2,hostBindings:function(n,r){2&n&&se("mdc-table-fixed-layout",r.fixedLayout)},exportAs:["matTable"],features:[Z([{provide:lm,useExisting:e},{provide:fc,useExisting:e},{provide:hw,useClass:FP},{provide:wd,useClass:UR},{provide:_w,useValue:null}]),P],ngContentSelectors:uie,decls:6,vars:0,consts:[["headerRowOutlet",""],["rowOutlet",""],["noDataRowOutlet",""],["footerRowOutlet",""]],template:function(n,r){1&n&&(ze(die),X(0),X(1,1),nr(2,0)(3,1)(4,2)(5,3))},dependencies:[om,sm,am,cm],styles:[".mat-mdc-table-sticky{position:sticky !important}.mdc-data-table{-webkit-overflow-scrolling:touch;display:inline-flex;flex-direction:column;box-sizing:border-box;position:relative}.mdc-data-table__table-container{-webkit-overflow-scrolling:touch;overflow-x:auto;width:100%}.mdc-data-table__table{min-width:100%;border:0;white-space:nowrap;border-spacing:0;table-layout:fixed}.mdc-data-table__cell{box-sizing:border-box;overflow:hidden;text-align:left;text-overflow:ellipsis}[dir=rtl] .mdc-data-table__cell,.mdc-data-table__cell[dir=rtl]{text-align:right}.mdc-data-table__cell--numeric{text-align:right}[dir=rtl] .mdc-data-table__cell--numeric,.mdc-data-table__cell--numeric[dir=rtl]{text-align:left}.mdc-data-table__header-cell{box-sizing:border-box;text-overflow:ellipsis;overflow:hidden;outline:none;text-align:left}[dir=rtl] .mdc-data-table__header-cell,.mdc-data-table__header-cell[dir=rtl]{text-align:right}.mdc-data-table__header-cell--numeric{text-align:right}[dir=rtl] .mdc-data-table__header-cell--numeric,.mdc-data-table__header-cell--numeric[dir=rtl]{text-align:left}.mdc-data-table__header-cell-wrapper{align-items:center;display:inline-flex;vertical-align:middle}.mdc-data-table__cell,.mdc-data-table__header-cell{padding:0 16px 0 16px}.mdc-data-table__header-cell--checkbox,.mdc-data-table__cell--checkbox{padding-left:4px;padding-right:0}[dir=rtl] .mdc-data-table__header-cell--checkbox,[dir=rtl] .mdc-data-table__cell--checkbox,.mdc-data-table__header-cell--checkbox[dir=rtl],.mdc-data-table__cell--checkbox[dir=rtl]{padding-left:0;padding-right:4px}mat-table{display:block}mat-header-row{min-height:56px}mat-row,mat-footer-row{min-height:48px}mat-row,mat-header-row,mat-footer-row{display:flex;border-width:0;border-bottom-width:1px;border-style:solid;align-items:center;box-sizing:border-box}mat-cell:first-of-type,mat-header-cell:first-of-type,mat-footer-cell:first-of-type{padding-left:24px}[dir=rtl] mat-cell:first-of-type:not(:only-of-type),[dir=rtl] mat-header-cell:first-of-type:not(:only-of-type),[dir=rtl] mat-footer-cell:first-of-type:not(:only-of-type){padding-left:0;padding-right:24px}mat-cell:last-of-type,mat-header-cell:last-of-type,mat-footer-cell:last-of-type{padding-right:24px}[dir=rtl] mat-cell:last-of-type:not(:only-of-type),[dir=rtl] mat-header-cell:last-of-type:not(:only-of-type),[dir=rtl] mat-footer-cell:last-of-type:not(:only-of-type){padding-right:0;padding-left:24px}mat-cell,mat-header-cell,mat-footer-cell{flex:1;display:flex;align-items:center;overflow:hidden;word-wrap:break-word;min-height:inherit}.mat-mdc-table{--mat-table-row-item-outline-width:1px;table-layout:auto;white-space:normal;background-color:var(--mat-table-background-color)}.mat-mdc-header-row{-moz-osx-font-smoothing:grayscale;-webkit-font-smoothing:antialiased;height:var(--mat-table-header-container-height, 56px);color:var(--mat-table-header-headline-color, rgba(0, 0, 0, 0.87));font-family:var(--mat-table-header-headline-font, Roboto, sans-serif);line-height:var(--mat-table-header-headline-line-height);font-size:var(--mat-table-header-headline-size, 14px);font-weight:var(--mat-table-header-headline-weight, 500)}.mat-mdc-row{height:var(--mat-table-row-item-container-height, 52px);color:var(--mat-table-row-item-label-text-color, rgba(0, 0, 0, 0.87))}.mat-mdc-row,.mdc-data-table__content{-moz-osx-font-smoothing:grayscale;-webkit-font-smoothing:antialiased;font-family:var(--mat-table-row-item-label-text-font, Roboto, sans-serif);line-height:var(--mat-table-row-item-label-text-line-height);font-size:var(--mat-table-row-item-label-text-size, 14px);font-weight:var(--mat-table-row-item-label-text-weight)}.mat-mdc-footer-row{-moz-osx-font-smoothing:grayscale;-webkit-font-smoothing:antialiased;height:var(--mat-table-footer-container-height, 52px);color:var(--mat-table-row-item-label-text-color, rgba(0, 0, 0, 0.87));font-family:var(--mat-table-footer-supporting-text-font, Roboto, sans-serif);line-height:var(--mat-table-footer-supporting-text-line-height);font-size:var(--mat-table-footer-supporting-text-size, 14px);font-weight:var(--mat-table-footer-supporting-text-weight);letter-spacing:var(--mat-table-footer-supporting-text-tracking)}.mat-mdc-header-cell{border-bottom-color:var(--mat-table-row-item-outline-color, rgba(0, 0, 0, 0.12));border-bottom-width:var(--mat-table-row-item-outline-width, 1px);border-bottom-style:solid;letter-spacing:var(--mat-table-header-headline-tracking);font-weight:inherit;line-height:inherit}.mat-mdc-cell{border-bottom-color:var(--mat-table-row-item-outline-color, rgba(0, 0, 0, 0.12));border-bottom-width:var(--mat-table-row-item-outline-width, 1px);border-bottom-style:solid;letter-spacing:var(--mat-table-row-item-label-text-tracking);line-height:inherit}.mdc-data-table__row:last-child .mat-mdc-cell{border-bottom:none}.mat-mdc-footer-cell{letter-spacing:var(--mat-table-row-item-label-text-tracking)}mat-row.mat-mdc-row,mat-header-row.mat-mdc-header-row,mat-footer-row.mat-mdc-footer-row{border-bottom:none}.mat-mdc-table tbody,.mat-mdc-table tfoot,.mat-mdc-table thead,.mat-mdc-cell,.mat-mdc-footer-cell,.mat-mdc-header-row,.mat-mdc-row,.mat-mdc-footer-row,.mat-mdc-table .mat-mdc-header-cell{background:inherit}.mat-mdc-table mat-header-row.mat-mdc-header-row,.mat-mdc-table mat-row.mat-mdc-row,.mat-mdc-table mat-footer-row.mat-mdc-footer-cell{height:unset}mat-header-cell.mat-mdc-header-cell,mat-cell.mat-mdc-cell,mat-footer-cell.mat-mdc-footer-cell{align-self:stretch}"],encapsulation:2}),t})(),bw=(()=>{var e;class t extends pc{}return(e=t).\u0275fac=function(){let i;return function(r){return(i||(i=be(e)))(r||e)}}(),e.\u0275dir=T({type:e,selectors:[["","matCellDef",""]],features:[Z([{provide:pc,useExisting:e}]),P]}),t})(),vw=(()=>{var e;class t extends mc{}return(e=t).\u0275fac=function(){let i;return function(r){return(i||(i=be(e)))(r||e)}}(),e.\u0275dir=T({type:e,selectors:[["","matHeaderCellDef",""]],features:[Z([{provide:mc,useExisting:e}]),P]}),t})(),yw=(()=>{var e;class t extends Lr{get name(){return this._name}set name(n){this._setNameInput(n)}_updateColumnCssClassName(){super._updateColumnCssClassName(),this._columnCssClassName.push(`mat-column-${this.cssClassFriendlyName}`)}}return(e=t).\u0275fac=function(){let i;return function(r){return(i||(i=be(e)))(r||e)}}(),e.\u0275dir=T({type:e,selectors:[["","matColumnDef",""]],inputs:{sticky:"sticky",name:["matColumnDef","name"]},features:[Z([{provide:Lr,useExisting:e},{provide:"MAT_SORT_HEADER_COLUMN_DEF",useExisting:e}]),P]}),t})(),ww=(()=>{var e;class t extends dw{}return(e=t).\u0275fac=function(){let i;return function(r){return(i||(i=be(e)))(r||e)}}(),e.\u0275dir=T({type:e,selectors:[["mat-header-cell"],["th","mat-header-cell",""]],hostAttrs:["role","columnheader",1,"mat-mdc-header-cell","mdc-data-table__header-cell"],features:[P]}),t})(),xw=(()=>{var e;class t extends uw{}return(e=t).\u0275fac=function(){let i;return function(r){return(i||(i=be(e)))(r||e)}}(),e.\u0275dir=T({type:e,selectors:[["mat-cell"],["td","mat-cell",""]],hostAttrs:[1,"mat-mdc-cell","mdc-data-table__cell"],features:[P]}),t})(),BP=(()=>{var e;class t extends Hd{}return(e=t).\u0275fac=function(){let i;return function(r){return(i||(i=be(e)))(r||e)}}(),e.\u0275dir=T({type:e,selectors:[["","matHeaderRowDef",""]],inputs:{columns:["matHeaderRowDef","columns"],sticky:["matHeaderRowDefSticky","sticky"]},features:[Z([{provide:Hd,useExisting:e}]),P]}),t})(),VP=(()=>{var e;class t extends im{}return(e=t).\u0275fac=function(){let i;return function(r){return(i||(i=be(e)))(r||e)}}(),e.\u0275dir=T({type:e,selectors:[["","matRowDef",""]],inputs:{columns:["matRowDefColumns","columns"],when:["matRowDefWhen","when"]},features:[Z([{provide:im,useExisting:e}]),P]}),t})(),jP=(()=>{var e;class t extends pw{}return(e=t).\u0275fac=function(){let i;return function(r){return(i||(i=be(e)))(r||e)}}(),e.\u0275cmp=fe({type:e,selectors:[["mat-header-row"],["tr","mat-header-row",""]],hostAttrs:["role","row",1,"mat-mdc-header-row","mdc-data-table__header-row"],exportAs:["matHeaderRow"],features:[Z([{provide:pw,useExisting:e}]),P],decls:1,vars:0,consts:[["cdkCellOutlet",""]],template:function(n,r){1&n&&nr(0,0)},dependencies:[Br],encapsulation:2}),t})(),HP=(()=>{var e;class t extends gw{}return(e=t).\u0275fac=function(){let i;return function(r){return(i||(i=be(e)))(r||e)}}(),e.\u0275cmp=fe({type:e,selectors:[["mat-row"],["tr","mat-row",""]],hostAttrs:["role","row",1,"mat-mdc-row","mdc-data-table__row"],exportAs:["matRow"],features:[Z([{provide:gw,useExisting:e}]),P],decls:1,vars:0,consts:[["cdkCellOutlet",""]],template:function(n,r){1&n&&nr(0,0)},dependencies:[Br],encapsulation:2}),t})(),vie=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275mod=le({type:e}),e.\u0275inj=ae({imports:[ve,lie,ve]}),t})();function wie(e,t){}const xie=function(e){return{animationDuration:e}},Cie=function(e,t){return{value:e,params:t}};function Die(e,t){1&e&&X(0)}const zP=["*"],Eie=["tabListContainer"],Sie=["tabList"],kie=["tabListInner"],Tie=["nextPaginator"],Mie=["previousPaginator"],Iie=["tabBodyWrapper"],Aie=["tabHeader"];function Rie(e,t){}function Oie(e,t){1&e&&R(0,Rie,0,0,"ng-template",14),2&e&&E("cdkPortalOutlet",B().$implicit.templateLabel)}function Fie(e,t){1&e&&A(0),2&e&&bt(B().$implicit.textLabel)}function Pie(e,t){if(1&e){const i=Ut();y(0,"div",6,7),$("click",function(){const r=Ye(i),o=r.$implicit,s=r.index,a=B(),c=hn(1);return Ke(a._handleClick(o,c,s))})("cdkFocusChange",function(r){const s=Ye(i).index;return Ke(B()._tabFocusChanged(r,s))}),ie(2,"span",8)(3,"div",9),y(4,"span",10)(5,"span",11),R(6,Oie,1,1,"ng-template",12),R(7,Fie,1,1,"ng-template",null,13,kh),w()()()}if(2&e){const i=t.$implicit,n=t.index,r=hn(1),o=hn(8),s=B();se("mdc-tab--active",s.selectedIndex===n),E("id",s._getTabLabelId(n))("ngClass",i.labelClass)("disabled",i.disabled)("fitInkBarToContent",s.fitInkBarToContent),de("tabIndex",s._getTabIndex(n))("aria-posinset",n+1)("aria-setsize",s._tabs.length)("aria-controls",s._getTabContentId(n))("aria-selected",s.selectedIndex===n)("aria-label",i.ariaLabel||null)("aria-labelledby",!i.ariaLabel&&i.ariaLabelledby?i.ariaLabelledby:null),x(3),E("matRippleTrigger",r)("matRippleDisabled",i.disabled||s.disableRipple),x(3),E("ngIf",i.templateLabel)("ngIfElse",o)}}function Nie(e,t){if(1&e){const i=Ut();y(0,"mat-tab-body",15),$("_onCentered",function(){return Ye(i),Ke(B()._removeTabBodyWrapperHeight())})("_onCentering",function(r){return Ye(i),Ke(B()._setTabBodyWrapperHeight(r))}),w()}if(2&e){const i=t.$implicit,n=t.index,r=B();se("mat-mdc-tab-body-active",r.selectedIndex===n),E("id",r._getTabContentId(n))("ngClass",i.bodyClass)("content",i.content)("position",i.position)("origin",i.origin)("animationDuration",r.animationDuration)("preserveContent",r.preserveContent),de("tabindex",null!=r.contentTabIndex&&r.selectedIndex===n?r.contentTabIndex:null)("aria-labelledby",r._getTabLabelId(n))("aria-hidden",r.selectedIndex!==n)}}const Lie={translateTab:ni("translateTab",[qt("center, void, left-origin-center, right-origin-center",je({transform:"none"})),qt("left",je({transform:"translate3d(-100%, 0, 0)",minHeight:"1px",visibility:"hidden"})),qt("right",je({transform:"translate3d(100%, 0, 0)",minHeight:"1px",visibility:"hidden"})),Bt("* => left, * => right, left => center, right => center",Lt("{{animationDuration}} cubic-bezier(0.35, 0, 0.25, 1)")),Bt("void => left-origin-center",[je({transform:"translate3d(-100%, 0, 0)",visibility:"hidden"}),Lt("{{animationDuration}} cubic-bezier(0.35, 0, 0.25, 1)")]),Bt("void => right-origin-center",[je({transform:"translate3d(100%, 0, 0)",visibility:"hidden"}),Lt("{{animationDuration}} cubic-bezier(0.35, 0, 0.25, 1)")])])};let Bie=(()=>{var e;class t extends La{constructor(n,r,o,s){super(n,r,s),this._host=o,this._centeringSub=Ae.EMPTY,this._leavingSub=Ae.EMPTY}ngOnInit(){super.ngOnInit(),this._centeringSub=this._host._beforeCentering.pipe(tn(this._host._isCenterPosition(this._host._position))).subscribe(n=>{n&&!this.hasAttached()&&this.attach(this._host._content)}),this._leavingSub=this._host._afterLeavingCenter.subscribe(()=>{this._host.preserveContent||this.detach()})}ngOnDestroy(){super.ngOnDestroy(),this._centeringSub.unsubscribe(),this._leavingSub.unsubscribe()}}return(e=t).\u0275fac=function(n){return new(n||e)(m(Bo),m(pt),m(He(()=>UP)),m(he))},e.\u0275dir=T({type:e,selectors:[["","matTabBodyHost",""]],features:[P]}),t})(),Vie=(()=>{var e;class t{set position(n){this._positionIndex=n,this._computePositionAnimationState()}constructor(n,r,o){this._elementRef=n,this._dir=r,this._dirChangeSubscription=Ae.EMPTY,this._translateTabComplete=new Y,this._onCentering=new U,this._beforeCentering=new U,this._afterLeavingCenter=new U,this._onCentered=new U(!0),this.animationDuration="500ms",this.preserveContent=!1,r&&(this._dirChangeSubscription=r.change.subscribe(s=>{this._computePositionAnimationState(s),o.markForCheck()})),this._translateTabComplete.pipe(Is((s,a)=>s.fromState===a.fromState&&s.toState===a.toState)).subscribe(s=>{this._isCenterPosition(s.toState)&&this._isCenterPosition(this._position)&&this._onCentered.emit(),this._isCenterPosition(s.fromState)&&!this._isCenterPosition(this._position)&&this._afterLeavingCenter.emit()})}ngOnInit(){"center"==this._position&&null!=this.origin&&(this._position=this._computePositionFromOrigin(this.origin))}ngOnDestroy(){this._dirChangeSubscription.unsubscribe(),this._translateTabComplete.complete()}_onTranslateTabStarted(n){const r=this._isCenterPosition(n.toState);this._beforeCentering.emit(r),r&&this._onCentering.emit(this._elementRef.nativeElement.clientHeight)}_getLayoutDirection(){return this._dir&&"rtl"===this._dir.value?"rtl":"ltr"}_isCenterPosition(n){return"center"==n||"left-origin-center"==n||"right-origin-center"==n}_computePositionAnimationState(n=this._getLayoutDirection()){this._position=this._positionIndex<0?"ltr"==n?"left":"right":this._positionIndex>0?"ltr"==n?"right":"left":"center"}_computePositionFromOrigin(n){const r=this._getLayoutDirection();return"ltr"==r&&n<=0||"rtl"==r&&n>0?"left-origin-center":"right-origin-center"}}return(e=t).\u0275fac=function(n){return new(n||e)(m(W),m(fn,8),m(Fe))},e.\u0275dir=T({type:e,inputs:{_content:["content","_content"],origin:"origin",animationDuration:"animationDuration",preserveContent:"preserveContent",position:"position"},outputs:{_onCentering:"_onCentering",_beforeCentering:"_beforeCentering",_afterLeavingCenter:"_afterLeavingCenter",_onCentered:"_onCentered"}}),t})(),UP=(()=>{var e;class t extends Vie{constructor(n,r,o){super(n,r,o)}}return(e=t).\u0275fac=function(n){return new(n||e)(m(W),m(fn,8),m(Fe))},e.\u0275cmp=fe({type:e,selectors:[["mat-tab-body"]],viewQuery:function(n,r){if(1&n&&ke(La,5),2&n){let o;H(o=z())&&(r._portalHost=o.first)}},hostAttrs:[1,"mat-mdc-tab-body"],features:[P],decls:3,vars:6,consts:[["cdkScrollable","",1,"mat-mdc-tab-body-content"],["content",""],["matTabBodyHost",""]],template:function(n,r){1&n&&(y(0,"div",0,1),$("@translateTab.start",function(s){return r._onTranslateTabStarted(s)})("@translateTab.done",function(s){return r._translateTabComplete.next(s)}),R(2,wie,0,0,"ng-template",2),w()),2&n&&E("@translateTab",function dk(e,t,i,n,r){return hk(F(),Dn(),e,t,i,n,r)}(3,Cie,r._position,function lk(e,t,i,n){return uk(F(),Dn(),e,t,i,n)}(1,xie,r.animationDuration)))},dependencies:[Bie],styles:['.mat-mdc-tab-body{top:0;left:0;right:0;bottom:0;position:absolute;display:block;overflow:hidden;outline:0;flex-basis:100%}.mat-mdc-tab-body.mat-mdc-tab-body-active{position:relative;overflow-x:hidden;overflow-y:auto;z-index:1;flex-grow:1}.mat-mdc-tab-group.mat-mdc-tab-group-dynamic-height .mat-mdc-tab-body.mat-mdc-tab-body-active{overflow-y:hidden}.mat-mdc-tab-body-content{height:100%;overflow:auto}.mat-mdc-tab-group-dynamic-height .mat-mdc-tab-body-content{overflow:hidden}.mat-mdc-tab-body-content[style*="visibility: hidden"]{display:none}'],encapsulation:2,data:{animation:[Lie.translateTab]}}),t})();const jie=new M("MatTabContent");let Hie=(()=>{var e;class t{constructor(n){this.template=n}}return(e=t).\u0275fac=function(n){return new(n||e)(m(ct))},e.\u0275dir=T({type:e,selectors:[["","matTabContent",""]],features:[Z([{provide:jie,useExisting:e}])]}),t})();const zie=new M("MatTabLabel"),$P=new M("MAT_TAB");let qP=(()=>{var e;class t extends i9{constructor(n,r,o){super(n,r),this._closestTab=o}}return(e=t).\u0275fac=function(n){return new(n||e)(m(ct),m(pt),m($P,8))},e.\u0275dir=T({type:e,selectors:[["","mat-tab-label",""],["","matTabLabel",""]],features:[Z([{provide:zie,useExisting:e}]),P]}),t})();const Cw="mdc-tab-indicator--active",GP="mdc-tab-indicator--no-transition";class Uie{constructor(t){this._items=t}hide(){this._items.forEach(t=>t.deactivateInkBar())}alignToElement(t){const i=this._items.find(r=>r.elementRef.nativeElement===t),n=this._currentItem;if(i!==n&&(n?.deactivateInkBar(),i)){const r=n?.elementRef.nativeElement.getBoundingClientRect?.();i.activateInkBar(r),this._currentItem=i}}}function $ie(e){return class extends e{constructor(...t){super(...t),this._fitToContent=!1}get fitInkBarToContent(){return this._fitToContent}set fitInkBarToContent(t){const i=Q(t);this._fitToContent!==i&&(this._fitToContent=i,this._inkBarElement&&this._appendInkBarElement())}activateInkBar(t){const i=this.elementRef.nativeElement;if(!t||!i.getBoundingClientRect||!this._inkBarContentElement)return void i.classList.add(Cw);const n=i.getBoundingClientRect(),r=t.width/n.width,o=t.left-n.left;i.classList.add(GP),this._inkBarContentElement.style.setProperty("transform",`translateX(${o}px) scaleX(${r})`),i.getBoundingClientRect(),i.classList.remove(GP),i.classList.add(Cw),this._inkBarContentElement.style.setProperty("transform","")}deactivateInkBar(){this.elementRef.nativeElement.classList.remove(Cw)}ngOnInit(){this._createInkBarElement()}ngOnDestroy(){this._inkBarElement?.remove(),this._inkBarElement=this._inkBarContentElement=null}_createInkBarElement(){const t=this.elementRef.nativeElement.ownerDocument||document;this._inkBarElement=t.createElement("span"),this._inkBarContentElement=t.createElement("span"),this._inkBarElement.className="mdc-tab-indicator",this._inkBarContentElement.className="mdc-tab-indicator__content mdc-tab-indicator__content--underline",this._inkBarElement.appendChild(this._inkBarContentElement),this._appendInkBarElement()}_appendInkBarElement(){(this._fitToContent?this.elementRef.nativeElement.querySelector(".mdc-tab__content"):this.elementRef.nativeElement).appendChild(this._inkBarElement)}}}const Gie=es(class{}),Wie=$ie((()=>{var e;class t extends Gie{constructor(n){super(),this.elementRef=n}focus(){this.elementRef.nativeElement.focus()}getOffsetLeft(){return this.elementRef.nativeElement.offsetLeft}getOffsetWidth(){return this.elementRef.nativeElement.offsetWidth}}return(e=t).\u0275fac=function(n){return new(n||e)(m(W))},e.\u0275dir=T({type:e,features:[P]}),t})());let WP=(()=>{var e;class t extends Wie{}return(e=t).\u0275fac=function(){let i;return function(r){return(i||(i=be(e)))(r||e)}}(),e.\u0275dir=T({type:e,selectors:[["","matTabLabelWrapper",""]],hostVars:3,hostBindings:function(n,r){2&n&&(de("aria-disabled",!!r.disabled),se("mat-mdc-tab-disabled",r.disabled))},inputs:{disabled:"disabled",fitInkBarToContent:"fitInkBarToContent"},features:[P]}),t})();const Qie=es(class{}),QP=new M("MAT_TAB_GROUP");let Yie=(()=>{var e;class t extends Qie{get content(){return this._contentPortal}constructor(n,r){super(),this._viewContainerRef=n,this._closestTabGroup=r,this.textLabel="",this._contentPortal=null,this._stateChanges=new Y,this.position=null,this.origin=null,this.isActive=!1}ngOnChanges(n){(n.hasOwnProperty("textLabel")||n.hasOwnProperty("disabled"))&&this._stateChanges.next()}ngOnDestroy(){this._stateChanges.complete()}ngOnInit(){this._contentPortal=new co(this._explicitContent||this._implicitContent,this._viewContainerRef)}_setTemplateLabelInput(n){n&&n._closestTab===this&&(this._templateLabel=n)}}return(e=t).\u0275fac=function(n){return new(n||e)(m(pt),m(QP,8))},e.\u0275dir=T({type:e,viewQuery:function(n,r){if(1&n&&ke(ct,7),2&n){let o;H(o=z())&&(r._implicitContent=o.first)}},inputs:{textLabel:["label","textLabel"],ariaLabel:["aria-label","ariaLabel"],ariaLabelledby:["aria-labelledby","ariaLabelledby"],labelClass:"labelClass",bodyClass:"bodyClass"},features:[P,kt]}),t})(),YP=(()=>{var e;class t extends Yie{constructor(){super(...arguments),this._explicitContent=void 0}get templateLabel(){return this._templateLabel}set templateLabel(n){this._setTemplateLabelInput(n)}}return(e=t).\u0275fac=function(){let i;return function(r){return(i||(i=be(e)))(r||e)}}(),e.\u0275cmp=fe({type:e,selectors:[["mat-tab"]],contentQueries:function(n,r,o){if(1&n&&(Ee(o,Hie,7,ct),Ee(o,qP,5)),2&n){let s;H(s=z())&&(r._explicitContent=s.first),H(s=z())&&(r.templateLabel=s.first)}},inputs:{disabled:"disabled"},exportAs:["matTab"],features:[Z([{provide:$P,useExisting:e}]),P],ngContentSelectors:zP,decls:1,vars:0,template:function(n,r){1&n&&(ze(),R(0,Die,1,0,"ng-template"))},encapsulation:2}),t})();const KP=ro({passive:!0});let Zie=(()=>{var e;class t{get disablePagination(){return this._disablePagination}set disablePagination(n){this._disablePagination=Q(n)}get selectedIndex(){return this._selectedIndex}set selectedIndex(n){n=Bi(n),this._selectedIndex!=n&&(this._selectedIndexChanged=!0,this._selectedIndex=n,this._keyManager&&this._keyManager.updateActiveItem(n))}constructor(n,r,o,s,a,c,l){this._elementRef=n,this._changeDetectorRef=r,this._viewportRuler=o,this._dir=s,this._ngZone=a,this._platform=c,this._animationMode=l,this._scrollDistance=0,this._selectedIndexChanged=!1,this._destroyed=new Y,this._showPaginationControls=!1,this._disableScrollAfter=!0,this._disableScrollBefore=!0,this._stopScrolling=new Y,this._disablePagination=!1,this._selectedIndex=0,this.selectFocusedIndex=new U,this.indexFocused=new U,a.runOutsideAngular(()=>{Vi(n.nativeElement,"mouseleave").pipe(ce(this._destroyed)).subscribe(()=>{this._stopInterval()})})}ngAfterViewInit(){Vi(this._previousPaginator.nativeElement,"touchstart",KP).pipe(ce(this._destroyed)).subscribe(()=>{this._handlePaginatorPress("before")}),Vi(this._nextPaginator.nativeElement,"touchstart",KP).pipe(ce(this._destroyed)).subscribe(()=>{this._handlePaginatorPress("after")})}ngAfterContentInit(){const n=this._dir?this._dir.change:re("ltr"),r=this._viewportRuler.change(150),o=()=>{this.updatePagination(),this._alignInkBarToSelectedTab()};this._keyManager=new Pv(this._items).withHorizontalOrientation(this._getLayoutDirection()).withHomeAndEnd().withWrap().skipPredicate(()=>!1),this._keyManager.updateActiveItem(this._selectedIndex),this._ngZone.onStable.pipe(Xe(1)).subscribe(o),Ot(n,r,this._items.changes,this._itemsResized()).pipe(ce(this._destroyed)).subscribe(()=>{this._ngZone.run(()=>{Promise.resolve().then(()=>{this._scrollDistance=Math.max(0,Math.min(this._getMaxScrollDistance(),this._scrollDistance)),o()})}),this._keyManager.withHorizontalOrientation(this._getLayoutDirection())}),this._keyManager.change.subscribe(s=>{this.indexFocused.emit(s),this._setTabFocus(s)})}_itemsResized(){return"function"!=typeof ResizeObserver?Rt:this._items.changes.pipe(tn(this._items),Vt(n=>new Me(r=>this._ngZone.runOutsideAngular(()=>{const o=new ResizeObserver(s=>r.next(s));return n.forEach(s=>o.observe(s.elementRef.nativeElement)),()=>{o.disconnect()}}))),Tv(1),Ve(n=>n.some(r=>r.contentRect.width>0&&r.contentRect.height>0)))}ngAfterContentChecked(){this._tabLabelCount!=this._items.length&&(this.updatePagination(),this._tabLabelCount=this._items.length,this._changeDetectorRef.markForCheck()),this._selectedIndexChanged&&(this._scrollToLabel(this._selectedIndex),this._checkScrollingControls(),this._alignInkBarToSelectedTab(),this._selectedIndexChanged=!1,this._changeDetectorRef.markForCheck()),this._scrollDistanceChanged&&(this._updateTabScrollPosition(),this._scrollDistanceChanged=!1,this._changeDetectorRef.markForCheck())}ngOnDestroy(){this._keyManager?.destroy(),this._destroyed.next(),this._destroyed.complete(),this._stopScrolling.complete()}_handleKeydown(n){if(!An(n))switch(n.keyCode){case 13:case 32:if(this.focusIndex!==this.selectedIndex){const r=this._items.get(this.focusIndex);r&&!r.disabled&&(this.selectFocusedIndex.emit(this.focusIndex),this._itemSelected(n))}break;default:this._keyManager.onKeydown(n)}}_onContentChanges(){const n=this._elementRef.nativeElement.textContent;n!==this._currentTextContent&&(this._currentTextContent=n||"",this._ngZone.run(()=>{this.updatePagination(),this._alignInkBarToSelectedTab(),this._changeDetectorRef.markForCheck()}))}updatePagination(){this._checkPaginationEnabled(),this._checkScrollingControls(),this._updateTabScrollPosition()}get focusIndex(){return this._keyManager?this._keyManager.activeItemIndex:0}set focusIndex(n){!this._isValidIndex(n)||this.focusIndex===n||!this._keyManager||this._keyManager.setActiveItem(n)}_isValidIndex(n){return!this._items||!!this._items.toArray()[n]}_setTabFocus(n){if(this._showPaginationControls&&this._scrollToLabel(n),this._items&&this._items.length){this._items.toArray()[n].focus();const r=this._tabListContainer.nativeElement;r.scrollLeft="ltr"==this._getLayoutDirection()?0:r.scrollWidth-r.offsetWidth}}_getLayoutDirection(){return this._dir&&"rtl"===this._dir.value?"rtl":"ltr"}_updateTabScrollPosition(){if(this.disablePagination)return;const n=this.scrollDistance,r="ltr"===this._getLayoutDirection()?-n:n;this._tabList.nativeElement.style.transform=`translateX(${Math.round(r)}px)`,(this._platform.TRIDENT||this._platform.EDGE)&&(this._tabListContainer.nativeElement.scrollLeft=0)}get scrollDistance(){return this._scrollDistance}set scrollDistance(n){this._scrollTo(n)}_scrollHeader(n){return this._scrollTo(this._scrollDistance+("before"==n?-1:1)*this._tabListContainer.nativeElement.offsetWidth/3)}_handlePaginatorClick(n){this._stopInterval(),this._scrollHeader(n)}_scrollToLabel(n){if(this.disablePagination)return;const r=this._items?this._items.toArray()[n]:null;if(!r)return;const o=this._tabListContainer.nativeElement.offsetWidth,{offsetLeft:s,offsetWidth:a}=r.elementRef.nativeElement;let c,l;"ltr"==this._getLayoutDirection()?(c=s,l=c+a):(l=this._tabListInner.nativeElement.offsetWidth-s,c=l-a);const d=this.scrollDistance,u=this.scrollDistance+o;c<d?this.scrollDistance-=d-c:l>u&&(this.scrollDistance+=Math.min(l-u,c-d))}_checkPaginationEnabled(){if(this.disablePagination)this._showPaginationControls=!1;else{const n=this._tabListInner.nativeElement.scrollWidth>this._elementRef.nativeElement.offsetWidth;n||(this.scrollDistance=0),n!==this._showPaginationControls&&this._changeDetectorRef.markForCheck(),this._showPaginationControls=n}}_checkScrollingControls(){this.disablePagination?this._disableScrollAfter=this._disableScrollBefore=!0:(this._disableScrollBefore=0==this.scrollDistance,this._disableScrollAfter=this.scrollDistance==this._getMaxScrollDistance(),this._changeDetectorRef.markForCheck())}_getMaxScrollDistance(){return this._tabListInner.nativeElement.scrollWidth-this._tabListContainer.nativeElement.offsetWidth||0}_alignInkBarToSelectedTab(){const n=this._items&&this._items.length?this._items.toArray()[this.selectedIndex]:null,r=n?n.elementRef.nativeElement:null;r?this._inkBar.alignToElement(r):this._inkBar.hide()}_stopInterval(){this._stopScrolling.next()}_handlePaginatorPress(n,r){r&&null!=r.button&&0!==r.button||(this._stopInterval(),Xv(650,100).pipe(ce(Ot(this._stopScrolling,this._destroyed))).subscribe(()=>{const{maxScrollDistance:o,distance:s}=this._scrollHeader(n);(0===s||s>=o)&&this._stopInterval()}))}_scrollTo(n){if(this.disablePagination)return{maxScrollDistance:0,distance:0};const r=this._getMaxScrollDistance();return this._scrollDistance=Math.max(0,Math.min(r,n)),this._scrollDistanceChanged=!0,this._checkScrollingControls(),{maxScrollDistance:r,distance:this._scrollDistance}}}return(e=t).\u0275fac=function(n){return new(n||e)(m(W),m(Fe),m(Rr),m(fn,8),m(G),m(nt),m(_t,8))},e.\u0275dir=T({type:e,inputs:{disablePagination:"disablePagination"}}),t})(),Jie=(()=>{var e;class t extends Zie{get disableRipple(){return this._disableRipple}set disableRipple(n){this._disableRipple=Q(n)}constructor(n,r,o,s,a,c,l){super(n,r,o,s,a,c,l),this._disableRipple=!1}_itemSelected(n){n.preventDefault()}}return(e=t).\u0275fac=function(n){return new(n||e)(m(W),m(Fe),m(Rr),m(fn,8),m(G),m(nt),m(_t,8))},e.\u0275dir=T({type:e,inputs:{disableRipple:"disableRipple"},features:[P]}),t})(),ere=(()=>{var e;class t extends Jie{constructor(n,r,o,s,a,c,l){super(n,r,o,s,a,c,l)}ngAfterContentInit(){this._inkBar=new Uie(this._items),super.ngAfterContentInit()}}return(e=t).\u0275fac=function(n){return new(n||e)(m(W),m(Fe),m(Rr),m(fn,8),m(G),m(nt),m(_t,8))},e.\u0275cmp=fe({type:e,selectors:[["mat-tab-header"]],contentQueries:function(n,r,o){if(1&n&&Ee(o,WP,4),2&n){let s;H(s=z())&&(r._items=s)}},viewQuery:function(n,r){if(1&n&&(ke(Eie,7),ke(Sie,7),ke(kie,7),ke(Tie,5),ke(Mie,5)),2&n){let o;H(o=z())&&(r._tabListContainer=o.first),H(o=z())&&(r._tabList=o.first),H(o=z())&&(r._tabListInner=o.first),H(o=z())&&(r._nextPaginator=o.first),H(o=z())&&(r._previousPaginator=o.first)}},hostAttrs:[1,"mat-mdc-tab-header"],hostVars:4,hostBindings:function(n,r){2&n&&se("mat-mdc-tab-header-pagination-controls-enabled",r._showPaginationControls)("mat-mdc-tab-header-rtl","rtl"==r._getLayoutDirection())},inputs:{selectedIndex:"selectedIndex"},outputs:{selectFocusedIndex:"selectFocusedIndex",indexFocused:"indexFocused"},features:[P],ngContentSelectors:zP,decls:13,vars:10,consts:[["aria-hidden","true","type","button","mat-ripple","","tabindex","-1",1,"mat-mdc-tab-header-pagination","mat-mdc-tab-header-pagination-before",3,"matRippleDisabled","disabled","click","mousedown","touchend"],["previousPaginator",""],[1,"mat-mdc-tab-header-pagination-chevron"],[1,"mat-mdc-tab-label-container",3,"keydown"],["tabListContainer",""],["role","tablist",1,"mat-mdc-tab-list",3,"cdkObserveContent"],["tabList",""],[1,"mat-mdc-tab-labels"],["tabListInner",""],["aria-hidden","true","type","button","mat-ripple","","tabindex","-1",1,"mat-mdc-tab-header-pagination","mat-mdc-tab-header-pagination-after",3,"matRippleDisabled","disabled","mousedown","click","touchend"],["nextPaginator",""]],template:function(n,r){1&n&&(ze(),y(0,"button",0,1),$("click",function(){return r._handlePaginatorClick("before")})("mousedown",function(s){return r._handlePaginatorPress("before",s)})("touchend",function(){return r._stopInterval()}),ie(2,"div",2),w(),y(3,"div",3,4),$("keydown",function(s){return r._handleKeydown(s)}),y(5,"div",5,6),$("cdkObserveContent",function(){return r._onContentChanges()}),y(7,"div",7,8),X(9),w()()(),y(10,"button",9,10),$("mousedown",function(s){return r._handlePaginatorPress("after",s)})("click",function(){return r._handlePaginatorClick("after")})("touchend",function(){return r._stopInterval()}),ie(12,"div",2),w()),2&n&&(se("mat-mdc-tab-header-pagination-disabled",r._disableScrollBefore),E("matRippleDisabled",r._disableScrollBefore||r.disableRipple)("disabled",r._disableScrollBefore||null),x(3),se("_mat-animation-noopable","NoopAnimations"===r._animationMode),x(7),se("mat-mdc-tab-header-pagination-disabled",r._disableScrollAfter),E("matRippleDisabled",r._disableScrollAfter||r.disableRipple)("disabled",r._disableScrollAfter||null))},dependencies:[ao,f7],styles:[".mat-mdc-tab-header{display:flex;overflow:hidden;position:relative;flex-shrink:0;--mdc-tab-indicator-active-indicator-height:2px;--mdc-tab-indicator-active-indicator-shape:0;--mdc-secondary-navigation-tab-container-height:48px}.mdc-tab-indicator .mdc-tab-indicator__content{transition-duration:var(--mat-tab-animation-duration, 250ms)}.mat-mdc-tab-header-pagination{-webkit-user-select:none;user-select:none;position:relative;display:none;justify-content:center;align-items:center;min-width:32px;cursor:pointer;z-index:2;-webkit-tap-highlight-color:rgba(0,0,0,0);touch-action:none;box-sizing:content-box;background:none;border:none;outline:0;padding:0}.mat-mdc-tab-header-pagination::-moz-focus-inner{border:0}.mat-mdc-tab-header-pagination .mat-ripple-element{opacity:.12;background-color:var(--mat-tab-header-inactive-ripple-color)}.mat-mdc-tab-header-pagination-controls-enabled .mat-mdc-tab-header-pagination{display:flex}.mat-mdc-tab-header-pagination-before,.mat-mdc-tab-header-rtl .mat-mdc-tab-header-pagination-after{padding-left:4px}.mat-mdc-tab-header-pagination-before .mat-mdc-tab-header-pagination-chevron,.mat-mdc-tab-header-rtl .mat-mdc-tab-header-pagination-after .mat-mdc-tab-header-pagination-chevron{transform:rotate(-135deg)}.mat-mdc-tab-header-rtl .mat-mdc-tab-header-pagination-before,.mat-mdc-tab-header-pagination-after{padding-right:4px}.mat-mdc-tab-header-rtl .mat-mdc-tab-header-pagination-before .mat-mdc-tab-header-pagination-chevron,.mat-mdc-tab-header-pagination-after .mat-mdc-tab-header-pagination-chevron{transform:rotate(45deg)}.mat-mdc-tab-header-pagination-chevron{border-style:solid;border-width:2px 2px 0 0;height:8px;width:8px;border-color:var(--mat-tab-header-pagination-icon-color)}.mat-mdc-tab-header-pagination-disabled{box-shadow:none;cursor:default;pointer-events:none}.mat-mdc-tab-header-pagination-disabled .mat-mdc-tab-header-pagination-chevron{opacity:.4}.mat-mdc-tab-list{flex-grow:1;position:relative;transition:transform 500ms cubic-bezier(0.35, 0, 0.25, 1)}._mat-animation-noopable .mat-mdc-tab-list{transition:none}._mat-animation-noopable span.mdc-tab-indicator__content,._mat-animation-noopable span.mdc-tab__text-label{transition:none}.mat-mdc-tab-label-container{display:flex;flex-grow:1;overflow:hidden;z-index:1}.mat-mdc-tab-labels{display:flex;flex:1 0 auto}[mat-align-tabs=center]>.mat-mdc-tab-header .mat-mdc-tab-labels{justify-content:center}[mat-align-tabs=end]>.mat-mdc-tab-header .mat-mdc-tab-labels{justify-content:flex-end}.mat-mdc-tab::before{margin:5px}.cdk-high-contrast-active .mat-mdc-tab[aria-disabled=true]{color:GrayText}"],encapsulation:2}),t})();const XP=new M("MAT_TABS_CONFIG");let tre=0;const nre=ts(so(class{constructor(e){this._elementRef=e}}),"primary");let ire=(()=>{var e;class t extends nre{get dynamicHeight(){return this._dynamicHeight}set dynamicHeight(n){this._dynamicHeight=Q(n)}get selectedIndex(){return this._selectedIndex}set selectedIndex(n){this._indexToSelect=Bi(n,null)}get animationDuration(){return this._animationDuration}set animationDuration(n){this._animationDuration=/^\d+$/.test(n+"")?n+"ms":n}get contentTabIndex(){return this._contentTabIndex}set contentTabIndex(n){this._contentTabIndex=Bi(n,null)}get disablePagination(){return this._disablePagination}set disablePagination(n){this._disablePagination=Q(n)}get preserveContent(){return this._preserveContent}set preserveContent(n){this._preserveContent=Q(n)}get backgroundColor(){return this._backgroundColor}set backgroundColor(n){const r=this._elementRef.nativeElement.classList;r.remove("mat-tabs-with-background",`mat-background-${this.backgroundColor}`),n&&r.add("mat-tabs-with-background",`mat-background-${n}`),this._backgroundColor=n}constructor(n,r,o,s){super(n),this._changeDetectorRef=r,this._animationMode=s,this._tabs=new Cr,this._indexToSelect=0,this._lastFocusedTabIndex=null,this._tabBodyWrapperHeight=0,this._tabsSubscription=Ae.EMPTY,this._tabLabelSubscription=Ae.EMPTY,this._dynamicHeight=!1,this._selectedIndex=null,this.headerPosition="above",this._disablePagination=!1,this._preserveContent=!1,this.selectedIndexChange=new U,this.focusChange=new U,this.animationDone=new U,this.selectedTabChange=new U(!0),this._groupId=tre++,this.animationDuration=o&&o.animationDuration?o.animationDuration:"500ms",this.disablePagination=!(!o||null==o.disablePagination)&&o.disablePagination,this.dynamicHeight=!(!o||null==o.dynamicHeight)&&o.dynamicHeight,this.contentTabIndex=o?.contentTabIndex??null,this.preserveContent=!!o?.preserveContent}ngAfterContentChecked(){const n=this._indexToSelect=this._clampTabIndex(this._indexToSelect);if(this._selectedIndex!=n){const r=null==this._selectedIndex;if(!r){this.selectedTabChange.emit(this._createChangeEvent(n));const o=this._tabBodyWrapper.nativeElement;o.style.minHeight=o.clientHeight+"px"}Promise.resolve().then(()=>{this._tabs.forEach((o,s)=>o.isActive=s===n),r||(this.selectedIndexChange.emit(n),this._tabBodyWrapper.nativeElement.style.minHeight="")})}this._tabs.forEach((r,o)=>{r.position=o-n,null!=this._selectedIndex&&0==r.position&&!r.origin&&(r.origin=n-this._selectedIndex)}),this._selectedIndex!==n&&(this._selectedIndex=n,this._lastFocusedTabIndex=null,this._changeDetectorRef.markForCheck())}ngAfterContentInit(){this._subscribeToAllTabChanges(),this._subscribeToTabLabels(),this._tabsSubscription=this._tabs.changes.subscribe(()=>{const n=this._clampTabIndex(this._indexToSelect);if(n===this._selectedIndex){const r=this._tabs.toArray();let o;for(let s=0;s<r.length;s++)if(r[s].isActive){this._indexToSelect=this._selectedIndex=s,this._lastFocusedTabIndex=null,o=r[s];break}!o&&r[n]&&Promise.resolve().then(()=>{r[n].isActive=!0,this.selectedTabChange.emit(this._createChangeEvent(n))})}this._changeDetectorRef.markForCheck()})}_subscribeToAllTabChanges(){this._allTabs.changes.pipe(tn(this._allTabs)).subscribe(n=>{this._tabs.reset(n.filter(r=>r._closestTabGroup===this||!r._closestTabGroup)),this._tabs.notifyOnChanges()})}ngOnDestroy(){this._tabs.destroy(),this._tabsSubscription.unsubscribe(),this._tabLabelSubscription.unsubscribe()}realignInkBar(){this._tabHeader&&this._tabHeader._alignInkBarToSelectedTab()}updatePagination(){this._tabHeader&&this._tabHeader.updatePagination()}focusTab(n){const r=this._tabHeader;r&&(r.focusIndex=n)}_focusChanged(n){this._lastFocusedTabIndex=n,this.focusChange.emit(this._createChangeEvent(n))}_createChangeEvent(n){const r=new ore;return r.index=n,this._tabs&&this._tabs.length&&(r.tab=this._tabs.toArray()[n]),r}_subscribeToTabLabels(){this._tabLabelSubscription&&this._tabLabelSubscription.unsubscribe(),this._tabLabelSubscription=Ot(...this._tabs.map(n=>n._stateChanges)).subscribe(()=>this._changeDetectorRef.markForCheck())}_clampTabIndex(n){return Math.min(this._tabs.length-1,Math.max(n||0,0))}_getTabLabelId(n){return`mat-tab-label-${this._groupId}-${n}`}_getTabContentId(n){return`mat-tab-content-${this._groupId}-${n}`}_setTabBodyWrapperHeight(n){if(!this._dynamicHeight||!this._tabBodyWrapperHeight)return;const r=this._tabBodyWrapper.nativeElement;r.style.height=this._tabBodyWrapperHeight+"px",this._tabBodyWrapper.nativeElement.offsetHeight&&(r.style.height=n+"px")}_removeTabBodyWrapperHeight(){const n=this._tabBodyWrapper.nativeElement;this._tabBodyWrapperHeight=n.clientHeight,n.style.height="",this.animationDone.emit()}_handleClick(n,r,o){r.focusIndex=o,n.disabled||(this.selectedIndex=o)}_getTabIndex(n){return n===(this._lastFocusedTabIndex??this.selectedIndex)?0:-1}_tabFocusChanged(n,r){n&&"mouse"!==n&&"touch"!==n&&(this._tabHeader.focusIndex=r)}}return(e=t).\u0275fac=function(n){return new(n||e)(m(W),m(Fe),m(XP,8),m(_t,8))},e.\u0275dir=T({type:e,inputs:{dynamicHeight:"dynamicHeight",selectedIndex:"selectedIndex",headerPosition:"headerPosition",animationDuration:"animationDuration",contentTabIndex:"contentTabIndex",disablePagination:"disablePagination",preserveContent:"preserveContent",backgroundColor:"backgroundColor"},outputs:{selectedIndexChange:"selectedIndexChange",focusChange:"focusChange",animationDone:"animationDone",selectedTabChange:"selectedTabChange"},features:[P]}),t})(),rre=(()=>{var e;class t extends ire{get fitInkBarToContent(){return this._fitInkBarToContent}set fitInkBarToContent(n){this._fitInkBarToContent=Q(n),this._changeDetectorRef.markForCheck()}get stretchTabs(){return this._stretchTabs}set stretchTabs(n){this._stretchTabs=Q(n)}constructor(n,r,o,s){super(n,r,o,s),this._fitInkBarToContent=!1,this._stretchTabs=!0,this.fitInkBarToContent=!(!o||null==o.fitInkBarToContent)&&o.fitInkBarToContent,this.stretchTabs=!o||null==o.stretchTabs||o.stretchTabs}}return(e=t).\u0275fac=function(n){return new(n||e)(m(W),m(Fe),m(XP,8),m(_t,8))},e.\u0275cmp=fe({type:e,selectors:[["mat-tab-group"]],contentQueries:function(n,r,o){if(1&n&&Ee(o,YP,5),2&n){let s;H(s=z())&&(r._allTabs=s)}},viewQuery:function(n,r){if(1&n&&(ke(Iie,5),ke(Aie,5)),2&n){let o;H(o=z())&&(r._tabBodyWrapper=o.first),H(o=z())&&(r._tabHeader=o.first)}},hostAttrs:["ngSkipHydration","",1,"mat-mdc-tab-group"],hostVars:8,hostBindings:function(n,r){2&n&&(kn("--mat-tab-animation-duration",r.animationDuration),se("mat-mdc-tab-group-dynamic-height",r.dynamicHeight)("mat-mdc-tab-group-inverted-header","below"===r.headerPosition)("mat-mdc-tab-group-stretch-tabs",r.stretchTabs))},inputs:{color:"color",disableRipple:"disableRipple",fitInkBarToContent:"fitInkBarToContent",stretchTabs:["mat-stretch-tabs","stretchTabs"]},exportAs:["matTabGroup"],features:[Z([{provide:QP,useExisting:e}]),P],decls:6,vars:7,consts:[[3,"selectedIndex","disableRipple","disablePagination","indexFocused","selectFocusedIndex"],["tabHeader",""],["class","mdc-tab mat-mdc-tab mat-mdc-focus-indicator","role","tab","matTabLabelWrapper","","cdkMonitorElementFocus","",3,"id","mdc-tab--active","ngClass","disabled","fitInkBarToContent","click","cdkFocusChange",4,"ngFor","ngForOf"],[1,"mat-mdc-tab-body-wrapper"],["tabBodyWrapper",""],["role","tabpanel",3,"id","mat-mdc-tab-body-active","ngClass","content","position","origin","animationDuration","preserveContent","_onCentered","_onCentering",4,"ngFor","ngForOf"],["role","tab","matTabLabelWrapper","","cdkMonitorElementFocus","",1,"mdc-tab","mat-mdc-tab","mat-mdc-focus-indicator",3,"id","ngClass","disabled","fitInkBarToContent","click","cdkFocusChange"],["tabNode",""],[1,"mdc-tab__ripple"],["mat-ripple","",1,"mat-mdc-tab-ripple",3,"matRippleTrigger","matRippleDisabled"],[1,"mdc-tab__content"],[1,"mdc-tab__text-label"],[3,"ngIf","ngIfElse"],["tabTextLabel",""],[3,"cdkPortalOutlet"],["role","tabpanel",3,"id","ngClass","content","position","origin","animationDuration","preserveContent","_onCentered","_onCentering"]],template:function(n,r){1&n&&(y(0,"mat-tab-header",0,1),$("indexFocused",function(s){return r._focusChanged(s)})("selectFocusedIndex",function(s){return r.selectedIndex=s}),R(2,Pie,9,17,"div",2),w(),y(3,"div",3,4),R(5,Nie,1,12,"mat-tab-body",5),w()),2&n&&(E("selectedIndex",r.selectedIndex||0)("disableRipple",r.disableRipple)("disablePagination",r.disablePagination),x(2),E("ngForOf",r._tabs),x(1),se("_mat-animation-noopable","NoopAnimations"===r._animationMode),x(2),E("ngForOf",r._tabs))},dependencies:[Ea,Wh,_i,La,ao,Q7,UP,WP,ere],styles:['.mdc-tab{min-width:90px;padding-right:24px;padding-left:24px;display:flex;flex:1 0 auto;justify-content:center;box-sizing:border-box;margin:0;padding-top:0;padding-bottom:0;border:none;outline:none;text-align:center;white-space:nowrap;cursor:pointer;-webkit-appearance:none;z-index:1}.mdc-tab::-moz-focus-inner{padding:0;border:0}.mdc-tab[hidden]{display:none}.mdc-tab--min-width{flex:0 1 auto}.mdc-tab__content{display:flex;align-items:center;justify-content:center;height:inherit;pointer-events:none}.mdc-tab__text-label{transition:150ms color linear;display:inline-block;line-height:1;z-index:2}.mdc-tab__icon{transition:150ms color linear;z-index:2}.mdc-tab--stacked .mdc-tab__content{flex-direction:column;align-items:center;justify-content:center}.mdc-tab--stacked .mdc-tab__text-label{padding-top:6px;padding-bottom:4px}.mdc-tab--active .mdc-tab__text-label,.mdc-tab--active .mdc-tab__icon{transition-delay:100ms}.mdc-tab:not(.mdc-tab--stacked) .mdc-tab__icon+.mdc-tab__text-label{padding-left:8px;padding-right:0}[dir=rtl] .mdc-tab:not(.mdc-tab--stacked) .mdc-tab__icon+.mdc-tab__text-label,.mdc-tab:not(.mdc-tab--stacked) .mdc-tab__icon+.mdc-tab__text-label[dir=rtl]{padding-left:0;padding-right:8px}.mdc-tab-indicator{display:flex;position:absolute;top:0;left:0;justify-content:center;width:100%;height:100%;pointer-events:none;z-index:1}.mdc-tab-indicator__content{transform-origin:left;opacity:0}.mdc-tab-indicator__content--underline{align-self:flex-end;box-sizing:border-box;width:100%;border-top-style:solid}.mdc-tab-indicator__content--icon{align-self:center;margin:0 auto}.mdc-tab-indicator--active .mdc-tab-indicator__content{opacity:1}.mdc-tab-indicator .mdc-tab-indicator__content{transition:250ms transform cubic-bezier(0.4, 0, 0.2, 1)}.mdc-tab-indicator--no-transition .mdc-tab-indicator__content{transition:none}.mdc-tab-indicator--fade .mdc-tab-indicator__content{transition:150ms opacity linear}.mdc-tab-indicator--active.mdc-tab-indicator--fade .mdc-tab-indicator__content{transition-delay:100ms}.mat-mdc-tab-ripple{position:absolute;top:0;left:0;bottom:0;right:0;pointer-events:none}.mat-mdc-tab{-webkit-tap-highlight-color:rgba(0,0,0,0);-webkit-font-smoothing:antialiased;-moz-osx-font-smoothing:grayscale;text-decoration:none;background:none;font-family:var(--mat-tab-header-label-text-font);font-size:var(--mat-tab-header-label-text-size);letter-spacing:var(--mat-tab-header-label-text-tracking);line-height:var(--mat-tab-header-label-text-line-height);font-weight:var(--mat-tab-header-label-text-weight)}.mat-mdc-tab .mdc-tab-indicator__content--underline{border-color:var(--mdc-tab-indicator-active-indicator-color)}.mat-mdc-tab .mdc-tab-indicator__content--underline{border-top-width:var(--mdc-tab-indicator-active-indicator-height)}.mat-mdc-tab .mdc-tab-indicator__content--underline{border-radius:var(--mdc-tab-indicator-active-indicator-shape)}.mat-mdc-tab:not(.mdc-tab--stacked){height:var(--mdc-secondary-navigation-tab-container-height)}.mat-mdc-tab:not(:disabled).mdc-tab--active .mdc-tab__icon{fill:currentColor}.mat-mdc-tab:not(:disabled):hover.mdc-tab--active .mdc-tab__icon{fill:currentColor}.mat-mdc-tab:not(:disabled):focus.mdc-tab--active .mdc-tab__icon{fill:currentColor}.mat-mdc-tab:not(:disabled):active.mdc-tab--active .mdc-tab__icon{fill:currentColor}.mat-mdc-tab:disabled.mdc-tab--active .mdc-tab__icon{fill:currentColor}.mat-mdc-tab:not(:disabled):not(.mdc-tab--active) .mdc-tab__icon{fill:currentColor}.mat-mdc-tab:not(:disabled):hover:not(.mdc-tab--active) .mdc-tab__icon{fill:currentColor}.mat-mdc-tab:not(:disabled):focus:not(.mdc-tab--active) .mdc-tab__icon{fill:currentColor}.mat-mdc-tab:not(:disabled):active:not(.mdc-tab--active) .mdc-tab__icon{fill:currentColor}.mat-mdc-tab:disabled:not(.mdc-tab--active) .mdc-tab__icon{fill:currentColor}.mat-mdc-tab.mdc-tab{flex-grow:0}.mat-mdc-tab:hover .mdc-tab__text-label{color:var(--mat-tab-header-inactive-hover-label-text-color)}.mat-mdc-tab:focus .mdc-tab__text-label{color:var(--mat-tab-header-inactive-focus-label-text-color)}.mat-mdc-tab.mdc-tab--active .mdc-tab__text-label{color:var(--mat-tab-header-active-label-text-color)}.mat-mdc-tab.mdc-tab--active .mdc-tab__ripple::before,.mat-mdc-tab.mdc-tab--active .mat-ripple-element{background-color:var(--mat-tab-header-active-ripple-color)}.mat-mdc-tab.mdc-tab--active:hover .mdc-tab__text-label{color:var(--mat-tab-header-active-hover-label-text-color)}.mat-mdc-tab.mdc-tab--active:hover .mdc-tab-indicator__content--underline{border-color:var(--mat-tab-header-active-hover-indicator-color)}.mat-mdc-tab.mdc-tab--active:focus .mdc-tab__text-label{color:var(--mat-tab-header-active-focus-label-text-color)}.mat-mdc-tab.mdc-tab--active:focus .mdc-tab-indicator__content--underline{border-color:var(--mat-tab-header-active-focus-indicator-color)}.mat-mdc-tab.mat-mdc-tab-disabled{opacity:.4;pointer-events:none}.mat-mdc-tab.mat-mdc-tab-disabled .mdc-tab__content{pointer-events:none}.mat-mdc-tab.mat-mdc-tab-disabled .mdc-tab__ripple::before,.mat-mdc-tab.mat-mdc-tab-disabled .mat-ripple-element{background-color:var(--mat-tab-header-disabled-ripple-color)}.mat-mdc-tab .mdc-tab__ripple::before{content:"";display:block;position:absolute;top:0;left:0;right:0;bottom:0;opacity:0;pointer-events:none;background-color:var(--mat-tab-header-inactive-ripple-color)}.mat-mdc-tab .mdc-tab__text-label{color:var(--mat-tab-header-inactive-label-text-color);display:inline-flex;align-items:center}.mat-mdc-tab .mdc-tab__content{position:relative;pointer-events:auto}.mat-mdc-tab:hover .mdc-tab__ripple::before{opacity:.04}.mat-mdc-tab.cdk-program-focused .mdc-tab__ripple::before,.mat-mdc-tab.cdk-keyboard-focused .mdc-tab__ripple::before{opacity:.12}.mat-mdc-tab .mat-ripple-element{opacity:.12;background-color:var(--mat-tab-header-inactive-ripple-color)}.mat-mdc-tab-group.mat-mdc-tab-group-stretch-tabs>.mat-mdc-tab-header .mat-mdc-tab{flex-grow:1}.mat-mdc-tab-group{display:flex;flex-direction:column;max-width:100%}.mat-mdc-tab-group.mat-tabs-with-background>.mat-mdc-tab-header,.mat-mdc-tab-group.mat-tabs-with-background>.mat-mdc-tab-header-pagination{background-color:var(--mat-tab-header-with-background-background-color)}.mat-mdc-tab-group.mat-tabs-with-background.mat-primary>.mat-mdc-tab-header .mat-mdc-tab .mdc-tab__text-label{color:var(--mat-tab-header-with-background-foreground-color)}.mat-mdc-tab-group.mat-tabs-with-background.mat-primary>.mat-mdc-tab-header .mdc-tab-indicator__content--underline{border-color:var(--mat-tab-header-with-background-foreground-color)}.mat-mdc-tab-group.mat-tabs-with-background:not(.mat-primary)>.mat-mdc-tab-header .mat-mdc-tab:not(.mdc-tab--active) .mdc-tab__text-label{color:var(--mat-tab-header-with-background-foreground-color)}.mat-mdc-tab-group.mat-tabs-with-background:not(.mat-primary)>.mat-mdc-tab-header .mat-mdc-tab:not(.mdc-tab--active) .mdc-tab-indicator__content--underline{border-color:var(--mat-tab-header-with-background-foreground-color)}.mat-mdc-tab-group.mat-tabs-with-background>.mat-mdc-tab-header .mat-mdc-tab-header-pagination-chevron,.mat-mdc-tab-group.mat-tabs-with-background>.mat-mdc-tab-header .mat-mdc-focus-indicator::before,.mat-mdc-tab-group.mat-tabs-with-background>.mat-mdc-tab-header-pagination .mat-mdc-tab-header-pagination-chevron,.mat-mdc-tab-group.mat-tabs-with-background>.mat-mdc-tab-header-pagination .mat-mdc-focus-indicator::before{border-color:var(--mat-tab-header-with-background-foreground-color)}.mat-mdc-tab-group.mat-tabs-with-background>.mat-mdc-tab-header .mat-ripple-element,.mat-mdc-tab-group.mat-tabs-with-background>.mat-mdc-tab-header .mdc-tab__ripple::before,.mat-mdc-tab-group.mat-tabs-with-background>.mat-mdc-tab-header-pagination .mat-ripple-element,.mat-mdc-tab-group.mat-tabs-with-background>.mat-mdc-tab-header-pagination .mdc-tab__ripple::before{background-color:var(--mat-tab-header-with-background-foreground-color)}.mat-mdc-tab-group.mat-tabs-with-background>.mat-mdc-tab-header .mat-mdc-tab-header-pagination-chevron,.mat-mdc-tab-group.mat-tabs-with-background>.mat-mdc-tab-header-pagination .mat-mdc-tab-header-pagination-chevron{color:var(--mat-tab-header-with-background-foreground-color)}.mat-mdc-tab-group.mat-mdc-tab-group-inverted-header{flex-direction:column-reverse}.mat-mdc-tab-group.mat-mdc-tab-group-inverted-header .mdc-tab-indicator__content--underline{align-self:flex-start}.mat-mdc-tab-body-wrapper{position:relative;overflow:hidden;display:flex;transition:height 500ms cubic-bezier(0.35, 0, 0.25, 1)}.mat-mdc-tab-body-wrapper._mat-animation-noopable{transition:none !important;animation:none !important}'],encapsulation:2}),t})();class ore{}let sre=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275mod=le({type:e}),e.\u0275inj=ae({imports:[vn,ve,qf,is,Mv,$I,ve]}),t})();const are=["trigger"],cre=["panel"];function lre(e,t){if(1&e&&(y(0,"span",10),A(1),w()),2&e){const i=B();x(1),bt(i.placeholder)}}function dre(e,t){if(1&e&&(y(0,"span",14),A(1),w()),2&e){const i=B(2);x(1),bt(i.triggerValue)}}function ure(e,t){1&e&&X(0,0,["*ngSwitchCase","true"])}function hre(e,t){1&e&&(y(0,"span",11),R(1,dre,2,1,"span",12),R(2,ure,1,0,"ng-content",13),w()),2&e&&(E("ngSwitch",!!B().customTrigger),x(2),E("ngSwitchCase",!0))}function fre(e,t){if(1&e){const i=Ut();zs(),ag(),y(0,"div",15,16),$("@transformPanel.done",function(r){return Ye(i),Ke(B()._panelDoneAnimatingStream.next(r.toState))})("keydown",function(r){return Ye(i),Ke(B()._handleKeydown(r))}),X(2,1),w()}if(2&e){const i=B();(function mS(e,t,i){Fi(Xn,rr,da(F(),e,t,i),!0)})("mat-mdc-select-panel mdc-menu-surface mdc-menu-surface--open ",i._getPanelTheme(),""),E("ngClass",i.panelClass)("@transformPanel","showing"),de("id",i.id+"-panel")("aria-multiselectable",i.multiple)("aria-label",i.ariaLabel||null)("aria-labelledby",i._getPanelAriaLabelledby())}}const pre=[[["mat-select-trigger"]],"*"],mre=["mat-select-trigger","*"],gre={transformPanelWrap:ni("transformPanelWrap",[Bt("* => void",K$("@transformPanel",[Y$()],{optional:!0}))]),transformPanel:ni("transformPanel",[qt("void",je({opacity:0,transform:"scale(1, 0.8)"})),Bt("void => showing",Lt("120ms cubic-bezier(0, 0, 0.2, 1)",je({opacity:1,transform:"scale(1, 1)"}))),Bt("* => void",Lt("100ms linear",je({opacity:0})))])};let ZP=0;const JP=new M("mat-select-scroll-strategy"),bre=new M("MAT_SELECT_CONFIG"),vre={provide:JP,deps:[wi],useFactory:function _re(e){return()=>e.scrollStrategies.reposition()}},yre=new M("MatSelectTrigger");class wre{constructor(t,i){this.source=t,this.value=i}}const xre=so(ns(es(jv(class{constructor(e,t,i,n,r){this._elementRef=e,this._defaultErrorStateMatcher=t,this._parentForm=i,this._parentFormGroup=n,this.ngControl=r,this.stateChanges=new Y}}))));let Cre=(()=>{var e;class t extends xre{get focused(){return this._focused||this._panelOpen}get placeholder(){return this._placeholder}set placeholder(n){this._placeholder=n,this.stateChanges.next()}get required(){return this._required??this.ngControl?.control?.hasValidator(Ay.required)??!1}set required(n){this._required=Q(n),this.stateChanges.next()}get multiple(){return this._multiple}set multiple(n){this._multiple=Q(n)}get disableOptionCentering(){return this._disableOptionCentering}set disableOptionCentering(n){this._disableOptionCentering=Q(n)}get compareWith(){return this._compareWith}set compareWith(n){this._compareWith=n,this._selectionModel&&this._initializeSelection()}get value(){return this._value}set value(n){this._assignValue(n)&&this._onChange(n)}get typeaheadDebounceInterval(){return this._typeaheadDebounceInterval}set typeaheadDebounceInterval(n){this._typeaheadDebounceInterval=Bi(n)}get id(){return this._id}set id(n){this._id=n||this._uid,this.stateChanges.next()}constructor(n,r,o,s,a,c,l,d,u,h,f,p,g,b){super(a,s,l,d,h),this._viewportRuler=n,this._changeDetectorRef=r,this._ngZone=o,this._dir=c,this._parentFormField=u,this._liveAnnouncer=g,this._defaultOptions=b,this._panelOpen=!1,this._compareWith=(_,v)=>_===v,this._uid="mat-select-"+ZP++,this._triggerAriaLabelledBy=null,this._destroy=new Y,this._onChange=()=>{},this._onTouched=()=>{},this._valueId="mat-select-value-"+ZP++,this._panelDoneAnimatingStream=new Y,this._overlayPanelClass=this._defaultOptions?.overlayPanelClass||"",this._focused=!1,this.controlType="mat-select",this._multiple=!1,this._disableOptionCentering=this._defaultOptions?.disableOptionCentering??!1,this.ariaLabel="",this.optionSelectionChanges=Kf(()=>{const _=this.options;return _?_.changes.pipe(tn(_),Vt(()=>Ot(..._.map(v=>v.onSelectionChange)))):this._ngZone.onStable.pipe(Xe(1),Vt(()=>this.optionSelectionChanges))}),this.openedChange=new U,this._openedStream=this.openedChange.pipe(Ve(_=>_),J(()=>{})),this._closedStream=this.openedChange.pipe(Ve(_=>!_),J(()=>{})),this.selectionChange=new U,this.valueChange=new U,this._trackedModal=null,this.ngControl&&(this.ngControl.valueAccessor=this),null!=b?.typeaheadDebounceInterval&&(this._typeaheadDebounceInterval=b.typeaheadDebounceInterval),this._scrollStrategyFactory=p,this._scrollStrategy=this._scrollStrategyFactory(),this.tabIndex=parseInt(f)||0,this.id=this.id}ngOnInit(){this._selectionModel=new qR(this.multiple),this.stateChanges.next(),this._panelDoneAnimatingStream.pipe(Is(),ce(this._destroy)).subscribe(()=>this._panelDoneAnimating(this.panelOpen))}ngAfterContentInit(){this._initKeyManager(),this._selectionModel.changed.pipe(ce(this._destroy)).subscribe(n=>{n.added.forEach(r=>r.select()),n.removed.forEach(r=>r.deselect())}),this.options.changes.pipe(tn(null),ce(this._destroy)).subscribe(()=>{this._resetOptions(),this._initializeSelection()})}ngDoCheck(){const n=this._getTriggerAriaLabelledby(),r=this.ngControl;if(n!==this._triggerAriaLabelledBy){const o=this._elementRef.nativeElement;this._triggerAriaLabelledBy=n,n?o.setAttribute("aria-labelledby",n):o.removeAttribute("aria-labelledby")}r&&(this._previousControl!==r.control&&(void 0!==this._previousControl&&null!==r.disabled&&r.disabled!==this.disabled&&(this.disabled=r.disabled),this._previousControl=r.control),this.updateErrorState())}ngOnChanges(n){(n.disabled||n.userAriaDescribedBy)&&this.stateChanges.next(),n.typeaheadDebounceInterval&&this._keyManager&&this._keyManager.withTypeAhead(this._typeaheadDebounceInterval)}ngOnDestroy(){this._keyManager?.destroy(),this._destroy.next(),this._destroy.complete(),this.stateChanges.complete(),this._clearFromModal()}toggle(){this.panelOpen?this.close():this.open()}open(){this._canOpen()&&(this._applyModalPanelOwnership(),this._panelOpen=!0,this._keyManager.withHorizontalOrientation(null),this._highlightCorrectOption(),this._changeDetectorRef.markForCheck())}_applyModalPanelOwnership(){const n=this._elementRef.nativeElement.closest('body > .cdk-overlay-container [aria-modal="true"]');if(!n)return;const r=`${this.id}-panel`;this._trackedModal&&ql(this._trackedModal,"aria-owns",r),Rv(n,"aria-owns",r),this._trackedModal=n}_clearFromModal(){this._trackedModal&&(ql(this._trackedModal,"aria-owns",`${this.id}-panel`),this._trackedModal=null)}close(){this._panelOpen&&(this._panelOpen=!1,this._keyManager.withHorizontalOrientation(this._isRtl()?"rtl":"ltr"),this._changeDetectorRef.markForCheck(),this._onTouched())}writeValue(n){this._assignValue(n)}registerOnChange(n){this._onChange=n}registerOnTouched(n){this._onTouched=n}setDisabledState(n){this.disabled=n,this._changeDetectorRef.markForCheck(),this.stateChanges.next()}get panelOpen(){return this._panelOpen}get selected(){return this.multiple?this._selectionModel?.selected||[]:this._selectionModel?.selected[0]}get triggerValue(){if(this.empty)return"";if(this._multiple){const n=this._selectionModel.selected.map(r=>r.viewValue);return this._isRtl()&&n.reverse(),n.join(", ")}return this._selectionModel.selected[0].viewValue}_isRtl(){return!!this._dir&&"rtl"===this._dir.value}_handleKeydown(n){this.disabled||(this.panelOpen?this._handleOpenKeydown(n):this._handleClosedKeydown(n))}_handleClosedKeydown(n){const r=n.keyCode,o=40===r||38===r||37===r||39===r,s=13===r||32===r,a=this._keyManager;if(!a.isTyping()&&s&&!An(n)||(this.multiple||n.altKey)&&o)n.preventDefault(),this.open();else if(!this.multiple){const c=this.selected;a.onKeydown(n);const l=this.selected;l&&c!==l&&this._liveAnnouncer.announce(l.viewValue,1e4)}}_handleOpenKeydown(n){const r=this._keyManager,o=n.keyCode,s=40===o||38===o,a=r.isTyping();if(s&&n.altKey)n.preventDefault(),this.close();else if(a||13!==o&&32!==o||!r.activeItem||An(n))if(!a&&this._multiple&&65===o&&n.ctrlKey){n.preventDefault();const c=this.options.some(l=>!l.disabled&&!l.selected);this.options.forEach(l=>{l.disabled||(c?l.select():l.deselect())})}else{const c=r.activeItemIndex;r.onKeydown(n),this._multiple&&s&&n.shiftKey&&r.activeItem&&r.activeItemIndex!==c&&r.activeItem._selectViaInteraction()}else n.preventDefault(),r.activeItem._selectViaInteraction()}_onFocus(){this.disabled||(this._focused=!0,this.stateChanges.next())}_onBlur(){this._focused=!1,this._keyManager?.cancelTypeahead(),!this.disabled&&!this.panelOpen&&(this._onTouched(),this._changeDetectorRef.markForCheck(),this.stateChanges.next())}_onAttached(){this._overlayDir.positionChange.pipe(Xe(1)).subscribe(()=>{this._changeDetectorRef.detectChanges(),this._positioningSettled()})}_getPanelTheme(){return this._parentFormField?`mat-${this._parentFormField.color}`:""}get empty(){return!this._selectionModel||this._selectionModel.isEmpty()}_initializeSelection(){Promise.resolve().then(()=>{this.ngControl&&(this._value=this.ngControl.value),this._setSelectionByValue(this._value),this.stateChanges.next()})}_setSelectionByValue(n){if(this.options.forEach(r=>r.setInactiveStyles()),this._selectionModel.clear(),this.multiple&&n)Array.isArray(n),n.forEach(r=>this._selectOptionByValue(r)),this._sortValues();else{const r=this._selectOptionByValue(n);r?this._keyManager.updateActiveItem(r):this.panelOpen||this._keyManager.updateActiveItem(-1)}this._changeDetectorRef.markForCheck()}_selectOptionByValue(n){const r=this.options.find(o=>{if(this._selectionModel.isSelected(o))return!1;try{return null!=o.value&&this._compareWith(o.value,n)}catch{return!1}});return r&&this._selectionModel.select(r),r}_assignValue(n){return!!(n!==this._value||this._multiple&&Array.isArray(n))&&(this.options&&this._setSelectionByValue(n),this._value=n,!0)}_skipPredicate(n){return n.disabled}_initKeyManager(){this._keyManager=new NI(this.options).withTypeAhead(this._typeaheadDebounceInterval).withVerticalOrientation().withHorizontalOrientation(this._isRtl()?"rtl":"ltr").withHomeAndEnd().withPageUpDown().withAllowedModifierKeys(["shiftKey"]).skipPredicate(this._skipPredicate),this._keyManager.tabOut.subscribe(()=>{this.panelOpen&&(!this.multiple&&this._keyManager.activeItem&&this._keyManager.activeItem._selectViaInteraction(),this.focus(),this.close())}),this._keyManager.change.subscribe(()=>{this._panelOpen&&this.panel?this._scrollOptionIntoView(this._keyManager.activeItemIndex||0):!this._panelOpen&&!this.multiple&&this._keyManager.activeItem&&this._keyManager.activeItem._selectViaInteraction()})}_resetOptions(){const n=Ot(this.options.changes,this._destroy);this.optionSelectionChanges.pipe(ce(n)).subscribe(r=>{this._onSelect(r.source,r.isUserInput),r.isUserInput&&!this.multiple&&this._panelOpen&&(this.close(),this.focus())}),Ot(...this.options.map(r=>r._stateChanges)).pipe(ce(n)).subscribe(()=>{this._changeDetectorRef.detectChanges(),this.stateChanges.next()})}_onSelect(n,r){const o=this._selectionModel.isSelected(n);null!=n.value||this._multiple?(o!==n.selected&&(n.selected?this._selectionModel.select(n):this._selectionModel.deselect(n)),r&&this._keyManager.setActiveItem(n),this.multiple&&(this._sortValues(),r&&this.focus())):(n.deselect(),this._selectionModel.clear(),null!=this.value&&this._propagateChanges(n.value)),o!==this._selectionModel.isSelected(n)&&this._propagateChanges(),this.stateChanges.next()}_sortValues(){if(this.multiple){const n=this.options.toArray();this._selectionModel.sort((r,o)=>this.sortComparator?this.sortComparator(r,o,n):n.indexOf(r)-n.indexOf(o)),this.stateChanges.next()}}_propagateChanges(n){let r=null;r=this.multiple?this.selected.map(o=>o.value):this.selected?this.selected.value:n,this._value=r,this.valueChange.emit(r),this._onChange(r),this.selectionChange.emit(this._getChangeEvent(r)),this._changeDetectorRef.markForCheck()}_highlightCorrectOption(){if(this._keyManager)if(this.empty){let n=-1;for(let r=0;r<this.options.length;r++)if(!this.options.get(r).disabled){n=r;break}this._keyManager.setActiveItem(n)}else this._keyManager.setActiveItem(this._selectionModel.selected[0])}_canOpen(){return!this._panelOpen&&!this.disabled&&this.options?.length>0}focus(n){this._elementRef.nativeElement.focus(n)}_getPanelAriaLabelledby(){if(this.ariaLabel)return null;const n=this._parentFormField?.getLabelId();return this.ariaLabelledby?(n?n+" ":"")+this.ariaLabelledby:n}_getAriaActiveDescendant(){return this.panelOpen&&this._keyManager&&this._keyManager.activeItem?this._keyManager.activeItem.id:null}_getTriggerAriaLabelledby(){if(this.ariaLabel)return null;const n=this._parentFormField?.getLabelId();let r=(n?n+" ":"")+this._valueId;return this.ariaLabelledby&&(r+=" "+this.ariaLabelledby),r}_panelDoneAnimating(n){this.openedChange.emit(n)}setDescribedByIds(n){n.length?this._elementRef.nativeElement.setAttribute("aria-describedby",n.join(" ")):this._elementRef.nativeElement.removeAttribute("aria-describedby")}onContainerClick(){this.focus(),this.open()}get shouldLabelFloat(){return this._panelOpen||!this.empty||this._focused&&!!this._placeholder}}return(e=t).\u0275fac=function(n){return new(n||e)(m(Rr),m(Fe),m(G),m(Ff),m(W),m(fn,8),m(Ya,8),m(Xa,8),m(Vd,8),m(Hi,10),ui("tabindex"),m(JP),m(Bv),m(bre,8))},e.\u0275dir=T({type:e,viewQuery:function(n,r){if(1&n&&(ke(are,5),ke(cre,5),ke(F1,5)),2&n){let o;H(o=z())&&(r.trigger=o.first),H(o=z())&&(r.panel=o.first),H(o=z())&&(r._overlayDir=o.first)}},inputs:{userAriaDescribedBy:["aria-describedby","userAriaDescribedBy"],panelClass:"panelClass",placeholder:"placeholder",required:"required",multiple:"multiple",disableOptionCentering:"disableOptionCentering",compareWith:"compareWith",value:"value",ariaLabel:["aria-label","ariaLabel"],ariaLabelledby:["aria-labelledby","ariaLabelledby"],errorStateMatcher:"errorStateMatcher",typeaheadDebounceInterval:"typeaheadDebounceInterval",sortComparator:"sortComparator",id:"id"},outputs:{openedChange:"openedChange",_openedStream:"opened",_closedStream:"closed",selectionChange:"selectionChange",valueChange:"valueChange"},features:[P,kt]}),t})(),Dre=(()=>{var e;class t extends Cre{constructor(){super(...arguments),this.panelWidth=this._defaultOptions&&typeof this._defaultOptions.panelWidth<"u"?this._defaultOptions.panelWidth:"auto",this._positions=[{originX:"start",originY:"bottom",overlayX:"start",overlayY:"top"},{originX:"end",originY:"bottom",overlayX:"end",overlayY:"top"},{originX:"start",originY:"top",overlayX:"start",overlayY:"bottom",panelClass:"mat-mdc-select-panel-above"},{originX:"end",originY:"top",overlayX:"end",overlayY:"bottom",panelClass:"mat-mdc-select-panel-above"}],this._hideSingleSelectionIndicator=this._defaultOptions?.hideSingleSelectionIndicator??!1,this._skipPredicate=n=>!this.panelOpen&&n.disabled}get shouldLabelFloat(){return this.panelOpen||!this.empty||this.focused&&!!this.placeholder}ngOnInit(){super.ngOnInit(),this._viewportRuler.change().pipe(ce(this._destroy)).subscribe(()=>{this.panelOpen&&(this._overlayWidth=this._getOverlayWidth(this._preferredOverlayOrigin),this._changeDetectorRef.detectChanges())})}open(){this._parentFormField&&(this._preferredOverlayOrigin=this._parentFormField.getConnectedOverlayOrigin()),this._overlayWidth=this._getOverlayWidth(this._preferredOverlayOrigin),super.open(),this.stateChanges.next()}close(){super.close(),this.stateChanges.next()}_scrollOptionIntoView(n){const r=this.options.toArray()[n];if(r){const o=this.panel.nativeElement,s=t1(n,this.options,this.optionGroups),a=r._getHostElement();o.scrollTop=0===n&&1===s?0:n1(a.offsetTop,a.offsetHeight,o.scrollTop,o.offsetHeight)}}_positioningSettled(){this._scrollOptionIntoView(this._keyManager.activeItemIndex||0)}_getChangeEvent(n){return new wre(this,n)}_getOverlayWidth(n){return"auto"===this.panelWidth?(n instanceof ry?n.elementRef:n||this._elementRef).nativeElement.getBoundingClientRect().width:null===this.panelWidth?"":this.panelWidth}get hideSingleSelectionIndicator(){return this._hideSingleSelectionIndicator}set hideSingleSelectionIndicator(n){this._hideSingleSelectionIndicator=Q(n),this._syncParentProperties()}_syncParentProperties(){if(this.options)for(const n of this.options)n._changeDetectorRef.markForCheck()}}return(e=t).\u0275fac=function(){let i;return function(r){return(i||(i=be(e)))(r||e)}}(),e.\u0275cmp=fe({type:e,selectors:[["mat-select"]],contentQueries:function(n,r,o){if(1&n&&(Ee(o,yre,5),Ee(o,Nf,5),Ee(o,zv,5)),2&n){let s;H(s=z())&&(r.customTrigger=s.first),H(s=z())&&(r.options=s),H(s=z())&&(r.optionGroups=s)}},hostAttrs:["role","combobox","aria-autocomplete","none","aria-haspopup","listbox","ngSkipHydration","",1,"mat-mdc-select"],hostVars:19,hostBindings:function(n,r){1&n&&$("keydown",function(s){return r._handleKeydown(s)})("focus",function(){return r._onFocus()})("blur",function(){return r._onBlur()}),2&n&&(de("id",r.id)("tabindex",r.tabIndex)("aria-controls",r.panelOpen?r.id+"-panel":null)("aria-expanded",r.panelOpen)("aria-label",r.ariaLabel||null)("aria-required",r.required.toString())("aria-disabled",r.disabled.toString())("aria-invalid",r.errorState)("aria-activedescendant",r._getAriaActiveDescendant()),se("mat-mdc-select-disabled",r.disabled)("mat-mdc-select-invalid",r.errorState)("mat-mdc-select-required",r.required)("mat-mdc-select-empty",r.empty)("mat-mdc-select-multiple",r.multiple))},inputs:{disabled:"disabled",disableRipple:"disableRipple",tabIndex:"tabIndex",panelWidth:"panelWidth",hideSingleSelectionIndicator:"hideSingleSelectionIndicator"},exportAs:["matSelect"],features:[Z([{provide:Kp,useExisting:e},{provide:Hv,useExisting:e}]),P],ngContentSelectors:mre,decls:11,vars:10,consts:[["cdk-overlay-origin","",1,"mat-mdc-select-trigger",3,"click"],["fallbackOverlayOrigin","cdkOverlayOrigin","trigger",""],[1,"mat-mdc-select-value",3,"ngSwitch"],["class","mat-mdc-select-placeholder mat-mdc-select-min-line",4,"ngSwitchCase"],["class","mat-mdc-select-value-text",3,"ngSwitch",4,"ngSwitchCase"],[1,"mat-mdc-select-arrow-wrapper"],[1,"mat-mdc-select-arrow"],["viewBox","0 0 24 24","width","24px","height","24px","focusable","false","aria-hidden","true"],["d","M7 10l5 5 5-5z"],["cdk-connected-overlay","","cdkConnectedOverlayLockPosition","","cdkConnectedOverlayHasBackdrop","","cdkConnectedOverlayBackdropClass","cdk-overlay-transparent-backdrop",3,"cdkConnectedOverlayPanelClass","cdkConnectedOverlayScrollStrategy","cdkConnectedOverlayOrigin","cdkConnectedOverlayOpen","cdkConnectedOverlayPositions","cdkConnectedOverlayWidth","backdropClick","attach","detach"],[1,"mat-mdc-select-placeholder","mat-mdc-select-min-line"],[1,"mat-mdc-select-value-text",3,"ngSwitch"],["class","mat-mdc-select-min-line",4,"ngSwitchDefault"],[4,"ngSwitchCase"],[1,"mat-mdc-select-min-line"],["role","listbox","tabindex","-1",3,"ngClass","keydown"],["panel",""]],template:function(n,r){if(1&n&&(ze(pre),y(0,"div",0,1),$("click",function(){return r.toggle()}),y(3,"div",2),R(4,lre,2,1,"span",3),R(5,hre,3,2,"span",4),w(),y(6,"div",5)(7,"div",6),zs(),y(8,"svg",7),ie(9,"path",8),w()()()(),R(10,fre,3,9,"ng-template",9),$("backdropClick",function(){return r.close()})("attach",function(){return r._onAttached()})("detach",function(){return r.close()})),2&n){const o=hn(1);x(3),E("ngSwitch",r.empty),de("id",r._valueId),x(1),E("ngSwitchCase",!0),x(1),E("ngSwitchCase",!1),x(5),E("cdkConnectedOverlayPanelClass",r._overlayPanelClass)("cdkConnectedOverlayScrollStrategy",r._scrollStrategy)("cdkConnectedOverlayOrigin",r._preferredOverlayOrigin||o)("cdkConnectedOverlayOpen",r.panelOpen)("cdkConnectedOverlayPositions",r._positions)("cdkConnectedOverlayWidth",r._overlayWidth)}},dependencies:[Ea,Sa,Qh,GT,F1,ry],styles:['.mat-mdc-select{display:inline-block;width:100%;outline:none;-moz-osx-font-smoothing:grayscale;-webkit-font-smoothing:antialiased;color:var(--mat-select-enabled-trigger-text-color);font-family:var(--mat-select-trigger-text-font);line-height:var(--mat-select-trigger-text-line-height);font-size:var(--mat-select-trigger-text-size);font-weight:var(--mat-select-trigger-text-weight);letter-spacing:var(--mat-select-trigger-text-tracking)}.mat-mdc-select-disabled{color:var(--mat-select-disabled-trigger-text-color)}.mat-mdc-select-trigger{display:inline-flex;align-items:center;cursor:pointer;position:relative;box-sizing:border-box;width:100%}.mat-mdc-select-disabled .mat-mdc-select-trigger{-webkit-user-select:none;user-select:none;cursor:default}.mat-mdc-select-value{width:100%;overflow:hidden;text-overflow:ellipsis;white-space:nowrap}.mat-mdc-select-value-text{white-space:nowrap;overflow:hidden;text-overflow:ellipsis}.mat-mdc-select-arrow-wrapper{height:24px;flex-shrink:0;display:inline-flex;align-items:center}.mat-form-field-appearance-fill .mat-mdc-select-arrow-wrapper{transform:translateY(-8px)}.mat-form-field-appearance-fill .mdc-text-field--no-label .mat-mdc-select-arrow-wrapper{transform:none}.mat-mdc-select-arrow{width:10px;height:5px;position:relative;color:var(--mat-select-enabled-arrow-color)}.mat-mdc-form-field.mat-focused .mat-mdc-select-arrow{color:var(--mat-select-focused-arrow-color)}.mat-mdc-form-field .mat-mdc-select.mat-mdc-select-invalid .mat-mdc-select-arrow{color:var(--mat-select-invalid-arrow-color)}.mat-mdc-form-field .mat-mdc-select.mat-mdc-select-disabled .mat-mdc-select-arrow{color:var(--mat-select-disabled-arrow-color)}.mat-mdc-select-arrow svg{fill:currentColor;position:absolute;top:50%;left:50%;transform:translate(-50%, -50%)}.cdk-high-contrast-active .mat-mdc-select-arrow svg{fill:CanvasText}.mat-mdc-select-disabled .cdk-high-contrast-active .mat-mdc-select-arrow svg{fill:GrayText}div.mat-mdc-select-panel{box-shadow:0px 5px 5px -3px rgba(0, 0, 0, 0.2), 0px 8px 10px 1px rgba(0, 0, 0, 0.14), 0px 3px 14px 2px rgba(0, 0, 0, 0.12);width:100%;max-height:275px;outline:0;overflow:auto;padding:8px 0;border-radius:4px;box-sizing:border-box;position:static;background-color:var(--mat-select-panel-background-color)}.cdk-high-contrast-active div.mat-mdc-select-panel{outline:solid 1px}.cdk-overlay-pane:not(.mat-mdc-select-panel-above) div.mat-mdc-select-panel{border-top-left-radius:0;border-top-right-radius:0;transform-origin:top center}.mat-mdc-select-panel-above div.mat-mdc-select-panel{border-bottom-left-radius:0;border-bottom-right-radius:0;transform-origin:bottom center}.mat-mdc-select-placeholder{transition:color 400ms 133.3333333333ms cubic-bezier(0.25, 0.8, 0.25, 1);color:var(--mat-select-placeholder-text-color)}._mat-animation-noopable .mat-mdc-select-placeholder{transition:none}.mat-form-field-hide-placeholder .mat-mdc-select-placeholder{color:rgba(0,0,0,0);-webkit-text-fill-color:rgba(0,0,0,0);transition:none;display:block}.mat-mdc-form-field-type-mat-select:not(.mat-form-field-disabled) .mat-mdc-text-field-wrapper{cursor:pointer}.mat-mdc-form-field-type-mat-select.mat-form-field-appearance-fill .mat-mdc-floating-label{max-width:calc(100% - 18px)}.mat-mdc-form-field-type-mat-select.mat-form-field-appearance-fill .mdc-floating-label--float-above{max-width:calc(100% / 0.75 - 24px)}.mat-mdc-form-field-type-mat-select.mat-form-field-appearance-outline .mdc-notched-outline__notch{max-width:calc(100% - 60px)}.mat-mdc-form-field-type-mat-select.mat-form-field-appearance-outline .mdc-text-field--label-floating .mdc-notched-outline__notch{max-width:calc(100% - 24px)}.mat-mdc-select-min-line:empty::before{content:" ";white-space:pre;width:1px;display:inline-block;visibility:hidden}'],encapsulation:2,data:{animation:[gre.transformPanel]},changeDetection:0}),t})(),eN=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275mod=le({type:e}),e.\u0275inj=ae({providers:[vre],imports:[vn,ed,Yl,ve,lo,jd,Yl,ve]}),t})();const Ere=["tooltip"],tN=new M("mat-tooltip-scroll-strategy"),Tre={provide:tN,deps:[wi],useFactory:function kre(e){return()=>e.scrollStrategies.reposition({scrollThrottle:20})}},Ire=new M("mat-tooltip-default-options",{providedIn:"root",factory:function Mre(){return{showDelay:0,hideDelay:0,touchendHideDelay:1500}}}),nN="tooltip-panel",iN=ro({passive:!0});let Nre=(()=>{var e;class t{get position(){return this._position}set position(n){n!==this._position&&(this._position=n,this._overlayRef&&(this._updatePosition(this._overlayRef),this._tooltipInstance?.show(0),this._overlayRef.updatePosition()))}get positionAtOrigin(){return this._positionAtOrigin}set positionAtOrigin(n){this._positionAtOrigin=Q(n),this._detach(),this._overlayRef=null}get disabled(){return this._disabled}set disabled(n){this._disabled=Q(n),this._disabled?this.hide(0):this._setupPointerEnterEventsIfNeeded()}get showDelay(){return this._showDelay}set showDelay(n){this._showDelay=Bi(n)}get hideDelay(){return this._hideDelay}set hideDelay(n){this._hideDelay=Bi(n),this._tooltipInstance&&(this._tooltipInstance._mouseLeaveHideDelay=this._hideDelay)}get message(){return this._message}set message(n){this._ariaDescriber.removeDescription(this._elementRef.nativeElement,this._message,"tooltip"),this._message=null!=n?String(n).trim():"",!this._message&&this._isTooltipVisible()?this.hide(0):(this._setupPointerEnterEventsIfNeeded(),this._updateTooltipMessage(),this._ngZone.runOutsideAngular(()=>{Promise.resolve().then(()=>{this._ariaDescriber.describe(this._elementRef.nativeElement,this.message,"tooltip")})}))}get tooltipClass(){return this._tooltipClass}set tooltipClass(n){this._tooltipClass=n,this._tooltipInstance&&this._setTooltipClass(this._tooltipClass)}constructor(n,r,o,s,a,c,l,d,u,h,f,p){this._overlay=n,this._elementRef=r,this._scrollDispatcher=o,this._viewContainerRef=s,this._ngZone=a,this._platform=c,this._ariaDescriber=l,this._focusMonitor=d,this._dir=h,this._defaultOptions=f,this._position="below",this._positionAtOrigin=!1,this._disabled=!1,this._viewInitialized=!1,this._pointerExitEventsInitialized=!1,this._viewportMargin=8,this._cssClassPrefix="mat",this.touchGestures="auto",this._message="",this._passiveListeners=[],this._destroyed=new Y,this._scrollStrategy=u,this._document=p,f&&(this._showDelay=f.showDelay,this._hideDelay=f.hideDelay,f.position&&(this.position=f.position),f.positionAtOrigin&&(this.positionAtOrigin=f.positionAtOrigin),f.touchGestures&&(this.touchGestures=f.touchGestures)),h.change.pipe(ce(this._destroyed)).subscribe(()=>{this._overlayRef&&this._updatePosition(this._overlayRef)})}ngAfterViewInit(){this._viewInitialized=!0,this._setupPointerEnterEventsIfNeeded(),this._focusMonitor.monitor(this._elementRef).pipe(ce(this._destroyed)).subscribe(n=>{n?"keyboard"===n&&this._ngZone.run(()=>this.show()):this._ngZone.run(()=>this.hide(0))})}ngOnDestroy(){const n=this._elementRef.nativeElement;clearTimeout(this._touchstartTimeout),this._overlayRef&&(this._overlayRef.dispose(),this._tooltipInstance=null),this._passiveListeners.forEach(([r,o])=>{n.removeEventListener(r,o,iN)}),this._passiveListeners.length=0,this._destroyed.next(),this._destroyed.complete(),this._ariaDescriber.removeDescription(n,this.message,"tooltip"),this._focusMonitor.stopMonitoring(n)}show(n=this.showDelay,r){if(this.disabled||!this.message||this._isTooltipVisible())return void this._tooltipInstance?._cancelPendingAnimations();const o=this._createOverlay(r);this._detach(),this._portal=this._portal||new $f(this._tooltipComponent,this._viewContainerRef);const s=this._tooltipInstance=o.attach(this._portal).instance;s._triggerElement=this._elementRef.nativeElement,s._mouseLeaveHideDelay=this._hideDelay,s.afterHidden().pipe(ce(this._destroyed)).subscribe(()=>this._detach()),this._setTooltipClass(this._tooltipClass),this._updateTooltipMessage(),s.show(n)}hide(n=this.hideDelay){const r=this._tooltipInstance;r&&(r.isVisible()?r.hide(n):(r._cancelPendingAnimations(),this._detach()))}toggle(n){this._isTooltipVisible()?this.hide():this.show(void 0,n)}_isTooltipVisible(){return!!this._tooltipInstance&&this._tooltipInstance.isVisible()}_createOverlay(n){if(this._overlayRef){const s=this._overlayRef.getConfig().positionStrategy;if((!this.positionAtOrigin||!n)&&s._origin instanceof W)return this._overlayRef;this._detach()}const r=this._scrollDispatcher.getAncestorScrollContainers(this._elementRef),o=this._overlay.position().flexibleConnectedTo(this.positionAtOrigin&&n||this._elementRef).withTransformOriginOn(`.${this._cssClassPrefix}-tooltip`).withFlexibleDimensions(!1).withViewportMargin(this._viewportMargin).withScrollableContainers(r);return o.positionChanges.pipe(ce(this._destroyed)).subscribe(s=>{this._updateCurrentPositionClass(s.connectionPair),this._tooltipInstance&&s.scrollableViewProperties.isOverlayClipped&&this._tooltipInstance.isVisible()&&this._ngZone.run(()=>this.hide(0))}),this._overlayRef=this._overlay.create({direction:this._dir,positionStrategy:o,panelClass:`${this._cssClassPrefix}-${nN}`,scrollStrategy:this._scrollStrategy()}),this._updatePosition(this._overlayRef),this._overlayRef.detachments().pipe(ce(this._destroyed)).subscribe(()=>this._detach()),this._overlayRef.outsidePointerEvents().pipe(ce(this._destroyed)).subscribe(()=>this._tooltipInstance?._handleBodyInteraction()),this._overlayRef.keydownEvents().pipe(ce(this._destroyed)).subscribe(s=>{this._isTooltipVisible()&&27===s.keyCode&&!An(s)&&(s.preventDefault(),s.stopPropagation(),this._ngZone.run(()=>this.hide(0)))}),this._defaultOptions?.disableTooltipInteractivity&&this._overlayRef.addPanelClass(`${this._cssClassPrefix}-tooltip-panel-non-interactive`),this._overlayRef}_detach(){this._overlayRef&&this._overlayRef.hasAttached()&&this._overlayRef.detach(),this._tooltipInstance=null}_updatePosition(n){const r=n.getConfig().positionStrategy,o=this._getOrigin(),s=this._getOverlayPosition();r.withPositions([this._addOffset({...o.main,...s.main}),this._addOffset({...o.fallback,...s.fallback})])}_addOffset(n){return n}_getOrigin(){const n=!this._dir||"ltr"==this._dir.value,r=this.position;let o;"above"==r||"below"==r?o={originX:"center",originY:"above"==r?"top":"bottom"}:"before"==r||"left"==r&&n||"right"==r&&!n?o={originX:"start",originY:"center"}:("after"==r||"right"==r&&n||"left"==r&&!n)&&(o={originX:"end",originY:"center"});const{x:s,y:a}=this._invertPosition(o.originX,o.originY);return{main:o,fallback:{originX:s,originY:a}}}_getOverlayPosition(){const n=!this._dir||"ltr"==this._dir.value,r=this.position;let o;"above"==r?o={overlayX:"center",overlayY:"bottom"}:"below"==r?o={overlayX:"center",overlayY:"top"}:"before"==r||"left"==r&&n||"right"==r&&!n?o={overlayX:"end",overlayY:"center"}:("after"==r||"right"==r&&n||"left"==r&&!n)&&(o={overlayX:"start",overlayY:"center"});const{x:s,y:a}=this._invertPosition(o.overlayX,o.overlayY);return{main:o,fallback:{overlayX:s,overlayY:a}}}_updateTooltipMessage(){this._tooltipInstance&&(this._tooltipInstance.message=this.message,this._tooltipInstance._markForCheck(),this._ngZone.onMicrotaskEmpty.pipe(Xe(1),ce(this._destroyed)).subscribe(()=>{this._tooltipInstance&&this._overlayRef.updatePosition()}))}_setTooltipClass(n){this._tooltipInstance&&(this._tooltipInstance.tooltipClass=n,this._tooltipInstance._markForCheck())}_invertPosition(n,r){return"above"===this.position||"below"===this.position?"top"===r?r="bottom":"bottom"===r&&(r="top"):"end"===n?n="start":"start"===n&&(n="end"),{x:n,y:r}}_updateCurrentPositionClass(n){const{overlayY:r,originX:o,originY:s}=n;let a;if(a="center"===r?this._dir&&"rtl"===this._dir.value?"end"===o?"left":"right":"start"===o?"left":"right":"bottom"===r&&"top"===s?"above":"below",a!==this._currentPosition){const c=this._overlayRef;if(c){const l=`${this._cssClassPrefix}-${nN}-`;c.removePanelClass(l+this._currentPosition),c.addPanelClass(l+a)}this._currentPosition=a}}_setupPointerEnterEventsIfNeeded(){this._disabled||!this.message||!this._viewInitialized||this._passiveListeners.length||(this._platformSupportsMouseEvents()?this._passiveListeners.push(["mouseenter",n=>{let r;this._setupPointerExitEventsIfNeeded(),void 0!==n.x&&void 0!==n.y&&(r=n),this.show(void 0,r)}]):"off"!==this.touchGestures&&(this._disableNativeGesturesIfNecessary(),this._passiveListeners.push(["touchstart",n=>{const r=n.targetTouches?.[0],o=r?{x:r.clientX,y:r.clientY}:void 0;this._setupPointerExitEventsIfNeeded(),clearTimeout(this._touchstartTimeout),this._touchstartTimeout=setTimeout(()=>this.show(void 0,o),500)}])),this._addListeners(this._passiveListeners))}_setupPointerExitEventsIfNeeded(){if(this._pointerExitEventsInitialized)return;this._pointerExitEventsInitialized=!0;const n=[];if(this._platformSupportsMouseEvents())n.push(["mouseleave",r=>{const o=r.relatedTarget;(!o||!this._overlayRef?.overlayElement.contains(o))&&this.hide()}],["wheel",r=>this._wheelListener(r)]);else if("off"!==this.touchGestures){this._disableNativeGesturesIfNecessary();const r=()=>{clearTimeout(this._touchstartTimeout),this.hide(this._defaultOptions.touchendHideDelay)};n.push(["touchend",r],["touchcancel",r])}this._addListeners(n),this._passiveListeners.push(...n)}_addListeners(n){n.forEach(([r,o])=>{this._elementRef.nativeElement.addEventListener(r,o,iN)})}_platformSupportsMouseEvents(){return!this._platform.IOS&&!this._platform.ANDROID}_wheelListener(n){if(this._isTooltipVisible()){const r=this._document.elementFromPoint(n.clientX,n.clientY),o=this._elementRef.nativeElement;r!==o&&!o.contains(r)&&this.hide()}}_disableNativeGesturesIfNecessary(){const n=this.touchGestures;if("off"!==n){const r=this._elementRef.nativeElement,o=r.style;("on"===n||"INPUT"!==r.nodeName&&"TEXTAREA"!==r.nodeName)&&(o.userSelect=o.msUserSelect=o.webkitUserSelect=o.MozUserSelect="none"),("on"===n||!r.draggable)&&(o.webkitUserDrag="none"),o.touchAction="none",o.webkitTapHighlightColor="transparent"}}}return(e=t).\u0275fac=function(n){jo()},e.\u0275dir=T({type:e,inputs:{position:["matTooltipPosition","position"],positionAtOrigin:["matTooltipPositionAtOrigin","positionAtOrigin"],disabled:["matTooltipDisabled","disabled"],showDelay:["matTooltipShowDelay","showDelay"],hideDelay:["matTooltipHideDelay","hideDelay"],touchGestures:["matTooltipTouchGestures","touchGestures"],message:["matTooltip","message"],tooltipClass:["matTooltipClass","tooltipClass"]}}),t})(),Lre=(()=>{var e;class t extends Nre{constructor(n,r,o,s,a,c,l,d,u,h,f,p){super(n,r,o,s,a,c,l,d,u,h,f,p),this._tooltipComponent=Vre,this._cssClassPrefix="mat-mdc",this._viewportMargin=8}_addOffset(n){const o=!this._dir||"ltr"==this._dir.value;return"top"===n.originY?n.offsetY=-8:"bottom"===n.originY?n.offsetY=8:"start"===n.originX?n.offsetX=o?-8:8:"end"===n.originX&&(n.offsetX=o?8:-8),n}}return(e=t).\u0275fac=function(n){return new(n||e)(m(wi),m(W),m(Gf),m(pt),m(G),m(nt),m(k7),m(ar),m(tN),m(fn,8),m(Ire,8),m(he))},e.\u0275dir=T({type:e,selectors:[["","matTooltip",""]],hostAttrs:[1,"mat-mdc-tooltip-trigger"],hostVars:2,hostBindings:function(n,r){2&n&&se("mat-mdc-tooltip-disabled",r.disabled)},exportAs:["matTooltip"],features:[P]}),t})(),Bre=(()=>{var e;class t{constructor(n,r){this._changeDetectorRef=n,this._closeOnInteraction=!1,this._isVisible=!1,this._onHide=new Y,this._animationsDisabled="NoopAnimations"===r}show(n){null!=this._hideTimeoutId&&clearTimeout(this._hideTimeoutId),this._showTimeoutId=setTimeout(()=>{this._toggleVisibility(!0),this._showTimeoutId=void 0},n)}hide(n){null!=this._showTimeoutId&&clearTimeout(this._showTimeoutId),this._hideTimeoutId=setTimeout(()=>{this._toggleVisibility(!1),this._hideTimeoutId=void 0},n)}afterHidden(){return this._onHide}isVisible(){return this._isVisible}ngOnDestroy(){this._cancelPendingAnimations(),this._onHide.complete(),this._triggerElement=null}_handleBodyInteraction(){this._closeOnInteraction&&this.hide(0)}_markForCheck(){this._changeDetectorRef.markForCheck()}_handleMouseLeave({relatedTarget:n}){(!n||!this._triggerElement.contains(n))&&(this.isVisible()?this.hide(this._mouseLeaveHideDelay):this._finalizeAnimation(!1))}_onShow(){}_handleAnimationEnd({animationName:n}){(n===this._showAnimation||n===this._hideAnimation)&&this._finalizeAnimation(n===this._showAnimation)}_cancelPendingAnimations(){null!=this._showTimeoutId&&clearTimeout(this._showTimeoutId),null!=this._hideTimeoutId&&clearTimeout(this._hideTimeoutId),this._showTimeoutId=this._hideTimeoutId=void 0}_finalizeAnimation(n){n?this._closeOnInteraction=!0:this.isVisible()||this._onHide.next()}_toggleVisibility(n){const r=this._tooltip.nativeElement,o=this._showAnimation,s=this._hideAnimation;if(r.classList.remove(n?s:o),r.classList.add(n?o:s),this._isVisible=n,n&&!this._animationsDisabled&&"function"==typeof getComputedStyle){const a=getComputedStyle(r);("0s"===a.getPropertyValue("animation-duration")||"none"===a.getPropertyValue("animation-name"))&&(this._animationsDisabled=!0)}n&&this._onShow(),this._animationsDisabled&&(r.classList.add("_mat-animation-noopable"),this._finalizeAnimation(n))}}return(e=t).\u0275fac=function(n){return new(n||e)(m(Fe),m(_t,8))},e.\u0275dir=T({type:e}),t})(),Vre=(()=>{var e;class t extends Bre{constructor(n,r,o){super(n,o),this._elementRef=r,this._isMultiline=!1,this._showAnimation="mat-mdc-tooltip-show",this._hideAnimation="mat-mdc-tooltip-hide"}_onShow(){this._isMultiline=this._isTooltipMultiline(),this._markForCheck()}_isTooltipMultiline(){const n=this._elementRef.nativeElement.getBoundingClientRect();return n.height>24&&n.width>=200}}return(e=t).\u0275fac=function(n){return new(n||e)(m(Fe),m(W),m(_t,8))},e.\u0275cmp=fe({type:e,selectors:[["mat-tooltip-component"]],viewQuery:function(n,r){if(1&n&&ke(Ere,7),2&n){let o;H(o=z())&&(r._tooltip=o.first)}},hostAttrs:["aria-hidden","true"],hostVars:2,hostBindings:function(n,r){1&n&&$("mouseleave",function(s){return r._handleMouseLeave(s)}),2&n&&kn("zoom",r.isVisible()?1:null)},features:[P],decls:4,vars:4,consts:[[1,"mdc-tooltip","mdc-tooltip--shown","mat-mdc-tooltip",3,"ngClass","animationend"],["tooltip",""],[1,"mdc-tooltip__surface","mdc-tooltip__surface-animation"]],template:function(n,r){1&n&&(y(0,"div",0,1),$("animationend",function(s){return r._handleAnimationEnd(s)}),y(2,"div",2),A(3),w()()),2&n&&(se("mdc-tooltip--multiline",r._isMultiline),E("ngClass",r.tooltipClass),x(3),bt(r.message))},dependencies:[Ea],styles:['.mdc-tooltip__surface{word-break:break-all;word-break:var(--mdc-tooltip-word-break, normal);overflow-wrap:anywhere}.mdc-tooltip--showing-transition .mdc-tooltip__surface-animation{transition:opacity 150ms 0ms cubic-bezier(0, 0, 0.2, 1),transform 150ms 0ms cubic-bezier(0, 0, 0.2, 1)}.mdc-tooltip--hide-transition .mdc-tooltip__surface-animation{transition:opacity 75ms 0ms cubic-bezier(0.4, 0, 1, 1)}.mdc-tooltip{position:fixed;display:none;z-index:9}.mdc-tooltip-wrapper--rich{position:relative}.mdc-tooltip--shown,.mdc-tooltip--showing,.mdc-tooltip--hide{display:inline-flex}.mdc-tooltip--shown.mdc-tooltip--rich,.mdc-tooltip--showing.mdc-tooltip--rich,.mdc-tooltip--hide.mdc-tooltip--rich{display:inline-block;left:-320px;position:absolute}.mdc-tooltip__surface{line-height:16px;padding:4px 8px;min-width:40px;max-width:200px;min-height:24px;max-height:40vh;box-sizing:border-box;overflow:hidden;text-align:center}.mdc-tooltip__surface::before{position:absolute;box-sizing:border-box;width:100%;height:100%;top:0;left:0;border:1px solid rgba(0,0,0,0);border-radius:inherit;content:"";pointer-events:none}@media screen and (forced-colors: active){.mdc-tooltip__surface::before{border-color:CanvasText}}.mdc-tooltip--rich .mdc-tooltip__surface{align-items:flex-start;display:flex;flex-direction:column;min-height:24px;min-width:40px;max-width:320px;position:relative}.mdc-tooltip--multiline .mdc-tooltip__surface{text-align:left}[dir=rtl] .mdc-tooltip--multiline .mdc-tooltip__surface,.mdc-tooltip--multiline .mdc-tooltip__surface[dir=rtl]{text-align:right}.mdc-tooltip__surface .mdc-tooltip__title{margin:0 8px}.mdc-tooltip__surface .mdc-tooltip__content{max-width:calc(200px - (2 * 8px));margin:8px;text-align:left}[dir=rtl] .mdc-tooltip__surface .mdc-tooltip__content,.mdc-tooltip__surface .mdc-tooltip__content[dir=rtl]{text-align:right}.mdc-tooltip--rich .mdc-tooltip__surface .mdc-tooltip__content{max-width:calc(320px - (2 * 8px));align-self:stretch}.mdc-tooltip__surface .mdc-tooltip__content-link{text-decoration:none}.mdc-tooltip--rich-actions,.mdc-tooltip__content,.mdc-tooltip__title{z-index:1}.mdc-tooltip__surface-animation{opacity:0;transform:scale(0.8);will-change:transform,opacity}.mdc-tooltip--shown .mdc-tooltip__surface-animation{transform:scale(1);opacity:1}.mdc-tooltip--hide .mdc-tooltip__surface-animation{transform:scale(1)}.mdc-tooltip__caret-surface-top,.mdc-tooltip__caret-surface-bottom{position:absolute;height:24px;width:24px;transform:rotate(35deg) skewY(20deg) scaleX(0.9396926208)}.mdc-tooltip__caret-surface-top .mdc-elevation-overlay,.mdc-tooltip__caret-surface-bottom .mdc-elevation-overlay{width:100%;height:100%;top:0;left:0}.mdc-tooltip__caret-surface-bottom{box-shadow:0px 3px 1px -2px rgba(0, 0, 0, 0.2), 0px 2px 2px 0px rgba(0, 0, 0, 0.14), 0px 1px 5px 0px rgba(0, 0, 0, 0.12);outline:1px solid rgba(0,0,0,0);z-index:-1}@media screen and (forced-colors: active){.mdc-tooltip__caret-surface-bottom{outline-color:CanvasText}}.mat-mdc-tooltip{--mdc-plain-tooltip-container-shape:4px;--mdc-plain-tooltip-supporting-text-line-height:16px}.mat-mdc-tooltip .mdc-tooltip__surface{background-color:var(--mdc-plain-tooltip-container-color)}.mat-mdc-tooltip .mdc-tooltip__surface{border-radius:var(--mdc-plain-tooltip-container-shape)}.mat-mdc-tooltip .mdc-tooltip__caret-surface-top,.mat-mdc-tooltip .mdc-tooltip__caret-surface-bottom{border-radius:var(--mdc-plain-tooltip-container-shape)}.mat-mdc-tooltip .mdc-tooltip__surface{color:var(--mdc-plain-tooltip-supporting-text-color)}.mat-mdc-tooltip .mdc-tooltip__surface{font-family:var(--mdc-plain-tooltip-supporting-text-font);line-height:var(--mdc-plain-tooltip-supporting-text-line-height);font-size:var(--mdc-plain-tooltip-supporting-text-size);font-weight:var(--mdc-plain-tooltip-supporting-text-weight);letter-spacing:var(--mdc-plain-tooltip-supporting-text-tracking)}.mat-mdc-tooltip{position:relative;transform:scale(0)}.mat-mdc-tooltip::before{content:"";top:0;right:0;bottom:0;left:0;z-index:-1;position:absolute}.mat-mdc-tooltip-panel-below .mat-mdc-tooltip::before{top:-8px}.mat-mdc-tooltip-panel-above .mat-mdc-tooltip::before{bottom:-8px}.mat-mdc-tooltip-panel-right .mat-mdc-tooltip::before{left:-8px}.mat-mdc-tooltip-panel-left .mat-mdc-tooltip::before{right:-8px}.mat-mdc-tooltip._mat-animation-noopable{animation:none;transform:scale(1)}.mat-mdc-tooltip-panel-non-interactive{pointer-events:none}@keyframes mat-mdc-tooltip-show{0%{opacity:0;transform:scale(0.8)}100%{opacity:1;transform:scale(1)}}@keyframes mat-mdc-tooltip-hide{0%{opacity:1;transform:scale(1)}100%{opacity:0;transform:scale(0.8)}}.mat-mdc-tooltip-show{animation:mat-mdc-tooltip-show 150ms cubic-bezier(0, 0, 0.2, 1) forwards}.mat-mdc-tooltip-hide{animation:mat-mdc-tooltip-hide 75ms cubic-bezier(0.4, 0, 1, 1) forwards}'],encapsulation:2,changeDetection:0}),t})(),jre=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275mod=le({type:e}),e.\u0275inj=ae({providers:[Tre],imports:[$I,vn,ed,ve,ve,lo]}),t})();function Hre(e,t){if(1&e&&(y(0,"mat-option",9),A(1),w()),2&e){const i=t.$implicit;E("value",i),x(1),Ue(" ",i," ")}}let zre=(()=>{var e;class t{constructor(){this.pageIndex=0,this.pageSize=10,this.pageSizes=[10,20,50,100],this.pageLength=0,this.totalLength=null,this.totalLessThanOrEqual=!1,this.page=new U}get firstItemIndex(){return this.pageIndex*this.pageSize+1}get lastItemIndex(){return this.pageIndex*this.pageSize+this.pageLength}get hasTotalLength(){return"number"==typeof this.totalLength}get hasPreviousPage(){return this.pageIndex>0}get hasNextPage(){return this.firstItemIndex+this.pageSize<=this.totalLength}emitChange(){this.page.emit({pageIndex:this.pageIndex,pageSize:this.pageSize})}}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275cmp=fe({type:e,selectors:[["app-paginator"]],inputs:{pageIndex:["pageIndex","pageIndex",yb],pageSize:["pageSize","pageSize",yb],pageSizes:"pageSizes",pageLength:["pageLength","pageLength",yb],totalLength:"totalLength",totalLessThanOrEqual:"totalLessThanOrEqual"},outputs:{page:"page"},features:[C_],decls:21,vars:14,consts:[[1,"paginator"],[1,"field-items-per-page"],[3,"value","valueChange"],[3,"value",4,"ngFor","ngForOf"],[1,"paginator-description"],[1,"paginator-navigation"],["mat-icon-button","","matTooltip","First page",3,"disabled","click"],["mat-icon-button","","matTooltip","Previous page",3,"disabled","click"],["mat-icon-button","","matTooltip","Next page",3,"disabled","click"],[3,"value"]],template:function(n,r){1&n&&(y(0,"div",0)(1,"mat-form-field",1)(2,"mat-label"),A(3,"Items per page"),w(),y(4,"mat-select",2),$("valueChange",function(s){return r.pageSize=s,r.pageIndex=0,r.emitChange()}),R(5,Hre,2,2,"mat-option",3),w()(),y(6,"p",4),A(7),en(8,"number"),en(9,"number"),en(10,"number"),w(),y(11,"div",5)(12,"button",6),$("click",function(){return r.pageIndex=0,r.emitChange()}),y(13,"mat-icon"),A(14,"first_page"),w()(),y(15,"button",7),$("click",function(){return r.pageIndex=r.pageIndex-1,r.emitChange()}),y(16,"mat-icon"),A(17,"navigate_before"),w()(),y(18,"button",8),$("click",function(){return r.pageIndex=r.pageIndex+1,r.emitChange()}),y(19,"mat-icon"),A(20,"navigate_next"),w()()()()),2&n&&(x(4),E("value",r.pageSize),x(1),E("ngForOf",r.pageSizes),x(2),L_(" ",nn(8,8,r.firstItemIndex)," - ",nn(9,10,r.lastItemIndex),"",r.hasTotalLength?" of "+(r.totalLessThanOrEqual?"\u2264 ":"")+nn(10,12,r.totalLength):""," "),x(5),E("disabled",!r.hasPreviousPage),x(3),E("disabled",!r.hasPreviousPage),x(3),E("disabled",!r.hasNextPage))},dependencies:[Wh,QF,nw,Nf,Dre,Qv,qv,Lre,Pb],styles:[".paginator[_ngcontent-%COMP%] > *[_ngcontent-%COMP%]{display:inline-block;vertical-align:middle}.paginator[_ngcontent-%COMP%]   p[_ngcontent-%COMP%]{margin:0 20px}.paginator[_ngcontent-%COMP%]   .field-items-per-page[_ngcontent-%COMP%]{width:140px}.paginator[_ngcontent-%COMP%]     .mat-mdc-form-field-subscript-wrapper{display:none}"]}),t})();var Ure=xs(622);let $re=(()=>{class e{static transformOne(i,n){return Ure(i,n)}transform(i,n){return Array.isArray(i)?i.map(r=>e.transformOne(r,n)):e.transformOne(i,n)}}return e.\u0275fac=function(i){return new(i||e)},e.\u0275pipe=wn({name:"filesize",type:e,pure:!0}),e})(),qre=(()=>{class e{}return e.\u0275fac=function(i){return new(i||e)},e.\u0275mod=le({type:e}),e.\u0275inj=ae({}),e})();function Gre(e,t){if(1&e&&(y(0,"mat-radio-button",50)(1,"mat-icon"),A(2),w(),A(3),y(4,"small"),A(5),en(6,"number"),en(7,"async"),w()()),2&e){const i=t.$implicit,n=B();E("value",i.key),x(2),bt(i.value.icon),x(1),Ue(" ",i.value.plural," "),x(2),Uo("",n.isDeepFiltered?"\u2264 ":"","",nn(6,5,nn(7,7,n.search.contentTypeCount(i.key))),"")}}function Wre(e,t){if(1&e){const i=Ut();y(0,"mat-checkbox",55),$("change",function(r){const s=Ye(i).$implicit,a=B(2).$implicit,c=B();return r.checked||a.isEmpty()?a.select(s.value):a.deselect(s.value),Ke(c.loadResult())}),A(1),y(2,"small"),A(3),en(4,"number"),w()()}if(2&e){const i=t.$implicit,n=B(2).$implicit,r=B();kn("display","block"),E("checked",n.isEmpty()||n.isSelected(i.value))("color","accent"),x(1),Ue(" ",i.label," "),x(2),Uo("",r.isDeepFiltered?"\u2264 ":"","",nn(4,7,i.count),"")}}function Qre(e,t){1&e&&(y(0,"span",56),A(1,"No aggregation results"),w())}function Yre(e,t){if(1&e){const i=Ut();y(0,"mat-expansion-panel",52),$("opened",function(){Ye(i);const r=B().$implicit,o=B();return r.activate(),Ke(o.loadResult())})("closed",function(){Ye(i);const r=B().$implicit,o=B();return r.deactivateAndReset(),Ke(o.loadResult())}),y(1,"mat-expansion-panel-header")(2,"mat-panel-title")(3,"mat-icon"),A(4),w(),A(5),w()(),y(6,"section"),R(7,Wre,5,9,"mat-checkbox",53),R(8,Qre,2,0,"span",54),en(9,"async"),w()()}if(2&e){const i=B().$implicit,n=B();E("expanded",i.isActive()),x(4),bt(i.icon),x(1),Ue(" ",i.name,""),x(1),bh(i.isEmpty()?"empty":"active"),x(1),E("ngForOf",i.aggregations),x(1),E("ngIf",!(nn(9,7,n.search.loading$)||null!=i.aggregations&&i.aggregations.length))}}function Kre(e,t){if(1&e&&(Ht(0),R(1,Yre,10,9,"mat-expansion-panel",51),zt()),2&e){const i=t.$implicit,n=B();x(1),E("ngIf",i.isRelevant(n.contentType.value))}}function Xre(e,t){if(1&e){const i=Ut();y(0,"button",57),$("click",function(){Ye(i);const r=B();return r.queryString.reset(),r.search.setQueryString(""),r.search.firstPage(),Ke(r.search.loadResult())}),y(1,"mat-icon"),A(2,"close"),w()()}}function Zre(e,t){1&e&&(y(0,"mat-icon"),A(1,"sell"),w(),A(2," Edit tags "))}function Jre(e,t){if(1&e){const i=Ut();y(0,"mat-chip-row",58),$("edited",function(r){const s=Ye(i).$implicit;return Ke(B().renameTag(s,r.value))})("removed",function(){const o=Ye(i).$implicit;return Ke(B().deleteTag(o))}),A(1),y(2,"button",59)(3,"mat-icon"),A(4,"cancel"),w()()()}if(2&e){const i=t.$implicit;E("editable",!0)("aria-description","press enter to edit"),x(1),Ue(" ",i," "),x(1),de("aria-label","remove "+i)}}function eoe(e,t){if(1&e&&(y(0,"mat-option",50),A(1),w()),2&e){const i=t.$implicit;E("value",i),x(1),bt(i)}}function toe(e,t){1&e&&(y(0,"mat-icon"),A(1,"delete_forever"),w(),A(2," Delete "))}function noe(e,t){1&e&&(y(0,"mat-icon",60),A(1,"close"),w())}function ioe(e,t){1&e&&(y(0,"mat-tab"),R(1,noe,2,0,"ng-template",18),w())}function roe(e,t){1&e&&ie(0,"mat-progress-bar",61)}function ooe(e,t){if(1&e){const i=Ut();y(0,"th",62)(1,"mat-checkbox",63),$("change",function(){return Ye(i),Ke(B().toggleAllRows())}),w()()}if(2&e){const i=B();x(1),E("checked",i.selectedItems.hasValue()&&i.isAllSelected())("indeterminate",i.selectedItems.hasValue()&&!i.isAllSelected())("aria-label",i.checkboxLabel())}}function soe(e,t){if(1&e){const i=Ut();y(0,"td",64)(1,"mat-checkbox",65),$("click",function(r){return r.stopPropagation()})("change",function(r){const s=Ye(i).$implicit,a=B();return Ke(r?a.selectedItems.toggle(s):null)}),w()()}if(2&e){const i=t.$implicit,n=B();x(1),E("checked",n.selectedItems.isSelected(i))("aria-label",n.checkboxLabel(i))}}function aoe(e,t){1&e&&(y(0,"th",62),A(1,"Summary"),w())}function coe(e,t){if(1&e&&(y(0,"mat-chip",69),A(1),w()),2&e){const i=t.$implicit;x(1),Ue(" ",i," ")}}function loe(e,t){if(1&e&&(Ht(0),A(1),zt()),2&e){const i=t.$implicit,n=t.index;x(1),Uo(" ",n>0?", ":"","",i.name," ")}}function doe(e,t){if(1&e&&(y(0,"mat-chip"),R(1,loe,2,2,"ng-container",7),w()),2&e){const i=t.$implicit;x(1),E("ngForOf",i)}}function uoe(e,t){if(1&e&&(y(0,"mat-chip"),A(1),w()),2&e){const i=t.ngIf;x(1),bt(i)}}function hoe(e,t){if(1&e&&(y(0,"mat-chip"),A(1),w()),2&e){const i=t.ngIf;x(1),bt(i)}}function foe(e,t){if(1&e&&(y(0,"mat-chip"),A(1),w()),2&e){const i=t.ngIf;x(1),bt(i)}}function poe(e,t){if(1&e&&(y(0,"mat-chip"),A(1),w()),2&e){const i=t.ngIf;x(1),bt(i)}}function moe(e,t){if(1&e&&(y(0,"mat-chip"),A(1),w()),2&e){const i=t.ngIf;x(1),bt(i)}}function goe(e,t){if(1&e){const i=Ut();y(0,"td",66),$("click",function(r){const s=Ye(i).$implicit;return B().expandedItem.toggle(s.id),Ke(r.stopPropagation())}),y(1,"mat-icon"),A(2),w(),y(3,"span",67),A(4),w(),y(5,"mat-chip-set"),R(6,coe,2,1,"mat-chip",68),R(7,doe,2,1,"mat-chip",31),R(8,uoe,2,1,"mat-chip",31),R(9,hoe,2,1,"mat-chip",31),R(10,foe,2,1,"mat-chip",31),R(11,poe,2,1,"mat-chip",31),R(12,moe,2,1,"mat-chip",31),w()()}if(2&e){const i=t.$implicit,n=B();let r,o;x(1),de("title",null!==(r=null==(r=n.search.contentTypeInfo(i.contentType))?null:r.singular)&&void 0!==r?r:"Unknown"),x(1),bt(null!==(o=null==(o=n.search.contentTypeInfo(i.contentType))?null:o.icon)&&void 0!==o?o:"question_mark"),x(2),bt(n.item(i).title),x(2),E("ngForOf",n.item(i).torrent.tagNames),x(1),E("ngIf",n.item(i).languages),x(1),E("ngIf",null==n.item(i).video3d?null:n.item(i).video3d.slice(1)),x(1),E("ngIf",null==n.item(i).videoResolution?null:n.item(i).videoResolution.slice(1)),x(1),E("ngIf",n.item(i).videoSource),x(1),E("ngIf",n.item(i).videoCodec),x(1),E("ngIf",n.item(i).videoModifier)}}function _oe(e,t){1&e&&(y(0,"th",62),A(1,"Size"),w())}function boe(e,t){if(1&e&&(y(0,"td",64),A(1),en(2,"filesize"),w()),2&e){const i=t.$implicit,n=B();x(1),Ue(" ",nn(2,1,n.item(i).torrent.size)," ")}}function voe(e,t){1&e&&(y(0,"th",62)(1,"abbr",70),A(2,"S / L"),w()())}function yoe(e,t){if(1&e&&(y(0,"td",64),A(1),w()),2&e){const i=t.$implicit,n=B();let r;x(1),Uo(" ",null!==(r=n.item(i).torrent.seeders)&&void 0!==r?r:"?"," / ",null!==(r=n.item(i).torrent.leechers)&&void 0!==r?r:"?"," ")}}function woe(e,t){1&e&&(y(0,"th",71),A(1," Magnet "),w())}function xoe(e,t){if(1&e&&(y(0,"td",64)(1,"a",72),ie(2,"mat-icon",73),w()()),2&e){const i=t.$implicit,n=B();x(1),A_("href",n.item(i).torrent.magnetUri,tl)}}function Coe(e,t){1&e&&ie(0,"img",81),2&e&&E("src","/images/tmdb/w300"+t.ngIf,tl)}function Doe(e,t){if(1&e&&(y(0,"span"),A(1),w()),2&e){const i=t.$implicit,n=t.index;x(1),bt((n>0?", ":"")+i.name)}}function Eoe(e,t){if(1&e&&(y(0,"p")(1,"strong"),A(2,"Title:"),w(),A(3),w()),2&e){const i=B().$implicit,n=B();x(3),Ue(" ",null==n.item(i).content?null:n.item(i).content.title," ")}}function Soe(e,t){if(1&e&&(Ht(0),A(1),zt()),2&e){const i=t.$implicit,n=t.index,r=B(2).$implicit,o=B();x(1),Ue(" ",(n>0?", ":"")+i.name+(i.id===(null==o.item(r).content||null==o.item(r).content.originalLanguage?null:o.item(r).content.originalLanguage.id)?" (original)":"")," ")}}function koe(e,t){if(1&e&&(y(0,"p")(1,"strong"),A(2,"Language:"),w(),A(3,"\xa0"),R(4,Soe,2,1,"ng-container",7),w()),2&e){const i=B().$implicit,n=B();x(4),E("ngForOf",n.item(i).languages)}}function Toe(e,t){if(1&e&&(y(0,"p")(1,"strong"),A(2,"Original release date:"),w(),A(3),w()),2&e){const i=B().$implicit,n=B();let r;x(3),Ue(" ",null!==(r=null==n.item(i).content?null:n.item(i).content.releaseDate)&&void 0!==r?r:null==n.item(i).content?null:n.item(i).content.releaseYear," ")}}function Moe(e,t){if(1&e&&(y(0,"p")(1,"strong"),A(2,"Episodes:"),w(),A(3),w()),2&e){const i=B().$implicit,n=B();x(3),Ue(" ",n.item(i).episodes.label," ")}}function Ioe(e,t){if(1&e&&(y(0,"p"),A(1),w()),2&e){const i=B().$implicit,n=B();x(1),Ue(" ",n.item(i).content.overview," ")}}function Aoe(e,t){if(1&e&&(Ht(0),y(1,"p")(2,"strong"),A(3,"Genres:"),w(),A(4),w(),zt()),2&e){const i=t.$implicit;x(4),Ue(" ",i.join(", "),"")}}function Roe(e,t){if(1&e&&(Ht(0),A(1),zt()),2&e){const i=B(2).$implicit,n=B();x(1),Ue("(",null==n.item(i).content?null:n.item(i).content.voteCount," votes)")}}function Ooe(e,t){if(1&e&&(y(0,"p")(1,"strong"),A(2,"Rating:"),w(),A(3),R(4,Roe,2,1,"ng-container",31),w()),2&e){const i=B().$implicit,n=B();x(3),Ue(" ",null==n.item(i).content?null:n.item(i).content.voteAverage," / 10 "),x(1),E("ngIf",null!=(null==n.item(i).content?null:n.item(i).content.voteCount))}}function Foe(e,t){if(1&e&&(Ht(0),A(1),y(2,"a",82),A(3),w(),zt()),2&e){const i=t.$implicit,n=t.index;x(1),Ue(" ",n>0?", ":"",""),x(1),E("href",i.url,tl),x(1),bt(i.metadataSource.name)}}function Poe(e,t){if(1&e&&(y(0,"p")(1,"strong"),A(2,"External links:"),w(),A(3,"\xa0 "),R(4,Foe,4,3,"ng-container",7),w()),2&e){const i=t.$implicit;x(4),E("ngForOf",i)}}function Noe(e,t){1&e&&(y(0,"mat-icon"),A(1,"file_present"),w(),A(2," Files "))}function Loe(e,t){1&e&&(y(0,"p"),A(1," No file information available. "),w())}function Boe(e,t){1&e&&(y(0,"p"),A(1," Files information was not saved as the number of files is over the configured threshold. "),w())}function Voe(e,t){if(1&e&&(y(0,"span")(1,"strong"),A(2,"File type: "),w(),A(3),ie(4,"br"),w()),2&e){const i=t.$implicit;x(3),Ue(" ",i.charAt(0).toUpperCase()+i.slice(1),"")}}function joe(e,t){if(1&e&&(y(0,"p")(1,"strong"),A(2,"Single file:"),w(),A(3),ie(4,"br"),R(5,Voe,5,1,"span",31),y(6,"strong"),A(7,"File size:"),w(),A(8),en(9,"filesize"),w()),2&e){const i=B(2).$implicit,n=B();x(3),Ue(" ",n.item(i).torrent.name,""),x(2),E("ngIf",n.item(i).torrent.fileType),x(3),Ue(" ",nn(9,3,n.item(i).torrent.size)," ")}}function Hoe(e,t){if(1&e&&(y(0,"tr")(1,"td",84),A(2),w(),y(3,"td"),A(4),w(),y(5,"td",85),A(6),en(7,"filesize"),w()()),2&e){const i=t.$implicit;x(2),Ue(" ",i.path," "),x(2),Ue(" ",i.fileType?i.fileType.charAt(0).toUpperCase()+i.fileType.slice(1):"Unknown"," "),x(2),Ue(" ",nn(7,3,i.size)," ")}}function zoe(e,t){if(1&e&&(y(0,"table")(1,"thead")(2,"tr")(3,"th"),A(4,"Path"),w(),y(5,"th"),A(6,"Type"),w(),y(7,"th"),A(8,"Size"),w()()(),y(9,"tbody"),R(10,Hoe,8,5,"tr",7),w()()),2&e){const i=B(2).$implicit,n=B();x(10),E("ngForOf",n.item(i).torrent.files)}}function Uoe(e,t){if(1&e&&(y(0,"mat-card",83),R(1,Loe,2,0,"p",31),R(2,Boe,2,0,"p",31),R(3,joe,10,5,"p",31),R(4,zoe,11,1,"table",31),w()),2&e){const i=B().$implicit,n=B();x(1),E("ngIf","no_info"===n.item(i).torrent.filesStatus),x(1),E("ngIf","over_threshold"===n.item(i).torrent.filesStatus),x(1),E("ngIf","single"===n.item(i).torrent.filesStatus),x(1),E("ngIf",null==n.item(i).torrent.files?null:n.item(i).torrent.files.length)}}function $oe(e,t){1&e&&(y(0,"mat-icon"),A(1,"sell"),w(),A(2," Edit tags "))}function qoe(e,t){if(1&e){const i=Ut();y(0,"mat-chip-row",58),$("edited",function(r){const s=Ye(i).$implicit;return Ke(B(3).expandedItem.renameTag(s,r.value))})("removed",function(){const o=Ye(i).$implicit;return Ke(B(3).expandedItem.deleteTag(o))}),A(1),y(2,"button",59)(3,"mat-icon"),A(4,"cancel"),w()()()}if(2&e){const i=t.$implicit;E("editable",!0)("aria-description","press enter to edit"),x(1),Ue(" ",i," "),x(1),de("aria-label","remove "+i)}}function Goe(e,t){if(1&e&&(y(0,"mat-option",50),A(1),w()),2&e){const i=t.$implicit;E("value",i),x(1),bt(i)}}function Woe(e,t){if(1&e){const i=Ut();y(0,"mat-card")(1,"mat-form-field",19)(2,"mat-chip-grid",20,21),R(4,qoe,5,4,"mat-chip-row",22),w(),y(5,"input",86),$("matChipInputTokenEnd",function(r){Ye(i);const o=B(2);return Ke(r.value&&o.expandedItem.addTag(r.value))}),w(),y(6,"mat-autocomplete",24,25),$("optionSelected",function(r){return Ye(i),Ke(B(2).expandedItem.addTag(r.option.viewValue))}),R(8,Goe,2,2,"mat-option",6),w()()()}if(2&e){const i=hn(3),n=hn(7),r=B().$implicit,o=B();x(4),E("ngForOf",o.item(r).torrent.tagNames),x(1),E("formControl",o.expandedItem.newTagCtrl)("matAutocomplete",n)("matChipInputFor",i)("matChipInputSeparatorKeyCodes",o.separatorKeysCodes)("value",o.expandedItem.newTagCtrl.value),x(3),E("ngForOf",o.expandedItem.suggestedTags)}}function Qoe(e,t){1&e&&(y(0,"mat-icon"),A(1,"delete_forever"),w(),A(2," Delete "))}function Yoe(e,t){if(1&e){const i=Ut();y(0,"mat-card")(1,"mat-card-content",87)(2,"p")(3,"strong"),A(4,"Are you sure you want to delete this torrent?"),w(),ie(5,"br"),A(6,"This action cannot be undone. "),w()(),y(7,"mat-card-actions",26)(8,"button",88),$("click",function(){return Ye(i),Ke(B(2).expandedItem.delete())}),y(9,"mat-icon"),A(10,"delete_forever"),w(),A(11,"Delete "),w()()()}}function Koe(e,t){1&e&&(y(0,"mat-icon",60),A(1,"close"),w())}function Xoe(e,t){1&e&&(y(0,"mat-tab"),R(1,Koe,2,0,"ng-template",18),w())}function Zoe(e,t){if(1&e){const i=Ut();y(0,"td",64)(1,"div",74),R(2,Coe,1,1,"img",75),y(3,"h2"),A(4),w(),y(5,"p")(6,"strong"),A(7,"Info hash:"),w(),y(8,"span",76),A(9),w()(),y(10,"p")(11,"strong"),A(12,"Source:"),w(),A(13,"\xa0"),R(14,Doe,2,1,"span",7),w(),R(15,Eoe,4,1,"p",31),R(16,koe,5,1,"p",31),R(17,Toe,4,1,"p",31),R(18,Moe,4,1,"p",31),R(19,Ioe,2,1,"p",31),R(20,Aoe,5,1,"ng-container",31),R(21,Ooe,5,2,"p",31),R(22,Poe,5,1,"p",31),ie(23,"mat-divider",77),y(24,"mat-tab-group",78),$("focusChange",function(r){return Ye(i),Ke(B().expandedItem.selectTab(4==r.index?0:r.index))}),ie(25,"mat-tab",79),y(26,"mat-tab"),R(27,Noe,3,0,"ng-template",18),R(28,Uoe,5,4,"mat-card",80),w(),y(29,"mat-tab"),R(30,$oe,3,0,"ng-template",18),R(31,Woe,9,7,"mat-card",31),w(),y(32,"mat-tab"),R(33,Qoe,3,0,"ng-template",18),R(34,Yoe,12,0,"mat-card",31),w(),R(35,Xoe,2,0,"mat-tab",31),w()()()}if(2&e){const i=t.$implicit,n=B();de("colspan",n.displayedColumns.length),x(1),E("@detailExpand",n.expandedItem.id===i.id?"expanded":"collapsed"),x(1),E("ngIf",n.getAttribute(n.item(i),"poster_path","tmdb")),x(2),bt(n.item(i).torrent.name),x(4),E("cdkCopyToClipboard",n.item(i).infoHash),x(1),bt(n.item(i).infoHash),x(5),E("ngForOf",n.item(i).torrent.sources),x(1),E("ngIf",n.item(i).content),x(1),E("ngIf",null==n.item(i).languages?null:n.item(i).languages.length),x(1),E("ngIf",null==n.item(i).content?null:n.item(i).content.releaseYear),x(1),E("ngIf",n.item(i).episodes),x(1),E("ngIf",null==n.item(i).content?null:n.item(i).content.overview),x(1),E("ngIf",n.getCollections(i,"genre")),x(1),E("ngIf",null!=(null==n.item(i).content?null:n.item(i).content.voteAverage)),x(1),E("ngIf",null==n.item(i).content?null:n.item(i).content.externalLinks),x(2),E("selectedIndex",n.expandedItem.selectedTabIndex)("mat-stretch-tabs",!1),x(1),E("aria-labelledby","hidden"),x(3),E("ngIf",n.expandedItem.id===i.id),x(3),E("ngIf",n.expandedItem.id===i.id),x(3),E("ngIf",n.expandedItem.id===i.id),x(1),E("ngIf",n.expandedItem.selectedTabIndex>0)}}function Joe(e,t){1&e&&ie(0,"tr",89)}function ese(e,t){if(1&e&&ie(0,"tr",90),2&e){const i=t.$implicit,n=B();bh("summary-row "+(i.id===n.expandedItem.id?"expanded":"collapsed"))}}function tse(e,t){1&e&&ie(0,"tr",91)}const nse=function(){return["expandedDetail"]};let ise=(()=>{var e;class t{constructor(n,r){this.graphQLService=n,this.errorsService=r,this.search=new gK(this.graphQLService,this.errorsService),this.displayedColumns=["select","summary","size","peers","magnet"],this.queryString=new Ka(""),this.items=Array(),this.contentType=new Ka(void 0),this.separatorKeysCodes=[13,188],this.selectedItems=new qR(!0,[]),this.selectedTabIndex=0,this.newTagCtrl=new Ka(""),this.editedTags=Array(),this.suggestedTags=Array(),this.expandedItem=new class{constructor(o){this.ds=o,this.itemSubject=new dt(void 0),this.newTagCtrl=new Ka(""),this.editedTags=Array(),this.suggestedTags=Array(),this.selectedTabIndex=0,o.search.items$.subscribe(s=>{const a=this.itemSubject.getValue();if(!a)return;const c=s.find(l=>l.id===a.id);this.editedTags=c?.torrent.tagNames??[],this.itemSubject.next(c)}),this.newTagCtrl.valueChanges.subscribe(s=>(s&&(s=rN(s),this.newTagCtrl.setValue(s,{emitEvent:!1})),o.graphQLService.torrentSuggestTags({query:{prefix:s,exclusions:this.itemSubject.getValue()?.torrent.tagNames}}).pipe(it(a=>{this.suggestedTags.splice(0,this.suggestedTags.length,...a.suggestions.map(c=>c.name))})).subscribe()))}get id(){return this.itemSubject.getValue()?.id}toggle(o){o===this.id&&(o=void 0);const s=this.ds.items.find(c=>c.id===o);this.itemSubject.getValue()?.id!==o&&(this.itemSubject.next(s),this.editedTags=s?.torrent.tagNames??[],this.newTagCtrl.reset(),this.selectedTabIndex=0)}selectTab(o){this.selectedTabIndex=o}addTag(o){this.editTags(s=>[...s,o]),this.saveTags()}renameTag(o,s){this.editTags(a=>a.map(c=>c===o?s:c)),this.saveTags()}deleteTag(o){this.editTags(s=>s.filter(a=>a!==o)),this.saveTags()}editTags(o){this.itemSubject.getValue()&&(this.editedTags=o(this.editedTags),this.newTagCtrl.reset())}saveTags(){const o=this.itemSubject.getValue();o&&this.ds.graphQLService.torrentSetTags({infoHashes:[o.infoHash],tagNames:this.editedTags}).pipe(Rn(s=>(this.ds.errorsService.addError(`Error saving tags: ${s.message}`),Rt))).pipe(it(()=>{this.editedTags=[],this.ds.search.loadResult(!1)})).subscribe()}delete(){const o=this.itemSubject.getValue();o&&this.ds.deleteTorrents([o.infoHash])}}(this),this.search.items$.subscribe(o=>{this.items=o,this.selectedItems.setSelection(...o.filter(({id:s})=>this.selectedItems.selected.some(({id:a})=>a===s)))})}ngAfterContentInit(){this.loadResult()}ngAfterViewInit(){this.contentType.valueChanges.subscribe(n=>{this.search.selectContentType(n)}),this.newTagCtrl.valueChanges.subscribe(n=>{n&&this.newTagCtrl.setValue(rN(n),{emitEvent:!1}),this.updateSuggestedTags()}),this.updateSuggestedTags()}get isDeepFiltered(){return this.search.isDeepFiltered}loadResult(n=!0){this.search.loadResult(n)}item(n){return n}originalOrder(){return 0}getAttribute(n,r,o){return n.content?.attributes?.find(s=>s.key===r&&(void 0===o||s.source===o))?.value}getCollections(n,r){const o=n.content?.collections?.filter(s=>s.type===r).map(s=>s.name);return o?.length?o.sort():void 0}isAllSelected(){return this.items.every(n=>this.selectedItems.isSelected(n))}toggleAllRows(){this.isAllSelected()?this.selectedItems.clear():this.selectedItems.select(...this.items)}checkboxLabel(n){return n?`${this.selectedItems.isSelected(n)?"deselect":"select"} ${n.torrent.name}`:(this.isAllSelected()?"deselect":"select")+" all"}selectTab(n){this.selectedTabIndex=n}addTag(n){this.editedTags.includes(n)||this.editedTags.push(n),this.newTagCtrl.reset(),this.updateSuggestedTags()}deleteTag(n){this.editedTags=this.editedTags.filter(r=>r!==n),this.updateSuggestedTags()}renameTag(n,r){this.editedTags=this.editedTags.map(o=>o===n?r:o),this.updateSuggestedTags()}putTags(){const n=this.selectedItems.selected.map(r=>r.infoHash);if(n.length)return this.newTagCtrl.value&&this.addTag(this.newTagCtrl.value),this.graphQLService.torrentPutTags({infoHashes:n,tagNames:this.editedTags}).pipe(Rn(r=>(this.errorsService.addError(`Error putting tags: ${r.message}`),Rt))).pipe(it(()=>{this.search.loadResult(!1)})).subscribe()}setTags(){const n=this.selectedItems.selected.map(r=>r.infoHash);if(n.length)return this.newTagCtrl.value&&this.addTag(this.newTagCtrl.value),this.graphQLService.torrentSetTags({infoHashes:n,tagNames:this.editedTags}).pipe(Rn(r=>(this.errorsService.addError(`Error setting tags: ${r.message}`),Rt))).pipe(it(()=>{this.search.loadResult(!1)})).subscribe()}deleteTags(){const n=this.selectedItems.selected.map(r=>r.infoHash);if(n.length)return this.newTagCtrl.value&&this.addTag(this.newTagCtrl.value),this.graphQLService.torrentDeleteTags({infoHashes:n,tagNames:this.editedTags}).pipe(Rn(r=>(this.errorsService.addError(`Error deleting tags: ${r.message}`),Rt))).pipe(it(()=>{this.search.loadResult(!1)})).subscribe()}updateSuggestedTags(){return this.graphQLService.torrentSuggestTags({query:{prefix:this.newTagCtrl.value,exclusions:this.editedTags}}).pipe(it(n=>{this.suggestedTags.splice(0,this.suggestedTags.length,...n.suggestions.map(r=>r.name))})).subscribe()}selectedInfoHashes(){return this.selectedItems.selected.map(n=>n.infoHash)}deleteTorrents(n){this.graphQLService.torrentDelete({infoHashes:n}).pipe(Rn(r=>(this.errorsService.addError(`Error deleting torrents: ${r.message}`),Rt))).pipe(it(()=>{this.search.loadResult(!1)})).subscribe()}}return(e=t).\u0275fac=function(n){return new(n||e)(m(OF),m(LF))},e.\u0275cmp=fe({type:e,selectors:[["app-torrent-content"]],decls:101,vars:60,consts:[[1,"example-container"],["opened","",3,"mode"],["drawer",""],[1,"panel-content-type",3,"expanded"],[3,"formControl"],["fontSet","material-icons"],[3,"value",4,"ngFor","ngForOf"],[4,"ngFor","ngForOf"],[1,"results"],[1,"search-form"],["fontSet","material-icons",3,"click"],[1,"field-search-query"],["matInput","","placeholder","Search",3,"formControl","keyup.enter"],["matSuffix","","mat-icon-button","","aria-label","Clear",3,"click",4,"ngIf"],[1,"button-refresh"],["mat-mini-fab","","title","Refresh results","color","primary",3,"click"],["animationDuration","0",1,"tab-group-bulk-actions",3,"selectedIndex","mat-stretch-tabs","focusChange"],[1,"bulk-tab-placeholder",3,"aria-labelledby"],["mat-tab-label",""],[1,"form-edit-tags"],["aria-label","Enter tags"],["chipGrid",""],[3,"editable","aria-description","edited","removed",4,"ngFor","ngForOf"],["placeholder","Tag...",3,"formControl","matAutocomplete","matChipInputFor","matChipInputSeparatorKeyCodes","value","matChipInputTokenEnd"],[3,"optionSelected"],["auto","matAutocomplete"],[1,"button-row"],["mat-stroked-button","","color","primary","title","Replace tags of the selected torrents",3,"disabled","click"],["mat-stroked-button","","color","primary","title","Add tags to the selected torrents",3,"disabled","click"],["mat-stroked-button","","color","primary","title","Remove tags from the selected torrents",3,"disabled","click"],["mat-stroked-button","","color","warn",3,"disabled","click"],[4,"ngIf"],[1,"progress-bar-container",2,"height","10px"],["mode","indeterminate",4,"ngIf"],["mat-table","",1,"table-results",3,"dataSource","multiTemplateDataRows"],["matColumnDef","select"],["mat-header-cell","",4,"matHeaderCellDef"],["mat-cell","",4,"matCellDef"],["matColumnDef","summary"],["mat-cell","",3,"click",4,"matCellDef"],["matColumnDef","size"],["matColumnDef","peers"],["matColumnDef","magnet"],["mat-header-cell","","style","text-align: center",4,"matHeaderCellDef"],["matColumnDef","expandedDetail"],["mat-header-row","",4,"matHeaderRowDef"],["mat-row","",3,"class",4,"matRowDef","matRowDefColumns"],["mat-row","","class","expanded-detail-row",4,"matRowDef","matRowDefColumns"],[1,"spacer"],[3,"pageIndex","pageSize","pageLength","totalLength","totalLessThanOrEqual","page"],[3,"value"],[3,"expanded","opened","closed",4,"ngIf"],[3,"expanded","opened","closed"],[3,"checked","color","display","change",4,"ngFor","ngForOf"],["class","empty",4,"ngIf"],[3,"checked","color","change"],[1,"empty"],["matSuffix","","mat-icon-button","","aria-label","Clear",3,"click"],[3,"editable","aria-description","edited","removed"],["matChipRemove",""],[2,"margin-right","0"],["mode","indeterminate"],["mat-header-cell",""],[3,"checked","indeterminate","aria-label","change"],["mat-cell",""],[3,"checked","aria-label","click","change"],["mat-cell","",3,"click"],[1,"title"],["class","chip-primary",4,"ngFor","ngForOf"],[1,"chip-primary"],["title","Seeders / Leechers"],["mat-header-cell","",2,"text-align","center"],[3,"href"],["svgIcon","magnet"],[1,"item-detail"],["class","poster",3,"src",4,"ngIf"],["title","Copy to clipboard",1,"info-hash",3,"cdkCopyToClipboard"],[2,"clear","both"],["animationDuration","0",3,"selectedIndex","mat-stretch-tabs","focusChange"],[3,"aria-labelledby"],["class","torrent-files",4,"ngIf"],[1,"poster",3,"src"],["target","_blank",3,"href"],[1,"torrent-files"],[1,"table-torrent-files-td-file"],[1,"table-torrent-files-td-size"],["placeholder","New tag...",3,"formControl","matAutocomplete","matChipInputFor","matChipInputSeparatorKeyCodes","value","matChipInputTokenEnd"],[2,"margin-top","10px"],["mat-stroked-button","","color","warn",3,"click"],["mat-header-row",""],["mat-row",""],["mat-row","",1,"expanded-detail-row"]],template:function(n,r){if(1&n){const o=Ut();y(0,"mat-drawer-container",0)(1,"mat-drawer",1,2)(3,"mat-expansion-panel",3)(4,"mat-expansion-panel-header")(5,"mat-panel-title")(6,"mat-icon"),A(7,"interests"),w(),A(8," Content Type "),w()(),y(9,"section")(10,"mat-radio-group",4)(11,"mat-radio-button")(12,"mat-icon",5),A(13,"emergency"),w(),A(14,"All "),y(15,"small"),A(16),en(17,"number"),en(18,"async"),w()(),R(19,Gre,8,9,"mat-radio-button",6),en(20,"keyvalue"),w()()(),R(21,Kre,2,1,"ng-container",7),w(),y(22,"mat-drawer-content")(23,"div",8)(24,"div",9)(25,"mat-icon",10),$("click",function(){return Ye(o),Ke(hn(2).toggle())}),A(26),w(),y(27,"mat-form-field",11)(28,"input",12),$("keyup.enter",function(){let a;return r.search.setQueryString(null!==(a=r.queryString.value)&&void 0!==a?a:""),r.search.firstPage(),r.search.loadResult()}),w(),R(29,Xre,3,0,"button",13),w(),y(30,"div",14)(31,"button",15),$("click",function(){return r.loadResult(!1)}),y(32,"mat-icon"),A(33,"sync"),w()()()(),ie(34,"mat-divider"),y(35,"mat-tab-group",16),$("focusChange",function(a){return r.selectTab(3==a.index?0:a.index)}),ie(36,"mat-tab",17),y(37,"mat-tab"),R(38,Zre,3,0,"ng-template",18),y(39,"mat-card")(40,"mat-form-field",19)(41,"mat-chip-grid",20,21),R(43,Jre,5,4,"mat-chip-row",22),w(),y(44,"input",23),$("matChipInputTokenEnd",function(a){return a.value&&r.addTag(a.value)}),w(),y(45,"mat-autocomplete",24,25),$("optionSelected",function(a){return r.addTag(a.option.viewValue)}),R(47,eoe,2,2,"mat-option",6),w()(),y(48,"mat-card-actions",26)(49,"button",27),$("click",function(){return r.setTags()}),A(50," Set tags "),w(),y(51,"button",28),$("click",function(){return r.putTags()}),A(52," Put tags "),w(),y(53,"button",29),$("click",function(){return r.deleteTags()}),A(54," Delete tags "),w()()()(),y(55,"mat-tab"),R(56,toe,3,0,"ng-template",18),y(57,"mat-card")(58,"mat-card-content")(59,"p")(60,"strong"),A(61,"Are you sure you want to delete the selected torrents?"),w(),ie(62,"br"),A(63,"This action cannot be undone. "),w()(),y(64,"mat-card-actions",26)(65,"button",30),$("click",function(){return r.deleteTorrents(r.selectedInfoHashes())}),y(66,"mat-icon"),A(67,"delete_forever"),w(),A(68,"Delete "),w()()()(),R(69,ioe,2,0,"mat-tab",31),w(),ie(70,"mat-divider"),y(71,"div",32),R(72,roe,1,0,"mat-progress-bar",33),en(73,"async"),w(),y(74,"table",34),Ht(75,35),R(76,ooe,2,3,"th",36),R(77,soe,2,2,"td",37),zt(),Ht(78,38),R(79,aoe,2,0,"th",36),R(80,goe,13,10,"td",39),zt(),Ht(81,40),R(82,_oe,2,0,"th",36),R(83,boe,3,3,"td",37),zt(),Ht(84,41),R(85,voe,3,0,"th",36),R(86,yoe,2,2,"td",37),zt(),Ht(87,42),R(88,woe,2,0,"th",43),R(89,xoe,3,1,"td",37),zt(),Ht(90,44),R(91,Zoe,36,22,"td",37),zt(),R(92,Joe,1,0,"tr",45),R(93,ese,1,2,"tr",46),R(94,tse,1,0,"tr",47),w(),ie(95,"span",48),y(96,"app-paginator",49),$("page",function(a){return r.search.handlePageEvent(a)}),en(97,"async"),en(98,"async"),en(99,"async"),en(100,"async"),w()()()()}if(2&n){const o=hn(2),s=hn(42),a=hn(46);x(1),E("mode","side"),x(2),E("expanded",!0),x(7),E("formControl",r.contentType),x(6),Uo("",r.isDeepFiltered?"\u2264 ":"","",nn(17,42,nn(18,44,r.search.overallTotalCount$)),""),x(3),E("ngForOf",function gk(e,t,i,n){const r=e+Oe,o=F(),s=Vs(o,r);return El(o,r)?hk(o,Dn(),t,s.transform,i,n,s):s.transform(i,n)}(20,46,r.search.contentTypes,r.originalOrder)),x(2),E("ngForOf",r.search.facets),x(1),kn("z-index",100)("overflow","visible"),x(3),bh("toggle-drawer "+(o.opened?"opened":"closed")),x(1),bt(o.opened?"arrow_circle_left":"arrow_circle_right"),x(2),E("formControl",r.queryString),x(1),E("ngIf",r.queryString.value),x(6),E("selectedIndex",r.selectedTabIndex)("mat-stretch-tabs",!1),x(1),E("aria-labelledby","hidden"),x(7),E("ngForOf",r.editedTags),x(1),E("formControl",r.newTagCtrl)("matAutocomplete",a)("matChipInputFor",s)("matChipInputSeparatorKeyCodes",r.separatorKeysCodes)("value",r.newTagCtrl.value),x(3),E("ngForOf",r.suggestedTags),x(2),E("disabled",!r.selectedItems.hasValue()),x(2),E("disabled",!r.selectedItems.hasValue()||!r.editedTags.length&&!r.newTagCtrl.value),x(2),E("disabled",!r.selectedItems.hasValue()||!r.editedTags.length&&!r.newTagCtrl.value),x(12),E("disabled",!r.selectedItems.hasValue()),x(4),E("ngIf",r.selectedTabIndex>0),x(3),E("ngIf",nn(73,49,r.search.loading$)),x(2),E("dataSource",r.search)("multiTemplateDataRows",!0),x(18),E("matHeaderRowDef",r.displayedColumns),x(1),E("matRowDefColumns",r.displayedColumns),x(1),E("matRowDefColumns",function ck(e,t,i){const n=Dn()+e,r=F();return r[n]===De?tr(r,n,i?t.call(i):t()):function fl(e,t){return e[t]}(r,n)}(59,nse)),x(2),E("pageIndex",nn(97,51,r.search.pageIndex$))("pageSize",nn(98,53,r.search.pageSize$))("pageLength",nn(99,55,r.search.pageLength$))("totalLength",nn(100,57,r.search.maxTotalCount$))("totalLessThanOrEqual",r.search.isDeepFiltered)}},dependencies:[Wh,_i,nee,Xee,Nf,XF,g1,Qv,LG,ZF,eP,JF,iP,vs,hP,fP,lP,aw,em,Jte,yP,mne,gne,QF,Nee,qv,Dne,Mne,SP,kP,IP,AP,tm,LP,vw,BP,yw,bw,VP,ww,xw,jP,HP,qP,YP,rre,zre,pp,fR,qy,YT,Pb,KT,$re],styles:[".mat-drawer-container[_ngcontent-%COMP%]{min-height:100%}.mat-drawer.mat-drawer-side[_ngcontent-%COMP%]{width:300px}.mat-drawer.mat-drawer-side[_ngcontent-%COMP%]   h4[_ngcontent-%COMP%]{margin-bottom:0}.mat-drawer.mat-drawer-side[_ngcontent-%COMP%]   .panel-content-type[_ngcontent-%COMP%]   mat-radio-button[_ngcontent-%COMP%]{width:100%;display:block}.mat-drawer.mat-drawer-side[_ngcontent-%COMP%]   .panel-content-type[_ngcontent-%COMP%]   mat-radio-button[_ngcontent-%COMP%]     .mdc-radio{display:none}.mat-drawer.mat-drawer-side[_ngcontent-%COMP%]   .panel-content-type[_ngcontent-%COMP%]   mat-radio-button[_ngcontent-%COMP%]     .mdc-form-field, .mat-drawer.mat-drawer-side[_ngcontent-%COMP%]   .panel-content-type[_ngcontent-%COMP%]   mat-radio-button[_ngcontent-%COMP%]     label{cursor:pointer;display:block;height:40px}.mat-drawer.mat-drawer-side[_ngcontent-%COMP%]   .panel-content-type[_ngcontent-%COMP%]   mat-radio-button[_ngcontent-%COMP%]   mat-icon[_ngcontent-%COMP%]{margin-right:10px;position:relative;top:5px}.mat-drawer.mat-drawer-side[_ngcontent-%COMP%]   .panel-content-type[_ngcontent-%COMP%]     .mat-mdc-radio-checked label{color:#e91e63}.mat-drawer.mat-drawer-side[_ngcontent-%COMP%]   section[_ngcontent-%COMP%]{margin-bottom:10px}.mat-drawer.mat-drawer-side[_ngcontent-%COMP%]   section[_ngcontent-%COMP%]   mat-checkbox[_ngcontent-%COMP%]{position:relative;left:-10px}.mat-drawer.mat-drawer-side[_ngcontent-%COMP%]   section.empty[_ngcontent-%COMP%]     .mdc-checkbox__background{background-color:#d3d3d3;border-color:#d3d3d3}  mat-checkbox label small,   mat-radio-button label small{margin-left:8px}.mat-expansion-panel-header[_ngcontent-%COMP%]{white-space:nowrap}.mat-expansion-panel-header[_ngcontent-%COMP%]   .mat-icon[_ngcontent-%COMP%]{overflow:visible;margin-right:20px}.search-form[_ngcontent-%COMP%]   .toggle-drawer[_ngcontent-%COMP%]{cursor:pointer;width:30px;height:30px;font-size:30px;left:-10px;margin-right:10px;position:relative;top:-25px;display:inline-block}.search-form[_ngcontent-%COMP%]   mat-form-field[_ngcontent-%COMP%]{display:inline-block;margin-right:20px}.search-form[_ngcontent-%COMP%]   mat-form-field.field-search-query[_ngcontent-%COMP%]{width:300px}.search-form[_ngcontent-%COMP%]   mat-form-field.field-auto-refresh[_ngcontent-%COMP%]{width:130px}.search-form[_ngcontent-%COMP%]   .button-refresh[_ngcontent-%COMP%]{display:inline-block;width:50px;vertical-align:top;padding-top:10px}.mat-column-select[_ngcontent-%COMP%]{padding-right:10px;width:30px}.mat-column-summary[_ngcontent-%COMP%]{padding-left:0}.mat-column-summary[_ngcontent-%COMP%]   button[_ngcontent-%COMP%]{padding-left:0;padding-right:0}th.mat-column-summary[_ngcontent-%COMP%]{padding-left:10px}td.mat-column-summary[_ngcontent-%COMP%]{vertical-align:middle;cursor:pointer}td.mat-column-summary[_ngcontent-%COMP%]   .title[_ngcontent-%COMP%]{display:inline-block;line-height:30px;word-wrap:break-word;max-width:900px}td.mat-column-summary[_ngcontent-%COMP%]   .mat-icon[_ngcontent-%COMP%]{display:inline-block;position:relative;top:5px;margin-right:10px}td.mat-column-summary[_ngcontent-%COMP%]   mat-chip-set[_ngcontent-%COMP%]{display:inline-block;margin-left:30px;position:relative;top:-2px;margin-bottom:4px}td.mat-column-summary[_ngcontent-%COMP%]   mat-chip-set[_ngcontent-%COMP%]   mat-chip[_ngcontent-%COMP%]{margin:0 10px 0 0}tr.expanded-detail-row[_ngcontent-%COMP%]{height:0}tr.mat-mdc-row.expanded[_ngcontent-%COMP%]   td.mat-column-summary[_ngcontent-%COMP%]   mat-icon[_ngcontent-%COMP%]{color:#e91e63}tr.mat-mdc-row.expanded[_ngcontent-%COMP%]   td[_ngcontent-%COMP%]{border-bottom:0}tr.mat-mdc-row.expanded[_ngcontent-%COMP%] + .expanded-detail-row[_ngcontent-%COMP%] > td[_ngcontent-%COMP%]{padding-bottom:10px}.mat-mdc-row.summary-row[_ngcontent-%COMP%]:hover   .mat-mdc-cell[_ngcontent-%COMP%]{background-color:#f5f5f5}.mat-mdc-row.summary-row[_ngcontent-%COMP%]:hover + tr.expanded-detail-row[_ngcontent-%COMP%]{background-color:#f5f5f5}.mat-column-magnet[_ngcontent-%COMP%]{text-align:center}.mat-column-magnet[_ngcontent-%COMP%]   .mat-icon[_ngcontent-%COMP%]{position:relative;top:5px}.item-detail[_ngcontent-%COMP%]{width:100%;overflow:hidden}.item-detail[_ngcontent-%COMP%]   h2[_ngcontent-%COMP%]{margin-top:10px;max-width:900px;word-wrap:break-word}.item-detail[_ngcontent-%COMP%]   .poster[_ngcontent-%COMP%]{float:right;margin:10px;border:1px solid currentColor}.item-detail[_ngcontent-%COMP%]   .info-hash[_ngcontent-%COMP%]{padding-left:5px;cursor:crosshair;text-decoration:underline;text-decoration-style:dotted}  .mdc-tab__text-label mat-icon{margin-right:10px}  div[aria-labelledby=hidden]{display:none}.results[_ngcontent-%COMP%]{padding-top:20px}.form-edit-tags[_ngcontent-%COMP%]{width:100%}.mat-mdc-standard-chip[_ngcontent-%COMP%]:not(.mdc-evolution-chip--disabled).chip-primary{background-color:#c5cae9}.mat-mdc-standard-chip[_ngcontent-%COMP%]:not(.mdc-evolution-chip--disabled).chip-primary   .mat-mdc-standard-chip[_ngcontent-%COMP%]:not(.mdc-evolution-chip--disabled).mdc-evolution-chip__text-label{color:#fff}.torrent-files[_ngcontent-%COMP%]{padding-top:10px;max-height:800px;overflow:scroll}.torrent-files[_ngcontent-%COMP%]   table[_ngcontent-%COMP%]{margin-bottom:10px;width:800px}.torrent-files[_ngcontent-%COMP%]   td[_ngcontent-%COMP%]{padding-right:20px;border-bottom:1px solid rgba(0,0,0,.12)}.torrent-files[_ngcontent-%COMP%]   tr[_ngcontent-%COMP%]:hover   td[_ngcontent-%COMP%]{background-color:#f5f5f5}.button-row[_ngcontent-%COMP%]   .mat-mdc-button-base[_ngcontent-%COMP%]{margin-left:8px}.form-edit-tags[_ngcontent-%COMP%]     .mat-mdc-form-field-subscript-wrapper{display:none}app-paginator[_ngcontent-%COMP%]{float:right;margin-top:10px;margin-bottom:20px}"],data:{animation:[ni("detailExpand",[qt("collapsed",je({height:"0px",minHeight:"0"})),qt("expanded",je({height:"*"})),Bt("expanded <=> collapsed",Lt("225ms cubic-bezier(0.4, 0.0, 0.2, 1)"))])]}}),t})();const rN=e=>e.toLowerCase().replaceAll(/[^a-z0-9\-]/g,"-").replace(/^-+/,"").replaceAll(/-+/g,"-");let rse=(()=>{var e;class t{constructor(){this.title="bitmagnet"}}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275cmp=fe({type:e,selectors:[["app-root"]],decls:19,vars:3,consts:[["color","primary"],["svgIcon","magnet",2,"position","relative","top","1px"],[2,"margin-left","10px"],[1,"spacer"],["mat-icon-button","","aria-label","Menu",3,"matMenuTriggerFor"],["menu","matMenu"],["mat-menu-item","","href","https://bitmagnet.io","target","_blank"],["mat-menu-item","","href","https://discord.gg/6mFNszX8qM","target","_blank"],["mat-menu-item","","href","https://github.com/bitmagnet-io/bitmagnet","target","_blank"]],template:function(n,r){if(1&n&&(y(0,"p")(1,"mat-toolbar",0),ie(2,"mat-icon",1),y(3,"span",2),A(4,"bitmagnet"),w(),ie(5,"span",3),y(6,"button",4)(7,"mat-icon"),A(8,"menu"),w()(),y(9,"mat-menu",null,5)(11,"a",6),A(12,"bitmagnet.io"),w(),y(13,"a",7),A(14,"bitmagnet on Discord"),w(),y(15,"a",8),A(16,"bitmagnet on GitHub"),w()()()(),ie(17,"app-torrent-content")(18,"router-outlet")),2&n){const o=hn(10);kn("margin-bottom",0),x(6),E("matMenuTriggerFor",o)}},dependencies:[wy,Qv,qv,U9,Wf,W9,zG,ise],styles:[".spacer[_ngcontent-%COMP%]{display:inline-flex;flex:1 1 auto}svg[_ngcontent-%COMP%]   .fill[_ngcontent-%COMP%]{fill:#3f51b5}  .mat-mdc-snack-bar-container.snack-bar-error>div{background-color:#f44336;color:#fff}  .mat-mdc-snack-bar-container.snack-bar-error>div .mdc-button__label{color:#fff}"]}),t})();class ose extends tc{constructor(t,i){super(),wt(this,"httpClient",void 0),wt(this,"options",void 0),wt(this,"requester",void 0),wt(this,"print",yO),this.httpClient=t,this.options=i,this.options.operationPrinter&&(this.print=this.options.operationPrinter),this.requester=n=>new vt(r=>{const o=n.getContext(),s=(v,C)=>function ys(...e){const t=e.find(i=>typeof i<"u");return typeof t>"u"?e[e.length-1]:t}(o[v],this.options[v],C);let a=s("method","POST");const c=s("includeQuery",!0),l=s("includeExtensions",!1),d=s("uri","graphql"),u=s("withCredentials"),h=s("useMultipart"),f=!0===this.options.useGETForQueries,p=n.query.definitions.some(v=>"OperationDefinition"===v.kind&&"query"===v.operation);f&&p&&(a="GET");const g={method:a,url:"function"==typeof d?d(n):d,body:{operationName:n.operationName,variables:n.variables},options:{withCredentials:u,useMultipart:h,headers:this.options.headers}};l&&(g.body.extensions=n.extensions),c&&(g.body.query=this.print(n.query));const b=function aN(e){let t=e.headers&&e.headers instanceof bi?e.headers:new bi(e.headers);if(e.clientAwareness){const{name:i,version:n}=e.clientAwareness;i&&!t.has("apollographql-client-name")&&(t=t.set("apollographql-client-name",i)),n&&!t.has("apollographql-client-version")&&(t=t.set("apollographql-client-version",n))}return t}(o);g.options.headers=((e,t)=>e&&t?t.keys().reduce((n,r)=>n.set(r,t.getAll(r)),e):t||e)(g.options.headers,b);const _=((e,t,i)=>{const n=-1!==["POST","PUT","PATCH"].indexOf(e.method.toUpperCase()),o=e.body.length;let a,s=e.options&&e.options.useMultipart;if(s){if(o)return new Me(l=>l.error(new Error("File upload is not available when combined with Batching")));if(!n)return new Me(l=>l.error(new Error("File upload is not available when GET is used")));if(!i)return new Me(l=>l.error(new Error('To use File upload you need to pass "extractFiles" function from "extract-files" library to HttpLink\'s options')));a=i(e.body),s=!!a.files.size}let c={};if(o){if(!n)return new Me(l=>l.error(new Error("Batching is not available for GET requests")));c={body:e.body}}else c=n?{body:s?a.clone:e.body}:{params:Object.keys(e.body).reduce((u,h)=>{const f=e.body[h];return u[h]=-1!==["variables","extensions"].indexOf(h.toLowerCase())?JSON.stringify(f):f,u},{})};if(s&&n){const l=new FormData;l.append("operations",JSON.stringify(c.body));const d={},u=a.files;let h=0;u.forEach(f=>{d[++h]=f}),l.append("map",JSON.stringify(d)),h=0,u.forEach((f,p)=>{l.append(++h+"",p,p.name)}),c.body=l}return t.request(e.method,e.url,{observe:"response",responseType:"json",reportProgress:!1,...c,...e.options})})(g,this.httpClient,this.options.extractFiles).subscribe({next:v=>{n.setContext({response:v}),r.next(v.body)},error:v=>r.error(v),complete:()=>r.complete()});return()=>{_.closed||_.unsubscribe()}})}request(t){return this.requester(t)}}let sse=(()=>{var e;class t{constructor(n){wt(this,"httpClient",void 0),this.httpClient=n}create(n){return new ose(this.httpClient,n)}}return e=t,wt(t,"\u0275fac",function(n){return new(n||e)(D(nf))}),wt(t,"\u0275prov",V({token:e,factory:e.\u0275fac,providedIn:"root"})),t})();var ase=function(){function e(){this.assumeImmutableResults=!1,this.getFragmentDoc=zp(AK)}return e.prototype.batch=function(t){var r,i=this;return this.performTransaction(function(){return r=t.update(i)},"string"==typeof t.optimistic?t.optimistic:!1===t.optimistic?null:void 0),r},e.prototype.recordOptimisticTransaction=function(t,i){this.performTransaction(t,i)},e.prototype.transformDocument=function(t){return t},e.prototype.transformForLink=function(t){return t},e.prototype.identify=function(t){},e.prototype.gc=function(){return[]},e.prototype.modify=function(t){return!1},e.prototype.readQuery=function(t,i){return void 0===i&&(i=!!t.optimistic),this.read(k(k({},t),{rootId:t.id||"ROOT_QUERY",optimistic:i}))},e.prototype.readFragment=function(t,i){return void 0===i&&(i=!!t.optimistic),this.read(k(k({},t),{query:this.getFragmentDoc(t.fragment,t.fragmentName),rootId:t.id,optimistic:i}))},e.prototype.writeQuery=function(t){var i=t.id,n=t.data,r=si(t,["id","data"]);return this.write(Object.assign(r,{dataId:i||"ROOT_QUERY",result:n}))},e.prototype.writeFragment=function(t){var i=t.id,n=t.data,r=t.fragment,o=t.fragmentName,s=si(t,["id","data","fragment","fragmentName"]);return this.write(Object.assign(s,{query:this.getFragmentDoc(r,o),dataId:i,result:n}))},e.prototype.updateQuery=function(t,i){return this.batch({update:function(n){var r=n.readQuery(t),o=i(r);return null==o?r:(n.writeQuery(k(k({},t),{data:o})),o)}})},e.prototype.updateFragment=function(t,i){return this.batch({update:function(n){var r=n.readFragment(t),o=i(r);return null==o?r:(n.writeFragment(k(k({},t),{data:o})),o)}})},e}(),cN=function(e){function t(i,n,r,o){var s,a=e.call(this,i)||this;if(a.message=i,a.path=n,a.query=r,a.variables=o,Array.isArray(a.path)){a.missing=a.message;for(var c=a.path.length-1;c>=0;--c)a.missing=((s={})[a.path[c]]=a.missing,s)}else a.missing=a.path;return a.__proto__=t.prototype,a}return Ln(t,e),t}(Error);function Dw(e){return!1!==globalThis.__DEV__&&function cse(e){var t=new Set([e]);return t.forEach(function(i){xt(i)&&function lse(e){if(!1!==globalThis.__DEV__&&!Object.isFrozen(e))try{Object.freeze(e)}catch(t){if(t instanceof TypeError)return null;throw t}return e}(i)===i&&Object.getOwnPropertyNames(i).forEach(function(n){xt(i[n])&&t.add(i[n])})}),e}(e),e}var on=Object.prototype.hasOwnProperty;function Ud(e){return null==e}function lN(e,t){var i=e.__typename,n=e.id,r=e._id;if("string"==typeof i&&(t&&(t.keyObject=Ud(n)?Ud(r)?void 0:{_id:r}:{id:n}),Ud(n)&&!Ud(r)&&(n=r),!Ud(n)))return"".concat(i,":").concat("number"==typeof n||"string"==typeof n?n:JSON.stringify(n))}var dN={dataIdFromObject:lN,addTypename:!0,resultCaching:!0,canonizeResults:!1};function uN(e){var t=e.canonizeResults;return void 0===t?dN.canonizeResults:t}var hN=/^[_a-z][_0-9a-z]*/i;function yo(e){var t=e.match(hN);return t?t[0]:e}function Ew(e,t,i){return!!xt(t)&&(Mt(t)?t.every(function(n){return Ew(e,n,i)}):e.selections.every(function(n){if(go(n)&&Ad(n,i)){var r=mo(n);return on.call(t,r)&&(!n.selectionSet||Ew(n.selectionSet,t[r],i))}return!0}))}function gc(e){return xt(e)&&!ot(e)&&!Mt(e)}function fN(e,t){var i=Ip(Op(e));return{fragmentMap:i,lookupFragment:function(n){var r=i[n];return!r&&t&&(r=t.lookup(n)),r||null}}}var hm=Object.create(null),Sw=function(){return hm},pN=Object.create(null),$d=function(){function e(t,i){var n=this;this.policies=t,this.group=i,this.data=Object.create(null),this.rootIds=Object.create(null),this.refs=Object.create(null),this.getFieldValue=function(r,o){return Dw(ot(r)?n.get(r.__ref,o):r&&r[o])},this.canRead=function(r){return ot(r)?n.has(r.__ref):"object"==typeof r},this.toReference=function(r,o){if("string"==typeof r)return Ja(r);if(ot(r))return r;var s=n.policies.identify(r)[0];if(s){var a=Ja(s);return o&&n.merge(s,r),a}}}return e.prototype.toObject=function(){return k({},this.data)},e.prototype.has=function(t){return void 0!==this.lookup(t,!0)},e.prototype.get=function(t,i){if(this.group.depend(t,i),on.call(this.data,t)){var n=this.data[t];if(n&&on.call(n,i))return n[i]}return"__typename"===i&&on.call(this.policies.rootTypenamesById,t)?this.policies.rootTypenamesById[t]:this instanceof wo?this.parent.get(t,i):void 0},e.prototype.lookup=function(t,i){return i&&this.group.depend(t,"__exists"),on.call(this.data,t)?this.data[t]:this instanceof wo?this.parent.lookup(t,i):this.policies.rootTypenamesById[t]?Object.create(null):void 0},e.prototype.merge=function(t,i){var r,n=this;ot(t)&&(t=t.__ref),ot(i)&&(i=i.__ref);var o="string"==typeof t?this.lookup(r=t):t,s="string"==typeof i?this.lookup(r=i):i;if(s){ye("string"==typeof r,1);var a=new _o(pse).merge(o,s);if(this.data[r]=a,a!==o&&(delete this.refs[r],this.group.caching)){var c=Object.create(null);o||(c.__exists=1),Object.keys(s).forEach(function(l){if(!o||o[l]!==a[l]){c[l]=1;var d=yo(l);d!==l&&!n.policies.hasKeyArgs(a.__typename,d)&&(c[d]=1),void 0===a[l]&&!(n instanceof wo)&&delete a[l]}}),c.__typename&&!(o&&o.__typename)&&this.policies.rootTypenamesById[r]===a.__typename&&delete c.__typename,Object.keys(c).forEach(function(l){return n.group.dirty(r,l)})}}},e.prototype.modify=function(t,i){var n=this,r=this.lookup(t);if(r){var o=Object.create(null),s=!1,a=!0,c={DELETE:hm,INVALIDATE:pN,isReference:ot,toReference:this.toReference,canRead:this.canRead,readField:function(l,d){return n.policies.readField("string"==typeof l?{fieldName:l,from:d||Ja(t)}:l,{store:n})}};if(Object.keys(r).forEach(function(l){var d=yo(l),u=r[l];if(void 0!==u){var h="function"==typeof i?i:i[l]||i[d];if(h){var f=h===Sw?hm:h(Dw(u),k(k({},c),{fieldName:d,storeFieldName:l,storage:n.getStorage(t,l)}));f===pN?n.group.dirty(t,l):(f===hm&&(f=void 0),f!==u&&(o[l]=f,s=!0,u=f))}void 0!==u&&(a=!1)}}),s)return this.merge(t,o),a&&(this instanceof wo?this.data[t]=void 0:delete this.data[t],this.group.dirty(t,"__exists")),!0}return!1},e.prototype.delete=function(t,i,n){var r,o=this.lookup(t);if(o){var s=this.getFieldValue(o,"__typename"),a=i&&n?this.policies.getStoreFieldName({typename:s,fieldName:i,args:n}):i;return this.modify(t,a?((r={})[a]=Sw,r):Sw)}return!1},e.prototype.evict=function(t,i){var n=!1;return t.id&&(on.call(this.data,t.id)&&(n=this.delete(t.id,t.fieldName,t.args)),this instanceof wo&&this!==i&&(n=this.parent.evict(t,i)||n),(t.fieldName||n)&&this.group.dirty(t.id,t.fieldName||"__exists")),n},e.prototype.clear=function(){this.replace(null)},e.prototype.extract=function(){var t=this,i=this.toObject(),n=[];return this.getRootIdSet().forEach(function(r){on.call(t.policies.rootTypenamesById,r)||n.push(r)}),n.length&&(i.__META={extraRootIds:n.sort()}),i},e.prototype.replace=function(t){var i=this;if(Object.keys(this.data).forEach(function(o){t&&on.call(t,o)||i.delete(o)}),t){var n=t.__META,r=si(t,["__META"]);Object.keys(r).forEach(function(o){i.merge(o,r[o])}),n&&n.extraRootIds.forEach(this.retain,this)}},e.prototype.retain=function(t){return this.rootIds[t]=(this.rootIds[t]||0)+1},e.prototype.release=function(t){if(this.rootIds[t]>0){var i=--this.rootIds[t];return i||delete this.rootIds[t],i}return 0},e.prototype.getRootIdSet=function(t){return void 0===t&&(t=new Set),Object.keys(this.rootIds).forEach(t.add,t),this instanceof wo?this.parent.getRootIdSet(t):Object.keys(this.policies.rootTypenamesById).forEach(t.add,t),t},e.prototype.gc=function(){var t=this,i=this.getRootIdSet(),n=this.toObject();i.forEach(function(s){on.call(n,s)&&(Object.keys(t.findChildRefIds(s)).forEach(i.add,i),delete n[s])});var r=Object.keys(n);if(r.length){for(var o=this;o instanceof wo;)o=o.parent;r.forEach(function(s){return o.delete(s)})}return r},e.prototype.findChildRefIds=function(t){if(!on.call(this.refs,t)){var i=this.refs[t]=Object.create(null),n=this.data[t];if(!n)return i;var r=new Set([n]);r.forEach(function(o){ot(o)&&(i[o.__ref]=!0),xt(o)&&Object.keys(o).forEach(function(s){var a=o[s];xt(a)&&r.add(a)})})}return this.refs[t]},e.prototype.makeCacheKey=function(){return this.group.keyMaker.lookupArray(arguments)},e}(),mN=function(){function e(t,i){void 0===i&&(i=null),this.caching=t,this.parent=i,this.d=null,this.resetCaching()}return e.prototype.resetCaching=function(){this.d=this.caching?aF():null,this.keyMaker=new bo(Nr)},e.prototype.depend=function(t,i){if(this.d){this.d(kw(t,i));var n=yo(i);n!==i&&this.d(kw(t,n)),this.parent&&this.parent.depend(t,i)}},e.prototype.dirty=function(t,i){this.d&&this.d.dirty(kw(t,i),"__exists"===i?"forget":"setDirty")},e}();function kw(e,t){return t+"#"+e}function gN(e,t){qd(e)&&e.group.depend(t,"__exists")}!function(e){var t=function(i){function n(r){var s=r.resultCaching,c=r.seed,l=i.call(this,r.policies,new mN(void 0===s||s))||this;return l.stump=new fse(l),l.storageTrie=new bo(Nr),c&&l.replace(c),l}return Ln(n,i),n.prototype.addLayer=function(r,o){return this.stump.addLayer(r,o)},n.prototype.removeLayer=function(){return this},n.prototype.getStorage=function(){return this.storageTrie.lookupArray(arguments)},n}(e);e.Root=t}($d||($d={}));var wo=function(e){function t(i,n,r,o){var s=e.call(this,n.policies,o)||this;return s.id=i,s.parent=n,s.replay=r,s.group=o,r(s),s}return Ln(t,e),t.prototype.addLayer=function(i,n){return new t(i,this,n,this.group)},t.prototype.removeLayer=function(i){var n=this,r=this.parent.removeLayer(i);return i===this.id?(this.group.caching&&Object.keys(this.data).forEach(function(o){var s=n.data[o],a=r.lookup(o);a?s?s!==a&&Object.keys(s).forEach(function(c){It(s[c],a[c])||n.group.dirty(o,c)}):(n.group.dirty(o,"__exists"),Object.keys(a).forEach(function(c){n.group.dirty(o,c)})):n.delete(o)}),r):r===this.parent?this:r.addLayer(this.id,this.replay)},t.prototype.toObject=function(){return k(k({},this.parent.toObject()),this.data)},t.prototype.findChildRefIds=function(i){var n=this.parent.findChildRefIds(i);return on.call(this.data,i)?k(k({},n),e.prototype.findChildRefIds.call(this,i)):n},t.prototype.getStorage=function(){for(var i=this.parent;i.parent;)i=i.parent;return i.getStorage.apply(i,arguments)},t}($d),fse=function(e){function t(i){return e.call(this,"EntityStore.Stump",i,function(){},new mN(i.group.caching,i.group))||this}return Ln(t,e),t.prototype.removeLayer=function(){return this},t.prototype.merge=function(){return this.parent.merge.apply(this.parent,arguments)},t}(wo);function pse(e,t,i){var n=e[i],r=t[i];return It(n,r)?n:r}function qd(e){return!!(e instanceof $d&&e.group.caching)}function _N(e){return[e.selectionSet,e.objectOrReference,e.context,e.context.canonizeResults]}var mse=function(){function e(t){var i=this;this.knownResults=new(Nr?WeakMap:Map),this.config=sc(t,{addTypename:!1!==t.addTypename,canonizeResults:uN(t)}),this.canon=t.canon||new A0,this.executeSelectionSet=zp(function(n){var r,o=n.context.canonizeResults,s=_N(n);s[3]=!o;var a=(r=i.executeSelectionSet).peek.apply(r,s);return a?o?k(k({},a),{result:i.canon.admit(a.result)}):a:(gN(n.context.store,n.enclosingRef.__ref),i.execSelectionSetImpl(n))},{max:this.config.resultCacheMaxSize,keyArgs:_N,makeCacheKey:function(n,r,o,s){if(qd(o.store))return o.store.makeCacheKey(n,ot(r)?r.__ref:r,o.varString,s)}}),this.executeSubSelectedArray=zp(function(n){return gN(n.context.store,n.enclosingRef.__ref),i.execSubSelectedArrayImpl(n)},{max:this.config.resultCacheMaxSize,makeCacheKey:function(n){var r=n.field,o=n.array,s=n.context;if(qd(s.store))return s.store.makeCacheKey(r,o,s.varString)}})}return e.prototype.resetCanon=function(){this.canon=new A0},e.prototype.diffQueryAgainstStore=function(t){var i=t.store,n=t.query,r=t.rootId,o=void 0===r?"ROOT_QUERY":r,s=t.variables,a=t.returnPartialData,c=void 0===a||a,l=t.canonizeResults,d=void 0===l?this.config.canonizeResults:l,u=this.config.cache.policies;s=k(k({},g0(oO(n))),s);var p,h=Ja(o),f=this.executeSelectionSet({selectionSet:Td(n).selectionSet,objectOrReference:h,enclosingRef:h,context:k({store:i,query:n,policies:u,variables:s,varString:_s(s),canonizeResults:d},fN(n,this.config.fragments))});if(f.missing&&(p=[new cN(gse(f.missing),f.missing,n,s)],!c))throw p[0];return{result:f.result,complete:!p,missing:p}},e.prototype.isFresh=function(t,i,n,r){if(qd(r.store)&&this.knownResults.get(t)===n){var o=this.executeSelectionSet.peek(n,i,r,this.canon.isKnown(t));if(o&&t===o.result)return!0}return!1},e.prototype.execSelectionSetImpl=function(t){var i=this,n=t.selectionSet,r=t.objectOrReference,o=t.enclosingRef,s=t.context;if(ot(r)&&!s.policies.rootTypenamesById[r.__ref]&&!s.store.has(r.__ref))return{result:this.canon.empty,missing:"Dangling reference to missing ".concat(r.__ref," object")};var h,a=s.variables,c=s.policies,d=s.store.getFieldValue(r,"__typename"),u=[],f=new _o;function p(C,S){var N;return C.missing&&(h=f.merge(h,((N={})[S]=C.missing,N))),C.result}this.config.addTypename&&"string"==typeof d&&!c.rootIdsByTypename[d]&&u.push({__typename:d});var g=new Set(n.selections);g.forEach(function(C){var S,N;if(Ad(C,a))if(go(C)){var L=c.readField({fieldName:C.name.value,field:C,variables:s.variables,from:r},s),q=mo(C);void 0===L?T0.added(C)||(h=f.merge(h,((S={})[q]="Can't find field '".concat(C.name.value,"' on ").concat(ot(r)?r.__ref+" object":"object "+JSON.stringify(r,null,2)),S))):Mt(L)?L=p(i.executeSubSelectedArray({field:C,array:L,enclosingRef:o,context:s}),q):C.selectionSet?null!=L&&(L=p(i.executeSelectionSet({selectionSet:C.selectionSet,objectOrReference:L,enclosingRef:ot(L)?L:o,context:s}),q)):s.canonizeResults&&(L=i.canon.pass(L)),void 0!==L&&u.push(((N={})[q]=L,N))}else{var oe=Ap(C,s.lookupFragment);if(!oe&&C.kind===te.FRAGMENT_SPREAD)throw Fn(7,C.name.value);oe&&c.fragmentMatches(oe,d)&&oe.selectionSet.selections.forEach(g.add,g)}});var _={result:C0(u),missing:h},v=s.canonizeResults?this.canon.admit(_):Dw(_);return v.result&&this.knownResults.set(v.result,n),v},e.prototype.execSubSelectedArrayImpl=function(t){var a,i=this,n=t.field,r=t.array,o=t.enclosingRef,s=t.context,c=new _o;function l(d,u){var h;return d.missing&&(a=c.merge(a,((h={})[u]=d.missing,h))),d.result}return n.selectionSet&&(r=r.filter(s.store.canRead)),r=r.map(function(d,u){return null===d?null:Mt(d)?l(i.executeSubSelectedArray({field:n,array:d,enclosingRef:o,context:s}),u):n.selectionSet?l(i.executeSelectionSet({selectionSet:n.selectionSet,objectOrReference:d,enclosingRef:ot(d)?d:o,context:s}),u):(!1!==globalThis.__DEV__&&function _se(e,t,i){if(!t.selectionSet){var n=new Set([i]);n.forEach(function(r){xt(r)&&(ye(!ot(r),8,function use(e,t){return ot(t)?e.get(t.__ref,"__typename"):t&&t.__typename}(e,r),t.name.value),Object.values(r).forEach(n.add,n))})}}(s.store,n,d),d)}),{result:s.canonizeResults?this.canon.admit(r):r,missing:a}},e}();function gse(e){try{JSON.stringify(e,function(t,i){if("string"==typeof i)throw i;return i})}catch(t){return t}}var bN=Object.create(null);function Tw(e){var t=JSON.stringify(e);return bN[t]||(bN[t]=Object.create(null))}function vN(e){var t=Tw(e);return t.keyFieldsFn||(t.keyFieldsFn=function(i,n){var r=function(s,a){return n.readField(a,s)},o=n.keyObject=Mw(e,function(s){var a=_c(n.storeObject,s,r);return void 0===a&&i!==n.storeObject&&on.call(i,s[0])&&(a=_c(i,s,xN)),ye(void 0!==a,2,s.join("."),i),a});return"".concat(n.typename,":").concat(JSON.stringify(o))})}function yN(e){var t=Tw(e);return t.keyArgsFn||(t.keyArgsFn=function(i,n){var r=n.field,o=n.variables,s=n.fieldName,a=Mw(e,function(l){var d=l[0],u=d.charAt(0);if("@"!==u)if("$"!==u){if(i)return _c(i,l)}else{var g=d.slice(1);if(o&&on.call(o,g)){var b=l.slice(0);return b[0]=g,_c(o,b)}}else if(r&&dr(r.directives)){var h=d.slice(1),f=r.directives.find(function(_){return _.name.value===h}),p=f&&Rp(f,o);return p&&_c(p,l.slice(1))}}),c=JSON.stringify(a);return(i||"{}"!==c)&&(s+=":"+c),s})}function Mw(e,t){var i=new _o;return wN(e).reduce(function(n,r){var o,s=t(r);if(void 0!==s){for(var a=r.length-1;a>=0;--a)(o={})[r[a]]=s,s=o;n=i.merge(n,s)}return n},Object.create(null))}function wN(e){var t=Tw(e);if(!t.paths){var i=t.paths=[],n=[];e.forEach(function(r,o){Mt(r)?(wN(r).forEach(function(s){return i.push(n.concat(s))}),n.length=0):(n.push(r),Mt(e[o+1])||(i.push(n.slice(0)),n.length=0))})}return t.paths}function xN(e,t){return e[t]}function _c(e,t,i){return i=i||xN,CN(t.reduce(function n(r,o){return Mt(r)?r.map(function(s){return n(s,o)}):r&&i(r,o)},e))}function CN(e){return xt(e)?Mt(e)?e.map(CN):Mw(Object.keys(e).sort(),function(t){return _c(e,t)}):e}function Iw(e){return void 0!==e.args?e.args:e.field?Rp(e.field,e.variables):null}f0.setStringify(_s);var bse=function(){},DN=function(e,t){return t.fieldName},EN=function(e,t,i){return(0,i.mergeObjects)(e,t)},SN=function(e,t){return t},vse=function(){function e(t){this.config=t,this.typePolicies=Object.create(null),this.toBeAdded=Object.create(null),this.supertypeMap=new Map,this.fuzzySubtypes=new Map,this.rootIdsByTypename=Object.create(null),this.rootTypenamesById=Object.create(null),this.usingPossibleTypes=!1,this.config=k({dataIdFromObject:lN},t),this.cache=this.config.cache,this.setRootTypename("Query"),this.setRootTypename("Mutation"),this.setRootTypename("Subscription"),t.possibleTypes&&this.addPossibleTypes(t.possibleTypes),t.typePolicies&&this.addTypePolicies(t.typePolicies)}return e.prototype.identify=function(t,i){var n,r=this,o=i&&(i.typename||(null===(n=i.storeObject)||void 0===n?void 0:n.__typename))||t.__typename;if(o===this.rootTypenamesById.ROOT_QUERY)return["ROOT_QUERY"];for(var c,s=i&&i.storeObject||t,a=k(k({},i),{typename:o,storeObject:s,readField:i&&i.readField||function(){var h=Aw(arguments,s);return r.readField(h,{store:r.cache.data,variables:h.variables})}}),l=o&&this.getTypePolicy(o),d=l&&l.keyFn||this.config.dataIdFromObject;d;){var u=d(k(k({},t),s),a);if(!Mt(u)){c=u;break}d=vN(u)}return c=c?String(c):void 0,a.keyObject?[c,a.keyObject]:[c]},e.prototype.addTypePolicies=function(t){var i=this;Object.keys(t).forEach(function(n){var r=t[n],o=r.queryType,s=r.mutationType,a=r.subscriptionType,c=si(r,["queryType","mutationType","subscriptionType"]);o&&i.setRootTypename("Query",n),s&&i.setRootTypename("Mutation",n),a&&i.setRootTypename("Subscription",n),on.call(i.toBeAdded,n)?i.toBeAdded[n].push(c):i.toBeAdded[n]=[c]})},e.prototype.updateTypePolicy=function(t,i){var n=this,r=this.getTypePolicy(t),o=i.keyFields,s=i.fields;function a(c,l){c.merge="function"==typeof l?l:!0===l?EN:!1===l?SN:c.merge}a(r,i.merge),r.keyFn=!1===o?bse:Mt(o)?vN(o):"function"==typeof o?o:r.keyFn,s&&Object.keys(s).forEach(function(c){var l=n.getFieldPolicy(t,c,!0),d=s[c];if("function"==typeof d)l.read=d;else{var u=d.keyArgs,h=d.read,f=d.merge;l.keyFn=!1===u?DN:Mt(u)?yN(u):"function"==typeof u?u:l.keyFn,"function"==typeof h&&(l.read=h),a(l,f)}l.read&&l.merge&&(l.keyFn=l.keyFn||DN)})},e.prototype.setRootTypename=function(t,i){void 0===i&&(i=t);var n="ROOT_"+t.toUpperCase(),r=this.rootTypenamesById[n];i!==r&&(ye(!r||r===t,3,t),r&&delete this.rootIdsByTypename[r],this.rootIdsByTypename[i]=n,this.rootTypenamesById[n]=i)},e.prototype.addPossibleTypes=function(t){var i=this;this.usingPossibleTypes=!0,Object.keys(t).forEach(function(n){i.getSupertypeSet(n,!0),t[n].forEach(function(r){i.getSupertypeSet(r,!0).add(n);var o=r.match(hN);(!o||o[0]!==r)&&i.fuzzySubtypes.set(r,new RegExp(r))})})},e.prototype.getTypePolicy=function(t){var i=this;if(!on.call(this.typePolicies,t)){var n=this.typePolicies[t]=Object.create(null);n.fields=Object.create(null);var r=this.supertypeMap.get(t);!r&&this.fuzzySubtypes.size&&(r=this.getSupertypeSet(t,!0),this.fuzzySubtypes.forEach(function(s,a){if(s.test(t)){var c=i.supertypeMap.get(a);c&&c.forEach(function(l){return r.add(l)})}})),r&&r.size&&r.forEach(function(s){var a=i.getTypePolicy(s),c=a.fields,l=si(a,["fields"]);Object.assign(n,l),Object.assign(n.fields,c)})}var o=this.toBeAdded[t];return o&&o.length&&o.splice(0).forEach(function(s){i.updateTypePolicy(t,s)}),this.typePolicies[t]},e.prototype.getFieldPolicy=function(t,i,n){if(t){var r=this.getTypePolicy(t).fields;return r[i]||n&&(r[i]=Object.create(null))}},e.prototype.getSupertypeSet=function(t,i){var n=this.supertypeMap.get(t);return!n&&i&&this.supertypeMap.set(t,n=new Set),n},e.prototype.fragmentMatches=function(t,i,n,r){var o=this;if(!t.typeCondition)return!0;if(!i)return!1;var s=t.typeCondition.name.value;if(i===s)return!0;if(this.usingPossibleTypes&&this.supertypeMap.has(s))for(var a=this.getSupertypeSet(i,!0),c=[a],l=function(p){var g=o.getSupertypeSet(p,!1);g&&g.size&&c.indexOf(g)<0&&c.push(g)},d=!(!n||!this.fuzzySubtypes.size),u=!1,h=0;h<c.length;++h){var f=c[h];if(f.has(s))return a.has(s)||(u&&!1!==globalThis.__DEV__&&ye.warn(4,i,s),a.add(s)),!0;f.forEach(l),d&&h===c.length-1&&Ew(t.selectionSet,n,r)&&(d=!1,u=!0,this.fuzzySubtypes.forEach(function(p,g){var b=i.match(p);b&&b[0]===i&&l(g)}))}return!1},e.prototype.hasKeyArgs=function(t,i){var n=this.getFieldPolicy(t,i,!1);return!(!n||!n.keyFn)},e.prototype.getStoreFieldName=function(t){var o,i=t.typename,n=t.fieldName,r=this.getFieldPolicy(i,n,!1),s=r&&r.keyFn;if(s&&i)for(var a={typename:i,fieldName:n,field:t.field||null,variables:t.variables},c=Iw(t);s;){var l=s(c,a);if(!Mt(l)){o=l||n;break}s=yN(l)}return void 0===o&&(o=t.field?function zK(e,t){var i=null;e.directives&&(i={},e.directives.forEach(function(r){i[r.name.value]={},r.arguments&&r.arguments.forEach(function(o){return ec(i[r.name.value],o.name,o.value,t)})}));var n=null;return e.arguments&&e.arguments.length&&(n={},e.arguments.forEach(function(r){return ec(n,r.name,r.value,t)})),f0(e.name.value,n,i)}(t.field,t.variables):f0(n,Iw(t))),!1===o?n:n===yo(o)?o:n+":"+o},e.prototype.readField=function(t,i){var n=t.from;if(n&&(t.field||t.fieldName)){if(void 0===t.typename){var o=i.store.getFieldValue(n,"__typename");o&&(t.typename=o)}var s=this.getStoreFieldName(t),a=yo(s),c=i.store.getFieldValue(n,s),l=this.getFieldPolicy(t.typename,a,!1),d=l&&l.read;if(d){var u=kN(this,n,t,i,i.store.getStorage(ot(n)?n.__ref:n,s));return q0.withValue(this.cache,d,[c,u])}return c}},e.prototype.getReadFunction=function(t,i){var n=this.getFieldPolicy(t,i,!1);return n&&n.read},e.prototype.getMergeFunction=function(t,i,n){var r=this.getFieldPolicy(t,i,!1),o=r&&r.merge;return!o&&n&&(o=(r=this.getTypePolicy(n))&&r.merge),o},e.prototype.runMergeFunction=function(t,i,n,r,o){var s=n.field,a=n.typename,c=n.merge;return c===EN?TN(r.store)(t,i):c===SN?i:(r.overwrite&&(t=void 0),c(t,i,kN(this,void 0,{typename:a,fieldName:s.name.value,field:s,variables:r.variables},r,o||Object.create(null))))},e}();function kN(e,t,i,n,r){var o=e.getStoreFieldName(i),s=yo(o),a=i.variables||n.variables,c=n.store,l=c.toReference,d=c.canRead;return{args:Iw(i),field:i.field||null,fieldName:s,storeFieldName:o,variables:a,isReference:ot,toReference:l,storage:r,cache:e.cache,canRead:d,readField:function(){return e.readField(Aw(arguments,t,a),n)},mergeObjects:TN(n.store)}}function Aw(e,t,i){var s,n=e[0];return"string"==typeof n?s={fieldName:n,from:e.length>1?e[1]:t}:(s=k({},n),on.call(s,"from")||(s.from=t)),!1!==globalThis.__DEV__&&void 0===s.from&&!1!==globalThis.__DEV__&&ye.warn(5,ZR(Array.from(e))),void 0===s.variables&&(s.variables=i),s}function TN(e){return function(i,n){if(Mt(i)||Mt(n))throw Fn(6);if(xt(i)&&xt(n)){var r=e.getFieldValue(i,"__typename"),o=e.getFieldValue(n,"__typename");if(r&&o&&r!==o)return n;if(ot(i)&&gc(n))return e.merge(i.__ref,n),i;if(gc(i)&&ot(n))return e.merge(i,n.__ref),n;if(gc(i)&&gc(n))return k(k({},i),n)}return n}}function Rw(e,t,i){var n="".concat(t).concat(i),r=e.flavors.get(n);return r||e.flavors.set(n,r=e.clientOnly===t&&e.deferred===i?e:k(k({},e),{clientOnly:t,deferred:i})),r}var yse=function(){function e(t,i,n){this.cache=t,this.reader=i,this.fragments=n}return e.prototype.writeToStore=function(t,i){var n=this,r=i.query,o=i.result,s=i.dataId,a=i.variables,c=i.overwrite,l=kd(r),d=function hse(){return new _o}();a=k(k({},g0(l)),a);var u=k(k({store:t,written:Object.create(null),merge:function(f,p){return d.merge(f,p)},variables:a,varString:_s(a)},fN(r,this.fragments)),{overwrite:!!c,incomingById:new Map,clientOnly:!1,deferred:!1,flavors:new Map}),h=this.processSelectionSet({result:o||Object.create(null),dataId:s,selectionSet:l.selectionSet,mergeTree:{map:new Map},context:u});if(!ot(h))throw Fn(9,o);return u.incomingById.forEach(function(f,p){var g=f.storeObject,b=f.mergeTree,_=f.fieldNodeSet,v=Ja(p);if(b&&b.map.size){var C=n.applyMerges(b,v,g,u);if(ot(C))return;g=C}if(!1!==globalThis.__DEV__&&!u.overwrite){var S=Object.create(null);_.forEach(function(q){q.selectionSet&&(S[q.name.value]=!0)}),Object.keys(g).forEach(function(q){(function(q){return!0===S[yo(q)]})(q)&&!function(q){var oe=b&&b.map.get(q);return!!(oe&&oe.info&&oe.info.merge)}(q)&&function wse(e,t,i,n){var r=function(u){var h=n.getFieldValue(u,i);return"object"==typeof h&&h},o=r(e);if(o){var s=r(t);if(s&&!ot(o)&&!It(o,s)&&!Object.keys(o).every(function(u){return void 0!==n.getFieldValue(s,u)})){var a=n.getFieldValue(e,"__typename")||n.getFieldValue(t,"__typename"),c=yo(i),l="".concat(a,".").concat(c);if(!RN.has(l)){RN.add(l);var d=[];!Mt(o)&&!Mt(s)&&[o,s].forEach(function(u){var h=n.getFieldValue(u,"__typename");"string"==typeof h&&!d.includes(h)&&d.push(h)}),!1!==globalThis.__DEV__&&ye.warn(12,c,a,d.length?"either ensure all objects of type "+d.join(" and ")+" have an ID or a custom merge function, or ":"",l,o,s)}}}}(v,g,q,u.store)})}t.merge(p,g)}),t.retain(h.__ref),h},e.prototype.processSelectionSet=function(t){var i=this,n=t.dataId,r=t.result,o=t.selectionSet,s=t.context,a=t.mergeTree,c=this.cache.policies,l=Object.create(null),d=n&&c.rootTypenamesById[n]||p0(r,o,s.fragmentMap)||n&&s.store.get(n,"__typename");"string"==typeof d&&(l.__typename=d);var u=function(){var C=Aw(arguments,l,s.variables);if(ot(C.from)){var S=s.incomingById.get(C.from.__ref);if(S){var N=c.readField(k(k({},C),{from:S.storeObject}),s);if(void 0!==N)return N}}return c.readField(C,s)},h=new Set;this.flattenFields(o,r,s,d).forEach(function(C,S){var N,L=mo(S),q=r[L];if(h.add(S),void 0!==q){var oe=c.getStoreFieldName({typename:d,fieldName:S.name.value,field:S,variables:C.variables}),Ne=IN(a,oe),qe=i.processFieldValue(q,S,S.selectionSet?Rw(C,!1,!1):C,Ne),At=void 0;S.selectionSet&&(ot(qe)||gc(qe))&&(At=u("__typename",qe));var Pn=c.getMergeFunction(d,S.name.value,At);Pn?Ne.info={field:S,typename:d,merge:Pn}:AN(a,oe),l=C.merge(l,((N={})[oe]=qe,N))}else!1!==globalThis.__DEV__&&!C.clientOnly&&!C.deferred&&!T0.added(S)&&!c.getReadFunction(d,S.name.value)&&!1!==globalThis.__DEV__&&ye.error(10,mo(S),r)});try{var f=c.identify(r,{typename:d,selectionSet:o,fragmentMap:s.fragmentMap,storeObject:l,readField:u}),g=f[1];n=n||f[0],g&&(l=s.merge(l,g))}catch(C){if(!n)throw C}if("string"==typeof n){var b=Ja(n),_=s.written[n]||(s.written[n]=[]);if(_.indexOf(o)>=0||(_.push(o),this.reader&&this.reader.isFresh(r,b,o,s)))return b;var v=s.incomingById.get(n);return v?(v.storeObject=s.merge(v.storeObject,l),v.mergeTree=Ow(v.mergeTree,a),h.forEach(function(C){return v.fieldNodeSet.add(C)})):s.incomingById.set(n,{storeObject:l,mergeTree:fm(a)?void 0:a,fieldNodeSet:h}),b}return l},e.prototype.processFieldValue=function(t,i,n,r){var o=this;return i.selectionSet&&null!==t?Mt(t)?t.map(function(s,a){var c=o.processFieldValue(s,i,n,IN(r,a));return AN(r,a),c}):this.processSelectionSet({result:t,selectionSet:i.selectionSet,context:n,mergeTree:r}):!1!==globalThis.__DEV__?HO(t):t},e.prototype.flattenFields=function(t,i,n,r){void 0===r&&(r=p0(i,t,n.fragmentMap));var o=new Map,s=this.cache.policies,a=new bo(!1);return function c(l,d){var u=a.lookup(l,d.clientOnly,d.deferred);u.visited||(u.visited=!0,l.selections.forEach(function(h){if(Ad(h,n.variables)){var f=d.clientOnly,p=d.deferred;if(!(f&&p)&&dr(h.directives)&&h.directives.forEach(function(_){var v=_.name.value;if("client"===v&&(f=!0),"defer"===v){var C=Rp(_,n.variables);(!C||!1!==C.if)&&(p=!0)}}),go(h)){var g=o.get(h);g&&(f=f&&g.clientOnly,p=p&&g.deferred),o.set(h,Rw(n,f,p))}else{var b=Ap(h,n.lookupFragment);if(!b&&h.kind===te.FRAGMENT_SPREAD)throw Fn(11,h.name.value);b&&s.fragmentMatches(b,r,i,n.variables)&&c(b.selectionSet,Rw(n,f,p))}}}))}(t,n),o},e.prototype.applyMerges=function(t,i,n,r,o){var s,a=this;if(t.map.size&&!ot(n)){var c=Mt(n)||!ot(i)&&!gc(i)?void 0:i,l=n;c&&!o&&(o=[ot(c)?c.__ref:c]);var d,u=function(h,f){return Mt(h)?"number"==typeof f?h[f]:void 0:r.store.getFieldValue(h,String(f))};t.map.forEach(function(h,f){var p=u(c,f),g=u(l,f);if(void 0!==g){o&&o.push(f);var b=a.applyMerges(h,p,g,r,o);b!==g&&(d=d||new Map).set(f,b),o&&ye(o.pop()===f)}}),d&&(n=Mt(l)?l.slice(0):k({},l),d.forEach(function(h,f){n[f]=h}))}return t.info?this.cache.policies.runMergeFunction(i,n,t.info,r,o&&(s=r.store).getStorage.apply(s,o)):n},e}(),MN=[];function IN(e,t){var i=e.map;return i.has(t)||i.set(t,MN.pop()||{map:new Map}),i.get(t)}function Ow(e,t){if(e===t||!t||fm(t))return e;if(!e||fm(e))return t;var i=e.info&&t.info?k(k({},e.info),t.info):e.info||t.info,n=e.map.size&&t.map.size,o={info:i,map:n?new Map:e.map.size?e.map:t.map};if(n){var s=new Set(t.map.keys());e.map.forEach(function(a,c){o.map.set(c,Ow(a,t.map.get(c))),s.delete(c)}),s.forEach(function(a){o.map.set(a,Ow(t.map.get(a),e.map.get(a)))})}return o}function fm(e){return!e||!(e.info||e.map.size)}function AN(e,t){var i=e.map,n=i.get(t);n&&fm(n)&&(MN.push(n),i.delete(t))}var RN=new Set,xse=function(e){function t(i){void 0===i&&(i={});var n=e.call(this)||this;return n.watches=new Set,n.addTypenameTransform=new BO(T0),n.assumeImmutableResults=!0,n.makeVar=HZ,n.txCount=0,n.config=function dse(e){return sc(dN,e)}(i),n.addTypename=!!n.config.addTypename,n.policies=new vse({cache:n,dataIdFromObject:n.config.dataIdFromObject,possibleTypes:n.config.possibleTypes,typePolicies:n.config.typePolicies}),n.init(),n}return Ln(t,e),t.prototype.init=function(){var i=this.data=new $d.Root({policies:this.policies,resultCaching:this.config.resultCaching});this.optimisticData=i.stump,this.resetResultCache()},t.prototype.resetResultCache=function(i){var n=this,r=this.storeReader,o=this.config.fragments;this.storeWriter=new yse(this,this.storeReader=new mse({cache:this,addTypename:this.addTypename,resultCacheMaxSize:this.config.resultCacheMaxSize,canonizeResults:uN(this.config),canon:i?void 0:r&&r.canon,fragments:o}),o),this.maybeBroadcastWatch=zp(function(s,a){return n.broadcastWatch(s,a)},{max:this.config.resultCacheMaxSize,makeCacheKey:function(s){var a=s.optimistic?n.optimisticData:n.data;if(qd(a))return a.makeCacheKey(s.query,s.callback,_s({optimistic:s.optimistic,id:s.id,variables:s.variables}))}}),new Set([this.data.group,this.optimisticData.group]).forEach(function(s){return s.resetCaching()})},t.prototype.restore=function(i){return this.init(),i&&this.data.replace(i),this},t.prototype.extract=function(i){return void 0===i&&(i=!1),(i?this.optimisticData:this.data).extract()},t.prototype.read=function(i){var n=i.returnPartialData,r=void 0!==n&&n;try{return this.storeReader.diffQueryAgainstStore(k(k({},i),{store:i.optimistic?this.optimisticData:this.data,config:this.config,returnPartialData:r})).result||null}catch(o){if(o instanceof cN)return null;throw o}},t.prototype.write=function(i){try{return++this.txCount,this.storeWriter.writeToStore(this.data,i)}finally{! --this.txCount&&!1!==i.broadcast&&this.broadcastWatches()}},t.prototype.modify=function(i){if(on.call(i,"id")&&!i.id)return!1;var n=i.optimistic?this.optimisticData:this.data;try{return++this.txCount,n.modify(i.id||"ROOT_QUERY",i.fields)}finally{! --this.txCount&&!1!==i.broadcast&&this.broadcastWatches()}},t.prototype.diff=function(i){return this.storeReader.diffQueryAgainstStore(k(k({},i),{store:i.optimistic?this.optimisticData:this.data,rootId:i.id||"ROOT_QUERY",config:this.config}))},t.prototype.watch=function(i){var n=this;return this.watches.size||function jZ(e){Nd(e).vars.forEach(function(t){return t.attachCache(e)})}(this),this.watches.add(i),i.immediate&&this.maybeBroadcastWatch(i),function(){n.watches.delete(i)&&!n.watches.size&&dF(n),n.maybeBroadcastWatch.forget(i)}},t.prototype.gc=function(i){_s.reset();var n=this.optimisticData.gc();return i&&!this.txCount&&(i.resetResultCache?this.resetResultCache(i.resetResultIdentities):i.resetResultIdentities&&this.storeReader.resetCanon()),n},t.prototype.retain=function(i,n){return(n?this.optimisticData:this.data).retain(i)},t.prototype.release=function(i,n){return(n?this.optimisticData:this.data).release(i)},t.prototype.identify=function(i){if(ot(i))return i.__ref;try{return this.policies.identify(i)[0]}catch(n){!1!==globalThis.__DEV__&&ye.warn(n)}},t.prototype.evict=function(i){if(!i.id){if(on.call(i,"id"))return!1;i=k(k({},i),{id:"ROOT_QUERY"})}try{return++this.txCount,this.optimisticData.evict(i,this.data)}finally{! --this.txCount&&!1!==i.broadcast&&this.broadcastWatches()}},t.prototype.reset=function(i){var n=this;return this.init(),_s.reset(),i&&i.discardWatches?(this.watches.forEach(function(r){return n.maybeBroadcastWatch.forget(r)}),this.watches.clear(),dF(this)):this.broadcastWatches(),Promise.resolve()},t.prototype.removeOptimistic=function(i){var n=this.optimisticData.removeLayer(i);n!==this.optimisticData&&(this.optimisticData=n,this.broadcastWatches())},t.prototype.batch=function(i){var l,n=this,r=i.update,o=i.optimistic,s=void 0===o||o,a=i.removeOptimistic,c=i.onWatchUpdated,d=function(h){var p=n.data,g=n.optimisticData;++n.txCount,h&&(n.data=n.optimisticData=h);try{return l=r(n)}finally{--n.txCount,n.data=p,n.optimisticData=g}},u=new Set;return c&&!this.txCount&&this.broadcastWatches(k(k({},i),{onWatchUpdated:function(h){return u.add(h),!1}})),"string"==typeof s?this.optimisticData=this.optimisticData.addLayer(s,d):!1===s?d(this.data):d(),"string"==typeof a&&(this.optimisticData=this.optimisticData.removeLayer(a)),c&&u.size?(this.broadcastWatches(k(k({},i),{onWatchUpdated:function(h,f){var p=c.call(this,h,f);return!1!==p&&u.delete(h),p}})),u.size&&u.forEach(function(h){return n.maybeBroadcastWatch.dirty(h)})):this.broadcastWatches(i),l},t.prototype.performTransaction=function(i,n){return this.batch({update:i,optimistic:n||null!==n})},t.prototype.transformDocument=function(i){return this.addTypenameToDocument(this.addFragmentsToDocument(i))},t.prototype.broadcastWatches=function(i){var n=this;this.txCount||this.watches.forEach(function(r){return n.maybeBroadcastWatch(r,i)})},t.prototype.addFragmentsToDocument=function(i){var n=this.config.fragments;return n?n.transform(i):i},t.prototype.addTypenameToDocument=function(i){return this.addTypename?this.addTypenameTransform.transformDocument(i):i},t.prototype.broadcastWatch=function(i,n){var r=i.lastDiff,o=this.diff(i);n&&(i.optimistic&&"string"==typeof n.optimistic&&(o.fromOptimisticTransaction=!0),n.onWatchUpdated&&!1===n.onWatchUpdated.call(this,i,o,r))||(!r||!It(r.result,o.result))&&i.callback(i.lastDiff=o,r)},t}(ase);const Cse=window.location.protocol+"//"+window.location.hostname+":"+window.location.port+"/graphql";function Dse(e){return{link:e.create({uri:Cse}),cache:new xse({typePolicies:{Query:{fields:{search:{merge:(t,i)=>({...t,...i})}}}}})}}let Ese=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275mod=le({type:e}),e.\u0275inj=ae({providers:[{provide:IF,useFactory:Dse,deps:[sse]},OF],imports:[EJ]}),t})(),Sse=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275mod=le({type:e}),e.\u0275inj=ae({imports:[vn,jd,Yl,eN,jf,Gv,jre]}),t})(),kse=(()=>{var e;class t{}return(e=t).\u0275fac=function(n){return new(n||e)},e.\u0275mod=le({type:e}),e.\u0275inj=ae({imports:[wI,iee,vn,nte,jf,hte,Dte,Zte,ene,_ne,jd,Gv,Ene,Ine,jne,eN,Yne,PF,vie,sre,qre,Sse,hK]}),t})(),Tse=(()=>{var e;class t{constructor(n,r){n.setDefaultFontSetClass("material-icons-outlined"),n.addSvgIcon("magnet",r.bypassSecurityTrustResourceUrl("assets/magnet.svg"))}}return(e=t).\u0275fac=function(n){return new(n||e)(D(Xl),D(Zh))},e.\u0275mod=le({type:e,bootstrap:[rse]}),e.\u0275inj=ae({providers:[LF],imports:[pY,wI,_M,Ese,H$,jf,GG,Gv,Q9,UG,kse]}),t})();d$().bootstrapModule(Tse).catch(e=>console.error(e))},622:function(yc){yc.exports=function(){"use strict";function xo(ki){return(xo="function"==typeof Symbol&&"symbol"==typeof Symbol.iterator?function(st){return typeof st}:function(st){return st&&"function"==typeof Symbol&&st.constructor===Symbol&&st!==Symbol.prototype?"symbol":typeof st})(ki)}var Co="iec",hr="jedec",Eo={symbol:{iec:{bits:["bit","Kibit","Mibit","Gibit","Tibit","Pibit","Eibit","Zibit","Yibit"],bytes:["B","KiB","MiB","GiB","TiB","PiB","EiB","ZiB","YiB"]},jedec:{bits:["bit","Kbit","Mbit","Gbit","Tbit","Pbit","Ebit","Zbit","Ybit"],bytes:["B","KB","MB","GB","TB","PB","EB","ZB","YB"]}},fullform:{iec:["","kibi","mebi","gibi","tebi","pebi","exbi","zebi","yobi"],jedec:["","kilo","mega","giga","tera","peta","exa","zetta","yotta"]}};function Si(ki){var st=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{},Ds=st.bits,So=void 0!==Ds&&Ds,Es=st.pad,bm=void 0!==Es&&Es,Vr=st.base,Nn=void 0===Vr?-1:Vr,Yd=st.round,ko=void 0===Yd?2:Yd,Kd=st.locale,jr=void 0===Kd?"":Kd,Ti=st.localeOptions,vm=void 0===Ti?{}:Ti,xc=st.separator,Me=void 0===xc?"":xc,Cc=st.spacer,ym=void 0===Cc?" ":Cc,Xd=st.symbols,wm=void 0===Xd?{}:Xd,Y=st.standard,Wn=void 0===Y?"":Y,Dc=st.output,at=void 0===Dc?"string":Dc,Ze=st.fullform,xm=void 0!==Ze&&Ze,J=st.fullforms,Ss=void 0===J?[]:J,Ln=st.exponent,k=void 0===Ln?-1:Ln,si=st.roundingMethod,Cm=void 0===si?"round":si,Zd=st.precision,Ec=void 0===Zd?0:Zd,Ct=k,To=Number(ki),Je=[],ks=0,Bn="";-1===Nn&&0===Wn.length?(Nn=10,Wn=hr):-1===Nn&&Wn.length>0?Nn=(Wn=Wn===Co?Co:hr)===Co?2:10:Wn=10==(Nn=2===Nn?2:10)||Wn===hr?hr:Co;var Vn=10===Nn?1e3:1024,Sc=!0===xm,Jd=To<0,Ts=Math[Cm];if(isNaN(ki))throw new TypeError("Invalid number");if("function"!==xo(Ts))throw new TypeError("Invalid rounding method");if(Jd&&(To=-To),(-1===Ct||isNaN(Ct))&&(Ct=Math.floor(Math.log(To)/Math.log(Vn)))<0&&(Ct=0),Ct>8&&(Ec>0&&(Ec+=8-Ct),Ct=8),"exponent"===at)return Ct;if(0===To)Je[0]=0,Bn=Je[1]=Eo.symbol[Wn][So?"bits":"bytes"][Ct];else{ks=To/(2===Nn?Math.pow(2,10*Ct):Math.pow(1e3,Ct)),So&&(ks*=8)>=Vn&&Ct<8&&(ks/=Vn,Ct++);var kc=Math.pow(10,Ct>0?ko:0);Je[0]=Ts(ks*kc)/kc,Je[0]===Vn&&Ct<8&&-1===k&&(Je[0]=1,Ct++),Bn=Je[1]=10===Nn&&1===Ct?So?"kbit":"kB":Eo.symbol[Wn][So?"bits":"bytes"][Ct]}if(Jd&&(Je[0]=-Je[0]),Ec>0&&(Je[0]=Je[0].toPrecision(Ec)),Je[1]=wm[Je[1]]||Je[1],!0===jr?Je[0]=Je[0].toLocaleString():jr.length>0?Je[0]=Je[0].toLocaleString(jr,vm):Me.length>0&&(Je[0]=Je[0].toString().replace(".",Me)),bm&&!1===Number.isInteger(Je[0])&&ko>0){var eu=Me||".",tu=Je[0].toString().split(eu),ai=tu[1]||"",Mi=ai.length,nu=ko-Mi;Je[0]="".concat(tu[0]).concat(eu).concat(ai.padEnd(Mi+nu,"0"))}return Sc&&(Je[1]=Ss[Ct]?Ss[Ct]:Eo.fullform[Wn][Ct]+(So?"bit":"byte")+(1===Je[0]?"":"s")),"array"===at?Je:"object"===at?{value:Je[0],symbol:Je[1],exponent:Ct,unit:Bn}:Je.join(ym)}return Si.partial=function(ki){return function(st){return Si(st,ki)}},Si}()}},yc=>{yc(yc.s=25)}]);
\ No newline at end of file